@@ -150,6 +150,7 @@ func (r *RenewingChecker) renewVpnCredentials(data *config.TokenData) error {
 	data.NordLynxPrivateKey = credentials.NordlynxPrivateKey
 	data.OpenVPNUsername = credentials.Username
 	data.OpenVPNPassword = credentials.Password
+	data.CredentialsUpdatedAt = credentials.UpdatedAt
 	return nil
 }
 
@@ -204,6 +205,7 @@ func saveVpnServerCredentials(userID int64, data config.TokenData) config.SaveFu
 		user.NordLynxPrivateKey = data.NordLynxPrivateKey
 		user.OpenVPNUsername = data.OpenVPNUsername
 		user.OpenVPNPassword = data.OpenVPNPassword
+		user.CredentialsUpdatedAt = data.CredentialsUpdatedAt
 		return c
 	}
 }
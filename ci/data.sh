@@ -50,13 +50,9 @@ sed "s/{DATE}/${TODAY}/; s/{VERSION}/${VERSION}/" < "${WORKDIR}"/contrib/manual/
 # copy manual pages
 gzip "${WORKDIR}"/dist/"${NAME}".1
 
-# patch autocomplete scripts
+# copy autocomplete scripts, same as printed by `nordvpn completion`
 mkdir -p "${WORKDIR}"/dist/autocomplete
-go mod download github.com/urfave/cli/v2
-cp "${GOPATH}"/pkg/mod/github.com/urfave/cli/v2@v2.25.0/autocomplete/bash_autocomplete \
-	"${WORKDIR}"/dist/autocomplete/bash_autocomplete
-cp "${GOPATH}"/pkg/mod/github.com/urfave/cli/v2@v2.25.0/autocomplete/zsh_autocomplete \
-	"${WORKDIR}"/dist/autocomplete/zsh_autocomplete
-git apply contrib/patches/bash_autocomplete.diff
-git apply contrib/patches/zsh_autocomplete.diff
+for shell in bash zsh fish; do
+	cp "${WORKDIR}"/cli/completion/nordvpn."${shell}" "${WORKDIR}"/dist/autocomplete/nordvpn."${shell}"
+done
 
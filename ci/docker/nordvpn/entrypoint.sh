@@ -32,4 +32,4 @@ nordvpn login --token "$NORDVPN_LOGIN_TOKEN"
 
 /bin/bash -c "$@"
 
-tail -fn +1 --pid "$(pidof nordvpnd)" /var/log/nordvpn/daemon.log
+tail -Fn +1 --pid "$(pidof nordvpnd)" /var/log/nordvpn/daemon.log
@@ -168,15 +168,70 @@ func NewApp(version, environment, hash, salt string,
 				Action: cmd.SetFirewallMark,
 			},
 			{
-				Name:      "ipv6",
-				Usage:     SetIpv6UsageText,
-				Action:    cmd.SetIpv6,
+				Name:        "routing-table",
+				Usage:       SetRoutingTableUsageText,
+				Action:      cmd.SetRoutingTable,
+				ArgsUsage:   SetRoutingTableArgsUsageText,
+				Description: SetRoutingTableDescription,
+			},
+			{
+				Name:         "ipv6",
+				Usage:        SetIpv6UsageText,
+				Action:       cmd.SetIpv6,
+				BashComplete: cmd.SetIpv6AutoComplete,
+				ArgsUsage:    SetIpv6ArgsUsageText,
+				Description:  SetIpv6Description,
+			},
+			{
+				Name:      "dns-ipv6",
+				Usage:     SetDNSIPv6UsageText,
+				Action:    cmd.SetDNSIPv6,
 				ArgsUsage: MsgSetBoolArgsUsage,
 				Description: fmt.Sprintf(
 					MsgSetBoolDescription,
-					SetIpv6UsageText,
-					"ipv6",
-					"ipv6",
+					SetDNSIPv6UsageText,
+					"dns-ipv6",
+					"dns-ipv6",
+				),
+				BashComplete: cmd.SetBoolAutocomplete,
+			},
+			{
+				Name:        "dns-search",
+				Usage:       SetDNSSearchUsageText,
+				Action:      cmd.SetDNSSearch,
+				ArgsUsage:   SetDNSSearchArgsUsageText,
+				Description: SetDNSSearchDescription,
+			},
+			{
+				Name:        "dns-filter",
+				Usage:       SetDNSFilterUsageText,
+				Action:      cmd.SetDNSFilter,
+				ArgsUsage:   SetDNSFilterArgsUsageText,
+				Description: SetDNSFilterDescription,
+			},
+			{
+				Name:        "dns-route",
+				Usage:       SetDNSRouteUsageText,
+				Action:      cmd.SetDNSRoute,
+				ArgsUsage:   SetDNSRouteArgsUsageText,
+				Description: SetDNSRouteDescription,
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  flagDNSRouteTunnel,
+						Usage: SetDNSRouteTunnelUsageText,
+					},
+				},
+			},
+			{
+				Name:      "legacy-dns",
+				Usage:     SetLegacyDNSUsageText,
+				Action:    cmd.SetLegacyDNS,
+				ArgsUsage: MsgSetBoolArgsUsage,
+				Description: fmt.Sprintf(
+					MsgSetBoolDescription,
+					SetLegacyDNSUsageText,
+					"legacy-dns",
+					"legacy-dns",
 				),
 				BashComplete: cmd.SetBoolAutocomplete,
 			},
@@ -219,6 +274,40 @@ func NewApp(version, environment, hash, salt string,
 					"killswitch",
 				),
 			},
+			{
+				Name:         "killswitch-log",
+				Usage:        SetKillSwitchLogUsageText,
+				Action:       cmd.SetKillSwitchLog,
+				BashComplete: cmd.SetBoolAutocomplete,
+				ArgsUsage:    MsgSetBoolArgsUsage,
+				Description: fmt.Sprintf(
+					MsgSetBoolDescription,
+					SetKillSwitchLogUsageText,
+					"killswitch-log",
+					"killswitch-log",
+				),
+			},
+			{
+				Name:         "killswitch-lan",
+				Usage:        SetKillSwitchLANUsageText,
+				Action:       cmd.SetKillSwitchLAN,
+				BashComplete: cmd.SetKillSwitchLANAutoComplete,
+				ArgsUsage:    SetKillSwitchLANArgsUsageText,
+				Description:  SetKillSwitchLANDescription,
+			},
+			{
+				Name:         "multicast-discovery",
+				Usage:        SetMulticastDiscoveryUsageText,
+				Action:       cmd.SetMulticastDiscovery,
+				BashComplete: cmd.SetBoolAutocomplete,
+				ArgsUsage:    MsgSetBoolArgsUsage,
+				Description: fmt.Sprintf(
+					MsgSetBoolDescription,
+					SetMulticastDiscoveryUsageText,
+					"multicast-discovery",
+					"multicast-discovery",
+				),
+			},
 			{
 				Name:         "notify",
 				Usage:        SetNotifyUsageText,
@@ -246,6 +335,42 @@ func NewApp(version, environment, hash, salt string,
 				),
 				Hidden: cmd.Except(config.Technology_OPENVPN),
 			},
+			{
+				Name:         "obfuscation-mode",
+				Usage:        SetObfuscationModeUsageText,
+				Action:       cmd.SetObfuscationMode,
+				BashComplete: cmd.SetObfuscationModeAutoComplete,
+				ArgsUsage:    SetObfuscationModeArgsUsageText,
+				Description:  SetObfuscationModeDescription,
+				Hidden:       cmd.Except(config.Technology_OPENVPN),
+			},
+			{
+				Name:        "mtu",
+				Usage:       SetMTUUsageText,
+				Action:      cmd.SetMTU,
+				ArgsUsage:   SetMTUArgsUsageText,
+				Description: SetMTUDescription,
+			},
+			{
+				Name:        "interface-name",
+				Usage:       SetInterfaceNameUsageText,
+				Action:      cmd.SetInterfaceName,
+				ArgsUsage:   SetInterfaceNameArgsUsageText,
+				Description: SetInterfaceNameDescription,
+			},
+			{
+				Name:        "port",
+				Usage:       SetServerPortUsageText,
+				Action:      cmd.SetServerPort,
+				ArgsUsage:   SetServerPortArgsUsageText,
+				Description: SetServerPortDescription,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  flagServerPortTechnology,
+						Usage: SetServerPortTechnologyUsage,
+					},
+				},
+			},
 			{
 				Name:         "protocol",
 				Usage:        SetProtocolUsageText,
@@ -263,6 +388,14 @@ func NewApp(version, environment, hash, salt string,
 				ArgsUsage:    SetTechnologyArgsUsageText,
 				Description:  SetTechnologyDescription,
 			},
+			{
+				Name:         "allowed-technologies",
+				Usage:        SetAllowedTechnologiesUsageText,
+				Action:       cmd.SetAllowedTechnologies,
+				BashComplete: cmd.SetAllowedTechnologiesAutoComplete,
+				ArgsUsage:    SetAllowedTechnologiesArgsUsageText,
+				Description:  SetAllowedTechnologiesDescription,
+			},
 			{
 				Name:         "meshnet",
 				Aliases:      []string{"mesh"},
@@ -285,11 +418,206 @@ func NewApp(version, environment, hash, salt string,
 				Action:       cmd.SetLANDiscovery,
 				BashComplete: cmd.SetBoolAutocomplete,
 			},
+			{
+				Name:         "default-route",
+				Usage:        SetDefaultRouteUsageText,
+				Action:       cmd.SetDefaultRoute,
+				BashComplete: cmd.SetDefaultRouteAutoComplete,
+				ArgsUsage:    SetDefaultRouteArgsUsageText,
+				Description:  SetDefaultRouteDescription,
+			},
+			{
+				Name:         "firewall-backend",
+				Usage:        SetFirewallBackendUsageText,
+				Action:       cmd.SetFirewallBackend,
+				BashComplete: cmd.SetFirewallBackendAutoComplete,
+				ArgsUsage:    SetFirewallBackendArgsUsageText,
+				Description:  SetFirewallBackendDescription,
+			},
+			{
+				Name:         "subnet-overlap",
+				Usage:        SetSubnetOverlapUsageText,
+				Action:       cmd.SetSubnetOverlap,
+				BashComplete: cmd.SetSubnetOverlapAutoComplete,
+				ArgsUsage:    SetSubnetOverlapArgsUsageText,
+				Description:  SetSubnetOverlapDescription,
+			},
+			{
+				Name:         "loglevel",
+				Usage:        SetLogLevelUsageText,
+				Action:       cmd.SetLogLevel,
+				BashComplete: cmd.SetLogLevelAutoComplete,
+				ArgsUsage:    SetLogLevelArgsUsageText,
+				Description:  SetLogLevelDescription,
+			},
+			{
+				Name:         "selection",
+				Usage:        SetServerSelectionUsageText,
+				Action:       cmd.SetServerSelection,
+				BashComplete: cmd.SetServerSelectionAutoComplete,
+				ArgsUsage:    SetServerSelectionArgsUsageText,
+				Description:  SetServerSelectionDescription,
+			},
+			{
+				Name:         "network-manager",
+				Usage:        SetNetworkManagerUsageText,
+				Action:       cmd.SetNetworkManager,
+				BashComplete: cmd.SetBoolAutocomplete,
+				ArgsUsage:    MsgSetBoolArgsUsage,
+				Description: fmt.Sprintf(
+					MsgSetBoolDescription,
+					SetNetworkManagerUsageText,
+					"network-manager",
+					"network-manager",
+				),
+			},
+			{
+				Name:         "dns-monitor",
+				Usage:        SetDNSMonitorUsageText,
+				Action:       cmd.SetDNSMonitor,
+				BashComplete: cmd.SetBoolAutocomplete,
+				ArgsUsage:    MsgSetBoolArgsUsage,
+				Description: fmt.Sprintf(
+					MsgSetBoolDescription,
+					SetDNSMonitorUsageText,
+					"dns-monitor",
+					"dns-monitor",
+				),
+			},
+			{
+				Name:         "virtual-location",
+				Usage:        SetVirtualLocationUsageText,
+				Action:       cmd.SetVirtualLocation,
+				BashComplete: cmd.SetBoolAutocomplete,
+				ArgsUsage:    MsgSetBoolArgsUsage,
+				Description: fmt.Sprintf(
+					MsgSetBoolDescription,
+					SetVirtualLocationUsageText,
+					"virtual-location",
+					"virtual-location",
+				),
+			},
+			{
+				Name:         "firewall-template",
+				Usage:        SetFirewallTemplateUsageText,
+				Action:       cmd.SetFirewallTemplate,
+				BashComplete: cmd.SetFirewallTemplateAutoComplete,
+				ArgsUsage:    SetFirewallTemplateArgsUsageText,
+				Description:  SetFirewallTemplateDescription,
+			},
+			{
+				Name:         "metrics",
+				Usage:        SetMetricsUsageText,
+				Action:       cmd.SetMetrics,
+				BashComplete: cmd.SetBoolAutocomplete,
+				ArgsUsage:    MsgSetBoolArgsUsage,
+				Description:  SetMetricsDescription,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  flagMetricsAddress,
+						Usage: SetMetricsAddressUsage,
+					},
+				},
+			},
+			{
+				Name:         "rest-api",
+				Usage:        SetRESTAPIUsageText,
+				Action:       cmd.SetRESTAPI,
+				BashComplete: cmd.SetBoolAutocomplete,
+				ArgsUsage:    MsgSetBoolArgsUsage,
+				Description:  SetRESTAPIDescription,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  flagRESTAPIAddress,
+						Usage: SetRESTAPIAddressUsage,
+					},
+					&cli.BoolFlag{
+						Name:  flagRESTAPINewToken,
+						Usage: SetRESTAPITokenUsage,
+					},
+				},
+			},
+			{
+				Name:         "local-proxy",
+				Usage:        SetLocalProxyUsageText,
+				Action:       cmd.SetLocalProxy,
+				BashComplete: cmd.SetBoolAutocomplete,
+				ArgsUsage:    MsgSetBoolArgsUsage,
+				Description:  SetLocalProxyDescription,
+				Flags: []cli.Flag{
+					&cli.UintFlag{
+						Name:  flagLocalProxyPort,
+						Usage: SetLocalProxyPortUsage,
+					},
+				},
+			},
+			{
+				Name:         "on-demand",
+				Usage:        SetOnDemandUsageText,
+				Action:       cmd.SetOnDemand,
+				BashComplete: cmd.SetBoolAutocomplete,
+				ArgsUsage:    MsgSetBoolArgsUsage,
+				Description:  SetOnDemandDescription,
+				Flags: []cli.Flag{
+					&cli.DurationFlag{
+						Name:  flagOnDemandIdleTimeout,
+						Usage: SetOnDemandIdleTimeoutUsage,
+					},
+				},
+			},
+			{
+				Name:        "rating-weight",
+				Usage:       SetRatingWeightUsageText,
+				Action:      cmd.SetRatingWeight,
+				ArgsUsage:   SetRatingWeightArgsUsageText,
+				Description: SetRatingWeightDescription,
+			},
+			{
+				Name:        "idle-timeout",
+				Usage:       SetIdleTimeoutUsageText,
+				Action:      cmd.SetIdleTimeout,
+				ArgsUsage:   SetIdleTimeoutArgsUsageText,
+				Description: SetIdleTimeoutDescription,
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  flagIdleTimeoutKillSwitch,
+						Usage: SetIdleTimeoutKillSwitchUsage,
+					},
+				},
+			},
+			{
+				Name:         "metered",
+				Usage:        SetMeteredUsageText,
+				Action:       cmd.SetMetered,
+				BashComplete: cmd.SetBoolAutocomplete,
+				ArgsUsage:    MsgSetBoolArgsUsage,
+				Description:  SetMeteredDescription,
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  flagMeteredAllowRefresh,
+						Usage: SetMeteredAllowRefreshUsage,
+					},
+					&cli.BoolFlag{
+						Name:  flagMeteredAllowAutoConnect,
+						Usage: SetMeteredAllowAutoConnectUsage,
+					},
+				},
+			},
+			{
+				Name:         "reconnect",
+				Usage:        SetReconnectUsageText,
+				Action:       cmd.SetReconnect,
+				BashComplete: cmd.SetReconnectAutoComplete,
+				ArgsUsage:    SetReconnectArgsUsageText,
+				Description:  SetReconnectDescription,
+			},
 		},
 	}
 
 	app := cli.NewApp()
 	app.EnableBashCompletion = true
+	app.Flags = []cli.Flag{outputFlag()}
+	app.Before = validateOutput
 	status.Code(err)
 	cmd.loaderInterceptor = loaderInterceptor
 	app.After = func(*cli.Context) error {
@@ -309,6 +637,33 @@ func NewApp(version, environment, hash, salt string,
 			Usage:              AccountUsageText,
 			Action:             cmd.Account,
 			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+			Subcommands: []*cli.Command{
+				{
+					Name:  "credentials",
+					Usage: AccountCredentialsUsageText,
+					Subcommands: []*cli.Command{
+						{
+							Name:        "status",
+							Usage:       AccountCredentialsStatusUsageText,
+							Description: AccountCredentialsStatusDescription,
+							Action:      cmd.AccountCredentialsStatus,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:               "cache-stats",
+			Usage:              CacheStatsUsageText,
+			Description:        CacheStatsDescription,
+			Action:             cmd.CacheStats,
+			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  flagCacheStatsJSON,
+					Usage: CacheStatsJSONUsage,
+				},
+			},
 		},
 		{
 			Name:         "cities",
@@ -318,6 +673,14 @@ func NewApp(version, environment, hash, salt string,
 			ArgsUsage:    CitiesArgsUsageText,
 			Description:  CitiesDescription,
 		},
+		{
+			Name:         "completion",
+			Usage:        CompletionUsageText,
+			Action:       cmd.Completion,
+			BashComplete: cmd.CompletionAutoComplete,
+			ArgsUsage:    CompletionArgsUsageText,
+			Description:  CompletionDescription,
+		},
 		{
 			Name:         "connect",
 			Aliases:      []string{"c"},
@@ -331,6 +694,39 @@ func NewApp(version, environment, hash, salt string,
 					Name:  "group, g",
 					Usage: ConnectFlagGroupUsageText,
 				},
+				&cli.StringFlag{
+					Name:  flagFavorite,
+					Usage: ConnectFlagFavoriteUsageText,
+				},
+				&cli.BoolFlag{
+					Name:  flagRandom,
+					Usage: ConnectFlagRandomUsageText,
+				},
+				&cli.BoolFlag{
+					Name:  flagPreviewDNS,
+					Usage: ConnectFlagPreviewDNSUsage,
+				},
+				&cli.BoolFlag{
+					Name:  flagVerify,
+					Usage: ConnectFlagVerifyUsageText,
+				},
+				&cli.StringFlag{
+					Name:      flagLogTo,
+					Usage:     ConnectFlagLogToUsageText,
+					TakesFile: true,
+				},
+				&cli.StringFlag{
+					Name:  flagVia,
+					Usage: ConnectFlagViaUsageText,
+				},
+				&cli.StringFlag{
+					Name:  flagViaInterface,
+					Usage: ConnectFlagViaInterfaceUsageText,
+				},
+				&cli.StringFlag{
+					Name:  flagProfile,
+					Usage: ConnectFlagProfileUsageText,
+				},
 			},
 		},
 		{
@@ -339,6 +735,52 @@ func NewApp(version, environment, hash, salt string,
 			Action:             cmd.Countries,
 			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
 		},
+		{
+			Name:               "daemon",
+			Usage:              DaemonUsageText,
+			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+			Subcommands: []*cli.Command{
+				{
+					Name:        "health",
+					Usage:       DaemonHealthUsageText,
+					Description: DaemonHealthDescription,
+					Action:      cmd.DaemonHealth,
+				},
+			},
+		},
+		{
+			Name:  "dedicated-ip",
+			Usage: DedicatedIPUsageText,
+			Subcommands: []*cli.Command{
+				{
+					Name:               "list",
+					Usage:              DedicatedIPListUsageText,
+					Action:             cmd.DedicatedIPList,
+					CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+				},
+				{
+					Name:         "connect",
+					Usage:        DedicatedIPConnectUsageText,
+					ArgsUsage:    DedicatedIPConnectArgsUsageText,
+					Description:  DedicatedIPConnectDescription,
+					Action:       cmd.DedicatedIPConnect,
+					BashComplete: cmd.DedicatedIPConnectAutoComplete,
+				},
+				{
+					Name:               "renew-info",
+					Usage:              DedicatedIPRenewInfoUsageText,
+					Action:             cmd.DedicatedIPRenewInfo,
+					CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+				},
+			},
+		},
+		{
+			Name:               "diagnose",
+			Usage:              DiagnoseUsageText,
+			Description:        DiagnoseDescription,
+			Action:             cmd.Diagnose,
+			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+		},
 		{
 			Name:               "disconnect",
 			Aliases:            []string{"d"},
@@ -352,6 +794,30 @@ func NewApp(version, environment, hash, salt string,
 			Action:             cmd.Groups,
 			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
 		},
+		{
+			Name:               "history",
+			Usage:              HistoryUsageText,
+			Description:        HistoryDescription,
+			Action:             cmd.History,
+			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  flagHistoryJSON,
+					Usage: HistoryJSONUsage,
+				},
+				&cli.StringFlag{
+					Name:  flagHistorySince,
+					Usage: HistorySinceUsage,
+				},
+			},
+		},
+		{
+			Name:               "health",
+			Usage:              HealthUsageText,
+			Description:        HealthDescription,
+			Action:             cmd.Health,
+			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+		},
 		{
 			Name:        "login",
 			Usage:       LoginUsageText,
@@ -389,6 +855,25 @@ func NewApp(version, environment, hash, salt string,
 				Usage: PersistTokenUsageText,
 			}},
 		},
+		{
+			Name:        "pause",
+			Usage:       PauseUsageText,
+			ArgsUsage:   PauseArgsUsageText,
+			Description: PauseDescription,
+			Action:      cmd.Pause,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  flagPauseKeepKillSwitch,
+					Usage: PauseKeepKillSwitchUsage,
+				},
+			},
+		},
+		{
+			Name:               "resume",
+			Usage:              ResumeUsageText,
+			Action:             cmd.Resume,
+			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+		},
 		{
 			Name:   "click",
 			Action: cmd.Click,
@@ -407,7 +892,52 @@ func NewApp(version, environment, hash, salt string,
 			Usage:  RegisterUsageText,
 			Action: cmd.Register,
 		},
+		{
+			Name:               "reload",
+			Usage:              ReloadUsageText,
+			Description:        ReloadDescription,
+			Action:             cmd.Reload,
+			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+		},
 		&setCommand,
+		{
+			Name:               "servers",
+			Usage:              ServersUsageText,
+			Description:        ServersDescription,
+			Action:             cmd.Servers,
+			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  flagServersCountry,
+					Usage: ServersCountryUsage,
+				},
+				&cli.StringFlag{
+					Name:  flagServersCity,
+					Usage: ServersCityUsage,
+				},
+				&cli.StringFlag{
+					Name:  flagServersGroup,
+					Usage: ServersGroupUsage,
+				},
+				&cli.UintFlag{
+					Name:  flagServersMaxLoad,
+					Usage: ServersMaxLoadUsage,
+				},
+				&cli.StringFlag{
+					Name:  flagServersSort,
+					Usage: ServersSortUsage,
+					Value: "load",
+				},
+				&cli.UintFlag{
+					Name:  flagServersLimit,
+					Usage: ServersLimitUsage,
+				},
+				&cli.BoolFlag{
+					Name:  flagServersJSON,
+					Usage: ServersJSONUsage,
+				},
+			},
+		},
 		{
 			Name:               "settings",
 			Usage:              SettingsUsageText,
@@ -419,6 +949,350 @@ func NewApp(version, environment, hash, salt string,
 			Usage:              StatusUsageText,
 			Action:             cmd.Status,
 			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  flagStatusVerbose,
+					Usage: StatusVerboseUsage,
+				},
+				&cli.BoolFlag{
+					Name:  flagStatusJSON,
+					Usage: StatusJSONUsage,
+				},
+				&cli.BoolFlag{
+					Name:  flagStatusWatch,
+					Usage: StatusWatchUsage,
+				},
+			},
+		},
+		{
+			Name:        "export-wg",
+			Usage:       ExportWGUsageText,
+			ArgsUsage:   ExportWGArgsUsage,
+			Description: ExportWGDescription,
+			Action:      cmd.ExportWG,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  flagExportWGIncludePrivateKey,
+					Usage: exportWGIncludePrivateKeyUsage,
+				},
+			},
+		},
+		{
+			Name:        "render-template",
+			Usage:       RenderTemplateUsageText,
+			Description: RenderTemplateDescription,
+			Action:      cmd.RenderTemplate,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  flagRenderTemplateServer,
+					Usage: renderTemplateServerUsage,
+				},
+				&cli.StringFlag{
+					Name:      flagRenderTemplateServerFile,
+					Usage:     renderTemplateServerFileUsage,
+					TakesFile: true,
+				},
+				&cli.StringFlag{
+					Name:  flagRenderTemplateProtocol,
+					Usage: renderTemplateProtocolUsage,
+				},
+				&cli.BoolFlag{
+					Name:  flagRenderTemplateObfuscated,
+					Usage: renderTemplateObfuscatedUsage,
+				},
+				&cli.StringFlag{
+					Name:      flagRenderTemplateTemplate,
+					Usage:     renderTemplateTemplateUsage,
+					TakesFile: true,
+				},
+			},
+			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+		},
+		{
+			Name:  "favorites",
+			Usage: FavoritesUsageText,
+			Subcommands: []*cli.Command{
+				{
+					Name:         "add",
+					Usage:        FavoritesAddUsageText,
+					ArgsUsage:    FavoritesAddArgsUsage,
+					Description:  FavoritesAddDescription,
+					Action:       cmd.FavoritesAdd,
+					BashComplete: cmd.FavoritesAddAutoComplete,
+				},
+				{
+					Name:   "list",
+					Usage:  FavoritesListUsageText,
+					Action: cmd.FavoritesList,
+				},
+				{
+					Name:        "import",
+					Usage:       FavoritesImportUsageText,
+					ArgsUsage:   FavoritesImportArgsUsage,
+					Description: FavoritesImportDescription,
+					Action:      cmd.FavoritesImport,
+				},
+				{
+					Name:        "export",
+					Usage:       FavoritesExportUsageText,
+					ArgsUsage:   FavoritesExportArgsUsage,
+					Description: FavoritesExportDescription,
+					Action:      cmd.FavoritesExport,
+				},
+			},
+		},
+		{
+			Name:        "server-note",
+			Usage:       ServerNoteUsageText,
+			ArgsUsage:   ServerNoteArgsUsage,
+			Description: ServerNoteDescription,
+			Action:      cmd.ServerNote,
+		},
+		{
+			Name:        "server-rate",
+			Usage:       ServerRateUsageText,
+			ArgsUsage:   ServerRateArgsUsage,
+			Description: ServerRateDescription,
+			Action:      cmd.ServerRate,
+		},
+		{
+			Name:               "server-notes",
+			Usage:              ServerNotesUsageText,
+			Action:             cmd.ServerNotes,
+			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+		},
+		{
+			Name:  "killswitch",
+			Usage: KillSwitchUsageText,
+			Subcommands: []*cli.Command{
+				{
+					Name:               "blocked",
+					Usage:              KillSwitchBlockedUsageText,
+					Description:        KillSwitchBlockedDescription,
+					Action:             cmd.KillSwitchBlocked,
+					CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+				},
+			},
+		},
+		{
+			Name:  "firewall",
+			Usage: FirewallUsageText,
+			Subcommands: []*cli.Command{
+				{
+					Name:               "rebuild",
+					Usage:              FirewallRebuildUsageText,
+					Description:        FirewallRebuildDescription,
+					Action:             cmd.FirewallRebuild,
+					CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+				},
+			},
+		},
+		{
+			Name:  "metered",
+			Usage: MeteredUsageText,
+			Subcommands: []*cli.Command{
+				{
+					Name:               "status",
+					Usage:              MeteredStatusUsageText,
+					Action:             cmd.MeteredStatus,
+					CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+				},
+				{
+					Name:        "add",
+					Usage:       MeteredAddUsageText,
+					Description: MeteredAddDescription,
+					ArgsUsage:   MeteredNetworkArgsUsage,
+					Action:      cmd.MeteredAdd,
+				},
+				{
+					Name:        "remove",
+					Usage:       MeteredRemoveUsageText,
+					Description: MeteredRemoveDescription,
+					ArgsUsage:   MeteredNetworkArgsUsage,
+					Action:      cmd.MeteredRemove,
+				},
+			},
+		},
+		{
+			Name:    "captive-portal",
+			Aliases: []string{"portal"},
+			Usage:   CaptivePortalUsageText,
+			Subcommands: []*cli.Command{
+				{
+					Name:        "add",
+					Usage:       CaptivePortalAddUsageText,
+					Description: CaptivePortalAddDescription,
+					ArgsUsage:   CaptivePortalNetworkArgsUsage,
+					Action:      cmd.CaptivePortalAdd,
+				},
+				{
+					Name:        "remove",
+					Usage:       CaptivePortalRemoveUsageText,
+					Description: CaptivePortalRemoveDescription,
+					ArgsUsage:   CaptivePortalNetworkArgsUsage,
+					Action:      cmd.CaptivePortalRemove,
+				},
+				{
+					Name:        "unlock",
+					Usage:       CaptivePortalUnlockUsageText,
+					Description: CaptivePortalUnlockDescription,
+					ArgsUsage:   CaptivePortalUnlockArgsUsage,
+					Action:      cmd.CaptivePortalUnlock,
+				},
+			},
+		},
+		{
+			Name:  "trusted-networks",
+			Usage: TrustedNetworksUsageText,
+			Subcommands: []*cli.Command{
+				{
+					Name:        "add",
+					Usage:       TrustedNetworksAddUsageText,
+					Description: TrustedNetworksAddDescription,
+					ArgsUsage:   TrustedNetworksArgsUsage,
+					Action:      cmd.TrustedNetworksAdd,
+				},
+				{
+					Name:        "remove",
+					Usage:       TrustedNetworksRemoveUsageText,
+					Description: TrustedNetworksRemoveDescription,
+					ArgsUsage:   TrustedNetworksArgsUsage,
+					Action:      cmd.TrustedNetworksRemove,
+				},
+			},
+		},
+		{
+			Name:  "autoconnect",
+			Usage: AutoConnectUsageText,
+			Subcommands: []*cli.Command{
+				{
+					Name:  "rule",
+					Usage: AutoConnectRuleUsageText,
+					Subcommands: []*cli.Command{
+						{
+							Name:        "add",
+							Usage:       AutoConnectRuleAddUsageText,
+							Description: AutoConnectRuleAddDescription,
+							Action:      cmd.AutoConnectRuleAdd,
+							Flags: []cli.Flag{
+								&cli.StringFlag{
+									Name:  flagRuleSSID,
+									Usage: AutoConnectRuleSSIDUsage,
+								},
+								&cli.StringFlag{
+									Name:  flagRuleBSSID,
+									Usage: AutoConnectRuleBSSIDUsage,
+								},
+								&cli.StringFlag{
+									Name:  flagRuleType,
+									Usage: AutoConnectRuleTypeUsage,
+								},
+								&cli.StringFlag{
+									Name:     flagRuleAction,
+									Usage:    AutoConnectRuleActionUsage,
+									Required: true,
+								},
+								&cli.StringFlag{
+									Name:  flagRuleServer,
+									Usage: AutoConnectRuleServerUsage,
+								},
+								&cli.StringFlag{
+									Name:  flagRuleGroup,
+									Usage: AutoConnectRuleGroupUsage,
+								},
+							},
+						},
+						{
+							Name:        "remove",
+							Usage:       AutoConnectRuleRemoveUsageText,
+							Description: AutoConnectRuleRemoveDescription,
+							ArgsUsage:   AutoConnectRuleRemoveArgsUsage,
+							Action:      cmd.AutoConnectRuleRemove,
+						},
+						{
+							Name:   "list",
+							Usage:  AutoConnectRuleListUsageText,
+							Action: cmd.AutoConnectRuleList,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:  "profile",
+			Usage: ProfileUsageText,
+			Subcommands: []*cli.Command{
+				{
+					Name:        "save",
+					Usage:       ProfileSaveUsageText,
+					ArgsUsage:   ProfileSaveArgsUsage,
+					Description: ProfileSaveDescription,
+					Action:      cmd.ProfileSave,
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  flagGroup,
+							Usage: ProfileSaveGroupUsage,
+						},
+					},
+				},
+				{
+					Name:        "remove",
+					Usage:       ProfileRemoveUsageText,
+					ArgsUsage:   ProfileRemoveArgsUsage,
+					Description: ProfileRemoveDescription,
+					Action:      cmd.ProfileRemove,
+				},
+				{
+					Name:   "list",
+					Usage:  ProfileListUsageText,
+					Action: cmd.ProfileList,
+				},
+			},
+		},
+		{
+			Name:  "split-tunnel",
+			Usage: SplitTunnelUsageText,
+			Subcommands: []*cli.Command{
+				{
+					Name:        "add",
+					Usage:       SplitTunnelAddUsageText,
+					Description: SplitTunnelAddDescription,
+					ArgsUsage:   SplitTunnelAppArgsUsage,
+					Action:      cmd.SplitTunnelAdd,
+				},
+				{
+					Name:        "remove",
+					Usage:       SplitTunnelRemoveUsageText,
+					Description: SplitTunnelRemoveDescription,
+					ArgsUsage:   SplitTunnelAppArgsUsage,
+					Action:      cmd.SplitTunnelRemove,
+				},
+				{
+					Name:         "mode",
+					Usage:        SplitTunnelModeUsageText,
+					Description:  SplitTunnelModeDescription,
+					ArgsUsage:    SplitTunnelModeArgsUsageText,
+					Action:       cmd.SplitTunnelMode,
+					BashComplete: cmd.SplitTunnelModeAutoComplete,
+				},
+				{
+					Name:   "list",
+					Usage:  SplitTunnelListUsageText,
+					Action: cmd.SplitTunnelList,
+				},
+			},
+		},
+		{
+			Name:        "tunnel-overhead",
+			Usage:       TunnelOverheadUsageText,
+			Action:      cmd.TunnelOverhead,
+			Description: TunnelOverheadDescription,
+			Flags: []cli.Flag{
+				&cli.DurationFlag{
+					Name:  flagTunnelOverheadMeasure,
+					Usage: tunnelOverheadMeasureUsage,
+				},
+			},
 		},
 		{
 			Name:  "version",
@@ -445,6 +1319,12 @@ func NewApp(version, environment, hash, salt string,
 							BashComplete: cmd.AllowlistAddPortAutoComplete,
 							ArgsUsage:    AllowlistAddPortArgsUsageText,
 							Description:  AllowlistAddPortDescription,
+							Flags: []cli.Flag{
+								&cli.DurationFlag{
+									Name:  flagAllowlistTTL,
+									Usage: AllowlistTTLUsage,
+								},
+							},
 						},
 						{
 							Name:         "ports",
@@ -453,6 +1333,12 @@ func NewApp(version, environment, hash, salt string,
 							BashComplete: cmd.AllowlistAddPortsAutoComplete,
 							ArgsUsage:    AllowlistAddPortsArgsUsageText,
 							Description:  AllowlistAddPortsDescription,
+							Flags: []cli.Flag{
+								&cli.DurationFlag{
+									Name:  flagAllowlistTTL,
+									Usage: AllowlistTTLUsage,
+								},
+							},
 						},
 						{
 							Name:         "subnet",
@@ -461,6 +1347,25 @@ func NewApp(version, environment, hash, salt string,
 							BashComplete: cmd.AllowlistAddSubnetAutoComplete,
 							ArgsUsage:    AllowlistAddSubnetArgsUsageText,
 							Description:  AllowlistAddSubnetDescription,
+							Flags: []cli.Flag{
+								&cli.DurationFlag{
+									Name:  flagAllowlistTTL,
+									Usage: AllowlistTTLUsage,
+								},
+							},
+						},
+						{
+							Name:        "domain",
+							Usage:       AllowlistAddDomainUsageText,
+							Action:      cmd.AllowlistAddDomain,
+							ArgsUsage:   AllowlistAddDomainArgsUsageText,
+							Description: AllowlistAddDomainDescription,
+							Flags: []cli.Flag{
+								&cli.DurationFlag{
+									Name:  flagAllowlistTTL,
+									Usage: AllowlistTTLUsage,
+								},
+							},
 						},
 					},
 				},
@@ -498,6 +1403,14 @@ func NewApp(version, environment, hash, salt string,
 							ArgsUsage:    AllowlistRemoveSubnetArgsUsageText,
 							Description:  AllowlistRemoveSubnetArgsDescription,
 						},
+						{
+							Name:         "domain",
+							Usage:        AllowlistRemoveDomainUsageText,
+							Action:       cmd.AllowlistRemoveDomain,
+							BashComplete: cmd.AllowlistRemoveDomainAutoComplete,
+							ArgsUsage:    AllowlistRemoveDomainArgsUsageText,
+							Description:  AllowlistRemoveDomainArgsDescription,
+						},
 					},
 				},
 			},
@@ -538,6 +1451,14 @@ func fileshareCommand(c *cmd) *cli.Command {
 						Name:  flagFileshareNoWait,
 						Usage: MsgFileshareNoWaitUsage,
 					},
+					&cli.StringSliceFlag{
+						Name:  flagFileshareTag,
+						Usage: MsgFileshareSendTagUsage,
+					},
+					&cli.StringFlag{
+						Name:  flagFileshareLimit,
+						Usage: MsgFileshareLimitUsage,
+					},
 				},
 				BashComplete: c.FileshareAutoCompletePeers,
 			},
@@ -556,9 +1477,27 @@ func fileshareCommand(c *cmd) *cli.Command {
 						Name:  flagFileshareNoWait,
 						Usage: MsgFileshareNoWaitUsage,
 					},
+					&cli.StringFlag{
+						Name:  flagFileshareLimit,
+						Usage: MsgFileshareLimitUsage,
+					},
 				},
 				BashComplete: c.FileshareAutoCompleteTransfersAccept,
 			},
+			{
+				Name:        FileshareResumeName,
+				Action:      c.FileshareResume,
+				Usage:       MsgFileshareResumeUsage,
+				ArgsUsage:   MsgFileshareResumeArgsUsage,
+				Description: MsgFileshareResumeDescription,
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  flagFileshareNoWait,
+						Usage: MsgFileshareNoWaitUsage,
+					},
+				},
+				BashComplete: c.FileshareAutoCompleteTransfersResume,
+			},
 			{
 				Name:        FileshareListName,
 				Action:      c.FileshareList,
@@ -574,6 +1513,10 @@ func fileshareCommand(c *cmd) *cli.Command {
 						Name:  flagFileshareListOut,
 						Usage: MsgFileshareListOutUsage,
 					},
+					&cli.StringSliceFlag{
+						Name:  flagFileshareTag,
+						Usage: MsgFileshareListTagUsage,
+					},
 				},
 				BashComplete: c.FileshareAutoCompleteTransfersList,
 			},
@@ -592,6 +1535,60 @@ func fileshareCommand(c *cmd) *cli.Command {
 				Description:  MsgFileshareClearDescription,
 				BashComplete: c.FileshareAutoCompleteClear,
 			},
+			{
+				Name:        FileshareSetConcurrencyName,
+				Action:      c.FileshareSetConcurrency,
+				Usage:       MsgFileshareSetConcurrencyUsage,
+				ArgsUsage:   MsgFileshareSetConcurrencyArgsUsage,
+				Description: MsgFileshareSetConcurrencyDescription,
+			},
+			{
+				Name:        FileshareWatchName,
+				Action:      c.FileshareWatch,
+				Usage:       MsgFileshareWatchUsage,
+				ArgsUsage:   MsgFileshareWatchArgsUsage,
+				Description: MsgFileshareWatchDescription,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  flagFileshareTo,
+						Usage: MsgFileshareWatchToUsage,
+					},
+				},
+			},
+			{
+				Name:         FileshareUnwatchName,
+				Action:       c.FileshareUnwatch,
+				Usage:        MsgFileshareUnwatchUsage,
+				ArgsUsage:    MsgFileshareUnwatchArgsUsage,
+				BashComplete: c.FileshareAutoCompleteWatches,
+			},
+			{
+				Name:         FileshareProgressName,
+				Action:       c.FileshareProgress,
+				Usage:        MsgFileshareProgressUsage,
+				ArgsUsage:    MsgFileshareProgressArgsUsage,
+				BashComplete: c.FileshareAutoCompleteTransfersList,
+			},
+			{
+				Name:  FileshareSetName,
+				Usage: MsgFileshareSetUsage,
+				Subcommands: []*cli.Command{
+					{
+						Name:        FileshareRateLimitName,
+						Action:      c.FileshareSetRateLimit,
+						Usage:       MsgFileshareRateLimitUsage,
+						ArgsUsage:   MsgFileshareRateLimitArgsUsage,
+						Description: MsgFileshareRateLimitDescription,
+					},
+					{
+						Name:        FileshareAcceptDirsName,
+						Action:      c.FileshareSetAcceptDirs,
+						Usage:       MsgFileshareAcceptDirsUsage,
+						ArgsUsage:   MsgFileshareAcceptDirsArgsUsage,
+						Description: MsgFileshareAcceptDirsDescription,
+					},
+				},
+			},
 		},
 	}
 }
@@ -845,6 +1842,74 @@ func meshnetCommand(c *cmd) *cli.Command {
 					},
 				},
 			},
+			{
+				Name:        "group",
+				Usage:       MsgMeshnetGroupUsage,
+				Description: MsgMeshnetGroupDescription,
+				Subcommands: []*cli.Command{
+					{
+						Name:   "list",
+						Action: c.MeshGroupList,
+						Usage:  MsgMeshnetGroupListUsage,
+					},
+					{
+						Name:      "create",
+						Action:    c.MeshGroupCreate,
+						Usage:     MsgMeshnetGroupCreateUsage,
+						ArgsUsage: MsgMeshnetGroupArgsUsage,
+					},
+					{
+						Name:         "delete",
+						Action:       c.MeshGroupDelete,
+						Usage:        MsgMeshnetGroupDeleteUsage,
+						ArgsUsage:    MsgMeshnetGroupArgsUsage,
+						BashComplete: c.MeshGroupAutoComplete,
+					},
+					{
+						Name:         "add",
+						Action:       c.MeshGroupAdd,
+						Usage:        MsgMeshnetGroupAddUsage,
+						ArgsUsage:    MsgMeshnetGroupPeerArgsUsage,
+						BashComplete: c.MeshGroupAutoComplete,
+					},
+					{
+						Name:         "remove",
+						Action:       c.MeshGroupRemove,
+						Usage:        MsgMeshnetGroupRemoveUsage,
+						ArgsUsage:    MsgMeshnetGroupPeerArgsUsage,
+						BashComplete: c.MeshGroupAutoComplete,
+					},
+					c.meshGroupPermissionCommand("incoming", MsgMeshnetGroupIncomingUsage, meshpb.PeerPermission_PERMISSION_INCOMING),
+					c.meshGroupPermissionCommand("routing", MsgMeshnetGroupRoutingUsage, meshpb.PeerPermission_PERMISSION_ROUTING),
+					c.meshGroupPermissionCommand("local", MsgMeshnetGroupLocalNetworkUsage, meshpb.PeerPermission_PERMISSION_LOCAL_NETWORK),
+					c.meshGroupPermissionCommand("fileshare", MsgMeshnetGroupFileshareUsage, meshpb.PeerPermission_PERMISSION_FILESHARE),
+				},
+			},
+			{
+				Name:        "pause",
+				Usage:       MsgMeshnetPauseUsage,
+				ArgsUsage:   MsgMeshnetPauseArgsUsage,
+				Description: MsgMeshnetPauseDescription,
+				Action:      c.MeshPause,
+			},
+			{
+				Name:               "resume",
+				Usage:              MsgMeshnetResumeUsage,
+				Action:             c.MeshResume,
+				CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+			},
+			{
+				Name:        "matrix",
+				Usage:       MsgMeshnetMatrixUsage,
+				Description: MsgMeshnetMatrixDescription,
+				Action:      c.MeshMatrix,
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  flagMatrixJSON,
+						Usage: MsgMeshnetMatrixJSONUsage,
+					},
+				},
+			},
 			{
 				Name:    "set",
 				Aliases: []string{"s"},
@@ -857,6 +1922,13 @@ func meshnetCommand(c *cmd) *cli.Command {
 						ArgsUsage: MsgMeshnetSetNicknameArgsUsage,
 						Action:    c.MeshSetMachineNickname,
 					},
+					{
+						Name:         "exit-node-fallback",
+						Usage:        MsgMeshnetSetExitNodeFallbackUsage,
+						ArgsUsage:    MsgMeshnetSetExitNodeFallbackArgsUsage,
+						Action:       c.MeshSetExitNodeFallback,
+						BashComplete: c.MeshSetExitNodeFallbackAutoComplete,
+					},
 				},
 			},
 			{
@@ -964,7 +2036,11 @@ func (s cliStream) RecvMsg(m interface{}) error {
 
 func (c *cmd) action(err error, f func(*cli.Context) error) func(*cli.Context) error {
 	return func(ctx *cli.Context) error {
-		c.loaderInterceptor.enabled = true
+		if err := checkJSONSupported(ctx); err != nil {
+			return err
+		}
+		// loader animation would corrupt the machine readable output
+		c.loaderInterceptor.enabled = !jsonOutput(ctx)
 		if err != nil {
 			log.Println(internal.ErrorPrefix, err)
 			color.Red(internal.ErrDaemonConnectionRefused.Error())
@@ -974,6 +2050,10 @@ func (c *cmd) action(err error, f func(*cli.Context) error) func(*cli.Context) e
 		if err != nil {
 			switch {
 			case errors.Is(err, ErrUpdateAvailable):
+				if jsonOutput(ctx) {
+					color.New(color.FgYellow).Fprintln(os.Stderr, UpdateAvailableMessage)
+					break
+				}
 				color.Yellow(fmt.Sprintf(UpdateAvailableMessage))
 			case errors.Is(err, ErrInternetConnection):
 				color.Red(ErrInternetConnection.Error())
@@ -1076,6 +2156,10 @@ func meshnetErrorToError(code meshpb.MeshnetErrorCode) error {
 		return errors.New(MsgMeshnetNordlynxMustBeEnabled)
 	case meshpb.MeshnetErrorCode_TUNNEL_CLOSED:
 		return errors.New(DisconnectNotConnected)
+	case meshpb.MeshnetErrorCode_MESHNET_PAUSED:
+		return errors.New(MsgMeshnetPaused)
+	case meshpb.MeshnetErrorCode_NOT_PAUSED:
+		return errors.New(MsgMeshnetNotPaused)
 	default:
 		return errors.New(AccountInternalError)
 	}
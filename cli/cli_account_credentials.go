@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Account credentials help text
+const (
+	AccountCredentialsUsageText         = "Manages the service credentials used to connect to VPN"
+	AccountCredentialsStatusUsageText   = "Shows when the login and the service credentials expire"
+	AccountCredentialsStatusDescription = `Use this command to check the service credentials used to connect to VPN.
+If they were rotated, the new credentials are provisioned and VPN is reconnected to use them.
+The credentials are also checked periodically in the background.
+
+Example: 'nordvpn account credentials status'`
+)
+
+func (c *cmd) AccountCredentialsStatus(ctx *cli.Context) error {
+	resp, err := c.client.CredentialsStatus(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeTokenInvalid:
+		return formatError(errors.New(MsgCredentialsTokenInvalid))
+	case internal.CodeGatewayError:
+		color.Yellow(MsgCredentialsCheckFailed)
+	case internal.CodeNoVPNService:
+		color.Yellow(ExpiredAccountMessage)
+	}
+	if resp.Rotated {
+		color.Green(MsgCredentialsRotated)
+	}
+	fmt.Print(formatCredentialsStatus(resp))
+	return nil
+}
+
+func formatCredentialsStatus(resp *pb.CredentialsStatusResponse) string {
+	var b strings.Builder
+	b.WriteString("Credentials Status:\n")
+	if resp.GetTokenLogin() {
+		b.WriteString("Login: Token (does not expire until revoked)\n")
+	} else {
+		fmt.Fprintf(&b, "Login: Expires on %s\n", formatDate(resp.GetTokenExpiresAt()))
+	}
+	fmt.Fprintf(&b, "VPN Service: Expires on %s\n", formatDate(resp.GetServiceExpiresAt()))
+	fmt.Fprintf(&b, "Credentials Updated: %s\n", formatDate(resp.GetUpdatedAt()))
+	fmt.Fprintf(&b, "Credentials Checked: %s\n", formatDate(resp.GetCheckedAt()))
+	return b.String()
+}
+
+// formatDate formats the date received from the API, unknown is returned for
+// the dates which cannot be parsed
+func formatDate(date string) string {
+	t, err := time.Parse(internal.ServerDateFormat, date)
+	if err != nil {
+		return "unknown"
+	}
+	return fmt.Sprintf("%s %s, %d", t.Month().String()[0:3], ordinal(t.Day()), t.Year())
+}
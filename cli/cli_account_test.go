@@ -3,6 +3,7 @@ package cli
 import (
 	"testing"
 
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/test/category"
 	"github.com/stretchr/testify/assert"
 )
@@ -84,3 +85,48 @@ func TestActiveBoolToString(t *testing.T) {
 		assert.Equal(t, got, test.result)
 	}
 }
+
+func TestFormatCredentialsStatus(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		resp     *pb.CredentialsStatusResponse
+		expected string
+	}{
+		{
+			name: "token login",
+			resp: &pb.CredentialsStatusResponse{
+				TokenLogin:       true,
+				ServiceExpiresAt: "2029-12-27 00:00:00",
+				UpdatedAt:        "2024-02-01 10:00:00",
+				CheckedAt:        "2024-03-01 12:00:00",
+			},
+			expected: `Credentials Status:
+Login: Token (does not expire until revoked)
+VPN Service: Expires on Dec 27th, 2029
+Credentials Updated: Feb 1st, 2024
+Credentials Checked: Mar 1st, 2024
+`,
+		},
+		{
+			name: "never checked",
+			resp: &pb.CredentialsStatusResponse{
+				TokenExpiresAt:   "2024-03-02 12:00:00",
+				ServiceExpiresAt: "2029-12-27 00:00:00",
+			},
+			expected: `Credentials Status:
+Login: Expires on Mar 2nd, 2024
+VPN Service: Expires on Dec 27th, 2029
+Credentials Updated: unknown
+Credentials Checked: unknown
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, formatCredentialsStatus(test.resp))
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/slices"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Allowlist add domain help text
+const (
+	AllowlistAddDomainUsageText     = "Adds domain to the allowlist"
+	AllowlistAddDomainArgsUsageText = `<domain>`
+	AllowlistAddDomainDescription   = `Use this command to allowlist domain.
+
+Example: 'nordvpn allowlist add domain intranet.corp.com'
+
+Optionally, the domain can be removed from the allowlist automatically after the given time.
+
+Example: 'nordvpn allowlist add domain intranet.corp.com --ttl 1h'
+
+Notes:
+  Domain is resolved by the daemon and all of its addresses are allowlisted.
+  Addresses are resolved again when they expire, so the allowlist follows the changes of the domain.`
+)
+
+func (c *cmd) AllowlistAddDomain(ctx *cli.Context) error {
+	args, ttl, err := allowlistAddArgs(ctx)
+	if err != nil {
+		return formatError(err)
+	}
+
+	if args.Len() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	domain := strings.ToLower(strings.TrimSuffix(args.First(), "."))
+
+	settings, err := c.getSettings()
+	if err != nil {
+		return formatError(err)
+	}
+	allowlist := settings.GetAllowlist()
+
+	if slices.Contains(allowlist.Domains, domain) {
+		return formatError(fmt.Errorf(AllowlistAddDomainExistsError, domain))
+	}
+
+	allowlist.Domains = append(allowlist.Domains, domain)
+	setAllowlistExpiry(allowlist, ttl, config.DomainKey(domain))
+
+	resp, err := c.client.SetAllowlist(context.Background(), &pb.SetAllowlistRequest{
+		Allowlist: allowlist,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeFailure:
+		return formatError(fmt.Errorf(AllowlistAddDomainExistsError, domain))
+	case internal.CodeVPNMisconfig:
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(AllowlistAddDomainSuccess, domain))
+	}
+	return nil
+}
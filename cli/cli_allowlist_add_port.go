@@ -25,11 +25,18 @@ Example: 'nordvpn allowlist add port 22'
 Optionally, protocol can be provided to specify which protocol should be allowlisted.
 Supported values for <protocol>: TCP, UDP
 
-Example: 'nordvpn allowlist add port 22 protocol TCP'`
+Example: 'nordvpn allowlist add port 22 protocol TCP'
+
+Optionally, the port can be removed from the allowlist automatically after the given time.
+
+Example: 'nordvpn allowlist add port 22 --ttl 1h'`
 )
 
 func (c *cmd) AllowlistAddPort(ctx *cli.Context) error {
-	args := ctx.Args()
+	args, ttl, err := allowlistAddArgs(ctx)
+	if err != nil {
+		return formatError(err)
+	}
 	if !(args.Len() == 1 || (args.Len() == 3 && args.Get(1) == AllowlistProtocol)) {
 		return formatError(argsCountError(ctx))
 	}
@@ -79,9 +86,11 @@ func (c *cmd) AllowlistAddPort(ctx *cli.Context) error {
 	}
 	if isTCP {
 		allowlist.Ports.Tcp = append(allowlist.Ports.Tcp, port)
+		setAllowlistExpiry(allowlist, ttl, config.PortKey("tcp", port))
 	}
 	if isUDP {
 		allowlist.Ports.Udp = append(allowlist.Ports.Udp, port)
+		setAllowlistExpiry(allowlist, ttl, config.PortKey("udp", port))
 	}
 	resp, err := c.client.SetAllowlist(context.Background(), &pb.SetAllowlistRequest{
 		Allowlist: allowlist,
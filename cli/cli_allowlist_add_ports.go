@@ -24,11 +24,18 @@ Example: 'nordvpn allowlist add ports 3000 8000'
 Optionally, protocol can be provided to specify which protocol should be allowlisted.
 Supported values for <protocol>: TCP, UDP
 
-Example: 'nordvpn allowlist add ports 3000 8000 protocol TCP'`
+Example: 'nordvpn allowlist add ports 3000 8000 protocol TCP'
+
+Optionally, the ports can be removed from the allowlist automatically after the given time.
+
+Example: 'nordvpn allowlist add ports 3000 8000 --ttl 1h'`
 )
 
 func (c *cmd) AllowlistAddPorts(ctx *cli.Context) error {
-	args := ctx.Args()
+	args, ttl, err := allowlistAddArgs(ctx)
+	if err != nil {
+		return formatError(err)
+	}
 	if !(args.Len() == 2 || (args.Len() == 4 && args.Get(2) == AllowlistProtocol)) {
 		return formatError(argsCountError(ctx))
 	}
@@ -85,11 +92,15 @@ func (c *cmd) AllowlistAddPorts(ctx *cli.Context) error {
 		return formatError(err)
 	}
 	allowlist := settings.GetAllowlist()
-	if isTCP {
-		allowlist.Ports.Tcp = append(allowlist.Ports.Tcp, ports...)
-	}
-	if isUDP {
-		allowlist.Ports.Udp = append(allowlist.Ports.Udp, ports...)
+	for _, port := range ports {
+		if isTCP {
+			allowlist.Ports.Tcp = append(allowlist.Ports.Tcp, port)
+			setAllowlistExpiry(allowlist, ttl, config.PortKey("tcp", port))
+		}
+		if isUDP {
+			allowlist.Ports.Udp = append(allowlist.Ports.Udp, port)
+			setAllowlistExpiry(allowlist, ttl, config.PortKey("udp", port))
+		}
 	}
 	resp, err := c.client.SetAllowlist(context.Background(), &pb.SetAllowlistRequest{
 		Allowlist: allowlist,
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	"golang.org/x/exp/slices"
@@ -21,12 +22,19 @@ const (
 
 Example: 'nordvpn allowlist add subnet 192.168.1.1/24'
 
+Optionally, the subnet can be removed from the allowlist automatically after the given time.
+
+Example: 'nordvpn allowlist add subnet 192.168.1.1/24 --ttl 1h'
+
 Notes:
   Address should be in CIDR notation`
 )
 
 func (c *cmd) AllowlistAddSubnet(ctx *cli.Context) error {
-	args := ctx.Args()
+	args, ttl, err := allowlistAddArgs(ctx)
+	if err != nil {
+		return formatError(err)
+	}
 
 	if args.Len() != 1 {
 		return formatError(argsCountError(ctx))
@@ -48,6 +56,7 @@ func (c *cmd) AllowlistAddSubnet(ctx *cli.Context) error {
 	}
 
 	allowlist.Subnets = append(allowlist.Subnets, subnet.String())
+	setAllowlistExpiry(allowlist, ttl, config.SubnetKey(subnet.String()))
 
 	resp, err := c.client.SetAllowlist(context.Background(), &pb.SetAllowlistRequest{
 		Allowlist: allowlist,
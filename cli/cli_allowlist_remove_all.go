@@ -12,7 +12,7 @@ import (
 )
 
 // AllowlistRemoveAllUsageText is shown next to all command by nordvpn allowlist remove --help
-const AllowlistRemoveAllUsageText = "Removes all ports and subnets from the allowlist"
+const AllowlistRemoveAllUsageText = "Removes all ports, subnets and domains from the allowlist"
 
 func (c *cmd) AllowlistRemoveAll(ctx *cli.Context) error {
 	resp, err := c.client.SetAllowlist(context.Background(), &pb.SetAllowlistRequest{
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/slices"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Allowlist remove domain help text
+const (
+	AllowlistRemoveDomainUsageText       = "Removes domain from the allowlist"
+	AllowlistRemoveDomainArgsUsageText   = `<domain>`
+	AllowlistRemoveDomainArgsDescription = `Use this command to remove domain from the allowlist.
+
+Example: 'nordvpn allowlist remove domain intranet.corp.com'`
+)
+
+func (c *cmd) AllowlistRemoveDomain(ctx *cli.Context) error {
+	args := ctx.Args()
+
+	if args.Len() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	domain := strings.ToLower(strings.TrimSuffix(args.First(), "."))
+
+	settings, err := c.getSettings()
+	if err != nil {
+		return formatError(err)
+	}
+	allowlist := settings.GetAllowlist()
+
+	domainIndex := slices.Index(allowlist.Domains, domain)
+	if domainIndex < 0 {
+		return formatError(fmt.Errorf(AllowlistRemoveDomainExistsError, domain))
+	}
+
+	allowlist.Domains = slices.Delete(allowlist.Domains, domainIndex, domainIndex+1)
+
+	resp, err := c.client.SetAllowlist(context.Background(), &pb.SetAllowlistRequest{
+		Allowlist: allowlist,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFailure:
+		return formatError(fmt.Errorf(AllowlistRemoveDomainExistsError, domain))
+	case internal.CodeVPNMisconfig:
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(AllowlistRemoveDomainSuccess, domain))
+	}
+	return nil
+}
+
+func (c *cmd) AllowlistRemoveDomainAutoComplete(ctx *cli.Context) {
+	settings, err := c.client.Settings(context.Background(), &pb.SettingsRequest{})
+	if err != nil {
+		return
+	}
+	allowlist := settings.GetData().GetAllowlist()
+	for _, domain := range allowlist.Domains {
+		if !slices.Contains(ctx.Args().Slice(), domain) {
+			fmt.Println(domain)
+		}
+	}
+}
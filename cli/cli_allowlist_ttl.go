@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+
+	"github.com/urfave/cli/v2"
+)
+
+const flagAllowlistTTL = "ttl"
+
+// AllowlistTTLUsage is shown next to the ttl flag of the allowlist add commands
+const AllowlistTTLUsage = "Removes the added entry from the allowlist after the given time, e.g. 1h"
+
+// allowlistArgs are the arguments left after removing the ttl flag
+type allowlistArgs []string
+
+func (a allowlistArgs) Get(n int) string {
+	if n < len(a) {
+		return a[n]
+	}
+	return ""
+}
+
+func (a allowlistArgs) First() string   { return a.Get(0) }
+func (a allowlistArgs) Len() int        { return len(a) }
+func (a allowlistArgs) Present() bool   { return len(a) != 0 }
+func (a allowlistArgs) Slice() []string { return a }
+
+func (a allowlistArgs) Tail() []string {
+	if len(a) < 2 {
+		return []string{}
+	}
+	return a[1:]
+}
+
+// allowlistAddArgs returns the arguments of the allowlist add command and the
+// time to live of the added entries. Zero TTL means that the entries are
+// permanent. TTL flag is accepted after the arguments as well, e.g.
+// 'nordvpn allowlist add subnet 10.0.0.0/8 --ttl 1h'.
+func allowlistAddArgs(ctx *cli.Context) (cli.Args, time.Duration, error) {
+	ttl := ctx.Duration(flagAllowlistTTL)
+	args := allowlistArgs{}
+	slice := ctx.Args().Slice()
+	for i := 0; i < len(slice); i++ {
+		name, value, hasValue := strings.Cut(strings.TrimLeft(slice[i], "-"), "=")
+		if !strings.HasPrefix(slice[i], "-") || name != flagAllowlistTTL {
+			args = append(args, slice[i])
+			continue
+		}
+		if !hasValue {
+			if i+1 == len(slice) {
+				return nil, 0, argsParseError(ctx)
+			}
+			i++
+			value = slice[i]
+		}
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, 0, argsParseError(ctx)
+		}
+		ttl = duration
+	}
+	if ttl < 0 {
+		return nil, 0, argsParseError(ctx)
+	}
+	return args, ttl, nil
+}
+
+// setAllowlistExpiry makes the allowlist entries identified by the keys
+// temporary if ttl is set
+func setAllowlistExpiry(allowlist *pb.Allowlist, ttl time.Duration, keys ...string) {
+	if ttl == 0 {
+		return
+	}
+	if allowlist.ExpiresAt == nil {
+		allowlist.ExpiresAt = map[string]int64{}
+	}
+	expiresAt := time.Now().Add(ttl).Unix()
+	for _, key := range keys {
+		allowlist.ExpiresAt[key] = expiresAt
+	}
+}
+
+// allowlistExpiry returns the time left until the first of the entries
+// identified by the keys is removed, ready to be appended to the entry. Empty
+// string is returned if the entries are permanent.
+func allowlistExpiry(allowlist *pb.Allowlist, now time.Time, keys ...string) string {
+	var expiresAt int64
+	for _, key := range keys {
+		if value, ok := allowlist.GetExpiresAt()[key]; ok && (expiresAt == 0 || value < expiresAt) {
+			expiresAt = value
+		}
+	}
+	if expiresAt == 0 {
+		return ""
+	}
+	remaining := time.Unix(expiresAt, 0).Sub(now).Round(time.Second)
+	if remaining < time.Second {
+		remaining = time.Second
+	}
+	return fmt.Sprintf(" (expires in %s)", remaining)
+}
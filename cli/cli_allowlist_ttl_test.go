@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v2"
+)
+
+func TestAllowlistAddArgs(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name        string
+		input       []string
+		expected    []string
+		expectedTTL time.Duration
+		hasError    bool
+	}{
+		{
+			name:     "no ttl",
+			input:    []string{"10.0.0.0/8"},
+			expected: []string{"10.0.0.0/8"},
+		},
+		{
+			name:        "ttl before args",
+			input:       []string{"--ttl", "1h", "22", "tcp"},
+			expected:    []string{"22", "tcp"},
+			expectedTTL: time.Hour,
+		},
+		{
+			name:        "ttl after args",
+			input:       []string{"22", "tcp", "--ttl", "30m"},
+			expected:    []string{"22", "tcp"},
+			expectedTTL: 30 * time.Minute,
+		},
+		{
+			name:        "ttl with equals sign after args",
+			input:       []string{"10.0.0.0/8", "-ttl=90s"},
+			expected:    []string{"10.0.0.0/8"},
+			expectedTTL: 90 * time.Second,
+		},
+		{
+			name:     "missing ttl value",
+			input:    []string{"10.0.0.0/8", "--ttl"},
+			hasError: true,
+		},
+		{
+			name:     "invalid ttl value",
+			input:    []string{"10.0.0.0/8", "--ttl", "soon"},
+			hasError: true,
+		},
+		{
+			name:     "negative ttl",
+			input:    []string{"10.0.0.0/8", "--ttl", "-1h"},
+			hasError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			set := flag.NewFlagSet("test", 0)
+			set.Duration(flagAllowlistTTL, 0, "")
+			assert.NoError(t, set.Parse(test.input))
+			ctx := cli.NewContext(cli.NewApp(), set, &cli.Context{Context: context.Background()})
+			ctx.Command = &cli.Command{Name: "subnet"}
+
+			args, ttl, err := allowlistAddArgs(ctx)
+
+			if test.hasError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, args.Slice())
+			assert.Equal(t, test.expectedTTL, ttl)
+		})
+	}
+}
+
+func TestAllowlistExpiry(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Unix(1700000000, 0)
+	allowlist := &pb.Allowlist{}
+	setAllowlistExpiry(allowlist, 0, "subnet/10.0.0.0/8")
+	assert.Empty(t, allowlist.ExpiresAt)
+
+	allowlist.ExpiresAt = map[string]int64{
+		"port/tcp/22":       now.Add(time.Hour).Unix(),
+		"port/udp/22":       now.Add(30 * time.Minute).Unix(),
+		"subnet/10.0.0.0/8": now.Add(-time.Minute).Unix(),
+	}
+
+	assert.Equal(t, "", allowlistExpiry(allowlist, now, "port/tcp/80"))
+	assert.Equal(t, " (expires in 1h0m0s)", allowlistExpiry(allowlist, now, "port/tcp/22"))
+	assert.Equal(t, " (expires in 30m0s)", allowlistExpiry(allowlist, now, "port/tcp/22", "port/udp/22"))
+	assert.Equal(t, " (expires in 1s)", allowlistExpiry(allowlist, now, "subnet/10.0.0.0/8"))
+}
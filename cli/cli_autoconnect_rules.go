@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagRuleSSID   = "ssid"
+	flagRuleBSSID  = "bssid"
+	flagRuleType   = "type"
+	flagRuleAction = "action"
+	flagRuleServer = "server"
+	flagRuleGroup  = "group"
+)
+
+// Auto-connect rules help text
+const (
+	AutoConnectUsageText          = "Manages how auto-connect behaves on different networks"
+	AutoConnectRuleUsageText      = "Manages the auto-connect rules applied after joining a network"
+	AutoConnectRuleAddUsageText   = "Adds the auto-connect rule"
+	AutoConnectRuleAddDescription = `Use this command to connect to VPN automatically after joining the network, or to skip auto-connect on it. The rule matches the network when all of the given --ssid, --bssid and --type match. If several rules match, the first one in the list is applied. Adding the rule with the same criteria replaces the existing one.
+The connect rule connects to the given --server or --group, or to the auto-connect server if neither is given. It is applied when joining the network while VPN is not connected, unless auto-connect is suppressed by the metered network policy.
+The skip rule prevents auto-connect on daemon start while on the network.
+Networks are detected using NetworkManager.
+
+Example: 'nordvpn autoconnect rule add --ssid "CoffeeShop" --action connect --group obfuscated'
+Example: 'nordvpn autoconnect rule add --ssid "HomeLAN" --action skip'
+Example: 'nordvpn autoconnect rule add --type mobile --action connect --server lt'`
+	AutoConnectRuleSSIDUsage         = "Name of the Wi-Fi network"
+	AutoConnectRuleBSSIDUsage        = "MAC address of the Wi-Fi access point"
+	AutoConnectRuleTypeUsage         = "Type of the network: wifi, ethernet or mobile"
+	AutoConnectRuleActionUsage       = "Action after joining the network: connect or skip"
+	AutoConnectRuleServerUsage       = "Server, country or city to connect to"
+	AutoConnectRuleGroupUsage        = "Server group to connect to"
+	AutoConnectRuleRemoveUsageText   = "Removes the auto-connect rule"
+	AutoConnectRuleRemoveArgsUsage   = "<index>"
+	AutoConnectRuleRemoveDescription = `Use this command to remove the auto-connect rule by its index as shown by 'nordvpn autoconnect rule list'.
+
+Example: 'nordvpn autoconnect rule remove 1'`
+	AutoConnectRuleListUsageText = "Shows the auto-connect rules"
+)
+
+// AutoConnectRuleAdd adds the auto-connect rule
+func (c *cmd) AutoConnectRuleAdd(ctx *cli.Context) error {
+	if ctx.NArg() != 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	rule := &pb.AutoConnectRule{
+		Ssid:        ctx.String(flagRuleSSID),
+		Bssid:       ctx.String(flagRuleBSSID),
+		NetworkType: ctx.String(flagRuleType),
+		Action:      ctx.String(flagRuleAction),
+		ServerTag:   ctx.String(flagRuleServer),
+		ServerGroup: ctx.String(flagRuleGroup),
+	}
+	resp, err := c.client.AddAutoConnectRule(context.Background(), &pb.AddAutoConnectRuleRequest{Rule: rule})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(errors.New(MsgAutoConnectRuleInvalid))
+	case internal.CodeNothingToDo:
+		color.Yellow(MsgAutoConnectRuleAlreadyAdded)
+	case internal.CodeSuccess:
+		color.Green(MsgAutoConnectRuleAdded)
+	}
+	return nil
+}
+
+// AutoConnectRuleRemove removes the auto-connect rule by its index
+func (c *cmd) AutoConnectRuleRemove(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	index, err := strconv.ParseUint(ctx.Args().First(), 10, 32)
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.RemoveAutoConnectRule(context.Background(), &pb.RemoveAutoConnectRuleRequest{
+		Index: uint32(index),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeNothingToDo:
+		color.Yellow(MsgAutoConnectRuleNotFound, index)
+	case internal.CodeSuccess:
+		color.Green(MsgAutoConnectRuleRemoved, index)
+	}
+	return nil
+}
+
+// AutoConnectRuleList shows the auto-connect rules in the order they are matched
+func (c *cmd) AutoConnectRuleList(ctx *cli.Context) error {
+	settings, err := c.getSettings()
+	if err != nil {
+		return formatError(err)
+	}
+
+	rules := toAutoConnectRulesJSON(settings.GetAutoconnectRules())
+	if jsonOutput(ctx) {
+		return printJSON(rules)
+	}
+
+	if len(rules) == 0 {
+		color.Yellow(MsgAutoConnectRulesEmpty)
+		return nil
+	}
+	for i, rule := range rules {
+		fmt.Printf("%d. %s\n", i+1, rule)
+	}
+	return nil
+}
+
+type autoConnectRuleJSON struct {
+	SSID        string `json:"ssid,omitempty"`
+	BSSID       string `json:"bssid,omitempty"`
+	NetworkType string `json:"network_type,omitempty"`
+	Action      string `json:"action"`
+	Server      string `json:"server,omitempty"`
+	Group       string `json:"group,omitempty"`
+}
+
+func (r autoConnectRuleJSON) String() string {
+	criteria := []string{}
+	if r.SSID != "" {
+		criteria = append(criteria, fmt.Sprintf("SSID '%s'", r.SSID))
+	}
+	if r.BSSID != "" {
+		criteria = append(criteria, "BSSID "+r.BSSID)
+	}
+	if r.NetworkType != "" {
+		criteria = append(criteria, "type "+r.NetworkType)
+	}
+
+	action := r.Action
+	target := []string{}
+	if r.Server != "" {
+		target = append(target, r.Server)
+	}
+	if r.Group != "" {
+		target = append(target, r.Group)
+	}
+	if len(target) > 0 {
+		action += " to " + strings.Join(target, " ")
+	}
+	return strings.Join(criteria, ", ") + ": " + action
+}
+
+func toAutoConnectRulesJSON(rules []*pb.AutoConnectRule) []autoConnectRuleJSON {
+	out := []autoConnectRuleJSON{}
+	for _, rule := range rules {
+		out = append(out, autoConnectRuleJSON{
+			SSID:        rule.GetSsid(),
+			BSSID:       rule.GetBssid(),
+			NetworkType: rule.GetNetworkType(),
+			Action:      rule.GetAction(),
+			Server:      rule.GetServerTag(),
+			Group:       rule.GetServerGroup(),
+		})
+	}
+	return out
+}
@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+
+	"github.com/hako/durafmt"
+	"github.com/urfave/cli/v2"
+)
+
+const flagCacheStatsJSON = "json"
+
+// Cache stats help text
+const (
+	CacheStatsUsageText   = "Shows how effectively the server list cache is used"
+	CacheStatsJSONUsage   = "Shows cache statistics in JSON format"
+	CacheStatsDescription = `Use this command to see how often the server list was served from the local cache instead of being downloaded since the daemon start.
+Data saved is estimated by the size of the cached server list, as every cache hit avoids downloading the whole list.
+
+Example: 'nordvpn cache-stats'`
+)
+
+// cacheStatsJSON is a JSON representation of the server list cache statistics
+type cacheStatsJSON struct {
+	Hits           uint64  `json:"hits"`
+	Downloads      uint64  `json:"downloads"`
+	HitRate        float64 `json:"hit_rate"`
+	EstimatedSaved uint64  `json:"estimated_saved_bytes"`
+	Servers        uint64  `json:"servers"`
+	Size           uint64  `json:"size_bytes"`
+	UpdatedAt      string  `json:"updated_at,omitempty"`
+	Fresh          bool    `json:"fresh"`
+}
+
+// CacheStats shows the use of the server list cache
+func (c *cmd) CacheStats(ctx *cli.Context) error {
+	resp, err := c.client.CacheStats(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+
+	if ctx.Bool(flagCacheStatsJSON) || jsonOutput(ctx) {
+		return printJSON(toCacheStatsJSON(resp))
+	}
+
+	fmt.Print(formatCacheStats(resp, time.Now()))
+	return nil
+}
+
+// formatCacheStats returns ready to print cache statistics
+func formatCacheStats(resp *pb.CacheStatsResponse, now time.Time) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Cache hits: %d\n", resp.Hits))
+	b.WriteString(fmt.Sprintf("Downloads: %d\n", resp.Downloads))
+	b.WriteString(fmt.Sprintf("Hit rate: %.0f%%\n", cacheHitRate(resp)*100))
+	b.WriteString(fmt.Sprintf("Estimated data saved: %s\n", uint64ToHumanBytes(resp.Hits*resp.Size)))
+	b.WriteString(fmt.Sprintf("Servers: %d\n", resp.Servers))
+	b.WriteString(fmt.Sprintf("Cache size: %s\n", uint64ToHumanBytes(resp.Size)))
+	if resp.UpdatedAt == 0 {
+		b.WriteString("Last updated: never\n")
+		return b.String()
+	}
+
+	age := now.Sub(time.Unix(resp.UpdatedAt, 0)).Truncate(time.Minute)
+	freshness := "outdated"
+	if resp.Fresh {
+		freshness = "fresh"
+	}
+	if age < time.Minute {
+		b.WriteString(fmt.Sprintf("Last updated: just now (%s)\n", freshness))
+	} else {
+		b.WriteString(fmt.Sprintf("Last updated: %s ago (%s)\n", durafmt.Parse(age).String(), freshness))
+	}
+	return b.String()
+}
+
+func toCacheStatsJSON(resp *pb.CacheStatsResponse) cacheStatsJSON {
+	stats := cacheStatsJSON{
+		Hits:           resp.Hits,
+		Downloads:      resp.Downloads,
+		HitRate:        cacheHitRate(resp),
+		EstimatedSaved: resp.Hits * resp.Size,
+		Servers:        resp.Servers,
+		Size:           resp.Size,
+		Fresh:          resp.Fresh,
+	}
+	if resp.UpdatedAt != 0 {
+		stats.UpdatedAt = time.Unix(resp.UpdatedAt, 0).UTC().Format(time.RFC3339)
+	}
+	return stats
+}
+
+// cacheHitRate returns the share of the server list requests served from the cache
+func cacheHitRate(resp *pb.CacheStatsResponse) float64 {
+	if resp.Hits+resp.Downloads == 0 {
+		return 0
+	}
+	return float64(resp.Hits) / float64(resp.Hits+resp.Downloads)
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatCacheStats(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		resp     *pb.CacheStatsResponse
+		expected string
+	}{
+		{
+			name: "never updated",
+			resp: &pb.CacheStatsResponse{},
+			expected: "Cache hits: 0\nDownloads: 0\nHit rate: 0%\nEstimated data saved: 0 B\n" +
+				"Servers: 0\nCache size: 0 B\nLast updated: never\n",
+		},
+		{
+			name: "fresh cache",
+			resp: &pb.CacheStatsResponse{
+				Hits:      3,
+				Downloads: 1,
+				Servers:   5000,
+				Size:      2 * 1024 * 1024,
+				UpdatedAt: now.Add(-25 * time.Minute).Unix(),
+				Fresh:     true,
+			},
+			expected: "Cache hits: 3\nDownloads: 1\nHit rate: 75%\nEstimated data saved: 6.00 MiB\n" +
+				"Servers: 5000\nCache size: 2.00 MiB\nLast updated: 25 minutes ago (fresh)\n",
+		},
+		{
+			name: "outdated cache",
+			resp: &pb.CacheStatsResponse{
+				Downloads: 1,
+				Servers:   5000,
+				Size:      2 * 1024 * 1024,
+				UpdatedAt: now.Add(-2 * time.Hour).Unix(),
+			},
+			expected: "Cache hits: 0\nDownloads: 1\nHit rate: 0%\nEstimated data saved: 0 B\n" +
+				"Servers: 5000\nCache size: 2.00 MiB\nLast updated: 2 hours ago (outdated)\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, formatCacheStats(test.resp, now))
+		})
+	}
+}
+
+func TestToCacheStatsJSON(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	updatedAt := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	stats := toCacheStatsJSON(&pb.CacheStatsResponse{
+		Hits:      1,
+		Downloads: 3,
+		Servers:   10,
+		Size:      100,
+		UpdatedAt: updatedAt.Unix(),
+		Fresh:     true,
+	})
+	assert.Equal(t, cacheStatsJSON{
+		Hits:           1,
+		Downloads:      3,
+		HitRate:        0.25,
+		EstimatedSaved: 100,
+		Servers:        10,
+		Size:           100,
+		UpdatedAt:      "2023-06-01T12:00:00Z",
+		Fresh:          true,
+	}, stats)
+}
@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/hako/durafmt"
+	"github.com/urfave/cli/v2"
+)
+
+// Captive portal help text
+const (
+	CaptivePortalUsageText      = "Manages the sign-in to the network connections with a captive portal"
+	CaptivePortalAddUsageText   = "Allows the sign-in to the gateway when joining the network connection"
+	CaptivePortalAddDescription = `Use this command to mark the network connection as having a captive portal, e.g. a hotel or a guest network requiring to sign in. Each time the network is joined while kill switch is enabled and VPN is not connected, DHCP and DNS, HTTP and HTTPS to the gateway are allowed for 2 minutes, so that the sign-in page served by the gateway can be opened. The rest of the traffic stays blocked by kill switch. Use the NetworkManager connection name, which is usually the Wi-Fi network name.
+
+Example: 'nordvpn captive-portal add "Hotel Wi-Fi"'`
+	CaptivePortalRemoveUsageText   = "Stops allowing the sign-in when joining the network connection"
+	CaptivePortalRemoveDescription = `Use this command to remove the network connection added with 'nordvpn captive-portal add'.
+
+Example: 'nordvpn captive-portal remove "Hotel Wi-Fi"'`
+	CaptivePortalNetworkArgsUsage  = "<network>"
+	CaptivePortalUnlockUsageText   = "Opens the sign-in page of the captive portal blocked by kill switch for a while"
+	CaptivePortalUnlockArgsUsage   = "[<duration>]"
+	CaptivePortalUnlockDescription = `Use this command to sign in to the network with a captive portal, e.g. at a hotel or an airport, while kill switch is enabled. Only DNS, HTTP and HTTPS to the network gateway and the sign-in page are allowed, the rest of the traffic stays blocked. Kill switch is fully enforced again once the duration passes, 3 minutes by default and 15 minutes at most, or when VPN connects or the network changes.
+Joining the network with a captive portal is detected and you are notified if notifications are enabled.
+
+Example: 'nordvpn portal unlock'
+Example: 'nordvpn portal unlock 5m'`
+)
+
+// CaptivePortalAdd marks the network connection as having a captive portal
+func (c *cmd) CaptivePortalAdd(ctx *cli.Context) error {
+	return c.setCaptivePortalNetwork(ctx, true)
+}
+
+// CaptivePortalRemove removes the captive portal mark from the network connection
+func (c *cmd) CaptivePortalRemove(ctx *cli.Context) error {
+	return c.setCaptivePortalNetwork(ctx, false)
+}
+
+func (c *cmd) setCaptivePortalNetwork(ctx *cli.Context, captivePortal bool) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	network := ctx.Args().First()
+	resp, err := c.client.SetCaptivePortalNetwork(context.Background(), &pb.SetCaptivePortalNetworkRequest{
+		Network:       network,
+		CaptivePortal: captivePortal,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		if captivePortal {
+			color.Yellow(MsgCaptivePortalNetworkAlreadyAdded, network)
+		} else {
+			color.Yellow(MsgCaptivePortalNetworkNotAdded, network)
+		}
+	case internal.CodeSuccess:
+		if captivePortal {
+			color.Green(MsgCaptivePortalNetworkAdded, network)
+		} else {
+			color.Green(MsgCaptivePortalNetworkRemoved, network)
+		}
+	}
+	return nil
+}
+
+// CaptivePortalUnlock opens the sign-in page of the captive portal
+func (c *cmd) CaptivePortalUnlock(ctx *cli.Context) error {
+	if ctx.NArg() > 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	var duration time.Duration
+	if ctx.NArg() == 1 {
+		var err error
+		duration, err = time.ParseDuration(ctx.Args().First())
+		if err != nil || duration < time.Second {
+			return formatError(argsParseError(ctx))
+		}
+	}
+
+	resp, err := c.client.UnlockCaptivePortal(context.Background(), &pb.UnlockCaptivePortalRequest{
+		Duration: uint32(duration.Seconds()),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(MsgCaptivePortalUnlockTooLong, 15*time.Minute))
+	case internal.CodeNothingToDo:
+		color.Yellow(MsgCaptivePortalKillSwitchDisabled)
+	case internal.CodeVPNRunning:
+		return formatError(errors.New(MsgCaptivePortalVPNRunning))
+	case internal.CodeFailure:
+		return formatError(errors.New(MsgCaptivePortalUnlockFailed))
+	case internal.CodeSuccess:
+		if len(resp.Data) > 0 {
+			if d, err := time.ParseDuration(resp.Data[0]); err == nil {
+				duration = d
+			}
+		}
+		color.Green(MsgCaptivePortalUnlocked, durafmt.Parse(duration).String())
+		if len(resp.Data) > 1 && resp.Data[1] != "" {
+			color.Green(MsgCaptivePortalSignIn, resp.Data[1])
+		}
+	}
+	return nil
+}
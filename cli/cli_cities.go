@@ -48,6 +48,10 @@ func (c *cmd) Cities(ctx *cli.Context) error {
 		return formatError(errors.New(CitiesNotFoundError))
 	}
 
+	if jsonOutput(ctx) {
+		return printJSON(resp.Data)
+	}
+
 	formattedList, err := internal.Columns(resp.Data)
 	if err != nil {
 		log.Println(internal.ErrorPrefix, err)
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	_ "embed"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Completion help text
+const (
+	CompletionUsageText     = "Prints the shell completion script"
+	CompletionArgsUsageText = "bash|zsh|fish"
+	CompletionDescription   = `Use this command to enable the completion of the commands, countries, cities, server groups and meshnet peers in the shell. Completion values are requested from the app each time, so that they are always up to date.
+
+Example: 'source <(nordvpn completion bash)'
+Example: 'nordvpn completion zsh > "${fpath[1]}/_nordvpn"'
+Example: 'nordvpn completion fish > ~/.config/fish/completions/nordvpn.fish'`
+)
+
+var (
+	//go:embed completion/nordvpn.bash
+	bashCompletion string
+	//go:embed completion/nordvpn.zsh
+	zshCompletion string
+	//go:embed completion/nordvpn.fish
+	fishCompletion string
+)
+
+// completionScripts by the shell name
+var completionScripts = map[string]string{
+	"bash": bashCompletion,
+	"zsh":  zshCompletion,
+	"fish": fishCompletion,
+}
+
+func (c *cmd) Completion(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	script, ok := completionScripts[ctx.Args().First()]
+	if !ok {
+		return formatError(argsParseError(ctx))
+	}
+	fmt.Print(script)
+	return nil
+}
+
+// CompletionAutoComplete lists the supported shells
+func (c *cmd) CompletionAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	for _, shell := range []string{"bash", "zsh", "fish"} {
+		fmt.Println(shell)
+	}
+}
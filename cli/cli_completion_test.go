@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v2"
+)
+
+func TestCompletion(t *testing.T) {
+	category.Set(t, category.Unit)
+	c := cmd{}
+
+	tests := []struct {
+		name     string
+		args     []string
+		contains string
+		hasError bool
+	}{
+		{
+			name:     "bash",
+			args:     []string{"bash"},
+			contains: "complete -o nospace -F _nordvpn_completion nordvpn",
+		},
+		{
+			name:     "zsh",
+			args:     []string{"zsh"},
+			contains: "#compdef nordvpn",
+		},
+		{
+			name:     "fish",
+			args:     []string{"fish"},
+			contains: "complete -c nordvpn",
+		},
+		{
+			name:     "unknown shell",
+			args:     []string{"tcsh"},
+			hasError: true,
+		},
+		{
+			name:     "missing shell",
+			hasError: true,
+		},
+		{
+			name:     "too many arguments",
+			args:     []string{"bash", "zsh"},
+			hasError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			app := cli.NewApp()
+			set := flag.NewFlagSet("test", 0)
+			set.Parse(test.args)
+			ctx := cli.NewContext(app, set, &cli.Context{Context: context.Background()})
+
+			result, err := captureOutput(func() {
+				err := c.Completion(ctx)
+				assert.Equal(t, test.hasError, err != nil)
+			})
+			assert.Nil(t, err)
+			if test.hasError {
+				assert.Empty(t, result)
+				return
+			}
+			assert.Contains(t, result, test.contains)
+			assert.Contains(t, result, "--complete")
+		})
+	}
+}
@@ -5,9 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"log"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strings"
 
 	"github.com/NordSecurity/nordvpn-linux/client"
@@ -20,15 +20,32 @@ import (
 
 // Connect help text
 const (
-	ConnectUsageText          = "Connects you to VPN"
-	ConnectFlagGroupUsageText = "Specify a server group to connect to"
-	ConnectArgsUsageText      = "[<country>|<server>|<country_code>|<city>|<group>|<country> <city>]"
-	ConnectDescription        = `Use this command to connect to NordVPN. Adding no arguments to the command will connect you to the recommended server.
+	ConnectUsageText                 = "Connects you to VPN"
+	ConnectFlagGroupUsageText        = "Specify a server group to connect to"
+	ConnectFlagFavoriteUsageText     = "Specify a favorite to connect to"
+	ConnectFlagRandomUsageText       = "Connect to a random server instead of the recommended one"
+	ConnectFlagPreviewDNSUsage       = "Show the changes which would be made to the DNS configuration without connecting"
+	ConnectFlagVerifyUsageText       = "Probe the matching servers and connect to the reachable one with the lowest latency"
+	ConnectFlagLogToUsageText        = "Write the daemon log of this connection to the specified file until disconnect"
+	ConnectFlagViaUsageText          = "Route the connection through the specified server, country or city first"
+	ConnectFlagViaInterfaceUsageText = "Send the tunnel packets through the specified network interface instead of the default route"
+	ConnectFlagProfileUsageText      = "Apply the settings of the specified profile before connecting"
+	ConnectArgsUsageText             = "[<country>|<server>|<country_code>|<city>|<group>|<country> <city>]"
+	ConnectDescription               = `Use this command to connect to NordVPN. Adding no arguments to the command will connect you to the recommended server.
 Provide a <country> argument to connect to a specific country. For example: 'nordvpn connect Australia'
 Provide a <server> argument to connect to a specific server. For example: 'nordvpn connect jp35'
 Provide a <country_code> argument to connect to a specific country. For example: 'nordvpn connect us'
 Provide a <city> argument to connect to a specific city. For example: 'nordvpn connect Hungary Budapest'
 Provide a <group> argument to connect to a specific servers group. For example: 'nordvpn connect Onion_Over_VPN'
+Provide a --favorite option to connect to an imported favorite. For example: 'nordvpn connect --favorite office'
+Provide a favorites argument to connect to the least loaded of your favorites. For example: 'nordvpn connect favorites'
+Provide a --random option to connect to a random server matching the other arguments. Less loaded servers are more likely to be picked. For example: 'nordvpn connect --random Germany'
+Provide a --preview-dns option to see how the DNS configuration would be changed by connecting, nothing is changed. For example: 'nordvpn connect --preview-dns'
+Provide a --verify option to probe the least loaded matching servers and connect to the reachable one with the lowest latency. Servers which are down or do not respond are skipped. For example: 'nordvpn connect --verify --favorite office'
+Provide a --log-to option to write the daemon log of this connection to a file until disconnect. The file must be in a directory owned by you. For example: 'nordvpn connect --log-to ~/nordvpn-session.log'
+Provide a --via option to make a multi-hop connection: the traffic enters VPN on the specified server and exits to the internet on the server you connect to. The entry hop always uses NordLynx and requires the WireGuard kernel module. For example: 'nordvpn connect --via de123 us456'
+Provide a --via-interface option to send the tunnel packets through the specified network interface, e.g. on dual-WAN routers or laptops with both Wi-Fi and Ethernet. The tunnel follows the interface when its address changes. For example: 'nordvpn connect --via-interface wwan0'
+Provide a --profile option to apply the settings of a saved profile and connect to its server group, unless a server or a group is given. For example: 'nordvpn connect --profile work'
 
 Press the Tab key to see auto-suggestions for countries and cities.`
 )
@@ -51,7 +68,42 @@ func (c *cmd) Connect(ctx *cli.Context) error {
 	serverTag := strings.Join(args.Slice(), " ")
 	serverTag = strings.ToLower(serverTag)
 	serverGroup := ctx.String(flagGroup)
+	favorite := ctx.String(flagFavorite)
+	if favorite != "" && (serverTag != "" || serverGroup != "") {
+		return formatError(errors.New(MsgConnectFavoriteWithServer))
+	}
+
+	if ctx.Bool(flagVerify) && ctx.Bool(flagRandom) {
+		return formatError(errors.New(MsgConnectVerifyWithRandom))
+	}
+
+	if ctx.Bool(flagPreviewDNS) {
+		return c.previewDNS()
+	}
 
+	logFile := ctx.String(flagLogTo)
+	if logFile != "" {
+		var err error
+		if logFile, err = filepath.Abs(logFile); err != nil {
+			return formatError(argsParseError(ctx))
+		}
+	}
+
+	return c.connect(ctx, &pb.ConnectRequest{
+		ServerTag:    serverTag,
+		ServerGroup:  serverGroup,
+		Favorite:     favorite,
+		Random:       ctx.Bool(flagRandom),
+		LogFile:      logFile,
+		Verify:       ctx.Bool(flagVerify),
+		Via:          strings.ToLower(ctx.String(flagVia)),
+		Profile:      ctx.String(flagProfile),
+		ViaInterface: ctx.String(flagViaInterface),
+	})
+}
+
+// connect sends the request to the daemon and prints the progress until connected
+func (c *cmd) connect(ctx *cli.Context, request *pb.ConnectRequest) error {
 	ch := make(chan os.Signal, 1)
 	signal.Notify(ch, os.Interrupt)
 	defer close(ch)
@@ -62,10 +114,7 @@ func (c *cmd) Connect(ctx *cli.Context) error {
 		}
 	}(ch)
 
-	resp, err := c.client.Connect(context.Background(), &pb.ConnectRequest{
-		ServerTag:   serverTag,
-		ServerGroup: serverGroup,
-	})
+	resp, err := c.client.Connect(context.Background(), request)
 	if err != nil {
 		return formatError(err)
 	}
@@ -88,7 +137,7 @@ func (c *cmd) Connect(ctx *cli.Context) error {
 			if rpcErr = c.Login(ctx); rpcErr != nil {
 				break
 			}
-			rpcErr = c.Connect(ctx)
+			rpcErr = c.connect(ctx, request)
 		case internal.CodeTokenRenewError:
 			rpcErr = errors.New(client.AccountTokenRenewError)
 		case internal.CodeAccountExpired:
@@ -111,6 +160,17 @@ func (c *cmd) Connect(ctx *cli.Context) error {
 			color.Yellow(client.UFWDisabledMessage)
 		case internal.CodeConnecting:
 			color.Green(fmt.Sprintf(client.ConnectStart, internal.StringsToInterfaces(out.Data)...))
+		case internal.CodeServerSkipped:
+			color.Yellow(MsgConnectServerSkipped, internal.StringsToInterfaces(out.Data)...)
+		case internal.CodeProtocolFallback:
+			color.Yellow(MsgConnectTCPFallback)
+		case internal.CodeObfuscationFallback:
+			color.Yellow(MsgConnectObfuscationFallback, internal.StringsToInterfaces(out.Data)...)
+		case internal.CodeSubnetOverlap:
+			color.Yellow(MsgConnectSubnetOverlap)
+			for _, overlap := range out.Data {
+				color.Yellow(overlap)
+			}
 		case internal.CodeConnected:
 			color.Green(fmt.Sprintf(internal.ConnectSuccess, internal.StringsToInterfaces(out.Data)...))
 		}
@@ -122,23 +182,30 @@ func (c *cmd) Connect(ctx *cli.Context) error {
 func (c *cmd) ConnectAutoComplete(ctx *cli.Context) {
 	args := ctx.Args()
 	if args.Len() == 0 {
-		resp, err := c.client.Groups(context.Background(), &pb.Empty{})
+		resp, err := c.client.Countries(context.Background(), &pb.Empty{})
 		if err != nil {
 			return
 		}
-		groupList, err := internal.Columns(resp.Data)
-		if err != nil {
-			log.Println(err)
+		for _, country := range resp.Data {
+			fmt.Println(country)
 		}
-		resp, err = c.client.Countries(context.Background(), &pb.Empty{})
+		resp, err = c.client.Groups(context.Background(), &pb.Empty{})
 		if err != nil {
 			return
 		}
-		countryList, err := internal.Columns(resp.Data)
+		for _, group := range resp.Data {
+			fmt.Println(group)
+		}
+		favorites, err := c.client.Favorites(context.Background(), &pb.Empty{})
 		if err != nil {
-			log.Println(err)
+			return
+		}
+		fmt.Println(favoritesServerTag)
+		for _, favorite := range favorites.GetFavorites() {
+			if favorite.GetServerTag() != "" && !strings.Contains(favorite.GetServerTag(), " ") {
+				fmt.Println(favorite.GetServerTag())
+			}
 		}
-		fmt.Println(countryList + " " + groupList)
 	} else if args.Len() == 1 {
 		resp, err := c.client.Cities(context.Background(), &pb.CitiesRequest{
 			Country: ctx.Args().First(),
@@ -146,10 +213,8 @@ func (c *cmd) ConnectAutoComplete(ctx *cli.Context) {
 		if err != nil {
 			return
 		}
-		cityList, err := internal.Columns(resp.Data)
-		if err != nil {
-			log.Println(err)
+		for _, city := range resp.Data {
+			fmt.Println(city)
 		}
-		fmt.Println(cityList)
 	}
 }
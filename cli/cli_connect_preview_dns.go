@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+const flagPreviewDNS = "preview-dns"
+
+// previewDNS prints the changes which would be made to the DNS configuration by connecting
+func (c *cmd) previewDNS() error {
+	resp, err := c.client.PreviewDNS(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeSuccess:
+		fmt.Print(formatDNSPreview(resp))
+	default:
+		return formatError(fmt.Errorf(MsgPreviewDNSFailure))
+	}
+	return nil
+}
+
+func formatDNSPreview(resp *pb.PreviewDNSResponse) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Method: %s\n", resp.Method)
+	fmt.Fprintf(&b, "Nameservers: %s\n", strings.Join(resp.Nameservers, ", "))
+	if len(resp.Commands) > 0 {
+		b.WriteString("\nCommands:\n")
+		for _, command := range resp.Commands {
+			b.WriteString(command + "\n")
+		}
+	}
+
+	b.WriteString("\nCurrent /etc/resolv.conf:\n")
+	if resp.Before == "" {
+		b.WriteString("(empty)\n")
+	} else {
+		b.WriteString(strings.TrimSuffix(resp.Before, "\n") + "\n")
+	}
+
+	switch {
+	case resp.File == "":
+		b.WriteString("\n" + MsgPreviewDNSManagedBySystem + "\n")
+	case resp.Diff == "":
+		fmt.Fprintf(&b, "\n"+MsgPreviewDNSNoChanges+"\n", resp.File)
+	default:
+		fmt.Fprintf(&b, "\nChanges to %s:\n%s", resp.File, resp.Diff)
+	}
+	return b.String()
+}
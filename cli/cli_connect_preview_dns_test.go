@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDNSPreview(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		resp     *pb.PreviewDNSResponse
+		expected string
+	}{
+		{
+			name: "resolv.conf is modified",
+			resp: &pb.PreviewDNSResponse{
+				Method:      "resolv.conf, default",
+				Nameservers: []string{"192.0.2.1", "192.0.2.2"},
+				File:        "/etc/resolv.conf",
+				Before:      "nameserver 192.168.1.1\n",
+				Diff:        "-nameserver 192.168.1.1\n+# Generated by NordVPN\n",
+			},
+			expected: `Method: resolv.conf, default
+Nameservers: 192.0.2.1, 192.0.2.2
+
+Current /etc/resolv.conf:
+nameserver 192.168.1.1
+
+Changes to /etc/resolv.conf:
+-nameserver 192.168.1.1
++# Generated by NordVPN
+`,
+		},
+		{
+			name: "commands",
+			resp: &pb.PreviewDNSResponse{
+				Method:      "resolvectl",
+				Nameservers: []string{"192.0.2.1"},
+				Commands:    []string{"resolvectl dns nordlynx 192.0.2.1", "resolvectl flush-caches"},
+			},
+			expected: `Method: resolvectl
+Nameservers: 192.0.2.1
+
+Commands:
+resolvectl dns nordlynx 192.0.2.1
+resolvectl flush-caches
+
+Current /etc/resolv.conf:
+(empty)
+
+` + MsgPreviewDNSManagedBySystem + "\n",
+		},
+		{
+			name: "not writable resolv.conf",
+			resp: &pb.PreviewDNSResponse{
+				Method:      "resolv.conf, default",
+				Nameservers: []string{"192.0.2.1"},
+				File:        "/etc/resolv.conf",
+				Before:      "nameserver 192.168.1.1",
+			},
+			expected: `Method: resolv.conf, default
+Nameservers: 192.0.2.1
+
+Current /etc/resolv.conf:
+nameserver 192.168.1.1
+
+/etc/resolv.conf would not be changed.
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, formatDNSPreview(test.resp))
+		})
+	}
+}
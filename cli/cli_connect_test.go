@@ -46,6 +46,7 @@ type mockDaemonClient struct {
 	cities    []string
 	groups    []string
 	countries []string
+	favorites []*pb.Favorite
 }
 
 func (c mockDaemonClient) Cities(ctx context.Context, in *pb.CitiesRequest, opts ...grpc.CallOption) (*pb.Payload, error) {
@@ -89,6 +90,13 @@ func (c mockDaemonClient) Groups(ctx context.Context, in *pb.Empty, opts ...grpc
 	}
 }
 
+func (c mockDaemonClient) Favorites(ctx context.Context, in *pb.Empty, opts ...grpc.CallOption) (*pb.FavoritesResponse, error) {
+	return &pb.FavoritesResponse{
+		Type:      internal.CodeSuccess,
+		Favorites: c.favorites,
+	}, nil
+}
+
 func TestConnectAutoComplete(t *testing.T) {
 	category.Set(t, category.Unit)
 	mockClient := mockDaemonClient{}
@@ -97,6 +105,7 @@ func TestConnectAutoComplete(t *testing.T) {
 		name      string
 		countries []string
 		groups    []string
+		favorites []*pb.Favorite
 		expected  []string
 		input     []string
 	}{
@@ -119,8 +128,15 @@ func TestConnectAutoComplete(t *testing.T) {
 			name:      "Groups and Countries",
 			groups:    []string{"Europe", "Obfuscated_Servers", "The_Americas"},
 			countries: []string{"Canada", "France", "Germany"},
-			expected:  []string{"Canada", "France", "Germany", "Europe", "Obfuscated_Servers", "The_Americas"},
-			input:     []string{},
+			favorites: []*pb.Favorite{
+				{Name: "de1024", ServerTag: "de1024"},
+				{Name: "office", ServerTag: "lithuania vilnius"},
+				{Name: "p2p", ServerGroup: "p2p"},
+			},
+			expected: []string{
+				"Canada", "France", "Germany", "Europe", "Obfuscated_Servers", "The_Americas", "favorites", "de1024",
+			},
+			input: []string{},
 		},
 	}
 
@@ -131,6 +147,7 @@ func TestConnectAutoComplete(t *testing.T) {
 			mockClient.cities = test.expected
 			mockClient.countries = test.countries
 			mockClient.groups = test.groups
+			mockClient.favorites = test.favorites
 			set.Parse(test.input)
 			ctx := cli.NewContext(app, set, &cli.Context{Context: context.Background()})
 
@@ -139,10 +156,7 @@ func TestConnectAutoComplete(t *testing.T) {
 			})
 
 			assert.Nil(t, err)
-
-			list, _ := internal.Columns(test.expected)
-			assert.NotEmpty(t, list)
-			assert.Equal(t, list, result)
+			assert.Equal(t, strings.Join(test.expected, "\n"), result)
 		})
 	}
 }
@@ -28,6 +28,10 @@ func (c *cmd) Countries(ctx *cli.Context) error {
 		return formatError(err)
 	}
 
+	if jsonOutput(ctx) {
+		return printJSON(resp.Data)
+	}
+
 	countryList, err := internal.Columns(resp.Data)
 	if err != nil {
 		log.Println(internal.ErrorPrefix, err)
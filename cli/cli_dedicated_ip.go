@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Dedicated IP help text
+const (
+	DedicatedIPUsageText            = "Manages the Dedicated IP servers assigned to you"
+	DedicatedIPListUsageText        = "Lists the Dedicated IP servers assigned to you"
+	DedicatedIPConnectUsageText     = "Connects to the Dedicated IP server assigned to you"
+	DedicatedIPConnectArgsUsageText = "[<server>]"
+	DedicatedIPConnectDescription   = `Use this command to connect to the Dedicated IP server assigned to you. If you have multiple Dedicated IP servers, the first one is used unless <server> is given.
+
+Example: 'nordvpn dedicated-ip connect de1234'`
+	DedicatedIPRenewInfoUsageText = "Shows when the Dedicated IP subscription expires"
+)
+
+// dedicatedIPExpiryWarning is how long before the expiry the user is reminded to renew
+const dedicatedIPExpiryWarning = 7 * 24 * time.Hour
+
+func (c *cmd) DedicatedIPList(ctx *cli.Context) error {
+	services, err := c.dedicatedIPServices()
+	if err != nil {
+		return formatError(err)
+	}
+	fmt.Print(formatDedicatedIPServers(services))
+	return nil
+}
+
+func (c *cmd) DedicatedIPConnect(ctx *cli.Context) error {
+	if ctx.NArg() > 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	services, err := c.dedicatedIPServices()
+	if err != nil {
+		return formatError(err)
+	}
+	server, err := pickDedicatedIPServer(services, ctx.Args().First())
+	if err != nil {
+		return formatError(err)
+	}
+	return c.connect(ctx, &pb.ConnectRequest{ServerTag: dedicatedIPServerTag(server)})
+}
+
+func (c *cmd) DedicatedIPConnectAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	services, err := c.dedicatedIPServices()
+	if err != nil {
+		return
+	}
+	for _, service := range services {
+		for _, server := range service.GetServers() {
+			fmt.Println(dedicatedIPServerTag(server))
+		}
+	}
+}
+
+func (c *cmd) DedicatedIPRenewInfo(ctx *cli.Context) error {
+	services, err := c.dedicatedIPServices()
+	if err != nil {
+		return formatError(err)
+	}
+	fmt.Print(formatDedicatedIPRenewInfo(services, time.Now()))
+	return nil
+}
+
+// dedicatedIPServices returns the Dedicated IP services of the user or the error to be shown
+func (c *cmd) dedicatedIPServices() ([]*pb.DedicatedIPService, error) {
+	resp, err := c.client.DedicatedIP(context.Background(), &pb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return nil, ErrConfig
+	case internal.CodeTokenInvalid:
+		return nil, errors.New(AccountTokenUnauthorizedError)
+	case internal.CodeGatewayError:
+		return nil, errors.New(MsgDedicatedIPFetchFailed)
+	case internal.CodeNoDedicatedIPService:
+		return nil, errors.New(MsgDedicatedIPNoService)
+	}
+	return resp.GetServices(), nil
+}
+
+// pickDedicatedIPServer returns the server matching the tag or the first
+// assigned server if the tag is empty
+func pickDedicatedIPServer(services []*pb.DedicatedIPService, tag string) (*pb.DedicatedIPServer, error) {
+	for _, service := range services {
+		for _, server := range service.GetServers() {
+			if tag == "" ||
+				strings.EqualFold(tag, dedicatedIPServerTag(server)) ||
+				strings.EqualFold(tag, server.GetHostname()) {
+				return server, nil
+			}
+		}
+	}
+	if tag == "" {
+		return nil, errors.New(MsgDedicatedIPNoServers)
+	}
+	return nil, fmt.Errorf(MsgDedicatedIPServerNotAssigned, tag)
+}
+
+// dedicatedIPServerTag returns the tag of the server accepted by connect, e.g. de1234
+func dedicatedIPServerTag(server *pb.DedicatedIPServer) string {
+	return strings.Split(server.GetHostname(), ".")[0]
+}
+
+func formatDedicatedIPServers(services []*pb.DedicatedIPService) string {
+	var b strings.Builder
+	b.WriteString("Dedicated IP servers:\n")
+	count := 0
+	for _, service := range services {
+		for _, server := range service.GetServers() {
+			count++
+			fmt.Fprintf(&b, "%s - %s, %s (%s)\n",
+				dedicatedIPServerTag(server),
+				server.GetCountry(),
+				server.GetCity(),
+				server.GetHostname(),
+			)
+		}
+	}
+	if count == 0 {
+		b.WriteString(MsgDedicatedIPNoServers + "\n")
+	}
+	return b.String()
+}
+
+func formatDedicatedIPRenewInfo(services []*pb.DedicatedIPService, now time.Time) string {
+	var b strings.Builder
+	for _, service := range services {
+		var servers []string
+		for _, server := range service.GetServers() {
+			servers = append(servers, dedicatedIPServerTag(server))
+		}
+		if len(servers) == 0 {
+			servers = append(servers, "no server assigned")
+		}
+		fmt.Fprintf(&b, "Dedicated IP (%s): ", strings.Join(servers, ", "))
+
+		expiry, err := time.Parse(internal.ServerDateFormat, service.GetExpiresAt())
+		switch {
+		case err != nil:
+			b.WriteString("Expiry unknown\n")
+		case now.After(expiry):
+			fmt.Fprintf(&b, "Expired on %s\n", formatDate(service.GetExpiresAt()))
+			b.WriteString(MsgDedicatedIPRenew + "\n")
+		default:
+			fmt.Fprintf(&b, "Active (Expires on %s)\n", formatDate(service.GetExpiresAt()))
+			if expiry.Sub(now) < dedicatedIPExpiryWarning {
+				b.WriteString(MsgDedicatedIPRenew + "\n")
+			}
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+var dedicatedIPServices = []*pb.DedicatedIPService{
+	{
+		ExpiresAt: "2024-03-05 00:00:00",
+		Servers: []*pb.DedicatedIPServer{
+			{Hostname: "de1234.nordvpn.com", Country: "Germany", City: "Berlin"},
+			{Hostname: "us5678.nordvpn.com", Country: "United States", City: "New York"},
+		},
+	},
+	{ExpiresAt: "2024-02-01 00:00:00"},
+}
+
+func TestPickDedicatedIPServer(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		services []*pb.DedicatedIPService
+		tag      string
+		expected string
+		err      string
+	}{
+		{name: "first", services: dedicatedIPServices, expected: "de1234.nordvpn.com"},
+		{name: "by tag", services: dedicatedIPServices, tag: "US5678", expected: "us5678.nordvpn.com"},
+		{name: "by hostname", services: dedicatedIPServices, tag: "us5678.nordvpn.com", expected: "us5678.nordvpn.com"},
+		{name: "not assigned", services: dedicatedIPServices, tag: "fr1", err: fmt.Sprintf(MsgDedicatedIPServerNotAssigned, "fr1")},
+		{name: "no servers", services: dedicatedIPServices[1:], err: MsgDedicatedIPNoServers},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, err := pickDedicatedIPServer(test.services, test.tag)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, server.GetHostname())
+		})
+	}
+}
+
+func TestFormatDedicatedIPServers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, `Dedicated IP servers:
+de1234 - Germany, Berlin (de1234.nordvpn.com)
+us5678 - United States, New York (us5678.nordvpn.com)
+`, formatDedicatedIPServers(dedicatedIPServices))
+}
+
+func TestFormatDedicatedIPRenewInfo(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, `Dedicated IP (de1234, us5678): Active (Expires on Mar 5th, 2024)
+`+MsgDedicatedIPRenew+`
+Dedicated IP (no server assigned): Expired on Feb 1st, 2024
+`+MsgDedicatedIPRenew+`
+`, formatDedicatedIPRenewInfo(dedicatedIPServices, now))
+}
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Diagnose help text
+const (
+	DiagnoseUsageText   = "Verifies that no traffic leaks outside of the VPN tunnel"
+	DiagnoseDescription = `Use this command to test the leak protection of the current connection.
+The daemon sends test traffic through and around the VPN tunnel to verify DNS leak protection, IPv6 leak protection, UDP egress used by WebRTC and Kill Switch integrity.
+Tests are skipped when neither VPN connection nor Kill Switch protect the traffic.
+
+Example: 'nordvpn diagnose'`
+)
+
+// Diagnose runs the leak tests and prints the report
+func (c *cmd) Diagnose(ctx *cli.Context) error {
+	resp, err := c.client.Diagnose(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+	if resp.Type != internal.CodeSuccess {
+		return formatError(internal.ErrUnhandled)
+	}
+	fmt.Print(formatDiagnostics(resp))
+	return nil
+}
+
+// formatDiagnostics returns ready to print leak test report
+func formatDiagnostics(resp *pb.DiagnoseResponse) string {
+	var b strings.Builder
+	var passed, failed, skipped int
+	for _, result := range resp.Results {
+		switch result.Status {
+		case pb.DiagnosticStatus_PASSED:
+			passed++
+		case pb.DiagnosticStatus_FAILED:
+			failed++
+		case pb.DiagnosticStatus_SKIPPED:
+			skipped++
+		}
+		b.WriteString(fmt.Sprintf("%s: %s\n", result.Name, strings.ToLower(result.Status.String())))
+		if result.Details != "" {
+			b.WriteString("  " + result.Details + "\n")
+		}
+		if result.Hint != "" {
+			b.WriteString("  Hint: " + result.Hint + "\n")
+		}
+	}
+	b.WriteString(fmt.Sprintf("\nPassed: %d, failed: %d, skipped: %d\n", passed, failed, skipped))
+	return b.String()
+}
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDiagnostics(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	resp := &pb.DiagnoseResponse{Results: []*pb.DiagnosticResult{
+		{
+			Name:    "DNS leak protection",
+			Status:  pb.DiagnosticStatus_PASSED,
+			Details: "DNS queries outside of the tunnel are blocked.",
+		},
+		{
+			Name:    "Kill Switch integrity",
+			Status:  pb.DiagnosticStatus_FAILED,
+			Details: "Connection outside of the tunnel succeeded.",
+			Hint:    "Toggle Kill Switch.",
+		},
+		{
+			Name:   "WebRTC UDP egress",
+			Status: pb.DiagnosticStatus_SKIPPED,
+		},
+	}}
+
+	expected := `DNS leak protection: passed
+  DNS queries outside of the tunnel are blocked.
+Kill Switch integrity: failed
+  Connection outside of the tunnel succeeded.
+  Hint: Toggle Kill Switch.
+WebRTC UDP egress: skipped
+
+Passed: 1, failed: 1, skipped: 1
+`
+	assert.Equal(t, expected, formatDiagnostics(resp))
+}
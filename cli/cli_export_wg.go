@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Export WireGuard config help text
+const (
+	ExportWGUsageText   = "Exports the WireGuard config of the NordLynx connection"
+	ExportWGArgsUsage   = "[<server>]"
+	ExportWGDescription = `Use this command to connect with other WireGuard clients or for debugging.
+Prints the wg-quick config of the current NordLynx connection. Provide a <server> argument
+to export the config for a specific server. For example: 'nordvpn export-wg lt10'
+
+The private key is redacted unless --include-private-key is used. Anyone who has the
+private key can connect to NordVPN using your account, so never share the exported config.
+
+Example: 'nordvpn export-wg --include-private-key > nordlynx.conf'`
+	exportWGIncludePrivateKeyUsage = "Includes the private key in the config"
+	flagExportWGIncludePrivateKey  = "include-private-key"
+)
+
+func (c *cmd) ExportWG(ctx *cli.Context) error {
+	if ctx.NArg() > 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	includePrivateKey := ctx.Bool(flagExportWGIncludePrivateKey)
+	resp, err := c.client.ExportWireGuardConfig(context.Background(), &pb.ExportWireGuardConfigRequest{
+		ServerTag:         ctx.Args().First(),
+		IncludePrivateKey: includePrivateKey,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeVPNNotRunning:
+		return formatError(errors.New(MsgExportWGNotConnected))
+	case internal.CodeDependencyError:
+		return formatError(errors.New(MsgExportWGNotNordLynx))
+	case internal.CodeTagNonexisting:
+		return formatError(errors.New(internal.TagNonexistentErrorMessage))
+	case internal.CodeServerUnavailable:
+		return formatError(fmt.Errorf(MsgExportWGServerNotNordLynx, ctx.Args().First()))
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeSuccess:
+		// warnings go to stderr, so that the config can be redirected to a file
+		if includePrivateKey {
+			fmt.Fprintln(os.Stderr, color.YellowString(MsgExportWGPrivateKeyWarning))
+		} else {
+			fmt.Fprintln(os.Stderr, color.YellowString(MsgExportWGPrivateKeyRedacted, flagExportWGIncludePrivateKey))
+		}
+		fmt.Print(resp.Config)
+	default:
+		return formatError(internal.ErrUnhandled)
+	}
+	return nil
+}
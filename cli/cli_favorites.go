@@ -0,0 +1,229 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Favorites help text
+const (
+	FavoritesUsageText      = "Manages favorite servers"
+	FavoritesAddUsageText   = "Adds a server to favorites"
+	FavoritesAddArgsUsage   = "<server>"
+	FavoritesAddDescription = `Use this command to add a server to favorites.
+Connect to the least loaded of your favorites with 'nordvpn connect favorites'.
+
+Example: 'nordvpn favorites add de1024'`
+	FavoritesListUsageText     = "Lists favorite servers"
+	FavoritesImportUsageText   = "Imports favorite servers from a file"
+	FavoritesImportArgsUsage   = "<file>"
+	FavoritesImportDescription = `Use this command to import favorite servers from a JSON file.
+Every favorite has a name and a server tag or a server group, as used with the 'nordvpn connect' command:
+[
+  {"name": "office", "server_tag": "lithuania vilnius"},
+  {"name": "p2p", "server_group": "p2p"},
+  {"name": "p2p-germany", "server_tag": "germany", "server_group": "p2p"}
+]
+
+Favorites which cannot be found in the server list are not imported.
+Favorites with existing names are replaced.
+Connect to a favorite with 'nordvpn connect --favorite <name>'.
+
+Example: 'nordvpn favorites import ~/favorites.json'`
+	FavoritesExportUsageText   = "Exports favorite servers to a file or prints them"
+	FavoritesExportArgsUsage   = "[<file>]"
+	FavoritesExportDescription = `Use this command to share favorite servers. Exported file can be imported with 'nordvpn favorites import'.
+If no file is provided, favorites are printed.
+
+Example: 'nordvpn favorites export ~/favorites.json'`
+)
+
+// favoritesServerTag connects to the least loaded of the favorites, e.g. 'nordvpn connect favorites'
+const favoritesServerTag = "favorites"
+
+// FavoritesAdd adds the server to favorites under its own name
+func (c *cmd) FavoritesAdd(ctx *cli.Context) error {
+	if ctx.NArg() == 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	serverTag := strings.ToLower(strings.Join(ctx.Args().Slice(), " "))
+	resp, err := c.client.ImportFavorites(context.Background(), &pb.ImportFavoritesRequest{
+		Favorites: []*pb.Favorite{{Name: serverTag, ServerTag: serverTag}},
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeNothingToDo:
+		return formatError(fmt.Errorf(MsgFavoritesNotFound, serverTag))
+	}
+	color.Green(MsgFavoritesAdded, serverTag)
+	return nil
+}
+
+// FavoritesAddAutoComplete suggests the countries, the same as connect does
+func (c *cmd) FavoritesAddAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	resp, err := c.client.Countries(context.Background(), &pb.Empty{})
+	if err != nil {
+		return
+	}
+	for _, country := range resp.Data {
+		fmt.Println(country)
+	}
+}
+
+// FavoritesList prints the favorites
+func (c *cmd) FavoritesList(ctx *cli.Context) error {
+	resp, err := c.client.Favorites(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+	if resp.Type != internal.CodeSuccess {
+		return formatError(ErrConfig)
+	}
+	if len(resp.Favorites) == 0 {
+		color.Yellow(MsgFavoritesEmpty)
+		return nil
+	}
+	fmt.Print(formatFavorites(resp.Favorites))
+	return nil
+}
+
+// FavoritesImport reads favorites from the file and imports them
+func (c *cmd) FavoritesImport(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	data, err := os.ReadFile(ctx.Args().First())
+	if err != nil {
+		return formatError(err)
+	}
+	favorites, err := parseFavorites(data)
+	if err != nil {
+		return formatError(fmt.Errorf(MsgFavoritesInvalidFile, err))
+	}
+
+	resp, err := c.client.ImportFavorites(context.Background(), &pb.ImportFavoritesRequest{
+		Favorites: favorites,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	for _, name := range resp.Unknown {
+		color.Yellow(MsgFavoritesUnknown, name)
+	}
+	switch resp.Type {
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(MsgFavoritesInvalidFile, errors.New(MsgFavoritesMissingFields)))
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeNothingToDo:
+		color.Yellow(MsgFavoritesNothingImported)
+	case internal.CodeSuccess:
+		color.Green(MsgFavoritesImported, resp.Imported)
+	}
+	return nil
+}
+
+// FavoritesExport writes favorites to the file or prints them
+func (c *cmd) FavoritesExport(ctx *cli.Context) error {
+	if ctx.NArg() > 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	resp, err := c.client.Favorites(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+	if resp.Type != internal.CodeSuccess {
+		return formatError(ErrConfig)
+	}
+
+	data, err := json.MarshalIndent(favoritesFromPb(resp.Favorites), "", "  ")
+	if err != nil {
+		return formatError(err)
+	}
+
+	if ctx.NArg() == 0 {
+		fmt.Println(string(data))
+		return nil
+	}
+	if err := os.WriteFile(ctx.Args().First(), append(data, '\n'), internal.PermUserRWGroupROthersR); err != nil {
+		return formatError(err)
+	}
+	color.Green(MsgFavoritesExported, len(resp.Favorites), ctx.Args().First())
+	return nil
+}
+
+// parseFavorites parses the favorites file
+func parseFavorites(data []byte) ([]*pb.Favorite, error) {
+	var favorites config.Favorites
+	if err := json.Unmarshal(data, &favorites); err != nil {
+		return nil, err
+	}
+
+	ret := make([]*pb.Favorite, 0, len(favorites))
+	for i, favorite := range favorites {
+		if strings.TrimSpace(favorite.Name) == "" ||
+			(strings.TrimSpace(favorite.ServerTag) == "" && strings.TrimSpace(favorite.ServerGroup) == "") {
+			return nil, fmt.Errorf("entry %d: %s", i+1, MsgFavoritesMissingFields)
+		}
+		ret = append(ret, &pb.Favorite{
+			Name:        favorite.Name,
+			ServerTag:   favorite.ServerTag,
+			ServerGroup: favorite.ServerGroup,
+		})
+	}
+	return ret, nil
+}
+
+// formatFavorites lists the favorites one per line. Names are omitted for the
+// favorites which are named after their servers, e.g. the ones added with 'favorites add'.
+func formatFavorites(favorites []*pb.Favorite) string {
+	var b strings.Builder
+	b.WriteString("Favorites:\n")
+	for _, favorite := range favorites {
+		target := favorite.GetServerTag()
+		if favorite.GetServerGroup() != "" {
+			target = strings.TrimSpace(target + " (" + favorite.GetServerGroup() + ")")
+		}
+		if strings.EqualFold(favorite.GetName(), favorite.GetServerTag()) && favorite.GetServerGroup() == "" {
+			fmt.Fprintf(&b, "%s\n", target)
+			continue
+		}
+		fmt.Fprintf(&b, "%s - %s\n", favorite.GetName(), target)
+	}
+	return b.String()
+}
+
+func favoritesFromPb(favorites []*pb.Favorite) config.Favorites {
+	ret := config.Favorites{}
+	for _, favorite := range favorites {
+		ret = append(ret, config.Favorite{
+			Name:        favorite.GetName(),
+			ServerTag:   favorite.GetServerTag(),
+			ServerGroup: favorite.GetServerGroup(),
+		})
+	}
+	return ret
+}
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFavorites(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		data     string
+		expected []*pb.Favorite
+		hasError bool
+	}{
+		{
+			name: "valid",
+			data: `[
+  {"name": "office", "server_tag": "lithuania vilnius"},
+  {"name": "p2p", "server_group": "p2p"}
+]`,
+			expected: []*pb.Favorite{
+				{Name: "office", ServerTag: "lithuania vilnius"},
+				{Name: "p2p", ServerGroup: "p2p"},
+			},
+		},
+		{
+			name:     "empty",
+			data:     "[]",
+			expected: []*pb.Favorite{},
+		},
+		{
+			name:     "missing name",
+			data:     `[{"server_tag": "lt"}]`,
+			hasError: true,
+		},
+		{
+			name:     "missing server",
+			data:     `[{"name": "office"}]`,
+			hasError: true,
+		},
+		{
+			name:     "not json",
+			data:     "office lt",
+			hasError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			favorites, err := parseFavorites([]byte(test.data))
+			if test.hasError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, favorites)
+		})
+	}
+}
+
+func TestFormatFavorites(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	favorites := []*pb.Favorite{
+		{Name: "de1024", ServerTag: "de1024"},
+		{Name: "office", ServerTag: "lithuania vilnius"},
+		{Name: "p2p", ServerGroup: "p2p"},
+		{Name: "p2p-germany", ServerTag: "germany", ServerGroup: "p2p"},
+	}
+	expected := `Favorites:
+de1024
+office - lithuania vilnius
+p2p - (p2p)
+p2p-germany - germany (p2p)
+`
+	assert.Equal(t, expected, formatFavorites(favorites))
+}
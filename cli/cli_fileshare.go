@@ -19,12 +19,14 @@ import (
 
 	"github.com/NordSecurity/nordvpn-linux/client"
 	dpb "github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/fileshare"
 	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	mpb "github.com/NordSecurity/nordvpn-linux/meshnet/pb"
 
 	"github.com/fatih/color"
 	"github.com/urfave/cli/v2"
+	"golang.org/x/exp/slices"
 )
 
 // AutocompleteFilepaths prints special value telling the autocomplete script to use default bash completion
@@ -82,6 +84,9 @@ func statusLoop(fileshareClient pb.FileshareClient, client transferStatusClient,
 					fmt.Printf("\r"+MsgFileshareProgressCanceled+"\n", resp.TransferId)
 				}
 				return
+			case pb.Status_INTERRUPTED:
+				fmt.Printf("\r"+MsgFileshareProgressInterrupted+"\n", resp.TransferId, resp.TransferId)
+				return
 			default:
 			}
 		}
@@ -140,6 +145,16 @@ func (c *cmd) FileshareSend(ctx *cli.Context) error {
 		return argsParseError(ctx)
 	}
 
+	tags, err := fileshare.ValidateTags(ctx.StringSlice(flagFileshareTag))
+	if err != nil {
+		return formatError(fmt.Errorf(MsgFileshareInvalidTag, err))
+	}
+
+	rateLimit, err := fileshareRateLimitFlag(ctx)
+	if err != nil {
+		return formatError(err)
+	}
+
 	absPaths := []string{}
 	for _, path := range args.Slice()[1:] {
 		absPath, err := filepath.Abs(path)
@@ -155,9 +170,11 @@ func (c *cmd) FileshareSend(ctx *cli.Context) error {
 	defer cancelFunc()
 
 	client, err := c.fileshareClient.Send(sendContext, &pb.SendRequest{
-		Peer:   args.First(),
-		Paths:  absPaths,
-		Silent: ctx.IsSet(flagFileshareNoWait),
+		Peer:      args.First(),
+		Paths:     absPaths,
+		Silent:    ctx.IsSet(flagFileshareNoWait),
+		Tags:      tags,
+		RateLimit: rateLimit,
 	})
 	if err != nil {
 		return formatError(err)
@@ -251,6 +268,11 @@ func (c *cmd) FileshareAccept(ctx *cli.Context) error {
 		return argsParseError(ctx)
 	}
 
+	rateLimit, err := fileshareRateLimitFlag(ctx)
+	if err != nil {
+		return formatError(err)
+	}
+
 	var path string
 	if ctx.IsSet(flagFilesharePath) {
 		var err error
@@ -283,6 +305,7 @@ func (c *cmd) FileshareAccept(ctx *cli.Context) error {
 		DstPath:    path,
 		Silent:     ctx.IsSet(flagFileshareNoWait),
 		Files:      args.Tail(),
+		RateLimit:  rateLimit,
 	})
 	if err != nil {
 		return formatError(err)
@@ -299,7 +322,12 @@ func (c *cmd) FileshareAccept(ctx *cli.Context) error {
 		}
 	}
 
-	if ctx.IsSet(flagFileshareNoWait) {
+	if resp.Status == pb.Status_QUEUED {
+		color.Yellow(MsgFileshareAcceptQueued)
+		if ctx.IsSet(flagFileshareNoWait) {
+			return nil
+		}
+	} else if ctx.IsSet(flagFileshareNoWait) {
 		color.Green(MsgFileshareAcceptNoWait)
 		return nil
 	}
@@ -307,6 +335,45 @@ func (c *cmd) FileshareAccept(ctx *cli.Context) error {
 	return statusLoop(c.fileshareClient, client, transferID)
 }
 
+// FileshareResume rpc
+func (c *cmd) FileshareResume(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	// disable spinner, we will show message to the user instead
+	c.loaderInterceptor.enabled = false
+	transferID := ctx.Args().First()
+	resumeContext, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+
+	client, err := c.fileshareClient.Resume(resumeContext, &pb.ResumeRequest{
+		TransferId: transferID,
+		Silent:     ctx.IsSet(flagFileshareNoWait),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	resp, err := client.Recv()
+	if err != nil {
+		return formatError(err)
+	}
+
+	if resp.GetError() != nil {
+		if err := getFileshareResponseToError(resp.GetError()); err != nil {
+			return formatError(err)
+		}
+	}
+
+	if ctx.IsSet(flagFileshareNoWait) {
+		color.Green(MsgFileshareResumeNoWait)
+		return nil
+	}
+
+	return statusLoop(c.fileshareClient, client, transferID)
+}
+
 // FileshareCancel rpc
 func (c *cmd) FileshareCancel(ctx *cli.Context) error {
 	if ctx.NArg() != 1 && ctx.NArg() != 2 {
@@ -372,6 +439,110 @@ func (c *cmd) FileshareClear(ctx *cli.Context) error {
 	return nil
 }
 
+// FileshareSetConcurrency rpc
+func (c *cmd) FileshareSetConcurrency(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	limit, err := strconv.ParseUint(ctx.Args().First(), 10, 32)
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.fileshareClient.SetConcurrency(context.Background(), &pb.SetConcurrencyRequest{Limit: uint32(limit)})
+	if err != nil {
+		return formatError(err)
+	}
+	if err := getFileshareResponseToError(resp); err != nil {
+		return formatError(err)
+	}
+
+	if limit == 0 {
+		color.Green(MsgFileshareSetConcurrencyUnlimited)
+	} else {
+		color.Green(MsgFileshareSetConcurrencySuccess, limit)
+	}
+	return nil
+}
+
+// FileshareSetRateLimit rpc
+func (c *cmd) FileshareSetRateLimit(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	limit, err := humanBytesToUint64(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.fileshareClient.SetRateLimit(context.Background(), &pb.SetRateLimitRequest{Limit: limit})
+	if err != nil {
+		return formatError(err)
+	}
+	if err := getFileshareResponseToError(resp); err != nil {
+		return formatError(err)
+	}
+
+	if limit == 0 {
+		color.Green(MsgFileshareRateLimitUnlimited)
+	} else {
+		color.Green(MsgFileshareRateLimitSuccess, uint64ToHumanBytes(limit))
+	}
+	return nil
+}
+
+// FileshareSetAcceptDirs permits the directories to accept transfers into. They
+// are saved to the config directory of the user, which nordfileshared reads on
+// start.
+func (c *cmd) FileshareSetAcceptDirs(ctx *cli.Context) error {
+	var dirs []string
+	for _, arg := range ctx.Args().Slice() {
+		dir, err := filepath.Abs(arg)
+		if err != nil {
+			return formatError(fmt.Errorf(MsgFileshareInvalidPath, err))
+		}
+		info, err := os.Stat(dir)
+		if err != nil {
+			return formatError(fmt.Errorf(MsgFilesharePathNotFound, dir))
+		}
+		if !info.IsDir() {
+			return formatError(errors.New(MsgFileshareAcceptPathIsNotADirectory))
+		}
+		if !slices.Contains(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return formatError(fmt.Errorf(MsgFileshareAcceptDirsError, err))
+	}
+	if err := fileshare.SaveAcceptDirectories(fileshare.AcceptDirsPath(home), dirs); err != nil {
+		return formatError(fmt.Errorf(MsgFileshareAcceptDirsError, err))
+	}
+
+	if len(dirs) == 0 {
+		color.Green(MsgFileshareAcceptDirsDefault)
+	} else {
+		color.Green(MsgFileshareAcceptDirsSuccess, strings.Join(dirs, ", "))
+	}
+	return nil
+}
+
+// fileshareRateLimitFlag returns the transfer rate limit in bytes per second, 0 if not set
+func fileshareRateLimitFlag(ctx *cli.Context) (uint64, error) {
+	if !ctx.IsSet(flagFileshareLimit) {
+		return 0, nil
+	}
+	limit, err := humanBytesToUint64(ctx.String(flagFileshareLimit))
+	if err != nil || limit == 0 {
+		return 0, fmt.Errorf(MsgFileshareInvalidRateLimit, ctx.String(flagFileshareLimit))
+	}
+	return limit, nil
+}
+
 // getFileshareResponseToError converts resp to error. Params are used in case of some error messages.
 func getFileshareResponseToError(resp *pb.Error, params ...any) error {
 	if resp == nil {
@@ -449,8 +620,28 @@ func fileshareErrorCodeToError(code pb.FileshareErrorCode, params ...any) error
 		return errors.New(MsgNoFiles)
 	case pb.FileshareErrorCode_ACCEPT_DIR_NO_PERMISSIONS:
 		return fmt.Errorf(MsgNoPermissions, params...)
+	case pb.FileshareErrorCode_ACCEPT_DIR_NOT_PERMITTED:
+		return fmt.Errorf(MsgAcceptDirNotPermitted, params...)
 	case pb.FileshareErrorCode_PURGE_FAILURE:
 		return errors.New(MsgFileshareClearFailure)
+	case pb.FileshareErrorCode_INVALID_TAG:
+		return fmt.Errorf(MsgFileshareInvalidTag, fileshare.ErrInvalidTag)
+	case pb.FileshareErrorCode_RESUME_OUTGOING:
+		return errors.New(MsgFileshareResumeOutgoingError)
+	case pb.FileshareErrorCode_NOTHING_TO_RESUME:
+		return errors.New(MsgFileshareNothingToResume)
+	case pb.FileshareErrorCode_RESUME_FAILED:
+		return errors.New(MsgFileshareResumeError)
+	case pb.FileshareErrorCode_RATE_LIMIT_FAILURE:
+		return errors.New(MsgFileshareRateLimitError)
+	case pb.FileshareErrorCode_WATCH_ALREADY_EXISTS:
+		return errors.New(MsgFileshareWatchExists)
+	case pb.FileshareErrorCode_WATCH_NOT_FOUND:
+		return errors.New(MsgFileshareWatchNotFound)
+	case pb.FileshareErrorCode_WATCH_NOT_A_DIRECTORY:
+		return errors.New(MsgFileshareWatchNotADir)
+	case pb.FileshareErrorCode_WATCH_FAILURE:
+		return errors.New(MsgFileshareWatchFailure)
 	default:
 		return errors.New(AccountInternalError)
 	}
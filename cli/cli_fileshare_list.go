@@ -9,6 +9,7 @@ import (
 	"os"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/fileshare"
 	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
@@ -19,6 +20,28 @@ import (
 	"github.com/urfave/cli/v2"
 )
 
+// transferJSON is a JSON representation of a fileshare transfer
+type transferJSON struct {
+	ID               string     `json:"id"`
+	Direction        string     `json:"direction"`
+	Peer             string     `json:"peer"`
+	Status           string     `json:"status"`
+	Created          time.Time  `json:"created"`
+	Path             string     `json:"path"`
+	Tags             []string   `json:"tags"`
+	TotalSize        uint64     `json:"total_size"`
+	TotalTransferred uint64     `json:"total_transferred"`
+	Files            []fileJSON `json:"files"`
+}
+
+type fileJSON struct {
+	ID          string `json:"id"`
+	Path        string `json:"path"`
+	Size        uint64 `json:"size"`
+	Transferred uint64 `json:"transferred"`
+	Status      string `json:"status"`
+}
+
 func (c *cmd) getTransfers() ([]*pb.Transfer, error) {
 	listClient, err := c.fileshareClient.List(context.Background(), &pb.Empty{})
 	if err != nil {
@@ -59,15 +82,29 @@ func (c *cmd) FileshareList(ctx *cli.Context) error {
 			return errors.New(MsgFileshareTransferNotFound)
 		}
 
+		if jsonOutput(ctx) {
+			return printJSON(toTransferJSON(transfers[idx]))
+		}
 		fmt.Println(strings.TrimSpace(transferToOutputString(transfers[idx])))
 		return nil
 	}
 
+	if ctx.IsSet(flagFileshareTag) {
+		tags, err := fileshare.ValidateTags(ctx.StringSlice(flagFileshareTag))
+		if err != nil {
+			return formatError(fmt.Errorf(MsgFileshareInvalidTag, err))
+		}
+		transfers = filterTransfersByTags(transfers, tags)
+	}
+
 	printIn, printOut := true, true
 	if ctx.IsSet(flagFileshareListIn) || ctx.IsSet(flagFileshareListOut) {
 		printIn = ctx.IsSet(flagFileshareListIn)
 		printOut = ctx.IsSet(flagFileshareListOut)
 	}
+	if jsonOutput(ctx) {
+		return printJSON(toTransfersJSON(transfers, printIn, printOut))
+	}
 	fmt.Println(strings.TrimSpace(transfersToOutputString(transfers, printIn, printOut)))
 	return nil
 }
@@ -120,13 +157,75 @@ func (c *cmd) FileshareAutoCompleteTransfersAccept(ctx *cli.Context) {
 	})
 }
 
+// FileshareAutoCompleteTransfersResume does transfer id autocompletion for `fileshare resume`
+func (c *cmd) FileshareAutoCompleteTransfersResume(ctx *cli.Context) {
+	c.fileshareAutoCompleteTransfers(ctx, pb.Direction_INCOMING, func(s pb.Status) bool {
+		return s == pb.Status_INTERRUPTED
+	})
+}
+
 // FileshareAutoCompleteTransfersCancel does transfer id and files autocompletion for `fileshare cancel`
 func (c *cmd) FileshareAutoCompleteTransfersCancel(ctx *cli.Context) {
 	c.fileshareAutoCompleteTransfers(ctx, pb.Direction_UNKNOWN_DIRECTION, func(s pb.Status) bool {
-		return s == pb.Status_REQUESTED || s == pb.Status_ONGOING
+		return s == pb.Status_REQUESTED || s == pb.Status_ONGOING || s == pb.Status_QUEUED
 	})
 }
 
+// filterTransfersByTags returns transfers which have all of the tags
+func filterTransfersByTags(transfers []*pb.Transfer, tags []string) []*pb.Transfer {
+	var filtered []*pb.Transfer
+	for _, transfer := range transfers {
+		hasAll := true
+		for _, tag := range tags {
+			if !slices.Contains(transfer.GetTags(), tag) {
+				hasAll = false
+				break
+			}
+		}
+		if hasAll {
+			filtered = append(filtered, transfer)
+		}
+	}
+	return filtered
+}
+
+func toTransfersJSON(transfers []*pb.Transfer, printIn, printOut bool) []transferJSON {
+	out := []transferJSON{}
+	for _, transfer := range transfers {
+		if (transfer.GetDirection() == pb.Direction_INCOMING && !printIn) ||
+			(transfer.GetDirection() == pb.Direction_OUTGOING && !printOut) {
+			continue
+		}
+		out = append(out, toTransferJSON(transfer))
+	}
+	return out
+}
+
+func toTransferJSON(transfer *pb.Transfer) transferJSON {
+	out := transferJSON{
+		ID:               transfer.GetId(),
+		Direction:        strings.ToLower(transfer.GetDirection().String()),
+		Peer:             transfer.GetPeer(),
+		Status:           strings.ToLower(transfer.GetStatus().String()),
+		Created:          transfer.GetCreated().AsTime(),
+		Path:             transfer.GetPath(),
+		Tags:             nonNilStrings(transfer.GetTags()),
+		TotalSize:        transfer.GetTotalSize(),
+		TotalTransferred: transfer.GetTotalTransferred(),
+		Files:            []fileJSON{},
+	}
+	fileshare.ForAllFiles(transfer.GetFiles(), func(f *pb.File) {
+		out.Files = append(out.Files, fileJSON{
+			ID:          f.GetId(),
+			Path:        f.GetPath(),
+			Size:        f.GetSize(),
+			Transferred: f.GetTransferred(),
+			Status:      strings.ToLower(f.GetStatus().String()),
+		})
+	})
+	return out
+}
+
 func transferToOutputString(transfer *pb.Transfer) string {
 	var builder strings.Builder
 	const (
@@ -139,6 +238,9 @@ func transferToOutputString(transfer *pb.Transfer) string {
 	tableWriter := tabwriter.NewWriter(&builder, minwidth, tabwidth, padding, padchar, flags)
 	headingCol := color.New(color.Bold)
 
+	if len(transfer.GetTags()) > 0 {
+		builder.WriteString(fmt.Sprintf("%s %s\n\n", headingCol.Sprintf("Tags:"), strings.Join(transfer.GetTags(), ", ")))
+	}
 	builder.WriteString(headingCol.Sprintf("File list:\n"))
 	fmt.Fprintf(tableWriter, "file\tsize\tstatus\t\n")
 	for _, file := range transfer.Files {
@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterTransfersByTags(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	invoices := &pb.Transfer{Id: "1", Tags: []string{"invoices"}}
+	invoicesQ4 := &pb.Transfer{Id: "2", Tags: []string{"invoices", "q4"}}
+	untagged := &pb.Transfer{Id: "3"}
+	transfers := []*pb.Transfer{invoices, invoicesQ4, untagged}
+
+	tests := []struct {
+		name     string
+		tags     []string
+		expected []*pb.Transfer
+	}{
+		{
+			name:     "single tag",
+			tags:     []string{"invoices"},
+			expected: []*pb.Transfer{invoices, invoicesQ4},
+		},
+		{
+			name:     "all tags must match",
+			tags:     []string{"invoices", "q4"},
+			expected: []*pb.Transfer{invoicesQ4},
+		},
+		{
+			name: "no matches",
+			tags: []string{"photos"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, filterTransfersByTags(transfers, test.tags))
+		})
+	}
+}
+
+func TestToTransfersJSON(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	incoming := &pb.Transfer{
+		Id:        "in",
+		Direction: pb.Direction_INCOMING,
+		Status:    pb.Status_ONGOING,
+		Files: []*pb.File{
+			{Id: "a", Path: "a.txt", Size: 10, Transferred: 5, Status: pb.Status_ONGOING},
+		},
+		TotalSize: 10,
+	}
+	outgoing := &pb.Transfer{Id: "out", Direction: pb.Direction_OUTGOING, Tags: []string{"invoices"}}
+	transfers := []*pb.Transfer{incoming, outgoing}
+
+	all := toTransfersJSON(transfers, true, true)
+	assert.Len(t, all, 2)
+	assert.Equal(t, "incoming", all[0].Direction)
+	assert.Equal(t, "ongoing", all[0].Status)
+	assert.Equal(t, []string{}, all[0].Tags)
+	assert.Equal(t, []fileJSON{
+		{ID: "a", Path: "a.txt", Size: 10, Transferred: 5, Status: "ongoing"},
+	}, all[0].Files)
+	assert.Equal(t, []string{"invoices"}, all[1].Tags)
+	assert.Equal(t, []fileJSON{}, all[1].Files)
+
+	out := toTransfersJSON(transfers, false, true)
+	assert.Len(t, out, 1)
+	assert.Equal(t, "out", out[0].ID)
+
+	assert.Equal(t, []transferJSON{}, toTransfersJSON(nil, true, true))
+}
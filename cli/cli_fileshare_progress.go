@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare"
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// FileshareProgress rpc
+func (c *cmd) FileshareProgress(ctx *cli.Context) error {
+	if ctx.NArg() > 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	progressCtx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	stream, err := c.fileshareClient.StreamProgress(progressCtx, &pb.ProgressRequest{TransferId: ctx.Args().First()})
+	if err != nil {
+		return formatError(err)
+	}
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if progressCtx.Err() != nil || errors.Is(err, io.EOF) {
+				return nil
+			}
+			return formatError(err)
+		}
+		if err := getFileshareResponseToError(resp.GetError()); err != nil {
+			return formatError(err)
+		}
+		fmt.Print(clearScreen + transfersProgressToOutputString(resp.GetTransfers()))
+	}
+}
+
+// transfersProgressToOutputString returns ready to print progress of the transfers
+func transfersProgressToOutputString(transfers []*pb.TransferProgress) string {
+	if len(transfers) == 0 {
+		return MsgFileshareProgressNoTransfers + "\n"
+	}
+
+	var builder strings.Builder
+	const (
+		minwidth = 0
+		tabwidth = 1
+		padding  = 1
+		padchar  = ' '
+		flags    = 0
+	)
+	headingCol := color.New(color.Bold)
+	for i, transfer := range transfers {
+		if i > 0 {
+			builder.WriteString("\n")
+		}
+		status := fileshare.GetTransferStatus(&pb.Transfer{
+			Direction: transfer.GetDirection(),
+			Status:    transfer.GetStatus(),
+		})
+		builder.WriteString(headingCol.Sprintf("%s %s %s", transfer.GetTransferId(),
+			strings.ToLower(transfer.GetDirection().String()), transfer.GetPeer()))
+		builder.WriteString(fmt.Sprintf(" %s %d%%", status, percentage(transfer.GetTotalTransferred(), transfer.GetTotalSize())))
+		if transfer.GetBytesPerSecond() > 0 {
+			builder.WriteString(fmt.Sprintf(" %s/s", uint64ToHumanBytes(transfer.GetBytesPerSecond())))
+		}
+		if transfer.GetEtaSeconds() > 0 {
+			eta := time.Duration(transfer.GetEtaSeconds()) * time.Second
+			builder.WriteString(fmt.Sprintf(" "+MsgFileshareProgressEta, eta))
+		}
+		builder.WriteString("\n")
+
+		tableWriter := tabwriter.NewWriter(&builder, minwidth, tabwidth, padding, padchar, flags)
+		for _, file := range transfer.GetFiles() {
+			fmt.Fprintf(tableWriter, "  %s\t%s\t%d%%\t\n",
+				file.GetPath(),
+				uint64ToHumanBytes(file.GetSize()),
+				percentage(file.GetTransferred(), file.GetSize()),
+			)
+		}
+		if err := tableWriter.Flush(); err != nil {
+			log.Println(err)
+		}
+	}
+	return builder.String()
+}
+
+func percentage(transferred uint64, size uint64) uint64 {
+	if size == 0 {
+		return 100
+	}
+	return transferred * 100 / size
+}
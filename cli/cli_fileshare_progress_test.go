@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransfersProgressToOutputString(t *testing.T) {
+	category.Set(t, category.Unit)
+	color.NoColor = true
+
+	assert.Equal(t, MsgFileshareProgressNoTransfers+"\n", transfersProgressToOutputString(nil))
+
+	transfers := []*pb.TransferProgress{
+		{
+			TransferId:       "id",
+			Direction:        pb.Direction_OUTGOING,
+			Peer:             "laptop",
+			Status:           pb.Status_ONGOING,
+			TotalSize:        2048,
+			TotalTransferred: 1024,
+			BytesPerSecond:   512,
+			EtaSeconds:       2,
+			Files: []*pb.FileProgress{
+				{Path: "/tmp/a", Size: 1024, Transferred: 1024, Finished: true},
+				{Path: "/tmp/bb", Size: 1024},
+			},
+		},
+	}
+	expected := "id outgoing laptop uploading 50% 512 B/s 2s left\n" +
+		"  /tmp/a  1.00 KiB 100% \n" +
+		"  /tmp/bb 1.00 KiB 0%   \n"
+	assert.Equal(t, expected, transfersProgressToOutputString(transfers))
+}
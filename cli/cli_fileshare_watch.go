@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+var errWatchArgsCount = errors.New("unexpected watch arguments")
+
+// FileshareWatch rpc
+func (c *cmd) FileshareWatch(ctx *cli.Context) error {
+	if ctx.NArg() == 0 {
+		if ctx.IsSet(flagFileshareTo) {
+			return formatError(argsCountError(ctx))
+		}
+		return c.fileshareListWatches()
+	}
+
+	folder, peer, err := parseWatchArgs(ctx.Args().Slice(), ctx.String(flagFileshareTo))
+	if errors.Is(err, errWatchArgsCount) {
+		return formatError(argsCountError(ctx))
+	}
+	if err != nil {
+		return formatError(err)
+	}
+	path, err := filepath.Abs(folder)
+	if err != nil {
+		return formatError(fmt.Errorf(MsgFileshareInvalidPath, err))
+	}
+
+	resp, err := c.fileshareClient.Watch(context.Background(), &pb.WatchRequest{Path: path, Peer: peer})
+	if err != nil {
+		return formatError(err)
+	}
+	if err := getFileshareResponseToError(resp); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgFileshareWatchSuccess, path, peer)
+	return nil
+}
+
+// FileshareUnwatch rpc
+func (c *cmd) FileshareUnwatch(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	path, err := filepath.Abs(ctx.Args().First())
+	if err != nil {
+		return formatError(fmt.Errorf(MsgFileshareInvalidPath, err))
+	}
+
+	resp, err := c.fileshareClient.Unwatch(context.Background(), &pb.UnwatchRequest{Path: path})
+	if err != nil {
+		return formatError(err)
+	}
+	if err := getFileshareResponseToError(resp); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgFileshareUnwatchSuccess, path)
+	return nil
+}
+
+// FileshareAutoCompleteWatches lists the watched folders
+func (c *cmd) FileshareAutoCompleteWatches(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	resp, err := c.fileshareClient.ListWatches(context.Background(), &pb.Empty{})
+	if err != nil {
+		return
+	}
+	for _, folder := range resp.GetFolders() {
+		fmt.Println(folder.GetPath())
+	}
+}
+
+func (c *cmd) fileshareListWatches() error {
+	resp, err := c.fileshareClient.ListWatches(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+	if err := getFileshareResponseToError(resp.GetError()); err != nil {
+		return formatError(err)
+	}
+
+	if len(resp.GetFolders()) == 0 {
+		color.Yellow(MsgFileshareWatchNoFolders)
+		return nil
+	}
+	for _, folder := range resp.GetFolders() {
+		fmt.Printf(MsgFileshareWatchListEntry+"\n", folder.GetPath(), folder.GetPeer(), folder.GetSentFiles())
+	}
+	return nil
+}
+
+// parseWatchArgs returns the folder and the peer of the watch command. Flags
+// given after the folder are not parsed by the cli library, so the peer is
+// also looked up in the arguments.
+func parseWatchArgs(args []string, to string) (string, string, error) {
+	switch {
+	case len(args) == 1 && to != "":
+		return args[0], to, nil
+	case len(args) == 1:
+		return "", "", errors.New(MsgFileshareWatchMissingPeer)
+	case len(args) == 3 && to == "" && (args[1] == "--"+flagFileshareTo || args[1] == "-"+flagFileshareTo):
+		return args[0], args[2], nil
+	case len(args) == 2 && to == "" && (args[1] == "--"+flagFileshareTo || args[1] == "-"+flagFileshareTo):
+		return "", "", errors.New(MsgFileshareWatchMissingPeer)
+	default:
+		return "", "", errWatchArgsCount
+	}
+}
@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWatchArgs(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name   string
+		args   []string
+		to     string
+		folder string
+		peer   string
+		err    error
+	}{
+		{
+			name:   "flag before folder",
+			args:   []string{"outbox"},
+			to:     "my-laptop",
+			folder: "outbox",
+			peer:   "my-laptop",
+		},
+		{
+			name:   "flag after folder",
+			args:   []string{"outbox", "--to", "my-laptop"},
+			folder: "outbox",
+			peer:   "my-laptop",
+		},
+		{
+			name: "missing peer",
+			args: []string{"outbox"},
+			err:  errors.New(MsgFileshareWatchMissingPeer),
+		},
+		{
+			name: "missing peer after flag",
+			args: []string{"outbox", "--to"},
+			err:  errors.New(MsgFileshareWatchMissingPeer),
+		},
+		{
+			name: "too many folders",
+			args: []string{"outbox", "inbox"},
+			to:   "my-laptop",
+			err:  errWatchArgsCount,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			folder, peer, err := parseWatchArgs(test.args, test.to)
+			assert.Equal(t, test.err, err)
+			assert.Equal(t, test.folder, folder)
+			assert.Equal(t, test.peer, peer)
+		})
+	}
+}
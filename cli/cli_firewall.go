@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Firewall help text
+const (
+	FirewallUsageText          = "Manages the firewall rules of the app"
+	FirewallRebuildUsageText   = "Rebuilds the firewall rules without disconnecting"
+	FirewallRebuildDescription = `Use this command if the firewall rules of the app got into a wrong state, e.g. after they were changed by another program.
+The rules of Kill Switch, allowlist and the VPN server exception are removed and applied again from the current state. The tunnel and the routes are not changed, so VPN stays connected.
+
+Example: 'nordvpn firewall rebuild'`
+)
+
+// FirewallRebuild removes the firewall rules and applies them again
+func (c *cmd) FirewallRebuild(ctx *cli.Context) error {
+	resp, err := c.client.RebuildFirewall(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeDependencyError:
+		color.Yellow(fmt.Sprintf(FirewallRequired, "firewall rebuild"))
+	case internal.CodeFailure:
+		return formatError(fmt.Errorf(MsgFirewallRebuildFailed, resp.Error))
+	case internal.CodeSuccess:
+		color.Green(MsgFirewallRebuilt)
+		if report := formatFirewallReconciliation(resp); report != "" {
+			color.Yellow(report)
+		}
+	}
+	return nil
+}
+
+// formatFirewallReconciliation returns ready to print differences found while
+// rebuilding the firewall, empty if the rules were in the expected state
+func formatFirewallReconciliation(resp *pb.RebuildFirewallResponse) string {
+	var lines []string
+	if len(resp.Missing) > 0 {
+		lines = append(lines, fmt.Sprintf(MsgFirewallRebuildMissing, strings.Join(resp.Missing, ", ")))
+	}
+	if len(resp.Duplicated) > 0 {
+		lines = append(lines, fmt.Sprintf(MsgFirewallRebuildDuplicated, strings.Join(resp.Duplicated, ", ")))
+	}
+	if len(resp.Unverified) > 0 {
+		lines = append(lines, fmt.Sprintf(MsgFirewallRebuildUnverified, strings.Join(resp.Unverified, ", ")))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatFirewallReconciliation(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		resp     *pb.RebuildFirewallResponse
+		expected string
+	}{
+		{
+			name: "nothing reconciled",
+			resp: &pb.RebuildFirewallResponse{},
+		},
+		{
+			name: "missing and duplicated",
+			resp: &pb.RebuildFirewallResponse{
+				Missing:    []string{"killswitch", "api-allowlist"},
+				Duplicated: []string{"allowlist"},
+			},
+			expected: "Restored missing rules: killswitch, api-allowlist\n" +
+				"Removed duplicates of rules: allowlist",
+		},
+		{
+			name:     "unverified",
+			resp:     &pb.RebuildFirewallResponse{Unverified: []string{"killswitch"}},
+			expected: "Rules are still not applied: killswitch",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, formatFirewallReconciliation(test.resp))
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Health help text
+const (
+	HealthUsageText   = "Shows the results of the daemon self-test"
+	HealthDescription = `Use this command to see whether the environment checks run on the daemon startup have passed.
+The daemon is ready only if all the critical checks (firewall backend, tun device, DNS backend) have passed.
+
+Example: 'nordvpn health'`
+)
+
+// Daemon health help text
+const (
+	DaemonUsageText         = "Shows the state of the daemon"
+	DaemonHealthUsageText   = "Shows whether the VPN is up and the traffic is routed through it"
+	DaemonHealthDescription = `Use this command to check whether the daemon is ready, connected to VPN and the traffic is routed through the tunnel.
+The command exits with a non-zero status otherwise, so it can be used as a container health check, e.g. docker HEALTHCHECK.
+
+Example: 'nordvpn daemon health'`
+)
+
+// Health shows the results of the daemon self-test
+func (c *cmd) Health(ctx *cli.Context) error {
+	resp, err := c.client.Health(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+	fmt.Print(formatHealth(resp))
+	return nil
+}
+
+// formatHealth returns ready to print self-test results
+func formatHealth(resp *pb.HealthResponse) string {
+	if !resp.Done {
+		return "Self-test is in progress\n"
+	}
+
+	var b strings.Builder
+	if resp.Ready {
+		b.WriteString("Ready: yes\n")
+	} else {
+		b.WriteString("Ready: no\n")
+	}
+	for _, check := range resp.Checks {
+		status := "passed"
+		switch {
+		case check.Error == "":
+		case check.Critical:
+			status = "failed (" + check.Error + ")"
+		default:
+			status = "warning (" + check.Error + ")"
+		}
+		b.WriteString(fmt.Sprintf("%s: %s\n", capitalize(check.Name), status))
+	}
+	return b.String()
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// DaemonHealth shows whether the VPN can be used and fails if it cannot
+func (c *cmd) DaemonHealth(ctx *cli.Context) error {
+	resp, err := c.client.Health(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+	fmt.Print(formatDaemonHealth(resp))
+	if !resp.GetHealthy() {
+		return formatError(errors.New(resp.GetReason()))
+	}
+	return nil
+}
+
+// formatDaemonHealth returns ready to print health of the VPN
+func formatDaemonHealth(resp *pb.HealthResponse) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Healthy: %s\n", yesNo(resp.GetHealthy())))
+	b.WriteString(fmt.Sprintf("Daemon ready: %s\n", yesNo(resp.GetReady())))
+	b.WriteString(fmt.Sprintf("Connected: %s\n", yesNo(resp.GetConnected())))
+	b.WriteString(fmt.Sprintf("Traffic routed through the tunnel: %s\n", yesNo(resp.GetRouted())))
+	return b.String()
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+	return "no"
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatHealth(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		resp     *pb.HealthResponse
+		expected string
+	}{
+		{
+			name:     "in progress",
+			resp:     &pb.HealthResponse{},
+			expected: "Self-test is in progress\n",
+		},
+		{
+			name: "ready with a warning",
+			resp: &pb.HealthResponse{Done: true, Ready: true, Checks: []*pb.SelfTestCheck{
+				{Name: "firewall backend", Critical: true},
+				{Name: "API", Error: "timed out"},
+			}},
+			expected: "Ready: yes\nFirewall backend: passed\nAPI: warning (timed out)\n",
+		},
+		{
+			name: "not ready",
+			resp: &pb.HealthResponse{Done: true, Checks: []*pb.SelfTestCheck{
+				{Name: "tun device", Critical: true, Error: "missing /dev/net/tun"},
+			}},
+			expected: "Ready: no\nTun device: failed (missing /dev/net/tun)\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, formatHealth(test.resp))
+		})
+	}
+}
+
+func TestFormatDaemonHealth(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		resp     *pb.HealthResponse
+		expected string
+	}{
+		{
+			name: "healthy",
+			resp: &pb.HealthResponse{Done: true, Ready: true, Connected: true, Routed: true, Healthy: true},
+			expected: "Healthy: yes\nDaemon ready: yes\nConnected: yes\n" +
+				"Traffic routed through the tunnel: yes\n",
+		},
+		{
+			name: "not connected",
+			resp: &pb.HealthResponse{Done: true, Ready: true, Reason: "not connected"},
+			expected: "Healthy: no\nDaemon ready: yes\nConnected: no\n" +
+				"Traffic routed through the tunnel: no\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, formatDaemonHealth(test.resp))
+		})
+	}
+}
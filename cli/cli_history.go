@@ -0,0 +1,163 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+
+	"github.com/hako/durafmt"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagHistoryJSON  = "json"
+	flagHistorySince = "since"
+)
+
+// History help text
+const (
+	HistoryUsageText   = "Shows the recent VPN connections"
+	HistoryJSONUsage   = "Shows the connection history in JSON format"
+	HistorySinceUsage  = "Shows only the connections active within the given time span, e.g. 7d or 12h"
+	HistoryDescription = `Use this command to see the recent VPN connections, how long they lasted, how much data was transferred and why they ended.
+It helps to troubleshoot flaky networks. Only the latest connections are kept, the newest is shown first.
+
+Example: 'nordvpn history --since 7d'`
+)
+
+// historyEntryJSON is a JSON representation of the connection in the history
+type historyEntryJSON struct {
+	Hostname         string `json:"hostname"`
+	Country          string `json:"country,omitempty"`
+	City             string `json:"city,omitempty"`
+	Technology       string `json:"technology"`
+	Protocol         string `json:"protocol"`
+	ConnectedAt      string `json:"connected_at"`
+	DisconnectedAt   string `json:"disconnected_at,omitempty"`
+	Received         uint64 `json:"received_bytes"`
+	Sent             uint64 `json:"sent_bytes"`
+	DisconnectReason string `json:"disconnect_reason,omitempty"`
+}
+
+// History shows the connection history
+func (c *cmd) History(ctx *cli.Context) error {
+	now := time.Now()
+	req := &pb.ConnectionHistoryRequest{}
+	if value := ctx.String(flagHistorySince); value != "" {
+		since, err := parseHistorySince(value, now)
+		if err != nil {
+			return formatError(err)
+		}
+		req.Since = since.Unix()
+	}
+
+	resp, err := c.client.ConnectionHistory(context.Background(), req)
+	if err != nil {
+		return formatError(err)
+	}
+
+	if ctx.Bool(flagHistoryJSON) || jsonOutput(ctx) {
+		entries := make([]historyEntryJSON, 0, len(resp.Entries))
+		for i := len(resp.Entries) - 1; i >= 0; i-- {
+			entries = append(entries, toHistoryEntryJSON(resp.Entries[i]))
+		}
+		return printJSON(entries)
+	}
+
+	if len(resp.Entries) == 0 {
+		fmt.Println(MsgHistoryEmpty)
+		return nil
+	}
+	fmt.Print(formatHistory(resp.Entries, now))
+	return nil
+}
+
+// parseHistorySince returns the start of the time span, such as 7d, before now
+func parseHistorySince(value string, now time.Time) (time.Time, error) {
+	years, months, days, seconds, err := parseTimespan(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return now.AddDate(-years, -months, -days).Add(-time.Duration(seconds) * time.Second), nil
+}
+
+// formatHistory returns the connections as a table, the newest first
+func formatHistory(entries []*pb.ConnectionHistoryEntry, now time.Time) string {
+	var builder strings.Builder
+	const (
+		minwidth = 0
+		tabwidth = 1
+		padding  = 2
+		padchar  = ' '
+		flags    = 0
+	)
+	tableWriter := tabwriter.NewWriter(&builder, minwidth, tabwidth, padding, padchar, flags)
+
+	fmt.Fprintf(tableWriter, "connected\tduration\tserver\ttechnology\treceived\tsent\tdisconnect reason\t\n")
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := entries[i]
+		connectedAt := time.Unix(entry.ConnectedAt, 0).In(now.Location())
+
+		duration := "-"
+		reason := entry.DisconnectReason
+		switch {
+		case entry.DisconnectedAt != 0:
+			duration = durafmt.Parse(time.Unix(entry.DisconnectedAt, 0).Sub(connectedAt)).LimitFirstN(2).String()
+		case reason == "":
+			duration = durafmt.Parse(now.Sub(connectedAt).Truncate(time.Second)).LimitFirstN(2).String()
+			reason = "connected"
+		}
+
+		server := entry.Hostname
+		if entry.Country != "" {
+			server = fmt.Sprintf("%s (%s)", server, strings.Trim(entry.Country+", "+entry.City, ", "))
+		}
+		fmt.Fprintf(tableWriter, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t\n",
+			connectedAt.Format("2006-01-02 15:04"),
+			duration,
+			server,
+			historyTechnology(entry),
+			uint64ToHumanBytes(entry.Download),
+			uint64ToHumanBytes(entry.Upload),
+			reason,
+		)
+	}
+
+	if err := tableWriter.Flush(); err != nil {
+		log.Println(err)
+	}
+	return builder.String()
+}
+
+// historyTechnology returns the technology of the connection and the protocol
+// for OpenVPN, as NordLynx always uses UDP
+func historyTechnology(entry *pb.ConnectionHistoryEntry) string {
+	if entry.Technology != config.Technology_OPENVPN {
+		return entry.Technology.String()
+	}
+	return entry.Technology.String() + "/" + entry.Protocol.String()
+}
+
+func toHistoryEntryJSON(entry *pb.ConnectionHistoryEntry) historyEntryJSON {
+	ret := historyEntryJSON{
+		Hostname:         entry.Hostname,
+		Country:          entry.Country,
+		City:             entry.City,
+		Technology:       entry.Technology.String(),
+		Protocol:         entry.Protocol.String(),
+		ConnectedAt:      time.Unix(entry.ConnectedAt, 0).UTC().Format(time.RFC3339),
+		Received:         entry.Download,
+		Sent:             entry.Upload,
+		DisconnectReason: entry.DisconnectReason,
+	}
+	if entry.DisconnectedAt != 0 {
+		ret.DisconnectedAt = time.Unix(entry.DisconnectedAt, 0).UTC().Format(time.RFC3339)
+	}
+	return ret
+}
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHistorySince(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value    string
+		expected time.Time
+		hasError bool
+	}{
+		{value: "7d", expected: time.Date(2024, time.March, 3, 12, 0, 0, 0, time.UTC)},
+		{value: "12h", expected: time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)},
+		{value: "1w 2h", expected: time.Date(2024, time.March, 3, 10, 0, 0, 0, time.UTC)},
+		{value: "week", hasError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			since, err := parseHistorySince(test.value, now)
+			if test.hasError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, since)
+		})
+	}
+}
+
+func TestFormatHistory(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	start := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	entries := []*pb.ConnectionHistoryEntry{
+		{
+			Hostname:         "de1.nordvpn.com",
+			Country:          "Germany",
+			City:             "Berlin",
+			Technology:       config.Technology_NORDLYNX,
+			Protocol:         config.Protocol_UDP,
+			ConnectedAt:      start.Unix(),
+			DisconnectedAt:   start.Add(90 * time.Minute).Unix(),
+			Download:         2048,
+			Upload:           512,
+			DisconnectReason: "user",
+		},
+		{
+			Hostname:         "lt1.nordvpn.com",
+			Technology:       config.Technology_OPENVPN,
+			Protocol:         config.Protocol_TCP,
+			ConnectedAt:      start.Add(2 * time.Hour).Unix(),
+			DisconnectedAt:   start.Add(2 * time.Hour).Unix(),
+			DisconnectReason: "connection failed",
+		},
+		{
+			Hostname:    "lv1.nordvpn.com",
+			Country:     "Latvia",
+			City:        "Riga",
+			Technology:  config.Technology_NORDLYNX,
+			ConnectedAt: start.Add(3 * time.Hour).Unix(),
+			Download:    1024,
+		},
+	}
+
+	expected := `connected         duration           server                             technology   received  sent   disconnect reason  
+2024-03-01 15:00  1 hour 30 minutes  lv1.nordvpn.com (Latvia, Riga)     NORDLYNX     1.00 KiB  0 B    connected          
+2024-03-01 14:00  0 seconds          lt1.nordvpn.com                    OPENVPN/TCP  0 B       0 B    connection failed  
+2024-03-01 12:00  1 hour 30 minutes  de1.nordvpn.com (Germany, Berlin)  NORDLYNX     2.00 KiB  512 B  user               
+`
+	assert.Equal(t, expected, formatHistory(entries, start.Add(270*time.Minute)))
+}
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Kill Switch help text
+const (
+	KillSwitchUsageText          = "Shows what Kill Switch is doing"
+	KillSwitchBlockedUsageText   = "Shows the traffic recently blocked by Kill Switch"
+	KillSwitchBlockedDescription = `Use this command to find out why an app can't connect while Kill Switch is on or VPN is connecting.
+Blocked traffic is logged only when 'nordvpn set killswitch-log' is enabled. The log is rate limited, so the counts are not exact.
+
+Example: 'nordvpn killswitch blocked'`
+)
+
+// KillSwitchBlocked prints the summaries of the recently blocked traffic
+func (c *cmd) KillSwitchBlocked(ctx *cli.Context) error {
+	resp, err := c.client.KillSwitchBlocked(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+	if resp.Type != internal.CodeSuccess {
+		return formatError(ErrConfig)
+	}
+
+	if len(resp.Traffic) == 0 {
+		if !resp.Logging {
+			color.Yellow(MsgKillSwitchLogDisabled)
+		} else {
+			color.Yellow(MsgKillSwitchBlockedEmpty)
+		}
+		return nil
+	}
+
+	fmt.Print(formatBlockedTraffic(resp.Traffic))
+	color.Yellow(MsgKillSwitchBlockedAllowlist, allowlistSuggestion(resp.Traffic[0]))
+	if !resp.Logging {
+		color.Yellow(MsgKillSwitchLogDisabled)
+	}
+	return nil
+}
+
+// formatBlockedTraffic returns ready to print blocked traffic summaries, one per line
+func formatBlockedTraffic(traffic []*pb.BlockedTraffic) string {
+	var b strings.Builder
+	for _, t := range traffic {
+		direction := "to"
+		if t.Inbound {
+			direction = "from"
+		}
+		destination := t.Address
+		if t.Port != 0 {
+			destination = fmt.Sprintf("%s port %d", t.Address, t.Port)
+		}
+		times := "time"
+		if t.Count != 1 {
+			times = "times"
+		}
+		fmt.Fprintf(&b, "Blocked %s %s %s: %d %s, last at %s\n",
+			t.Protocol,
+			direction,
+			destination,
+			t.Count,
+			times,
+			time.Unix(t.LastSeen, 0).Format(time.DateTime),
+		)
+	}
+	return b.String()
+}
+
+// allowlistSuggestion returns the allowlist command which would allow the traffic
+func allowlistSuggestion(traffic *pb.BlockedTraffic) string {
+	protocol := strings.ToUpper(traffic.Protocol)
+	if traffic.Port != 0 && (protocol == "TCP" || protocol == "UDP") {
+		return fmt.Sprintf("nordvpn allowlist add port %d protocol %s", traffic.Port, protocol)
+	}
+
+	bits := 32
+	if addr, err := netip.ParseAddr(traffic.Address); err == nil {
+		bits = addr.BitLen()
+	}
+	return fmt.Sprintf("nordvpn allowlist add subnet %s/%d", traffic.Address, bits)
+}
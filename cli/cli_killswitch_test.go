@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatBlockedTraffic(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	lastSeen := time.Date(2024, 1, 1, 12, 0, 5, 0, time.Local)
+	traffic := []*pb.BlockedTraffic{
+		{Protocol: "UDP", Address: "1.1.1.1", Port: 53, Count: 3, LastSeen: lastSeen.Unix()},
+		{Inbound: true, Protocol: "ICMP", Address: "192.168.1.7", Count: 1, LastSeen: lastSeen.Unix()},
+	}
+
+	expected := "Blocked UDP to 1.1.1.1 port 53: 3 times, last at 2024-01-01 12:00:05\n" +
+		"Blocked ICMP from 192.168.1.7: 1 time, last at 2024-01-01 12:00:05\n"
+	assert.Equal(t, expected, formatBlockedTraffic(traffic))
+}
+
+func TestAllowlistSuggestion(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		traffic  *pb.BlockedTraffic
+		expected string
+	}{
+		{
+			name:     "port",
+			traffic:  &pb.BlockedTraffic{Protocol: "UDP", Address: "1.1.1.1", Port: 53},
+			expected: "nordvpn allowlist add port 53 protocol UDP",
+		},
+		{
+			name:     "ipv4 without port",
+			traffic:  &pb.BlockedTraffic{Protocol: "ICMP", Address: "192.168.1.7"},
+			expected: "nordvpn allowlist add subnet 192.168.1.7/32",
+		},
+		{
+			name:     "ipv6 with unsupported protocol",
+			traffic:  &pb.BlockedTraffic{Protocol: "SCTP", Address: "2001:db8::1", Port: 9899},
+			expected: "nordvpn allowlist add subnet 2001:db8::1/128",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, allowlistSuggestion(test.traffic))
+		})
+	}
+}
@@ -0,0 +1,309 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// groupPermissionNames are used in the messages about the group permissions
+var groupPermissionNames = map[pb.PeerPermission]string{
+	pb.PeerPermission_PERMISSION_INCOMING:      "Incoming traffic",
+	pb.PeerPermission_PERMISSION_ROUTING:       "Traffic routing",
+	pb.PeerPermission_PERMISSION_LOCAL_NETWORK: "Local network access",
+	pb.PeerPermission_PERMISSION_FILESHARE:     "Fileshare",
+}
+
+// MeshGroupList lists the groups with the hostnames of their peers
+func (c *cmd) MeshGroupList(ctx *cli.Context) error {
+	resp, err := c.meshClient.GetPeerGroups(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+
+	var groups []*pb.PeerGroup
+	switch resp := resp.Response.(type) {
+	case *pb.GetPeerGroupsResponse_Groups:
+		groups = resp.Groups.GetGroups()
+	case *pb.GetPeerGroupsResponse_ServiceErrorCode:
+		return formatError(serviceErrorCodeToError(resp.ServiceErrorCode))
+	default:
+		return formatError(errors.New(AccountInternalError))
+	}
+
+	if len(groups) == 0 {
+		color.Yellow(MsgMeshnetGroupNoGroups)
+		return nil
+	}
+
+	// peers which are no longer in the meshnet are shown by their identifiers
+	hostnames := map[string]string{}
+	if peersResp, err := c.meshClient.GetPeers(context.Background(), &pb.Empty{}); err == nil {
+		if peers, err := getPeersResponseToPeerList(peersResp); err == nil {
+			for _, peer := range append(peers.GetLocal(), peers.GetExternal()...) {
+				hostnames[peer.GetIdentifier()] = peer.GetHostname()
+			}
+		}
+	}
+
+	for i, group := range groups {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Println(color.New(color.Bold).Sprint("Group: ") + group.GetName())
+		names := make([]string, 0, len(group.GetPeers()))
+		for _, identifier := range group.GetPeers() {
+			if hostname, ok := hostnames[identifier]; ok {
+				names = append(names, hostname)
+			} else {
+				names = append(names, identifier)
+			}
+		}
+		if len(names) == 0 {
+			fmt.Println("Peers: none")
+		} else {
+			fmt.Println("Peers: " + strings.Join(names, ", "))
+		}
+	}
+	return nil
+}
+
+// MeshGroupCreate creates an empty group
+func (c *cmd) MeshGroupCreate(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+	name := ctx.Args().First()
+
+	resp, err := c.meshClient.CreatePeerGroup(context.Background(), &pb.PeerGroupRequest{Name: name})
+	if err != nil {
+		return formatError(err)
+	}
+	if err := peerGroupResponseToError(resp, name, ""); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgMeshnetGroupCreateSuccess, name)
+	return nil
+}
+
+// MeshGroupDelete deletes the group
+func (c *cmd) MeshGroupDelete(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+	name := ctx.Args().First()
+
+	resp, err := c.meshClient.DeletePeerGroup(context.Background(), &pb.PeerGroupRequest{Name: name})
+	if err != nil {
+		return formatError(err)
+	}
+	if err := peerGroupResponseToError(resp, name, ""); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgMeshnetGroupDeleteSuccess, name)
+	return nil
+}
+
+// MeshGroupAdd adds the peer to the group
+func (c *cmd) MeshGroupAdd(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return formatError(argsCountError(ctx))
+	}
+	name := ctx.Args().First()
+	peer, err := c.retrievePeer(ctx.Args().Get(1))
+	if err != nil {
+		return formatError(err)
+	}
+
+	resp, err := c.meshClient.AddPeerToGroup(context.Background(), &pb.PeerGroupMemberRequest{
+		Group:      name,
+		Identifier: peer.GetIdentifier(),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+	if err := peerGroupResponseToError(resp, name, peer.GetHostname()); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgMeshnetGroupAddSuccess, peer.GetHostname(), name)
+	return nil
+}
+
+// MeshGroupRemove removes the peer from the group. Peers which are no longer
+// in the meshnet can be removed by their identifiers.
+func (c *cmd) MeshGroupRemove(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return formatError(argsCountError(ctx))
+	}
+	name := ctx.Args().First()
+	identifier := ctx.Args().Get(1)
+	hostname := identifier
+	if peer, err := c.retrievePeer(identifier); err == nil {
+		identifier = peer.GetIdentifier()
+		hostname = peer.GetHostname()
+	}
+
+	resp, err := c.meshClient.RemovePeerFromGroup(context.Background(), &pb.PeerGroupMemberRequest{
+		Group:      name,
+		Identifier: identifier,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+	if err := peerGroupResponseToError(resp, name, hostname); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgMeshnetGroupRemoveSuccess, hostname, name)
+	return nil
+}
+
+// MeshGroupSetPermission returns the action which allows or denies the
+// permission for all the peers of the group
+func (c *cmd) MeshGroupSetPermission(permission pb.PeerPermission, allow bool) cli.ActionFunc {
+	return func(ctx *cli.Context) error {
+		if ctx.NArg() != 1 {
+			return formatError(argsCountError(ctx))
+		}
+		name := ctx.Args().First()
+
+		resp, err := c.meshClient.SetGroupPermission(context.Background(), &pb.SetGroupPermissionRequest{
+			Group:      name,
+			Permission: permission,
+			Allow:      allow,
+		})
+		if err != nil {
+			return formatError(err)
+		}
+
+		var results []*pb.PeerPermissionResult
+		switch resp := resp.Response.(type) {
+		case *pb.SetGroupPermissionResponse_Results:
+			results = resp.Results.GetResults()
+		case *pb.SetGroupPermissionResponse_PeerGroupErrorCode:
+			return formatError(peerGroupErrorCodeToError(resp.PeerGroupErrorCode, name, ""))
+		case *pb.SetGroupPermissionResponse_ServiceErrorCode:
+			return formatError(serviceErrorCodeToError(resp.ServiceErrorCode))
+		case *pb.SetGroupPermissionResponse_MeshnetErrorCode:
+			return formatError(meshnetErrorToError(resp.MeshnetErrorCode))
+		default:
+			return formatError(errors.New(AccountInternalError))
+		}
+
+		permissionName := groupPermissionNames[permission]
+		state := "denied"
+		if allow {
+			state = "allowed"
+		}
+		updated := 0
+		for _, result := range results {
+			switch result.GetOutcome() {
+			case pb.PermissionOutcome_PERMISSION_UPDATED:
+				updated++
+				color.Green(MsgMeshnetGroupPermissionUpdated, permissionName, result.GetHostname(), state)
+			case pb.PermissionOutcome_PERMISSION_UNCHANGED:
+				color.Yellow(MsgMeshnetGroupPermissionSame, permissionName, result.GetHostname(), state)
+			case pb.PermissionOutcome_PERMISSION_PEER_NOT_FOUND:
+				color.Yellow(MsgMeshnetGroupPeerGone, result.GetIdentifier(), name)
+			default:
+				color.Red(MsgMeshnetGroupPermissionFailed, strings.ToLower(permissionName), result.GetHostname())
+			}
+		}
+		fmt.Printf(MsgMeshnetGroupPermissionSummary+"\n", permissionName, updated, len(results), name)
+		return nil
+	}
+}
+
+// MeshGroupAutoComplete lists the group names for the first argument and the
+// peers for the second one
+func (c *cmd) MeshGroupAutoComplete(ctx *cli.Context) {
+	switch ctx.NArg() {
+	case 0:
+		resp, err := c.meshClient.GetPeerGroups(context.Background(), &pb.Empty{})
+		if err != nil {
+			return
+		}
+		for _, group := range resp.GetGroups().GetGroups() {
+			fmt.Println(group.GetName())
+		}
+	case 1:
+		// peers are completed only for the commands taking both arguments
+		if ctx.Command.ArgsUsage == MsgMeshnetGroupPeerArgsUsage {
+			c.MeshPeerAutoComplete(ctx)
+		}
+	}
+}
+
+func peerGroupResponseToError(resp *pb.PeerGroupResponse, group string, peer string) error {
+	if resp == nil {
+		return errors.New(AccountInternalError)
+	}
+
+	switch resp := resp.Response.(type) {
+	case *pb.PeerGroupResponse_Empty:
+		return nil
+	case *pb.PeerGroupResponse_PeerGroupErrorCode:
+		return peerGroupErrorCodeToError(resp.PeerGroupErrorCode, group, peer)
+	case *pb.PeerGroupResponse_UpdatePeerErrorCode:
+		return updatePeerErrorCodeToError(resp.UpdatePeerErrorCode, peer)
+	case *pb.PeerGroupResponse_ServiceErrorCode:
+		return serviceErrorCodeToError(resp.ServiceErrorCode)
+	case *pb.PeerGroupResponse_MeshnetErrorCode:
+		return meshnetErrorToError(resp.MeshnetErrorCode)
+	default:
+		return errors.New(AccountInternalError)
+	}
+}
+
+func peerGroupErrorCodeToError(code pb.PeerGroupErrorCode, group string, peer string) error {
+	switch code {
+	case pb.PeerGroupErrorCode_GROUP_NOT_FOUND:
+		return fmt.Errorf(MsgMeshnetGroupNotFound, group)
+	case pb.PeerGroupErrorCode_GROUP_ALREADY_EXISTS:
+		return fmt.Errorf(MsgMeshnetGroupAlreadyExists, group)
+	case pb.PeerGroupErrorCode_INVALID_GROUP_NAME:
+		return fmt.Errorf(MsgMeshnetGroupInvalidName, group)
+	case pb.PeerGroupErrorCode_PEER_ALREADY_IN_GROUP:
+		return fmt.Errorf(MsgMeshnetGroupPeerAlreadyInGroup, peer, group)
+	case pb.PeerGroupErrorCode_PEER_NOT_IN_GROUP:
+		return fmt.Errorf(MsgMeshnetGroupPeerNotInGroup, peer, group)
+	case pb.PeerGroupErrorCode_GROUP_EMPTY:
+		return fmt.Errorf(MsgMeshnetGroupEmpty, group)
+	default:
+		return errors.New(AccountInternalError)
+	}
+}
+
+// meshGroupPermissionCommand builds the allow and deny subcommands of the
+// group permission
+func (c *cmd) meshGroupPermissionCommand(name string, usage string, permission pb.PeerPermission) *cli.Command {
+	return &cli.Command{
+		Name:  name,
+		Usage: usage,
+		Subcommands: []*cli.Command{
+			{
+				Name:         "allow",
+				Usage:        MsgMeshnetGroupAllowUsage,
+				ArgsUsage:    MsgMeshnetGroupArgsUsage,
+				Action:       c.MeshGroupSetPermission(permission, true),
+				BashComplete: c.MeshGroupAutoComplete,
+			},
+			{
+				Name:         "deny",
+				Usage:        MsgMeshnetGroupDenyUsage,
+				ArgsUsage:    MsgMeshnetGroupArgsUsage,
+				Action:       c.MeshGroupSetPermission(permission, false),
+				BashComplete: c.MeshGroupAutoComplete,
+			},
+		},
+	}
+}
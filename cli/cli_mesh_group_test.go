@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerGroupResponseToError(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name string
+		resp *pb.PeerGroupResponse
+		err  string
+	}{
+		{
+			name: "success",
+			resp: &pb.PeerGroupResponse{Response: &pb.PeerGroupResponse_Empty{}},
+		},
+		{
+			name: "group not found",
+			resp: &pb.PeerGroupResponse{Response: &pb.PeerGroupResponse_PeerGroupErrorCode{
+				PeerGroupErrorCode: pb.PeerGroupErrorCode_GROUP_NOT_FOUND,
+			}},
+			err: "Group 'homelab' does not exist.",
+		},
+		{
+			name: "peer already in group",
+			resp: &pb.PeerGroupResponse{Response: &pb.PeerGroupResponse_PeerGroupErrorCode{
+				PeerGroupErrorCode: pb.PeerGroupErrorCode_PEER_ALREADY_IN_GROUP,
+			}},
+			err: "Peer 'nas.nord' is already in the group 'homelab'.",
+		},
+		{
+			name: "peer not found",
+			resp: &pb.PeerGroupResponse{Response: &pb.PeerGroupResponse_UpdatePeerErrorCode{
+				UpdatePeerErrorCode: pb.UpdatePeerErrorCode_PEER_NOT_FOUND,
+			}},
+			err: "Peer 'nas.nord' is unknown.",
+		},
+		{
+			name: "meshnet not enabled",
+			resp: &pb.PeerGroupResponse{Response: &pb.PeerGroupResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_ENABLED,
+			}},
+			err: MsgMeshnetNotEnabled,
+		},
+		{
+			name: "no response",
+			err:  AccountInternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := peerGroupResponseToError(test.resp, "homelab", "nas.nord")
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+const flagMatrixJSON = "json"
+
+// peerReachability is a JSON representation of the peer probe result
+type peerReachability struct {
+	Hostname  string `json:"hostname"`
+	Nickname  string `json:"nickname,omitempty"`
+	IP        string `json:"ip,omitempty"`
+	Status    string `json:"status"`
+	Reachable bool   `json:"reachable"`
+	Path      string `json:"path,omitempty"`
+	RTTMs     uint32 `json:"rtt_ms,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+type reachabilityMatrix struct {
+	From  string             `json:"from"`
+	Peers []peerReachability `json:"peers"`
+}
+
+// MeshMatrix probes the peers from this device and reports their reachability
+func (c *cmd) MeshMatrix(ctx *cli.Context) error {
+	resp, err := c.meshClient.GetPeerReachability(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+
+	reachability, err := getPeerReachabilityResponseToList(resp)
+	if err != nil {
+		return formatError(err)
+	}
+
+	matrix := toReachabilityMatrix(reachability)
+	if ctx.Bool(flagMatrixJSON) || jsonOutput(ctx) {
+		return printJSON(matrix)
+	}
+
+	fmt.Println(strings.TrimSpace(reachabilityMatrixToOutputString(matrix)))
+	return nil
+}
+
+func getPeerReachabilityResponseToList(
+	resp *pb.GetPeerReachabilityResponse,
+) (*pb.PeerReachabilityList, error) {
+	if resp == nil {
+		return nil, errors.New(AccountInternalError)
+	}
+	switch resp := resp.Response.(type) {
+	case *pb.GetPeerReachabilityResponse_Reachability:
+		return resp.Reachability, nil
+	case *pb.GetPeerReachabilityResponse_ServiceErrorCode:
+		return nil, serviceErrorCodeToError(resp.ServiceErrorCode)
+	case *pb.GetPeerReachabilityResponse_MeshnetErrorCode:
+		return nil, meshnetErrorToError(resp.MeshnetErrorCode)
+	default:
+		return nil, errors.New(AccountInternalError)
+	}
+}
+
+func toReachabilityMatrix(list *pb.PeerReachabilityList) reachabilityMatrix {
+	matrix := reachabilityMatrix{
+		From:  list.GetHostname(),
+		Peers: []peerReachability{},
+	}
+	for _, p := range list.GetPeers() {
+		path := ""
+		if p.GetPath() != pb.PeerPath_PATH_UNKNOWN {
+			path = strings.ToLower(strings.TrimPrefix(p.GetPath().String(), "PATH_"))
+		}
+		matrix.Peers = append(matrix.Peers, peerReachability{
+			Hostname:  p.GetHostname(),
+			Nickname:  p.GetNickname(),
+			IP:        p.GetIp(),
+			Status:    strings.ToLower(p.GetStatus().String()),
+			Reachable: p.GetReachable(),
+			Path:      path,
+			RTTMs:     p.GetRttMs(),
+			Error:     p.GetError(),
+		})
+	}
+	return matrix
+}
+
+func reachabilityMatrixToOutputString(matrix reachabilityMatrix) string {
+	var builder strings.Builder
+	const (
+		minwidth = 0
+		tabwidth = 1
+		padding  = 2
+		padchar  = ' '
+		flags    = 0
+	)
+	tableWriter := tabwriter.NewWriter(&builder, minwidth, tabwidth, padding, padchar, flags)
+	boldCol := color.New(color.Bold)
+
+	builder.WriteString(boldCol.Sprintf(MsgMeshnetMatrixFrom, matrix.From) + "\n")
+	if len(matrix.Peers) == 0 {
+		builder.WriteString("[no peers]\n")
+		return builder.String()
+	}
+
+	fmt.Fprintf(tableWriter, "peer\tip\tstatus\treachable\tpath\trtt\t\n")
+	for _, p := range matrix.Peers {
+		name := p.Hostname
+		if p.Nickname != "" {
+			name = p.Nickname
+		}
+		reachable := "no"
+		rtt := "-"
+		if p.Reachable {
+			reachable = "yes"
+			rtt = fmt.Sprintf("%d ms", p.RTTMs)
+		}
+		path := p.Path
+		if path == "" {
+			path = "-"
+		}
+		fmt.Fprintf(tableWriter, "%s\t%s\t%s\t%s\t%s\t%s\t\n",
+			name, p.IP, p.Status, reachable, path, rtt)
+	}
+
+	if err := tableWriter.Flush(); err != nil {
+		log.Println(err)
+	}
+	return builder.String()
+}
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	minMeshnetPause = time.Minute
+	maxMeshnetPause = 24 * time.Hour
+)
+
+// MeshPause tears down meshnet for the given duration
+func (c *cmd) MeshPause(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	duration, err := time.ParseDuration(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+	if duration < minMeshnetPause || duration > maxMeshnetPause {
+		return formatError(fmt.Errorf(MsgMeshnetPauseInvalidDuration, minMeshnetPause, maxMeshnetPause))
+	}
+
+	resp, err := c.meshClient.PauseMeshnet(context.Background(), &pb.PauseMeshnetRequest{
+		Duration: uint32(duration.Seconds()),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	if err := getMeshnetResponseToError(resp); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgMeshnetPauseSuccess, duration)
+	return nil
+}
+
+// MeshResume sets the paused meshnet up again
+func (c *cmd) MeshResume(ctx *cli.Context) error {
+	resp, err := c.meshClient.ResumeMeshnet(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+
+	if err := getMeshnetResponseToError(resp); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgMeshnetResumeSuccess)
+	return nil
+}
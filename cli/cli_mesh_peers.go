@@ -27,6 +27,35 @@ type keyval struct {
 	Value string
 }
 
+// peerListJSON is a JSON representation of the meshnet peer list
+type peerListJSON struct {
+	Self     peerJSON   `json:"self"`
+	Local    []peerJSON `json:"local"`
+	External []peerJSON `json:"external"`
+}
+
+// peerJSON is a JSON representation of a meshnet peer. Permissions are not
+// set for this device.
+type peerJSON struct {
+	Identifier                   string `json:"identifier"`
+	Hostname                     string `json:"hostname"`
+	Nickname                     string `json:"nickname,omitempty"`
+	Status                       string `json:"status,omitempty"`
+	IP                           string `json:"ip"`
+	PublicKey                    string `json:"public_key"`
+	OS                           string `json:"os"`
+	Distribution                 string `json:"distribution"`
+	AllowIncomingTraffic         bool   `json:"allow_incoming_traffic"`
+	AllowRouting                 bool   `json:"allow_routing"`
+	AllowLocalNetworkAccess      bool   `json:"allow_local_network_access"`
+	AllowSendingFiles            bool   `json:"allow_sending_files"`
+	AllowsIncomingTraffic        bool   `json:"allows_incoming_traffic"`
+	AllowsRouting                bool   `json:"allows_routing"`
+	AllowsLocalNetworkAccess     bool   `json:"allows_local_network_access"`
+	AllowsSendingFiles           bool   `json:"allows_sending_files"`
+	AcceptFileshareAutomatically bool   `json:"accept_fileshare_automatically"`
+}
+
 func (c *cmd) MeshRefresh(ctx *cli.Context) error {
 	resp, err := c.meshClient.RefreshMeshnet(context.Background(), &pb.Empty{})
 	if err != nil {
@@ -106,8 +135,8 @@ func (c *cmd) MeshPeerList(ctx *cli.Context) error {
 	if err != nil {
 		return formatError(err)
 	}
+	condition := ""
 	if ctx.IsSet(flagFilter) {
-		condition := ""
 		for _, value := range strings.Split(ctx.String(flagFilter), ",") {
 			filtersFunc, ok := availableFilters[value]
 			if !ok {
@@ -118,10 +147,11 @@ func (c *cmd) MeshPeerList(ctx *cli.Context) error {
 				condition = value
 			}
 		}
-		fmt.Println(strings.TrimSpace(peersToOutputString(peers, condition)))
-	} else {
-		fmt.Println(strings.TrimSpace(peersToOutputString(peers, "")))
 	}
+	if jsonOutput(ctx) {
+		return printJSON(toPeerListJSON(peers, condition))
+	}
+	fmt.Println(strings.TrimSpace(peersToOutputString(peers, condition)))
 	return nil
 }
 
@@ -158,6 +188,49 @@ func peersToOutputString(peers *pb.PeerList, condition string) string {
 	return builder.String()
 }
 
+func toPeerListJSON(peers *pb.PeerList, condition string) peerListJSON {
+	list := peerListJSON{
+		Self:     toPeerJSON(peers.GetSelf()),
+		Local:    []peerJSON{},
+		External: []peerJSON{},
+	}
+	// status and permissions have no meaning for this device
+	list.Self.Status = ""
+	if condition != externalFilter {
+		for _, p := range peers.GetLocal() {
+			list.Local = append(list.Local, toPeerJSON(p))
+		}
+	}
+	if condition != internalFilter {
+		for _, p := range peers.GetExternal() {
+			list.External = append(list.External, toPeerJSON(p))
+		}
+	}
+	return list
+}
+
+func toPeerJSON(peer *pb.Peer) peerJSON {
+	return peerJSON{
+		Identifier:                   peer.GetIdentifier(),
+		Hostname:                     peer.GetHostname(),
+		Nickname:                     peer.GetNickname(),
+		Status:                       strings.ToLower(peer.GetStatus().String()),
+		IP:                           peer.GetIp(),
+		PublicKey:                    peer.GetPubkey(),
+		OS:                           peer.GetOs(),
+		Distribution:                 peer.GetDistro(),
+		AllowIncomingTraffic:         peer.GetDoIAllowInbound(),
+		AllowRouting:                 peer.GetDoIAllowRouting(),
+		AllowLocalNetworkAccess:      peer.GetDoIAllowLocalNetwork(),
+		AllowSendingFiles:            peer.GetDoIAllowFileshare(),
+		AllowsIncomingTraffic:        peer.GetIsInboundAllowed(),
+		AllowsRouting:                peer.GetIsRoutable(),
+		AllowsLocalNetworkAccess:     peer.GetIsLocalNetworkAllowed(),
+		AllowsSendingFiles:           peer.GetIsFileshareAllowed(),
+		AcceptFileshareAutomatically: peer.GetAlwaysAcceptFiles(),
+	}
+}
+
 func selfToOutputString(peer *pb.Peer) string {
 	// if peer has nickname, then it will be displayed first, otherwise is the hostname
 	var title keyval
@@ -700,6 +773,76 @@ func (c *cmd) MeshRemoveMachineNickname(ctx *cli.Context) error {
 	return nil
 }
 
+func (c *cmd) MeshSetExitNodeFallback(ctx *cli.Context) error {
+	if ctx.NArg() < 1 || ctx.NArg() > 2 {
+		return argsCountError(ctx)
+	}
+
+	var mode pb.ExitNodeFallbackMode
+	switch ctx.Args().First() {
+	case "reconnect":
+		mode = pb.ExitNodeFallbackMode_RECONNECT
+	case "off":
+		mode = pb.ExitNodeFallbackMode_FALLBACK_OFF
+	case "peer":
+		mode = pb.ExitNodeFallbackMode_FALLBACK_PEER
+	case "vpn":
+		mode = pb.ExitNodeFallbackMode_FALLBACK_VPN
+	default:
+		return argsParseError(ctx)
+	}
+
+	target := ctx.Args().Get(1)
+	switch mode {
+	case pb.ExitNodeFallbackMode_FALLBACK_PEER:
+		if target == "" {
+			return argsCountError(ctx)
+		}
+	case pb.ExitNodeFallbackMode_RECONNECT, pb.ExitNodeFallbackMode_FALLBACK_OFF:
+		if target != "" {
+			return argsCountError(ctx)
+		}
+	}
+
+	resp, err := c.meshClient.SetExitNodeFallback(context.Background(), &pb.SetExitNodeFallbackRequest{
+		Mode:   mode,
+		Target: target,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	if resp == nil {
+		return errors.New(AccountInternalError)
+	}
+	switch resp := resp.Response.(type) {
+	case *pb.SetExitNodeFallbackResponse_ServiceErrorCode:
+		return formatError(serviceErrorCodeToError(resp.ServiceErrorCode))
+	case *pb.SetExitNodeFallbackResponse_MeshnetErrorCode:
+		return formatError(meshnetErrorToError(resp.MeshnetErrorCode))
+	case *pb.SetExitNodeFallbackResponse_UpdatePeerErrorCode:
+		return formatError(updatePeerErrorCodeToError(resp.UpdatePeerErrorCode, target))
+	case *pb.SetExitNodeFallbackResponse_ConnectErrorCode:
+		return formatError(connectErrorCodeToError(resp.ConnectErrorCode, target))
+	}
+
+	color.Green(MsgMeshnetSetExitNodeFallbackSuccessful, ctx.Args().First())
+	return nil
+}
+
+func (c *cmd) MeshSetExitNodeFallbackAutoComplete(ctx *cli.Context) {
+	switch ctx.NArg() {
+	case 0:
+		for _, mode := range []string{"reconnect", "peer", "vpn", "off"} {
+			fmt.Println(mode)
+		}
+	case 1:
+		if ctx.Args().First() == "peer" {
+			c.MeshPeerAutoComplete(ctx)
+		}
+	}
+}
+
 // retrievePeerFromArgs queries the peer list from the meshnet service,
 // then tries to find a peer by the given identifier, which is either
 // public key, hostname or an IP address
@@ -710,7 +853,11 @@ func (c *cmd) retrievePeerFromArgs(
 	if identifier == "" {
 		return nil, argsCountError(ctx)
 	}
+	return c.retrievePeer(identifier)
+}
 
+// retrievePeer finds the peer by its public key, hostname, nickname or IP
+func (c *cmd) retrievePeer(identifier string) (*pb.Peer, error) {
 	// Get peer list to search by the identifier
 	peersResp, err := c.meshClient.GetPeers(
 		context.Background(),
@@ -153,3 +153,25 @@ func TestMeshPeerList(t *testing.T) {
 		})
 	}
 }
+
+func TestToPeerListJSON(t *testing.T) {
+	category.Set(t, category.Unit)
+	resp, err := mockMeshClient{}.GetPeers(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	peers := resp.GetPeers()
+
+	list := toPeerListJSON(peers, "")
+	assert.Equal(t, "test", list.Self.Hostname)
+	assert.Empty(t, list.Self.Status)
+	assert.Len(t, list.Local, 2)
+	assert.Len(t, list.External, 2)
+	assert.Equal(t, "AllowsEverything", list.External[0].Hostname)
+	assert.Equal(t, "connected", list.External[0].Status)
+	assert.True(t, list.External[0].AllowRouting)
+	assert.True(t, list.External[0].AllowsIncomingTraffic)
+
+	list = toPeerListJSON(peers, externalFilter)
+	assert.Empty(t, list.Local)
+	assert.NotNil(t, list.Local)
+	assert.Len(t, list.External, 2)
+}
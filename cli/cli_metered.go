@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Metered help text
+const (
+	MeteredUsageText       = "Shows or changes which network connections are metered"
+	MeteredStatusUsageText = "Shows whether the current network connection is metered"
+	MeteredAddUsageText    = "Treats the network connection as metered"
+	MeteredAddDescription  = `Use this command to treat the network connection as metered even if the system does not report it so. Use the NetworkManager connection name, which is usually the Wi-Fi network name.
+
+Example: 'nordvpn metered add "Phone hotspot"'`
+	MeteredRemoveUsageText   = "Stops treating the network connection as metered"
+	MeteredRemoveDescription = `Use this command to stop treating the network connection added with 'nordvpn metered add' as metered.
+
+Example: 'nordvpn metered remove "Phone hotspot"'`
+	MeteredNetworkArgsUsage = "<network>"
+)
+
+// MeteredStatus prints the metered state of the current network connection
+func (c *cmd) MeteredStatus(ctx *cli.Context) error {
+	resp, err := c.client.MeteredStatus(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+	if resp.Type != internal.CodeSuccess {
+		return formatError(ErrConfig)
+	}
+	fmt.Print(formatMeteredStatus(resp))
+	return nil
+}
+
+// MeteredAdd marks the network connection as metered
+func (c *cmd) MeteredAdd(ctx *cli.Context) error {
+	return c.setMeteredNetwork(ctx, true)
+}
+
+// MeteredRemove removes the metered mark from the network connection
+func (c *cmd) MeteredRemove(ctx *cli.Context) error {
+	return c.setMeteredNetwork(ctx, false)
+}
+
+func (c *cmd) setMeteredNetwork(ctx *cli.Context, metered bool) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	network := ctx.Args().First()
+	resp, err := c.client.SetMeteredNetwork(context.Background(), &pb.SetMeteredNetworkRequest{
+		Network: network,
+		Metered: metered,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		if metered {
+			color.Yellow(MsgMeteredNetworkAlreadyAdded, network)
+		} else {
+			color.Yellow(MsgMeteredNetworkNotAdded, network)
+		}
+	case internal.CodeSuccess:
+		if metered {
+			color.Green(MsgMeteredNetworkAdded, network)
+		} else {
+			color.Green(MsgMeteredNetworkRemoved, network)
+		}
+	}
+	return nil
+}
+
+// formatMeteredStatus returns ready to print metered state of the network connection
+func formatMeteredStatus(resp *pb.MeteredStatusResponse) string {
+	var b strings.Builder
+	network := resp.Network
+	if network == "" {
+		network = "unknown"
+	}
+	fmt.Fprintf(&b, "Network: %s\n", network)
+
+	metered := "no"
+	if resp.Metered {
+		metered = "yes"
+		if resp.Manual {
+			metered += " (set manually)"
+		}
+	} else if resp.Error != "" {
+		metered = "unknown (" + resp.Error + ")"
+	}
+	fmt.Fprintf(&b, "Metered: %s\n", metered)
+
+	policy := "inactive"
+	if resp.Active {
+		policy = "active"
+	}
+	fmt.Fprintf(&b, "Metered Policy: %s\n", policy)
+	return b.String()
+}
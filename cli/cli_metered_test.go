@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatMeteredStatus(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		resp     *pb.MeteredStatusResponse
+		expected string
+	}{
+		{
+			name:     "not metered",
+			resp:     &pb.MeteredStatusResponse{Network: "Home"},
+			expected: "Network: Home\nMetered: no\nMetered Policy: inactive\n",
+		},
+		{
+			name:     "metered by the system",
+			resp:     &pb.MeteredStatusResponse{Network: "Phone", Metered: true, Active: true},
+			expected: "Network: Phone\nMetered: yes\nMetered Policy: active\n",
+		},
+		{
+			name:     "metered by the user",
+			resp:     &pb.MeteredStatusResponse{Network: "Hotspot", Metered: true, Manual: true},
+			expected: "Network: Hotspot\nMetered: yes (set manually)\nMetered Policy: inactive\n",
+		},
+		{
+			name:     "detection failed",
+			resp:     &pb.MeteredStatusResponse{Error: "NetworkManager is not running"},
+			expected: "Network: unknown\nMetered: unknown (NetworkManager is not running)\nMetered Policy: inactive\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, formatMeteredStatus(test.resp))
+		})
+	}
+}
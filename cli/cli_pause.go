@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/hako/durafmt"
+	"github.com/urfave/cli/v2"
+)
+
+const flagPauseKeepKillSwitch = "keep-kill-switch"
+
+// Pause help text
+const (
+	PauseUsageText           = "Disconnects from VPN for the given duration and connects to the same server afterwards"
+	PauseArgsUsageText       = "<duration>"
+	PauseKeepKillSwitchUsage = "Keep Kill Switch enforced during the pause, so that the traffic is blocked"
+	PauseDescription         = `Use this command to disconnect from VPN for a while, e.g. to access the service which blocks VPN servers. The connection to the same server is established again once the duration passes or on 'nordvpn resume', 'nordvpn disconnect' cancels the pause. The remaining time is shown by 'nordvpn status'.
+Kill Switch, if enabled, is relaxed for the pause and enforced again after it.
+
+Example: 'nordvpn pause 10m'
+Example: 'nordvpn pause --keep-kill-switch 1h'`
+	ResumeUsageText = "Connects to VPN again without waiting for the pause to end"
+)
+
+func (c *cmd) Pause(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	duration, err := time.ParseDuration(ctx.Args().First())
+	if err != nil || duration < time.Second {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.Pause(context.Background(), &pb.PauseRequest{
+		DurationSeconds: uint32(duration.Seconds()),
+		KeepKillSwitch:  ctx.Bool(flagPauseKeepKillSwitch),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(MsgPauseTooLong, 24*time.Hour))
+	case internal.CodeVPNNotRunning:
+		return formatError(errors.New(DisconnectNotConnected))
+	case internal.CodeSuccess:
+		color.Green(MsgPaused, durafmt.Parse(duration.Truncate(time.Second)).String())
+	}
+	return nil
+}
+
+func (c *cmd) Resume(ctx *cli.Context) error {
+	resp, err := c.client.Resume(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeFailure:
+		return formatError(errors.New(MsgResumeFailed))
+	case internal.CodeNothingToDo:
+		color.Yellow(MsgNotPaused)
+	case internal.CodeSuccess:
+		color.Green(MsgResumed)
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+const flagProfile = "profile"
+
+// Profiles help text
+const (
+	ProfileUsageText       = "Manages the named sets of connection settings"
+	ProfileSaveUsageText   = "Saves the current connection settings as a profile"
+	ProfileSaveArgsUsage   = "<name>"
+	ProfileSaveGroupUsage  = "Server group to connect to with the profile"
+	ProfileSaveDescription = `Use this command to save the current technology, protocol, DNS, allowlist and kill switch settings under the given name. Saving the profile with an existing name replaces it.
+Connect with the profile using 'nordvpn connect --profile <name>'. All of the settings of the profile are applied together before connecting, and your settings are left unchanged if any of them cannot be applied.
+
+Example: 'nordvpn profile save work --group p2p'
+Example: 'nordvpn profile save home'`
+	ProfileRemoveUsageText   = "Removes the profile"
+	ProfileRemoveArgsUsage   = "<name>"
+	ProfileRemoveDescription = `Use this command to remove the profile.
+
+Example: 'nordvpn profile remove work'`
+	ProfileListUsageText = "Shows the saved profiles"
+)
+
+// ProfileSave saves the current connection settings as a profile
+func (c *cmd) ProfileSave(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	name := ctx.Args().First()
+	resp, err := c.client.SaveProfile(context.Background(), &pb.SaveProfileRequest{
+		Name:        name,
+		ServerGroup: ctx.String(flagGroup),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeGroupNonexisting:
+		return formatError(errors.New(internal.GroupNonexistentErrorMessage))
+	case internal.CodeNothingToDo:
+		color.Yellow(MsgProfileAlreadySaved, name)
+	case internal.CodeSuccess:
+		color.Green(MsgProfileSaved, name)
+	}
+	return nil
+}
+
+// ProfileRemove removes the profile by its name
+func (c *cmd) ProfileRemove(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	name := ctx.Args().First()
+	resp, err := c.client.RemoveProfile(context.Background(), &pb.RemoveProfileRequest{Name: name})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeNothingToDo:
+		color.Yellow(MsgProfileNotFound, name)
+	case internal.CodeSuccess:
+		color.Green(MsgProfileRemoved, name)
+	}
+	return nil
+}
+
+// ProfileList shows the saved profiles
+func (c *cmd) ProfileList(ctx *cli.Context) error {
+	resp, err := c.client.Profiles(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+	if resp.Type != internal.CodeSuccess {
+		return formatError(ErrConfig)
+	}
+
+	if jsonOutput(ctx) {
+		return printJSON(toProfilesJSON(resp.GetProfiles()))
+	}
+
+	if len(resp.GetProfiles()) == 0 {
+		color.Yellow(MsgProfilesEmpty)
+		return nil
+	}
+	for i, profile := range resp.GetProfiles() {
+		if i > 0 {
+			fmt.Println()
+		}
+		displayProfile(profile)
+	}
+	return nil
+}
+
+func displayProfile(profile *pb.Profile) {
+	fmt.Println(profile.GetName())
+	fmt.Printf("Technology: %s\n", profile.GetTechnology())
+	fmt.Printf("Protocol: %s\n", profile.GetProtocol())
+	if profile.GetServerGroup() != "" {
+		fmt.Printf("Server Group: %s\n", profile.GetServerGroup())
+	}
+	if len(profile.GetDns()) == 0 {
+		fmt.Printf("DNS: %+v\n", nstrings.GetBoolLabel(false))
+	} else {
+		fmt.Printf("DNS: %+v\n", strings.Join(profile.GetDns(), ", "))
+	}
+	fmt.Printf("Kill Switch: %+v\n", nstrings.GetBoolLabel(profile.GetKillSwitch()))
+	displayAllowlist(profile.GetAllowlist())
+}
+
+type profileJSON struct {
+	Name        string        `json:"name"`
+	Technology  string        `json:"technology"`
+	Protocol    string        `json:"protocol"`
+	ServerGroup string        `json:"server_group,omitempty"`
+	DNS         []string      `json:"dns"`
+	KillSwitch  bool          `json:"kill_switch"`
+	Allowlist   allowlistJSON `json:"allowlist"`
+}
+
+func toProfilesJSON(profiles []*pb.Profile) []profileJSON {
+	out := []profileJSON{}
+	for _, profile := range profiles {
+		entry := profileJSON{
+			Name:        profile.GetName(),
+			Technology:  profile.GetTechnology().String(),
+			Protocol:    profile.GetProtocol().String(),
+			ServerGroup: profile.GetServerGroup(),
+			DNS:         nonNilStrings(profile.GetDns()),
+			KillSwitch:  profile.GetKillSwitch(),
+			Allowlist: allowlistJSON{
+				TCPPorts: profile.GetAllowlist().GetPorts().GetTcp(),
+				UDPPorts: profile.GetAllowlist().GetPorts().GetUdp(),
+				Subnets:  nonNilStrings(profile.GetAllowlist().GetSubnets()),
+				Domains:  nonNilStrings(profile.GetAllowlist().GetDomains()),
+			},
+		}
+		if entry.Allowlist.TCPPorts == nil {
+			entry.Allowlist.TCPPorts = []int64{}
+		}
+		if entry.Allowlist.UDPPorts == nil {
+			entry.Allowlist.UDPPorts = []int64{}
+		}
+		out = append(out, entry)
+	}
+	return out
+}
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToProfilesJSON(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	profiles := []*pb.Profile{
+		{
+			Name:        "work",
+			Technology:  config.Technology_OPENVPN,
+			Protocol:    config.Protocol_TCP,
+			ServerGroup: "p2p",
+			Dns:         []string{"192.0.2.1"},
+			Allowlist:   &pb.Allowlist{Ports: &pb.Ports{Tcp: []int64{22}}, Subnets: []string{"10.0.0.0/8"}},
+			KillSwitch:  true,
+		},
+		{
+			Name:       "home",
+			Technology: config.Technology_NORDLYNX,
+			Protocol:   config.Protocol_UDP,
+		},
+	}
+
+	out, err := json.Marshal(toProfilesJSON(profiles))
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+  {
+    "name": "work",
+    "technology": "OPENVPN",
+    "protocol": "TCP",
+    "server_group": "p2p",
+    "dns": ["192.0.2.1"],
+    "kill_switch": true,
+    "allowlist": {"tcp_ports": [22], "udp_ports": [], "subnets": ["10.0.0.0/8"], "domains": []}
+  },
+  {
+    "name": "home",
+    "technology": "NORDLYNX",
+    "protocol": "UDP",
+    "dns": [],
+    "kill_switch": false,
+    "allowlist": {"tcp_ports": [], "udp_ports": [], "subnets": [], "domains": []}
+  }
+]`, string(out))
+
+	out, err = json.Marshal(toProfilesJSON(nil))
+	require.NoError(t, err)
+	assert.Equal(t, "[]", string(out))
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Reload help text
+const (
+	ReloadUsageText   = "Applies the changed settings without restarting the daemon"
+	ReloadDescription = `Use this command to apply the settings changed since the daemon start or the previous reload.
+Allowlist, LAN discovery, Kill Switch, DNS, default route, subnet overlap, firewall template and split tunnel settings are applied without disconnecting.
+Technology, protocol, obfuscation and IPv6 take effect after reconnecting. Firewall mark and firewall backend take effect after restarting the daemon.
+The daemon reloads the settings on SIGHUP as well.
+
+Example: 'nordvpn reload'`
+)
+
+// Reload asks the daemon to apply the changed settings
+func (c *cmd) Reload(ctx *cli.Context) error {
+	resp, err := c.client.Reload(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFailure:
+		if report := formatReloadReport(resp); report != "" {
+			color.Yellow(report)
+		}
+		return formatError(fmt.Errorf(MsgReloadFailed, strings.Join(resp.Failed, ", ")))
+	case internal.CodeSuccess:
+		color.Green(MsgReloaded)
+		if report := formatReloadReport(resp); report != "" {
+			color.Yellow(report)
+		}
+	}
+	return nil
+}
+
+// formatReloadReport returns ready to print outcome of the reload for the
+// changed settings, empty if nothing was changed
+func formatReloadReport(resp *pb.ReloadResponse) string {
+	var lines []string
+	if len(resp.Applied) > 0 {
+		lines = append(lines, fmt.Sprintf(MsgReloadApplied, strings.Join(resp.Applied, ", ")))
+	}
+	if len(resp.ReconnectRequired) > 0 {
+		lines = append(lines, fmt.Sprintf(MsgReloadReconnectRequired, strings.Join(resp.ReconnectRequired, ", ")))
+	}
+	if len(resp.RestartRequired) > 0 {
+		lines = append(lines, fmt.Sprintf(MsgReloadRestartRequired, strings.Join(resp.RestartRequired, ", ")))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Render template help text
+const (
+	RenderTemplateUsageText   = "Renders the OpenVPN config from the template without connecting"
+	RenderTemplateDescription = `Use this command to check the changes made to the OpenVPN templates or to debug the config generation.
+Prints the OpenVPN config which would be used to connect to the given server. The server is looked up in
+the cached server list, so the command works offline. Use --server-file to provide the server record
+in the NordVPN API JSON format instead.
+
+The installed template is used unless --template is provided. Protocol defaults to the current setting.
+
+Example: 'nordvpn render-template --server lt10 --protocol udp'
+Example: 'nordvpn render-template --server lt10 --protocol tcp --obfuscated --template ovpn_xor_template.xslt'
+Example: 'nordvpn render-template --server-file server.json'`
+	renderTemplateServerUsage     = "Server tag, e.g. lt10"
+	renderTemplateServerFileUsage = "Path to the file with the server record"
+	renderTemplateProtocolUsage   = "Protocol, udp or tcp"
+	renderTemplateObfuscatedUsage = "Renders the obfuscated template"
+	renderTemplateTemplateUsage   = "Path to the template to use instead of the installed one"
+	flagRenderTemplateServer      = "server"
+	flagRenderTemplateServerFile  = "server-file"
+	flagRenderTemplateProtocol    = "protocol"
+	flagRenderTemplateObfuscated  = "obfuscated"
+	flagRenderTemplateTemplate    = "template"
+)
+
+func (c *cmd) RenderTemplate(ctx *cli.Context) error {
+	if ctx.NArg() != 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	serverTag, serverFile := ctx.String(flagRenderTemplateServer), ctx.String(flagRenderTemplateServerFile)
+	if (serverTag == "") == (serverFile == "") {
+		return formatError(fmt.Errorf(MsgRenderTemplateServer, flagRenderTemplateServer, flagRenderTemplateServerFile))
+	}
+
+	req := &pb.RenderOpenVPNConfigRequest{
+		ServerTag:  serverTag,
+		Obfuscated: ctx.Bool(flagRenderTemplateObfuscated),
+	}
+
+	switch strings.ToUpper(ctx.String(flagRenderTemplateProtocol)) {
+	case "":
+	case config.Protocol_UDP.String():
+		req.Protocol = config.Protocol_UDP
+	case config.Protocol_TCP.String():
+		req.Protocol = config.Protocol_TCP
+	default:
+		return formatError(argsParseError(ctx))
+	}
+
+	var err error
+	if serverFile != "" {
+		if req.Server, err = os.ReadFile(serverFile); err != nil {
+			return formatError(err)
+		}
+	}
+	if templateFile := ctx.String(flagRenderTemplateTemplate); templateFile != "" {
+		if req.Template, err = os.ReadFile(templateFile); err != nil {
+			return formatError(err)
+		}
+	}
+
+	resp, err := c.client.RenderOpenVPNConfig(context.Background(), req)
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeTagNonexisting:
+		return formatError(errors.New(internal.TagNonexistentErrorMessage))
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(MsgRenderTemplateInvalidServer, resp.Error))
+	case internal.CodeFailure:
+		return formatError(fmt.Errorf(MsgRenderTemplateFailure, resp.Hostname, resp.Error))
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeSuccess:
+		fmt.Print(resp.Config)
+	default:
+		return formatError(internal.ErrUnhandled)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Server notes help text
+const (
+	ServerNoteUsageText   = "Adds a note to a server"
+	ServerNoteArgsUsage   = "<server> [<note>]"
+	ServerNoteDescription = `Use this command to remember what a server is good for. Notes are stored locally and are shown by 'nordvpn server-notes' and 'nordvpn status'.
+If no note is provided, the note of the server is removed.
+
+Example: 'nordvpn server-note de100 "great for streaming"'
+Example: 'nordvpn server-note de100'`
+	ServerRateUsageText   = "Rates a server"
+	ServerRateArgsUsage   = "<server> <rating>"
+	ServerRateDescription = `Use this command to rate a server from 1 to 5. Ratings are stored locally and are shown by 'nordvpn server-notes' and 'nordvpn status'.
+Use 0 to remove the rating of the server.
+With 'nordvpn set rating-weight' higher rated servers are picked more often when connecting.
+
+Example: 'nordvpn server-rate de100 5'
+Example: 'nordvpn server-rate de100 0'`
+	ServerNotesUsageText = "Shows server notes and ratings"
+)
+
+// ServerNote sets or removes the note of the server
+func (c *cmd) ServerNote(ctx *cli.Context) error {
+	if ctx.NArg() < 1 || ctx.NArg() > 2 {
+		return formatError(argsCountError(ctx))
+	}
+
+	server := ctx.Args().First()
+	note := ctx.Args().Get(1)
+	resp, err := c.client.SetServerNote(context.Background(), &pb.SetServerNoteRequest{
+		Server: server,
+		Note:   note,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeTagNonexisting:
+		return formatError(errors.New(internal.TagNonexistentErrorMessage))
+	case internal.CodeNothingToDo:
+		color.Yellow(MsgServerNoteNothingToDo, server)
+	case internal.CodeSuccess:
+		if strings.TrimSpace(note) == "" {
+			color.Green(MsgServerNoteRemoved, server)
+		} else {
+			color.Green(MsgServerNoteSet, server)
+		}
+	}
+	return nil
+}
+
+// ServerRate sets or removes the rating of the server
+func (c *cmd) ServerRate(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return formatError(argsCountError(ctx))
+	}
+
+	server := ctx.Args().First()
+	rating, err := strconv.ParseUint(ctx.Args().Get(1), 10, 32)
+	if err != nil || (rating != 0 && (rating < config.MinServerRating || rating > config.MaxServerRating)) {
+		return formatError(fmt.Errorf(MsgServerRatingInvalid, config.MinServerRating, config.MaxServerRating))
+	}
+
+	resp, err := c.client.SetServerRating(context.Background(), &pb.SetServerRatingRequest{
+		Server: server,
+		Rating: uint32(rating),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(MsgServerRatingInvalid, config.MinServerRating, config.MaxServerRating))
+	case internal.CodeTagNonexisting:
+		return formatError(errors.New(internal.TagNonexistentErrorMessage))
+	case internal.CodeNothingToDo:
+		color.Yellow(MsgServerNoteNothingToDo, server)
+	case internal.CodeSuccess:
+		if rating == 0 {
+			color.Green(MsgServerRatingRemoved, server)
+		} else {
+			color.Green(MsgServerRatingSet, server, formatServerRating(uint32(rating)))
+		}
+	}
+	return nil
+}
+
+// ServerNotes prints server notes and ratings
+func (c *cmd) ServerNotes(ctx *cli.Context) error {
+	resp, err := c.client.ServerNotes(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+	if resp.Type != internal.CodeSuccess {
+		return formatError(ErrConfig)
+	}
+
+	if len(resp.Notes) == 0 {
+		color.Yellow(MsgServerNotesEmpty)
+		return nil
+	}
+	fmt.Print(formatServerNotes(resp.Notes))
+	return nil
+}
+
+// formatServerNotes returns ready to print server notes, one server per line
+func formatServerNotes(notes []*pb.ServerNote) string {
+	var b strings.Builder
+	for _, note := range notes {
+		fields := []string{note.Server}
+		if note.Rating != 0 {
+			fields = append(fields, formatServerRating(note.Rating))
+		}
+		if note.Note != "" {
+			fields = append(fields, note.Note)
+		}
+		if note.Stale {
+			fields = append(fields, "(no longer available)")
+		}
+		b.WriteString(strings.Join(fields, "  ") + "\n")
+	}
+	return b.String()
+}
+
+func formatServerRating(rating uint32) string {
+	return fmt.Sprintf("%d/%d", rating, config.MaxServerRating)
+}
@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatServerNotes(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	notes := []*pb.ServerNote{
+		{Server: "de100", Note: "great for streaming", Rating: 5},
+		{Server: "lt10", Rating: 2},
+		{Server: "lv20", Note: "slow at night", Stale: true},
+	}
+
+	expected := `de100  5/5  great for streaming
+lt10  2/5
+lv20  slow at night  (no longer available)
+`
+	assert.Equal(t, expected, formatServerNotes(notes))
+}
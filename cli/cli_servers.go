@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagServersCountry = "country"
+	flagServersCity    = "city"
+	flagServersGroup   = "group"
+	flagServersMaxLoad = "max-load"
+	flagServersSort    = "sort"
+	flagServersLimit   = "limit"
+	flagServersJSON    = "json"
+)
+
+// Servers help text
+const (
+	ServersUsageText    = "Lists the servers which can be connected to with the current settings"
+	ServersCountryUsage = "Lists only the servers in the country, given by its name or code"
+	ServersCityUsage    = "Lists only the servers in the city"
+	ServersGroupUsage   = "Lists only the servers of the group"
+	ServersMaxLoadUsage = "Lists only the servers with the load of at most the given percent"
+	ServersSortUsage    = "Sorts the servers by load, name or country"
+	ServersLimitUsage   = "Lists at most the given number of servers"
+	ServersJSONUsage    = "Shows the servers in JSON format"
+	ServersDescription  = `Use this command to inspect the servers before connecting to one of them.
+Servers are taken from the server list cached by the daemon and only the ones supporting the current technology, protocol and obfuscation are listed.
+
+Example: 'nordvpn servers --country de --group p2p --max-load 30 --sort load --limit 20'`
+)
+
+// serverJSON is a JSON representation of the listed server
+type serverJSON struct {
+	Name        string   `json:"name"`
+	Hostname    string   `json:"hostname"`
+	Country     string   `json:"country"`
+	CountryCode string   `json:"country_code"`
+	City        string   `json:"city"`
+	Load        uint32   `json:"load"`
+	Groups      []string `json:"groups"`
+}
+
+// Servers lists the servers
+func (c *cmd) Servers(ctx *cli.Context) error {
+	if ctx.NArg() != 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	sortBy, ok := pb.ServerSort_value["SERVER_SORT_"+strings.ToUpper(ctx.String(flagServersSort))]
+	if !ok {
+		return formatError(fmt.Errorf(MsgServersInvalidSort, ctx.String(flagServersSort)))
+	}
+	maxLoad := ctx.Uint(flagServersMaxLoad)
+	if maxLoad > 100 {
+		return formatError(fmt.Errorf(MsgServersInvalidMaxLoad, maxLoad))
+	}
+
+	resp, err := c.client.Servers(context.Background(), &pb.ServersRequest{
+		Country: ctx.String(flagServersCountry),
+		City:    ctx.String(flagServersCity),
+		Group:   ctx.String(flagServersGroup),
+		MaxLoad: uint32(maxLoad),
+		Sort:    pb.ServerSort(sortBy),
+		Limit:   uint32(ctx.Uint(flagServersLimit)),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeEmptyPayloadError:
+		return formatError(errors.New(MsgServersNotDownloaded))
+	case internal.CodeTagNonexisting:
+		return formatError(errors.New(internal.TagNonexistentErrorMessage))
+	case internal.CodeGroupNonexisting:
+		return formatError(errors.New(internal.GroupNonexistentErrorMessage))
+	}
+
+	if ctx.Bool(flagServersJSON) || jsonOutput(ctx) {
+		servers := make([]serverJSON, 0, len(resp.Servers))
+		for _, server := range resp.Servers {
+			servers = append(servers, toServerJSON(server))
+		}
+		return printJSON(servers)
+	}
+
+	if len(resp.Servers) == 0 {
+		fmt.Println(MsgServersNoneFound)
+		return nil
+	}
+	fmt.Print(formatServers(resp.Servers))
+	return nil
+}
+
+// formatServers returns the servers as a table
+func formatServers(servers []*pb.ServerInfo) string {
+	var builder strings.Builder
+	const (
+		minwidth = 0
+		tabwidth = 1
+		padding  = 2
+		padchar  = ' '
+		flags    = 0
+	)
+	tableWriter := tabwriter.NewWriter(&builder, minwidth, tabwidth, padding, padchar, flags)
+
+	fmt.Fprintf(tableWriter, "server\tcountry\tcity\tload\tgroups\t\n")
+	for _, server := range servers {
+		fmt.Fprintf(tableWriter, "%s\t%s\t%s\t%d%%\t%s\t\n",
+			strings.Split(server.Hostname, ".")[0],
+			server.Country,
+			server.City,
+			server.Load,
+			strings.Join(server.Groups, ", "),
+		)
+	}
+
+	if err := tableWriter.Flush(); err != nil {
+		log.Println(err)
+	}
+	return builder.String()
+}
+
+func toServerJSON(server *pb.ServerInfo) serverJSON {
+	groups := server.Groups
+	if groups == nil {
+		groups = []string{}
+	}
+	return serverJSON{
+		Name:        server.Name,
+		Hostname:    server.Hostname,
+		Country:     server.Country,
+		CountryCode: server.CountryCode,
+		City:        server.City,
+		Load:        server.Load,
+		Groups:      groups,
+	}
+}
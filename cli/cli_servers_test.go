@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatServers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	servers := []*pb.ServerInfo{
+		{
+			Name:        "Germany #1",
+			Hostname:    "de1.nordvpn.com",
+			Country:     "Germany",
+			CountryCode: "DE",
+			City:        "Berlin",
+			Load:        12,
+			Groups:      []string{"P2P", "Standard VPN servers"},
+		},
+		{
+			Name:        "Lithuania #20",
+			Hostname:    "lt20.nordvpn.com",
+			Country:     "Lithuania",
+			CountryCode: "LT",
+			City:        "Vilnius",
+			Load:        7,
+		},
+	}
+
+	expected := "server  country    city     load  groups                     \n" +
+		"de1     Germany    Berlin   12%   P2P, Standard VPN servers  \n" +
+		"lt20    Lithuania  Vilnius  7%                               \n"
+	assert.Equal(t, expected, formatServers(servers))
+}
+
+func TestToServerJSON(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	server := toServerJSON(&pb.ServerInfo{Name: "Lithuania #20", Hostname: "lt20.nordvpn.com", Load: 7})
+	assert.Equal(t, "lt20.nordvpn.com", server.Hostname)
+	assert.Equal(t, uint32(7), server.Load)
+	// groups are always an array in the output
+	assert.Equal(t, []string{}, server.Groups)
+}
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set allowed technologies help text
+const (
+	SetAllowedTechnologiesUsageText     = "Restricts the technologies used to connect"
+	SetAllowedTechnologiesArgsUsageText = `<technology>... | all`
+	SetAllowedTechnologiesDescription   = `Use this command to make sure that the connection is never made with the technology you do not want.
+Server recommendations and auto-connect only use the allowed technologies, and the technology cannot be
+changed to the one which is not allowed. The current technology must be allowed.
+Supported values for <technology>: OPENVPN or NORDLYNX. Use 'all' to remove the restriction.
+
+Example: 'nordvpn set allowed-technologies NORDLYNX'
+Example: 'nordvpn set allowed-technologies all'`
+)
+
+func (c *cmd) SetAllowedTechnologies(ctx *cli.Context) error {
+	args := ctx.Args()
+	if args.Len() == 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	var technologies []config.Technology
+	if !(args.Len() == 1 && strings.EqualFold(args.First(), "all")) {
+		for _, arg := range args.Slice() {
+			switch strings.ToUpper(arg) {
+			case config.Technology_OPENVPN.String():
+				technologies = append(technologies, config.Technology_OPENVPN)
+			case config.Technology_NORDLYNX.String():
+				technologies = append(technologies, config.Technology_NORDLYNX)
+			default:
+				return formatError(argsParseError(ctx))
+			}
+		}
+	}
+
+	resp, err := c.client.SetAllowedTechnologies(context.Background(), &pb.SetAllowedTechnologiesRequest{
+		Technologies: technologies,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	value := formatAllowedTechnologies(technologies)
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeTechnologyNotAllowed:
+		return formatError(fmt.Errorf(MsgSetAllowedTechnologiesExcludesCurrent, internal.StringsToInterfaces(resp.Data)...))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Allowed technologies", value))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Allowed technologies", value))
+	}
+	return nil
+}
+
+func (c *cmd) SetAllowedTechnologiesAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() == 0 {
+		fmt.Println("all")
+	}
+	c.SetTechnologyAutoComplete(ctx)
+}
+
+// formatAllowedTechnologies returns the technologies joined by commas, "all" if
+// there is no restriction
+func formatAllowedTechnologies(technologies []config.Technology) string {
+	if len(technologies) == 0 {
+		return "all"
+	}
+	names := make([]string, 0, len(technologies))
+	for _, tech := range technologies {
+		names = append(names, tech.String())
+	}
+	return strings.Join(names, ", ")
+}
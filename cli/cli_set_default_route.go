@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set default route help text
+const (
+	SetDefaultRouteUsageText     = "Sets how a conflicting default route is handled when connecting"
+	SetDefaultRouteArgsUsageText = `<mode>`
+	SetDefaultRouteDescription   = `Use this command to set how a pre-existing default route, which conflicts with the VPN default route, is handled when connecting.
+Supported values for <mode>:
+	replace - the conflicting default route is removed until disconnect (default)
+	coexist - the conflicting default route is kept with a lower priority than the VPN route
+	refuse - the connection is refused while the conflicting default route exists
+
+Example: 'nordvpn set default-route coexist'`
+)
+
+func (c *cmd) SetDefaultRoute(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	mode, ok := pb.DefaultRouteMode_value[strings.ToUpper(ctx.Args().First())]
+	if !ok {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetDefaultRouteMode(context.Background(), &pb.SetDefaultRouteModeRequest{
+		Mode: pb.DefaultRouteMode(mode),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	label := strings.ToLower(pb.DefaultRouteMode(mode).String())
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Default route", label))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Default route", label))
+	}
+	return nil
+}
+
+func (c *cmd) SetDefaultRouteAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	for i := 0; i < len(pb.DefaultRouteMode_name); i++ {
+		fmt.Println(strings.ToLower(pb.DefaultRouteMode(i).String()))
+	}
+}
@@ -23,8 +23,13 @@ const (
 Supported values for <disabled>: 0, false, disable, off, disabled
 Example: nordvpn set dns off
 
-Arguments <servers> is a list of IP addresses separated by space
+Arguments <servers> is a list of IP addresses, DNS-over-TLS or DNS-over-HTTPS
+endpoints separated by space. DNS-over-TLS endpoint is tls://<ip>[:port], the
+certificate of the server must be issued for the IP address. DNS-over-HTTPS
+endpoint is an https:// URL. Encrypted servers are queried through the VPN by a
+local forwarder, which is given to the system resolver instead.
 Example: nordvpn set dns 0.0.0.0 1.2.3.4
+Example: nordvpn set dns tls://1.1.1.1 https://dns.example/dns-query
 
 Limits:
   Can set up to 3 DNS servers
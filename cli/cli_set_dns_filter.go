@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set DNS filter help text
+const (
+	SetDNSFilterUsageText     = "Sets DNS record types which are not resolved"
+	SetDNSFilterArgsUsageText = `<types>|<disabled>`
+	SetDNSFilterDescription   = `Use this command to stop resolving the DNS record types while connected.
+Queries of these types are answered locally with an empty answer, e.g. filtering
+AAAA keeps the applications from attempting IPv6 connections outside of an
+IPv4-only tunnel.
+
+Supported values for <disabled>: 0, false, disable, off, disabled
+Example: nordvpn set dns-filter off
+
+Arguments <types> is a list of record types separated by space, such as A, AAAA,
+HTTPS, SVCB, MX, TXT or a numeric type in the TYPEnnn form
+Example: nordvpn set dns-filter AAAA HTTPS
+
+Notes:
+  Queries are answered by a local forwarder, which is given to the system resolver
+  instead of the DNS servers`
+)
+
+func (c *cmd) SetDNSFilter(ctx *cli.Context) error {
+	args := ctx.Args()
+
+	if args.Len() == 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	var types []string
+	if !(args.Len() == 1 && nstrings.CanParseFalseFromString(args.First())) {
+		types = args.Slice()
+	}
+
+	resp, err := c.client.SetDNSFilter(context.Background(), &pb.SetDNSFilterRequest{Types: types})
+	if err != nil {
+		return formatError(err)
+	}
+
+	label := nstrings.GetBoolLabel(false)
+	if types != nil {
+		label = strings.ToUpper(strings.Join(types, ", "))
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFailure:
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(MsgDNSFilterTypeInvalid, strings.Join(resp.Data, "")))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "DNS filter", label))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "DNS filter", label))
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+const SetDNSIPv6UsageText = "Enables or disables configuration of the IPv6 nameservers."
+
+func (c *cmd) SetDNSIPv6(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	flag, err := nstrings.BoolFromString(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetDNSIPv6(context.Background(), &pb.SetGenericRequest{Enabled: flag})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFailure:
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "IPv6 DNS", nstrings.GetBoolLabel(flag)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "IPv6 DNS", nstrings.GetBoolLabel(flag)))
+	}
+	return nil
+}
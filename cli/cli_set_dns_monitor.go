@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+const SetDNSMonitorUsageText = "Enables or disables DNS monitoring. " +
+	"When enabled, the VPN DNS is watched while connected and re-applied if another program, " +
+	"such as NetworkManager or dhclient, overwrites it. Takes effect on the next connect."
+
+func (c *cmd) SetDNSMonitor(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	flag, err := nstrings.BoolFromString(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetDNSMonitor(context.Background(), &pb.SetGenericRequest{Enabled: flag})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "DNS Monitor", nstrings.GetBoolLabel(flag)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "DNS Monitor", nstrings.GetBoolLabel(flag)))
+	}
+	return nil
+}
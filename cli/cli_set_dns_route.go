@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+const flagDNSRouteTunnel = "tunnel"
+
+// Set DNS route help text
+const (
+	SetDNSRouteUsageText       = "Sends DNS queries of a domain to a specific nameserver"
+	SetDNSRouteArgsUsageText   = `<domain>=<nameserver>|<disabled> [--tunnel]`
+	SetDNSRouteTunnelUsageText = "Queries the nameserver through the VPN tunnel"
+	SetDNSRouteDescription     = `Use this command to resolve the domain and its subdomains with an internal nameserver
+while connected, e.g. to reach the names of a corporate network. Other domains are
+resolved by the VPN nameservers.
+
+Nameserver is queried outside of the VPN tunnel, unless --tunnel is given.
+Example: nordvpn set dns-route corp.example=10.0.0.53
+Example: nordvpn set dns-route corp.example=10.0.0.53 --tunnel
+
+Supported values for <disabled>: 0, false, disable, off, disabled
+Example: nordvpn set dns-route corp.example=off`
+)
+
+func (c *cmd) SetDNSRoute(ctx *cli.Context) error {
+	viaTunnel := ctx.Bool(flagDNSRouteTunnel)
+	var args []string
+	// flag is accepted after the route as well
+	for _, arg := range ctx.Args().Slice() {
+		if arg == "--"+flagDNSRouteTunnel || arg == "-"+flagDNSRouteTunnel {
+			viaTunnel = true
+			continue
+		}
+		args = append(args, arg)
+	}
+	if len(args) != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	domain, nameserver, ok := strings.Cut(args[0], "=")
+	if !ok || domain == "" || nameserver == "" {
+		return formatError(argsParseError(ctx))
+	}
+	remove := nstrings.CanParseFalseFromString(nameserver)
+
+	resp, err := c.client.SetDNSRoute(context.Background(), &pb.SetDNSRouteRequest{
+		Route:  &pb.DNSRoute{Domain: domain, Nameserver: nameserver, ViaTunnel: viaTunnel},
+		Remove: remove,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFailure:
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(MsgDNSRouteInvalid, strings.Join(resp.Data, "")))
+	case internal.CodeConflict:
+		return formatError(fmt.Errorf(MsgDNSRouteMeshnet, strings.Join(resp.Data, "")))
+	case internal.CodeNothingToDo:
+		if remove {
+			color.Yellow(fmt.Sprintf(MsgDNSRouteNotFound, domain))
+		} else {
+			color.Yellow(fmt.Sprintf(MsgDNSRouteAlreadySet, domain, nameserver, dnsRouteVia(viaTunnel)))
+		}
+	case internal.CodeSuccess:
+		if remove {
+			color.Green(fmt.Sprintf(MsgDNSRouteRemoved, domain))
+		} else {
+			color.Green(fmt.Sprintf(MsgDNSRouteSet, domain, nameserver, dnsRouteVia(viaTunnel)))
+		}
+	}
+	return nil
+}
+
+func dnsRouteVia(viaTunnel bool) string {
+	if viaTunnel {
+		return MsgDNSRouteViaTunnel
+	}
+	return MsgDNSRouteOutsideTunnel
+}
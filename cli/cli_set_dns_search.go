@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set DNS search help text
+const (
+	SetDNSSearchUsageText     = "Sets DNS search domains"
+	SetDNSSearchArgsUsageText = `<domains>|<disabled>`
+	SetDNSSearchDescription   = `Use this command to set the domains appended to short hostnames while connected.
+
+Supported values for <disabled>: 0, false, disable, off, disabled
+Example: nordvpn set dns-search off
+
+Arguments <domains> is a list of domain names separated by space
+Example: nordvpn set dns-search corp.example.com example.org
+
+Limits:
+  Can set up to 5 search domains
+
+Notes:
+  Domain 'nord' is added while meshnet is enabled`
+)
+
+func (c *cmd) SetDNSSearch(ctx *cli.Context) error {
+	args := ctx.Args()
+
+	if args.Len() == 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	var domains []string
+	if !(args.Len() == 1 && nstrings.CanParseFalseFromString(args.First())) {
+		domains = args.Slice()
+	}
+
+	resp, err := c.client.SetDNSSearchDomains(context.Background(), &pb.SetDNSSearchDomainsRequest{
+		Domains: domains,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	label := nstrings.GetBoolLabel(false)
+	if domains != nil {
+		label = strings.Join(domains, ", ")
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFailure:
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(MsgDNSSearchDomainInvalid, strings.Join(resp.Data, "")))
+	case internal.CodeBadRequest:
+		return formatError(fmt.Errorf(MsgDNSSearchDomainsTooMany))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "DNS search domains", label))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "DNS search domains", label))
+	}
+	return nil
+}
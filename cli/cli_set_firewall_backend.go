@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set firewall backend help text
+const (
+	SetFirewallBackendUsageText     = "Sets how the firewall rules are applied"
+	SetFirewallBackendArgsUsageText = `<backend>`
+	SetFirewallBackendDescription   = `Use this command to set which backend applies the firewall rules, e.g. Kill Switch and allowlist.
+Supported values for <backend>:
+	iptables - the rules are applied with iptables (default)
+	nftables - the rules are applied to a separate nftables table, so they coexist with firewalld and other nftables based firewalls
+
+The setting takes effect after restarting the daemon. If nftables is not available, iptables is used instead.
+
+Allowlist, meshnet, split tunnel, on-demand and firewall templates apply their rules with iptables,
+so nftables cannot be selected while any of them is enabled. If any of them is enabled later, iptables
+is used after the next restart of the daemon.
+
+Example: 'nordvpn set firewall-backend nftables'`
+)
+
+func (c *cmd) SetFirewallBackend(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	backend, ok := pb.FirewallBackend_value[strings.ToUpper(ctx.Args().First())]
+	if !ok {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetFirewallBackend(context.Background(), &pb.SetFirewallBackendRequest{
+		Backend: pb.FirewallBackend(backend),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	label := strings.ToLower(pb.FirewallBackend(backend).String())
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeConflict:
+		return formatError(fmt.Errorf(MsgFirewallBackendConflict, label, strings.Join(resp.Data, ", ")))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Firewall backend", label))
+	case internal.CodeSuccess:
+		color.Yellow("Restart daemon (e.g. `sudo systemctl restart nordvpnd` on systemd distros) for this setting to take an effect.")
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Firewall backend", label))
+	}
+	return nil
+}
+
+func (c *cmd) SetFirewallBackendAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	for i := 0; i < len(pb.FirewallBackend_name); i++ {
+		fmt.Println(strings.ToLower(pb.FirewallBackend(i).String()))
+	}
+}
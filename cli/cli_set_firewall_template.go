@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set firewall template help text
+const (
+	SetFirewallTemplateUsageText     = "Sets a file with custom firewall rules which are applied at the given stage"
+	SetFirewallTemplateArgsUsageText = `<stage> <path>|off`
+	SetFirewallTemplateDescription   = `Use this command to apply custom firewall rules alongside the rules of the app.
+Supported values for <stage>:
+	persistent - rules are present while the app is running
+	pre-connect - rules are applied before connecting and removed after disconnecting
+	post-disconnect - rules are applied after disconnecting and removed before connecting
+
+Template must be owned by root and writable only by the owner. It contains one iptables rule per line,
+e.g. '-A INPUT -s 192.0.2.0/24 -p tcp --dport 22 -j ACCEPT'. Empty lines and lines starting with # are ignored.
+Only rules appended to INPUT, OUTPUT and FORWARD chains are supported. Template is validated before it is applied,
+an invalid template is not applied at all.
+
+Ordering guarantees:
+	rules are appended to the end of the chains in the order they appear in the template
+	rules of the app always precede the template rules, e.g. Kill Switch cannot be overridden by the template
+	template rules are not affected by the rules of the app
+
+Set the same path again to apply the changes of the template contents.
+
+Example: 'nordvpn set firewall-template persistent /etc/nordvpn/persistent.rules'
+Example: 'nordvpn set firewall-template pre-connect off'`
+)
+
+var firewallTemplateStages = map[string]pb.FirewallTemplateStage{
+	"persistent":      pb.FirewallTemplateStage_TEMPLATE_PERSISTENT,
+	"pre-connect":     pb.FirewallTemplateStage_TEMPLATE_PRE_CONNECT,
+	"post-disconnect": pb.FirewallTemplateStage_TEMPLATE_POST_DISCONNECT,
+}
+
+func (c *cmd) SetFirewallTemplate(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return formatError(argsCountError(ctx))
+	}
+
+	label := ctx.Args().First()
+	stage, ok := firewallTemplateStages[label]
+	if !ok {
+		return formatError(argsParseError(ctx))
+	}
+
+	path := ctx.Args().Get(1)
+	if path == "off" {
+		path = ""
+	} else {
+		var err error
+		if path, err = filepath.Abs(path); err != nil {
+			return formatError(argsParseError(ctx))
+		}
+	}
+
+	resp, err := c.client.SetFirewallTemplate(context.Background(), &pb.SetFirewallTemplateRequest{
+		Stage: stage,
+		Path:  path,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	value := path
+	if value == "" {
+		value = "off"
+	}
+	name := fmt.Sprintf("Firewall template %s", label)
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeFailure:
+		if len(resp.Data) > 0 {
+			return formatError(errors.New(resp.Data[0]))
+		}
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, name, value))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, name, value))
+	}
+	return nil
+}
+
+func (c *cmd) SetFirewallTemplateAutoComplete(ctx *cli.Context) {
+	switch ctx.NArg() {
+	case 0:
+		for _, stage := range []string{"persistent", "pre-connect", "post-disconnect"} {
+			fmt.Println(stage)
+		}
+	case 1:
+		fmt.Println("off")
+	}
+}
+
+func displayFirewallTemplates(templates *pb.FirewallTemplates) {
+	for _, template := range []struct {
+		label string
+		path  string
+	}{
+		{"Persistent", templates.GetPersistent()},
+		{"Pre-connect", templates.GetPreConnect()},
+		{"Post-disconnect", templates.GetPostDisconnect()},
+	} {
+		if template.path == "" {
+			continue
+		}
+		fmt.Printf("Firewall Template %s: %s\n", template.label, template.path)
+	}
+}
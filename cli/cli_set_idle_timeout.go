@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+const flagIdleTimeoutKillSwitch = "kill-switch"
+
+// Set idle-timeout help text
+const (
+	SetIdleTimeoutUsageText       = "Sets the duration after which an idle VPN connection is disconnected"
+	SetIdleTimeoutArgsUsageText   = `<duration>`
+	SetIdleTimeoutKillSwitchUsage = "Enable Kill Switch after the idle disconnect, so that the traffic is blocked"
+	SetIdleTimeoutDescription     = `Use this command to disconnect VPN automatically after the connection had no traffic for the given duration.
+Unlike on-demand connection, the connection is not established again once there is traffic.
+Use 0 to disable the idle disconnect.
+
+Example: 'nordvpn set idle-timeout 30m'
+Example: 'nordvpn set idle-timeout --kill-switch 1h'
+Example: 'nordvpn set idle-timeout 0'`
+)
+
+func (c *cmd) SetIdleTimeout(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	timeout, err := time.ParseDuration(ctx.Args().First())
+	if err != nil || timeout < 0 {
+		return formatError(argsParseError(ctx))
+	}
+	if timeout != 0 && timeout < time.Minute {
+		return formatError(fmt.Errorf(MsgIdleTimeoutTooShort, time.Minute))
+	}
+
+	resp, err := c.client.SetIdleDisconnect(context.Background(), &pb.SetIdleDisconnectRequest{
+		Timeout:    uint32(timeout.Seconds()),
+		KillSwitch: ctx.Bool(flagIdleTimeoutKillSwitch),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	value := timeout.String()
+	if timeout == 0 {
+		value = "disabled"
+	}
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(MsgIdleTimeoutTooShort, time.Minute))
+	case internal.CodeDependencyError:
+		color.Yellow(fmt.Sprintf(FirewallRequired, "--"+flagIdleTimeoutKillSwitch))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Idle timeout", value))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Idle timeout", value))
+	}
+	return nil
+}
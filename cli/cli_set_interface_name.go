@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// interfaceNameDefault restores the default names of the tunnel interface
+const interfaceNameDefault = "default"
+
+// Set interface name help text
+const (
+	SetInterfaceNameUsageText     = "Sets the name of the tunnel interface"
+	SetInterfaceNameArgsUsageText = `<name>|default`
+	SetInterfaceNameDescription   = `Use this command to set the name of the tunnel interface, e.g. to match the firewall rules or the monitoring which expect a particular name. The name applies to NordLynx and OpenVPN connections and is used starting with the next connection. Meshnet keeps using the nordlynx interface.
+The name can be up to 15 characters long and contain letters, digits and the characters '_', '.' and '-'.
+Use 'default' to restore the default names.
+
+Example: 'nordvpn set interface-name corpvpn0'
+Example: 'nordvpn set interface-name default'`
+)
+
+func (c *cmd) SetInterfaceName(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	var name string
+	if arg := ctx.Args().First(); !strings.EqualFold(arg, interfaceNameDefault) {
+		name = arg
+	}
+
+	resp, err := c.client.SetInterfaceName(context.Background(), &pb.SetInterfaceNameRequest{Name: name})
+	if err != nil {
+		return formatError(err)
+	}
+
+	label := interfaceNameLabel(name)
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(MsgInterfaceNameInvalid, ctx.Args().First()))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Interface name", label))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Interface name", label))
+		if connected, _ := strconv.ParseBool(resp.Data[0]); connected {
+			color.Yellow(SetReconnect)
+		}
+	}
+	return nil
+}
+
+func interfaceNameLabel(name string) string {
+	if name == "" {
+		return interfaceNameDefault
+	}
+	return name
+}
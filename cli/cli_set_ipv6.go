@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/internal"
@@ -12,30 +13,76 @@ import (
 	"github.com/urfave/cli/v2"
 )
 
-const SetIpv6UsageText = "Enables or disables use of the IPv6."
+// Set IPv6 help text
+const (
+	SetIpv6UsageText     = "Sets how IPv6 traffic is handled while connected"
+	SetIpv6ArgsUsageText = `<mode>`
+	SetIpv6Description   = `Use this command to set how IPv6 traffic is handled while connected to VPN.
+Supported values for <mode>:
+	block - all IPv6 traffic is dropped by the firewall (default)
+	tunnel - IPv6 traffic is routed through the VPN, it is blocked if the server does not support IPv6
+	native - IPv6 is left untouched, IPv6 traffic bypasses the VPN and leaks your IPv6 address
+
+Values 1, true, enable, on, enabled set tunnel mode, values 0, false, disable, off, disabled set block mode.
+
+Example: 'nordvpn set ipv6 tunnel'`
+)
 
 func (c *cmd) SetIpv6(ctx *cli.Context) error {
 	if ctx.NArg() != 1 {
 		return formatError(argsCountError(ctx))
 	}
 
-	flag, err := nstrings.BoolFromString(ctx.Args().First())
+	mode, err := ipv6ModeFromString(ctx.Args().First())
 	if err != nil {
 		return formatError(argsParseError(ctx))
 	}
 
-	resp, err := c.client.SetIpv6(context.Background(), &pb.SetGenericRequest{Enabled: flag})
+	resp, err := c.client.SetIPv6Mode(context.Background(), &pb.SetIPv6ModeRequest{Mode: mode})
 	if err != nil {
 		return formatError(err)
 	}
 
+	label := ipv6ModeLabel(mode)
 	switch resp.Type {
 	case internal.CodeConfigError:
 		return formatError(ErrConfig)
 	case internal.CodeNothingToDo:
-		color.Yellow(fmt.Sprintf(MsgAlreadySet, "IPv6", nstrings.GetBoolLabel(flag)))
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "IPv6", label))
 	case internal.CodeSuccess:
-		color.Green(fmt.Sprintf(MsgSetSuccess, "IPv6", nstrings.GetBoolLabel(flag)))
+		color.Green(fmt.Sprintf(MsgSetSuccess, "IPv6", label))
+	}
+	if mode == pb.IPv6Mode_IPV6_MODE_NATIVE && resp.Type != internal.CodeConfigError {
+		color.Red(MsgIPv6NativeLeak)
 	}
 	return nil
 }
+
+func (c *cmd) SetIpv6AutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	for i := 0; i < len(pb.IPv6Mode_name); i++ {
+		fmt.Println(ipv6ModeLabel(pb.IPv6Mode(i)))
+	}
+}
+
+// ipv6ModeFromString parses the mode, the boolean values of the former on/off
+// setting select the tunnel and block modes
+func ipv6ModeFromString(value string) (pb.IPv6Mode, error) {
+	if mode, ok := pb.IPv6Mode_value["IPV6_MODE_"+strings.ToUpper(value)]; ok {
+		return pb.IPv6Mode(mode), nil
+	}
+	enabled, err := nstrings.BoolFromString(value)
+	if err != nil {
+		return 0, err
+	}
+	if enabled {
+		return pb.IPv6Mode_IPV6_MODE_TUNNEL, nil
+	}
+	return pb.IPv6Mode_IPV6_MODE_BLOCK, nil
+}
+
+func ipv6ModeLabel(mode pb.IPv6Mode) string {
+	return strings.ToLower(strings.TrimPrefix(mode.String(), "IPV6_MODE_"))
+}
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPv6ModeFromString(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		value    string
+		expected pb.IPv6Mode
+		hasError bool
+	}{
+		{value: "tunnel", expected: pb.IPv6Mode_IPV6_MODE_TUNNEL},
+		{value: "BLOCK", expected: pb.IPv6Mode_IPV6_MODE_BLOCK},
+		{value: "native", expected: pb.IPv6Mode_IPV6_MODE_NATIVE},
+		{value: "on", expected: pb.IPv6Mode_IPV6_MODE_TUNNEL},
+		{value: "off", expected: pb.IPv6Mode_IPV6_MODE_BLOCK},
+		{value: "leak", hasError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			mode, err := ipv6ModeFromString(test.value)
+			if test.hasError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, mode)
+
+			// label is accepted back
+			labelMode, err := ipv6ModeFromString(ipv6ModeLabel(mode))
+			assert.NoError(t, err)
+			assert.Equal(t, mode, labelMode)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set kill switch LAN help text
+const (
+	SetKillSwitchLANUsageText     = "Allows or blocks LAN traffic while Kill Switch is enabled"
+	SetKillSwitchLANArgsUsageText = `allow|block`
+	SetKillSwitchLANDescription   = `Use this command to set whether traffic to private (RFC1918) and link-local networks is allowed while Kill Switch is enabled.
+It is independent of LAN discovery, which controls LAN access while connected to VPN.
+Supported values:
+	allow - LAN traffic is allowed
+	block - LAN traffic is blocked (default)
+
+Example: 'nordvpn set killswitch-lan allow'`
+
+	killSwitchLANAllow = "allow"
+	killSwitchLANBlock = "block"
+)
+
+func (c *cmd) SetKillSwitchLAN(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	var allowed bool
+	switch ctx.Args().First() {
+	case killSwitchLANAllow:
+		allowed = true
+	case killSwitchLANBlock:
+	default:
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetKillSwitchLAN(context.Background(), &pb.SetGenericRequest{Enabled: allowed})
+	if err != nil {
+		return formatError(err)
+	}
+
+	label := killSwitchLANLabel(allowed)
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeKillSwitchError:
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeDependencyError:
+		color.Yellow(fmt.Sprintf(FirewallRequired, "Kill Switch LAN access"))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Kill Switch LAN access", label))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Kill Switch LAN access", label))
+	}
+	return nil
+}
+
+func (c *cmd) SetKillSwitchLANAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	fmt.Println(killSwitchLANAllow)
+	fmt.Println(killSwitchLANBlock)
+}
+
+func killSwitchLANLabel(allowed bool) string {
+	if allowed {
+		return killSwitchLANAllow
+	}
+	return killSwitchLANBlock
+}
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+const SetKillSwitchLogUsageText = "Enables or disables logging of the traffic blocked by Kill Switch. " +
+	"Use 'nordvpn killswitch blocked' to see it."
+
+func (c *cmd) SetKillSwitchLog(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	flag, err := nstrings.BoolFromString(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetKillSwitchLog(context.Background(), &pb.SetGenericRequest{Enabled: flag})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeDependencyError:
+		color.Yellow(fmt.Sprintf(FirewallRequired, "Kill Switch logging"))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Kill Switch logging", nstrings.GetBoolLabel(flag)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Kill Switch logging", nstrings.GetBoolLabel(flag)))
+		color.Yellow(MsgKillSwitchLogReconnect)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+const SetLegacyDNSUsageText = "Enables or disables setting DNS by modifying /etc/resolv.conf even if systemd-resolved is running. " +
+	"The setting takes effect after restarting the daemon."
+
+func (c *cmd) SetLegacyDNS(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	flag, err := nstrings.BoolFromString(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetLegacyDNS(context.Background(), &pb.SetGenericRequest{Enabled: flag})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Legacy DNS", nstrings.GetBoolLabel(flag)))
+	case internal.CodeSuccess:
+		color.Yellow("Restart daemon (e.g. `sudo systemctl restart nordvpnd` on systemd distros) for this setting to take an effect.")
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Legacy DNS", nstrings.GetBoolLabel(flag)))
+	}
+	return nil
+}
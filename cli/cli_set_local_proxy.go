@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+const flagLocalProxyPort = "port"
+
+// Set local proxy help text
+const (
+	SetLocalProxyUsageText   = "Enables or disables the local SOCKS5 and HTTP proxy forwarding through the VPN tunnel"
+	SetLocalProxyPortUsage   = "Port to listen on (default 1080)"
+	SetLocalProxyDescription = `Use this command to let single applications use the VPN connection on the machines where routing all of the traffic through VPN is not wanted.
+The proxy listens on 127.0.0.1 and accepts both SOCKS5 and HTTP proxy requests on the same port. Connections, including the hostname lookups, are made through the VPN tunnel only. While VPN is not connected, the proxy refuses the connections instead of sending them outside of the tunnel.
+
+Example: 'nordvpn set local-proxy on'
+Example: 'nordvpn set local-proxy on --port 1080'
+Example: 'nordvpn set local-proxy off'`
+)
+
+func (c *cmd) SetLocalProxy(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	flag, err := nstrings.BoolFromString(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetLocalProxy(context.Background(), &pb.SetLocalProxyRequest{
+		Enabled: flag,
+		Port:    uint32(ctx.Uint(flagLocalProxyPort)),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(MsgLocalProxyInvalidPort, ctx.Uint(flagLocalProxyPort)))
+	case internal.CodeFailure:
+		return notAppliedError(resp)
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Local proxy", nstrings.GetBoolLabel(flag)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Local proxy", nstrings.GetBoolLabel(flag)))
+		if flag && len(resp.Data) > 1 {
+			color.Green(MsgLocalProxyServing, resp.Data[0])
+			if connected, _ := strconv.ParseBool(resp.Data[1]); !connected {
+				color.Yellow(MsgLocalProxyNotConnected)
+			}
+		}
+	}
+	return nil
+}
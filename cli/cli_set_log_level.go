@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// logLevelPrefix is shared by the names of the pb.LogLevel values
+const logLevelPrefix = "LOG_LEVEL_"
+
+// Set log level help text
+const (
+	SetLogLevelUsageText     = "Sets the verbosity of the daemon log"
+	SetLogLevelArgsUsageText = `<level>`
+	SetLogLevelDescription   = `Use this command to set the verbosity of the daemon log. The change is applied immediately, without restarting the daemon.
+Supported values for <level>:
+	debug - everything is logged, including the debug messages
+	info - informational messages, warnings and errors are logged (default)
+	warn - only warnings and errors are logged
+
+Set the LOG_FORMAT=json environment variable for the daemon to log in JSON format.
+
+Example: 'nordvpn set loglevel debug'`
+)
+
+func (c *cmd) SetLogLevel(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	level, ok := pb.LogLevel_value[logLevelPrefix+strings.ToUpper(ctx.Args().First())]
+	if !ok {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetLogLevel(context.Background(), &pb.SetLogLevelRequest{
+		Level: pb.LogLevel(level),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	label := logLevelLabel(pb.LogLevel(level))
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Log level", label))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Log level", label))
+	}
+	return nil
+}
+
+func (c *cmd) SetLogLevelAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	for i := 0; i < len(pb.LogLevel_name); i++ {
+		fmt.Println(logLevelLabel(pb.LogLevel(i)))
+	}
+}
+
+func logLevelLabel(level pb.LogLevel) string {
+	return strings.ToLower(strings.TrimPrefix(level.String(), logLevelPrefix))
+}
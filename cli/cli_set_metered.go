@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagMeteredAllowRefresh     = "allow-refresh"
+	flagMeteredAllowAutoConnect = "allow-autoconnect"
+)
+
+// Set metered help text
+const (
+	SetMeteredUsageText             = "Enables or disables the metered network policy"
+	SetMeteredAllowRefreshUsage     = "Keep the background refresh of the server list and other data on metered networks"
+	SetMeteredAllowAutoConnectUsage = "Keep auto-connect and on-demand connection on metered networks"
+	SetMeteredDescription           = `Use this command to limit the background activity of the app while the network connection is metered, e.g. a mobile or a tethered connection.
+Connections marked as metered in NetworkManager and the networks added with 'nordvpn metered add' are treated as metered.
+While the policy is active, the background refresh of the server list and other data is skipped and the VPN connection is not established automatically.
+
+Example: 'nordvpn set metered on'
+Example: 'nordvpn set metered --allow-autoconnect on'
+Example: 'nordvpn set metered off'`
+)
+
+func (c *cmd) SetMetered(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	flag, err := nstrings.BoolFromString(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetMetered(context.Background(), &pb.SetMeteredRequest{
+		Enabled:          flag,
+		AllowRefresh:     ctx.Bool(flagMeteredAllowRefresh),
+		AllowAutoconnect: ctx.Bool(flagMeteredAllowAutoConnect),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Metered network policy", nstrings.GetBoolLabel(flag)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Metered network policy", nstrings.GetBoolLabel(flag)))
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+const flagMetricsAddress = "address"
+
+// Set metrics help text
+const (
+	SetMetricsUsageText    = "Enables or disables the metrics endpoint for Prometheus"
+	SetMetricsAddressUsage = "IP address and port to serve the metrics on (default 127.0.0.1:9101)"
+	SetMetricsDescription  = `Use this command to serve the daemon metrics in the Prometheus text format on http://<address>/metrics.
+Metrics include the connection state, the time it takes to establish the tunnel, bytes transferred through the tunnel, connection and reconnection counts and failed API requests.
+Metrics are served on the loopback interface unless a different address is given. The endpoint has no authentication, so expose it only to the networks you trust.
+
+Example: 'nordvpn set metrics on'
+Example: 'nordvpn set metrics on --address 127.0.0.1:9101'
+Example: 'nordvpn set metrics off'`
+)
+
+func (c *cmd) SetMetrics(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	flag, err := nstrings.BoolFromString(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetMetrics(context.Background(), &pb.SetMetricsRequest{
+		Enabled: flag,
+		Address: ctx.String(flagMetricsAddress),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(MsgMetricsInvalidAddress, ctx.String(flagMetricsAddress)))
+	case internal.CodeFailure:
+		return notAppliedError(resp)
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Metrics", nstrings.GetBoolLabel(flag)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Metrics", nstrings.GetBoolLabel(flag)))
+		if flag && len(resp.Data) > 0 {
+			color.Green(MsgMetricsServing, resp.Data[0])
+		}
+	}
+	return nil
+}
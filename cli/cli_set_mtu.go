@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// mtuAuto restores the detection of the path MTU
+const mtuAuto = "auto"
+
+// Set MTU help text
+const (
+	SetMTUUsageText     = "Sets the MTU of the tunnel"
+	SetMTUArgsUsageText = `<mtu>|auto`
+	SetMTUDescription   = `Use this command to set the MTU of the tunnel interface. By default the path MTU to the VPN server is probed after connecting and the MTU of the tunnel is lowered when the large packets would not reach the server, e.g. on PPPoE or LTE links. Set the MTU manually if the probes are blocked on your network. MTU of the active connection is changed right away.
+Use 'auto' to restore the detection.
+
+Example: 'nordvpn set mtu 1380'
+Example: 'nordvpn set mtu auto'`
+)
+
+func (c *cmd) SetMTU(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	var mtu uint64
+	if arg := ctx.Args().First(); !strings.EqualFold(arg, mtuAuto) {
+		var err error
+		if mtu, err = strconv.ParseUint(arg, 10, 32); err != nil || mtu == 0 {
+			return formatError(fmt.Errorf(MsgMTUInvalid, arg))
+		}
+	}
+
+	resp, err := c.client.SetMTU(context.Background(), &pb.SetMTURequest{Mtu: uint32(mtu)})
+	if err != nil {
+		return formatError(err)
+	}
+
+	label := mtuLabel(uint32(mtu))
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(MsgMTUInvalid, ctx.Args().First()))
+	case internal.CodeFailure:
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "MTU", label))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "MTU", label))
+	}
+	return nil
+}
+
+func mtuLabel(mtu uint32) string {
+	if mtu == 0 {
+		return mtuAuto
+	}
+	return strconv.FormatUint(uint64(mtu), 10)
+}
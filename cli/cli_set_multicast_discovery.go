@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+const SetMulticastDiscoveryUsageText = "Allows or blocks mDNS and SSDP discovery while Kill Switch is enabled. " +
+	"Printers and casting devices can be found while the rest of LAN traffic stays blocked."
+
+func (c *cmd) SetMulticastDiscovery(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	flag, err := nstrings.BoolFromString(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetMulticastDiscovery(context.Background(), &pb.SetGenericRequest{Enabled: flag})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeKillSwitchError:
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeDependencyError:
+		color.Yellow(fmt.Sprintf(FirewallRequired, "Multicast discovery"))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Multicast discovery", nstrings.GetBoolLabel(flag)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Multicast discovery", nstrings.GetBoolLabel(flag)))
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// obfuscationModePrefix is shared by the names of the pb.ObfuscationMode values
+const obfuscationModePrefix = "OBFUSCATION_MODE_"
+
+// Set obfuscation mode help text
+const (
+	SetObfuscationModeUsageText     = "Sets how the obfuscated connections are disguised"
+	SetObfuscationModeArgsUsageText = `<mode>`
+	SetObfuscationModeDescription   = `Use this command to set how the obfuscated OpenVPN connections are disguised. It takes effect when obfuscation is enabled.
+Supported values for <mode>:
+	xor - the OpenVPN packets are scrambled (default)
+	tls - the connection is wrapped in TLS on port 443, so it looks like HTTPS
+	websocket - the connection is wrapped in WebSocket over TLS on port 443, so it looks like a web application
+
+The tls and websocket modes use OpenVPN over TCP regardless of the protocol setting. Use them on networks with deep packet inspection, where the xor mode is detected.
+
+Example: 'nordvpn set obfuscation-mode tls'`
+)
+
+func (c *cmd) SetObfuscationMode(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	mode, ok := pb.ObfuscationMode_value[obfuscationModePrefix+strings.ToUpper(ctx.Args().First())]
+	if !ok {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetObfuscationMode(context.Background(), &pb.SetObfuscationModeRequest{
+		Mode: pb.ObfuscationMode(mode),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	label := obfuscationModeLabel(pb.ObfuscationMode(mode))
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Obfuscation mode", label))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Obfuscation mode", label))
+	}
+	return nil
+}
+
+func (c *cmd) SetObfuscationModeAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	for i := 0; i < len(pb.ObfuscationMode_name); i++ {
+		fmt.Println(obfuscationModeLabel(pb.ObfuscationMode(i)))
+	}
+}
+
+func obfuscationModeLabel(mode pb.ObfuscationMode) string {
+	return strings.ToLower(strings.TrimPrefix(mode.String(), obfuscationModePrefix))
+}
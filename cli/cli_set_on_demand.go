@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+const flagOnDemandIdleTimeout = "idle-timeout"
+
+// Set on-demand help text
+const (
+	SetOnDemandUsageText        = "Enables or disables on-demand connection. When enabled, VPN connection is established automatically once there is outgoing traffic and is closed after being idle."
+	SetOnDemandIdleTimeoutUsage = "Disconnect after the connection had no traffic for the given duration, e.g. 10m (minimum 1m, default 5m)"
+	SetOnDemandDescription      = `Use this command to enable or disable on-demand connection.
+While on-demand connection is waiting for the traffic, Kill Switch setting stays in effect:
+	Kill Switch enabled - the traffic is blocked until VPN connection is established
+	Kill Switch disabled - the traffic which triggers the connection leaves the device unprotected
+
+Traffic to the allowlisted subnets, to the local network with LAN discovery enabled and to meshnet does not trigger the connection.
+
+Example: 'nordvpn set on-demand on'
+Example: 'nordvpn set on-demand --idle-timeout 10m on'`
+)
+
+func (c *cmd) SetOnDemand(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	flag, err := nstrings.BoolFromString(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	idleTimeout := ctx.Duration(flagOnDemandIdleTimeout)
+	if idleTimeout != 0 && idleTimeout < time.Minute {
+		return formatError(fmt.Errorf(MsgOnDemandIdleTimeoutTooShort, time.Minute))
+	}
+
+	resp, err := c.client.SetOnDemand(context.Background(), &pb.SetOnDemandRequest{
+		Enabled:     flag,
+		IdleTimeout: uint32(idleTimeout.Seconds()),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(MsgOnDemandIdleTimeoutTooShort, time.Minute))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "On-demand", nstrings.GetBoolLabel(flag)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "On-demand", nstrings.GetBoolLabel(flag)))
+		if flag {
+			settings, err := c.getSettings()
+			if err == nil && !settings.GetKillSwitch() {
+				color.Yellow(MsgOnDemandKillSwitchDisabled)
+			}
+		}
+	}
+	return nil
+}
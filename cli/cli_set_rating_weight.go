@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set rating-weight help text
+const (
+	SetRatingWeightUsageText     = "Sets how much server ratings affect the server pick when connecting"
+	SetRatingWeightArgsUsageText = `<percent>`
+	SetRatingWeightDescription   = `Use this command to prefer servers which you rated higher with 'nordvpn server-rate'.
+Every star above 3 makes the server more likely to be picked from the recommended ones by the given percent, every star below 3 makes it less likely.
+Use 0 to disable it.
+
+Example: 'nordvpn set rating-weight 50'
+Example: 'nordvpn set rating-weight 0'`
+)
+
+func (c *cmd) SetRatingWeight(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	weight, err := strconv.ParseUint(strings.TrimSuffix(ctx.Args().First(), "%"), 10, 32)
+	if err != nil || weight > config.MaxRatingWeight {
+		return formatError(fmt.Errorf(MsgRatingWeightInvalid, config.MaxRatingWeight))
+	}
+
+	resp, err := c.client.SetRatingWeight(context.Background(), &pb.SetUint32Request{Value: uint32(weight)})
+	if err != nil {
+		return formatError(err)
+	}
+
+	value := formatRatingWeight(uint32(weight))
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(MsgRatingWeightInvalid, config.MaxRatingWeight))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Rating weight", value))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Rating weight", value))
+	}
+	return nil
+}
+
+func formatRatingWeight(weight uint32) string {
+	if weight == 0 {
+		return "disabled"
+	}
+	return fmt.Sprintf("%d%%", weight)
+}
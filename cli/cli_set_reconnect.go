@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set reconnect help text
+const (
+	SetReconnectUsageText     = "Sets how the dropped VPN connection and auto-connect are retried"
+	SetReconnectArgsUsageText = `<option> <value>`
+	SetReconnectDescription   = `Use this command to tune the retries after the VPN connection drops, e.g. when the tunnel cannot be set up again after a network change, and the retries of auto-connect on start.
+Supported values for <option>:
+	max-retries - number of retries before giving up, 0 retries until connected
+	backoff - delay before the first retry which is doubled with each retry up to 10m, 0 uses the default schedule
+	jitter - upper bound of the random delay added to each retry, 0 disables it
+	aggressive - on or off, retries right after the drop and then every second regardless of the backoff
+
+Each attempt is logged by the daemon.
+
+Example: 'nordvpn set reconnect max-retries 10'
+Example: 'nordvpn set reconnect backoff 2s'
+Example: 'nordvpn set reconnect jitter 500ms'
+Example: 'nordvpn set reconnect aggressive on'`
+)
+
+var reconnectOptions = map[string]pb.ReconnectOption{
+	"max-retries": pb.ReconnectOption_RECONNECT_MAX_RETRIES,
+	"backoff":     pb.ReconnectOption_RECONNECT_BACKOFF,
+	"jitter":      pb.ReconnectOption_RECONNECT_JITTER,
+	"aggressive":  pb.ReconnectOption_RECONNECT_AGGRESSIVE,
+}
+
+func (c *cmd) SetReconnect(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return formatError(argsCountError(ctx))
+	}
+
+	label := ctx.Args().First()
+	option, ok := reconnectOptions[label]
+	if !ok {
+		return formatError(argsParseError(ctx))
+	}
+
+	arg := ctx.Args().Get(1)
+	var value uint32
+	switch option {
+	case pb.ReconnectOption_RECONNECT_MAX_RETRIES:
+		retries, err := strconv.ParseUint(arg, 10, 32)
+		if err != nil {
+			return formatError(argsParseError(ctx))
+		}
+		value = uint32(retries)
+	case pb.ReconnectOption_RECONNECT_BACKOFF, pb.ReconnectOption_RECONNECT_JITTER:
+		duration, err := time.ParseDuration(arg)
+		if err != nil || duration < 0 || duration.Milliseconds() > math.MaxUint32 {
+			return formatError(argsParseError(ctx))
+		}
+		value = uint32(duration.Milliseconds())
+		arg = duration.String()
+	case pb.ReconnectOption_RECONNECT_AGGRESSIVE:
+		flag, err := nstrings.BoolFromString(arg)
+		if err != nil {
+			return formatError(argsParseError(ctx))
+		}
+		if flag {
+			value = 1
+		}
+		arg = nstrings.GetBoolLabel(flag)
+	}
+
+	resp, err := c.client.SetReconnect(context.Background(), &pb.SetReconnectRequest{
+		Option: option,
+		Value:  value,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	name := fmt.Sprintf("Reconnect %s", label)
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, name, arg))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, name, arg))
+	}
+	return nil
+}
+
+func (c *cmd) SetReconnectAutoComplete(ctx *cli.Context) {
+	switch ctx.NArg() {
+	case 0:
+		for _, option := range []string{"max-retries", "backoff", "jitter", "aggressive"} {
+			fmt.Println(option)
+		}
+	case 1:
+		if ctx.Args().First() == "aggressive" {
+			fmt.Println("on")
+			fmt.Println("off")
+		}
+	}
+}
+
+func displayReconnectPolicy(policy *pb.ReconnectPolicy) {
+	if policy.GetMaxRetries() == 0 {
+		fmt.Println("Reconnect Max Retries: unlimited")
+	} else {
+		fmt.Printf("Reconnect Max Retries: %d\n", policy.GetMaxRetries())
+	}
+	if policy.GetBackoff() == 0 {
+		fmt.Println("Reconnect Backoff: default")
+	} else {
+		fmt.Printf("Reconnect Backoff: %s\n", time.Duration(policy.GetBackoff())*time.Millisecond)
+	}
+	if policy.GetJitter() != 0 {
+		fmt.Printf("Reconnect Jitter: %s\n", time.Duration(policy.GetJitter())*time.Millisecond)
+	}
+	fmt.Printf("Aggressive Reconnect: %+v\n", nstrings.GetBoolLabel(policy.GetAggressive()))
+}
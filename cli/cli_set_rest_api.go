@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagRESTAPIAddress  = "address"
+	flagRESTAPINewToken = "new-token"
+)
+
+// Set REST API help text
+const (
+	SetRESTAPIUsageText    = "Enables or disables the REST API controlling the app over HTTP"
+	SetRESTAPIAddressUsage = "Loopback IP address and port to serve the API on (default 127.0.0.1:9102)"
+	SetRESTAPITokenUsage   = "Replace the token authenticating the requests"
+	SetRESTAPIDescription  = `Use this command to control the app over HTTP with JSON requests, e.g. from home automation or web dashboards.
+The API is served on the loopback interface only. Requests must carry the token printed by this command in the 'Authorization: Bearer <token>' header. Run the command again to look the token up.
+
+Endpoints:
+  GET  /v1/status              connection status
+  GET  /v1/settings            current settings
+  POST /v1/connect             connect, e.g. {"server_tag": "germany"}
+  POST /v1/disconnect          disconnect
+  POST /v1/settings/<setting>  change the setting, e.g. /v1/settings/killswitch {"kill_switch": true}
+
+Settings which can be changed: autoconnect, dns, firewall, ipv6, killswitch, lan-discovery, obfuscate, protocol, routing, technology, threatprotectionlite.
+Requests and responses are the messages of the daemon gRPC API encoded as JSON. Responses carrying a failure code are returned with the HTTP status 422.
+
+Example: 'nordvpn set rest-api on'
+Example: 'nordvpn set rest-api on --address 127.0.0.1:9102 --new-token'
+Example: 'nordvpn set rest-api off'`
+)
+
+func (c *cmd) SetRESTAPI(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	flag, err := nstrings.BoolFromString(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetRESTAPI(context.Background(), &pb.SetRESTAPIRequest{
+		Enabled:  flag,
+		Address:  ctx.String(flagRESTAPIAddress),
+		NewToken: ctx.Bool(flagRESTAPINewToken),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(MsgRESTAPIInvalidAddress, ctx.String(flagRESTAPIAddress)))
+	case internal.CodeFailure:
+		return notAppliedError(resp)
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "REST API", nstrings.GetBoolLabel(flag)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "REST API", nstrings.GetBoolLabel(flag)))
+	}
+	if flag && len(resp.Data) > 1 {
+		color.Green(MsgRESTAPIServing, resp.Data[0], resp.Data[1])
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// routingTableAuto restores picking the first routing table not in use
+const routingTableAuto = "auto"
+
+// Set routing table help text
+const (
+	SetRoutingTableUsageText     = "Sets the routing table used for the VPN traffic"
+	SetRoutingTableArgsUsageText = `<table>|auto`
+	SetRoutingTableDescription   = `Use this command to set the routing table used for the VPN traffic, e.g. when the table picked by the app conflicts with your policy routing. By default the first table not in use starting with 205 is picked. Use together with 'nordvpn set fwmark' to move both the firewall mark and the routing table out of the way. The setting takes effect after restarting the daemon.
+Use 'auto' to restore picking the table.
+
+Example: 'nordvpn set routing-table 305'
+Example: 'nordvpn set routing-table auto'`
+)
+
+func (c *cmd) SetRoutingTable(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	var table uint64
+	if arg := ctx.Args().First(); !strings.EqualFold(arg, routingTableAuto) {
+		var err error
+		if table, err = strconv.ParseUint(arg, 10, 32); err != nil || table == 0 {
+			return formatError(fmt.Errorf(MsgRoutingTableInvalid, arg))
+		}
+	}
+
+	resp, err := c.client.SetRoutingTable(context.Background(), &pb.SetUint32Request{Value: uint32(table)})
+	if err != nil {
+		return formatError(err)
+	}
+
+	label := routingTableLabel(uint32(table))
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(MsgRoutingTableInvalid, ctx.Args().First()))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Routing table", label))
+	case internal.CodeSuccess:
+		color.Yellow("Restart daemon (e.g. `sudo systemctl restart nordvpnd` on systemd distros) for this setting to take an effect.")
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Routing table", label))
+	}
+	return nil
+}
+
+func routingTableLabel(table uint32) string {
+	if table == 0 {
+		return routingTableAuto
+	}
+	return strconv.FormatUint(uint64(table), 10)
+}
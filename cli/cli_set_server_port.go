@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagServerPortTechnology = "technology"
+	// serverPortDefault restores the port picked by the app
+	serverPortDefault = "default"
+)
+
+// Set server port help text
+const (
+	SetServerPortUsageText       = "Sets the port of the VPN server to connect to"
+	SetServerPortArgsUsageText   = `<port>|default`
+	SetServerPortTechnologyUsage = "Technology to set the port for: OPENVPN or NORDLYNX (default current technology)"
+	SetServerPortDescription     = `Use this command to pin the port of the VPN server, e.g. behind the firewalls which allow only some of the ports. The port is set separately for each technology and is used starting with the next connection. Connection fails if the server does not accept the connections on the port.
+Use 'default' to let the app pick the port again.
+
+Example: 'nordvpn set port 443'
+Example: 'nordvpn set port 53 --technology OPENVPN'
+Example: 'nordvpn set port default'`
+)
+
+func (c *cmd) SetServerPort(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	var port uint64
+	if arg := ctx.Args().First(); !strings.EqualFold(arg, serverPortDefault) {
+		var err error
+		if port, err = strconv.ParseUint(arg, 10, 16); err != nil || port == 0 {
+			return formatError(fmt.Errorf(MsgServerPortInvalid, arg))
+		}
+	}
+
+	var tech config.Technology
+	switch strings.ToUpper(ctx.String(flagServerPortTechnology)) {
+	case "":
+	case config.Technology_OPENVPN.String():
+		tech = config.Technology_OPENVPN
+	case config.Technology_NORDLYNX.String():
+		tech = config.Technology_NORDLYNX
+	default:
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetServerPort(context.Background(), &pb.SetServerPortRequest{
+		Technology: tech,
+		Port:       uint32(port),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	label := serverPortDefault
+	if port != 0 {
+		label = strconv.FormatUint(port, 10)
+	}
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(MsgServerPortInvalid, ctx.Args().First()))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, serverPortSetting(resp), label))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, serverPortSetting(resp), label))
+		if len(resp.Data) > 1 {
+			if connected, _ := strconv.ParseBool(resp.Data[1]); connected {
+				color.Yellow(SetReconnect)
+			}
+		}
+	}
+	return nil
+}
+
+// serverPortSetting names the setting after the technology the port was set for
+func serverPortSetting(resp *pb.Payload) string {
+	if len(resp.Data) == 0 {
+		return "Server port"
+	}
+	return resp.Data[0] + " server port"
+}
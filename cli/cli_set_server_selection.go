@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// serverSelectionPrefix is shared by the names of the pb.ServerSelection values
+const serverSelectionPrefix = "SERVER_SELECTION_"
+
+// Set server selection help text
+const (
+	SetServerSelectionUsageText     = "Sets how the server is picked when connecting"
+	SetServerSelectionArgsUsageText = `<strategy>`
+	SetServerSelectionDescription   = `Use this command to set how the server is picked when connecting to a country, city or group.
+Supported values for <strategy>:
+	load - the server recommended by NordVPN based on the distance and the load (default)
+	latency - the recommended servers are probed and the one with the lowest latency is picked
+	auto - the recommended servers are probed and the one with the best combination of latency and load is picked
+
+Example: 'nordvpn set selection auto'`
+)
+
+func (c *cmd) SetServerSelection(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	selection, ok := pb.ServerSelection_value[serverSelectionPrefix+strings.ToUpper(ctx.Args().First())]
+	if !ok {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetServerSelection(context.Background(), &pb.SetServerSelectionRequest{
+		Selection: pb.ServerSelection(selection),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	label := serverSelectionLabel(pb.ServerSelection(selection))
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Server selection", label))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Server selection", label))
+	}
+	return nil
+}
+
+func (c *cmd) SetServerSelectionAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	for i := 0; i < len(pb.ServerSelection_name); i++ {
+		fmt.Println(serverSelectionLabel(pb.ServerSelection(i)))
+	}
+}
+
+func serverSelectionLabel(selection pb.ServerSelection) string {
+	return strings.ToLower(strings.TrimPrefix(selection.String(), serverSelectionPrefix))
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set subnet overlap help text
+const (
+	SetSubnetOverlapUsageText     = "Sets how LAN subnets overlapping with the VPN subnets are handled when connecting"
+	SetSubnetOverlapArgsUsageText = `<mode>`
+	SetSubnetOverlapDescription   = `Use this command to set how LAN subnets, which overlap with the VPN or Meshnet subnets, are handled when connecting.
+Supported values for <mode>:
+	warn - the overlap is only reported (default)
+	prefer-lan - the overlapping addresses are reached through the LAN
+	prefer-vpn - the overlapping addresses are reached through the VPN
+
+Example: 'nordvpn set subnet-overlap prefer-lan'`
+)
+
+func (c *cmd) SetSubnetOverlap(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	mode, ok := pb.SubnetOverlapMode_value[strings.ToUpper(strings.ReplaceAll(ctx.Args().First(), "-", "_"))]
+	if !ok {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetSubnetOverlapMode(context.Background(), &pb.SetSubnetOverlapModeRequest{
+		Mode: pb.SubnetOverlapMode(mode),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	label := subnetOverlapModeLabel(pb.SubnetOverlapMode(mode))
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Subnet overlap", label))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Subnet overlap", label))
+	}
+	return nil
+}
+
+func (c *cmd) SetSubnetOverlapAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	for i := 0; i < len(pb.SubnetOverlapMode_name); i++ {
+		fmt.Println(subnetOverlapModeLabel(pb.SubnetOverlapMode(i)))
+	}
+}
+
+func subnetOverlapModeLabel(mode pb.SubnetOverlapMode) string {
+	return strings.ReplaceAll(strings.ToLower(mode.String()), "_", "-")
+}
@@ -19,11 +19,20 @@ const (
 	SetTechnologyUsageText     = "Sets the technology"
 	SetTechnologyArgsUsageText = `<technology>`
 	SetTechnologyDescription   = `Use this command to set the technology.
-Supported values for <technology>: OPENVPN or NORDLYNX.
+Supported values for <technology>: OPENVPN, NORDLYNX or AUTO.
+
+AUTO picks the technology for each network when connecting. NordLynx is used if
+it gets through the network, otherwise OpenVPN, which falls back to TCP and to
+the obfuscated servers when needed. The pick is remembered for the network once
+the servers of either technology replied, otherwise the current technology is
+used and the network is probed again on the next connection.
 
 Example: 'nordvpn set technology OPENVPN'`
 )
 
+// autoTechnologyLabel selects the automatic technology mode
+const autoTechnologyLabel = "AUTO"
+
 func (c *cmd) SetTechnology(ctx *cli.Context) error {
 	args := ctx.Args()
 
@@ -35,19 +44,19 @@ func (c *cmd) SetTechnology(ctx *cli.Context) error {
 		return formatError(argsParseError(ctx))
 	}
 
-	var tech config.Technology
+	var req pb.SetTechnologyRequest
 	switch strings.ToUpper(args.First()) {
 	case config.Technology_OPENVPN.String():
-		tech = config.Technology_OPENVPN
+		req.Technology = config.Technology_OPENVPN
 	case config.Technology_NORDLYNX.String():
-		tech = config.Technology_NORDLYNX
+		req.Technology = config.Technology_NORDLYNX
+	case autoTechnologyLabel:
+		req.Auto = true
 	default:
 		return formatError(argsParseError(ctx))
 	}
 
-	resp, err := c.client.SetTechnology(context.Background(), &pb.SetTechnologyRequest{
-		Technology: tech,
-	})
+	resp, err := c.client.SetTechnology(context.Background(), &req)
 	if err != nil {
 		return formatError(err)
 	}
@@ -59,6 +68,8 @@ func (c *cmd) SetTechnology(ctx *cli.Context) error {
 		return formatError(argsParseError(ctx))
 	case internal.CodeDependencyError:
 		return formatError(fmt.Errorf(SetTechnologyDepsError, internal.StringsToInterfaces(resp.Data)...))
+	case internal.CodeTechnologyNotAllowed:
+		return formatError(fmt.Errorf(MsgSetTechnologyNotAllowed, internal.StringsToInterfaces(resp.Data)...))
 	case internal.CodeNothingToDo:
 		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Technology", strings.Join(resp.Data, " ")))
 	case internal.CodeSuccessWithoutAC:
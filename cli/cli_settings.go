@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/client"
 	"github.com/NordSecurity/nordvpn-linux/config"
@@ -25,6 +26,126 @@ type PortRange struct {
 	start     int64
 	end       int64
 	protocols []string
+	// expiry of the temporary ports, empty for the permanent ones
+	expiry string
+}
+
+// settingsJSON is a JSON representation of the settings
+type settingsJSON struct {
+	Technology                 string                `json:"technology"`
+	AutoTechnology             bool                  `json:"auto_technology"`
+	AllowedTechnologies        []string              `json:"allowed_technologies"`
+	Protocol                   string                `json:"protocol,omitempty"`
+	Firewall                   bool                  `json:"firewall"`
+	FirewallMark               uint32                `json:"firewall_mark"`
+	RoutingTable               string                `json:"routing_table"`
+	FirewallBackend            string                `json:"firewall_backend"`
+	Routing                    bool                  `json:"routing"`
+	Analytics                  bool                  `json:"analytics"`
+	KillSwitch                 bool                  `json:"kill_switch"`
+	KillSwitchLog              bool                  `json:"kill_switch_log"`
+	KillSwitchLAN              string                `json:"kill_switch_lan"`
+	MulticastDiscovery         bool                  `json:"multicast_discovery"`
+	ThreatProtectionLite       bool                  `json:"threat_protection_lite"`
+	Obfuscate                  bool                  `json:"obfuscate"`
+	ObfuscationMode            string                `json:"obfuscation_mode"`
+	InterfaceName              string                `json:"interface_name"`
+	MTU                        string                `json:"mtu"`
+	Notify                     bool                  `json:"notify"`
+	AutoConnect                bool                  `json:"auto_connect"`
+	IPv6                       bool                  `json:"ipv6"`
+	IPv6Mode                   string                `json:"ipv6_mode"`
+	Meshnet                    bool                  `json:"meshnet"`
+	DNS                        []string              `json:"dns"`
+	DNSIPv6                    bool                  `json:"dns_ipv6"`
+	DNSSearchDomains           []string              `json:"dns_search_domains"`
+	DNSFilter                  []string              `json:"dns_filter"`
+	DNSRoutes                  []dnsRouteJSON        `json:"dns_routes"`
+	LegacyDNS                  bool                  `json:"legacy_dns"`
+	LANDiscovery               bool                  `json:"lan_discovery"`
+	DefaultRoute               string                `json:"default_route"`
+	SubnetOverlap              string                `json:"subnet_overlap"`
+	LogLevel                   string                `json:"log_level"`
+	ServerSelection            string                `json:"server_selection"`
+	VirtualLocation            bool                  `json:"virtual_location"`
+	DNSMonitor                 bool                  `json:"dns_monitor"`
+	NetworkManager             bool                  `json:"network_manager"`
+	OnDemand                   bool                  `json:"on_demand"`
+	OnDemandIdleTimeoutSeconds uint32                `json:"on_demand_idle_timeout_seconds"`
+	IdleTimeoutSeconds         uint32                `json:"idle_timeout_seconds"`
+	IdleTimeoutKillSwitch      bool                  `json:"idle_timeout_kill_switch"`
+	Metered                    meteredJSON           `json:"metered"`
+	Reconnect                  reconnectJSON         `json:"reconnect"`
+	CaptivePortalNetworks      []string              `json:"captive_portal_networks"`
+	TCPNetworks                []string              `json:"tcp_networks"`
+	ObfuscatedNetworks         []string              `json:"obfuscated_networks"`
+	TrustedNetworks            []string              `json:"trusted_networks"`
+	SplitTunnel                splitTunnelJSON       `json:"split_tunnel"`
+	Metrics                    metricsJSON           `json:"metrics"`
+	RESTAPI                    restAPIJSON           `json:"rest_api"`
+	LocalProxy                 localProxyJSON        `json:"local_proxy"`
+	ServerPorts                serverPortsJSON       `json:"server_ports"`
+	AutoConnectRules           []autoConnectRuleJSON `json:"autoconnect_rules"`
+	RatingWeight               uint32                `json:"rating_weight"`
+	FirewallTemplates          firewallTemplatesJSON `json:"firewall_templates"`
+	Allowlist                  allowlistJSON         `json:"allowlist"`
+}
+
+type meteredJSON struct {
+	Enabled          bool     `json:"enabled"`
+	AllowRefresh     bool     `json:"allow_refresh"`
+	AllowAutoconnect bool     `json:"allow_autoconnect"`
+	Networks         []string `json:"networks"`
+}
+
+type reconnectJSON struct {
+	MaxRetries    uint32 `json:"max_retries"`
+	BackoffMillis uint32 `json:"backoff_ms"`
+	JitterMillis  uint32 `json:"jitter_ms"`
+	Aggressive    bool   `json:"aggressive"`
+}
+
+type metricsJSON struct {
+	Enabled bool   `json:"enabled"`
+	Address string `json:"address"`
+}
+
+type restAPIJSON struct {
+	Enabled bool   `json:"enabled"`
+	Address string `json:"address"`
+}
+
+type localProxyJSON struct {
+	Enabled bool   `json:"enabled"`
+	Port    uint32 `json:"port"`
+}
+
+// serverPortsJSON lists the pinned ports, zero means that the port is picked
+// by the app
+type serverPortsJSON struct {
+	OpenVPN  uint32 `json:"openvpn"`
+	NordLynx uint32 `json:"nordlynx"`
+}
+
+type dnsRouteJSON struct {
+	Domain     string `json:"domain"`
+	Nameserver string `json:"nameserver"`
+	ViaTunnel  bool   `json:"via_tunnel"`
+}
+
+type firewallTemplatesJSON struct {
+	Persistent     string `json:"persistent,omitempty"`
+	PreConnect     string `json:"pre_connect,omitempty"`
+	PostDisconnect string `json:"post_disconnect,omitempty"`
+}
+
+type allowlistJSON struct {
+	TCPPorts []int64  `json:"tcp_ports"`
+	UDPPorts []int64  `json:"udp_ports"`
+	Subnets  []string `json:"subnets"`
+	Domains  []string `json:"domains"`
+	// ExpiresAt maps the temporary entries to the unix time they are removed at
+	ExpiresAt map[string]int64 `json:"expires_at,omitempty"`
 }
 
 func (c *cmd) Settings(ctx *cli.Context) error {
@@ -33,34 +154,244 @@ func (c *cmd) Settings(ctx *cli.Context) error {
 		return formatError(err)
 	}
 
-	fmt.Printf("Technology: %s\n", settings.GetTechnology())
+	if jsonOutput(ctx) {
+		return printJSON(toSettingsJSON(settings))
+	}
+
+	fmt.Printf("Technology: %s\n", technologyLabel(settings))
+	if len(settings.AllowedTechnologies) > 0 {
+		fmt.Printf("Allowed Technologies: %s\n", formatAllowedTechnologies(settings.AllowedTechnologies))
+	}
 	if settings.Technology == config.Technology_OPENVPN {
 		fmt.Printf("Protocol: %s\n", settings.GetProtocol())
 	}
+	if port := serverPort(settings); port != 0 {
+		fmt.Printf("Server Port: %d\n", port)
+	}
 	fmt.Printf("Firewall: %+v\n", nstrings.GetBoolLabel(settings.GetFirewall()))
 	fmt.Printf("Firewall Mark: 0x%x\n", settings.GetFwmark())
+	fmt.Printf("Routing Table: %s\n", routingTableLabel(settings.GetRoutingTable()))
 	fmt.Printf("Routing: %+v\n", nstrings.GetBoolLabel(settings.GetRouting()))
 	fmt.Printf("Analytics: %+v\n", nstrings.GetBoolLabel(settings.GetAnalytics()))
 	fmt.Printf("Kill Switch: %+v\n", nstrings.GetBoolLabel(settings.GetKillSwitch()))
+	fmt.Printf("Kill Switch Logging: %+v\n", nstrings.GetBoolLabel(settings.KillSwitchLog))
+	fmt.Printf("Kill Switch LAN: %s\n", killSwitchLANLabel(settings.GetKillSwitchLan()))
+	fmt.Printf("Multicast Discovery: %+v\n", nstrings.GetBoolLabel(settings.GetMulticastDiscovery()))
 	fmt.Printf("Threat Protection Lite: %+v\n", nstrings.GetBoolLabel(settings.ThreatProtectionLite))
 	if settings.Technology == config.Technology_OPENVPN {
 		fmt.Printf("Obfuscate: %+v\n", nstrings.GetBoolLabel(settings.GetObfuscate()))
+		if settings.GetObfuscate() {
+			fmt.Printf("Obfuscation Mode: %+v\n", obfuscationModeLabel(settings.GetObfuscationMode()))
+		}
+	}
+	if settings.GetInterfaceName() != "" {
+		fmt.Printf("Interface Name: %s\n", settings.GetInterfaceName())
 	}
+	fmt.Printf("MTU: %s\n", mtuLabel(settings.GetMtu()))
 	fmt.Printf("Notify: %+v\n", nstrings.GetBoolLabel(settings.Notify))
 	fmt.Printf("Auto-connect: %+v\n", nstrings.GetBoolLabel(settings.AutoConnect))
-	fmt.Printf("IPv6: %+v\n", nstrings.GetBoolLabel(settings.Ipv6))
+	fmt.Printf("IPv6: %+v\n", ipv6ModeLabel(settings.GetIpv6Mode()))
 	fmt.Printf("Meshnet: %+v\n", nstrings.GetBoolLabel(settings.Meshnet))
 	if len(settings.Dns) == 0 {
 		fmt.Printf("DNS: %+v\n", nstrings.GetBoolLabel(false))
 	} else {
 		fmt.Printf("DNS: %+v\n", strings.Join(settings.Dns, ", "))
 	}
+	fmt.Printf("IPv6 DNS: %+v\n", nstrings.GetBoolLabel(settings.DnsIpv6))
+	if len(settings.DnsSearchDomains) > 0 {
+		fmt.Printf("DNS Search Domains: %+v\n", strings.Join(settings.DnsSearchDomains, ", "))
+	}
+	if len(settings.DnsFilter) > 0 {
+		fmt.Printf("DNS Filter: %+v\n", strings.Join(settings.DnsFilter, ", "))
+	}
+	if len(settings.DnsRoutes) > 0 {
+		fmt.Println("DNS Routes:")
+		for _, route := range settings.DnsRoutes {
+			fmt.Printf("\t%s: %s %s\n", route.Domain, route.Nameserver, dnsRouteVia(route.ViaTunnel))
+		}
+	}
+	fmt.Printf("Legacy DNS: %+v\n", nstrings.GetBoolLabel(settings.GetLegacyDns()))
+	fmt.Printf("DNS Monitor: %+v\n", nstrings.GetBoolLabel(settings.GetDnsMonitor()))
+	fmt.Printf("NetworkManager: %+v\n", nstrings.GetBoolLabel(settings.GetNetworkManager()))
 	fmt.Printf("LAN Discovery: %+v\n", nstrings.GetBoolLabel(settings.LanDiscovery))
+	fmt.Printf("Default Route: %+v\n", strings.ToLower(settings.DefaultRouteMode.String()))
+	fmt.Printf("Firewall Backend: %+v\n", strings.ToLower(settings.FirewallBackend.String()))
+	fmt.Printf("Subnet Overlap: %+v\n", subnetOverlapModeLabel(settings.SubnetOverlapMode))
+	fmt.Printf("Log Level: %+v\n", logLevelLabel(settings.LogLevel))
+	fmt.Printf("Server Selection: %+v\n", serverSelectionLabel(settings.ServerSelection))
+	fmt.Printf("Virtual Location: %+v\n", nstrings.GetBoolLabel(settings.GetVirtualLocation()))
+	fmt.Printf("On-demand: %+v\n", nstrings.GetBoolLabel(settings.OnDemand))
+	if settings.OnDemand {
+		fmt.Printf("On-demand Idle Timeout: %s\n", time.Duration(settings.OnDemandIdleTimeout)*time.Second)
+	}
+	if settings.IdleTimeout == 0 {
+		fmt.Printf("Idle Timeout: %+v\n", nstrings.GetBoolLabel(false))
+	} else {
+		fmt.Printf("Idle Timeout: %s\n", time.Duration(settings.IdleTimeout)*time.Second)
+		fmt.Printf("Kill Switch After Idle Timeout: %+v\n", nstrings.GetBoolLabel(settings.IdleTimeoutKillSwitch))
+	}
+	displayReconnectPolicy(settings.GetReconnect())
+	fmt.Printf("Metered Policy: %+v\n", nstrings.GetBoolLabel(settings.Metered.GetEnabled()))
+	if settings.Metered.GetEnabled() {
+		fmt.Printf("Refresh On Metered Networks: %+v\n", nstrings.GetBoolLabel(settings.Metered.GetAllowRefresh()))
+		fmt.Printf("Auto-connect On Metered Networks: %+v\n", nstrings.GetBoolLabel(settings.Metered.GetAllowAutoconnect()))
+	}
+	if len(settings.Metered.GetNetworks()) > 0 {
+		fmt.Printf("Metered Networks: %+v\n", strings.Join(settings.Metered.GetNetworks(), ", "))
+	}
+	if len(settings.CaptivePortalNetworks) > 0 {
+		fmt.Printf("Captive Portal Networks: %+v\n", strings.Join(settings.CaptivePortalNetworks, ", "))
+	}
+	if len(settings.GetTcpNetworks()) > 0 {
+		fmt.Printf("TCP Networks: %+v\n", strings.Join(settings.GetTcpNetworks(), ", "))
+	}
+	if len(settings.GetObfuscatedNetworks()) > 0 {
+		fmt.Printf("Obfuscated Networks: %+v\n", strings.Join(settings.GetObfuscatedNetworks(), ", "))
+	}
+	if len(settings.GetTrustedNetworks()) > 0 {
+		fmt.Printf("Trusted Networks: %+v\n", strings.Join(settings.GetTrustedNetworks(), ", "))
+	}
+	if len(settings.GetSplitTunnel().GetApps()) > 0 {
+		fmt.Printf("Split Tunnel Mode: %+v\n", splitTunnelModeLabel(settings.GetSplitTunnel().GetMode()))
+		fmt.Printf("Split Tunnel Apps: %+v\n", strings.Join(settings.GetSplitTunnel().GetApps(), ", "))
+	}
+	fmt.Printf("Metrics: %+v\n", nstrings.GetBoolLabel(settings.GetMetrics().GetEnabled()))
+	if settings.GetMetrics().GetEnabled() {
+		fmt.Printf("Metrics Address: %+v\n", settings.GetMetrics().GetAddress())
+	}
+	fmt.Printf("REST API: %+v\n", nstrings.GetBoolLabel(settings.GetRestApi().GetEnabled()))
+	if settings.GetRestApi().GetEnabled() {
+		fmt.Printf("REST API Address: %+v\n", settings.GetRestApi().GetAddress())
+	}
+	fmt.Printf("Local Proxy: %+v\n", nstrings.GetBoolLabel(settings.GetLocalProxy().GetEnabled()))
+	if settings.GetLocalProxy().GetEnabled() {
+		fmt.Printf("Local Proxy Port: %d\n", settings.GetLocalProxy().GetPort())
+	}
+	for i, rule := range toAutoConnectRulesJSON(settings.GetAutoconnectRules()) {
+		fmt.Printf("Auto-connect Rule %d: %s\n", i+1, rule)
+	}
+	fmt.Printf("Rating Weight: %s\n", formatRatingWeight(settings.RatingWeight))
+	displayFirewallTemplates(settings.FirewallTemplates)
 
 	displayAllowlist(settings.Allowlist)
 	return nil
 }
 
+// technologyLabel returns the technology, the one last picked in the automatic
+// mode
+func technologyLabel(settings *pb.Settings) string {
+	if settings.GetAutoTechnology() {
+		return fmt.Sprintf("%s (%s)", autoTechnologyLabel, settings.GetTechnology())
+	}
+	return settings.GetTechnology().String()
+}
+
+func toSettingsJSON(settings *pb.Settings) settingsJSON {
+	out := settingsJSON{
+		Technology:                 settings.GetTechnology().String(),
+		AutoTechnology:             settings.GetAutoTechnology(),
+		AllowedTechnologies:        []string{},
+		Firewall:                   settings.GetFirewall(),
+		FirewallMark:               settings.GetFwmark(),
+		RoutingTable:               routingTableLabel(settings.GetRoutingTable()),
+		FirewallBackend:            strings.ToLower(settings.GetFirewallBackend().String()),
+		Routing:                    settings.GetRouting(),
+		Analytics:                  settings.GetAnalytics(),
+		KillSwitch:                 settings.GetKillSwitch(),
+		KillSwitchLog:              settings.GetKillSwitchLog(),
+		KillSwitchLAN:              killSwitchLANLabel(settings.GetKillSwitchLan()),
+		MulticastDiscovery:         settings.GetMulticastDiscovery(),
+		ThreatProtectionLite:       settings.GetThreatProtectionLite(),
+		Obfuscate:                  settings.GetObfuscate(),
+		ObfuscationMode:            obfuscationModeLabel(settings.GetObfuscationMode()),
+		InterfaceName:              interfaceNameLabel(settings.GetInterfaceName()),
+		MTU:                        mtuLabel(settings.GetMtu()),
+		Notify:                     settings.GetNotify(),
+		AutoConnect:                settings.GetAutoConnect(),
+		IPv6:                       settings.GetIpv6(),
+		IPv6Mode:                   ipv6ModeLabel(settings.GetIpv6Mode()),
+		Meshnet:                    settings.GetMeshnet(),
+		DNS:                        nonNilStrings(settings.GetDns()),
+		DNSIPv6:                    settings.GetDnsIpv6(),
+		DNSSearchDomains:           nonNilStrings(settings.GetDnsSearchDomains()),
+		DNSFilter:                  nonNilStrings(settings.GetDnsFilter()),
+		DNSRoutes:                  toDNSRoutesJSON(settings.GetDnsRoutes()),
+		LegacyDNS:                  settings.GetLegacyDns(),
+		LANDiscovery:               settings.GetLanDiscovery(),
+		DefaultRoute:               strings.ToLower(settings.GetDefaultRouteMode().String()),
+		SubnetOverlap:              subnetOverlapModeLabel(settings.GetSubnetOverlapMode()),
+		LogLevel:                   logLevelLabel(settings.GetLogLevel()),
+		ServerSelection:            serverSelectionLabel(settings.GetServerSelection()),
+		VirtualLocation:            settings.GetVirtualLocation(),
+		DNSMonitor:                 settings.GetDnsMonitor(),
+		NetworkManager:             settings.GetNetworkManager(),
+		OnDemand:                   settings.GetOnDemand(),
+		OnDemandIdleTimeoutSeconds: settings.GetOnDemandIdleTimeout(),
+		IdleTimeoutSeconds:         settings.GetIdleTimeout(),
+		IdleTimeoutKillSwitch:      settings.GetIdleTimeoutKillSwitch(),
+		Metered: meteredJSON{
+			Enabled:          settings.GetMetered().GetEnabled(),
+			AllowRefresh:     settings.GetMetered().GetAllowRefresh(),
+			AllowAutoconnect: settings.GetMetered().GetAllowAutoconnect(),
+			Networks:         nonNilStrings(settings.GetMetered().GetNetworks()),
+		},
+		Reconnect: reconnectJSON{
+			MaxRetries:    settings.GetReconnect().GetMaxRetries(),
+			BackoffMillis: settings.GetReconnect().GetBackoff(),
+			JitterMillis:  settings.GetReconnect().GetJitter(),
+			Aggressive:    settings.GetReconnect().GetAggressive(),
+		},
+		CaptivePortalNetworks: nonNilStrings(settings.GetCaptivePortalNetworks()),
+		TCPNetworks:           nonNilStrings(settings.GetTcpNetworks()),
+		ObfuscatedNetworks:    nonNilStrings(settings.GetObfuscatedNetworks()),
+		TrustedNetworks:       nonNilStrings(settings.GetTrustedNetworks()),
+		SplitTunnel:           toSplitTunnelJSON(settings.GetSplitTunnel()),
+		Metrics: metricsJSON{
+			Enabled: settings.GetMetrics().GetEnabled(),
+			Address: settings.GetMetrics().GetAddress(),
+		},
+		RESTAPI: restAPIJSON{
+			Enabled: settings.GetRestApi().GetEnabled(),
+			Address: settings.GetRestApi().GetAddress(),
+		},
+		LocalProxy: localProxyJSON{
+			Enabled: settings.GetLocalProxy().GetEnabled(),
+			Port:    settings.GetLocalProxy().GetPort(),
+		},
+		ServerPorts: serverPortsJSON{
+			OpenVPN:  settings.GetServerPorts().GetOpenvpn(),
+			NordLynx: settings.GetServerPorts().GetNordlynx(),
+		},
+		AutoConnectRules: toAutoConnectRulesJSON(settings.GetAutoconnectRules()),
+		RatingWeight:     settings.GetRatingWeight(),
+		FirewallTemplates: firewallTemplatesJSON{
+			Persistent:     settings.GetFirewallTemplates().GetPersistent(),
+			PreConnect:     settings.GetFirewallTemplates().GetPreConnect(),
+			PostDisconnect: settings.GetFirewallTemplates().GetPostDisconnect(),
+		},
+		Allowlist: allowlistJSON{
+			TCPPorts:  settings.GetAllowlist().GetPorts().GetTcp(),
+			UDPPorts:  settings.GetAllowlist().GetPorts().GetUdp(),
+			Subnets:   nonNilStrings(settings.GetAllowlist().GetSubnets()),
+			Domains:   nonNilStrings(settings.GetAllowlist().GetDomains()),
+			ExpiresAt: settings.GetAllowlist().GetExpiresAt(),
+		},
+	}
+	for _, tech := range settings.GetAllowedTechnologies() {
+		out.AllowedTechnologies = append(out.AllowedTechnologies, tech.String())
+	}
+	if settings.GetTechnology() == config.Technology_OPENVPN {
+		out.Protocol = settings.GetProtocol().String()
+	}
+	if out.Allowlist.TCPPorts == nil {
+		out.Allowlist.TCPPorts = []int64{}
+	}
+	if out.Allowlist.UDPPorts == nil {
+		out.Allowlist.UDPPorts = []int64{}
+	}
+	return out
+}
+
 func (c *cmd) getSettings() (*pb.Settings, error) {
 	resp, err := c.client.Settings(context.Background(), &pb.SettingsRequest{
 		Uid: int64(os.Getuid()),
@@ -80,6 +411,7 @@ func (c *cmd) getSettings() (*pb.Settings, error) {
 
 func displayAllowlist(allowlist *pb.Allowlist) {
 	if allowlist != nil {
+		now := time.Now()
 		udpPorts := allowlist.GetPorts().GetUdp()
 		tcpPorts := allowlist.GetPorts().GetTcp()
 		if len(udpPorts)+len(tcpPorts) > 0 {
@@ -91,23 +423,34 @@ func displayAllowlist(allowlist *pb.Allowlist) {
 			for _, port := range allPorts {
 				//find current iteration's protocols
 				var protos []string
+				var keys []string
 				if index := slices.Index(udpPorts, port); index != -1 {
 					protos = append(protos, "UDP")
+					keys = append(keys, config.PortKey("udp", port))
 				}
 				if index := slices.Index(tcpPorts, port); index != -1 {
 					protos = append(protos, "TCP")
+					keys = append(keys, config.PortKey("tcp", port))
 				}
+				expiry := allowlistExpiry(allowlist, now, keys...)
 
 				var lastProtos []string
 				var lastEndPort int64
+				var lastExpiry string
 				if len(allowlistedRanges) > 0 {
 					last := allowlistedRanges[len(allowlistedRanges)-1]
 					lastProtos = last.protocols
 					lastEndPort = last.end
+					lastExpiry = last.expiry
 				}
 				//check if the range allowlist range continues or should we be starting a new one
-				if !slices.Equal(protos, lastProtos) || client.InterfaceToInt64(port)-lastEndPort > 1 {
-					allowlistedRanges = append(allowlistedRanges, PortRange{start: client.InterfaceToInt64(port), protocols: protos})
+				if !slices.Equal(protos, lastProtos) || client.InterfaceToInt64(port)-lastEndPort > 1 ||
+					expiry != lastExpiry {
+					allowlistedRanges = append(allowlistedRanges, PortRange{
+						start:     client.InterfaceToInt64(port),
+						protocols: protos,
+						expiry:    expiry,
+					})
 				}
 				//populate the range
 				allowlistedRanges[len(allowlistedRanges)-1].end = client.InterfaceToInt64(port)
@@ -117,9 +460,9 @@ func displayAllowlist(allowlist *pb.Allowlist) {
 			for _, wlRange := range allowlistedRanges {
 				protoString := strings.Join(wlRange.protocols, "|")
 				if wlRange.start == wlRange.end {
-					fmt.Printf("  %*d (%s)\n", maxLength*2+3, wlRange.start, protoString)
+					fmt.Printf("  %*d (%s)%s\n", maxLength*2+3, wlRange.start, protoString, wlRange.expiry)
 				} else {
-					fmt.Printf("  %*d - %*d (%s)\n", maxLength, wlRange.start, maxLength, wlRange.end, protoString)
+					fmt.Printf("  %*d - %*d (%s)%s\n", maxLength, wlRange.start, maxLength, wlRange.end, protoString, wlRange.expiry)
 				}
 			}
 		}
@@ -127,8 +470,35 @@ func displayAllowlist(allowlist *pb.Allowlist) {
 		if len(subnets) > 0 {
 			fmt.Printf("Allowlisted subnets:\n")
 			for _, subnet := range subnets {
-				fmt.Printf("\t%s\n", subnet)
+				fmt.Printf("\t%s%s\n", subnet, allowlistExpiry(allowlist, now, config.SubnetKey(subnet)))
 			}
 		}
+		domains := allowlist.GetDomains()
+		if len(domains) > 0 {
+			fmt.Printf("Allowlisted domains:\n")
+			for _, domain := range domains {
+				fmt.Printf("\t%s%s\n", domain, allowlistExpiry(allowlist, now, config.DomainKey(domain)))
+			}
+		}
+	}
+}
+
+// serverPort returns the port pinned for the current technology
+func serverPort(settings *pb.Settings) uint32 {
+	if settings.GetTechnology() == config.Technology_OPENVPN {
+		return settings.GetServerPorts().GetOpenvpn()
+	}
+	return settings.GetServerPorts().GetNordlynx()
+}
+
+func toDNSRoutesJSON(routes []*pb.DNSRoute) []dnsRouteJSON {
+	ret := []dnsRouteJSON{}
+	for _, route := range routes {
+		ret = append(ret, dnsRouteJSON{
+			Domain:     route.GetDomain(),
+			Nameserver: route.GetNameserver(),
+			ViaTunnel:  route.GetViaTunnel(),
+		})
 	}
+	return ret
 }
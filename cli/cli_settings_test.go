@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToSettingsJSON(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	settings := &pb.Settings{
+		Technology:        config.Technology_NORDLYNX,
+		Protocol:          config.Protocol_TCP,
+		KillSwitch:        true,
+		Fwmark:            0xe1f1,
+		Dns:               []string{"1.1.1.1"},
+		FirewallBackend:   pb.FirewallBackend_NFTABLES,
+		SubnetOverlapMode: pb.SubnetOverlapMode_PREFER_LAN,
+		LogLevel:          pb.LogLevel_LOG_LEVEL_DEBUG,
+		ServerSelection:   pb.ServerSelection_SERVER_SELECTION_AUTO,
+		ObfuscationMode:   pb.ObfuscationMode_OBFUSCATION_MODE_WEBSOCKET,
+		InterfaceName:     "corpvpn0",
+		Mtu:               1380,
+		RoutingTable:      305,
+		LegacyDns:         true,
+		Allowlist: &pb.Allowlist{
+			Ports: &pb.Ports{Tcp: []int64{22}},
+		},
+	}
+
+	out := toSettingsJSON(settings)
+	assert.Equal(t, "NORDLYNX", out.Technology)
+	// protocol applies to OpenVPN only
+	assert.Empty(t, out.Protocol)
+	assert.True(t, out.KillSwitch)
+	assert.Equal(t, uint32(0xe1f1), out.FirewallMark)
+	assert.Equal(t, "nftables", out.FirewallBackend)
+	assert.Equal(t, "prefer-lan", out.SubnetOverlap)
+	assert.Equal(t, "debug", out.LogLevel)
+	assert.Equal(t, "auto", out.ServerSelection)
+	assert.Equal(t, "websocket", out.ObfuscationMode)
+	assert.Equal(t, "corpvpn0", out.InterfaceName)
+	assert.Equal(t, "1380", out.MTU)
+	assert.Equal(t, "305", out.RoutingTable)
+	assert.True(t, out.LegacyDNS)
+	assert.Equal(t, []int64{22}, out.Allowlist.TCPPorts)
+	assert.Equal(t, splitTunnelJSON{Mode: "exclude", Apps: []string{}}, out.SplitTunnel)
+
+	data, err := json.Marshal(out)
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	// empty lists are arrays, so that scripts do not have to handle null
+	assert.Equal(t, []any{}, decoded["dns_search_domains"])
+	assert.Equal(t, []any{}, decoded["dns_routes"])
+	assert.Equal(t, []any{}, decoded["allowed_technologies"])
+	assert.Equal(t, []any{}, decoded["allowlist"].(map[string]any)["udp_ports"])
+
+	settings.SplitTunnel = &pb.SplitTunnel{Mode: pb.SplitTunnelMode_SPLIT_TUNNEL_INCLUDE, Apps: []string{"firefox"}}
+	assert.Equal(t, splitTunnelJSON{Mode: "include", Apps: []string{"firefox"}}, toSettingsJSON(settings).SplitTunnel)
+
+	settings.Technology = config.Technology_OPENVPN
+	assert.Equal(t, "TCP", toSettingsJSON(settings).Protocol)
+}
@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Split tunnel help text
+const (
+	SplitTunnelUsageText      = "Routes the traffic of the selected applications differently from the rest of the system"
+	SplitTunnelAddUsageText   = "Adds the application to the split tunnel"
+	SplitTunnelAddDescription = `Use this command to route the traffic of the application outside of the VPN, or only the traffic of the added applications through the VPN in include mode. The application is either a binary name, matching the binary anywhere on the system, or an absolute path of the binary. Processes of the application are picked up within a few seconds after they start, processes started by them follow the same route. Split tunneling requires cgroup v2 and takes effect while connected to VPN.
+
+Example: 'nordvpn split-tunnel add firefox'
+Example: 'nordvpn split-tunnel add /usr/bin/curl'`
+	SplitTunnelRemoveUsageText   = "Removes the application from the split tunnel"
+	SplitTunnelRemoveDescription = `Use this command to remove the application added with 'nordvpn split-tunnel add'.
+
+Example: 'nordvpn split-tunnel remove firefox'`
+	SplitTunnelAppArgsUsage      = "<app>"
+	SplitTunnelModeUsageText     = "Sets whether the split tunnel applications bypass the VPN or are the only ones using it"
+	SplitTunnelModeArgsUsageText = "<mode>"
+	SplitTunnelModeDescription   = `Use this command to set how the traffic of the split tunnel applications is routed while connected to VPN.
+Supported values for <mode>:
+	exclude - the applications bypass the VPN (default)
+	include - only the applications use the VPN, the rest of the traffic bypasses it
+
+Example: 'nordvpn split-tunnel mode include'`
+	SplitTunnelListUsageText = "Shows the split tunnel mode and applications"
+)
+
+// SplitTunnelAdd adds the application to the split tunnel
+func (c *cmd) SplitTunnelAdd(ctx *cli.Context) error {
+	return c.setSplitTunnelApp(ctx, true)
+}
+
+// SplitTunnelRemove removes the application from the split tunnel
+func (c *cmd) SplitTunnelRemove(ctx *cli.Context) error {
+	return c.setSplitTunnelApp(ctx, false)
+}
+
+func (c *cmd) setSplitTunnelApp(ctx *cli.Context, enabled bool) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	app := ctx.Args().First()
+	resp, err := c.client.SetSplitTunnelApp(context.Background(), &pb.SetSplitTunnelAppRequest{
+		App:     app,
+		Enabled: enabled,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeFailure:
+		return notAppliedError(resp)
+	case internal.CodeNothingToDo:
+		if enabled {
+			color.Yellow(MsgSplitTunnelAppAlreadyAdded, app)
+		} else {
+			color.Yellow(MsgSplitTunnelAppNotAdded, app)
+		}
+	case internal.CodeSuccess:
+		if enabled {
+			color.Green(MsgSplitTunnelAppAdded, app)
+		} else {
+			color.Green(MsgSplitTunnelAppRemoved, app)
+		}
+	}
+	return nil
+}
+
+// SplitTunnelMode sets how the traffic of the split tunnel applications is routed
+func (c *cmd) SplitTunnelMode(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	mode, ok := pb.SplitTunnelMode_value["SPLIT_TUNNEL_"+strings.ToUpper(ctx.Args().First())]
+	if !ok {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetSplitTunnelMode(context.Background(), &pb.SetSplitTunnelModeRequest{
+		Mode: pb.SplitTunnelMode(mode),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	label := splitTunnelModeLabel(pb.SplitTunnelMode(mode))
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFailure:
+		return notAppliedError(resp)
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Split tunnel mode", label))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Split tunnel mode", label))
+	}
+	return nil
+}
+
+func (c *cmd) SplitTunnelModeAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	for i := 0; i < len(pb.SplitTunnelMode_name); i++ {
+		fmt.Println(splitTunnelModeLabel(pb.SplitTunnelMode(i)))
+	}
+}
+
+// SplitTunnelList shows the split tunnel mode and applications
+func (c *cmd) SplitTunnelList(ctx *cli.Context) error {
+	settings, err := c.getSettings()
+	if err != nil {
+		return formatError(err)
+	}
+
+	splitTunnel := toSplitTunnelJSON(settings.GetSplitTunnel())
+	if jsonOutput(ctx) {
+		return printJSON(splitTunnel)
+	}
+
+	fmt.Printf("Split Tunnel Mode: %s\n", splitTunnel.Mode)
+	if len(splitTunnel.Apps) == 0 {
+		color.Yellow(MsgSplitTunnelEmpty)
+		return nil
+	}
+	fmt.Printf("Split Tunnel Apps: %s\n", strings.Join(splitTunnel.Apps, ", "))
+	return nil
+}
+
+type splitTunnelJSON struct {
+	Mode string   `json:"mode"`
+	Apps []string `json:"apps"`
+}
+
+func toSplitTunnelJSON(splitTunnel *pb.SplitTunnel) splitTunnelJSON {
+	return splitTunnelJSON{
+		Mode: splitTunnelModeLabel(splitTunnel.GetMode()),
+		Apps: nonNilStrings(splitTunnel.GetApps()),
+	}
+}
+
+func splitTunnelModeLabel(mode pb.SplitTunnelMode) string {
+	return strings.ToLower(strings.TrimPrefix(mode.String(), "SPLIT_TUNNEL_"))
+}
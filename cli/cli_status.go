@@ -2,28 +2,125 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"os/signal"
 	"strings"
 	"time"
 
+	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
 
 	"github.com/hako/durafmt"
 	"github.com/urfave/cli/v2"
 )
 
-// StatusUsageText is shown next to status command by nordvpn --help
-const StatusUsageText = "Shows connection status"
+const (
+	flagStatusVerbose = "verbose"
+	flagStatusJSON    = "json"
+	flagStatusWatch   = "watch"
+	// clearScreen moves the cursor to the top left corner and clears the
+	// terminal
+	clearScreen = "\033[H\033[2J"
+)
+
+// Status help text
+const (
+	// StatusUsageText is shown next to status command by nordvpn --help
+	StatusUsageText    = "Shows connection status"
+	StatusVerboseUsage = "Shows the IP through which the traffic enters VPN and the IP from which it exits to the internet"
+	StatusJSONUsage    = "Shows status in JSON format including the entry and exit IPs"
+	StatusWatchUsage   = "Refreshes the status every second until interrupted. " +
+		"With --json, prints one JSON object per line"
+)
+
+// statusJSON is a JSON representation of the connection status
+type statusJSON struct {
+	State           string `json:"state"`
+	Hostname        string `json:"hostname,omitempty"`
+	Via             string `json:"via,omitempty"`
+	Country         string `json:"country,omitempty"`
+	City            string `json:"city,omitempty"`
+	Technology      string `json:"technology,omitempty"`
+	Protocol        string `json:"protocol,omitempty"`
+	DataPlane       string `json:"data_plane,omitempty"`
+	Uplink          string `json:"uplink,omitempty"`
+	EntryIP         string `json:"entry_ip,omitempty"`
+	ExitIP          string `json:"exit_ip,omitempty"`
+	ExitCountryCode string `json:"exit_country_code,omitempty"`
+	Received        uint64 `json:"received_bytes"`
+	Sent            uint64 `json:"sent_bytes"`
+	DownloadRate    uint64 `json:"download_rate_bytes"`
+	UploadRate      uint64 `json:"upload_rate_bytes"`
+	HandshakeAge    int64  `json:"handshake_age_seconds,omitempty"`
+	ServerLoad      int64  `json:"server_load,omitempty"`
+	UptimeSeconds   int64  `json:"uptime_seconds,omitempty"`
+	ResumeInSeconds int64  `json:"resume_in_seconds,omitempty"`
+}
 
 func (c *cmd) Status(ctx *cli.Context) error {
+	asJSON := ctx.Bool(flagStatusJSON) || jsonOutput(ctx)
+	if ctx.Bool(flagStatusWatch) {
+		return c.watchStatus(asJSON)
+	}
+
 	resp, err := c.client.Status(context.Background(), &pb.Empty{})
 	if err != nil {
 		return formatError(err)
 	}
+
+	var ips *pb.ConnectionIPsResponse
+	if ctx.Bool(flagStatusVerbose) || asJSON {
+		ips, err = c.client.ConnectionIPs(context.Background(), &pb.Empty{})
+		if err != nil {
+			return formatError(err)
+		}
+	}
+
+	if asJSON {
+		return printJSON(toStatusJSON(resp, ips))
+	}
+
 	fmt.Print(Status(resp))
+	if ctx.Bool(flagStatusVerbose) {
+		fmt.Print(ConnectionIPs(ips))
+	}
 	return nil
 }
 
+// watchStatus prints the status sent by the daemon every second until
+// interrupted
+func (c *cmd) watchStatus(asJSON bool) error {
+	watchCtx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	stream, err := c.client.WatchStatus(watchCtx, &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if watchCtx.Err() != nil || errors.Is(err, io.EOF) {
+				return nil
+			}
+			return formatError(err)
+		}
+		if asJSON {
+			if err := encoder.Encode(toStatusJSON(resp, nil)); err != nil {
+				return formatError(err)
+			}
+			continue
+		}
+		fmt.Print(clearScreen + Status(resp))
+	}
+}
+
 // Status returns ready to print status string.
 func Status(resp *pb.StatusResponse) string {
 	var b strings.Builder
@@ -33,6 +130,10 @@ func Status(resp *pb.StatusResponse) string {
 		b.WriteString(fmt.Sprintf("Hostname: %s\n", resp.Hostname))
 	}
 
+	if resp.Via != "" {
+		b.WriteString(fmt.Sprintf("Via: %s\n", resp.Via))
+	}
+
 	if resp.Ip != "" {
 		b.WriteString(fmt.Sprintf("IP: %s\n", resp.Ip))
 	}
@@ -45,6 +146,14 @@ func Status(resp *pb.StatusResponse) string {
 		b.WriteString(fmt.Sprintf("City: %s\n", resp.City))
 	}
 
+	if resp.Rating != 0 {
+		b.WriteString(fmt.Sprintf("Your rating: %s\n", formatServerRating(resp.Rating)))
+	}
+
+	if resp.Note != "" {
+		b.WriteString(fmt.Sprintf("Your note: %s\n", resp.Note))
+	}
+
 	if resp.Uptime != -1 {
 		b.WriteString(
 			fmt.Sprintf("Current technology: %s\n", resp.Technology.String()),
@@ -52,6 +161,14 @@ func Status(resp *pb.StatusResponse) string {
 		b.WriteString(
 			fmt.Sprintf("Current protocol: %s\n", resp.Protocol.String()),
 		)
+		if resp.Technology == config.Technology_NORDLYNX {
+			if resp.DataPlane != "" {
+				b.WriteString(fmt.Sprintf("Data plane: %s\n", resp.DataPlane))
+			}
+		}
+		if resp.Uplink != "" {
+			b.WriteString(fmt.Sprintf("Uplink: %s\n", resp.Uplink))
+		}
 	}
 
 	// show transfer rates only if running
@@ -62,10 +179,87 @@ func Status(resp *pb.StatusResponse) string {
 		)
 	}
 
+	if resp.DownloadRate != 0 || resp.UploadRate != 0 {
+		b.WriteString(fmt.Sprintf(
+			"Throughput: %s/s received, %s/s sent\n",
+			uint64ToHumanBytes(resp.DownloadRate), uint64ToHumanBytes(resp.UploadRate)),
+		)
+	}
+
+	if resp.HandshakeAge > 0 {
+		age := time.Duration(resp.HandshakeAge) * time.Second
+		b.WriteString(fmt.Sprintf("Latest handshake: %s ago\n", durafmt.Parse(age).String()))
+	}
+
+	if resp.ServerLoad > 0 {
+		b.WriteString(fmt.Sprintf("Server load: %d%%\n", resp.ServerLoad))
+	}
+
 	if resp.Uptime != -1 {
 		// truncate to skip milliseconds from being displayed
 		uptime := time.Duration(resp.Uptime).Truncate(1000 * time.Millisecond)
 		b.WriteString(fmt.Sprintf("Uptime: %s\n", durafmt.Parse(uptime).String()))
 	}
+
+	if resp.IdleDisconnectIn > 0 {
+		idle := time.Duration(resp.IdleDisconnectIn) * time.Second
+		b.WriteString(fmt.Sprintf("Idle disconnect in: %s\n", durafmt.Parse(idle).String()))
+	}
+
+	if resp.ResumeIn > 0 {
+		resume := time.Duration(resp.ResumeIn) * time.Second
+		b.WriteString(fmt.Sprintf("Paused, resuming in: %s\n", durafmt.Parse(resume).String()))
+	}
+	return b.String()
+}
+
+// ConnectionIPs returns ready to print entry and exit IPs of the connection
+func ConnectionIPs(resp *pb.ConnectionIPsResponse) string {
+	if resp.GetType() != internal.CodeSuccess {
+		return ""
+	}
+
+	var b strings.Builder
+	if resp.EntryIp != "" {
+		b.WriteString(fmt.Sprintf("Entry IP: %s\n", resp.EntryIp))
+	}
+	switch {
+	case resp.ExitIp == "":
+		b.WriteString("Exit IP: unknown\n")
+	case resp.ExitCountryCode != "":
+		b.WriteString(fmt.Sprintf("Exit IP: %s (%s)\n", resp.ExitIp, resp.ExitCountryCode))
+	default:
+		b.WriteString(fmt.Sprintf("Exit IP: %s\n", resp.ExitIp))
+	}
 	return b.String()
 }
+
+func toStatusJSON(resp *pb.StatusResponse, ips *pb.ConnectionIPsResponse) statusJSON {
+	status := statusJSON{
+		State:           resp.State,
+		Hostname:        resp.Hostname,
+		Via:             resp.Via,
+		Country:         resp.Country,
+		City:            resp.City,
+		Received:        resp.Download,
+		Sent:            resp.Upload,
+		DownloadRate:    resp.DownloadRate,
+		UploadRate:      resp.UploadRate,
+		HandshakeAge:    resp.HandshakeAge,
+		ServerLoad:      resp.ServerLoad,
+		ResumeInSeconds: resp.ResumeIn,
+	}
+	if resp.Uptime != -1 {
+		status.Technology = resp.Technology.String()
+		status.Protocol = resp.Protocol.String()
+		status.DataPlane = resp.DataPlane
+		status.Uplink = resp.Uplink
+		status.UptimeSeconds = int64(time.Duration(resp.Uptime).Seconds())
+	}
+	if ips.GetType() == internal.CodeSuccess {
+		status.EntryIP = ips.EntryIp
+		status.ExitIP = ips.ExitIp
+		status.ExitCountryCode = ips.ExitCountryCode
+	}
+	return status
+}
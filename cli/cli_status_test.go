@@ -5,6 +5,7 @@ import (
 
 	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
 	"github.com/NordSecurity/nordvpn-linux/test/category"
 
 	"github.com/stretchr/testify/assert"
@@ -41,6 +42,100 @@ Current technology: NORDLYNX
 Current protocol: UDP
 Transfer: 69 B received, 69 B sent
 Uptime: 13 seconds
+`,
+		},
+		{
+			name: "server note",
+			resp: &pb.StatusResponse{
+				State:      "Connected",
+				Technology: config.Technology_NORDLYNX,
+				Protocol:   config.Protocol_UDP,
+				Hostname:   "lt10.nordvpn.com",
+				Uptime:     13e9,
+				Note:       "great for streaming",
+				Rating:     5,
+			},
+			expected: `Status: Connected
+Hostname: lt10.nordvpn.com
+Your rating: 5/5
+Your note: great for streaming
+Current technology: NORDLYNX
+Current protocol: UDP
+Uptime: 13 seconds
+`,
+		},
+		{
+			name: "multi-hop",
+			resp: &pb.StatusResponse{
+				State:      "Connected",
+				Technology: config.Technology_NORDLYNX,
+				Protocol:   config.Protocol_UDP,
+				Hostname:   "us456.nordvpn.com",
+				Via:        "de123.nordvpn.com",
+				Uptime:     13e9,
+			},
+			expected: `Status: Connected
+Hostname: us456.nordvpn.com
+Via: de123.nordvpn.com
+Current technology: NORDLYNX
+Current protocol: UDP
+Uptime: 13 seconds
+`,
+		},
+		{
+			name: "userspace data plane",
+			resp: &pb.StatusResponse{
+				State:      "Connected",
+				Technology: config.Technology_NORDLYNX,
+				Protocol:   config.Protocol_UDP,
+				Uptime:     13e9,
+				DataPlane:  "userspace",
+			},
+			expected: `Status: Connected
+Current technology: NORDLYNX
+Current protocol: UDP
+Data plane: userspace
+Uptime: 13 seconds
+`,
+		},
+		{
+			name: "transfer statistics",
+			resp: &pb.StatusResponse{
+				State:        "Connected",
+				Technology:   config.Technology_NORDLYNX,
+				Protocol:     config.Protocol_UDP,
+				Download:     4096,
+				Upload:       1024,
+				DownloadRate: 2048,
+				UploadRate:   512,
+				HandshakeAge: 95,
+				ServerLoad:   42,
+				Uptime:       13e9,
+			},
+			expected: `Status: Connected
+Current technology: NORDLYNX
+Current protocol: UDP
+Transfer: 4.00 KiB received, 1.00 KiB sent
+Throughput: 2.00 KiB/s received, 512 B/s sent
+Latest handshake: 1 minute 35 seconds ago
+Server load: 42%
+Uptime: 13 seconds
+`,
+		},
+		{
+			name: "idle disconnect",
+			resp: &pb.StatusResponse{
+				State:            "Connected",
+				Technology:       config.Technology_NORDLYNX,
+				Protocol:         config.Protocol_UDP,
+				Uptime:           13e9,
+				IdleDisconnectIn: 300,
+			},
+			expected: `Status: Connected
+Current technology: NORDLYNX
+Current protocol: UDP
+Uptime: 13 seconds
+Idle disconnect in: 5 minutes
 `,
 		},
 		{
@@ -50,6 +145,17 @@ Uptime: 13 seconds
 				Uptime: -1,
 			},
 			expected: `Status: Disconnected
+`,
+		},
+		{
+			name: "paused",
+			resp: &pb.StatusResponse{
+				State:    "Disconnected",
+				Uptime:   -1,
+				ResumeIn: 570,
+			},
+			expected: `Status: Disconnected
+Paused, resuming in: 9 minutes 30 seconds
 `,
 		},
 	}
@@ -60,3 +166,73 @@ Uptime: 13 seconds
 		})
 	}
 }
+
+func TestConnectionIPs(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		resp     *pb.ConnectionIPsResponse
+		expected string
+	}{
+		{
+			name: "entry and exit",
+			resp: &pb.ConnectionIPsResponse{
+				Type:            internal.CodeSuccess,
+				EntryIp:         "192.0.2.10",
+				ExitIp:          "198.51.100.7",
+				ExitCountryCode: "DE",
+			},
+			expected: "Entry IP: 192.0.2.10\nExit IP: 198.51.100.7 (DE)\n",
+		},
+		{
+			name:     "unknown exit",
+			resp:     &pb.ConnectionIPsResponse{Type: internal.CodeSuccess, EntryIp: "192.0.2.10"},
+			expected: "Entry IP: 192.0.2.10\nExit IP: unknown\n",
+		},
+		{
+			name: "not connected",
+			resp: &pb.ConnectionIPsResponse{Type: internal.CodeVPNNotRunning},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ConnectionIPs(test.resp))
+		})
+	}
+}
+
+func TestToStatusJSON(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	status := toStatusJSON(&pb.StatusResponse{
+		State:      "Connected",
+		Technology: config.Technology_NORDLYNX,
+		Protocol:   config.Protocol_UDP,
+		Hostname:   "de100.nordvpn.com",
+		Download:   69,
+		Upload:     42,
+		Uptime:     13e9,
+	}, &pb.ConnectionIPsResponse{
+		Type:    internal.CodeSuccess,
+		EntryIp: "192.0.2.10",
+		ExitIp:  "198.51.100.7",
+	})
+	assert.Equal(t, statusJSON{
+		State:         "Connected",
+		Hostname:      "de100.nordvpn.com",
+		Technology:    "NORDLYNX",
+		Protocol:      "UDP",
+		EntryIP:       "192.0.2.10",
+		ExitIP:        "198.51.100.7",
+		Received:      69,
+		Sent:          42,
+		UptimeSeconds: 13,
+	}, status)
+
+	assert.Equal(t, statusJSON{State: "Disconnected"}, toStatusJSON(&pb.StatusResponse{
+		State:  "Disconnected",
+		Uptime: -1,
+	}, &pb.ConnectionIPsResponse{Type: internal.CodeVPNNotRunning}))
+}
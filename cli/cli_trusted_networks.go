@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Trusted networks help text
+const (
+	TrustedNetworksUsageText      = "Manages the networks on which VPN is not used"
+	TrustedNetworksAddUsageText   = "Disconnects VPN and relaxes kill switch on the network"
+	TrustedNetworksAddDescription = `Use this command to trust the network, e.g. your home LAN. After joining the trusted network, VPN is disconnected and kill switch is relaxed. After leaving it, kill switch is enforced again and VPN is connected to the server used before, or to the auto-connect server if auto-connect is enabled. Connecting manually on the trusted network is respected until the network changes. Auto-connect on start is skipped on trusted networks.
+The network is given either by the NetworkManager connection name or the Wi-Fi network name and must be the network you are connected to. It is trusted only together with the hardware address of its gateway, so add it again if the router is replaced.
+
+Example: 'nordvpn trusted-networks add "HomeLAN"'`
+	TrustedNetworksRemoveUsageText   = "Stops trusting the network"
+	TrustedNetworksRemoveDescription = `Use this command to remove the network added with 'nordvpn trusted-networks add'.
+
+Example: 'nordvpn trusted-networks remove "HomeLAN"'`
+	TrustedNetworksArgsUsage = "<name|ssid>"
+)
+
+// TrustedNetworksAdd adds the network to the trusted networks
+func (c *cmd) TrustedNetworksAdd(ctx *cli.Context) error {
+	return c.setTrustedNetwork(ctx, true)
+}
+
+// TrustedNetworksRemove removes the network from the trusted networks
+func (c *cmd) TrustedNetworksRemove(ctx *cli.Context) error {
+	return c.setTrustedNetwork(ctx, false)
+}
+
+func (c *cmd) setTrustedNetwork(ctx *cli.Context, trusted bool) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	network := ctx.Args().First()
+	resp, err := c.client.SetTrustedNetwork(context.Background(), &pb.SetTrustedNetworkRequest{
+		Network: network,
+		Trusted: trusted,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeConflict:
+		return formatError(fmt.Errorf(MsgTrustedNetworkNotJoined, network))
+	case internal.CodeGatewayError:
+		return formatError(errors.New(MsgTrustedNetworkUnidentified))
+	case internal.CodeNothingToDo:
+		if trusted {
+			color.Yellow(MsgTrustedNetworkAlreadyAdded, network)
+		} else {
+			color.Yellow(MsgTrustedNetworkNotAdded, network)
+		}
+	case internal.CodeSuccess:
+		if trusted {
+			color.Green(MsgTrustedNetworkAdded, network)
+		} else {
+			color.Green(MsgTrustedNetworkRemoved, network)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	// TunnelOverheadUsageText is shown next to tunnel-overhead command by nordvpn --help
+	TunnelOverheadUsageText = "Estimates the overhead added by the VPN tunnel"
+	// TunnelOverheadDescription is shown in the tunnel-overhead command help
+	TunnelOverheadDescription = `Use this command to find out how much of the link capacity is used by the VPN encapsulation.
+Per-packet overhead and goodput are estimated from the current protocol and the MTU of the tunnel.
+Goodput is the share of the link traffic which carries the payload of full sized TCP packets.
+
+With --measure, traffic on the tunnel and the network interfaces is counted for the given duration
+and the measured values are reported as well. Measurement is passive, so transfer some data
+while it runs. Traffic which does not go through the tunnel is counted too, therefore
+the measured values are approximate.
+
+Example: 'nordvpn tunnel-overhead'
+Example: 'nordvpn tunnel-overhead --measure 10s'`
+	tunnelOverheadMeasureUsage = "Measures the overhead for the given duration, at most 30s"
+	flagTunnelOverheadMeasure  = "measure"
+	maxTunnelOverheadMeasure   = 30 * time.Second
+)
+
+func (c *cmd) TunnelOverhead(ctx *cli.Context) error {
+	if ctx.NArg() != 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	measure := ctx.Duration(flagTunnelOverheadMeasure)
+	if measure < 0 || measure > maxTunnelOverheadMeasure {
+		return formatError(fmt.Errorf(MsgTunnelOverheadMeasureTooLong, maxTunnelOverheadMeasure))
+	}
+	if measure > 0 {
+		fmt.Printf(MsgTunnelOverheadMeasuring+"\n", measure)
+	}
+
+	resp, err := c.client.TunnelOverhead(context.Background(), &pb.TunnelOverheadRequest{
+		MeasureSeconds: uint32(measure.Round(time.Second) / time.Second),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeVPNNotRunning:
+		return formatError(errors.New(DisconnectNotConnected))
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(MsgTunnelOverheadMeasureTooLong, maxTunnelOverheadMeasure))
+	case internal.CodeSuccess:
+		fmt.Print(TunnelOverhead(resp))
+	default:
+		return formatError(internal.ErrUnhandled)
+	}
+	return nil
+}
+
+// TunnelOverhead returns ready to print tunnel overhead string.
+func TunnelOverhead(resp *pb.TunnelOverheadResponse) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Technology: %s\n", resp.Technology.String()))
+	b.WriteString(fmt.Sprintf("Protocol: %s\n", resp.Protocol.String()))
+	b.WriteString(fmt.Sprintf("Tunnel interface: %s (MTU %d)\n", resp.Interface, resp.TunnelMtu))
+	b.WriteString(fmt.Sprintf("Network interface: %s (MTU %d)\n", resp.LinkInterface, resp.LinkMtu))
+	b.WriteString(fmt.Sprintf("Theoretical overhead: %d bytes per packet\n", resp.Overhead))
+	b.WriteString(fmt.Sprintf(
+		"Theoretical goodput: %.1f%% (%.1f%% without VPN)\n",
+		resp.Goodput*100, resp.LinkGoodput*100,
+	))
+
+	measurement := resp.GetMeasurement()
+	if measurement == nil {
+		return b.String()
+	}
+	b.WriteString(fmt.Sprintf(
+		"Measured traffic: %s in %d packets over %ds\n",
+		uint64ToHumanBytes(measurement.TunnelBytes),
+		measurement.TunnelPackets,
+		measurement.DurationSeconds,
+	))
+	if measurement.TunnelPackets == 0 {
+		b.WriteString(MsgTunnelOverheadNoTraffic + "\n")
+		return b.String()
+	}
+	b.WriteString(fmt.Sprintf("Measured overhead: %.1f bytes per packet\n", measurement.Overhead))
+	b.WriteString(fmt.Sprintf("Measured goodput: %.1f%%\n", measurement.Goodput*100))
+	return b.String()
+}
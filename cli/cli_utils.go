@@ -2,10 +2,12 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
 	"github.com/NordSecurity/nordvpn-linux/nstrings"
 
 	"github.com/urfave/cli/v2"
@@ -28,3 +30,11 @@ func (c *cmd) SetBoolAutocomplete(ctx *cli.Context) {
 		fmt.Println(v)
 	}
 }
+
+// notAppliedError is returned when the setting was saved, but could not be applied
+func notAppliedError(resp *pb.Payload) error {
+	if len(resp.Data) > 0 {
+		return formatError(errors.New(resp.Data[0]))
+	}
+	return formatError(internal.ErrUnhandled)
+}
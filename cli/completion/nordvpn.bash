@@ -0,0 +1,38 @@
+# bash completion for nordvpn, the values are requested from the app each
+# time, so that they reflect the current state of the daemon
+
+# Macs have bash3 for which the bash-completion package doesn't include
+# _init_completion. This is a minimal version of that function.
+_nordvpn_init_completion() {
+  COMPREPLY=()
+  _get_comp_words_by_ref "$@" cur prev words cword
+}
+
+_nordvpn_completion() {
+  if [[ "${COMP_WORDS[0]}" != "source" ]]; then
+    local cur opts base words
+    COMPREPLY=()
+    cur="${COMP_WORDS[COMP_CWORD]}"
+    if declare -F _init_completion >/dev/null 2>&1; then
+      _init_completion -n "=:" || return
+    else
+      _nordvpn_init_completion -n "=:" || return
+    fi
+    words=("${words[@]:0:$cword}")
+    if [[ "$cur" == "-"* ]]; then
+      requestComp="${words[*]} ${cur} --complete"
+    else
+      requestComp="${words[*]} --complete"
+    fi
+    opts=$(eval "${requestComp}" 2>/dev/null)
+    # special value printed by the app when file paths are completed
+    if [[ $opts == "nordvpn_autocomplete_filepaths" ]]; then
+      compopt -o bashdefault -o default
+    else
+      COMPREPLY=($(compgen -W "${opts}" -- ${cur}))
+    fi
+    return 0
+  fi
+}
+
+complete -o nospace -F _nordvpn_completion nordvpn
@@ -0,0 +1,19 @@
+# fish completion for nordvpn, the values are requested from the app each time,
+# so that they reflect the current state of the daemon
+
+function __nordvpn_completion
+    set -l tokens (commandline -opc)
+    set -l current (commandline -ct)
+    if string match -q -- '-*' $current
+        set -a tokens $current
+    end
+    set -l opts ($tokens --complete 2>/dev/null)
+    # special value printed by the app when file paths are completed
+    if test "$opts[1]" = nordvpn_autocomplete_filepaths
+        __fish_complete_path $current
+        return
+    end
+    printf '%s\n' $opts
+end
+
+complete -c nordvpn -f -a '(__nordvpn_completion)'
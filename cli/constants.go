@@ -17,6 +17,12 @@ const (
 
 const (
 	flagGroup         = "group"
+	flagFavorite      = "favorite"
+	flagRandom        = "random"
+	flagLogTo         = "log-to"
+	flagVerify        = "verify"
+	flagVia           = "via"
+	flagViaInterface  = "via-interface"
 	flagToken         = "token"
 	flagLoginCallback = "callback"
 	stringProtocol    = "protocol"
@@ -33,6 +33,148 @@ Example: nordvpn set %s off
 Supported values for <enabled>: 1, true, enable, on, enabled
 Example: nordvpn set %s on`
 
+	MsgOnDemandIdleTimeoutTooShort = "Idle timeout must be at least %s."
+	MsgIdleTimeoutTooShort         = "Idle timeout must be at least %s."
+	MsgPauseTooLong                = "Pause can last at most %s."
+	MsgPaused                      = "VPN connection is paused for %s. It will be resumed automatically, use 'nordvpn resume' to resume it earlier."
+	MsgResumed                     = "VPN connection is resumed."
+	MsgNotPaused                   = "VPN connection is not paused."
+	MsgResumeFailed                = "Failed to resume VPN connection. Use 'nordvpn connect' to connect."
+	MsgOnDemandKillSwitchDisabled  = "Kill Switch is disabled: the traffic which triggers on-demand connection is not protected until VPN connection is established."
+
+	MsgMetricsInvalidAddress = "Metrics address '%s' is invalid, use an IP address with a port, e.g. 127.0.0.1:9101."
+	MsgMetricsServing        = "Metrics are served on http://%s/metrics"
+
+	MsgRESTAPIInvalidAddress = "REST API address '%s' is invalid, use a loopback IP address with a port, e.g. 127.0.0.1:9102."
+	MsgRESTAPIServing        = "REST API is served on http://%s/v1 with the token %s"
+
+	MsgLocalProxyInvalidPort  = "Local proxy port '%d' is invalid, use a port between 1 and 65535."
+	MsgLocalProxyServing      = "Local proxy is listening on 127.0.0.1:%s"
+	MsgLocalProxyNotConnected = "Local proxy refuses the connections until you connect to VPN."
+
+	MsgServerPortInvalid = "Server port '%s' is invalid, use a port between 1 and 65535 or 'default'."
+
+	MsgRoutingTableInvalid = "Routing table '%s' is invalid, use a number other than 253, 254 and 255, which are reserved, or 'auto'."
+
+	MsgMTUInvalid = "MTU '%s' is invalid, use a number between 576 and 1500 or 'auto'."
+
+	MsgInterfaceNameInvalid = "Interface name '%s' is invalid, use up to 15 letters, digits, '_', '.' or '-', or 'default'."
+
+	MsgFirewallBackendConflict = "Firewall backend cannot be set to %s, as the rules of these enabled features are applied with iptables: %s. Disable them first."
+
+	MsgTunnelOverheadMeasureTooLong = "Measurement duration must be between 0 and %s."
+	MsgTunnelOverheadMeasuring      = "Measuring the tunnel traffic for %s, transfer some data meanwhile..."
+	MsgTunnelOverheadNoTraffic      = "No traffic went through the tunnel during the measurement."
+
+	MsgConnectFavoriteWithServer = "Favorite cannot be combined with a server or a group."
+	MsgConnectVerifyWithRandom   = "Options --verify and --random cannot be used together."
+	MsgFavoritesInvalidFile      = "Invalid favorites file: %s"
+	MsgFavoritesMissingFields    = "every favorite must have a name and a server tag or a server group"
+	MsgFavoritesUnknown          = "Favorite '%s' was not imported: server or group was not found."
+	MsgFavoritesNothingImported  = "No favorites were imported."
+	MsgFavoritesImported         = "%d favorites were imported successfully."
+	MsgFavoritesExported         = "%d favorites were exported to %s."
+	MsgFavoritesAdded            = "%s was added to favorites."
+	MsgFavoritesNotFound         = "Server '%s' was not found, so it was not added to favorites."
+	MsgFavoritesEmpty            = "You have no favorites. Add one with 'nordvpn favorites add <server>'."
+
+	MsgProfileSaved        = "Profile '%s' is saved."
+	MsgProfileAlreadySaved = "Profile '%s' is already saved with the current settings."
+	MsgProfileRemoved      = "Profile '%s' is removed."
+	MsgProfileNotFound     = "Profile '%s' does not exist."
+	MsgProfilesEmpty       = "No profiles are saved."
+
+	MsgExportWGNotConnected       = "You are not connected to NordVPN. Connect or provide a server to export the config for."
+	MsgExportWGNotNordLynx        = "Current connection does not use NordLynx technology."
+	MsgExportWGServerNotNordLynx  = "Server '%s' does not support NordLynx technology."
+	MsgExportWGPrivateKeyWarning  = "WARNING: the config contains your private key. Anyone who has it can use your NordVPN account. Do not share it."
+	MsgExportWGPrivateKeyRedacted = "Private key is redacted. Use --%s to include it."
+
+	MsgRenderTemplateServer        = "Provide either --%s or --%s."
+	MsgRenderTemplateInvalidServer = "Invalid server record: %s"
+	MsgRenderTemplateFailure       = "Rendering the OpenVPN config for %s failed: %s"
+
+	MsgPreviewDNSFailure         = "Can't preview the DNS changes. See the daemon log for more details."
+	MsgPreviewDNSManagedBySystem = "/etc/resolv.conf is managed by the system and is updated by it after the commands above."
+	MsgPreviewDNSNoChanges       = "%s would not be changed."
+
+	MsgServerNoteSet         = "Note of server '%s' is set successfully."
+	MsgServerNoteRemoved     = "Note of server '%s' is removed successfully."
+	MsgServerNoteNothingToDo = "Nothing to change for server '%s'."
+	MsgServerRatingSet       = "Server '%s' is rated %s successfully."
+	MsgServerRatingRemoved   = "Rating of server '%s' is removed successfully."
+	MsgServerRatingInvalid   = "Rating must be a number from %d to %d, or 0 to remove it."
+	MsgServerNotesEmpty      = "You have no server notes or ratings."
+	MsgRatingWeightInvalid   = "Rating weight must be a number from 0 to %d."
+
+	MsgMeteredNetworkAdded        = "Network '%s' is treated as metered."
+	MsgMeteredNetworkRemoved      = "Network '%s' is no longer treated as metered."
+	MsgMeteredNetworkAlreadyAdded = "Network '%s' is already treated as metered."
+	MsgMeteredNetworkNotAdded     = "Network '%s' was not added as metered."
+
+	MsgCaptivePortalNetworkAdded        = "The sign-in to the gateway will be allowed when joining network '%s'."
+	MsgCaptivePortalNetworkRemoved      = "Network '%s' is no longer treated as having a captive portal."
+	MsgCaptivePortalNetworkAlreadyAdded = "Network '%s' is already treated as having a captive portal."
+	MsgCaptivePortalNetworkNotAdded     = "Network '%s' was not added as having a captive portal."
+	MsgTrustedNetworkAdded              = "VPN will be disconnected and kill switch relaxed on trusted network '%s'."
+	MsgTrustedNetworkRemoved            = "Network '%s' is no longer trusted."
+	MsgTrustedNetworkAlreadyAdded       = "Network '%s' is already trusted."
+	MsgTrustedNetworkNotAdded           = "Network '%s' was not added to the trusted networks."
+	MsgTrustedNetworkNotJoined          = "Connect to network '%s' before trusting it."
+	MsgTrustedNetworkUnidentified       = "The gateway of the current network could not be identified, so the network cannot be trusted."
+	MsgCaptivePortalUnlocked            = "Kill switch allows the sign-in to the captive portal for %s."
+	MsgCaptivePortalSignIn              = "Open %s in your browser to sign in."
+	MsgCaptivePortalUnlockTooLong       = "The sign-in page can be opened for at most %s."
+	MsgCaptivePortalKillSwitchDisabled  = "Kill switch is disabled, so the sign-in page is not blocked."
+	MsgCaptivePortalVPNRunning          = "Disconnect from VPN to sign in to the captive portal."
+	MsgCaptivePortalUnlockFailed        = "Opening the sign-in page failed. Check the daemon logs for details."
+
+	MsgSplitTunnelAppAdded        = "Application '%s' is added to the split tunnel."
+	MsgSplitTunnelAppRemoved      = "Application '%s' is removed from the split tunnel."
+	MsgSplitTunnelAppAlreadyAdded = "Application '%s' is already added to the split tunnel."
+	MsgSplitTunnelAppNotAdded     = "Application '%s' was not added to the split tunnel."
+	MsgSplitTunnelEmpty           = "No applications are added to the split tunnel."
+
+	MsgAutoConnectRuleAdded        = "Auto-connect rule is added."
+	MsgAutoConnectRuleAlreadyAdded = "Auto-connect rule is already added."
+	MsgAutoConnectRuleRemoved      = "Auto-connect rule %d is removed."
+	MsgAutoConnectRuleNotFound     = "Auto-connect rule %d does not exist."
+	MsgAutoConnectRuleInvalid      = "Auto-connect rule is invalid. Provide at least one of --ssid, --bssid or --type, and --action connect or skip. --server and --group can be used only with the connect action."
+	MsgAutoConnectRulesEmpty       = "No auto-connect rules are added."
+
+	MsgDNSSearchDomainInvalid  = "'%s' is not a valid search domain."
+	MsgDNSSearchDomainsTooMany = "More than 5 search domains provided."
+	MsgDNSFilterTypeInvalid    = "'%s' is not a valid DNS record type."
+
+	MsgDNSRouteSet           = "Queries of '%s' are sent to %s %s."
+	MsgDNSRouteAlreadySet    = "Queries of '%s' are already sent to %s %s."
+	MsgDNSRouteRemoved       = "DNS route of '%s' is removed."
+	MsgDNSRouteNotFound      = "DNS route of '%s' does not exist."
+	MsgDNSRouteInvalid       = "'%s' is not a valid domain or nameserver."
+	MsgDNSRouteMeshnet       = "Domain '%s' is reserved for meshnet."
+	MsgDNSRouteOutsideTunnel = "outside of the VPN tunnel"
+	MsgDNSRouteViaTunnel     = "through the VPN tunnel"
+
+	MsgIPv6NativeLeak = "WARNING: IPv6 traffic bypasses the VPN in native mode and reveals your IPv6 address. " +
+		"Use 'nordvpn set ipv6 tunnel' or 'nordvpn set ipv6 block' to prevent the leak."
+
+	MsgReloaded                = "Settings are reloaded."
+	MsgReloadFailed            = "Failed to apply settings: %s"
+	MsgReloadApplied           = "Applied settings: %s"
+	MsgReloadReconnectRequired = "Reconnect to apply settings: %s"
+	MsgReloadRestartRequired   = "Restart the daemon to apply settings: %s"
+
+	MsgFirewallRebuilt           = "Firewall rules are rebuilt."
+	MsgFirewallRebuildFailed     = "Rebuilding the firewall rules failed: %s"
+	MsgFirewallRebuildMissing    = "Restored missing rules: %s"
+	MsgFirewallRebuildDuplicated = "Removed duplicates of rules: %s"
+	MsgFirewallRebuildUnverified = "Rules are still not applied: %s"
+
+	MsgKillSwitchLogDisabled      = "Logging of the blocked traffic is disabled. Enable it with 'nordvpn set killswitch-log on'."
+	MsgKillSwitchLogReconnect     = "The setting is applied the next time Kill Switch or VPN connection blocks the traffic. Reconnect or toggle Kill Switch to apply it now."
+	MsgKillSwitchBlockedEmpty     = "Kill Switch has not blocked any traffic recently."
+	MsgKillSwitchBlockedAllowlist = "If this traffic is intended, add it to the allowlist, e.g. '%s'."
+
 	ObfuscateOnServerNotObfuscated              = "We couldn’t turn on obfuscation because the current auto-connect server doesn’t support it. Set a different server for auto-connect to use obfuscation."
 	ObfuscateOffServerObfuscated                = "We couldn’t turn off obfuscation because your current auto-connect server is obfuscated by default. Set a different server for auto-connect, then turn off obfuscation."
 	AutoConnectOnNonObfuscatedServerObfuscateOn = "Your selected server doesn’t support obfuscation. Choose a different server or turn off obfuscation."
@@ -54,7 +196,16 @@ Example: nordvpn set %s on`
 	SetProtocolUnavailable = "Protocol setting is not available when the set technology is not OpenVPN"
 	SetProtocolAlreadySet  = "Protocol is already set to %s"
 
-	SetTechnologyDepsError = "Missing %s kernel module or configuration utility."
+	SetTechnologyDepsError     = "Missing %s kernel module or configuration utility."
+	MsgSetTechnologyNotAllowed = "Technology %s is not allowed. Use 'nordvpn set allowed-technologies' to allow it."
+
+	MsgSetAllowedTechnologiesExcludesCurrent = "Current technology %s must stay allowed. Change the technology with 'nordvpn set technology' first."
+
+	MsgConnectServerSkipped = "Skipped server %s: %s"
+	MsgConnectSubnetOverlap = "Some LAN subnets overlap with the VPN subnets. Use 'nordvpn set subnet-overlap' to choose which one is preferred."
+	MsgConnectTCPFallback   = "UDP seems to be blocked on this network, retrying over TCP. TCP will be used on this network from now on, run 'nordvpn set protocol' to reset it."
+
+	MsgConnectObfuscationFallback = "VPN traffic seems to be blocked on this network, retrying on obfuscated server %s. Obfuscated servers will be used on this network from now on, run 'nordvpn set obfuscate' to reset it."
 
 	SetDNSDisableThreatProtectionLite = "Disabling Threat Protection Lite."
 	SetDNSInvalidAddress              = "The provided IP address is invalid."
@@ -75,6 +226,9 @@ Example: nordvpn set %s on`
 	AllowlistAddSubnetSuccess      = "Subnet %s is allowlisted successfully."
 	AllowlistAddSubnetLANDiscovery = "Allowlisting a private subnet is not available while local network discovery is enabled."
 
+	AllowlistAddDomainExistsError = "Domain %s is already allowlisted."
+	AllowlistAddDomainSuccess     = "Domain %s is allowlisted successfully."
+
 	AllowlistRemovePortExistsError = "Port %d (%s) is not allowlisted."
 	AllowlistRemovePortSuccess     = "Port %d (%s) is removed from the allowlist successfully."
 
@@ -84,8 +238,11 @@ Example: nordvpn set %s on`
 	AllowlistRemoveSubnetExistsError = "Subnet %s is not allowlisted."
 	AllowlistRemoveSubnetSuccess     = "Subnet %s is removed from the allowlist successfully."
 
+	AllowlistRemoveDomainExistsError = "Domain %s is not allowlisted."
+	AllowlistRemoveDomainSuccess     = "Domain %s is removed from the allowlist successfully."
+
 	AllowlistRemoveAllError   = "Allowlist elements could not be removed."
-	AllowlistRemoveAllSuccess = "All ports and subnets have been removed from the allowlist successfully."
+	AllowlistRemoveAllSuccess = "All ports, subnets and domains have been removed from the allowlist successfully."
 
 	AllowlistPortRangeError  = "Port %d value is out of range [%d - %d]."
 	AllowlistPortsRangeError = "Ports %d - %d value is out of range [%d - %d]."
@@ -99,9 +256,29 @@ Example: nordvpn set %s on`
 	AccountInternalError          = "It's not you, it's us. We're having trouble with our servers. If the issue persists, please contact our customer support."
 	AccountTokenUnauthorizedError = "There was a problem with your credentials. Please try to log out and log back in again. If the issue persists, please contact our customer support."
 	AccountCantFetchVPNService    = "We were not able to fetch your VPN service data. If the issue persists, please contact our customer support."
-	UpdateAvailableMessage        = "A new version of NordVPN is available! Please update the application."
-	DisconnectNotConnected        = "You are not connected to NordVPN."
-	DisconnectConnectionRating    = "How would you rate your connection quality on a scale from 1 (poor) to 5 (excellent)? Type '%s rate [1-5]'."
+	MsgCredentialsTokenInvalid    = "Your login token was rejected, so the service credentials cannot be renewed. Please log in again."
+	MsgCredentialsCheckFailed     = "We couldn't check your service credentials. The last known state is shown."
+	MsgCredentialsRotated         = "Your service credentials were rotated. The new credentials will be used from now on."
+
+	// Dedicated IP
+	MsgDedicatedIPNoService         = "You don't have a Dedicated IP subscription."
+	MsgDedicatedIPNoServers         = "No Dedicated IP server is assigned to you yet. Select the server location in your Nord Account."
+	MsgDedicatedIPServerNotAssigned = "Dedicated IP server '%s' is not assigned to you. Use 'nordvpn dedicated-ip list' to see your servers."
+	MsgDedicatedIPFetchFailed       = "We couldn't load your Dedicated IP servers. Please try again later."
+	MsgDedicatedIPRenew             = "Renew your Dedicated IP subscription in your Nord Account to keep using it."
+
+	// History
+	MsgHistoryEmpty = "No connections were recorded yet."
+
+	// Servers
+	MsgServersNoneFound      = "No servers match the given filters."
+	MsgServersNotDownloaded  = "Server list is not downloaded yet. Please try again later."
+	MsgServersInvalidSort    = "'%s' is not a valid sort order. Use load, name or country."
+	MsgServersInvalidMaxLoad = "Max load %d is not a valid percentage."
+
+	UpdateAvailableMessage     = "A new version of NordVPN is available! Please update the application."
+	DisconnectNotConnected     = "You are not connected to NordVPN."
+	DisconnectConnectionRating = "How would you rate your connection quality on a scale from 1 (poor) to 5 (excellent)? Type '%s rate [1-5]'."
 
 	CitiesNotFoundError = "Servers by city are not available for this country."
 
@@ -130,7 +307,73 @@ Example: nordvpn set meshnet on`
 	MsgMeshnetUsage                 = "Meshnet is a way to safely access other devices, no matter where in the world they are. Once set up, Meshnet functions just like a secure local area network (LAN) — it connects devices directly. It also allows securely sending files to other devices. Use the \"nordvpn set meshnet on\" command to enable Meshnet. Learn more: https://meshnet.nordvpn.com/"
 
 	MsgMeshnetRefreshUsage = "Refreshes the Meshnet in case it was not updated automatically."
-	MsgMeshnetPeerUnknown  = "Peer '%s' is unknown."
+
+	MsgMeshnetPauseUsage       = "Pauses Meshnet for the given duration without disabling it"
+	MsgMeshnetPauseArgsUsage   = "<duration>"
+	MsgMeshnetPauseDescription = `Use this command to temporarily stop Meshnet. Meshnet connections and firewall rules are removed, while the peers and their permissions are kept.
+Meshnet is resumed automatically after the given duration or with 'nordvpn meshnet resume'.
+Peer permissions changed during the pause are applied once Meshnet is resumed.
+
+Example: 'nordvpn meshnet pause 30m'`
+	MsgMeshnetResumeUsage          = "Resumes the paused Meshnet"
+	MsgMeshnetPauseInvalidDuration = "Pause duration must be between %s and %s."
+	MsgMeshnetPauseSuccess         = "Meshnet is paused for %s."
+	MsgMeshnetResumeSuccess        = "Meshnet is resumed successfully."
+	MsgMeshnetPaused               = "Meshnet is paused. Use the \"nordvpn meshnet resume\" command to resume it."
+	MsgMeshnetNotPaused            = "Meshnet is not paused."
+
+	MsgMeshnetGroupUsage       = "Manage groups of Meshnet peers."
+	MsgMeshnetGroupDescription = `Use groups to manage the permissions of many Meshnet peers at once. Groups are kept on this device only.
+Permissions set for a group are applied to each of its peers, peers added to the group later keep their own permissions until the group permission is set again.
+
+Example: 'nordvpn meshnet group create homelab'
+Example: 'nordvpn meshnet group add homelab nas.nord'
+Example: 'nordvpn meshnet group routing allow homelab'`
+	MsgMeshnetGroupArgsUsage          = "<group>"
+	MsgMeshnetGroupPeerArgsUsage      = "<group> " + MsgMeshnetPeerArgsUsage
+	MsgMeshnetGroupListUsage          = "Lists the groups of Meshnet peers."
+	MsgMeshnetGroupCreateUsage        = "Creates an empty group of Meshnet peers."
+	MsgMeshnetGroupDeleteUsage        = "Deletes the group. Permissions of its peers are not changed."
+	MsgMeshnetGroupAddUsage           = "Adds a Meshnet peer to the group."
+	MsgMeshnetGroupRemoveUsage        = "Removes a Meshnet peer from the group."
+	MsgMeshnetGroupIncomingUsage      = "Allows/denies the peers of the group to access this device remotely (incoming connections)."
+	MsgMeshnetGroupRoutingUsage       = "Allows/denies the peers of the group to route all traffic through this device."
+	MsgMeshnetGroupLocalNetworkUsage  = "Allows/denies access to your local network when the peers of the group are routing traffic through this device."
+	MsgMeshnetGroupFileshareUsage     = "Allows/denies the peers of the group to send files to this device."
+	MsgMeshnetGroupAllowUsage         = "Allows the permission for all the peers of the group."
+	MsgMeshnetGroupDenyUsage          = "Denies the permission for all the peers of the group."
+	MsgMeshnetGroupCreateSuccess      = "Group '%s' has been created."
+	MsgMeshnetGroupDeleteSuccess      = "Group '%s' has been deleted."
+	MsgMeshnetGroupAddSuccess         = "Peer '%s' has been added to the group '%s'."
+	MsgMeshnetGroupRemoveSuccess      = "Peer '%s' has been removed from the group '%s'."
+	MsgMeshnetGroupNotFound           = "Group '%s' does not exist."
+	MsgMeshnetGroupAlreadyExists      = "Group '%s' already exists."
+	MsgMeshnetGroupInvalidName        = "Group name '%s' is invalid. Use up to 32 letters, digits, underscores and hyphens."
+	MsgMeshnetGroupPeerAlreadyInGroup = "Peer '%s' is already in the group '%s'."
+	MsgMeshnetGroupPeerNotInGroup     = "Peer '%s' is not in the group '%s'."
+	MsgMeshnetGroupEmpty              = "Group '%s' has no peers."
+	MsgMeshnetGroupNoGroups           = "There are no groups. Use the \"nordvpn meshnet group create\" command to create one."
+	MsgMeshnetGroupPermissionUpdated  = "%s for '%s' has been %s."
+	MsgMeshnetGroupPermissionSame     = "%s for '%s' is already %s."
+	MsgMeshnetGroupPermissionFailed   = "Failed to update %s for '%s'."
+	MsgMeshnetGroupPeerGone           = "Peer '%s' is no longer in the Meshnet. Remove it with the \"nordvpn meshnet group remove %s %[1]s\" command."
+	MsgMeshnetGroupPermissionSummary  = "%s has been updated for %d of %d peers in the group '%s'."
+
+	MsgMeshnetMatrixUsage       = "Probes the peers from this device and shows whether they are reachable."
+	MsgMeshnetMatrixDescription = `Use this command to diagnose partial connectivity within Meshnet.
+Every online peer is probed from this device and reported together with the path used to reach it:
+	direct - the traffic goes straight to the peer
+	relay - the traffic goes through a relay server
+
+Offline peers are reported without probing them. Each probe is bounded by a timeout.
+Reachability is reported only from the perspective of this device.
+
+Example: 'nordvpn meshnet matrix'
+Example: 'nordvpn meshnet matrix --json'`
+	MsgMeshnetMatrixJSONUsage = "Prints the result in JSON format"
+	MsgMeshnetMatrixFrom      = "Reachability from %s:"
+
+	MsgMeshnetPeerUnknown = "Peer '%s' is unknown."
 
 	// Invites
 	MsgMeshnetInviteUsage                     = "Add other users' devices to your Meshnet."
@@ -164,6 +407,10 @@ Example: nordvpn set meshnet on`
 	MsgMeshnetSetNicknameArgsUsage    = "<new_nickname>"
 	MsgMeshnetSetNicknameSuccessful   = "The nickname for this machine is now set to '%s'."
 
+	MsgMeshnetSetExitNodeFallbackUsage      = "Sets what happens when the Meshnet exit node you are connected to drops. Supported values: reconnect (default), peer, vpn, off."
+	MsgMeshnetSetExitNodeFallbackArgsUsage  = "reconnect|off|peer <peer_hostname>|<peer_nickname>|<peer_ip>|<peer_pubkey>|vpn [<server_tag>]"
+	MsgMeshnetSetExitNodeFallbackSuccessful = "Exit node fallback is set to '%s' successfully."
+
 	// Meshnet remove commands group
 	MsgMeshnetRemoveUsage = "Remove a Meshnet configuration option."
 
@@ -258,14 +505,26 @@ Learn more:
 	FileshareName       = "fileshare"
 	FileshareSendName   = "send"
 	FileshareAcceptName = "accept"
+	FileshareResumeName = "resume"
 	FileshareCancelName = "cancel"
 	FileshareListName   = "list"
 	FileshareClearName  = "clear"
 
+	FileshareSetConcurrencyName = "set-concurrency"
+	FileshareSetName            = "set"
+	FileshareRateLimitName      = "rate-limit"
+	FileshareAcceptDirsName     = "accept-directories"
+	FileshareWatchName          = "watch"
+	FileshareUnwatchName        = "unwatch"
+	FileshareProgressName       = "progress"
+
 	flagFileshareNoWait  = "background"
 	flagFilesharePath    = "path"
 	flagFileshareListIn  = "incoming"
 	flagFileshareListOut = "outgoing"
+	flagFileshareTag     = "tag"
+	flagFileshareLimit   = "limit"
+	flagFileshareTo      = "to"
 
 	MsgFileshareUsage                     = "Transfer files of any size between Meshnet peers securely and privately"
 	MsgFileshareDescription               = MsgFileshareUsage + "\n" + "Learn more: https://meshnet.nordvpn.com/features/sharing-files-in-meshnet\n\nNote: most arguments (peer name, transfer ID, file name) in fileshare commands can be entered faster using auto-completion. Simply press Tab and the app will suggest valid options for you."
@@ -286,6 +545,9 @@ Learn more:
 	MsgFileshareAlreadyAcceptedError = "This transfer is already completed."
 	MsgFileshareFileInvalidated      = "The transfer of this file is already completed or canceled."
 	MsgFileshareTransferInvalidated  = "This transfer is already completed or canceled."
+	MsgFileshareResumeOutgoingError  = "Only the receiver can resume the transfer. The transfer will continue once the receiver resumes it."
+	MsgFileshareNothingToResume      = "This transfer has no interrupted files to resume."
+	MsgFileshareResumeError          = "The transfer couldn't be resumed."
 	MsgTooManyFiles                  = "Number of files in a transfer cannot exceed 1000. Try archiving the directory."
 	MsgNoFiles                       = "The directory you’re trying to send is empty. Please choose another one."
 	MsgDirectoryToDeep               = "File depth cannot exceed 5 directories. Try archiving the directory."
@@ -293,38 +555,93 @@ Learn more:
 	MsgFileNotInProgress             = "This file is not in progress"
 	MsgNotEnoughSpace                = "The transfer can't be accepted because there's not enough storage on your device."
 	MsgNoPermissions                 = "You don’t have write permissions for the download directory %s. To receive the file transfer, choose another download directory using the --" + flagFilesharePath + " parameter."
+	MsgAcceptDirNotPermitted         = "Transfers can't be accepted into %s. Permit the directory with 'nordvpn fileshare set " + FileshareAcceptDirsName + "' or choose the default download directory."
 
 	MsgFileshareSendUsage       = "Send files or directories to a Meshnet peer."
 	MsgFileshareSendArgsUsage   = "<peer_hostname>|<peer_nickname>|<peer_ip>|<peer_pubkey> <path_1> [path_2...]"
 	MsgFileshareSendDescription = MsgFileshareSendUsage + "\n\nTo cancel a transfer in progress, press Ctrl+C"
 	MsgFileshareNoWaitUsage     = "Send a file transfer in the background instead of seeing its progress. It allows you to continue using the terminal for other commands while a transfer is in progress."
+	MsgFileshareSendTagUsage    = "Tag the transfer to find it later in the transfer history. Tags are not sent to the peer. Can be used multiple times."
+	MsgFileshareInvalidTag      = "Invalid tag: %s"
 	MsgFileshareSendNoWait      = "File transfer %s has started in the background."
 	MsgFileshareAcceptNoWait    = "File transfer has started in the background."
+	MsgFileshareAcceptQueued    = "Too many file transfers are in progress. The transfer is queued and will start once the others finish."
 	MsgFileshareWaitAccept      = "Waiting for the peer to accept your transfer..."
 	MsgTransferNotCreated       = "Can’t send the files. Please check if you have the \"read\" permission for the files you want to send."
 
 	MsgFileshareListUsage       = "Lists transfers. If transfer ID is provided, lists files in the transfer."
 	MsgFileshareListArgsUsage   = `[transfer_id]`
 	MsgFileshareListDescription = `Adding no arguments to the command will list transfers.
-Provide a [transfer_id] argument to list files in the specified transfer.`
+Provide a [transfer_id] argument to list files in the specified transfer.
+Use --tag to list transfers tagged when sending, e.g. 'nordvpn fileshare list --tag invoices'.`
 	MsgFileshareListInUsage       = "Show only incoming transfers."
 	MsgFileshareListOutUsage      = "Show only outgoing transfers."
+	MsgFileshareListTagUsage      = "Show only transfers with the given tag. Can be used multiple times to show transfers with all of the tags."
 	MsgFileshareCancelUsage       = "Cancel a transfer or a single file. To cancel an entire transfer, specify the transfer ID. To cancel a single file, specify the transfer ID and the file ID."
 	MsgFileshareCancelArgsUsage   = "<transfer_id> [file_id]"
 	MsgFileshareCancelSuccess     = "File transfer canceled."
 	MsgFileshareAcceptUsage       = "Accept an incoming file transfer. To download an entire transfer, specify the transfer ID. To download a single file, specify the transfer ID and the file ID."
 	MsgFileshareAcceptArgsUsage   = "<transfer_id> [file_id1] [file_id2...]"
 	MsgFileshareAcceptDescription = MsgFileshareAcceptUsage + "\n\nTo cancel a transfer in progress, press Ctrl+C"
-	MsgFileshareAcceptPathUsage   = "Specify download path (default: $XDG_DOWNLOAD_DIR or $HOME/Downloads)"
+	MsgFileshareAcceptPathUsage   = "Specify download path (default: $XDG_DOWNLOAD_DIR or $HOME/Downloads). Other directories than $HOME/Downloads have to be permitted with 'nordvpn fileshare set " + FileshareAcceptDirsName + "'"
+	MsgFileshareResumeUsage       = "Resume an interrupted incoming file transfer."
+	MsgFileshareResumeArgsUsage   = "<transfer_id>"
+	MsgFileshareResumeDescription = MsgFileshareResumeUsage + "\n\nTransfers are interrupted when the peer goes offline or the network changes. Files continue downloading from the already received part into the same directory, so large transfers don't have to start over.\n\nTo cancel a transfer in progress, press Ctrl+C"
+	MsgFileshareResumeNoWait      = "File transfer has been resumed in the background."
 	MsgFileshareClearUsage        = "Clear entries older than the specified time period from the file transfer history."
 	MsgFileshareClearArgsUsage    = "all|<time_period> [time_period...]"
 	MsgFileshareClearDescription  = MsgFileshareClearUsage + "\n\nSpecify the time period using the systemd time span syntax: https://www.freedesktop.org/software/systemd/man/latest/systemd.time.html\n\nFor example, \"nordvpn fileshare clear 1d 12h\" clears entries older than 36 hours. Use \"nordvpn fileshare clear all\" to remove all entries."
 	MsgFileshareClearSuccess      = "File transfer history cleared."
 	MsgFileshareClearFailure      = "Can't clear file transfer history. See nordfileshared.log for more details."
 
+	MsgFileshareSetConcurrencyUsage       = "Set the maximum number of file transfers downloaded at the same time. Use 0 to remove the limit."
+	MsgFileshareSetConcurrencyArgsUsage   = "<number>"
+	MsgFileshareSetConcurrencyDescription = MsgFileshareSetConcurrencyUsage + "\n\nAccepted transfers above the limit are queued and start in the order they were accepted once other transfers finish. The limit is kept until Meshnet is disabled."
+	MsgFileshareSetConcurrencySuccess     = "Concurrent file transfers are limited to %d."
+	MsgFileshareSetConcurrencyUnlimited   = "Concurrent file transfers are not limited."
+
+	MsgFileshareSetUsage             = "Set file transfer settings."
+	MsgFileshareRateLimitUsage       = "Limit the upload and download speed of file transfers. Use 0 to remove the limit."
+	MsgFileshareRateLimitArgsUsage   = "<size>"
+	MsgFileshareRateLimitDescription = MsgFileshareRateLimitUsage + "\n\nThe size is given per second, e.g. \"nordvpn fileshare set rate-limit 5MB\". Units are binary (1KB = 1024 bytes). Use --" + flagFileshareLimit + " with send or accept to override the limit for a single transfer. The limit is kept until Meshnet is disabled."
+	MsgFileshareRateLimitSuccess     = "File transfer speed is limited to %s/s."
+	MsgFileshareRateLimitUnlimited   = "File transfer speed is not limited."
+	MsgFileshareRateLimitError       = "Can't limit the file transfer speed. See nordvpnd.log for more details."
+	MsgFileshareLimitUsage           = "Limit the speed of this transfer, e.g. 5MB. Overrides the limit set with 'nordvpn fileshare set rate-limit' while the transfer is in progress."
+	MsgFileshareInvalidRateLimit     = "Invalid speed limit: %s"
+
+	MsgFileshareAcceptDirsUsage       = "Set the directories, besides the default download directory, which file transfers can be accepted into."
+	MsgFileshareAcceptDirsArgsUsage   = "[<directory>...]"
+	MsgFileshareAcceptDirsDescription = MsgFileshareAcceptDirsUsage + "\n\nOnly these directories, their subdirectories and the default download directory can be used with --" + flagFilesharePath + ", as the file sharing service cannot write anywhere else. Run the command without directories to permit only the default download directory. The change applies once the file sharing service is restarted, e.g. by turning Meshnet off and on."
+	MsgFileshareAcceptDirsSuccess     = "File transfers can be accepted into %s after the file sharing service is restarted."
+	MsgFileshareAcceptDirsDefault     = "File transfers can be accepted only into the default download directory after the file sharing service is restarted."
+	MsgFileshareAcceptDirsError       = "Can't save the directories: %s"
+
+	MsgFileshareWatchUsage       = "Send the files dropped into a folder to a Meshnet peer automatically. Lists the watched folders if no folder is provided."
+	MsgFileshareWatchArgsUsage   = "[folder] --" + flagFileshareTo + " <peer_hostname>|<peer_nickname>|<peer_ip>|<peer_pubkey>"
+	MsgFileshareWatchDescription = MsgFileshareWatchUsage + "\n\nFor example, \"nordvpn fileshare watch ~/outbox --" + flagFileshareTo + " my-laptop\" sends every file dropped into ~/outbox to my-laptop. Files already in the folder are not sent. A file is sent once it stops changing, and again only if it is modified later. Hidden files and subdirectories are skipped. If the peer is offline, the files are sent once it is back online."
+	MsgFileshareWatchToUsage     = "Peer receiving the files dropped into the folder."
+	MsgFileshareWatchMissingPeer = "Please provide the peer receiving the files via --" + flagFileshareTo + "."
+	MsgFileshareWatchSuccess     = "Files dropped into %s will be sent to %s."
+	MsgFileshareWatchNoFolders   = "No folders are watched."
+	MsgFileshareWatchListEntry   = "%s → %s (%d files sent)"
+	MsgFileshareWatchExists      = "This folder is already watched. Use 'nordvpn fileshare unwatch' first to send its files to another peer."
+	MsgFileshareWatchNotFound    = "This folder is not watched."
+	MsgFileshareWatchNotADir     = "Only folders can be watched."
+	MsgFileshareWatchFailure     = "Can't save the watched folders. See nordfileshared.log for more details."
+	MsgFileshareUnwatchUsage     = "Stop sending the files dropped into the folder."
+	MsgFileshareUnwatchArgsUsage = "<folder>"
+	MsgFileshareUnwatchSuccess   = "Folder %s is no longer watched."
+
+	MsgFileshareProgressUsage       = "Show the live progress of the file transfers. Shows the progress of the given transfer only if the transfer ID is provided."
+	MsgFileshareProgressArgsUsage   = "[transfer_id]"
+	MsgFileshareProgressNoTransfers = "No file transfers are in progress."
+	MsgFileshareProgressEta         = "%s left"
+
 	MsgFileshareProgressOngoing        = "File transfer [%s] progress [%d%%]"
 	MsgFileshareProgressFinished       = "File transfer [%s] completed.      " // Need extra spaces to cover the progress message
 	MsgFileshareProgressFinishedErrors = "File transfer [%s] completed. Some of the files have failed to transfer."
 	MsgFileshareProgressCanceledByPeer = "File transfer [%s] canceled by peer."
 	MsgFileshareProgressCanceled       = "File transfer [%s] canceled by other process."
+	MsgFileshareProgressInterrupted    = "File transfer [%s] interrupted. Resume it with 'nordvpn fileshare resume %s'."
 )
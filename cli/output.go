@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagOutput = "output"
+	// EnvOutput sets the output format when the flag is not given. Commands
+	// which print text only ignore it.
+	EnvOutput = "NORDVPN_OUTPUT"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+// OutputUsage is shown next to the output flag by nordvpn --help
+const OutputUsage = "Output format of status, settings, countries, cities, servers, history, cache-stats, " +
+	"meshnet peer list, meshnet matrix, fileshare list, profile list, split-tunnel list and " +
+	"autoconnect rule list, one of: text, json. Other commands fail with json. " +
+	"Defaults to $" + EnvOutput + ", which other commands ignore"
+
+// jsonCommands are the commands which can print JSON given by their names without
+// the program name. Other commands print messages meant for humans only.
+var jsonCommands = map[string]bool{
+	"status":                true,
+	"settings":              true,
+	"countries":             true,
+	"cities":                true,
+	"servers":               true,
+	"history":               true,
+	"cache-stats":           true,
+	"meshnet peer list":     true,
+	"meshnet matrix":        true,
+	"fileshare list":        true,
+	"profile list":          true,
+	"split-tunnel list":     true,
+	"autoconnect rule list": true,
+}
+
+func outputFlag() *cli.StringFlag {
+	// environment is read by outputFormat, so that it can be told apart from
+	// the flag
+	return &cli.StringFlag{
+		Name:  flagOutput,
+		Usage: OutputUsage,
+		Value: outputText,
+	}
+}
+
+// outputFormat returns the format given by the flag or by the environment if the
+// flag is not given. Returns true if the format was given by the flag.
+func outputFormat(ctx *cli.Context) (string, bool) {
+	if ctx.IsSet(flagOutput) {
+		return ctx.String(flagOutput), true
+	}
+	if format, ok := os.LookupEnv(EnvOutput); ok && format != "" {
+		return format, false
+	}
+	return outputText, false
+}
+
+// validateOutput checks the output format before any command is run
+func validateOutput(ctx *cli.Context) error {
+	format, _ := outputFormat(ctx)
+	switch strings.ToLower(format) {
+	case outputText, outputJSON:
+		return nil
+	default:
+		return formatError(fmt.Errorf("unsupported output format %s, use text or json", format))
+	}
+}
+
+// checkJSONSupported fails if JSON is requested with the flag for a command which
+// cannot print it, so that scripts do not have to parse the text by mistake.
+// JSON requested by the environment falls back to text for such commands.
+func checkJSONSupported(ctx *cli.Context) error {
+	format, explicit := outputFormat(ctx)
+	if !explicit || !strings.EqualFold(format, outputJSON) {
+		return nil
+	}
+	if name := commandPath(ctx); !jsonCommands[name] {
+		return formatError(fmt.Errorf("%s does not support json output", name))
+	}
+	return nil
+}
+
+// commandPath returns the names of the command and its parents without the
+// program name, e.g. meshnet peer list
+func commandPath(ctx *cli.Context) string {
+	var names []string
+	for _, c := range ctx.Lineage() {
+		if c.Command != nil {
+			names = append([]string{c.Command.Name}, names...)
+		}
+	}
+	// the outermost command is the program itself
+	if len(names) > 0 {
+		names = names[1:]
+	}
+	return strings.Join(names, " ")
+}
+
+// jsonOutput reports whether the command should print JSON instead of text
+func jsonOutput(ctx *cli.Context) bool {
+	format, explicit := outputFormat(ctx)
+	if !strings.EqualFold(format, outputJSON) {
+		return false
+	}
+	return explicit || jsonCommands[commandPath(ctx)]
+}
+
+func printJSON(v any) error {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return formatError(err)
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+// nonNilStrings makes empty lists appear as [] instead of null in JSON
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
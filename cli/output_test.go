@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func outputContext(t *testing.T, output string) *cli.Context {
+	t.Helper()
+	set := flag.NewFlagSet("test", 0)
+	require.NoError(t, outputFlag().Apply(set))
+	if output != "" {
+		require.NoError(t, set.Set(flagOutput, output))
+	}
+	return cli.NewContext(cli.NewApp(), set, &cli.Context{Context: context.Background()})
+}
+
+func TestValidateOutput(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		output   string
+		json     bool
+		hasError bool
+	}{
+		{output: ""},
+		{output: "text"},
+		{output: "json", json: true},
+		{output: "JSON", json: true},
+		{output: "yaml", hasError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.output, func(t *testing.T) {
+			ctx := outputContext(t, test.output)
+			err := validateOutput(ctx)
+			if test.hasError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.json, jsonOutput(ctx))
+		})
+	}
+}
+
+func TestOutputEnv(t *testing.T) {
+	category.Set(t, category.Unit)
+	t.Setenv(EnvOutput, "json")
+
+	var asJSON bool
+	action := func(ctx *cli.Context) error {
+		asJSON = jsonOutput(ctx)
+		return checkJSONSupported(ctx)
+	}
+	app := cli.NewApp()
+	app.Flags = []cli.Flag{outputFlag()}
+	app.Commands = []*cli.Command{
+		{Name: "status", Action: action},
+		{Name: "connect", Action: action},
+	}
+
+	assert.NoError(t, app.Run([]string{"nordvpn", "status"}))
+	assert.True(t, asJSON)
+	assert.NoError(t, app.Run([]string{"nordvpn", "--output", "text", "status"}))
+	assert.False(t, asJSON)
+	// text only commands ignore the environment
+	assert.NoError(t, app.Run([]string{"nordvpn", "connect"}))
+	assert.False(t, asJSON)
+	assert.Error(t, app.Run([]string{"nordvpn", "--output", "json", "connect"}))
+
+	t.Setenv(EnvOutput, "yaml")
+	assert.Error(t, validateOutput(outputContext(t, "")))
+}
+
+func TestCountriesJSON(t *testing.T) {
+	category.Set(t, category.Unit)
+	mockClient := mockDaemonClient{countries: []string{"France", "Germany"}}
+	c := cmd{&mockClient, nil, nil, "", nil}
+
+	result, err := captureOutput(func() {
+		assert.NoError(t, c.Countries(outputContext(t, "json")))
+	})
+	require.NoError(t, err)
+
+	var countries []string
+	require.NoError(t, json.Unmarshal([]byte(result), &countries))
+	assert.Equal(t, []string{"France", "Germany"}, countries)
+}
+
+func TestCheckJSONSupported(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		args     []string
+		path     string
+		hasError bool
+	}{
+		{name: "text", args: []string{"account"}, path: "account"},
+		{name: "supported", args: []string{"--output", "json", "status"}, path: "status"},
+		{
+			name: "supported subcommand",
+			args: []string{"--output", "json", "meshnet", "peer", "list"},
+			path: "meshnet peer list",
+		},
+		{name: "unsupported", args: []string{"--output", "json", "account"}, path: "account", hasError: true},
+		{
+			name:     "unsupported subcommand",
+			args:     []string{"--output", "json", "meshnet", "peer", "remove"},
+			path:     "meshnet peer remove",
+			hasError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var path string
+			action := func(ctx *cli.Context) error {
+				path = commandPath(ctx)
+				return checkJSONSupported(ctx)
+			}
+			app := cli.NewApp()
+			app.Flags = []cli.Flag{outputFlag()}
+			app.Commands = []*cli.Command{
+				{Name: "status", Action: action},
+				{Name: "account", Action: action},
+				{Name: "meshnet", Subcommands: []*cli.Command{{Name: "peer", Subcommands: []*cli.Command{
+					{Name: "list", Action: action},
+					{Name: "remove", Action: action},
+				}}}},
+			}
+
+			err := app.Run(append([]string{"nordvpn"}, test.args...))
+			assert.Equal(t, test.hasError, err != nil)
+			assert.Equal(t, test.path, path)
+		})
+	}
+}
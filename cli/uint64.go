@@ -3,6 +3,8 @@ package cli
 import (
 	"fmt"
 	"math/bits"
+	"strconv"
+	"strings"
 )
 
 func uint64ToHumanBytes(bytes uint64) string {
@@ -15,3 +17,25 @@ func uint64ToHumanBytes(bytes uint64) string {
 
 	return fmt.Sprintf("%.2f %ciB", val, " KMGTPE"[base])
 }
+
+// humanBytesToUint64 parses sizes such as 512KB, 5MiB or 1g. Units are binary.
+func humanBytesToUint64(size string) (uint64, error) {
+	value := strings.TrimSuffix(strings.ToUpper(strings.TrimSpace(size)), "B")
+
+	var base uint
+	if unit := strings.TrimSuffix(value, "I"); unit != "" {
+		if i := strings.IndexByte("KMGTPE", unit[len(unit)-1]); i != -1 {
+			base = uint(i + 1)
+			value = unit[:len(unit)-1]
+		}
+	}
+
+	num, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size: %s", size)
+	}
+	if num != 0 && bits.Len64(num)+int(base*10) > 64 {
+		return 0, fmt.Errorf("size is too big: %s", size)
+	}
+	return num << (base * 10), nil
+}
@@ -30,3 +30,41 @@ func TestUint64ToHumanBytes(t *testing.T) {
 		assert.Equal(t, got, data.expected)
 	}
 }
+
+func TestHumanBytesToUint64(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		input    string
+		expected uint64
+		hasError bool
+	}{
+		{input: "0", expected: 0},
+		{input: "100", expected: 100},
+		{input: "100B", expected: 100},
+		{input: "512KB", expected: 512 * 1024},
+		{input: "5MB", expected: 5 * 1024 * 1024},
+		{input: "5mib", expected: 5 * 1024 * 1024},
+		{input: "5M", expected: 5 * 1024 * 1024},
+		{input: "1 GB", expected: 1024 * 1024 * 1024},
+		{input: "", hasError: true},
+		{input: "MB", hasError: true},
+		{input: "5iB", hasError: true},
+		{input: "-5MB", hasError: true},
+		{input: "1.5MB", hasError: true},
+		{input: "5XB", hasError: true},
+		{input: "16EB", hasError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			got, err := humanBytesToUint64(test.input)
+			if test.hasError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/NordSecurity/nordvpn-linux/cli"
+	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 
 	"github.com/fatih/color"
@@ -25,7 +26,7 @@ var (
 	Version      = "0.0.0"
 	Environment  = ""
 	Hash         = ""
-	DaemonURL    = fmt.Sprintf("%s://%s", internal.Proto, internal.DaemonSocket)
+	DaemonURL    = fmt.Sprintf("%s://%s", internal.Proto, config.DaemonSocket(internal.DaemonSocket))
 	FileshareURL = fmt.Sprintf("%s://%s", internal.Proto, internal.GetFilesharedSocket(os.Getuid()))
 )
 
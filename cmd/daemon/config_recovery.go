@@ -0,0 +1,45 @@
+package main
+
+import (
+	"log"
+	"os"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// refusesConfigRecovery reports whether the recovery is refused by EnvConfigRecovery
+// or, if it is not set, by the daemon configuration file
+func refusesConfigRecovery(conf config.DaemonConf) bool {
+	if recovery, ok := os.LookupEnv(EnvConfigRecovery); ok {
+		return strings.EqualFold(recovery, config.ConfigRecoveryRefuse)
+	}
+	return conf.RefusesConfigRecovery()
+}
+
+// recoverConfig replaces the config which cannot be loaded with the default one,
+// unless the recovery is refused. Otherwise, persistent kill switch would block
+// the traffic until the config is fixed manually.
+func recoverConfig(fsystem *config.FilesystemConfigManager, loadErr error, refuse bool) config.Config {
+	log.Println(internal.ErrorPrefix, "config cannot be loaded:", loadErr)
+	if refuse {
+		log.Fatalln(internal.ErrorPrefix, "refusing to start with the default config, because",
+			"config recovery is set to", config.ConfigRecoveryRefuse)
+	}
+
+	backups, err := fsystem.Recover()
+	if err != nil {
+		log.Fatalln(internal.ErrorPrefix, "recovering config:", err)
+	}
+	for _, backup := range backups {
+		log.Println(internal.WarningPrefix, "unreadable file was backed up to", backup)
+	}
+	log.Println(internal.WarningPrefix, "starting with the default config, all settings were reset")
+
+	var cfg config.Config
+	if err := fsystem.Load(&cfg); err != nil {
+		log.Fatalln(internal.ErrorPrefix, "loading default config:", err)
+	}
+	return cfg
+}
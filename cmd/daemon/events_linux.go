@@ -29,6 +29,7 @@ func (*dummyAnalytics) NotifyDefaults(any) error                       { return
 func (*dummyAnalytics) NotifyConnect(events.DataConnect) error         { return nil }
 func (*dummyAnalytics) NotifyDisconnect(events.DataDisconnect) error   { return nil }
 func (*dummyAnalytics) NotifyLogin(any) error                          { return nil }
+func (*dummyAnalytics) NotifyLogout(any) error                         { return nil }
 func (*dummyAnalytics) NotifyAccountCheck(core.ServicesResponse) error { return nil }
 func (*dummyAnalytics) NotifyRequestAPI(events.DataRequestAPI) error   { return nil }
 func (*dummyAnalytics) NotifyRate(events.ServerRating) error           { return nil }
@@ -3,25 +3,32 @@
 package main
 
 import (
+	"io"
 	"os"
 	"strconv"
 
+	"github.com/NordSecurity/nordvpn-linux/daemon/logging"
 	"github.com/NordSecurity/nordvpn-linux/fileshare/service"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 )
 
-func fileshareImplementation() service.Fileshare {
+func fileshareImplementation(logRetention int) service.Fileshare {
+	fork := &service.ForkFileshare{
+		OpenLog: func(path string) io.WriteCloser {
+			return logging.NewRotatingFile(path, logRetention)
+		},
+	}
 	switch {
 	case os.Getenv(internal.ListenPID) == strconv.Itoa(os.Getpid()):
 		// Try to use systemd, but fallback to fork
-		return service.NewCombinedFileshare(&service.SystemdFileshare{}, &service.ForkFileshare{})
+		return service.NewCombinedFileshare(&service.SystemdFileshare{}, fork)
 	default:
 		/*
 			Start filesharing directly on non-systemd scenarios.
 			This comes with a drawback that after system reboot filesharing daemon will not
 			be started automatically as the main daemon is started before the user session starts.
 		*/
-		return &service.ForkFileshare{}
+		return fork
 	}
 
 }
@@ -6,6 +6,6 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/fileshare/service"
 )
 
-func fileshareImplementation() service.Fileshare {
+func fileshareImplementation(int) service.Fileshare {
 	return service.NoopFileshare{}
 }
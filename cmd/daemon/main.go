@@ -6,6 +6,7 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net"
 	"net/http"
@@ -14,25 +15,43 @@ import (
 	"os/exec"
 	"runtime"
 	"strconv"
+	"strings"
 
 	"github.com/NordSecurity/nordvpn-linux/auth"
 	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/core"
 	"github.com/NordSecurity/nordvpn-linux/daemon"
+	"github.com/NordSecurity/nordvpn-linux/daemon/dbusapi"
 	"github.com/NordSecurity/nordvpn-linux/daemon/device"
+	"github.com/NordSecurity/nordvpn-linux/daemon/diagnostics"
 	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
 	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
 	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/allowlist"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/blocked"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/boot"
 	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/iptables"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/nftables"
 	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/notables"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/ondemand"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/ratelimit"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/templates"
+	"github.com/NordSecurity/nordvpn-linux/daemon/health"
+	"github.com/NordSecurity/nordvpn-linux/daemon/hooks"
+	"github.com/NordSecurity/nordvpn-linux/daemon/logging"
+	"github.com/NordSecurity/nordvpn-linux/daemon/metered"
+	"github.com/NordSecurity/nordvpn-linux/daemon/metrics"
+	"github.com/NordSecurity/nordvpn-linux/daemon/netmanager"
 	"github.com/NordSecurity/nordvpn-linux/daemon/netstate"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/proxy"
 	"github.com/NordSecurity/nordvpn-linux/daemon/response"
+	"github.com/NordSecurity/nordvpn-linux/daemon/restapi"
 	"github.com/NordSecurity/nordvpn-linux/daemon/routes"
 	"github.com/NordSecurity/nordvpn-linux/daemon/routes/iprouter"
 	"github.com/NordSecurity/nordvpn-linux/daemon/routes/iprule"
 	"github.com/NordSecurity/nordvpn-linux/daemon/routes/norouter"
 	"github.com/NordSecurity/nordvpn-linux/daemon/routes/norule"
+	"github.com/NordSecurity/nordvpn-linux/daemon/splittunnel"
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/nordlynx"
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/openvpn"
 	"github.com/NordSecurity/nordvpn-linux/distro"
@@ -55,6 +74,7 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/request"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 // Values set when building the application
@@ -77,6 +97,24 @@ const (
 	// API client to ignore X-headers. This makes setting up MITM proxies up possible. This
 	// should not be used for regular usage.
 	EnvIgnoreHeaderValidation = "IGNORE_HEADER_VALIDATION"
+	// EnvConfigRecovery defines what is done when the config cannot be loaded. By default,
+	// the config is backed up and replaced with the default one. Setting this to `refuse`
+	// makes the daemon exit instead. It overrides config_recovery of the daemon
+	// configuration file.
+	EnvConfigRecovery = "CONFIG_RECOVERY"
+	// EnvLogFormat defines the format of the daemon log. Setting this to `json` makes the
+	// daemon emit a JSON object per record, which is easier to process by log collectors.
+	EnvLogFormat = "LOG_FORMAT"
+	// EnvLogFile defines the file the daemon logs to instead of the standard output. It
+	// is meant for systems without systemd, as the file is rotated by the daemon.
+	EnvLogFile = "LOG_FILE"
+	// EnvLogRetention defines for how many days the rotated log files of the daemon and
+	// the fileshare daemon are kept. Defaults to 14.
+	EnvLogRetention = "LOG_RETENTION"
+	// EnvHealthAddress defines the IP address with a port the health probes of the
+	// container orchestrators are responded on. It overrides the address from the
+	// daemon configuration file.
+	EnvHealthAddress = "NORDVPN_HEALTH_ADDRESS"
 )
 
 func init() {
@@ -109,9 +147,33 @@ func main() {
 
 	// Logging
 
-	log.SetOutput(os.Stdout)
+	logRetention := logging.ParseRetention(os.Getenv(EnvLogRetention))
+	var logOutput io.Writer = os.Stdout
+	if logFile := os.Getenv(EnvLogFile); logFile != "" {
+		logOutput = logging.NewRotatingFile(logFile, logRetention)
+	}
+	sessionLog := daemon.NewSessionLog(logOutput)
+	logWriter := logging.NewWriter(sessionLog, logging.ParseFormat(os.Getenv(EnvLogFormat)), logging.Info)
+	// time is added by the logWriter
+	log.SetFlags(0)
+	log.SetOutput(logWriter)
 	log.Println(internal.InfoPrefix, "Daemon has started")
 
+	// Daemon configuration file
+
+	var daemonConf config.DaemonConf
+	if conf, err := config.LoadDaemonConf(config.DaemonConfFilePath); err != nil {
+		log.Println(internal.ErrorPrefix, "ignoring daemon configuration file:", err)
+	} else {
+		daemonConf = conf
+	}
+	if daemonConf.Socket != "" && socketType(ConnType) == sockUnix {
+		ConnURL = daemonConf.Socket
+	}
+	if daemonConf.Group != "" {
+		internal.NordvpnGroup = daemonConf.Group
+	}
+
 	// Config
 
 	fsystem := config.NewFilesystemConfigManager(
@@ -121,13 +183,24 @@ func main() {
 		config.LinuxMachineIDGetter{},
 		config.StdFilesystemHandle{},
 	)
+	fsystem.SetDefaults(daemonConf.Defaults)
 	var cfg config.Config
 	if err := fsystem.Load(&cfg); err != nil {
-		log.Println(err)
-		if err := fsystem.Reset(); err != nil {
-			log.Fatalln(err)
+		cfg = recoverConfig(fsystem, err, refusesConfigRecovery(daemonConf))
+	}
+
+	envConf, envErr := daemon.ParseEnvConfig(os.LookupEnv)
+	if envErr != nil {
+		log.Println(internal.ErrorPrefix, "ignoring invalid environment variables:", envErr)
+	}
+	if envConf.HasSettings() {
+		if err := fsystem.SaveWith(envConf.Apply); err != nil {
+			log.Println(internal.ErrorPrefix, "applying settings from the environment:", err)
+		} else if err := fsystem.Load(&cfg); err != nil {
+			log.Println(internal.ErrorPrefix, err)
 		}
 	}
+	logWriter.SetLevel(daemon.LoggingLevel(cfg.LogLevel))
 
 	// Events
 
@@ -150,6 +223,7 @@ func main() {
 		&subs.Subject[events.DataConnect]{},
 		&subs.Subject[events.DataDisconnect]{},
 		&subs.Subject[any]{},
+		&subs.Subject[any]{},
 		&subs.Subject[core.ServicesResponse]{},
 		&subs.Subject[events.ServerRating]{},
 		&subs.Subject[int]{},
@@ -157,6 +231,7 @@ func main() {
 	meshnetEvents := meshnet.NewEvents(
 		&subs.Subject[[]string]{},
 		&subs.Subject[any]{},
+		&subs.Subject[events.DataExitNodeRecovery]{},
 	)
 	debugSubject := &subs.Subject[string]{}
 	infoSubject := &subs.Subject[string]{}
@@ -175,23 +250,55 @@ func main() {
 	httpCallsSubject.Subscribe(apiLogFn)
 	infoSubject.Subscribe(loggerSubscriber.NotifyInfo)
 	errSubject.Subscribe(loggerSubscriber.NotifyError)
+	meshnetEvents.ExitNodeRecovery.Subscribe(loggerSubscriber.NotifyExitNodeRecovery)
 
 	daemonEvents.Settings.Subscribe(logger.NewSubscriber())
 	daemonEvents.Settings.Publish(cfg)
 
+	// subscribed after the initial settings are published, as clients are not
+	// connected yet
+	eventStream := daemon.NewEventStream()
+	daemonEvents.Subscribe(eventStream)
+	meshnetEvents.Subscribe(eventStream)
+	dnsOverwriteSubject := &subs.Subject[events.DataDNSOverwrite]{}
+	dnsOverwriteSubject.Subscribe(eventStream.NotifyDNSOverwrite)
+	dnsOverwriteSubject.Subscribe(daemon.NotifyDNSOverwrite(fsystem))
+
 	// Firewall
 	stateModule := "conntrack"
 	stateFlag := "--ctstate"
 	chainPrefix := ""
-	iptablesAgent := iptables.New(
+	var firewallAgent firewall.Agent = iptables.New(
 		stateModule,
 		stateFlag,
 		chainPrefix,
 		iptables.FilterSupportedIPTables(internal.GetSupportedIPTables()),
 	)
+	firewallBackendCheck := daemon.CheckCommandsAvailable(internal.GetSupportedIPTables()...)
+	// routing marks, NAT and forwarding rules are applied with iptables by
+	// every backend
+	iptablesCheck := firewallBackendCheck
+	var nftablesInUse bool
+	if cfg.FirewallBackend == config.FirewallBackendNftables {
+		if features := daemon.IPTablesFeatures(cfg); len(features) > 0 {
+			log.Println(internal.WarningPrefix, "nftables firewall backend cannot be used with",
+				strings.Join(features, ", ")+", using iptables")
+		} else if nftablesAgent, err := nftables.New(); err != nil {
+			log.Println(internal.WarningPrefix, "nftables firewall backend is not available, using iptables:", err)
+		} else {
+			firewallAgent = nftablesAgent
+			nftablesInUse = true
+			// the table was created by the constructor, so the backend is usable
+			firewallBackendCheck = func() error { return nil }
+			if err := iptablesCheck(); err != nil {
+				log.Println(internal.WarningPrefix, "allowlist routing, meshnet exit node, split tunnel, "+
+					"rate limiting, on-demand and firewall templates require iptables:", err)
+			}
+		}
+	}
 	fw := firewall.NewFirewall(
 		&notables.Facade{},
-		iptablesAgent,
+		firewallAgent,
 		debugSubject,
 		cfg.Firewall,
 	)
@@ -209,8 +316,12 @@ func main() {
 	}
 
 	userAgent := fmt.Sprintf("NordApp Linux %s %s", Version, distro.KernelName())
+	httpProxy, err := request.NewProxy(daemonConf.Proxy)
+	if err != nil {
+		log.Fatalln("Error on creating proxy:", err)
+	}
 	// simple standard http client with dialer wrapped inside
-	httpClientSimple := request.NewStdHTTP()
+	httpClientSimple := request.NewStdHTTP(request.WithProxy(httpProxy))
 	httpClientSimple.Transport = request.NewPublishingRoundTripper(httpClientSimple.Transport, httpCallsSubject)
 	cdnAPI := core.NewCDNAPI(
 		userAgent,
@@ -228,13 +339,27 @@ func main() {
 		threatProtectionLiteServers = dns.NewNameServers(nameservers.Servers)
 	}
 
-	resolver := network.NewResolver(fw, threatProtectionLiteServers)
+	nat64 := network.NewNAT64()
+	resolver := network.NewResolver(fw, threatProtectionLiteServers, nat64)
 
 	if err := kernel.SetParameter(netCoreRmemMaxKey, netCodeRmemMaxValue); err != nil {
 		log.Println(internal.WarningPrefix, err)
 	}
+	var apiProxy request.Proxy
+	if httpProxy.IsUsedFor(daemon.BaseURL) {
+		log.Println(internal.InfoPrefix, "sending API requests through the proxy")
+		apiProxy = httpProxy
+	}
 	httpClientWithRotator := request.NewStdHTTP()
-	httpClientWithRotator.Transport = createTimedOutTransport(resolver, cfg.FirewallMark, httpCallsSubject, daemonEvents.Service.Connect)
+	httpClientWithRotator.Transport = createTimedOutTransport(resolver, cfg.FirewallMark, apiProxy, httpCallsSubject, daemonEvents.Service.Connect)
+	if len(daemonConf.APIMirrors) > 0 {
+		mirrorTransport, err := request.NewMirrorRoundTripper(httpClientWithRotator.Transport, daemon.BaseURL, daemonConf.APIMirrors)
+		if err != nil {
+			log.Println(internal.ErrorPrefix, "using API mirrors:", err)
+		} else {
+			httpClientWithRotator.Transport = mirrorTransport
+		}
+	}
 
 	defaultAPI := core.NewDefaultAPI(
 		userAgent,
@@ -256,8 +381,15 @@ func main() {
 		httpClientSimple,
 	)
 	gwret := routes.IPGatewayRetriever{}
-	dnsSetter := dns.NewSetter(infoSubject)
-	dnsHostSetter := dns.NewHostsFileSetter(dns.HostsFilePath)
+	dnsSetter := dns.NewSetter(infoSubject, cfg.LegacyDNS)
+	vpnDNSSetter := dns.NewMonitor(dnsSetter, func() bool {
+		var cfg config.Config
+		if err := fsystem.Load(&cfg); err != nil {
+			log.Println(internal.ErrorPrefix, "loading config for dns monitor:", err)
+		}
+		return cfg.DNSMonitor.Get()
+	}, infoSubject, dnsOverwriteSubject)
+	dnsHostSetter := dns.NewMeshnetResolver(dns.NewHostsFileSetter(dns.HostsFilePath))
 
 	eventsDbPath := fmt.Sprintf("%smoose.db", internal.DatFilesPath)
 	// TODO: remove once this is fixed: https://github.com/ziglang/zig/issues/11878
@@ -340,15 +472,24 @@ func main() {
 		cfg.Routing.Get(),
 	)
 
+	fwTemplates := templates.NewIPTables(func(command string, arg ...string) ([]byte, error) {
+		return exec.Command(command, arg...).CombinedOutput()
+	})
 	netw := networker.NewCombined(
 		vpn,
+		nordlynx.NewHop(cfg.FirewallMark),
 		mesh,
 		gwret,
+		routes.NetlinkDefaultRouteManager{},
 		infoSubject,
 		allowlistRouter,
-		dnsSetter,
+		vpnDNSSetter,
 		ipv6.NewIpv6(),
 		fw,
+		fwTemplates,
+		splittunnel.NewCgroup(func(command string, arg ...string) ([]byte, error) {
+			return exec.Command(command, arg...).CombinedOutput()
+		}, cfg.FirewallMark),
 		allowlist.NewAllowlistRouting(func(command string, arg ...string) ([]byte, error) {
 			return exec.Command(command, arg...).CombinedOutput()
 		}),
@@ -358,6 +499,7 @@ func main() {
 			iprule.NewRouter(
 				routes.NewSysctlRPFilterManager(),
 				cfg.FirewallMark,
+				uint(cfg.RoutingTable),
 			),
 			cfg.Routing.Get(),
 		),
@@ -374,10 +516,74 @@ func main() {
 			)),
 		cfg.FirewallMark,
 		cfg.LanDiscovery,
+		cfg.DefaultRouteMode,
+		cfg.DNSIPv6.Get(),
 	)
+	if err := netw.SetFirewallTemplates(cfg.FirewallTemplates); err != nil {
+		log.Println(internal.ErrorPrefix, "applying firewall templates:", err)
+	}
+	if err := netw.SetSplitTunnel(cfg.SplitTunnel); err != nil {
+		log.Println(internal.ErrorPrefix, "setting split tunnel:", err)
+	}
+	netw.SetKillSwitchLog(cfg.KillSwitchLog)
+	if err := netw.SetKillSwitchLAN(cfg.KillSwitchLAN); err != nil {
+		log.Println(internal.ErrorPrefix, "setting kill switch LAN access:", err)
+	}
+	if err := netw.SetMulticastDiscovery(cfg.MulticastDiscovery); err != nil {
+		log.Println(internal.ErrorPrefix, "setting multicast discovery:", err)
+	}
+	netw.SetSubnetOverlapMode(cfg.SubnetOverlapMode)
+	netw.SetIPv6Mode(cfg.GetIPv6Mode())
+	if err := netw.SetDNSSearchDomains(cfg.DNSSearchDomains); err != nil {
+		log.Println(internal.WarningPrefix, "setting DNS search domains:", err)
+	}
+	if err := netw.SetDNSRoutes(cfg.DNSRoutes); err != nil {
+		log.Println(internal.WarningPrefix, "setting DNS routes:", err)
+	}
+	if err := netw.SetDNSFilter(cfg.DNSFilter); err != nil {
+		log.Println(internal.WarningPrefix, "setting DNS filter:", err)
+	}
+	if err := netw.SetMTU(cfg.MTU); err != nil {
+		log.Println(internal.WarningPrefix, "setting MTU of the tunnel:", err)
+	}
+
+	metricsCollector := metrics.NewCollector(netw)
+	daemonEvents.Service.Connect.Subscribe(metricsCollector.NotifyConnect)
+	daemonEvents.Service.Disconnect.Subscribe(metricsCollector.NotifyDisconnect)
+	httpCallsSubject.Subscribe(metricsCollector.NotifyRequestAPI)
+	metricsServer := metrics.NewServer(metricsCollector)
+	if err := metricsServer.Set(cfg.Metrics); err != nil {
+		log.Println(internal.ErrorPrefix, "starting metrics server:", err)
+	}
+
+	// REST API forwards the requests to the gRPC server of the daemon, the
+	// connection is established once the first request is received
+	restAPIConn, err := grpc.Dial(daemonTarget(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalln(internal.ErrorPrefix, "creating REST API client:", err)
+	}
+	restAPIServer := restapi.NewServer(pb.NewDaemonClient(restAPIConn))
+	if err := restAPIServer.Set(cfg.RESTAPI); err != nil {
+		log.Println(internal.ErrorPrefix, "starting REST API server:", err)
+	}
+
+	localProxy := proxy.NewServer(func() (string, bool) {
+		status, err := netw.ConnectionStatus()
+		return status.Interface, err == nil
+	})
+	if err := localProxy.Set(cfg.LocalProxy); err != nil {
+		log.Println(internal.ErrorPrefix, "starting local proxy:", err)
+	}
+
+	blockedTraffic := blocked.NewMonitor()
+	go func() {
+		if err := blockedTraffic.Run(); err != nil {
+			log.Println(internal.WarningPrefix, "monitoring blocked traffic:", err)
+		}
+	}()
 
 	// RPC Servers
-	fileshareImplementation := fileshareImplementation()
+	fileshareImplementation := fileshareImplementation(logRetention)
 
 	keygen, err := keygenImplementation(vpnFactory)
 	if err != nil {
@@ -418,6 +624,49 @@ func main() {
 		daemon.VersionFilePath,
 	)
 
+	selfTestChecks := []daemon.SelfTestCheck{
+		{
+			Name: "data files",
+			Run: func() error {
+				return daemon.NewDataManager(
+					daemon.InsightsFilePath,
+					daemon.ServersDataFilePath,
+					daemon.CountryDataFilePath,
+					daemon.VersionFilePath,
+				).LoadData()
+			},
+		},
+		{
+			Name:     "firewall backend",
+			Critical: true,
+			Run:      firewallBackendCheck,
+		},
+		{
+			Name:     "tun device",
+			Critical: true,
+			Run:      daemon.CheckFilesExist("/dev/net/tun"),
+		},
+		{
+			Name: "API",
+			Run:  daemon.CheckURLReachable(httpClientSimple, daemon.BaseURL),
+		},
+		{
+			Name:     "DNS backend",
+			Critical: true,
+			Run: func() error {
+				_, err := dnsSetter.MethodName()
+				return err
+			},
+		},
+	}
+	if nftablesInUse {
+		selfTestChecks = append(selfTestChecks, daemon.SelfTestCheck{
+			Name: "iptables",
+			Run:  iptablesCheck,
+		})
+	}
+	selfTest := daemon.NewSelfTest(selfTestChecks)
+
 	rpc := daemon.NewRPC(
 		internal.Environment(Environment),
 		authChecker,
@@ -434,6 +683,7 @@ func main() {
 		daemonEvents,
 		vpnFactory,
 		&endpointResolver,
+		nat64,
 		netw,
 		debugSubject,
 		threatProtectionLiteServers,
@@ -441,6 +691,24 @@ func main() {
 		analytics,
 		fileshareImplementation,
 		meshAPIex,
+		ondemand.NewIPTables(func(command string, arg ...string) ([]byte, error) {
+			return exec.Command(command, arg...).CombinedOutput()
+		}),
+		dnsSetter,
+		blockedTraffic,
+		metered.NetworkManager{},
+		daemon.NewHTTPPortalProber(cfg.FirewallMark),
+		sessionLog,
+		selfTest,
+		metricsServer,
+		localProxy,
+		diagnostics.NewLeakTest(diagnostics.SocketProber{}, gwret),
+		logWriter,
+		daemon.NewConnectionHistory(daemon.HistoryFilePath),
+		hooks.NewRunner(hooks.DefaultDir),
+		eventStream,
+		restAPIServer,
+		boot.NewKillSwitch(),
 	)
 	meshService := meshnet.NewServer(
 		authChecker,
@@ -454,15 +722,63 @@ func main() {
 		meshnetEvents.PeerUpdate,
 		daemonEvents.Settings.Meshnet,
 		daemonEvents.Service.Connect,
+		meshnetEvents.ExitNodeRecovery,
 		fileshareImplementation,
+		ratelimit.NewIPTables(func(command string, arg ...string) ([]byte, error) {
+			return exec.Command(command, arg...).CombinedOutput()
+		}),
+		rpc,
+		daemon.NewInviteNotifier(fsystem),
 	)
 
-	s := grpc.NewServer(grpc.Creds(internal.UnixSocketCredentials{}))
+	healthServer := health.NewServer(rpc.CheckHealth)
+	healthAddress := daemonConf.HealthAddress
+	if address := os.Getenv(EnvHealthAddress); address != "" {
+		healthAddress = address
+	}
+	if healthAddress != "" {
+		if err := healthServer.Start(healthAddress); err != nil {
+			log.Println(internal.ErrorPrefix, "starting health server:", err)
+		}
+	}
+
+	idleExit := daemon.NewIdleExit(daemonConf.IdleExitTimeout())
+	s := grpc.NewServer(
+		grpc.Creds(internal.UnixSocketCredentials{}),
+		grpc.ChainUnaryInterceptor(idleExit.UnaryInterceptor),
+		grpc.ChainStreamInterceptor(idleExit.StreamInterceptor),
+	)
 	pb.RegisterDaemonServer(s, rpc)
 	meshpb.RegisterMeshnetServer(s, meshService)
 
+	dbusService := dbusapi.NewService(rpc)
+	daemonEvents.Service.Connect.Subscribe(dbusService.NotifyConnect)
+	daemonEvents.Service.Disconnect.Subscribe(dbusService.NotifyDisconnect)
+	if err := dbusService.Start(); err != nil {
+		log.Println(internal.WarningPrefix, "starting D-Bus service:", err)
+	}
+
+	nmIntegration := netmanager.NewIntegration(func() string {
+		status, err := netw.ConnectionStatus()
+		if err != nil {
+			return ""
+		}
+		return status.Interface
+	}, func() bool {
+		var cfg config.Config
+		if err := fsystem.Load(&cfg); err != nil {
+			log.Println(internal.ErrorPrefix, "loading config for NetworkManager integration:", err)
+		}
+		return cfg.NetworkManager.Get()
+	})
+	daemonEvents.Service.Connect.Subscribe(nmIntegration.NotifyConnect)
+	daemonEvents.Service.Disconnect.Subscribe(nmIntegration.NotifyDisconnect)
+
 	// Start jobs
 
+	// systemd listener is used by default, manual one if pids mismatch
+	socketActivated := socketType(ConnType) == sockUnix &&
+		os.Getenv(internal.ListenPID) == strconv.Itoa(os.Getpid())
 	go func() {
 		var (
 			listener net.Listener
@@ -470,16 +786,21 @@ func main() {
 		)
 		switch socketType(ConnType) {
 		case sockUnix:
-			// use systemd listener by default
 			var listenerFunction = internal.SystemDListener
-			// switch to manual if pids mismatch
-			if os.Getenv(internal.ListenPID) != strconv.Itoa(os.Getpid()) {
+			manual := !socketActivated
+			if manual {
 				listenerFunction = internal.ManualListener(ConnURL, internal.PermUserRWGroupRW)
 			}
 			listener, err = listenerFunction()
 			if err != nil {
 				log.Fatalf("Error on listening to UNIX domain socket: %s\n", err)
 			}
+			// socket created by systemd belongs to the group of the socket unit
+			if manual && daemonConf.Group != "" {
+				if err := chownToNordvpnGroup(ConnURL); err != nil {
+					log.Println(internal.WarningPrefix, "changing group of the socket:", err)
+				}
+			}
 		case sockTCP:
 			listener, err = net.Listen("tcp", ConnURL)
 			if err != nil {
@@ -498,15 +819,26 @@ func main() {
 			log.Println(internal.WarningPrefix, err)
 		}
 	}()
+	go selfTest.Run()
 	go rpc.StartJobs()
 	go meshService.StartJobs()
 	rpc.StartKillSwitch()
 
-	if cfg.AutoConnect {
-		go rpc.StartAutoConnect(network.ExponentialBackoff)
-	}
+	go func() {
+		// connecting requires the user to be logged in
+		if envConf.Token != "" {
+			rpc.StartTokenLogin(envConf.Token, network.ExponentialBackoff)
+		}
+		if cfg.AutoConnect {
+			rpc.StartAutoConnect(network.ExponentialBackoff)
+		}
+	}()
 
-	monitor, err := netstate.NewNetlinkMonitor([]string{openvpn.InterfaceName, nordlynx.InterfaceName})
+	tunnelInterfaces := []string{openvpn.InterfaceName, nordlynx.InterfaceName}
+	if cfg.InterfaceName != "" {
+		tunnelInterfaces = append(tunnelInterfaces, cfg.InterfaceName)
+	}
+	monitor, err := netstate.NewNetlinkMonitor(tunnelInterfaces)
 	if err != nil {
 		log.Fatalln(err)
 	}
@@ -520,13 +852,42 @@ func main() {
 		go rpc.StartAutoMeshnet(meshService, network.ExponentialBackoff)
 	}
 
+	internal.OnReloadSignal(func() {
+		if _, err := rpc.ReloadConfig(); err != nil {
+			log.Println(internal.ErrorPrefix, "reloading config:", err)
+		}
+	})
+
 	// Graceful stop
 
-	internal.WaitSignal()
+	// only systemd can start the daemon again once it exits
+	var idle <-chan struct{}
+	if socketActivated && daemonConf.IdleExitTimeout() > 0 {
+		idle = rpc.StartIdleExit(idleExit)
+	}
+	internal.WaitTerminationSignalOr(idle)
 
+	if err := restAPIServer.Stop(); err != nil {
+		log.Println(internal.ErrorPrefix, "stopping REST API server:", err)
+	}
+	if err := restAPIConn.Close(); err != nil {
+		log.Println(internal.ErrorPrefix, "closing REST API client:", err)
+	}
+	if err := healthServer.Stop(); err != nil {
+		log.Println(internal.ErrorPrefix, "stopping health server:", err)
+	}
 	s.GracefulStop()
+	if err := metricsServer.Stop(); err != nil {
+		log.Println(internal.ErrorPrefix, "stopping metrics server:", err)
+	}
+	if err := localProxy.Stop(); err != nil {
+		log.Println(internal.ErrorPrefix, "stopping local proxy:", err)
+	}
+	if err := dbusService.Stop(); err != nil {
+		log.Println(internal.ErrorPrefix, "stopping D-Bus service:", err)
+	}
 
-	if err := dnsSetter.Unset(""); err != nil {
+	if err := vpnDNSSetter.Unset(""); err != nil {
 		log.Printf("unsetting dns: %s", err)
 	}
 	if err := fsystem.Load(&cfg); err != nil {
@@ -546,7 +907,33 @@ func main() {
 	if err := netw.UnSetMesh(); err != nil && !errors.Is(err, networker.ErrMeshNotActive) {
 		log.Println(internal.ErrorPrefix, "disconnecting from meshnet:", err)
 	}
+	for _, stage := range []templates.Stage{templates.Persistent, templates.PreConnect, templates.PostDisconnect} {
+		if err := fwTemplates.Remove(stage); err != nil {
+			log.Println(internal.ErrorPrefix, "removing firewall template:", err)
+		}
+	}
+	if err := rpc.StopOnDemand(); err != nil {
+		log.Println(internal.ErrorPrefix, "stopping on-demand:", err)
+	}
 	if err := rpc.StopKillSwitch(); err != nil {
 		log.Println(internal.ErrorPrefix, "stopping KillSwitch:", err)
 	}
 }
+
+// chownToNordvpnGroup allows the members of the nordvpn group to access the file
+func chownToNordvpnGroup(path string) error {
+	gid, err := internal.GetNordvpnGid()
+	if err != nil {
+		return err
+	}
+	return os.Chown(path, -1, gid)
+}
+
+// daemonTarget is the address of the gRPC server for the clients running
+// inside of the daemon
+func daemonTarget() string {
+	if socketType(ConnType) == sockUnix {
+		return "unix://" + ConnURL
+	}
+	return ConnURL
+}
@@ -31,7 +31,10 @@ const (
 	envHTTPTransportsKey = "HTTP_TRANSPORTS"
 )
 
-func createH1Transport(resolver network.DNSResolver, fwmark uint32) func() http.RoundTripper {
+// createH1Transport creates the transport resolving the API domains with the
+// resolver. If proxy is not nil, requests are sent through it and its domain is
+// resolved by the system, as the proxy can be reachable only in the local network.
+func createH1Transport(resolver network.DNSResolver, fwmark uint32, proxy request.Proxy) func() http.RoundTripper {
 	return func() http.RoundTripper {
 		var operr error
 		fwmark := func(fd uintptr) {
@@ -51,6 +54,13 @@ func createH1Transport(resolver network.DNSResolver, fwmark uint32) func() http.
 			},
 			Timeout: request.DefaultTimeout,
 		}
+		if proxy != nil {
+			return &http.Transport{
+				Proxy:               proxy,
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: request.TransportTimeout,
+			}
+		}
 		return &http.Transport{
 			DialContext: func(ctx context.Context, netw, addr string) (net.Conn, error) {
 				domain, _, ok := strings.Cut(addr, ":")
@@ -121,16 +131,21 @@ func validateHTTPTransportsString(val string) []string {
 	return finalVal
 }
 
-// createTimedOutTransports provides transports to APIs' client
+// createTimedOutTransports provides transports to APIs' client. Only http1 is
+// used with the proxy, because http3 cannot be sent through it.
 func createTimedOutTransport(
 	resolver network.DNSResolver,
 	fwmark uint32,
+	proxy request.Proxy,
 	httpCallsSubject events.Publisher[events.DataRequestAPI],
 	connectSubject events.PublishSubcriber[events.DataConnect],
 ) http.RoundTripper {
 	transportsStr := os.Getenv(envHTTPTransportsKey)
 	log.Println(internal.InfoPrefix, "http transports to use (environment):", transportsStr)
 	transportTypes := validateHTTPTransportsString(transportsStr)
+	if proxy != nil {
+		transportTypes = []string{"http1"}
+	}
 	log.Println(internal.InfoPrefix, "http transports to use (after validation):", transportTypes)
 
 	containsH1 := slices.Contains(transportTypes, "http1")
@@ -139,7 +154,7 @@ func createTimedOutTransport(
 	var h1Transport http.RoundTripper
 	var h3Transport http.RoundTripper
 	if containsH1 {
-		h1ReTransport := request.NewHTTPReTransport(createH1Transport(resolver, fwmark))
+		h1ReTransport := request.NewHTTPReTransport(createH1Transport(resolver, fwmark, proxy))
 		connectSubject.Subscribe(h1ReTransport.NotifyConnect)
 		h1Transport = request.NewPublishingRoundTripper(
 			h1ReTransport,
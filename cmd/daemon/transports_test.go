@@ -66,13 +66,13 @@ func TestTransports(t *testing.T) {
 		{
 			comment:     "test older transport small req/resp",
 			inputURL:    serverListSmallURL,
-			transport:   createH1Transport(&workingResolver{}, 0)(),
+			transport:   createH1Transport(&workingResolver{}, 0, nil)(),
 			expectError: false,
 		},
 		{
 			comment:     "test older transport large resp",
 			inputURL:    serverListLargeURL,
-			transport:   createH1Transport(&workingResolver{}, 0)(),
+			transport:   createH1Transport(&workingResolver{}, 0, nil)(),
 			expectError: false,
 		},
 		{
@@ -90,7 +90,7 @@ func TestTransports(t *testing.T) {
 		{
 			comment:     "test non quic/H3 url with H1 transport",
 			inputURL:    nonH3serverURL,
-			transport:   createH1Transport(&workingResolver{}, 0)(),
+			transport:   createH1Transport(&workingResolver{}, 0, nil)(),
 			expectError: false,
 		},
 		{
@@ -124,7 +124,7 @@ func TestH1Transport_RoundTrip(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.ip, func(t *testing.T) {
-			transport := createH1Transport(&workingResolver{IP: test.ip}, 0)()
+			transport := createH1Transport(&workingResolver{IP: test.ip}, 0, nil)()
 			req, err := http.NewRequest(http.MethodGet, serverListSmallURL, nil)
 			assert.NoError(t, err)
 			resp, err := transport.RoundTrip(req)
@@ -23,7 +23,7 @@ func getVpnFactory(eventsDbPath string, fwmark uint32, envIsDev bool,
 	return func(tech config.Technology) (vpn.VPN, error) {
 		switch tech {
 		case config.Technology_NORDLYNX:
-			return nordlynx.NewKernelSpace(fwmark), nil
+			return nordlynx.NewFallback(fwmark), nil
 		case config.Technology_OPENVPN:
 			return openvpn.New(fwmark), nil
 		case config.Technology_UNKNOWN_TECHNOLOGY:
@@ -46,6 +46,9 @@ func (noopMesh) Tun() tunnel.T                   { return &tunnel.Tunnel{} }
 func (noopMesh) StatusMap() (map[string]string, error) {
 	return map[string]string{}, nil
 }
+func (noopMesh) PathMap() (map[string]string, error) {
+	return map[string]string{}, nil
+}
 func (noopMesh) NetworkChanged() error {
 	return fmt.Errorf("not supported")
 }
@@ -54,8 +54,14 @@ func main() {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 		0,
 		false,
+		config.DefaultRouteReplace,
+		true,
 	)
 	daemon.JobInsights(dm, api, netw, true)()
 	if err := daemon.JobCountries(dm, api)(); err != nil {
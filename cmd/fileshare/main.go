@@ -15,11 +15,13 @@ import (
 	"path"
 	"strconv"
 
+	"github.com/NordSecurity/nordvpn-linux/config"
 	daemonpb "github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/nordlynx"
 	"github.com/NordSecurity/nordvpn-linux/fileshare"
 	"github.com/NordSecurity/nordvpn-linux/fileshare/libdrop"
 	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/fileshare/sandbox"
 	"github.com/NordSecurity/nordvpn-linux/fileshare/storage"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	meshpb "github.com/NordSecurity/nordvpn-linux/meshnet/pb"
@@ -33,12 +35,74 @@ var (
 	Environment = ""
 	PprofPort   = 6961
 	ConnURL     = internal.GetFilesharedSocket(os.Getuid())
-	DaemonURL   = fmt.Sprintf("%s://%s", internal.Proto, internal.DaemonSocket)
+	DaemonURL   = fmt.Sprintf("%s://%s", internal.Proto, config.DaemonSocket(internal.DaemonSocket))
 )
 
 const transferHistoryChunkSize = 10000
 
 func main() {
+	// Logging
+
+	log.SetOutput(os.Stdout)
+
+	// Sandbox
+
+	currentUser, err := user.Current()
+	if err != nil {
+		log.Fatalf("can't retrieve current user info: %s", err)
+	}
+	// we have to hardcode config directory, using os.UserConfigDir is not viable as nordfileshared
+	// is spawned by nordvpnd(owned by root) and inherits roots environment variables
+	storagePath := path.Join(
+		currentUser.HomeDir,
+		internal.ConfigDirectory,
+		internal.UserDataPath,
+		internal.FileshareHistoryFile,
+	)
+	if err := internal.EnsureDir(storagePath); err != nil {
+		log.Fatalf("ensuring dir for transfer history file: %s", err)
+	}
+	// socket directory cannot be created inside the sandbox
+	if err := internal.EnsureDir(ConnURL); err != nil {
+		log.Fatalf("ensuring dir for socket: %s", err)
+	}
+	defaultDownloadDirectory, err := fileshare.GetDefaultDownloadDirectory()
+	if err != nil {
+		log.Println("failed to find default download directory: ", err.Error())
+	}
+	var acceptDirs []string
+	if defaultDownloadDirectory != "" {
+		acceptDirs = append(acceptDirs, defaultDownloadDirectory)
+	}
+	// directories permitted with 'nordvpn fileshare set accept-directories'
+	permittedDirs, err := fileshare.LoadAcceptDirectories(fileshare.AcceptDirsPath(currentUser.HomeDir))
+	if err != nil {
+		log.Println(internal.WarningPrefix, err)
+	}
+	acceptDirs = append(acceptDirs, permittedDirs...)
+	// SQLite temporary files are kept in a private directory instead of the
+	// shared one, which other users can write to
+	tempDir := path.Join(path.Dir(storagePath), internal.FileshareTempDir)
+	if err := ensurePrivateDir(tempDir); err != nil {
+		log.Fatalf("ensuring temporary directory: %s", err)
+	}
+	for _, key := range []string{"TMPDIR", "SQLITE_TMPDIR"} {
+		if err := os.Setenv(key, tempDir); err != nil {
+			log.Fatalf("setting temporary directory: %s", err)
+		}
+	}
+	writablePaths := append([]string{
+		path.Dir(storagePath),
+		internal.DatFilesPath,
+		path.Dir(ConnURL),
+		tempDir,
+		// standard streams of the applications opened from notifications
+		os.DevNull,
+	}, acceptDirs...)
+	if err := sandbox.Enter(writablePaths); err != nil {
+		log.Fatalf("entering sandbox: %s", err)
+	}
+
 	// Pprof
 	go func() {
 		if internal.IsDevEnv(Environment) {
@@ -49,9 +113,6 @@ func main() {
 		}
 	}()
 
-	// Logging
-
-	log.SetOutput(os.Stdout)
 	log.Println(internal.InfoPrefix, "Daemon has started")
 
 	// Connection to Meshnet gRPC server
@@ -75,11 +136,6 @@ func main() {
 	}
 
 	// Libdrop init
-	defaultDownloadDirectory, err := fileshare.GetDefaultDownloadDirectory()
-	if err != nil {
-		log.Println("failed to find default download directory: ", err.Error())
-	}
-
 	eventManager := fileshare.NewEventManager(
 		internal.IsProdEnv(Environment),
 		meshClient,
@@ -87,6 +143,8 @@ func main() {
 		fileshare.NewStdFilesystem("/"),
 		defaultDownloadDirectory,
 	)
+	// nothing else is writable in the sandbox
+	eventManager.SetAcceptDirectories(acceptDirs)
 
 	privKeyResponse, err := meshClient.GetPrivateKey(context.Background(), &meshpb.Empty{})
 	if err != nil || privKeyResponse.GetPrivateKey() == "" {
@@ -97,21 +155,6 @@ func main() {
 		log.Fatalf("can't decode mesh private key: %v", err)
 	}
 
-	currentUser, err := user.Current()
-	if err != nil {
-		log.Fatalf("can't retrieve current user info: %s", err)
-	}
-	// we have to hardcode config directory, using os.UserConfigDir is not viable as nordfileshared
-	// is spawned by nordvpnd(owned by root) and inherits roots environment variables
-	storagePath := path.Join(
-		currentUser.HomeDir,
-		internal.ConfigDirectory,
-		internal.UserDataPath,
-		internal.FileshareHistoryFile,
-	)
-	if err := internal.EnsureDir(storagePath); err != nil {
-		log.Fatalf("ensuring dir for transfer history file: %s", err)
-	}
 	eventsDbPath := fmt.Sprintf("%smoose.db", internal.DatFilesPath)
 	fileshareImplementation := libdrop.New(
 		eventManager.EventFunc,
@@ -124,7 +167,8 @@ func main() {
 	)
 	eventManager.SetFileshare(fileshareImplementation)
 	legacyStoragePath := path.Join(currentUser.HomeDir, internal.ConfigDirectory, internal.UserDataPath)
-	eventManager.SetStorage(storage.NewCombined(legacyStoragePath, fileshareImplementation))
+	historyStorage := storage.NewCombined(legacyStoragePath, fileshareImplementation)
+	eventManager.SetStorage(historyStorage)
 
 	settings, err := daemonClient.Settings(context.Background(), &daemonpb.SettingsRequest{
 		Uid: int64(os.Getuid()),
@@ -152,9 +196,20 @@ func main() {
 	// Fileshare gRPC server init
 	fileshareServer := fileshare.NewServer(fileshareImplementation,
 		eventManager,
+		historyStorage,
 		meshClient, fileshare.NewStdFilesystem("/"),
 		fileshare.StdOsInfo{},
 		transferHistoryChunkSize)
+	watchesPath := path.Join(
+		currentUser.HomeDir,
+		internal.ConfigDirectory,
+		internal.UserDataPath,
+		internal.FileshareWatchesFile,
+	)
+	folderWatcher := fileshare.NewFolderWatcher(watchesPath, fileshare.NewStdFilesystem("/"), eventManager)
+	fileshareServer.SetFolderWatcher(folderWatcher)
+	folderWatcher.Start(fileshare.WatchScanInterval)
+
 	grpcServer := grpc.NewServer()
 	pb.RegisterFileshareServer(grpcServer, fileshareServer)
 
@@ -176,6 +231,7 @@ func main() {
 	// Teardown
 
 	internal.WaitSignal()
+	folderWatcher.Stop()
 	eventManager.CancelLiveTransfers()
 
 	grpcServer.GracefulStop()
@@ -206,3 +262,12 @@ func firstAddressByInterfaceName(name string) (netip.Addr, error) {
 
 	return ip.Addr(), nil
 }
+
+// ensurePrivateDir creates the directory accessible only by the user, or
+// restricts the existing one
+func ensurePrivateDir(dir string) error {
+	if err := os.MkdirAll(dir, internal.PermUserRWX); err != nil {
+		return err
+	}
+	return os.Chmod(dir, internal.PermUserRWX)
+}
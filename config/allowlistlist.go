@@ -2,6 +2,10 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // NewAllowlist ready to use
@@ -30,10 +34,118 @@ func NewAllowlist(udpPorts []int64, tcpPorts []int64, subnets []string) Allowlis
 	}
 }
 
-// Allowlist is a collection of ports and subnets
+// Allowlist is a collection of ports, subnets and domains
 type Allowlist struct {
 	Ports   Ports   `json:"ports"`
 	Subnets Subnets `json:"subnets"`
+	// Domains are resolved by the daemon and their addresses are allowlisted
+	// the same way as subnets
+	Domains Domains `json:"domains,omitempty"`
+	// Expiry maps the keys of the temporary entries to the time they are
+	// removed at
+	Expiry map[string]time.Time `json:"expiry,omitempty"`
+}
+
+// PortKey identifies the allowlisted port in Expiry
+func PortKey(protocol string, port int64) string {
+	return fmt.Sprintf("port/%s/%d", strings.ToLower(protocol), port)
+}
+
+// SubnetKey identifies the allowlisted subnet in Expiry
+func SubnetKey(subnet string) string {
+	return "subnet/" + subnet
+}
+
+// DomainKey identifies the allowlisted domain in Expiry
+func DomainKey(domain string) string {
+	return "domain/" + domain
+}
+
+// Has returns true if the entry identified by the key is allowlisted
+func (a Allowlist) Has(key string) bool {
+	kind, value, _ := strings.Cut(key, "/")
+	switch kind {
+	case "subnet":
+		return a.Subnets[value]
+	case "domain":
+		return a.Domains[value]
+	case "port":
+		protocol, port, _ := strings.Cut(value, "/")
+		number, err := strconv.ParseInt(port, 10, 64)
+		if err != nil {
+			return false
+		}
+		switch protocol {
+		case "tcp":
+			return a.Ports.TCP[number]
+		case "udp":
+			return a.Ports.UDP[number]
+		}
+	}
+	return false
+}
+
+// WithoutExpired returns a copy of the allowlist without the entries which have
+// expired at now. Returns true if any entries were removed.
+func (a Allowlist) WithoutExpired(now time.Time) (Allowlist, bool) {
+	expired := map[string]bool{}
+	for key, expiry := range a.Expiry {
+		if !now.Before(expiry) {
+			expired[key] = true
+		}
+	}
+	if len(expired) == 0 {
+		return a, false
+	}
+
+	result := Allowlist{
+		Ports:   Ports{TCP: PortSet{}, UDP: PortSet{}},
+		Subnets: Subnets{},
+	}
+	for port := range a.Ports.TCP {
+		if !expired[PortKey("tcp", port)] {
+			result.Ports.TCP[port] = true
+		}
+	}
+	for port := range a.Ports.UDP {
+		if !expired[PortKey("udp", port)] {
+			result.Ports.UDP[port] = true
+		}
+	}
+	for subnet := range a.Subnets {
+		if !expired[SubnetKey(subnet)] {
+			result.Subnets[subnet] = true
+		}
+	}
+	for domain := range a.Domains {
+		if !expired[DomainKey(domain)] {
+			if result.Domains == nil {
+				result.Domains = Domains{}
+			}
+			result.Domains[domain] = true
+		}
+	}
+	for key, expiry := range a.Expiry {
+		if !expired[key] {
+			if result.Expiry == nil {
+				result.Expiry = map[string]time.Time{}
+			}
+			result.Expiry[key] = expiry
+		}
+	}
+	return result, true
+}
+
+// NewDomains creates a set of domains
+func NewDomains(domains []string) Domains {
+	if len(domains) == 0 {
+		return nil
+	}
+	set := Domains{}
+	for _, domain := range domains {
+		set[domain] = true
+	}
+	return set
 }
 
 // Subnets is a set of subnets.
@@ -74,6 +186,44 @@ func (s *Subnets) ToSlice() []string {
 	return result
 }
 
+// Domains is a set of domains.
+type Domains map[string]bool
+
+// MarshalJSON into []string.
+func (d Domains) MarshalJSON() ([]byte, error) {
+	var domains []string
+	for domain := range d {
+		domains = append(domains, domain)
+	}
+
+	return json.Marshal(domains)
+}
+
+// UnmarshalJSON into map[string]bool.
+func (d *Domains) UnmarshalJSON(b []byte) error {
+	var i []string
+	err := json.Unmarshal(b, &i)
+	if err != nil {
+		return err
+	}
+
+	domains := map[string]bool{}
+	for _, domain := range i {
+		domains[domain] = true
+	}
+
+	*d = domains
+	return nil
+}
+
+func (d *Domains) ToSlice() []string {
+	result := make([]string, 0, len(*d))
+	for domain := range *d {
+		result = append(result, domain)
+	}
+	return result
+}
+
 // Ports is a collection of TCP and UDP ports.
 type Ports struct {
 	TCP PortSet `json:"tcp"`
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowlist_Has(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	allowlist := NewAllowlist([]int64{53}, []int64{22}, []string{"10.0.0.0/8"})
+	allowlist.Domains = NewDomains([]string{"intranet.corp.com"})
+
+	assert.True(t, allowlist.Has(PortKey("TCP", 22)))
+	assert.True(t, allowlist.Has(PortKey("udp", 53)))
+	assert.False(t, allowlist.Has(PortKey("udp", 22)))
+	assert.True(t, allowlist.Has(SubnetKey("10.0.0.0/8")))
+	assert.False(t, allowlist.Has(SubnetKey("192.168.0.0/16")))
+	assert.True(t, allowlist.Has(DomainKey("intranet.corp.com")))
+	assert.False(t, allowlist.Has("port/tcp/ssh"))
+	assert.False(t, allowlist.Has("unknown"))
+}
+
+func TestAllowlist_WithoutExpired(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Now()
+	allowlist := NewAllowlist([]int64{22}, []int64{22, 80}, []string{"10.0.0.0/8", "192.168.0.0/16"})
+	allowlist.Domains = NewDomains([]string{"intranet.corp.com"})
+	allowlist.Expiry = map[string]time.Time{
+		PortKey("tcp", 22):             now.Add(-time.Second),
+		SubnetKey("10.0.0.0/8"):        now,
+		SubnetKey("192.168.0.0/16"):    now.Add(time.Hour),
+		DomainKey("intranet.corp.com"): now.Add(-time.Hour),
+	}
+
+	result, removed := allowlist.WithoutExpired(now)
+	assert.True(t, removed)
+	assert.Equal(t, PortSet{80: true}, result.Ports.TCP)
+	assert.Equal(t, PortSet{22: true}, result.Ports.UDP)
+	assert.Equal(t, Subnets{"192.168.0.0/16": true}, result.Subnets)
+	assert.Nil(t, result.Domains)
+	assert.Equal(t, map[string]time.Time{SubnetKey("192.168.0.0/16"): now.Add(time.Hour)}, result.Expiry)
+	// original allowlist is not modified
+	assert.Len(t, allowlist.Expiry, 4)
+	assert.True(t, allowlist.Ports.TCP[22])
+
+	_, removed = result.WithoutExpired(now)
+	assert.False(t, removed)
+}
@@ -0,0 +1,75 @@
+package config
+
+import (
+	"strings"
+)
+
+// AutoConnectAction is what is done after joining the network matching the rule
+type AutoConnectAction string
+
+const (
+	// AutoConnectActionConnect connects to the server or group of the rule
+	AutoConnectActionConnect AutoConnectAction = "connect"
+	// AutoConnectActionSkip leaves the network unprotected, auto-connect on
+	// daemon start is skipped too
+	AutoConnectActionSkip AutoConnectAction = "skip"
+)
+
+// NetworkType is the type of the interface of the network connection
+type NetworkType string
+
+const (
+	NetworkTypeWiFi     NetworkType = "wifi"
+	NetworkTypeEthernet NetworkType = "ethernet"
+	NetworkTypeMobile   NetworkType = "mobile"
+)
+
+// AutoConnectRule is applied after joining the network matching all of its
+// non-empty criteria
+type AutoConnectRule struct {
+	SSID string `json:"ssid,omitempty"`
+	// BSSID is the MAC address of the access point
+	BSSID       string            `json:"bssid,omitempty"`
+	NetworkType NetworkType       `json:"network_type,omitempty"`
+	Action      AutoConnectAction `json:"action"`
+	ServerTag   string            `json:"server_tag,omitempty"`
+	ServerGroup string            `json:"server_group,omitempty"`
+}
+
+// Network describes the network connection the rules are matched against
+type Network struct {
+	SSID  string
+	BSSID string
+	Type  NetworkType
+}
+
+// Matches reports whether the network matches all of the criteria of the rule.
+// Rule without criteria does not match any network.
+func (r AutoConnectRule) Matches(network Network) bool {
+	if r.SSID == "" && r.BSSID == "" && r.NetworkType == "" {
+		return false
+	}
+	return (r.SSID == "" || r.SSID == network.SSID) &&
+		(r.BSSID == "" || strings.EqualFold(r.BSSID, network.BSSID)) &&
+		(r.NetworkType == "" || r.NetworkType == network.Type)
+}
+
+// SameCriteria reports whether both rules match the same networks
+func (r AutoConnectRule) SameCriteria(other AutoConnectRule) bool {
+	return r.SSID == other.SSID &&
+		strings.EqualFold(r.BSSID, other.BSSID) &&
+		r.NetworkType == other.NetworkType
+}
+
+// AutoConnectRules are matched in order, the first matching rule is applied
+type AutoConnectRules []AutoConnectRule
+
+// Match returns the first rule matching the network
+func (r AutoConnectRules) Match(network Network) (AutoConnectRule, bool) {
+	for _, rule := range r {
+		if rule.Matches(network) {
+			return rule, true
+		}
+	}
+	return AutoConnectRule{}, false
+}
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoConnectRules_Match(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	rules := AutoConnectRules{
+		{SSID: "HomeLAN", Action: AutoConnectActionSkip},
+		{SSID: "CoffeeShop", BSSID: "AA:BB:CC:DD:EE:FF", Action: AutoConnectActionConnect, ServerTag: "lt"},
+		{SSID: "CoffeeShop", Action: AutoConnectActionConnect, ServerGroup: "obfuscated"},
+		{NetworkType: NetworkTypeMobile, Action: AutoConnectActionConnect},
+		{Action: AutoConnectActionSkip},
+	}
+
+	tests := []struct {
+		name     string
+		network  Network
+		expected int
+	}{
+		{name: "ssid", network: Network{SSID: "HomeLAN", Type: NetworkTypeWiFi}, expected: 0},
+		{name: "ssid is case sensitive", network: Network{SSID: "homelan", Type: NetworkTypeWiFi}, expected: -1},
+		{name: "bssid", network: Network{SSID: "CoffeeShop", BSSID: "aa:bb:cc:dd:ee:ff"}, expected: 1},
+		{name: "first match", network: Network{SSID: "CoffeeShop", BSSID: "11:22:33:44:55:66"}, expected: 2},
+		{name: "network type", network: Network{Type: NetworkTypeMobile}, expected: 3},
+		{name: "rule without criteria", network: Network{Type: NetworkTypeEthernet}, expected: -1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rule, ok := rules.Match(test.network)
+			if test.expected == -1 {
+				assert.False(t, ok)
+				return
+			}
+			assert.True(t, ok)
+			assert.Equal(t, rules[test.expected], rule)
+		})
+	}
+}
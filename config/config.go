@@ -57,8 +57,365 @@ type Config struct {
 	LanDiscovery    bool                `json:"lan_discovery"`
 	RemoteConfig    string              `json:"remote_config,omitempty"`
 	RCLastUpdate    time.Time           `json:"rc_last_update,omitempty"`
+	// DefaultRouteMode defines how the conflicting default route is handled on connect
+	DefaultRouteMode DefaultRouteMode `json:"default_route_mode,omitempty"`
+	// IPv6Mode defines how the IPv6 traffic is handled while connected. It
+	// supersedes IPv6, use GetIPv6Mode to read it
+	IPv6Mode IPv6Mode `json:"ipv6_mode,omitempty"`
+	// FirewallBackend defines which backend applies the firewall rules
+	FirewallBackend FirewallBackend `json:"firewall_backend,omitempty"`
+	OnDemand        OnDemand        `json:"on_demand"`
+	// FirewallTemplates are user supplied firewall rules applied alongside the app rules
+	FirewallTemplates FirewallTemplates `json:"firewall_templates"`
+	// Favorites are named servers and groups imported by the user
+	Favorites Favorites `json:"favorites,omitempty"`
+	// DNSIPv6 defines whether IPv6 nameservers are configured on connect
+	DNSIPv6 TrueField `json:"dns_ipv6"`
+	// DNSSearchDomains are appended to the search list of the system resolver on connect
+	DNSSearchDomains []string `json:"dns_search_domains,omitempty"`
+	// DNSRoutes send the queries of the domains to the internal nameservers on connect
+	DNSRoutes DNSRoutes `json:"dns_routes,omitempty"`
+	// DNSFilter are the record types answered locally with an empty answer on
+	// connect instead of being resolved, e.g. AAAA or HTTPS
+	DNSFilter []string `json:"dns_filter,omitempty"`
+	// IdleDisconnect tears down the connection which had no traffic for a while
+	IdleDisconnect IdleDisconnect `json:"idle_disconnect"`
+	// Reconnect controls the retries after the VPN connection drops and of the
+	// auto-connect on start
+	Reconnect ReconnectPolicy `json:"reconnect,omitempty"`
+	// ServerNotes are notes and ratings given to servers by the user
+	ServerNotes ServerNotes `json:"server_notes,omitempty"`
+	// RatingWeight is the percentage by which server ratings affect the pick of the
+	// recommended server, 0 disables it
+	RatingWeight uint32 `json:"rating_weight,omitempty"`
+	// KillSwitchLog defines whether a summary of the traffic dropped by the kill
+	// switch is logged
+	KillSwitchLog bool `json:"kill_switch_log,omitempty"`
+	// KillSwitchLAN defines whether the private and link-local network traffic is
+	// allowed while the kill switch is set, regardless of the LAN discovery
+	KillSwitchLAN bool `json:"kill_switch_lan,omitempty"`
+	// MulticastDiscovery defines whether the mDNS and SSDP discovery traffic is
+	// allowed while the kill switch is set, without allowing the rest of the LAN
+	MulticastDiscovery bool `json:"multicast_discovery,omitempty"`
+	// LogLevel defines the minimum level of the daemon log messages
+	LogLevel LogLevel `json:"log_level,omitempty"`
+	// ServerSelection defines how the server is picked when connecting by country,
+	// city or group
+	ServerSelection ServerSelection `json:"server_selection,omitempty"`
+	// ObfuscationMode defines how the obfuscated OpenVPN connections are
+	// disguised
+	ObfuscationMode ObfuscationMode `json:"obfuscation_mode,omitempty"`
+	// InterfaceName of the VPN tunnel, empty means the default name of the
+	// technology
+	InterfaceName string `json:"interface_name,omitempty"`
+	// MTU of the VPN tunnel, 0 means that it is fitted to the path MTU to the
+	// server
+	MTU uint32 `json:"mtu,omitempty"`
+	// RoutingTable used for the VPN traffic, 0 means that the first table not
+	// in use is picked
+	RoutingTable uint32 `json:"routing_table,omitempty"`
+	// LegacyDNS forces setting DNS by modifying resolv.conf even if
+	// systemd-resolved is running
+	LegacyDNS bool `json:"legacy_dns,omitempty"`
+	// Metered defines the behavior on metered network connections
+	Metered Metered `json:"metered"`
+	// AllowedTechnologies restricts the technologies used to connect, so that
+	// the connection is never made with the technology the user does not want
+	AllowedTechnologies Technologies `json:"allowed_technologies,omitempty"`
+	// SubnetOverlapMode defines how the LAN subnets overlapping with the VPN
+	// subnets are handled on connect
+	SubnetOverlapMode SubnetOverlapMode `json:"subnet_overlap_mode,omitempty"`
+	// CaptivePortalNetworks are the names of the network connections with a
+	// captive portal, kill switch is relaxed for a while after joining them
+	CaptivePortalNetworks []string `json:"captive_portal_networks,omitempty"`
+	// TrustedNetworks are the network connection names or the SSIDs on which
+	// VPN is disconnected and kill switch is relaxed
+	TrustedNetworks []string `json:"trusted_networks,omitempty"`
+	// TrustedNetworkAddresses are the hardware addresses of the gateways or the
+	// access points of the trusted networks, keyed by the network. Networks
+	// are trusted only with the address they were added with.
+	TrustedNetworkAddresses map[string]string `json:"trusted_network_addresses,omitempty"`
+	// TCPNetworks are the names of the network connections on which OpenVPN
+	// over UDP failed repeatedly, TCP is used on them right away
+	TCPNetworks []string `json:"tcp_networks,omitempty"`
+	// ObfuscatedNetworks are the names of the network connections on which plain
+	// OpenVPN was interfered with, obfuscated servers are used on them right away
+	ObfuscatedNetworks []string `json:"obfuscated_networks,omitempty"`
+	// AutoTechnology defines whether the technology is picked for each network
+	// by probing which one gets through. Technology is the last one picked.
+	AutoTechnology bool `json:"auto_technology,omitempty"`
+	// AutoTechnologyNetworks are the technologies picked by the fingerprints of
+	// the networks, so that the probing is not repeated on every connect
+	AutoTechnologyNetworks map[string]Technology `json:"auto_technology_networks,omitempty"`
+	// SplitTunnel selects the applications which bypass the VPN or which are the
+	// only ones using it
+	SplitTunnel SplitTunnel `json:"split_tunnel"`
+	// Metrics defines whether the daemon serves the metrics for Prometheus
+	Metrics Metrics `json:"metrics"`
+	// AutoConnectRules define what is done after joining the matching networks
+	AutoConnectRules AutoConnectRules `json:"autoconnect_rules,omitempty"`
+	// LocalProxy defines whether the daemon serves the local proxy forwarding
+	// through the tunnel
+	LocalProxy LocalProxy `json:"local_proxy"`
+	// Profiles are named connection settings saved by the user
+	Profiles Profiles `json:"profiles,omitempty"`
+	// ServerPorts are the destination ports pinned by the user for each technology
+	ServerPorts ServerPorts `json:"server_ports"`
+	// RESTAPI defines whether the daemon API is exposed over HTTP as JSON
+	RESTAPI RESTAPI `json:"rest_api"`
+	// VirtualLocation defines whether the virtual location servers are used.
+	// Their traffic exits in another country than the advertised one.
+	VirtualLocation TrueField `json:"virtual_location"`
+	// DNSMonitor defines whether DNS is watched while connected and re-applied
+	// if it was overwritten by another process
+	DNSMonitor TrueField `json:"dns_monitor"`
+	// NetworkManager defines whether the tunnel is handed over to NetworkManager
+	// while connected
+	NetworkManager TrueField `json:"network_manager"`
 }
 
+// ServerPorts stores the port of the VPN server connected to for each
+// technology. Zero means that the port is picked by the app.
+type ServerPorts struct {
+	OpenVPN  uint16 `json:"openvpn,omitempty"`
+	NordLynx uint16 `json:"nordlynx,omitempty"`
+}
+
+// Get returns the port pinned for the technology
+func (p ServerPorts) Get(tech Technology) uint16 {
+	switch tech {
+	case Technology_OPENVPN:
+		return p.OpenVPN
+	case Technology_NORDLYNX:
+		return p.NordLynx
+	case Technology_UNKNOWN_TECHNOLOGY:
+		fallthrough
+	default:
+		return 0
+	}
+}
+
+// Set returns a copy with the port of the technology replaced
+func (p ServerPorts) Set(tech Technology, port uint16) ServerPorts {
+	switch tech {
+	case Technology_OPENVPN:
+		p.OpenVPN = port
+	case Technology_NORDLYNX:
+		p.NordLynx = port
+	case Technology_UNKNOWN_TECHNOLOGY:
+	}
+	return p
+}
+
+// LocalProxy stores settings of the local SOCKS5 and HTTP proxy, which lets
+// single applications use the VPN connection
+type LocalProxy struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// Port to listen on. Zero means the default port.
+	Port uint16 `json:"port,omitempty"`
+}
+
+// RESTAPI stores settings of the local HTTP listener exposing the daemon API
+// as JSON for the clients without protobuf tooling
+type RESTAPI struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// Address to listen on. Empty means the default address.
+	Address string `json:"address,omitempty"`
+	// Token authenticates the requests. It is generated when the API is
+	// enabled for the first time and kept until a new one is requested.
+	Token string `json:"token,omitempty"`
+}
+
+// Metrics stores settings of the local listener serving the daemon metrics in
+// the Prometheus text format.
+type Metrics struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// Address to listen on. Empty means the default address.
+	Address string `json:"address,omitempty"`
+}
+
+// SplitTunnel stores the applications routed differently from the rest of the
+// system while connected to VPN. Applications are binary names or absolute paths.
+type SplitTunnel struct {
+	Mode SplitTunnelMode `json:"mode,omitempty"`
+	Apps []string        `json:"apps,omitempty"`
+}
+
+// FirewallTemplates stores paths of the root-owned firewall rule files for each
+// stage of the connection lifecycle. Empty path means that the stage is not used.
+type FirewallTemplates struct {
+	// Persistent rules are present while the daemon is running
+	Persistent string `json:"persistent,omitempty"`
+	// PreConnect rules are applied before connecting and removed after disconnecting
+	PreConnect string `json:"pre_connect,omitempty"`
+	// PostDisconnect rules are applied after disconnecting and removed before connecting
+	PostDisconnect string `json:"post_disconnect,omitempty"`
+}
+
+// OnDemand stores settings of the VPN connection which is established only when
+// there is outgoing traffic and torn down after being idle.
+type OnDemand struct {
+	Enabled bool `json:"enabled"`
+	// IdleTimeout after which the connection is torn down. Zero means the default timeout.
+	IdleTimeout time.Duration `json:"idle_timeout,omitempty"`
+}
+
+// IdleDisconnect stores settings of the automatic disconnect of the idle VPN
+// connection. Unlike OnDemand, the connection is not established again.
+type IdleDisconnect struct {
+	// Timeout after which the idle connection is torn down. Zero disables the disconnect.
+	Timeout time.Duration `json:"timeout,omitempty"`
+	// KillSwitch is enabled after the idle disconnect, so that the traffic is blocked
+	KillSwitch bool `json:"kill_switch,omitempty"`
+}
+
+// ReconnectPolicy stores how the failed background connects are retried
+type ReconnectPolicy struct {
+	// MaxRetries bounds the number of retries. Zero retries until connected.
+	MaxRetries uint32 `json:"max_retries,omitempty"`
+	// Backoff is the delay before the first retry, doubled with each retry.
+	// Zero means the default schedule.
+	Backoff time.Duration `json:"backoff,omitempty"`
+	// Jitter is the upper bound of the random delay added to each retry
+	Jitter time.Duration `json:"jitter,omitempty"`
+	// Aggressive retries right after the drop and then every second regardless
+	// of the backoff
+	Aggressive bool `json:"aggressive,omitempty"`
+}
+
+// Metered stores the policy applied while the primary network connection is
+// metered, so that the background activity does not use up the data plan.
+type Metered struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// AllowRefresh keeps the background refresh of the server list and other data
+	AllowRefresh bool `json:"allow_refresh,omitempty"`
+	// AllowAutoConnect keeps auto-connect and on-demand connection
+	AllowAutoConnect bool `json:"allow_auto_connect,omitempty"`
+	// Networks are the names of the network connections which are metered
+	// regardless of what the system reports
+	Networks []string `json:"networks,omitempty"`
+}
+
+// DefaultRouteMode defines how a default route which conflicts with the one
+// added for the VPN connection is handled.
+type DefaultRouteMode string
+
+const (
+	// DefaultRouteReplace removes the conflicting default route until disconnect.
+	// It is the default mode.
+	DefaultRouteReplace DefaultRouteMode = ""
+	// DefaultRouteCoexist keeps the conflicting default route, but lowers its
+	// priority so that the VPN route takes precedence.
+	DefaultRouteCoexist DefaultRouteMode = "coexist"
+	// DefaultRouteRefuse refuses to connect while the conflicting default route exists.
+	DefaultRouteRefuse DefaultRouteMode = "refuse"
+)
+
+// IPv6Mode defines how the IPv6 traffic is handled while connected to VPN
+type IPv6Mode string
+
+const (
+	// IPv6Block drops all of the IPv6 traffic with the firewall. It is the
+	// default mode.
+	IPv6Block IPv6Mode = ""
+	// IPv6Tunnel routes the IPv6 traffic through the VPN if the server supports
+	// it, otherwise IPv6 is disabled.
+	IPv6Tunnel IPv6Mode = "tunnel"
+	// IPv6Native leaves IPv6 untouched, so the IPv6 traffic bypasses the VPN.
+	IPv6Native IPv6Mode = "native"
+)
+
+// GetIPv6Mode returns the IPv6 mode, taking the IPv6 toggle of the older
+// configs into account.
+func (c Config) GetIPv6Mode() IPv6Mode {
+	if c.IPv6Mode == IPv6Block && c.IPv6 {
+		return IPv6Tunnel
+	}
+	return c.IPv6Mode
+}
+
+// FirewallBackend defines how the firewall rules are applied
+type FirewallBackend string
+
+const (
+	// FirewallBackendIPTables applies the rules with iptables. It is the default backend.
+	FirewallBackendIPTables FirewallBackend = ""
+	// FirewallBackendNftables applies the rules to a separate nftables table via
+	// netlink, so that they coexist with the tables of other firewall managers.
+	FirewallBackendNftables FirewallBackend = "nftables"
+)
+
+// SubnetOverlapMode defines how a LAN subnet which overlaps with the subnet
+// used by the VPN is handled.
+type SubnetOverlapMode string
+
+const (
+	// SubnetOverlapWarn only reports the overlapping subnets. It is the default mode.
+	SubnetOverlapWarn SubnetOverlapMode = ""
+	// SubnetOverlapPreferLAN routes the overlapping addresses to the LAN.
+	SubnetOverlapPreferLAN SubnetOverlapMode = "prefer-lan"
+	// SubnetOverlapPreferVPN routes the overlapping addresses to the VPN.
+	SubnetOverlapPreferVPN SubnetOverlapMode = "prefer-vpn"
+)
+
+// LogLevel defines the minimum level of the daemon log messages
+type LogLevel string
+
+const (
+	// LogLevelInfo logs everything except the debug messages. It is the default level.
+	LogLevelInfo LogLevel = ""
+	// LogLevelDebug logs all of the messages.
+	LogLevelDebug LogLevel = "debug"
+	// LogLevelWarn logs only the warnings and errors.
+	LogLevelWarn LogLevel = "warn"
+)
+
+// ServerSelection defines how the server is picked from the candidates
+type ServerSelection string
+
+const (
+	// ServerSelectionLoad picks the server recommended by the API, which is based on
+	// the distance and the load. It is the default selection.
+	ServerSelectionLoad ServerSelection = ""
+	// ServerSelectionLatency picks the candidate with the lowest round trip time.
+	ServerSelectionLatency ServerSelection = "latency"
+	// ServerSelectionAuto picks the candidate with the best combination of the round
+	// trip time and the load.
+	ServerSelectionAuto ServerSelection = "auto"
+)
+
+// ObfuscationMode defines how the obfuscated OpenVPN connections are disguised
+type ObfuscationMode string
+
+const (
+	// ObfuscationModeXOR scrambles the OpenVPN packets. It is the default mode.
+	ObfuscationModeXOR ObfuscationMode = ""
+	// ObfuscationModeTLS wraps the OpenVPN connection over TCP in TLS on port 443.
+	ObfuscationModeTLS ObfuscationMode = "tls"
+	// ObfuscationModeWebSocket wraps the OpenVPN connection over TCP in WebSocket
+	// over TLS on port 443.
+	ObfuscationModeWebSocket ObfuscationMode = "websocket"
+)
+
+// IsWrapped reports whether the connection is wrapped in another protocol,
+// which requires OpenVPN over TCP
+func (m ObfuscationMode) IsWrapped() bool {
+	return m == ObfuscationModeTLS || m == ObfuscationModeWebSocket
+}
+
+// SplitTunnelMode defines whether the split tunnel applications bypass the VPN
+// or are the only ones using it
+type SplitTunnelMode string
+
+const (
+	// SplitTunnelExclude routes the traffic of the applications outside of the
+	// VPN. It is the default mode.
+	SplitTunnelExclude SplitTunnelMode = ""
+	// SplitTunnelInclude routes only the traffic of the applications through the
+	// VPN, the rest of the traffic bypasses it.
+	SplitTunnelInclude SplitTunnelMode = "include"
+)
+
 type AutoConnectData struct {
 	ID        int64    `json:"id,omitempty"`
 	ServerTag string   `json:"server_tag,omitempty"`
@@ -89,8 +446,35 @@ type NCData struct {
 }
 
 type meshnet struct {
-	EnabledByUID uint32 `json:"enabled_by_uid"` // Linux user which enabled meshnet
-	EnabledByGID uint32 `json:"enabled_by_gid"` // Group of Linux user which enabled meshnet
+	EnabledByUID     uint32           `json:"enabled_by_uid"` // Linux user which enabled meshnet
+	EnabledByGID     uint32           `json:"enabled_by_gid"` // Group of Linux user which enabled meshnet
+	ExitNodeFallback ExitNodeFallback `json:"exit_node_fallback"`
+	// PeerGroups maps the group names to the identifiers of their peers
+	PeerGroups map[string][]string `json:"peer_groups,omitempty"`
+}
+
+// ExitNodeFallbackMode defines how the daemon recovers when a meshnet peer
+// used as an exit node stops responding.
+type ExitNodeFallbackMode string
+
+const (
+	// ExitNodeFallbackReconnect waits for the same peer to come back and reconnects to it.
+	// It is the default mode.
+	ExitNodeFallbackReconnect ExitNodeFallbackMode = ""
+	// ExitNodeFallbackPeer switches to another meshnet peer.
+	ExitNodeFallbackPeer ExitNodeFallbackMode = "peer"
+	// ExitNodeFallbackVPN switches to a regular VPN server.
+	ExitNodeFallbackVPN ExitNodeFallbackMode = "vpn"
+	// ExitNodeFallbackOff disables the recovery.
+	ExitNodeFallbackOff ExitNodeFallbackMode = "off"
+)
+
+// ExitNodeFallback stores exit node recovery settings.
+type ExitNodeFallback struct {
+	Mode ExitNodeFallbackMode `json:"mode,omitempty"`
+	// Target is a peer identifier for ExitNodeFallbackPeer or an optional
+	// server tag for ExitNodeFallbackVPN.
+	Target string `json:"target,omitempty"`
 }
 
 func (d *NCData) IsUserIDEmpty() bool {
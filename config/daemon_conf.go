@@ -0,0 +1,211 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// DaemonConfFilePath defines the location of the daemon configuration file
+const DaemonConfFilePath = "/etc/nordvpn/daemon.conf"
+
+// Values of the config recovery
+const (
+	ConfigRecoveryReset  = "reset"
+	ConfigRecoveryRefuse = "refuse"
+)
+
+// DaemonConf is read by the daemon at startup from a TOML file. It is meant for
+// image based and immutable deployments, where the settings cannot be
+// conveniently changed with the cli after boot. Settings which can be changed
+// with the cli are the defaults, which are used until the user changes them and
+// after the settings are reset.
+type DaemonConf struct {
+	// Socket is the path of the unix socket the daemon listens on. It is used by
+	// the cli as well. With systemd socket activation the socket is created by
+	// systemd, so the socket unit has to be changed accordingly.
+	Socket string `toml:"socket"`
+	// Group is allowed to access the daemon instead of the nordvpn group. The
+	// D-Bus policy installed with the package names the nordvpn group, so it
+	// has to be changed to the same group for the D-Bus API.
+	Group string `toml:"group"`
+	// LogLevel is one of debug, info or warn
+	LogLevel string `toml:"log_level"`
+	// FirewallBackend is either iptables or nftables
+	FirewallBackend string `toml:"firewall_backend"`
+	// APIMirrors are the base URLs of the API mirrors, which are tried in order
+	// when the API cannot be reached
+	APIMirrors []string `toml:"api_mirrors"`
+	// Proxy is the URL of the http, https or socks5 proxy the API requests are
+	// sent through. HTTPS_PROXY environment variable is used if it is empty.
+	// VPN tunnel does not use the proxy.
+	Proxy string `toml:"proxy"`
+	// Technology is either nordlynx or openvpn
+	Technology string `toml:"technology"`
+	// HealthAddress is the IP address with a port the health probes are
+	// responded on. Health is not served if it is empty.
+	HealthAddress string `toml:"health_address"`
+	// IdleExit is the duration, e.g. 15m, after which the unused daemon exits
+	// when it was started by the systemd socket activation. The daemon keeps
+	// running while it is needed, e.g. connected or with the kill switch
+	// enabled. The daemon does not exit if it is empty.
+	IdleExit string `toml:"idle_exit"`
+	// ConfigRecovery is what is done when the config cannot be loaded at
+	// startup. The config is backed up and reset to the defaults with reset,
+	// which is the default. The daemon exits instead with refuse.
+	ConfigRecovery string `toml:"config_recovery"`
+}
+
+// LoadDaemonConf reads the daemon configuration file. Empty configuration is
+// returned if the file does not exist.
+func LoadDaemonConf(path string) (DaemonConf, error) {
+	var conf DaemonConf
+	meta, err := toml.DecodeFile(path, &conf)
+	if errors.Is(err, fs.ErrNotExist) {
+		return DaemonConf{}, nil
+	}
+	if err != nil {
+		return DaemonConf{}, fmt.Errorf("reading %s: %w", path, err)
+	}
+	if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+		return DaemonConf{}, fmt.Errorf("reading %s: unknown key %s", path, undecoded[0])
+	}
+	if err := conf.validate(); err != nil {
+		return DaemonConf{}, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return conf, nil
+}
+
+// DaemonSocket returns the socket configured in the daemon configuration file
+// or the given socket if it is not configured or cannot be read.
+func DaemonSocket(socket string) string {
+	conf, err := LoadDaemonConf(DaemonConfFilePath)
+	if err != nil || conf.Socket == "" {
+		return socket
+	}
+	return conf.Socket
+}
+
+func (c DaemonConf) validate() error {
+	if c.Socket != "" && !strings.HasPrefix(c.Socket, "/") {
+		return fmt.Errorf("socket must be an absolute path: %s", c.Socket)
+	}
+	if _, err := c.logLevel(); err != nil {
+		return err
+	}
+	if _, err := c.firewallBackend(); err != nil {
+		return err
+	}
+	if _, err := c.technology(); err != nil {
+		return err
+	}
+	if _, err := c.idleExit(); err != nil {
+		return err
+	}
+	switch strings.ToLower(c.ConfigRecovery) {
+	case "", ConfigRecoveryReset, ConfigRecoveryRefuse:
+	default:
+		return fmt.Errorf("invalid config recovery: %s", c.ConfigRecovery)
+	}
+	if c.HealthAddress != "" {
+		if addrPort, err := netip.ParseAddrPort(c.HealthAddress); err != nil || addrPort.Port() == 0 {
+			return fmt.Errorf("health address must be an IP address with a port: %s", c.HealthAddress)
+		}
+	}
+	if c.Proxy != "" {
+		u, err := url.Parse(c.Proxy)
+		if err != nil || u.Host == "" ||
+			(u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "socks5") {
+			return fmt.Errorf("proxy must be an http, https or socks5 URL: %s", c.Proxy)
+		}
+	}
+	for _, mirror := range c.APIMirrors {
+		u, err := url.Parse(mirror)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("API mirror must be an https URL: %s", mirror)
+		}
+	}
+	return nil
+}
+
+func (c DaemonConf) logLevel() (LogLevel, error) {
+	switch strings.ToLower(c.LogLevel) {
+	case "", "info":
+		return LogLevelInfo, nil
+	case "debug":
+		return LogLevelDebug, nil
+	case "warn":
+		return LogLevelWarn, nil
+	default:
+		return LogLevelInfo, fmt.Errorf("invalid log level: %s", c.LogLevel)
+	}
+}
+
+func (c DaemonConf) firewallBackend() (FirewallBackend, error) {
+	switch strings.ToLower(c.FirewallBackend) {
+	case "", "iptables":
+		return FirewallBackendIPTables, nil
+	case "nftables":
+		return FirewallBackendNftables, nil
+	default:
+		return FirewallBackendIPTables, fmt.Errorf("invalid firewall backend: %s", c.FirewallBackend)
+	}
+}
+
+func (c DaemonConf) technology() (Technology, error) {
+	switch strings.ToLower(c.Technology) {
+	case "":
+		return Technology_UNKNOWN_TECHNOLOGY, nil
+	case "nordlynx":
+		return Technology_NORDLYNX, nil
+	case "openvpn":
+		return Technology_OPENVPN, nil
+	default:
+		return Technology_UNKNOWN_TECHNOLOGY, fmt.Errorf("invalid technology: %s", c.Technology)
+	}
+}
+
+func (c DaemonConf) idleExit() (time.Duration, error) {
+	if c.IdleExit == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(c.IdleExit)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("idle exit must be a positive duration: %s", c.IdleExit)
+	}
+	return timeout, nil
+}
+
+// IdleExitTimeout returns the idle duration after which the socket activated
+// daemon exits, zero if it does not. Conf has to be validated.
+func (c DaemonConf) IdleExitTimeout() time.Duration {
+	timeout, _ := c.idleExit()
+	return timeout
+}
+
+// RefusesConfigRecovery reports whether the daemon exits instead of resetting
+// the config which cannot be loaded. Conf has to be validated.
+func (c DaemonConf) RefusesConfigRecovery() bool {
+	return strings.ToLower(c.ConfigRecovery) == ConfigRecoveryRefuse
+}
+
+// Defaults applies the configured settings to the default config. Conf has to
+// be validated.
+func (c DaemonConf) Defaults(cfg Config) Config {
+	if c.LogLevel != "" {
+		cfg.LogLevel, _ = c.logLevel()
+	}
+	if c.FirewallBackend != "" {
+		cfg.FirewallBackend, _ = c.firewallBackend()
+	}
+	if technology, _ := c.technology(); technology != Technology_UNKNOWN_TECHNOLOGY {
+		cfg.Technology = technology
+	}
+	return cfg
+}
@@ -0,0 +1,166 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadDaemonConf(t *testing.T) {
+	category.Set(t, category.File)
+
+	tests := []struct {
+		name     string
+		content  string
+		expected DaemonConf
+		hasError bool
+	}{
+		{
+			name: "all options",
+			content: `socket = "/run/vpn/nordvpnd.sock"
+group = "vpn"
+log_level = "debug"
+firewall_backend = "nftables"
+api_mirrors = ["https://mirror1.example.com", "https://mirror2.example.com"]
+proxy = "socks5://proxy.example.com:1080"
+technology = "openvpn"
+health_address = "0.0.0.0:9103"
+idle_exit = "15m"
+config_recovery = "refuse"
+`,
+			expected: DaemonConf{
+				Socket:          "/run/vpn/nordvpnd.sock",
+				Group:           "vpn",
+				LogLevel:        "debug",
+				FirewallBackend: "nftables",
+				APIMirrors:      []string{"https://mirror1.example.com", "https://mirror2.example.com"},
+				Proxy:           "socks5://proxy.example.com:1080",
+				Technology:      "openvpn",
+				HealthAddress:   "0.0.0.0:9103",
+				IdleExit:        "15m",
+				ConfigRecovery:  "refuse",
+			},
+		},
+		{
+			name:    "empty",
+			content: "",
+		},
+		{
+			name:     "unknown key",
+			content:  `killswitch = true`,
+			hasError: true,
+		},
+		{
+			name:     "invalid syntax",
+			content:  `log_level = debug`,
+			hasError: true,
+		},
+		{
+			name:     "relative socket",
+			content:  `socket = "nordvpnd.sock"`,
+			hasError: true,
+		},
+		{
+			name:     "invalid log level",
+			content:  `log_level = "trace"`,
+			hasError: true,
+		},
+		{
+			name:     "invalid firewall backend",
+			content:  `firewall_backend = "ufw"`,
+			hasError: true,
+		},
+		{
+			name:     "invalid technology",
+			content:  `technology = "ikev2"`,
+			hasError: true,
+		},
+		{
+			name:     "health address without port",
+			content:  `health_address = "0.0.0.0"`,
+			hasError: true,
+		},
+		{
+			name:     "unsupported proxy scheme",
+			content:  `proxy = "ftp://proxy.example.com"`,
+			hasError: true,
+		},
+		{
+			name:     "invalid idle exit",
+			content:  `idle_exit = "15"`,
+			hasError: true,
+		},
+		{
+			name:     "negative idle exit",
+			content:  `idle_exit = "-5m"`,
+			hasError: true,
+		},
+		{
+			name:     "invalid config recovery",
+			content:  `config_recovery = "ignore"`,
+			hasError: true,
+		},
+		{
+			name:     "plain http mirror",
+			content:  `api_mirrors = ["http://mirror.example.com"]`,
+			hasError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "daemon.conf")
+			require.NoError(t, os.WriteFile(path, []byte(test.content), 0600))
+
+			conf, err := LoadDaemonConf(path)
+			if test.hasError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, conf)
+		})
+	}
+}
+
+func TestLoadDaemonConf_Missing(t *testing.T) {
+	category.Set(t, category.File)
+
+	conf, err := LoadDaemonConf(filepath.Join(t.TempDir(), "daemon.conf"))
+	assert.NoError(t, err)
+	assert.Equal(t, DaemonConf{}, conf)
+}
+
+func TestDaemonConf_Defaults(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	conf := DaemonConf{LogLevel: "warn", FirewallBackend: "nftables", Technology: "openvpn"}
+	fsHandle := newMemoryFilesystem()
+	manager := NewFilesystemConfigManager(testConfigPath, testVaultPath, "salt", fixedMachineID{}, fsHandle)
+	manager.SetDefaults(conf.Defaults)
+
+	// defaults are used when the config does not exist
+	var cfg Config
+	require.NoError(t, manager.Load(&cfg))
+	assert.Equal(t, LogLevelWarn, cfg.LogLevel)
+	assert.Equal(t, FirewallBackendNftables, cfg.FirewallBackend)
+	assert.Equal(t, Technology_OPENVPN, cfg.Technology)
+	assert.True(t, cfg.Firewall)
+
+	// changes made by the user are kept
+	require.NoError(t, manager.SaveWith(func(c Config) Config {
+		c.Technology = Technology_NORDLYNX
+		return c
+	}))
+	require.NoError(t, manager.Load(&cfg))
+	assert.Equal(t, Technology_NORDLYNX, cfg.Technology)
+
+	// and reset to the defaults
+	require.NoError(t, manager.Reset())
+	require.NoError(t, manager.Load(&cfg))
+	assert.Equal(t, Technology_OPENVPN, cfg.Technology)
+}
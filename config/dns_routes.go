@@ -0,0 +1,37 @@
+package config
+
+import "golang.org/x/exp/slices"
+
+// DNSRoute sends the queries of the domain and its subdomains to the nameserver
+// instead of the VPN nameservers
+type DNSRoute struct {
+	Domain     string `json:"domain"`
+	Nameserver string `json:"nameserver"`
+	// ViaTunnel queries the nameserver through the tunnel, otherwise the
+	// nameserver is queried through the physical network
+	ViaTunnel bool `json:"via_tunnel,omitempty"`
+}
+
+// DNSRoutes is a list of routes with unique domains
+type DNSRoutes []DNSRoute
+
+// Set returns routes with the route added. Route of the same domain is
+// replaced in place.
+func (r DNSRoutes) Set(route DNSRoute) DNSRoutes {
+	routes := slices.Clone(r)
+	idx := slices.IndexFunc(routes, func(existing DNSRoute) bool { return existing.Domain == route.Domain })
+	if idx == -1 {
+		return append(routes, route)
+	}
+	routes[idx] = route
+	return routes
+}
+
+// Remove returns routes without the route of the domain and whether it was present
+func (r DNSRoutes) Remove(domain string) (DNSRoutes, bool) {
+	idx := slices.IndexFunc(r, func(existing DNSRoute) bool { return existing.Domain == domain })
+	if idx == -1 {
+		return r, false
+	}
+	return slices.Delete(slices.Clone(r), idx, idx+1), true
+}
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDNSRoutes_Set(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	corp := DNSRoute{Domain: "corp.example", Nameserver: "10.0.0.53"}
+	lab := DNSRoute{Domain: "lab.example", Nameserver: "10.1.0.53", ViaTunnel: true}
+	routes := DNSRoutes{corp}.Set(lab)
+	assert.Equal(t, DNSRoutes{corp, lab}, routes)
+
+	updated := DNSRoute{Domain: "corp.example", Nameserver: "10.0.0.54"}
+	assert.Equal(t, DNSRoutes{updated, lab}, routes.Set(updated))
+	// original routes are not modified
+	assert.Equal(t, DNSRoutes{corp, lab}, routes)
+}
+
+func TestDNSRoutes_Remove(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	corp := DNSRoute{Domain: "corp.example", Nameserver: "10.0.0.53"}
+	lab := DNSRoute{Domain: "lab.example", Nameserver: "10.1.0.53"}
+	routes := DNSRoutes{corp, lab}
+
+	removed, ok := routes.Remove("corp.example")
+	assert.True(t, ok)
+	assert.Equal(t, DNSRoutes{lab}, removed)
+	assert.Equal(t, DNSRoutes{corp, lab}, routes)
+
+	removed, ok = routes.Remove("other.example")
+	assert.False(t, ok)
+	assert.Equal(t, routes, removed)
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// Favorite is a named server tag and group to connect to
+type Favorite struct {
+	Name        string `json:"name"`
+	ServerTag   string `json:"server_tag,omitempty"`
+	ServerGroup string `json:"server_group,omitempty"`
+}
+
+// Favorites is a flat list of favorites with unique names
+type Favorites []Favorite
+
+// Get returns the favorite with the given name. Names are case insensitive.
+func (f Favorites) Get(name string) (Favorite, bool) {
+	for _, favorite := range f {
+		if strings.EqualFold(favorite.Name, name) {
+			return favorite, true
+		}
+	}
+	return Favorite{}, false
+}
+
+// Merge returns favorites with the other favorites added. Favorites with the same
+// name are replaced in place.
+func (f Favorites) Merge(other Favorites) Favorites {
+	merged := slices.Clone(f)
+	for _, favorite := range other {
+		idx := slices.IndexFunc(merged, func(existing Favorite) bool {
+			return strings.EqualFold(existing.Name, favorite.Name)
+		})
+		if idx == -1 {
+			merged = append(merged, favorite)
+			continue
+		}
+		merged[idx] = favorite
+	}
+	return merged
+}
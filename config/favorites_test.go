@@ -0,0 +1,36 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFavorites_Merge(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	favorites := Favorites{
+		{Name: "office", ServerTag: "lt"},
+		{Name: "p2p", ServerGroup: "p2p"},
+	}
+	merged := favorites.Merge(Favorites{
+		{Name: "Office", ServerTag: "lv"},
+		{Name: "streaming", ServerTag: "us new_york"},
+	})
+
+	assert.Equal(t, Favorites{
+		{Name: "Office", ServerTag: "lv"},
+		{Name: "p2p", ServerGroup: "p2p"},
+		{Name: "streaming", ServerTag: "us new_york"},
+	}, merged)
+	// original is not modified
+	assert.Equal(t, "lt", favorites[0].ServerTag)
+
+	favorite, ok := merged.Get("office")
+	assert.True(t, ok)
+	assert.Equal(t, "lv", favorite.ServerTag)
+	_, ok = merged.Get("home")
+	assert.False(t, ok)
+}
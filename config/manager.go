@@ -43,6 +43,7 @@ type FilesystemHandle interface {
 	CreateFile(string, fs.FileMode) error
 	ReadFile(string) ([]byte, error)
 	WriteFile(string, []byte, fs.FileMode) error
+	Rename(string, string) error
 }
 
 type StdFilesystemHandle struct {
@@ -69,6 +70,10 @@ func (StdFilesystemHandle) WriteFile(location string, data []byte, mode fs.FileM
 	return os.WriteFile(location, data, mode)
 }
 
+func (StdFilesystemHandle) Rename(from string, to string) error {
+	return os.Rename(from, to)
+}
+
 type MachineIDGetter interface {
 	GetMachineID() uuid.UUID
 }
@@ -89,6 +94,7 @@ type FilesystemConfigManager struct {
 	salt            string
 	machineIDGetter MachineIDGetter
 	fsHandle        FilesystemHandle
+	defaults        SaveFunc
 	mu              sync.Mutex
 }
 
@@ -105,6 +111,24 @@ func NewFilesystemConfigManager(location, vault, salt string,
 	}
 }
 
+// SetDefaults changes the default config, which is used when the config does
+// not exist yet, is reset or recovered.
+//
+// Thread-safe.
+func (f *FilesystemConfigManager) SetDefaults(fn SaveFunc) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.defaults = fn
+}
+
+func (f *FilesystemConfigManager) defaultConfig() Config {
+	cfg := *newConfig()
+	if f.defaults != nil {
+		cfg = f.defaults(cfg)
+	}
+	return cfg
+}
+
 // SaveWith modifications provided by fn.
 //
 // Thread-safe.
@@ -146,7 +170,7 @@ func (f *FilesystemConfigManager) save(c Config) error {
 func (f *FilesystemConfigManager) Reset() error {
 	f.mu.Lock()
 	defer f.mu.Unlock()
-	return f.save(*newConfig())
+	return f.save(f.defaultConfig())
 }
 
 // Load encrypted config from the filesystem.
@@ -161,37 +185,29 @@ func (f *FilesystemConfigManager) Load(c *Config) error {
 func (f *FilesystemConfigManager) load(c *Config) error {
 	if !f.fsHandle.FileExists(f.location) {
 		// reasigning value behind the pointer
-		*c = *newConfig()
+		*c = f.defaultConfig()
 		return nil
 	}
 
 	pass, err := f.getPassphrase()
 	if err != nil {
-		return err
+		return newLoadError(f.vault, err)
 	}
 
-	var data []byte
-
-	if f.fsHandle.FileExists(f.location) {
-		// #nosec G304 -- no input comes from the user
-		data, err = f.fsHandle.ReadFile(f.location)
-		if err != nil {
-			return err
-		}
-	} else {
-		if err := f.fsHandle.CreateFile(f.location, internal.PermUserRW); err != nil {
-			return err
-		}
+	// #nosec G304 -- no input comes from the user
+	data, err := f.fsHandle.ReadFile(f.location)
+	if err != nil {
+		return newLoadError(f.location, err)
 	}
 
 	decrypted, err := internal.Decrypt(data, pass)
 	if err != nil {
-		return err
+		return newDecryptError(f.location, data, err)
 	}
 
 	// this overrides default values
 	if err := json.Unmarshal(decrypted, c); err != nil {
-		return err
+		return newLoadError(f.location, err)
 	}
 
 	if c.FirewallMark == 0 {
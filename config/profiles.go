@@ -0,0 +1,86 @@
+package config
+
+import (
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// Profile is a named set of connection settings which are applied together
+// when connecting with the profile
+type Profile struct {
+	Name        string     `json:"name"`
+	Technology  Technology `json:"technology,omitempty"`
+	Protocol    Protocol   `json:"protocol,omitempty"`
+	ServerGroup string     `json:"server_group,omitempty"`
+	DNS         DNS        `json:"dns,omitempty"`
+	Allowlist   Allowlist  `json:"allowlist"`
+	KillSwitch  bool       `json:"kill_switch,omitempty"`
+}
+
+// NewProfile captures the connection settings of the config
+func NewProfile(name string, serverGroup string, cfg Config) Profile {
+	return Profile{
+		Name:        name,
+		Technology:  cfg.Technology,
+		Protocol:    cfg.AutoConnectData.Protocol,
+		ServerGroup: serverGroup,
+		DNS:         slices.Clone(cfg.AutoConnectData.DNS),
+		Allowlist:   cfg.AutoConnectData.Allowlist,
+		KillSwitch:  cfg.KillSwitch,
+	}
+}
+
+// ApplyTo returns the config with the settings of the profile. Obfuscation is
+// turned off for NordLynx, as it is not supported by it.
+func (p Profile) ApplyTo(cfg Config) Config {
+	cfg.Technology = p.Technology
+	cfg.AutoConnectData.Protocol = p.Protocol
+	cfg.AutoConnectData.DNS = slices.Clone(p.DNS)
+	cfg.AutoConnectData.Allowlist = p.Allowlist
+	cfg.KillSwitch = p.KillSwitch
+	if p.Technology == Technology_NORDLYNX {
+		cfg.AutoConnectData.Obfuscate = false
+	}
+	return cfg
+}
+
+// Profiles is a flat list of profiles with unique names
+type Profiles []Profile
+
+// Get returns the profile with the given name. Names are case insensitive.
+func (p Profiles) Get(name string) (Profile, bool) {
+	idx := p.index(name)
+	if idx == -1 {
+		return Profile{}, false
+	}
+	return p[idx], true
+}
+
+// Set returns profiles with the profile added. Profile with the same name is
+// replaced in place.
+func (p Profiles) Set(profile Profile) Profiles {
+	profiles := slices.Clone(p)
+	idx := profiles.index(profile.Name)
+	if idx == -1 {
+		return append(profiles, profile)
+	}
+	profiles[idx] = profile
+	return profiles
+}
+
+// Remove returns profiles without the profile with the given name
+func (p Profiles) Remove(name string) Profiles {
+	profiles := slices.Clone(p)
+	idx := profiles.index(name)
+	if idx == -1 {
+		return profiles
+	}
+	return slices.Delete(profiles, idx, idx+1)
+}
+
+func (p Profiles) index(name string) int {
+	return slices.IndexFunc(p, func(profile Profile) bool {
+		return strings.EqualFold(profile.Name, name)
+	})
+}
@@ -0,0 +1,77 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfile_ApplyTo(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	work := Config{
+		Technology: Technology_OPENVPN,
+		KillSwitch: true,
+		AutoConnectData: AutoConnectData{
+			Protocol:  Protocol_TCP,
+			DNS:       DNS{"10.0.0.1"},
+			Allowlist: NewAllowlist([]int64{22}, nil, []string{"10.0.0.0/8"}),
+		},
+	}
+	profile := NewProfile("work", "p2p", work)
+	assert.Equal(t, "p2p", profile.ServerGroup)
+
+	current := Config{
+		Technology:  Technology_NORDLYNX,
+		AutoConnect: true,
+		AutoConnectData: AutoConnectData{
+			Protocol:  Protocol_UDP,
+			ServerTag: "lt",
+		},
+	}
+	applied := profile.ApplyTo(current)
+	assert.Equal(t, Technology_OPENVPN, applied.Technology)
+	assert.Equal(t, Protocol_TCP, applied.AutoConnectData.Protocol)
+	assert.Equal(t, DNS{"10.0.0.1"}, applied.AutoConnectData.DNS)
+	assert.Equal(t, work.AutoConnectData.Allowlist, applied.AutoConnectData.Allowlist)
+	assert.True(t, applied.KillSwitch)
+	// settings which are not a part of the profile are kept
+	assert.True(t, applied.AutoConnect)
+	assert.Equal(t, "lt", applied.AutoConnectData.ServerTag)
+
+	obfuscated := Config{AutoConnectData: AutoConnectData{Obfuscate: true}}
+	home := Profile{Name: "home", Technology: Technology_NORDLYNX, Protocol: Protocol_UDP}
+	assert.False(t, home.ApplyTo(obfuscated).AutoConnectData.Obfuscate)
+}
+
+func TestProfiles_SetRemove(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	profiles := Profiles{
+		{Name: "work", ServerGroup: "p2p"},
+		{Name: "home"},
+	}
+	updated := profiles.Set(Profile{Name: "Work", KillSwitch: true})
+	updated = updated.Set(Profile{Name: "travel"})
+
+	assert.Equal(t, Profiles{
+		{Name: "Work", KillSwitch: true},
+		{Name: "home"},
+		{Name: "travel"},
+	}, updated)
+	// original is not modified
+	assert.Equal(t, "p2p", profiles[0].ServerGroup)
+
+	profile, ok := updated.Get("WORK")
+	assert.True(t, ok)
+	assert.True(t, profile.KillSwitch)
+
+	removed := updated.Remove("home")
+	assert.Equal(t, Profiles{{Name: "Work", KillSwitch: true}, {Name: "travel"}}, removed)
+	_, ok = removed.Get("home")
+	assert.False(t, ok)
+	assert.Len(t, updated, 3)
+	assert.Equal(t, removed, removed.Remove("office"))
+}
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+)
+
+// minEncryptedSize is the size of the nonce and the authentication tag added to
+// the encrypted config. Shorter files were cut off while being written.
+const minEncryptedSize = 12 + 16
+
+// LoadError is returned when the config file exists, but cannot be loaded
+type LoadError struct {
+	// Path of the file which cannot be loaded
+	Path string
+	// Reason describes what is wrong with the file
+	Reason string
+	Err    error
+}
+
+func (e *LoadError) Error() string {
+	return fmt.Sprintf("%s %s: %s", e.Path, e.Reason, e.Err)
+}
+
+func (e *LoadError) Unwrap() error { return e.Err }
+
+func newLoadError(path string, err error) error {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.Is(err, fs.ErrPermission):
+		return &LoadError{Path: path, Reason: "cannot be read due to wrong permissions", Err: err}
+	case errors.As(err, &syntaxErr):
+		return &LoadError{
+			Path:   path,
+			Reason: fmt.Sprintf("contains malformed data at offset %d", syntaxErr.Offset),
+			Err:    err,
+		}
+	case errors.As(err, &typeErr):
+		return &LoadError{
+			Path:   path,
+			Reason: fmt.Sprintf("contains invalid value of %s", typeErr.Field),
+			Err:    err,
+		}
+	default:
+		return &LoadError{Path: path, Reason: "cannot be loaded", Err: err}
+	}
+}
+
+func newDecryptError(path string, data []byte, err error) error {
+	if len(data) < minEncryptedSize {
+		return &LoadError{
+			Path:   path,
+			Reason: fmt.Sprintf("is truncated to %d bytes", len(data)),
+			Err:    err,
+		}
+	}
+	return &LoadError{Path: path, Reason: "is corrupted and cannot be decrypted", Err: err}
+}
+
+// Recover backs up the config which cannot be loaded and replaces it with the
+// default config. Encryption key is backed up and regenerated as well if it
+// cannot be loaded. Paths of the backups are returned.
+//
+// Thread-safe.
+func (f *FilesystemConfigManager) Recover() ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	suffix := ".corrupted-" + time.Now().Format("20060102150405")
+	var backups []string
+	if _, err := f.loadKey(); err != nil && !errors.Is(err, errNoInstallFile) {
+		if err := f.fsHandle.Rename(f.vault, f.vault+suffix); err != nil {
+			return backups, fmt.Errorf("backing up %s: %w", f.vault, err)
+		}
+		backups = append(backups, f.vault+suffix)
+	}
+
+	if f.fsHandle.FileExists(f.location) {
+		if err := f.fsHandle.Rename(f.location, f.location+suffix); err != nil {
+			return backups, fmt.Errorf("backing up %s: %w", f.location, err)
+		}
+		backups = append(backups, f.location+suffix)
+	}
+
+	return backups, f.save(f.defaultConfig())
+}
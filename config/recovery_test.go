@@ -0,0 +1,225 @@
+package config
+
+import (
+	"io/fs"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testConfigPath = "/var/lib/nordvpn/data/settings.dat"
+	testVaultPath  = "/var/lib/nordvpn/data/install.dat"
+)
+
+type memoryFilesystem struct {
+	files      map[string][]byte
+	unreadable map[string]bool
+}
+
+func newMemoryFilesystem() *memoryFilesystem {
+	return &memoryFilesystem{files: map[string][]byte{}, unreadable: map[string]bool{}}
+}
+
+func (m *memoryFilesystem) FileExists(location string) bool {
+	_, ok := m.files[location]
+	return ok
+}
+
+func (m *memoryFilesystem) CreateFile(location string, _ fs.FileMode) error {
+	m.files[location] = nil
+	return nil
+}
+
+func (m *memoryFilesystem) ReadFile(location string) ([]byte, error) {
+	if m.unreadable[location] {
+		return nil, fs.ErrPermission
+	}
+	data, ok := m.files[location]
+	if !ok {
+		return nil, fs.ErrNotExist
+	}
+	return data, nil
+}
+
+func (m *memoryFilesystem) WriteFile(location string, data []byte, _ fs.FileMode) error {
+	m.files[location] = data
+	return nil
+}
+
+func (m *memoryFilesystem) Rename(from string, to string) error {
+	data, ok := m.files[from]
+	if !ok {
+		return fs.ErrNotExist
+	}
+	m.files[to] = data
+	delete(m.files, from)
+	delete(m.unreadable, from)
+	return nil
+}
+
+type fixedMachineID struct{}
+
+func (fixedMachineID) GetMachineID() uuid.UUID { return uuid.UUID{1} }
+
+func newTestManager(t *testing.T, fsHandle *memoryFilesystem) *FilesystemConfigManager {
+	t.Helper()
+	manager := NewFilesystemConfigManager(testConfigPath, testVaultPath, "salt", fixedMachineID{}, fsHandle)
+	require.NoError(t, manager.SaveWith(func(c Config) Config {
+		c.KillSwitch = true
+		return c
+	}))
+	return manager
+}
+
+func encryptTestConfig(t *testing.T, manager *FilesystemConfigManager, data string) []byte {
+	t.Helper()
+	pass, err := manager.getPassphrase()
+	require.NoError(t, err)
+	encrypted, err := internal.Encrypt([]byte(data), pass)
+	require.NoError(t, err)
+	return encrypted
+}
+
+func TestFilesystemConfigManager_LoadError(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name   string
+		modify func(*FilesystemConfigManager, *memoryFilesystem)
+		path   string
+		reason string
+	}{
+		{
+			name: "truncated",
+			modify: func(_ *FilesystemConfigManager, fsHandle *memoryFilesystem) {
+				fsHandle.files[testConfigPath] = fsHandle.files[testConfigPath][:10]
+			},
+			path:   testConfigPath,
+			reason: "is truncated to 10 bytes",
+		},
+		{
+			name: "empty",
+			modify: func(_ *FilesystemConfigManager, fsHandle *memoryFilesystem) {
+				fsHandle.files[testConfigPath] = nil
+			},
+			path:   testConfigPath,
+			reason: "is truncated to 0 bytes",
+		},
+		{
+			name: "corrupted",
+			modify: func(_ *FilesystemConfigManager, fsHandle *memoryFilesystem) {
+				data := fsHandle.files[testConfigPath]
+				data[len(data)-1] ^= 0xff
+			},
+			path:   testConfigPath,
+			reason: "is corrupted and cannot be decrypted",
+		},
+		{
+			name: "malformed",
+			modify: func(manager *FilesystemConfigManager, fsHandle *memoryFilesystem) {
+				fsHandle.files[testConfigPath] = encryptTestConfig(t, manager, `{"kill_switch": true,}`)
+			},
+			path:   testConfigPath,
+			reason: "contains malformed data at offset 22",
+		},
+		{
+			name: "invalid value",
+			modify: func(manager *FilesystemConfigManager, fsHandle *memoryFilesystem) {
+				fsHandle.files[testConfigPath] = encryptTestConfig(t, manager, `{"kill_switch": "yes"}`)
+			},
+			path:   testConfigPath,
+			reason: "contains invalid value of kill_switch",
+		},
+		{
+			name: "wrong permissions",
+			modify: func(_ *FilesystemConfigManager, fsHandle *memoryFilesystem) {
+				fsHandle.unreadable[testConfigPath] = true
+			},
+			path:   testConfigPath,
+			reason: "cannot be read due to wrong permissions",
+		},
+		{
+			name: "wrong permissions of the key",
+			modify: func(_ *FilesystemConfigManager, fsHandle *memoryFilesystem) {
+				fsHandle.unreadable[testVaultPath] = true
+			},
+			path:   testVaultPath,
+			reason: "cannot be read due to wrong permissions",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fsHandle := newMemoryFilesystem()
+			manager := newTestManager(t, fsHandle)
+			test.modify(manager, fsHandle)
+
+			var cfg Config
+			err := manager.Load(&cfg)
+			var loadErr *LoadError
+			require.ErrorAs(t, err, &loadErr)
+			assert.Equal(t, test.path, loadErr.Path)
+			assert.Equal(t, test.reason, loadErr.Reason)
+		})
+	}
+}
+
+func TestFilesystemConfigManager_Recover(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name    string
+		modify  func(*memoryFilesystem)
+		backups int
+	}{
+		{
+			name: "config",
+			modify: func(fsHandle *memoryFilesystem) {
+				fsHandle.files[testConfigPath] = fsHandle.files[testConfigPath][:10]
+			},
+			backups: 1,
+		},
+		{
+			name: "config with wrong permissions",
+			modify: func(fsHandle *memoryFilesystem) {
+				fsHandle.unreadable[testConfigPath] = true
+			},
+			backups: 1,
+		},
+		{
+			name: "key",
+			modify: func(fsHandle *memoryFilesystem) {
+				fsHandle.unreadable[testVaultPath] = true
+			},
+			backups: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fsHandle := newMemoryFilesystem()
+			manager := newTestManager(t, fsHandle)
+			test.modify(fsHandle)
+			unreadable := fsHandle.files[testConfigPath]
+
+			backups, err := manager.Recover()
+			assert.NoError(t, err)
+			assert.Len(t, backups, test.backups)
+			for _, backup := range backups {
+				assert.Contains(t, fsHandle.files, backup)
+			}
+			// config is backed up as is
+			assert.Equal(t, unreadable, fsHandle.files[backups[len(backups)-1]])
+
+			var cfg Config
+			assert.NoError(t, manager.Load(&cfg))
+			assert.False(t, cfg.KillSwitch)
+			assert.True(t, cfg.Firewall)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package config
+
+import "strings"
+
+const (
+	// MinServerRating is the lowest rating which can be given to a server
+	MinServerRating = 1
+	// MaxServerRating is the highest rating which can be given to a server
+	MaxServerRating = 5
+	// MaxRatingWeight is the highest weight of server ratings in the server pick
+	MaxRatingWeight = 100
+)
+
+// ServerNote is a note and a rating given to a server by the user
+type ServerNote struct {
+	Note string `json:"note,omitempty"`
+	// Rating from MinServerRating to MaxServerRating, 0 if the server is not rated
+	Rating uint32 `json:"rating,omitempty"`
+}
+
+// IsEmpty returns true if neither a note nor a rating is set
+func (n ServerNote) IsEmpty() bool {
+	return n.Note == "" && n.Rating == 0
+}
+
+// ServerNotes are server notes keyed by the server name, e.g. "lt10". Notes are kept
+// even if the server is removed from the server list.
+type ServerNotes map[string]ServerNote
+
+// Get returns the note of the server with the given name or hostname
+func (n ServerNotes) Get(server string) (ServerNote, bool) {
+	note, ok := n[ServerName(server)]
+	return note, ok
+}
+
+// With returns a copy of the notes with the server note replaced. Empty notes are
+// removed.
+func (n ServerNotes) With(server string, note ServerNote) ServerNotes {
+	ret := ServerNotes{}
+	for name, existing := range n {
+		ret[name] = existing
+	}
+	if note.IsEmpty() {
+		delete(ret, ServerName(server))
+	} else {
+		ret[ServerName(server)] = note
+	}
+	return ret
+}
+
+// ServerName returns the name of the server, e.g. "lt10" for "LT10.nordvpn.com"
+func ServerName(hostname string) string {
+	return strings.ToLower(strings.Split(strings.TrimSpace(hostname), ".")[0])
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerNotes_With(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	notes := ServerNotes{"lt10": {Note: "fast", Rating: 4}}
+
+	updated := notes.With("DE100.nordvpn.com", ServerNote{Note: "great for streaming"})
+	assert.Equal(t, ServerNotes{
+		"lt10":  {Note: "fast", Rating: 4},
+		"de100": {Note: "great for streaming"},
+	}, updated)
+	// original is not modified
+	assert.Len(t, notes, 1)
+
+	note, ok := updated.Get("de100")
+	assert.True(t, ok)
+	assert.Equal(t, "great for streaming", note.Note)
+
+	removed := updated.With("lt10", ServerNote{})
+	_, ok = removed.Get("lt10")
+	assert.False(t, ok)
+	assert.Len(t, removed, 1)
+}
+
+func TestServerName(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	for input, expected := range map[string]string{
+		"lt10":                "lt10",
+		"LT10.nordvpn.com":    "lt10",
+		" de-nl5.nordvpn.com": "de-nl5",
+	} {
+		assert.Equal(t, expected, ServerName(input))
+	}
+}
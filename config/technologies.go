@@ -0,0 +1,12 @@
+package config
+
+import "golang.org/x/exp/slices"
+
+// Technologies restricts the technologies which can be used to connect. Empty
+// list allows all of them.
+type Technologies []Technology
+
+// Allows returns true if the technology can be used to connect
+func (t Technologies) Allows(tech Technology) bool {
+	return len(t) == 0 || slices.Contains(t, tech)
+}
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTechnologies_Allows(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		allowed  Technologies
+		tech     Technology
+		expected bool
+	}{
+		{name: "all allowed by default", tech: Technology_OPENVPN, expected: true},
+		{name: "allowed", allowed: Technologies{Technology_NORDLYNX}, tech: Technology_NORDLYNX, expected: true},
+		{name: "excluded", allowed: Technologies{Technology_NORDLYNX}, tech: Technology_OPENVPN},
+		{
+			name:     "one of many",
+			allowed:  Technologies{Technology_NORDLYNX, Technology_OPENVPN},
+			tech:     Technology_OPENVPN,
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.allowed.Allows(test.tech))
+		})
+	}
+}
@@ -8,5 +8,9 @@ type TokenData struct {
 	NordLynxPrivateKey string `json:"nordlynx_private_key"`
 	OpenVPNUsername    string `json:"openvpn_username"`
 	OpenVPNPassword    string `json:"openvpn_password"`
-	NCData             NCData `json:"nc_data,omitempty"`
+	// CredentialsUpdatedAt is when the service credentials were last changed by the API
+	CredentialsUpdatedAt string `json:"credentials_updated_at,omitempty"`
+	// CredentialsCheckedAt is when the service credentials were last compared with the API
+	CredentialsCheckedAt string `json:"credentials_checked_at,omitempty"`
+	NCData               NCData `json:"nc_data,omitempty"`
 }
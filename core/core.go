@@ -369,10 +369,15 @@ func (api *DefaultAPI) Server(id int64) (*Server, error) {
 		return nil, err
 	}
 
-	if len(ret) != 1 {
+	switch len(ret) {
+	case 0:
+		// server withdrawn from the list is not returned at all
+		return nil, fmt.Errorf("server %d: %w", id, ErrNotFound)
+	case 1:
+		return &ret[0], nil
+	default:
 		return nil, fmt.Errorf("invalid response")
 	}
-	return &ret[0], nil
 }
 
 // Insights returns insights about user
@@ -158,6 +158,25 @@ func TestDefaultAPI_Servers(t *testing.T) {
 	}
 }
 
+func TestDefaultAPI_Server_Withdrawn(t *testing.T) {
+	category.Set(t, category.Integration)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, ServersURL+fmt.Sprintf(ServersURLSpecificQuery, 7), r.URL.String())
+		rw.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	api := NewDefaultAPI(
+		"",
+		server.URL,
+		http.DefaultClient,
+		response.NoopValidator{},
+	)
+	_, err := api.Server(7)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
 func TestDefaultAPI_Services(t *testing.T) {
 	category.Set(t, category.Integration)
 
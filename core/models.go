@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/netip"
+	"strconv"
 	"strings"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
@@ -147,16 +148,32 @@ type CredentialsResponse struct {
 type ServicesResponse []ServiceData
 
 type ServiceData struct {
-	ID        int64   `json:"ID"`
-	ExpiresAt string  `json:"expires_at"`
-	Service   Service `json:"service"`
+	ID        int64          `json:"ID"`
+	ExpiresAt string         `json:"expires_at"`
+	Service   Service        `json:"service"`
+	Details   ServiceDetails `json:"details"`
 }
 
+// IDs of the services
+const (
+	VPNServiceID         int64 = 1
+	DedicatedIPServiceID int64 = 11
+)
+
 type Service struct {
 	ID   int64  `json:"id"`
 	Name string `json:"name"`
 }
 
+// ServiceDetails contains the servers assigned to the user by the service, e.g. Dedicated IP
+type ServiceDetails struct {
+	Servers []ServiceServer `json:"servers"`
+}
+
+type ServiceServer struct {
+	ID int64 `json:"id"`
+}
+
 type CurrentUserResponse struct {
 	Username string `json:"username"`
 	Email    string `json:"email"`
@@ -188,6 +205,9 @@ type Technology struct {
 		Name  string      `json:"name,omitempty"`
 		Value interface{} `json:"value,omitempty"`
 	} `json:"metadata"`
+	// Ports which the server accepts connections on for this technology. Empty if
+	// the server does not advertise them.
+	Ports []uint16 `json:"-"`
 }
 
 func (t Technology) IsOnline() bool {
@@ -304,6 +324,24 @@ func IsObfuscated() Predicate {
 	}
 }
 
+// IsVirtualLocation returns true for servers which are physically located in another
+// country than the advertised one.
+func IsVirtualLocation() Predicate {
+	return func(s Server) bool {
+		for _, spec := range s.Specifications {
+			if spec.Identifier != virtualLocationSpecification {
+				continue
+			}
+			for _, v := range spec.Values {
+				if v.Value == "true" {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
 // IsConnectableWithProtocol behaves like IsConnectableVia, but also includes protocol.
 func IsConnectableWithProtocol(tech config.Technology, proto config.Protocol) Predicate {
 	return func(s Server) bool {
@@ -389,6 +427,7 @@ func (s *Server) UnmarshalJSON(b []byte) error {
 				break
 			}
 		}
+		hack.Technologies[i].Ports = metadataPorts(tech)
 		// gob ignores nil fields
 		hack.Technologies[i].Metadata = nil
 	}
@@ -397,6 +436,44 @@ func (s *Server) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// metadataPorts parses the ports advertised by the server. They are either a list
+// of numbers or a comma separated string.
+func metadataPorts(tech Technology) []uint16 {
+	var ports []uint16
+	for _, meta := range tech.Metadata {
+		if meta.Name != "ports" {
+			continue
+		}
+		var values []string
+		switch value := meta.Value.(type) {
+		case string:
+			values = strings.Split(value, ",")
+		case []interface{}:
+			for _, v := range value {
+				values = append(values, fmt.Sprint(v))
+			}
+		}
+		for _, value := range values {
+			port, err := strconv.ParseUint(strings.TrimSpace(value), 10, 16)
+			if err != nil || port == 0 {
+				continue
+			}
+			ports = append(ports, uint16(port))
+		}
+	}
+	return ports
+}
+
+// Ports returns the ports advertised by the server for the given technology
+func (s *Server) Ports(tech ServerTechnology) []uint16 {
+	for _, t := range s.Technologies {
+		if t.ID == tech {
+			return t.Ports
+		}
+	}
+	return nil
+}
+
 type Groups []Group
 
 type Group struct {
@@ -404,6 +481,10 @@ type Group struct {
 	Title string             `json:"title"`
 }
 
+// virtualLocationSpecification identifies the specification of the virtual
+// location servers
+const virtualLocationSpecification = "virtual_location"
+
 type Specification struct {
 	Identifier string `json:"identifier"`
 	Values     []struct {
@@ -455,6 +536,7 @@ type Pivot struct {
 }
 
 type Insights struct {
+	IP          string  `json:"ip,omitempty"`
 	CountryCode string  `json:"country_code"`
 	Longitude   float64 `json:"longitude"`
 	Latitude    float64 `json:"latitude"`
@@ -397,6 +397,56 @@ func TestIsObfuscated(t *testing.T) {
 	}
 }
 
+func TestIsVirtualLocation(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		specs    []Specification
+		expected bool
+	}{
+		{
+			name:     "no specifications",
+			expected: false,
+		},
+		{
+			name:     "virtual location",
+			specs:    []Specification{virtualLocationSpec("true")},
+			expected: true,
+		},
+		{
+			name:     "physical location",
+			specs:    []Specification{virtualLocationSpec("false")},
+			expected: false,
+		},
+		{
+			name: "other specification",
+			specs: []Specification{{
+				Identifier: "version",
+				Values: []struct {
+					Value string `json:"value"`
+				}{{Value: "true"}},
+			}},
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, IsVirtualLocation()(Server{Specifications: test.specs}))
+		})
+	}
+}
+
+func virtualLocationSpec(value string) Specification {
+	return Specification{
+		Identifier: virtualLocationSpecification,
+		Values: []struct {
+			Value string `json:"value"`
+		}{{Value: value}},
+	}
+}
+
 func TestIsConnectableVia(t *testing.T) {
 	category.Set(t, category.Unit)
 
@@ -627,6 +677,24 @@ func TestServerUnmarshal(t *testing.T) {
 	assert.False(t, strings.HasPrefix(server.NordLynxPublicKey, "\t"))
 }
 
+func TestServerUnmarshalPorts(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var server Server
+	err := json.Unmarshal([]byte(`{
+		"technologies": [
+			{"id": 15, "metadata": [{"name": "ports", "value": [1194, 443]}]},
+			{"id": 17, "metadata": [{"name": "ports", "value": "465, 587,invalid"}]},
+			{"id": 5, "metadata": []}
+		]
+	}`), &server)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint16{1194, 443}, server.Ports(OpenVPNUDPObfuscated))
+	assert.Equal(t, []uint16{465, 587}, server.Ports(OpenVPNTCPObfuscated))
+	assert.Empty(t, server.Ports(OpenVPNTCP))
+	assert.Empty(t, server.Ports(WireguardTech))
+}
+
 func TestServerGroupsString(t *testing.T) {
 	category.Set(t, category.Unit)
 
@@ -0,0 +1,58 @@
+package daemon
+
+import (
+	"log"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// removeExpiredAllowlist removes the temporary allowlist entries which have
+// expired at now
+func (r *RPC) removeExpiredAllowlist(now time.Time) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return
+	}
+
+	allowlist, removed := cfg.AutoConnectData.Allowlist.WithoutExpired(now)
+	if !removed {
+		return
+	}
+
+	firewallAllowlist := allowlist
+	if cfg.LanDiscovery {
+		firewallAllowlist = addLANPermissions(firewallAllowlist)
+	}
+	if err := r.netw.SetAllowlist(firewallAllowlist); err != nil {
+		log.Println(internal.ErrorPrefix, "removing expired allowlist entries:", err)
+		return
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		// entries could have been changed in the meantime
+		c.AutoConnectData.Allowlist, _ = c.AutoConnectData.Allowlist.WithoutExpired(now)
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return
+	}
+	log.Println(internal.InfoPrefix, "expired allowlist entries were removed")
+	r.syncBootKillSwitch()
+
+	r.events.Settings.Allowlist.Publish(events.DataAllowlist{
+		TCPPorts: allowlist.Ports.TCP.ToSlice(),
+		UDPPorts: allowlist.Ports.UDP.ToSlice(),
+		Subnets:  allowlist.Subnets.ToSlice(),
+	})
+}
+
+// JobAllowlistExpiry removes the temporary allowlist entries when they expire
+func JobAllowlistExpiry(r *RPC) func() {
+	return func() {
+		r.removeExpiredAllowlist(time.Now())
+	}
+}
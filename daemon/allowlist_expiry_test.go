@@ -0,0 +1,80 @@
+package daemon
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/events/subs"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoveExpiredAllowlist(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Now()
+	permanent := config.NewAllowlist(nil, []int64{22}, []string{"10.0.0.0/8"})
+
+	tests := []struct {
+		name          string
+		expiry        map[string]time.Time
+		setErr        error
+		expected      config.Allowlist
+		expectPublish bool
+	}{
+		{
+			name:     "nothing expired",
+			expiry:   map[string]time.Time{config.PortKey("tcp", 22): now.Add(time.Minute)},
+			expected: config.Allowlist{Ports: permanent.Ports, Subnets: permanent.Subnets},
+		},
+		{
+			name:          "expired entry removed",
+			expiry:        map[string]time.Time{config.PortKey("tcp", 22): now.Add(-time.Minute)},
+			expected:      config.NewAllowlist(nil, nil, []string{"10.0.0.0/8"}),
+			expectPublish: true,
+		},
+		{
+			name:     "firewall failure keeps the entry",
+			expiry:   map[string]time.Time{config.SubnetKey("10.0.0.0/8"): now},
+			setErr:   errors.New("firewall failure"),
+			expected: config.Allowlist{Ports: permanent.Ports, Subnets: permanent.Subnets},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			allowlist := config.NewAllowlist(nil, []int64{22}, []string{"10.0.0.0/8"})
+			allowlist.Expiry = test.expiry
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.AutoConnectData.Allowlist = allowlist
+			netw := &testnetworker.Mock{SetAllowlistErr: test.setErr}
+			published := false
+			allowlistEvents := &subs.Subject[events.DataAllowlist]{}
+			allowlistEvents.Subscribe(func(events.DataAllowlist) error {
+				published = true
+				return nil
+			})
+			rpc := RPC{
+				cm:     cm,
+				netw:   netw,
+				events: &Events{Settings: &SettingsEvents{Allowlist: allowlistEvents}},
+			}
+
+			rpc.removeExpiredAllowlist(now)
+
+			actual := cm.Cfg.AutoConnectData.Allowlist
+			assert.Equal(t, test.expected.Ports, actual.Ports)
+			assert.Equal(t, test.expected.Subnets, actual.Subnets)
+			assert.Equal(t, test.expectPublish, published)
+			if test.expectPublish {
+				assert.Empty(t, actual.Expiry)
+				assert.Equal(t, actual.Ports, netw.Allowlist.Ports)
+			}
+		})
+	}
+}
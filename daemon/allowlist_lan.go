@@ -4,8 +4,8 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/config"
 )
 
-// addLANPermissions creates a new Allowlist. Subnets map is copied and updated with LANs, Port maps
-// remain unchanged.
+// addLANPermissions creates a new Allowlist. Subnets map is copied and updated with LANs, Port and
+// Domain maps remain unchanged.
 func addLANPermissions(allowlist config.Allowlist) config.Allowlist {
 	localNetworks := []string{
 		"10.0.0.0/8",
@@ -28,6 +28,7 @@ func addLANPermissions(allowlist config.Allowlist) config.Allowlist {
 	newAllowlist := config.Allowlist{
 		Ports:   allowlist.Ports,
 		Subnets: newSubnets,
+		Domains: allowlist.Domains,
 	}
 
 	return newAllowlist
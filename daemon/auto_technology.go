@@ -0,0 +1,273 @@
+package daemon
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"net/netip"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/metered"
+	"github.com/NordSecurity/nordvpn-linux/daemon/routes"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/nordlynx"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/openvpn"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	// autoTechnologyProbeTimeout is how long the servers have to reply to the
+	// probes
+	autoTechnologyProbeTimeout = 2 * time.Second
+	// autoTechnologyProbedServers are probed at once, so that a single server
+	// being down does not rule the technology out
+	autoTechnologyProbedServers = 3
+)
+
+// HandshakeProber checks whether the NordLynx server replies to the handshake
+type HandshakeProber interface {
+	Probe(ctx context.Context, endpoint netip.AddrPort, privateKey string, publicKey string) error
+}
+
+// ConnectProber checks whether the OpenVPN server accepts the connection
+type ConnectProber interface {
+	Probe(ctx context.Context, endpoint netip.AddrPort) error
+}
+
+// technologySelector picks the technology for the network in the automatic
+// technology mode. NordLynx is picked if its handshake gets through, otherwise
+// OpenVPN if the TCP or the obfuscated servers, which it falls back to on its
+// own, accept the connection.
+type technologySelector struct {
+	detector      metered.Detector
+	gateway       routes.GatewayRetriever
+	prober        HandshakeProber
+	openVPNProber ConnectProber
+}
+
+// fingerprint identifies the network by its connection and the default gateway.
+// Returns the fingerprint and the label for the logs, both empty if the network
+// cannot be detected.
+func (t *technologySelector) fingerprint() (string, string) {
+	if t.detector == nil {
+		return "", ""
+	}
+	connection, err := t.detector.PrimaryConnection()
+	if err != nil {
+		log.Println(internal.WarningPrefix, "auto technology: detecting network connection:", err)
+		return "", ""
+	}
+	var gateway netip.Addr
+	if t.gateway != nil {
+		// the default route of the main table goes through the physical
+		// interface even while VPN is connected
+		gateway, _, _ = t.gateway.Default(false)
+	}
+	if connection.Name == "" && connection.SSID == "" && !gateway.IsValid() {
+		return "", ""
+	}
+	label := connection.Name
+	if label == "" {
+		label = gateway.String()
+	}
+	// access points of the same Wi-Fi network share the fingerprint
+	sum := sha256.Sum256([]byte(strings.Join(
+		[]string{connection.Type, connection.Name, connection.SSID, gateway.String()},
+		"\x00",
+	)))
+	return hex.EncodeToString(sum[:8]), label
+}
+
+// nordLynxReachable reports whether any of the least loaded NordLynx servers
+// replied to the handshake
+func (t *technologySelector) nordLynxReachable(servers core.Servers, privateKey string, port uint16) bool {
+	if t.prober == nil {
+		return false
+	}
+	var probes []func(context.Context) error
+	for _, server := range probedServers(servers, autoTechnologyProbedServers) {
+		server := server
+		probes = append(probes, func(ctx context.Context) error {
+			ip, err := server.IPv4()
+			if err != nil {
+				return err
+			}
+			endpoint, err := netip.ParseAddrPort(nordlynx.Endpoint(ip, port))
+			if err != nil {
+				return err
+			}
+			return t.prober.Probe(ctx, endpoint, privateKey, server.NordLynxPublicKey)
+		})
+	}
+	return anyReachable("NordLynx", probes)
+}
+
+// openVPNReachable reports whether any of the least loaded OpenVPN TCP or
+// obfuscated TCP servers accepted the connection. Non-zero port replaces the
+// ports of the servers the same way as in the OpenVPN config.
+func (t *technologySelector) openVPNReachable(servers core.Servers, port uint16) bool {
+	if t.openVPNProber == nil {
+		return false
+	}
+	var probes []func(context.Context) error
+	probe := func(server core.Server, port uint16) {
+		probes = append(probes, func(ctx context.Context) error {
+			ip, err := server.IPv4()
+			if err != nil {
+				return err
+			}
+			return t.openVPNProber.Probe(ctx, netip.AddrPortFrom(ip, port))
+		})
+	}
+	for _, server := range leastLoaded(servers, autoTechnologyProbedServers, core.IsConnectableVia(core.OpenVPNTCP)) {
+		tcpPort := port
+		if tcpPort == 0 {
+			tcpPort = openvpn.DefaultTCPPort
+		}
+		probe(server, tcpPort)
+	}
+	for _, server := range leastLoaded(servers, autoTechnologyProbedServers, core.IsConnectableVia(core.OpenVPNTCPObfuscated)) {
+		obfuscatedPort := port
+		if ports := server.Ports(core.OpenVPNTCPObfuscated); obfuscatedPort == 0 && len(ports) > 0 {
+			obfuscatedPort = ports[0]
+		}
+		if obfuscatedPort == 0 {
+			obfuscatedPort = openvpn.DefaultTCPPort
+		}
+		probe(server, obfuscatedPort)
+	}
+	return anyReachable("OpenVPN", probes)
+}
+
+// anyReachable runs the probes at once and reports whether any of them
+// succeeded before the timeout
+func anyReachable(tech string, probes []func(context.Context) error) bool {
+	if len(probes) == 0 {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), autoTechnologyProbeTimeout)
+	defer cancel()
+	replies := make(chan error, len(probes))
+	for _, probe := range probes {
+		probe := probe
+		go func() { replies <- probe(ctx) }()
+	}
+	for range probes {
+		err := <-replies
+		if err == nil {
+			return true
+		}
+		log.Println(internal.DebugPrefix, "auto technology: probing", tech+":", err)
+	}
+	return false
+}
+
+// probedServers returns the least loaded servers supporting NordLynx
+func probedServers(servers core.Servers, count int) core.Servers {
+	return leastLoaded(servers, count, func(server core.Server) bool {
+		return server.NordLynxPublicKey != "" && core.IsConnectableVia(core.WireguardTech)(server)
+	})
+}
+
+// leastLoaded returns up to count least loaded servers matching the predicate
+func leastLoaded(servers core.Servers, count int, predicate core.Predicate) core.Servers {
+	var candidates core.Servers
+	for _, server := range servers {
+		if predicate(server) {
+			candidates = append(candidates, server)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].Load < candidates[j].Load })
+	if len(candidates) > count {
+		candidates = candidates[:count]
+	}
+	return candidates
+}
+
+// autoTechnology returns the technology to connect with on the current network
+// and its fingerprint. The technology picked before on the network is reused,
+// otherwise it is probed and remembered if any of the servers replied.
+func (r *RPC) autoTechnology(cfg config.Config) (config.Technology, string) {
+	switch {
+	case !cfg.AllowedTechnologies.Allows(config.Technology_NORDLYNX):
+		return config.Technology_OPENVPN, ""
+	case !cfg.AllowedTechnologies.Allows(config.Technology_OPENVPN):
+		return config.Technology_NORDLYNX, ""
+	case r.techSelector == nil:
+		return cfg.Technology, ""
+	}
+
+	fingerprint, network := r.techSelector.fingerprint()
+	if tech, ok := cfg.AutoTechnologyNetworks[fingerprint]; ok && fingerprint != "" {
+		log.Println(internal.InfoPrefix, "auto technology: using", tech, "picked before on", network)
+		return tech, fingerprint
+	}
+
+	// probes are dropped by the kill switch, so they would not tell anything
+	// about the network
+	if cfg.KillSwitch {
+		log.Println(internal.InfoPrefix, "auto technology: cannot probe the network, using", cfg.Technology)
+		return cfg.Technology, ""
+	}
+	privateKey := cfg.TokensData[cfg.AutoConnectData.ID].NordLynxPrivateKey
+	if privateKey == "" {
+		return cfg.Technology, ""
+	}
+
+	servers := r.dm.GetServersData().Servers
+	var tech config.Technology
+	switch {
+	case r.techSelector.nordLynxReachable(servers, privateKey, cfg.ServerPorts.Get(config.Technology_NORDLYNX)):
+		tech = config.Technology_NORDLYNX
+	case r.techSelector.openVPNReachable(servers, cfg.ServerPorts.Get(config.Technology_OPENVPN)):
+		tech = config.Technology_OPENVPN
+	default:
+		// network is probably offline, the result would be wrong once it is not
+		log.Println(internal.InfoPrefix, "auto technology: no server replied on", network, "using", cfg.Technology)
+		return cfg.Technology, ""
+	}
+	log.Println(internal.InfoPrefix, "auto technology: picked", tech, "on", network)
+	r.rememberTechnology(fingerprint, tech)
+	return tech, fingerprint
+}
+
+// rememberTechnology makes the next connections on the network use the
+// technology without probing
+func (r *RPC) rememberTechnology(fingerprint string, tech config.Technology) {
+	if fingerprint == "" {
+		return
+	}
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		networks := maps.Clone(c.AutoTechnologyNetworks)
+		if networks == nil {
+			networks = map[string]config.Technology{}
+		}
+		networks[fingerprint] = tech
+		c.AutoTechnologyNetworks = networks
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, "saving technology picked for the network:", err)
+	}
+}
+
+// forgetTechnology makes the next connection on the network probe again, e.g.
+// after the remembered technology failed to connect
+func (r *RPC) forgetTechnology(fingerprint string) {
+	if fingerprint == "" {
+		return
+	}
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		networks := maps.Clone(c.AutoTechnologyNetworks)
+		delete(networks, fingerprint)
+		c.AutoTechnologyNetworks = networks
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, "forgetting technology picked for the network:", err)
+	}
+}
@@ -0,0 +1,306 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/metered"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	mocknetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockHandshakeProber struct {
+	reachable bool
+	mu        sync.Mutex
+	probed    []netip.AddrPort
+}
+
+func (p *mockHandshakeProber) Probe(_ context.Context, endpoint netip.AddrPort, _ string, _ string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.probed = append(p.probed, endpoint)
+	if !p.reachable {
+		return errors.New("i/o timeout")
+	}
+	return nil
+}
+
+type mockConnectProber struct {
+	reachable bool
+	mu        sync.Mutex
+	probed    []netip.AddrPort
+}
+
+func (p *mockConnectProber) Probe(_ context.Context, endpoint netip.AddrPort) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.probed = append(p.probed, endpoint)
+	if !p.reachable {
+		return errors.New("connection refused")
+	}
+	return nil
+}
+
+func nordLynxTestServer(station string, load int64) core.Server {
+	return core.Server{
+		Station:           station,
+		Load:              load,
+		Status:            core.Online,
+		NordLynxPublicKey: "cHVibGljIGtleQ==",
+		Technologies: core.Technologies{
+			{ID: core.WireguardTech, Pivot: core.Pivot{Status: core.Online}},
+		},
+	}
+}
+
+func TestTechnologySelector_Fingerprint(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	wifi := metered.Connection{Name: "Cafe", Type: metered.TypeWireless, SSID: "Cafe", BSSID: "00:11:22:33:44:55"}
+	gateway := netip.MustParseAddr("192.168.1.1")
+	fingerprint := func(connection metered.Connection, err error, gateway netip.Addr) (string, string) {
+		selector := technologySelector{
+			detector: &mockMeteredDetector{connection: connection, err: err},
+			gateway:  &mockTrustedGateway{addr: gateway},
+		}
+		return selector.fingerprint()
+	}
+
+	cafe, label := fingerprint(wifi, nil, gateway)
+	assert.NotEmpty(t, cafe)
+	assert.Equal(t, "Cafe", label)
+
+	otherAccessPoint := wifi
+	otherAccessPoint.BSSID = "66:77:88:99:aa:bb"
+	same, _ := fingerprint(otherAccessPoint, nil, gateway)
+	assert.Equal(t, cafe, same)
+
+	other, _ := fingerprint(wifi, nil, netip.MustParseAddr("10.0.0.1"))
+	assert.NotEqual(t, cafe, other)
+
+	wired, label := fingerprint(metered.Connection{}, nil, gateway)
+	assert.NotEmpty(t, wired)
+	assert.Equal(t, "192.168.1.1", label)
+
+	undetected, _ := fingerprint(metered.Connection{}, errors.New("no NetworkManager"), gateway)
+	assert.Empty(t, undetected)
+	unknown, _ := fingerprint(metered.Connection{}, nil, netip.Addr{})
+	assert.Empty(t, unknown)
+}
+
+func TestProbedServers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	offline := nordLynxTestServer("192.0.2.4", 1)
+	offline.Status = core.Offline
+	withoutKey := nordLynxTestServer("192.0.2.5", 2)
+	withoutKey.NordLynxPublicKey = ""
+	servers := core.Servers{
+		nordLynxTestServer("192.0.2.1", 30),
+		offline,
+		withoutKey,
+		nordLynxTestServer("192.0.2.2", 10),
+		nordLynxTestServer("192.0.2.3", 20),
+		{Station: "192.0.2.6", Status: core.Online},
+	}
+
+	probed := probedServers(servers, 2)
+	require.Len(t, probed, 2)
+	assert.Equal(t, "192.0.2.2", probed[0].Station)
+	assert.Equal(t, "192.0.2.3", probed[1].Station)
+}
+
+func openVPNTestServer(station string, load int64, tech core.ServerTechnology, ports ...uint16) core.Server {
+	return core.Server{
+		Station: station,
+		Load:    load,
+		Status:  core.Online,
+		Technologies: core.Technologies{
+			{ID: tech, Pivot: core.Pivot{Status: core.Online}, Ports: ports},
+		},
+	}
+}
+
+func TestTechnologySelector_OpenVPNReachable(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	servers := core.Servers{
+		nordLynxTestServer("192.0.2.1", 1),
+		openVPNTestServer("192.0.2.2", 20, core.OpenVPNTCP),
+		openVPNTestServer("192.0.2.3", 10, core.OpenVPNUDP),
+		openVPNTestServer("192.0.2.4", 30, core.OpenVPNTCPObfuscated, 993, 995),
+		openVPNTestServer("192.0.2.5", 40, core.OpenVPNTCPObfuscated),
+	}
+
+	// probes are unanswered, so that all of them are made before returning
+	tests := []struct {
+		name     string
+		port     uint16
+		expected []string
+	}{
+		{
+			name:     "default ports",
+			expected: []string{"192.0.2.2:443", "192.0.2.4:993", "192.0.2.5:443"},
+		},
+		{
+			name:     "configured port",
+			port:     80,
+			expected: []string{"192.0.2.2:80", "192.0.2.4:80", "192.0.2.5:80"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			prober := &mockConnectProber{}
+			selector := technologySelector{openVPNProber: prober}
+			assert.False(t, selector.openVPNReachable(servers, test.port))
+
+			var probed []string
+			for _, endpoint := range prober.probed {
+				probed = append(probed, endpoint.String())
+			}
+			assert.ElementsMatch(t, test.expected, probed)
+		})
+	}
+
+	selector := technologySelector{openVPNProber: &mockConnectProber{reachable: true}}
+	assert.True(t, selector.openVPNReachable(servers, 0))
+	assert.False(t, selector.openVPNReachable(core.Servers{servers[0], servers[2]}, 0))
+}
+
+func TestRPC_AutoTechnology(t *testing.T) {
+	category.Set(t, category.Unit)
+	defer testsCleanup()
+
+	dm := testNewDataManager()
+	server := nordLynxTestServer("192.0.2.1", 10)
+	server.Technologies = append(server.Technologies, core.Technology{ID: core.OpenVPNTCP, Pivot: core.Pivot{Status: core.Online}})
+	require.NoError(t, dm.SetServersData(time.Now(), core.Servers{server}, ""))
+	detector := &mockMeteredDetector{connection: metered.Connection{Name: "Home"}}
+	home, _ := (&technologySelector{detector: detector}).fingerprint()
+
+	tests := []struct {
+		name             string
+		cfg              func(*config.Config)
+		reachable        bool
+		openVPNReachable bool
+		expected         config.Technology
+		remembered       bool
+		probed           bool
+		openVPNProbed    bool
+	}{
+		{
+			name:             "NordLynx gets through",
+			reachable:        true,
+			openVPNReachable: true,
+			expected:         config.Technology_NORDLYNX,
+			remembered:       true,
+			probed:           true,
+		},
+		{
+			name:             "NordLynx is blocked",
+			openVPNReachable: true,
+			expected:         config.Technology_OPENVPN,
+			remembered:       true,
+			probed:           true,
+			openVPNProbed:    true,
+		},
+		{
+			name: "nothing gets through",
+			cfg: func(c *config.Config) {
+				c.Technology = config.Technology_NORDLYNX
+			},
+			expected:      config.Technology_NORDLYNX,
+			probed:        true,
+			openVPNProbed: true,
+		},
+		{
+			name: "picked before",
+			cfg: func(c *config.Config) {
+				c.AutoTechnologyNetworks = map[string]config.Technology{home: config.Technology_OPENVPN}
+			},
+			reachable:  true,
+			expected:   config.Technology_OPENVPN,
+			remembered: true,
+		},
+		{
+			name: "kill switch drops the probes",
+			cfg: func(c *config.Config) {
+				c.KillSwitch = true
+				c.Technology = config.Technology_OPENVPN
+			},
+			reachable: true,
+			expected:  config.Technology_OPENVPN,
+		},
+		{
+			name: "only OpenVPN is allowed",
+			cfg: func(c *config.Config) {
+				c.AllowedTechnologies = config.Technologies{config.Technology_OPENVPN}
+			},
+			reachable: true,
+			expected:  config.Technology_OPENVPN,
+		},
+		{
+			name: "no NordLynx key",
+			cfg: func(c *config.Config) {
+				c.TokensData = nil
+				c.Technology = config.Technology_OPENVPN
+			},
+			reachable: true,
+			expected:  config.Technology_OPENVPN,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.AutoTechnology = true
+			cm.Cfg.AutoConnectData.ID = 1
+			cm.Cfg.TokensData = map[int64]config.TokenData{1: {NordLynxPrivateKey: "cHJpdmF0ZSBrZXk="}}
+			if test.cfg != nil {
+				test.cfg(cm.Cfg)
+			}
+			prober := &mockHandshakeProber{reachable: test.reachable}
+			openVPNProber := &mockConnectProber{reachable: test.openVPNReachable}
+			r := RPC{
+				cm:   cm,
+				dm:   dm,
+				netw: &mocknetworker.Mock{},
+				techSelector: &technologySelector{
+					detector:      detector,
+					prober:        prober,
+					openVPNProber: openVPNProber,
+				},
+			}
+
+			tech, fingerprint := r.autoTechnology(*cm.Cfg)
+			assert.Equal(t, test.expected, tech)
+			assert.Equal(t, test.probed, len(prober.probed) > 0)
+			if test.probed {
+				assert.Equal(t, "192.0.2.1:51820", prober.probed[0].String())
+			}
+			assert.Equal(t, test.openVPNProbed, len(openVPNProber.probed) > 0)
+			if test.openVPNProbed {
+				assert.Equal(t, "192.0.2.1:443", openVPNProber.probed[0].String())
+			}
+			if test.remembered {
+				assert.Equal(t, home, fingerprint)
+				assert.Equal(t, test.expected, cm.Cfg.AutoTechnologyNetworks[home])
+			} else {
+				assert.Empty(t, cm.Cfg.AutoTechnologyNetworks)
+			}
+
+			r.forgetTechnology(fingerprint)
+			assert.Empty(t, cm.Cfg.AutoTechnologyNetworks)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package daemon
+
+import (
+	"log"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/metered"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+)
+
+// autoConnectRules applies the matching auto-connect rule after joining a
+// network. The network which is joined before the daemon starts is handled by
+// the auto-connect on start.
+type autoConnectRules struct {
+	cm       config.Manager
+	netw     networker.Networker
+	detector metered.Detector
+	// connect to VPN with the request
+	connect func(*pb.ConnectRequest) error
+	// allowed reports whether auto-connect is allowed by the metered policy
+	allowed func() bool
+	mu      sync.Mutex
+	// network is the last seen primary network connection, nil until the
+	// first check
+	network *config.Network
+}
+
+func (a *autoConnectRules) check() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	connection, err := a.detector.PrimaryConnection()
+	if err != nil {
+		log.Println(internal.WarningPrefix, "auto-connect rules: detecting network connection:", err)
+		return
+	}
+	network := networkOf(connection)
+	first := a.network == nil
+	if !first && *a.network == network {
+		return
+	}
+	a.network = &network
+	if first || (network == config.Network{}) {
+		return
+	}
+
+	var cfg config.Config
+	if err := a.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return
+	}
+
+	rule, ok := cfg.AutoConnectRules.Match(network)
+	if !ok {
+		return
+	}
+	if rule.Action == config.AutoConnectActionSkip {
+		log.Println(internal.InfoPrefix, "auto-connect rules: skipping auto-connect on", networkLabel(network))
+		return
+	}
+	if a.netw.IsVPNActive() {
+		return
+	}
+	if !a.allowed() {
+		log.Println(internal.InfoPrefix, "auto-connect rules: auto-connect is suppressed by the metered network policy")
+		return
+	}
+
+	log.Println(internal.InfoPrefix, "auto-connect rules: connecting on", networkLabel(network))
+	if err := a.connect(autoConnectRequest(cfg, rule)); err != nil {
+		log.Println(internal.ErrorPrefix, "auto-connect rules: connecting:", err)
+	}
+}
+
+// current returns the rule matching the current network
+func (a *autoConnectRules) current(cfg config.Config) (config.AutoConnectRule, bool) {
+	if len(cfg.AutoConnectRules) == 0 {
+		return config.AutoConnectRule{}, false
+	}
+	connection, err := a.detector.PrimaryConnection()
+	if err != nil {
+		log.Println(internal.WarningPrefix, "auto-connect rules: detecting network connection:", err)
+		return config.AutoConnectRule{}, false
+	}
+	return cfg.AutoConnectRules.Match(networkOf(connection))
+}
+
+// autoConnectRequest returns the request connecting to the server or group of
+// the rule, or to the auto-connect server if the rule has neither
+func autoConnectRequest(cfg config.Config, rule config.AutoConnectRule) *pb.ConnectRequest {
+	if rule.ServerTag == "" && rule.ServerGroup == "" {
+		return &pb.ConnectRequest{ServerTag: cfg.AutoConnectData.ServerTag}
+	}
+	return &pb.ConnectRequest{ServerTag: rule.ServerTag, ServerGroup: rule.ServerGroup}
+}
+
+// networkOf returns the network of the connection for matching the rules
+func networkOf(connection metered.Connection) config.Network {
+	network := config.Network{SSID: connection.SSID, BSSID: connection.BSSID}
+	switch connection.Type {
+	case metered.TypeWireless:
+		network.Type = config.NetworkTypeWiFi
+	case metered.TypeEthernet:
+		network.Type = config.NetworkTypeEthernet
+	case metered.TypeGSM, metered.TypeCDMA:
+		network.Type = config.NetworkTypeMobile
+	}
+	return network
+}
+
+func networkLabel(network config.Network) string {
+	if network.SSID != "" {
+		return network.SSID
+	}
+	return string(network.Type)
+}
+
+// JobAutoConnectRules applies the auto-connect rules after joining a network
+func JobAutoConnectRules(r *RPC) func() {
+	return r.autoConnectRules.check
+}
@@ -0,0 +1,128 @@
+package daemon
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/metered"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoConnectRules(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	rules := config.AutoConnectRules{
+		{SSID: "HomeLAN", Action: config.AutoConnectActionSkip},
+		{SSID: "CoffeeShop", Action: config.AutoConnectActionConnect, ServerGroup: "obfuscated"},
+		{NetworkType: config.NetworkTypeMobile, Action: config.AutoConnectActionConnect},
+	}
+	wifi := func(ssid string) metered.Connection {
+		return metered.Connection{Name: ssid, Type: metered.TypeWireless, SSID: ssid}
+	}
+
+	tests := []struct {
+		name       string
+		connection metered.Connection
+		vpnActive  bool
+		disallowed bool
+		expected   *pb.ConnectRequest
+	}{
+		{
+			name:       "connect rule",
+			connection: wifi("CoffeeShop"),
+			expected:   &pb.ConnectRequest{ServerGroup: "obfuscated"},
+		},
+		{
+			name:       "rule without server",
+			connection: metered.Connection{Name: "phone", Type: metered.TypeGSM},
+			expected:   &pb.ConnectRequest{ServerTag: "lt"},
+		},
+		{
+			name:       "skip rule",
+			connection: wifi("HomeLAN"),
+		},
+		{
+			name:       "no matching rule",
+			connection: wifi("Airport"),
+		},
+		{
+			name:       "vpn is active",
+			connection: wifi("CoffeeShop"),
+			vpnActive:  true,
+		},
+		{
+			name:       "metered policy",
+			connection: wifi("CoffeeShop"),
+			disallowed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.AutoConnectRules = rules
+			cm.Cfg.AutoConnectData.ServerTag = "lt"
+			detector := mockMeteredDetector{connection: metered.Connection{Name: "wired", Type: metered.TypeEthernet}}
+			var requests []*pb.ConnectRequest
+			a := autoConnectRules{
+				cm:       cm,
+				netw:     &onDemandNetworker{active: test.vpnActive},
+				detector: &detector,
+				connect: func(request *pb.ConnectRequest) error {
+					requests = append(requests, request)
+					return nil
+				},
+				allowed: func() bool { return !test.disallowed },
+			}
+
+			a.check()
+			detector.connection = test.connection
+			a.check()
+			if test.expected == nil {
+				assert.Empty(t, requests)
+			} else {
+				assert.Equal(t, []*pb.ConnectRequest{test.expected}, requests)
+			}
+		})
+	}
+}
+
+func TestAutoConnectRules_OnlyOnJoin(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.AutoConnectRules = config.AutoConnectRules{
+		{SSID: "CoffeeShop", Action: config.AutoConnectActionConnect},
+	}
+	detector := mockMeteredDetector{connection: metered.Connection{Type: metered.TypeWireless, SSID: "CoffeeShop"}}
+	connects := 0
+	a := autoConnectRules{
+		cm:       cm,
+		netw:     &onDemandNetworker{},
+		detector: &detector,
+		connect: func(*pb.ConnectRequest) error {
+			connects++
+			return nil
+		},
+		allowed: func() bool { return true },
+	}
+
+	// network joined before the daemon start is handled by auto-connect
+	a.check()
+	assert.Equal(t, 0, connects)
+
+	detector.connection = metered.Connection{}
+	a.check()
+	detector.connection = metered.Connection{Type: metered.TypeWireless, SSID: "CoffeeShop"}
+	a.check()
+	a.check()
+	assert.Equal(t, 1, connects)
+
+	// roaming to another access point of the same network is a network change
+	detector.connection.BSSID = "aa:bb:cc:dd:ee:ff"
+	a.check()
+	assert.Equal(t, 2, connects)
+}
@@ -0,0 +1,71 @@
+package daemon
+
+import (
+	"log"
+	"net/netip"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/boot"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// BootKillSwitch blocks the traffic from the boot until the daemon sets the
+// kill switch
+type BootKillSwitch interface {
+	// Set the rules applied at the next boot, nil disables them
+	Set(*boot.Ruleset) error
+	// Release removes the rules applied at boot
+	Release() error
+}
+
+// bootKillSwitchRuleset returns the rules matching the kill switch settings, nil
+// if the kill switch is disabled. Allowlisted domains are not included, as they
+// cannot be resolved before the daemon starts.
+func bootKillSwitchRuleset(cfg config.Config) *boot.Ruleset {
+	if !cfg.Firewall || !cfg.KillSwitch {
+		return nil
+	}
+	allowlist := reloadedAllowlist(cfg)
+	ruleset := boot.Ruleset{
+		TCPPorts: allowlist.Ports.TCP.ToSlice(),
+		UDPPorts: allowlist.Ports.UDP.ToSlice(),
+		LAN:      cfg.KillSwitchLAN,
+	}
+	for subnet := range allowlist.Subnets {
+		prefix, err := netip.ParsePrefix(subnet)
+		if err != nil {
+			log.Println(internal.WarningPrefix, "boot kill switch: skipping allowlisted subnet:", err)
+			continue
+		}
+		ruleset.Subnets = append(ruleset.Subnets, prefix)
+	}
+	return &ruleset
+}
+
+// syncBootKillSwitch updates the rules applied at boot after the kill switch
+// settings have changed. Rules applied at the last boot are removed together
+// with the kill switch.
+func (r *RPC) syncBootKillSwitch() {
+	if r.bootKillSwitch == nil {
+		return
+	}
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, "boot kill switch: loading config:", err)
+		return
+	}
+	if err := r.bootKillSwitch.Set(bootKillSwitchRuleset(cfg)); err != nil {
+		log.Println(internal.ErrorPrefix, "boot kill switch:", err)
+	}
+}
+
+// releaseBootKillSwitch removes the rules applied at boot once the daemon has
+// set the kill switch itself
+func (r *RPC) releaseBootKillSwitch() {
+	if r.bootKillSwitch == nil {
+		return
+	}
+	if err := r.bootKillSwitch.Release(); err != nil {
+		log.Println(internal.ErrorPrefix, "boot kill switch: releasing:", err)
+	}
+}
@@ -0,0 +1,131 @@
+package daemon
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/boot"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	mocknetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockBootKillSwitch struct {
+	ruleset  *boot.Ruleset
+	set      bool
+	released bool
+}
+
+func (m *mockBootKillSwitch) Set(ruleset *boot.Ruleset) error {
+	m.ruleset = ruleset
+	m.set = true
+	return nil
+}
+
+func (m *mockBootKillSwitch) Release() error {
+	m.released = true
+	return nil
+}
+
+// failingKillSwitchNetworker fails to set the kill switch
+type failingKillSwitchNetworker struct {
+	mocknetworker.Mock
+}
+
+func (*failingKillSwitchNetworker) SetKillSwitch(config.Allowlist) error { return mock.ErrOnPurpose }
+
+func TestBootKillSwitchRuleset(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	allowlist := config.NewAllowlist([]int64{53}, []int64{22}, []string{"192.0.2.0/24"})
+	tests := []struct {
+		name     string
+		cfg      config.Config
+		expected *boot.Ruleset
+	}{
+		{
+			name: "kill switch disabled",
+			cfg:  config.Config{Firewall: true},
+		},
+		{
+			name: "firewall disabled",
+			cfg:  config.Config{KillSwitch: true},
+		},
+		{
+			name:     "kill switch enabled",
+			cfg:      config.Config{Firewall: true, KillSwitch: true},
+			expected: &boot.Ruleset{TCPPorts: []int64{}, UDPPorts: []int64{}},
+		},
+		{
+			name: "allowlist",
+			cfg: config.Config{
+				Firewall:        true,
+				KillSwitch:      true,
+				KillSwitchLAN:   true,
+				AutoConnectData: config.AutoConnectData{Allowlist: allowlist},
+			},
+			expected: &boot.Ruleset{
+				Subnets:  []netip.Prefix{netip.MustParsePrefix("192.0.2.0/24")},
+				TCPPorts: []int64{22},
+				UDPPorts: []int64{53},
+				LAN:      true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, bootKillSwitchRuleset(test.cfg))
+		})
+	}
+}
+
+func TestRPC_StartKillSwitchReleasesBootRules(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name             string
+		killSwitch       bool
+		netw             networker.Networker
+		expectedSet      bool
+		expectedRuleset  bool
+		expectedReleased bool
+	}{
+		{
+			name:             "kill switch set",
+			killSwitch:       true,
+			netw:             &mocknetworker.Mock{},
+			expectedSet:      true,
+			expectedRuleset:  true,
+			expectedReleased: true,
+		},
+		{
+			name:        "kill switch disabled",
+			netw:        &mocknetworker.Mock{},
+			expectedSet: true,
+		},
+		{
+			name:       "kill switch failed",
+			killSwitch: true,
+			netw:       &failingKillSwitchNetworker{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Firewall = true
+			cm.Cfg.KillSwitch = test.killSwitch
+			bks := &mockBootKillSwitch{}
+			r := RPC{cm: cm, netw: test.netw, bootKillSwitch: bks}
+
+			r.StartKillSwitch()
+			assert.Equal(t, test.expectedSet, bks.set)
+			assert.Equal(t, test.expectedRuleset, bks.ruleset != nil)
+			assert.Equal(t, test.expectedReleased, bks.released)
+		})
+	}
+}
@@ -0,0 +1,302 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/metered"
+	"github.com/NordSecurity/nordvpn-linux/daemon/routes"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"golang.org/x/exp/slices"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// CaptivePortalWindow is for how long the sign-in to the gateway is allowed
+	// after joining the network with a captive portal
+	CaptivePortalWindow = 2 * time.Minute
+	// DefaultPortalUnlock is for how long the sign-in page is opened by default
+	DefaultPortalUnlock = 3 * time.Minute
+	// MaxPortalUnlock bounds for how long the sign-in page can be opened
+	MaxPortalUnlock = 15 * time.Minute
+	// portalProbeURL responds with 204 No Content unless the request is
+	// intercepted by a captive portal
+	portalProbeURL = "http://connectivitycheck.gstatic.com/generate_204"
+	// portalProbeTimeout bounds the probe, so that the check job is not held up
+	portalProbeTimeout = 5 * time.Second
+)
+
+// Portal is the result of probing for a captive portal
+type Portal struct {
+	// Detected is true if the probe was intercepted
+	Detected bool
+	// URL of the sign-in page, empty if the portal did not redirect to it
+	URL string
+}
+
+// PortalProber checks whether the network has a captive portal. Probes bypass
+// kill switch, so they work before the sign-in.
+type PortalProber interface {
+	Probe() (Portal, error)
+	// Resolve the host name using the DNS of the network
+	Resolve(host string) ([]netip.Addr, error)
+}
+
+// captivePortal allows the sign-in to the gateway for a bounded time after
+// joining the network which is known to have a captive portal, so that the user
+// can sign in while the rest of the traffic stays blocked by kill switch.
+type captivePortal struct {
+	cm       config.Manager
+	netw     networker.Networker
+	detector metered.Detector
+	prober   PortalProber
+	gateway  routes.GatewayRetriever
+	// notify the users about the detected portal
+	notify func(network string)
+	now    func() time.Time
+	mu     sync.Mutex
+	// network is the name of the last seen primary network connection
+	network string
+	// relaxedUntil is when the sign-in to the gateway is blocked again, zero if
+	// not allowed
+	relaxedUntil time.Time
+	// unlockedUntil is when the sign-in page is closed again, zero if not open
+	unlockedUntil time.Time
+}
+
+func (p *captivePortal) check() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var cfg config.Config
+	if err := p.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return
+	}
+
+	if !p.relaxedUntil.IsZero() && (!p.now().Before(p.relaxedUntil) || p.netw.IsVPNActive()) {
+		p.enforce()
+	}
+	if !p.unlockedUntil.IsZero() && (!p.now().Before(p.unlockedUntil) || p.netw.IsVPNActive()) {
+		p.lock()
+	}
+
+	connection, err := p.detector.PrimaryConnection()
+	if err != nil {
+		log.Println(internal.WarningPrefix, "captive portal: detecting network connection:", err)
+		return
+	}
+	if connection.Name == p.network {
+		return
+	}
+	p.network = connection.Name
+	// the sign-in page of the previous network is not opened for the new one
+	if !p.unlockedUntil.IsZero() {
+		p.lock()
+	}
+
+	if connection.Name == "" || !cfg.KillSwitch || p.netw.IsVPNActive() {
+		return
+	}
+	if slices.Contains(cfg.CaptivePortalNetworks, connection.Name) {
+		p.relax(connection.Name)
+		return
+	}
+	p.detect(connection.Name)
+}
+
+// detect probes the joined network and notifies the users if it has a captive
+// portal, which cannot be reached because of kill switch
+func (p *captivePortal) detect(network string) {
+	portal, err := p.prober.Probe()
+	if err != nil {
+		log.Println(internal.WarningPrefix, "captive portal: probing", network+":", err)
+		return
+	}
+	if !portal.Detected {
+		return
+	}
+	log.Println(internal.InfoPrefix, "captive portal: detected on", network, portal.URL)
+	p.notify(network)
+}
+
+// unlock opens the kill switch for the sign-in page of the captive portal for
+// the given duration. DNS, HTTP and HTTPS are allowed to the gateway and the
+// server of the sign-in page. Returns the URL of the sign-in page if known.
+func (p *captivePortal) unlock(duration time.Duration) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	gateway, _, err := p.gateway.Default(false)
+	if err != nil {
+		return "", fmt.Errorf("getting default gateway: %w", err)
+	}
+	addrs := []netip.Addr{gateway}
+
+	portal, err := p.prober.Probe()
+	if err != nil {
+		log.Println(internal.WarningPrefix, "captive portal: probing:", err)
+	}
+	if portal.URL != "" {
+		// the sign-in page is often served by other host than the gateway
+		if u, err := url.Parse(portal.URL); err == nil {
+			ips, err := p.prober.Resolve(u.Hostname())
+			if err != nil {
+				log.Println(internal.WarningPrefix, "captive portal: resolving sign-in page:", err)
+			}
+			for _, ip := range ips {
+				if !slices.Contains(addrs, ip) {
+					addrs = append(addrs, ip)
+				}
+			}
+		}
+	}
+
+	if err := p.netw.AllowCaptivePortal(addrs); err != nil {
+		return "", fmt.Errorf("allowing captive portal: %w", err)
+	}
+	p.unlockedUntil = p.now().Add(duration)
+	log.Println(internal.InfoPrefix, "captive portal: sign-in is allowed to", addrs, "for", duration)
+	return portal.URL, nil
+}
+
+// pending reports whether kill switch is relaxed or opened for the sign-in
+// page and has to be enforced again later
+func (p *captivePortal) pending() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return !p.relaxedUntil.IsZero() || !p.unlockedUntil.IsZero()
+}
+
+// lock closes the kill switch opened for the sign-in page
+func (p *captivePortal) lock() {
+	p.unlockedUntil = time.Time{}
+	// the sign-in allowed on join uses the same rules
+	p.relaxedUntil = time.Time{}
+	if err := p.netw.BlockCaptivePortal(); err != nil {
+		log.Println(internal.ErrorPrefix, "captive portal: blocking sign-in:", err)
+		return
+	}
+	log.Println(internal.InfoPrefix, "captive portal: sign-in is blocked")
+}
+
+// relax allows DHCP and DNS, HTTP and HTTPS to the gateway of the joined
+// network. Kill switch stays set for the rest of the traffic.
+func (p *captivePortal) relax(network string) {
+	gateway, _, err := p.gateway.Default(false)
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "captive portal: getting default gateway:", err)
+		return
+	}
+	if err := p.netw.AllowCaptivePortal([]netip.Addr{gateway}); err != nil {
+		log.Println(internal.ErrorPrefix, "captive portal: allowing sign-in:", err)
+		return
+	}
+	p.relaxedUntil = p.now().Add(CaptivePortalWindow)
+	log.Println(internal.InfoPrefix, "captive portal: sign-in to", gateway, "is allowed on", network, "for", CaptivePortalWindow)
+}
+
+// enforce blocks the sign-in allowed on join unless the sign-in page was opened
+// meanwhile, which is blocked once it expires
+func (p *captivePortal) enforce() {
+	p.relaxedUntil = time.Time{}
+	if !p.unlockedUntil.IsZero() {
+		return
+	}
+	if err := p.netw.BlockCaptivePortal(); err != nil {
+		log.Println(internal.ErrorPrefix, "captive portal: blocking sign-in:", err)
+		return
+	}
+	log.Println(internal.InfoPrefix, "captive portal: sign-in is blocked")
+}
+
+// JobCaptivePortal allows the sign-in after joining the network with a captive portal
+func JobCaptivePortal(r *RPC) func() {
+	return r.captivePortal.check
+}
+
+// HTTPPortalProber probes for the captive portal over plain HTTP. Connections
+// and DNS queries are marked with fwmark, so they bypass kill switch and the
+// VPN tunnel.
+type HTTPPortalProber struct {
+	client   *http.Client
+	resolver *net.Resolver
+}
+
+// NewHTTPPortalProber creates the prober marking its traffic with fwmark
+func NewHTTPPortalProber(fwmark uint32) *HTTPPortalProber {
+	dialer := &net.Dialer{
+		Timeout: portalProbeTimeout,
+		Control: func(network, address string, conn syscall.RawConn) error {
+			var operr error
+			if err := conn.Control(func(fd uintptr) {
+				operr = syscall.SetsockoptInt(int(fd), unix.SOL_SOCKET, unix.SO_MARK, int(fwmark))
+			}); err != nil {
+				return err
+			}
+			return operr
+		},
+	}
+	resolver := &net.Resolver{PreferGo: true, Dial: dialer.DialContext}
+	dialer.Resolver = resolver
+	return &HTTPPortalProber{
+		client: &http.Client{
+			Transport: &http.Transport{DialContext: dialer.DialContext},
+			// redirect of the probe points to the sign-in page
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+			Timeout:       portalProbeTimeout,
+		},
+		resolver: resolver,
+	}
+}
+
+func (p *HTTPPortalProber) Probe() (Portal, error) {
+	resp, err := p.client.Get(portalProbeURL)
+	if err != nil {
+		return Portal{}, err
+	}
+	defer resp.Body.Close()
+	return portalFromResponse(resp), nil
+}
+
+func (p *HTTPPortalProber) Resolve(host string) ([]netip.Addr, error) {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return []netip.Addr{addr}, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), portalProbeTimeout)
+	defer cancel()
+	ips, err := p.resolver.LookupNetIP(ctx, "ip4", host)
+	if err != nil {
+		return nil, err
+	}
+	if len(ips) == 0 {
+		return nil, errors.New("no addresses")
+	}
+	for i, ip := range ips {
+		ips[i] = ip.Unmap()
+	}
+	return ips, nil
+}
+
+// portalFromResponse returns the captive portal intercepting the probe
+func portalFromResponse(resp *http.Response) Portal {
+	if resp.StatusCode == http.StatusNoContent {
+		return Portal{}
+	}
+	portal := Portal{Detected: true}
+	if location, err := resp.Location(); err == nil {
+		portal.URL = location.String()
+	}
+	return portal
+}
@@ -0,0 +1,257 @@
+package daemon
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/metered"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type captivePortalNetworker struct {
+	killSwitchNetworker
+}
+
+func (n *captivePortalNetworker) UnsetKillSwitch() error {
+	n.killSwitch = false
+	return nil
+}
+
+type mockPortalProber struct {
+	portal Portal
+	addrs  []netip.Addr
+	err    error
+}
+
+func (p *mockPortalProber) Probe() (Portal, error)               { return p.portal, p.err }
+func (p *mockPortalProber) Resolve(string) ([]netip.Addr, error) { return p.addrs, nil }
+
+type mockPortalGateway struct{}
+
+func (mockPortalGateway) Default(bool) (netip.Addr, net.Interface, error) {
+	return netip.MustParseAddr("192.168.1.1"), net.Interface{}, nil
+}
+
+func TestCaptivePortal(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name       string
+		network    string
+		killSwitch bool
+		vpnActive  bool
+		relaxed    bool
+	}{
+		{
+			name:       "captive portal network",
+			network:    "hotel",
+			killSwitch: true,
+			relaxed:    true,
+		},
+		{
+			name:       "other network",
+			network:    "home",
+			killSwitch: true,
+		},
+		{
+			name:    "kill switch disabled",
+			network: "hotel",
+		},
+		{
+			name:       "vpn is active",
+			network:    "hotel",
+			killSwitch: true,
+			vpnActive:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.KillSwitch = test.killSwitch
+			cm.Cfg.CaptivePortalNetworks = []string{"hotel"}
+			netw := captivePortalNetworker{killSwitchNetworker{
+				onDemandNetworker: onDemandNetworker{active: test.vpnActive},
+				killSwitch:        test.killSwitch,
+			}}
+			now := time.Now()
+			p := captivePortal{
+				cm:       cm,
+				netw:     &netw,
+				detector: &mockMeteredDetector{connection: metered.Connection{Name: test.network}},
+				prober:   &mockPortalProber{},
+				gateway:  mockPortalGateway{},
+				now:      func() time.Time { return now },
+			}
+
+			p.check()
+			if test.relaxed {
+				assert.Equal(t, []netip.Addr{netip.MustParseAddr("192.168.1.1")}, netw.CaptivePortal)
+			} else {
+				assert.Empty(t, netw.CaptivePortal)
+			}
+			// kill switch stays set for the rest of the traffic
+			assert.Equal(t, test.killSwitch, netw.killSwitch)
+
+			// relaxation is bounded
+			now = now.Add(CaptivePortalWindow)
+			p.check()
+			assert.Empty(t, netw.CaptivePortal)
+			assert.Equal(t, test.killSwitch, netw.killSwitch)
+		})
+	}
+}
+
+func TestCaptivePortal_OnlyOnJoin(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.KillSwitch = true
+	cm.Cfg.CaptivePortalNetworks = []string{"hotel"}
+	netw := captivePortalNetworker{killSwitchNetworker{killSwitch: true}}
+	detector := mockMeteredDetector{connection: metered.Connection{Name: "hotel"}}
+	now := time.Now()
+	p := captivePortal{
+		cm:       cm,
+		netw:     &netw,
+		detector: &detector,
+		gateway:  mockPortalGateway{},
+		now:      func() time.Time { return now },
+	}
+
+	p.check()
+	assert.NotEmpty(t, netw.CaptivePortal)
+
+	// staying on the same network does not allow the sign-in again
+	now = now.Add(CaptivePortalWindow)
+	p.check()
+	assert.Empty(t, netw.CaptivePortal)
+	p.check()
+	assert.Empty(t, netw.CaptivePortal)
+
+	// rejoining does
+	detector.connection.Name = ""
+	p.check()
+	detector.connection.Name = "hotel"
+	p.check()
+	assert.NotEmpty(t, netw.CaptivePortal)
+	assert.True(t, netw.killSwitch)
+
+	// sign-in page opened meanwhile stays open until it expires
+	p.unlockedUntil = now.Add(DefaultPortalUnlock)
+	now = now.Add(CaptivePortalWindow)
+	p.check()
+	assert.NotEmpty(t, netw.CaptivePortal)
+	now = now.Add(DefaultPortalUnlock)
+	p.check()
+	assert.Empty(t, netw.CaptivePortal)
+}
+
+func TestCaptivePortal_Detect(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name       string
+		portal     Portal
+		killSwitch bool
+		notified   bool
+	}{
+		{
+			name:       "portal detected",
+			portal:     Portal{Detected: true, URL: "http://192.168.1.1/login"},
+			killSwitch: true,
+			notified:   true,
+		},
+		{
+			name:       "no portal",
+			killSwitch: true,
+		},
+		{
+			name:   "kill switch disabled",
+			portal: Portal{Detected: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.KillSwitch = test.killSwitch
+			var notified []string
+			p := captivePortal{
+				cm:       cm,
+				netw:     &captivePortalNetworker{killSwitchNetworker{killSwitch: test.killSwitch}},
+				detector: &mockMeteredDetector{connection: metered.Connection{Name: "airport"}},
+				prober:   &mockPortalProber{portal: test.portal},
+				notify:   func(network string) { notified = append(notified, network) },
+				now:      time.Now,
+			}
+
+			p.check()
+			// probed only on join
+			p.check()
+			if test.notified {
+				assert.Equal(t, []string{"airport"}, notified)
+			} else {
+				assert.Empty(t, notified)
+			}
+		})
+	}
+}
+
+func TestCaptivePortal_Unlock(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.KillSwitch = true
+	netw := captivePortalNetworker{killSwitchNetworker{killSwitch: true}}
+	portalAddr := netip.MustParseAddr("203.0.113.7")
+	now := time.Now()
+	p := captivePortal{
+		cm:       cm,
+		netw:     &netw,
+		detector: &mockMeteredDetector{connection: metered.Connection{Name: "airport"}},
+		prober: &mockPortalProber{
+			portal: Portal{Detected: true, URL: "https://portal.example.com/login"},
+			addrs:  []netip.Addr{portalAddr},
+		},
+		gateway: mockPortalGateway{},
+		notify:  func(string) {},
+		now:     func() time.Time { return now },
+	}
+	p.check()
+
+	url, err := p.unlock(DefaultPortalUnlock)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://portal.example.com/login", url)
+	assert.Equal(t, []netip.Addr{netip.MustParseAddr("192.168.1.1"), portalAddr}, netw.CaptivePortal)
+	// kill switch stays set
+	assert.True(t, netw.killSwitch)
+
+	p.check()
+	assert.NotEmpty(t, netw.CaptivePortal)
+
+	now = now.Add(DefaultPortalUnlock)
+	p.check()
+	assert.Empty(t, netw.CaptivePortal)
+}
+
+func TestPortalFromResponse(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, Portal{}, portalFromResponse(&http.Response{StatusCode: http.StatusNoContent}))
+
+	redirect := &http.Response{
+		StatusCode: http.StatusFound,
+		Header:     http.Header{"Location": []string{"http://192.168.1.1/login"}},
+		Request:    &http.Request{},
+	}
+	assert.Equal(t, Portal{Detected: true, URL: "http://192.168.1.1/login"}, portalFromResponse(redirect))
+
+	// portal serving the sign-in page in place of the probe
+	assert.Equal(t, Portal{Detected: true}, portalFromResponse(&http.Response{StatusCode: http.StatusOK, Request: &http.Request{}}))
+}
@@ -32,6 +32,9 @@ func (workingFirewall) Delete([]string) error     { return nil }
 func (workingFirewall) Enable() error             { return nil }
 func (workingFirewall) Disable() error            { return nil }
 func (workingFirewall) IsEnabled() bool           { return true }
+func (workingFirewall) Rebuild() (firewall.Reconciliation, error) {
+	return firewall.Reconciliation{}, nil
+}
 
 type UniqueAddress struct{}
 
@@ -0,0 +1,69 @@
+package daemon
+
+import (
+	"log"
+	"net"
+	"net/netip"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/hooks"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+)
+
+// HookRunner runs the administrator supplied scripts at the stages of the
+// connection lifecycle
+type HookRunner interface {
+	Run(stage hooks.Stage, env hooks.Env) error
+}
+
+// runHooks runs the scripts of the stage. Failures are logged only, so that
+// a broken script does not prevent connecting or disconnecting.
+func (r *RPC) runHooks(stage hooks.Stage, env hooks.Env) {
+	if r.hooks == nil {
+		return
+	}
+	if err := r.hooks.Run(stage, env); err != nil {
+		log.Println(internal.WarningPrefix, "running", stage, "hooks:", err)
+	}
+}
+
+// hookEnv describes the established connection to the scripts
+func hookEnv(status networker.ConnectionStatus) hooks.Env {
+	return hooks.Env{
+		Hostname:   status.Hostname,
+		Country:    status.Country,
+		City:       status.City,
+		ServerIP:   status.IP,
+		Technology: status.Technology.String(),
+		Protocol:   status.Protocol.String(),
+		Interface:  status.Interface,
+		TunnelIPs:  interfaceAddrs(status.Interface),
+	}
+}
+
+// interfaceAddrs returns the addresses of the network interface, nil if it
+// does not exist
+func interfaceAddrs(name string) []netip.Addr {
+	if name == "" {
+		return nil
+	}
+	iface, err := net.InterfaceByName(name)
+	if err != nil {
+		return nil
+	}
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return nil
+	}
+	var ips []netip.Addr
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok {
+			continue
+		}
+		if ip, ok := netip.AddrFromSlice(ipNet.IP); ok {
+			ips = append(ips, ip.Unmap())
+		}
+	}
+	return ips
+}
@@ -0,0 +1,47 @@
+package daemon
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/hooks"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockHookRunner struct {
+	stages []hooks.Stage
+}
+
+func (m *mockHookRunner) Run(stage hooks.Stage, _ hooks.Env) error {
+	m.stages = append(m.stages, stage)
+	return nil
+}
+
+func TestStopConnection_Hooks(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name      string
+		vpnActive bool
+		expected  []hooks.Stage
+	}{
+		{
+			name:      "connected",
+			vpnActive: true,
+			expected:  []hooks.Stage{hooks.PreDisconnect, hooks.PostDisconnect},
+		},
+		{
+			name: "not connected",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := mockHookRunner{}
+			r := RPC{netw: &testnetworker.Mock{VpnActive: test.vpnActive}, hooks: &runner}
+			assert.NoError(t, r.stopConnection(disconnectReasonUser))
+			assert.Equal(t, test.expected, runner.stages)
+		})
+	}
+}
@@ -27,6 +27,9 @@ const (
 	// VersionFilePath defines filename of latest available version file
 	VersionFilePath = internal.DatFilesPath + "version.dat"
 
+	// HistoryFilePath defines filename of connection history file
+	HistoryFilePath = internal.DatFilesPath + "history.dat"
+
 	// RandomComponentMin defines minimal value of random component
 	RandomComponentMin = 0
 
@@ -0,0 +1,96 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+)
+
+// credentialsRotation keeps the service credentials of the logged in user in
+// sync with the API. The credentials are otherwise only fetched on login, so
+// once they are rotated by the API, e.g. after being revoked, the headless
+// logins which are never repeated would fail to connect.
+type credentialsRotation struct {
+	cm   config.Manager
+	api  core.CredentialsAPI
+	netw networker.Networker
+	// reconnect the VPN connection so that it uses the new credentials
+	reconnect func() error
+	now       func() time.Time
+	mu        sync.Mutex
+}
+
+// check fetches the service credentials and saves them if they were rotated.
+// VPN is reconnected if it uses the rotated credentials. Returns true if the
+// credentials were rotated.
+func (c *credentialsRotation) check() (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var cfg config.Config
+	if err := c.cm.Load(&cfg); err != nil {
+		return false, fmt.Errorf("loading config: %w", err)
+	}
+	uid := cfg.AutoConnectData.ID
+	data, ok := cfg.TokensData[uid]
+	if !ok {
+		return false, internal.ErrNotLoggedIn
+	}
+
+	credentials, err := c.api.ServiceCredentials(data.Token)
+	if err != nil {
+		return false, fmt.Errorf("retrieving credentials: %w", err)
+	}
+
+	keyRotated := credentials.NordlynxPrivateKey != data.NordLynxPrivateKey
+	passwordRotated := credentials.Username != data.OpenVPNUsername ||
+		credentials.Password != data.OpenVPNPassword
+	checkedAt := c.now().UTC().Format(internal.ServerDateFormat)
+	if err := c.cm.SaveWith(func(c config.Config) config.Config {
+		user, ok := c.TokensData[uid]
+		if !ok {
+			return c
+		}
+		user.NordLynxPrivateKey = credentials.NordlynxPrivateKey
+		user.OpenVPNUsername = credentials.Username
+		user.OpenVPNPassword = credentials.Password
+		user.CredentialsUpdatedAt = credentials.UpdatedAt
+		user.CredentialsCheckedAt = checkedAt
+		c.TokensData[uid] = user
+		return c
+	}); err != nil {
+		return false, fmt.Errorf("saving credentials: %w", err)
+	}
+
+	if !keyRotated && !passwordRotated {
+		return false, nil
+	}
+	log.Println(internal.InfoPrefix, "credentials: service credentials were rotated")
+
+	inUse := keyRotated && cfg.Technology == config.Technology_NORDLYNX ||
+		passwordRotated && cfg.Technology == config.Technology_OPENVPN
+	if !inUse || !c.netw.IsVPNActive() {
+		return true, nil
+	}
+	log.Println(internal.InfoPrefix, "credentials: reconnecting with the new credentials")
+	if err := c.reconnect(); err != nil {
+		return true, fmt.Errorf("reconnecting: %w", err)
+	}
+	return true, nil
+}
+
+// JobCredentialsRotation periodically checks whether the service credentials were rotated
+func JobCredentialsRotation(r *RPC) func() {
+	return func() {
+		if _, err := r.credentials.check(); err != nil && !errors.Is(err, internal.ErrNotLoggedIn) {
+			log.Println(internal.ErrorPrefix, "credentials:", err)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	mocknetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+type rotatedCredentialsAPI struct {
+	validCredentialsAPI
+	credentials core.CredentialsResponse
+	err         error
+}
+
+func (r rotatedCredentialsAPI) ServiceCredentials(string) (*core.CredentialsResponse, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return &r.credentials, nil
+}
+
+func TestCredentialsRotation_Check(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	const uid = 1
+	stored := config.TokenData{
+		Token:              "token",
+		NordLynxPrivateKey: "key",
+		OpenVPNUsername:    "user",
+		OpenVPNPassword:    "password",
+	}
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name              string
+		technology        config.Technology
+		connected         bool
+		credentials       core.CredentialsResponse
+		err               error
+		expectedRotated   bool
+		expectedReconnect bool
+		expectedErr       error
+	}{
+		{
+			name:       "not rotated",
+			technology: config.Technology_NORDLYNX,
+			connected:  true,
+			credentials: core.CredentialsResponse{
+				NordlynxPrivateKey: "key",
+				Username:           "user",
+				Password:           "password",
+			},
+		},
+		{
+			name:       "key rotated while connected",
+			technology: config.Technology_NORDLYNX,
+			connected:  true,
+			credentials: core.CredentialsResponse{
+				NordlynxPrivateKey: "new key",
+				Username:           "user",
+				Password:           "password",
+			},
+			expectedRotated:   true,
+			expectedReconnect: true,
+		},
+		{
+			name:       "key rotated while disconnected",
+			technology: config.Technology_NORDLYNX,
+			credentials: core.CredentialsResponse{
+				NordlynxPrivateKey: "new key",
+				Username:           "user",
+				Password:           "password",
+			},
+			expectedRotated: true,
+		},
+		{
+			name:       "password rotated while connected to NordLynx",
+			technology: config.Technology_NORDLYNX,
+			connected:  true,
+			credentials: core.CredentialsResponse{
+				NordlynxPrivateKey: "key",
+				Username:           "user",
+				Password:           "new password",
+			},
+			expectedRotated: true,
+		},
+		{
+			name:       "password rotated while connected to OpenVPN",
+			technology: config.Technology_OPENVPN,
+			connected:  true,
+			credentials: core.CredentialsResponse{
+				NordlynxPrivateKey: "key",
+				Username:           "user",
+				Password:           "new password",
+			},
+			expectedRotated:   true,
+			expectedReconnect: true,
+		},
+		{
+			name:        "token rejected",
+			technology:  config.Technology_NORDLYNX,
+			err:         core.ErrUnauthorized,
+			expectedErr: core.ErrUnauthorized,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Technology = test.technology
+			cm.Cfg.AutoConnectData.ID = uid
+			cm.Cfg.TokensData = map[int64]config.TokenData{uid: stored}
+			test.credentials.UpdatedAt = "2024-02-01 00:00:00"
+
+			reconnected := false
+			rotation := credentialsRotation{
+				cm:   cm,
+				api:  rotatedCredentialsAPI{credentials: test.credentials, err: test.err},
+				netw: &mocknetworker.Mock{VpnActive: test.connected},
+				reconnect: func() error {
+					reconnected = true
+					return nil
+				},
+				now: func() time.Time { return now },
+			}
+
+			rotated, err := rotation.check()
+			assert.ErrorIs(t, err, test.expectedErr)
+			assert.Equal(t, test.expectedRotated, rotated)
+			assert.Equal(t, test.expectedReconnect, reconnected)
+
+			data := cm.Cfg.TokensData[uid]
+			if test.err != nil {
+				assert.Equal(t, stored, data)
+				return
+			}
+			assert.Equal(t, test.credentials.NordlynxPrivateKey, data.NordLynxPrivateKey)
+			assert.Equal(t, test.credentials.Password, data.OpenVPNPassword)
+			assert.Equal(t, "2024-02-01 00:00:00", data.CredentialsUpdatedAt)
+			assert.Equal(t, "2024-03-01 12:00:00", data.CredentialsCheckedAt)
+		})
+	}
+}
+
+func TestCredentialsRotation_CheckNotLoggedIn(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	rotation := credentialsRotation{
+		cm:   mock.NewMockConfigManager(),
+		api:  rotatedCredentialsAPI{},
+		netw: &mocknetworker.Mock{},
+		now:  time.Now,
+	}
+	_, err := rotation.check()
+	assert.ErrorIs(t, err, internal.ErrNotLoggedIn)
+}
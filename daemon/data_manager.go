@@ -3,6 +3,7 @@ package daemon
 import (
 	"fmt"
 	"log"
+	"os"
 	"sort"
 	"sync"
 	"time"
@@ -21,12 +22,13 @@ type InsightsDataManager interface {
 }
 
 type DataManager struct {
-	appData      AppData
-	countryData  CountryData
-	insightsData InsightsData
-	serversData  ServersData
-	versionData  VersionData
-	mu           sync.Mutex
+	appData           AppData
+	countryData       CountryData
+	insightsData      InsightsData
+	serversData       ServersData
+	serversCacheStats ServersCacheStats
+	versionData       VersionData
+	mu                sync.Mutex
 }
 
 func NewDataManager(insightsFilePath, serversFilePath, countryFilePath, versionFilePath string) *DataManager {
@@ -124,6 +126,35 @@ func (dm *DataManager) SetServersData(updatedAt time.Time, servers core.Servers,
 	return dm.serversData.save()
 }
 
+// CountServersCacheHit records that the server list was served from the cache
+func (dm *DataManager) CountServersCacheHit() {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	dm.serversCacheStats.Hits++
+}
+
+// CountServersDownload records that the server list was downloaded
+func (dm *DataManager) CountServersDownload() {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	dm.serversCacheStats.Downloads++
+}
+
+// GetServersCacheStats returns the use of the server list cache since the daemon start
+// together with the current state of the cache
+func (dm *DataManager) GetServersCacheStats() ServersCacheStats {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	stats := dm.serversCacheStats
+	stats.Servers = len(dm.serversData.Servers)
+	stats.UpdatedAt = dm.serversData.UpdatedAt
+	stats.Fresh = dm.serversData.isValid()
+	if info, err := os.Stat(dm.serversData.filePath); err == nil {
+		stats.Size = info.Size()
+	}
+	return stats
+}
+
 func (dm *DataManager) UpdateServerPenalty(s core.Server) error {
 	dm.mu.Lock()
 	defer dm.mu.Unlock()
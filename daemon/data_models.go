@@ -100,6 +100,20 @@ type ServersData struct {
 	Hash      string
 }
 
+// ServersCacheStats describes the use of the server list cache
+type ServersCacheStats struct {
+	// Hits is the number of times the server list was served from the cache
+	Hits uint64
+	// Downloads is the number of times the server list was downloaded
+	Downloads uint64
+	Servers   int
+	// Size of the cache file in bytes
+	Size      int64
+	UpdatedAt time.Time
+	// Fresh cache is used instead of downloading the server list
+	Fresh bool
+}
+
 func (data *ServersData) load() error {
 	content, err := internal.FileRead(data.filePath)
 	if err != nil {
@@ -0,0 +1,98 @@
+package dbusapi
+
+import (
+	"errors"
+	"fmt"
+	"os/user"
+	"strconv"
+
+	"github.com/godbus/dbus/v5"
+	"golang.org/x/exp/slices"
+)
+
+// errorAccessDenied is returned when the caller may not use the daemon
+const errorAccessDenied = "org.freedesktop.DBus.Error.AccessDenied"
+
+// credentials are the ids of the process which sent the message
+type credentials struct {
+	uid  uint32
+	gids []uint32
+}
+
+// authorizer gives the callers the same access as to the daemon socket, i.e. to
+// root and to the members of the group owning the socket. The bus policy is
+// installed for the default group, so the group configured for the daemon is
+// checked here.
+type authorizer struct {
+	credentials func(dbus.Sender) (credentials, error)
+	groupID     func() (int, error)
+}
+
+func (a authorizer) authorize(sender dbus.Sender) *dbus.Error {
+	creds, err := a.credentials(sender)
+	if err != nil {
+		return accessDenied(fmt.Errorf("retrieving credentials of %s: %w", sender, err))
+	}
+	if creds.uid == 0 {
+		return nil
+	}
+	gid, err := a.groupID()
+	if err != nil {
+		return accessDenied(fmt.Errorf("retrieving daemon group: %w", err))
+	}
+	if !slices.Contains(creds.gids, uint32(gid)) {
+		return accessDenied(fmt.Errorf("user %d is not a member of the daemon group", creds.uid))
+	}
+	return nil
+}
+
+// busCredentials asks the bus for the ids of the process which owns the sender
+// connection. Group ids are reported by the bus since D-Bus 1.12, otherwise the
+// groups of the user are used.
+func busCredentials(conn *dbus.Conn) func(dbus.Sender) (credentials, error) {
+	return func(sender dbus.Sender) (credentials, error) {
+		var reply map[string]dbus.Variant
+		if err := conn.BusObject().Call(
+			"org.freedesktop.DBus.GetConnectionCredentials", 0, string(sender),
+		).Store(&reply); err != nil {
+			return credentials{}, err
+		}
+
+		uid, ok := reply["UnixUserID"].Value().(uint32)
+		if !ok {
+			return credentials{}, errors.New("user id was not reported")
+		}
+		if gids, ok := reply["UnixGroupIDs"].Value().([]uint32); ok {
+			return credentials{uid: uid, gids: gids}, nil
+		}
+		gids, err := userGroups(uid)
+		if err != nil {
+			return credentials{}, err
+		}
+		return credentials{uid: uid, gids: gids}, nil
+	}
+}
+
+func userGroups(uid uint32) ([]uint32, error) {
+	u, err := user.LookupId(strconv.FormatUint(uint64(uid), 10))
+	if err != nil {
+		return nil, err
+	}
+	ids, err := u.GroupIds()
+	if err != nil {
+		return nil, err
+	}
+	var gids []uint32
+	for _, id := range ids {
+		gid, err := strconv.ParseUint(id, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		gids = append(gids, uint32(gid))
+	}
+	return gids, nil
+}
+
+func accessDenied(err error) *dbus.Error {
+	return dbus.NewError(errorAccessDenied, []interface{}{err.Error()})
+}
@@ -0,0 +1,50 @@
+package dbusapi
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorizer(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	const group = 990
+	tests := []struct {
+		name     string
+		creds    credentials
+		credsErr error
+		groupErr error
+		allowed  bool
+	}{
+		{name: "root", creds: credentials{uid: 0}, allowed: true},
+		{name: "group member", creds: credentials{uid: 1000, gids: []uint32{1000, group}}, allowed: true},
+		{name: "not a group member", creds: credentials{uid: 1000, gids: []uint32{1000}}},
+		{name: "credentials not available", credsErr: mock.ErrOnPurpose},
+		{name: "group not found", creds: credentials{uid: 1000, gids: []uint32{group}}, groupErr: mock.ErrOnPurpose},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			auth := authorizer{
+				credentials: func(sender dbus.Sender) (credentials, error) {
+					assert.Equal(t, dbus.Sender(":1.42"), sender)
+					return test.creds, test.credsErr
+				},
+				groupID: func() (int, error) { return group, test.groupErr },
+			}
+
+			err := auth.authorize(":1.42")
+			if test.allowed {
+				assert.Nil(t, err)
+				return
+			}
+			if assert.NotNil(t, err) {
+				assert.Equal(t, errorAccessDenied, err.Name)
+			}
+		})
+	}
+}
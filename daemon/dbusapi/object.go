@@ -0,0 +1,165 @@
+package dbusapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/godbus/dbus/v5"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// errorFailed is returned when the daemon could not complete the call
+	errorFailed = InterfaceName + ".Error.Failed"
+	// stateDisconnected is the state reported by the daemon while disconnected
+	stateDisconnected = "Disconnected"
+)
+
+// object is exported on the bus. Each exported method becomes a D-Bus method.
+// Sender is filled by the bus library and is not a part of the D-Bus signature.
+type object struct {
+	daemon    pb.DaemonServer
+	authorize func(dbus.Sender) *dbus.Error
+	refresh   func()
+}
+
+// Connect to the server, country, city or group, or to the recommended server
+// if the server is empty. Returns the hostname of the connected server.
+func (o *object) Connect(sender dbus.Sender, server string) (string, *dbus.Error) {
+	if err := o.authorize(sender); err != nil {
+		return "", err
+	}
+	defer o.refresh()
+	stream := &payloadStream{}
+	if err := o.daemon.Connect(&pb.ConnectRequest{ServerTag: server}, stream); err != nil {
+		return "", failed(err)
+	}
+	for _, payload := range stream.payloads {
+		if payload.GetType() == internal.CodeConnected {
+			var hostname string
+			if data := payload.GetData(); len(data) > 1 {
+				hostname = data[1]
+			}
+			return hostname, nil
+		}
+	}
+	return "", failed(connectError(stream.payloads))
+}
+
+// Disconnect from VPN. Nothing is done if VPN is not connected.
+func (o *object) Disconnect(sender dbus.Sender) *dbus.Error {
+	if err := o.authorize(sender); err != nil {
+		return err
+	}
+	defer o.refresh()
+	stream := &payloadStream{}
+	if err := o.daemon.Disconnect(&pb.Empty{}, stream); err != nil {
+		return failed(err)
+	}
+	return nil
+}
+
+// Status returns the connection status including the transfer statistics which
+// are not available as properties
+func (o *object) Status(sender dbus.Sender) (map[string]dbus.Variant, *dbus.Error) {
+	if err := o.authorize(sender); err != nil {
+		return nil, err
+	}
+	status, err := o.daemon.Status(context.Background(), &pb.Empty{})
+	if err != nil {
+		return nil, failed(err)
+	}
+
+	ret := map[string]dbus.Variant{}
+	for name, value := range statusProperties(status) {
+		ret[name] = dbus.MakeVariant(value)
+	}
+	ret["Download"] = dbus.MakeVariant(status.GetDownload())
+	ret["Upload"] = dbus.MakeVariant(status.GetUpload())
+	var uptime int64 = -1
+	if status.GetUptime() >= 0 {
+		uptime = int64(time.Duration(status.GetUptime()).Seconds())
+	}
+	ret["Uptime"] = dbus.MakeVariant(uptime)
+	return ret, nil
+}
+
+// statusProperties returns the connection properties of the daemon object
+func statusProperties(status *pb.StatusResponse) map[string]interface{} {
+	props := map[string]interface{}{
+		"State":      status.GetState(),
+		"Technology": "",
+		"Protocol":   "",
+		"IP":         "",
+		"Hostname":   status.GetHostname(),
+		"Country":    status.GetCountry(),
+		"City":       status.GetCity(),
+	}
+	if status.GetState() != stateDisconnected {
+		props["Technology"] = status.GetTechnology().String()
+		props["Protocol"] = status.GetProtocol().String()
+		props["IP"] = status.GetIp()
+	}
+	return props
+}
+
+// changedProperties returns the properties which differ between the old and the
+// new values
+func changedProperties(old map[string]interface{}, new map[string]interface{}) map[string]dbus.Variant {
+	changed := map[string]dbus.Variant{}
+	for name, value := range new {
+		if old[name] != value {
+			changed[name] = dbus.MakeVariant(value)
+		}
+	}
+	return changed
+}
+
+// connectError describes why the connection was not established
+func connectError(payloads []*pb.Payload) error {
+	for _, payload := range payloads {
+		switch payload.GetType() {
+		case internal.CodeAccountExpired, internal.CodeOpenVPNAccountExpired:
+			return errors.New("account has expired")
+		case internal.CodeTokenRenewError:
+			return errors.New("failed to renew the login token")
+		case internal.CodeTagNonexisting, internal.CodeGroupNonexisting, internal.CodeServerUnavailable:
+			return errors.New("server is not available")
+		case internal.CodeDoubleGroupError:
+			return errors.New("server and group cannot be combined")
+		case internal.CodeTechnologyNotAllowed:
+			return errors.New("technology is not allowed")
+		case internal.CodeFailure:
+			return errors.New("connection failed")
+		}
+	}
+	if len(payloads) > 0 {
+		return fmt.Errorf("connection failed with code %d", payloads[len(payloads)-1].GetType())
+	}
+	return errors.New("connection failed")
+}
+
+func failed(err error) *dbus.Error {
+	return dbus.NewError(errorFailed, []interface{}{err.Error()})
+}
+
+// payloadStream collects the payloads sent by the streaming daemon methods
+type payloadStream struct {
+	payloads []*pb.Payload
+}
+
+func (*payloadStream) SetHeader(metadata.MD) error  { return nil }
+func (*payloadStream) SendHeader(metadata.MD) error { return nil }
+func (*payloadStream) SetTrailer(metadata.MD)       {}
+func (*payloadStream) Context() context.Context     { return context.Background() }
+func (*payloadStream) SendMsg(interface{}) error    { return nil }
+func (*payloadStream) RecvMsg(interface{}) error    { return nil }
+func (s *payloadStream) Send(payload *pb.Payload) error {
+	s.payloads = append(s.payloads, payload)
+	return nil
+}
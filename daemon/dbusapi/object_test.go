@@ -0,0 +1,196 @@
+package dbusapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeDaemon struct {
+	pb.UnimplementedDaemonServer
+	connectPayloads []*pb.Payload
+	connectErr      error
+	serverTag       string
+	status          *pb.StatusResponse
+}
+
+func (f *fakeDaemon) Connect(in *pb.ConnectRequest, srv pb.Daemon_ConnectServer) error {
+	f.serverTag = in.GetServerTag()
+	for _, payload := range f.connectPayloads {
+		if err := srv.Send(payload); err != nil {
+			return err
+		}
+	}
+	return f.connectErr
+}
+
+func (f *fakeDaemon) Disconnect(_ *pb.Empty, srv pb.Daemon_DisconnectServer) error {
+	return srv.Send(&pb.Payload{Type: internal.CodeDisconnected})
+}
+
+func (f *fakeDaemon) Status(context.Context, *pb.Empty) (*pb.StatusResponse, error) {
+	return f.status, nil
+}
+
+func allowAll(dbus.Sender) *dbus.Error { return nil }
+
+func TestObject_Unauthorized(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	daemon := &fakeDaemon{status: &pb.StatusResponse{State: stateDisconnected}}
+	o := object{
+		daemon: daemon,
+		authorize: func(dbus.Sender) *dbus.Error {
+			return accessDenied(errors.New("user 1000 is not a member of the daemon group"))
+		},
+		refresh: func() { t.Error("refreshed without a call to the daemon") },
+	}
+
+	_, dbusErr := o.Connect(":1.42", "lt")
+	assert.Equal(t, errorAccessDenied, dbusErr.Name)
+	assert.Empty(t, daemon.serverTag)
+	assert.Equal(t, errorAccessDenied, o.Disconnect(":1.42").Name)
+	_, dbusErr = o.Status(":1.42")
+	assert.Equal(t, errorAccessDenied, dbusErr.Name)
+}
+
+func TestObject_Connect(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name             string
+		payloads         []*pb.Payload
+		err              error
+		expectedHostname string
+		expectedError    string
+	}{
+		{
+			name: "connected",
+			payloads: []*pb.Payload{
+				{Type: internal.CodeConnecting},
+				{Type: internal.CodeConnected, Data: []string{"Lithuania #1", "lt1.nordvpn.com"}},
+			},
+			expectedHostname: "lt1.nordvpn.com",
+		},
+		{
+			name:          "not logged in",
+			err:           internal.ErrNotLoggedIn,
+			expectedError: internal.ErrNotLoggedIn.Error(),
+		},
+		{
+			name:          "account expired",
+			payloads:      []*pb.Payload{{Type: internal.CodeAccountExpired}},
+			expectedError: "account has expired",
+		},
+		{
+			name: "failure",
+			payloads: []*pb.Payload{
+				{Type: internal.CodeConnecting},
+				{Type: internal.CodeFailure},
+			},
+			expectedError: "connection failed",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			daemon := &fakeDaemon{connectPayloads: test.payloads, connectErr: test.err}
+			refreshed := false
+			o := object{daemon: daemon, authorize: allowAll, refresh: func() { refreshed = true }}
+
+			hostname, dbusErr := o.Connect(":1.42", "lt")
+			assert.Equal(t, "lt", daemon.serverTag)
+			assert.Equal(t, test.expectedHostname, hostname)
+			assert.True(t, refreshed)
+			if test.expectedError == "" {
+				assert.Nil(t, dbusErr)
+				return
+			}
+			if assert.NotNil(t, dbusErr) {
+				assert.Equal(t, errorFailed, dbusErr.Name)
+				assert.Equal(t, []interface{}{test.expectedError}, dbusErr.Body)
+			}
+		})
+	}
+}
+
+func TestObject_Status(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	daemon := &fakeDaemon{status: &pb.StatusResponse{
+		State:      "Connected",
+		Technology: config.Technology_NORDLYNX,
+		Protocol:   config.Protocol_UDP,
+		Ip:         "192.0.2.1",
+		Hostname:   "lt1.nordvpn.com",
+		Country:    "Lithuania",
+		City:       "Vilnius",
+		Download:   100,
+		Upload:     10,
+		Uptime:     int64(90 * time.Second),
+	}}
+	o := object{daemon: daemon, authorize: allowAll, refresh: func() {}}
+
+	status, dbusErr := o.Status(":1.42")
+	assert.Nil(t, dbusErr)
+	assert.Equal(t, map[string]dbus.Variant{
+		"State":      dbus.MakeVariant("Connected"),
+		"Technology": dbus.MakeVariant("NORDLYNX"),
+		"Protocol":   dbus.MakeVariant("UDP"),
+		"IP":         dbus.MakeVariant("192.0.2.1"),
+		"Hostname":   dbus.MakeVariant("lt1.nordvpn.com"),
+		"Country":    dbus.MakeVariant("Lithuania"),
+		"City":       dbus.MakeVariant("Vilnius"),
+		"Download":   dbus.MakeVariant(uint64(100)),
+		"Upload":     dbus.MakeVariant(uint64(10)),
+		"Uptime":     dbus.MakeVariant(int64(90)),
+	}, status)
+
+	daemon.status = &pb.StatusResponse{State: stateDisconnected, Uptime: -1}
+	status, dbusErr = o.Status(":1.42")
+	assert.Nil(t, dbusErr)
+	assert.Equal(t, dbus.MakeVariant(""), status["Technology"])
+	assert.Equal(t, dbus.MakeVariant(int64(-1)), status["Uptime"])
+}
+
+func TestChangedProperties(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	disconnected := statusProperties(&pb.StatusResponse{State: stateDisconnected})
+	assert.Empty(t, changedProperties(disconnected, disconnected))
+
+	connected := statusProperties(&pb.StatusResponse{
+		State:      "Connected",
+		Technology: config.Technology_NORDLYNX,
+		Protocol:   config.Protocol_UDP,
+		Ip:         "192.0.2.1",
+		Hostname:   "lt1.nordvpn.com",
+		Country:    "Lithuania",
+		City:       "Vilnius",
+	})
+	changed := changedProperties(disconnected, connected)
+	assert.Len(t, changed, 7)
+	assert.Equal(t, dbus.MakeVariant("Connected"), changed["State"])
+
+	reconnected := statusProperties(&pb.StatusResponse{
+		State:      "Connected",
+		Technology: config.Technology_NORDLYNX,
+		Protocol:   config.Protocol_UDP,
+		Ip:         "192.0.2.2",
+		Hostname:   "lt2.nordvpn.com",
+		Country:    "Lithuania",
+		City:       "Vilnius",
+	})
+	assert.Equal(t, map[string]dbus.Variant{
+		"IP":       dbus.MakeVariant("192.0.2.2"),
+		"Hostname": dbus.MakeVariant("lt2.nordvpn.com"),
+	}, changedProperties(connected, reconnected))
+}
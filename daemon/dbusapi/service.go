@@ -0,0 +1,199 @@
+/*
+Package dbusapi exposes the connection management of the daemon on the D-Bus system
+bus, so that the desktop integrations do not have to use the gRPC API.
+
+Methods are served by the same implementation as the gRPC API. Access to them is
+limited by the bus policy installed with the package and, like the access to the
+daemon socket, given to root and the members of the daemon group only. The policy
+is installed for the default nordvpn group. If the group is changed in the daemon
+configuration, the policy has to be changed to the same group, otherwise the
+members of the new group are denied by the bus.
+*/
+package dbusapi
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/godbus/dbus/v5/introspect"
+	"github.com/godbus/dbus/v5/prop"
+)
+
+const (
+	// BusName is the well-known name of the daemon on the system bus
+	BusName = "org.nordvpn.Daemon"
+	// ObjectPath of the daemon object
+	ObjectPath = dbus.ObjectPath("/org/nordvpn/Daemon")
+	// InterfaceName of the daemon methods and properties
+	InterfaceName = "org.nordvpn.Daemon"
+	// pollInterval is how often the connection properties are refreshed when
+	// there are no connection events, e.g. to pick up the reconnects
+	pollInterval = 5 * time.Second
+)
+
+// Service owns the bus name and keeps the connection properties up to date
+type Service struct {
+	daemon  pb.DaemonServer
+	conn    *dbus.Conn
+	props   *prop.Properties
+	mu      sync.Mutex
+	current map[string]interface{}
+	refresh chan struct{}
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewService creates a service calling the daemon methods
+func NewService(daemon pb.DaemonServer) *Service {
+	return &Service{
+		daemon:  daemon,
+		current: statusProperties(&pb.StatusResponse{State: stateDisconnected}),
+		refresh: make(chan struct{}, 1),
+	}
+}
+
+// Start exports the daemon object on the system bus and requests the bus name
+func (s *Service) Start() error {
+	conn, err := dbus.ConnectSystemBus()
+	if err != nil {
+		return fmt.Errorf("connecting to system bus: %w", err)
+	}
+
+	auth := authorizer{credentials: busCredentials(conn), groupID: internal.GetNordvpnGid}
+	methods := &object{daemon: s.daemon, authorize: auth.authorize, refresh: s.Refresh}
+	if err := conn.Export(methods, ObjectPath, InterfaceName); err != nil {
+		conn.Close()
+		return fmt.Errorf("exporting methods: %w", err)
+	}
+
+	propMap := map[string]*prop.Prop{}
+	for name, value := range s.current {
+		// changes are emitted together by refresh
+		propMap[name] = &prop.Prop{Value: value, Emit: prop.EmitFalse}
+	}
+	props, err := prop.Export(conn, ObjectPath, prop.Map{InterfaceName: propMap})
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("exporting properties: %w", err)
+	}
+
+	node := &introspect.Node{
+		Name: string(ObjectPath),
+		Interfaces: []introspect.Interface{
+			introspect.IntrospectData,
+			prop.IntrospectData,
+			{
+				Name:       InterfaceName,
+				Methods:    introspect.Methods(methods),
+				Properties: props.Introspection(InterfaceName),
+				Signals:    []introspect.Signal{},
+			},
+		},
+	}
+	if err := conn.Export(introspect.NewIntrospectable(node), ObjectPath, "org.freedesktop.DBus.Introspectable"); err != nil {
+		conn.Close()
+		return fmt.Errorf("exporting introspection: %w", err)
+	}
+
+	reply, err := conn.RequestName(BusName, dbus.NameFlagDoNotQueue)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("requesting bus name: %w", err)
+	}
+	if reply != dbus.RequestNameReplyPrimaryOwner {
+		conn.Close()
+		return fmt.Errorf("bus name %s is already taken", BusName)
+	}
+
+	s.conn = conn
+	s.props = props
+	s.done = make(chan struct{})
+	s.wg.Add(1)
+	go s.run()
+	return nil
+}
+
+// Stop releases the bus name and closes the connection to the bus
+func (s *Service) Stop() error {
+	if s.conn == nil {
+		return nil
+	}
+	close(s.done)
+	s.wg.Wait()
+	if _, err := s.conn.ReleaseName(BusName); err != nil {
+		log.Println(internal.WarningPrefix, "releasing bus name:", err)
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// Refresh the connection properties without waiting for the next poll
+func (s *Service) Refresh() {
+	select {
+	case s.refresh <- struct{}{}:
+	default:
+	}
+}
+
+// NotifyConnect refreshes the properties after the connection attempts
+func (s *Service) NotifyConnect(events.DataConnect) error {
+	s.Refresh()
+	return nil
+}
+
+// NotifyDisconnect refreshes the properties after disconnecting
+func (s *Service) NotifyDisconnect(events.DataDisconnect) error {
+	s.Refresh()
+	return nil
+}
+
+func (s *Service) run() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	s.update()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+		case <-s.refresh:
+		}
+		s.update()
+	}
+}
+
+// update sets the properties from the connection status and emits
+// PropertiesChanged with the ones which changed
+func (s *Service) update() {
+	status, err := s.daemon.Status(context.Background(), &pb.Empty{})
+	if err != nil {
+		log.Println(internal.WarningPrefix, "getting status for D-Bus properties:", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	next := statusProperties(status)
+	changed := changedProperties(s.current, next)
+	if len(changed) == 0 {
+		return
+	}
+	for name, value := range changed {
+		s.props.SetMust(InterfaceName, name, value.Value())
+	}
+	s.current = next
+	if err := s.conn.Emit(ObjectPath, "org.freedesktop.DBus.Properties.PropertiesChanged",
+		InterfaceName, changed, []string{}); err != nil {
+		log.Println(internal.WarningPrefix, "emitting D-Bus properties:", err)
+	}
+}
@@ -0,0 +1,380 @@
+// Package diagnostics verifies the leak protection by sending controlled test
+// traffic through and around the VPN tunnel.
+package diagnostics
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/routes"
+
+	"golang.org/x/exp/slices"
+)
+
+// Status of a single leak test
+type Status int
+
+const (
+	// Passed means that no leak was detected
+	Passed Status = iota
+	// Failed means that the test traffic leaked
+	Failed
+	// Skipped means that the test could not be run or its result is inconclusive
+	Skipped
+)
+
+const (
+	// probeTimeout limits the time to wait for the response to a single probe
+	probeTimeout = 3 * time.Second
+	// dnsProbeHost is the name resolved by the DNS probes
+	dnsProbeHost = "nordvpn.com"
+	// stunProbeHost is the STUN server used by the WebRTC probe
+	stunProbeHost = "stun.l.google.com"
+	stunProbePort = 19302
+	// stunMagicCookie is a fixed value of every STUN message, RFC 5389
+	stunMagicCookie = 0x2112A442
+
+	msgNoDefaultRoute = "There is no default route, the test traffic can't be sent outside of the tunnel."
+)
+
+var (
+	// dnsProbeIPv4 is a public resolver which is not used by the app
+	dnsProbeIPv4 = netip.MustParseAddrPort("1.1.1.1:53")
+	// dnsProbeIPv6 is a public resolver which is not used by the app
+	dnsProbeIPv6 = netip.MustParseAddrPort("[2606:4700:4700::1111]:53")
+	// killSwitchProbe is a public HTTPS endpoint
+	killSwitchProbe = netip.MustParseAddrPort("1.1.1.1:443")
+)
+
+// Result of a single leak test
+type Result struct {
+	Name   string
+	Status Status
+	// Details explain the outcome of the test
+	Details string
+	// Hint suggests how to fix the failure
+	Hint string
+}
+
+// State of the connection the leak tests are run against
+type State struct {
+	// Connected to VPN
+	Connected bool
+	// KillSwitch is enabled
+	KillSwitch bool
+	// Tunnel is the name of the VPN tunnel interface, empty if not connected
+	Tunnel string
+	// Nameservers set by the app while connected
+	Nameservers []string
+}
+
+// Prober sends the test traffic. Empty iface means that the traffic is routed as
+// usual, otherwise it is bound to the given interface bypassing the tunnel.
+type Prober interface {
+	// ExchangeUDP sends the request and returns the first response
+	ExchangeUDP(ctx context.Context, iface string, addr netip.AddrPort, request []byte) ([]byte, error)
+	// ConnectTCP establishes and closes a TCP connection
+	ConnectTCP(ctx context.Context, iface string, addr netip.AddrPort) error
+	// Route returns the name of the interface the traffic to addr is routed through
+	Route(addr netip.Addr) (string, error)
+}
+
+// LeakTest runs the leak tests
+type LeakTest struct {
+	prober      Prober
+	gateway     routes.GatewayRetriever
+	nameservers func() ([]netip.Addr, error)
+	lookup      func(ctx context.Context, host string) ([]netip.Addr, error)
+}
+
+// NewLeakTest creates a LeakTest which sends the probes using the given prober
+func NewLeakTest(prober Prober, gateway routes.GatewayRetriever) *LeakTest {
+	return &LeakTest{
+		prober:      prober,
+		gateway:     gateway,
+		nameservers: resolvconfNameservers,
+		lookup: func(ctx context.Context, host string) ([]netip.Addr, error) {
+			return net.DefaultResolver.LookupNetIP(ctx, "ip4", host)
+		},
+	}
+}
+
+// Run all of the leak tests one after another, so that the probes do not
+// interfere with each other
+func (l *LeakTest) Run(ctx context.Context, state State) []Result {
+	return []Result{
+		l.dnsLeak(ctx, state),
+		l.ipv6Leak(ctx, state),
+		l.webRTCLeak(ctx, state),
+		l.killSwitchIntegrity(ctx, state),
+	}
+}
+
+func (l *LeakTest) dnsLeak(ctx context.Context, state State) Result {
+	result := Result{Name: "DNS leak protection"}
+	if skipped, ok := notProtected(result, state); ok {
+		return skipped
+	}
+
+	if state.Connected {
+		nameservers, err := l.nameservers()
+		if err != nil {
+			return skip(result, fmt.Sprintf("Can't read system nameservers: %s", err))
+		}
+		for _, nameserver := range nameservers {
+			if nameserver.IsLoopback() || slices.Contains(state.Nameservers, nameserver.String()) {
+				continue
+			}
+			result.Status = Failed
+			result.Details = fmt.Sprintf("System uses nameserver %s which is not set by NordVPN.", nameserver)
+			result.Hint = "Make sure that no other software (e.g. NetworkManager) overrides DNS " +
+				"while connected. Reconnect to set DNS again."
+			return result
+		}
+	}
+
+	iface, err := l.physicalInterface(false)
+	if err != nil {
+		return skip(result, msgNoDefaultRoute)
+	}
+	if l.dnsAnswered(ctx, iface, dnsProbeIPv4) {
+		result.Status = Failed
+		result.Details = fmt.Sprintf("DNS query sent outside of the tunnel was answered by %s.", dnsProbeIPv4.Addr())
+		result.Hint = "Enable firewall with 'nordvpn set firewall on' and reconnect."
+		return result
+	}
+
+	if state.Connected && !l.dnsAnswered(ctx, "", dnsProbeIPv4) {
+		return skip(result, "Test resolver is unreachable through the tunnel, the result is inconclusive.")
+	}
+
+	result.Details = "DNS queries outside of the tunnel are blocked."
+	return result
+}
+
+func (l *LeakTest) ipv6Leak(ctx context.Context, state State) Result {
+	result := Result{Name: "IPv6 leak protection"}
+	if skipped, ok := notProtected(result, state); ok {
+		return skipped
+	}
+
+	iface, err := l.physicalInterface(true)
+	if err != nil {
+		result.Details = "There is no IPv6 default route, IPv6 traffic can't leak."
+		return result
+	}
+
+	if l.dnsAnswered(ctx, iface, dnsProbeIPv6) {
+		result.Status = Failed
+		result.Details = "IPv6 traffic sent outside of the tunnel was answered."
+		result.Hint = "Enable firewall with 'nordvpn set firewall on' and reconnect."
+		return result
+	}
+
+	if state.Connected {
+		routeIface, err := l.prober.Route(dnsProbeIPv6.Addr())
+		if err == nil && routeIface != state.Tunnel && l.dnsAnswered(ctx, "", dnsProbeIPv6) {
+			result.Status = Failed
+			result.Details = fmt.Sprintf("IPv6 traffic bypasses the tunnel through %s.", routeIface)
+			result.Hint = "Reconnect so that IPv6 traffic is blocked again, or disable IPv6 on the interface."
+			return result
+		}
+	}
+
+	result.Details = "IPv6 traffic outside of the tunnel is blocked."
+	return result
+}
+
+func (l *LeakTest) webRTCLeak(ctx context.Context, state State) Result {
+	result := Result{Name: "WebRTC UDP egress"}
+	if skipped, ok := notProtected(result, state); ok {
+		return skipped
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+	addrs, err := l.lookup(lookupCtx, stunProbeHost)
+	if err != nil || len(addrs) == 0 {
+		return skip(result, fmt.Sprintf("Can't resolve STUN server %s.", stunProbeHost))
+	}
+	server := netip.AddrPortFrom(addrs[0], stunProbePort)
+
+	iface, err := l.physicalInterface(false)
+	if err != nil {
+		return skip(result, msgNoDefaultRoute)
+	}
+
+	request, transactionID := stunBindingRequest()
+	response, err := l.prober.ExchangeUDP(ctx, iface, server, request)
+	if err == nil && isSTUNResponse(response, transactionID) {
+		result.Status = Failed
+		result.Details = "STUN request sent outside of the tunnel was answered, " +
+			"browsers can reveal your real IP address over WebRTC."
+		result.Hint = "Enable firewall with 'nordvpn set firewall on' and reconnect, " +
+			"or disable WebRTC in the browser."
+		return result
+	}
+
+	result.Details = "UDP traffic outside of the tunnel is blocked."
+	return result
+}
+
+func (l *LeakTest) killSwitchIntegrity(ctx context.Context, state State) Result {
+	result := Result{Name: "Kill Switch integrity"}
+	if !state.KillSwitch {
+		result.Status = Skipped
+		result.Details = "Kill Switch is disabled."
+		result.Hint = "Enable it with 'nordvpn set killswitch on'."
+		return result
+	}
+
+	iface, err := l.physicalInterface(false)
+	if err != nil {
+		return skip(result, msgNoDefaultRoute)
+	}
+
+	if l.prober.ConnectTCP(ctx, iface, killSwitchProbe) == nil {
+		result.Status = Failed
+		result.Details = "Connection outside of the tunnel succeeded."
+		result.Hint = "Toggle Kill Switch with 'nordvpn set killswitch off' and 'nordvpn set killswitch on', " +
+			"and make sure that no other firewall software removes its rules."
+		return result
+	}
+
+	if !state.Connected && l.prober.ConnectTCP(ctx, "", killSwitchProbe) == nil {
+		result.Status = Failed
+		result.Details = "Connection succeeded while disconnected from VPN."
+		result.Hint = "Toggle Kill Switch with 'nordvpn set killswitch off' and 'nordvpn set killswitch on', " +
+			"and make sure that no other firewall software removes its rules."
+		return result
+	}
+
+	result.Details = "Traffic outside of the tunnel is blocked."
+	return result
+}
+
+// ErrNotConnected is returned when the routing is verified while not connected
+var ErrNotConnected = errors.New("not connected to VPN")
+
+// VerifyRouting checks that the traffic to the public addresses is routed
+// through the tunnel. It does not send any traffic.
+func (l *LeakTest) VerifyRouting(state State) error {
+	if !state.Connected || state.Tunnel == "" {
+		return ErrNotConnected
+	}
+	iface, err := l.prober.Route(killSwitchProbe.Addr())
+	if err != nil {
+		return err
+	}
+	if iface != state.Tunnel {
+		return fmt.Errorf("traffic is routed through %s instead of %s", iface, state.Tunnel)
+	}
+	return nil
+}
+
+// physicalInterface returns the name of the interface with the default gateway
+func (l *LeakTest) physicalInterface(ipv6 bool) (string, error) {
+	_, iface, err := l.gateway.Default(ipv6)
+	if err != nil {
+		return "", err
+	}
+	return iface.Name, nil
+}
+
+func (l *LeakTest) dnsAnswered(ctx context.Context, iface string, resolver netip.AddrPort) bool {
+	request, id := dnsQuery(dnsProbeHost)
+	response, err := l.prober.ExchangeUDP(ctx, iface, resolver, request)
+	return err == nil && isDNSResponse(response, id)
+}
+
+// notProtected skips the result if neither VPN nor Kill Switch protect the traffic
+func notProtected(result Result, state State) (Result, bool) {
+	if state.Connected || state.KillSwitch {
+		return result, false
+	}
+	result.Status = Skipped
+	result.Details = "Not connected to VPN and Kill Switch is disabled."
+	result.Hint = "Connect to VPN or enable Kill Switch and run the diagnostics again."
+	return result, true
+}
+
+func skip(result Result, details string) Result {
+	result.Status = Skipped
+	result.Details = details
+	return result
+}
+
+// dnsQuery returns a recursive query of the A record and its ID
+func dnsQuery(host string) ([]byte, uint16) {
+	var idBytes [2]byte
+	_, _ = rand.Read(idBytes[:])
+	id := binary.BigEndian.Uint16(idBytes[:])
+
+	// header: ID, recursion desired flag, 1 question
+	query := []byte{idBytes[0], idBytes[1], 0x01, 0x00, 0, 1, 0, 0, 0, 0, 0, 0}
+	for _, label := range strings.Split(host, ".") {
+		query = append(query, byte(len(label)))
+		query = append(query, label...)
+	}
+	// root label, type A, class IN
+	query = append(query, 0, 0, 1, 0, 1)
+	return query, id
+}
+
+func isDNSResponse(response []byte, id uint16) bool {
+	return len(response) >= 12 &&
+		binary.BigEndian.Uint16(response) == id &&
+		response[2]&0x80 != 0
+}
+
+// stunBindingRequest returns a STUN binding request and its transaction ID
+func stunBindingRequest() ([]byte, []byte) {
+	request := make([]byte, 20)
+	binary.BigEndian.PutUint16(request[0:], 0x0001)
+	binary.BigEndian.PutUint32(request[4:], stunMagicCookie)
+	_, _ = rand.Read(request[8:])
+	return request, request[8:]
+}
+
+func isSTUNResponse(response []byte, transactionID []byte) bool {
+	return len(response) >= 20 &&
+		binary.BigEndian.Uint16(response) == 0x0101 &&
+		binary.BigEndian.Uint32(response[4:]) == stunMagicCookie &&
+		string(response[8:20]) == string(transactionID)
+}
+
+// resolvconfNameservers returns the nameservers listed in resolv.conf
+func resolvconfNameservers() ([]netip.Addr, error) {
+	file, err := os.Open("/etc/resolv.conf")
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var nameservers []netip.Addr
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != "nameserver" {
+			continue
+		}
+		addr, err := netip.ParseAddr(fields[1])
+		if err != nil {
+			continue
+		}
+		nameservers = append(nameservers, addr.Unmap())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading resolv.conf: %w", err)
+	}
+	return nameservers, nil
+}
@@ -0,0 +1,248 @@
+package diagnostics
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+const physical = "eth0"
+
+type probe struct {
+	iface string
+	addr  netip.AddrPort
+}
+
+// mockProber answers the probes sent to the open destinations
+type mockProber struct {
+	open  map[probe]bool
+	route string
+}
+
+func (m *mockProber) ExchangeUDP(_ context.Context, iface string, addr netip.AddrPort, request []byte) ([]byte, error) {
+	if !m.open[probe{iface, addr}] {
+		return nil, errors.New("i/o timeout")
+	}
+	response := append([]byte{}, request...)
+	if addr.Port() == stunProbePort {
+		binary.BigEndian.PutUint16(response, 0x0101)
+	} else {
+		response[2] |= 0x80
+	}
+	return response, nil
+}
+
+func (m *mockProber) ConnectTCP(_ context.Context, iface string, addr netip.AddrPort) error {
+	if !m.open[probe{iface, addr}] {
+		return errors.New("i/o timeout")
+	}
+	return nil
+}
+
+func (m *mockProber) Route(netip.Addr) (string, error) { return m.route, nil }
+
+type mockGateway struct{ ipv6 bool }
+
+func (g mockGateway) Default(ipv6 bool) (netip.Addr, net.Interface, error) {
+	if ipv6 && !g.ipv6 {
+		return netip.Addr{}, net.Interface{}, errors.New("no default route")
+	}
+	return netip.Addr{}, net.Interface{Name: physical}, nil
+}
+
+var stunProbe = netip.AddrPortFrom(netip.MustParseAddr("74.125.250.129"), stunProbePort)
+
+func newTestLeakTest(prober *mockProber, gateway mockGateway, nameservers ...string) *LeakTest {
+	return &LeakTest{
+		prober:  prober,
+		gateway: gateway,
+		nameservers: func() ([]netip.Addr, error) {
+			var addrs []netip.Addr
+			for _, nameserver := range nameservers {
+				addrs = append(addrs, netip.MustParseAddr(nameserver))
+			}
+			return addrs, nil
+		},
+		lookup: func(context.Context, string) ([]netip.Addr, error) {
+			return []netip.Addr{stunProbe.Addr()}, nil
+		},
+	}
+}
+
+func statuses(results []Result) []Status {
+	var out []Status
+	for _, result := range results {
+		out = append(out, result.Status)
+	}
+	return out
+}
+
+func TestLeakTest_Run(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	connected := State{
+		Connected:   true,
+		KillSwitch:  true,
+		Tunnel:      "nordlynx",
+		Nameservers: []string{"103.86.96.100"},
+	}
+	throughTunnel := map[probe]bool{
+		{"", dnsProbeIPv4}:    true,
+		{"", dnsProbeIPv6}:    true,
+		{"", stunProbe}:       true,
+		{"", killSwitchProbe}: true,
+	}
+
+	tests := []struct {
+		name        string
+		state       State
+		open        map[probe]bool
+		route       string
+		gateway     mockGateway
+		nameservers []string
+		expected    []Status
+	}{
+		{
+			name:     "not protected",
+			state:    State{},
+			expected: []Status{Skipped, Skipped, Skipped, Skipped},
+		},
+		{
+			name:        "protected",
+			state:       connected,
+			open:        throughTunnel,
+			route:       "nordlynx",
+			gateway:     mockGateway{ipv6: true},
+			nameservers: []string{"103.86.96.100", "127.0.0.53"},
+			expected:    []Status{Passed, Passed, Passed, Passed},
+		},
+		{
+			name:        "foreign nameserver",
+			state:       connected,
+			open:        throughTunnel,
+			route:       "nordlynx",
+			nameservers: []string{"192.168.1.1"},
+			expected:    []Status{Failed, Passed, Passed, Passed},
+		},
+		{
+			name:  "traffic around the tunnel",
+			state: connected,
+			open: map[probe]bool{
+				{"", dnsProbeIPv4}:          true,
+				{physical, dnsProbeIPv4}:    true,
+				{physical, dnsProbeIPv6}:    true,
+				{physical, stunProbe}:       true,
+				{physical, killSwitchProbe}: true,
+			},
+			route:    "nordlynx",
+			gateway:  mockGateway{ipv6: true},
+			expected: []Status{Failed, Failed, Failed, Failed},
+		},
+		{
+			name:     "IPv6 bypasses the tunnel",
+			state:    connected,
+			open:     throughTunnel,
+			route:    physical,
+			gateway:  mockGateway{ipv6: true},
+			expected: []Status{Passed, Failed, Passed, Passed},
+		},
+		{
+			name:     "inconclusive",
+			state:    connected,
+			route:    "nordlynx",
+			expected: []Status{Skipped, Passed, Passed, Passed},
+		},
+		{
+			name:     "kill switch while disconnected",
+			state:    State{KillSwitch: true},
+			expected: []Status{Passed, Passed, Passed, Passed},
+		},
+		{
+			name:     "kill switch leaks while disconnected",
+			state:    State{KillSwitch: true},
+			open:     map[probe]bool{{"", killSwitchProbe}: true},
+			expected: []Status{Passed, Passed, Passed, Failed},
+		},
+		{
+			name:     "kill switch disabled",
+			state:    State{Connected: true, Tunnel: "nordlynx"},
+			open:     throughTunnel,
+			route:    "nordlynx",
+			expected: []Status{Passed, Passed, Passed, Skipped},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			leakTest := newTestLeakTest(
+				&mockProber{open: test.open, route: test.route},
+				test.gateway,
+				test.nameservers...,
+			)
+			results := leakTest.Run(context.Background(), test.state)
+			assert.Equal(t, test.expected, statuses(results))
+			for _, result := range results {
+				assert.NotEmpty(t, result.Details)
+				if result.Status == Failed {
+					assert.NotEmpty(t, result.Hint)
+				}
+			}
+		})
+	}
+}
+
+func TestDNSQuery(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	query, id := dnsQuery("nordvpn.com")
+	assert.Equal(t, id, binary.BigEndian.Uint16(query))
+	assert.Equal(t, "\x07nordvpn\x03com\x00\x00\x01\x00\x01", string(query[12:]))
+	// query itself is not a response
+	assert.False(t, isDNSResponse(query, id))
+}
+
+func TestLeakTest_VerifyRouting(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		state    State
+		route    string
+		hasError bool
+	}{
+		{
+			name:  "routed through the tunnel",
+			state: State{Connected: true, Tunnel: "nordlynx"},
+			route: "nordlynx",
+		},
+		{
+			name:     "routed around the tunnel",
+			state:    State{Connected: true, Tunnel: "nordlynx"},
+			route:    physical,
+			hasError: true,
+		},
+		{
+			name:     "not connected",
+			route:    physical,
+			hasError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			leakTest := newTestLeakTest(&mockProber{route: test.route}, mockGateway{})
+			err := leakTest.VerifyRouting(test.state)
+			if test.hasError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
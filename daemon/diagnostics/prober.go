@@ -0,0 +1,94 @@
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"syscall"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// SocketProber sends the probes from the sockets bound to the interface. The sockets
+// are not marked, so the firewall treats the probes as any other user traffic.
+type SocketProber struct{}
+
+// ExchangeUDP sends the request and returns the first response
+func (SocketProber) ExchangeUDP(
+	ctx context.Context,
+	iface string,
+	addr netip.AddrPort,
+	request []byte,
+) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	conn, err := dialer(iface).DialContext(ctx, "udp", addr.String())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := conn.Write(request); err != nil {
+		return nil, err
+	}
+	response := make([]byte, 1500)
+	n, err := conn.Read(response)
+	if err != nil {
+		return nil, err
+	}
+	return response[:n], nil
+}
+
+// ConnectTCP establishes and closes a TCP connection
+func (SocketProber) ConnectTCP(ctx context.Context, iface string, addr netip.AddrPort) error {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	conn, err := dialer(iface).DialContext(ctx, "tcp", addr.String())
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// Route returns the name of the interface the traffic to addr is routed through
+func (SocketProber) Route(addr netip.Addr) (string, error) {
+	routes, err := netlink.RouteGet(addr.AsSlice())
+	if err != nil {
+		return "", fmt.Errorf("getting route to %s: %w", addr, err)
+	}
+	if len(routes) == 0 {
+		return "", fmt.Errorf("no route to %s", addr)
+	}
+	link, err := net.InterfaceByIndex(routes[0].LinkIndex)
+	if err != nil {
+		return "", fmt.Errorf("getting route interface: %w", err)
+	}
+	return link.Name, nil
+}
+
+// dialer returns a dialer which binds the sockets to the interface, if given
+func dialer(iface string) *net.Dialer {
+	if iface == "" {
+		return &net.Dialer{}
+	}
+	return &net.Dialer{
+		Control: func(network, address string, conn syscall.RawConn) error {
+			var sockErr error
+			if err := conn.Control(func(fd uintptr) {
+				sockErr = unix.SetsockoptString(int(fd), unix.SOL_SOCKET, unix.SO_BINDTODEVICE, iface)
+			}); err != nil {
+				return err
+			}
+			return sockErr
+		},
+	}
+}
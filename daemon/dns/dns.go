@@ -13,14 +13,23 @@ import (
 
 // Setter is responsible for configuring DNS.
 type Setter interface {
-	Set(iface string, nameservers []string) error
+	// Set nameservers and search domains, which are optional
+	Set(iface string, nameservers []string, searchDomains []string) error
 	Unset(iface string) error
 }
 
+// Checker tells whether the DNS set earlier is still in effect, it is used to
+// detect the DNS overwritten by another program
+type Checker interface {
+	IsSet(iface string, nameservers []string) (bool, error)
+}
+
 // Method is abstraction of DNS handling method
 type Method interface {
-	Set(iface string, nameservers []string) error
+	Set(iface string, nameservers []string, searchDomains []string) error
 	Unset(iface string) error
+	// Preview changes of Set without applying them
+	Preview(iface string, nameservers []string, searchDomains []string) (Preview, error)
 	IsAvailable() bool
 	Name() string
 }
@@ -39,19 +48,26 @@ modifies /etc/resolv.conf by adding or removing lines.
 
 4. In case the resolvconf command line utility fails, /etc/resolv.conf is
 backed up and modified directly by NordVPN.
+
+With the legacy mode, systemd-resolved is skipped and only the methods
+modifying /etc/resolv.conf are used.
 */
 type DefaultSetter struct {
 	publisher events.Publisher[string]
 	methods   []Method
+	// active is the method which set DNS, it is used to unset it
+	active Method
 }
 
-func NewSetter(publisher events.Publisher[string]) *DefaultSetter {
+func NewSetter(publisher events.Publisher[string], legacy bool) *DefaultSetter {
 	ds := DefaultSetter{
 		publisher: publisher,
 		methods:   []Method{},
 	}
-	ds.methods = append(ds.methods, &Resolved{})
-	ds.methods = append(ds.methods, &Resolvectl{})
+	if !legacy {
+		ds.methods = append(ds.methods, NewResolved())
+		ds.methods = append(ds.methods, &Resolvectl{})
+	}
 	ds.methods = append(ds.methods, &Resolvconf{})
 	ds.methods = append(ds.methods, &ResolvConfFile{})
 	return &ds
@@ -60,10 +76,13 @@ func NewSetter(publisher events.Publisher[string]) *DefaultSetter {
 // Set DNS for a given iface if the system supports per interface DNS settings.
 // Also, backup current DNS settings (only in case of direct resolv.conf edit).
 // Backup is not overridden, so its safe to call this function multiple times in a row.
-func (d *DefaultSetter) Set(iface string, nameservers []string) error {
+func (d *DefaultSetter) Set(iface string, nameservers []string, searchDomains []string) error {
 	d.publisher.Publish(
 		"setting dns to " + strings.Join(nameservers, " "),
 	)
+	if len(searchDomains) > 0 {
+		d.publisher.Publish("setting dns search domains to " + strings.Join(searchDomains, " "))
+	}
 
 	if len(nameservers) == 0 {
 		return errors.New("nameservers not provided")
@@ -72,9 +91,10 @@ func (d *DefaultSetter) Set(iface string, nameservers []string) error {
 	for _, method := range d.methods {
 		if method.IsAvailable() {
 			d.publisher.Publish("set dns for interface [" + iface + "] using: " + method.Name())
-			if err := method.Set(iface, nameservers); err != nil {
+			if err := method.Set(iface, nameservers, searchDomains); err != nil {
 				return fmt.Errorf("setting dns with %s: %w", method.Name(), err)
 			}
+			d.active = method
 			return nil
 		}
 	}
@@ -83,19 +103,51 @@ func (d *DefaultSetter) Set(iface string, nameservers []string) error {
 }
 
 // Unset DNS for network interface, restore DNS from a backup, if backup
-// is available, and remove the backup on success.
+// is available, and remove the backup on success. DNS is unset with the same
+// method it was set with, so that the state saved by the method is restored.
 func (d *DefaultSetter) Unset(iface string) error {
 	d.publisher.Publish("unsetting DNS")
 
-	for _, method := range d.methods {
-		if method.IsAvailable() {
-			d.publisher.Publish("unset dns for interface [" + iface + "] using: " + method.Name())
-			if err := method.Unset(iface); err != nil {
-				return fmt.Errorf("unsetting dns with %s: %w", method.Name(), err)
+	method := d.active
+	if method == nil {
+		for _, m := range d.methods {
+			if m.IsAvailable() {
+				method = m
+				break
 			}
-			return nil
 		}
 	}
+	if method == nil {
+		return nil
+	}
 
+	d.publisher.Publish("unset dns for interface [" + iface + "] using: " + method.Name())
+	if err := method.Unset(iface); err != nil {
+		return fmt.Errorf("unsetting dns with %s: %w", method.Name(), err)
+	}
+	d.active = nil
 	return nil
 }
+
+// IsSet checks the DNS with the method it was set with. Error is returned if the
+// method cannot be checked.
+func (d *DefaultSetter) IsSet(iface string, nameservers []string) (bool, error) {
+	if d.active == nil {
+		return false, errors.New("dns is not set")
+	}
+	checker, ok := d.active.(Checker)
+	if !ok {
+		return false, fmt.Errorf("dns set with %s cannot be checked", d.active.Name())
+	}
+	return checker.IsSet(iface, nameservers)
+}
+
+// MethodName returns the name of the method which would be used to set DNS
+func (d *DefaultSetter) MethodName() (string, error) {
+	for _, method := range d.methods {
+		if method.IsAvailable() {
+			return method.Name(), nil
+		}
+	}
+	return "", errors.New("no dns setting method is available")
+}
@@ -27,14 +27,37 @@ const (
 // Resolvconf based DNS handling method
 type Resolvconf struct{}
 
-func (m *Resolvconf) Set(iface string, nameservers []string) error {
-	return setDNSWithResolvconf(iface, nameservers)
+func (m *Resolvconf) Set(iface string, nameservers []string, searchDomains []string) error {
+	return setDNSWithResolvconf(iface, nameservers, searchDomains)
 }
 
 func (m *Resolvconf) Unset(iface string) error {
 	return unsetDNSWithResolvconf(iface)
 }
 
+func (m *Resolvconf) Preview(iface string, nameservers []string, searchDomains []string) (Preview, error) {
+	prefix, err := resolvconfIfacePrefix()
+	if err != nil {
+		return Preview{}, fmt.Errorf("determining interface prefix: %w", err)
+	}
+	before, err := currentResolvconf()
+	if err != nil {
+		return Preview{}, err
+	}
+	// records are passed via stdin
+	command := fmt.Sprintf("%s %s <<EOF\n%s\nEOF",
+		execResolvconf,
+		strings.Join(resolvconfSetArgs(prefix+iface), " "),
+		nameserverLines(nameservers, searchDomains),
+	)
+	return Preview{Commands: []string{command}, Before: before}, nil
+}
+
+// IsSet checks whether the nameservers are still listed in resolv.conf
+func (m *Resolvconf) IsSet(iface string, nameservers []string) (bool, error) {
+	return resolvconfContains(nameservers)
+}
+
 func (m *Resolvconf) IsAvailable() bool {
 	return internal.IsCommandAvailable(execResolvconf)
 }
@@ -64,12 +87,12 @@ func resolvconfIfacePrefix() (string, error) {
 	return "", nil
 }
 
-func setDNSWithResolvconf(iface string, addresses []string) error {
-	var addrs = make([]string, len(addresses))
-	for idx, address := range addresses {
-		addrs[idx] = "nameserver " + address
-	}
-	content := strings.Join(addrs, "\n")
+func resolvconfSetArgs(record string) []string {
+	return []string{"-a", record, "-m", "0", "-x"}
+}
+
+func setDNSWithResolvconf(iface string, addresses []string, searchDomains []string) error {
+	content := nameserverLines(addresses, searchDomains)
 	prefix, err := resolvconfIfacePrefix()
 	if err != nil {
 		return fmt.Errorf("determining interface prefix: %w", err)
@@ -77,7 +100,7 @@ func setDNSWithResolvconf(iface string, addresses []string) error {
 
 	// #nosec G204 -- the code would have failed already if iface did not belong
 	// to an actual network interface on the system
-	cmd := exec.Command(execResolvconf, "-a", prefix+iface, "-m", "0", "-x")
+	cmd := exec.Command(execResolvconf, resolvconfSetArgs(prefix+iface)...)
 	cmd.Stdin = strings.NewReader(content)
 	out, err := cmd.CombinedOutput()
 	if err != nil {
@@ -21,14 +21,32 @@ const (
 // This is last fallback method if others are not available
 type ResolvConfFile struct{}
 
-func (m *ResolvConfFile) Set(iface string, nameservers []string) error {
-	return setDNSinResolvconfFile(nameservers)
+func (m *ResolvConfFile) Set(iface string, nameservers []string, searchDomains []string) error {
+	return setDNSinResolvconfFile(nameservers, searchDomains)
 }
 
 func (m *ResolvConfFile) Unset(iface string) error {
 	return unsetDNSinResolvconfFile()
 }
 
+func (m *ResolvConfFile) Preview(iface string, nameservers []string, searchDomains []string) (Preview, error) {
+	before, err := currentResolvconf()
+	if err != nil {
+		return Preview{}, err
+	}
+	after := resolvconfFileContent(nameservers, searchDomains)
+	if internal.FileExists(resolvconfFilePath) && !internal.FileWritable(resolvconfFilePath) {
+		// not writable file is left as is
+		after = before
+	}
+	return Preview{File: resolvconfFilePath, Before: before, After: after}, nil
+}
+
+// IsSet checks whether the nameservers are still listed in resolv.conf
+func (m *ResolvConfFile) IsSet(iface string, nameservers []string) (bool, error) {
+	return resolvconfContains(nameservers)
+}
+
 func (m *ResolvConfFile) IsAvailable() bool {
 	return internal.FileExists(resolvconfFilePath)
 }
@@ -37,7 +55,7 @@ func (m *ResolvConfFile) Name() string {
 	return "resolv.conf, default"
 }
 
-func setDNSinResolvconfFile(addresses []string) error {
+func setDNSinResolvconfFile(addresses []string, searchDomains []string) error {
 	if internal.FileExists(resolvconfFilePath) {
 		if !internal.FileWritable(resolvconfFilePath) {
 			log.Println(internal.WarningPrefix, "dns not set, resolv.conf file is not writable")
@@ -48,22 +66,34 @@ func setDNSinResolvconfFile(addresses []string) error {
 	if err != nil {
 		return fmt.Errorf("backing up dns: %w", err)
 	}
-	return resetDNSinResolvconfFile(addresses)
+	return resetDNSinResolvconfFile(addresses, searchDomains)
 }
 
-func resetDNSinResolvconfFile(addresses []string) error {
-	var addrs = make([]string, len(addresses))
-	for idx, address := range addresses {
-		addrs[idx] = "nameserver " + address
-	}
-
+func resetDNSinResolvconfFile(addresses []string, searchDomains []string) error {
 	// set DNS
 	_ = internal.FileUnlock(resolvconfFilePath)
 	defer internal.FileLock(resolvconfFilePath)
-	content := "# Generated by NordVPN\n" + strings.Join(addrs, "\n")
+	content := resolvconfFileContent(addresses, searchDomains)
 	return internal.FileWrite(resolvconfFilePath, []byte(content), internal.PermUserRWGroupROthersR)
 }
 
+func resolvconfFileContent(addresses []string, searchDomains []string) string {
+	return "# Generated by NordVPN\n" + nameserverLines(addresses, searchDomains)
+}
+
+// nameserverLines returns resolv.conf lines of the nameservers followed by the
+// search line if there are search domains
+func nameserverLines(addresses []string, searchDomains []string) string {
+	var addrs = make([]string, len(addresses))
+	for idx, address := range addresses {
+		addrs[idx] = "nameserver " + address
+	}
+	if len(searchDomains) > 0 {
+		addrs = append(addrs, "search "+strings.Join(searchDomains, " "))
+	}
+	return strings.Join(addrs, "\n")
+}
+
 func unsetDNSinResolvconfFile() error {
 	out, err := internal.FileRead(resolvconfFilePath)
 	if err != nil {
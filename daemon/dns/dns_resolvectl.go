@@ -18,14 +18,30 @@ const (
 // Systemd-resolved and resolvectl based DNS handling method
 type Resolvectl struct{}
 
-func (m *Resolvectl) Set(iface string, nameservers []string) error {
-	return setDNSWithResolvectl(iface, nameservers)
+func (m *Resolvectl) Set(iface string, nameservers []string, searchDomains []string) error {
+	return setDNSWithResolvectl(iface, nameservers, searchDomains)
 }
 
 func (m *Resolvectl) Unset(iface string) error {
 	return unsetDNSWithResolvectl(iface)
 }
 
+func (m *Resolvectl) Preview(iface string, nameservers []string, searchDomains []string) (Preview, error) {
+	prefix, err := resolvconfIfacePrefix()
+	if err != nil {
+		return Preview{}, fmt.Errorf("determining interface prefix: %w", err)
+	}
+	before, err := currentResolvconf()
+	if err != nil {
+		return Preview{}, err
+	}
+	var commands []string
+	for _, args := range resolvectlSetArgs(prefix+iface, nameservers, searchDomains) {
+		commands = append(commands, execResolvectl+" "+strings.Join(args, " "))
+	}
+	return Preview{Commands: commands, Before: before}, nil
+}
+
 func (m *Resolvectl) IsAvailable() bool {
 	return internal.IsCommandAvailable(execResolvectl)
 }
@@ -34,34 +50,39 @@ func (m *Resolvectl) Name() string {
 	return "resolvectl"
 }
 
-func setDNSWithResolvectl(iface string, addresses []string) error {
+func setDNSWithResolvectl(iface string, addresses []string, searchDomains []string) error {
 	prefix, err := resolvconfIfacePrefix()
 	if err != nil {
 		return fmt.Errorf("determining interface prefix: %w", err)
 	}
 
-	cmdStr := []string{"dns", prefix + iface}
-	cmdStr = append(cmdStr, addresses...)
+	args := resolvectlSetArgs(prefix+iface, addresses, searchDomains)
 	// #nosec G204 -- input is properly validated
-	if out, err := exec.Command(execResolvectl, cmdStr...).CombinedOutput(); err != nil {
+	if out, err := exec.Command(execResolvectl, args[0]...).CombinedOutput(); err != nil {
 		return fmt.Errorf("setting dns with resolvectl: %s: %w", strings.TrimSpace(string(out)), err)
 	}
-	// "Catch-all" domain routing for interface, more here: https://github.com/poettering/systemd/commit/8cedb0aef94da880e61b4c8cfeb7f450f8760ec6
-	// #nosec G204 -- input is properly validated
-	if out, err := exec.Command("resolvectl", "domain", prefix+iface, "~.").CombinedOutput(); err != nil {
-		log.Println("dns domain routing with resolvectl:", strings.TrimSpace(string(out)), "err:", err)
-	}
-	// #nosec G204 -- input is properly validated
-	if out, err := exec.Command("resolvectl", "default-route", prefix+iface, "true").CombinedOutput(); err != nil {
-		log.Println("dns domain default-route with resolvectl:", strings.TrimSpace(string(out)), "err:", err)
-	}
-	// #nosec G204 -- input is properly validated
-	if out, err := exec.Command("resolvectl", "flush-caches").CombinedOutput(); err != nil {
-		log.Println("flushing dns caches resolvectl:", strings.TrimSpace(string(out)), "err:", err)
+	// failures of the remaining commands are not fatal
+	for _, arg := range args[1:] {
+		// #nosec G204 -- input is properly validated
+		if out, err := exec.Command(execResolvectl, arg...).CombinedOutput(); err != nil {
+			log.Println("dns", arg[0], "with resolvectl:", strings.TrimSpace(string(out)), "err:", err)
+		}
 	}
 	return nil
 }
 
+// resolvectlSetArgs returns arguments of resolvectl commands used to set DNS for the link
+func resolvectlSetArgs(link string, addresses []string, searchDomains []string) [][]string {
+	return [][]string{
+		append([]string{"dns", link}, addresses...),
+		// "Catch-all" domain routing for interface, more here: https://github.com/poettering/systemd/commit/8cedb0aef94da880e61b4c8cfeb7f450f8760ec6
+		// search domains are used for both routing and completing single-label names
+		append([]string{"domain", link, "~."}, searchDomains...),
+		{"default-route", link, "true"},
+		{"flush-caches"},
+	}
+}
+
 func unsetDNSWithResolvectl(iface string) error {
 	prefix, err := resolvconfIfacePrefix()
 	if err != nil {
@@ -1,12 +1,17 @@
 package dns
 
 import (
+	"bytes"
 	"fmt"
+	"log"
 	"net"
-	"os/exec"
 	"strings"
+	"sync"
 
 	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/godbus/dbus/v5"
+	"golang.org/x/exp/slices"
+	"golang.org/x/sys/unix"
 )
 
 // Daemons and Services
@@ -17,19 +22,197 @@ const (
 
 // Executables
 const (
-	// execBusctl defines busctl executable
+	// execBusctl defines busctl executable, used to preview the D-Bus calls
 	execBusctl = "busctl"
 )
 
+// systemd-resolved D-Bus API
+const (
+	resolvedDest        = "org.freedesktop.resolve1"
+	resolvedPath        = "/org/freedesktop/resolve1"
+	resolvedManager     = "org.freedesktop.resolve1.Manager"
+	resolvedLinkDomains = "org.freedesktop.resolve1.Link.Domains"
+	resolvedLinkDNS     = "org.freedesktop.resolve1.Link.DNS"
+)
+
+// resolvedAddress is the DNS server address as accepted by SetLinkDNS
+type resolvedAddress struct {
+	Family  int32
+	Address []byte
+}
+
+// resolvedDomain is the search or routing domain of the link
+type resolvedDomain struct {
+	Domain      string
+	RoutingOnly bool
+}
+
+// resolvedMethodCall is the call of the systemd-resolved manager method
+type resolvedMethodCall struct {
+	method string
+	args   []any
+}
+
+// resolvedBus calls systemd-resolved
+type resolvedBus interface {
+	Call(method string, args ...any) error
+	LinkDomains(index int) ([]resolvedDomain, error)
+	LinkDNS(index int) ([]resolvedAddress, error)
+}
+
 // Systemd-resolved DBUS API based DNS handling method
-type Resolved struct{}
+type Resolved struct {
+	bus   resolvedBus
+	links func(ifname string) ([]internal.NetLink, error)
+	mu    sync.Mutex
+	// domains of the unmanaged links before they were cleared, restored on Unset
+	domains map[int][]resolvedDomain
+}
+
+func NewResolved() *Resolved {
+	return &Resolved{
+		bus:     systemBus{},
+		links:   unmanagedLinks,
+		domains: map[int][]resolvedDomain{},
+	}
+}
+
+// Set DNS of the tunnel and make it the default route for DNS queries. Domains
+// of the links not managed by systemd-networkd are cleared, so that the
+// queries matching them do not leak, and are restored on Unset.
+// https://www.freedesktop.org/wiki/Software/systemd/resolved/
+func (m *Resolved) Set(iface string, nameservers []string, searchDomains []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
-func (m *Resolved) Set(iface string, nameservers []string) error {
-	return setDNSWithSystemdResolve(iface, nameservers)
+	link, err := net.InterfaceByName(iface)
+	if err != nil {
+		return err
+	}
+	for _, call := range resolvedSetLinkCalls(int32(link.Index), nameservers, searchDomains) {
+		if err := m.bus.Call(call.method, call.args...); err != nil {
+			return fmt.Errorf("calling %s for %s via dbus: %w", call.method, iface, err)
+		}
+	}
+
+	links, err := m.links(iface)
+	if err != nil {
+		return err
+	}
+	for _, link := range links {
+		// on reconnect the domains are already cleared, so the saved ones are kept
+		if _, ok := m.domains[link.Index]; !ok {
+			domains, err := m.bus.LinkDomains(link.Index)
+			if err != nil {
+				log.Println(internal.WarningPrefix, "getting link domains for", link.Name, "via dbus:", err)
+			} else {
+				m.domains[link.Index] = domains
+			}
+		}
+		if err := m.bus.Call("SetLinkDomains", int32(link.Index), []resolvedDomain{}); err != nil {
+			return fmt.Errorf("setting link domains for %s via dbus: %w", link.Name, err)
+		}
+	}
+
+	if err := m.bus.Call("FlushCaches"); err != nil {
+		return fmt.Errorf("flushing local dns caches via dbus: %w", err)
+	}
+	return nil
 }
 
+// Unset DNS of the tunnel and restore the domains of the other links
 func (m *Resolved) Unset(iface string) error {
-	return unsetDNSWithSystemdResolve(iface)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.restoreDomains()
+	if iface == "" {
+		return nil
+	}
+
+	link, err := net.InterfaceByName(iface)
+	if err != nil {
+		return err
+	}
+	if err := m.bus.Call("RevertLink", int32(link.Index)); err != nil {
+		return fmt.Errorf("reverting link %s via dbus: %w", iface, err)
+	}
+	if err := m.bus.Call("FlushCaches"); err != nil {
+		return fmt.Errorf("flushing local dns caches via dbus: %w", err)
+	}
+	return nil
+}
+
+// restoreDomains sets the saved domains back, links might have gone away since
+func (m *Resolved) restoreDomains() {
+	for index, domains := range m.domains {
+		if len(domains) == 0 {
+			continue
+		}
+		if err := m.bus.Call("SetLinkDomains", int32(index), domains); err != nil {
+			log.Println(internal.WarningPrefix, "restoring link domains via dbus:", err)
+		}
+	}
+	m.domains = map[int][]resolvedDomain{}
+}
+
+// IsSet checks whether the nameservers are still set for the tunnel, it is
+// still the default route for the queries and no domains were given back to
+// the other links, so that the queries matching them would leak
+func (m *Resolved) IsSet(iface string, nameservers []string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	link, err := net.InterfaceByName(iface)
+	if err != nil {
+		return false, err
+	}
+	addresses, err := m.bus.LinkDNS(link.Index)
+	if err != nil {
+		return false, fmt.Errorf("getting link dns for %s via dbus: %w", iface, err)
+	}
+	for _, address := range resolvedAddresses(nameservers) {
+		if !slices.ContainsFunc(addresses, func(a resolvedAddress) bool {
+			return a.Family == address.Family && bytes.Equal(a.Address, address.Address)
+		}) {
+			return false, nil
+		}
+	}
+	domains, err := m.bus.LinkDomains(link.Index)
+	if err != nil {
+		return false, fmt.Errorf("getting link domains for %s via dbus: %w", iface, err)
+	}
+	if !slices.Contains(domains, resolvedDomain{Domain: "~.", RoutingOnly: true}) {
+		return false, nil
+	}
+
+	links, err := m.links(iface)
+	if err != nil {
+		return false, err
+	}
+	for _, link := range links {
+		domains, err := m.bus.LinkDomains(link.Index)
+		if err != nil {
+			// link might have gone away in the meantime
+			continue
+		}
+		if len(domains) > 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (m *Resolved) Preview(iface string, nameservers []string, searchDomains []string) (Preview, error) {
+	commands, err := previewDNSWithSystemdResolve(iface, nameservers, searchDomains)
+	if err != nil {
+		return Preview{}, err
+	}
+	before, err := currentResolvconf()
+	if err != nil {
+		return Preview{}, err
+	}
+	return Preview{Commands: commands, Before: before}, nil
 }
 
 func (m *Resolved) IsAvailable() bool {
@@ -40,151 +223,172 @@ func (m *Resolved) Name() string {
 	return "resolved"
 }
 
-// setDNSWithSystemdResolve uses systemd-resolve dbus API to manage DNS
-// https://www.freedesktop.org/wiki/Software/systemd/resolved/
-func setDNSWithSystemdResolve(ifname string, addresses []string) error {
-	iface, err := net.InterfaceByName(ifname)
+// previewDNSWithSystemdResolve returns busctl commands equivalent to the D-Bus
+// calls made by Set
+func previewDNSWithSystemdResolve(ifname string, addresses []string, searchDomains []string) ([]string, error) {
+	// interface might not exist before connecting
+	index := fmt.Sprintf("<%s index>", ifname)
+	if iface, err := net.InterfaceByName(ifname); err == nil {
+		index = fmt.Sprintf("%d", iface.Index)
+	}
+
+	commands := resolvedSetLinkArgs(index, addresses, searchDomains)
+	links, err := unmanagedLinks(ifname)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	for _, link := range links {
+		commands = append(commands, resolvedCall("SetLinkDomains", "ia(sb)", fmt.Sprintf("%d", link.Index), "0"))
+	}
+	commands = append(commands, resolvedCall("FlushCaches"))
+
+	var previews []string
+	for _, args := range commands {
+		previews = append(previews, execBusctl+" "+strings.Join(args, " "))
 	}
-	// Set dns
-	args := []string{
+	return previews, nil
+}
+
+// resolvedCall returns busctl arguments for calling the method of systemd-resolved manager
+func resolvedCall(method string, args ...string) []string {
+	return append([]string{
 		"call",
-		"org.freedesktop.resolve1",
-		"/org/freedesktop/resolve1",
-		"org.freedesktop.resolve1.Manager",
-		"SetLinkDNS", "ia(iay)", fmt.Sprintf("%d", iface.Index), fmt.Sprintf("%d", len(addresses)),
+		resolvedDest,
+		resolvedPath,
+		resolvedManager,
+		method,
+	}, args...)
+}
+
+// resolvedSetLinkCalls returns the calls used to set DNS for the link
+func resolvedSetLinkCalls(index int32, addresses []string, searchDomains []string) []resolvedMethodCall {
+	dns := resolvedAddresses(addresses)
+
+	// routing domain first, followed by the search domains, which are not routing only
+	domains := []resolvedDomain{{Domain: "~.", RoutingOnly: true}}
+	for _, domain := range searchDomains {
+		domains = append(domains, resolvedDomain{Domain: domain})
 	}
+
+	return []resolvedMethodCall{
+		{method: "SetLinkDNS", args: []any{index, dns}},
+		{method: "SetLinkDomains", args: []any{index, domains}},
+		{method: "SetLinkDefaultRoute", args: []any{index, true}},
+		{method: "SetLinkDNSSEC", args: []any{index, "allow-downgrade"}},
+	}
+}
+
+// resolvedAddresses converts the addresses to the format of SetLinkDNS,
+// invalid addresses are skipped
+func resolvedAddresses(addresses []string) []resolvedAddress {
+	var dns []resolvedAddress
+	for _, address := range addresses {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			continue
+		}
+		if ip4 := ip.To4(); ip4 != nil {
+			dns = append(dns, resolvedAddress{Family: unix.AF_INET, Address: ip4})
+		} else {
+			dns = append(dns, resolvedAddress{Family: unix.AF_INET6, Address: ip.To16()})
+		}
+	}
+	return dns
+}
+
+// resolvedSetLinkArgs returns busctl arguments used to set DNS for the link
+func resolvedSetLinkArgs(index string, addresses []string, searchDomains []string) [][]string {
+	dnsArgs := []string{"ia(iay)", index, fmt.Sprintf("%d", len(addresses))}
 	// prepare addresses for busctl
 	for _, address := range addresses {
 		ip := net.ParseIP(address)
 		if ip4 := ip.To4(); ip4 != nil {
 			ip = ip4
-			args = append(args, "2", "4")
+			dnsArgs = append(dnsArgs, "2", "4")
 		} else {
-			args = append(args, "10", "16")
+			dnsArgs = append(dnsArgs, "10", "16")
 		}
 		for _, octet := range ip {
-			args = append(args, fmt.Sprintf("%d", octet))
+			dnsArgs = append(dnsArgs, fmt.Sprintf("%d", octet))
 		}
 	}
-	// #nosec G204 -- input is properly validated
-	out, err := exec.Command(execBusctl, args...).CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("setting link dns for %s via dbus: %s: %w", iface.Name, strings.TrimSpace(string(out)), err)
-	}
 
 	// Set routing domains (more info: https://github.com/poettering/systemd/commit/8cedb0aef94da880e61b4c8cfeb7f450f8760ec6)
-	// #nosec G204 -- input is properly validated
-	out, err = exec.Command(execBusctl,
-		"call",
-		"org.freedesktop.resolve1",
-		"/org/freedesktop/resolve1",
-		"org.freedesktop.resolve1.Manager",
-		"SetLinkDomains", "ia(sb)", fmt.Sprintf("%d", iface.Index), "1", "~.", "true",
-	).CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("setting link routing domains for %s via dbus: %s: %w", iface.Name, strings.TrimSpace(string(out)), err)
+	// followed by the search domains, which are not routing only
+	domainArgs := []string{"ia(sb)", index, fmt.Sprintf("%d", len(searchDomains)+1), "~.", "true"}
+	for _, domain := range searchDomains {
+		domainArgs = append(domainArgs, domain, "false")
 	}
 
-	// Set Default route to tunnel interface
-	// #nosec G204 -- input is properly validated
-	out, err = exec.Command(execBusctl,
-		"call",
-		"org.freedesktop.resolve1",
-		"/org/freedesktop/resolve1",
-		"org.freedesktop.resolve1.Manager",
-		"SetLinkDefaultRoute", "ib", fmt.Sprintf("%d", iface.Index), "true",
-	).CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("setting link default route for %s via dbus: %s: %w", iface.Name, strings.TrimSpace(string(out)), err)
-	}
-
-	// Use secure DNS extension, but allow to downgrade if it's unsupported
-	// #nosec G204 -- input is properly validated
-	out, err = exec.Command(execBusctl,
-		"call",
-		"org.freedesktop.resolve1",
-		"/org/freedesktop/resolve1",
-		"org.freedesktop.resolve1.Manager",
-		"SetLinkDNSSEC", "is", fmt.Sprintf("%d", iface.Index), "allow-downgrade",
-	).CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("setting link dns sec for %s via dbus: %s: %w", iface.Name, strings.TrimSpace(string(out)), err)
+	return [][]string{
+		resolvedCall("SetLinkDNS", dnsArgs...),
+		resolvedCall("SetLinkDomains", domainArgs...),
+		// Set Default route to tunnel interface
+		resolvedCall("SetLinkDefaultRoute", "ib", index, "true"),
+		// Use secure DNS extension, but allow to downgrade if it's unsupported
+		resolvedCall("SetLinkDNSSEC", "is", index, "allow-downgrade"),
 	}
+}
 
+// unmanagedLinks returns links which are not managed by systemd-networkd
+func unmanagedLinks(ifname string) ([]internal.NetLink, error) {
 	links, err := internal.NetworkLinks()
 	if err != nil {
-		return fmt.Errorf("listing network links: %w", err)
+		return nil, fmt.Errorf("listing network links: %w", err)
 	}
-	// Setup other links
+	var unmanaged []internal.NetLink
 	for _, link := range links {
 		// lo is managed by systemd-networkd
 		// vpn and managed links should be ignored
-		if link.Name == "lo" || link.Name == iface.Name || !internal.IsNetworkLinkUnmanaged(link.Name) {
+		if link.Name == "lo" || link.Name == ifname || !internal.IsNetworkLinkUnmanaged(link.Name) {
 			continue
 		}
-
-		// Remove domains
-		// #nosec G204 -- input is properly validated
-		out, err = exec.Command(execBusctl,
-			"call",
-			"org.freedesktop.resolve1",
-			"/org/freedesktop/resolve1",
-			"org.freedesktop.resolve1.Manager",
-			"SetLinkDomains", "ia(sb)", fmt.Sprintf("%d", link.Index), "0",
-		).CombinedOutput()
-		if err != nil {
-			return fmt.Errorf("setting link domains for %s via dbus: %s: %w", link.Name, strings.TrimSpace(string(out)), err)
-		}
-	}
-
-	out, err = exec.Command(execBusctl,
-		"call",
-		"org.freedesktop.resolve1",
-		"/org/freedesktop/resolve1",
-		"org.freedesktop.resolve1.Manager",
-		"FlushCaches",
-	).CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("flushing local dns caches via dbus: %s: %w", strings.TrimSpace(string(out)), err)
+		unmanaged = append(unmanaged, link)
 	}
-
-	return nil
+	return unmanaged, nil
 }
 
-func unsetDNSWithSystemdResolve(ifname string) error {
-	if ifname == "" {
-		return nil
-	}
+// systemBus calls systemd-resolved over the system D-Bus
+type systemBus struct{}
 
-	iface, err := net.InterfaceByName(ifname)
+func (systemBus) Call(method string, args ...any) error {
+	conn, err := dbus.SystemBus()
 	if err != nil {
-		return err
+		return fmt.Errorf("connecting to system bus: %w", err)
 	}
+	return conn.Object(resolvedDest, resolvedPath).Call(resolvedManager+"."+method, 0, args...).Err
+}
 
-	// #nosec G204 -- input is properly validated
-	out, err := exec.Command(execBusctl,
-		"call",
-		"org.freedesktop.resolve1",
-		"/org/freedesktop/resolve1",
-		"org.freedesktop.resolve1.Manager",
-		"RevertLink", "i", fmt.Sprintf("%d", iface.Index),
-	).CombinedOutput()
+func (systemBus) LinkDomains(index int) ([]resolvedDomain, error) {
+	conn, err := dbus.SystemBus()
 	if err != nil {
-		return fmt.Errorf("reverting link %s via dbus: %s: %w", iface.Name, strings.TrimSpace(string(out)), err)
+		return nil, fmt.Errorf("connecting to system bus: %w", err)
 	}
+	var path dbus.ObjectPath
+	if err := conn.Object(resolvedDest, resolvedPath).
+		Call(resolvedManager+".GetLink", 0, int32(index)).Store(&path); err != nil {
+		return nil, fmt.Errorf("getting link: %w", err)
+	}
+	var domains []resolvedDomain
+	if err := conn.Object(resolvedDest, path).StoreProperty(resolvedLinkDomains, &domains); err != nil {
+		return nil, fmt.Errorf("getting domains: %w", err)
+	}
+	return domains, nil
+}
 
-	out, err = exec.Command(execBusctl,
-		"call",
-		"org.freedesktop.resolve1",
-		"/org/freedesktop/resolve1",
-		"org.freedesktop.resolve1.Manager",
-		"FlushCaches",
-	).CombinedOutput()
+func (systemBus) LinkDNS(index int) ([]resolvedAddress, error) {
+	conn, err := dbus.SystemBus()
 	if err != nil {
-		return fmt.Errorf("flushing local dns caches via dbus: %s: %w", strings.TrimSpace(string(out)), err)
+		return nil, fmt.Errorf("connecting to system bus: %w", err)
 	}
-
-	return nil
+	var path dbus.ObjectPath
+	if err := conn.Object(resolvedDest, resolvedPath).
+		Call(resolvedManager+".GetLink", 0, int32(index)).Store(&path); err != nil {
+		return nil, fmt.Errorf("getting link: %w", err)
+	}
+	var addresses []resolvedAddress
+	if err := conn.Object(resolvedDest, path).StoreProperty(resolvedLinkDNS, &addresses); err != nil {
+		return nil, fmt.Errorf("getting dns: %w", err)
+	}
+	return addresses, nil
 }
@@ -0,0 +1,123 @@
+package dns
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockResolvedBus struct {
+	domains map[int][]resolvedDomain
+	dns     map[int][]resolvedAddress
+	calls   []string
+	err     error
+}
+
+func (b *mockResolvedBus) Call(method string, args ...any) error {
+	b.calls = append(b.calls, method)
+	if method == "SetLinkDomains" {
+		b.domains[int(args[0].(int32))] = args[1].([]resolvedDomain)
+	}
+	return nil
+}
+
+func (b *mockResolvedBus) LinkDomains(index int) ([]resolvedDomain, error) {
+	return b.domains[index], b.err
+}
+
+func (b *mockResolvedBus) LinkDNS(index int) ([]resolvedAddress, error) {
+	return b.dns[index], b.err
+}
+
+func TestResolved_RestoresLinkDomains(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	lan := []resolvedDomain{{Domain: "lan"}}
+	tests := []struct {
+		name     string
+		err      error
+		expected []resolvedDomain
+	}{
+		{name: "domains are restored", expected: lan},
+		{name: "domains cannot be read", err: errors.New("no such link"), expected: []resolvedDomain{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bus := &mockResolvedBus{domains: map[int][]resolvedDomain{2: lan}, err: test.err}
+			resolved := NewResolved()
+			resolved.bus = bus
+			resolved.links = func(string) ([]internal.NetLink, error) {
+				return []internal.NetLink{{Name: "eth0", Index: 2}}, nil
+			}
+
+			assert.NoError(t, resolved.Set("lo", []string{"1.1.1.1"}, nil))
+			// reconnect must not overwrite the saved domains with the cleared ones
+			assert.NoError(t, resolved.Set("lo", []string{"1.1.1.1"}, nil))
+			assert.Empty(t, bus.domains[2])
+
+			assert.NoError(t, resolved.Unset("lo"))
+			assert.Equal(t, test.expected, bus.domains[2])
+			assert.Contains(t, bus.calls, "RevertLink")
+		})
+	}
+}
+
+func TestResolved_IsSet(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	lo, err := net.InterfaceByName("lo")
+	require.NoError(t, err)
+	vpnDomains := []resolvedDomain{{Domain: "~.", RoutingOnly: true}}
+
+	tests := []struct {
+		name     string
+		dns      []string
+		domains  []resolvedDomain
+		lan      []resolvedDomain
+		expected bool
+	}{
+		{name: "set", dns: []string{"1.1.1.1"}, domains: vpnDomains, expected: true},
+		{name: "nameservers overwritten", dns: []string{"192.168.1.1"}, domains: vpnDomains},
+		{name: "not the default route", dns: []string{"1.1.1.1"}, domains: []resolvedDomain{}},
+		{name: "domains given back", dns: []string{"1.1.1.1"}, domains: vpnDomains, lan: []resolvedDomain{{Domain: "lan"}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bus := &mockResolvedBus{
+				domains: map[int][]resolvedDomain{lo.Index: test.domains, 2: test.lan},
+				dns:     map[int][]resolvedAddress{lo.Index: resolvedAddresses(test.dns)},
+			}
+			resolved := NewResolved()
+			resolved.bus = bus
+			resolved.links = func(string) ([]internal.NetLink, error) {
+				return []internal.NetLink{{Name: "eth0", Index: 2}}, nil
+			}
+
+			set, err := resolved.IsSet("lo", []string{"1.1.1.1"})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, set)
+		})
+	}
+}
+
+func TestResolvedSetLinkCalls(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	calls := resolvedSetLinkCalls(5, []string{"192.0.2.1", "2001:db8::1"}, []string{"corp.example.com"})
+	assert.Equal(t, []any{
+		int32(5),
+		[]resolvedAddress{
+			{Family: 2, Address: []byte{192, 0, 2, 1}},
+			{Family: 10, Address: []byte{0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}},
+		},
+	}, calls[0].args)
+	assert.Equal(t, []any{
+		int32(5),
+		[]resolvedDomain{{Domain: "~.", RoutingOnly: true}, {Domain: "corp.example.com"}},
+	}, calls[1].args)
+}
@@ -14,12 +14,15 @@ type MockMethod struct {
 	err   error
 }
 
-func (m *MockMethod) Set(iface string, nameservers []string) error {
+func (m *MockMethod) Set(iface string, nameservers []string, searchDomains []string) error {
 	return m.err
 }
 func (m *MockMethod) Unset(iface string) error {
 	return m.err
 }
+func (m *MockMethod) Preview(iface string, nameservers []string, searchDomains []string) (Preview, error) {
+	return Preview{Commands: []string{"mock " + iface}}, m.err
+}
 func (m *MockMethod) IsAvailable() bool {
 	return m.avail
 }
@@ -116,10 +119,49 @@ func Test_Method(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			err := test.settr.Set(test.intf, test.dnss)
+			err := test.settr.Set(test.intf, test.dnss, nil)
 			assert.True(t, (test.setErr && err != nil) || (!test.setErr && err == nil))
 			err = test.settr.Unset(test.intf)
 			assert.True(t, (test.unsetErr && err != nil) || (!test.unsetErr && err == nil))
 		})
 	}
 }
+
+type recordingMethod struct {
+	MockMethod
+	unset int
+}
+
+func (m *recordingMethod) Unset(iface string) error {
+	m.unset++
+	return m.err
+}
+
+func TestDefaultSetter_UnsetWithActiveMethod(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	first := &recordingMethod{MockMethod: MockMethod{avail: true}}
+	second := &recordingMethod{MockMethod: MockMethod{avail: true}}
+	ds := DefaultSetter{publisher: &subs.Subject[string]{}, methods: []Method{first, second}}
+
+	assert.NoError(t, ds.Set("nordlynx", []string{"1.1.1.1"}, nil))
+	// first method went away while connected, e.g. systemd-resolved was stopped
+	first.avail = false
+	assert.NoError(t, ds.Unset("nordlynx"))
+	assert.Equal(t, 1, first.unset)
+	assert.Equal(t, 0, second.unset)
+
+	// nothing is set, so the available method cleans up
+	assert.NoError(t, ds.Unset("nordlynx"))
+	assert.Equal(t, 1, first.unset)
+	assert.Equal(t, 1, second.unset)
+}
+
+func TestNewSetter_Legacy(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	for _, method := range NewSetter(&subs.Subject[string]{}, true).methods {
+		assert.NotContains(t, []string{"resolved", "resolvectl"}, method.Name())
+	}
+	assert.Equal(t, "resolved", NewSetter(&subs.Subject[string]{}, false).methods[0].Name())
+}
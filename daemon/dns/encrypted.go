@@ -0,0 +1,279 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	tlsPrefix   = "tls://"
+	httpsPrefix = "https://"
+	dotPort     = 853
+	dnsPort     = 53
+	// dohContentType is defined in RFC 8484
+	dohContentType = "application/dns-message"
+	// maxMessageSize is the largest DNS message sent over TCP
+	maxMessageSize = 65535
+)
+
+// IsEncrypted reports whether the nameserver is a DNS-over-TLS or a DNS-over-HTTPS
+// endpoint
+func IsEncrypted(nameserver string) bool {
+	return strings.HasPrefix(nameserver, tlsPrefix) || strings.HasPrefix(nameserver, httpsPrefix)
+}
+
+// HasEncrypted reports whether any of the nameservers is encrypted
+func HasEncrypted(nameservers []string) bool {
+	for _, nameserver := range nameservers {
+		if IsEncrypted(nameserver) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidNameserver checks whether the nameserver is an IP address, a
+// DNS-over-TLS endpoint given as tls://<ip>[:port] or a DNS-over-HTTPS URL, e.g.
+// https://dns.example/dns-query
+func IsValidNameserver(nameserver string) bool {
+	_, err := parseUpstream(nameserver, &net.Dialer{}, nil)
+	return err == nil
+}
+
+// PlainOnly filters out the encrypted nameservers
+func PlainOnly(nameservers []string) []string {
+	var ret []string
+	for _, nameserver := range nameservers {
+		if !IsEncrypted(nameserver) {
+			ret = append(ret, nameserver)
+		}
+	}
+	return ret
+}
+
+// upstream is a nameserver the queries are forwarded to
+type upstream interface {
+	// exchange sends the query and returns the response
+	exchange(ctx context.Context, query []byte) ([]byte, error)
+	String() string
+}
+
+// parseUpstream creates the upstream using the dialer for the connections.
+// Hostnames of the DNS-over-HTTPS endpoints are resolved with the bootstrap resolver.
+func parseUpstream(nameserver string, dialer *net.Dialer, bootstrap *net.Resolver) (upstream, error) {
+	switch {
+	case strings.HasPrefix(nameserver, tlsPrefix):
+		addrPort, err := parseAddrPort(strings.TrimPrefix(nameserver, tlsPrefix), dotPort)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dns-over-tls nameserver %s: %w", nameserver, err)
+		}
+		return &tlsUpstream{addrPort: addrPort, dialer: dialer}, nil
+	case strings.HasPrefix(nameserver, httpsPrefix):
+		endpoint, err := url.Parse(nameserver)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dns-over-https nameserver %s: %w", nameserver, err)
+		}
+		if endpoint.Hostname() == "" || endpoint.User != nil || endpoint.Fragment != "" {
+			return nil, fmt.Errorf("invalid dns-over-https nameserver %s", nameserver)
+		}
+		return newHTTPSUpstream(endpoint, dialer, bootstrap), nil
+	default:
+		addr, err := netip.ParseAddr(nameserver)
+		if err != nil {
+			return nil, fmt.Errorf("invalid nameserver %s: %w", nameserver, err)
+		}
+		return &plainUpstream{addrPort: netip.AddrPortFrom(addr, dnsPort), dialer: dialer}, nil
+	}
+}
+
+// parseAddrPort parses an IP address with an optional port
+func parseAddrPort(value string, defaultPort uint16) (netip.AddrPort, error) {
+	if addrPort, err := netip.ParseAddrPort(value); err == nil {
+		return addrPort, nil
+	}
+	addr, err := netip.ParseAddr(strings.Trim(value, "[]"))
+	if err != nil {
+		return netip.AddrPort{}, err
+	}
+	return netip.AddrPortFrom(addr, defaultPort), nil
+}
+
+// boundDialer creates connections bound to the interface, so that the queries
+// leave through the tunnel only
+func boundDialer(iface string, timeout time.Duration) *net.Dialer {
+	return &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, conn syscall.RawConn) error {
+			var sockErr error
+			err := conn.Control(func(fd uintptr) {
+				sockErr = unix.SetsockoptString(int(fd), unix.SOL_SOCKET, unix.SO_BINDTODEVICE, iface)
+			})
+			if err != nil {
+				return err
+			}
+			return sockErr
+		},
+	}
+}
+
+// plainUpstream is an unencrypted nameserver, queried over UDP
+type plainUpstream struct {
+	addrPort netip.AddrPort
+	dialer   *net.Dialer
+}
+
+func (u *plainUpstream) exchange(ctx context.Context, query []byte) ([]byte, error) {
+	conn, err := u.dialer.DialContext(ctx, "udp", u.addrPort.String())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := conn.Write(query); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, maxMessageSize)
+	n, err := conn.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf[:n], nil
+}
+
+func (u *plainUpstream) String() string { return u.addrPort.Addr().String() }
+
+// tlsUpstream is a DNS-over-TLS nameserver defined in RFC 7858. Certificate of
+// the nameserver must be issued for its IP address.
+type tlsUpstream struct {
+	addrPort netip.AddrPort
+	dialer   *net.Dialer
+}
+
+func (u *tlsUpstream) exchange(ctx context.Context, query []byte) ([]byte, error) {
+	tlsDialer := tls.Dialer{
+		NetDialer: u.dialer,
+		Config: &tls.Config{
+			ServerName: u.addrPort.Addr().String(),
+			MinVersion: tls.VersionTLS12,
+		},
+	}
+	conn, err := tlsDialer.DialContext(ctx, "tcp", u.addrPort.String())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+	if err := writeStreamMessage(conn, query); err != nil {
+		return nil, err
+	}
+	return readStreamMessage(conn)
+}
+
+func (u *tlsUpstream) String() string { return tlsPrefix + u.addrPort.String() }
+
+// httpsUpstream is a DNS-over-HTTPS nameserver defined in RFC 8484
+type httpsUpstream struct {
+	endpoint *url.URL
+	client   *http.Client
+}
+
+func newHTTPSUpstream(endpoint *url.URL, dialer *net.Dialer, bootstrap *net.Resolver) *httpsUpstream {
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+			host, port, err := net.SplitHostPort(address)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := netip.ParseAddr(host); err == nil || bootstrap == nil {
+				return dialer.DialContext(ctx, network, address)
+			}
+			addrs, err := bootstrap.LookupNetIP(ctx, "ip", host)
+			if err != nil {
+				return nil, fmt.Errorf("resolving %s: %w", host, err)
+			}
+			var dialErr error
+			for _, addr := range addrs {
+				conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(addr.Unmap().String(), port))
+				if err == nil {
+					return conn, nil
+				}
+				dialErr = err
+			}
+			return nil, dialErr
+		},
+		ForceAttemptHTTP2:   true,
+		TLSHandshakeTimeout: dialer.Timeout,
+		MaxIdleConns:        1,
+		IdleConnTimeout:     time.Minute,
+	}
+	return &httpsUpstream{endpoint: endpoint, client: &http.Client{Transport: transport}}
+}
+
+func (u *httpsUpstream) exchange(ctx context.Context, query []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.endpoint.String(), bytes.NewReader(query))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", dohContentType)
+	req.Header.Set("Accept", dohContentType)
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected http status %s", resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxMessageSize))
+}
+
+func (u *httpsUpstream) String() string { return u.endpoint.String() }
+
+// writeStreamMessage writes the message prefixed with its length as used by
+// DNS over TCP and TLS
+func writeStreamMessage(w io.Writer, msg []byte) error {
+	if len(msg) > maxMessageSize {
+		return errors.New("dns message is too long")
+	}
+	buf := make([]byte, 2+len(msg))
+	binary.BigEndian.PutUint16(buf, uint16(len(msg)))
+	copy(buf[2:], msg)
+	_, err := w.Write(buf)
+	return err
+}
+
+// readStreamMessage reads the length prefixed message
+func readStreamMessage(r io.Reader) ([]byte, error) {
+	var length uint16
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return nil, err
+	}
+	msg := make([]byte, length)
+	if _, err := io.ReadFull(r, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
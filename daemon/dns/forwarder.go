@@ -0,0 +1,210 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"net/netip"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	// upstreamTimeout limits a single query to a single upstream
+	upstreamTimeout = 5 * time.Second
+	// minUDPSize is the size of the UDP response guaranteed by RFC 1035
+	minUDPSize = 512
+)
+
+// Forwarder is a small DNS server forwarding the queries to the nameservers
+// through the tunnel. It allows using DNS-over-TLS and DNS-over-HTTPS nameservers
+// with the system resolver, which supports plain DNS only.
+//
+// Upstream nameservers are tried in order until one of them answers. Hostnames
+// of the DNS-over-HTTPS nameservers are resolved using the plain nameservers
+// from the same list, or the default nameservers if there are none. Queries of
+// the routed domains are sent to the nameservers of the routes instead.
+// Queries of the filtered record types are answered locally.
+type Forwarder struct {
+	upstreams []upstream
+	routes    []route
+	filter    *QueryTypeFilter
+	server    server
+}
+
+// NewForwarder creates a forwarder which connects to the nameservers through
+// the given interface. Nameservers of the routes outside of the tunnel are
+// queried with the fwmark. Filter can be nil.
+func NewForwarder(
+	iface string,
+	nameservers []string,
+	routes []Route,
+	filter *QueryTypeFilter,
+	fwmark uint32,
+) (*Forwarder, error) {
+	dialer := boundDialer(iface, upstreamTimeout)
+
+	bootstrapServers := PlainOnly(nameservers)
+	if len(bootstrapServers) == 0 {
+		bootstrapServers = []string{primaryNameserver4, secondaryNameserver4}
+	}
+	bootstrap := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var dialErr error
+			for _, server := range bootstrapServers {
+				conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(server, "53"))
+				if err == nil {
+					return conn, nil
+				}
+				dialErr = err
+			}
+			return nil, dialErr
+		},
+	}
+
+	var upstreams []upstream
+	for _, nameserver := range nameservers {
+		u, err := parseUpstream(nameserver, dialer, bootstrap)
+		if err != nil {
+			return nil, err
+		}
+		upstreams = append(upstreams, u)
+	}
+	if len(upstreams) == 0 {
+		return nil, errors.New("nameservers not provided")
+	}
+	return &Forwarder{upstreams: upstreams, routes: newRoutes(routes, dialer, fwmark), filter: filter}, nil
+}
+
+// Start serving the queries on UDP and TCP port 53 of the address
+func (f *Forwarder) Start(addr netip.Addr) error {
+	f.server.name = "dns forwarder"
+	f.server.handler = f.resolve
+	return f.server.start(addr)
+}
+
+// Stop serving the queries and wait until the pending ones are answered
+func (f *Forwarder) Stop() error {
+	return f.server.stop()
+}
+
+// resolve answers the query of the filtered type or forwards it to the
+// nameserver of the matching route or to the upstreams in order. SERVFAIL is
+// returned if none of them answers and nil if the query cannot be parsed.
+func (f *Forwarder) resolve(query []byte) []byte {
+	if f.filter != nil {
+		resp, err := f.filter.Filter(query)
+		if err != nil {
+			return nil
+		}
+		if resp != nil {
+			return resp
+		}
+	}
+	if resp, ok := resolveRoute(f.routes, query); ok {
+		return resp
+	}
+	for _, u := range f.upstreams {
+		ctx, cancel := context.WithTimeout(context.Background(), upstreamTimeout)
+		resp, err := u.exchange(ctx, query)
+		cancel()
+		if err == nil {
+			return resp
+		}
+		log.Println(internal.WarningPrefix, "dns forwarder querying", u.String()+":", err)
+	}
+	return serverFailure(query)
+}
+
+// serverFailure builds the SERVFAIL response to the query
+func serverFailure(query []byte) []byte {
+	var parser dnsmessage.Parser
+	header, err := parser.Start(query)
+	if err != nil {
+		return nil
+	}
+	questions, err := parser.AllQuestions()
+	if err != nil {
+		return nil
+	}
+	resp := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:                 header.ID,
+			Response:           true,
+			OpCode:             header.OpCode,
+			RecursionDesired:   header.RecursionDesired,
+			RecursionAvailable: true,
+			RCode:              dnsmessage.RCodeServerFailure,
+		},
+		Questions: questions,
+	}
+	packed, err := resp.Pack()
+	if err != nil {
+		return nil
+	}
+	return packed
+}
+
+// udpPayloadSize returns the size of the UDP response the client accepts
+// according to the EDNS0 option of the query
+func udpPayloadSize(query []byte) int {
+	var parser dnsmessage.Parser
+	if _, err := parser.Start(query); err != nil {
+		return minUDPSize
+	}
+	if err := parser.SkipAllQuestions(); err != nil {
+		return minUDPSize
+	}
+	if err := parser.SkipAllAnswers(); err != nil {
+		return minUDPSize
+	}
+	if err := parser.SkipAllAuthorities(); err != nil {
+		return minUDPSize
+	}
+	for {
+		header, err := parser.AdditionalHeader()
+		if err != nil {
+			return minUDPSize
+		}
+		if header.Type == dnsmessage.TypeOPT {
+			// requestor's payload size is stored in the class field
+			if size := int(header.Class); size > minUDPSize {
+				return size
+			}
+			return minUDPSize
+		}
+		if err := parser.SkipAdditional(); err != nil {
+			return minUDPSize
+		}
+	}
+}
+
+// truncate replaces the response which does not fit into UDP payload with the
+// header and questions only and sets the TC flag, so that the client retries
+// over TCP
+func truncate(resp []byte, size int) []byte {
+	if len(resp) <= size {
+		return resp
+	}
+	var parser dnsmessage.Parser
+	header, err := parser.Start(resp)
+	if err != nil {
+		return nil
+	}
+	questions, err := parser.AllQuestions()
+	if err != nil {
+		return nil
+	}
+	header.Truncated = true
+	msg := dnsmessage.Message{Header: header, Questions: questions}
+	packed, err := msg.Pack()
+	if err != nil {
+		return nil
+	}
+	return packed
+}
@@ -0,0 +1,163 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+type fakeUpstream struct {
+	resp []byte
+	err  error
+	hits int
+}
+
+func (u *fakeUpstream) exchange(context.Context, []byte) ([]byte, error) {
+	u.hits++
+	return u.resp, u.err
+}
+
+func (u *fakeUpstream) String() string { return "fake" }
+
+func TestIsValidNameserver(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	for nameserver, expected := range map[string]bool{
+		"1.1.1.1":                            true,
+		"2606:4700:4700::1111":               true,
+		"tls://1.1.1.1":                      true,
+		"tls://1.1.1.1:8853":                 true,
+		"tls://[2606:4700:4700::1111]:853":   true,
+		"tls://2606:4700:4700::1111":         true,
+		"https://dns.example/dns-query":      true,
+		"https://1.1.1.1/dns-query":          true,
+		"https://dns.example:8443/dns-query": true,
+		"tls://dns.example":                  false,
+		"tls://":                             false,
+		"https://":                           false,
+		"https://user@dns.example/dns-query": false,
+		"http://dns.example/dns-query":       false,
+		"dns.example":                        false,
+		"":                                   false,
+	} {
+		assert.Equal(t, expected, IsValidNameserver(nameserver), nameserver)
+	}
+}
+
+func TestPlainOnly(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	nameservers := []string{"tls://1.1.1.1", "9.9.9.9", "https://dns.example/dns-query"}
+	assert.True(t, HasEncrypted(nameservers))
+	assert.Equal(t, []string{"9.9.9.9"}, PlainOnly(nameservers))
+	assert.False(t, HasEncrypted(PlainOnly(nameservers)))
+}
+
+func TestStreamMessage(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeStreamMessage(&buf, []byte{1, 2, 3}))
+	assert.Equal(t, []byte{0, 3, 1, 2, 3}, buf.Bytes())
+
+	msg, err := readStreamMessage(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 2, 3}, msg)
+}
+
+func TestForwarder_Resolve(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	query := newQuery(t, nil)
+
+	failing := &fakeUpstream{err: mock.ErrOnPurpose}
+	working := &fakeUpstream{resp: []byte("response")}
+	forwarder := Forwarder{upstreams: []upstream{failing, working}}
+	assert.Equal(t, []byte("response"), forwarder.resolve(query))
+	assert.Equal(t, 1, failing.hits)
+
+	forwarder = Forwarder{upstreams: []upstream{failing}}
+	var parser dnsmessage.Parser
+	header, err := parser.Start(forwarder.resolve(query))
+	require.NoError(t, err)
+	assert.True(t, header.Response)
+	assert.Equal(t, uint16(0x1234), header.ID)
+	assert.Equal(t, dnsmessage.RCodeServerFailure, header.RCode)
+
+	assert.Nil(t, forwarder.resolve([]byte{1}))
+}
+
+func TestForwarder_ResolveFiltered(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	filter, err := NewQueryTypeFilter([]string{"AAAA"})
+	require.NoError(t, err)
+	working := &fakeUpstream{resp: []byte("response")}
+	forwarder := Forwarder{upstreams: []upstream{working}, filter: filter}
+
+	var parser dnsmessage.Parser
+	header, err := parser.Start(forwarder.resolve(packQuery(t, dnsmessage.TypeAAAA)))
+	require.NoError(t, err)
+	assert.True(t, header.Response)
+	assert.Equal(t, dnsmessage.RCodeSuccess, header.RCode)
+	assert.Equal(t, 0, working.hits)
+
+	assert.Equal(t, []byte("response"), forwarder.resolve(packQuery(t, dnsmessage.TypeA)))
+	assert.Equal(t, 1, working.hits)
+
+	assert.Nil(t, forwarder.resolve([]byte{1}))
+}
+
+func TestUDPPayloadSize(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, minUDPSize, udpPayloadSize(newQuery(t, nil)))
+
+	opt := dnsmessage.Resource{
+		Header: dnsmessage.ResourceHeader{
+			Name:  dnsmessage.MustNewName("."),
+			Type:  dnsmessage.TypeOPT,
+			Class: 1232,
+		},
+		Body: &dnsmessage.OPTResource{},
+	}
+	assert.Equal(t, 1232, udpPayloadSize(newQuery(t, []dnsmessage.Resource{opt})))
+}
+
+func TestTruncate(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	query := newQuery(t, nil)
+	assert.Equal(t, query, truncate(query, minUDPSize))
+
+	truncated := truncate(query, 10)
+	var parser dnsmessage.Parser
+	header, err := parser.Start(truncated)
+	require.NoError(t, err)
+	assert.True(t, header.Truncated)
+	questions, err := parser.AllQuestions()
+	require.NoError(t, err)
+	assert.Len(t, questions, 1)
+}
+
+func newQuery(t *testing.T, additionals []dnsmessage.Resource) []byte {
+	t.Helper()
+	msg := dnsmessage.Message{
+		Header: dnsmessage.Header{ID: 0x1234, RecursionDesired: true},
+		Questions: []dnsmessage.Question{{
+			Name:  dnsmessage.MustNewName("nordvpn.com."),
+			Type:  dnsmessage.TypeA,
+			Class: dnsmessage.ClassINET,
+		}},
+		Additionals: additionals,
+	}
+	packed, err := msg.Pack()
+	require.NoError(t, err)
+	return packed
+}
@@ -0,0 +1,251 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/netip"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/slices"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	// MeshnetResolvedDropInPath is the systemd-resolved configuration which
+	// routes the queries of the meshnet domain to the stub listener
+	MeshnetResolvedDropInPath = "/etc/systemd/resolved.conf.d/nordvpn-meshnet.conf"
+	// meshnetDomain is the domain of the meshnet peer hostnames
+	meshnetDomain = "nord"
+	// meshnetRecordTTL is short, so that the renamed or removed peers do not
+	// linger in the caches
+	meshnetRecordTTL = 30
+)
+
+// meshnetStubAddress is the address the meshnet names are served on. It is
+// distinct from 127.0.0.53 and 127.0.0.54 used by systemd-resolved itself.
+var meshnetStubAddress = netip.AddrFrom4([4]byte{127, 0, 0, 153})
+
+// MeshnetResolver makes the meshnet hostnames resolvable for all the
+// applications. When systemd-resolved is running, the names are served by the
+// embedded stub listener, and the meshnet domain is routed to it as a routing
+// only domain, so no other queries reach the stub. Otherwise the hosts file is
+// used.
+type MeshnetResolver struct {
+	fallback   HostnameSetter
+	dropInPath string
+	available  func() bool
+	reload     func() error
+	flush      func() error
+	mu         sync.RWMutex
+	records    map[string][]netip.Addr
+	server     server
+	// running is true when the split DNS is installed
+	running bool
+}
+
+// NewMeshnetResolver creates the resolver which uses the fallback when the
+// split DNS cannot be installed
+func NewMeshnetResolver(fallback HostnameSetter) *MeshnetResolver {
+	return &MeshnetResolver{
+		fallback:   fallback,
+		dropInPath: MeshnetResolvedDropInPath,
+		available:  func() bool { return internal.IsServiceActive(serviceSystemdResolved) },
+		reload:     reloadResolved,
+		flush:      func() error { return systemBus{}.Call("FlushCaches") },
+		records:    map[string][]netip.Addr{},
+	}
+}
+
+// SetHosts replaces the served names with the hosts
+func (r *MeshnetResolver) SetHosts(hosts Hosts) error {
+	r.mu.Lock()
+	r.records = hostRecords(hosts)
+	r.mu.Unlock()
+
+	if r.running {
+		if err := r.flush(); err != nil {
+			log.Println(internal.WarningPrefix, "flushing meshnet dns caches:", err)
+		}
+		return nil
+	}
+
+	if r.available() {
+		err := r.install()
+		if err == nil {
+			// names might be left in the hosts file by the previous versions
+			// or by the earlier fallback
+			return r.fallback.UnsetHosts()
+		}
+		log.Println(internal.WarningPrefix, "installing meshnet split dns, falling back to hosts file:", err)
+	}
+	return r.fallback.SetHosts(hosts)
+}
+
+// UnsetHosts stops serving the names and removes them from the hosts file
+func (r *MeshnetResolver) UnsetHosts() error {
+	r.mu.Lock()
+	r.records = map[string][]netip.Addr{}
+	r.mu.Unlock()
+
+	var errs []error
+	if r.running {
+		errs = append(errs, r.uninstall())
+	}
+	errs = append(errs, r.fallback.UnsetHosts())
+	return errors.Join(errs...)
+}
+
+// install starts the stub listener and routes the meshnet domain to it
+func (r *MeshnetResolver) install() error {
+	r.server.name = "meshnet dns"
+	r.server.handler = r.resolve
+	if err := r.server.start(meshnetStubAddress); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.dropInPath), internal.PermUserRWXGroupRXOthersRX); err != nil {
+		// #nosec G104 -- the error of the installation is more relevant
+		r.server.stop()
+		return fmt.Errorf("creating resolved configuration directory: %w", err)
+	}
+	content := fmt.Sprintf("# NordVPN meshnet\n[Resolve]\nDNS=%s\nDomains=~%s\n", meshnetStubAddress, meshnetDomain)
+	// #nosec G306 -- resolved configuration is world readable
+	if err := os.WriteFile(r.dropInPath, []byte(content), internal.PermUserRWGroupROthersR); err != nil {
+		// #nosec G104 -- the error of the installation is more relevant
+		r.server.stop()
+		return fmt.Errorf("writing resolved configuration: %w", err)
+	}
+	if err := r.reload(); err != nil {
+		// #nosec G104 -- the error of the installation is more relevant
+		os.Remove(r.dropInPath)
+		// #nosec G104 -- the error of the installation is more relevant
+		r.server.stop()
+		return fmt.Errorf("reloading resolved: %w", err)
+	}
+	r.running = true
+	return nil
+}
+
+// uninstall removes the routing of the meshnet domain and stops the listener
+func (r *MeshnetResolver) uninstall() error {
+	var errs []error
+	if err := os.Remove(r.dropInPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		errs = append(errs, fmt.Errorf("removing resolved configuration: %w", err))
+	}
+	if err := r.reload(); err != nil {
+		errs = append(errs, fmt.Errorf("reloading resolved: %w", err))
+	}
+	errs = append(errs, r.server.stop())
+	r.running = false
+	return errors.Join(errs...)
+}
+
+// resolve answers the query from the meshnet records. Queries outside the
+// meshnet domain are refused, as they are never routed here by resolved.
+func (r *MeshnetResolver) resolve(query []byte) []byte {
+	var parser dnsmessage.Parser
+	header, err := parser.Start(query)
+	if err != nil {
+		return nil
+	}
+	question, err := parser.Question()
+	if err != nil {
+		return nil
+	}
+
+	resp := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:               header.ID,
+			Response:         true,
+			OpCode:           header.OpCode,
+			Authoritative:    true,
+			RecursionDesired: header.RecursionDesired,
+		},
+		Questions: []dnsmessage.Question{question},
+	}
+
+	name := strings.ToLower(strings.TrimSuffix(question.Name.String(), "."))
+	r.mu.RLock()
+	addrs, ok := r.records[name]
+	r.mu.RUnlock()
+	switch {
+	case question.Class != dnsmessage.ClassINET ||
+		!strings.HasSuffix(name, "."+meshnetDomain):
+		resp.Header.Authoritative = false
+		resp.Header.RCode = dnsmessage.RCodeRefused
+	case !ok:
+		resp.Header.RCode = dnsmessage.RCodeNameError
+	default:
+		resp.Answers = addressResources(question, addrs)
+	}
+
+	packed, err := resp.Pack()
+	if err != nil {
+		return serverFailure(query)
+	}
+	return packed
+}
+
+// addressResources returns the records of the addresses matching the type of
+// the question. Other types are answered with no data.
+func addressResources(question dnsmessage.Question, addrs []netip.Addr) []dnsmessage.Resource {
+	var resources []dnsmessage.Resource
+	for _, addr := range addrs {
+		header := dnsmessage.ResourceHeader{
+			Name:  question.Name,
+			Type:  question.Type,
+			Class: dnsmessage.ClassINET,
+			TTL:   meshnetRecordTTL,
+		}
+		switch {
+		case question.Type == dnsmessage.TypeA && addr.Is4():
+			resources = append(resources, dnsmessage.Resource{
+				Header: header,
+				Body:   &dnsmessage.AResource{A: addr.As4()},
+			})
+		case question.Type == dnsmessage.TypeAAAA && addr.Is6():
+			resources = append(resources, dnsmessage.Resource{
+				Header: header,
+				Body:   &dnsmessage.AAAAResource{AAAA: addr.As16()},
+			})
+		}
+	}
+	return resources
+}
+
+// hostRecords maps the lowercase names of the hosts within the meshnet
+// domain to their addresses
+func hostRecords(hosts Hosts) map[string][]netip.Addr {
+	records := map[string][]netip.Addr{}
+	for _, host := range hosts {
+		for _, name := range append([]string{host.FQDN}, host.DomainNames...) {
+			name = strings.ToLower(strings.TrimSuffix(name, "."))
+			if name == "" {
+				continue
+			}
+			if !strings.HasSuffix(name, "."+meshnetDomain) {
+				name += "." + meshnetDomain
+			}
+			if !slices.Contains(records[name], host.IP) {
+				records[name] = append(records[name], host.IP)
+			}
+		}
+	}
+	return records
+}
+
+// reloadResolved makes systemd-resolved read the configuration again. Older
+// versions do not support reloading and are restarted instead.
+func reloadResolved() error {
+	out, err := exec.Command(internal.SystemctlExec, "reload-or-restart", serviceSystemdResolved).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
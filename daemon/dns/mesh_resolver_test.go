@@ -0,0 +1,167 @@
+package dns
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+type hostsSetterMock struct {
+	hosts Hosts
+	set   int
+	unset int
+}
+
+func (h *hostsSetterMock) SetHosts(hosts Hosts) error {
+	h.hosts = hosts
+	h.set++
+	return nil
+}
+
+func (h *hostsSetterMock) UnsetHosts() error {
+	h.hosts = nil
+	h.unset++
+	return nil
+}
+
+var meshnetTestHosts = Hosts{
+	{
+		IP:          netip.MustParseAddr("100.64.0.2"),
+		FQDN:        "Laptop-Spain.nord",
+		DomainNames: []string{"laptop", "laptop-spain"},
+	},
+	{
+		IP:          netip.MustParseAddr("fd00::2"),
+		FQDN:        "laptop-spain.nord",
+		DomainNames: []string{"laptop"},
+	},
+}
+
+func TestHostRecords(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, map[string][]netip.Addr{
+		"laptop-spain.nord": {netip.MustParseAddr("100.64.0.2"), netip.MustParseAddr("fd00::2")},
+		"laptop.nord":       {netip.MustParseAddr("100.64.0.2"), netip.MustParseAddr("fd00::2")},
+	}, hostRecords(meshnetTestHosts))
+}
+
+func TestMeshnetResolver_Resolve(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name    string
+		qname   string
+		qtype   dnsmessage.Type
+		rcode   dnsmessage.RCode
+		answers []string
+	}{
+		{
+			name:    "A record",
+			qname:   "laptop-spain.nord.",
+			qtype:   dnsmessage.TypeA,
+			rcode:   dnsmessage.RCodeSuccess,
+			answers: []string{"100.64.0.2"},
+		},
+		{
+			name:    "AAAA record of the nickname",
+			qname:   "LAPTOP.nord.",
+			qtype:   dnsmessage.TypeAAAA,
+			rcode:   dnsmessage.RCodeSuccess,
+			answers: []string{"fd00::2"},
+		},
+		{
+			name:  "no data for other types",
+			qname: "laptop.nord.",
+			qtype: dnsmessage.TypeMX,
+			rcode: dnsmessage.RCodeSuccess,
+		},
+		{
+			name:  "unknown peer",
+			qname: "desktop.nord.",
+			qtype: dnsmessage.TypeA,
+			rcode: dnsmessage.RCodeNameError,
+		},
+		{
+			name:  "outside of meshnet domain",
+			qname: "nordvpn.com.",
+			qtype: dnsmessage.TypeA,
+			rcode: dnsmessage.RCodeRefused,
+		},
+	}
+
+	resolver := NewMeshnetResolver(&hostsSetterMock{})
+	resolver.records = hostRecords(meshnetTestHosts)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg := dnsmessage.Message{
+				Header: dnsmessage.Header{ID: 42, RecursionDesired: true},
+				Questions: []dnsmessage.Question{{
+					Name:  dnsmessage.MustNewName(test.qname),
+					Type:  test.qtype,
+					Class: dnsmessage.ClassINET,
+				}},
+			}
+			query, err := msg.Pack()
+			require.NoError(t, err)
+
+			var resp dnsmessage.Message
+			require.NoError(t, resp.Unpack(resolver.resolve(query)))
+			assert.Equal(t, uint16(42), resp.Header.ID)
+			assert.True(t, resp.Header.Response)
+			assert.Equal(t, test.rcode, resp.Header.RCode)
+
+			var answers []string
+			for _, answer := range resp.Answers {
+				switch body := answer.Body.(type) {
+				case *dnsmessage.AResource:
+					answers = append(answers, netip.AddrFrom4(body.A).String())
+				case *dnsmessage.AAAAResource:
+					answers = append(answers, netip.AddrFrom16(body.AAAA).String())
+				}
+				assert.Equal(t, uint32(meshnetRecordTTL), answer.Header.TTL)
+			}
+			assert.Equal(t, test.answers, answers)
+		})
+	}
+}
+
+func TestMeshnetResolver_FallbackToHostsFile(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fallback := &hostsSetterMock{}
+	resolver := NewMeshnetResolver(fallback)
+	resolver.available = func() bool { return false }
+
+	assert.NoError(t, resolver.SetHosts(meshnetTestHosts))
+	assert.Equal(t, meshnetTestHosts, fallback.hosts)
+	assert.False(t, resolver.running)
+
+	assert.NoError(t, resolver.UnsetHosts())
+	assert.Nil(t, fallback.hosts)
+	assert.Empty(t, resolver.records)
+}
+
+func TestMeshnetResolver_UpdateWhileRunning(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fallback := &hostsSetterMock{}
+	resolver := NewMeshnetResolver(fallback)
+	flushed := 0
+	resolver.flush = func() error {
+		flushed++
+		return nil
+	}
+	resolver.running = true
+
+	assert.NoError(t, resolver.SetHosts(meshnetTestHosts))
+	assert.Equal(t, 1, flushed)
+	assert.Zero(t, fallback.set)
+	assert.Contains(t, resolver.records, "laptop.nord")
+}
@@ -0,0 +1,212 @@
+package dns
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+const (
+	// monitorInterval defines how often DNS is checked
+	monitorInterval = time.Second
+	// monitorQuietPeriod defines how long DNS has to stay intact before the
+	// re-apply limit is reset, and how long re-applying is paused after the
+	// limit is reached
+	monitorQuietPeriod = time.Minute
+	// monitorMaxReapply defines how many times DNS is re-applied within the
+	// quiet period before re-applying is paused
+	monitorMaxReapply = 3
+)
+
+// Monitor is a Setter which watches DNS for the whole session after it is set
+// and re-applies it in case it was overwritten by another process, such as
+// NetworkManager or dhclient, which rewrites resolv.conf or the
+// systemd-resolved configuration. Overwrites are published, so that the user
+// can be warned about the possible DNS leak.
+//
+// DNS is checked with the method it was set with if the setter is a Checker,
+// otherwise resolv.conf is read. Monitoring is skipped if DNS cannot be checked
+// right after setting it or if monitoring is disabled when DNS is set.
+type Monitor struct {
+	setter     Setter
+	enabled    func() bool
+	publisher  events.Publisher[string]
+	overwrites events.Publisher[events.DataDNSOverwrite]
+	read       func() (string, error)
+	now        func() time.Time
+	interval   time.Duration
+	quiet      time.Duration
+	mu         sync.Mutex
+	stopCh     chan struct{}
+	doneCh     chan struct{}
+}
+
+func NewMonitor(
+	setter Setter,
+	enabled func() bool,
+	publisher events.Publisher[string],
+	overwrites events.Publisher[events.DataDNSOverwrite],
+) *Monitor {
+	return &Monitor{
+		setter:     setter,
+		enabled:    enabled,
+		publisher:  publisher,
+		overwrites: overwrites,
+		read:       currentResolvconf,
+		now:        time.Now,
+		interval:   monitorInterval,
+		quiet:      monitorQuietPeriod,
+	}
+}
+
+// Set DNS using the underlying setter and start monitoring it
+func (m *Monitor) Set(iface string, nameservers []string, searchDomains []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stop()
+
+	if err := m.setter.Set(iface, nameservers, searchDomains); err != nil {
+		return err
+	}
+
+	if !m.enabled() {
+		return nil
+	}
+
+	if set, err := m.isSet(iface, nameservers); err != nil || !set {
+		return nil
+	}
+
+	m.stopCh = make(chan struct{})
+	m.doneCh = make(chan struct{})
+	go m.watch(m.stopCh, m.doneCh, iface, nameservers, searchDomains)
+	return nil
+}
+
+// Unset DNS using the underlying setter after monitoring is stopped
+func (m *Monitor) Unset(iface string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stop()
+	return m.setter.Unset(iface)
+}
+
+// stop monitoring and wait for it to finish. Must be called with mu locked.
+func (m *Monitor) stop() {
+	if m.stopCh == nil {
+		return
+	}
+	close(m.stopCh)
+	<-m.doneCh
+	m.stopCh = nil
+	m.doneCh = nil
+}
+
+// isSet checks DNS with the setter if it supports it or by reading resolv.conf
+func (m *Monitor) isSet(iface string, nameservers []string) (bool, error) {
+	if checker, ok := m.setter.(Checker); ok {
+		return checker.IsSet(iface, nameservers)
+	}
+	content, err := m.read()
+	if err != nil {
+		return false, err
+	}
+	return containsNameservers(content, nameservers), nil
+}
+
+func (m *Monitor) watch(
+	stop <-chan struct{},
+	done chan<- struct{},
+	iface string,
+	nameservers []string,
+	searchDomains []string,
+) {
+	defer close(done)
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	reapplied := 0
+	var lastReapply time.Time
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			set, err := m.isSet(iface, nameservers)
+			if err != nil {
+				log.Println(internal.WarningPrefix, "monitoring dns:", err)
+				continue
+			}
+			quiet := m.now().Sub(lastReapply) >= m.quiet
+			if set {
+				if quiet {
+					reapplied = 0
+				}
+				continue
+			}
+
+			if reapplied == monitorMaxReapply {
+				if !quiet {
+					continue
+				}
+				reapplied = 0
+			}
+			reapplied++
+			lastReapply = m.now()
+			log.Println(internal.WarningPrefix, "dns was overwritten by another process, re-applying")
+			m.publisher.Publish("dns was overwritten by another process, re-applying")
+			if err := m.setter.Set(iface, nameservers, searchDomains); err != nil {
+				log.Println(internal.WarningPrefix, "re-applying dns:", err)
+				m.overwrites.Publish(events.DataDNSOverwrite{
+					Type:        events.DNSRestoreFailure,
+					Nameservers: nameservers,
+					Error:       err,
+				})
+				continue
+			}
+			if reapplied == monitorMaxReapply {
+				log.Println(internal.WarningPrefix,
+					"dns keeps being overwritten by another process, DNS may leak")
+				m.publisher.Publish("dns keeps being overwritten by another process, pausing re-applying")
+				m.overwrites.Publish(events.DataDNSOverwrite{
+					Type:        events.DNSRestoreFailure,
+					Nameservers: nameservers,
+					Error:       errors.New("dns keeps being overwritten by another process"),
+				})
+				continue
+			}
+			m.overwrites.Publish(events.DataDNSOverwrite{Type: events.DNSRestored, Nameservers: nameservers})
+		}
+	}
+}
+
+// containsNameservers reports whether every nameserver is listed in resolv.conf content
+func containsNameservers(content string, nameservers []string) bool {
+	listed := map[string]bool{}
+	for _, line := range splitLines(content) {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && fields[0] == "nameserver" {
+			listed[fields[1]] = true
+		}
+	}
+	for _, nameserver := range nameservers {
+		if !listed[nameserver] {
+			return false
+		}
+	}
+	return true
+}
+
+// resolvconfContains reports whether every nameserver is listed in resolv.conf
+func resolvconfContains(nameservers []string) (bool, error) {
+	content, err := currentResolvconf()
+	if err != nil {
+		return false, err
+	}
+	return containsNameservers(content, nameservers), nil
+}
@@ -0,0 +1,194 @@
+package dns
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/events/subs"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+// resolvconfSetter imitates DNS setting method which writes resolv.conf directly
+type resolvconfSetter struct {
+	mu      sync.Mutex
+	content string
+	sets    int
+}
+
+func (s *resolvconfSetter) Set(iface string, nameservers []string, searchDomains []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sets++
+	s.content = resolvconfFileContent(nameservers, searchDomains)
+	return nil
+}
+
+func (s *resolvconfSetter) Unset(iface string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.content = ""
+	return nil
+}
+
+func (s *resolvconfSetter) read() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.content, nil
+}
+
+func (s *resolvconfSetter) overwrite(content string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.content = content
+}
+
+func (s *resolvconfSetter) setCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sets
+}
+
+// overwriteRecorder collects the published overwrite events
+type overwriteRecorder struct {
+	mu     sync.Mutex
+	events []events.TypeDNSOverwrite
+}
+
+func (r *overwriteRecorder) notify(data events.DataDNSOverwrite) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, data.Type)
+	return nil
+}
+
+func (r *overwriteRecorder) get() []events.TypeDNSOverwrite {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]events.TypeDNSOverwrite{}, r.events...)
+}
+
+func newTestMonitor(setter *resolvconfSetter, quiet time.Duration) (*Monitor, *overwriteRecorder) {
+	recorder := &overwriteRecorder{}
+	overwrites := &subs.Subject[events.DataDNSOverwrite]{}
+	overwrites.Subscribe(recorder.notify)
+	m := NewMonitor(setter, func() bool { return true }, &subs.Subject[string]{}, overwrites)
+	m.read = setter.read
+	m.interval = time.Millisecond
+	m.quiet = quiet
+	return m, recorder
+}
+
+func TestMonitor_ReappliesOverwrittenDNS(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	setter := &resolvconfSetter{}
+	monitor, recorder := newTestMonitor(setter, time.Minute)
+	nameservers := []string{"103.86.96.100", "103.86.99.100"}
+
+	assert.NoError(t, monitor.Set("nordlynx", nameservers, nil))
+	assert.Equal(t, 1, setter.setCount())
+
+	setter.overwrite("# Generated by NetworkManager\nnameserver 192.168.1.1\n")
+	assert.Eventually(t, func() bool {
+		content, _ := setter.read()
+		return containsNameservers(content, nameservers)
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, 2, setter.setCount())
+	assert.Equal(t, []events.TypeDNSOverwrite{events.DNSRestored}, recorder.get())
+
+	assert.NoError(t, monitor.Unset("nordlynx"))
+	setter.overwrite("nameserver 192.168.1.1\n")
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, 2, setter.setCount())
+}
+
+func TestMonitor_Disabled(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	setter := &resolvconfSetter{}
+	monitor, recorder := newTestMonitor(setter, time.Minute)
+	monitor.enabled = func() bool { return false }
+
+	assert.NoError(t, monitor.Set("nordlynx", []string{"103.86.96.100"}, nil))
+	assert.Nil(t, monitor.stopCh)
+
+	setter.overwrite("nameserver 192.168.1.1\n")
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, 1, setter.setCount())
+	assert.Empty(t, recorder.get())
+	assert.NoError(t, monitor.Unset("nordlynx"))
+}
+
+func TestMonitor_PausesReapplying(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	setter := &resolvconfSetter{}
+	monitor, recorder := newTestMonitor(setter, time.Minute)
+	nameservers := []string{"103.86.96.100"}
+
+	assert.NoError(t, monitor.Set("nordlynx", nameservers, nil))
+	for i := 0; i < monitorMaxReapply; i++ {
+		sets := setter.setCount()
+		setter.overwrite("nameserver 192.168.1.1\n")
+		assert.Eventually(t, func() bool { return setter.setCount() > sets }, time.Second, time.Millisecond)
+	}
+	assert.Equal(t, []events.TypeDNSOverwrite{
+		events.DNSRestored,
+		events.DNSRestored,
+		events.DNSRestoreFailure,
+	}, recorder.get())
+
+	setter.overwrite("nameserver 192.168.1.1\n")
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, monitorMaxReapply+1, setter.setCount())
+	// monitoring continues
+	assert.NotNil(t, monitor.stopCh)
+	assert.NoError(t, monitor.Unset("nordlynx"))
+}
+
+func TestMonitor_ResumesAfterQuietPeriod(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	setter := &resolvconfSetter{}
+	monitor, _ := newTestMonitor(setter, 20*time.Millisecond)
+	nameservers := []string{"103.86.96.100"}
+
+	assert.NoError(t, monitor.Set("nordlynx", nameservers, nil))
+	for i := 0; i < monitorMaxReapply; i++ {
+		sets := setter.setCount()
+		setter.overwrite("nameserver 192.168.1.1\n")
+		assert.Eventually(t, func() bool { return setter.setCount() > sets }, time.Second, time.Millisecond)
+	}
+
+	// overwrite long after DNS was set is still noticed
+	time.Sleep(50 * time.Millisecond)
+	setter.overwrite("nameserver 192.168.1.1\n")
+	assert.Eventually(t, func() bool { return setter.setCount() == monitorMaxReapply+2 }, time.Second, time.Millisecond)
+	assert.NoError(t, monitor.Unset("nordlynx"))
+}
+
+func TestMonitor_SkipsUnmanagedResolvconf(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	setter := &resolvconfSetter{}
+	monitor, _ := newTestMonitor(setter, time.Minute)
+	// imitate systemd-resolved stub resolver
+	monitor.read = func() (string, error) { return "nameserver 127.0.0.53\n", nil }
+
+	assert.NoError(t, monitor.Set("nordlynx", []string{"103.86.96.100"}, nil))
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, 1, setter.setCount())
+	assert.Nil(t, monitor.stopCh)
+}
+
+func TestContainsNameservers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	content := "# Generated by NordVPN\nnameserver 103.86.96.100\nnameserver 103.86.99.100\nsearch example.com"
+	assert.True(t, containsNameservers(content, []string{"103.86.96.100", "103.86.99.100"}))
+	assert.False(t, containsNameservers(content, []string{"103.86.96.100", "1.1.1.1"}))
+	assert.False(t, containsNameservers("", []string{"103.86.96.100"}))
+}
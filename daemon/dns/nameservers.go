@@ -1,6 +1,9 @@
 package dns
 
-import "net"
+import (
+	"net"
+	"net/netip"
+)
 
 const (
 	primaryNameserver4                       = "103.86.96.100"
@@ -55,3 +58,16 @@ func (n *NameServers) Get(isThreatProtectionLite bool, ipv6 bool) []string {
 func (n *NameServers) LookupIP(host string) ([]net.IP, error) {
 	return net.LookupIP(host)
 }
+
+// IPv4Only filters out IPv6 nameservers. Invalid addresses are kept, so that
+// they are reported when setting DNS.
+func IPv4Only(nameservers []string) []string {
+	var ret []string
+	for _, nameserver := range nameservers {
+		if addr, err := netip.ParseAddr(nameserver); err == nil && addr.Unmap().Is6() {
+			continue
+		}
+		ret = append(ret, nameserver)
+	}
+	return ret
+}
@@ -73,3 +73,13 @@ func TestNameservers(t *testing.T) {
 		})
 	}
 }
+
+func TestIPv4Only(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t,
+		[]string{primaryNameserver4, "::ffff:192.0.2.1", "invalid"},
+		IPv4Only([]string{primaryNameserver6, primaryNameserver4, "::ffff:192.0.2.1", secondaryNameserver6, "invalid"}),
+	)
+	assert.Empty(t, IPv4Only([]string{primaryNameserver6}))
+}
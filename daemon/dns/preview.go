@@ -0,0 +1,120 @@
+package dns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// Preview describes the changes which would be made by setting DNS
+type Preview struct {
+	// Method which would be used to set DNS
+	Method string
+	// Commands which would be executed
+	Commands []string
+	// File which would be modified directly, empty if it is managed by the method
+	File string
+	// Before is the current content of resolv.conf
+	Before string
+	// After is the content of resolv.conf after setting DNS, empty if the content is
+	// generated by the system and cannot be determined in advance
+	After string
+}
+
+// Diff between the current and the resulting resolv.conf content, empty if there are
+// no changes or the resulting content is not known
+func (p Preview) Diff() string {
+	if p.After == "" || p.Before == p.After {
+		return ""
+	}
+	return diffLines(p.Before, p.After)
+}
+
+// Previewer reports the changes which would be made by setting DNS without applying them
+type Previewer interface {
+	Preview(iface string, nameservers []string, searchDomains []string) (Preview, error)
+}
+
+// Preview changes of setting DNS for a given iface using the same method as Set
+func (d *DefaultSetter) Preview(iface string, nameservers []string, searchDomains []string) (Preview, error) {
+	if len(nameservers) == 0 {
+		return Preview{}, fmt.Errorf("nameservers not provided")
+	}
+
+	for _, method := range d.methods {
+		if method.IsAvailable() {
+			preview, err := method.Preview(iface, nameservers, searchDomains)
+			if err != nil {
+				return Preview{}, fmt.Errorf("previewing dns with %s: %w", method.Name(), err)
+			}
+			preview.Method = method.Name()
+			return preview, nil
+		}
+	}
+
+	return Preview{}, fmt.Errorf("no dns setting method is available")
+}
+
+// currentResolvconf returns the content of resolv.conf, which is empty if the file
+// does not exist
+func currentResolvconf() (string, error) {
+	if !internal.FileExists(resolvconfFilePath) {
+		return "", nil
+	}
+	out, err := internal.FileRead(resolvconfFilePath)
+	if err != nil {
+		return "", fmt.Errorf("reading resolv.conf: %w", err)
+	}
+	return string(out), nil
+}
+
+// diffLines returns line based diff in which removed lines are prefixed with "-", added
+// lines with "+" and unchanged lines with " "
+func diffLines(before, after string) string {
+	a := splitLines(before)
+	b := splitLines(after)
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var diff strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			diff.WriteString(" " + a[i] + "\n")
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			diff.WriteString("-" + a[i] + "\n")
+			i++
+		default:
+			diff.WriteString("+" + b[j] + "\n")
+			j++
+		}
+	}
+	return diff.String()
+}
+
+func splitLines(content string) []string {
+	content = strings.TrimSuffix(content, "\n")
+	if content == "" {
+		return nil
+	}
+	return strings.Split(content, "\n")
+}
@@ -0,0 +1,111 @@
+package dns
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/events/subs"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultSetter_Preview(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		methods  []Method
+		expected []string
+		hasError bool
+	}{
+		{
+			name: "first available method is used",
+			methods: []Method{
+				&MockMethod{avail: false},
+				&MockMethod{avail: true},
+			},
+			expected: []string{"mock nordlynx"},
+		},
+		{
+			name:     "no methods",
+			hasError: true,
+		},
+		{
+			name:     "method fails",
+			methods:  []Method{&MockMethod{avail: true, err: errors.New("err1")}},
+			hasError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setter := DefaultSetter{publisher: &subs.Subject[string]{}, methods: test.methods}
+			preview, err := setter.Preview("nordlynx", []string{"192.0.2.1"}, nil)
+			if test.hasError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "mock", preview.Method)
+			assert.Equal(t, test.expected, preview.Commands)
+		})
+	}
+}
+
+func TestPreview_Diff(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		before   string
+		after    string
+		expected string
+	}{
+		{
+			name:   "replaced nameservers",
+			before: "# local resolver\nnameserver 127.0.0.53\noptions edns0\n",
+			after:  "# local resolver\nnameserver 192.0.2.1\nnameserver 192.0.2.2\noptions edns0",
+			expected: ` # local resolver
+-nameserver 127.0.0.53
++nameserver 192.0.2.1
++nameserver 192.0.2.2
+ options edns0
+`,
+		},
+		{
+			name:     "no resolv.conf",
+			after:    resolvconfFileContent([]string{"192.0.2.1"}, nil),
+			expected: "+# Generated by NordVPN\n+nameserver 192.0.2.1\n",
+		},
+		{
+			name:   "unchanged",
+			before: "nameserver 192.0.2.1",
+			after:  "nameserver 192.0.2.1",
+		},
+		{
+			name:   "unknown result",
+			before: "nameserver 192.0.2.1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			preview := Preview{Before: test.before, After: test.after}
+			assert.Equal(t, test.expected, preview.Diff())
+		})
+	}
+}
+
+func TestResolvectlSetArgs(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, [][]string{
+		{"dns", "nordlynx", "192.0.2.1", "192.0.2.2"},
+		{"domain", "nordlynx", "~."},
+		{"default-route", "nordlynx", "true"},
+		{"flush-caches"},
+	}, resolvectlSetArgs("nordlynx", []string{"192.0.2.1", "192.0.2.2"}, nil))
+
+	assert.Equal(t, []string{"domain", "nordlynx", "~.", "corp.example.com", "nord"},
+		resolvectlSetArgs("nordlynx", []string{"192.0.2.1"}, []string{"corp.example.com", "nord"})[1])
+}
@@ -0,0 +1,95 @@
+package dns
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// queryTypes maps record type names to their values. Types missing here can be given
+// in the generic TYPEnnn form.
+var queryTypes = map[string]dnsmessage.Type{
+	"A":     dnsmessage.TypeA,
+	"NS":    dnsmessage.TypeNS,
+	"CNAME": dnsmessage.TypeCNAME,
+	"SOA":   dnsmessage.TypeSOA,
+	"PTR":   dnsmessage.TypePTR,
+	"MX":    dnsmessage.TypeMX,
+	"TXT":   dnsmessage.TypeTXT,
+	"AAAA":  dnsmessage.TypeAAAA,
+	"SRV":   dnsmessage.TypeSRV,
+	"SVCB":  dnsmessage.Type(64),
+	"HTTPS": dnsmessage.Type(65),
+	"ANY":   dnsmessage.TypeALL,
+}
+
+// QueryTypeFilter answers queries of the filtered record types locally with an
+// empty answer, so that they are never resolved. Queries of other types are passed
+// through. It is meant to be used by a stub resolver in front of the VPN nameservers.
+type QueryTypeFilter struct {
+	types map[dnsmessage.Type]bool
+}
+
+// NewQueryTypeFilter creates a filter for the given record types, e.g. AAAA, HTTPS
+// or TYPE65. Type names are case insensitive.
+func NewQueryTypeFilter(types []string) (*QueryTypeFilter, error) {
+	filter := QueryTypeFilter{types: map[dnsmessage.Type]bool{}}
+	for _, name := range types {
+		qtype, err := parseQueryType(name)
+		if err != nil {
+			return nil, err
+		}
+		filter.types[qtype] = true
+	}
+	return &filter, nil
+}
+
+func parseQueryType(name string) (dnsmessage.Type, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if qtype, ok := queryTypes[name]; ok {
+		return qtype, nil
+	}
+	if value, ok := strings.CutPrefix(name, "TYPE"); ok {
+		if num, err := strconv.ParseUint(value, 10, 16); err == nil {
+			return dnsmessage.Type(num), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown dns record type: %s", name)
+}
+
+// Filter returns the response to the query if its type is filtered. Otherwise, nil
+// is returned and the query should be forwarded to the nameserver.
+func (f *QueryTypeFilter) Filter(query []byte) ([]byte, error) {
+	var parser dnsmessage.Parser
+	header, err := parser.Start(query)
+	if err != nil {
+		return nil, fmt.Errorf("parsing dns query header: %w", err)
+	}
+	questions, err := parser.AllQuestions()
+	if err != nil {
+		return nil, fmt.Errorf("parsing dns query questions: %w", err)
+	}
+	if header.Response || len(questions) != 1 || !f.types[questions[0].Type] {
+		return nil, nil
+	}
+
+	// NOERROR with no answers means the name exists, but has no records of the type
+	resp := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:                 header.ID,
+			Response:           true,
+			OpCode:             header.OpCode,
+			RecursionDesired:   header.RecursionDesired,
+			RecursionAvailable: true,
+			RCode:              dnsmessage.RCodeSuccess,
+		},
+		Questions: questions,
+	}
+	out, err := resp.Pack()
+	if err != nil {
+		return nil, fmt.Errorf("packing dns response: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,90 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+func packQuery(t *testing.T, qtype dnsmessage.Type) []byte {
+	t.Helper()
+	query := dnsmessage.Message{
+		Header: dnsmessage.Header{ID: 4242, RecursionDesired: true},
+		Questions: []dnsmessage.Question{{
+			Name:  dnsmessage.MustNewName("nordvpn.com."),
+			Type:  qtype,
+			Class: dnsmessage.ClassINET,
+		}},
+	}
+	out, err := query.Pack()
+	require.NoError(t, err)
+	return out
+}
+
+func TestNewQueryTypeFilter(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	filter, err := NewQueryTypeFilter([]string{"aaaa", " HTTPS", "TYPE64"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[dnsmessage.Type]bool{
+		dnsmessage.TypeAAAA: true,
+		dnsmessage.Type(65): true,
+		dnsmessage.Type(64): true,
+	}, filter.types)
+
+	_, err = NewQueryTypeFilter([]string{"AAAA", "BOGUS"})
+	assert.Error(t, err)
+	_, err = NewQueryTypeFilter([]string{"TYPE70000"})
+	assert.Error(t, err)
+}
+
+func TestQueryTypeFilter_Filter(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	filter, err := NewQueryTypeFilter([]string{"AAAA", "HTTPS"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		qtype    dnsmessage.Type
+		filtered bool
+	}{
+		{name: "AAAA is filtered", qtype: dnsmessage.TypeAAAA, filtered: true},
+		{name: "HTTPS is filtered", qtype: dnsmessage.Type(65), filtered: true},
+		{name: "A passes through", qtype: dnsmessage.TypeA},
+		{name: "SVCB passes through", qtype: dnsmessage.Type(64)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := filter.Filter(packQuery(t, test.qtype))
+			require.NoError(t, err)
+			if !test.filtered {
+				assert.Nil(t, resp)
+				return
+			}
+
+			var msg dnsmessage.Message
+			require.NoError(t, msg.Unpack(resp))
+			assert.Equal(t, uint16(4242), msg.Header.ID)
+			assert.True(t, msg.Header.Response)
+			assert.True(t, msg.Header.RecursionDesired)
+			assert.Equal(t, dnsmessage.RCodeSuccess, msg.Header.RCode)
+			require.Len(t, msg.Questions, 1)
+			assert.Equal(t, test.qtype, msg.Questions[0].Type)
+			assert.Empty(t, msg.Answers)
+		})
+	}
+}
+
+func TestQueryTypeFilter_FilterInvalid(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	filter, err := NewQueryTypeFilter([]string{"AAAA"})
+	require.NoError(t, err)
+
+	_, err = filter.Filter([]byte{0x01})
+	assert.Error(t, err)
+}
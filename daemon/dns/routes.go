@@ -0,0 +1,118 @@
+package dns
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"golang.org/x/net/dns/dnsmessage"
+	"golang.org/x/sys/unix"
+)
+
+// Route sends the queries of the domain and its subdomains to the nameserver
+// instead of the VPN nameservers, e.g. to reach the internal names of a
+// corporate network while connected.
+type Route struct {
+	Domain     string
+	Nameserver netip.Addr
+	// ViaTunnel queries the nameserver through the tunnel, otherwise the
+	// queries bypass it
+	ViaTunnel bool
+}
+
+// route is a parsed Route
+type route struct {
+	domain   string
+	upstream upstream
+}
+
+// newRoutes creates the upstreams of the routes. Nameservers outside of the
+// tunnel are queried with the fwmark, so that the queries are routed and
+// allowed by the firewall the same way as the allowlisted traffic.
+func newRoutes(routes []Route, tunnelDialer *net.Dialer, fwmark uint32) []route {
+	markDialer := markedDialer(fwmark, upstreamTimeout)
+	var ret []route
+	for _, r := range routes {
+		dialer := markDialer
+		if r.ViaTunnel {
+			dialer = tunnelDialer
+		}
+		ret = append(ret, route{
+			domain:   strings.ToLower(strings.TrimSuffix(r.Domain, ".")),
+			upstream: &plainUpstream{addrPort: netip.AddrPortFrom(r.Nameserver, dnsPort), dialer: dialer},
+		})
+	}
+	return ret
+}
+
+// match returns the upstream of the most specific route matching the name
+func match(routes []route, name string) (upstream, bool) {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	var best *route
+	for i, r := range routes {
+		if name != r.domain && !strings.HasSuffix(name, "."+r.domain) {
+			continue
+		}
+		if best == nil || len(r.domain) > len(best.domain) {
+			best = &routes[i]
+		}
+	}
+	if best == nil {
+		return nil, false
+	}
+	return best.upstream, true
+}
+
+// resolveRoute forwards the query to the nameserver of the route matching the
+// queried name. Returns false if no route matches. Queries of the routed
+// domains are never sent to the VPN nameservers, so SERVFAIL is returned if
+// the nameserver of the route does not answer.
+func resolveRoute(routes []route, query []byte) ([]byte, bool) {
+	if len(routes) == 0 {
+		return nil, false
+	}
+	var parser dnsmessage.Parser
+	if _, err := parser.Start(query); err != nil {
+		return nil, false
+	}
+	question, err := parser.Question()
+	if err != nil {
+		return nil, false
+	}
+	u, ok := match(routes, question.Name.String())
+	if !ok {
+		return nil, false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), upstreamTimeout)
+	defer cancel()
+	resp, err := u.exchange(ctx, query)
+	if err != nil {
+		log.Println(internal.WarningPrefix, "dns forwarder querying", u.String(), "for", question.Name.String()+":", err)
+		return serverFailure(query), true
+	}
+	return resp, true
+}
+
+// markedDialer creates connections marked with the fwmark
+func markedDialer(fwmark uint32, timeout time.Duration) *net.Dialer {
+	return &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, conn syscall.RawConn) error {
+			var sockErr error
+			err := conn.Control(func(fd uintptr) {
+				sockErr = unix.SetsockoptInt(int(fd), unix.SOL_SOCKET, unix.SO_MARK, int(fwmark))
+			})
+			if err != nil {
+				return err
+			}
+			return sockErr
+		},
+	}
+}
@@ -0,0 +1,66 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+func TestMatch(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	corp := &fakeUpstream{}
+	lab := &fakeUpstream{}
+	routes := []route{
+		{domain: "corp.example", upstream: corp},
+		{domain: "lab.corp.example", upstream: lab},
+	}
+
+	for name, expected := range map[string]upstream{
+		"corp.example.":          corp,
+		"intranet.corp.example.": corp,
+		"Intranet.Corp.Example.": corp,
+		"lab.corp.example.":      lab,
+		"git.lab.corp.example.":  lab,
+		"notcorp.example.":       nil,
+		"example.":               nil,
+	} {
+		u, ok := match(routes, name)
+		assert.Equal(t, expected != nil, ok, name)
+		if expected != nil {
+			assert.Same(t, expected, u, name)
+		}
+	}
+}
+
+func TestForwarder_ResolveRoute(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	// newQuery asks for nordvpn.com
+	query := newQuery(t, nil)
+
+	vpn := &fakeUpstream{resp: []byte("vpn")}
+	corp := &fakeUpstream{resp: []byte("corp")}
+	forwarder := Forwarder{
+		upstreams: []upstream{vpn},
+		routes:    []route{{domain: "nordvpn.com", upstream: corp}},
+	}
+	assert.Equal(t, []byte("corp"), forwarder.resolve(query))
+	assert.Equal(t, 0, vpn.hits)
+
+	forwarder.routes = []route{{domain: "corp.example", upstream: corp}}
+	assert.Equal(t, []byte("vpn"), forwarder.resolve(query))
+
+	// routed queries do not leak to the VPN nameservers
+	failing := &fakeUpstream{err: mock.ErrOnPurpose}
+	forwarder.routes = []route{{domain: "nordvpn.com", upstream: failing}}
+	var parser dnsmessage.Parser
+	header, err := parser.Start(forwarder.resolve(query))
+	require.NoError(t, err)
+	assert.Equal(t, dnsmessage.RCodeServerFailure, header.RCode)
+	assert.Equal(t, 1, vpn.hits)
+}
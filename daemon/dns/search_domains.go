@@ -0,0 +1,45 @@
+package dns
+
+import "strings"
+
+const (
+	// MaxSearchDomains is the amount of the search domains supported by resolv.conf
+	MaxSearchDomains = 6
+	// MeshnetSearchDomain completes the short names of meshnet peers
+	MeshnetSearchDomain = "nord"
+
+	maxDomainLength = 253
+	maxLabelLength  = 63
+)
+
+// IsValidSearchDomain returns true if the domain is a valid DNS name which can be
+// written to the search line of resolv.conf
+func IsValidSearchDomain(domain string) bool {
+	return IsValidDomain(domain)
+}
+
+// IsValidDomain returns true if the domain is a valid DNS name
+func IsValidDomain(domain string) bool {
+	if domain == "" || len(domain) > maxDomainLength {
+		return false
+	}
+	for _, label := range strings.Split(domain, ".") {
+		if !isValidLabel(label) {
+			return false
+		}
+	}
+	return true
+}
+
+func isValidLabel(label string) bool {
+	if label == "" || len(label) > maxLabelLength ||
+		strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+		return false
+	}
+	for _, r := range label {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
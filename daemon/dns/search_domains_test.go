@@ -0,0 +1,76 @@
+package dns
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidSearchDomain(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		domain string
+		valid  bool
+	}{
+		{domain: "nord", valid: true},
+		{domain: "corp.example.com", valid: true},
+		{domain: "eu-west-1.internal", valid: true},
+		{domain: ""},
+		{domain: "corp..example"},
+		{domain: ".corp"},
+		{domain: "corp."},
+		{domain: "-corp.example"},
+		{domain: "corp-.example"},
+		{domain: "corp example"},
+		{domain: "corp_example"},
+		{domain: "corp\nnameserver 1.1.1.1"},
+		{domain: strings.Repeat("a", 64) + ".example"},
+		{domain: strings.Repeat("a.", 127) + "ab"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.domain, func(t *testing.T) {
+			assert.Equal(t, test.valid, IsValidSearchDomain(test.domain))
+		})
+	}
+}
+
+func TestNameserverLines(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name          string
+		searchDomains []string
+		expected      string
+	}{
+		{
+			name:     "without search domains",
+			expected: "nameserver 192.0.2.1\nnameserver 192.0.2.2",
+		},
+		{
+			name:          "with search domains",
+			searchDomains: []string{"corp.example.com", "nord"},
+			expected:      "nameserver 192.0.2.1\nnameserver 192.0.2.2\nsearch corp.example.com nord",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected,
+				nameserverLines([]string{"192.0.2.1", "192.0.2.2"}, test.searchDomains))
+		})
+	}
+}
+
+func TestResolvedSetLinkArgs_SearchDomains(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	args := resolvedSetLinkArgs("5", []string{"192.0.2.1"}, []string{"corp.example.com"})
+	assert.Equal(t,
+		resolvedCall("SetLinkDomains", "ia(sb)", "5", "2", "~.", "true", "corp.example.com", "false"),
+		args[1],
+	)
+}
@@ -0,0 +1,128 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// tcpIdleTimeout closes the idle connections of the clients
+const tcpIdleTimeout = 10 * time.Second
+
+// server answers the DNS queries received on UDP and TCP with the handler.
+// Handler returns nil if the query should not be answered.
+type server struct {
+	// name is used in the logs
+	name     string
+	handler  func(query []byte) []byte
+	udpConn  net.PacketConn
+	listener net.Listener
+	wg       sync.WaitGroup
+}
+
+// start serving the queries on UDP and TCP port 53 of the address
+func (s *server) start(addr netip.Addr) error {
+	address := netip.AddrPortFrom(addr, dnsPort).String()
+	udpConn, err := net.ListenPacket("udp", address)
+	if err != nil {
+		return fmt.Errorf("listening on udp %s: %w", address, err)
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		udpConn.Close()
+		return fmt.Errorf("listening on tcp %s: %w", address, err)
+	}
+	s.udpConn = udpConn
+	s.listener = listener
+
+	s.wg.Add(2)
+	go s.serveUDP()
+	go s.serveTCP()
+	return nil
+}
+
+// stop serving the queries and wait until the pending ones are answered
+func (s *server) stop() error {
+	var errs []error
+	if s.udpConn != nil {
+		errs = append(errs, s.udpConn.Close())
+	}
+	if s.listener != nil {
+		errs = append(errs, s.listener.Close())
+	}
+	s.wg.Wait()
+	s.udpConn = nil
+	s.listener = nil
+	return errors.Join(errs...)
+}
+
+func (s *server) serveUDP() {
+	defer s.wg.Done()
+	buf := make([]byte, maxMessageSize)
+	for {
+		n, addr, err := s.udpConn.ReadFrom(buf)
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				log.Println(internal.ErrorPrefix, s.name, "reading udp query:", err)
+			}
+			return
+		}
+		query := append([]byte{}, buf[:n]...)
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			resp := truncate(s.handler(query), udpPayloadSize(query))
+			if resp == nil {
+				return
+			}
+			if _, err := s.udpConn.WriteTo(resp, addr); err != nil {
+				log.Println(internal.WarningPrefix, s.name, "writing udp response:", err)
+			}
+		}()
+	}
+}
+
+func (s *server) serveTCP() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				log.Println(internal.ErrorPrefix, s.name, "accepting tcp connection:", err)
+			}
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			s.serveTCPConn(conn)
+		}()
+	}
+}
+
+// serveTCPConn answers the queries of the connection until it is closed or idle
+func (s *server) serveTCPConn(conn net.Conn) {
+	for {
+		if err := conn.SetDeadline(time.Now().Add(tcpIdleTimeout)); err != nil {
+			return
+		}
+		query, err := readStreamMessage(conn)
+		if err != nil {
+			return
+		}
+		resp := s.handler(query)
+		if resp == nil {
+			return
+		}
+		if err := writeStreamMessage(conn, resp); err != nil {
+			return
+		}
+	}
+}
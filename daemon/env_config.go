@@ -0,0 +1,264 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+)
+
+// Environment variables configuring the daemon at startup, so that containers
+// can be configured without running the cli inside of them. Settings provided
+// by the environment are saved, so they override the changes made with the cli
+// on the next start.
+const (
+	// EnvToken is the token the daemon logs in with if it is not logged in
+	EnvToken = "NORDVPN_TOKEN"
+	// EnvConnect is the server tag, country, city or group the daemon connects
+	// to on startup. Recommended server is used if it is set to on. Setting
+	// this to off disables connecting on startup.
+	EnvConnect = "NORDVPN_CONNECT"
+	// EnvTechnology is either nordlynx or openvpn
+	EnvTechnology = "NORDVPN_TECHNOLOGY"
+	// EnvProtocol is either udp or tcp
+	EnvProtocol = "NORDVPN_PROTOCOL"
+	// EnvKillSwitch is on or off
+	EnvKillSwitch = "NORDVPN_KILLSWITCH"
+	// EnvFirewall is on or off
+	EnvFirewall = "NORDVPN_FIREWALL"
+	// EnvObfuscate is on or off, it can be turned on only with openvpn
+	EnvObfuscate = "NORDVPN_OBFUSCATE"
+	// EnvThreatProtectionLite is on or off
+	EnvThreatProtectionLite = "NORDVPN_THREATPROTECTIONLITE"
+	// EnvDNS is a comma separated list of DNS servers or off
+	EnvDNS = "NORDVPN_DNS"
+	// EnvAllowlistSubnets is a comma separated list of allowlisted subnets
+	EnvAllowlistSubnets = "NORDVPN_ALLOWLIST_SUBNETS"
+	// EnvAllowlistPorts is a comma separated list of ports allowlisted for
+	// both TCP and UDP
+	EnvAllowlistPorts = "NORDVPN_ALLOWLIST_PORTS"
+	// EnvAnalytics is on or off
+	EnvAnalytics = "NORDVPN_ANALYTICS"
+)
+
+// envConnectRecommended are the values of EnvConnect meaning the recommended server
+var envConnectRecommended = []string{"1", "true", "on", "enable", "enabled"}
+
+// EnvConfig is the daemon configuration provided by the environment. Unset
+// values do not change the settings.
+type EnvConfig struct {
+	Token                string
+	Connect              *bool
+	ServerTag            string
+	Technology           *config.Technology
+	Protocol             *config.Protocol
+	KillSwitch           *bool
+	Firewall             *bool
+	Obfuscate            *bool
+	ThreatProtectionLite *bool
+	DNS                  *config.DNS
+	AllowlistSubnets     []string
+	AllowlistPorts       []int64
+	Analytics            *bool
+}
+
+// ParseEnvConfig reads the configuration from the environment. Invalid values
+// are ignored and reported in the error, so that the valid ones can still be
+// applied.
+func ParseEnvConfig(lookup func(string) (string, bool)) (EnvConfig, error) {
+	var conf EnvConfig
+	var errs []error
+	parse := func(key string, fn func(string) error) {
+		value, ok := lookup(key)
+		if !ok || value == "" {
+			return
+		}
+		if err := fn(strings.TrimSpace(value)); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", key, err))
+		}
+	}
+	parseBool := func(key string, target **bool) {
+		parse(key, func(value string) error {
+			enabled, err := nstrings.BoolFromString(value)
+			if err != nil {
+				return err
+			}
+			*target = &enabled
+			return nil
+		})
+	}
+
+	parse(EnvToken, func(value string) error {
+		if !isTokenValid(value) {
+			return errors.New("invalid token")
+		}
+		conf.Token = value
+		return nil
+	})
+	parse(EnvConnect, func(value string) error {
+		connect := !nstrings.CanParseFalseFromString(value)
+		conf.Connect = &connect
+		if connect && !internal.Contains(envConnectRecommended, strings.ToLower(value)) {
+			conf.ServerTag = value
+		}
+		return nil
+	})
+	parse(EnvTechnology, func(value string) error {
+		technology, ok := config.Technology_value[strings.ToUpper(value)]
+		if !ok || config.Technology(technology) == config.Technology_UNKNOWN_TECHNOLOGY {
+			return fmt.Errorf("invalid technology %s", value)
+		}
+		conf.Technology = (*config.Technology)(&technology)
+		return nil
+	})
+	parse(EnvProtocol, func(value string) error {
+		protocol, ok := config.Protocol_value[strings.ToUpper(value)]
+		if !ok || config.Protocol(protocol) == config.Protocol_UNKNOWN_PROTOCOL {
+			return fmt.Errorf("invalid protocol %s", value)
+		}
+		conf.Protocol = (*config.Protocol)(&protocol)
+		return nil
+	})
+	parseBool(EnvKillSwitch, &conf.KillSwitch)
+	parseBool(EnvFirewall, &conf.Firewall)
+	parseBool(EnvObfuscate, &conf.Obfuscate)
+	parseBool(EnvThreatProtectionLite, &conf.ThreatProtectionLite)
+	parseBool(EnvAnalytics, &conf.Analytics)
+	parse(EnvDNS, func(value string) error {
+		dns := config.DNS{}
+		if !nstrings.CanParseFalseFromString(value) {
+			for _, server := range splitList(value) {
+				if _, err := netip.ParseAddr(server); err != nil {
+					return fmt.Errorf("invalid DNS server %s", server)
+				}
+				dns = append(dns, server)
+			}
+		}
+		conf.DNS = &dns
+		return nil
+	})
+	parse(EnvAllowlistSubnets, func(value string) error {
+		for _, subnet := range splitList(value) {
+			if _, err := netip.ParsePrefix(subnet); err != nil {
+				return fmt.Errorf("invalid subnet %s", subnet)
+			}
+			conf.AllowlistSubnets = append(conf.AllowlistSubnets, subnet)
+		}
+		return nil
+	})
+	parse(EnvAllowlistPorts, func(value string) error {
+		for _, port := range splitList(value) {
+			number, err := strconv.ParseInt(port, 10, 64)
+			if err != nil || number < 1 || number > 65535 {
+				return fmt.Errorf("invalid port %s", port)
+			}
+			conf.AllowlistPorts = append(conf.AllowlistPorts, number)
+		}
+		return nil
+	})
+
+	if conf.ThreatProtectionLite != nil && *conf.ThreatProtectionLite &&
+		conf.DNS != nil && len(*conf.DNS) > 0 {
+		errs = append(errs, fmt.Errorf("%s and %s cannot be used together", EnvThreatProtectionLite, EnvDNS))
+		conf.ThreatProtectionLite = nil
+		conf.DNS = nil
+	}
+
+	return conf, errors.Join(errs...)
+}
+
+// HasSettings returns true if any of the settings is provided
+func (e EnvConfig) HasSettings() bool {
+	return e.Connect != nil || e.Technology != nil || e.Protocol != nil ||
+		e.KillSwitch != nil || e.Firewall != nil || e.Obfuscate != nil ||
+		e.ThreatProtectionLite != nil || e.DNS != nil || e.AllowlistSubnets != nil ||
+		e.AllowlistPorts != nil || e.Analytics != nil
+}
+
+// Apply saves the settings provided by the environment to the config
+func (e EnvConfig) Apply(cfg config.Config) config.Config {
+	if e.Technology != nil {
+		cfg.Technology = *e.Technology
+	}
+	if e.Protocol != nil {
+		cfg.AutoConnectData.Protocol = *e.Protocol
+	}
+	if e.Obfuscate != nil {
+		if *e.Obfuscate && cfg.Technology != config.Technology_OPENVPN {
+			log.Println(internal.WarningPrefix, EnvObfuscate, "is ignored, obfuscation is supported only by openvpn")
+		} else {
+			cfg.AutoConnectData.Obfuscate = *e.Obfuscate
+		}
+	}
+	// nordlynx does not support obfuscation
+	if e.Technology != nil && cfg.Technology != config.Technology_OPENVPN {
+		cfg.AutoConnectData.Obfuscate = false
+	}
+	if e.KillSwitch != nil {
+		cfg.KillSwitch = *e.KillSwitch
+	}
+	if e.Firewall != nil {
+		cfg.Firewall = *e.Firewall
+	}
+	if e.ThreatProtectionLite != nil {
+		cfg.AutoConnectData.ThreatProtectionLite = *e.ThreatProtectionLite
+		if *e.ThreatProtectionLite {
+			cfg.AutoConnectData.DNS = nil
+		}
+	}
+	if e.DNS != nil {
+		cfg.AutoConnectData.DNS = *e.DNS
+		if len(*e.DNS) > 0 {
+			cfg.AutoConnectData.ThreatProtectionLite = false
+		}
+	}
+	if e.AllowlistSubnets != nil || e.AllowlistPorts != nil {
+		current := cfg.AutoConnectData.Allowlist
+		allowlist := config.NewAllowlist(e.AllowlistPorts, e.AllowlistPorts, e.AllowlistSubnets)
+		// only the provided part of the allowlist is replaced
+		if e.AllowlistSubnets == nil {
+			allowlist.Subnets = current.Subnets
+		}
+		if e.AllowlistPorts == nil {
+			allowlist.Ports = current.Ports
+		}
+		allowlist.Domains = current.Domains
+		// entries kept from the current allowlist still expire
+		for key, expiry := range current.Expiry {
+			if allowlist.Has(key) {
+				if allowlist.Expiry == nil {
+					allowlist.Expiry = map[string]time.Time{}
+				}
+				allowlist.Expiry[key] = expiry
+			}
+		}
+		cfg.AutoConnectData.Allowlist = allowlist
+	}
+	if e.Analytics != nil {
+		cfg.Analytics.Set(*e.Analytics)
+	}
+	if e.Connect != nil {
+		cfg.AutoConnect = *e.Connect
+		if *e.Connect {
+			cfg.AutoConnectData.ServerTag = e.ServerTag
+		}
+	}
+	return cfg
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
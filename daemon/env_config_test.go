@@ -0,0 +1,219 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func lookupEnv(env map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+}
+
+func TestParseEnvConfig(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	enabled := true
+	disabled := false
+	nordlynx := config.Technology_NORDLYNX
+	tcp := config.Protocol_TCP
+
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected EnvConfig
+		hasError bool
+	}{
+		{
+			name: "empty",
+			env:  map[string]string{},
+		},
+		{
+			name: "all variables",
+			env: map[string]string{
+				EnvToken:                "abc123",
+				EnvConnect:              "lt",
+				EnvTechnology:           "NordLynx",
+				EnvProtocol:             "tcp",
+				EnvKillSwitch:           "on",
+				EnvFirewall:             "true",
+				EnvObfuscate:            "off",
+				EnvThreatProtectionLite: "0",
+				EnvDNS:                  "1.1.1.1, 8.8.8.8",
+				EnvAllowlistSubnets:     "192.168.1.0/24",
+				EnvAllowlistPorts:       "22,8080",
+				EnvAnalytics:            "disabled",
+			},
+			expected: EnvConfig{
+				Token:                "abc123",
+				Connect:              &enabled,
+				ServerTag:            "lt",
+				Technology:           &nordlynx,
+				Protocol:             &tcp,
+				KillSwitch:           &enabled,
+				Firewall:             &enabled,
+				Obfuscate:            &disabled,
+				ThreatProtectionLite: &disabled,
+				DNS:                  &config.DNS{"1.1.1.1", "8.8.8.8"},
+				AllowlistSubnets:     []string{"192.168.1.0/24"},
+				AllowlistPorts:       []int64{22, 8080},
+				Analytics:            &disabled,
+			},
+		},
+		{
+			name:     "connect to recommended server",
+			env:      map[string]string{EnvConnect: "on"},
+			expected: EnvConfig{Connect: &enabled},
+		},
+		{
+			name:     "do not connect",
+			env:      map[string]string{EnvConnect: "off"},
+			expected: EnvConfig{Connect: &disabled},
+		},
+		{
+			name:     "dns off",
+			env:      map[string]string{EnvDNS: "off"},
+			expected: EnvConfig{DNS: &config.DNS{}},
+		},
+		{
+			name:     "invalid values are ignored",
+			env:      map[string]string{EnvKillSwitch: "maybe", EnvTechnology: "ikev2", EnvFirewall: "off"},
+			expected: EnvConfig{Firewall: &disabled},
+			hasError: true,
+		},
+		{
+			name:     "invalid token",
+			env:      map[string]string{EnvToken: "not a token"},
+			hasError: true,
+		},
+		{
+			name:     "invalid port",
+			env:      map[string]string{EnvAllowlistPorts: "22,70000"},
+			expected: EnvConfig{AllowlistPorts: []int64{22}},
+			hasError: true,
+		},
+		{
+			name:     "threat protection lite with dns",
+			env:      map[string]string{EnvThreatProtectionLite: "on", EnvDNS: "1.1.1.1"},
+			hasError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			conf, err := ParseEnvConfig(lookupEnv(test.env))
+			if test.hasError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, conf)
+		})
+	}
+}
+
+func TestEnvConfig_Apply(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	current := config.Config{
+		Technology: config.Technology_OPENVPN,
+		Firewall:   true,
+		AutoConnectData: config.AutoConnectData{
+			Protocol:  config.Protocol_UDP,
+			Obfuscate: true,
+			DNS:       config.DNS{"1.1.1.1"},
+			Allowlist: config.NewAllowlist([]int64{53}, []int64{22}, []string{"10.0.0.0/8"}),
+		},
+	}
+
+	tests := []struct {
+		name   string
+		env    map[string]string
+		modify func(*config.Config)
+	}{
+		{
+			name:   "nothing provided",
+			env:    map[string]string{},
+			modify: func(*config.Config) {},
+		},
+		{
+			name: "switching to nordlynx disables obfuscation",
+			env:  map[string]string{EnvTechnology: "nordlynx", EnvKillSwitch: "on"},
+			modify: func(c *config.Config) {
+				c.Technology = config.Technology_NORDLYNX
+				c.AutoConnectData.Obfuscate = false
+				c.KillSwitch = true
+			},
+		},
+		{
+			name: "threat protection lite replaces dns",
+			env:  map[string]string{EnvThreatProtectionLite: "on"},
+			modify: func(c *config.Config) {
+				c.AutoConnectData.ThreatProtectionLite = true
+				c.AutoConnectData.DNS = nil
+			},
+		},
+		{
+			name: "only subnets are replaced",
+			env:  map[string]string{EnvAllowlistSubnets: "192.168.1.0/24"},
+			modify: func(c *config.Config) {
+				c.AutoConnectData.Allowlist.Subnets = config.Subnets{"192.168.1.0/24": true}
+			},
+		},
+		{
+			name: "only ports are replaced",
+			env:  map[string]string{EnvAllowlistPorts: "8080"},
+			modify: func(c *config.Config) {
+				c.AutoConnectData.Allowlist.Ports = config.Ports{
+					TCP: config.PortSet{8080: true},
+					UDP: config.PortSet{8080: true},
+				}
+			},
+		},
+		{
+			name: "connect",
+			env:  map[string]string{EnvConnect: "de"},
+			modify: func(c *config.Config) {
+				c.AutoConnect = true
+				c.AutoConnectData.ServerTag = "de"
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			conf, err := ParseEnvConfig(lookupEnv(test.env))
+			assert.NoError(t, err)
+
+			expected := current
+			expected.AutoConnectData.Allowlist = config.NewAllowlist([]int64{53}, []int64{22}, []string{"10.0.0.0/8"})
+			test.modify(&expected)
+			assert.Equal(t, expected, conf.Apply(current))
+		})
+	}
+}
+
+func TestEnvConfig_ApplyKeepsExpiry(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	expiry := time.Now().Add(time.Hour)
+	current := config.Config{}
+	current.AutoConnectData.Allowlist = config.NewAllowlist([]int64{53}, []int64{22}, []string{"10.0.0.0/8"})
+	current.AutoConnectData.Allowlist.Expiry = map[string]time.Time{
+		config.PortKey("tcp", 22):      expiry,
+		config.SubnetKey("10.0.0.0/8"): expiry,
+	}
+
+	conf, err := ParseEnvConfig(lookupEnv(map[string]string{EnvAllowlistPorts: "8080"}))
+	assert.NoError(t, err)
+
+	// expiry of the replaced port is dropped together with the port
+	allowlist := conf.Apply(current).AutoConnectData.Allowlist
+	assert.Equal(t, map[string]time.Time{config.SubnetKey("10.0.0.0/8"): expiry}, allowlist.Expiry)
+}
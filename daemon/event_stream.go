@@ -0,0 +1,276 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// eventStreamBuffer is the number of events kept for a subscriber which does
+// not receive them fast enough
+const eventStreamBuffer = 64
+
+// ErrEventSubscriberTooSlow is returned to the subscriber which was dropped
+// because its buffer was full
+var ErrEventSubscriberTooSlow = errors.New("events were not received fast enough")
+
+// EventStream forwards the daemon and meshnet events to the clients of
+// SubscribeEvents. Events are published synchronously by the subjects, so they
+// are never blocked by the clients. Thread safe.
+type EventStream struct {
+	mu          sync.Mutex
+	subscribers map[chan *pb.Event]struct{}
+	now         func() time.Time
+}
+
+// NewEventStream creates an event stream without subscribers
+func NewEventStream() *EventStream {
+	return &EventStream{
+		subscribers: map[chan *pb.Event]struct{}{},
+		now:         time.Now,
+	}
+}
+
+// subscribe returns the channel receiving the events and the function which
+// unsubscribes. The channel is closed if the subscriber is too slow.
+func (s *EventStream) subscribe() (<-chan *pb.Event, func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ch := make(chan *pb.Event, eventStreamBuffer)
+	s.subscribers[ch] = struct{}{}
+	return ch, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.subscribers[ch]; ok {
+			delete(s.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+func (s *EventStream) publish(event *pb.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	event.Time = s.now().Unix()
+	for ch := range s.subscribers {
+		select {
+		case ch <- event:
+		default:
+			log.Println(internal.WarningPrefix, "dropping events subscriber:", ErrEventSubscriberTooSlow)
+			delete(s.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+func (s *EventStream) publishConnection(event *pb.ConnectionEvent) error {
+	s.publish(&pb.Event{Event: &pb.Event_Connection{Connection: event}})
+	return nil
+}
+
+func (s *EventStream) publishSetting(name string, value string) error {
+	s.publish(&pb.Event{Event: &pb.Event_Setting{Setting: &pb.SettingEvent{Name: name, Value: value}}})
+	return nil
+}
+
+func (s *EventStream) publishMeshnet(event *pb.MeshnetEvent) error {
+	s.publish(&pb.Event{Event: &pb.Event_Meshnet{Meshnet: event}})
+	return nil
+}
+
+func (s *EventStream) publishAccount(eventType pb.AccountEventType) error {
+	s.publish(&pb.Event{Event: &pb.Event_Account{Account: &pb.AccountEvent{Type: eventType}}})
+	return nil
+}
+
+func (s *EventStream) NotifyKillswitch(data bool) error {
+	return s.publishSetting("killswitch", strconv.FormatBool(data))
+}
+
+func (s *EventStream) NotifyAutoconnect(data bool) error {
+	return s.publishSetting("autoconnect", strconv.FormatBool(data))
+}
+
+func (s *EventStream) NotifyDNS(data events.DataDNS) error {
+	return s.publishSetting("dns", strings.Join(data.Ips, ","))
+}
+
+func (s *EventStream) NotifyThreatProtectionLite(data bool) error {
+	return s.publishSetting("threatprotectionlite", strconv.FormatBool(data))
+}
+
+func (s *EventStream) NotifyProtocol(data config.Protocol) error {
+	return s.publishSetting("protocol", data.String())
+}
+
+func (s *EventStream) NotifyAllowlist(data events.DataAllowlist) error {
+	allowlist := append([]string{}, data.Subnets...)
+	for _, port := range data.TCPPorts {
+		allowlist = append(allowlist, fmt.Sprintf("%d/tcp", port))
+	}
+	for _, port := range data.UDPPorts {
+		allowlist = append(allowlist, fmt.Sprintf("%d/udp", port))
+	}
+	return s.publishSetting("allowlist", strings.Join(allowlist, ","))
+}
+
+func (s *EventStream) NotifyTechnology(data config.Technology) error {
+	return s.publishSetting("technology", data.String())
+}
+
+func (s *EventStream) NotifyObfuscate(data bool) error {
+	return s.publishSetting("obfuscate", strconv.FormatBool(data))
+}
+
+func (s *EventStream) NotifyFirewall(data bool) error {
+	return s.publishSetting("firewall", strconv.FormatBool(data))
+}
+
+func (s *EventStream) NotifyRouting(data bool) error {
+	return s.publishSetting("routing", strconv.FormatBool(data))
+}
+
+func (s *EventStream) NotifyNotify(data bool) error {
+	return s.publishSetting("notify", strconv.FormatBool(data))
+}
+
+func (s *EventStream) NotifyMeshnet(data bool) error {
+	return s.publishSetting("meshnet", strconv.FormatBool(data))
+}
+
+func (s *EventStream) NotifyIpv6(data bool) error {
+	return s.publishSetting("ipv6", strconv.FormatBool(data))
+}
+
+func (s *EventStream) NotifyDefaults(any) error {
+	return s.publishSetting("defaults", "")
+}
+
+func (s *EventStream) NotifyConnect(data events.DataConnect) error {
+	// meshnet publishes only the successful connections to the peers, without
+	// the details
+	if data.IsMeshnetPeer {
+		return s.publishConnection(&pb.ConnectionEvent{Type: pb.ConnectionEventType_CONNECTED})
+	}
+	event := &pb.ConnectionEvent{
+		Hostname:   data.TargetServerDomain,
+		Country:    data.TargetServerCountry,
+		City:       data.TargetServerCity,
+		Technology: data.Technology,
+		Protocol:   data.Protocol,
+	}
+	switch data.Type {
+	case events.ConnectAttempt:
+		event.Type = pb.ConnectionEventType_CONNECTING
+	case events.ConnectSuccess:
+		event.Type = pb.ConnectionEventType_CONNECTED
+	case events.ConnectFailure:
+		event.Type = pb.ConnectionEventType_CONNECT_FAILED
+	}
+	return s.publishConnection(event)
+}
+
+func (s *EventStream) NotifyDisconnect(data events.DataDisconnect) error {
+	event := &pb.ConnectionEvent{
+		Technology: data.Technology,
+		Protocol:   data.Protocol,
+	}
+	switch data.Type {
+	case events.DisconnectAttempt:
+		event.Type = pb.ConnectionEventType_DISCONNECTING
+	case events.DisconnectSuccess:
+		event.Type = pb.ConnectionEventType_DISCONNECTED
+	case events.DisconnectFailure:
+		event.Type = pb.ConnectionEventType_DISCONNECT_FAILED
+	}
+	return s.publishConnection(event)
+}
+
+func (s *EventStream) NotifyLogin(any) error {
+	return s.publishAccount(pb.AccountEventType_LOGGED_IN)
+}
+
+func (s *EventStream) NotifyLogout(any) error {
+	return s.publishAccount(pb.AccountEventType_LOGGED_OUT)
+}
+
+func (s *EventStream) NotifyAccountCheck(core.ServicesResponse) error { return nil }
+
+func (s *EventStream) NotifyRate(events.ServerRating) error { return nil }
+
+func (s *EventStream) NotifyHeartBeat(int) error { return nil }
+
+func (s *EventStream) NotifyPeerUpdate(peers []string) error {
+	return s.publishMeshnet(&pb.MeshnetEvent{Type: pb.MeshnetEventType_PEERS_UPDATED, Peers: peers})
+}
+
+func (s *EventStream) NotifySelfRemoved(any) error {
+	return s.publishMeshnet(&pb.MeshnetEvent{Type: pb.MeshnetEventType_SELF_REMOVED})
+}
+
+func (s *EventStream) NotifyExitNodeRecovery(data events.DataExitNodeRecovery) error {
+	event := &pb.MeshnetEvent{ExitNode: data.Peer}
+	switch data.Type {
+	case events.ExitNodeDropped:
+		event.Type = pb.MeshnetEventType_EXIT_NODE_DROPPED
+	case events.ExitNodeRecovering:
+		event.Type = pb.MeshnetEventType_EXIT_NODE_RECOVERING
+	case events.ExitNodeRecovered:
+		event.Type = pb.MeshnetEventType_EXIT_NODE_RECOVERED
+	case events.ExitNodeRecoveryFailure:
+		event.Type = pb.MeshnetEventType_EXIT_NODE_RECOVERY_FAILED
+	}
+	return s.publishMeshnet(event)
+}
+
+func (s *EventStream) NotifyDNSOverwrite(data events.DataDNSOverwrite) error {
+	event := &pb.DNSEvent{Nameservers: data.Nameservers}
+	switch data.Type {
+	case events.DNSRestored:
+		event.Type = pb.DNSEventType_DNS_RESTORED
+	case events.DNSRestoreFailure:
+		event.Type = pb.DNSEventType_DNS_RESTORE_FAILED
+	}
+	s.publish(&pb.Event{Event: &pb.Event_Dns{Dns: event}})
+	return nil
+}
+
+func (s *EventStream) NotifyObfuscationFallback(data events.DataObfuscationFallback) error {
+	event := &pb.ObfuscationFallbackEvent{Hostname: data.Hostname, Network: data.Network}
+	switch data.Type {
+	case events.ObfuscationFallbackHandshake:
+		event.Reason = pb.ObfuscationFallbackReason_HANDSHAKE_FAILURE
+	case events.ObfuscationFallbackThroughput:
+		event.Reason = pb.ObfuscationFallbackReason_THROUGHPUT_COLLAPSE
+	}
+	s.publish(&pb.Event{Event: &pb.Event_ObfuscationFallback{ObfuscationFallback: event}})
+	return nil
+}
+
+func (s *EventStream) NotifyServerFailover(data events.DataServerFailover) error {
+	event := &pb.ServerFailoverEvent{Hostname: data.Hostname, Target: data.Target}
+	switch data.Type {
+	case events.ServerFailoverHandshake:
+		event.Reason = pb.ServerFailoverReason_HANDSHAKE_TIMEOUT
+	case events.ServerFailoverOffline:
+		event.Reason = pb.ServerFailoverReason_SERVER_OFFLINE
+	case events.ServerFailoverMaintenance:
+		event.Reason = pb.ServerFailoverReason_SERVER_MAINTENANCE
+	case events.ServerFailoverWithdrawn:
+		event.Reason = pb.ServerFailoverReason_SERVER_WITHDRAWN
+	}
+	s.publish(&pb.Event{Event: &pb.Event_ServerFailover{ServerFailover: event}})
+	return nil
+}
@@ -0,0 +1,204 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestEventStream_Publish(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Unix(1700000000, 0)
+	tests := []struct {
+		name    string
+		publish func(*EventStream) error
+		event   *pb.Event
+	}{
+		{
+			name: "connected",
+			publish: func(s *EventStream) error {
+				return s.NotifyConnect(events.DataConnect{
+					Type:                events.ConnectSuccess,
+					TargetServerDomain:  "de123.nordvpn.com",
+					TargetServerCountry: "Germany",
+					TargetServerCity:    "Berlin",
+					Technology:          config.Technology_NORDLYNX,
+				})
+			},
+			event: &pb.Event{Event: &pb.Event_Connection{Connection: &pb.ConnectionEvent{
+				Type:       pb.ConnectionEventType_CONNECTED,
+				Hostname:   "de123.nordvpn.com",
+				Country:    "Germany",
+				City:       "Berlin",
+				Technology: config.Technology_NORDLYNX,
+			}}},
+		},
+		{
+			name: "connected to meshnet peer",
+			publish: func(s *EventStream) error {
+				return s.NotifyConnect(events.DataConnect{IsMeshnetPeer: true})
+			},
+			event: &pb.Event{Event: &pb.Event_Connection{Connection: &pb.ConnectionEvent{
+				Type: pb.ConnectionEventType_CONNECTED,
+			}}},
+		},
+		{
+			name: "disconnecting",
+			publish: func(s *EventStream) error {
+				return s.NotifyDisconnect(events.DataDisconnect{Type: events.DisconnectAttempt})
+			},
+			event: &pb.Event{Event: &pb.Event_Connection{Connection: &pb.ConnectionEvent{
+				Type: pb.ConnectionEventType_DISCONNECTING,
+			}}},
+		},
+		{
+			name: "allowlist changed",
+			publish: func(s *EventStream) error {
+				return s.NotifyAllowlist(events.DataAllowlist{
+					Subnets:  []string{"192.168.0.0/16"},
+					TCPPorts: []int64{22},
+					UDPPorts: []int64{53},
+				})
+			},
+			event: &pb.Event{Event: &pb.Event_Setting{Setting: &pb.SettingEvent{
+				Name:  "allowlist",
+				Value: "192.168.0.0/16,22/tcp,53/udp",
+			}}},
+		},
+		{
+			name:    "killswitch enabled",
+			publish: func(s *EventStream) error { return s.NotifyKillswitch(true) },
+			event: &pb.Event{Event: &pb.Event_Setting{Setting: &pb.SettingEvent{
+				Name:  "killswitch",
+				Value: "true",
+			}}},
+		},
+		{
+			name:    "peers updated",
+			publish: func(s *EventStream) error { return s.NotifyPeerUpdate([]string{"machine"}) },
+			event: &pb.Event{Event: &pb.Event_Meshnet{Meshnet: &pb.MeshnetEvent{
+				Type:  pb.MeshnetEventType_PEERS_UPDATED,
+				Peers: []string{"machine"},
+			}}},
+		},
+		{
+			name: "exit node dropped",
+			publish: func(s *EventStream) error {
+				return s.NotifyExitNodeRecovery(events.DataExitNodeRecovery{Type: events.ExitNodeDropped, Peer: "laptop"})
+			},
+			event: &pb.Event{Event: &pb.Event_Meshnet{Meshnet: &pb.MeshnetEvent{
+				Type:     pb.MeshnetEventType_EXIT_NODE_DROPPED,
+				ExitNode: "laptop",
+			}}},
+		},
+		{
+			name: "dns restore failed",
+			publish: func(s *EventStream) error {
+				return s.NotifyDNSOverwrite(events.DataDNSOverwrite{
+					Type:        events.DNSRestoreFailure,
+					Nameservers: []string{"103.86.96.100"},
+				})
+			},
+			event: &pb.Event{Event: &pb.Event_Dns{Dns: &pb.DNSEvent{
+				Type:        pb.DNSEventType_DNS_RESTORE_FAILED,
+				Nameservers: []string{"103.86.96.100"},
+			}}},
+		},
+		{
+			name: "obfuscation fallback",
+			publish: func(s *EventStream) error {
+				return s.NotifyObfuscationFallback(events.DataObfuscationFallback{
+					Type:     events.ObfuscationFallbackThroughput,
+					Hostname: "de1.nordvpn.com",
+					Network:  "Hotel WiFi",
+				})
+			},
+			event: &pb.Event{Event: &pb.Event_ObfuscationFallback{ObfuscationFallback: &pb.ObfuscationFallbackEvent{
+				Reason:   pb.ObfuscationFallbackReason_THROUGHPUT_COLLAPSE,
+				Hostname: "de1.nordvpn.com",
+				Network:  "Hotel WiFi",
+			}}},
+		},
+		{
+			name: "server failover",
+			publish: func(s *EventStream) error {
+				return s.NotifyServerFailover(events.DataServerFailover{
+					Type:     events.ServerFailoverMaintenance,
+					Hostname: "de1.nordvpn.com",
+					Target:   "de2.nordvpn.com",
+				})
+			},
+			event: &pb.Event{Event: &pb.Event_ServerFailover{ServerFailover: &pb.ServerFailoverEvent{
+				Reason:   pb.ServerFailoverReason_SERVER_MAINTENANCE,
+				Hostname: "de1.nordvpn.com",
+				Target:   "de2.nordvpn.com",
+			}}},
+		},
+		{
+			name:    "logged out",
+			publish: func(s *EventStream) error { return s.NotifyLogout(nil) },
+			event: &pb.Event{Event: &pb.Event_Account{Account: &pb.AccountEvent{
+				Type: pb.AccountEventType_LOGGED_OUT,
+			}}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stream := NewEventStream()
+			stream.now = func() time.Time { return now }
+			eventsCh, unsubscribe := stream.subscribe()
+			defer unsubscribe()
+
+			require.NoError(t, test.publish(stream))
+			test.event.Time = now.Unix()
+			assert.True(t, proto.Equal(test.event, <-eventsCh))
+		})
+	}
+}
+
+func TestEventStream_DropsSlowSubscriber(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	stream := NewEventStream()
+	slow, unsubscribeSlow := stream.subscribe()
+	defer unsubscribeSlow()
+	fast, unsubscribeFast := stream.subscribe()
+	defer unsubscribeFast()
+
+	for i := 0; i <= eventStreamBuffer; i++ {
+		assert.NoError(t, stream.NotifyLogin(nil))
+		<-fast
+	}
+
+	received := 0
+	for range slow {
+		received++
+	}
+	assert.Equal(t, eventStreamBuffer, received)
+	assert.Len(t, stream.subscribers, 1)
+}
+
+func TestEventStream_Unsubscribe(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	stream := NewEventStream()
+	eventsCh, unsubscribe := stream.subscribe()
+	unsubscribe()
+	// unsubscribing twice does not close the channel twice
+	unsubscribe()
+
+	assert.NoError(t, stream.NotifyLogin(nil))
+	_, ok := <-eventsCh
+	assert.False(t, ok)
+	assert.Empty(t, stream.subscribers)
+}
@@ -30,6 +30,7 @@ func NewEvents(
 	connect events.PublishSubcriber[events.DataConnect],
 	disconnect events.PublishSubcriber[events.DataDisconnect],
 	login events.PublishSubcriber[any],
+	logout events.PublishSubcriber[any],
 	accountCheck events.PublishSubcriber[core.ServicesResponse],
 	rate events.PublishSubcriber[events.ServerRating],
 	heartBeat events.PublishSubcriber[int],
@@ -55,6 +56,7 @@ func NewEvents(
 			Connect:      connect,
 			Disconnect:   disconnect,
 			Login:        login,
+			Logout:       logout,
 			AccountCheck: accountCheck,
 			Rate:         rate,
 			HeartBeat:    heartBeat,
@@ -127,6 +129,7 @@ type ServicePublisher interface {
 	NotifyConnect(events.DataConnect) error
 	NotifyDisconnect(events.DataDisconnect) error
 	NotifyLogin(any) error
+	NotifyLogout(any) error
 	NotifyAccountCheck(core.ServicesResponse) error
 	NotifyRate(events.ServerRating) error
 	NotifyHeartBeat(int) error
@@ -136,6 +139,7 @@ type ServiceEvents struct {
 	Connect      events.PublishSubcriber[events.DataConnect]
 	Disconnect   events.PublishSubcriber[events.DataDisconnect]
 	Login        events.PublishSubcriber[any]
+	Logout       events.PublishSubcriber[any]
 	AccountCheck events.PublishSubcriber[core.ServicesResponse]
 	Rate         events.PublishSubcriber[events.ServerRating]
 	HeartBeat    events.PublishSubcriber[int]
@@ -145,6 +149,7 @@ func (s *ServiceEvents) Subscribe(to ServicePublisher) {
 	s.Connect.Subscribe(to.NotifyConnect)
 	s.Disconnect.Subscribe(to.NotifyDisconnect)
 	s.Login.Subscribe(to.NotifyLogin)
+	s.Logout.Subscribe(to.NotifyLogout)
 	s.AccountCheck.Subscribe(to.NotifyAccountCheck)
 	s.Rate.Subscribe(to.NotifyRate)
 	s.HeartBeat.Subscribe(to.NotifyHeartBeat)
@@ -164,7 +169,7 @@ func (s *SettingsEvents) Publish(cfg config.Config) {
 		Subnets:  cfg.AutoConnectData.Allowlist.Subnets.ToSlice(),
 	})
 	s.Meshnet.Publish(cfg.Mesh)
-	s.Ipv6.Publish(cfg.IPv6)
+	s.Ipv6.Publish(cfg.GetIPv6Mode() == config.IPv6Tunnel)
 	s.Technology.Publish(cfg.Technology)
 	s.Obfuscate.Publish(cfg.AutoConnectData.Obfuscate)
 	s.Notify.Publish(cfg.UsersData.Notify != nil && len(cfg.UsersData.Notify) > 0)
@@ -35,6 +35,7 @@ func TestNewDaemonSubjects(t *testing.T) {
 		&subs.Subject[events.DataConnect]{},
 		&subs.Subject[events.DataDisconnect]{},
 		&subs.Subject[any]{},
+		&subs.Subject[any]{},
 		&subs.Subject[core.ServicesResponse]{},
 		&subs.Subject[events.ServerRating]{},
 		&subs.Subject[int]{},
@@ -63,6 +64,7 @@ func TestDaemonSubjectsSubscribe(t *testing.T) {
 		&subs.Subject[events.DataConnect]{},
 		&subs.Subject[events.DataDisconnect]{},
 		&subs.Subject[any]{},
+		&subs.Subject[any]{},
 		&subs.Subject[core.ServicesResponse]{},
 		&subs.Subject[events.ServerRating]{},
 		&subs.Subject[int]{},
@@ -84,6 +86,7 @@ func (mockDaemonSubscriber) NotifyTechnology(config.Technology) error       { re
 func (mockDaemonSubscriber) NotifyConnect(events.DataConnect) error         { return nil }
 func (mockDaemonSubscriber) NotifyDisconnect(events.DataDisconnect) error   { return nil }
 func (mockDaemonSubscriber) NotifyLogin(any) error                          { return nil }
+func (mockDaemonSubscriber) NotifyLogout(any) error                         { return nil }
 func (mockDaemonSubscriber) NotifyAccountCheck(core.ServicesResponse) error { return nil }
 func (mockDaemonSubscriber) NotifyObfuscate(bool) error                     { return nil }
 func (mockDaemonSubscriber) NotifyNotify(bool) error                        { return nil }
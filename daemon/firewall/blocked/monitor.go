@@ -0,0 +1,181 @@
+/*
+Package blocked keeps summaries of the traffic dropped by the firewall rules with
+logging enabled.
+*/
+package blocked
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+)
+
+const (
+	kmsgPath = "/dev/kmsg"
+	// maxSummaries bounds the amount of the remembered destinations, the least
+	// recently blocked ones are forgotten first
+	maxSummaries = 50
+	// kmsg returns a single record per read, records are at most this long
+	maxRecordSize = 8192
+)
+
+// Summary of the packets blocked to or from a single remote address
+type Summary struct {
+	// Direction is either firewall.Inbound or firewall.Outbound
+	Direction firewall.Direction
+	Protocol  string
+	// Address is the remote address, destination of the outbound packets and the
+	// source of the inbound ones
+	Address netip.Addr
+	// Port is the destination port, 0 if the protocol has no ports
+	Port     uint16
+	Count    uint64
+	LastSeen time.Time
+}
+
+func (s Summary) sameTraffic(other Summary) bool {
+	return s.Direction == other.Direction &&
+		s.Protocol == other.Protocol &&
+		s.Address == other.Address &&
+		s.Port == other.Port
+}
+
+// Lister lists the recently blocked traffic
+type Lister interface {
+	Recent() []Summary
+}
+
+// Monitor reads the kernel log entries of the dropped packets
+//
+// Thread-safe.
+type Monitor struct {
+	summaries []Summary
+	now       func() time.Time
+	mu        sync.Mutex
+}
+
+// NewMonitor is a default constructor for Monitor
+func NewMonitor() *Monitor {
+	return &Monitor{now: time.Now}
+}
+
+// Run reads the new kernel log records until reading fails
+func (m *Monitor) Run() error {
+	// #nosec G304 -- path is a constant
+	file, err := os.Open(kmsgPath)
+	if err != nil {
+		return fmt.Errorf("opening kernel log: %w", err)
+	}
+	defer file.Close()
+
+	// only the records written after the start are of interest
+	if _, err := file.Seek(0, io.SeekEnd); err != nil {
+		return fmt.Errorf("seeking kernel log: %w", err)
+	}
+
+	buf := make([]byte, maxRecordSize)
+	for {
+		n, err := file.Read(buf)
+		if err != nil {
+			// records were overwritten before they were read, reading continues
+			// from the next available one
+			if errors.Is(err, syscall.EPIPE) {
+				continue
+			}
+			return fmt.Errorf("reading kernel log: %w", err)
+		}
+		if summary, ok := parseRecord(string(buf[:n])); ok {
+			m.add(summary)
+		}
+	}
+}
+
+func (m *Monitor) add(summary Summary) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	for i := range m.summaries {
+		if m.summaries[i].sameTraffic(summary) {
+			m.summaries[i].Count++
+			m.summaries[i].LastSeen = now
+			return
+		}
+	}
+
+	summary.Count = 1
+	summary.LastSeen = now
+	m.summaries = append(m.summaries, summary)
+	if len(m.summaries) > maxSummaries {
+		oldest := 0
+		for i, s := range m.summaries {
+			if s.LastSeen.Before(m.summaries[oldest].LastSeen) {
+				oldest = i
+			}
+		}
+		m.summaries = append(m.summaries[:oldest], m.summaries[oldest+1:]...)
+	}
+}
+
+// Recent returns the summaries of the blocked traffic, the most recently blocked
+// first
+func (m *Monitor) Recent() []Summary {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	summaries := make([]Summary, len(m.summaries))
+	copy(summaries, m.summaries)
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return summaries[i].LastSeen.After(summaries[j].LastSeen)
+	})
+	return summaries
+}
+
+// parseRecord parses the kernel log record written by the LOG target, e.g.
+// "4,1021,5183012,-;nordvpn-denied:IN= OUT=eth0 SRC=192.168.1.2 DST=1.1.1.1 ... PROTO=UDP SPT=41234 DPT=53 LEN=40"
+func parseRecord(record string) (Summary, bool) {
+	_, entry, found := strings.Cut(record, ";"+firewall.LogPrefix)
+	if !found {
+		return Summary{}, false
+	}
+
+	fields := map[string]string{}
+	// message is followed by the key value dictionary on the separate lines
+	entry, _, _ = strings.Cut(entry, "\n")
+	for _, field := range strings.Fields(entry) {
+		if key, value, ok := strings.Cut(field, "="); ok {
+			fields[key] = value
+		}
+	}
+
+	summary := Summary{
+		Direction: firewall.Outbound,
+		Protocol:  fields["PROTO"],
+	}
+	address := fields["DST"]
+	if fields["IN"] != "" {
+		summary.Direction = firewall.Inbound
+		address = fields["SRC"]
+	}
+
+	addr, err := netip.ParseAddr(address)
+	if err != nil || summary.Protocol == "" {
+		return Summary{}, false
+	}
+	summary.Address = addr
+
+	if port, err := strconv.ParseUint(fields["DPT"], 10, 16); err == nil {
+		summary.Port = uint16(port)
+	}
+	return summary, true
+}
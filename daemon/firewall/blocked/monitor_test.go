@@ -0,0 +1,126 @@
+package blocked
+
+import (
+	"fmt"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRecord(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		record   string
+		expected Summary
+		ok       bool
+	}{
+		{
+			name: "outbound udp",
+			record: "4,1021,5183012,-;nordvpn-denied:IN= OUT=eth0 SRC=192.168.1.2 DST=1.1.1.1 LEN=61 TOS=0x00 " +
+				"PREC=0x00 TTL=64 ID=1234 DF PROTO=UDP SPT=41234 DPT=53 LEN=41 \n SUBSYSTEM=net\n",
+			expected: Summary{
+				Direction: firewall.Outbound,
+				Protocol:  "UDP",
+				Address:   netip.MustParseAddr("1.1.1.1"),
+				Port:      53,
+			},
+			ok: true,
+		},
+		{
+			name: "inbound tcp",
+			record: "4,1022,5183013,-;nordvpn-denied:IN=wlan0 OUT= MAC=00 SRC=192.168.1.7 DST=192.168.1.2 " +
+				"LEN=60 PROTO=TCP SPT=51000 DPT=22 WINDOW=64240 RES=0x00 SYN URGP=0",
+			expected: Summary{
+				Direction: firewall.Inbound,
+				Protocol:  "TCP",
+				Address:   netip.MustParseAddr("192.168.1.7"),
+				Port:      22,
+			},
+			ok: true,
+		},
+		{
+			name:   "ipv6 icmp",
+			record: "4,1023,5183014,-;nordvpn-denied:IN= OUT=eth0 SRC=2001:db8::2 DST=2001:db8::1 LEN=104 PROTO=ICMPv6 TYPE=128 CODE=0",
+			expected: Summary{
+				Direction: firewall.Outbound,
+				Protocol:  "ICMPv6",
+				Address:   netip.MustParseAddr("2001:db8::1"),
+			},
+			ok: true,
+		},
+		{
+			name:   "other record",
+			record: "6,1024,5183015,-;wlan0: associated",
+		},
+		{
+			name:   "prefix in the middle of the message",
+			record: "6,1025,5183016,-;something nordvpn-denied:IN= OUT=eth0 DST=1.1.1.1 PROTO=UDP",
+		},
+		{
+			name:   "no address",
+			record: "4,1026,5183017,-;nordvpn-denied:IN= OUT=eth0 PROTO=UDP",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			summary, ok := parseRecord(test.record)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, summary)
+		})
+	}
+}
+
+func TestMonitor_Recent(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	now := start
+	monitor := Monitor{now: func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}}
+
+	dns := Summary{Protocol: "UDP", Address: netip.MustParseAddr("1.1.1.1"), Port: 53}
+	https := Summary{Protocol: "TCP", Address: netip.MustParseAddr("1.1.1.1"), Port: 443}
+	monitor.add(dns)
+	monitor.add(https)
+	monitor.add(dns)
+
+	recent := monitor.Recent()
+	assert.Len(t, recent, 2)
+	assert.Equal(t, uint64(2), recent[0].Count)
+	assert.Equal(t, uint16(53), recent[0].Port)
+	assert.Equal(t, start.Add(3*time.Second), recent[0].LastSeen)
+	assert.Equal(t, uint64(1), recent[1].Count)
+	assert.Equal(t, uint16(443), recent[1].Port)
+}
+
+func TestMonitor_RecentIsBounded(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Now()
+	monitor := Monitor{now: func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}}
+
+	for i := 0; i < maxSummaries+10; i++ {
+		monitor.add(Summary{
+			Protocol: "UDP",
+			Address:  netip.MustParseAddr(fmt.Sprintf("10.0.0.%d", i)),
+		})
+	}
+
+	recent := monitor.Recent()
+	assert.Len(t, recent, maxSummaries)
+	// the oldest ones are forgotten
+	assert.Equal(t, netip.MustParseAddr(fmt.Sprintf("10.0.0.%d", maxSummaries+9)), recent[0].Address)
+	assert.Equal(t, netip.MustParseAddr("10.0.0.10"), recent[maxSummaries-1].Address)
+}
@@ -0,0 +1,188 @@
+// Package boot keeps the kill switch in place from the boot until the daemon
+// starts, so that nothing leaks before the daemon applies its own rules.
+//
+// The rules are kept as an nftables ruleset file, which is loaded by the
+// nordvpn-killswitch.service unit before the network is configured. The daemon
+// removes the loaded table once it has set the kill switch itself.
+package boot
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	nft "github.com/google/nftables"
+)
+
+const (
+	// RulesetPath is the ruleset file loaded by nordvpn-killswitch.service
+	RulesetPath = internal.AppDataPath + "killswitch.nft"
+	// tableName is separate from the table of the nftables firewall backend, so
+	// that the boot rules are removed without touching the daemon rules
+	tableName = "nordvpn_boot"
+)
+
+// lanNetworks are the private (RFC1918) and link-local networks, the same ones
+// which the kill switch allows when the LAN is allowed
+var lanNetworks = []netip.Prefix{
+	netip.MustParsePrefix("10.0.0.0/8"),
+	netip.MustParsePrefix("172.16.0.0/12"),
+	netip.MustParsePrefix("192.168.0.0/16"),
+	netip.MustParsePrefix("169.254.0.0/16"),
+	netip.MustParsePrefix("fe80::/10"),
+}
+
+// Ruleset is the traffic allowed at boot, everything else is dropped
+type Ruleset struct {
+	Subnets  []netip.Prefix
+	TCPPorts []int64
+	UDPPorts []int64
+	// LAN allows the private and link-local networks
+	LAN bool
+}
+
+// String renders the ruleset in the nft -f format. Loading it replaces the
+// table left from the previous boot instead of failing.
+func (r Ruleset) String() string {
+	var b strings.Builder
+	b.WriteString("# Generated by nordvpnd, do not edit.\n")
+	b.WriteString("# Loaded at boot while the kill switch is enabled, removed once nordvpnd starts.\n")
+	fmt.Fprintf(&b, "table inet %s\n", tableName)
+	fmt.Fprintf(&b, "delete table inet %s\n", tableName)
+	fmt.Fprintf(&b, "table inet %s {\n", tableName)
+	r.writeChain(&b, "input", "iif", "saddr", "sport", "dport")
+	r.writeChain(&b, "output", "oif", "daddr", "dport", "sport")
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func (r Ruleset) writeChain(b *strings.Builder, name, iface, remoteAddr, remotePort, localPort string) {
+	fmt.Fprintf(b, "\tchain %s {\n", name)
+	fmt.Fprintf(b, "\t\ttype filter hook %s priority 0; policy drop;\n", name)
+	fmt.Fprintf(b, "\t\t%s \"lo\" accept\n", iface)
+	// addresses are not configured yet at boot, DHCP and neighbor discovery
+	// have to pass for the daemon to reach anything once it starts
+	fmt.Fprintf(b, "\t\tudp %s { 67, 68, 546, 547 } udp %s { 67, 68, 546, 547 } accept\n", remotePort, localPort)
+	b.WriteString("\t\ticmpv6 type { nd-router-solicit, nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert } accept\n")
+
+	subnets := r.Subnets
+	if r.LAN {
+		subnets = append(append([]netip.Prefix{}, subnets...), lanNetworks...)
+	}
+	var v4, v6 []string
+	for _, subnet := range subnets {
+		if subnet.Addr().Is4() {
+			v4 = append(v4, subnet.Masked().String())
+		} else {
+			v6 = append(v6, subnet.Masked().String())
+		}
+	}
+	if len(v4) > 0 {
+		fmt.Fprintf(b, "\t\tip %s { %s } accept\n", remoteAddr, strings.Join(v4, ", "))
+	}
+	if len(v6) > 0 {
+		fmt.Fprintf(b, "\t\tip6 %s { %s } accept\n", remoteAddr, strings.Join(v6, ", "))
+	}
+
+	// allowlisted ports are allowed in both directions, the same as by the kill
+	// switch, e.g. for SSH to the machine and from it
+	for _, proto := range []struct {
+		name  string
+		ports []int64
+	}{{"tcp", r.TCPPorts}, {"udp", r.UDPPorts}} {
+		if len(proto.ports) == 0 {
+			continue
+		}
+		ports := joinPorts(proto.ports)
+		fmt.Fprintf(b, "\t\t%s %s { %s } accept\n", proto.name, localPort, ports)
+		fmt.Fprintf(b, "\t\t%s %s { %s } accept\n", proto.name, remotePort, ports)
+	}
+	b.WriteString("\t}\n")
+}
+
+func joinPorts(ports []int64) string {
+	sorted := append([]int64{}, ports...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	var ret []string
+	for _, port := range sorted {
+		ret = append(ret, fmt.Sprint(port))
+	}
+	return strings.Join(ret, ", ")
+}
+
+// conn is the subset of nftables.Conn used to remove the loaded rules
+type conn interface {
+	ListTablesOfFamily(nft.TableFamily) ([]*nft.Table, error)
+	DelTable(*nft.Table)
+	Flush() error
+}
+
+// KillSwitch manages the rules applied at boot
+type KillSwitch struct {
+	path string
+	conn func() (conn, error)
+}
+
+// NewKillSwitch is a default constructor for KillSwitch
+func NewKillSwitch() *KillSwitch {
+	return &KillSwitch{
+		path: RulesetPath,
+		conn: func() (conn, error) { return nft.New() },
+	}
+}
+
+// Set makes the ruleset load at the next boot, nil ruleset disables the kill
+// switch at boot and releases the rules loaded at the last one
+func (k *KillSwitch) Set(ruleset *Ruleset) error {
+	if ruleset == nil {
+		if err := k.Release(); err != nil {
+			return err
+		}
+		if err := os.Remove(k.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("removing boot kill switch ruleset: %w", err)
+		}
+		return nil
+	}
+
+	// ruleset is replaced at once, so that a crash never leaves a partial file
+	tmp := filepath.Join(filepath.Dir(k.path), "."+filepath.Base(k.path))
+	if err := os.WriteFile(tmp, []byte(ruleset.String()), internal.PermUserRW); err != nil {
+		return fmt.Errorf("writing boot kill switch ruleset: %w", err)
+	}
+	if err := os.Rename(tmp, k.path); err != nil {
+		return fmt.Errorf("replacing boot kill switch ruleset: %w", err)
+	}
+	return nil
+}
+
+// Release removes the rules loaded at boot. It has to be called only after the
+// daemon has set its own kill switch. Nothing is loaded at boot without the
+// ruleset file, so nftables is not touched then.
+func (k *KillSwitch) Release() error {
+	if _, err := os.Stat(k.path); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	c, err := k.conn()
+	if err != nil {
+		return fmt.Errorf("opening nftables connection: %w", err)
+	}
+	tables, err := c.ListTablesOfFamily(nft.TableFamilyINet)
+	if err != nil {
+		return fmt.Errorf("listing nftables tables: %w", err)
+	}
+	for _, table := range tables {
+		if table.Name != tableName {
+			continue
+		}
+		c.DelTable(table)
+		if err := c.Flush(); err != nil {
+			return fmt.Errorf("deleting nftables table %s: %w", tableName, err)
+		}
+	}
+	return nil
+}
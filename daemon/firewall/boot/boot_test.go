@@ -0,0 +1,108 @@
+package boot
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	nft "github.com/google/nftables"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleset_String(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	ruleset := Ruleset{
+		Subnets: []netip.Prefix{
+			netip.MustParsePrefix("192.0.2.1/24"),
+			netip.MustParsePrefix("2001:db8::/32"),
+		},
+		TCPPorts: []int64{443, 22},
+	}
+	expected := `# Generated by nordvpnd, do not edit.
+# Loaded at boot while the kill switch is enabled, removed once nordvpnd starts.
+table inet nordvpn_boot
+delete table inet nordvpn_boot
+table inet nordvpn_boot {
+	chain input {
+		type filter hook input priority 0; policy drop;
+		iif "lo" accept
+		udp sport { 67, 68, 546, 547 } udp dport { 67, 68, 546, 547 } accept
+		icmpv6 type { nd-router-solicit, nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert } accept
+		ip saddr { 192.0.2.0/24 } accept
+		ip6 saddr { 2001:db8::/32 } accept
+		tcp dport { 22, 443 } accept
+		tcp sport { 22, 443 } accept
+	}
+	chain output {
+		type filter hook output priority 0; policy drop;
+		oif "lo" accept
+		udp dport { 67, 68, 546, 547 } udp sport { 67, 68, 546, 547 } accept
+		icmpv6 type { nd-router-solicit, nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert } accept
+		ip daddr { 192.0.2.0/24 } accept
+		ip6 daddr { 2001:db8::/32 } accept
+		tcp sport { 22, 443 } accept
+		tcp dport { 22, 443 } accept
+	}
+}
+`
+	assert.Equal(t, expected, ruleset.String())
+}
+
+func TestRuleset_StringLAN(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	rules := Ruleset{LAN: true}.String()
+	assert.Contains(t, rules, "ip saddr { 10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16, 169.254.0.0/16 } accept")
+	assert.Contains(t, rules, "ip6 daddr { fe80::/10 } accept")
+	assert.NotContains(t, rules, "tcp")
+
+	assert.NotContains(t, Ruleset{}.String(), "10.0.0.0/8")
+}
+
+type mockConn struct {
+	tables  []*nft.Table
+	deleted []string
+}
+
+func (m *mockConn) ListTablesOfFamily(nft.TableFamily) ([]*nft.Table, error) { return m.tables, nil }
+func (m *mockConn) DelTable(t *nft.Table)                                    { m.deleted = append(m.deleted, t.Name) }
+func (*mockConn) Flush() error                                               { return nil }
+
+func TestKillSwitch(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	c := &mockConn{tables: []*nft.Table{
+		{Name: "nordvpn", Family: nft.TableFamilyINet},
+		{Name: tableName, Family: nft.TableFamilyINet},
+	}}
+	path := filepath.Join(t.TempDir(), "killswitch.nft")
+	k := KillSwitch{path: path, conn: func() (conn, error) { return c, nil }}
+
+	// nothing is loaded at boot without the ruleset
+	require.NoError(t, k.Release())
+	assert.Empty(t, c.deleted)
+
+	ruleset := Ruleset{TCPPorts: []int64{22}}
+	require.NoError(t, k.Set(&ruleset))
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, ruleset.String(), string(content))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	require.NoError(t, k.Release())
+	assert.Equal(t, []string{tableName}, c.deleted)
+	assert.FileExists(t, path)
+
+	c.deleted = nil
+	require.NoError(t, k.Set(nil))
+	assert.Equal(t, []string{tableName}, c.deleted)
+	assert.NoFileExists(t, path)
+	require.NoError(t, k.Set(nil))
+}
@@ -13,6 +13,8 @@ var (
 	ErrFirewallAlreadyEnabled = fmt.Errorf("firewall is already enabled")
 	// ErrFirewallAlreadyDisabled defines that disable was called twice in a row
 	ErrFirewallAlreadyDisabled = fmt.Errorf("firewall is already disabled")
+	// ErrFirewallDisabled defines that the operation requires firewall to be enabled
+	ErrFirewallDisabled = fmt.Errorf("firewall is disabled")
 )
 
 // Error marks that it originated in firewall package
@@ -10,6 +10,20 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/events"
 )
 
+// maxRuleCopies bounds the amount of the same rule copies deleted on rebuild
+const maxRuleCopies = 10
+
+// Reconciliation lists the differences between the rules stored in memory and
+// the ones applied to the system, which were found while rebuilding the firewall
+type Reconciliation struct {
+	// Missing rules were not applied before the rebuild
+	Missing []string
+	// Duplicated rules were applied more than once before the rebuild
+	Duplicated []string
+	// Unverified rules were not applied after the rebuild
+	Unverified []string
+}
+
 // Firewall is responsible for correctly changing one firewall agent over another.
 //
 // Thread-safe.
@@ -136,3 +150,65 @@ func (fw *Firewall) swap(current Agent, next Agent) error {
 	}
 	return nil
 }
+
+// Rebuild removes the rules from the system and applies them again in the order
+// they were added. Rules stored in memory are not changed, so the rebuilt rules
+// match the current state.
+func (fw *Firewall) Rebuild() (Reconciliation, error) {
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+	if !fw.enabled {
+		return Reconciliation{}, NewError(ErrFirewallDisabled)
+	}
+
+	var result Reconciliation
+	for _, rule := range fw.rules.rules {
+		fw.publisher.Publish(fmt.Sprintf("rebuilding rule %s", rule.Name))
+		copies, err := fw.deleteCopies(rule)
+		if err != nil {
+			return result, NewError(fmt.Errorf("deleting %s: %w", rule.Name, err))
+		}
+		switch {
+		case copies == 0:
+			result.Missing = append(result.Missing, rule.Name)
+		case copies > 1:
+			result.Duplicated = append(result.Duplicated, rule.Name)
+		}
+	}
+
+	for _, rule := range fw.rules.rules {
+		if err := fw.current.Add(rule); err != nil {
+			return result, NewError(fmt.Errorf("adding %s: %w", rule.Name, err))
+		}
+	}
+
+	for _, rule := range fw.rules.rules {
+		exists, err := fw.current.Exists(rule)
+		if err != nil {
+			return result, NewError(fmt.Errorf("verifying %s: %w", rule.Name, err))
+		}
+		if !exists {
+			result.Unverified = append(result.Unverified, rule.Name)
+		}
+	}
+	return result, nil
+}
+
+// deleteCopies deletes every applied copy of the rule and returns their amount.
+// Partially applied rule is not counted, but its leftovers are deleted as well.
+func (fw *Firewall) deleteCopies(rule Rule) (int, error) {
+	copies := 0
+	for ; copies < maxRuleCopies; copies++ {
+		exists, err := fw.current.Exists(rule)
+		if err != nil {
+			return copies, err
+		}
+		if !exists {
+			break
+		}
+		if err := fw.current.Delete(rule); err != nil {
+			return copies, err
+		}
+	}
+	return copies, fw.current.Delete(rule)
+}
@@ -42,6 +42,10 @@ func (m *mockAgent) Delete(rule Rule) error {
 	return nil
 }
 
+func (m *mockAgent) Exists(rule Rule) (bool, error) {
+	return false, nil
+}
+
 func (f *failingAgent) Add(rule Rule) error {
 	f.added++
 	return fmt.Errorf("adding")
@@ -52,6 +56,10 @@ func (f *failingAgent) Delete(rule Rule) error {
 	return fmt.Errorf("deleting")
 }
 
+func (f *failingAgent) Exists(rule Rule) (bool, error) {
+	return false, fmt.Errorf("checking")
+}
+
 func TestFirewallAdd(t *testing.T) {
 	category.Set(t, category.Unit)
 
@@ -415,3 +423,94 @@ func TestFirewallSwap(t *testing.T) {
 		})
 	}
 }
+
+// systemAgent keeps the amount of the applied copies of each rule
+type systemAgent struct {
+	applied map[string]int
+	// ignored rules are not applied when added
+	ignored []string
+}
+
+func (s *systemAgent) Add(rule Rule) error {
+	for _, name := range s.ignored {
+		if name == rule.Name {
+			return nil
+		}
+	}
+	s.applied[rule.Name]++
+	return nil
+}
+
+func (s *systemAgent) Delete(rule Rule) error {
+	if s.applied[rule.Name] > 0 {
+		s.applied[rule.Name]--
+	}
+	return nil
+}
+
+func (s *systemAgent) Exists(rule Rule) (bool, error) {
+	return s.applied[rule.Name] > 0, nil
+}
+
+func TestFirewallRebuild(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		applied  map[string]int
+		ignored  []string
+		enabled  bool
+		expected Reconciliation
+		hasError bool
+	}{
+		{
+			name:    "nothing to reconcile",
+			applied: map[string]int{"first": 1, "second": 1, "third": 1},
+			enabled: true,
+		},
+		{
+			name:    "missing and duplicated rules",
+			applied: map[string]int{"first": 1, "second": 3},
+			enabled: true,
+			expected: Reconciliation{
+				Missing:    []string{"third"},
+				Duplicated: []string{"second"},
+			},
+		},
+		{
+			name:     "rule is not applied",
+			applied:  map[string]int{"first": 1, "second": 1, "third": 1},
+			ignored:  []string{"second"},
+			enabled:  true,
+			expected: Reconciliation{Unverified: []string{"second"}},
+		},
+		{
+			name:     "firewall disabled",
+			applied:  map[string]int{},
+			hasError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			agent := &systemAgent{applied: map[string]int{}}
+			fw := NewFirewall(&mockAgent{}, agent, &subs.Subject[string]{}, test.enabled)
+			if test.enabled {
+				assert.NoError(t, fw.Add([]Rule{{Name: "first"}, {Name: "second"}, {Name: "third"}}))
+			}
+			agent.applied = test.applied
+			agent.ignored = test.ignored
+
+			result, err := fw.Rebuild()
+			if test.hasError {
+				assert.ErrorIs(t, err, ErrFirewallDisabled)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+			if len(test.ignored) == 0 {
+				assert.Equal(t, map[string]int{"first": 1, "second": 1, "third": 1}, agent.applied)
+			}
+		})
+	}
+}
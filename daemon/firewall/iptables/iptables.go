@@ -23,8 +23,16 @@ const (
 	accept   ruleTarget = "ACCEPT"
 	drop                = "DROP"
 	connmark            = "CONNMARK --save-mark --nfmask 0xffffffff --ctmask 0xffffffff"
+	logDrop             = "LOG --log-prefix " + firewall.LogPrefix + " --log-level warning"
 )
 
+// notExistingRule is a part of the output when the rule is not in the chain
+const notExistingRule = "does a matching rule exist in that chain"
+
+// logLimit bounds the amount of the kernel log entries written about the dropped
+// packets
+const logLimit = "-m limit --limit 10/min --limit-burst 5"
+
 // ruleTarget specifies what can be passed as an argument to `-j`
 type ruleTarget string
 
@@ -79,6 +87,29 @@ func (ipt *IPTables) Delete(rule firewall.Rule) error {
 	return ipt.applyRule(rule, false)
 }
 
+// Exists returns true if all iptables rules of the firewall rule are applied
+func (ipt *IPTables) Exists(rule firewall.Rule) (bool, error) {
+	ipt.Lock()
+	defer ipt.Unlock()
+	module, stateFlag := ipt.getStateModule(rule)
+	allRules := ruleToIPTables(rule, module, stateFlag, ipt.chainPrefix)
+
+	for _, iptableVersion := range ipt.supportedIPTables {
+		for _, ipTableRule := range allRules[iptableVersion] {
+			args := fmt.Sprintf("-C %s -w", ipTableRule)
+			// #nosec G204 -- input is properly sanitized
+			out, err := exec.Command(iptableVersion, strings.Split(args, " ")...).CombinedOutput()
+			if err != nil {
+				if strings.Contains(string(out), notExistingRule) {
+					return false, nil
+				}
+				return false, fmt.Errorf("checking %s rule '%s': %w: %s", iptableVersion, ipTableRule, err, string(out))
+			}
+		}
+	}
+	return true, nil
+}
+
 func (ipt *IPTables) applyRule(rule firewall.Rule, add bool) error {
 	flag := "-D"
 	errStr := "deleting"
@@ -100,8 +131,8 @@ func (ipt *IPTables) applyRule(rule firewall.Rule, add bool) error {
 			// #nosec G204 -- input is properly sanitized
 			out, err := exec.Command(iptableVersion, strings.Split(args, " ")...).CombinedOutput()
 			if err != nil {
-				if flag == "-D" && strings.Contains(string(out), "does a matching rule exist in that chain") {
-					return nil
+				if flag == "-D" && strings.Contains(string(out), notExistingRule) {
+					continue
 				}
 				return fmt.Errorf("%s %s rule '%s': %w: %s", errStr, iptableVersion, ipTableRule, err, string(out))
 			}
@@ -158,7 +189,7 @@ func ruleToIPTables(rule firewall.Rule, module string, stateFlag string, chainPr
 					for _, protocol := range rule.Protocols {
 						for _, input := range toInputSlice(rule.Direction) {
 							for _, icmpv6Type := range defaultIcmpv6(rule.Icmpv6Types) {
-								for _, target := range toTargetSlice(rule.Allow, input, rule.Marks) {
+								for _, target := range toTargetSlice(rule.Allow, input, rule.Marks, rule.Log) {
 									for _, mark := range rule.Marks {
 										if pRange.Min != 0 {
 											for _, portFlag := range portsDirectionToPortsFlag(rule.PortsDirection) {
@@ -248,12 +279,16 @@ func toInputSlice(direction firewall.Direction) []bool {
 	return nil
 }
 
-func toTargetSlice(allowPackets bool, input bool, marks []uint32) []ruleTarget {
+func toTargetSlice(allowPackets bool, input bool, marks []uint32, log bool) []ruleTarget {
 	var targets []ruleTarget
 	if allowPackets {
 		targets = append(targets, accept)
 	} else {
 		targets = append(targets, drop)
+		// rules are inserted, so the log rule ends up right above the drop rule
+		if log {
+			targets = append(targets, logDrop)
+		}
 	}
 
 	if input { // connmark is meant for OUTPUT chain only
@@ -372,6 +407,9 @@ func generateIPTablesRule(
 	if hopLimit > 0 {
 		rule += fmt.Sprintf(" -m hl --hl-eq %d", hopLimit)
 	}
+	if target == logDrop {
+		rule += " " + logLimit
+	}
 
 	jump := " -j "
 
@@ -155,6 +155,9 @@ func TestGenerateIPTablesRule(t *testing.T) {
 		}, {
 			input: false, target: connmark, mark: 0x123,
 			rule: "OUTPUT -m mark --mark 0x123 -m comment --comment nordvpn -j CONNMARK --save-mark --nfmask 0xffffffff --ctmask 0xffffffff",
+		}, {
+			input: false, target: logDrop, iface: "eth0",
+			rule: "OUTPUT -o eth0 -m limit --limit 10/min --limit-burst 5 -m comment --comment nordvpn -j LOG --log-prefix nordvpn-denied: --log-level warning",
 		},
 	}
 
@@ -267,6 +270,7 @@ func TestToTargetSlice(t *testing.T) {
 		allowPackets bool
 		inputChain   bool
 		marks        []uint32
+		log          bool
 		out          []ruleTarget
 	}{
 		{
@@ -311,11 +315,25 @@ func TestToTargetSlice(t *testing.T) {
 			marks:        []uint32{0x123},
 			out:          []ruleTarget{drop, connmark},
 		},
+		{
+			name:         "drop and log packets",
+			allowPackets: false,
+			inputChain:   true,
+			log:          true,
+			out:          []ruleTarget{drop, logDrop},
+		},
+		{
+			name:         "log is ignored for allowed packets",
+			allowPackets: true,
+			inputChain:   true,
+			log:          true,
+			out:          []ruleTarget{accept},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			out := toTargetSlice(test.allowPackets, test.inputChain, test.marks)
+			out := toTargetSlice(test.allowPackets, test.inputChain, test.marks, test.log)
 			assert.Equal(t, test.out, out)
 		})
 	}
@@ -0,0 +1,684 @@
+// Package nftables implements nftables firewall agent.
+//
+// The agent applies the filter rules of the firewall, i.e. Kill Switch, allowlist
+// and meshnet peer rules. The rules outside of the filter chains are still applied
+// with iptables when this backend is used:
+//   - allowlist routing marks (mangle table)
+//   - meshnet exit node masquerading and forwarding (nat table, FORWARD chain)
+//   - split tunnel marks and masquerading
+//   - fileshare rate limiting and on-demand traffic detection (mangle table)
+//   - firewall templates, which are given in the iptables-save format
+//
+// Availability of iptables is reported by the daemon self-test for them.
+package nftables
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/iptables"
+	nft "github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
+	"github.com/google/nftables/expr"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	tableName   = "nordvpn"
+	inputChain  = "input"
+	outputChain = "output"
+)
+
+// protocols maps the protocol names used by the firewall rules to their numbers
+var protocols = map[string]byte{
+	"icmp":      unix.IPPROTO_ICMP,
+	"tcp":       unix.IPPROTO_TCP,
+	"udp":       unix.IPPROTO_UDP,
+	"ipv6-icmp": unix.IPPROTO_ICMPV6,
+	"icmpv6":    unix.IPPROTO_ICMPV6,
+}
+
+// conn is the subset of nftables.Conn used by the agent
+type conn interface {
+	AddTable(*nft.Table) *nft.Table
+	AddChain(*nft.Chain) *nft.Chain
+	InsertRule(*nft.Rule) *nft.Rule
+	DelRule(*nft.Rule) error
+	GetRules(*nft.Table, *nft.Chain) ([]*nft.Rule, error)
+	Flush() error
+}
+
+// Nftables handles all firewall changes with nftables via netlink. The rules are kept
+// in a separate inet table, so they do not interfere with the tables of other
+// firewall managers.
+type Nftables struct {
+	conn   conn
+	table  *nft.Table
+	input  *nft.Chain
+	output *nft.Chain
+	sync.Mutex
+}
+
+// New is a default constructor for Nftables firewall. It fails if nftables is not
+// usable on the system.
+func New() (*Nftables, error) {
+	c, err := nft.New()
+	if err != nil {
+		return nil, fmt.Errorf("opening nftables connection: %w", err)
+	}
+	n := newNftables(c)
+	if err := n.ensureChains(); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func newNftables(c conn) *Nftables {
+	table := &nft.Table{Name: tableName, Family: nft.TableFamilyINet}
+	accept := nft.ChainPolicyAccept
+	return &Nftables{
+		conn:  c,
+		table: table,
+		input: &nft.Chain{
+			Name:     inputChain,
+			Table:    table,
+			Type:     nft.ChainTypeFilter,
+			Hooknum:  nft.ChainHookInput,
+			Priority: nft.ChainPriorityFilter,
+			Policy:   &accept,
+		},
+		output: &nft.Chain{
+			Name:     outputChain,
+			Table:    table,
+			Type:     nft.ChainTypeFilter,
+			Hooknum:  nft.ChainHookOutput,
+			Priority: nft.ChainPriorityFilter,
+			Policy:   &accept,
+		},
+	}
+}
+
+// ensureChains creates the table and its chains unless they already exist
+func (n *Nftables) ensureChains() error {
+	n.conn.AddTable(n.table)
+	n.conn.AddChain(n.input)
+	n.conn.AddChain(n.output)
+	if err := n.conn.Flush(); err != nil {
+		return fmt.Errorf("creating nftables table %s: %w", tableName, err)
+	}
+	return nil
+}
+
+func (n *Nftables) chain(input bool) *nft.Chain {
+	if input {
+		return n.input
+	}
+	return n.output
+}
+
+func (n *Nftables) Add(rule firewall.Rule) error {
+	n.Lock()
+	defer n.Unlock()
+	rules, err := ruleToNftables(rule)
+	if err != nil {
+		return err
+	}
+	if err := n.ensureChains(); err != nil {
+		return err
+	}
+	// rules are inserted at the top of the chain the same way iptables -I does
+	for _, r := range rules {
+		n.conn.InsertRule(&nft.Rule{
+			Table:    n.table,
+			Chain:    n.chain(r.input),
+			Exprs:    r.exprs,
+			UserData: r.userData(),
+		})
+	}
+	if err := n.conn.Flush(); err != nil {
+		return fmt.Errorf("adding nftables rule %s: %w", rule.Name, err)
+	}
+	return nil
+}
+
+func (n *Nftables) Delete(rule firewall.Rule) error {
+	n.Lock()
+	defer n.Unlock()
+	rules, err := ruleToNftables(rule)
+	if err != nil {
+		return err
+	}
+	applied, err := n.appliedRules()
+	if err != nil {
+		return err
+	}
+	for _, r := range rules {
+		key := r.userData()
+		chain := n.chain(r.input).Name
+		for i, existing := range applied[chain] {
+			if string(existing.UserData) != string(key) {
+				continue
+			}
+			if err := n.conn.DelRule(existing); err != nil {
+				return fmt.Errorf("deleting nftables rule %s: %w", rule.Name, err)
+			}
+			// every copy of the rule is deleted only once
+			applied[chain] = append(applied[chain][:i], applied[chain][i+1:]...)
+			break
+		}
+	}
+	if err := n.conn.Flush(); err != nil {
+		return fmt.Errorf("deleting nftables rule %s: %w", rule.Name, err)
+	}
+	return nil
+}
+
+// Exists returns true if all nftables rules of the firewall rule are applied
+func (n *Nftables) Exists(rule firewall.Rule) (bool, error) {
+	n.Lock()
+	defer n.Unlock()
+	rules, err := ruleToNftables(rule)
+	if err != nil {
+		return false, err
+	}
+	applied, err := n.appliedRules()
+	if err != nil {
+		return false, err
+	}
+	counts := map[string]int{}
+	for chain, existing := range applied {
+		for _, r := range existing {
+			counts[chain+string(r.UserData)]++
+		}
+	}
+	for _, r := range rules {
+		key := n.chain(r.input).Name + string(r.userData())
+		if counts[key] == 0 {
+			return false, nil
+		}
+		counts[key]--
+	}
+	return true, nil
+}
+
+// appliedRules returns the rules currently in the chains of the table keyed by the
+// chain name
+func (n *Nftables) appliedRules() (map[string][]*nft.Rule, error) {
+	if err := n.ensureChains(); err != nil {
+		return nil, err
+	}
+	applied := map[string][]*nft.Rule{}
+	for _, chain := range []*nft.Chain{n.input, n.output} {
+		rules, err := n.conn.GetRules(n.table, chain)
+		if err != nil {
+			return nil, fmt.Errorf("listing nftables rules of %s chain: %w", chain.Name, err)
+		}
+		applied[chain.Name] = rules
+	}
+	return applied, nil
+}
+
+// nftRule is a single nftables rule generated from the firewall rule
+type nftRule struct {
+	input   bool
+	exprs   []expr.Any
+	comment string
+	// desc is a human readable form of the expressions, used to tell the rules apart
+	desc []string
+}
+
+func (r *nftRule) match(desc string, exprs ...expr.Any) {
+	r.desc = append(r.desc, desc)
+	r.exprs = append(r.exprs, exprs...)
+}
+
+// userData identifies the rule in the chain. It is stored as the rule comment, so
+// `nft list ruleset` shows which rules belong to the daemon.
+func (r *nftRule) userData() []byte {
+	sum := sha256.Sum256([]byte(strings.Join(r.desc, " ")))
+	comment := r.comment + ":" + hex.EncodeToString(sum[:6])
+	// NFTNL_UDATA_RULE_COMMENT type, length including the terminating null byte
+	data := []byte{0, byte(len(comment) + 1)}
+	data = append(data, comment...)
+	return append(data, 0)
+}
+
+type family int
+
+const (
+	familyAny family = iota
+	familyIPv4
+	familyIPv6
+)
+
+func ruleFamily(rule firewall.Rule, remote netip.Prefix, local netip.Prefix) family {
+	switch {
+	case rule.Ipv6Only || remote.Addr().Is6() || local.Addr().Is6():
+		return familyIPv6
+	case remote.Addr().Is4() || local.Addr().Is4():
+		return familyIPv4
+	case rule.HopLimit > 0 || len(rule.Icmpv6Types) > 0:
+		return familyIPv6
+	case rule.ConnectionStates.SrcAddr.IsValid() && !rule.ConnectionStates.SrcAddr.IsUnspecified():
+		if rule.ConnectionStates.SrcAddr.Is4() {
+			return familyIPv4
+		}
+		return familyIPv6
+	}
+	return familyAny
+}
+
+// ruleToNftables expands the firewall rule the same way as it is done for iptables,
+// so both agents apply the same set of rules
+func ruleToNftables(rule firewall.Rule) ([]nftRule, error) {
+	rule = generateNonEmptyRule(rule)
+	comment := rule.Comment
+	if comment == "" {
+		comment = "nordvpn"
+	}
+
+	var rules []nftRule
+	for _, iface := range rule.Interfaces {
+		for _, remote := range rule.RemoteNetworks {
+			for _, local := range rule.LocalNetworks {
+				for _, pRange := range portRanges(rule.Ports) {
+					for _, protocol := range rule.Protocols {
+						for _, input := range toInputSlice(rule.Direction) {
+							for _, icmpv6Type := range defaultIcmpv6(rule.Icmpv6Types) {
+								for _, target := range toTargetSlice(rule.Allow, input, rule.Marks, rule.Log) {
+									for _, mark := range rule.Marks {
+										for _, ports := range portMatches(rule, pRange) {
+											for _, origin := range originMatches(rule.ConnectionStates.SrcAddr) {
+												r := nftRule{input: input, comment: comment}
+												fam := ruleFamily(rule, remote, local)
+												if err := r.build(
+													fam, iface, remote, local, protocol, ports, icmpv6Type,
+													rule.HopLimit, rule.ConnectionStates.States, origin, mark, target,
+												); err != nil {
+													return nil, fmt.Errorf("converting rule %s: %w", rule.Name, err)
+												}
+												rules = append(rules, r)
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return rules, nil
+}
+
+// generateNonEmptyRule fills nil fields with values of one element with nil value
+func generateNonEmptyRule(rule firewall.Rule) firewall.Rule {
+	if rule.RemoteNetworks == nil {
+		rule.RemoteNetworks = []netip.Prefix{{}}
+	}
+	if rule.LocalNetworks == nil {
+		rule.LocalNetworks = []netip.Prefix{{}}
+	}
+	if rule.Protocols == nil {
+		rule.Protocols = []string{""}
+	}
+	if rule.Interfaces == nil {
+		rule.Interfaces = []net.Interface{{}}
+	}
+	if rule.Marks == nil {
+		rule.Marks = []uint32{0}
+	}
+	return rule
+}
+
+func portRanges(ports []int) []iptables.PortRange {
+	if len(ports) == 0 {
+		return []iptables.PortRange{{}}
+	}
+	return iptables.PortsToPortRanges(ports)
+}
+
+func toInputSlice(direction firewall.Direction) []bool {
+	switch direction {
+	case firewall.Inbound:
+		return []bool{true}
+	case firewall.Outbound:
+		return []bool{false}
+	case firewall.TwoWay:
+		return []bool{true, false}
+	}
+	return nil
+}
+
+func defaultIcmpv6(icmp6Types []int) []int {
+	if len(icmp6Types) > 0 {
+		return icmp6Types
+	}
+	return []int{0}
+}
+
+type target int
+
+const (
+	accept target = iota
+	drop
+	logDrop
+	connmark
+)
+
+func toTargetSlice(allowPackets bool, input bool, marks []uint32, log bool) []target {
+	var targets []target
+	if allowPackets {
+		targets = append(targets, accept)
+	} else {
+		targets = append(targets, drop)
+		// rules are inserted, so the log rule ends up right above the drop rule
+		if log {
+			targets = append(targets, logDrop)
+		}
+	}
+
+	if input { // connmark is meant for output chain only
+		return targets
+	}
+
+	if len(marks) > 0 && marks[0] != 0 {
+		targets = append(targets, connmark)
+	}
+	return targets
+}
+
+// portMatch matches a single source and destination port range, zero means any port
+type portMatch struct {
+	source      iptables.PortRange
+	destination iptables.PortRange
+}
+
+// portMatches returns the port combinations of the rule. Port lists are not supported
+// by a single expression, so a rule is generated for every port in the list.
+func portMatches(rule firewall.Rule, pRange iptables.PortRange) []portMatch {
+	if pRange.Min != 0 {
+		switch rule.PortsDirection {
+		case firewall.Destination:
+			return []portMatch{{destination: pRange}}
+		case firewall.Source:
+			return []portMatch{{source: pRange}}
+		default:
+			return []portMatch{{source: pRange}, {destination: pRange}}
+		}
+	}
+
+	sports := []int{0}
+	if len(rule.SourcePorts) > 0 {
+		sports = rule.SourcePorts
+	}
+	dports := []int{0}
+	if len(rule.DestinationPorts) > 0 {
+		dports = rule.DestinationPorts
+	}
+	var matches []portMatch
+	for _, sport := range sports {
+		for _, dport := range dports {
+			matches = append(matches, portMatch{
+				source:      iptables.PortRange{Min: sport, Max: sport},
+				destination: iptables.PortRange{Min: dport, Max: dport},
+			})
+		}
+	}
+	return matches
+}
+
+// originMatch matches the connections originated from the address. Connection
+// tracking original source is not exposed by the netlink library, so it is matched
+// as the packet address in the corresponding conntrack direction instead.
+type originMatch struct {
+	addr  netip.Addr
+	reply bool
+}
+
+func originMatches(addr netip.Addr) []originMatch {
+	if !addr.IsValid() || addr.IsUnspecified() {
+		return []originMatch{{}}
+	}
+	return []originMatch{{addr: addr}, {addr: addr, reply: true}}
+}
+
+// build converts input fields to the expressions of a single nftables rule
+func (r *nftRule) build(
+	fam family,
+	iface net.Interface,
+	remote netip.Prefix,
+	local netip.Prefix,
+	protocol string,
+	ports portMatch,
+	icmpv6Type int,
+	hopLimit uint8,
+	states []firewall.ConnectionState,
+	origin originMatch,
+	mark uint32,
+	target target,
+) error {
+	if iface.Name != "" {
+		key, name := expr.MetaKeyOIFNAME, "oifname"
+		if r.input {
+			key, name = expr.MetaKeyIIFNAME, "iifname"
+		}
+		r.match(name+" "+iface.Name,
+			&expr.Meta{Key: key, Register: 1},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: ifname(iface.Name)},
+		)
+	}
+
+	switch fam {
+	case familyIPv4:
+		r.match("meta nfproto ipv4", nfproto(unix.NFPROTO_IPV4)...)
+	case familyIPv6:
+		r.match("meta nfproto ipv6", nfproto(unix.NFPROTO_IPV6)...)
+	}
+	// remote address is the source of the incoming packets and the destination of
+	// the outgoing ones
+	if remote.IsValid() {
+		r.matchAddr(remote, r.input)
+	}
+	if local.IsValid() {
+		r.matchAddr(local, !r.input)
+	}
+
+	if protocol != "" {
+		proto, ok := protocols[protocol]
+		if !ok {
+			return fmt.Errorf("unsupported protocol %s", protocol)
+		}
+		r.match("meta l4proto "+protocol,
+			&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{proto}},
+		)
+	}
+
+	if mark != 0 {
+		if target == connmark {
+			r.match(fmt.Sprintf("meta mark %#x", mark),
+				&expr.Meta{Key: expr.MetaKeyMARK, Register: 1},
+				&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: binaryutil.NativeEndian.PutUint32(mark)},
+			)
+		} else {
+			r.match(fmt.Sprintf("ct mark %#x", mark),
+				&expr.Ct{Key: expr.CtKeyMARK, Register: 1},
+				&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: binaryutil.NativeEndian.PutUint32(mark)},
+			)
+		}
+	}
+
+	r.matchPorts("sport", 0, ports.source)
+	r.matchPorts("dport", 2, ports.destination)
+
+	if len(states) > 0 {
+		var bits uint32
+		var names []string
+		for _, state := range states {
+			bit, name := connectionStateToBit(state)
+			bits |= bit
+			names = append(names, name)
+		}
+		r.match("ct state "+strings.Join(names, ","),
+			&expr.Ct{Key: expr.CtKeySTATE, Register: 1},
+			&expr.Bitwise{
+				SourceRegister: 1,
+				DestRegister:   1,
+				Len:            4,
+				Mask:           binaryutil.NativeEndian.PutUint32(bits),
+				Xor:            binaryutil.NativeEndian.PutUint32(0),
+			},
+			&expr.Cmp{Op: expr.CmpOpNeq, Register: 1, Data: binaryutil.NativeEndian.PutUint32(0)},
+		)
+	}
+	if origin.addr.IsValid() {
+		// packets in the reply direction are addressed to the connection originator
+		dir := byte(0)
+		if origin.reply {
+			dir = 1
+		}
+		r.match(fmt.Sprintf("ct direction %d", dir),
+			&expr.Ct{Key: expr.CtKeyDIRECTION, Register: 1},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{dir}},
+		)
+		r.matchAddr(netip.PrefixFrom(origin.addr, origin.addr.BitLen()), !origin.reply)
+	}
+
+	if icmpv6Type > 0 {
+		r.match(fmt.Sprintf("icmpv6 type %d", icmpv6Type),
+			&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseTransportHeader, Offset: 0, Len: 1},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{byte(icmpv6Type)}},
+		)
+	}
+	if hopLimit > 0 {
+		// hop limit of IPv6 and TTL of IPv4 headers
+		offset := uint32(7)
+		if fam == familyIPv4 {
+			offset = 8
+		}
+		r.match(fmt.Sprintf("hoplimit %d", hopLimit),
+			&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseNetworkHeader, Offset: offset, Len: 1},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{hopLimit}},
+		)
+	}
+
+	switch target {
+	case accept:
+		r.match("accept", &expr.Verdict{Kind: expr.VerdictAccept})
+	case drop:
+		r.match("drop", &expr.Verdict{Kind: expr.VerdictDrop})
+	case logDrop:
+		// log limit bounds the amount of the kernel log entries written about the
+		// dropped packets
+		r.match("limit rate 10/minute burst 5 packets log prefix "+firewall.LogPrefix+" level warn",
+			&expr.Limit{Type: expr.LimitTypePkts, Rate: 10, Unit: expr.LimitTimeMinute, Burst: 5},
+			&expr.Log{
+				Level: expr.LogLevelWarning,
+				Key:   1<<unix.NFTA_LOG_PREFIX | 1<<unix.NFTA_LOG_LEVEL,
+				Data:  []byte(firewall.LogPrefix),
+			},
+		)
+	case connmark:
+		r.match("ct mark set meta mark",
+			&expr.Meta{Key: expr.MetaKeyMARK, Register: 1},
+			&expr.Ct{Key: expr.CtKeyMARK, Register: 1, SourceRegister: true},
+		)
+	}
+	return nil
+}
+
+// matchAddr matches the source or the destination address of the packet against the network
+func (r *nftRule) matchAddr(network netip.Prefix, source bool) {
+	network = network.Masked()
+	addr := network.Addr().AsSlice()
+	offset, name := uint32(16), "ip daddr "
+	if source {
+		offset, name = 12, "ip saddr "
+	}
+	if network.Addr().Is6() {
+		offset, name = 24, "ip6 daddr "
+		if source {
+			offset, name = 8, "ip6 saddr "
+		}
+	}
+
+	exprs := []expr.Any{&expr.Payload{
+		DestRegister: 1,
+		Base:         expr.PayloadBaseNetworkHeader,
+		Offset:       offset,
+		Len:          uint32(len(addr)),
+	}}
+	if network.Bits() < network.Addr().BitLen() {
+		exprs = append(exprs, &expr.Bitwise{
+			SourceRegister: 1,
+			DestRegister:   1,
+			Len:            uint32(len(addr)),
+			Mask:           net.CIDRMask(network.Bits(), network.Addr().BitLen()),
+			Xor:            make([]byte, len(addr)),
+		})
+	}
+	exprs = append(exprs, &expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: addr})
+	r.match(name+network.String(), exprs...)
+}
+
+// matchPorts matches the transport header port at the offset against the range
+func (r *nftRule) matchPorts(name string, offset uint32, ports iptables.PortRange) {
+	if ports.Min == 0 {
+		return
+	}
+	load := &expr.Payload{
+		DestRegister: 1,
+		Base:         expr.PayloadBaseTransportHeader,
+		Offset:       offset,
+		Len:          2,
+	}
+	if ports.Min == ports.Max {
+		r.match(fmt.Sprintf("th %s %d", name, ports.Min), load,
+			&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: binaryutil.BigEndian.PutUint16(uint16(ports.Min))},
+		)
+		return
+	}
+	r.match(fmt.Sprintf("th %s %d-%d", name, ports.Min, ports.Max), load,
+		&expr.Range{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			FromData: binaryutil.BigEndian.PutUint16(uint16(ports.Min)),
+			ToData:   binaryutil.BigEndian.PutUint16(uint16(ports.Max)),
+		},
+	)
+}
+
+func nfproto(proto byte) []expr.Any {
+	return []expr.Any{
+		&expr.Meta{Key: expr.MetaKeyNFPROTO, Register: 1},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{proto}},
+	}
+}
+
+// ifname returns the interface name padded to the kernel interface name size
+func ifname(name string) []byte {
+	b := make([]byte, unix.IFNAMSIZ)
+	copy(b, name)
+	return b
+}
+
+// connectionStateToBit converts package connection state to conntrack state bit
+func connectionStateToBit(state firewall.ConnectionState) (uint32, string) {
+	switch state {
+	case firewall.Related:
+		return expr.CtStateBitRELATED, "related"
+	case firewall.Established:
+		return expr.CtStateBitESTABLISHED, "established"
+	case firewall.New:
+		return expr.CtStateBitNEW, "new"
+	}
+	return 0, ""
+}
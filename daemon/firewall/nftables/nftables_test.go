@@ -0,0 +1,282 @@
+package nftables
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	nft "github.com/google/nftables"
+	"github.com/google/nftables/expr"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockConn keeps the rules in memory, inserting them at the top of the chain
+type mockConn struct {
+	rules  map[string][]*nft.Rule
+	handle uint64
+}
+
+func newMockConn() *mockConn {
+	return &mockConn{rules: map[string][]*nft.Rule{}}
+}
+
+func (*mockConn) AddTable(t *nft.Table) *nft.Table { return t }
+func (*mockConn) AddChain(c *nft.Chain) *nft.Chain { return c }
+func (*mockConn) Flush() error                     { return nil }
+
+func (m *mockConn) InsertRule(r *nft.Rule) *nft.Rule {
+	m.handle++
+	r.Handle = m.handle
+	m.rules[r.Chain.Name] = append([]*nft.Rule{r}, m.rules[r.Chain.Name]...)
+	return r
+}
+
+func (m *mockConn) DelRule(r *nft.Rule) error {
+	rules := m.rules[r.Chain.Name]
+	for i, existing := range rules {
+		if existing.Handle == r.Handle {
+			m.rules[r.Chain.Name] = append(rules[:i], rules[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (m *mockConn) GetRules(_ *nft.Table, c *nft.Chain) ([]*nft.Rule, error) {
+	return append([]*nft.Rule{}, m.rules[c.Name]...), nil
+}
+
+func TestAgentInterface(t *testing.T) {
+	category.Set(t, category.Unit)
+	assert.Implements(t, (*firewall.Agent)(nil), newNftables(newMockConn()))
+}
+
+func TestRuleToNftables(t *testing.T) {
+	category.Set(t, category.Unit)
+	tests := []struct {
+		name  string
+		rule  firewall.Rule
+		rules [][]string
+		input []bool
+	}{
+		{
+			name: "drop all outgoing",
+			rule: firewall.Rule{Direction: firewall.Outbound},
+			rules: [][]string{
+				{"drop"},
+			},
+			input: []bool{false},
+		},
+		{
+			name: "allow both ways on interface",
+			rule: firewall.Rule{
+				Interfaces: []net.Interface{{Name: "nordlynx"}},
+				Direction:  firewall.TwoWay,
+				Allow:      true,
+			},
+			rules: [][]string{
+				{"iifname nordlynx", "accept"},
+				{"oifname nordlynx", "accept"},
+			},
+			input: []bool{true, false},
+		},
+		{
+			name: "network, protocol and port range",
+			rule: firewall.Rule{
+				RemoteNetworks: []netip.Prefix{netip.MustParsePrefix("192.168.1.0/24")},
+				Protocols:      []string{"tcp"},
+				Ports:          []int{22, 23},
+				PortsDirection: firewall.Destination,
+				Direction:      firewall.Outbound,
+				Allow:          true,
+			},
+			rules: [][]string{
+				{"meta nfproto ipv4", "ip daddr 192.168.1.0/24", "meta l4proto tcp", "th dport 22-23", "accept"},
+			},
+			input: []bool{false},
+		},
+		{
+			name: "logged drop with marks",
+			rule: firewall.Rule{
+				Direction: firewall.Outbound,
+				Marks:     []uint32{0xe1f1},
+				Log:       true,
+			},
+			rules: [][]string{
+				{"ct mark 0xe1f1", "drop"},
+				{"ct mark 0xe1f1", "limit rate 10/minute burst 5 packets log prefix " + firewall.LogPrefix + " level warn"},
+				{"meta mark 0xe1f1", "ct mark set meta mark"},
+			},
+			input: []bool{false, false, false},
+		},
+		{
+			name: "connection states with origin",
+			rule: firewall.Rule{
+				RemoteNetworks: []netip.Prefix{netip.MustParsePrefix("100.64.0.0/10")},
+				Direction:      firewall.Inbound,
+				ConnectionStates: firewall.ConnectionStates{
+					SrcAddr: netip.MustParseAddr("100.64.0.1"),
+					States:  []firewall.ConnectionState{firewall.Related, firewall.Established},
+				},
+				Allow: true,
+			},
+			rules: [][]string{
+				{
+					"meta nfproto ipv4", "ip saddr 100.64.0.0/10", "ct state related,established",
+					"ct direction 0", "ip saddr 100.64.0.1/32", "accept",
+				},
+				{
+					"meta nfproto ipv4", "ip saddr 100.64.0.0/10", "ct state related,established",
+					"ct direction 1", "ip daddr 100.64.0.1/32", "accept",
+				},
+			},
+			input: []bool{true, true},
+		},
+		{
+			name: "source and destination port lists",
+			rule: firewall.Rule{
+				Ipv6Only:         true,
+				Protocols:        []string{"ipv6-icmp"},
+				Icmpv6Types:      []int{134},
+				HopLimit:         255,
+				SourcePorts:      []int{1},
+				DestinationPorts: []int{2, 3},
+				Direction:        firewall.Inbound,
+				Allow:            true,
+				Comment:          "nordvpn-test",
+			},
+			rules: [][]string{
+				{"meta nfproto ipv6", "meta l4proto ipv6-icmp", "th sport 1", "th dport 2", "icmpv6 type 134", "hoplimit 255", "accept"},
+				{"meta nfproto ipv6", "meta l4proto ipv6-icmp", "th sport 1", "th dport 3", "icmpv6 type 134", "hoplimit 255", "accept"},
+			},
+			input: []bool{true, true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := ruleToNftables(tt.rule)
+			require.NoError(t, err)
+			var descs [][]string
+			var inputs []bool
+			for _, r := range rules {
+				descs = append(descs, r.desc)
+				inputs = append(inputs, r.input)
+			}
+			assert.Equal(t, tt.rules, descs)
+			assert.Equal(t, tt.input, inputs)
+		})
+	}
+}
+
+func TestRuleToNftables_UnsupportedProtocol(t *testing.T) {
+	category.Set(t, category.Unit)
+	_, err := ruleToNftables(firewall.Rule{Protocols: []string{"sctp"}})
+	assert.Error(t, err)
+}
+
+func TestRuleToNftables_AddressMask(t *testing.T) {
+	category.Set(t, category.Unit)
+	rules, err := ruleToNftables(firewall.Rule{
+		RemoteNetworks: []netip.Prefix{netip.MustParsePrefix("fd00::/8")},
+		Direction:      firewall.Outbound,
+	})
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+
+	exprs := rules[0].exprs
+	// meta nfproto + cmp, payload, bitwise, cmp, verdict
+	require.Len(t, exprs, 6)
+	assert.Equal(t, &expr.Payload{
+		DestRegister: 1, Base: expr.PayloadBaseNetworkHeader, Offset: 24, Len: 16,
+	}, exprs[2])
+	bitwise, ok := exprs[3].(*expr.Bitwise)
+	require.True(t, ok)
+	assert.Equal(t, []byte(net.CIDRMask(8, 128)), bitwise.Mask)
+	assert.Equal(t, netip.MustParseAddr("fd00::").AsSlice(), exprs[4].(*expr.Cmp).Data)
+}
+
+func TestNftables_AddExistsDelete(t *testing.T) {
+	category.Set(t, category.Unit)
+	conn := newMockConn()
+	n := newNftables(conn)
+
+	killswitch := firewall.Rule{Name: "killswitch", Direction: firewall.TwoWay}
+	allowlist := firewall.Rule{
+		Name:           "allowlist",
+		RemoteNetworks: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")},
+		Direction:      firewall.TwoWay,
+		Allow:          true,
+	}
+
+	exists, err := n.Exists(killswitch)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	require.NoError(t, n.Add(killswitch))
+	require.NoError(t, n.Add(allowlist))
+	assert.Len(t, conn.rules[inputChain], 2)
+	assert.Len(t, conn.rules[outputChain], 2)
+
+	// rules added later end up on the top of the chain
+	assert.Equal(t, &expr.Verdict{Kind: expr.VerdictAccept}, lastExpr(conn.rules[outputChain][0]))
+	assert.Equal(t, &expr.Verdict{Kind: expr.VerdictDrop}, lastExpr(conn.rules[outputChain][1]))
+
+	exists, err = n.Exists(allowlist)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	require.NoError(t, n.Delete(allowlist))
+	exists, err = n.Exists(allowlist)
+	require.NoError(t, err)
+	assert.False(t, exists)
+	exists, err = n.Exists(killswitch)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	// deleting a missing rule is not an error
+	require.NoError(t, n.Delete(allowlist))
+	require.NoError(t, n.Delete(killswitch))
+	assert.Empty(t, conn.rules[inputChain])
+	assert.Empty(t, conn.rules[outputChain])
+}
+
+func TestNftables_DeleteOneCopy(t *testing.T) {
+	category.Set(t, category.Unit)
+	conn := newMockConn()
+	n := newNftables(conn)
+
+	rule := firewall.Rule{Name: "drop", Direction: firewall.Inbound}
+	require.NoError(t, n.Add(rule))
+	require.NoError(t, n.Add(rule))
+	require.NoError(t, n.Delete(rule))
+
+	exists, err := n.Exists(rule)
+	require.NoError(t, err)
+	assert.True(t, exists)
+	assert.Len(t, conn.rules[inputChain], 1)
+}
+
+func TestUserData(t *testing.T) {
+	category.Set(t, category.Unit)
+	rules, err := ruleToNftables(firewall.Rule{Direction: firewall.Inbound})
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+
+	data := rules[0].userData()
+	assert.Equal(t, byte(0), data[0])
+	assert.Equal(t, int(data[1]), len(data)-2)
+	assert.Equal(t, byte(0), data[len(data)-1])
+	assert.Contains(t, string(data), "nordvpn:")
+
+	other, err := ruleToNftables(firewall.Rule{Direction: firewall.Inbound, Allow: true})
+	require.NoError(t, err)
+	assert.NotEqual(t, data, other[0].userData())
+}
+
+func lastExpr(r *nft.Rule) expr.Any {
+	return r.Exprs[len(r.Exprs)-1]
+}
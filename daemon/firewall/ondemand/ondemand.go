@@ -0,0 +1,178 @@
+// Package ondemand implements detection of outgoing traffic, which is used to
+// establish the VPN connection on demand.
+package ondemand
+
+import (
+	"bytes"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+const (
+	RuleComment = "nordvpn_ondemand"
+	chain       = "nordvpn-ondemand"
+	iptablesCmd = "iptables"
+)
+
+// Detector counts new outgoing connections.
+type Detector interface {
+	// Enable starts counting new outgoing connections to destinations other than
+	// the excluded ones. Connections marked with ignoredMark are not counted.
+	Enable(excluded []netip.Prefix, ignoredMark uint32) error
+	// Disable stops counting and removes the counter
+	Disable() error
+	// Demand reports the amount of new outgoing connections counted since enabled
+	Demand() (uint64, error)
+}
+
+type runCommandFunc func(command string, arg ...string) ([]byte, error)
+
+// IPTables counts new outgoing connections using a dedicated chain in the mangle
+// table. Mangle table is traversed before the filter table, so the connections
+// are counted even when they are dropped by the kill switch. Rules in the chain
+// only return to the caller and do not change the verdict.
+type IPTables struct {
+	runCommandFunc runCommandFunc
+	enabled        bool
+}
+
+// NewIPTables is a default constructor for IPTables
+func NewIPTables(commandFunc runCommandFunc) *IPTables {
+	return &IPTables{runCommandFunc: commandFunc}
+}
+
+func (ipt *IPTables) Enable(excluded []netip.Prefix, ignoredMark uint32) error {
+	// rules might be left from the previous run
+	if err := ipt.cleanup(); err != nil {
+		return err
+	}
+
+	commands := []string{
+		"-t mangle -N " + chain,
+	}
+	for _, prefix := range excluded {
+		if !prefix.Addr().Is4() {
+			continue
+		}
+		commands = append(commands, fmt.Sprintf("-t mangle -A %s -d %s -j RETURN", chain, prefix))
+	}
+	commands = append(commands,
+		// iptables -t mangle -A nordvpn-ondemand -j RETURN -m comment --comment nordvpn_ondemand
+		fmt.Sprintf("-t mangle -A %s -j RETURN -m comment --comment %s", chain, RuleComment),
+		// iptables -t mangle -I OUTPUT ! -o lo -m mark ! --mark 0xe1f1 -m conntrack --ctstate NEW -j nordvpn-ondemand -m comment --comment nordvpn_ondemand
+		fmt.Sprintf(
+			"-t mangle -I OUTPUT ! -o lo -m mark ! --mark %#x -m conntrack --ctstate NEW -j %s -m comment --comment %s",
+			ignoredMark,
+			chain,
+			RuleComment,
+		),
+	)
+
+	ipt.enabled = true
+	for _, args := range commands {
+		// #nosec G204 -- input is properly sanitized
+		out, err := ipt.runCommandFunc(iptablesCmd, strings.Fields(args)...)
+		if err != nil {
+			if err := ipt.Disable(); err != nil {
+				return err
+			}
+			return fmt.Errorf("iptables %s: %w: %s", args, err, string(out))
+		}
+	}
+	return nil
+}
+
+func (ipt *IPTables) Disable() error {
+	if !ipt.enabled {
+		return nil
+	}
+	if err := ipt.cleanup(); err != nil {
+		return err
+	}
+	ipt.enabled = false
+	return nil
+}
+
+func (ipt *IPTables) cleanup() error {
+	if err := ipt.deleteJumpRules(); err != nil {
+		return err
+	}
+
+	commands := []string{
+		"-t mangle -F " + chain,
+		"-t mangle -X " + chain,
+	}
+	for _, args := range commands {
+		// #nosec G204 -- input is properly sanitized
+		out, err := ipt.runCommandFunc(iptablesCmd, strings.Fields(args)...)
+		if err != nil && !isNotExist(out) {
+			return fmt.Errorf("iptables %s: %w: %s", args, err, string(out))
+		}
+	}
+	return nil
+}
+
+// deleteJumpRules removes the rules which jump to the counting chain
+func (ipt *IPTables) deleteJumpRules() error {
+	args := "-t mangle -L OUTPUT -n --line-numbers"
+	// #nosec G204 -- input is properly sanitized
+	out, err := ipt.runCommandFunc(iptablesCmd, strings.Fields(args)...)
+	if err != nil {
+		return fmt.Errorf("iptables listing rules: %w: %s", err, string(out))
+	}
+
+	for _, line := range bytes.Split(out, []byte{'\n'}) {
+		if !bytes.Contains(line, []byte(RuleComment)) {
+			continue
+		}
+		ruleno := strings.Fields(string(line))[0]
+		args := "-t mangle -D OUTPUT " + ruleno
+		// #nosec G204 -- input is properly sanitized
+		out, err := ipt.runCommandFunc(iptablesCmd, strings.Fields(args)...)
+		if err != nil {
+			return fmt.Errorf("iptables deleting rule: %w: %s", err, string(out))
+		}
+		// rule numbers have changed
+		return ipt.deleteJumpRules()
+	}
+	return nil
+}
+
+func (ipt *IPTables) Demand() (uint64, error) {
+	if !ipt.enabled {
+		return 0, nil
+	}
+
+	args := "-t mangle -L " + chain + " -v -x -n"
+	// #nosec G204 -- input is properly sanitized
+	out, err := ipt.runCommandFunc(iptablesCmd, strings.Fields(args)...)
+	if err != nil {
+		return 0, fmt.Errorf("iptables listing rules: %w: %s", err, string(out))
+	}
+	return demandFromOutput(out)
+}
+
+// demandFromOutput finds the packet count of the counting rule in iptables output
+func demandFromOutput(out []byte) (uint64, error) {
+	for _, line := range bytes.Split(out, []byte{'\n'}) {
+		if !bytes.Contains(line, []byte(RuleComment)) {
+			continue
+		}
+		fields := strings.Fields(string(line))
+		if len(fields) == 0 {
+			continue
+		}
+		packets, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parsing packet count: %w", err)
+		}
+		return packets, nil
+	}
+	return 0, fmt.Errorf("on demand rule was not found")
+}
+
+func isNotExist(out []byte) bool {
+	return bytes.Contains(out, []byte("No chain/target/match by that name"))
+}
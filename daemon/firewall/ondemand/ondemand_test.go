@@ -0,0 +1,102 @@
+package ondemand
+
+import (
+	"errors"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+type commandRecorder struct {
+	commands []string
+	output   map[string]string
+	failing  string
+}
+
+func (c *commandRecorder) run(command string, arg ...string) ([]byte, error) {
+	args := strings.Join(arg, " ")
+	c.commands = append(c.commands, args)
+	if c.failing != "" && strings.Contains(args, c.failing) {
+		return []byte("iptables: No chain/target/match by that name."), errors.New("exit status 1")
+	}
+	return []byte(c.output[args]), nil
+}
+
+func TestIPTables_Enable(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	recorder := commandRecorder{}
+	ipt := NewIPTables(recorder.run)
+	err := ipt.Enable([]netip.Prefix{
+		netip.MustParsePrefix("192.168.0.0/16"),
+		netip.MustParsePrefix("fd00::/8"),
+	}, 0xe1f1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"-t mangle -L OUTPUT -n --line-numbers",
+		"-t mangle -F nordvpn-ondemand",
+		"-t mangle -X nordvpn-ondemand",
+		"-t mangle -N nordvpn-ondemand",
+		"-t mangle -A nordvpn-ondemand -d 192.168.0.0/16 -j RETURN",
+		"-t mangle -A nordvpn-ondemand -j RETURN -m comment --comment nordvpn_ondemand",
+		"-t mangle -I OUTPUT ! -o lo -m mark ! --mark 0xe1f1 -m conntrack --ctstate NEW -j nordvpn-ondemand -m comment --comment nordvpn_ondemand",
+	}, recorder.commands)
+
+	recorder.commands = nil
+	assert.NoError(t, ipt.Disable())
+	assert.Len(t, recorder.commands, 3)
+
+	// already disabled
+	recorder.commands = nil
+	assert.NoError(t, ipt.Disable())
+	assert.Empty(t, recorder.commands)
+}
+
+func TestIPTables_EnableCleansUpLeftovers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	recorder := commandRecorder{
+		output: map[string]string{
+			"-t mangle -L OUTPUT -n --line-numbers": `Chain OUTPUT (policy ACCEPT)
+num  target     prot opt source               destination
+1    nordvpn-ondemand  all  --  0.0.0.0/0            0.0.0.0/0            mark match ! 0xe1f1 ctstate NEW /* nordvpn_ondemand */
+`,
+		},
+		failing: "-X nordvpn-ondemand",
+	}
+	ipt := NewIPTables(func(command string, arg ...string) ([]byte, error) {
+		out, err := recorder.run(command, arg...)
+		if strings.Join(arg, " ") == "-t mangle -D OUTPUT 1" {
+			// rule is gone after deletion
+			delete(recorder.output, "-t mangle -L OUTPUT -n --line-numbers")
+		}
+		return out, err
+	})
+	assert.NoError(t, ipt.Enable(nil, 0))
+	assert.Contains(t, recorder.commands, "-t mangle -D OUTPUT 1")
+}
+
+func TestIPTables_Demand(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	recorder := commandRecorder{output: map[string]string{
+		"-t mangle -L nordvpn-ondemand -v -x -n": `Chain nordvpn-ondemand (1 references)
+    pkts      bytes target     prot opt in     out     source               destination
+       3      180 RETURN     all  --  *      *       0.0.0.0/0            192.168.0.0/16
+      12      720 RETURN     all  --  *      *       0.0.0.0/0            0.0.0.0/0            /* nordvpn_ondemand */
+`,
+	}}
+	ipt := NewIPTables(recorder.run)
+
+	demand, err := ipt.Demand()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), demand)
+
+	assert.NoError(t, ipt.Enable(nil, 0))
+	demand, err = ipt.Demand()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(12), demand)
+}
@@ -0,0 +1,200 @@
+// Package ratelimit implements throttling of the meshnet fileshare traffic.
+package ratelimit
+
+import (
+	"bytes"
+	"fmt"
+	"net/netip"
+	"strings"
+	"sync"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	RuleComment   = "nordvpn_fileshare_limit"
+	inputChain    = "nordvpn-fileshare-in"
+	outputChain   = "nordvpn-fileshare-out"
+	iptablesCmd   = "iptables"
+	filesharePort = 49111
+)
+
+// Limiter throttles the fileshare traffic of the meshnet peers.
+type Limiter interface {
+	// Set limits the fileshare traffic to the given amount of bytes per second in
+	// each direction, 0 removes the limit. When the peer address is invalid, the
+	// limit applies to all the peers without their own limit.
+	Set(peer netip.Addr, limit uint64) error
+	// Disable removes all of the limits
+	Disable() error
+}
+
+type runCommandFunc func(command string, arg ...string) ([]byte, error)
+
+// IPTables drops the fileshare packets exceeding the limits using dedicated
+// chains in the mangle table. Mangle table is traversed before the filter table,
+// so the limits apply even to the peers which are allowed to send anything.
+// Rules in the chains either drop the packet or return to the caller.
+type IPTables struct {
+	runCommandFunc runCommandFunc
+	limit          uint64
+	peerLimits     map[netip.Addr]uint64
+	enabled        bool
+	mu             sync.Mutex
+}
+
+// NewIPTables is a default constructor for IPTables
+func NewIPTables(commandFunc runCommandFunc) *IPTables {
+	return &IPTables{
+		runCommandFunc: commandFunc,
+		peerLimits:     map[netip.Addr]uint64{},
+	}
+}
+
+func (ipt *IPTables) Set(peer netip.Addr, limit uint64) error {
+	ipt.mu.Lock()
+	defer ipt.mu.Unlock()
+
+	switch {
+	case !peer.IsValid():
+		ipt.limit = limit
+	case limit == 0:
+		delete(ipt.peerLimits, peer)
+	default:
+		ipt.peerLimits[peer] = limit
+	}
+	return ipt.apply()
+}
+
+func (ipt *IPTables) Disable() error {
+	ipt.mu.Lock()
+	defer ipt.mu.Unlock()
+
+	ipt.limit = 0
+	ipt.peerLimits = map[netip.Addr]uint64{}
+	return ipt.apply()
+}
+
+// apply replaces the rules in the system with the ones matching the current limits
+func (ipt *IPTables) apply() error {
+	limited := ipt.limit != 0 || len(ipt.peerLimits) != 0
+	if !ipt.enabled && !limited {
+		return nil
+	}
+	// rules might be left from the previous run
+	if err := ipt.cleanup(); err != nil {
+		return err
+	}
+	ipt.enabled = false
+	if !limited {
+		return nil
+	}
+
+	commands := []string{
+		"-t mangle -N " + inputChain,
+		"-t mangle -N " + outputChain,
+	}
+
+	peers := maps.Keys(ipt.peerLimits)
+	slices.SortFunc(peers, func(a, b netip.Addr) bool { return a.Less(b) })
+	for i, peer := range peers {
+		// packets of the peers with their own limit skip the default one
+		commands = append(commands,
+			// iptables -t mangle -A nordvpn-fileshare-in -s 100.64.0.2 -m hashlimit --hashlimit-above 1024kb/s --hashlimit-name nordfs-in-1 -j DROP
+			fmt.Sprintf("-t mangle -A %s -s %s %s -j DROP", inputChain, peer, hashlimit(ipt.peerLimits[peer], "in", i+1)),
+			fmt.Sprintf("-t mangle -A %s -s %s -j RETURN", inputChain, peer),
+			fmt.Sprintf("-t mangle -A %s -d %s %s -j DROP", outputChain, peer, hashlimit(ipt.peerLimits[peer], "out", i+1)),
+			fmt.Sprintf("-t mangle -A %s -d %s -j RETURN", outputChain, peer),
+		)
+	}
+	if ipt.limit != 0 {
+		commands = append(commands,
+			fmt.Sprintf("-t mangle -A %s %s -j DROP", inputChain, hashlimit(ipt.limit, "in", 0)),
+			fmt.Sprintf("-t mangle -A %s %s -j DROP", outputChain, hashlimit(ipt.limit, "out", 0)),
+		)
+	}
+	commands = append(commands,
+		// iptables -t mangle -I INPUT -p tcp --dport 49111 -j nordvpn-fileshare-in -m comment --comment nordvpn_fileshare_limit
+		fmt.Sprintf("-t mangle -I INPUT -p tcp --dport %d -j %s -m comment --comment %s",
+			filesharePort, inputChain, RuleComment),
+		fmt.Sprintf("-t mangle -I OUTPUT -p tcp --dport %d -j %s -m comment --comment %s",
+			filesharePort, outputChain, RuleComment),
+	)
+
+	ipt.enabled = true
+	for _, args := range commands {
+		// #nosec G204 -- input is properly sanitized
+		out, err := ipt.runCommandFunc(iptablesCmd, strings.Fields(args)...)
+		if err != nil {
+			if err := ipt.cleanup(); err != nil {
+				return err
+			}
+			ipt.enabled = false
+			return fmt.Errorf("iptables %s: %w: %s", args, err, string(out))
+		}
+	}
+	return nil
+}
+
+// hashlimit returns the match of the packets exceeding the limit. Names of the
+// hash tables are limited to 15 characters, so the index is used to tell them
+// apart.
+func hashlimit(limit uint64, direction string, index int) string {
+	kilobytes := (limit + 1023) / 1024
+	return fmt.Sprintf("-m hashlimit --hashlimit-above %dkb/s --hashlimit-name nordfs-%s-%d",
+		kilobytes, direction, index)
+}
+
+func (ipt *IPTables) cleanup() error {
+	for _, chain := range []string{"INPUT", "OUTPUT"} {
+		if err := ipt.deleteJumpRules(chain); err != nil {
+			return err
+		}
+	}
+
+	commands := []string{
+		"-t mangle -F " + inputChain,
+		"-t mangle -X " + inputChain,
+		"-t mangle -F " + outputChain,
+		"-t mangle -X " + outputChain,
+	}
+	for _, args := range commands {
+		// #nosec G204 -- input is properly sanitized
+		out, err := ipt.runCommandFunc(iptablesCmd, strings.Fields(args)...)
+		if err != nil && !isNotExist(out) {
+			return fmt.Errorf("iptables %s: %w: %s", args, err, string(out))
+		}
+	}
+	return nil
+}
+
+// deleteJumpRules removes the rules which jump to the limiting chains
+func (ipt *IPTables) deleteJumpRules(chain string) error {
+	args := "-t mangle -L " + chain + " -n --line-numbers"
+	// #nosec G204 -- input is properly sanitized
+	out, err := ipt.runCommandFunc(iptablesCmd, strings.Fields(args)...)
+	if err != nil {
+		return fmt.Errorf("iptables listing rules: %w: %s", err, string(out))
+	}
+
+	for _, line := range bytes.Split(out, []byte{'\n'}) {
+		if !bytes.Contains(line, []byte(RuleComment)) {
+			continue
+		}
+		ruleno := strings.Fields(string(line))[0]
+		args := "-t mangle -D " + chain + " " + ruleno
+		// #nosec G204 -- input is properly sanitized
+		out, err := ipt.runCommandFunc(iptablesCmd, strings.Fields(args)...)
+		if err != nil {
+			return fmt.Errorf("iptables deleting rule: %w: %s", err, string(out))
+		}
+		// rule numbers have changed
+		return ipt.deleteJumpRules(chain)
+	}
+	return nil
+}
+
+func isNotExist(out []byte) bool {
+	return bytes.Contains(out, []byte("No chain/target/match by that name"))
+}
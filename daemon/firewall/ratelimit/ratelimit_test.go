@@ -0,0 +1,121 @@
+package ratelimit
+
+import (
+	"errors"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+type commandRecorder struct {
+	commands []string
+	output   map[string]string
+	failing  string
+}
+
+func (c *commandRecorder) run(command string, arg ...string) ([]byte, error) {
+	args := strings.Join(arg, " ")
+	c.commands = append(c.commands, args)
+	if c.failing != "" && strings.Contains(args, c.failing) {
+		return []byte("iptables: No chain/target/match by that name."), errors.New("exit status 1")
+	}
+	return []byte(c.output[args]), nil
+}
+
+var cleanupCommands = []string{
+	"-t mangle -L INPUT -n --line-numbers",
+	"-t mangle -L OUTPUT -n --line-numbers",
+	"-t mangle -F nordvpn-fileshare-in",
+	"-t mangle -X nordvpn-fileshare-in",
+	"-t mangle -F nordvpn-fileshare-out",
+	"-t mangle -X nordvpn-fileshare-out",
+}
+
+func TestIPTables_Set(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	recorder := commandRecorder{}
+	ipt := NewIPTables(recorder.run)
+
+	assert.NoError(t, ipt.Set(netip.Addr{}, 5*1024*1024))
+	assert.Equal(t, append(cleanupCommands,
+		"-t mangle -N nordvpn-fileshare-in",
+		"-t mangle -N nordvpn-fileshare-out",
+		"-t mangle -A nordvpn-fileshare-in -m hashlimit --hashlimit-above 5120kb/s --hashlimit-name nordfs-in-0 -j DROP",
+		"-t mangle -A nordvpn-fileshare-out -m hashlimit --hashlimit-above 5120kb/s --hashlimit-name nordfs-out-0 -j DROP",
+		"-t mangle -I INPUT -p tcp --dport 49111 -j nordvpn-fileshare-in -m comment --comment nordvpn_fileshare_limit",
+		"-t mangle -I OUTPUT -p tcp --dport 49111 -j nordvpn-fileshare-out -m comment --comment nordvpn_fileshare_limit",
+	), recorder.commands)
+
+	recorder.commands = nil
+	assert.NoError(t, ipt.Set(netip.MustParseAddr("100.64.0.3"), 1000))
+	assert.NoError(t, ipt.Set(netip.MustParseAddr("100.64.0.2"), 20*1024*1024))
+	assert.Equal(t, append(cleanupCommands,
+		"-t mangle -N nordvpn-fileshare-in",
+		"-t mangle -N nordvpn-fileshare-out",
+		"-t mangle -A nordvpn-fileshare-in -s 100.64.0.2 -m hashlimit --hashlimit-above 20480kb/s --hashlimit-name nordfs-in-1 -j DROP",
+		"-t mangle -A nordvpn-fileshare-in -s 100.64.0.2 -j RETURN",
+		"-t mangle -A nordvpn-fileshare-out -d 100.64.0.2 -m hashlimit --hashlimit-above 20480kb/s --hashlimit-name nordfs-out-1 -j DROP",
+		"-t mangle -A nordvpn-fileshare-out -d 100.64.0.2 -j RETURN",
+		"-t mangle -A nordvpn-fileshare-in -s 100.64.0.3 -m hashlimit --hashlimit-above 1kb/s --hashlimit-name nordfs-in-2 -j DROP",
+		"-t mangle -A nordvpn-fileshare-in -s 100.64.0.3 -j RETURN",
+		"-t mangle -A nordvpn-fileshare-out -d 100.64.0.3 -m hashlimit --hashlimit-above 1kb/s --hashlimit-name nordfs-out-2 -j DROP",
+		"-t mangle -A nordvpn-fileshare-out -d 100.64.0.3 -j RETURN",
+		"-t mangle -A nordvpn-fileshare-in -m hashlimit --hashlimit-above 5120kb/s --hashlimit-name nordfs-in-0 -j DROP",
+		"-t mangle -A nordvpn-fileshare-out -m hashlimit --hashlimit-above 5120kb/s --hashlimit-name nordfs-out-0 -j DROP",
+		"-t mangle -I INPUT -p tcp --dport 49111 -j nordvpn-fileshare-in -m comment --comment nordvpn_fileshare_limit",
+		"-t mangle -I OUTPUT -p tcp --dport 49111 -j nordvpn-fileshare-out -m comment --comment nordvpn_fileshare_limit",
+	), recorder.commands[len(recorder.commands)-len(cleanupCommands)-14:])
+
+	// removing all of the limits removes the chains
+	recorder.commands = nil
+	assert.NoError(t, ipt.Set(netip.MustParseAddr("100.64.0.2"), 0))
+	assert.NoError(t, ipt.Set(netip.MustParseAddr("100.64.0.3"), 0))
+	assert.NoError(t, ipt.Set(netip.Addr{}, 0))
+	assert.Equal(t, cleanupCommands, recorder.commands[len(recorder.commands)-len(cleanupCommands):])
+
+	recorder.commands = nil
+	assert.NoError(t, ipt.Disable())
+	assert.Empty(t, recorder.commands)
+}
+
+func TestIPTables_SetFailure(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	recorder := commandRecorder{failing: "hashlimit"}
+	ipt := NewIPTables(recorder.run)
+	assert.Error(t, ipt.Set(netip.Addr{}, 1024))
+	// chains are removed after the failure
+	assert.Equal(t, cleanupCommands, recorder.commands[len(recorder.commands)-len(cleanupCommands):])
+
+	recorder.commands = nil
+	assert.NoError(t, ipt.Disable())
+	assert.Empty(t, recorder.commands)
+}
+
+func TestIPTables_CleansUpLeftovers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	recorder := commandRecorder{
+		output: map[string]string{
+			"-t mangle -L INPUT -n --line-numbers": `Chain INPUT (policy ACCEPT)
+num  target     prot opt source               destination
+1    nordvpn-fileshare-in  tcp  --  0.0.0.0/0            0.0.0.0/0            tcp dpt:49111 /* nordvpn_fileshare_limit */
+`,
+		},
+		failing: "-X nordvpn-fileshare",
+	}
+	ipt := NewIPTables(func(command string, arg ...string) ([]byte, error) {
+		out, err := recorder.run(command, arg...)
+		if strings.Join(arg, " ") == "-t mangle -D INPUT 1" {
+			// rule is gone after deletion
+			delete(recorder.output, "-t mangle -L INPUT -n --line-numbers")
+		}
+		return out, err
+	})
+	assert.NoError(t, ipt.Set(netip.Addr{}, 1024))
+	assert.Contains(t, recorder.commands, "-t mangle -D INPUT 1")
+}
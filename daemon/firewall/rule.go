@@ -29,6 +29,10 @@ const (
 	New
 )
 
+// LogPrefix is prepended to the kernel log entries of the packets denied by the
+// rules with Log set
+const LogPrefix = "nordvpn-denied:"
+
 // Direction defines a direction of packages to which rule is applicable
 type Direction int
 
@@ -75,6 +79,9 @@ type Rule struct {
 	Marks []uint32
 	// Allow defines if rule denies packets via current rule or allows them
 	Allow bool `json:"allow"`
+	// Log defines that a rate limited summary of the denied packets is written to
+	// the kernel log with LogPrefix
+	Log bool `json:"log"`
 
 	Ipv6Only         bool   `json:"ipv6_only"`
 	Icmpv6Types      []int  `json:"icmp6_types"`
@@ -96,6 +103,7 @@ func (r Rule) Equal(other Rule) bool {
 		r.ConnectionStates.Equal(other.ConnectionStates) &&
 		slices.Equal(r.Marks, other.Marks) &&
 		r.Allow == other.Allow &&
+		r.Log == other.Log &&
 		r.Ipv6Only == other.Ipv6Only &&
 		slices.Equal(r.Icmpv6Types, other.Icmpv6Types) &&
 		r.HopLimit == other.HopLimit &&
@@ -12,6 +12,8 @@ type Service interface {
 	Enable() error
 	// Disable firewall
 	Disable() error
+	// Rebuild removes the rules from the system and applies them again
+	Rebuild() (Reconciliation, error)
 }
 
 // Agent carries out required firewall changes.
@@ -22,4 +24,6 @@ type Agent interface {
 	Add(Rule) error
 	// Delete a firewall rule
 	Delete(Rule) error
+	// Exists returns true if the firewall rule is applied
+	Exists(Rule) (bool, error)
 }
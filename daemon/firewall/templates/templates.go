@@ -0,0 +1,231 @@
+// Package templates applies user supplied firewall rules at well-defined points
+// of the connection lifecycle.
+//
+// Template is a root-owned file with one iptables rule per line in the
+// iptables-save format, e.g.:
+//
+//	# allow SSH from the office network
+//	-A INPUT -s 192.0.2.0/24 -p tcp --dport 22 -j ACCEPT
+//
+// Empty lines and lines starting with # are ignored. Only -A command is supported
+// and only INPUT, OUTPUT and FORWARD chains of the filter table can be used.
+//
+// Ordering guarantees:
+//   - rules of a template are appended to the end of the chains in the order
+//     they appear in the file
+//   - app rules are always inserted at the beginning of the chains, therefore
+//     template rules are evaluated only after the app rules, e.g. they cannot
+//     override the kill switch
+//   - template rules are never touched by the app rules and survive connect and
+//     disconnect transitions, unless their stage ends
+package templates
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"syscall"
+
+	"golang.org/x/exp/slices"
+)
+
+// Stage defines when template rules are present
+type Stage string
+
+const (
+	// Persistent rules are present while the daemon is running
+	Persistent Stage = "persistent"
+	// PreConnect rules are applied before connecting and removed after disconnecting
+	PreConnect Stage = "pre-connect"
+	// PostDisconnect rules are applied after disconnecting and removed before connecting
+	PostDisconnect Stage = "post-disconnect"
+)
+
+const (
+	commentPrefix = "nordvpn-template-"
+	iptablesCmd   = "iptables"
+)
+
+var (
+	// ErrNotRootOwned is returned when template can be modified by non-root users
+	ErrNotRootOwned = errors.New("template must be owned by root and writable only by the owner")
+
+	allowedChains = []string{"INPUT", "OUTPUT", "FORWARD"}
+	// options which would change something else than a single rule in filter table
+	forbiddenOptions = []string{
+		"-t", "--table",
+		"-I", "--insert", "-D", "--delete", "-R", "--replace",
+		"-F", "--flush", "-Z", "--zero", "-N", "--new-chain",
+		"-X", "--delete-chain", "-P", "--policy", "-E", "--rename-chain",
+		"-L", "--list", "-S", "--list-rules",
+	}
+)
+
+// Manager applies and removes template rules
+type Manager interface {
+	// Validate checks whether the template can be applied
+	Validate(path string) error
+	// Apply replaces the rules of the stage with the rules from the template
+	Apply(stage Stage, path string) error
+	// Remove the rules of the stage
+	Remove(stage Stage) error
+}
+
+type runCommandFunc func(command string, arg ...string) ([]byte, error)
+
+// IPTables applies template rules using iptables. Applied rules are tracked by
+// the comment, so leftovers of the previous run are removed as well.
+type IPTables struct {
+	runCommandFunc runCommandFunc
+	readFileFunc   func(path string) ([]byte, error)
+}
+
+// NewIPTables is a default constructor for IPTables
+func NewIPTables(commandFunc runCommandFunc) *IPTables {
+	return &IPTables{
+		runCommandFunc: commandFunc,
+		readFileFunc:   readRootOwnedFile,
+	}
+}
+
+func (ipt *IPTables) Validate(path string) error {
+	rules, err := ipt.load(path)
+	if err != nil {
+		return err
+	}
+	return ipt.check(rules)
+}
+
+func (ipt *IPTables) Apply(stage Stage, path string) error {
+	rules, err := ipt.load(path)
+	if err != nil {
+		return err
+	}
+	// nothing is changed unless all of the rules are valid
+	if err := ipt.check(rules); err != nil {
+		return err
+	}
+
+	if err := ipt.Remove(stage); err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		args := append(slices.Clone(rule), "-m", "comment", "--comment", commentPrefix+string(stage))
+		// #nosec G204 -- input is properly sanitized
+		out, err := ipt.runCommandFunc(iptablesCmd, args...)
+		if err != nil {
+			if err := ipt.Remove(stage); err != nil {
+				return err
+			}
+			return fmt.Errorf("iptables %s: %w: %s", strings.Join(args, " "), err, string(out))
+		}
+	}
+	return nil
+}
+
+func (ipt *IPTables) Remove(stage Stage) error {
+	// #nosec G204 -- input is properly sanitized
+	out, err := ipt.runCommandFunc(iptablesCmd, "-S")
+	if err != nil {
+		return fmt.Errorf("iptables listing rules: %w: %s", err, string(out))
+	}
+
+	comment := commentPrefix + string(stage)
+	for _, line := range bytes.Split(out, []byte{'\n'}) {
+		args := strings.Fields(string(line))
+		if len(args) < 2 || args[0] != "-A" || !slices.Contains(args, comment) {
+			continue
+		}
+		args[0] = "-D"
+		// #nosec G204 -- input is properly sanitized
+		out, err := ipt.runCommandFunc(iptablesCmd, args...)
+		if err != nil {
+			return fmt.Errorf("iptables deleting rule: %w: %s", err, string(out))
+		}
+	}
+	return nil
+}
+
+// load reads and parses the template
+func (ipt *IPTables) load(path string) ([][]string, error) {
+	data, err := ipt.readFileFunc(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading template %s: %w", path, err)
+	}
+	rules, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing template %s: %w", path, err)
+	}
+	return rules, nil
+}
+
+// check asks iptables to parse the rules. Exit status 1 means that the rule was
+// parsed, but does not exist, while invalid rules exit with status 2.
+func (ipt *IPTables) check(rules [][]string) error {
+	for _, rule := range rules {
+		args := append([]string{"-C"}, rule[1:]...)
+		// #nosec G204 -- input is properly sanitized
+		out, err := ipt.runCommandFunc(iptablesCmd, args...)
+		if err == nil || bytes.Contains(out, []byte("does a matching rule exist")) {
+			continue
+		}
+		return fmt.Errorf("invalid rule '%s': %s", strings.Join(rule, " "), strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// Parse template rules and validate that they only append rules to the
+// supported chains
+func Parse(data []byte) ([][]string, error) {
+	var rules [][]string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.ContainsAny(line, `"'`) {
+			return nil, fmt.Errorf("line %d: quotes are not supported", lineNo)
+		}
+
+		args := strings.Fields(line)
+		if args[0] != "-A" && args[0] != "--append" {
+			return nil, fmt.Errorf("line %d: rule must start with -A", lineNo)
+		}
+		if len(args) < 2 || !slices.Contains(allowedChains, args[1]) {
+			return nil, fmt.Errorf("line %d: chain must be one of %s", lineNo, strings.Join(allowedChains, ", "))
+		}
+		for _, arg := range args[2:] {
+			if slices.Contains(forbiddenOptions, arg) {
+				return nil, fmt.Errorf("line %d: option %s is not allowed", lineNo, arg)
+			}
+		}
+		if !slices.Contains(args, "-j") && !slices.Contains(args, "--jump") {
+			return nil, fmt.Errorf("line %d: rule must have a target", lineNo)
+		}
+		args[0] = "-A"
+		rules = append(rules, args)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// readRootOwnedFile refuses to read files which can be modified by other users
+// than root, because their rules would be applied with root privileges
+func readRootOwnedFile(path string) ([]byte, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok || stat.Uid != 0 || info.Mode().Perm()&0022 != 0 || !info.Mode().IsRegular() {
+		return nil, ErrNotRootOwned
+	}
+	// #nosec G304 -- file is owned by root
+	return os.ReadFile(path)
+}
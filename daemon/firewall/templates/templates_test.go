@@ -0,0 +1,151 @@
+package templates
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+type commandRecorder struct {
+	commands []string
+	output   map[string]string
+	invalid  string
+}
+
+func (c *commandRecorder) run(command string, arg ...string) ([]byte, error) {
+	args := strings.Join(arg, " ")
+	c.commands = append(c.commands, args)
+	if strings.HasPrefix(args, "-C") {
+		if c.invalid != "" && strings.Contains(args, c.invalid) {
+			return []byte("iptables v1.8.7 (nf_tables): unknown option \"" + c.invalid + "\""), errors.New("exit status 2")
+		}
+		return []byte("iptables: Bad rule (does a matching rule exist in that chain?)."), errors.New("exit status 1")
+	}
+	return []byte(c.output[args]), nil
+}
+
+func newTestIPTables(recorder *commandRecorder, template string) *IPTables {
+	ipt := NewIPTables(recorder.run)
+	ipt.readFileFunc = func(string) ([]byte, error) { return []byte(template), nil }
+	return ipt
+}
+
+func TestParse(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		template string
+		expected [][]string
+		hasError bool
+	}{
+		{
+			name: "valid",
+			template: `# comment
+
+-A INPUT -s 192.0.2.0/24 -p tcp --dport 22 -j ACCEPT
+  --append OUTPUT -d 192.0.2.1 -j DROP
+`,
+			expected: [][]string{
+				{"-A", "INPUT", "-s", "192.0.2.0/24", "-p", "tcp", "--dport", "22", "-j", "ACCEPT"},
+				{"-A", "OUTPUT", "-d", "192.0.2.1", "-j", "DROP"},
+			},
+		},
+		{
+			name:     "empty",
+			template: "# nothing here\n",
+		},
+		{
+			name:     "insert",
+			template: "-I INPUT -j ACCEPT",
+			hasError: true,
+		},
+		{
+			name:     "unsupported chain",
+			template: "-A PREROUTING -j ACCEPT",
+			hasError: true,
+		},
+		{
+			name:     "other table",
+			template: "-A OUTPUT -t nat -j ACCEPT",
+			hasError: true,
+		},
+		{
+			name:     "flush",
+			template: "-A OUTPUT -j ACCEPT -F",
+			hasError: true,
+		},
+		{
+			name:     "no target",
+			template: "-A OUTPUT -d 192.0.2.1",
+			hasError: true,
+		},
+		{
+			name:     "quotes",
+			template: `-A OUTPUT -m comment --comment "my rule" -j ACCEPT`,
+			hasError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rules, err := Parse([]byte(test.template))
+			if test.hasError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, rules)
+		})
+	}
+}
+
+func TestIPTables_Apply(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	recorder := commandRecorder{output: map[string]string{
+		"-S": `-P INPUT ACCEPT
+-A INPUT -s 192.0.2.0/24 -m comment --comment nordvpn-template-persistent -j ACCEPT
+-A INPUT -s 198.51.100.0/24 -m comment --comment nordvpn-template-pre-connect -j ACCEPT
+`,
+	}}
+	ipt := newTestIPTables(&recorder, "-A INPUT -s 203.0.113.0/24 -j ACCEPT\n-A OUTPUT -d 203.0.113.1 -j DROP")
+
+	assert.NoError(t, ipt.Apply(PreConnect, "/etc/nordvpn/pre-connect.rules"))
+	assert.Equal(t, []string{
+		"-C INPUT -s 203.0.113.0/24 -j ACCEPT",
+		"-C OUTPUT -d 203.0.113.1 -j DROP",
+		"-S",
+		// only the rules of the same stage are replaced
+		"-D INPUT -s 198.51.100.0/24 -m comment --comment nordvpn-template-pre-connect -j ACCEPT",
+		"-A INPUT -s 203.0.113.0/24 -j ACCEPT -m comment --comment nordvpn-template-pre-connect",
+		"-A OUTPUT -d 203.0.113.1 -j DROP -m comment --comment nordvpn-template-pre-connect",
+	}, recorder.commands)
+}
+
+func TestIPTables_ApplyInvalidRule(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	recorder := commandRecorder{invalid: "--bogus"}
+	ipt := newTestIPTables(&recorder, "-A INPUT -s 203.0.113.0/24 -j ACCEPT\n-A INPUT --bogus -j ACCEPT")
+
+	assert.Error(t, ipt.Apply(Persistent, "/etc/nordvpn/persistent.rules"))
+	// nothing is applied
+	for _, command := range recorder.commands {
+		assert.True(t, strings.HasPrefix(command, "-C"), command)
+	}
+}
+
+func TestReadRootOwnedFile(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	_, err := readRootOwnedFile("/nonexistent/template.rules")
+	assert.Error(t, err)
+
+	// directories are refused
+	_, err = readRootOwnedFile("/")
+	assert.ErrorIs(t, err, ErrNotRootOwned)
+}
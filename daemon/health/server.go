@@ -0,0 +1,126 @@
+// Package health responds to the liveness and readiness probes of the container
+// orchestrators, e.g. Kubernetes or docker HEALTHCHECK, over HTTP.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+const (
+	// HealthPath responds with 200 only if the VPN can be used, i.e. the daemon
+	// is ready, the tunnel is up and the traffic is routed through it. Otherwise
+	// it responds with 503 and the reason.
+	HealthPath = "/healthz"
+	// LivenessPath responds with 200 as long as the daemon is running
+	LivenessPath   = "/livez"
+	contentType    = "text/plain; charset=utf-8"
+	requestTimeout = 10 * time.Second
+)
+
+// ErrInvalidAddress is returned when the address is not an IP address with a port
+var ErrInvalidAddress = errors.New("health address must be an IP address with a port")
+
+// IsValidAddress checks whether the address is an IP address with a port, e.g. 0.0.0.0:9103
+func IsValidAddress(address string) bool {
+	addrPort, err := netip.ParseAddrPort(address)
+	return err == nil && addrPort.Port() != 0
+}
+
+// CheckFunc returns nil if healthy or the reason why not
+type CheckFunc func() error
+
+// Server serves the health of the daemon over HTTP
+type Server struct {
+	check  CheckFunc
+	server *http.Server
+}
+
+// NewServer creates a stopped server
+func NewServer(check CheckFunc) *Server {
+	return &Server{check: check}
+}
+
+// Start serving on the address
+func (s *Server) Start(address string) error {
+	if !IsValidAddress(address) {
+		return ErrInvalidAddress
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("listening on %s: %w", address, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(HealthPath, s.handleHealth)
+	mux.HandleFunc(LivenessPath, handleLiveness)
+	s.server = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: requestTimeout,
+		WriteTimeout:      requestTimeout,
+	}
+	go func(server *http.Server) {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Println(internal.ErrorPrefix, "serving health:", err)
+		}
+	}(s.server)
+	log.Println(internal.InfoPrefix, "serving health on", address)
+	return nil
+}
+
+// Stop the server if it is running
+func (s *Server) Stop() error {
+	if s.server == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	err := s.server.Shutdown(ctx)
+	s.server = nil
+	if err != nil {
+		return fmt.Errorf("stopping health server: %w", err)
+	}
+	return nil
+}
+
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	if !allowedMethod(w, r) {
+		return
+	}
+	if err := s.check(); err != nil {
+		write(w, http.StatusServiceUnavailable, err.Error())
+		return
+	}
+	write(w, http.StatusOK, "ok")
+}
+
+func handleLiveness(w http.ResponseWriter, r *http.Request) {
+	if !allowedMethod(w, r) {
+		return
+	}
+	write(w, http.StatusOK, "ok")
+}
+
+func allowedMethod(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return false
+	}
+	return true
+}
+
+func write(w http.ResponseWriter, code int, body string) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(code)
+	if _, err := fmt.Fprintln(w, body); err != nil {
+		log.Println(internal.WarningPrefix, "writing health response:", err)
+	}
+}
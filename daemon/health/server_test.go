@@ -0,0 +1,78 @@
+package health
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer_Handle(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		checkErr     error
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "healthy",
+			method:       http.MethodGet,
+			path:         HealthPath,
+			expectedCode: http.StatusOK,
+			expectedBody: "ok\n",
+		},
+		{
+			name:         "not healthy",
+			method:       http.MethodGet,
+			path:         HealthPath,
+			checkErr:     errors.New("not connected to VPN"),
+			expectedCode: http.StatusServiceUnavailable,
+			expectedBody: "not connected to VPN\n",
+		},
+		{
+			name:         "alive while not healthy",
+			method:       http.MethodGet,
+			path:         LivenessPath,
+			checkErr:     errors.New("not connected to VPN"),
+			expectedCode: http.StatusOK,
+			expectedBody: "ok\n",
+		},
+		{
+			name:         "method not allowed",
+			method:       http.MethodPost,
+			path:         HealthPath,
+			expectedCode: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := NewServer(func() error { return test.checkErr })
+			mux := http.NewServeMux()
+			mux.HandleFunc(HealthPath, server.handleHealth)
+			mux.HandleFunc(LivenessPath, handleLiveness)
+			recorder := httptest.NewRecorder()
+
+			mux.ServeHTTP(recorder, httptest.NewRequest(test.method, test.path, nil))
+
+			assert.Equal(t, test.expectedCode, recorder.Code)
+			assert.Equal(t, test.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestIsValidAddress(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.True(t, IsValidAddress("0.0.0.0:9103"))
+	assert.True(t, IsValidAddress("[::]:9103"))
+	assert.False(t, IsValidAddress("localhost:9103"))
+	assert.False(t, IsValidAddress("0.0.0.0"))
+}
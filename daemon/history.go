@@ -0,0 +1,165 @@
+package daemon
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+)
+
+// maxHistoryEntries bounds the connection history, the oldest connections are dropped
+const maxHistoryEntries = 200
+
+// Reasons of the disconnects recorded in the connection history
+const (
+	disconnectReasonUser        = "user"
+	disconnectReasonIdle        = "idle"
+	disconnectReasonOnDemand    = "on-demand"
+	disconnectReasonPause       = "paused"
+	disconnectReasonTrusted     = "trusted network"
+	disconnectReasonCredentials = "credentials rotated"
+	disconnectReasonLogout      = "logout"
+	disconnectReasonDefaults    = "settings reset"
+	disconnectReasonFailure     = "connection failed"
+	// disconnectReasonInterference is used when the connection is switched to
+	// an obfuscated server
+	disconnectReasonInterference = "network interference"
+	// disconnectReasonFailover is used when the connection is moved to an
+	// equivalent server
+	disconnectReasonFailover = "server failover"
+	// disconnectReasonInterrupted is used when the connection ended without the
+	// daemon noticing, e.g. on restart or on reconnect to another server
+	disconnectReasonInterrupted = "interrupted"
+)
+
+// HistoryEntry is a single VPN connection
+type HistoryEntry struct {
+	Hostname   string
+	Country    string
+	City       string
+	Technology config.Technology
+	Protocol   config.Protocol
+	// ConnectedAt is when the connection was established
+	ConnectedAt time.Time
+	// DisconnectedAt is zero while the connection is active
+	DisconnectedAt   time.Time
+	Download         uint64
+	Upload           uint64
+	DisconnectReason string
+}
+
+// IsActive reports whether the connection was not finished yet
+func (e HistoryEntry) IsActive() bool {
+	return e.DisconnectedAt.IsZero()
+}
+
+// ConnectionHistory keeps the latest connections on disk so that they outlive
+// daemon restarts
+type ConnectionHistory struct {
+	filePath string
+	entries  []HistoryEntry
+	mu       sync.Mutex
+}
+
+// NewConnectionHistory loads the connection history from the file. Missing or
+// corrupted file results in an empty history.
+func NewConnectionHistory(filePath string) *ConnectionHistory {
+	h := &ConnectionHistory{filePath: filePath}
+	if err := h.load(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Println(internal.WarningPrefix, "loading connection history:", err)
+	}
+	return h
+}
+
+// Add records a new connection. The previous connection, if still active, is
+// marked as interrupted.
+func (h *ConnectionHistory) Add(entry HistoryEntry) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if last := len(h.entries) - 1; last >= 0 && h.entries[last].IsActive() {
+		h.entries[last].DisconnectedAt = entry.ConnectedAt
+		h.entries[last].DisconnectReason = disconnectReasonInterrupted
+	}
+	h.entries = append(h.entries, entry)
+	if len(h.entries) > maxHistoryEntries {
+		h.entries = h.entries[len(h.entries)-maxHistoryEntries:]
+	}
+	return h.save()
+}
+
+// Finish ends the active connection with the given reason and the amount of
+// transferred data. Nothing is done if no connection is active.
+func (h *ConnectionHistory) Finish(at time.Time, reason string, download uint64, upload uint64) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	last := len(h.entries) - 1
+	if last < 0 || !h.entries[last].IsActive() {
+		return nil
+	}
+	h.entries[last].DisconnectedAt = at
+	h.entries[last].DisconnectReason = reason
+	h.entries[last].Download = download
+	h.entries[last].Upload = upload
+	return h.save()
+}
+
+// Since returns the connections which were active at or after the given time,
+// the oldest first
+func (h *ConnectionHistory) Since(since time.Time) []HistoryEntry {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var entries []HistoryEntry
+	for _, entry := range h.entries {
+		if entry.IsActive() || !entry.DisconnectedAt.Before(since) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func (h *ConnectionHistory) load() error {
+	content, err := internal.FileRead(h.filePath)
+	if err != nil {
+		return err
+	}
+	return gob.NewDecoder(bytes.NewReader(content)).Decode(&h.entries)
+}
+
+func (h *ConnectionHistory) save() error {
+	buffer := &bytes.Buffer{}
+	if err := gob.NewEncoder(buffer).Encode(h.entries); err != nil {
+		return err
+	}
+	return internal.FileWrite(h.filePath, buffer.Bytes(), internal.PermUserRW)
+}
+
+// recordConnect adds the established connection to the history
+func (r *RPC) recordConnect(entry HistoryEntry) {
+	if r.history == nil {
+		return
+	}
+	if err := r.history.Add(entry); err != nil {
+		log.Println(internal.WarningPrefix, "recording connection:", err)
+	}
+}
+
+// recordDisconnect finishes the active connection in the history. The status
+// must be retrieved before stopping the VPN, afterwards the transfer is unknown.
+func (r *RPC) recordDisconnect(status networker.ConnectionStatus, reason string) {
+	if r.history == nil {
+		return
+	}
+	if err := r.history.Finish(time.Now(), reason, status.Download, status.Upload); err != nil {
+		log.Println(internal.WarningPrefix, "recording disconnect:", err)
+	}
+}
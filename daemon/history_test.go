@@ -0,0 +1,60 @@
+package daemon
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectionHistory(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	path := filepath.Join(t.TempDir(), "history.dat")
+	start := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	history := NewConnectionHistory(path)
+	require.NoError(t, history.Add(HistoryEntry{Hostname: "de1.nordvpn.com", ConnectedAt: start}))
+	require.NoError(t, history.Finish(start.Add(time.Hour), disconnectReasonUser, 100, 10))
+	// finishing without an active connection is a no-op
+	require.NoError(t, history.Finish(start.Add(2*time.Hour), disconnectReasonIdle, 1, 1))
+	require.NoError(t, history.Add(HistoryEntry{Hostname: "lt1.nordvpn.com", ConnectedAt: start.Add(3 * time.Hour)}))
+	require.NoError(t, history.Add(HistoryEntry{Hostname: "lv1.nordvpn.com", ConnectedAt: start.Add(4 * time.Hour)}))
+
+	// history survives restarts
+	entries := NewConnectionHistory(path).Since(time.Time{})
+	assert.Len(t, entries, 3)
+
+	assert.Equal(t, "de1.nordvpn.com", entries[0].Hostname)
+	assert.Equal(t, start.Add(time.Hour), entries[0].DisconnectedAt.UTC())
+	assert.Equal(t, disconnectReasonUser, entries[0].DisconnectReason)
+	assert.Equal(t, uint64(100), entries[0].Download)
+	assert.Equal(t, uint64(10), entries[0].Upload)
+
+	assert.Equal(t, "lt1.nordvpn.com", entries[1].Hostname)
+	assert.Equal(t, start.Add(4*time.Hour), entries[1].DisconnectedAt.UTC())
+	assert.Equal(t, disconnectReasonInterrupted, entries[1].DisconnectReason)
+
+	assert.True(t, entries[2].IsActive())
+
+	entries = history.Since(start.Add(2 * time.Hour))
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "lt1.nordvpn.com", entries[0].Hostname)
+}
+
+func TestConnectionHistory_Bounded(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	history := NewConnectionHistory(filepath.Join(t.TempDir(), "history.dat"))
+	start := time.Now()
+	for i := 0; i < maxHistoryEntries+5; i++ {
+		require.NoError(t, history.Add(HistoryEntry{ConnectedAt: start.Add(time.Duration(i) * time.Minute)}))
+	}
+
+	entries := history.Since(time.Time{})
+	assert.Len(t, entries, maxHistoryEntries)
+	assert.True(t, entries[0].ConnectedAt.Equal(start.Add(5*time.Minute)))
+}
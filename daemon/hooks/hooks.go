@@ -0,0 +1,184 @@
+// Package hooks runs administrator supplied scripts at the stages of the VPN
+// connection lifecycle, similar to the up and down scripts of OpenVPN.
+//
+// Scripts are looked up in the <stage>.d subdirectories of the hooks directory,
+// e.g. /etc/nordvpn/hooks/post-connect.d, and run in the lexical order of their
+// names. Like with run-parts, only the names consisting of letters, digits,
+// underscores and hyphens are run, so that backup and package manager files
+// are skipped. Scripts must be executable, owned by root and writable only by
+// the owner, because they run with root privileges.
+//
+// The connection is described by the environment variables:
+//
+//	NORDVPN_HOOK_STAGE       stage of the lifecycle, e.g. post-connect
+//	NORDVPN_SERVER_HOSTNAME  hostname of the server
+//	NORDVPN_SERVER_COUNTRY   country of the server
+//	NORDVPN_SERVER_CITY      city of the server
+//	NORDVPN_SERVER_IP        IP address of the server
+//	NORDVPN_TECHNOLOGY       technology of the connection, e.g. NORDLYNX
+//	NORDVPN_PROTOCOL         protocol of the connection, e.g. UDP
+//	NORDVPN_INTERFACE        name of the tunnel interface
+//	NORDVPN_TUNNEL_IPS       space separated addresses of the tunnel interface
+//
+// Failing scripts are logged and do not stop the connection lifecycle.
+package hooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/netip"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// Stage of the connection lifecycle at which the scripts are run
+type Stage string
+
+const (
+	// PreConnect scripts run before the tunnel is set up
+	PreConnect Stage = "pre-connect"
+	// PostConnect scripts run after the connection is established
+	PostConnect Stage = "post-connect"
+	// PreDisconnect scripts run before the tunnel is torn down
+	PreDisconnect Stage = "pre-disconnect"
+	// PostDisconnect scripts run after the tunnel is torn down
+	PostDisconnect Stage = "post-disconnect"
+)
+
+// DefaultDir is where the stage directories are looked up
+const DefaultDir = "/etc/nordvpn/hooks"
+
+// scriptTimeout bounds each script, so that a hanging script does not block
+// the connection lifecycle
+const scriptTimeout = 30 * time.Second
+
+var (
+	// ErrNotRootOwned is returned when script can be modified by non-root users
+	ErrNotRootOwned = errors.New("script must be owned by root and writable only by the owner")
+
+	scriptName = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+)
+
+// Env describes the connection to the scripts
+type Env struct {
+	Hostname   string
+	Country    string
+	City       string
+	ServerIP   netip.Addr
+	Technology string
+	Protocol   string
+	Interface  string
+	TunnelIPs  []netip.Addr
+}
+
+func (e Env) variables(stage Stage) []string {
+	serverIP := ""
+	if e.ServerIP.IsValid() {
+		serverIP = e.ServerIP.String()
+	}
+	tunnelIPs := make([]string, 0, len(e.TunnelIPs))
+	for _, ip := range e.TunnelIPs {
+		tunnelIPs = append(tunnelIPs, ip.String())
+	}
+	return []string{
+		"NORDVPN_HOOK_STAGE=" + string(stage),
+		"NORDVPN_SERVER_HOSTNAME=" + e.Hostname,
+		"NORDVPN_SERVER_COUNTRY=" + e.Country,
+		"NORDVPN_SERVER_CITY=" + e.City,
+		"NORDVPN_SERVER_IP=" + serverIP,
+		"NORDVPN_TECHNOLOGY=" + e.Technology,
+		"NORDVPN_PROTOCOL=" + e.Protocol,
+		"NORDVPN_INTERFACE=" + e.Interface,
+		"NORDVPN_TUNNEL_IPS=" + strings.Join(tunnelIPs, " "),
+	}
+}
+
+type runScriptFunc func(ctx context.Context, path string, env []string) ([]byte, error)
+
+// Runner runs the scripts of the stages
+type Runner struct {
+	dir       string
+	runScript runScriptFunc
+}
+
+// NewRunner creates the runner of the scripts in the stage directories of dir
+func NewRunner(dir string) *Runner {
+	return &Runner{dir: dir, runScript: runScript}
+}
+
+// Run executes the scripts of the stage one by one. Missing stage directory
+// means that there is nothing to run.
+func (r *Runner) Run(stage Stage, env Env) error {
+	dir := filepath.Join(r.dir, string(stage)+".d")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("listing %s scripts: %w", stage, err)
+	}
+
+	variables := env.variables(stage)
+	var errs []error
+	// entries are sorted by name
+	for _, entry := range entries {
+		if !scriptName.MatchString(entry.Name()) {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		if err := checkScript(path); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), scriptTimeout)
+		out, err := r.runScript(ctx, path, variables)
+		cancel()
+		if len(out) > 0 {
+			log.Println(internal.InfoPrefix, "hook", path, "output:", strings.TrimSpace(string(out)))
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+			continue
+		}
+		log.Println(internal.InfoPrefix, "hook", path, "succeeded")
+	}
+	return errors.Join(errs...)
+}
+
+// checkScript refuses to run the scripts which can be modified by other users
+// than root, because they would run with root privileges. Directories and the
+// files which are not executable are skipped with an error.
+func checkScript(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return errors.New("not a regular file")
+	}
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok || stat.Uid != 0 || info.Mode().Perm()&0022 != 0 {
+		return ErrNotRootOwned
+	}
+	if info.Mode().Perm()&0100 == 0 {
+		return errors.New("not executable")
+	}
+	return nil
+}
+
+func runScript(ctx context.Context, path string, env []string) ([]byte, error) {
+	// #nosec G204 -- script is owned by root
+	cmd := exec.CommandContext(ctx, path)
+	cmd.Env = append(os.Environ(), env...)
+	return cmd.CombinedOutput()
+}
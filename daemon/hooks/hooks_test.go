@@ -0,0 +1,91 @@
+package hooks
+
+import (
+	"context"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvVariables(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	env := Env{
+		Hostname:   "lt16.nordvpn.com",
+		Country:    "Lithuania",
+		City:       "Vilnius",
+		ServerIP:   netip.MustParseAddr("192.0.2.16"),
+		Technology: "NORDLYNX",
+		Protocol:   "UDP",
+		Interface:  "nordlynx",
+		TunnelIPs:  []netip.Addr{netip.MustParseAddr("10.5.0.2"), netip.MustParseAddr("fc00::2")},
+	}
+	assert.Equal(t, []string{
+		"NORDVPN_HOOK_STAGE=post-connect",
+		"NORDVPN_SERVER_HOSTNAME=lt16.nordvpn.com",
+		"NORDVPN_SERVER_COUNTRY=Lithuania",
+		"NORDVPN_SERVER_CITY=Vilnius",
+		"NORDVPN_SERVER_IP=192.0.2.16",
+		"NORDVPN_TECHNOLOGY=NORDLYNX",
+		"NORDVPN_PROTOCOL=UDP",
+		"NORDVPN_INTERFACE=nordlynx",
+		"NORDVPN_TUNNEL_IPS=10.5.0.2 fc00::2",
+	}, env.variables(PostConnect))
+
+	assert.Contains(t, Env{}.variables(PreConnect), "NORDVPN_SERVER_IP=")
+}
+
+func TestRunner_MissingDirectory(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	runner := NewRunner(filepath.Join(t.TempDir(), "hooks"))
+	assert.NoError(t, runner.Run(PreConnect, Env{}))
+}
+
+func TestRunner_Run(t *testing.T) {
+	category.Set(t, category.Unit)
+	if os.Geteuid() != 0 {
+		t.Skip("scripts must be owned by root")
+	}
+
+	dir := t.TempDir()
+	stageDir := filepath.Join(dir, "post-connect.d")
+	assert.NoError(t, os.Mkdir(stageDir, 0700))
+	for name, perm := range map[string]os.FileMode{
+		"20-monitoring":  0700,
+		"10-routes":      0700,
+		"10-routes.bak":  0700,
+		"30-not-exec":    0600,
+		"40-world-write": 0777,
+	} {
+		path := filepath.Join(stageDir, name)
+		assert.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"), perm))
+		assert.NoError(t, os.Chmod(path, perm))
+	}
+
+	var ran []string
+	runner := Runner{
+		dir: dir,
+		runScript: func(_ context.Context, path string, env []string) ([]byte, error) {
+			ran = append(ran, filepath.Base(path))
+			assert.Contains(t, env, "NORDVPN_HOOK_STAGE=post-connect")
+			return nil, nil
+		},
+	}
+
+	err := runner.Run(PostConnect, Env{})
+	assert.ErrorContains(t, err, "30-not-exec: not executable")
+	assert.ErrorIs(t, err, ErrNotRootOwned)
+	assert.Equal(t, []string{"10-routes", "20-monitoring"}, ran)
+}
+
+func TestCheckScript(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Error(t, checkScript("/nonexistent/script"))
+	assert.Error(t, checkScript("/"))
+}
@@ -0,0 +1,130 @@
+package daemon
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+)
+
+const (
+	// MinIdleDisconnectTimeout is the shortest allowed idle disconnect timeout
+	MinIdleDisconnectTimeout = time.Minute
+	// idleBytes is the amount of traffic between two checks below which the
+	// connection is considered idle. It covers the tunnel keepalive packets.
+	idleBytes = 1024
+)
+
+// trafficActivity tracks when the traffic was last seen on the tunnel
+type trafficActivity struct {
+	traffic    uint64
+	lastActive time.Time
+}
+
+// idle records the traffic counters and returns for how long the connection had
+// no traffic
+func (a *trafficActivity) idle(traffic uint64, now time.Time) time.Duration {
+	if a.lastActive.IsZero() || traffic < a.traffic || traffic-a.traffic > idleBytes {
+		a.lastActive = now
+	}
+	a.traffic = traffic
+	return now.Sub(a.lastActive)
+}
+
+func (a *trafficActivity) reset() {
+	a.lastActive = time.Time{}
+}
+
+// idleDisconnect tears down the VPN connection which had no traffic for the
+// configured timeout, so that an unattended device does not stay connected.
+// Optionally, kill switch is enabled afterwards to block the traffic.
+type idleDisconnect struct {
+	cm         config.Manager
+	netw       networker.Networker
+	disconnect func() error
+	now        func() time.Time
+	mu         sync.Mutex
+	activity   trafficActivity
+	// remaining is the time left until the disconnect, zero when not tracked
+	remaining time.Duration
+}
+
+func (d *idleDisconnect) check() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var cfg config.Config
+	if err := d.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return
+	}
+
+	if cfg.IdleDisconnect.Timeout == 0 || !d.netw.IsVPNActive() {
+		d.activity.reset()
+		d.remaining = 0
+		return
+	}
+
+	status, err := d.netw.ConnectionStatus()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "idle disconnect: retrieving connection status:", err)
+		return
+	}
+
+	idle := d.activity.idle(status.Download+status.Upload, d.now())
+	if idle < cfg.IdleDisconnect.Timeout {
+		d.remaining = cfg.IdleDisconnect.Timeout - idle
+		return
+	}
+
+	log.Println(internal.InfoPrefix, "idle disconnect: connection was idle for", cfg.IdleDisconnect.Timeout, "disconnecting")
+	if err := d.disconnect(); err != nil {
+		log.Println(internal.ErrorPrefix, "idle disconnect: disconnecting:", err)
+		return
+	}
+	d.activity.reset()
+	d.remaining = 0
+
+	if cfg.IdleDisconnect.KillSwitch {
+		d.enableKillSwitch(cfg)
+	}
+}
+
+func (d *idleDisconnect) enableKillSwitch(cfg config.Config) {
+	if cfg.KillSwitch {
+		return
+	}
+	if !cfg.Firewall {
+		log.Println(internal.WarningPrefix, "idle disconnect: kill switch requires firewall to be enabled")
+		return
+	}
+
+	if err := d.netw.SetKillSwitch(reloadedAllowlist(cfg)); err != nil {
+		log.Println(internal.ErrorPrefix, "idle disconnect: enabling kill switch:", err)
+		return
+	}
+	if err := d.cm.SaveWith(func(c config.Config) config.Config {
+		c.KillSwitch = true
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return
+	}
+	log.Println(internal.InfoPrefix, "idle disconnect: kill switch enabled")
+}
+
+// remainingTime until the idle connection is torn down. Zero means that the
+// connection is not going to be torn down.
+func (d *idleDisconnect) remainingTime() time.Duration {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.remaining
+}
+
+// JobIdleDisconnect disconnects from VPN when the connection is idle
+func JobIdleDisconnect(r *RPC) func() {
+	return r.idleDisconnect.check
+}
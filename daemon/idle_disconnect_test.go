@@ -0,0 +1,108 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type killSwitchNetworker struct {
+	onDemandNetworker
+	killSwitch bool
+}
+
+func (n *killSwitchNetworker) SetKillSwitch(config.Allowlist) error {
+	n.killSwitch = true
+	return nil
+}
+
+func TestIdleDisconnect(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name       string
+		firewall   bool
+		killSwitch bool
+		expected   bool
+	}{
+		{
+			name: "without kill switch",
+		},
+		{
+			name:       "with kill switch",
+			firewall:   true,
+			killSwitch: true,
+			expected:   true,
+		},
+		{
+			name:       "kill switch without firewall",
+			killSwitch: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Firewall = test.firewall
+			cm.Cfg.IdleDisconnect.Timeout = 2 * time.Minute
+			cm.Cfg.IdleDisconnect.KillSwitch = test.killSwitch
+			netw := killSwitchNetworker{onDemandNetworker: onDemandNetworker{active: true}}
+			disconnected := false
+			now := time.Now()
+			d := idleDisconnect{
+				cm:   cm,
+				netw: &netw,
+				disconnect: func() error {
+					disconnected = true
+					netw.active = false
+					return nil
+				},
+				now: func() time.Time { return now },
+			}
+
+			d.check()
+			assert.Equal(t, 2*time.Minute, d.remainingTime())
+
+			now = now.Add(time.Minute)
+			netw.traffic = 10 * idleBytes
+			d.check()
+			assert.Equal(t, 2*time.Minute, d.remainingTime())
+
+			// keepalive packets only
+			now = now.Add(time.Minute)
+			netw.traffic += idleBytes / 2
+			d.check()
+			assert.False(t, disconnected)
+			assert.Equal(t, time.Minute, d.remainingTime())
+
+			now = now.Add(time.Minute)
+			d.check()
+			assert.True(t, disconnected)
+			assert.Zero(t, d.remainingTime())
+			assert.Equal(t, test.expected, netw.killSwitch)
+			assert.Equal(t, test.expected, cm.Cfg.KillSwitch)
+		})
+	}
+}
+
+func TestIdleDisconnect_Disabled(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	d := idleDisconnect{
+		cm:   cm,
+		netw: &onDemandNetworker{active: true},
+		disconnect: func() error {
+			assert.Fail(t, "disconnect must not be called")
+			return nil
+		},
+		now: time.Now,
+	}
+
+	d.check()
+	assert.Zero(t, d.remainingTime())
+}
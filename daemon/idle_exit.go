@@ -0,0 +1,144 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"google.golang.org/grpc"
+)
+
+// idleExitCheckInterval is how often the socket activated daemon checks whether
+// it can exit
+const idleExitCheckInterval = time.Minute
+
+// IdleExit tracks the calls to the daemon, so that the socket activated daemon
+// exits once it has not been used for a while. systemd starts it again on the
+// next connection to the socket.
+type IdleExit struct {
+	timeout time.Duration
+	now     func() time.Time
+	mu      sync.Mutex
+	// calls in progress, streams like the event subscription keep the daemon
+	// in use while they are open
+	calls    int
+	lastCall time.Time
+}
+
+// NewIdleExit is a default constructor for IdleExit. Zero timeout disables it.
+func NewIdleExit(timeout time.Duration) *IdleExit {
+	return &IdleExit{timeout: timeout, now: time.Now, lastCall: time.Now()}
+}
+
+func (e *IdleExit) begin() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.calls++
+}
+
+func (e *IdleExit) end() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.calls--
+	e.lastCall = e.now()
+}
+
+// UnaryInterceptor records the unary calls
+func (e *IdleExit) UnaryInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	e.begin()
+	defer e.end()
+	return handler(ctx, req)
+}
+
+// StreamInterceptor records the streaming calls
+func (e *IdleExit) StreamInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	e.begin()
+	defer e.end()
+	return handler(srv, ss)
+}
+
+// idle reports whether no call was made for the timeout
+func (e *IdleExit) idle() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.timeout > 0 && e.calls == 0 && e.now().Sub(e.lastCall) >= e.timeout
+}
+
+// residentReason returns why the daemon has to keep running even if nobody
+// uses it, empty if it can exit
+func (r *RPC) residentReason(cfg config.Config) string {
+	switch {
+	case r.netw.IsVPNActive():
+		return "VPN is connected"
+	case r.pause != nil && r.pause.remainingTime() > 0:
+		return "VPN is paused"
+	case r.recovery != nil && r.recovery.recovering():
+		return "VPN connection is being recovered"
+	case r.captivePortal != nil && r.captivePortal.pending():
+		return "captive portal sign-in is allowed"
+	case len(cfg.AutoConnectData.Allowlist.Expiry) > 0:
+		// expired entries would stay open in the firewall
+		return "temporary allowlist entries are set"
+	case cfg.Mesh:
+		return "meshnet is enabled"
+	case cfg.KillSwitch:
+		return "kill switch is enabled"
+	case cfg.AutoConnect:
+		return "auto-connect is enabled"
+	case cfg.OnDemand.Enabled:
+		return "on-demand is enabled"
+	case len(cfg.AutoConnectRules) > 0:
+		return "auto-connect rules are set"
+	case cfg.Metrics.Enabled:
+		return "metrics are served"
+	case cfg.LocalProxy.Enabled:
+		return "local proxy is enabled"
+	case cfg.RESTAPI.Enabled:
+		return "REST API is served"
+	}
+	return ""
+}
+
+// canExit reports whether the idle daemon is not needed anymore
+func (r *RPC) canExit(e *IdleExit) bool {
+	if !e.idle() {
+		return false
+	}
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, "idle exit: loading config:", err)
+		return false
+	}
+	return r.residentReason(cfg) == ""
+}
+
+// StartIdleExit closes the returned channel once the daemon has been idle for
+// the timeout and nothing requires it to keep running
+func (r *RPC) StartIdleExit(e *IdleExit) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(idleExitCheckInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			if r.canExit(e) {
+				log.Println(internal.InfoPrefix, "daemon was not used for", e.timeout, "exiting")
+				close(done)
+				return
+			}
+		}
+	}()
+	return done
+}
@@ -0,0 +1,107 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	mocknetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestIdleExit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Now()
+	e := NewIdleExit(10 * time.Minute)
+	e.now = func() time.Time { return now }
+	e.lastCall = now
+
+	now = now.Add(5 * time.Minute)
+	assert.False(t, e.idle())
+
+	// call in progress keeps the daemon in use
+	_, err := e.UnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{},
+		func(ctx context.Context, req any) (any, error) {
+			now = now.Add(20 * time.Minute)
+			assert.False(t, e.idle())
+			return nil, nil
+		})
+	assert.NoError(t, err)
+	assert.False(t, e.idle())
+
+	now = now.Add(10 * time.Minute)
+	assert.True(t, e.idle())
+
+	disabled := NewIdleExit(0)
+	disabled.now = func() time.Time { return now.Add(time.Hour) }
+	assert.False(t, disabled.idle())
+}
+
+func TestRPC_ResidentReason(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		cfg      config.Config
+		active   bool
+		expected bool
+	}{
+		{name: "unused", expected: false},
+		{name: "connected", active: true, expected: true},
+		{name: "meshnet", cfg: config.Config{Mesh: true}, expected: true},
+		{name: "kill switch", cfg: config.Config{KillSwitch: true}, expected: true},
+		{name: "auto-connect", cfg: config.Config{AutoConnect: true}, expected: true},
+		{name: "on-demand", cfg: config.Config{OnDemand: config.OnDemand{Enabled: true}}, expected: true},
+		{name: "REST API", cfg: config.Config{RESTAPI: config.RESTAPI{Enabled: true}}, expected: true},
+		{
+			name: "temporary allowlist",
+			cfg: config.Config{AutoConnectData: config.AutoConnectData{Allowlist: config.Allowlist{
+				Expiry: map[string]time.Time{config.PortKey("tcp", 22): time.Now().Add(time.Hour)},
+			}}},
+			expected: true,
+		},
+		{name: "unrelated setting", cfg: config.Config{Firewall: true, LanDiscovery: true}, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := RPC{netw: &mocknetworker.Mock{VpnActive: test.active}}
+			assert.Equal(t, test.expected, r.residentReason(test.cfg) != "")
+		})
+	}
+}
+
+func TestRPC_CanExit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Now()
+	e := NewIdleExit(time.Minute)
+	e.now = func() time.Time { return now }
+	e.lastCall = now.Add(-time.Hour)
+
+	cm := mock.NewMockConfigManager()
+	r := RPC{cm: cm, netw: &mocknetworker.Mock{}}
+	assert.True(t, r.canExit(e))
+
+	cm.Cfg.KillSwitch = true
+	assert.False(t, r.canExit(e))
+
+	cm.Cfg.KillSwitch = false
+	r.recovery = &connectionRecovery{server: "lt16"}
+	assert.False(t, r.canExit(e))
+
+	r.recovery = &connectionRecovery{}
+	r.captivePortal = &captivePortal{unlockedUntil: now.Add(time.Minute)}
+	assert.False(t, r.canExit(e))
+
+	r.captivePortal = &captivePortal{}
+	assert.True(t, r.canExit(e))
+
+	cm.LoadErr = mock.ErrOnPurpose
+	assert.False(t, r.canExit(e))
+}
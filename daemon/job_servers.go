@@ -28,10 +28,11 @@ func JobServers(dm *DataManager, cm config.Manager, api core.ServersAPI, validat
 		}
 		if validate && dm.ServerDataExists() {
 			// always fill app data even if db file is outdated
-			SetAppData(dm, cfg.Technology, dm.GetServersData().Servers)
+			SetAppData(dm, cfg.Technology, cfg.VirtualLocation.Get(), dm.GetServersData().Servers)
 
 			// if db is still valid, make sure it's locked and do nothing
 			if dm.IsServersDataValid() {
+				dm.CountServersCacheHit()
 				return nil
 			}
 		}
@@ -43,6 +44,8 @@ func JobServers(dm *DataManager, cm config.Manager, api core.ServersAPI, validat
 			return err
 		}
 
+		dm.CountServersDownload()
+
 		if len(servers) == 0 {
 			return errors.New("empty servers list")
 		}
@@ -151,7 +154,7 @@ func JobServers(dm *DataManager, cm config.Manager, api core.ServersAPI, validat
 			return servers[i].Penalty < servers[j].Penalty
 		})
 
-		SetAppData(dm, cfg.Technology, servers)
+		SetAppData(dm, cfg.Technology, cfg.VirtualLocation.Get(), servers)
 		err = dm.SetServersData(currentTime, servers, headers.Get(core.HeaderDigest))
 		if err != nil {
 			return err
@@ -160,7 +163,10 @@ func JobServers(dm *DataManager, cm config.Manager, api core.ServersAPI, validat
 	}
 }
 
-func SetAppData(dm *DataManager, tech config.Technology, servers core.Servers) {
+// SetAppData fills the country, city and group names of the servers connectable
+// with the technology. Virtual location servers are skipped unless virtualLocation
+// is set.
+func SetAppData(dm *DataManager, tech config.Technology, virtualLocation bool, servers core.Servers) {
 	countryNames := map[bool]map[config.Protocol]mapset.Set[string]{
 		false: {
 			config.Protocol_UDP: mapset.NewSet[string](),
@@ -193,6 +199,10 @@ func SetAppData(dm *DataManager, tech config.Technology, servers core.Servers) {
 	}
 
 	for _, server := range servers {
+		if !virtualLocation && core.IsVirtualLocation()(server) {
+			continue
+		}
+
 		var (
 			hasUDP bool
 			hasTCP bool
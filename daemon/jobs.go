@@ -19,15 +19,26 @@ func (r *RPC) StartJobs() {
 	// order of the jobs below matters
 	// servers job requires geo info and configs data to create server list
 	// TODO what if configs file is deleted just before servers job or disk is full?
-	if _, err := r.scheduler.Every(6).Hours().Do(JobCountries(r.dm, r.api)); err != nil {
+	countries := JobCountries(r.dm, r.api)
+	if _, err := r.scheduler.Every(6).Hours().Do(r.metered.refreshJob(
+		func() { _ = countries() },
+		func() bool { return !r.dm.CountryDataExists() || r.dm.IsCountryDataValid() },
+	)); err != nil {
 		log.Println(internal.WarningPrefix, "job countries", err)
 	}
 
-	if _, err := r.scheduler.Every(30).Minutes().Do(JobInsights(r.dm, r.api, r.netw, false)); err != nil {
+	if _, err := r.scheduler.Every(30).Minutes().Do(
+		r.metered.refreshJob(JobInsights(r.dm, r.api, r.netw, false), nil),
+	); err != nil {
 		log.Println(internal.WarningPrefix, "job insights", err)
 	}
 
-	if _, err := r.scheduler.Every(1).Hour().Do(JobServers(r.dm, r.cm, r.api, true)); err != nil {
+	servers := JobServers(r.dm, r.cm, r.api, true)
+	if _, err := r.scheduler.Every(1).Hour().Do(r.metered.refreshJob(
+		func() { _ = servers() },
+		// valid data is not downloaded, but the job has to fill in the app data
+		func() bool { return !r.dm.ServerDataExists() || r.dm.IsServersDataValid() },
+	)); err != nil {
 		log.Println(internal.WarningPrefix, "job servers", err)
 	}
 	// TODO if autoconnect runs before servers job, it will return zero servers list
@@ -36,11 +47,11 @@ func (r *RPC) StartJobs() {
 		log.Println(internal.WarningPrefix, "job servers", err)
 	}
 
-	if _, err := r.scheduler.Every(1).Day().Do(JobTemplates(r.cdn)); err != nil {
+	if _, err := r.scheduler.Every(1).Day().Do(r.metered.refreshJob(JobTemplates(r.cdn), nil)); err != nil {
 		log.Println(internal.WarningPrefix, "job templates", err)
 	}
 
-	if _, err := r.scheduler.Every(3).Hours().Do(JobVersionCheck(r.dm, r.repo)); err != nil {
+	if _, err := r.scheduler.Every(3).Hours().Do(r.metered.refreshJob(JobVersionCheck(r.dm, r.repo), nil)); err != nil {
 		log.Println(internal.WarningPrefix, "job version", err)
 	}
 
@@ -48,6 +59,50 @@ func (r *RPC) StartJobs() {
 		log.Println(internal.WarningPrefix, "job heart beat", err)
 	}
 
+	if _, err := r.scheduler.Every(10).Seconds().Do(JobOnDemand(r)); err != nil {
+		log.Println(internal.WarningPrefix, "job on-demand", err)
+	}
+
+	if _, err := r.scheduler.Every(10).Seconds().Do(JobIdleDisconnect(r)); err != nil {
+		log.Println(internal.WarningPrefix, "job idle disconnect", err)
+	}
+
+	if _, err := r.scheduler.Every(5).Seconds().Do(JobCaptivePortal(r)); err != nil {
+		log.Println(internal.WarningPrefix, "job captive portal", err)
+	}
+
+	if _, err := r.scheduler.Every(5).Seconds().Do(JobAutoConnectRules(r)); err != nil {
+		log.Println(internal.WarningPrefix, "job auto-connect rules", err)
+	}
+
+	if _, err := r.scheduler.Every(5).Seconds().Do(JobTrustedNetworks(r)); err != nil {
+		log.Println(internal.WarningPrefix, "job trusted networks", err)
+	}
+
+	if _, err := r.scheduler.Every(10).Seconds().Do(JobAllowlistExpiry(r)); err != nil {
+		log.Println(internal.WarningPrefix, "job allowlist expiry", err)
+	}
+
+	if _, err := r.scheduler.Every(1).Seconds().Do(JobPause(r)); err != nil {
+		log.Println(internal.WarningPrefix, "job pause", err)
+	}
+
+	if _, err := r.scheduler.Every(1).Seconds().Do(JobReconnect(r)); err != nil {
+		log.Println(internal.WarningPrefix, "job reconnect", err)
+	}
+
+	if _, err := r.scheduler.Every(5).Seconds().Do(JobThroughputCollapse(r)); err != nil {
+		log.Println(internal.WarningPrefix, "job throughput collapse", err)
+	}
+
+	if _, err := r.scheduler.Every(10).Seconds().Do(JobServerHealth(r)); err != nil {
+		log.Println(internal.WarningPrefix, "job server health", err)
+	}
+
+	if _, err := r.scheduler.Every(6).Hours().Do(JobCredentialsRotation(r)); err != nil {
+		log.Println(internal.WarningPrefix, "job credentials rotation", err)
+	}
+
 	r.scheduler.RunAll()
 	r.scheduler.StartBlocking()
 }
@@ -62,11 +117,15 @@ func (r *RPC) StartKillSwitch() {
 
 	if cfg.KillSwitch {
 		if err := r.netw.SetKillSwitch(cfg.AutoConnectData.Allowlist); err != nil {
+			// rules applied at boot are kept, so that the traffic stays blocked
 			log.Println(internal.ErrorPrefix, "starting killswitch:", err)
 			return
 		}
+		r.syncBootKillSwitch()
+		r.releaseBootKillSwitch()
 		return
 	}
+	r.syncBootKillSwitch()
 }
 
 func (r *RPC) StopKillSwitch() error {
@@ -76,6 +135,9 @@ func (r *RPC) StopKillSwitch() error {
 		return fmt.Errorf("loading daemon config: %w", err)
 	}
 
+	// kill switch enabled by the idle disconnect is applied at the next boot
+	// as well
+	r.syncBootKillSwitch()
 	if cfg.KillSwitch {
 		if err := r.netw.UnsetKillSwitch(); err != nil {
 			return fmt.Errorf("unsetting killswitch: %w", err)
@@ -102,6 +164,15 @@ func (a *autoconnectServer) Send(data *pb.Payload) error {
 	return nil
 }
 
+// connectWith connects to VPN in the background, e.g. on the auto-connect rule
+func (r *RPC) connectWith(request *pb.ConnectRequest) error {
+	server := autoconnectServer{}
+	if err := r.Connect(request, &server); err != nil {
+		return err
+	}
+	return server.err
+}
+
 type GetTimeoutFunc func(tries int) time.Duration
 
 func connectErrorCheck(err error) bool {
@@ -111,6 +182,11 @@ func connectErrorCheck(err error) bool {
 
 // StartAutoConnect connect to VPN server if autoconnect is enabled
 func (r *RPC) StartAutoConnect(timeoutFn GetTimeoutFunc) error {
+	if !r.metered.autoConnectAllowed() {
+		log.Println(internal.InfoPrefix, "auto-connect is suppressed by the metered network policy")
+		return nil
+	}
+
 	tries := 1
 	for {
 		if r.netw.IsVPNActive() {
@@ -125,20 +201,58 @@ func (r *RPC) StartAutoConnect(timeoutFn GetTimeoutFunc) error {
 			return err
 		}
 
+		rule, _ := r.autoConnectRules.current(cfg)
+		if rule.Action == config.AutoConnectActionSkip {
+			log.Println(internal.InfoPrefix, "auto-connect is skipped by the auto-connect rule")
+			return nil
+		}
+		if network := r.trustedNetworks.current(cfg); network != "" {
+			log.Println(internal.InfoPrefix, "auto-connect is skipped on the trusted network", network)
+			return nil
+		}
+
 		server := autoconnectServer{}
-		err = r.Connect(&pb.ConnectRequest{ServerTag: cfg.AutoConnectData.ServerTag}, &server)
+		err = r.Connect(autoConnectRequest(cfg, rule), &server)
 		if connectErrorCheck(err) && server.err == nil {
 			log.Println(internal.InfoPrefix, "auto-connect success")
 			return nil
 		}
 		log.Println(internal.ErrorPrefix, "err1:", server.err, "| err2:", err)
-		tryAfterDuration := timeoutFn(tries)
+		// the first try is not a retry
+		if retriesExhausted(cfg.Reconnect, tries-1) {
+			log.Println(internal.ErrorPrefix, "auto-connect failed after", tries, "tries, giving up")
+			return nil
+		}
+		tryAfterDuration := reconnectDelay(cfg.Reconnect, tries, timeoutFn)
 		tries++
 		log.Println(internal.WarningPrefix, "will retry(", tries, ") auto-connect after:", tryAfterDuration)
 		<-time.After(tryAfterDuration)
 	}
 }
 
+// StartTokenLogin logs in with the token provided by the environment, e.g. when
+// the daemon runs in a container. Login is retried while the API cannot be
+// reached.
+func (r *RPC) StartTokenLogin(token string, timeoutFn GetTimeoutFunc) {
+	for tries := 1; ; tries++ {
+		resp, err := r.LoginWithToken(context.Background(), &pb.LoginWithTokenRequest{Token: token})
+		if errors.Is(err, internal.ErrAlreadyLoggedIn) {
+			return
+		}
+		switch resp.GetType() {
+		case internal.CodeSuccess:
+			log.Println(internal.InfoPrefix, "logged in with the token from", EnvToken)
+			return
+		case internal.CodeTokenInvalid, internal.CodeTokenLoginFailure, internal.CodeConfigError:
+			log.Println(internal.ErrorPrefix, "login with the token from", EnvToken, "failed with code:", resp.GetType())
+			return
+		}
+		tryAfterDuration := timeoutFn(tries)
+		log.Println(internal.WarningPrefix, "will retry(", tries+1, ") login after:", tryAfterDuration)
+		<-time.After(tryAfterDuration)
+	}
+}
+
 func meshErrorCheck(err error) bool {
 	return err == nil ||
 		errors.Is(err, meshnet.ErrNotLoggedIn) ||
@@ -119,6 +119,7 @@ func TestStartAutoConnect(t *testing.T) {
 					&subs.Subject[events.DataConnect]{},
 					&subs.Subject[events.DataDisconnect]{},
 					&subs.Subject[any]{},
+					&subs.Subject[any]{},
 					&subs.Subject[core.ServicesResponse]{},
 					&subs.Subject[events.ServerRating]{},
 					&subs.Subject[int]{},
@@ -127,6 +128,7 @@ func TestStartAutoConnect(t *testing.T) {
 					return &mock.WorkingVPN{}, nil
 				},
 				newEndpointResolverMock(netip.MustParseAddr("127.0.0.1")),
+				nil,
 				netw,
 				&subs.Subject[string]{},
 				&mock.DNSGetter{Names: []string{"1.1.1.1"}},
@@ -134,6 +136,22 @@ func TestStartAutoConnect(t *testing.T) {
 				&mockAnalytics{},
 				service.NoopFileshare{},
 				&RegistryMock{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			err := rpc.StartAutoConnect(mockTimeout)
@@ -213,7 +231,11 @@ func (*meshNetworker) Refresh(mesh.MachineMap) error                          {
 func (*meshNetworker) StatusMap() (map[string]string, error) {
 	return map[string]string{}, nil
 }
+func (*meshNetworker) PathMap() (map[string]string, error) {
+	return map[string]string{}, nil
+}
 func (*meshNetworker) LastServerName() string { return "" }
+func (*meshNetworker) IsVPNActive() bool      { return false }
 
 func TestStartAutoMeshnet(t *testing.T) {
 	category.Set(t, category.Unit)
@@ -305,6 +327,7 @@ func TestStartAutoMeshnet(t *testing.T) {
 					&subs.Subject[events.DataConnect]{},
 					&subs.Subject[events.DataDisconnect]{},
 					&subs.Subject[any]{},
+					&subs.Subject[any]{},
 					&subs.Subject[core.ServicesResponse]{},
 					&subs.Subject[events.ServerRating]{},
 					&subs.Subject[int]{},
@@ -313,6 +336,7 @@ func TestStartAutoMeshnet(t *testing.T) {
 					return &mock.WorkingVPN{}, nil
 				},
 				newEndpointResolverMock(netip.MustParseAddr("127.0.0.1")),
+				nil,
 				test.netw,
 				&subs.Subject[string]{},
 				&mock.DNSGetter{Names: []string{"1.1.1.1"}},
@@ -320,6 +344,22 @@ func TestStartAutoMeshnet(t *testing.T) {
 				&mockAnalytics{},
 				service.NoopFileshare{},
 				&RegistryMock{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			meshService := meshnet.NewServer(
@@ -334,7 +374,11 @@ func TestStartAutoMeshnet(t *testing.T) {
 				nil,
 				&subs.Subject[bool]{},
 				&subs.Subject[events.DataConnect]{},
+				&subs.Subject[events.DataExitNodeRecovery]{},
 				service.NoopFileshare{},
+				nil,
+				nil,
+				nil,
 			)
 
 			err := rpc.StartAutoMeshnet(meshService, mockTimeout)
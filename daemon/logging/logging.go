@@ -0,0 +1,152 @@
+// Package logging turns the output of the standard logger into leveled records,
+// so that the verbosity can be changed at runtime and the records can be
+// emitted as JSON for journald or log collectors.
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// Level of a log record
+type Level int
+
+const (
+	Debug Level = iota
+	Info
+	Warning
+	Error
+)
+
+func (l Level) String() string {
+	switch l {
+	case Debug:
+		return "debug"
+	case Info:
+		return "info"
+	case Warning:
+		return "warning"
+	case Error:
+		return "error"
+	}
+	return "info"
+}
+
+// Format of the emitted records
+type Format int
+
+const (
+	// Text keeps the format of the standard logger
+	Text Format = iota
+	// JSON emits a JSON object per line
+	JSON
+)
+
+// textTimeLayout matches the date and time flags of the standard logger
+const textTimeLayout = "2006/01/02 15:04:05"
+
+// prefixes map the message prefixes used across the app to the levels
+var prefixes = []struct {
+	prefix string
+	level  Level
+}{
+	{internal.DebugPrefix, Debug},
+	{internal.InfoPrefix, Info},
+	{internal.WarningPrefix, Warning},
+	{internal.DeferPrefix, Warning},
+	{internal.ErrorPrefix, Error},
+}
+
+type record struct {
+	Time    string `json:"time"`
+	Level   string `json:"level"`
+	Message string `json:"msg"`
+}
+
+// Writer is used as the output of the standard logger, which must have its
+// flags cleared, as the time is added by the Writer. Every write is a single
+// record, its level is determined by the message prefix. Messages without a
+// known prefix are logged at Info level.
+type Writer struct {
+	out    io.Writer
+	format Format
+	mu     sync.Mutex
+	level  Level
+	now    func() time.Time
+}
+
+// NewWriter creates a Writer which emits the records of the given level and above
+func NewWriter(out io.Writer, format Format, level Level) *Writer {
+	return &Writer{out: out, format: format, level: level, now: time.Now}
+}
+
+// SetLevel changes the minimum level of the emitted records
+func (w *Writer) SetLevel(level Level) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.level = level
+}
+
+// Level returns the minimum level of the emitted records
+func (w *Writer) Level() Level {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.level
+}
+
+// Write emits p as a single record unless its level is below the minimum
+func (w *Writer) Write(p []byte) (int, error) {
+	message := string(bytes.TrimRight(p, "\n"))
+	level, message := parse(message)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if level < w.level {
+		return len(p), nil
+	}
+
+	var line []byte
+	now := w.now()
+	switch w.format {
+	case JSON:
+		var err error
+		line, err = json.Marshal(record{
+			Time:    now.Format(time.RFC3339Nano),
+			Level:   level.String(),
+			Message: message,
+		})
+		if err != nil {
+			return 0, err
+		}
+	case Text:
+		line = []byte(now.Format(textTimeLayout) + " " + string(bytes.TrimRight(p, "\n")))
+	}
+	if _, err := w.out.Write(append(line, '\n')); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// parse returns the level of the message and the message without the level prefix
+func parse(message string) (Level, string) {
+	for _, p := range prefixes {
+		if rest, ok := strings.CutPrefix(message, p.prefix); ok {
+			return p.level, strings.TrimSpace(rest)
+		}
+	}
+	return Info, message
+}
+
+// ParseFormat returns the format by its name, Text is used for unknown names
+func ParseFormat(name string) Format {
+	if strings.EqualFold(name, "json") {
+		return JSON
+	}
+	return Text
+}
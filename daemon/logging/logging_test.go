@@ -0,0 +1,80 @@
+package logging
+
+import (
+	"bytes"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestLogger(format Format, level Level) (*log.Logger, *Writer, *bytes.Buffer) {
+	var buf bytes.Buffer
+	writer := NewWriter(&buf, format, level)
+	writer.now = func() time.Time { return time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC) }
+	return log.New(writer, "", 0), writer, &buf
+}
+
+func TestWriter_Text(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	logger, _, buf := newTestLogger(Text, Info)
+	logger.Println(internal.ErrorPrefix, "connecting:", "timeout")
+	logger.Println(internal.DebugPrefix, "hidden")
+	logger.Println("no prefix")
+
+	assert.Equal(t, "2024/05/06 07:08:09 [Error] connecting: timeout\n"+
+		"2024/05/06 07:08:09 no prefix\n", buf.String())
+}
+
+func TestWriter_JSON(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	logger, _, buf := newTestLogger(JSON, Debug)
+	logger.Println(internal.WarningPrefix, "dns not set")
+	logger.Println(internal.DeferPrefix, "cleanup")
+	logger.Println(internal.DebugPrefix, `quoted "value"`)
+
+	assert.Equal(t,
+		`{"time":"2024-05-06T07:08:09Z","level":"warning","msg":"dns not set"}`+"\n"+
+			`{"time":"2024-05-06T07:08:09Z","level":"warning","msg":"cleanup"}`+"\n"+
+			`{"time":"2024-05-06T07:08:09Z","level":"debug","msg":"quoted \"value\""}`+"\n",
+		buf.String())
+}
+
+func TestWriter_SetLevel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		level    Level
+		expected []string
+	}{
+		{level: Debug, expected: []string{"debug", "info", "warning", "error"}},
+		{level: Info, expected: []string{"info", "warning", "error"}},
+		{level: Warning, expected: []string{"warning", "error"}},
+		{level: Error, expected: []string{"error"}},
+	}
+
+	logger, writer, buf := newTestLogger(Text, Info)
+	for _, test := range tests {
+		t.Run(test.level.String(), func(t *testing.T) {
+			buf.Reset()
+			writer.SetLevel(test.level)
+			assert.Equal(t, test.level, writer.Level())
+
+			logger.Println(internal.DebugPrefix, "debug")
+			logger.Println(internal.InfoPrefix, "info")
+			logger.Println(internal.WarningPrefix, "warning")
+			logger.Println(internal.ErrorPrefix, "error")
+
+			var logged []string
+			for _, line := range bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n")) {
+				logged = append(logged, string(line[bytes.LastIndexByte(line, ' ')+1:]))
+			}
+			assert.Equal(t, test.expected, logged)
+		})
+	}
+}
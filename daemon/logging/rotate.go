@@ -0,0 +1,41 @@
+package logging
+
+import (
+	"strconv"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const (
+	// DefaultRetention is the number of days the rotated log files are kept for
+	DefaultRetention = 14
+	// maxFileSize in megabytes after which the log file is rotated
+	maxFileSize = 10
+	// maxBackups limits the number of rotated files, so that the disk is not filled
+	// even if a lot is logged within the retention period
+	maxBackups = 10
+)
+
+// NewRotatingFile returns a writer to the log file at path. The file is rotated
+// once it grows over the size limit, rotated files are compressed and removed
+// after the given number of days. When the file is rotated, the new one keeps
+// the owner and the permissions of the previous one.
+func NewRotatingFile(path string, retention int) *lumberjack.Logger {
+	return &lumberjack.Logger{
+		Filename:   path,
+		MaxSize:    maxFileSize,
+		MaxBackups: maxBackups,
+		MaxAge:     retention,
+		Compress:   true,
+	}
+}
+
+// ParseRetention returns the number of days from value, DefaultRetention is used
+// for empty or invalid values
+func ParseRetention(value string) int {
+	days, err := strconv.Atoi(value)
+	if err != nil || days <= 0 {
+		return DefaultRetention
+	}
+	return days
+}
@@ -0,0 +1,50 @@
+package logging
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRetention(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	for _, test := range []struct {
+		value    string
+		expected int
+	}{
+		{value: "", expected: DefaultRetention},
+		{value: "30", expected: 30},
+		{value: "0", expected: DefaultRetention},
+		{value: "-1", expected: DefaultRetention},
+		{value: "week", expected: DefaultRetention},
+	} {
+		t.Run(test.value, func(t *testing.T) {
+			assert.Equal(t, test.expected, ParseRetention(test.value))
+		})
+	}
+}
+
+func TestNewRotatingFile(t *testing.T) {
+	category.Set(t, category.File)
+
+	path := filepath.Join(t.TempDir(), "daemon.log")
+	file := NewRotatingFile(path, 7)
+	assert.Equal(t, 7, file.MaxAge)
+	assert.True(t, file.Compress)
+
+	_, err := file.Write([]byte("first\n"))
+	require.NoError(t, err)
+	require.NoError(t, file.Rotate())
+	_, err = file.Write([]byte("second\n"))
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "second\n", string(data))
+}
@@ -0,0 +1,90 @@
+package daemon
+
+import (
+	"log"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/metered"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/slices"
+)
+
+// meteredStatus is the metered state of the primary network connection
+type meteredStatus struct {
+	connection metered.Connection
+	// manual is true if the connection is metered because of the user setting
+	manual bool
+	// active is true if the metered policy is applied
+	active bool
+	err    error
+}
+
+func (s meteredStatus) isMetered() bool {
+	return s.connection.Metered || s.manual
+}
+
+// meteredPolicy limits the background activity while the primary network
+// connection is metered. The state is detected on every check, because the
+// checks are rare and the network can change at any time.
+type meteredPolicy struct {
+	cm       config.Manager
+	detector metered.Detector
+	mu       sync.Mutex
+	// active is the last known state, used to log the policy changes
+	active bool
+}
+
+func (p *meteredPolicy) status(cfg config.Config) meteredStatus {
+	var status meteredStatus
+	status.connection, status.err = p.detector.PrimaryConnection()
+	status.manual = status.connection.Name != "" && slices.Contains(cfg.Metered.Networks, status.connection.Name)
+	status.active = cfg.Metered.Enabled && status.isMetered()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if status.active != p.active {
+		if status.active {
+			log.Println(internal.InfoPrefix, "metered network policy is activated on", status.connection.Name)
+		} else {
+			log.Println(internal.InfoPrefix, "metered network policy is deactivated")
+		}
+		p.active = status.active
+	}
+	return status
+}
+
+// allowed returns false if the metered policy is active and the activity is not
+// allowed by the policy
+func (p *meteredPolicy) allowed(allow func(config.Metered) bool) bool {
+	var cfg config.Config
+	if err := p.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return true
+	}
+	if !cfg.Metered.Enabled || allow(cfg.Metered) {
+		return true
+	}
+	return !p.status(cfg).active
+}
+
+func (p *meteredPolicy) refreshAllowed() bool {
+	return p.allowed(func(m config.Metered) bool { return m.AllowRefresh })
+}
+
+func (p *meteredPolicy) autoConnectAllowed() bool {
+	return p.allowed(func(m config.Metered) bool { return m.AllowAutoConnect })
+}
+
+// refreshJob returns the background refresh job which is skipped while the
+// metered policy is active. force is optional, the job runs regardless if it
+// returns true, e.g. when the data is missing or it would not be downloaded.
+func (p *meteredPolicy) refreshJob(job func(), force func() bool) func() {
+	return func() {
+		if (force != nil && force()) || p.refreshAllowed() {
+			job()
+			return
+		}
+		log.Println(internal.InfoPrefix, "metered network policy: skipping background refresh")
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Package metered detects whether the primary network connection is metered and
+which network it is.
+*/
+package metered
+
+import (
+	"fmt"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	nmDest         = "org.freedesktop.NetworkManager"
+	nmPath         = "/org/freedesktop/NetworkManager"
+	nmMetered      = "org.freedesktop.NetworkManager.Metered"
+	nmPrimary      = "org.freedesktop.NetworkManager.PrimaryConnection"
+	nmActiveID     = "org.freedesktop.NetworkManager.Connection.Active.Id"
+	nmActiveType   = "org.freedesktop.NetworkManager.Connection.Active.Type"
+	nmActiveDevs   = "org.freedesktop.NetworkManager.Connection.Active.Devices"
+	nmActiveAP     = "org.freedesktop.NetworkManager.Device.Wireless.ActiveAccessPoint"
+	nmAPSsid       = "org.freedesktop.NetworkManager.AccessPoint.Ssid"
+	nmAPHwAddress  = "org.freedesktop.NetworkManager.AccessPoint.HwAddress"
+	nmNoConnection = "/"
+)
+
+// Connection types of NetworkManager
+const (
+	TypeWireless = "802-11-wireless"
+	TypeEthernet = "802-3-ethernet"
+	TypeGSM      = "gsm"
+	TypeCDMA     = "cdma"
+)
+
+// NMMetered values of NetworkManager
+const (
+	nmMeteredYes      = 1
+	nmMeteredGuessYes = 3
+)
+
+// Connection is the primary network connection
+type Connection struct {
+	// Name of the connection, e.g. the Wi-Fi network name
+	Name string
+	// Metered is true if the system reports the connection as metered
+	Metered bool
+	// Type of the connection as reported by NetworkManager, e.g. TypeWireless
+	Type string
+	// SSID of the Wi-Fi network, empty for the other types
+	SSID string
+	// BSSID is the MAC address of the Wi-Fi access point, empty for the other types
+	BSSID string
+}
+
+// Detector returns the primary network connection
+type Detector interface {
+	PrimaryConnection() (Connection, error)
+}
+
+// NetworkManager reads the primary connection state from NetworkManager over D-Bus
+type NetworkManager struct{}
+
+// PrimaryConnection returns the primary connection of NetworkManager. Both the
+// metered state set by the user and the one guessed by NetworkManager, e.g. for
+// the mobile broadband or the tethered connections, are treated as metered.
+func (NetworkManager) PrimaryConnection() (Connection, error) {
+	conn, err := dbus.SystemBus()
+	if err != nil {
+		return Connection{}, fmt.Errorf("connecting to system bus: %w", err)
+	}
+
+	nm := conn.Object(nmDest, nmPath)
+	var state uint32
+	if err := nm.StoreProperty(nmMetered, &state); err != nil {
+		return Connection{}, fmt.Errorf("getting metered state: %w", err)
+	}
+
+	var primary dbus.ObjectPath
+	if err := nm.StoreProperty(nmPrimary, &primary); err != nil {
+		return Connection{}, fmt.Errorf("getting primary connection: %w", err)
+	}
+
+	connection := Connection{Metered: isMetered(state)}
+	if primary == nmNoConnection || !primary.IsValid() {
+		return connection, nil
+	}
+	active := conn.Object(nmDest, primary)
+	if err := active.StoreProperty(nmActiveID, &connection.Name); err != nil {
+		return Connection{}, fmt.Errorf("getting primary connection name: %w", err)
+	}
+	if err := active.StoreProperty(nmActiveType, &connection.Type); err != nil {
+		return Connection{}, fmt.Errorf("getting primary connection type: %w", err)
+	}
+	if connection.Type == TypeWireless {
+		if err := storeAccessPoint(conn, active, &connection); err != nil {
+			return Connection{}, fmt.Errorf("getting access point: %w", err)
+		}
+	}
+	return connection, nil
+}
+
+// storeAccessPoint sets the SSID and BSSID of the active access point of the
+// Wi-Fi connection
+func storeAccessPoint(conn *dbus.Conn, active dbus.BusObject, connection *Connection) error {
+	var devices []dbus.ObjectPath
+	if err := active.StoreProperty(nmActiveDevs, &devices); err != nil {
+		return fmt.Errorf("getting devices: %w", err)
+	}
+	if len(devices) == 0 {
+		return nil
+	}
+
+	var path dbus.ObjectPath
+	if err := conn.Object(nmDest, devices[0]).StoreProperty(nmActiveAP, &path); err != nil {
+		return fmt.Errorf("getting active access point: %w", err)
+	}
+	if path == nmNoConnection || !path.IsValid() {
+		return nil
+	}
+
+	ap := conn.Object(nmDest, path)
+	var ssid []byte
+	if err := ap.StoreProperty(nmAPSsid, &ssid); err != nil {
+		return fmt.Errorf("getting SSID: %w", err)
+	}
+	if err := ap.StoreProperty(nmAPHwAddress, &connection.BSSID); err != nil {
+		return fmt.Errorf("getting BSSID: %w", err)
+	}
+	connection.SSID = string(ssid)
+	return nil
+}
+
+func isMetered(state uint32) bool {
+	return state == nmMeteredYes || state == nmMeteredGuessYes
+}
@@ -0,0 +1,30 @@
+package metered
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMetered(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		state    uint32
+		expected bool
+	}{
+		{name: "unknown", state: 0},
+		{name: "yes", state: 1, expected: true},
+		{name: "no", state: 2},
+		{name: "guess yes", state: 3, expected: true},
+		{name: "guess no", state: 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, isMetered(test.state))
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package daemon
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/metered"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockMeteredDetector struct {
+	connection metered.Connection
+	err        error
+	calls      int
+}
+
+func (d *mockMeteredDetector) PrimaryConnection() (metered.Connection, error) {
+	d.calls++
+	return d.connection, d.err
+}
+
+func TestMeteredPolicy(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name               string
+		policy             config.Metered
+		connection         metered.Connection
+		err                error
+		refreshAllowed     bool
+		autoConnectAllowed bool
+		detected           bool
+	}{
+		{
+			name:               "policy disabled",
+			connection:         metered.Connection{Name: "phone", Metered: true},
+			refreshAllowed:     true,
+			autoConnectAllowed: true,
+		},
+		{
+			name:       "metered by system",
+			policy:     config.Metered{Enabled: true},
+			connection: metered.Connection{Name: "phone", Metered: true},
+			detected:   true,
+		},
+		{
+			name:       "metered manually",
+			policy:     config.Metered{Enabled: true, Networks: []string{"phone"}},
+			connection: metered.Connection{Name: "phone"},
+			detected:   true,
+		},
+		{
+			name:               "not metered",
+			policy:             config.Metered{Enabled: true, Networks: []string{"phone"}},
+			connection:         metered.Connection{Name: "home"},
+			refreshAllowed:     true,
+			autoConnectAllowed: true,
+			detected:           true,
+		},
+		{
+			name:               "refresh allowed",
+			policy:             config.Metered{Enabled: true, AllowRefresh: true},
+			connection:         metered.Connection{Name: "phone", Metered: true},
+			refreshAllowed:     true,
+			autoConnectAllowed: false,
+			detected:           true,
+		},
+		{
+			name:               "auto-connect allowed",
+			policy:             config.Metered{Enabled: true, AllowAutoConnect: true},
+			connection:         metered.Connection{Name: "phone", Metered: true},
+			refreshAllowed:     false,
+			autoConnectAllowed: true,
+			detected:           true,
+		},
+		{
+			name:               "detection fails",
+			policy:             config.Metered{Enabled: true},
+			err:                mock.ErrOnPurpose,
+			refreshAllowed:     true,
+			autoConnectAllowed: true,
+			detected:           true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Metered = test.policy
+			detector := &mockMeteredDetector{connection: test.connection, err: test.err}
+			policy := meteredPolicy{cm: cm, detector: detector}
+
+			assert.Equal(t, test.refreshAllowed, policy.refreshAllowed())
+			assert.Equal(t, test.autoConnectAllowed, policy.autoConnectAllowed())
+			assert.Equal(t, test.detected, detector.calls > 0)
+		})
+	}
+}
+
+func TestMeteredPolicy_RefreshJob(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Metered = config.Metered{Enabled: true}
+	detector := &mockMeteredDetector{connection: metered.Connection{Name: "phone", Metered: true}}
+	policy := meteredPolicy{cm: cm, detector: detector}
+
+	runs := 0
+	job := func() { runs++ }
+
+	policy.refreshJob(job, nil)()
+	assert.Equal(t, 0, runs)
+
+	policy.refreshJob(job, func() bool { return true })()
+	assert.Equal(t, 1, runs)
+
+	detector.connection.Metered = false
+	policy.refreshJob(job, nil)()
+	assert.Equal(t, 2, runs)
+}
@@ -0,0 +1,280 @@
+/*
+Package metrics collects the daemon metrics and serves them in the Prometheus text
+exposition format.
+*/
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+)
+
+// handshakeBuckets are the upper bounds of the handshake duration histogram in seconds
+var handshakeBuckets = []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// labelEscaper escapes the characters which are not allowed in the label values
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// StatusGetter returns the status of the active VPN connection
+type StatusGetter interface {
+	ConnectionStatus() (networker.ConnectionStatus, error)
+}
+
+// tunnel identifies the VPN tunnel the transferred bytes are reported for
+type tunnel struct {
+	iface  string
+	server string
+}
+
+// Collector keeps the counters updated by the daemon events. Connection state and
+// transferred bytes are read from the networker when the metrics are written.
+type Collector struct {
+	status StatusGetter
+	now    func() time.Time
+	mu     sync.Mutex
+	// connectStart is the time of the pending connection attempt
+	connectStart   *time.Time
+	connected      bool
+	connects       map[string]uint64
+	reconnects     uint64
+	disconnects    uint64
+	handshakes     []uint64
+	handshakeSum   float64
+	handshakeCount uint64
+	apiRequests    uint64
+	apiErrors      map[string]uint64
+	// received and sent bytes of the finished connections, so that the counters
+	// do not go back when the tunnel is re-created
+	received map[tunnel]uint64
+	sent     map[tunnel]uint64
+	// current is the tunnel of the active connection and its last reported totals
+	current         *tunnel
+	currentReceived uint64
+	currentSent     uint64
+}
+
+// NewCollector creates a collector reading the connection status from the getter
+func NewCollector(status StatusGetter) *Collector {
+	return &Collector{
+		status:     status,
+		now:        time.Now,
+		connects:   map[string]uint64{},
+		handshakes: make([]uint64, len(handshakeBuckets)),
+		apiErrors:  map[string]uint64{},
+		received:   map[tunnel]uint64{},
+		sent:       map[tunnel]uint64{},
+	}
+}
+
+// NotifyConnect counts the connection attempts and measures the time it takes to
+// establish the tunnel. Connection established without disconnecting from the
+// previous one is counted as a reconnect. Connections to meshnet peers are not
+// counted.
+func (c *Collector) NotifyConnect(data events.DataConnect) error {
+	if data.IsMeshnetPeer {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch data.Type {
+	case events.ConnectAttempt:
+		now := c.now()
+		c.connectStart = &now
+	case events.ConnectSuccess:
+		c.connects["success"]++
+		if c.connected {
+			c.reconnects++
+		}
+		c.connected = true
+		if c.connectStart != nil {
+			c.observeHandshake(c.now().Sub(*c.connectStart).Seconds())
+		}
+		c.connectStart = nil
+	case events.ConnectFailure:
+		c.connects["failure"]++
+		c.connectStart = nil
+	}
+	return nil
+}
+
+// NotifyDisconnect marks the connection as closed
+func (c *Collector) NotifyDisconnect(data events.DataDisconnect) error {
+	if data.Type != events.DisconnectSuccess {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.disconnects++
+	c.connected = false
+	return nil
+}
+
+// NotifyRequestAPI counts the API requests and the ones which failed. Errors are
+// labeled with the HTTP status code or "transport" if no response was received.
+func (c *Collector) NotifyRequestAPI(data events.DataRequestAPI) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.apiRequests++
+	switch {
+	case data.Error != nil || data.Response == nil:
+		c.apiErrors["transport"]++
+	case data.Response.StatusCode >= 400:
+		c.apiErrors[strconv.Itoa(data.Response.StatusCode)]++
+	}
+	return nil
+}
+
+// Thread unsafe.
+func (c *Collector) observeHandshake(seconds float64) {
+	for i, bound := range handshakeBuckets {
+		if seconds <= bound {
+			c.handshakes[i]++
+		}
+	}
+	c.handshakeSum += seconds
+	c.handshakeCount++
+}
+
+// updateTransfer moves the totals of the previous tunnel to the finished
+// connections when the tunnel changes or its counters are reset.
+// Thread unsafe.
+func (c *Collector) updateTransfer(status networker.ConnectionStatus, active bool) {
+	var next *tunnel
+	if active {
+		next = &tunnel{iface: status.Interface, server: status.Hostname}
+	}
+	reset := c.current != nil && next != nil &&
+		(*c.current != *next || status.Download < c.currentReceived || status.Upload < c.currentSent)
+	if c.current != nil && (next == nil || reset) {
+		c.received[*c.current] += c.currentReceived
+		c.sent[*c.current] += c.currentSent
+		c.current, c.currentReceived, c.currentSent = nil, 0, 0
+	}
+	if next != nil {
+		c.current, c.currentReceived, c.currentSent = next, status.Download, status.Upload
+	}
+}
+
+// WriteTo writes the metrics in the Prometheus text exposition format
+func (c *Collector) WriteTo(w io.Writer) (int64, error) {
+	status, err := c.status.ConnectionStatus()
+	active := err == nil
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.updateTransfer(status, active)
+
+	var b strings.Builder
+	writeHeader(&b, "nordvpn_connected", "gauge", "Whether the VPN connection is active.")
+	writeSample(&b, "nordvpn_connected", nil, boolValue(active))
+
+	writeHeader(&b, "nordvpn_connection_uptime_seconds", "gauge", "Duration of the active VPN connection.")
+	var uptime float64
+	if active && status.Uptime != nil {
+		uptime = status.Uptime.Seconds()
+	}
+	writeSample(&b, "nordvpn_connection_uptime_seconds", nil, uptime)
+
+	writeHeader(&b, "nordvpn_connects_total", "counter", "VPN connection attempts by result.")
+	for _, result := range []string{"success", "failure"} {
+		writeSample(&b, "nordvpn_connects_total", []label{{"result", result}}, float64(c.connects[result]))
+	}
+
+	writeHeader(&b, "nordvpn_reconnects_total", "counter", "VPN connections established without disconnecting from the previous one.")
+	writeSample(&b, "nordvpn_reconnects_total", nil, float64(c.reconnects))
+
+	writeHeader(&b, "nordvpn_disconnects_total", "counter", "VPN disconnects.")
+	writeSample(&b, "nordvpn_disconnects_total", nil, float64(c.disconnects))
+
+	writeHeader(&b, "nordvpn_handshake_duration_seconds", "histogram", "Time it takes to establish the VPN tunnel.")
+	for i, bound := range handshakeBuckets {
+		writeSample(&b, "nordvpn_handshake_duration_seconds_bucket",
+			[]label{{"le", strconv.FormatFloat(bound, 'g', -1, 64)}}, float64(c.handshakes[i]))
+	}
+	writeSample(&b, "nordvpn_handshake_duration_seconds_bucket", []label{{"le", "+Inf"}}, float64(c.handshakeCount))
+	writeSample(&b, "nordvpn_handshake_duration_seconds_sum", nil, c.handshakeSum)
+	writeSample(&b, "nordvpn_handshake_duration_seconds_count", nil, float64(c.handshakeCount))
+
+	writeHeader(&b, "nordvpn_tunnel_received_bytes_total", "counter", "Bytes received through the VPN tunnel.")
+	writeTransfer(&b, "nordvpn_tunnel_received_bytes_total", c.received, c.current, c.currentReceived)
+	writeHeader(&b, "nordvpn_tunnel_sent_bytes_total", "counter", "Bytes sent through the VPN tunnel.")
+	writeTransfer(&b, "nordvpn_tunnel_sent_bytes_total", c.sent, c.current, c.currentSent)
+
+	writeHeader(&b, "nordvpn_api_requests_total", "counter", "Requests sent to the NordVPN API.")
+	writeSample(&b, "nordvpn_api_requests_total", nil, float64(c.apiRequests))
+
+	writeHeader(&b, "nordvpn_api_errors_total", "counter", "Failed requests to the NordVPN API by HTTP status code.")
+	codes := make([]string, 0, len(c.apiErrors))
+	for code := range c.apiErrors {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		writeSample(&b, "nordvpn_api_errors_total", []label{{"code", code}}, float64(c.apiErrors[code]))
+	}
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+type label struct {
+	name  string
+	value string
+}
+
+func writeHeader(b *strings.Builder, name string, kind string, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+func writeSample(b *strings.Builder, name string, labels []label, value float64) {
+	b.WriteString(name)
+	if len(labels) > 0 {
+		pairs := make([]string, 0, len(labels))
+		for _, l := range labels {
+			pairs = append(pairs, fmt.Sprintf(`%s="%s"`, l.name, labelEscaper.Replace(l.value)))
+		}
+		fmt.Fprintf(b, "{%s}", strings.Join(pairs, ","))
+	}
+	fmt.Fprintf(b, " %s\n", strconv.FormatFloat(value, 'g', -1, 64))
+}
+
+// writeTransfer writes the bytes of the finished connections together with the
+// active one, sorted by the tunnel
+func writeTransfer(b *strings.Builder, name string, finished map[tunnel]uint64, current *tunnel, currentBytes uint64) {
+	totals := make(map[tunnel]uint64, len(finished)+1)
+	for t, bytes := range finished {
+		totals[t] = bytes
+	}
+	if current != nil {
+		totals[*current] += currentBytes
+	}
+	tunnels := make([]tunnel, 0, len(totals))
+	for t := range totals {
+		tunnels = append(tunnels, t)
+	}
+	sort.Slice(tunnels, func(i, j int) bool {
+		if tunnels[i].iface != tunnels[j].iface {
+			return tunnels[i].iface < tunnels[j].iface
+		}
+		return tunnels[i].server < tunnels[j].server
+	})
+	for _, t := range tunnels {
+		writeSample(b, name, []label{{"interface", t.iface}, {"server", t.server}}, float64(totals[t]))
+	}
+}
+
+func boolValue(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
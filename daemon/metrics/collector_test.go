@@ -0,0 +1,156 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeStatus struct {
+	status networker.ConnectionStatus
+	err    error
+}
+
+func (f *fakeStatus) ConnectionStatus() (networker.ConnectionStatus, error) {
+	return f.status, f.err
+}
+
+func write(t *testing.T, c *Collector) string {
+	t.Helper()
+	var b strings.Builder
+	_, err := c.WriteTo(&b)
+	require.NoError(t, err)
+	return b.String()
+}
+
+func TestCollector_Connects(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Unix(0, 0)
+	c := NewCollector(&fakeStatus{err: errors.New("inactive")})
+	c.now = func() time.Time { return now }
+
+	assert.NoError(t, c.NotifyConnect(events.DataConnect{Type: events.ConnectAttempt}))
+	now = now.Add(700 * time.Millisecond)
+	assert.NoError(t, c.NotifyConnect(events.DataConnect{Type: events.ConnectSuccess}))
+	assert.NoError(t, c.NotifyConnect(events.DataConnect{Type: events.ConnectAttempt}))
+	assert.NoError(t, c.NotifyConnect(events.DataConnect{Type: events.ConnectFailure}))
+	assert.NoError(t, c.NotifyConnect(events.DataConnect{Type: events.ConnectAttempt}))
+	now = now.Add(3 * time.Second)
+	assert.NoError(t, c.NotifyConnect(events.DataConnect{Type: events.ConnectSuccess}))
+	assert.NoError(t, c.NotifyConnect(events.DataConnect{IsMeshnetPeer: true}))
+	assert.NoError(t, c.NotifyDisconnect(events.DataDisconnect{Type: events.DisconnectAttempt}))
+	assert.NoError(t, c.NotifyDisconnect(events.DataDisconnect{Type: events.DisconnectSuccess}))
+	assert.NoError(t, c.NotifyConnect(events.DataConnect{Type: events.ConnectSuccess}))
+
+	out := write(t, c)
+	for _, line := range []string{
+		"nordvpn_connected 0",
+		`nordvpn_connects_total{result="success"} 3`,
+		`nordvpn_connects_total{result="failure"} 1`,
+		"nordvpn_reconnects_total 1",
+		"nordvpn_disconnects_total 1",
+		`nordvpn_handshake_duration_seconds_bucket{le="0.5"} 0`,
+		`nordvpn_handshake_duration_seconds_bucket{le="1"} 1`,
+		`nordvpn_handshake_duration_seconds_bucket{le="5"} 2`,
+		`nordvpn_handshake_duration_seconds_bucket{le="+Inf"} 2`,
+		"nordvpn_handshake_duration_seconds_sum 3.7",
+		"nordvpn_handshake_duration_seconds_count 2",
+		"# TYPE nordvpn_handshake_duration_seconds histogram",
+	} {
+		assert.Contains(t, out, line+"\n")
+	}
+}
+
+func TestCollector_Transfer(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	uptime := time.Minute
+	status := &fakeStatus{status: networker.ConnectionStatus{
+		Interface: "nordlynx",
+		Hostname:  "lt1.nordvpn.com",
+		Download:  100,
+		Upload:    10,
+		Uptime:    &uptime,
+	}}
+	c := NewCollector(status)
+
+	out := write(t, c)
+	assert.Contains(t, out, "nordvpn_connected 1\n")
+	assert.Contains(t, out, "nordvpn_connection_uptime_seconds 60\n")
+	assert.Contains(t, out, `nordvpn_tunnel_received_bytes_total{interface="nordlynx",server="lt1.nordvpn.com"} 100`+"\n")
+	assert.Contains(t, out, `nordvpn_tunnel_sent_bytes_total{interface="nordlynx",server="lt1.nordvpn.com"} 10`+"\n")
+
+	// tunnel was re-created, so the counters started from zero
+	status.status.Download, status.status.Upload = 50, 5
+	out = write(t, c)
+	assert.Contains(t, out, `nordvpn_tunnel_received_bytes_total{interface="nordlynx",server="lt1.nordvpn.com"} 150`+"\n")
+	assert.Contains(t, out, `nordvpn_tunnel_sent_bytes_total{interface="nordlynx",server="lt1.nordvpn.com"} 15`+"\n")
+
+	status.status.Hostname = "lv1.nordvpn.com"
+	status.status.Download, status.status.Upload = 20, 2
+	out = write(t, c)
+	assert.Contains(t, out, `nordvpn_tunnel_received_bytes_total{interface="nordlynx",server="lt1.nordvpn.com"} 150`+"\n")
+	assert.Contains(t, out, `nordvpn_tunnel_received_bytes_total{interface="nordlynx",server="lv1.nordvpn.com"} 20`+"\n")
+
+	status.err = errors.New("inactive")
+	out = write(t, c)
+	assert.Contains(t, out, "nordvpn_connected 0\n")
+	assert.Contains(t, out, `nordvpn_tunnel_received_bytes_total{interface="nordlynx",server="lv1.nordvpn.com"} 20`+"\n")
+	assert.Contains(t, out, `nordvpn_tunnel_sent_bytes_total{interface="nordlynx",server="lv1.nordvpn.com"} 2`+"\n")
+}
+
+func TestCollector_RequestAPI(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	c := NewCollector(&fakeStatus{err: errors.New("inactive")})
+	for _, data := range []events.DataRequestAPI{
+		{Response: &http.Response{StatusCode: http.StatusOK}},
+		{Response: &http.Response{StatusCode: http.StatusTooManyRequests}},
+		{Response: &http.Response{StatusCode: http.StatusTooManyRequests}},
+		{Response: &http.Response{StatusCode: http.StatusBadGateway}},
+		{Error: errors.New("timeout")},
+	} {
+		assert.NoError(t, c.NotifyRequestAPI(data))
+	}
+
+	out := write(t, c)
+	assert.Contains(t, out, "nordvpn_api_requests_total 5\n")
+	assert.Contains(t, out, `nordvpn_api_errors_total{code="429"} 2
+nordvpn_api_errors_total{code="502"} 1
+nordvpn_api_errors_total{code="transport"} 1
+`)
+}
+
+func TestIsValidAddress(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	for address, expected := range map[string]bool{
+		"127.0.0.1:9101": true,
+		"0.0.0.0:9101":   true,
+		"[::1]:9101":     true,
+		"127.0.0.1":      false,
+		"127.0.0.1:0":    false,
+		"localhost:9101": false,
+		":9101":          false,
+		"":               false,
+	} {
+		assert.Equal(t, expected, IsValidAddress(address), address)
+	}
+}
+
+func TestLabelEscaper(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var b strings.Builder
+	writeSample(&b, "metric", []label{{"server", "a\"b\\c\nd"}}, 1)
+	assert.Equal(t, `metric{server="a\"b\\c\nd"} 1`+"\n", b.String())
+}
@@ -0,0 +1,137 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+const (
+	// DefaultAddress is used when the address is not configured. Metrics are
+	// served on the loopback interface only unless configured otherwise.
+	DefaultAddress = "127.0.0.1:9101"
+	// Path the metrics are served on
+	Path = "/metrics"
+	// contentType of the Prometheus text exposition format
+	contentType    = "text/plain; version=0.0.4; charset=utf-8"
+	requestTimeout = 10 * time.Second
+)
+
+// ErrInvalidAddress is returned when the address is not an IP address with a port
+var ErrInvalidAddress = errors.New("metrics address must be an IP address with a port")
+
+// IsValidAddress checks whether the address is an IP address with a port, e.g. 127.0.0.1:9101
+func IsValidAddress(address string) bool {
+	addrPort, err := netip.ParseAddrPort(address)
+	return err == nil && addrPort.Port() != 0
+}
+
+// Address returns the address the metrics are served on
+func Address(cfg config.Metrics) string {
+	if cfg.Address == "" {
+		return DefaultAddress
+	}
+	return cfg.Address
+}
+
+// Server serves the metrics of the collector over HTTP
+type Server struct {
+	collector *Collector
+	mu        sync.Mutex
+	server    *http.Server
+	address   string
+}
+
+// NewServer creates a stopped server
+func NewServer(collector *Collector) *Server {
+	return &Server{collector: collector}
+}
+
+// Set starts, restarts or stops the server according to the settings
+func (s *Server) Set(cfg config.Metrics) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	address := Address(cfg)
+	if cfg.Enabled && s.server != nil && s.address == address {
+		return nil
+	}
+	if err := s.stop(); err != nil {
+		return err
+	}
+	if !cfg.Enabled {
+		return nil
+	}
+	return s.start(address)
+}
+
+// Stop the server if it is running
+func (s *Server) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stop()
+}
+
+// Thread unsafe.
+func (s *Server) start(address string) error {
+	if !IsValidAddress(address) {
+		return ErrInvalidAddress
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("listening on %s: %w", address, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(Path, s.handle)
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: requestTimeout,
+		WriteTimeout:      requestTimeout,
+	}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Println(internal.ErrorPrefix, "serving metrics:", err)
+		}
+	}()
+	s.server = server
+	s.address = address
+	log.Println(internal.InfoPrefix, "serving metrics on", address)
+	return nil
+}
+
+// Thread unsafe.
+func (s *Server) stop() error {
+	if s.server == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	err := s.server.Shutdown(ctx)
+	s.server = nil
+	s.address = ""
+	if err != nil {
+		return fmt.Errorf("stopping metrics server: %w", err)
+	}
+	return nil
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	if _, err := s.collector.WriteTo(w); err != nil {
+		log.Println(internal.WarningPrefix, "writing metrics:", err)
+	}
+}
@@ -47,6 +47,12 @@ func (fm *filesystemMock) WriteFile(location string, data []byte, mode fs.FileMo
 	return nil
 }
 
+func (fm *filesystemMock) Rename(from string, to string) error {
+	fm.files[to] = fm.files[from]
+	delete(fm.files, from)
+	return nil
+}
+
 func newFilesystemMock(t *testing.T) filesystemMock {
 	t.Helper()
 
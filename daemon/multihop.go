@@ -0,0 +1,66 @@
+package daemon
+
+import (
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/network"
+)
+
+// pickViaServer picks the entry server of the multi-hop connection to the exit
+// server. Entry hop always uses NordLynx over IPv4, independently of the
+// connection settings. On IPv6-only network it goes over IPv6 instead.
+func (r *RPC) pickViaServer(cfg config.Config, via string, exit core.Server) (*vpn.ServerData, error) {
+	insights := r.dm.GetInsightsData().Insights
+	server, _, err := PickServer(
+		r.selectableServersAPI(cfg),
+		r.dm.GetCountryData().Countries,
+		r.selectableServers(cfg),
+		insights.Longitude,
+		insights.Latitude,
+		config.Technology_NORDLYNX,
+		config.Protocol_UDP,
+		false,
+		via,
+		"",
+		cfg.ServerNotes,
+		cfg.RatingWeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if server.Hostname == exit.Hostname {
+		return nil, internal.ErrSameHop
+	}
+
+	endpoint, ipv6Only := r.nat64Endpoint(server)
+	if !ipv6Only {
+		ip, err := server.IPv4()
+		if err != nil {
+			return nil, err
+		}
+		endpoint = network.NewIPv4Endpoint(ip)
+	}
+	subnet, err := endpoint.Network()
+	if err != nil {
+		return nil, err
+	}
+	country, err := server.Locations.Country()
+	if err != nil {
+		return nil, err
+	}
+	var city string
+	if len(server.Locations) > 0 {
+		city = server.Locations[0].City.Name
+	}
+	return &vpn.ServerData{
+		IP:                subnet.Addr(),
+		Hostname:          server.Hostname,
+		Country:           country.Name,
+		City:              city,
+		Protocol:          config.Protocol_UDP,
+		NordLynxPublicKey: server.NordLynxPublicKey,
+		Port:              cfg.ServerPorts.NordLynx,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package daemon
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPickViaServer(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	newServer := func(hostname string, station string, country string, keys ...string) core.Server {
+		return core.Server{
+			Hostname:          hostname,
+			Station:           station,
+			Status:            core.Online,
+			Keys:              keys,
+			NordLynxPublicKey: hostname + "-key",
+			Locations:         core.Locations{{Country: core.Country{Name: country}}},
+			Technologies: core.Technologies{
+				core.Technology{
+					ID:    core.WireguardTech,
+					Pivot: core.Pivot{Status: core.Online},
+				},
+			},
+			Groups: core.Groups{
+				core.Group{ID: config.StandardVPNServers},
+			},
+		}
+	}
+	de := newServer("de123.nordvpn.com", "192.0.2.1", "Germany", "de123")
+	us := newServer("us456.nordvpn.com", "192.0.2.2", "United States", "us456")
+	dm := &DataManager{serversData: ServersData{Servers: core.Servers{de, us}}}
+
+	tests := []struct {
+		name     string
+		via      string
+		exit     core.Server
+		expected string
+		err      error
+	}{
+		{
+			name:     "entry server",
+			via:      "de123",
+			exit:     us,
+			expected: "de123.nordvpn.com",
+		},
+		{
+			name: "same server",
+			via:  "us456",
+			exit: us,
+			err:  internal.ErrSameHop,
+		},
+		{
+			name: "unavailable server",
+			via:  "lt99",
+			exit: us,
+			err:  internal.ErrServerIsUnavailable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rpc := RPC{dm: dm, serversAPI: &mockFailingServersAPI{}}
+			server, err := rpc.pickViaServer(config.Config{}, test.via, test.exit)
+			assert.ErrorIs(t, err, test.err)
+			if test.err != nil {
+				assert.Nil(t, server)
+				return
+			}
+			assert.Equal(t, test.expected, server.Hostname)
+			assert.Equal(t, netip.MustParseAddr("192.0.2.1"), server.IP)
+			assert.Equal(t, "de123.nordvpn.com-key", server.NordLynxPublicKey)
+			assert.Equal(t, config.Protocol_UDP, server.Protocol)
+			assert.Equal(t, "Germany", server.Country)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package daemon
+
+import (
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/network"
+)
+
+// nat64Endpoint returns the endpoint of the server reachable on IPv6-only
+// network and true if the daemon runs on such a network. Native IPv6 address
+// of the server is preferred over the one synthesized for NAT64.
+func (r *RPC) nat64Endpoint(server core.Server) (network.Endpoint, bool) {
+	if r.nat64 == nil {
+		return network.Endpoint{}, false
+	}
+	prefix, ok := r.nat64.Prefix()
+	if !ok {
+		return network.Endpoint{}, false
+	}
+	log.Println(internal.InfoPrefix, "IPv6-only network detected, NAT64 prefix:", prefix)
+	return network.NewIPv6Endpoint(network.ThroughNAT64(prefix, server.IPs())), true
+}
@@ -0,0 +1,79 @@
+package daemon
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+type nat64Mock struct {
+	prefix netip.Prefix
+}
+
+func (m nat64Mock) Prefix() (netip.Prefix, bool) { return m.prefix, m.prefix.IsValid() }
+
+func TestNAT64Endpoint(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	ipv4Server := core.Server{Station: "192.0.2.33"}
+	dualStackServer := core.Server{
+		Station: "192.0.2.33",
+		IPRecords: []core.ServerIPRecord{
+			{ServerIP: core.ServerIP{IP: "192.0.2.33", Version: 4}},
+			{ServerIP: core.ServerIP{IP: "2001:db8::1", Version: 6}},
+		},
+	}
+	prefix := netip.MustParsePrefix("64:ff9b::/96")
+
+	tests := []struct {
+		name     string
+		nat64    *nat64Mock
+		server   core.Server
+		ipv6Only bool
+		expected netip.Addr
+	}{
+		{
+			name:   "no detector",
+			server: ipv4Server,
+		},
+		{
+			name:   "ipv4 network",
+			nat64:  &nat64Mock{},
+			server: ipv4Server,
+		},
+		{
+			name:     "synthesized address",
+			nat64:    &nat64Mock{prefix: prefix},
+			server:   ipv4Server,
+			ipv6Only: true,
+			expected: netip.MustParseAddr("64:ff9b::c000:221"),
+		},
+		{
+			name:     "native ipv6 address",
+			nat64:    &nat64Mock{prefix: prefix},
+			server:   dualStackServer,
+			ipv6Only: true,
+			expected: netip.MustParseAddr("2001:db8::1"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := RPC{}
+			if test.nat64 != nil {
+				r.nat64 = test.nat64
+			}
+			endpoint, ipv6Only := r.nat64Endpoint(test.server)
+			assert.Equal(t, test.ipv6Only, ipv6Only)
+			if !ipv6Only {
+				return
+			}
+			subnet, err := endpoint.Network()
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, subnet.Addr())
+		})
+	}
+}
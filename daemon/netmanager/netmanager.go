@@ -0,0 +1,133 @@
+/*
+Package netmanager makes the VPN tunnel visible in NetworkManager.
+
+NetworkManager assumes the configuration of a managed device created outside of
+it as an external connection without changing it. Handing the tunnel over to it
+while connected makes the desktop network indicators show the tunnel and runs
+the NetworkManager dispatcher scripts on connect and disconnect. The tunnel is
+handed over only if the integration is enabled in the settings.
+*/
+package netmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	nmDest        = "org.freedesktop.NetworkManager"
+	nmPath        = "/org/freedesktop/NetworkManager"
+	nmGetDevice   = "org.freedesktop.NetworkManager.GetDeviceByIpIface"
+	nmDevice      = "org.freedesktop.NetworkManager.Device"
+	nmSetProperty = "org.freedesktop.DBus.Properties.Set"
+	// callTimeout bounds the calls, so that the connection is not held up by
+	// unresponsive NetworkManager
+	callTimeout = 2 * time.Second
+)
+
+// Manager sets whether the device is managed by NetworkManager
+type Manager interface {
+	SetManaged(iface string, managed bool) error
+}
+
+// Integration hands the tunnel over to NetworkManager while connected. The
+// calls to NetworkManager are made without holding the lock, so that a slow
+// NetworkManager does not hold up the other events.
+type Integration struct {
+	manager Manager
+	tunnel  func() string
+	enabled func() bool
+	mu      sync.Mutex
+	// managed is the name of the tunnel handed over, empty if there is none
+	managed string
+}
+
+// NewIntegration creates the integration with NetworkManager. tunnel returns
+// the name of the tunnel interface of the active connection, enabled reports
+// whether the integration is enabled in the settings.
+func NewIntegration(tunnel func() string, enabled func() bool) *Integration {
+	return &Integration{manager: NetworkManager{}, tunnel: tunnel, enabled: enabled}
+}
+
+// NotifyConnect hands the tunnel over to NetworkManager
+func (i *Integration) NotifyConnect(data events.DataConnect) error {
+	if data.Type != events.ConnectSuccess || !i.enabled() {
+		return nil
+	}
+
+	iface := i.tunnel()
+	i.mu.Lock()
+	previous := i.managed
+	if iface == "" || iface == previous {
+		i.mu.Unlock()
+		return nil
+	}
+	i.managed = iface
+	i.mu.Unlock()
+
+	i.release(previous)
+	// NetworkManager might be missing, which is not an error
+	if err := i.manager.SetManaged(iface, true); err != nil {
+		log.Println(internal.InfoPrefix, "tunnel is not shown in NetworkManager:", err)
+		i.mu.Lock()
+		if i.managed == iface {
+			i.managed = ""
+		}
+		i.mu.Unlock()
+	}
+	return nil
+}
+
+// NotifyDisconnect takes the tunnel back from NetworkManager. It is done even
+// if the integration was disabled after the tunnel was handed over.
+func (i *Integration) NotifyDisconnect(events.DataDisconnect) error {
+	i.mu.Lock()
+	managed := i.managed
+	i.managed = ""
+	i.mu.Unlock()
+	i.release(managed)
+	return nil
+}
+
+// release takes the tunnel back if it still exists, e.g. the NordLynx
+// interface is kept for meshnet
+func (i *Integration) release(iface string) {
+	if iface == "" {
+		return
+	}
+	if err := i.manager.SetManaged(iface, false); err != nil {
+		log.Println(internal.DebugPrefix, "releasing tunnel from NetworkManager:", err)
+	}
+}
+
+// NetworkManager sets the devices managed over D-Bus. The change is not
+// persistent and is lost after NetworkManager restarts.
+type NetworkManager struct{}
+
+func (NetworkManager) SetManaged(iface string, managed bool) error {
+	conn, err := dbus.SystemBus()
+	if err != nil {
+		return fmt.Errorf("connecting to system bus: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	var device dbus.ObjectPath
+	if err := conn.Object(nmDest, nmPath).CallWithContext(ctx, nmGetDevice, 0, iface).Store(&device); err != nil {
+		return fmt.Errorf("getting device %s: %w", iface, err)
+	}
+	if err := conn.Object(nmDest, device).CallWithContext(
+		ctx, nmSetProperty, 0, nmDevice, "Managed", dbus.MakeVariant(managed),
+	).Err; err != nil {
+		return fmt.Errorf("setting device %s managed: %w", iface, err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package netmanager
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockManager struct {
+	managed map[string]bool
+	err     error
+}
+
+func (m *mockManager) SetManaged(iface string, managed bool) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.managed[iface] = managed
+	return nil
+}
+
+func enabled() bool { return true }
+
+func TestIntegration(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	manager := &mockManager{managed: map[string]bool{}}
+	tunnel := "nordlynx"
+	integration := &Integration{manager: manager, tunnel: func() string { return tunnel }, enabled: enabled}
+
+	assert.NoError(t, integration.NotifyConnect(events.DataConnect{Type: events.ConnectAttempt}))
+	assert.Empty(t, manager.managed)
+
+	assert.NoError(t, integration.NotifyConnect(events.DataConnect{Type: events.ConnectSuccess}))
+	assert.Equal(t, map[string]bool{"nordlynx": true}, manager.managed)
+
+	// tunnel of the new connection replaces the old one
+	tunnel = "nordtun"
+	assert.NoError(t, integration.NotifyConnect(events.DataConnect{Type: events.ConnectSuccess}))
+	assert.Equal(t, map[string]bool{"nordlynx": false, "nordtun": true}, manager.managed)
+
+	assert.NoError(t, integration.NotifyDisconnect(events.DataDisconnect{}))
+	assert.Equal(t, map[string]bool{"nordlynx": false, "nordtun": false}, manager.managed)
+}
+
+func TestIntegration_NetworkManagerMissing(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	manager := &mockManager{err: errors.New("service unknown")}
+	integration := &Integration{manager: manager, tunnel: func() string { return "nordlynx" }, enabled: enabled}
+
+	assert.NoError(t, integration.NotifyConnect(events.DataConnect{Type: events.ConnectSuccess}))
+	assert.Empty(t, integration.managed)
+	assert.NoError(t, integration.NotifyDisconnect(events.DataDisconnect{}))
+}
+
+func TestIntegration_Disabled(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	manager := &mockManager{managed: map[string]bool{}}
+	isEnabled := true
+	integration := &Integration{
+		manager: manager,
+		tunnel:  func() string { return "nordlynx" },
+		enabled: func() bool { return isEnabled },
+	}
+
+	assert.NoError(t, integration.NotifyConnect(events.DataConnect{Type: events.ConnectSuccess}))
+	assert.Equal(t, map[string]bool{"nordlynx": true}, manager.managed)
+
+	// tunnel handed over before disabling is still taken back
+	isEnabled = false
+	assert.NoError(t, integration.NotifyDisconnect(events.DataDisconnect{}))
+	assert.Equal(t, map[string]bool{"nordlynx": false}, manager.managed)
+
+	assert.NoError(t, integration.NotifyConnect(events.DataConnect{Type: events.ConnectSuccess}))
+	assert.Equal(t, map[string]bool{"nordlynx": false}, manager.managed)
+	assert.Empty(t, integration.managed)
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/events"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 )
 
@@ -42,6 +43,18 @@ func Notify(cm config.Manager, notificationType NotificationType, args []string)
 	return nil
 }
 
+// NotifyDNSOverwrite warns the users when the VPN DNS is overwritten by
+// another program while connected
+func NotifyDNSOverwrite(cm config.Manager) func(events.DataDNSOverwrite) error {
+	return func(data events.DataDNSOverwrite) error {
+		notificationType := NotificationType(internal.NotificationDNSRestored)
+		if data.Type == events.DNSRestoreFailure {
+			notificationType = internal.NotificationDNSLeak
+		}
+		return Notify(cm, notificationType, nil)
+	}
+}
+
 func notify(id int64, body string) error {
 	var cmd *exec.Cmd
 	commandContext, cancelFunc := context.WithTimeout(context.Background(), time.Second*5)
@@ -86,6 +99,18 @@ func handleNotificationType(notificationType NotificationType, args []string) st
 		return fmt.Sprintf(internal.ReconnectSuccess, internal.StringsToInterfaces(args)...)
 	case internal.NotificationDisconnected:
 		return internal.DisconnectSuccess
+	case internal.NotificationCaptivePortal:
+		return fmt.Sprintf(internal.CaptivePortalFound, internal.StringsToInterfaces(args)...)
+	case internal.NotificationMeshnetInvite:
+		return fmt.Sprintf(internal.MeshnetInviteReceived, internal.StringsToInterfaces(args)...)
+	case internal.NotificationDNSRestored:
+		return internal.DNSRestored
+	case internal.NotificationDNSLeak:
+		return internal.DNSLeak
+	case internal.NotificationObfuscationFallback:
+		return internal.ObfuscationFallback
+	case internal.NotificationServerFailover:
+		return fmt.Sprintf(internal.ServerFailover, internal.StringsToInterfaces(args)...)
 	default:
 		return fmt.Sprintf("Unknown type (%v)", notificationType)
 	}
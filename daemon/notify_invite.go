@@ -0,0 +1,115 @@
+package daemon
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/meshnet"
+)
+
+const (
+	// inviteNotificationTimeout bounds how long the invitation notification
+	// waits for the answer
+	inviteNotificationTimeout = 10 * time.Minute
+
+	actionKeyAcceptInvite  = "accept-invite"
+	actionKeyDeclineInvite = "decline-invite"
+)
+
+// notifyActionsFunc shows the notification with the actions to the user and
+// returns the key of the invoked action, empty if it was dismissed
+type notifyActionsFunc func(ctx context.Context, id int64, body string) (string, error)
+
+// InviteNotifier asks the users who enabled notifications to accept or decline
+// the meshnet invitations. The first answer is used.
+type InviteNotifier struct {
+	cm            config.Manager
+	notifyActions notifyActionsFunc
+	// notify is used when the notification with actions cannot be shown
+	notify func(id int64, body string) error
+}
+
+// NewInviteNotifier creates the notifier which uses notify-send actions
+func NewInviteNotifier(cm config.Manager) *InviteNotifier {
+	return &InviteNotifier{cm: cm, notifyActions: notifyActions, notify: notify}
+}
+
+// NotifyInvite implements meshnet.InviteNotifier
+func (n *InviteNotifier) NotifyInvite(email string) (meshnet.InviteAnswer, error) {
+	var cfg config.Config
+	if err := n.cm.Load(&cfg); err != nil {
+		return meshnet.InviteIgnored, err
+	}
+	if cfg.UsersData == nil || len(cfg.UsersData.Notify) == 0 {
+		return meshnet.InviteIgnored, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), inviteNotificationTimeout)
+	defer cancel()
+
+	keys := make(chan string, len(cfg.UsersData.Notify))
+	for id := range cfg.UsersData.Notify {
+		go func(id int64) {
+			key, err := n.notifyActions(ctx, id, fmt.Sprintf(internal.MeshnetInviteActions, email))
+			if err != nil && ctx.Err() == nil {
+				log.Println(internal.WarningPrefix, "notification actions are not supported:", err)
+				if err := n.notify(id, handleNotificationType(internal.NotificationMeshnetInvite, []string{email})); err != nil {
+					log.Println(internal.ErrorPrefix, err)
+				}
+			}
+			keys <- key
+		}(id)
+	}
+
+	for range cfg.UsersData.Notify {
+		switch <-keys {
+		case actionKeyAcceptInvite:
+			return meshnet.InviteAccepted, nil
+		case actionKeyDeclineInvite:
+			return meshnet.InviteDeclined, nil
+		}
+	}
+	return meshnet.InviteIgnored, nil
+}
+
+// notifyActions runs notify-send as the user and waits until the notification
+// is closed. notify-send prints the key of the invoked action.
+func notifyActions(ctx context.Context, id int64, body string) (string, error) {
+	if !internal.IsCommandAvailable("notify-send") {
+		return "", fmt.Errorf("notify-send is not available")
+	}
+	dbusAddr := internal.DBUSSessionBusAddress(id)
+	if dbusAddr == "" {
+		// user does not have active dbus session - cannot send notification
+		return "", nil
+	}
+
+	// #nosec G204 -- body is formatted by the daemon
+	cmd := exec.CommandContext(ctx,
+		"notify-send",
+		"--wait",
+		"--action="+actionKeyAcceptInvite+"=Accept",
+		"--action="+actionKeyDeclineInvite+"=Decline",
+		"-i", IconPath,
+		summary,
+		body)
+	cmd.Env = os.Environ()
+	cmd.Env = append(cmd.Env, "DISPLAY=:0.0")
+	cmd.Env = append(cmd.Env, dbusAddr)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Credential: &syscall.Credential{Uid: uint32(id)}}
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("running notify command: %w", err)
+	}
+	return strings.TrimSpace(out.String()), nil
+}
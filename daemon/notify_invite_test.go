@@ -0,0 +1,89 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/meshnet"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInviteNotifier_NotifyInvite(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		users    map[int64]bool
+		keys     map[int64]string
+		err      error
+		answer   meshnet.InviteAnswer
+		fallback []int64
+	}{
+		{
+			name:   "no users to notify",
+			answer: meshnet.InviteIgnored,
+		},
+		{
+			name:   "accepted",
+			users:  map[int64]bool{1000: true},
+			keys:   map[int64]string{1000: actionKeyAcceptInvite},
+			answer: meshnet.InviteAccepted,
+		},
+		{
+			name:   "declined",
+			users:  map[int64]bool{1000: true},
+			keys:   map[int64]string{1000: actionKeyDeclineInvite},
+			answer: meshnet.InviteDeclined,
+		},
+		{
+			name:   "answered by one of the users",
+			users:  map[int64]bool{1000: true, 1001: true},
+			keys:   map[int64]string{1001: actionKeyAcceptInvite},
+			answer: meshnet.InviteAccepted,
+		},
+		{
+			name:   "dismissed",
+			users:  map[int64]bool{1000: true},
+			answer: meshnet.InviteIgnored,
+		},
+		{
+			name:     "actions not supported",
+			users:    map[int64]bool{1000: true},
+			err:      errors.New("unknown option --action"),
+			answer:   meshnet.InviteIgnored,
+			fallback: []int64{1000},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			if test.users != nil {
+				cm.Cfg.UsersData = &config.UsersData{Notify: test.users}
+			}
+			var fallback []int64
+			notifier := InviteNotifier{
+				cm: cm,
+				notifyActions: func(_ context.Context, id int64, body string) (string, error) {
+					assert.Contains(t, body, "inviter@nordvpn.com")
+					return test.keys[id], test.err
+				},
+				notify: func(id int64, body string) error {
+					assert.Contains(t, body, "nordvpn meshnet invite accept inviter@nordvpn.com")
+					fallback = append(fallback, id)
+					return nil
+				},
+			}
+
+			answer, err := notifier.NotifyInvite("inviter@nordvpn.com")
+			assert.NoError(t, err)
+			assert.Equal(t, test.answer, answer)
+			assert.Equal(t, test.fallback, fallback)
+		})
+	}
+}
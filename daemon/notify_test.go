@@ -39,6 +39,24 @@ func TestHandleNotificationType(t *testing.T) {
 			args:             []string{},
 			expected:         "You are disconnected from NordVPN.",
 		},
+		{
+			name:             "dns leak",
+			notificationType: internal.NotificationDNSLeak,
+			args:             []string{},
+			expected:         "DNS settings keep being changed by another program. DNS queries may leak outside of NordVPN.",
+		},
+		{
+			name:             "obfuscation fallback",
+			notificationType: internal.NotificationObfuscationFallback,
+			args:             []string{},
+			expected:         "The network seems to interfere with VPN traffic. Switching to an obfuscated server.",
+		},
+		{
+			name:             "server failover",
+			notificationType: internal.NotificationServerFailover,
+			args:             []string{"de1.nordvpn.com", "is under maintenance", "de2.nordvpn.com"},
+			expected:         "Server de1.nordvpn.com is under maintenance. Reconnecting to de2.nordvpn.com.",
+		},
 		{
 			name:             "notificationType unknown",
 			notificationType: 65,
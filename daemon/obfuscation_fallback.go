@@ -0,0 +1,253 @@
+package daemon
+
+import (
+	"log"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// stalledUploadBytes is the traffic sent between two checks above which the
+	// tunnel is considered in use. It is above the tunnel keepalive packets.
+	stalledUploadBytes = 4 * 1024
+	// throughputCollapseTimeout is for how long the traffic may be sent without
+	// receiving anything back before the network is considered to interfere
+	throughputCollapseTimeout = 30 * time.Second
+)
+
+// ConnectWithObfuscationFallback behaves like connect, but connects to the
+// obfuscated server returned by obfuscated once connect failed. Plain OpenVPN
+// failing repeatedly, even after the fallback to TCP, usually means that the
+// network blocks it by deep packet inspection.
+func ConnectWithObfuscationFallback(
+	events chan ConnectEvent,
+	serverData vpn.ServerData,
+	connect func(chan ConnectEvent, vpn.ServerData),
+	obfuscated func() (vpn.ServerData, error),
+) {
+	defer close(events)
+
+	attemptEvents := make(chan ConnectEvent)
+	go connect(attemptEvents, serverData)
+
+	var failure *ConnectEvent
+	for ev := range attemptEvents {
+		ev := ev
+		if ev.Code == internal.CodeFailure {
+			failure = &ev
+			continue
+		}
+		events <- ev
+	}
+	if failure == nil {
+		return
+	}
+
+	log.Println(internal.WarningPrefix, "plain OpenVPN failed:", failure.Message)
+	obfuscatedData, err := obfuscated()
+	if err != nil {
+		log.Println(internal.WarningPrefix, "picking obfuscated server to fall back to:", err)
+		events <- *failure
+		return
+	}
+
+	log.Println(internal.InfoPrefix, "falling back to obfuscated server", obfuscatedData.Hostname)
+	events <- ConnectEvent{Code: internal.CodeObfuscationFallback, Message: obfuscatedData.Hostname}
+
+	attemptEvents = make(chan ConnectEvent)
+	go connect(attemptEvents, obfuscatedData)
+	for ev := range attemptEvents {
+		if ev.Code == internal.CodeConnecting {
+			// the user was already notified about connecting
+			continue
+		}
+		events <- ev
+	}
+}
+
+// usesPlainOpenVPN reports whether the connections use OpenVPN without
+// obfuscation, which can fall back to the obfuscated servers
+func usesPlainOpenVPN(cfg config.Config) bool {
+	return cfg.Technology == config.Technology_OPENVPN && !cfg.AutoConnectData.Obfuscate
+}
+
+// canFallBackToObfuscation reports whether the connection to the server tag
+// and group can be retried on an obfuscated server. Obfuscated servers are a
+// group of their own, so the connections to the other groups and to the
+// specific servers are not retried.
+func canFallBackToObfuscation(cfg config.Config, servers core.Servers, tag string, group string) bool {
+	return usesPlainOpenVPN(cfg) &&
+		group == "" &&
+		!strings.EqualFold(tag, favoritesServerTag) &&
+		core.IsServerObfuscated(servers, tag) == core.NotAServerName
+}
+
+// obfuscationPreferred reports whether the plain OpenVPN connection is made to
+// an obfuscated server right away, because the network interfered with it
+// before
+func (r *RPC) obfuscationPreferred(cfg config.Config, network string) bool {
+	forced := atomic.SwapUint32(&r.obfuscateNext, 0) == 1
+	if !usesPlainOpenVPN(cfg) {
+		return false
+	}
+	return forced || (network != "" && slices.Contains(cfg.ObfuscatedNetworks, network))
+}
+
+// preferObfuscation makes the connections on the network use obfuscated
+// servers right away
+func (r *RPC) preferObfuscation(network string) {
+	if network == "" {
+		return
+	}
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		if !slices.Contains(c.ObfuscatedNetworks, network) {
+			c.ObfuscatedNetworks = append(c.ObfuscatedNetworks, network)
+		}
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, "saving obfuscation preference of the network:", err)
+		return
+	}
+	log.Println(internal.InfoPrefix, "obfuscated servers will be used on", network)
+}
+
+// announceObfuscationFallback tells the user why the connection is switched to
+// an obfuscated server
+func (r *RPC) announceObfuscationFallback(data events.DataObfuscationFallback) {
+	if r.eventStream != nil {
+		if err := r.eventStream.NotifyObfuscationFallback(data); err != nil {
+			log.Println(internal.WarningPrefix, "publishing obfuscation fallback:", err)
+		}
+	}
+	if err := Notify(r.cm, internal.NotificationObfuscationFallback, nil); err != nil {
+		log.Println(internal.WarningPrefix, "notifying about obfuscation fallback:", err)
+	}
+}
+
+// switchToObfuscated connects to an obfuscated server in the country of the
+// plain OpenVPN server after the network started to interfere with the
+// connection
+func (r *RPC) switchToObfuscated() error {
+	server := r.lastServer
+	network := r.networkName()
+	r.announceObfuscationFallback(events.DataObfuscationFallback{
+		Type:     events.ObfuscationFallbackThroughput,
+		Hostname: server.Hostname,
+		Network:  network,
+	})
+	r.preferObfuscation(network)
+
+	if err := r.disconnect(disconnectReasonInterference); err != nil {
+		return err
+	}
+	atomic.StoreUint32(&r.obfuscateNext, 1)
+	return r.connectWith(&pb.ConnectRequest{
+		ServerTag: obfuscatedFallbackTag(r.dm.GetServersData().Servers, server),
+	})
+}
+
+// obfuscatedFallbackTag returns the code of the country of the server if it has
+// obfuscated servers, otherwise empty tag to connect to the recommended one
+func obfuscatedFallbackTag(servers core.Servers, server core.Server) string {
+	country, err := server.Locations.Country()
+	if err != nil {
+		return ""
+	}
+	if !slices.ContainsFunc(servers, func(s core.Server) bool {
+		return core.IsObfuscated()(s) &&
+			len(s.Locations) > 0 &&
+			s.Locations[0].Country.Code == country.Code
+	}) {
+		return ""
+	}
+	return strings.ToLower(country.Code)
+}
+
+// throughputCollapse detects the plain OpenVPN connection which keeps sending
+// traffic, but receives nothing back. It is typical of deep packet inspection
+// throttling the VPN traffic once it is identified, so the connection is
+// switched to an obfuscated server.
+type throughputCollapse struct {
+	netw networker.Networker
+	// lastServer returns the server connected to
+	lastServer func() core.Server
+	// switchToObfuscated connects to an obfuscated server instead
+	switchToObfuscated func() error
+	now                func() time.Time
+	mu                 sync.Mutex
+	counted            bool
+	download           uint64
+	upload             uint64
+	// stalledSince is when the traffic stopped coming back, zero if it did not
+	stalledSince time.Time
+}
+
+// stalledFor records the traffic counters and returns for how long the traffic
+// has been sent without receiving anything back
+func (t *throughputCollapse) stalledFor(download uint64, upload uint64, now time.Time) time.Duration {
+	counted, prevDownload, prevUpload := t.counted, t.download, t.upload
+	t.counted, t.download, t.upload = true, download, upload
+	if !counted || download < prevDownload || upload < prevUpload ||
+		download-prevDownload > idleBytes || upload-prevUpload <= stalledUploadBytes {
+		t.stalledSince = time.Time{}
+		return 0
+	}
+	if t.stalledSince.IsZero() {
+		t.stalledSince = now
+	}
+	return now.Sub(t.stalledSince)
+}
+
+func (t *throughputCollapse) reset() {
+	t.counted = false
+	t.stalledSince = time.Time{}
+}
+
+func (t *throughputCollapse) check() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.netw.IsVPNActive() {
+		t.reset()
+		return
+	}
+
+	status, err := t.netw.ConnectionStatus()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "throughput collapse: retrieving connection status:", err)
+		return
+	}
+	if status.Technology != config.Technology_OPENVPN || core.IsObfuscated()(t.lastServer()) {
+		t.reset()
+		return
+	}
+
+	stalled := t.stalledFor(status.Download, status.Upload, t.now())
+	if stalled < throughputCollapseTimeout {
+		return
+	}
+
+	log.Println(internal.WarningPrefix, "throughput collapse: nothing received for", stalled,
+		"while sending, switching to an obfuscated server")
+	t.reset()
+	if err := t.switchToObfuscated(); err != nil {
+		log.Println(internal.ErrorPrefix, "throughput collapse: switching to an obfuscated server:", err)
+	}
+}
+
+// JobThroughputCollapse switches the plain OpenVPN connection interfered with by
+// the network to an obfuscated server
+func JobThroughputCollapse(r *RPC) func() {
+	return r.throughput.check
+}
@@ -0,0 +1,265 @@
+package daemon
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	mocknetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectWithObfuscationFallback(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name           string
+		plainBlocked   bool
+		obfuscatedErr  error
+		obfuscatedFail bool
+		expectedCodes  []int64
+		expectedHosts  []string
+	}{
+		{
+			name:          "plain works",
+			expectedCodes: []int64{internal.CodeConnecting, internal.CodeConnected},
+			expectedHosts: []string{"de1.nordvpn.com"},
+		},
+		{
+			name:         "plain blocked",
+			plainBlocked: true,
+			expectedCodes: []int64{
+				internal.CodeConnecting,
+				internal.CodeObfuscationFallback,
+				internal.CodeConnected,
+			},
+			expectedHosts: []string{"de1.nordvpn.com", "de50.nordvpn.com"},
+		},
+		{
+			name:          "no obfuscated server",
+			plainBlocked:  true,
+			obfuscatedErr: internal.ErrServerIsUnavailable,
+			expectedCodes: []int64{internal.CodeConnecting, internal.CodeFailure},
+			expectedHosts: []string{"de1.nordvpn.com"},
+		},
+		{
+			name:           "obfuscated blocked",
+			plainBlocked:   true,
+			obfuscatedFail: true,
+			expectedCodes: []int64{
+				internal.CodeConnecting,
+				internal.CodeObfuscationFallback,
+				internal.CodeFailure,
+			},
+			expectedHosts: []string{"de1.nordvpn.com", "de50.nordvpn.com"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var hosts []string
+			connect := func(ch chan ConnectEvent, serverData vpn.ServerData) {
+				defer close(ch)
+				hosts = append(hosts, serverData.Hostname)
+				ch <- ConnectEvent{Code: internal.CodeConnecting}
+				if (!serverData.Obfuscated && test.plainBlocked) || (serverData.Obfuscated && test.obfuscatedFail) {
+					ch <- ConnectEvent{Code: internal.CodeFailure, Message: "TLS handshake failed"}
+					return
+				}
+				ch <- ConnectEvent{Code: internal.CodeConnected}
+			}
+			obfuscated := func() (vpn.ServerData, error) {
+				if test.obfuscatedErr != nil {
+					return vpn.ServerData{}, test.obfuscatedErr
+				}
+				return vpn.ServerData{Hostname: "de50.nordvpn.com", Obfuscated: true}, nil
+			}
+
+			events := make(chan ConnectEvent)
+			go ConnectWithObfuscationFallback(
+				events,
+				vpn.ServerData{Hostname: "de1.nordvpn.com"},
+				connect,
+				obfuscated,
+			)
+
+			var codes []int64
+			for ev := range events {
+				codes = append(codes, ev.Code)
+			}
+			assert.Equal(t, test.expectedCodes, codes)
+			assert.Equal(t, test.expectedHosts, hosts)
+		})
+	}
+}
+
+func TestCanFallBackToObfuscation(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	servers := core.Servers{{Hostname: "de1.nordvpn.com"}}
+	openvpn := config.Config{Technology: config.Technology_OPENVPN}
+	obfuscated := openvpn
+	obfuscated.AutoConnectData.Obfuscate = true
+
+	tests := []struct {
+		name     string
+		cfg      config.Config
+		tag      string
+		group    string
+		expected bool
+	}{
+		{name: "quick connect", cfg: openvpn, expected: true},
+		{name: "country", cfg: openvpn, tag: "de", expected: true},
+		{name: "server", cfg: openvpn, tag: "de1"},
+		{name: "group", cfg: openvpn, group: "p2p"},
+		{name: "favorites", cfg: openvpn, tag: favoritesServerTag},
+		{name: "already obfuscated", cfg: obfuscated},
+		{name: "nordlynx", cfg: config.Config{Technology: config.Technology_NORDLYNX}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, canFallBackToObfuscation(test.cfg, servers, test.tag, test.group))
+		})
+	}
+}
+
+func TestRPC_ObfuscationPreferred(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Technology = config.Technology_OPENVPN
+	r := RPC{cm: cm}
+
+	assert.False(t, r.obfuscationPreferred(*cm.Cfg, "Hotel WiFi"))
+
+	r.preferObfuscation("Hotel WiFi")
+	r.preferObfuscation("Hotel WiFi")
+	assert.Equal(t, []string{"Hotel WiFi"}, cm.Cfg.ObfuscatedNetworks)
+	assert.True(t, r.obfuscationPreferred(*cm.Cfg, "Hotel WiFi"))
+	assert.False(t, r.obfuscationPreferred(*cm.Cfg, "Home"))
+
+	// forced once, regardless of the network
+	r.obfuscateNext = 1
+	assert.True(t, r.obfuscationPreferred(*cm.Cfg, ""))
+	assert.False(t, r.obfuscationPreferred(*cm.Cfg, ""))
+
+	r.preferObfuscation("")
+	assert.Equal(t, []string{"Hotel WiFi"}, cm.Cfg.ObfuscatedNetworks)
+}
+
+// throughputNetworker reports the traffic counters of the OpenVPN connection
+type throughputNetworker struct {
+	mocknetworker.Mock
+	active   bool
+	download uint64
+	upload   uint64
+}
+
+func (n *throughputNetworker) IsVPNActive() bool { return n.active }
+
+func (n *throughputNetworker) ConnectionStatus() (networker.ConnectionStatus, error) {
+	return networker.ConnectionStatus{
+		Technology: config.Technology_OPENVPN,
+		Download:   n.download,
+		Upload:     n.upload,
+	}, nil
+}
+
+func TestThroughputCollapse(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	obfuscatedServer := core.Server{
+		Status: core.Online,
+		Technologies: core.Technologies{
+			{ID: core.OpenVPNUDPObfuscated, Pivot: core.Pivot{Status: core.Online}},
+			{ID: core.OpenVPNTCPObfuscated, Pivot: core.Pivot{Status: core.Online}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		server   core.Server
+		received uint64
+		sent     uint64
+		expected bool
+	}{
+		{
+			name:     "nothing received while sending",
+			sent:     10 * stalledUploadBytes,
+			expected: true,
+		},
+		{
+			name:     "keepalive packets only",
+			received: idleBytes / 2,
+			sent:     idleBytes / 2,
+		},
+		{
+			name:     "traffic received",
+			received: 10 * idleBytes,
+			sent:     10 * stalledUploadBytes,
+		},
+		{
+			name:   "obfuscated server",
+			server: obfuscatedServer,
+			sent:   10 * stalledUploadBytes,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			netw := &throughputNetworker{active: true}
+			switched := false
+			now := time.Now()
+			tc := throughputCollapse{
+				netw:       netw,
+				lastServer: func() core.Server { return test.server },
+				switchToObfuscated: func() error {
+					switched = true
+					return nil
+				},
+				now: func() time.Time { return now },
+			}
+
+			tc.check()
+			for elapsed := time.Duration(0); elapsed <= throughputCollapseTimeout; elapsed += 5 * time.Second {
+				now = now.Add(5 * time.Second)
+				netw.download += test.received
+				netw.upload += test.sent
+				tc.check()
+			}
+			assert.Equal(t, test.expected, switched)
+		})
+	}
+}
+
+func TestThroughputCollapse_ResetsOnDisconnect(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	netw := &throughputNetworker{active: true}
+	now := time.Now()
+	tc := throughputCollapse{
+		netw:               netw,
+		lastServer:         func() core.Server { return core.Server{} },
+		switchToObfuscated: func() error { return errors.New("should not be called") },
+		now:                func() time.Time { return now },
+	}
+
+	tc.check()
+	now = now.Add(throughputCollapseTimeout / 2)
+	netw.upload += 10 * stalledUploadBytes
+	tc.check()
+	assert.False(t, tc.stalledSince.IsZero())
+
+	netw.active = false
+	tc.check()
+	assert.True(t, tc.stalledSince.IsZero())
+	assert.False(t, tc.counted)
+}
@@ -0,0 +1,186 @@
+package daemon
+
+import (
+	"log"
+	"net/netip"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/ondemand"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+)
+
+const (
+	// DefaultOnDemandIdleTimeout is used when the idle timeout is not configured
+	DefaultOnDemandIdleTimeout = 5 * time.Minute
+	// MinOnDemandIdleTimeout is the shortest allowed idle timeout
+	MinOnDemandIdleTimeout = time.Minute
+)
+
+var meshnetSubnet = netip.MustParsePrefix("100.64.0.0/10")
+
+// onDemand establishes the VPN connection when there is outgoing traffic and
+// tears it down after being idle.
+//
+// While waiting for the traffic, kill switch stays as configured: if it is
+// enabled, the traffic is blocked until the tunnel is established, otherwise
+// the traffic leaves unprotected until then.
+type onDemand struct {
+	cm         config.Manager
+	netw       networker.Networker
+	detector   ondemand.Detector
+	connect    func(serverTag string) error
+	disconnect func() error
+	// connectAllowed returns false if the connection must not be established,
+	// e.g. because of the metered network policy
+	connectAllowed func() bool
+	now            func() time.Time
+	detecting      bool
+	demand         uint64
+	activity       trafficActivity
+}
+
+func (o *onDemand) check() {
+	var cfg config.Config
+	if err := o.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return
+	}
+
+	if !cfg.OnDemand.Enabled {
+		o.stopDetecting()
+		o.activity.reset()
+		return
+	}
+
+	if o.netw.IsVPNActive() {
+		o.stopDetecting()
+		o.checkIdle(onDemandIdleTimeout(cfg.OnDemand))
+		return
+	}
+
+	o.activity.reset()
+	if !o.connectAllowed() {
+		o.stopDetecting()
+		return
+	}
+	o.checkDemand(cfg)
+}
+
+// checkIdle tears down the connection which had no traffic for the idle timeout
+func (o *onDemand) checkIdle(timeout time.Duration) {
+	status, err := o.netw.ConnectionStatus()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "on-demand: retrieving connection status:", err)
+		return
+	}
+
+	if o.activity.idle(status.Download+status.Upload, o.now()) < timeout {
+		return
+	}
+
+	log.Println(internal.InfoPrefix, "on-demand: connection was idle for", timeout, "disconnecting")
+	if err := o.disconnect(); err != nil {
+		log.Println(internal.ErrorPrefix, "on-demand: disconnecting:", err)
+		return
+	}
+	o.activity.reset()
+}
+
+// checkDemand connects to VPN once outgoing traffic is detected
+func (o *onDemand) checkDemand(cfg config.Config) {
+	if !o.detecting {
+		if err := o.detector.Enable(onDemandExcluded(cfg), cfg.FirewallMark); err != nil {
+			log.Println(internal.ErrorPrefix, "on-demand: enabling traffic detection:", err)
+			return
+		}
+		o.detecting = true
+		o.demand = 0
+		if cfg.KillSwitch {
+			log.Println(internal.InfoPrefix,
+				"on-demand: waiting for traffic, kill switch blocks it until the tunnel is established")
+		} else {
+			log.Println(internal.WarningPrefix,
+				"on-demand: waiting for traffic, it is not protected until the tunnel is established")
+		}
+		return
+	}
+
+	demand, err := o.detector.Demand()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "on-demand: detecting traffic:", err)
+		return
+	}
+	if demand <= o.demand {
+		return
+	}
+
+	log.Println(internal.InfoPrefix, "on-demand: outgoing traffic detected, connecting")
+	o.stopDetecting()
+	if err := o.connect(cfg.AutoConnectData.ServerTag); err != nil {
+		log.Println(internal.ErrorPrefix, "on-demand: connecting:", err)
+	}
+}
+
+func (o *onDemand) stopDetecting() {
+	if !o.detecting {
+		return
+	}
+	if err := o.detector.Disable(); err != nil {
+		log.Println(internal.ErrorPrefix, "on-demand: disabling traffic detection:", err)
+		return
+	}
+	o.detecting = false
+}
+
+func onDemandIdleTimeout(cfg config.OnDemand) time.Duration {
+	if cfg.IdleTimeout == 0 {
+		return DefaultOnDemandIdleTimeout
+	}
+	return cfg.IdleTimeout
+}
+
+// onDemandExcluded returns destinations which do not trigger the connection
+func onDemandExcluded(cfg config.Config) []netip.Prefix {
+	allowlist := cfg.AutoConnectData.Allowlist
+	if cfg.LanDiscovery {
+		allowlist = addLANPermissions(allowlist)
+	}
+
+	var excluded []netip.Prefix
+	for subnet := range allowlist.Subnets {
+		prefix, err := netip.ParsePrefix(subnet)
+		if err != nil {
+			log.Println(internal.WarningPrefix, "on-demand: parsing subnet:", err)
+			continue
+		}
+		excluded = append(excluded, prefix)
+	}
+	if cfg.Mesh {
+		excluded = append(excluded, meshnetSubnet)
+	}
+	return excluded
+}
+
+// JobOnDemand connects to VPN when there is outgoing traffic and disconnects
+// when the connection is idle
+func JobOnDemand(r *RPC) func() {
+	o := onDemand{
+		cm:       r.cm,
+		netw:     r.netw,
+		detector: r.demandDetector,
+		connect:  r.ConnectVPN,
+		disconnect: func() error {
+			return r.disconnect(disconnectReasonOnDemand)
+		},
+		connectAllowed: r.metered.autoConnectAllowed,
+		now:            time.Now,
+	}
+	return o.check
+}
+
+// StopOnDemand removes the traffic detection rules
+func (r *RPC) StopOnDemand() error {
+	return r.demandDetector.Disable()
+}
@@ -0,0 +1,154 @@
+package daemon
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	mocknetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockDetector struct {
+	enabled  bool
+	excluded []netip.Prefix
+	demand   uint64
+}
+
+func (d *mockDetector) Enable(excluded []netip.Prefix, _ uint32) error {
+	d.enabled = true
+	d.excluded = excluded
+	return nil
+}
+
+func (d *mockDetector) Disable() error {
+	d.enabled = false
+	return nil
+}
+
+func (d *mockDetector) Demand() (uint64, error) { return d.demand, nil }
+
+type onDemandNetworker struct {
+	mocknetworker.Mock
+	active  bool
+	traffic uint64
+}
+
+func (n *onDemandNetworker) IsVPNActive() bool { return n.active }
+
+func (n *onDemandNetworker) ConnectionStatus() (networker.ConnectionStatus, error) {
+	return networker.ConnectionStatus{Download: n.traffic}, nil
+}
+
+func TestOnDemand_ConnectsOnDemand(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.OnDemand.Enabled = true
+	cm.Cfg.AutoConnectData.ServerTag = "lt"
+	detector := mockDetector{}
+	connected := ""
+	o := onDemand{
+		cm:       cm,
+		netw:     &onDemandNetworker{},
+		detector: &detector,
+		connect: func(serverTag string) error {
+			connected = serverTag
+			return nil
+		},
+		connectAllowed: func() bool { return true },
+		now:            time.Now,
+	}
+
+	o.check()
+	assert.True(t, detector.enabled)
+
+	// no traffic
+	o.check()
+	assert.Empty(t, connected)
+
+	detector.demand = 2
+	o.check()
+	assert.Equal(t, "lt", connected)
+	assert.False(t, detector.enabled)
+}
+
+func TestOnDemand_Disabled(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.OnDemand.Enabled = true
+	detector := mockDetector{}
+	o := onDemand{
+		cm:             cm,
+		netw:           &onDemandNetworker{},
+		detector:       &detector,
+		connectAllowed: func() bool { return true },
+		now:            time.Now,
+	}
+
+	o.check()
+	assert.True(t, detector.enabled)
+
+	cm.Cfg.OnDemand.Enabled = false
+	o.check()
+	assert.False(t, detector.enabled)
+}
+
+func TestOnDemand_DisconnectsWhenIdle(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.OnDemand.Enabled = true
+	cm.Cfg.OnDemand.IdleTimeout = 2 * time.Minute
+	netw := onDemandNetworker{active: true}
+	disconnected := false
+	now := time.Now()
+	o := onDemand{
+		cm:       cm,
+		netw:     &netw,
+		detector: &mockDetector{},
+		disconnect: func() error {
+			disconnected = true
+			return nil
+		},
+		now: func() time.Time { return now },
+	}
+
+	o.check()
+	now = now.Add(time.Minute)
+	netw.traffic = 10 * idleBytes
+	o.check()
+	assert.False(t, disconnected)
+
+	// keepalive packets only
+	now = now.Add(time.Minute)
+	netw.traffic += idleBytes / 2
+	o.check()
+	assert.False(t, disconnected)
+
+	now = now.Add(time.Minute)
+	o.check()
+	assert.True(t, disconnected)
+}
+
+func TestOnDemandExcluded(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cfg := config.Config{
+		Mesh: true,
+		AutoConnectData: config.AutoConnectData{
+			Allowlist: config.Allowlist{
+				Subnets: config.Subnets{"1.1.1.0/24": true},
+			},
+		},
+	}
+	assert.ElementsMatch(t, []netip.Prefix{
+		netip.MustParsePrefix("1.1.1.0/24"),
+		netip.MustParsePrefix("100.64.0.0/10"),
+	}, onDemandExcluded(cfg))
+}
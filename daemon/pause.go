@@ -0,0 +1,154 @@
+package daemon
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+)
+
+// MaxPauseDuration is the longest allowed pause of the VPN connection
+const MaxPauseDuration = 24 * time.Hour
+
+// vpnPause keeps VPN disconnected for a bounded time and connects to the server
+// which was used before the pause afterwards. Kill switch is relaxed for the
+// pause unless requested otherwise, as it would block the traffic.
+type vpnPause struct {
+	cm         config.Manager
+	netw       networker.Networker
+	disconnect func() error
+	connect    func(*pb.ConnectRequest) error
+	now        func() time.Time
+	mu         sync.Mutex
+	// until is when the connection is resumed, zero if not paused
+	until time.Time
+	// server is the tag of the server connected before the pause
+	server string
+	// killSwitchRelaxed is true if kill switch was unset for the pause
+	killSwitchRelaxed bool
+}
+
+// start disconnects from VPN and schedules the resume
+func (p *vpnPause) start(duration time.Duration, server string, keepKillSwitch bool) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.disconnect(); err != nil {
+		return err
+	}
+	p.until = p.now().Add(duration)
+	p.server = server
+	p.killSwitchRelaxed = false
+	log.Println(internal.InfoPrefix, "pause: connection is paused for", duration)
+
+	var cfg config.Config
+	if err := p.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return nil
+	}
+	if !cfg.KillSwitch || keepKillSwitch {
+		return nil
+	}
+	if err := p.netw.UnsetKillSwitch(); err != nil {
+		log.Println(internal.ErrorPrefix, "pause: relaxing kill switch:", err)
+		return nil
+	}
+	p.killSwitchRelaxed = true
+	return nil
+}
+
+func (p *vpnPause) check() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.until.IsZero() {
+		return
+	}
+	if p.netw.IsVPNActive() {
+		log.Println(internal.InfoPrefix, "pause: connected meanwhile, the pause is over")
+		p.end()
+		return
+	}
+	if p.now().Before(p.until) {
+		return
+	}
+	if err := p.resumeLocked(); err != nil {
+		log.Println(internal.ErrorPrefix, "pause: resuming connection:", err)
+	}
+}
+
+// resume connects to the server used before the pause without waiting for the
+// pause to end. Returns false if the connection is not paused.
+func (p *vpnPause) resume() (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.until.IsZero() {
+		return false, nil
+	}
+	return true, p.resumeLocked()
+}
+
+// cancel the pause without connecting. Returns false if the connection is not
+// paused.
+func (p *vpnPause) cancel() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.until.IsZero() {
+		return false
+	}
+	p.end()
+	log.Println(internal.InfoPrefix, "pause: cancelled")
+	return true
+}
+
+func (p *vpnPause) resumeLocked() error {
+	server := p.server
+	p.end()
+	log.Println(internal.InfoPrefix, "pause: resuming connection")
+	return p.connect(&pb.ConnectRequest{ServerTag: server})
+}
+
+// end forgets the pause and enforces kill switch again unless it was disabled
+// by the user meanwhile
+func (p *vpnPause) end() {
+	p.until = time.Time{}
+	if !p.killSwitchRelaxed {
+		return
+	}
+	p.killSwitchRelaxed = false
+
+	var cfg config.Config
+	if err := p.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return
+	}
+	if !cfg.KillSwitch {
+		return
+	}
+
+	if err := p.netw.SetKillSwitch(reloadedAllowlist(cfg)); err != nil {
+		log.Println(internal.ErrorPrefix, "pause: enforcing kill switch:", err)
+	}
+}
+
+// remainingTime until the connection is resumed. Zero means that the connection
+// is not paused.
+func (p *vpnPause) remainingTime() time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.until.IsZero() {
+		return 0
+	}
+	return p.until.Sub(p.now())
+}
+
+// JobPause resumes the paused connection when the pause is over
+func JobPause(r *RPC) func() {
+	return r.pause.check
+}
@@ -0,0 +1,153 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestPause(netw *captivePortalNetworker, killSwitch bool, now *time.Time) (*vpnPause, *[]*pb.ConnectRequest) {
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.KillSwitch = killSwitch
+	var requests []*pb.ConnectRequest
+	p := &vpnPause{
+		cm:   cm,
+		netw: netw,
+		disconnect: func() error {
+			netw.active = false
+			return nil
+		},
+		connect: func(request *pb.ConnectRequest) error {
+			requests = append(requests, request)
+			netw.active = true
+			return nil
+		},
+		now: func() time.Time { return *now },
+	}
+	return p, &requests
+}
+
+func TestPause(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name               string
+		killSwitch         bool
+		keepKillSwitch     bool
+		expectedKillSwitch bool
+	}{
+		{
+			name: "without kill switch",
+		},
+		{
+			name:       "kill switch is relaxed",
+			killSwitch: true,
+		},
+		{
+			name:               "kill switch is kept",
+			killSwitch:         true,
+			keepKillSwitch:     true,
+			expectedKillSwitch: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			netw := captivePortalNetworker{killSwitchNetworker{
+				onDemandNetworker: onDemandNetworker{active: true},
+				killSwitch:        test.killSwitch,
+			}}
+			now := time.Now()
+			p, requests := newTestPause(&netw, test.killSwitch, &now)
+
+			assert.NoError(t, p.start(10*time.Minute, "lt10", test.keepKillSwitch))
+			assert.False(t, netw.active)
+			assert.Equal(t, test.expectedKillSwitch, netw.killSwitch)
+			assert.Equal(t, 10*time.Minute, p.remainingTime())
+
+			now = now.Add(9 * time.Minute)
+			p.check()
+			assert.Empty(t, *requests)
+			assert.Equal(t, time.Minute, p.remainingTime())
+
+			now = now.Add(time.Minute)
+			p.check()
+			assert.Equal(t, []*pb.ConnectRequest{{ServerTag: "lt10"}}, *requests)
+			assert.Equal(t, test.killSwitch, netw.killSwitch)
+			assert.Zero(t, p.remainingTime())
+		})
+	}
+}
+
+func TestPause_Resume(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	netw := captivePortalNetworker{killSwitchNetworker{
+		onDemandNetworker: onDemandNetworker{active: true},
+		killSwitch:        true,
+	}}
+	now := time.Now()
+	p, requests := newTestPause(&netw, true, &now)
+
+	paused, err := p.resume()
+	assert.False(t, paused)
+	assert.NoError(t, err)
+
+	assert.NoError(t, p.start(time.Hour, "lt10", false))
+	paused, err = p.resume()
+	assert.True(t, paused)
+	assert.NoError(t, err)
+	assert.Len(t, *requests, 1)
+	assert.True(t, netw.killSwitch)
+
+	// resumes only once
+	now = now.Add(time.Hour)
+	p.check()
+	assert.Len(t, *requests, 1)
+}
+
+func TestPause_ConnectedMeanwhile(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	netw := captivePortalNetworker{killSwitchNetworker{
+		onDemandNetworker: onDemandNetworker{active: true},
+		killSwitch:        true,
+	}}
+	now := time.Now()
+	p, requests := newTestPause(&netw, true, &now)
+
+	assert.NoError(t, p.start(time.Hour, "lt10", false))
+	netw.active = true
+	p.check()
+	assert.True(t, netw.killSwitch)
+	assert.Zero(t, p.remainingTime())
+
+	now = now.Add(time.Hour)
+	p.check()
+	assert.Empty(t, *requests)
+}
+
+func TestPause_Cancel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	netw := captivePortalNetworker{killSwitchNetworker{
+		onDemandNetworker: onDemandNetworker{active: true},
+		killSwitch:        true,
+	}}
+	now := time.Now()
+	p, requests := newTestPause(&netw, true, &now)
+
+	assert.False(t, p.cancel())
+	assert.NoError(t, p.start(time.Hour, "lt10", false))
+	assert.False(t, netw.killSwitch)
+	assert.True(t, p.cancel())
+	assert.True(t, netw.killSwitch)
+
+	now = now.Add(time.Hour)
+	p.check()
+	assert.Empty(t, *requests)
+}
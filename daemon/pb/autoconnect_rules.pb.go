@@ -0,0 +1,327 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: autoconnect_rules.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AutoConnectRule is applied after joining the network matching all of its
+// non-empty criteria
+type AutoConnectRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ssid  string `protobuf:"bytes,1,opt,name=ssid,proto3" json:"ssid,omitempty"`
+	Bssid string `protobuf:"bytes,2,opt,name=bssid,proto3" json:"bssid,omitempty"`
+	// network_type is wifi, ethernet or mobile
+	NetworkType string `protobuf:"bytes,3,opt,name=network_type,json=networkType,proto3" json:"network_type,omitempty"`
+	// action is connect or skip
+	Action      string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	ServerTag   string `protobuf:"bytes,5,opt,name=server_tag,json=serverTag,proto3" json:"server_tag,omitempty"`
+	ServerGroup string `protobuf:"bytes,6,opt,name=server_group,json=serverGroup,proto3" json:"server_group,omitempty"`
+}
+
+func (x *AutoConnectRule) Reset() {
+	*x = AutoConnectRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoconnect_rules_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoConnectRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoConnectRule) ProtoMessage() {}
+
+func (x *AutoConnectRule) ProtoReflect() protoreflect.Message {
+	mi := &file_autoconnect_rules_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoConnectRule.ProtoReflect.Descriptor instead.
+func (*AutoConnectRule) Descriptor() ([]byte, []int) {
+	return file_autoconnect_rules_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AutoConnectRule) GetSsid() string {
+	if x != nil {
+		return x.Ssid
+	}
+	return ""
+}
+
+func (x *AutoConnectRule) GetBssid() string {
+	if x != nil {
+		return x.Bssid
+	}
+	return ""
+}
+
+func (x *AutoConnectRule) GetNetworkType() string {
+	if x != nil {
+		return x.NetworkType
+	}
+	return ""
+}
+
+func (x *AutoConnectRule) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AutoConnectRule) GetServerTag() string {
+	if x != nil {
+		return x.ServerTag
+	}
+	return ""
+}
+
+func (x *AutoConnectRule) GetServerGroup() string {
+	if x != nil {
+		return x.ServerGroup
+	}
+	return ""
+}
+
+type AddAutoConnectRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule *AutoConnectRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *AddAutoConnectRuleRequest) Reset() {
+	*x = AddAutoConnectRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoconnect_rules_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAutoConnectRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAutoConnectRuleRequest) ProtoMessage() {}
+
+func (x *AddAutoConnectRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autoconnect_rules_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAutoConnectRuleRequest.ProtoReflect.Descriptor instead.
+func (*AddAutoConnectRuleRequest) Descriptor() ([]byte, []int) {
+	return file_autoconnect_rules_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddAutoConnectRuleRequest) GetRule() *AutoConnectRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type RemoveAutoConnectRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index of the rule starting from 1, in the order of the rules in settings
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *RemoveAutoConnectRuleRequest) Reset() {
+	*x = RemoveAutoConnectRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoconnect_rules_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveAutoConnectRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAutoConnectRuleRequest) ProtoMessage() {}
+
+func (x *RemoveAutoConnectRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autoconnect_rules_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAutoConnectRuleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAutoConnectRuleRequest) Descriptor() ([]byte, []int) {
+	return file_autoconnect_rules_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RemoveAutoConnectRuleRequest) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+var File_autoconnect_rules_proto protoreflect.FileDescriptor
+
+var file_autoconnect_rules_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0xb8, 0x01,
+	0x0a, 0x0f, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x73, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x73, 0x73, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x73, 0x73, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x73, 0x73, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x54, 0x61, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x44, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x41,
+	0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x34,
+	0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f,
+	0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_autoconnect_rules_proto_rawDescOnce sync.Once
+	file_autoconnect_rules_proto_rawDescData = file_autoconnect_rules_proto_rawDesc
+)
+
+func file_autoconnect_rules_proto_rawDescGZIP() []byte {
+	file_autoconnect_rules_proto_rawDescOnce.Do(func() {
+		file_autoconnect_rules_proto_rawDescData = protoimpl.X.CompressGZIP(file_autoconnect_rules_proto_rawDescData)
+	})
+	return file_autoconnect_rules_proto_rawDescData
+}
+
+var file_autoconnect_rules_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_autoconnect_rules_proto_goTypes = []interface{}{
+	(*AutoConnectRule)(nil),              // 0: pb.AutoConnectRule
+	(*AddAutoConnectRuleRequest)(nil),    // 1: pb.AddAutoConnectRuleRequest
+	(*RemoveAutoConnectRuleRequest)(nil), // 2: pb.RemoveAutoConnectRuleRequest
+}
+var file_autoconnect_rules_proto_depIdxs = []int32{
+	0, // 0: pb.AddAutoConnectRuleRequest.rule:type_name -> pb.AutoConnectRule
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_autoconnect_rules_proto_init() }
+func file_autoconnect_rules_proto_init() {
+	if File_autoconnect_rules_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_autoconnect_rules_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoConnectRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoconnect_rules_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAutoConnectRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoconnect_rules_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAutoConnectRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_autoconnect_rules_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_autoconnect_rules_proto_goTypes,
+		DependencyIndexes: file_autoconnect_rules_proto_depIdxs,
+		MessageInfos:      file_autoconnect_rules_proto_msgTypes,
+	}.Build()
+	File_autoconnect_rules_proto = out.File
+	file_autoconnect_rules_proto_rawDesc = nil
+	file_autoconnect_rules_proto_goTypes = nil
+	file_autoconnect_rules_proto_depIdxs = nil
+}
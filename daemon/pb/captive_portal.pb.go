@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: captive_portal.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SetCaptivePortalNetworkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Network       string `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	CaptivePortal bool   `protobuf:"varint,2,opt,name=captive_portal,json=captivePortal,proto3" json:"captive_portal,omitempty"`
+}
+
+func (x *SetCaptivePortalNetworkRequest) Reset() {
+	*x = SetCaptivePortalNetworkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_captive_portal_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCaptivePortalNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCaptivePortalNetworkRequest) ProtoMessage() {}
+
+func (x *SetCaptivePortalNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_captive_portal_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCaptivePortalNetworkRequest.ProtoReflect.Descriptor instead.
+func (*SetCaptivePortalNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_captive_portal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetCaptivePortalNetworkRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *SetCaptivePortalNetworkRequest) GetCaptivePortal() bool {
+	if x != nil {
+		return x.CaptivePortal
+	}
+	return false
+}
+
+type UnlockCaptivePortalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// seconds for which the sign-in page is opened, 0 for the default
+	Duration uint32 `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *UnlockCaptivePortalRequest) Reset() {
+	*x = UnlockCaptivePortalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_captive_portal_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockCaptivePortalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockCaptivePortalRequest) ProtoMessage() {}
+
+func (x *UnlockCaptivePortalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_captive_portal_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockCaptivePortalRequest.ProtoReflect.Descriptor instead.
+func (*UnlockCaptivePortalRequest) Descriptor() ([]byte, []int) {
+	return file_captive_portal_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UnlockCaptivePortalRequest) GetDuration() uint32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+var File_captive_portal_proto protoreflect.FileDescriptor
+
+var file_captive_portal_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x63, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x61, 0x0a, 0x1e, 0x53, 0x65,
+	0x74, 0x43, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x70, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x63, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x22, 0x38, 0x0a,
+	0x1a, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78,
+	0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_captive_portal_proto_rawDescOnce sync.Once
+	file_captive_portal_proto_rawDescData = file_captive_portal_proto_rawDesc
+)
+
+func file_captive_portal_proto_rawDescGZIP() []byte {
+	file_captive_portal_proto_rawDescOnce.Do(func() {
+		file_captive_portal_proto_rawDescData = protoimpl.X.CompressGZIP(file_captive_portal_proto_rawDescData)
+	})
+	return file_captive_portal_proto_rawDescData
+}
+
+var file_captive_portal_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_captive_portal_proto_goTypes = []interface{}{
+	(*SetCaptivePortalNetworkRequest)(nil), // 0: pb.SetCaptivePortalNetworkRequest
+	(*UnlockCaptivePortalRequest)(nil),     // 1: pb.UnlockCaptivePortalRequest
+}
+var file_captive_portal_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_captive_portal_proto_init() }
+func file_captive_portal_proto_init() {
+	if File_captive_portal_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_captive_portal_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCaptivePortalNetworkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_captive_portal_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockCaptivePortalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_captive_portal_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_captive_portal_proto_goTypes,
+		DependencyIndexes: file_captive_portal_proto_depIdxs,
+		MessageInfos:      file_captive_portal_proto_msgTypes,
+	}.Build()
+	File_captive_portal_proto = out.File
+	file_captive_portal_proto_rawDesc = nil
+	file_captive_portal_proto_goTypes = nil
+	file_captive_portal_proto_depIdxs = nil
+}
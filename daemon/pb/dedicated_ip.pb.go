@@ -0,0 +1,323 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: dedicated_ip.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DedicatedIPServer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hostname string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Country  string `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	City     string `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+}
+
+func (x *DedicatedIPServer) Reset() {
+	*x = DedicatedIPServer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dedicated_ip_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DedicatedIPServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DedicatedIPServer) ProtoMessage() {}
+
+func (x *DedicatedIPServer) ProtoReflect() protoreflect.Message {
+	mi := &file_dedicated_ip_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DedicatedIPServer.ProtoReflect.Descriptor instead.
+func (*DedicatedIPServer) Descriptor() ([]byte, []int) {
+	return file_dedicated_ip_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DedicatedIPServer) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DedicatedIPServer) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *DedicatedIPServer) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *DedicatedIPServer) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+type DedicatedIPService struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpiresAt string               `protobuf:"bytes,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Servers   []*DedicatedIPServer `protobuf:"bytes,2,rep,name=servers,proto3" json:"servers,omitempty"`
+}
+
+func (x *DedicatedIPService) Reset() {
+	*x = DedicatedIPService{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dedicated_ip_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DedicatedIPService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DedicatedIPService) ProtoMessage() {}
+
+func (x *DedicatedIPService) ProtoReflect() protoreflect.Message {
+	mi := &file_dedicated_ip_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DedicatedIPService.ProtoReflect.Descriptor instead.
+func (*DedicatedIPService) Descriptor() ([]byte, []int) {
+	return file_dedicated_ip_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DedicatedIPService) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *DedicatedIPService) GetServers() []*DedicatedIPServer {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+type DedicatedIPResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     int64                 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Services []*DedicatedIPService `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+}
+
+func (x *DedicatedIPResponse) Reset() {
+	*x = DedicatedIPResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dedicated_ip_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DedicatedIPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DedicatedIPResponse) ProtoMessage() {}
+
+func (x *DedicatedIPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dedicated_ip_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DedicatedIPResponse.ProtoReflect.Descriptor instead.
+func (*DedicatedIPResponse) Descriptor() ([]byte, []int) {
+	return file_dedicated_ip_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DedicatedIPResponse) GetType() int64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *DedicatedIPResponse) GetServices() []*DedicatedIPService {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+var File_dedicated_ip_proto protoreflect.FileDescriptor
+
+var file_dedicated_ip_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x64, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x6d, 0x0a, 0x11, 0x44, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x49, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x22, 0x64, 0x0a, 0x12, 0x44, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x49, 0x50, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x2f, 0x0a, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x62, 0x2e, 0x44, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x49, 0x50, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x5d, 0x0a,
+	0x13, 0x44, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x49, 0x50, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e,
+	0x44, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x49, 0x50, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x42, 0x31, 0x5a, 0x2f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d,
+	0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dedicated_ip_proto_rawDescOnce sync.Once
+	file_dedicated_ip_proto_rawDescData = file_dedicated_ip_proto_rawDesc
+)
+
+func file_dedicated_ip_proto_rawDescGZIP() []byte {
+	file_dedicated_ip_proto_rawDescOnce.Do(func() {
+		file_dedicated_ip_proto_rawDescData = protoimpl.X.CompressGZIP(file_dedicated_ip_proto_rawDescData)
+	})
+	return file_dedicated_ip_proto_rawDescData
+}
+
+var file_dedicated_ip_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_dedicated_ip_proto_goTypes = []interface{}{
+	(*DedicatedIPServer)(nil),   // 0: pb.DedicatedIPServer
+	(*DedicatedIPService)(nil),  // 1: pb.DedicatedIPService
+	(*DedicatedIPResponse)(nil), // 2: pb.DedicatedIPResponse
+}
+var file_dedicated_ip_proto_depIdxs = []int32{
+	0, // 0: pb.DedicatedIPService.servers:type_name -> pb.DedicatedIPServer
+	1, // 1: pb.DedicatedIPResponse.services:type_name -> pb.DedicatedIPService
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_dedicated_ip_proto_init() }
+func file_dedicated_ip_proto_init() {
+	if File_dedicated_ip_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_dedicated_ip_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DedicatedIPServer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dedicated_ip_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DedicatedIPService); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dedicated_ip_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DedicatedIPResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_dedicated_ip_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_dedicated_ip_proto_goTypes,
+		DependencyIndexes: file_dedicated_ip_proto_depIdxs,
+		MessageInfos:      file_dedicated_ip_proto_msgTypes,
+	}.Build()
+	File_dedicated_ip_proto = out.File
+	file_dedicated_ip_proto_rawDesc = nil
+	file_dedicated_ip_proto_goTypes = nil
+	file_dedicated_ip_proto_depIdxs = nil
+}
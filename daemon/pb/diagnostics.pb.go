@@ -0,0 +1,307 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: diagnostics.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DiagnosticStatus int32
+
+const (
+	DiagnosticStatus_PASSED DiagnosticStatus = 0
+	DiagnosticStatus_FAILED DiagnosticStatus = 1
+	// the test could not be run or its result is inconclusive
+	DiagnosticStatus_SKIPPED DiagnosticStatus = 2
+)
+
+// Enum value maps for DiagnosticStatus.
+var (
+	DiagnosticStatus_name = map[int32]string{
+		0: "PASSED",
+		1: "FAILED",
+		2: "SKIPPED",
+	}
+	DiagnosticStatus_value = map[string]int32{
+		"PASSED":  0,
+		"FAILED":  1,
+		"SKIPPED": 2,
+	}
+)
+
+func (x DiagnosticStatus) Enum() *DiagnosticStatus {
+	p := new(DiagnosticStatus)
+	*p = x
+	return p
+}
+
+func (x DiagnosticStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiagnosticStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_diagnostics_proto_enumTypes[0].Descriptor()
+}
+
+func (DiagnosticStatus) Type() protoreflect.EnumType {
+	return &file_diagnostics_proto_enumTypes[0]
+}
+
+func (x DiagnosticStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiagnosticStatus.Descriptor instead.
+func (DiagnosticStatus) EnumDescriptor() ([]byte, []int) {
+	return file_diagnostics_proto_rawDescGZIP(), []int{0}
+}
+
+type DiagnosticResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status  DiagnosticStatus `protobuf:"varint,2,opt,name=status,proto3,enum=pb.DiagnosticStatus" json:"status,omitempty"`
+	Details string           `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	// how to fix the failure, empty if the test has passed
+	Hint string `protobuf:"bytes,4,opt,name=hint,proto3" json:"hint,omitempty"`
+}
+
+func (x *DiagnosticResult) Reset() {
+	*x = DiagnosticResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_diagnostics_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnosticResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticResult) ProtoMessage() {}
+
+func (x *DiagnosticResult) ProtoReflect() protoreflect.Message {
+	mi := &file_diagnostics_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticResult.ProtoReflect.Descriptor instead.
+func (*DiagnosticResult) Descriptor() ([]byte, []int) {
+	return file_diagnostics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DiagnosticResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiagnosticResult) GetStatus() DiagnosticStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DiagnosticStatus_PASSED
+}
+
+func (x *DiagnosticResult) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *DiagnosticResult) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+type DiagnoseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    int64               `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Results []*DiagnosticResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *DiagnoseResponse) Reset() {
+	*x = DiagnoseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_diagnostics_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnoseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnoseResponse) ProtoMessage() {}
+
+func (x *DiagnoseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_diagnostics_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnoseResponse.ProtoReflect.Descriptor instead.
+func (*DiagnoseResponse) Descriptor() ([]byte, []int) {
+	return file_diagnostics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DiagnoseResponse) GetType() int64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *DiagnoseResponse) GetResults() []*DiagnosticResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_diagnostics_proto protoreflect.FileDescriptor
+
+var file_diagnostics_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x82, 0x01, 0x0a, 0x10, 0x44, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x22, 0x56, 0x0a, 0x10,
+	0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x2a, 0x37, 0x0a, 0x10, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x53, 0x53,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x02, 0x42, 0x31, 0x5a,
+	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e,
+	0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_diagnostics_proto_rawDescOnce sync.Once
+	file_diagnostics_proto_rawDescData = file_diagnostics_proto_rawDesc
+)
+
+func file_diagnostics_proto_rawDescGZIP() []byte {
+	file_diagnostics_proto_rawDescOnce.Do(func() {
+		file_diagnostics_proto_rawDescData = protoimpl.X.CompressGZIP(file_diagnostics_proto_rawDescData)
+	})
+	return file_diagnostics_proto_rawDescData
+}
+
+var file_diagnostics_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_diagnostics_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_diagnostics_proto_goTypes = []interface{}{
+	(DiagnosticStatus)(0),    // 0: pb.DiagnosticStatus
+	(*DiagnosticResult)(nil), // 1: pb.DiagnosticResult
+	(*DiagnoseResponse)(nil), // 2: pb.DiagnoseResponse
+}
+var file_diagnostics_proto_depIdxs = []int32{
+	0, // 0: pb.DiagnosticResult.status:type_name -> pb.DiagnosticStatus
+	1, // 1: pb.DiagnoseResponse.results:type_name -> pb.DiagnosticResult
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_diagnostics_proto_init() }
+func file_diagnostics_proto_init() {
+	if File_diagnostics_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_diagnostics_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnosticResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_diagnostics_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnoseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_diagnostics_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_diagnostics_proto_goTypes,
+		DependencyIndexes: file_diagnostics_proto_depIdxs,
+		EnumInfos:         file_diagnostics_proto_enumTypes,
+		MessageInfos:      file_diagnostics_proto_msgTypes,
+	}.Build()
+	File_diagnostics_proto = out.File
+	file_diagnostics_proto_rawDesc = nil
+	file_diagnostics_proto_goTypes = nil
+	file_diagnostics_proto_depIdxs = nil
+}
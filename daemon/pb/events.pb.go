@@ -0,0 +1,1256 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: events.proto
+
+package pb
+
+import (
+	config "github.com/NordSecurity/nordvpn-linux/config"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ConnectionEventType int32
+
+const (
+	ConnectionEventType_CONNECTING        ConnectionEventType = 0
+	ConnectionEventType_CONNECTED         ConnectionEventType = 1
+	ConnectionEventType_CONNECT_FAILED    ConnectionEventType = 2
+	ConnectionEventType_DISCONNECTING     ConnectionEventType = 3
+	ConnectionEventType_DISCONNECTED      ConnectionEventType = 4
+	ConnectionEventType_DISCONNECT_FAILED ConnectionEventType = 5
+)
+
+// Enum value maps for ConnectionEventType.
+var (
+	ConnectionEventType_name = map[int32]string{
+		0: "CONNECTING",
+		1: "CONNECTED",
+		2: "CONNECT_FAILED",
+		3: "DISCONNECTING",
+		4: "DISCONNECTED",
+		5: "DISCONNECT_FAILED",
+	}
+	ConnectionEventType_value = map[string]int32{
+		"CONNECTING":        0,
+		"CONNECTED":         1,
+		"CONNECT_FAILED":    2,
+		"DISCONNECTING":     3,
+		"DISCONNECTED":      4,
+		"DISCONNECT_FAILED": 5,
+	}
+)
+
+func (x ConnectionEventType) Enum() *ConnectionEventType {
+	p := new(ConnectionEventType)
+	*p = x
+	return p
+}
+
+func (x ConnectionEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConnectionEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_events_proto_enumTypes[0].Descriptor()
+}
+
+func (ConnectionEventType) Type() protoreflect.EnumType {
+	return &file_events_proto_enumTypes[0]
+}
+
+func (x ConnectionEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConnectionEventType.Descriptor instead.
+func (ConnectionEventType) EnumDescriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{0}
+}
+
+type MeshnetEventType int32
+
+const (
+	MeshnetEventType_PEERS_UPDATED             MeshnetEventType = 0
+	MeshnetEventType_SELF_REMOVED              MeshnetEventType = 1
+	MeshnetEventType_EXIT_NODE_DROPPED         MeshnetEventType = 2
+	MeshnetEventType_EXIT_NODE_RECOVERING      MeshnetEventType = 3
+	MeshnetEventType_EXIT_NODE_RECOVERED       MeshnetEventType = 4
+	MeshnetEventType_EXIT_NODE_RECOVERY_FAILED MeshnetEventType = 5
+)
+
+// Enum value maps for MeshnetEventType.
+var (
+	MeshnetEventType_name = map[int32]string{
+		0: "PEERS_UPDATED",
+		1: "SELF_REMOVED",
+		2: "EXIT_NODE_DROPPED",
+		3: "EXIT_NODE_RECOVERING",
+		4: "EXIT_NODE_RECOVERED",
+		5: "EXIT_NODE_RECOVERY_FAILED",
+	}
+	MeshnetEventType_value = map[string]int32{
+		"PEERS_UPDATED":             0,
+		"SELF_REMOVED":              1,
+		"EXIT_NODE_DROPPED":         2,
+		"EXIT_NODE_RECOVERING":      3,
+		"EXIT_NODE_RECOVERED":       4,
+		"EXIT_NODE_RECOVERY_FAILED": 5,
+	}
+)
+
+func (x MeshnetEventType) Enum() *MeshnetEventType {
+	p := new(MeshnetEventType)
+	*p = x
+	return p
+}
+
+func (x MeshnetEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MeshnetEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_events_proto_enumTypes[1].Descriptor()
+}
+
+func (MeshnetEventType) Type() protoreflect.EnumType {
+	return &file_events_proto_enumTypes[1]
+}
+
+func (x MeshnetEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MeshnetEventType.Descriptor instead.
+func (MeshnetEventType) EnumDescriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{1}
+}
+
+type AccountEventType int32
+
+const (
+	AccountEventType_LOGGED_IN  AccountEventType = 0
+	AccountEventType_LOGGED_OUT AccountEventType = 1
+)
+
+// Enum value maps for AccountEventType.
+var (
+	AccountEventType_name = map[int32]string{
+		0: "LOGGED_IN",
+		1: "LOGGED_OUT",
+	}
+	AccountEventType_value = map[string]int32{
+		"LOGGED_IN":  0,
+		"LOGGED_OUT": 1,
+	}
+)
+
+func (x AccountEventType) Enum() *AccountEventType {
+	p := new(AccountEventType)
+	*p = x
+	return p
+}
+
+func (x AccountEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_events_proto_enumTypes[2].Descriptor()
+}
+
+func (AccountEventType) Type() protoreflect.EnumType {
+	return &file_events_proto_enumTypes[2]
+}
+
+func (x AccountEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountEventType.Descriptor instead.
+func (AccountEventType) EnumDescriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{2}
+}
+
+type DNSEventType int32
+
+const (
+	DNSEventType_DNS_RESTORED       DNSEventType = 0
+	DNSEventType_DNS_RESTORE_FAILED DNSEventType = 1
+)
+
+// Enum value maps for DNSEventType.
+var (
+	DNSEventType_name = map[int32]string{
+		0: "DNS_RESTORED",
+		1: "DNS_RESTORE_FAILED",
+	}
+	DNSEventType_value = map[string]int32{
+		"DNS_RESTORED":       0,
+		"DNS_RESTORE_FAILED": 1,
+	}
+)
+
+func (x DNSEventType) Enum() *DNSEventType {
+	p := new(DNSEventType)
+	*p = x
+	return p
+}
+
+func (x DNSEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DNSEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_events_proto_enumTypes[3].Descriptor()
+}
+
+func (DNSEventType) Type() protoreflect.EnumType {
+	return &file_events_proto_enumTypes[3]
+}
+
+func (x DNSEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DNSEventType.Descriptor instead.
+func (DNSEventType) EnumDescriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{3}
+}
+
+type ObfuscationFallbackReason int32
+
+const (
+	// HANDSHAKE_FAILURE means that plain OpenVPN failed to connect repeatedly
+	ObfuscationFallbackReason_HANDSHAKE_FAILURE ObfuscationFallbackReason = 0
+	// THROUGHPUT_COLLAPSE means that the traffic of the plain OpenVPN connection
+	// stopped coming back
+	ObfuscationFallbackReason_THROUGHPUT_COLLAPSE ObfuscationFallbackReason = 1
+)
+
+// Enum value maps for ObfuscationFallbackReason.
+var (
+	ObfuscationFallbackReason_name = map[int32]string{
+		0: "HANDSHAKE_FAILURE",
+		1: "THROUGHPUT_COLLAPSE",
+	}
+	ObfuscationFallbackReason_value = map[string]int32{
+		"HANDSHAKE_FAILURE":   0,
+		"THROUGHPUT_COLLAPSE": 1,
+	}
+)
+
+func (x ObfuscationFallbackReason) Enum() *ObfuscationFallbackReason {
+	p := new(ObfuscationFallbackReason)
+	*p = x
+	return p
+}
+
+func (x ObfuscationFallbackReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ObfuscationFallbackReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_events_proto_enumTypes[4].Descriptor()
+}
+
+func (ObfuscationFallbackReason) Type() protoreflect.EnumType {
+	return &file_events_proto_enumTypes[4]
+}
+
+func (x ObfuscationFallbackReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ObfuscationFallbackReason.Descriptor instead.
+func (ObfuscationFallbackReason) EnumDescriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{4}
+}
+
+type ServerFailoverReason int32
+
+const (
+	// HANDSHAKE_TIMEOUT means that the server stopped completing the handshakes
+	ServerFailoverReason_HANDSHAKE_TIMEOUT ServerFailoverReason = 0
+	// SERVER_OFFLINE means that the API reports the server as offline
+	ServerFailoverReason_SERVER_OFFLINE ServerFailoverReason = 1
+	// SERVER_MAINTENANCE means that the server was taken down for maintenance
+	ServerFailoverReason_SERVER_MAINTENANCE ServerFailoverReason = 2
+	// SERVER_WITHDRAWN means that the server was removed from the server list
+	ServerFailoverReason_SERVER_WITHDRAWN ServerFailoverReason = 3
+)
+
+// Enum value maps for ServerFailoverReason.
+var (
+	ServerFailoverReason_name = map[int32]string{
+		0: "HANDSHAKE_TIMEOUT",
+		1: "SERVER_OFFLINE",
+		2: "SERVER_MAINTENANCE",
+		3: "SERVER_WITHDRAWN",
+	}
+	ServerFailoverReason_value = map[string]int32{
+		"HANDSHAKE_TIMEOUT":  0,
+		"SERVER_OFFLINE":     1,
+		"SERVER_MAINTENANCE": 2,
+		"SERVER_WITHDRAWN":   3,
+	}
+)
+
+func (x ServerFailoverReason) Enum() *ServerFailoverReason {
+	p := new(ServerFailoverReason)
+	*p = x
+	return p
+}
+
+func (x ServerFailoverReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServerFailoverReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_events_proto_enumTypes[5].Descriptor()
+}
+
+func (ServerFailoverReason) Type() protoreflect.EnumType {
+	return &file_events_proto_enumTypes[5]
+}
+
+func (x ServerFailoverReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ServerFailoverReason.Descriptor instead.
+func (ServerFailoverReason) EnumDescriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{5}
+}
+
+type ConnectionEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type ConnectionEventType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.ConnectionEventType" json:"type,omitempty"`
+	// hostname, country and city are set once the server is selected
+	Hostname   string            `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Country    string            `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	City       string            `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	Technology config.Technology `protobuf:"varint,5,opt,name=technology,proto3,enum=config.Technology" json:"technology,omitempty"`
+	Protocol   config.Protocol   `protobuf:"varint,6,opt,name=protocol,proto3,enum=config.Protocol" json:"protocol,omitempty"`
+}
+
+func (x *ConnectionEvent) Reset() {
+	*x = ConnectionEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionEvent) ProtoMessage() {}
+
+func (x *ConnectionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionEvent.ProtoReflect.Descriptor instead.
+func (*ConnectionEvent) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConnectionEvent) GetType() ConnectionEventType {
+	if x != nil {
+		return x.Type
+	}
+	return ConnectionEventType_CONNECTING
+}
+
+func (x *ConnectionEvent) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *ConnectionEvent) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ConnectionEvent) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ConnectionEvent) GetTechnology() config.Technology {
+	if x != nil {
+		return x.Technology
+	}
+	return config.Technology(0)
+}
+
+func (x *ConnectionEvent) GetProtocol() config.Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return config.Protocol(0)
+}
+
+type SettingEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the setting as used by "nordvpn set", e.g. killswitch
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// value is empty when the settings were reset to the defaults
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SettingEvent) Reset() {
+	*x = SettingEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettingEvent) ProtoMessage() {}
+
+func (x *SettingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettingEvent.ProtoReflect.Descriptor instead.
+func (*SettingEvent) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SettingEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SettingEvent) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type MeshnetEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type MeshnetEventType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.MeshnetEventType" json:"type,omitempty"`
+	// peers are the IDs of the updated peers, empty if unknown
+	Peers []string `protobuf:"bytes,2,rep,name=peers,proto3" json:"peers,omitempty"`
+	// exit_node is the hostname of the dropped exit node
+	ExitNode string `protobuf:"bytes,3,opt,name=exit_node,json=exitNode,proto3" json:"exit_node,omitempty"`
+}
+
+func (x *MeshnetEvent) Reset() {
+	*x = MeshnetEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshnetEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshnetEvent) ProtoMessage() {}
+
+func (x *MeshnetEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshnetEvent.ProtoReflect.Descriptor instead.
+func (*MeshnetEvent) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MeshnetEvent) GetType() MeshnetEventType {
+	if x != nil {
+		return x.Type
+	}
+	return MeshnetEventType_PEERS_UPDATED
+}
+
+func (x *MeshnetEvent) GetPeers() []string {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *MeshnetEvent) GetExitNode() string {
+	if x != nil {
+		return x.ExitNode
+	}
+	return ""
+}
+
+type AccountEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type AccountEventType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.AccountEventType" json:"type,omitempty"`
+}
+
+func (x *AccountEvent) Reset() {
+	*x = AccountEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountEvent) ProtoMessage() {}
+
+func (x *AccountEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountEvent.ProtoReflect.Descriptor instead.
+func (*AccountEvent) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AccountEvent) GetType() AccountEventType {
+	if x != nil {
+		return x.Type
+	}
+	return AccountEventType_LOGGED_IN
+}
+
+// DNSEvent is sent when another program overwrites the VPN DNS while connected
+type DNSEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        DNSEventType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.DNSEventType" json:"type,omitempty"`
+	Nameservers []string     `protobuf:"bytes,2,rep,name=nameservers,proto3" json:"nameservers,omitempty"`
+}
+
+func (x *DNSEvent) Reset() {
+	*x = DNSEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DNSEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DNSEvent) ProtoMessage() {}
+
+func (x *DNSEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DNSEvent.ProtoReflect.Descriptor instead.
+func (*DNSEvent) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DNSEvent) GetType() DNSEventType {
+	if x != nil {
+		return x.Type
+	}
+	return DNSEventType_DNS_RESTORED
+}
+
+func (x *DNSEvent) GetNameservers() []string {
+	if x != nil {
+		return x.Nameservers
+	}
+	return nil
+}
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time is the unix time in seconds
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Types that are assignable to Event:
+	//	*Event_Connection
+	//	*Event_Setting
+	//	*Event_Meshnet
+	//	*Event_Account
+	//	*Event_Dns
+	//	*Event_ObfuscationFallback
+	//	*Event_ServerFailover
+	Event isEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Event) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (m *Event) GetEvent() isEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *Event) GetConnection() *ConnectionEvent {
+	if x, ok := x.GetEvent().(*Event_Connection); ok {
+		return x.Connection
+	}
+	return nil
+}
+
+func (x *Event) GetSetting() *SettingEvent {
+	if x, ok := x.GetEvent().(*Event_Setting); ok {
+		return x.Setting
+	}
+	return nil
+}
+
+func (x *Event) GetMeshnet() *MeshnetEvent {
+	if x, ok := x.GetEvent().(*Event_Meshnet); ok {
+		return x.Meshnet
+	}
+	return nil
+}
+
+func (x *Event) GetAccount() *AccountEvent {
+	if x, ok := x.GetEvent().(*Event_Account); ok {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *Event) GetDns() *DNSEvent {
+	if x, ok := x.GetEvent().(*Event_Dns); ok {
+		return x.Dns
+	}
+	return nil
+}
+
+func (x *Event) GetObfuscationFallback() *ObfuscationFallbackEvent {
+	if x, ok := x.GetEvent().(*Event_ObfuscationFallback); ok {
+		return x.ObfuscationFallback
+	}
+	return nil
+}
+
+func (x *Event) GetServerFailover() *ServerFailoverEvent {
+	if x, ok := x.GetEvent().(*Event_ServerFailover); ok {
+		return x.ServerFailover
+	}
+	return nil
+}
+
+type isEvent_Event interface {
+	isEvent_Event()
+}
+
+type Event_Connection struct {
+	Connection *ConnectionEvent `protobuf:"bytes,2,opt,name=connection,proto3,oneof"`
+}
+
+type Event_Setting struct {
+	Setting *SettingEvent `protobuf:"bytes,3,opt,name=setting,proto3,oneof"`
+}
+
+type Event_Meshnet struct {
+	Meshnet *MeshnetEvent `protobuf:"bytes,4,opt,name=meshnet,proto3,oneof"`
+}
+
+type Event_Account struct {
+	Account *AccountEvent `protobuf:"bytes,5,opt,name=account,proto3,oneof"`
+}
+
+type Event_Dns struct {
+	Dns *DNSEvent `protobuf:"bytes,6,opt,name=dns,proto3,oneof"`
+}
+
+type Event_ObfuscationFallback struct {
+	ObfuscationFallback *ObfuscationFallbackEvent `protobuf:"bytes,7,opt,name=obfuscation_fallback,json=obfuscationFallback,proto3,oneof"`
+}
+
+type Event_ServerFailover struct {
+	ServerFailover *ServerFailoverEvent `protobuf:"bytes,8,opt,name=server_failover,json=serverFailover,proto3,oneof"`
+}
+
+func (*Event_Connection) isEvent_Event() {}
+
+func (*Event_Setting) isEvent_Event() {}
+
+func (*Event_Meshnet) isEvent_Event() {}
+
+func (*Event_Account) isEvent_Event() {}
+
+func (*Event_Dns) isEvent_Event() {}
+
+func (*Event_ObfuscationFallback) isEvent_Event() {}
+
+func (*Event_ServerFailover) isEvent_Event() {}
+
+// ObfuscationFallbackEvent is sent when the connection switches to an
+// obfuscated server, because the network seems to interfere with plain OpenVPN
+type ObfuscationFallbackEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason ObfuscationFallbackReason `protobuf:"varint,1,opt,name=reason,proto3,enum=pb.ObfuscationFallbackReason" json:"reason,omitempty"`
+	// hostname of the plain OpenVPN server
+	Hostname string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// network is the name of the network connection, empty if not known
+	Network string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+}
+
+func (x *ObfuscationFallbackEvent) Reset() {
+	*x = ObfuscationFallbackEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObfuscationFallbackEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObfuscationFallbackEvent) ProtoMessage() {}
+
+func (x *ObfuscationFallbackEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObfuscationFallbackEvent.ProtoReflect.Descriptor instead.
+func (*ObfuscationFallbackEvent) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ObfuscationFallbackEvent) GetReason() ObfuscationFallbackReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ObfuscationFallbackReason_HANDSHAKE_FAILURE
+}
+
+func (x *ObfuscationFallbackEvent) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *ObfuscationFallbackEvent) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+// ServerFailoverEvent is sent when the connection is moved to an equivalent
+// server, because the connected one degraded or was withdrawn
+type ServerFailoverEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason ServerFailoverReason `protobuf:"varint,1,opt,name=reason,proto3,enum=pb.ServerFailoverReason" json:"reason,omitempty"`
+	// hostname of the server the connection is moved from
+	Hostname string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// target is the hostname of the server the connection is moved to
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *ServerFailoverEvent) Reset() {
+	*x = ServerFailoverEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerFailoverEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerFailoverEvent) ProtoMessage() {}
+
+func (x *ServerFailoverEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerFailoverEvent.ProtoReflect.Descriptor instead.
+func (*ServerFailoverEvent) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ServerFailoverEvent) GetReason() ServerFailoverReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ServerFailoverReason_HANDSHAKE_TIMEOUT
+}
+
+func (x *ServerFailoverEvent) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *ServerFailoverEvent) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+var File_events_proto protoreflect.FileDescriptor
+
+var file_events_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02,
+	0x70, 0x62, 0x1a, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2f, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xea, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a,
+	0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x6e,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67,
+	0x79, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22,
+	0x38, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x6b, 0x0a, 0x0c, 0x4d, 0x65, 0x73,
+	0x68, 0x6e, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73,
+	0x68, 0x6e, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69,
+	0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78,
+	0x69, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x38, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x52, 0x0a, 0x08, 0x44, 0x4e, 0x53, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e,
+	0x44, 0x4e, 0x53, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x22, 0x9e, 0x03, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x07, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x68, 0x6e,
+	0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65,
+	0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x68, 0x6e, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x03, 0x64, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x03, 0x64, 0x6e, 0x73, 0x12, 0x51, 0x0a, 0x14, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x13, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x42, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x46, 0x61, 0x69,
+	0x6c, 0x6f, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x07, 0x0a, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x18, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x22,
+	0x7b, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x2a, 0x84, 0x01, 0x0a,
+	0x13, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49,
+	0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x49, 0x53, 0x43, 0x4f,
+	0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x49,
+	0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11,
+	0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x05, 0x2a, 0xa0, 0x01, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x45, 0x45, 0x52,
+	0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53,
+	0x45, 0x4c, 0x46, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a,
+	0x11, 0x45, 0x58, 0x49, 0x54, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x58, 0x49, 0x54, 0x5f, 0x4e, 0x4f, 0x44,
+	0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x17,
+	0x0a, 0x13, 0x45, 0x58, 0x49, 0x54, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f,
+	0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x49, 0x54, 0x5f,
+	0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x31, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x4f,
+	0x47, 0x47, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x4f, 0x47,
+	0x47, 0x45, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x01, 0x2a, 0x38, 0x0a, 0x0c, 0x44, 0x4e, 0x53,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x4e, 0x53,
+	0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x44,
+	0x4e, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x01, 0x2a, 0x4b, 0x0a, 0x19, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x15, 0x0a, 0x11, 0x48, 0x41, 0x4e, 0x44, 0x53, 0x48, 0x41, 0x4b, 0x45, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x48, 0x52, 0x4f, 0x55,
+	0x47, 0x48, 0x50, 0x55, 0x54, 0x5f, 0x43, 0x4f, 0x4c, 0x4c, 0x41, 0x50, 0x53, 0x45, 0x10, 0x01,
+	0x2a, 0x6f, 0x0a, 0x14, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x11, 0x48, 0x41, 0x4e, 0x44,
+	0x53, 0x48, 0x41, 0x4b, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e,
+	0x45, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41,
+	0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x53,
+	0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x4e, 0x10,
+	0x03, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72,
+	0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_events_proto_rawDescOnce sync.Once
+	file_events_proto_rawDescData = file_events_proto_rawDesc
+)
+
+func file_events_proto_rawDescGZIP() []byte {
+	file_events_proto_rawDescOnce.Do(func() {
+		file_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_events_proto_rawDescData)
+	})
+	return file_events_proto_rawDescData
+}
+
+var file_events_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_events_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_events_proto_goTypes = []interface{}{
+	(ConnectionEventType)(0),         // 0: pb.ConnectionEventType
+	(MeshnetEventType)(0),            // 1: pb.MeshnetEventType
+	(AccountEventType)(0),            // 2: pb.AccountEventType
+	(DNSEventType)(0),                // 3: pb.DNSEventType
+	(ObfuscationFallbackReason)(0),   // 4: pb.ObfuscationFallbackReason
+	(ServerFailoverReason)(0),        // 5: pb.ServerFailoverReason
+	(*ConnectionEvent)(nil),          // 6: pb.ConnectionEvent
+	(*SettingEvent)(nil),             // 7: pb.SettingEvent
+	(*MeshnetEvent)(nil),             // 8: pb.MeshnetEvent
+	(*AccountEvent)(nil),             // 9: pb.AccountEvent
+	(*DNSEvent)(nil),                 // 10: pb.DNSEvent
+	(*Event)(nil),                    // 11: pb.Event
+	(*ObfuscationFallbackEvent)(nil), // 12: pb.ObfuscationFallbackEvent
+	(*ServerFailoverEvent)(nil),      // 13: pb.ServerFailoverEvent
+	(config.Technology)(0),           // 14: config.Technology
+	(config.Protocol)(0),             // 15: config.Protocol
+}
+var file_events_proto_depIdxs = []int32{
+	0,  // 0: pb.ConnectionEvent.type:type_name -> pb.ConnectionEventType
+	14, // 1: pb.ConnectionEvent.technology:type_name -> config.Technology
+	15, // 2: pb.ConnectionEvent.protocol:type_name -> config.Protocol
+	1,  // 3: pb.MeshnetEvent.type:type_name -> pb.MeshnetEventType
+	2,  // 4: pb.AccountEvent.type:type_name -> pb.AccountEventType
+	3,  // 5: pb.DNSEvent.type:type_name -> pb.DNSEventType
+	6,  // 6: pb.Event.connection:type_name -> pb.ConnectionEvent
+	7,  // 7: pb.Event.setting:type_name -> pb.SettingEvent
+	8,  // 8: pb.Event.meshnet:type_name -> pb.MeshnetEvent
+	9,  // 9: pb.Event.account:type_name -> pb.AccountEvent
+	10, // 10: pb.Event.dns:type_name -> pb.DNSEvent
+	12, // 11: pb.Event.obfuscation_fallback:type_name -> pb.ObfuscationFallbackEvent
+	13, // 12: pb.Event.server_failover:type_name -> pb.ServerFailoverEvent
+	4,  // 13: pb.ObfuscationFallbackEvent.reason:type_name -> pb.ObfuscationFallbackReason
+	5,  // 14: pb.ServerFailoverEvent.reason:type_name -> pb.ServerFailoverReason
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_events_proto_init() }
+func file_events_proto_init() {
+	if File_events_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettingEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshnetEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObfuscationFallbackEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerFailoverEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_events_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*Event_Connection)(nil),
+		(*Event_Setting)(nil),
+		(*Event_Meshnet)(nil),
+		(*Event_Account)(nil),
+		(*Event_Dns)(nil),
+		(*Event_ObfuscationFallback)(nil),
+		(*Event_ServerFailover)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_events_proto_rawDesc,
+			NumEnums:      6,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_proto_goTypes,
+		DependencyIndexes: file_events_proto_depIdxs,
+		EnumInfos:         file_events_proto_enumTypes,
+		MessageInfos:      file_events_proto_msgTypes,
+	}.Build()
+	File_events_proto = out.File
+	file_events_proto_rawDesc = nil
+	file_events_proto_goTypes = nil
+	file_events_proto_depIdxs = nil
+}
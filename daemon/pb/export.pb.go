@@ -0,0 +1,444 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: export.proto
+
+package pb
+
+import (
+	config "github.com/NordSecurity/nordvpn-linux/config"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExportWireGuardConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// server_tag selects the server, current connection is used if empty
+	ServerTag         string `protobuf:"bytes,1,opt,name=server_tag,json=serverTag,proto3" json:"server_tag,omitempty"`
+	IncludePrivateKey bool   `protobuf:"varint,2,opt,name=include_private_key,json=includePrivateKey,proto3" json:"include_private_key,omitempty"`
+}
+
+func (x *ExportWireGuardConfigRequest) Reset() {
+	*x = ExportWireGuardConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_export_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportWireGuardConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWireGuardConfigRequest) ProtoMessage() {}
+
+func (x *ExportWireGuardConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_export_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWireGuardConfigRequest.ProtoReflect.Descriptor instead.
+func (*ExportWireGuardConfigRequest) Descriptor() ([]byte, []int) {
+	return file_export_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportWireGuardConfigRequest) GetServerTag() string {
+	if x != nil {
+		return x.ServerTag
+	}
+	return ""
+}
+
+func (x *ExportWireGuardConfigRequest) GetIncludePrivateKey() bool {
+	if x != nil {
+		return x.IncludePrivateKey
+	}
+	return false
+}
+
+type ExportWireGuardConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     int64  `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Config   string `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Hostname string `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+}
+
+func (x *ExportWireGuardConfigResponse) Reset() {
+	*x = ExportWireGuardConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_export_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportWireGuardConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWireGuardConfigResponse) ProtoMessage() {}
+
+func (x *ExportWireGuardConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_export_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWireGuardConfigResponse.ProtoReflect.Descriptor instead.
+func (*ExportWireGuardConfigResponse) Descriptor() ([]byte, []int) {
+	return file_export_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportWireGuardConfigResponse) GetType() int64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ExportWireGuardConfigResponse) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+func (x *ExportWireGuardConfigResponse) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+type RenderOpenVPNConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// server_tag selects the server from the cached server list
+	ServerTag string `protobuf:"bytes,1,opt,name=server_tag,json=serverTag,proto3" json:"server_tag,omitempty"`
+	// server is the server record in the API JSON format, used instead of server_tag
+	Server     []byte          `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	Protocol   config.Protocol `protobuf:"varint,3,opt,name=protocol,proto3,enum=config.Protocol" json:"protocol,omitempty"`
+	Obfuscated bool            `protobuf:"varint,4,opt,name=obfuscated,proto3" json:"obfuscated,omitempty"`
+	// template is used instead of the installed template if not empty
+	Template []byte `protobuf:"bytes,5,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *RenderOpenVPNConfigRequest) Reset() {
+	*x = RenderOpenVPNConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_export_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenderOpenVPNConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderOpenVPNConfigRequest) ProtoMessage() {}
+
+func (x *RenderOpenVPNConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_export_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderOpenVPNConfigRequest.ProtoReflect.Descriptor instead.
+func (*RenderOpenVPNConfigRequest) Descriptor() ([]byte, []int) {
+	return file_export_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RenderOpenVPNConfigRequest) GetServerTag() string {
+	if x != nil {
+		return x.ServerTag
+	}
+	return ""
+}
+
+func (x *RenderOpenVPNConfigRequest) GetServer() []byte {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+func (x *RenderOpenVPNConfigRequest) GetProtocol() config.Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return config.Protocol(0)
+}
+
+func (x *RenderOpenVPNConfigRequest) GetObfuscated() bool {
+	if x != nil {
+		return x.Obfuscated
+	}
+	return false
+}
+
+func (x *RenderOpenVPNConfigRequest) GetTemplate() []byte {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type RenderOpenVPNConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     int64  `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Config   string `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Hostname string `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// error describes why the config could not be rendered
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *RenderOpenVPNConfigResponse) Reset() {
+	*x = RenderOpenVPNConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_export_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenderOpenVPNConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderOpenVPNConfigResponse) ProtoMessage() {}
+
+func (x *RenderOpenVPNConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_export_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderOpenVPNConfigResponse.ProtoReflect.Descriptor instead.
+func (*RenderOpenVPNConfigResponse) Descriptor() ([]byte, []int) {
+	return file_export_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RenderOpenVPNConfigResponse) GetType() int64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *RenderOpenVPNConfigResponse) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+func (x *RenderOpenVPNConfigResponse) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *RenderOpenVPNConfigResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_export_proto protoreflect.FileDescriptor
+
+var file_export_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02,
+	0x70, 0x62, 0x1a, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6d, 0x0a, 0x1c, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x67, 0x0a, 0x1d, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0xbd, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e,
+	0x56, 0x50, 0x4e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x62, 0x66, 0x75, 0x73,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x22, 0x7b, 0x0a, 0x1b, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x56,
+	0x50, 0x4e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x31,
+	0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72,
+	0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70,
+	0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_export_proto_rawDescOnce sync.Once
+	file_export_proto_rawDescData = file_export_proto_rawDesc
+)
+
+func file_export_proto_rawDescGZIP() []byte {
+	file_export_proto_rawDescOnce.Do(func() {
+		file_export_proto_rawDescData = protoimpl.X.CompressGZIP(file_export_proto_rawDescData)
+	})
+	return file_export_proto_rawDescData
+}
+
+var file_export_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_export_proto_goTypes = []interface{}{
+	(*ExportWireGuardConfigRequest)(nil),  // 0: pb.ExportWireGuardConfigRequest
+	(*ExportWireGuardConfigResponse)(nil), // 1: pb.ExportWireGuardConfigResponse
+	(*RenderOpenVPNConfigRequest)(nil),    // 2: pb.RenderOpenVPNConfigRequest
+	(*RenderOpenVPNConfigResponse)(nil),   // 3: pb.RenderOpenVPNConfigResponse
+	(config.Protocol)(0),                  // 4: config.Protocol
+}
+var file_export_proto_depIdxs = []int32{
+	4, // 0: pb.RenderOpenVPNConfigRequest.protocol:type_name -> config.Protocol
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_export_proto_init() }
+func file_export_proto_init() {
+	if File_export_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_export_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportWireGuardConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_export_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportWireGuardConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_export_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderOpenVPNConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_export_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderOpenVPNConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_export_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_export_proto_goTypes,
+		DependencyIndexes: file_export_proto_depIdxs,
+		MessageInfos:      file_export_proto_msgTypes,
+	}.Build()
+	File_export_proto = out.File
+	file_export_proto_rawDesc = nil
+	file_export_proto_goTypes = nil
+	file_export_proto_depIdxs = nil
+}
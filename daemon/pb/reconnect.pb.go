@@ -0,0 +1,315 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: reconnect.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReconnectOption int32
+
+const (
+	ReconnectOption_RECONNECT_MAX_RETRIES ReconnectOption = 0
+	ReconnectOption_RECONNECT_BACKOFF     ReconnectOption = 1
+	ReconnectOption_RECONNECT_JITTER      ReconnectOption = 2
+	ReconnectOption_RECONNECT_AGGRESSIVE  ReconnectOption = 3
+)
+
+// Enum value maps for ReconnectOption.
+var (
+	ReconnectOption_name = map[int32]string{
+		0: "RECONNECT_MAX_RETRIES",
+		1: "RECONNECT_BACKOFF",
+		2: "RECONNECT_JITTER",
+		3: "RECONNECT_AGGRESSIVE",
+	}
+	ReconnectOption_value = map[string]int32{
+		"RECONNECT_MAX_RETRIES": 0,
+		"RECONNECT_BACKOFF":     1,
+		"RECONNECT_JITTER":      2,
+		"RECONNECT_AGGRESSIVE":  3,
+	}
+)
+
+func (x ReconnectOption) Enum() *ReconnectOption {
+	p := new(ReconnectOption)
+	*p = x
+	return p
+}
+
+func (x ReconnectOption) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReconnectOption) Descriptor() protoreflect.EnumDescriptor {
+	return file_reconnect_proto_enumTypes[0].Descriptor()
+}
+
+func (ReconnectOption) Type() protoreflect.EnumType {
+	return &file_reconnect_proto_enumTypes[0]
+}
+
+func (x ReconnectOption) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReconnectOption.Descriptor instead.
+func (ReconnectOption) EnumDescriptor() ([]byte, []int) {
+	return file_reconnect_proto_rawDescGZIP(), []int{0}
+}
+
+type ReconnectPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 retries until connected
+	MaxRetries uint32 `protobuf:"varint,1,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// milliseconds before the first retry, 0 for the default schedule
+	Backoff uint32 `protobuf:"varint,2,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	// upper bound of the random milliseconds added to each retry
+	Jitter     uint32 `protobuf:"varint,3,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	Aggressive bool   `protobuf:"varint,4,opt,name=aggressive,proto3" json:"aggressive,omitempty"`
+}
+
+func (x *ReconnectPolicy) Reset() {
+	*x = ReconnectPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reconnect_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconnectPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconnectPolicy) ProtoMessage() {}
+
+func (x *ReconnectPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_reconnect_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconnectPolicy.ProtoReflect.Descriptor instead.
+func (*ReconnectPolicy) Descriptor() ([]byte, []int) {
+	return file_reconnect_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReconnectPolicy) GetMaxRetries() uint32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *ReconnectPolicy) GetBackoff() uint32 {
+	if x != nil {
+		return x.Backoff
+	}
+	return 0
+}
+
+func (x *ReconnectPolicy) GetJitter() uint32 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *ReconnectPolicy) GetAggressive() bool {
+	if x != nil {
+		return x.Aggressive
+	}
+	return false
+}
+
+type SetReconnectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Option ReconnectOption `protobuf:"varint,1,opt,name=option,proto3,enum=pb.ReconnectOption" json:"option,omitempty"`
+	// number of retries, milliseconds, or 0 and 1 for off and on
+	Value uint32 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SetReconnectRequest) Reset() {
+	*x = SetReconnectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reconnect_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetReconnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReconnectRequest) ProtoMessage() {}
+
+func (x *SetReconnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reconnect_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReconnectRequest.ProtoReflect.Descriptor instead.
+func (*SetReconnectRequest) Descriptor() ([]byte, []int) {
+	return file_reconnect_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetReconnectRequest) GetOption() ReconnectOption {
+	if x != nil {
+		return x.Option
+	}
+	return ReconnectOption_RECONNECT_MAX_RETRIES
+}
+
+func (x *SetReconnectRequest) GetValue() uint32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+var File_reconnect_proto protoreflect.FileDescriptor
+
+var file_reconnect_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x84, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61,
+	0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x63,
+	0x6b, 0x6f, 0x66, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x61, 0x67, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x76, 0x65, 0x22, 0x58, 0x0a, 0x13,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2a, 0x73, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x43,
+	0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x49,
+	0x45, 0x53, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
+	0x54, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x4f, 0x46, 0x46, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x52,
+	0x45, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x5f, 0x4a, 0x49, 0x54, 0x54, 0x45, 0x52, 0x10,
+	0x02, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x5f, 0x41,
+	0x47, 0x47, 0x52, 0x45, 0x53, 0x53, 0x49, 0x56, 0x45, 0x10, 0x03, 0x42, 0x31, 0x5a, 0x2f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c,
+	0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_reconnect_proto_rawDescOnce sync.Once
+	file_reconnect_proto_rawDescData = file_reconnect_proto_rawDesc
+)
+
+func file_reconnect_proto_rawDescGZIP() []byte {
+	file_reconnect_proto_rawDescOnce.Do(func() {
+		file_reconnect_proto_rawDescData = protoimpl.X.CompressGZIP(file_reconnect_proto_rawDescData)
+	})
+	return file_reconnect_proto_rawDescData
+}
+
+var file_reconnect_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_reconnect_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_reconnect_proto_goTypes = []interface{}{
+	(ReconnectOption)(0),        // 0: pb.ReconnectOption
+	(*ReconnectPolicy)(nil),     // 1: pb.ReconnectPolicy
+	(*SetReconnectRequest)(nil), // 2: pb.SetReconnectRequest
+}
+var file_reconnect_proto_depIdxs = []int32{
+	0, // 0: pb.SetReconnectRequest.option:type_name -> pb.ReconnectOption
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_reconnect_proto_init() }
+func file_reconnect_proto_init() {
+	if File_reconnect_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_reconnect_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconnectPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reconnect_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReconnectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_reconnect_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_reconnect_proto_goTypes,
+		DependencyIndexes: file_reconnect_proto_depIdxs,
+		EnumInfos:         file_reconnect_proto_enumTypes,
+		MessageInfos:      file_reconnect_proto_msgTypes,
+	}.Build()
+	File_reconnect_proto = out.File
+	file_reconnect_proto_rawDesc = nil
+	file_reconnect_proto_goTypes = nil
+	file_reconnect_proto_depIdxs = nil
+}
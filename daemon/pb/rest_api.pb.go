@@ -0,0 +1,239 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: rest_api.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RESTAPI struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// address the API is served on, e.g. 127.0.0.1:9102
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *RESTAPI) Reset() {
+	*x = RESTAPI{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rest_api_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RESTAPI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RESTAPI) ProtoMessage() {}
+
+func (x *RESTAPI) ProtoReflect() protoreflect.Message {
+	mi := &file_rest_api_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RESTAPI.ProtoReflect.Descriptor instead.
+func (*RESTAPI) Descriptor() ([]byte, []int) {
+	return file_rest_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RESTAPI) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *RESTAPI) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type SetRESTAPIRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// address to listen on, empty keeps the current address
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// new_token replaces the token used to authenticate the requests
+	NewToken bool `protobuf:"varint,3,opt,name=new_token,json=newToken,proto3" json:"new_token,omitempty"`
+}
+
+func (x *SetRESTAPIRequest) Reset() {
+	*x = SetRESTAPIRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rest_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRESTAPIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRESTAPIRequest) ProtoMessage() {}
+
+func (x *SetRESTAPIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rest_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRESTAPIRequest.ProtoReflect.Descriptor instead.
+func (*SetRESTAPIRequest) Descriptor() ([]byte, []int) {
+	return file_rest_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetRESTAPIRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetRESTAPIRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SetRESTAPIRequest) GetNewToken() bool {
+	if x != nil {
+		return x.NewToken
+	}
+	return false
+}
+
+var File_rest_api_proto protoreflect.FileDescriptor
+
+var file_rest_api_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x70, 0x62, 0x22, 0x3d, 0x0a, 0x07, 0x52, 0x45, 0x53, 0x54, 0x41, 0x50, 0x49, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x64, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x52, 0x45, 0x53, 0x54, 0x41, 0x50,
+	0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x6e, 0x65, 0x77, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x6e, 0x65, 0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e,
+	0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rest_api_proto_rawDescOnce sync.Once
+	file_rest_api_proto_rawDescData = file_rest_api_proto_rawDesc
+)
+
+func file_rest_api_proto_rawDescGZIP() []byte {
+	file_rest_api_proto_rawDescOnce.Do(func() {
+		file_rest_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_rest_api_proto_rawDescData)
+	})
+	return file_rest_api_proto_rawDescData
+}
+
+var file_rest_api_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rest_api_proto_goTypes = []interface{}{
+	(*RESTAPI)(nil),           // 0: pb.RESTAPI
+	(*SetRESTAPIRequest)(nil), // 1: pb.SetRESTAPIRequest
+}
+var file_rest_api_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rest_api_proto_init() }
+func file_rest_api_proto_init() {
+	if File_rest_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rest_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RESTAPI); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rest_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRESTAPIRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rest_api_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rest_api_proto_goTypes,
+		DependencyIndexes: file_rest_api_proto_depIdxs,
+		MessageInfos:      file_rest_api_proto_msgTypes,
+	}.Build()
+	File_rest_api_proto = out.File
+	file_rest_api_proto_rawDesc = nil
+	file_rest_api_proto_goTypes = nil
+	file_rest_api_proto_depIdxs = nil
+}
@@ -0,0 +1,237 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: server_ports.proto
+
+package pb
+
+import (
+	config "github.com/NordSecurity/nordvpn-linux/config"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ServerPorts are the ports of the VPN server pinned for each technology, zero
+// when the port is picked by the app
+type ServerPorts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Openvpn  uint32 `protobuf:"varint,1,opt,name=openvpn,proto3" json:"openvpn,omitempty"`
+	Nordlynx uint32 `protobuf:"varint,2,opt,name=nordlynx,proto3" json:"nordlynx,omitempty"`
+}
+
+func (x *ServerPorts) Reset() {
+	*x = ServerPorts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_ports_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerPorts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerPorts) ProtoMessage() {}
+
+func (x *ServerPorts) ProtoReflect() protoreflect.Message {
+	mi := &file_server_ports_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerPorts.ProtoReflect.Descriptor instead.
+func (*ServerPorts) Descriptor() ([]byte, []int) {
+	return file_server_ports_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ServerPorts) GetOpenvpn() uint32 {
+	if x != nil {
+		return x.Openvpn
+	}
+	return 0
+}
+
+func (x *ServerPorts) GetNordlynx() uint32 {
+	if x != nil {
+		return x.Nordlynx
+	}
+	return 0
+}
+
+type SetServerPortRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// technology the port is pinned for, the current technology if unknown
+	Technology config.Technology `protobuf:"varint,1,opt,name=technology,proto3,enum=config.Technology" json:"technology,omitempty"`
+	// port of the VPN server, zero restores the default
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *SetServerPortRequest) Reset() {
+	*x = SetServerPortRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_ports_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetServerPortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetServerPortRequest) ProtoMessage() {}
+
+func (x *SetServerPortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_ports_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetServerPortRequest.ProtoReflect.Descriptor instead.
+func (*SetServerPortRequest) Descriptor() ([]byte, []int) {
+	return file_server_ports_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetServerPortRequest) GetTechnology() config.Technology {
+	if x != nil {
+		return x.Technology
+	}
+	return config.Technology(0)
+}
+
+func (x *SetServerPortRequest) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+var File_server_ports_proto protoreflect.FileDescriptor
+
+var file_server_ports_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2f, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x43, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x76, 0x70, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x76, 0x70, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f,
+	0x72, 0x64, 0x6c, 0x79, 0x6e, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x6f,
+	0x72, 0x64, 0x6c, 0x79, 0x6e, 0x78, 0x22, 0x5e, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32,
+	0x0a, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x65, 0x63, 0x68,
+	0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f,
+	0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_server_ports_proto_rawDescOnce sync.Once
+	file_server_ports_proto_rawDescData = file_server_ports_proto_rawDesc
+)
+
+func file_server_ports_proto_rawDescGZIP() []byte {
+	file_server_ports_proto_rawDescOnce.Do(func() {
+		file_server_ports_proto_rawDescData = protoimpl.X.CompressGZIP(file_server_ports_proto_rawDescData)
+	})
+	return file_server_ports_proto_rawDescData
+}
+
+var file_server_ports_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_server_ports_proto_goTypes = []interface{}{
+	(*ServerPorts)(nil),          // 0: pb.ServerPorts
+	(*SetServerPortRequest)(nil), // 1: pb.SetServerPortRequest
+	(config.Technology)(0),       // 2: config.Technology
+}
+var file_server_ports_proto_depIdxs = []int32{
+	2, // 0: pb.SetServerPortRequest.technology:type_name -> config.Technology
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_server_ports_proto_init() }
+func file_server_ports_proto_init() {
+	if File_server_ports_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_server_ports_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerPorts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_ports_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetServerPortRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_server_ports_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_server_ports_proto_goTypes,
+		DependencyIndexes: file_server_ports_proto_depIdxs,
+		MessageInfos:      file_server_ports_proto_msgTypes,
+	}.Build()
+	File_server_ports_proto = out.File
+	file_server_ports_proto_rawDesc = nil
+	file_server_ports_proto_goTypes = nil
+	file_server_ports_proto_depIdxs = nil
+}
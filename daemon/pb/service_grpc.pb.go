@@ -23,41 +23,112 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DaemonClient interface {
 	AccountInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AccountResponse, error)
+	CredentialsStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CredentialsStatusResponse, error)
 	TokenInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TokenInfoResponse, error)
+	CacheStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CacheStatsResponse, error)
 	Cities(ctx context.Context, in *CitiesRequest, opts ...grpc.CallOption) (*Payload, error)
 	Connect(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (Daemon_ConnectClient, error)
+	ConnectionIPs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ConnectionIPsResponse, error)
+	ConnectionHistory(ctx context.Context, in *ConnectionHistoryRequest, opts ...grpc.CallOption) (*ConnectionHistoryResponse, error)
 	Countries(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error)
+	DedicatedIP(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DedicatedIPResponse, error)
 	Disconnect(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_DisconnectClient, error)
+	ExportWireGuardConfig(ctx context.Context, in *ExportWireGuardConfigRequest, opts ...grpc.CallOption) (*ExportWireGuardConfigResponse, error)
+	Favorites(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FavoritesResponse, error)
 	Groups(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error)
+	Health(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HealthResponse, error)
+	Diagnose(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DiagnoseResponse, error)
+	ImportFavorites(ctx context.Context, in *ImportFavoritesRequest, opts ...grpc.CallOption) (*ImportFavoritesResponse, error)
+	KillSwitchBlocked(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*KillSwitchBlockedResponse, error)
 	IsLoggedIn(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Bool, error)
 	LoginWithToken(ctx context.Context, in *LoginWithTokenRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	LoginOAuth2(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_LoginOAuth2Client, error)
 	LoginOAuth2Callback(ctx context.Context, in *String, opts ...grpc.CallOption) (*Empty, error)
 	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*Payload, error)
+	MeteredStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MeteredStatusResponse, error)
 	Plans(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PlansResponse, error)
+	PreviewDNS(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PreviewDNSResponse, error)
 	Ping(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error)
 	RateConnection(ctx context.Context, in *RateRequest, opts ...grpc.CallOption) (*Payload, error)
+	RebuildFirewall(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RebuildFirewallResponse, error)
+	Reload(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ReloadResponse, error)
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*Payload, error)
+	RenderOpenVPNConfig(ctx context.Context, in *RenderOpenVPNConfigRequest, opts ...grpc.CallOption) (*RenderOpenVPNConfigResponse, error)
+	ServerNotes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServerNotesResponse, error)
+	Servers(ctx context.Context, in *ServersRequest, opts ...grpc.CallOption) (*ServersResponse, error)
 	SetAutoConnect(ctx context.Context, in *SetAutoconnectRequest, opts ...grpc.CallOption) (*Payload, error)
 	SetThreatProtectionLite(ctx context.Context, in *SetThreatProtectionLiteRequest, opts ...grpc.CallOption) (*SetThreatProtectionLiteResponse, error)
 	SetDefaults(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error)
 	SetDNS(ctx context.Context, in *SetDNSRequest, opts ...grpc.CallOption) (*SetDNSResponse, error)
 	SetFirewall(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
 	SetFirewallMark(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error)
+	SetRoutingTable(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error)
+	SetLegacyDNS(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
 	SetRouting(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
 	SetAnalytics(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
 	SetKillSwitch(ctx context.Context, in *SetKillSwitchRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetKillSwitchLog(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetKillSwitchLAN(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetMulticastDiscovery(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
 	SetNotify(ctx context.Context, in *SetNotifyRequest, opts ...grpc.CallOption) (*Payload, error)
 	SetObfuscate(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
 	SetProtocol(ctx context.Context, in *SetProtocolRequest, opts ...grpc.CallOption) (*SetProtocolResponse, error)
 	SetTechnology(ctx context.Context, in *SetTechnologyRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetAllowedTechnologies(ctx context.Context, in *SetAllowedTechnologiesRequest, opts ...grpc.CallOption) (*Payload, error)
 	SetLANDiscovery(ctx context.Context, in *SetLANDiscoveryRequest, opts ...grpc.CallOption) (*SetLANDiscoveryResponse, error)
 	SetAllowlist(ctx context.Context, in *SetAllowlistRequest, opts ...grpc.CallOption) (*Payload, error)
 	Settings(ctx context.Context, in *SettingsRequest, opts ...grpc.CallOption) (*SettingsResponse, error)
 	SettingsProtocols(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error)
 	SettingsTechnologies(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error)
 	Status(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StatusResponse, error)
+	// WatchStatus sends the status once per second until cancelled
+	WatchStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_WatchStatusClient, error)
+	// SubscribeEvents sends connection, settings, meshnet and account events as
+	// they happen until cancelled
+	SubscribeEvents(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_SubscribeEventsClient, error)
+	TunnelOverhead(ctx context.Context, in *TunnelOverheadRequest, opts ...grpc.CallOption) (*TunnelOverheadResponse, error)
 	SetIpv6(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetIPv6Mode(ctx context.Context, in *SetIPv6ModeRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetDefaultRouteMode(ctx context.Context, in *SetDefaultRouteModeRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetSubnetOverlapMode(ctx context.Context, in *SetSubnetOverlapModeRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetServerSelection(ctx context.Context, in *SetServerSelectionRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetVirtualLocation(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetDNSMonitor(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetNetworkManager(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetObfuscationMode(ctx context.Context, in *SetObfuscationModeRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetInterfaceName(ctx context.Context, in *SetInterfaceNameRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetMTU(ctx context.Context, in *SetMTURequest, opts ...grpc.CallOption) (*Payload, error)
+	SetFirewallBackend(ctx context.Context, in *SetFirewallBackendRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetOnDemand(ctx context.Context, in *SetOnDemandRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetFirewallTemplate(ctx context.Context, in *SetFirewallTemplateRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetDNSIPv6(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetServerPort(ctx context.Context, in *SetServerPortRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetDNSSearchDomains(ctx context.Context, in *SetDNSSearchDomainsRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetDNSRoute(ctx context.Context, in *SetDNSRouteRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetDNSFilter(ctx context.Context, in *SetDNSFilterRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetIdleDisconnect(ctx context.Context, in *SetIdleDisconnectRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetServerNote(ctx context.Context, in *SetServerNoteRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetServerRating(ctx context.Context, in *SetServerRatingRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetRatingWeight(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error)
+	SetMetered(ctx context.Context, in *SetMeteredRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetReconnect(ctx context.Context, in *SetReconnectRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetMeteredNetwork(ctx context.Context, in *SetMeteredNetworkRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetCaptivePortalNetwork(ctx context.Context, in *SetCaptivePortalNetworkRequest, opts ...grpc.CallOption) (*Payload, error)
+	UnlockCaptivePortal(ctx context.Context, in *UnlockCaptivePortalRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetTrustedNetwork(ctx context.Context, in *SetTrustedNetworkRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetSplitTunnelApp(ctx context.Context, in *SetSplitTunnelAppRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetSplitTunnelMode(ctx context.Context, in *SetSplitTunnelModeRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetMetrics(ctx context.Context, in *SetMetricsRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetRESTAPI(ctx context.Context, in *SetRESTAPIRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetLocalProxy(ctx context.Context, in *SetLocalProxyRequest, opts ...grpc.CallOption) (*Payload, error)
+	SaveProfile(ctx context.Context, in *SaveProfileRequest, opts ...grpc.CallOption) (*Payload, error)
+	RemoveProfile(ctx context.Context, in *RemoveProfileRequest, opts ...grpc.CallOption) (*Payload, error)
+	Profiles(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ProfilesResponse, error)
+	AddAutoConnectRule(ctx context.Context, in *AddAutoConnectRuleRequest, opts ...grpc.CallOption) (*Payload, error)
+	RemoveAutoConnectRule(ctx context.Context, in *RemoveAutoConnectRuleRequest, opts ...grpc.CallOption) (*Payload, error)
+	Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*Payload, error)
+	Resume(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error)
 }
 
 type daemonClient struct {
@@ -77,6 +148,15 @@ func (c *daemonClient) AccountInfo(ctx context.Context, in *Empty, opts ...grpc.
 	return out, nil
 }
 
+func (c *daemonClient) CredentialsStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CredentialsStatusResponse, error) {
+	out := new(CredentialsStatusResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/CredentialsStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) TokenInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TokenInfoResponse, error) {
 	out := new(TokenInfoResponse)
 	err := c.cc.Invoke(ctx, "/pb.Daemon/TokenInfo", in, out, opts...)
@@ -86,6 +166,15 @@ func (c *daemonClient) TokenInfo(ctx context.Context, in *Empty, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *daemonClient) CacheStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CacheStatsResponse, error) {
+	out := new(CacheStatsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/CacheStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) Cities(ctx context.Context, in *CitiesRequest, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
 	err := c.cc.Invoke(ctx, "/pb.Daemon/Cities", in, out, opts...)
@@ -127,6 +216,24 @@ func (x *daemonConnectClient) Recv() (*Payload, error) {
 	return m, nil
 }
 
+func (c *daemonClient) ConnectionIPs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ConnectionIPsResponse, error) {
+	out := new(ConnectionIPsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/ConnectionIPs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ConnectionHistory(ctx context.Context, in *ConnectionHistoryRequest, opts ...grpc.CallOption) (*ConnectionHistoryResponse, error) {
+	out := new(ConnectionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/ConnectionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) Countries(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
 	err := c.cc.Invoke(ctx, "/pb.Daemon/Countries", in, out, opts...)
@@ -136,6 +243,15 @@ func (c *daemonClient) Countries(ctx context.Context, in *Empty, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *daemonClient) DedicatedIP(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DedicatedIPResponse, error) {
+	out := new(DedicatedIPResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/DedicatedIP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) Disconnect(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_DisconnectClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[1], "/pb.Daemon/Disconnect", opts...)
 	if err != nil {
@@ -168,6 +284,24 @@ func (x *daemonDisconnectClient) Recv() (*Payload, error) {
 	return m, nil
 }
 
+func (c *daemonClient) ExportWireGuardConfig(ctx context.Context, in *ExportWireGuardConfigRequest, opts ...grpc.CallOption) (*ExportWireGuardConfigResponse, error) {
+	out := new(ExportWireGuardConfigResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/ExportWireGuardConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) Favorites(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FavoritesResponse, error) {
+	out := new(FavoritesResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/Favorites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) Groups(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
 	err := c.cc.Invoke(ctx, "/pb.Daemon/Groups", in, out, opts...)
@@ -177,6 +311,42 @@ func (c *daemonClient) Groups(ctx context.Context, in *Empty, opts ...grpc.CallO
 	return out, nil
 }
 
+func (c *daemonClient) Health(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HealthResponse, error) {
+	out := new(HealthResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/Health", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) Diagnose(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DiagnoseResponse, error) {
+	out := new(DiagnoseResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/Diagnose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ImportFavorites(ctx context.Context, in *ImportFavoritesRequest, opts ...grpc.CallOption) (*ImportFavoritesResponse, error) {
+	out := new(ImportFavoritesResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/ImportFavorites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) KillSwitchBlocked(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*KillSwitchBlockedResponse, error) {
+	out := new(KillSwitchBlockedResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/KillSwitchBlocked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) IsLoggedIn(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Bool, error) {
 	out := new(Bool)
 	err := c.cc.Invoke(ctx, "/pb.Daemon/IsLoggedIn", in, out, opts...)
@@ -245,6 +415,15 @@ func (c *daemonClient) Logout(ctx context.Context, in *LogoutRequest, opts ...gr
 	return out, nil
 }
 
+func (c *daemonClient) MeteredStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MeteredStatusResponse, error) {
+	out := new(MeteredStatusResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/MeteredStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) Plans(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PlansResponse, error) {
 	out := new(PlansResponse)
 	err := c.cc.Invoke(ctx, "/pb.Daemon/Plans", in, out, opts...)
@@ -254,6 +433,15 @@ func (c *daemonClient) Plans(ctx context.Context, in *Empty, opts ...grpc.CallOp
 	return out, nil
 }
 
+func (c *daemonClient) PreviewDNS(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PreviewDNSResponse, error) {
+	out := new(PreviewDNSResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/PreviewDNS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) Ping(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
 	err := c.cc.Invoke(ctx, "/pb.Daemon/Ping", in, out, opts...)
@@ -272,6 +460,24 @@ func (c *daemonClient) RateConnection(ctx context.Context, in *RateRequest, opts
 	return out, nil
 }
 
+func (c *daemonClient) RebuildFirewall(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RebuildFirewallResponse, error) {
+	out := new(RebuildFirewallResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/RebuildFirewall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) Reload(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ReloadResponse, error) {
+	out := new(ReloadResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/Reload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
 	err := c.cc.Invoke(ctx, "/pb.Daemon/Register", in, out, opts...)
@@ -281,6 +487,33 @@ func (c *daemonClient) Register(ctx context.Context, in *RegisterRequest, opts .
 	return out, nil
 }
 
+func (c *daemonClient) RenderOpenVPNConfig(ctx context.Context, in *RenderOpenVPNConfigRequest, opts ...grpc.CallOption) (*RenderOpenVPNConfigResponse, error) {
+	out := new(RenderOpenVPNConfigResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/RenderOpenVPNConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ServerNotes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServerNotesResponse, error) {
+	out := new(ServerNotesResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/ServerNotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) Servers(ctx context.Context, in *ServersRequest, opts ...grpc.CallOption) (*ServersResponse, error) {
+	out := new(ServersResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/Servers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) SetAutoConnect(ctx context.Context, in *SetAutoconnectRequest, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
 	err := c.cc.Invoke(ctx, "/pb.Daemon/SetAutoConnect", in, out, opts...)
@@ -335,6 +568,24 @@ func (c *daemonClient) SetFirewallMark(ctx context.Context, in *SetUint32Request
 	return out, nil
 }
 
+func (c *daemonClient) SetRoutingTable(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetRoutingTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetLegacyDNS(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetLegacyDNS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) SetRouting(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
 	err := c.cc.Invoke(ctx, "/pb.Daemon/SetRouting", in, out, opts...)
@@ -362,6 +613,33 @@ func (c *daemonClient) SetKillSwitch(ctx context.Context, in *SetKillSwitchReque
 	return out, nil
 }
 
+func (c *daemonClient) SetKillSwitchLog(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetKillSwitchLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetKillSwitchLAN(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetKillSwitchLAN", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetMulticastDiscovery(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetMulticastDiscovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) SetNotify(ctx context.Context, in *SetNotifyRequest, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
 	err := c.cc.Invoke(ctx, "/pb.Daemon/SetNotify", in, out, opts...)
@@ -398,6 +676,15 @@ func (c *daemonClient) SetTechnology(ctx context.Context, in *SetTechnologyReque
 	return out, nil
 }
 
+func (c *daemonClient) SetAllowedTechnologies(ctx context.Context, in *SetAllowedTechnologiesRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetAllowedTechnologies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) SetLANDiscovery(ctx context.Context, in *SetLANDiscoveryRequest, opts ...grpc.CallOption) (*SetLANDiscoveryResponse, error) {
 	out := new(SetLANDiscoveryResponse)
 	err := c.cc.Invoke(ctx, "/pb.Daemon/SetLANDiscovery", in, out, opts...)
@@ -452,836 +739,2783 @@ func (c *daemonClient) Status(ctx context.Context, in *Empty, opts ...grpc.CallO
 	return out, nil
 }
 
-func (c *daemonClient) SetIpv6(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
-	out := new(Payload)
-	err := c.cc.Invoke(ctx, "/pb.Daemon/SetIpv6", in, out, opts...)
+func (c *daemonClient) WatchStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_WatchStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[3], "/pb.Daemon/WatchStatus", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &daemonWatchStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-// DaemonServer is the server API for Daemon service.
-// All implementations must embed UnimplementedDaemonServer
-// for forward compatibility
-type DaemonServer interface {
-	AccountInfo(context.Context, *Empty) (*AccountResponse, error)
-	TokenInfo(context.Context, *Empty) (*TokenInfoResponse, error)
-	Cities(context.Context, *CitiesRequest) (*Payload, error)
-	Connect(*ConnectRequest, Daemon_ConnectServer) error
-	Countries(context.Context, *Empty) (*Payload, error)
-	Disconnect(*Empty, Daemon_DisconnectServer) error
-	Groups(context.Context, *Empty) (*Payload, error)
-	IsLoggedIn(context.Context, *Empty) (*Bool, error)
-	LoginWithToken(context.Context, *LoginWithTokenRequest) (*LoginResponse, error)
-	LoginOAuth2(*Empty, Daemon_LoginOAuth2Server) error
-	LoginOAuth2Callback(context.Context, *String) (*Empty, error)
-	Logout(context.Context, *LogoutRequest) (*Payload, error)
-	Plans(context.Context, *Empty) (*PlansResponse, error)
-	Ping(context.Context, *Empty) (*Payload, error)
-	RateConnection(context.Context, *RateRequest) (*Payload, error)
-	Register(context.Context, *RegisterRequest) (*Payload, error)
-	SetAutoConnect(context.Context, *SetAutoconnectRequest) (*Payload, error)
-	SetThreatProtectionLite(context.Context, *SetThreatProtectionLiteRequest) (*SetThreatProtectionLiteResponse, error)
-	SetDefaults(context.Context, *Empty) (*Payload, error)
-	SetDNS(context.Context, *SetDNSRequest) (*SetDNSResponse, error)
-	SetFirewall(context.Context, *SetGenericRequest) (*Payload, error)
-	SetFirewallMark(context.Context, *SetUint32Request) (*Payload, error)
-	SetRouting(context.Context, *SetGenericRequest) (*Payload, error)
-	SetAnalytics(context.Context, *SetGenericRequest) (*Payload, error)
-	SetKillSwitch(context.Context, *SetKillSwitchRequest) (*Payload, error)
-	SetNotify(context.Context, *SetNotifyRequest) (*Payload, error)
-	SetObfuscate(context.Context, *SetGenericRequest) (*Payload, error)
-	SetProtocol(context.Context, *SetProtocolRequest) (*SetProtocolResponse, error)
-	SetTechnology(context.Context, *SetTechnologyRequest) (*Payload, error)
-	SetLANDiscovery(context.Context, *SetLANDiscoveryRequest) (*SetLANDiscoveryResponse, error)
-	SetAllowlist(context.Context, *SetAllowlistRequest) (*Payload, error)
-	Settings(context.Context, *SettingsRequest) (*SettingsResponse, error)
-	SettingsProtocols(context.Context, *Empty) (*Payload, error)
-	SettingsTechnologies(context.Context, *Empty) (*Payload, error)
-	Status(context.Context, *Empty) (*StatusResponse, error)
-	SetIpv6(context.Context, *SetGenericRequest) (*Payload, error)
-	mustEmbedUnimplementedDaemonServer()
+type Daemon_WatchStatusClient interface {
+	Recv() (*StatusResponse, error)
+	grpc.ClientStream
 }
 
-// UnimplementedDaemonServer must be embedded to have forward compatible implementations.
-type UnimplementedDaemonServer struct {
+type daemonWatchStatusClient struct {
+	grpc.ClientStream
 }
 
-func (UnimplementedDaemonServer) AccountInfo(context.Context, *Empty) (*AccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
-}
-func (UnimplementedDaemonServer) TokenInfo(context.Context, *Empty) (*TokenInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TokenInfo not implemented")
-}
-func (UnimplementedDaemonServer) Cities(context.Context, *CitiesRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Cities not implemented")
-}
-func (UnimplementedDaemonServer) Connect(*ConnectRequest, Daemon_ConnectServer) error {
-	return status.Errorf(codes.Unimplemented, "method Connect not implemented")
+func (x *daemonWatchStatusClient) Recv() (*StatusResponse, error) {
+	m := new(StatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedDaemonServer) Countries(context.Context, *Empty) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Countries not implemented")
+
+func (c *daemonClient) SubscribeEvents(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_SubscribeEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[4], "/pb.Daemon/SubscribeEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonSubscribeEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedDaemonServer) Disconnect(*Empty, Daemon_DisconnectServer) error {
-	return status.Errorf(codes.Unimplemented, "method Disconnect not implemented")
+
+type Daemon_SubscribeEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
 }
-func (UnimplementedDaemonServer) Groups(context.Context, *Empty) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
+
+type daemonSubscribeEventsClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedDaemonServer) IsLoggedIn(context.Context, *Empty) (*Bool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsLoggedIn not implemented")
+
+func (x *daemonSubscribeEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedDaemonServer) LoginWithToken(context.Context, *LoginWithTokenRequest) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginWithToken not implemented")
+
+func (c *daemonClient) TunnelOverhead(ctx context.Context, in *TunnelOverheadRequest, opts ...grpc.CallOption) (*TunnelOverheadResponse, error) {
+	out := new(TunnelOverheadResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/TunnelOverhead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) LoginOAuth2(*Empty, Daemon_LoginOAuth2Server) error {
-	return status.Errorf(codes.Unimplemented, "method LoginOAuth2 not implemented")
+
+func (c *daemonClient) SetIpv6(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetIpv6", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) LoginOAuth2Callback(context.Context, *String) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginOAuth2Callback not implemented")
+
+func (c *daemonClient) SetIPv6Mode(ctx context.Context, in *SetIPv6ModeRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetIPv6Mode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) Logout(context.Context, *LogoutRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+
+func (c *daemonClient) SetDefaultRouteMode(ctx context.Context, in *SetDefaultRouteModeRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetDefaultRouteMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) Plans(context.Context, *Empty) (*PlansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Plans not implemented")
+
+func (c *daemonClient) SetSubnetOverlapMode(ctx context.Context, in *SetSubnetOverlapModeRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetSubnetOverlapMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) Ping(context.Context, *Empty) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+
+func (c *daemonClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) RateConnection(context.Context, *RateRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RateConnection not implemented")
+
+func (c *daemonClient) SetServerSelection(ctx context.Context, in *SetServerSelectionRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetServerSelection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) Register(context.Context, *RegisterRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+
+func (c *daemonClient) SetVirtualLocation(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetVirtualLocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetAutoConnect(context.Context, *SetAutoconnectRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAutoConnect not implemented")
+
+func (c *daemonClient) SetDNSMonitor(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetDNSMonitor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetThreatProtectionLite(context.Context, *SetThreatProtectionLiteRequest) (*SetThreatProtectionLiteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetThreatProtectionLite not implemented")
+
+func (c *daemonClient) SetNetworkManager(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetNetworkManager", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetDefaults(context.Context, *Empty) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDefaults not implemented")
+
+func (c *daemonClient) SetObfuscationMode(ctx context.Context, in *SetObfuscationModeRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetObfuscationMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetDNS(context.Context, *SetDNSRequest) (*SetDNSResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDNS not implemented")
+
+func (c *daemonClient) SetInterfaceName(ctx context.Context, in *SetInterfaceNameRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetInterfaceName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetFirewall(context.Context, *SetGenericRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetFirewall not implemented")
+
+func (c *daemonClient) SetMTU(ctx context.Context, in *SetMTURequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetMTU", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetFirewallMark(context.Context, *SetUint32Request) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetFirewallMark not implemented")
+
+func (c *daemonClient) SetFirewallBackend(ctx context.Context, in *SetFirewallBackendRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetFirewallBackend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetRouting(context.Context, *SetGenericRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetRouting not implemented")
+
+func (c *daemonClient) SetOnDemand(ctx context.Context, in *SetOnDemandRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetOnDemand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetAnalytics(context.Context, *SetGenericRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAnalytics not implemented")
+
+func (c *daemonClient) SetFirewallTemplate(ctx context.Context, in *SetFirewallTemplateRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetFirewallTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetKillSwitch(context.Context, *SetKillSwitchRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetKillSwitch not implemented")
+
+func (c *daemonClient) SetDNSIPv6(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetDNSIPv6", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetNotify(context.Context, *SetNotifyRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetNotify not implemented")
+
+func (c *daemonClient) SetServerPort(ctx context.Context, in *SetServerPortRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetServerPort", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetObfuscate(context.Context, *SetGenericRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetObfuscate not implemented")
+
+func (c *daemonClient) SetDNSSearchDomains(ctx context.Context, in *SetDNSSearchDomainsRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetDNSSearchDomains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetProtocol(context.Context, *SetProtocolRequest) (*SetProtocolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetProtocol not implemented")
+
+func (c *daemonClient) SetDNSRoute(ctx context.Context, in *SetDNSRouteRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetDNSRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetTechnology(context.Context, *SetTechnologyRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetTechnology not implemented")
+
+func (c *daemonClient) SetDNSFilter(ctx context.Context, in *SetDNSFilterRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetDNSFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetLANDiscovery(context.Context, *SetLANDiscoveryRequest) (*SetLANDiscoveryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLANDiscovery not implemented")
+
+func (c *daemonClient) SetIdleDisconnect(ctx context.Context, in *SetIdleDisconnectRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetIdleDisconnect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetServerNote(ctx context.Context, in *SetServerNoteRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetServerNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetServerRating(ctx context.Context, in *SetServerRatingRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetServerRating", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetRatingWeight(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetRatingWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetMetered(ctx context.Context, in *SetMeteredRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetMetered", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetReconnect(ctx context.Context, in *SetReconnectRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetReconnect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetMeteredNetwork(ctx context.Context, in *SetMeteredNetworkRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetMeteredNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetCaptivePortalNetwork(ctx context.Context, in *SetCaptivePortalNetworkRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetCaptivePortalNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) UnlockCaptivePortal(ctx context.Context, in *UnlockCaptivePortalRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/UnlockCaptivePortal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetTrustedNetwork(ctx context.Context, in *SetTrustedNetworkRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetTrustedNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetSplitTunnelApp(ctx context.Context, in *SetSplitTunnelAppRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetSplitTunnelApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetSplitTunnelMode(ctx context.Context, in *SetSplitTunnelModeRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetSplitTunnelMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetMetrics(ctx context.Context, in *SetMetricsRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetRESTAPI(ctx context.Context, in *SetRESTAPIRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetRESTAPI", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetLocalProxy(ctx context.Context, in *SetLocalProxyRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetLocalProxy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SaveProfile(ctx context.Context, in *SaveProfileRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SaveProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) RemoveProfile(ctx context.Context, in *RemoveProfileRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/RemoveProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) Profiles(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ProfilesResponse, error) {
+	out := new(ProfilesResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/Profiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) AddAutoConnectRule(ctx context.Context, in *AddAutoConnectRuleRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/AddAutoConnectRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) RemoveAutoConnectRule(ctx context.Context, in *RemoveAutoConnectRuleRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/RemoveAutoConnectRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/Pause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) Resume(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/Resume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DaemonServer is the server API for Daemon service.
+// All implementations must embed UnimplementedDaemonServer
+// for forward compatibility
+type DaemonServer interface {
+	AccountInfo(context.Context, *Empty) (*AccountResponse, error)
+	CredentialsStatus(context.Context, *Empty) (*CredentialsStatusResponse, error)
+	TokenInfo(context.Context, *Empty) (*TokenInfoResponse, error)
+	CacheStats(context.Context, *Empty) (*CacheStatsResponse, error)
+	Cities(context.Context, *CitiesRequest) (*Payload, error)
+	Connect(*ConnectRequest, Daemon_ConnectServer) error
+	ConnectionIPs(context.Context, *Empty) (*ConnectionIPsResponse, error)
+	ConnectionHistory(context.Context, *ConnectionHistoryRequest) (*ConnectionHistoryResponse, error)
+	Countries(context.Context, *Empty) (*Payload, error)
+	DedicatedIP(context.Context, *Empty) (*DedicatedIPResponse, error)
+	Disconnect(*Empty, Daemon_DisconnectServer) error
+	ExportWireGuardConfig(context.Context, *ExportWireGuardConfigRequest) (*ExportWireGuardConfigResponse, error)
+	Favorites(context.Context, *Empty) (*FavoritesResponse, error)
+	Groups(context.Context, *Empty) (*Payload, error)
+	Health(context.Context, *Empty) (*HealthResponse, error)
+	Diagnose(context.Context, *Empty) (*DiagnoseResponse, error)
+	ImportFavorites(context.Context, *ImportFavoritesRequest) (*ImportFavoritesResponse, error)
+	KillSwitchBlocked(context.Context, *Empty) (*KillSwitchBlockedResponse, error)
+	IsLoggedIn(context.Context, *Empty) (*Bool, error)
+	LoginWithToken(context.Context, *LoginWithTokenRequest) (*LoginResponse, error)
+	LoginOAuth2(*Empty, Daemon_LoginOAuth2Server) error
+	LoginOAuth2Callback(context.Context, *String) (*Empty, error)
+	Logout(context.Context, *LogoutRequest) (*Payload, error)
+	MeteredStatus(context.Context, *Empty) (*MeteredStatusResponse, error)
+	Plans(context.Context, *Empty) (*PlansResponse, error)
+	PreviewDNS(context.Context, *Empty) (*PreviewDNSResponse, error)
+	Ping(context.Context, *Empty) (*Payload, error)
+	RateConnection(context.Context, *RateRequest) (*Payload, error)
+	RebuildFirewall(context.Context, *Empty) (*RebuildFirewallResponse, error)
+	Reload(context.Context, *Empty) (*ReloadResponse, error)
+	Register(context.Context, *RegisterRequest) (*Payload, error)
+	RenderOpenVPNConfig(context.Context, *RenderOpenVPNConfigRequest) (*RenderOpenVPNConfigResponse, error)
+	ServerNotes(context.Context, *Empty) (*ServerNotesResponse, error)
+	Servers(context.Context, *ServersRequest) (*ServersResponse, error)
+	SetAutoConnect(context.Context, *SetAutoconnectRequest) (*Payload, error)
+	SetThreatProtectionLite(context.Context, *SetThreatProtectionLiteRequest) (*SetThreatProtectionLiteResponse, error)
+	SetDefaults(context.Context, *Empty) (*Payload, error)
+	SetDNS(context.Context, *SetDNSRequest) (*SetDNSResponse, error)
+	SetFirewall(context.Context, *SetGenericRequest) (*Payload, error)
+	SetFirewallMark(context.Context, *SetUint32Request) (*Payload, error)
+	SetRoutingTable(context.Context, *SetUint32Request) (*Payload, error)
+	SetLegacyDNS(context.Context, *SetGenericRequest) (*Payload, error)
+	SetRouting(context.Context, *SetGenericRequest) (*Payload, error)
+	SetAnalytics(context.Context, *SetGenericRequest) (*Payload, error)
+	SetKillSwitch(context.Context, *SetKillSwitchRequest) (*Payload, error)
+	SetKillSwitchLog(context.Context, *SetGenericRequest) (*Payload, error)
+	SetKillSwitchLAN(context.Context, *SetGenericRequest) (*Payload, error)
+	SetMulticastDiscovery(context.Context, *SetGenericRequest) (*Payload, error)
+	SetNotify(context.Context, *SetNotifyRequest) (*Payload, error)
+	SetObfuscate(context.Context, *SetGenericRequest) (*Payload, error)
+	SetProtocol(context.Context, *SetProtocolRequest) (*SetProtocolResponse, error)
+	SetTechnology(context.Context, *SetTechnologyRequest) (*Payload, error)
+	SetAllowedTechnologies(context.Context, *SetAllowedTechnologiesRequest) (*Payload, error)
+	SetLANDiscovery(context.Context, *SetLANDiscoveryRequest) (*SetLANDiscoveryResponse, error)
+	SetAllowlist(context.Context, *SetAllowlistRequest) (*Payload, error)
+	Settings(context.Context, *SettingsRequest) (*SettingsResponse, error)
+	SettingsProtocols(context.Context, *Empty) (*Payload, error)
+	SettingsTechnologies(context.Context, *Empty) (*Payload, error)
+	Status(context.Context, *Empty) (*StatusResponse, error)
+	// WatchStatus sends the status once per second until cancelled
+	WatchStatus(*Empty, Daemon_WatchStatusServer) error
+	// SubscribeEvents sends connection, settings, meshnet and account events as
+	// they happen until cancelled
+	SubscribeEvents(*Empty, Daemon_SubscribeEventsServer) error
+	TunnelOverhead(context.Context, *TunnelOverheadRequest) (*TunnelOverheadResponse, error)
+	SetIpv6(context.Context, *SetGenericRequest) (*Payload, error)
+	SetIPv6Mode(context.Context, *SetIPv6ModeRequest) (*Payload, error)
+	SetDefaultRouteMode(context.Context, *SetDefaultRouteModeRequest) (*Payload, error)
+	SetSubnetOverlapMode(context.Context, *SetSubnetOverlapModeRequest) (*Payload, error)
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*Payload, error)
+	SetServerSelection(context.Context, *SetServerSelectionRequest) (*Payload, error)
+	SetVirtualLocation(context.Context, *SetGenericRequest) (*Payload, error)
+	SetDNSMonitor(context.Context, *SetGenericRequest) (*Payload, error)
+	SetNetworkManager(context.Context, *SetGenericRequest) (*Payload, error)
+	SetObfuscationMode(context.Context, *SetObfuscationModeRequest) (*Payload, error)
+	SetInterfaceName(context.Context, *SetInterfaceNameRequest) (*Payload, error)
+	SetMTU(context.Context, *SetMTURequest) (*Payload, error)
+	SetFirewallBackend(context.Context, *SetFirewallBackendRequest) (*Payload, error)
+	SetOnDemand(context.Context, *SetOnDemandRequest) (*Payload, error)
+	SetFirewallTemplate(context.Context, *SetFirewallTemplateRequest) (*Payload, error)
+	SetDNSIPv6(context.Context, *SetGenericRequest) (*Payload, error)
+	SetServerPort(context.Context, *SetServerPortRequest) (*Payload, error)
+	SetDNSSearchDomains(context.Context, *SetDNSSearchDomainsRequest) (*Payload, error)
+	SetDNSRoute(context.Context, *SetDNSRouteRequest) (*Payload, error)
+	SetDNSFilter(context.Context, *SetDNSFilterRequest) (*Payload, error)
+	SetIdleDisconnect(context.Context, *SetIdleDisconnectRequest) (*Payload, error)
+	SetServerNote(context.Context, *SetServerNoteRequest) (*Payload, error)
+	SetServerRating(context.Context, *SetServerRatingRequest) (*Payload, error)
+	SetRatingWeight(context.Context, *SetUint32Request) (*Payload, error)
+	SetMetered(context.Context, *SetMeteredRequest) (*Payload, error)
+	SetReconnect(context.Context, *SetReconnectRequest) (*Payload, error)
+	SetMeteredNetwork(context.Context, *SetMeteredNetworkRequest) (*Payload, error)
+	SetCaptivePortalNetwork(context.Context, *SetCaptivePortalNetworkRequest) (*Payload, error)
+	UnlockCaptivePortal(context.Context, *UnlockCaptivePortalRequest) (*Payload, error)
+	SetTrustedNetwork(context.Context, *SetTrustedNetworkRequest) (*Payload, error)
+	SetSplitTunnelApp(context.Context, *SetSplitTunnelAppRequest) (*Payload, error)
+	SetSplitTunnelMode(context.Context, *SetSplitTunnelModeRequest) (*Payload, error)
+	SetMetrics(context.Context, *SetMetricsRequest) (*Payload, error)
+	SetRESTAPI(context.Context, *SetRESTAPIRequest) (*Payload, error)
+	SetLocalProxy(context.Context, *SetLocalProxyRequest) (*Payload, error)
+	SaveProfile(context.Context, *SaveProfileRequest) (*Payload, error)
+	RemoveProfile(context.Context, *RemoveProfileRequest) (*Payload, error)
+	Profiles(context.Context, *Empty) (*ProfilesResponse, error)
+	AddAutoConnectRule(context.Context, *AddAutoConnectRuleRequest) (*Payload, error)
+	RemoveAutoConnectRule(context.Context, *RemoveAutoConnectRuleRequest) (*Payload, error)
+	Pause(context.Context, *PauseRequest) (*Payload, error)
+	Resume(context.Context, *Empty) (*Payload, error)
+	mustEmbedUnimplementedDaemonServer()
+}
+
+// UnimplementedDaemonServer must be embedded to have forward compatible implementations.
+type UnimplementedDaemonServer struct {
+}
+
+func (UnimplementedDaemonServer) AccountInfo(context.Context, *Empty) (*AccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
+}
+func (UnimplementedDaemonServer) CredentialsStatus(context.Context, *Empty) (*CredentialsStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialsStatus not implemented")
+}
+func (UnimplementedDaemonServer) TokenInfo(context.Context, *Empty) (*TokenInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenInfo not implemented")
+}
+func (UnimplementedDaemonServer) CacheStats(context.Context, *Empty) (*CacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CacheStats not implemented")
+}
+func (UnimplementedDaemonServer) Cities(context.Context, *CitiesRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cities not implemented")
+}
+func (UnimplementedDaemonServer) Connect(*ConnectRequest, Daemon_ConnectServer) error {
+	return status.Errorf(codes.Unimplemented, "method Connect not implemented")
+}
+func (UnimplementedDaemonServer) ConnectionIPs(context.Context, *Empty) (*ConnectionIPsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionIPs not implemented")
+}
+func (UnimplementedDaemonServer) ConnectionHistory(context.Context, *ConnectionHistoryRequest) (*ConnectionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionHistory not implemented")
+}
+func (UnimplementedDaemonServer) Countries(context.Context, *Empty) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Countries not implemented")
+}
+func (UnimplementedDaemonServer) DedicatedIP(context.Context, *Empty) (*DedicatedIPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DedicatedIP not implemented")
+}
+func (UnimplementedDaemonServer) Disconnect(*Empty, Daemon_DisconnectServer) error {
+	return status.Errorf(codes.Unimplemented, "method Disconnect not implemented")
+}
+func (UnimplementedDaemonServer) ExportWireGuardConfig(context.Context, *ExportWireGuardConfigRequest) (*ExportWireGuardConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportWireGuardConfig not implemented")
+}
+func (UnimplementedDaemonServer) Favorites(context.Context, *Empty) (*FavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Favorites not implemented")
+}
+func (UnimplementedDaemonServer) Groups(context.Context, *Empty) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
+}
+func (UnimplementedDaemonServer) Health(context.Context, *Empty) (*HealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+func (UnimplementedDaemonServer) Diagnose(context.Context, *Empty) (*DiagnoseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Diagnose not implemented")
+}
+func (UnimplementedDaemonServer) ImportFavorites(context.Context, *ImportFavoritesRequest) (*ImportFavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportFavorites not implemented")
+}
+func (UnimplementedDaemonServer) KillSwitchBlocked(context.Context, *Empty) (*KillSwitchBlockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillSwitchBlocked not implemented")
+}
+func (UnimplementedDaemonServer) IsLoggedIn(context.Context, *Empty) (*Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsLoggedIn not implemented")
+}
+func (UnimplementedDaemonServer) LoginWithToken(context.Context, *LoginWithTokenRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginWithToken not implemented")
+}
+func (UnimplementedDaemonServer) LoginOAuth2(*Empty, Daemon_LoginOAuth2Server) error {
+	return status.Errorf(codes.Unimplemented, "method LoginOAuth2 not implemented")
+}
+func (UnimplementedDaemonServer) LoginOAuth2Callback(context.Context, *String) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginOAuth2Callback not implemented")
+}
+func (UnimplementedDaemonServer) Logout(context.Context, *LogoutRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedDaemonServer) MeteredStatus(context.Context, *Empty) (*MeteredStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MeteredStatus not implemented")
+}
+func (UnimplementedDaemonServer) Plans(context.Context, *Empty) (*PlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Plans not implemented")
+}
+func (UnimplementedDaemonServer) PreviewDNS(context.Context, *Empty) (*PreviewDNSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewDNS not implemented")
+}
+func (UnimplementedDaemonServer) Ping(context.Context, *Empty) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedDaemonServer) RateConnection(context.Context, *RateRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateConnection not implemented")
+}
+func (UnimplementedDaemonServer) RebuildFirewall(context.Context, *Empty) (*RebuildFirewallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildFirewall not implemented")
+}
+func (UnimplementedDaemonServer) Reload(context.Context, *Empty) (*ReloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reload not implemented")
+}
+func (UnimplementedDaemonServer) Register(context.Context, *RegisterRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedDaemonServer) RenderOpenVPNConfig(context.Context, *RenderOpenVPNConfigRequest) (*RenderOpenVPNConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderOpenVPNConfig not implemented")
+}
+func (UnimplementedDaemonServer) ServerNotes(context.Context, *Empty) (*ServerNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServerNotes not implemented")
+}
+func (UnimplementedDaemonServer) Servers(context.Context, *ServersRequest) (*ServersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Servers not implemented")
+}
+func (UnimplementedDaemonServer) SetAutoConnect(context.Context, *SetAutoconnectRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoConnect not implemented")
+}
+func (UnimplementedDaemonServer) SetThreatProtectionLite(context.Context, *SetThreatProtectionLiteRequest) (*SetThreatProtectionLiteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetThreatProtectionLite not implemented")
+}
+func (UnimplementedDaemonServer) SetDefaults(context.Context, *Empty) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaults not implemented")
+}
+func (UnimplementedDaemonServer) SetDNS(context.Context, *SetDNSRequest) (*SetDNSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDNS not implemented")
+}
+func (UnimplementedDaemonServer) SetFirewall(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFirewall not implemented")
+}
+func (UnimplementedDaemonServer) SetFirewallMark(context.Context, *SetUint32Request) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFirewallMark not implemented")
+}
+func (UnimplementedDaemonServer) SetRoutingTable(context.Context, *SetUint32Request) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRoutingTable not implemented")
+}
+func (UnimplementedDaemonServer) SetLegacyDNS(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLegacyDNS not implemented")
+}
+func (UnimplementedDaemonServer) SetRouting(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRouting not implemented")
+}
+func (UnimplementedDaemonServer) SetAnalytics(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAnalytics not implemented")
+}
+func (UnimplementedDaemonServer) SetKillSwitch(context.Context, *SetKillSwitchRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKillSwitch not implemented")
+}
+func (UnimplementedDaemonServer) SetKillSwitchLog(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKillSwitchLog not implemented")
+}
+func (UnimplementedDaemonServer) SetKillSwitchLAN(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKillSwitchLAN not implemented")
+}
+func (UnimplementedDaemonServer) SetMulticastDiscovery(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMulticastDiscovery not implemented")
+}
+func (UnimplementedDaemonServer) SetNotify(context.Context, *SetNotifyRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNotify not implemented")
+}
+func (UnimplementedDaemonServer) SetObfuscate(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetObfuscate not implemented")
+}
+func (UnimplementedDaemonServer) SetProtocol(context.Context, *SetProtocolRequest) (*SetProtocolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProtocol not implemented")
+}
+func (UnimplementedDaemonServer) SetTechnology(context.Context, *SetTechnologyRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTechnology not implemented")
+}
+func (UnimplementedDaemonServer) SetAllowedTechnologies(context.Context, *SetAllowedTechnologiesRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllowedTechnologies not implemented")
+}
+func (UnimplementedDaemonServer) SetLANDiscovery(context.Context, *SetLANDiscoveryRequest) (*SetLANDiscoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLANDiscovery not implemented")
+}
+func (UnimplementedDaemonServer) SetAllowlist(context.Context, *SetAllowlistRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllowlist not implemented")
+}
+func (UnimplementedDaemonServer) Settings(context.Context, *SettingsRequest) (*SettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Settings not implemented")
+}
+func (UnimplementedDaemonServer) SettingsProtocols(context.Context, *Empty) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettingsProtocols not implemented")
+}
+func (UnimplementedDaemonServer) SettingsTechnologies(context.Context, *Empty) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettingsTechnologies not implemented")
+}
+func (UnimplementedDaemonServer) Status(context.Context, *Empty) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (UnimplementedDaemonServer) WatchStatus(*Empty, Daemon_WatchStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStatus not implemented")
+}
+func (UnimplementedDaemonServer) SubscribeEvents(*Empty, Daemon_SubscribeEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEvents not implemented")
+}
+func (UnimplementedDaemonServer) TunnelOverhead(context.Context, *TunnelOverheadRequest) (*TunnelOverheadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TunnelOverhead not implemented")
+}
+func (UnimplementedDaemonServer) SetIpv6(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIpv6 not implemented")
+}
+func (UnimplementedDaemonServer) SetIPv6Mode(context.Context, *SetIPv6ModeRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIPv6Mode not implemented")
+}
+func (UnimplementedDaemonServer) SetDefaultRouteMode(context.Context, *SetDefaultRouteModeRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultRouteMode not implemented")
+}
+func (UnimplementedDaemonServer) SetSubnetOverlapMode(context.Context, *SetSubnetOverlapModeRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSubnetOverlapMode not implemented")
+}
+func (UnimplementedDaemonServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedDaemonServer) SetServerSelection(context.Context, *SetServerSelectionRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetServerSelection not implemented")
+}
+func (UnimplementedDaemonServer) SetVirtualLocation(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVirtualLocation not implemented")
+}
+func (UnimplementedDaemonServer) SetDNSMonitor(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDNSMonitor not implemented")
+}
+func (UnimplementedDaemonServer) SetNetworkManager(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNetworkManager not implemented")
+}
+func (UnimplementedDaemonServer) SetObfuscationMode(context.Context, *SetObfuscationModeRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetObfuscationMode not implemented")
+}
+func (UnimplementedDaemonServer) SetInterfaceName(context.Context, *SetInterfaceNameRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInterfaceName not implemented")
+}
+func (UnimplementedDaemonServer) SetMTU(context.Context, *SetMTURequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMTU not implemented")
+}
+func (UnimplementedDaemonServer) SetFirewallBackend(context.Context, *SetFirewallBackendRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFirewallBackend not implemented")
+}
+func (UnimplementedDaemonServer) SetOnDemand(context.Context, *SetOnDemandRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOnDemand not implemented")
+}
+func (UnimplementedDaemonServer) SetFirewallTemplate(context.Context, *SetFirewallTemplateRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFirewallTemplate not implemented")
+}
+func (UnimplementedDaemonServer) SetDNSIPv6(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDNSIPv6 not implemented")
+}
+func (UnimplementedDaemonServer) SetServerPort(context.Context, *SetServerPortRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetServerPort not implemented")
+}
+func (UnimplementedDaemonServer) SetDNSSearchDomains(context.Context, *SetDNSSearchDomainsRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDNSSearchDomains not implemented")
+}
+func (UnimplementedDaemonServer) SetDNSRoute(context.Context, *SetDNSRouteRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDNSRoute not implemented")
+}
+func (UnimplementedDaemonServer) SetDNSFilter(context.Context, *SetDNSFilterRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDNSFilter not implemented")
+}
+func (UnimplementedDaemonServer) SetIdleDisconnect(context.Context, *SetIdleDisconnectRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIdleDisconnect not implemented")
+}
+func (UnimplementedDaemonServer) SetServerNote(context.Context, *SetServerNoteRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetServerNote not implemented")
+}
+func (UnimplementedDaemonServer) SetServerRating(context.Context, *SetServerRatingRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetServerRating not implemented")
+}
+func (UnimplementedDaemonServer) SetRatingWeight(context.Context, *SetUint32Request) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRatingWeight not implemented")
+}
+func (UnimplementedDaemonServer) SetMetered(context.Context, *SetMeteredRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMetered not implemented")
+}
+func (UnimplementedDaemonServer) SetReconnect(context.Context, *SetReconnectRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReconnect not implemented")
+}
+func (UnimplementedDaemonServer) SetMeteredNetwork(context.Context, *SetMeteredNetworkRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMeteredNetwork not implemented")
+}
+func (UnimplementedDaemonServer) SetCaptivePortalNetwork(context.Context, *SetCaptivePortalNetworkRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCaptivePortalNetwork not implemented")
+}
+func (UnimplementedDaemonServer) UnlockCaptivePortal(context.Context, *UnlockCaptivePortalRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockCaptivePortal not implemented")
+}
+func (UnimplementedDaemonServer) SetTrustedNetwork(context.Context, *SetTrustedNetworkRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTrustedNetwork not implemented")
+}
+func (UnimplementedDaemonServer) SetSplitTunnelApp(context.Context, *SetSplitTunnelAppRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSplitTunnelApp not implemented")
+}
+func (UnimplementedDaemonServer) SetSplitTunnelMode(context.Context, *SetSplitTunnelModeRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSplitTunnelMode not implemented")
+}
+func (UnimplementedDaemonServer) SetMetrics(context.Context, *SetMetricsRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMetrics not implemented")
+}
+func (UnimplementedDaemonServer) SetRESTAPI(context.Context, *SetRESTAPIRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRESTAPI not implemented")
+}
+func (UnimplementedDaemonServer) SetLocalProxy(context.Context, *SetLocalProxyRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLocalProxy not implemented")
+}
+func (UnimplementedDaemonServer) SaveProfile(context.Context, *SaveProfileRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveProfile not implemented")
+}
+func (UnimplementedDaemonServer) RemoveProfile(context.Context, *RemoveProfileRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProfile not implemented")
+}
+func (UnimplementedDaemonServer) Profiles(context.Context, *Empty) (*ProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Profiles not implemented")
+}
+func (UnimplementedDaemonServer) AddAutoConnectRule(context.Context, *AddAutoConnectRuleRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAutoConnectRule not implemented")
+}
+func (UnimplementedDaemonServer) RemoveAutoConnectRule(context.Context, *RemoveAutoConnectRuleRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAutoConnectRule not implemented")
+}
+func (UnimplementedDaemonServer) Pause(context.Context, *PauseRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (UnimplementedDaemonServer) Resume(context.Context, *Empty) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
+func (UnimplementedDaemonServer) mustEmbedUnimplementedDaemonServer() {}
+
+// UnsafeDaemonServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DaemonServer will
+// result in compilation errors.
+type UnsafeDaemonServer interface {
+	mustEmbedUnimplementedDaemonServer()
+}
+
+func RegisterDaemonServer(s grpc.ServiceRegistrar, srv DaemonServer) {
+	s.RegisterService(&Daemon_ServiceDesc, srv)
+}
+
+func _Daemon_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).AccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/AccountInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).AccountInfo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_CredentialsStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).CredentialsStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/CredentialsStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).CredentialsStatus(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_TokenInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).TokenInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/TokenInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).TokenInfo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_CacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).CacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/CacheStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).CacheStats(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Cities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Cities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Cities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Cities(ctx, req.(*CitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Connect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ConnectRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).Connect(m, &daemonConnectServer{stream})
+}
+
+type Daemon_ConnectServer interface {
+	Send(*Payload) error
+	grpc.ServerStream
+}
+
+type daemonConnectServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonConnectServer) Send(m *Payload) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Daemon_ConnectionIPs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ConnectionIPs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/ConnectionIPs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ConnectionIPs(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ConnectionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ConnectionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/ConnectionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ConnectionHistory(ctx, req.(*ConnectionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Countries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Countries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Countries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Countries(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_DedicatedIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).DedicatedIP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/DedicatedIP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).DedicatedIP(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Disconnect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).Disconnect(m, &daemonDisconnectServer{stream})
+}
+
+type Daemon_DisconnectServer interface {
+	Send(*Payload) error
+	grpc.ServerStream
+}
+
+type daemonDisconnectServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonDisconnectServer) Send(m *Payload) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Daemon_ExportWireGuardConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWireGuardConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ExportWireGuardConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/ExportWireGuardConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ExportWireGuardConfig(ctx, req.(*ExportWireGuardConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Favorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Favorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Favorites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Favorites(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Groups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Groups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Groups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Groups(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Health(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Diagnose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Diagnose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Diagnose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Diagnose(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ImportFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ImportFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/ImportFavorites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ImportFavorites(ctx, req.(*ImportFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_KillSwitchBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).KillSwitchBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/KillSwitchBlocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).KillSwitchBlocked(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_IsLoggedIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).IsLoggedIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/IsLoggedIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).IsLoggedIn(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_LoginWithToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginWithTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).LoginWithToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/LoginWithToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).LoginWithToken(ctx, req.(*LoginWithTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_LoginOAuth2_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).LoginOAuth2(m, &daemonLoginOAuth2Server{stream})
+}
+
+type Daemon_LoginOAuth2Server interface {
+	Send(*String) error
+	grpc.ServerStream
+}
+
+type daemonLoginOAuth2Server struct {
+	grpc.ServerStream
+}
+
+func (x *daemonLoginOAuth2Server) Send(m *String) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Daemon_LoginOAuth2Callback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(String)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).LoginOAuth2Callback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/LoginOAuth2Callback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).LoginOAuth2Callback(ctx, req.(*String))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_MeteredStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).MeteredStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/MeteredStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).MeteredStatus(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Plans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Plans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Plans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Plans(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_PreviewDNS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).PreviewDNS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/PreviewDNS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).PreviewDNS(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Ping(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_RateConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).RateConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/RateConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).RateConnection(ctx, req.(*RateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_RebuildFirewall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).RebuildFirewall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/RebuildFirewall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).RebuildFirewall(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Reload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Reload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Reload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Reload(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_RenderOpenVPNConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderOpenVPNConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).RenderOpenVPNConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/RenderOpenVPNConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).RenderOpenVPNConfig(ctx, req.(*RenderOpenVPNConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ServerNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ServerNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/ServerNotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ServerNotes(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Servers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Servers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Servers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Servers(ctx, req.(*ServersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetAutoConnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAutoconnectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetAutoConnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetAutoConnect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetAutoConnect(ctx, req.(*SetAutoconnectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetThreatProtectionLite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetThreatProtectionLiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetThreatProtectionLite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetThreatProtectionLite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetThreatProtectionLite(ctx, req.(*SetThreatProtectionLiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetDefaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetDefaults(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetDNS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDNSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetDNS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetDNS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetDNS(ctx, req.(*SetDNSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetFirewall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetFirewall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetFirewall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetFirewall(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetFirewallMark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUint32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetFirewallMark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetFirewallMark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetFirewallMark(ctx, req.(*SetUint32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetRoutingTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUint32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetRoutingTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetRoutingTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetRoutingTable(ctx, req.(*SetUint32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetLegacyDNS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetLegacyDNS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetLegacyDNS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetLegacyDNS(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetRouting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetRouting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetRouting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetRouting(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetAnalytics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetAnalytics(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetKillSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKillSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetKillSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetKillSwitch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetKillSwitch(ctx, req.(*SetKillSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetKillSwitchLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetKillSwitchLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetKillSwitchLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetKillSwitchLog(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetKillSwitchLAN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetKillSwitchLAN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetKillSwitchLAN",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetKillSwitchLAN(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetMulticastDiscovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetMulticastDiscovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetMulticastDiscovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetMulticastDiscovery(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetNotify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNotifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetNotify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetNotify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetNotify(ctx, req.(*SetNotifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetObfuscate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetObfuscate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetObfuscate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetObfuscate(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetProtocol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProtocolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetProtocol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetProtocol",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetProtocol(ctx, req.(*SetProtocolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetTechnology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTechnologyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetTechnology(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetTechnology",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetTechnology(ctx, req.(*SetTechnologyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetAllowedTechnologies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAllowedTechnologiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetAllowedTechnologies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetAllowedTechnologies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetAllowedTechnologies(ctx, req.(*SetAllowedTechnologiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetLANDiscovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLANDiscoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetLANDiscovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetLANDiscovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetLANDiscovery(ctx, req.(*SetLANDiscoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetAllowlist(ctx, req.(*SetAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Settings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Settings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Settings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Settings(ctx, req.(*SettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SettingsProtocols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SettingsProtocols(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SettingsProtocols",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SettingsProtocols(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SettingsTechnologies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SettingsTechnologies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SettingsTechnologies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SettingsTechnologies(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Status(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDaemonServer) SetAllowlist(context.Context, *SetAllowlistRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAllowlist not implemented")
+
+func _Daemon_WatchStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).WatchStatus(m, &daemonWatchStatusServer{stream})
 }
-func (UnimplementedDaemonServer) Settings(context.Context, *SettingsRequest) (*SettingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Settings not implemented")
+
+type Daemon_WatchStatusServer interface {
+	Send(*StatusResponse) error
+	grpc.ServerStream
 }
-func (UnimplementedDaemonServer) SettingsProtocols(context.Context, *Empty) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SettingsProtocols not implemented")
+
+type daemonWatchStatusServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedDaemonServer) SettingsTechnologies(context.Context, *Empty) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SettingsTechnologies not implemented")
+
+func (x *daemonWatchStatusServer) Send(m *StatusResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedDaemonServer) Status(context.Context, *Empty) (*StatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+
+func _Daemon_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).SubscribeEvents(m, &daemonSubscribeEventsServer{stream})
 }
-func (UnimplementedDaemonServer) SetIpv6(context.Context, *SetGenericRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetIpv6 not implemented")
+
+type Daemon_SubscribeEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
 }
-func (UnimplementedDaemonServer) mustEmbedUnimplementedDaemonServer() {}
 
-// UnsafeDaemonServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to DaemonServer will
-// result in compilation errors.
-type UnsafeDaemonServer interface {
-	mustEmbedUnimplementedDaemonServer()
+type daemonSubscribeEventsServer struct {
+	grpc.ServerStream
 }
 
-func RegisterDaemonServer(s grpc.ServiceRegistrar, srv DaemonServer) {
-	s.RegisterService(&Daemon_ServiceDesc, srv)
+func (x *daemonSubscribeEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func _Daemon_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Daemon_TunnelOverhead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TunnelOverheadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).AccountInfo(ctx, in)
+		return srv.(DaemonServer).TunnelOverhead(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/AccountInfo",
+		FullMethod: "/pb.Daemon/TunnelOverhead",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).AccountInfo(ctx, req.(*Empty))
+		return srv.(DaemonServer).TunnelOverhead(ctx, req.(*TunnelOverheadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_TokenInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Daemon_SetIpv6_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).TokenInfo(ctx, in)
+		return srv.(DaemonServer).SetIpv6(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/TokenInfo",
+		FullMethod: "/pb.Daemon/SetIpv6",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).TokenInfo(ctx, req.(*Empty))
+		return srv.(DaemonServer).SetIpv6(ctx, req.(*SetGenericRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Cities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CitiesRequest)
+func _Daemon_SetIPv6Mode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIPv6ModeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).Cities(ctx, in)
+		return srv.(DaemonServer).SetIPv6Mode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/Cities",
+		FullMethod: "/pb.Daemon/SetIPv6Mode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).Cities(ctx, req.(*CitiesRequest))
+		return srv.(DaemonServer).SetIPv6Mode(ctx, req.(*SetIPv6ModeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Connect_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ConnectRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Daemon_SetDefaultRouteMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultRouteModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(DaemonServer).Connect(m, &daemonConnectServer{stream})
-}
-
-type Daemon_ConnectServer interface {
-	Send(*Payload) error
-	grpc.ServerStream
-}
-
-type daemonConnectServer struct {
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(DaemonServer).SetDefaultRouteMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetDefaultRouteMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetDefaultRouteMode(ctx, req.(*SetDefaultRouteModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *daemonConnectServer) Send(m *Payload) error {
-	return x.ServerStream.SendMsg(m)
+func _Daemon_SetSubnetOverlapMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSubnetOverlapModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetSubnetOverlapMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetSubnetOverlapMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetSubnetOverlapMode(ctx, req.(*SetSubnetOverlapModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Countries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Daemon_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).Countries(ctx, in)
+		return srv.(DaemonServer).SetLogLevel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/Countries",
+		FullMethod: "/pb.Daemon/SetLogLevel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).Countries(ctx, req.(*Empty))
+		return srv.(DaemonServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Disconnect_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Daemon_SetServerSelection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetServerSelectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(DaemonServer).Disconnect(m, &daemonDisconnectServer{stream})
+	if interceptor == nil {
+		return srv.(DaemonServer).SetServerSelection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetServerSelection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetServerSelection(ctx, req.(*SetServerSelectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type Daemon_DisconnectServer interface {
-	Send(*Payload) error
-	grpc.ServerStream
+func _Daemon_SetVirtualLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetVirtualLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetVirtualLocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetVirtualLocation(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type daemonDisconnectServer struct {
-	grpc.ServerStream
+func _Daemon_SetDNSMonitor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetDNSMonitor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetDNSMonitor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetDNSMonitor(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *daemonDisconnectServer) Send(m *Payload) error {
-	return x.ServerStream.SendMsg(m)
+func _Daemon_SetNetworkManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetNetworkManager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetNetworkManager",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetNetworkManager(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Groups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Daemon_SetObfuscationMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetObfuscationModeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).Groups(ctx, in)
+		return srv.(DaemonServer).SetObfuscationMode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/Groups",
+		FullMethod: "/pb.Daemon/SetObfuscationMode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).Groups(ctx, req.(*Empty))
+		return srv.(DaemonServer).SetObfuscationMode(ctx, req.(*SetObfuscationModeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_IsLoggedIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Daemon_SetInterfaceName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInterfaceNameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).IsLoggedIn(ctx, in)
+		return srv.(DaemonServer).SetInterfaceName(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/IsLoggedIn",
+		FullMethod: "/pb.Daemon/SetInterfaceName",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).IsLoggedIn(ctx, req.(*Empty))
+		return srv.(DaemonServer).SetInterfaceName(ctx, req.(*SetInterfaceNameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_LoginWithToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginWithTokenRequest)
+func _Daemon_SetMTU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMTURequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).LoginWithToken(ctx, in)
+		return srv.(DaemonServer).SetMTU(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/LoginWithToken",
+		FullMethod: "/pb.Daemon/SetMTU",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).LoginWithToken(ctx, req.(*LoginWithTokenRequest))
+		return srv.(DaemonServer).SetMTU(ctx, req.(*SetMTURequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_LoginOAuth2_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Daemon_SetFirewallBackend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFirewallBackendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(DaemonServer).LoginOAuth2(m, &daemonLoginOAuth2Server{stream})
+	if interceptor == nil {
+		return srv.(DaemonServer).SetFirewallBackend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetFirewallBackend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetFirewallBackend(ctx, req.(*SetFirewallBackendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type Daemon_LoginOAuth2Server interface {
-	Send(*String) error
-	grpc.ServerStream
+func _Daemon_SetOnDemand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOnDemandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetOnDemand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetOnDemand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetOnDemand(ctx, req.(*SetOnDemandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type daemonLoginOAuth2Server struct {
-	grpc.ServerStream
+func _Daemon_SetFirewallTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFirewallTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetFirewallTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetFirewallTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetFirewallTemplate(ctx, req.(*SetFirewallTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *daemonLoginOAuth2Server) Send(m *String) error {
-	return x.ServerStream.SendMsg(m)
+func _Daemon_SetDNSIPv6_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetDNSIPv6(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetDNSIPv6",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetDNSIPv6(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_LoginOAuth2Callback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(String)
+func _Daemon_SetServerPort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetServerPortRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).LoginOAuth2Callback(ctx, in)
+		return srv.(DaemonServer).SetServerPort(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/LoginOAuth2Callback",
+		FullMethod: "/pb.Daemon/SetServerPort",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).LoginOAuth2Callback(ctx, req.(*String))
+		return srv.(DaemonServer).SetServerPort(ctx, req.(*SetServerPortRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogoutRequest)
+func _Daemon_SetDNSSearchDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDNSSearchDomainsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).Logout(ctx, in)
+		return srv.(DaemonServer).SetDNSSearchDomains(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/Logout",
+		FullMethod: "/pb.Daemon/SetDNSSearchDomains",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).Logout(ctx, req.(*LogoutRequest))
+		return srv.(DaemonServer).SetDNSSearchDomains(ctx, req.(*SetDNSSearchDomainsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Plans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Daemon_SetDNSRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDNSRouteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).Plans(ctx, in)
+		return srv.(DaemonServer).SetDNSRoute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/Plans",
+		FullMethod: "/pb.Daemon/SetDNSRoute",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).Plans(ctx, req.(*Empty))
+		return srv.(DaemonServer).SetDNSRoute(ctx, req.(*SetDNSRouteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Daemon_SetDNSFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDNSFilterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).Ping(ctx, in)
+		return srv.(DaemonServer).SetDNSFilter(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/Ping",
+		FullMethod: "/pb.Daemon/SetDNSFilter",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).Ping(ctx, req.(*Empty))
+		return srv.(DaemonServer).SetDNSFilter(ctx, req.(*SetDNSFilterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_RateConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RateRequest)
+func _Daemon_SetIdleDisconnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIdleDisconnectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).RateConnection(ctx, in)
+		return srv.(DaemonServer).SetIdleDisconnect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/RateConnection",
+		FullMethod: "/pb.Daemon/SetIdleDisconnect",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).RateConnection(ctx, req.(*RateRequest))
+		return srv.(DaemonServer).SetIdleDisconnect(ctx, req.(*SetIdleDisconnectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func _Daemon_SetServerNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetServerNoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).Register(ctx, in)
+		return srv.(DaemonServer).SetServerNote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/Register",
+		FullMethod: "/pb.Daemon/SetServerNote",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(DaemonServer).SetServerNote(ctx, req.(*SetServerNoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetAutoConnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetAutoconnectRequest)
+func _Daemon_SetServerRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetServerRatingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetAutoConnect(ctx, in)
+		return srv.(DaemonServer).SetServerRating(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetAutoConnect",
+		FullMethod: "/pb.Daemon/SetServerRating",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetAutoConnect(ctx, req.(*SetAutoconnectRequest))
+		return srv.(DaemonServer).SetServerRating(ctx, req.(*SetServerRatingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetThreatProtectionLite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetThreatProtectionLiteRequest)
+func _Daemon_SetRatingWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUint32Request)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetThreatProtectionLite(ctx, in)
+		return srv.(DaemonServer).SetRatingWeight(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetThreatProtectionLite",
+		FullMethod: "/pb.Daemon/SetRatingWeight",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetThreatProtectionLite(ctx, req.(*SetThreatProtectionLiteRequest))
+		return srv.(DaemonServer).SetRatingWeight(ctx, req.(*SetUint32Request))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Daemon_SetMetered_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMeteredRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetDefaults(ctx, in)
+		return srv.(DaemonServer).SetMetered(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetDefaults",
+		FullMethod: "/pb.Daemon/SetMetered",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetDefaults(ctx, req.(*Empty))
+		return srv.(DaemonServer).SetMetered(ctx, req.(*SetMeteredRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetDNS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetDNSRequest)
+func _Daemon_SetReconnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReconnectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetDNS(ctx, in)
+		return srv.(DaemonServer).SetReconnect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetDNS",
+		FullMethod: "/pb.Daemon/SetReconnect",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetDNS(ctx, req.(*SetDNSRequest))
+		return srv.(DaemonServer).SetReconnect(ctx, req.(*SetReconnectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetFirewall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetGenericRequest)
+func _Daemon_SetMeteredNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMeteredNetworkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetFirewall(ctx, in)
+		return srv.(DaemonServer).SetMeteredNetwork(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetFirewall",
+		FullMethod: "/pb.Daemon/SetMeteredNetwork",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetFirewall(ctx, req.(*SetGenericRequest))
+		return srv.(DaemonServer).SetMeteredNetwork(ctx, req.(*SetMeteredNetworkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetFirewallMark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetUint32Request)
+func _Daemon_SetCaptivePortalNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCaptivePortalNetworkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetFirewallMark(ctx, in)
+		return srv.(DaemonServer).SetCaptivePortalNetwork(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetFirewallMark",
+		FullMethod: "/pb.Daemon/SetCaptivePortalNetwork",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetFirewallMark(ctx, req.(*SetUint32Request))
+		return srv.(DaemonServer).SetCaptivePortalNetwork(ctx, req.(*SetCaptivePortalNetworkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetRouting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetGenericRequest)
+func _Daemon_UnlockCaptivePortal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockCaptivePortalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetRouting(ctx, in)
+		return srv.(DaemonServer).UnlockCaptivePortal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetRouting",
+		FullMethod: "/pb.Daemon/UnlockCaptivePortal",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetRouting(ctx, req.(*SetGenericRequest))
+		return srv.(DaemonServer).UnlockCaptivePortal(ctx, req.(*UnlockCaptivePortalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetGenericRequest)
+func _Daemon_SetTrustedNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTrustedNetworkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetAnalytics(ctx, in)
+		return srv.(DaemonServer).SetTrustedNetwork(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetAnalytics",
+		FullMethod: "/pb.Daemon/SetTrustedNetwork",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetAnalytics(ctx, req.(*SetGenericRequest))
+		return srv.(DaemonServer).SetTrustedNetwork(ctx, req.(*SetTrustedNetworkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetKillSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetKillSwitchRequest)
+func _Daemon_SetSplitTunnelApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSplitTunnelAppRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetKillSwitch(ctx, in)
+		return srv.(DaemonServer).SetSplitTunnelApp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetKillSwitch",
+		FullMethod: "/pb.Daemon/SetSplitTunnelApp",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetKillSwitch(ctx, req.(*SetKillSwitchRequest))
+		return srv.(DaemonServer).SetSplitTunnelApp(ctx, req.(*SetSplitTunnelAppRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetNotify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetNotifyRequest)
+func _Daemon_SetSplitTunnelMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSplitTunnelModeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetNotify(ctx, in)
+		return srv.(DaemonServer).SetSplitTunnelMode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetNotify",
+		FullMethod: "/pb.Daemon/SetSplitTunnelMode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetNotify(ctx, req.(*SetNotifyRequest))
+		return srv.(DaemonServer).SetSplitTunnelMode(ctx, req.(*SetSplitTunnelModeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetObfuscate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetGenericRequest)
+func _Daemon_SetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMetricsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetObfuscate(ctx, in)
+		return srv.(DaemonServer).SetMetrics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetObfuscate",
+		FullMethod: "/pb.Daemon/SetMetrics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetObfuscate(ctx, req.(*SetGenericRequest))
+		return srv.(DaemonServer).SetMetrics(ctx, req.(*SetMetricsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetProtocol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetProtocolRequest)
+func _Daemon_SetRESTAPI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRESTAPIRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetProtocol(ctx, in)
+		return srv.(DaemonServer).SetRESTAPI(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetProtocol",
+		FullMethod: "/pb.Daemon/SetRESTAPI",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetProtocol(ctx, req.(*SetProtocolRequest))
+		return srv.(DaemonServer).SetRESTAPI(ctx, req.(*SetRESTAPIRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetTechnology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetTechnologyRequest)
+func _Daemon_SetLocalProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLocalProxyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetTechnology(ctx, in)
+		return srv.(DaemonServer).SetLocalProxy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetTechnology",
+		FullMethod: "/pb.Daemon/SetLocalProxy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetTechnology(ctx, req.(*SetTechnologyRequest))
+		return srv.(DaemonServer).SetLocalProxy(ctx, req.(*SetLocalProxyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetLANDiscovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetLANDiscoveryRequest)
+func _Daemon_SaveProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetLANDiscovery(ctx, in)
+		return srv.(DaemonServer).SaveProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetLANDiscovery",
+		FullMethod: "/pb.Daemon/SaveProfile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetLANDiscovery(ctx, req.(*SetLANDiscoveryRequest))
+		return srv.(DaemonServer).SaveProfile(ctx, req.(*SaveProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetAllowlistRequest)
+func _Daemon_RemoveProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetAllowlist(ctx, in)
+		return srv.(DaemonServer).RemoveProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetAllowlist",
+		FullMethod: "/pb.Daemon/RemoveProfile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetAllowlist(ctx, req.(*SetAllowlistRequest))
+		return srv.(DaemonServer).RemoveProfile(ctx, req.(*RemoveProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Settings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SettingsRequest)
+func _Daemon_Profiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).Settings(ctx, in)
+		return srv.(DaemonServer).Profiles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/Settings",
+		FullMethod: "/pb.Daemon/Profiles",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).Settings(ctx, req.(*SettingsRequest))
+		return srv.(DaemonServer).Profiles(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SettingsProtocols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Daemon_AddAutoConnectRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAutoConnectRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SettingsProtocols(ctx, in)
+		return srv.(DaemonServer).AddAutoConnectRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SettingsProtocols",
+		FullMethod: "/pb.Daemon/AddAutoConnectRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SettingsProtocols(ctx, req.(*Empty))
+		return srv.(DaemonServer).AddAutoConnectRule(ctx, req.(*AddAutoConnectRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SettingsTechnologies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Daemon_RemoveAutoConnectRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAutoConnectRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SettingsTechnologies(ctx, in)
+		return srv.(DaemonServer).RemoveAutoConnectRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SettingsTechnologies",
+		FullMethod: "/pb.Daemon/RemoveAutoConnectRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SettingsTechnologies(ctx, req.(*Empty))
+		return srv.(DaemonServer).RemoveAutoConnectRule(ctx, req.(*RemoveAutoConnectRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Daemon_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).Status(ctx, in)
+		return srv.(DaemonServer).Pause(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/Status",
+		FullMethod: "/pb.Daemon/Pause",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).Status(ctx, req.(*Empty))
+		return srv.(DaemonServer).Pause(ctx, req.(*PauseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetIpv6_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetGenericRequest)
+func _Daemon_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetIpv6(ctx, in)
+		return srv.(DaemonServer).Resume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetIpv6",
+		FullMethod: "/pb.Daemon/Resume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetIpv6(ctx, req.(*SetGenericRequest))
+		return srv.(DaemonServer).Resume(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1297,22 +3531,66 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Daemon_AccountInfo_Handler,
 		},
+		{
+			MethodName: "CredentialsStatus",
+			Handler:    _Daemon_CredentialsStatus_Handler,
+		},
 		{
 			MethodName: "TokenInfo",
 			Handler:    _Daemon_TokenInfo_Handler,
 		},
+		{
+			MethodName: "CacheStats",
+			Handler:    _Daemon_CacheStats_Handler,
+		},
 		{
 			MethodName: "Cities",
 			Handler:    _Daemon_Cities_Handler,
 		},
+		{
+			MethodName: "ConnectionIPs",
+			Handler:    _Daemon_ConnectionIPs_Handler,
+		},
+		{
+			MethodName: "ConnectionHistory",
+			Handler:    _Daemon_ConnectionHistory_Handler,
+		},
 		{
 			MethodName: "Countries",
 			Handler:    _Daemon_Countries_Handler,
 		},
+		{
+			MethodName: "DedicatedIP",
+			Handler:    _Daemon_DedicatedIP_Handler,
+		},
+		{
+			MethodName: "ExportWireGuardConfig",
+			Handler:    _Daemon_ExportWireGuardConfig_Handler,
+		},
+		{
+			MethodName: "Favorites",
+			Handler:    _Daemon_Favorites_Handler,
+		},
 		{
 			MethodName: "Groups",
 			Handler:    _Daemon_Groups_Handler,
 		},
+		{
+			MethodName: "Health",
+			Handler:    _Daemon_Health_Handler,
+		},
+		{
+			MethodName: "Diagnose",
+			Handler:    _Daemon_Diagnose_Handler,
+		},
+		{
+			MethodName: "ImportFavorites",
+			Handler:    _Daemon_ImportFavorites_Handler,
+		},
+		{
+			MethodName: "KillSwitchBlocked",
+			Handler:    _Daemon_KillSwitchBlocked_Handler,
+		},
 		{
 			MethodName: "IsLoggedIn",
 			Handler:    _Daemon_IsLoggedIn_Handler,
@@ -1329,10 +3607,18 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Logout",
 			Handler:    _Daemon_Logout_Handler,
 		},
+		{
+			MethodName: "MeteredStatus",
+			Handler:    _Daemon_MeteredStatus_Handler,
+		},
 		{
 			MethodName: "Plans",
 			Handler:    _Daemon_Plans_Handler,
 		},
+		{
+			MethodName: "PreviewDNS",
+			Handler:    _Daemon_PreviewDNS_Handler,
+		},
 		{
 			MethodName: "Ping",
 			Handler:    _Daemon_Ping_Handler,
@@ -1341,10 +3627,30 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RateConnection",
 			Handler:    _Daemon_RateConnection_Handler,
 		},
+		{
+			MethodName: "RebuildFirewall",
+			Handler:    _Daemon_RebuildFirewall_Handler,
+		},
+		{
+			MethodName: "Reload",
+			Handler:    _Daemon_Reload_Handler,
+		},
 		{
 			MethodName: "Register",
 			Handler:    _Daemon_Register_Handler,
 		},
+		{
+			MethodName: "RenderOpenVPNConfig",
+			Handler:    _Daemon_RenderOpenVPNConfig_Handler,
+		},
+		{
+			MethodName: "ServerNotes",
+			Handler:    _Daemon_ServerNotes_Handler,
+		},
+		{
+			MethodName: "Servers",
+			Handler:    _Daemon_Servers_Handler,
+		},
 		{
 			MethodName: "SetAutoConnect",
 			Handler:    _Daemon_SetAutoConnect_Handler,
@@ -1369,6 +3675,14 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetFirewallMark",
 			Handler:    _Daemon_SetFirewallMark_Handler,
 		},
+		{
+			MethodName: "SetRoutingTable",
+			Handler:    _Daemon_SetRoutingTable_Handler,
+		},
+		{
+			MethodName: "SetLegacyDNS",
+			Handler:    _Daemon_SetLegacyDNS_Handler,
+		},
 		{
 			MethodName: "SetRouting",
 			Handler:    _Daemon_SetRouting_Handler,
@@ -1381,6 +3695,18 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetKillSwitch",
 			Handler:    _Daemon_SetKillSwitch_Handler,
 		},
+		{
+			MethodName: "SetKillSwitchLog",
+			Handler:    _Daemon_SetKillSwitchLog_Handler,
+		},
+		{
+			MethodName: "SetKillSwitchLAN",
+			Handler:    _Daemon_SetKillSwitchLAN_Handler,
+		},
+		{
+			MethodName: "SetMulticastDiscovery",
+			Handler:    _Daemon_SetMulticastDiscovery_Handler,
+		},
 		{
 			MethodName: "SetNotify",
 			Handler:    _Daemon_SetNotify_Handler,
@@ -1397,6 +3723,10 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetTechnology",
 			Handler:    _Daemon_SetTechnology_Handler,
 		},
+		{
+			MethodName: "SetAllowedTechnologies",
+			Handler:    _Daemon_SetAllowedTechnologies_Handler,
+		},
 		{
 			MethodName: "SetLANDiscovery",
 			Handler:    _Daemon_SetLANDiscovery_Handler,
@@ -1421,10 +3751,178 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _Daemon_Status_Handler,
 		},
+		{
+			MethodName: "TunnelOverhead",
+			Handler:    _Daemon_TunnelOverhead_Handler,
+		},
 		{
 			MethodName: "SetIpv6",
 			Handler:    _Daemon_SetIpv6_Handler,
 		},
+		{
+			MethodName: "SetIPv6Mode",
+			Handler:    _Daemon_SetIPv6Mode_Handler,
+		},
+		{
+			MethodName: "SetDefaultRouteMode",
+			Handler:    _Daemon_SetDefaultRouteMode_Handler,
+		},
+		{
+			MethodName: "SetSubnetOverlapMode",
+			Handler:    _Daemon_SetSubnetOverlapMode_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _Daemon_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "SetServerSelection",
+			Handler:    _Daemon_SetServerSelection_Handler,
+		},
+		{
+			MethodName: "SetVirtualLocation",
+			Handler:    _Daemon_SetVirtualLocation_Handler,
+		},
+		{
+			MethodName: "SetDNSMonitor",
+			Handler:    _Daemon_SetDNSMonitor_Handler,
+		},
+		{
+			MethodName: "SetNetworkManager",
+			Handler:    _Daemon_SetNetworkManager_Handler,
+		},
+		{
+			MethodName: "SetObfuscationMode",
+			Handler:    _Daemon_SetObfuscationMode_Handler,
+		},
+		{
+			MethodName: "SetInterfaceName",
+			Handler:    _Daemon_SetInterfaceName_Handler,
+		},
+		{
+			MethodName: "SetMTU",
+			Handler:    _Daemon_SetMTU_Handler,
+		},
+		{
+			MethodName: "SetFirewallBackend",
+			Handler:    _Daemon_SetFirewallBackend_Handler,
+		},
+		{
+			MethodName: "SetOnDemand",
+			Handler:    _Daemon_SetOnDemand_Handler,
+		},
+		{
+			MethodName: "SetFirewallTemplate",
+			Handler:    _Daemon_SetFirewallTemplate_Handler,
+		},
+		{
+			MethodName: "SetDNSIPv6",
+			Handler:    _Daemon_SetDNSIPv6_Handler,
+		},
+		{
+			MethodName: "SetServerPort",
+			Handler:    _Daemon_SetServerPort_Handler,
+		},
+		{
+			MethodName: "SetDNSSearchDomains",
+			Handler:    _Daemon_SetDNSSearchDomains_Handler,
+		},
+		{
+			MethodName: "SetDNSRoute",
+			Handler:    _Daemon_SetDNSRoute_Handler,
+		},
+		{
+			MethodName: "SetDNSFilter",
+			Handler:    _Daemon_SetDNSFilter_Handler,
+		},
+		{
+			MethodName: "SetIdleDisconnect",
+			Handler:    _Daemon_SetIdleDisconnect_Handler,
+		},
+		{
+			MethodName: "SetServerNote",
+			Handler:    _Daemon_SetServerNote_Handler,
+		},
+		{
+			MethodName: "SetServerRating",
+			Handler:    _Daemon_SetServerRating_Handler,
+		},
+		{
+			MethodName: "SetRatingWeight",
+			Handler:    _Daemon_SetRatingWeight_Handler,
+		},
+		{
+			MethodName: "SetMetered",
+			Handler:    _Daemon_SetMetered_Handler,
+		},
+		{
+			MethodName: "SetReconnect",
+			Handler:    _Daemon_SetReconnect_Handler,
+		},
+		{
+			MethodName: "SetMeteredNetwork",
+			Handler:    _Daemon_SetMeteredNetwork_Handler,
+		},
+		{
+			MethodName: "SetCaptivePortalNetwork",
+			Handler:    _Daemon_SetCaptivePortalNetwork_Handler,
+		},
+		{
+			MethodName: "UnlockCaptivePortal",
+			Handler:    _Daemon_UnlockCaptivePortal_Handler,
+		},
+		{
+			MethodName: "SetTrustedNetwork",
+			Handler:    _Daemon_SetTrustedNetwork_Handler,
+		},
+		{
+			MethodName: "SetSplitTunnelApp",
+			Handler:    _Daemon_SetSplitTunnelApp_Handler,
+		},
+		{
+			MethodName: "SetSplitTunnelMode",
+			Handler:    _Daemon_SetSplitTunnelMode_Handler,
+		},
+		{
+			MethodName: "SetMetrics",
+			Handler:    _Daemon_SetMetrics_Handler,
+		},
+		{
+			MethodName: "SetRESTAPI",
+			Handler:    _Daemon_SetRESTAPI_Handler,
+		},
+		{
+			MethodName: "SetLocalProxy",
+			Handler:    _Daemon_SetLocalProxy_Handler,
+		},
+		{
+			MethodName: "SaveProfile",
+			Handler:    _Daemon_SaveProfile_Handler,
+		},
+		{
+			MethodName: "RemoveProfile",
+			Handler:    _Daemon_RemoveProfile_Handler,
+		},
+		{
+			MethodName: "Profiles",
+			Handler:    _Daemon_Profiles_Handler,
+		},
+		{
+			MethodName: "AddAutoConnectRule",
+			Handler:    _Daemon_AddAutoConnectRule_Handler,
+		},
+		{
+			MethodName: "RemoveAutoConnectRule",
+			Handler:    _Daemon_RemoveAutoConnectRule_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _Daemon_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _Daemon_Resume_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1442,6 +3940,16 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Daemon_LoginOAuth2_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchStatus",
+			Handler:       _Daemon_WatchStatus_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeEvents",
+			Handler:       _Daemon_SubscribeEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "service.proto",
 }
@@ -263,6 +263,395 @@ func (SetLANDiscoveryStatus) EnumDescriptor() ([]byte, []int) {
 	return file_set_proto_rawDescGZIP(), []int{4}
 }
 
+type DefaultRouteMode int32
+
+const (
+	DefaultRouteMode_REPLACE DefaultRouteMode = 0
+	DefaultRouteMode_COEXIST DefaultRouteMode = 1
+	DefaultRouteMode_REFUSE  DefaultRouteMode = 2
+)
+
+// Enum value maps for DefaultRouteMode.
+var (
+	DefaultRouteMode_name = map[int32]string{
+		0: "REPLACE",
+		1: "COEXIST",
+		2: "REFUSE",
+	}
+	DefaultRouteMode_value = map[string]int32{
+		"REPLACE": 0,
+		"COEXIST": 1,
+		"REFUSE":  2,
+	}
+)
+
+func (x DefaultRouteMode) Enum() *DefaultRouteMode {
+	p := new(DefaultRouteMode)
+	*p = x
+	return p
+}
+
+func (x DefaultRouteMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DefaultRouteMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_set_proto_enumTypes[5].Descriptor()
+}
+
+func (DefaultRouteMode) Type() protoreflect.EnumType {
+	return &file_set_proto_enumTypes[5]
+}
+
+func (x DefaultRouteMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DefaultRouteMode.Descriptor instead.
+func (DefaultRouteMode) EnumDescriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{5}
+}
+
+type IPv6Mode int32
+
+const (
+	IPv6Mode_IPV6_MODE_BLOCK  IPv6Mode = 0
+	IPv6Mode_IPV6_MODE_TUNNEL IPv6Mode = 1
+	IPv6Mode_IPV6_MODE_NATIVE IPv6Mode = 2
+)
+
+// Enum value maps for IPv6Mode.
+var (
+	IPv6Mode_name = map[int32]string{
+		0: "IPV6_MODE_BLOCK",
+		1: "IPV6_MODE_TUNNEL",
+		2: "IPV6_MODE_NATIVE",
+	}
+	IPv6Mode_value = map[string]int32{
+		"IPV6_MODE_BLOCK":  0,
+		"IPV6_MODE_TUNNEL": 1,
+		"IPV6_MODE_NATIVE": 2,
+	}
+)
+
+func (x IPv6Mode) Enum() *IPv6Mode {
+	p := new(IPv6Mode)
+	*p = x
+	return p
+}
+
+func (x IPv6Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IPv6Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_set_proto_enumTypes[6].Descriptor()
+}
+
+func (IPv6Mode) Type() protoreflect.EnumType {
+	return &file_set_proto_enumTypes[6]
+}
+
+func (x IPv6Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IPv6Mode.Descriptor instead.
+func (IPv6Mode) EnumDescriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{6}
+}
+
+type FirewallBackend int32
+
+const (
+	FirewallBackend_IPTABLES FirewallBackend = 0
+	FirewallBackend_NFTABLES FirewallBackend = 1
+)
+
+// Enum value maps for FirewallBackend.
+var (
+	FirewallBackend_name = map[int32]string{
+		0: "IPTABLES",
+		1: "NFTABLES",
+	}
+	FirewallBackend_value = map[string]int32{
+		"IPTABLES": 0,
+		"NFTABLES": 1,
+	}
+)
+
+func (x FirewallBackend) Enum() *FirewallBackend {
+	p := new(FirewallBackend)
+	*p = x
+	return p
+}
+
+func (x FirewallBackend) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FirewallBackend) Descriptor() protoreflect.EnumDescriptor {
+	return file_set_proto_enumTypes[7].Descriptor()
+}
+
+func (FirewallBackend) Type() protoreflect.EnumType {
+	return &file_set_proto_enumTypes[7]
+}
+
+func (x FirewallBackend) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FirewallBackend.Descriptor instead.
+func (FirewallBackend) EnumDescriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{7}
+}
+
+type SubnetOverlapMode int32
+
+const (
+	SubnetOverlapMode_WARN       SubnetOverlapMode = 0
+	SubnetOverlapMode_PREFER_LAN SubnetOverlapMode = 1
+	SubnetOverlapMode_PREFER_VPN SubnetOverlapMode = 2
+)
+
+// Enum value maps for SubnetOverlapMode.
+var (
+	SubnetOverlapMode_name = map[int32]string{
+		0: "WARN",
+		1: "PREFER_LAN",
+		2: "PREFER_VPN",
+	}
+	SubnetOverlapMode_value = map[string]int32{
+		"WARN":       0,
+		"PREFER_LAN": 1,
+		"PREFER_VPN": 2,
+	}
+)
+
+func (x SubnetOverlapMode) Enum() *SubnetOverlapMode {
+	p := new(SubnetOverlapMode)
+	*p = x
+	return p
+}
+
+func (x SubnetOverlapMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubnetOverlapMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_set_proto_enumTypes[8].Descriptor()
+}
+
+func (SubnetOverlapMode) Type() protoreflect.EnumType {
+	return &file_set_proto_enumTypes[8]
+}
+
+func (x SubnetOverlapMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubnetOverlapMode.Descriptor instead.
+func (SubnetOverlapMode) EnumDescriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{8}
+}
+
+type LogLevel int32
+
+const (
+	LogLevel_LOG_LEVEL_INFO  LogLevel = 0
+	LogLevel_LOG_LEVEL_DEBUG LogLevel = 1
+	LogLevel_LOG_LEVEL_WARN  LogLevel = 2
+)
+
+// Enum value maps for LogLevel.
+var (
+	LogLevel_name = map[int32]string{
+		0: "LOG_LEVEL_INFO",
+		1: "LOG_LEVEL_DEBUG",
+		2: "LOG_LEVEL_WARN",
+	}
+	LogLevel_value = map[string]int32{
+		"LOG_LEVEL_INFO":  0,
+		"LOG_LEVEL_DEBUG": 1,
+		"LOG_LEVEL_WARN":  2,
+	}
+)
+
+func (x LogLevel) Enum() *LogLevel {
+	p := new(LogLevel)
+	*p = x
+	return p
+}
+
+func (x LogLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LogLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_set_proto_enumTypes[9].Descriptor()
+}
+
+func (LogLevel) Type() protoreflect.EnumType {
+	return &file_set_proto_enumTypes[9]
+}
+
+func (x LogLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LogLevel.Descriptor instead.
+func (LogLevel) EnumDescriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{9}
+}
+
+type ServerSelection int32
+
+const (
+	ServerSelection_SERVER_SELECTION_LOAD    ServerSelection = 0
+	ServerSelection_SERVER_SELECTION_LATENCY ServerSelection = 1
+	ServerSelection_SERVER_SELECTION_AUTO    ServerSelection = 2
+)
+
+// Enum value maps for ServerSelection.
+var (
+	ServerSelection_name = map[int32]string{
+		0: "SERVER_SELECTION_LOAD",
+		1: "SERVER_SELECTION_LATENCY",
+		2: "SERVER_SELECTION_AUTO",
+	}
+	ServerSelection_value = map[string]int32{
+		"SERVER_SELECTION_LOAD":    0,
+		"SERVER_SELECTION_LATENCY": 1,
+		"SERVER_SELECTION_AUTO":    2,
+	}
+)
+
+func (x ServerSelection) Enum() *ServerSelection {
+	p := new(ServerSelection)
+	*p = x
+	return p
+}
+
+func (x ServerSelection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServerSelection) Descriptor() protoreflect.EnumDescriptor {
+	return file_set_proto_enumTypes[10].Descriptor()
+}
+
+func (ServerSelection) Type() protoreflect.EnumType {
+	return &file_set_proto_enumTypes[10]
+}
+
+func (x ServerSelection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ServerSelection.Descriptor instead.
+func (ServerSelection) EnumDescriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{10}
+}
+
+type ObfuscationMode int32
+
+const (
+	ObfuscationMode_OBFUSCATION_MODE_XOR       ObfuscationMode = 0
+	ObfuscationMode_OBFUSCATION_MODE_TLS       ObfuscationMode = 1
+	ObfuscationMode_OBFUSCATION_MODE_WEBSOCKET ObfuscationMode = 2
+)
+
+// Enum value maps for ObfuscationMode.
+var (
+	ObfuscationMode_name = map[int32]string{
+		0: "OBFUSCATION_MODE_XOR",
+		1: "OBFUSCATION_MODE_TLS",
+		2: "OBFUSCATION_MODE_WEBSOCKET",
+	}
+	ObfuscationMode_value = map[string]int32{
+		"OBFUSCATION_MODE_XOR":       0,
+		"OBFUSCATION_MODE_TLS":       1,
+		"OBFUSCATION_MODE_WEBSOCKET": 2,
+	}
+)
+
+func (x ObfuscationMode) Enum() *ObfuscationMode {
+	p := new(ObfuscationMode)
+	*p = x
+	return p
+}
+
+func (x ObfuscationMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ObfuscationMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_set_proto_enumTypes[11].Descriptor()
+}
+
+func (ObfuscationMode) Type() protoreflect.EnumType {
+	return &file_set_proto_enumTypes[11]
+}
+
+func (x ObfuscationMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ObfuscationMode.Descriptor instead.
+func (ObfuscationMode) EnumDescriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{11}
+}
+
+type FirewallTemplateStage int32
+
+const (
+	FirewallTemplateStage_TEMPLATE_PERSISTENT      FirewallTemplateStage = 0
+	FirewallTemplateStage_TEMPLATE_PRE_CONNECT     FirewallTemplateStage = 1
+	FirewallTemplateStage_TEMPLATE_POST_DISCONNECT FirewallTemplateStage = 2
+)
+
+// Enum value maps for FirewallTemplateStage.
+var (
+	FirewallTemplateStage_name = map[int32]string{
+		0: "TEMPLATE_PERSISTENT",
+		1: "TEMPLATE_PRE_CONNECT",
+		2: "TEMPLATE_POST_DISCONNECT",
+	}
+	FirewallTemplateStage_value = map[string]int32{
+		"TEMPLATE_PERSISTENT":      0,
+		"TEMPLATE_PRE_CONNECT":     1,
+		"TEMPLATE_POST_DISCONNECT": 2,
+	}
+)
+
+func (x FirewallTemplateStage) Enum() *FirewallTemplateStage {
+	p := new(FirewallTemplateStage)
+	*p = x
+	return p
+}
+
+func (x FirewallTemplateStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FirewallTemplateStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_set_proto_enumTypes[12].Descriptor()
+}
+
+func (FirewallTemplateStage) Type() protoreflect.EnumType {
+	return &file_set_proto_enumTypes[12]
+}
+
+func (x FirewallTemplateStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FirewallTemplateStage.Descriptor instead.
+func (FirewallTemplateStage) EnumDescriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{12}
+}
+
 type SetAutoconnectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -481,7 +870,6 @@ type SetThreatProtectionLiteResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
-	//
 	//	*SetThreatProtectionLiteResponse_ErrorCode
 	//	*SetThreatProtectionLiteResponse_SetThreatProtectionLiteStatus
 	Response isSetThreatProtectionLiteResponse_Response `protobuf_oneof:"response"`
@@ -618,7 +1006,6 @@ type SetDNSResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
-	//
 	//	*SetDNSResponse_ErrorCode
 	//	*SetDNSResponse_SetDnsStatus
 	Response isSetDNSResponse_Response `protobuf_oneof:"response"`
@@ -693,17 +1080,17 @@ func (*SetDNSResponse_ErrorCode) isSetDNSResponse_Response() {}
 
 func (*SetDNSResponse_SetDnsStatus) isSetDNSResponse_Response() {}
 
-type SetKillSwitchRequest struct {
+type SetDNSSearchDomainsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KillSwitch bool       `protobuf:"varint,2,opt,name=kill_switch,json=killSwitch,proto3" json:"kill_switch,omitempty"`
-	Allowlist  *Allowlist `protobuf:"bytes,3,opt,name=allowlist,proto3" json:"allowlist,omitempty"`
+	// domains appended to the resolver search list, empty removes them
+	Domains []string `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
 }
 
-func (x *SetKillSwitchRequest) Reset() {
-	*x = SetKillSwitchRequest{}
+func (x *SetDNSSearchDomainsRequest) Reset() {
+	*x = SetDNSSearchDomainsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_set_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -711,13 +1098,13 @@ func (x *SetKillSwitchRequest) Reset() {
 	}
 }
 
-func (x *SetKillSwitchRequest) String() string {
+func (x *SetDNSSearchDomainsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetKillSwitchRequest) ProtoMessage() {}
+func (*SetDNSSearchDomainsRequest) ProtoMessage() {}
 
-func (x *SetKillSwitchRequest) ProtoReflect() protoreflect.Message {
+func (x *SetDNSSearchDomainsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_set_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -729,36 +1116,29 @@ func (x *SetKillSwitchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetKillSwitchRequest.ProtoReflect.Descriptor instead.
-func (*SetKillSwitchRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetDNSSearchDomainsRequest.ProtoReflect.Descriptor instead.
+func (*SetDNSSearchDomainsRequest) Descriptor() ([]byte, []int) {
 	return file_set_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *SetKillSwitchRequest) GetKillSwitch() bool {
-	if x != nil {
-		return x.KillSwitch
-	}
-	return false
-}
-
-func (x *SetKillSwitchRequest) GetAllowlist() *Allowlist {
+func (x *SetDNSSearchDomainsRequest) GetDomains() []string {
 	if x != nil {
-		return x.Allowlist
+		return x.Domains
 	}
 	return nil
 }
 
-type SetNotifyRequest struct {
+type SetDNSFilterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid    int64 `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	Notify bool  `protobuf:"varint,3,opt,name=notify,proto3" json:"notify,omitempty"`
+	// record types answered locally with an empty answer, empty disables the filter
+	Types []string `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
 }
 
-func (x *SetNotifyRequest) Reset() {
-	*x = SetNotifyRequest{}
+func (x *SetDNSFilterRequest) Reset() {
+	*x = SetDNSFilterRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_set_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -766,13 +1146,13 @@ func (x *SetNotifyRequest) Reset() {
 	}
 }
 
-func (x *SetNotifyRequest) String() string {
+func (x *SetDNSFilterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetNotifyRequest) ProtoMessage() {}
+func (*SetDNSFilterRequest) ProtoMessage() {}
 
-func (x *SetNotifyRequest) ProtoReflect() protoreflect.Message {
+func (x *SetDNSFilterRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_set_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -784,35 +1164,34 @@ func (x *SetNotifyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetNotifyRequest.ProtoReflect.Descriptor instead.
-func (*SetNotifyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetDNSFilterRequest.ProtoReflect.Descriptor instead.
+func (*SetDNSFilterRequest) Descriptor() ([]byte, []int) {
 	return file_set_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SetNotifyRequest) GetUid() int64 {
-	if x != nil {
-		return x.Uid
-	}
-	return 0
-}
-
-func (x *SetNotifyRequest) GetNotify() bool {
+func (x *SetDNSFilterRequest) GetTypes() []string {
 	if x != nil {
-		return x.Notify
+		return x.Types
 	}
-	return false
+	return nil
 }
 
-type SetProtocolRequest struct {
+type DNSRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Protocol config.Protocol `protobuf:"varint,2,opt,name=protocol,proto3,enum=config.Protocol" json:"protocol,omitempty"`
+	// domain whose queries, including the ones of its subdomains, are sent to
+	// the nameserver
+	Domain     string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Nameserver string `protobuf:"bytes,2,opt,name=nameserver,proto3" json:"nameserver,omitempty"`
+	// via_tunnel queries the nameserver through the tunnel instead of the
+	// physical network
+	ViaTunnel bool `protobuf:"varint,3,opt,name=via_tunnel,json=viaTunnel,proto3" json:"via_tunnel,omitempty"`
 }
 
-func (x *SetProtocolRequest) Reset() {
-	*x = SetProtocolRequest{}
+func (x *DNSRoute) Reset() {
+	*x = DNSRoute{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_set_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -820,13 +1199,13 @@ func (x *SetProtocolRequest) Reset() {
 	}
 }
 
-func (x *SetProtocolRequest) String() string {
+func (x *DNSRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetProtocolRequest) ProtoMessage() {}
+func (*DNSRoute) ProtoMessage() {}
 
-func (x *SetProtocolRequest) ProtoReflect() protoreflect.Message {
+func (x *DNSRoute) ProtoReflect() protoreflect.Message {
 	mi := &file_set_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -838,11 +1217,238 @@ func (x *SetProtocolRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetProtocolRequest.ProtoReflect.Descriptor instead.
-func (*SetProtocolRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DNSRoute.ProtoReflect.Descriptor instead.
+func (*DNSRoute) Descriptor() ([]byte, []int) {
 	return file_set_proto_rawDescGZIP(), []int{9}
 }
 
+func (x *DNSRoute) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *DNSRoute) GetNameserver() string {
+	if x != nil {
+		return x.Nameserver
+	}
+	return ""
+}
+
+func (x *DNSRoute) GetViaTunnel() bool {
+	if x != nil {
+		return x.ViaTunnel
+	}
+	return false
+}
+
+type SetDNSRouteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Route *DNSRoute `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// remove the route of the domain, nameserver is ignored
+	Remove bool `protobuf:"varint,2,opt,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (x *SetDNSRouteRequest) Reset() {
+	*x = SetDNSRouteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDNSRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDNSRouteRequest) ProtoMessage() {}
+
+func (x *SetDNSRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDNSRouteRequest.ProtoReflect.Descriptor instead.
+func (*SetDNSRouteRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetDNSRouteRequest) GetRoute() *DNSRoute {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *SetDNSRouteRequest) GetRemove() bool {
+	if x != nil {
+		return x.Remove
+	}
+	return false
+}
+
+type SetKillSwitchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KillSwitch bool       `protobuf:"varint,2,opt,name=kill_switch,json=killSwitch,proto3" json:"kill_switch,omitempty"`
+	Allowlist  *Allowlist `protobuf:"bytes,3,opt,name=allowlist,proto3" json:"allowlist,omitempty"`
+}
+
+func (x *SetKillSwitchRequest) Reset() {
+	*x = SetKillSwitchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetKillSwitchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetKillSwitchRequest) ProtoMessage() {}
+
+func (x *SetKillSwitchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetKillSwitchRequest.ProtoReflect.Descriptor instead.
+func (*SetKillSwitchRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetKillSwitchRequest) GetKillSwitch() bool {
+	if x != nil {
+		return x.KillSwitch
+	}
+	return false
+}
+
+func (x *SetKillSwitchRequest) GetAllowlist() *Allowlist {
+	if x != nil {
+		return x.Allowlist
+	}
+	return nil
+}
+
+type SetNotifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid    int64 `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Notify bool  `protobuf:"varint,3,opt,name=notify,proto3" json:"notify,omitempty"`
+}
+
+func (x *SetNotifyRequest) Reset() {
+	*x = SetNotifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetNotifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotifyRequest) ProtoMessage() {}
+
+func (x *SetNotifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotifyRequest.ProtoReflect.Descriptor instead.
+func (*SetNotifyRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetNotifyRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *SetNotifyRequest) GetNotify() bool {
+	if x != nil {
+		return x.Notify
+	}
+	return false
+}
+
+type SetProtocolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol config.Protocol `protobuf:"varint,2,opt,name=protocol,proto3,enum=config.Protocol" json:"protocol,omitempty"`
+}
+
+func (x *SetProtocolRequest) Reset() {
+	*x = SetProtocolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetProtocolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProtocolRequest) ProtoMessage() {}
+
+func (x *SetProtocolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProtocolRequest.ProtoReflect.Descriptor instead.
+func (*SetProtocolRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{13}
+}
+
 func (x *SetProtocolRequest) GetProtocol() config.Protocol {
 	if x != nil {
 		return x.Protocol
@@ -856,7 +1462,6 @@ type SetProtocolResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
-	//
 	//	*SetProtocolResponse_ErrorCode
 	//	*SetProtocolResponse_SetProtocolStatus
 	Response isSetProtocolResponse_Response `protobuf_oneof:"response"`
@@ -865,7 +1470,7 @@ type SetProtocolResponse struct {
 func (x *SetProtocolResponse) Reset() {
 	*x = SetProtocolResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[10]
+		mi := &file_set_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -878,7 +1483,7 @@ func (x *SetProtocolResponse) String() string {
 func (*SetProtocolResponse) ProtoMessage() {}
 
 func (x *SetProtocolResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[10]
+	mi := &file_set_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -891,7 +1496,7 @@ func (x *SetProtocolResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetProtocolResponse.ProtoReflect.Descriptor instead.
 func (*SetProtocolResponse) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{10}
+	return file_set_proto_rawDescGZIP(), []int{14}
 }
 
 func (m *SetProtocolResponse) GetResponse() isSetProtocolResponse_Response {
@@ -937,12 +1542,14 @@ type SetTechnologyRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Technology config.Technology `protobuf:"varint,2,opt,name=technology,proto3,enum=config.Technology" json:"technology,omitempty"`
+	// auto picks the technology for each network, technology is ignored then
+	Auto bool `protobuf:"varint,3,opt,name=auto,proto3" json:"auto,omitempty"`
 }
 
 func (x *SetTechnologyRequest) Reset() {
 	*x = SetTechnologyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[11]
+		mi := &file_set_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -955,7 +1562,7 @@ func (x *SetTechnologyRequest) String() string {
 func (*SetTechnologyRequest) ProtoMessage() {}
 
 func (x *SetTechnologyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[11]
+	mi := &file_set_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -968,7 +1575,7 @@ func (x *SetTechnologyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetTechnologyRequest.ProtoReflect.Descriptor instead.
 func (*SetTechnologyRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{11}
+	return file_set_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SetTechnologyRequest) GetTechnology() config.Technology {
@@ -978,31 +1585,39 @@ func (x *SetTechnologyRequest) GetTechnology() config.Technology {
 	return config.Technology(0)
 }
 
-type SetAllowlistRequest struct {
+func (x *SetTechnologyRequest) GetAuto() bool {
+	if x != nil {
+		return x.Auto
+	}
+	return false
+}
+
+type SetAllowedTechnologiesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Allowlist *Allowlist `protobuf:"bytes,2,opt,name=allowlist,proto3" json:"allowlist,omitempty"`
+	// technologies allowed to connect with, empty allows all
+	Technologies []config.Technology `protobuf:"varint,1,rep,packed,name=technologies,proto3,enum=config.Technology" json:"technologies,omitempty"`
 }
 
-func (x *SetAllowlistRequest) Reset() {
-	*x = SetAllowlistRequest{}
+func (x *SetAllowedTechnologiesRequest) Reset() {
+	*x = SetAllowedTechnologiesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[12]
+		mi := &file_set_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetAllowlistRequest) String() string {
+func (x *SetAllowedTechnologiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetAllowlistRequest) ProtoMessage() {}
+func (*SetAllowedTechnologiesRequest) ProtoMessage() {}
 
-func (x *SetAllowlistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[12]
+func (x *SetAllowedTechnologiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1013,43 +1628,43 @@ func (x *SetAllowlistRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetAllowlistRequest.ProtoReflect.Descriptor instead.
-func (*SetAllowlistRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SetAllowedTechnologiesRequest.ProtoReflect.Descriptor instead.
+func (*SetAllowedTechnologiesRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SetAllowlistRequest) GetAllowlist() *Allowlist {
+func (x *SetAllowedTechnologiesRequest) GetTechnologies() []config.Technology {
 	if x != nil {
-		return x.Allowlist
+		return x.Technologies
 	}
 	return nil
 }
 
-type SetLANDiscoveryRequest struct {
+type SetAllowlistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Allowlist *Allowlist `protobuf:"bytes,2,opt,name=allowlist,proto3" json:"allowlist,omitempty"`
 }
 
-func (x *SetLANDiscoveryRequest) Reset() {
-	*x = SetLANDiscoveryRequest{}
+func (x *SetAllowlistRequest) Reset() {
+	*x = SetAllowlistRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[13]
+		mi := &file_set_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetLANDiscoveryRequest) String() string {
+func (x *SetAllowlistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLANDiscoveryRequest) ProtoMessage() {}
+func (*SetAllowlistRequest) ProtoMessage() {}
 
-func (x *SetLANDiscoveryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[13]
+func (x *SetAllowlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1060,47 +1675,766 @@ func (x *SetLANDiscoveryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLANDiscoveryRequest.ProtoReflect.Descriptor instead.
-func (*SetLANDiscoveryRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SetAllowlistRequest.ProtoReflect.Descriptor instead.
+func (*SetAllowlistRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetAllowlistRequest) GetAllowlist() *Allowlist {
+	if x != nil {
+		return x.Allowlist
+	}
+	return nil
+}
+
+type SetLANDiscoveryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *SetLANDiscoveryRequest) Reset() {
+	*x = SetLANDiscoveryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLANDiscoveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLANDiscoveryRequest) ProtoMessage() {}
+
+func (x *SetLANDiscoveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLANDiscoveryRequest.ProtoReflect.Descriptor instead.
+func (*SetLANDiscoveryRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetLANDiscoveryRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetLANDiscoveryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*SetLANDiscoveryResponse_ErrorCode
+	//	*SetLANDiscoveryResponse_SetLanDiscoveryStatus
+	Response isSetLANDiscoveryResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *SetLANDiscoveryResponse) Reset() {
+	*x = SetLANDiscoveryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLANDiscoveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLANDiscoveryResponse) ProtoMessage() {}
+
+func (x *SetLANDiscoveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLANDiscoveryResponse.ProtoReflect.Descriptor instead.
+func (*SetLANDiscoveryResponse) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{19}
+}
+
+func (m *SetLANDiscoveryResponse) GetResponse() isSetLANDiscoveryResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *SetLANDiscoveryResponse) GetErrorCode() SetErrorCode {
+	if x, ok := x.GetResponse().(*SetLANDiscoveryResponse_ErrorCode); ok {
+		return x.ErrorCode
+	}
+	return SetErrorCode_FAILURE
+}
+
+func (x *SetLANDiscoveryResponse) GetSetLanDiscoveryStatus() SetLANDiscoveryStatus {
+	if x, ok := x.GetResponse().(*SetLANDiscoveryResponse_SetLanDiscoveryStatus); ok {
+		return x.SetLanDiscoveryStatus
+	}
+	return SetLANDiscoveryStatus_DISCOVERY_CONFIGURED
+}
+
+type isSetLANDiscoveryResponse_Response interface {
+	isSetLANDiscoveryResponse_Response()
+}
+
+type SetLANDiscoveryResponse_ErrorCode struct {
+	ErrorCode SetErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=pb.SetErrorCode,oneof"`
+}
+
+type SetLANDiscoveryResponse_SetLanDiscoveryStatus struct {
+	SetLanDiscoveryStatus SetLANDiscoveryStatus `protobuf:"varint,2,opt,name=set_lan_discovery_status,json=setLanDiscoveryStatus,proto3,enum=pb.SetLANDiscoveryStatus,oneof"`
+}
+
+func (*SetLANDiscoveryResponse_ErrorCode) isSetLANDiscoveryResponse_Response() {}
+
+func (*SetLANDiscoveryResponse_SetLanDiscoveryStatus) isSetLANDiscoveryResponse_Response() {}
+
+type SetDefaultRouteModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode DefaultRouteMode `protobuf:"varint,1,opt,name=mode,proto3,enum=pb.DefaultRouteMode" json:"mode,omitempty"`
+}
+
+func (x *SetDefaultRouteModeRequest) Reset() {
+	*x = SetDefaultRouteModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDefaultRouteModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultRouteModeRequest) ProtoMessage() {}
+
+func (x *SetDefaultRouteModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultRouteModeRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultRouteModeRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetDefaultRouteModeRequest) GetMode() DefaultRouteMode {
+	if x != nil {
+		return x.Mode
+	}
+	return DefaultRouteMode_REPLACE
+}
+
+type SetIPv6ModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode IPv6Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=pb.IPv6Mode" json:"mode,omitempty"`
+}
+
+func (x *SetIPv6ModeRequest) Reset() {
+	*x = SetIPv6ModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetIPv6ModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIPv6ModeRequest) ProtoMessage() {}
+
+func (x *SetIPv6ModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIPv6ModeRequest.ProtoReflect.Descriptor instead.
+func (*SetIPv6ModeRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetIPv6ModeRequest) GetMode() IPv6Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return IPv6Mode_IPV6_MODE_BLOCK
+}
+
+type SetFirewallBackendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Backend FirewallBackend `protobuf:"varint,1,opt,name=backend,proto3,enum=pb.FirewallBackend" json:"backend,omitempty"`
+}
+
+func (x *SetFirewallBackendRequest) Reset() {
+	*x = SetFirewallBackendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFirewallBackendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFirewallBackendRequest) ProtoMessage() {}
+
+func (x *SetFirewallBackendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFirewallBackendRequest.ProtoReflect.Descriptor instead.
+func (*SetFirewallBackendRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetFirewallBackendRequest) GetBackend() FirewallBackend {
+	if x != nil {
+		return x.Backend
+	}
+	return FirewallBackend_IPTABLES
+}
+
+type SetSubnetOverlapModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode SubnetOverlapMode `protobuf:"varint,1,opt,name=mode,proto3,enum=pb.SubnetOverlapMode" json:"mode,omitempty"`
+}
+
+func (x *SetSubnetOverlapModeRequest) Reset() {
+	*x = SetSubnetOverlapModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSubnetOverlapModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSubnetOverlapModeRequest) ProtoMessage() {}
+
+func (x *SetSubnetOverlapModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSubnetOverlapModeRequest.ProtoReflect.Descriptor instead.
+func (*SetSubnetOverlapModeRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetSubnetOverlapModeRequest) GetMode() SubnetOverlapMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SubnetOverlapMode_WARN
+}
+
+type SetLogLevelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level LogLevel `protobuf:"varint,1,opt,name=level,proto3,enum=pb.LogLevel" json:"level,omitempty"`
+}
+
+func (x *SetLogLevelRequest) Reset() {
+	*x = SetLogLevelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelRequest) ProtoMessage() {}
+
+func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetLogLevelRequest) GetLevel() LogLevel {
+	if x != nil {
+		return x.Level
+	}
+	return LogLevel_LOG_LEVEL_INFO
+}
+
+type SetServerSelectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Selection ServerSelection `protobuf:"varint,1,opt,name=selection,proto3,enum=pb.ServerSelection" json:"selection,omitempty"`
+}
+
+func (x *SetServerSelectionRequest) Reset() {
+	*x = SetServerSelectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetServerSelectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetServerSelectionRequest) ProtoMessage() {}
+
+func (x *SetServerSelectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetServerSelectionRequest.ProtoReflect.Descriptor instead.
+func (*SetServerSelectionRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetServerSelectionRequest) GetSelection() ServerSelection {
+	if x != nil {
+		return x.Selection
+	}
+	return ServerSelection_SERVER_SELECTION_LOAD
+}
+
+type SetObfuscationModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode ObfuscationMode `protobuf:"varint,1,opt,name=mode,proto3,enum=pb.ObfuscationMode" json:"mode,omitempty"`
+}
+
+func (x *SetObfuscationModeRequest) Reset() {
+	*x = SetObfuscationModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetObfuscationModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetObfuscationModeRequest) ProtoMessage() {}
+
+func (x *SetObfuscationModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetObfuscationModeRequest.ProtoReflect.Descriptor instead.
+func (*SetObfuscationModeRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetObfuscationModeRequest) GetMode() ObfuscationMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ObfuscationMode_OBFUSCATION_MODE_XOR
+}
+
+type SetInterfaceNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the tunnel interface, empty restores the default names
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SetInterfaceNameRequest) Reset() {
+	*x = SetInterfaceNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetInterfaceNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInterfaceNameRequest) ProtoMessage() {}
+
+func (x *SetInterfaceNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInterfaceNameRequest.ProtoReflect.Descriptor instead.
+func (*SetInterfaceNameRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetInterfaceNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SetMTURequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// MTU of the tunnel, 0 enables the detection of the path MTU
+	Mtu uint32 `protobuf:"varint,1,opt,name=mtu,proto3" json:"mtu,omitempty"`
+}
+
+func (x *SetMTURequest) Reset() {
+	*x = SetMTURequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMTURequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMTURequest) ProtoMessage() {}
+
+func (x *SetMTURequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMTURequest.ProtoReflect.Descriptor instead.
+func (*SetMTURequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetMTURequest) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+type SetOnDemandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// idle_timeout in seconds, 0 keeps the current value
+	IdleTimeout uint32 `protobuf:"varint,2,opt,name=idle_timeout,json=idleTimeout,proto3" json:"idle_timeout,omitempty"`
+}
+
+func (x *SetOnDemandRequest) Reset() {
+	*x = SetOnDemandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOnDemandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOnDemandRequest) ProtoMessage() {}
+
+func (x *SetOnDemandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOnDemandRequest.ProtoReflect.Descriptor instead.
+func (*SetOnDemandRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetOnDemandRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetOnDemandRequest) GetIdleTimeout() uint32 {
+	if x != nil {
+		return x.IdleTimeout
+	}
+	return 0
+}
+
+type SetIdleDisconnectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// timeout in seconds, 0 disables the idle disconnect
+	Timeout uint32 `protobuf:"varint,1,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// kill_switch is enabled after the idle disconnect
+	KillSwitch bool `protobuf:"varint,2,opt,name=kill_switch,json=killSwitch,proto3" json:"kill_switch,omitempty"`
+}
+
+func (x *SetIdleDisconnectRequest) Reset() {
+	*x = SetIdleDisconnectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetIdleDisconnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIdleDisconnectRequest) ProtoMessage() {}
+
+func (x *SetIdleDisconnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIdleDisconnectRequest.ProtoReflect.Descriptor instead.
+func (*SetIdleDisconnectRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetIdleDisconnectRequest) GetTimeout() uint32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *SetIdleDisconnectRequest) GetKillSwitch() bool {
+	if x != nil {
+		return x.KillSwitch
+	}
+	return false
+}
+
+type SetFirewallTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stage FirewallTemplateStage `protobuf:"varint,1,opt,name=stage,proto3,enum=pb.FirewallTemplateStage" json:"stage,omitempty"`
+	// path is an absolute path of the template, empty path removes the template
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *SetFirewallTemplateRequest) Reset() {
+	*x = SetFirewallTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFirewallTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFirewallTemplateRequest) ProtoMessage() {}
+
+func (x *SetFirewallTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFirewallTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SetFirewallTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetFirewallTemplateRequest) GetStage() FirewallTemplateStage {
+	if x != nil {
+		return x.Stage
+	}
+	return FirewallTemplateStage_TEMPLATE_PERSISTENT
 }
 
-func (x *SetLANDiscoveryRequest) GetEnabled() bool {
+func (x *SetFirewallTemplateRequest) GetPath() string {
 	if x != nil {
-		return x.Enabled
+		return x.Path
 	}
-	return false
+	return ""
 }
 
-type SetLANDiscoveryResponse struct {
+type FirewallTemplates struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Response:
-	//
-	//	*SetLANDiscoveryResponse_ErrorCode
-	//	*SetLANDiscoveryResponse_SetLanDiscoveryStatus
-	Response isSetLANDiscoveryResponse_Response `protobuf_oneof:"response"`
+	Persistent     string `protobuf:"bytes,1,opt,name=persistent,proto3" json:"persistent,omitempty"`
+	PreConnect     string `protobuf:"bytes,2,opt,name=pre_connect,json=preConnect,proto3" json:"pre_connect,omitempty"`
+	PostDisconnect string `protobuf:"bytes,3,opt,name=post_disconnect,json=postDisconnect,proto3" json:"post_disconnect,omitempty"`
 }
 
-func (x *SetLANDiscoveryResponse) Reset() {
-	*x = SetLANDiscoveryResponse{}
+func (x *FirewallTemplates) Reset() {
+	*x = FirewallTemplates{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[14]
+		mi := &file_set_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetLANDiscoveryResponse) String() string {
+func (x *FirewallTemplates) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLANDiscoveryResponse) ProtoMessage() {}
+func (*FirewallTemplates) ProtoMessage() {}
 
-func (x *SetLANDiscoveryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[14]
+func (x *FirewallTemplates) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1111,48 +2445,32 @@ func (x *SetLANDiscoveryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLANDiscoveryResponse.ProtoReflect.Descriptor instead.
-func (*SetLANDiscoveryResponse) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use FirewallTemplates.ProtoReflect.Descriptor instead.
+func (*FirewallTemplates) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{32}
 }
 
-func (m *SetLANDiscoveryResponse) GetResponse() isSetLANDiscoveryResponse_Response {
-	if m != nil {
-		return m.Response
+func (x *FirewallTemplates) GetPersistent() string {
+	if x != nil {
+		return x.Persistent
 	}
-	return nil
+	return ""
 }
 
-func (x *SetLANDiscoveryResponse) GetErrorCode() SetErrorCode {
-	if x, ok := x.GetResponse().(*SetLANDiscoveryResponse_ErrorCode); ok {
-		return x.ErrorCode
+func (x *FirewallTemplates) GetPreConnect() string {
+	if x != nil {
+		return x.PreConnect
 	}
-	return SetErrorCode_FAILURE
+	return ""
 }
 
-func (x *SetLANDiscoveryResponse) GetSetLanDiscoveryStatus() SetLANDiscoveryStatus {
-	if x, ok := x.GetResponse().(*SetLANDiscoveryResponse_SetLanDiscoveryStatus); ok {
-		return x.SetLanDiscoveryStatus
+func (x *FirewallTemplates) GetPostDisconnect() string {
+	if x != nil {
+		return x.PostDisconnect
 	}
-	return SetLANDiscoveryStatus_DISCOVERY_CONFIGURED
-}
-
-type isSetLANDiscoveryResponse_Response interface {
-	isSetLANDiscoveryResponse_Response()
-}
-
-type SetLANDiscoveryResponse_ErrorCode struct {
-	ErrorCode SetErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=pb.SetErrorCode,oneof"`
-}
-
-type SetLANDiscoveryResponse_SetLanDiscoveryStatus struct {
-	SetLanDiscoveryStatus SetLANDiscoveryStatus `protobuf:"varint,2,opt,name=set_lan_discovery_status,json=setLanDiscoveryStatus,proto3,enum=pb.SetLANDiscoveryStatus,oneof"`
+	return ""
 }
 
-func (*SetLANDiscoveryResponse_ErrorCode) isSetLANDiscoveryResponse_Response() {}
-
-func (*SetLANDiscoveryResponse_SetLanDiscoveryStatus) isSetLANDiscoveryResponse_Response() {}
-
 var File_set_proto protoreflect.FileDescriptor
 
 var file_set_proto_rawDesc = []byte{
@@ -1209,87 +2527,211 @@ var file_set_proto_rawDesc = []byte{
 	0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
 	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
 	0x48, 0x00, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x44, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0x0a, 0x14,
-	0x53, 0x65, 0x74, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6b, 0x69, 0x6c, 0x6c, 0x53,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x2b, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x6c,
-	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69,
-	0x73, 0x74, 0x22, 0x3c, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69,
-	0x66, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79,
-	0x22, 0x42, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x9d, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
-	0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x47, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x11, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x54, 0x65, 0x63, 0x68, 0x6e,
-	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0a,
-	0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x6f,
-	0x6c, 0x6f, 0x67, 0x79, 0x52, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
-	0x22, 0x42, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e,
-	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x6c, 0x69, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4c, 0x41, 0x4e, 0x44, 0x69,
-	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a, 0x1a,
+	0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x73, 0x22, 0x2b, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x22, 0x61, 0x0a, 0x08, 0x44, 0x4e, 0x53, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x69, 0x61, 0x5f, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x76, 0x69, 0x61, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x50, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x44,
+	0x4e, 0x53, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x64, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4b, 0x69, 0x6c,
+	0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x6b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12,
+	0x2b, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x10,
+	0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x22, 0x42, 0x0a, 0x12, 0x53, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x9d,
+	0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x47, 0x0a, 0x13, 0x73, 0x65, 0x74,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52,
+	0x11, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e,
+	0x0a, 0x14, 0x53, 0x65, 0x74, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x0a,
+	0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75,
+	0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x61, 0x75, 0x74, 0x6f, 0x22, 0x57,
+	0x0a, 0x1d, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x54, 0x65, 0x63, 0x68,
+	0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x36, 0x0a, 0x0c, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54,
+	0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x0c, 0x74, 0x65, 0x63, 0x68, 0x6e,
+	0x6f, 0x6c, 0x6f, 0x67, 0x69, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b,
+	0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74,
+	0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x16, 0x53,
+	0x65, 0x74, 0x4c, 0x41, 0x4e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22,
+	0xae, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x4c, 0x41, 0x4e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x54,
+	0x0a, 0x18, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x41, 0x4e, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x15, 0x73,
+	0x65, 0x74, 0x4c, 0x61, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x46, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70,
+	0x62, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x36, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x49,
+	0x50, 0x76, 0x36, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x70,
+	0x62, 0x2e, 0x49, 0x50, 0x76, 0x36, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x22, 0x4a, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a,
+	0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x22, 0x48, 0x0a, 0x1b,
+	0x53, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x38, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x70, 0x62,
+	0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x22, 0x4e, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a,
+	0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x44, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x62,
+	0x2e, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x2d, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x21, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x4d, 0x54, 0x55, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x22, 0x51, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4f,
+	0x6e, 0x44, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
 	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74,
-	0x4c, 0x41, 0x4e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65,
-	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x54, 0x0a, 0x18, 0x73, 0x65, 0x74, 0x5f, 0x6c,
-	0x61, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x65, 0x74, 0x4c, 0x41, 0x4e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x15, 0x73, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x44, 0x69,
-	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a,
-	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x3e, 0x0a, 0x0c, 0x53, 0x65, 0x74,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49,
-	0x4c, 0x55, 0x52, 0x45, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
-	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x4c, 0x52, 0x45,
-	0x41, 0x44, 0x59, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x02, 0x2a, 0x51, 0x0a, 0x1d, 0x53, 0x65, 0x74,
-	0x54, 0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4c, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x50,
-	0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c,
-	0x0a, 0x18, 0x54, 0x50, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44,
-	0x5f, 0x44, 0x4e, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x01, 0x2a, 0x6e, 0x0a, 0x0c,
-	0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e,
-	0x44, 0x4e, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x1c, 0x0a, 0x18, 0x44, 0x4e, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52,
-	0x45, 0x44, 0x5f, 0x54, 0x50, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x01, 0x12, 0x17,
-	0x0a, 0x13, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x44, 0x4e, 0x53, 0x5f, 0x41, 0x44,
-	0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x4f, 0x4f, 0x5f, 0x4d,
-	0x41, 0x4e, 0x59, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x53, 0x10, 0x03, 0x2a, 0x64, 0x0a, 0x11,
-	0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x43, 0x4f,
-	0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x52,
-	0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45,
-	0x44, 0x5f, 0x56, 0x50, 0x4e, 0x5f, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e,
-	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x45, 0x43, 0x48, 0x4e, 0x4f, 0x4c, 0x4f, 0x47, 0x59,
-	0x10, 0x02, 0x2a, 0x5b, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x4c, 0x41, 0x4e, 0x44, 0x69, 0x73, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x14, 0x44,
-	0x49, 0x53, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55,
-	0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x56, 0x45,
-	0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x5f, 0x41, 0x4c,
-	0x4c, 0x4f, 0x57, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x01, 0x42,
-	0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f,
-	0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76,
-	0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f,
-	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x64, 0x6c, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x69, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x55, 0x0a, 0x18, 0x53,
+	0x65, 0x74, 0x49, 0x64, 0x6c, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x22, 0x61, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c,
+	0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x7d, 0x0a, 0x11, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c,
+	0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65,
+	0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x6f, 0x73, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x2a, 0x3e, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10,
+	0x00, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x53,
+	0x45, 0x54, 0x10, 0x02, 0x2a, 0x51, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x50, 0x4c, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x50, 0x4c,
+	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x5f, 0x44, 0x4e, 0x53, 0x5f,
+	0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x01, 0x2a, 0x6e, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x44, 0x4e,
+	0x53, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x4e, 0x53, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x44,
+	0x4e, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x5f, 0x54, 0x50,
+	0x4c, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x44, 0x4e, 0x53, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53,
+	0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x56,
+	0x41, 0x4c, 0x55, 0x45, 0x53, 0x10, 0x03, 0x2a, 0x64, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x13,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55,
+	0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f,
+	0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x5f, 0x56, 0x50, 0x4e,
+	0x5f, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x54, 0x45, 0x43, 0x48, 0x4e, 0x4f, 0x4c, 0x4f, 0x47, 0x59, 0x10, 0x02, 0x2a, 0x5b, 0x0a,
+	0x15, 0x53, 0x65, 0x74, 0x4c, 0x41, 0x4e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x56,
+	0x45, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x28, 0x0a, 0x24, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x43, 0x4f,
+	0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x4c, 0x49,
+	0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x01, 0x2a, 0x38, 0x0a, 0x10, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b,
+	0x0a, 0x07, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43,
+	0x4f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x46, 0x55,
+	0x53, 0x45, 0x10, 0x02, 0x2a, 0x4b, 0x0a, 0x08, 0x49, 0x50, 0x76, 0x36, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x13, 0x0a, 0x0f, 0x49, 0x50, 0x56, 0x36, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x4c,
+	0x4f, 0x43, 0x4b, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x50, 0x56, 0x36, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x49,
+	0x50, 0x56, 0x36, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x41, 0x54, 0x49, 0x56, 0x45, 0x10,
+	0x02, 0x2a, 0x2d, 0x0a, 0x0f, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x50, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x53,
+	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x46, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x53, 0x10, 0x01,
+	0x2a, 0x3d, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61,
+	0x70, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x00, 0x12,
+	0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x45, 0x46, 0x45, 0x52, 0x5f, 0x4c, 0x41, 0x4e, 0x10, 0x01, 0x12,
+	0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x45, 0x46, 0x45, 0x52, 0x5f, 0x56, 0x50, 0x4e, 0x10, 0x02, 0x2a,
+	0x47, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x0e, 0x4c,
+	0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x00, 0x12,
+	0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x44, 0x45, 0x42,
+	0x55, 0x47, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45,
+	0x4c, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x02, 0x2a, 0x65, 0x0a, 0x0f, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x53,
+	0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4c, 0x4f, 0x41, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52,
+	0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e,
+	0x43, 0x59, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x53,
+	0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x02, 0x2a,
+	0x65, 0x0a, 0x0f, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x42, 0x46, 0x55, 0x53, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x58, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14,
+	0x4f, 0x42, 0x46, 0x55, 0x53, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x54, 0x4c, 0x53, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x42, 0x46, 0x55, 0x53, 0x43,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x57, 0x45, 0x42, 0x53, 0x4f,
+	0x43, 0x4b, 0x45, 0x54, 0x10, 0x02, 0x2a, 0x68, 0x0a, 0x15, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61,
+	0x6c, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x17, 0x0a, 0x13, 0x54, 0x45, 0x4d, 0x50, 0x4c, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x53,
+	0x49, 0x53, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x45, 0x4d, 0x50,
+	0x4c, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
+	0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x45, 0x4d, 0x50, 0x4c, 0x41, 0x54, 0x45, 0x5f, 0x50,
+	0x4f, 0x53, 0x54, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x10, 0x02,
+	0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e,
+	0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64,
+	0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1304,52 +2746,88 @@ func file_set_proto_rawDescGZIP() []byte {
 	return file_set_proto_rawDescData
 }
 
-var file_set_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_set_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_set_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
+var file_set_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_set_proto_goTypes = []interface{}{
 	(SetErrorCode)(0),                       // 0: pb.SetErrorCode
 	(SetThreatProtectionLiteStatus)(0),      // 1: pb.SetThreatProtectionLiteStatus
 	(SetDNSStatus)(0),                       // 2: pb.SetDNSStatus
 	(SetProtocolStatus)(0),                  // 3: pb.SetProtocolStatus
 	(SetLANDiscoveryStatus)(0),              // 4: pb.SetLANDiscoveryStatus
-	(*SetAutoconnectRequest)(nil),           // 5: pb.SetAutoconnectRequest
-	(*SetGenericRequest)(nil),               // 6: pb.SetGenericRequest
-	(*SetUint32Request)(nil),                // 7: pb.SetUint32Request
-	(*SetThreatProtectionLiteRequest)(nil),  // 8: pb.SetThreatProtectionLiteRequest
-	(*SetThreatProtectionLiteResponse)(nil), // 9: pb.SetThreatProtectionLiteResponse
-	(*SetDNSRequest)(nil),                   // 10: pb.SetDNSRequest
-	(*SetDNSResponse)(nil),                  // 11: pb.SetDNSResponse
-	(*SetKillSwitchRequest)(nil),            // 12: pb.SetKillSwitchRequest
-	(*SetNotifyRequest)(nil),                // 13: pb.SetNotifyRequest
-	(*SetProtocolRequest)(nil),              // 14: pb.SetProtocolRequest
-	(*SetProtocolResponse)(nil),             // 15: pb.SetProtocolResponse
-	(*SetTechnologyRequest)(nil),            // 16: pb.SetTechnologyRequest
-	(*SetAllowlistRequest)(nil),             // 17: pb.SetAllowlistRequest
-	(*SetLANDiscoveryRequest)(nil),          // 18: pb.SetLANDiscoveryRequest
-	(*SetLANDiscoveryResponse)(nil),         // 19: pb.SetLANDiscoveryResponse
-	(*Allowlist)(nil),                       // 20: pb.Allowlist
-	(config.Protocol)(0),                    // 21: config.Protocol
-	(config.Technology)(0),                  // 22: config.Technology
+	(DefaultRouteMode)(0),                   // 5: pb.DefaultRouteMode
+	(IPv6Mode)(0),                           // 6: pb.IPv6Mode
+	(FirewallBackend)(0),                    // 7: pb.FirewallBackend
+	(SubnetOverlapMode)(0),                  // 8: pb.SubnetOverlapMode
+	(LogLevel)(0),                           // 9: pb.LogLevel
+	(ServerSelection)(0),                    // 10: pb.ServerSelection
+	(ObfuscationMode)(0),                    // 11: pb.ObfuscationMode
+	(FirewallTemplateStage)(0),              // 12: pb.FirewallTemplateStage
+	(*SetAutoconnectRequest)(nil),           // 13: pb.SetAutoconnectRequest
+	(*SetGenericRequest)(nil),               // 14: pb.SetGenericRequest
+	(*SetUint32Request)(nil),                // 15: pb.SetUint32Request
+	(*SetThreatProtectionLiteRequest)(nil),  // 16: pb.SetThreatProtectionLiteRequest
+	(*SetThreatProtectionLiteResponse)(nil), // 17: pb.SetThreatProtectionLiteResponse
+	(*SetDNSRequest)(nil),                   // 18: pb.SetDNSRequest
+	(*SetDNSResponse)(nil),                  // 19: pb.SetDNSResponse
+	(*SetDNSSearchDomainsRequest)(nil),      // 20: pb.SetDNSSearchDomainsRequest
+	(*SetDNSFilterRequest)(nil),             // 21: pb.SetDNSFilterRequest
+	(*DNSRoute)(nil),                        // 22: pb.DNSRoute
+	(*SetDNSRouteRequest)(nil),              // 23: pb.SetDNSRouteRequest
+	(*SetKillSwitchRequest)(nil),            // 24: pb.SetKillSwitchRequest
+	(*SetNotifyRequest)(nil),                // 25: pb.SetNotifyRequest
+	(*SetProtocolRequest)(nil),              // 26: pb.SetProtocolRequest
+	(*SetProtocolResponse)(nil),             // 27: pb.SetProtocolResponse
+	(*SetTechnologyRequest)(nil),            // 28: pb.SetTechnologyRequest
+	(*SetAllowedTechnologiesRequest)(nil),   // 29: pb.SetAllowedTechnologiesRequest
+	(*SetAllowlistRequest)(nil),             // 30: pb.SetAllowlistRequest
+	(*SetLANDiscoveryRequest)(nil),          // 31: pb.SetLANDiscoveryRequest
+	(*SetLANDiscoveryResponse)(nil),         // 32: pb.SetLANDiscoveryResponse
+	(*SetDefaultRouteModeRequest)(nil),      // 33: pb.SetDefaultRouteModeRequest
+	(*SetIPv6ModeRequest)(nil),              // 34: pb.SetIPv6ModeRequest
+	(*SetFirewallBackendRequest)(nil),       // 35: pb.SetFirewallBackendRequest
+	(*SetSubnetOverlapModeRequest)(nil),     // 36: pb.SetSubnetOverlapModeRequest
+	(*SetLogLevelRequest)(nil),              // 37: pb.SetLogLevelRequest
+	(*SetServerSelectionRequest)(nil),       // 38: pb.SetServerSelectionRequest
+	(*SetObfuscationModeRequest)(nil),       // 39: pb.SetObfuscationModeRequest
+	(*SetInterfaceNameRequest)(nil),         // 40: pb.SetInterfaceNameRequest
+	(*SetMTURequest)(nil),                   // 41: pb.SetMTURequest
+	(*SetOnDemandRequest)(nil),              // 42: pb.SetOnDemandRequest
+	(*SetIdleDisconnectRequest)(nil),        // 43: pb.SetIdleDisconnectRequest
+	(*SetFirewallTemplateRequest)(nil),      // 44: pb.SetFirewallTemplateRequest
+	(*FirewallTemplates)(nil),               // 45: pb.FirewallTemplates
+	(*Allowlist)(nil),                       // 46: pb.Allowlist
+	(config.Protocol)(0),                    // 47: config.Protocol
+	(config.Technology)(0),                  // 48: config.Technology
 }
 var file_set_proto_depIdxs = []int32{
-	20, // 0: pb.SetAutoconnectRequest.allowlist:type_name -> pb.Allowlist
+	46, // 0: pb.SetAutoconnectRequest.allowlist:type_name -> pb.Allowlist
 	0,  // 1: pb.SetThreatProtectionLiteResponse.error_code:type_name -> pb.SetErrorCode
 	1,  // 2: pb.SetThreatProtectionLiteResponse.set_threat_protection_lite_status:type_name -> pb.SetThreatProtectionLiteStatus
 	0,  // 3: pb.SetDNSResponse.error_code:type_name -> pb.SetErrorCode
 	2,  // 4: pb.SetDNSResponse.set_dns_status:type_name -> pb.SetDNSStatus
-	20, // 5: pb.SetKillSwitchRequest.allowlist:type_name -> pb.Allowlist
-	21, // 6: pb.SetProtocolRequest.protocol:type_name -> config.Protocol
-	0,  // 7: pb.SetProtocolResponse.error_code:type_name -> pb.SetErrorCode
-	3,  // 8: pb.SetProtocolResponse.set_protocol_status:type_name -> pb.SetProtocolStatus
-	22, // 9: pb.SetTechnologyRequest.technology:type_name -> config.Technology
-	20, // 10: pb.SetAllowlistRequest.allowlist:type_name -> pb.Allowlist
-	0,  // 11: pb.SetLANDiscoveryResponse.error_code:type_name -> pb.SetErrorCode
-	4,  // 12: pb.SetLANDiscoveryResponse.set_lan_discovery_status:type_name -> pb.SetLANDiscoveryStatus
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	22, // 5: pb.SetDNSRouteRequest.route:type_name -> pb.DNSRoute
+	46, // 6: pb.SetKillSwitchRequest.allowlist:type_name -> pb.Allowlist
+	47, // 7: pb.SetProtocolRequest.protocol:type_name -> config.Protocol
+	0,  // 8: pb.SetProtocolResponse.error_code:type_name -> pb.SetErrorCode
+	3,  // 9: pb.SetProtocolResponse.set_protocol_status:type_name -> pb.SetProtocolStatus
+	48, // 10: pb.SetTechnologyRequest.technology:type_name -> config.Technology
+	48, // 11: pb.SetAllowedTechnologiesRequest.technologies:type_name -> config.Technology
+	46, // 12: pb.SetAllowlistRequest.allowlist:type_name -> pb.Allowlist
+	0,  // 13: pb.SetLANDiscoveryResponse.error_code:type_name -> pb.SetErrorCode
+	4,  // 14: pb.SetLANDiscoveryResponse.set_lan_discovery_status:type_name -> pb.SetLANDiscoveryStatus
+	5,  // 15: pb.SetDefaultRouteModeRequest.mode:type_name -> pb.DefaultRouteMode
+	6,  // 16: pb.SetIPv6ModeRequest.mode:type_name -> pb.IPv6Mode
+	7,  // 17: pb.SetFirewallBackendRequest.backend:type_name -> pb.FirewallBackend
+	8,  // 18: pb.SetSubnetOverlapModeRequest.mode:type_name -> pb.SubnetOverlapMode
+	9,  // 19: pb.SetLogLevelRequest.level:type_name -> pb.LogLevel
+	10, // 20: pb.SetServerSelectionRequest.selection:type_name -> pb.ServerSelection
+	11, // 21: pb.SetObfuscationModeRequest.mode:type_name -> pb.ObfuscationMode
+	12, // 22: pb.SetFirewallTemplateRequest.stage:type_name -> pb.FirewallTemplateStage
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_set_proto_init() }
@@ -1444,7 +2922,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetKillSwitchRequest); i {
+			switch v := v.(*SetDNSSearchDomainsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1456,7 +2934,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetNotifyRequest); i {
+			switch v := v.(*SetDNSFilterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1468,7 +2946,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetProtocolRequest); i {
+			switch v := v.(*DNSRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1480,7 +2958,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetProtocolResponse); i {
+			switch v := v.(*SetDNSRouteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1492,7 +2970,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetTechnologyRequest); i {
+			switch v := v.(*SetKillSwitchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1504,7 +2982,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetAllowlistRequest); i {
+			switch v := v.(*SetNotifyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1516,7 +2994,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetLANDiscoveryRequest); i {
+			switch v := v.(*SetProtocolRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1528,6 +3006,66 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetProtocolResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTechnologyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAllowedTechnologiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAllowlistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLANDiscoveryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetLANDiscoveryResponse); i {
 			case 0:
 				return &v.state
@@ -1539,6 +3077,162 @@ func file_set_proto_init() {
 				return nil
 			}
 		}
+		file_set_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDefaultRouteModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetIPv6ModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFirewallBackendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSubnetOverlapModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetServerSelectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetObfuscationModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetInterfaceNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMTURequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOnDemandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetIdleDisconnectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFirewallTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FirewallTemplates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_set_proto_msgTypes[4].OneofWrappers = []interface{}{
 		(*SetThreatProtectionLiteResponse_ErrorCode)(nil),
@@ -1548,11 +3242,11 @@ func file_set_proto_init() {
 		(*SetDNSResponse_ErrorCode)(nil),
 		(*SetDNSResponse_SetDnsStatus)(nil),
 	}
-	file_set_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_set_proto_msgTypes[14].OneofWrappers = []interface{}{
 		(*SetProtocolResponse_ErrorCode)(nil),
 		(*SetProtocolResponse_SetProtocolStatus)(nil),
 	}
-	file_set_proto_msgTypes[14].OneofWrappers = []interface{}{
+	file_set_proto_msgTypes[19].OneofWrappers = []interface{}{
 		(*SetLANDiscoveryResponse_ErrorCode)(nil),
 		(*SetLANDiscoveryResponse_SetLanDiscoveryStatus)(nil),
 	}
@@ -1561,8 +3255,8 @@ func file_set_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_set_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   15,
+			NumEnums:      13,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -144,6 +144,59 @@ type Settings struct {
 	LanDiscovery         bool              `protobuf:"varint,14,opt,name=lan_discovery,json=lanDiscovery,proto3" json:"lan_discovery,omitempty"`
 	Allowlist            *Allowlist        `protobuf:"bytes,15,opt,name=allowlist,proto3" json:"allowlist,omitempty"`
 	Obfuscate            bool              `protobuf:"varint,16,opt,name=obfuscate,proto3" json:"obfuscate,omitempty"`
+	DefaultRouteMode     DefaultRouteMode  `protobuf:"varint,17,opt,name=default_route_mode,json=defaultRouteMode,proto3,enum=pb.DefaultRouteMode" json:"default_route_mode,omitempty"`
+	OnDemand             bool              `protobuf:"varint,18,opt,name=on_demand,json=onDemand,proto3" json:"on_demand,omitempty"`
+	// on_demand_idle_timeout in seconds
+	OnDemandIdleTimeout uint32             `protobuf:"varint,19,opt,name=on_demand_idle_timeout,json=onDemandIdleTimeout,proto3" json:"on_demand_idle_timeout,omitempty"`
+	FirewallTemplates   *FirewallTemplates `protobuf:"bytes,20,opt,name=firewall_templates,json=firewallTemplates,proto3" json:"firewall_templates,omitempty"`
+	DnsIpv6             bool               `protobuf:"varint,21,opt,name=dns_ipv6,json=dnsIpv6,proto3" json:"dns_ipv6,omitempty"`
+	// idle_timeout in seconds, 0 when idle disconnect is disabled
+	IdleTimeout           uint32 `protobuf:"varint,22,opt,name=idle_timeout,json=idleTimeout,proto3" json:"idle_timeout,omitempty"`
+	IdleTimeoutKillSwitch bool   `protobuf:"varint,23,opt,name=idle_timeout_kill_switch,json=idleTimeoutKillSwitch,proto3" json:"idle_timeout_kill_switch,omitempty"`
+	// rating_weight is the percentage by which server ratings affect the server pick
+	RatingWeight        uint32              `protobuf:"varint,24,opt,name=rating_weight,json=ratingWeight,proto3" json:"rating_weight,omitempty"`
+	KillSwitchLog       bool                `protobuf:"varint,25,opt,name=kill_switch_log,json=killSwitchLog,proto3" json:"kill_switch_log,omitempty"`
+	Metered             *Metered            `protobuf:"bytes,26,opt,name=metered,proto3" json:"metered,omitempty"`
+	AllowedTechnologies []config.Technology `protobuf:"varint,27,rep,packed,name=allowed_technologies,json=allowedTechnologies,proto3,enum=config.Technology" json:"allowed_technologies,omitempty"`
+	SubnetOverlapMode   SubnetOverlapMode   `protobuf:"varint,28,opt,name=subnet_overlap_mode,json=subnetOverlapMode,proto3,enum=pb.SubnetOverlapMode" json:"subnet_overlap_mode,omitempty"`
+	// names of the network connections with a captive portal
+	CaptivePortalNetworks []string           `protobuf:"bytes,29,rep,name=captive_portal_networks,json=captivePortalNetworks,proto3" json:"captive_portal_networks,omitempty"`
+	DnsSearchDomains      []string           `protobuf:"bytes,30,rep,name=dns_search_domains,json=dnsSearchDomains,proto3" json:"dns_search_domains,omitempty"`
+	FirewallBackend       FirewallBackend    `protobuf:"varint,31,opt,name=firewall_backend,json=firewallBackend,proto3,enum=pb.FirewallBackend" json:"firewall_backend,omitempty"`
+	SplitTunnel           *SplitTunnel       `protobuf:"bytes,32,opt,name=split_tunnel,json=splitTunnel,proto3" json:"split_tunnel,omitempty"`
+	Metrics               *Metrics           `protobuf:"bytes,33,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	AutoconnectRules      []*AutoConnectRule `protobuf:"bytes,34,rep,name=autoconnect_rules,json=autoconnectRules,proto3" json:"autoconnect_rules,omitempty"`
+	LocalProxy            *LocalProxy        `protobuf:"bytes,36,opt,name=local_proxy,json=localProxy,proto3" json:"local_proxy,omitempty"`
+	ServerPorts           *ServerPorts       `protobuf:"bytes,37,opt,name=server_ports,json=serverPorts,proto3" json:"server_ports,omitempty"`
+	KillSwitchLan         bool               `protobuf:"varint,38,opt,name=kill_switch_lan,json=killSwitchLan,proto3" json:"kill_switch_lan,omitempty"`
+	LogLevel              LogLevel           `protobuf:"varint,39,opt,name=log_level,json=logLevel,proto3,enum=pb.LogLevel" json:"log_level,omitempty"`
+	ServerSelection       ServerSelection    `protobuf:"varint,40,opt,name=server_selection,json=serverSelection,proto3,enum=pb.ServerSelection" json:"server_selection,omitempty"`
+	// names of the network connections on which OpenVPN uses TCP, because UDP
+	// failed repeatedly
+	TcpNetworks     []string        `protobuf:"bytes,41,rep,name=tcp_networks,json=tcpNetworks,proto3" json:"tcp_networks,omitempty"`
+	ObfuscationMode ObfuscationMode `protobuf:"varint,42,opt,name=obfuscation_mode,json=obfuscationMode,proto3,enum=pb.ObfuscationMode" json:"obfuscation_mode,omitempty"`
+	// name of the tunnel interface, empty if the default names are used
+	InterfaceName string `protobuf:"bytes,43,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	// MTU of the tunnel, 0 if it is fitted to the path MTU
+	Mtu uint32 `protobuf:"varint,44,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	// routing table used for the VPN traffic, 0 if the first table not in use is picked
+	RoutingTable uint32 `protobuf:"varint,45,opt,name=routing_table,json=routingTable,proto3" json:"routing_table,omitempty"`
+	// DNS is set by modifying resolv.conf even if systemd-resolved is running
+	LegacyDns bool `protobuf:"varint,46,opt,name=legacy_dns,json=legacyDns,proto3" json:"legacy_dns,omitempty"`
+	// network connection names or subnets on which VPN is suppressed
+	TrustedNetworks    []string         `protobuf:"bytes,47,rep,name=trusted_networks,json=trustedNetworks,proto3" json:"trusted_networks,omitempty"`
+	Reconnect          *ReconnectPolicy `protobuf:"bytes,48,opt,name=reconnect,proto3" json:"reconnect,omitempty"`
+	RestApi            *RESTAPI         `protobuf:"bytes,49,opt,name=rest_api,json=restApi,proto3" json:"rest_api,omitempty"`
+	DnsRoutes          []*DNSRoute      `protobuf:"bytes,50,rep,name=dns_routes,json=dnsRoutes,proto3" json:"dns_routes,omitempty"`
+	Ipv6Mode           IPv6Mode         `protobuf:"varint,51,opt,name=ipv6_mode,json=ipv6Mode,proto3,enum=pb.IPv6Mode" json:"ipv6_mode,omitempty"`
+	MulticastDiscovery bool             `protobuf:"varint,52,opt,name=multicast_discovery,json=multicastDiscovery,proto3" json:"multicast_discovery,omitempty"`
+	VirtualLocation    bool             `protobuf:"varint,53,opt,name=virtual_location,json=virtualLocation,proto3" json:"virtual_location,omitempty"`
+	ObfuscatedNetworks []string         `protobuf:"bytes,54,rep,name=obfuscated_networks,json=obfuscatedNetworks,proto3" json:"obfuscated_networks,omitempty"`
+	// technology is picked for each network, technology is the last one picked
+	AutoTechnology bool     `protobuf:"varint,55,opt,name=auto_technology,json=autoTechnology,proto3" json:"auto_technology,omitempty"`
+	DnsMonitor     bool     `protobuf:"varint,56,opt,name=dns_monitor,json=dnsMonitor,proto3" json:"dns_monitor,omitempty"`
+	DnsFilter      []string `protobuf:"bytes,57,rep,name=dns_filter,json=dnsFilter,proto3" json:"dns_filter,omitempty"`
+	NetworkManager bool     `protobuf:"varint,58,opt,name=network_manager,json=networkManager,proto3" json:"network_manager,omitempty"`
 }
 
 func (x *Settings) Reset() {
@@ -290,6 +343,293 @@ func (x *Settings) GetObfuscate() bool {
 	return false
 }
 
+func (x *Settings) GetDefaultRouteMode() DefaultRouteMode {
+	if x != nil {
+		return x.DefaultRouteMode
+	}
+	return DefaultRouteMode_REPLACE
+}
+
+func (x *Settings) GetOnDemand() bool {
+	if x != nil {
+		return x.OnDemand
+	}
+	return false
+}
+
+func (x *Settings) GetOnDemandIdleTimeout() uint32 {
+	if x != nil {
+		return x.OnDemandIdleTimeout
+	}
+	return 0
+}
+
+func (x *Settings) GetFirewallTemplates() *FirewallTemplates {
+	if x != nil {
+		return x.FirewallTemplates
+	}
+	return nil
+}
+
+func (x *Settings) GetDnsIpv6() bool {
+	if x != nil {
+		return x.DnsIpv6
+	}
+	return false
+}
+
+func (x *Settings) GetIdleTimeout() uint32 {
+	if x != nil {
+		return x.IdleTimeout
+	}
+	return 0
+}
+
+func (x *Settings) GetIdleTimeoutKillSwitch() bool {
+	if x != nil {
+		return x.IdleTimeoutKillSwitch
+	}
+	return false
+}
+
+func (x *Settings) GetRatingWeight() uint32 {
+	if x != nil {
+		return x.RatingWeight
+	}
+	return 0
+}
+
+func (x *Settings) GetKillSwitchLog() bool {
+	if x != nil {
+		return x.KillSwitchLog
+	}
+	return false
+}
+
+func (x *Settings) GetMetered() *Metered {
+	if x != nil {
+		return x.Metered
+	}
+	return nil
+}
+
+func (x *Settings) GetAllowedTechnologies() []config.Technology {
+	if x != nil {
+		return x.AllowedTechnologies
+	}
+	return nil
+}
+
+func (x *Settings) GetSubnetOverlapMode() SubnetOverlapMode {
+	if x != nil {
+		return x.SubnetOverlapMode
+	}
+	return SubnetOverlapMode_WARN
+}
+
+func (x *Settings) GetCaptivePortalNetworks() []string {
+	if x != nil {
+		return x.CaptivePortalNetworks
+	}
+	return nil
+}
+
+func (x *Settings) GetDnsSearchDomains() []string {
+	if x != nil {
+		return x.DnsSearchDomains
+	}
+	return nil
+}
+
+func (x *Settings) GetFirewallBackend() FirewallBackend {
+	if x != nil {
+		return x.FirewallBackend
+	}
+	return FirewallBackend_IPTABLES
+}
+
+func (x *Settings) GetSplitTunnel() *SplitTunnel {
+	if x != nil {
+		return x.SplitTunnel
+	}
+	return nil
+}
+
+func (x *Settings) GetMetrics() *Metrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *Settings) GetAutoconnectRules() []*AutoConnectRule {
+	if x != nil {
+		return x.AutoconnectRules
+	}
+	return nil
+}
+
+func (x *Settings) GetLocalProxy() *LocalProxy {
+	if x != nil {
+		return x.LocalProxy
+	}
+	return nil
+}
+
+func (x *Settings) GetServerPorts() *ServerPorts {
+	if x != nil {
+		return x.ServerPorts
+	}
+	return nil
+}
+
+func (x *Settings) GetKillSwitchLan() bool {
+	if x != nil {
+		return x.KillSwitchLan
+	}
+	return false
+}
+
+func (x *Settings) GetLogLevel() LogLevel {
+	if x != nil {
+		return x.LogLevel
+	}
+	return LogLevel_LOG_LEVEL_INFO
+}
+
+func (x *Settings) GetServerSelection() ServerSelection {
+	if x != nil {
+		return x.ServerSelection
+	}
+	return ServerSelection_SERVER_SELECTION_LOAD
+}
+
+func (x *Settings) GetTcpNetworks() []string {
+	if x != nil {
+		return x.TcpNetworks
+	}
+	return nil
+}
+
+func (x *Settings) GetObfuscationMode() ObfuscationMode {
+	if x != nil {
+		return x.ObfuscationMode
+	}
+	return ObfuscationMode_OBFUSCATION_MODE_XOR
+}
+
+func (x *Settings) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *Settings) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *Settings) GetRoutingTable() uint32 {
+	if x != nil {
+		return x.RoutingTable
+	}
+	return 0
+}
+
+func (x *Settings) GetLegacyDns() bool {
+	if x != nil {
+		return x.LegacyDns
+	}
+	return false
+}
+
+func (x *Settings) GetTrustedNetworks() []string {
+	if x != nil {
+		return x.TrustedNetworks
+	}
+	return nil
+}
+
+func (x *Settings) GetReconnect() *ReconnectPolicy {
+	if x != nil {
+		return x.Reconnect
+	}
+	return nil
+}
+
+func (x *Settings) GetRestApi() *RESTAPI {
+	if x != nil {
+		return x.RestApi
+	}
+	return nil
+}
+
+func (x *Settings) GetDnsRoutes() []*DNSRoute {
+	if x != nil {
+		return x.DnsRoutes
+	}
+	return nil
+}
+
+func (x *Settings) GetIpv6Mode() IPv6Mode {
+	if x != nil {
+		return x.Ipv6Mode
+	}
+	return IPv6Mode_IPV6_MODE_BLOCK
+}
+
+func (x *Settings) GetMulticastDiscovery() bool {
+	if x != nil {
+		return x.MulticastDiscovery
+	}
+	return false
+}
+
+func (x *Settings) GetVirtualLocation() bool {
+	if x != nil {
+		return x.VirtualLocation
+	}
+	return false
+}
+
+func (x *Settings) GetObfuscatedNetworks() []string {
+	if x != nil {
+		return x.ObfuscatedNetworks
+	}
+	return nil
+}
+
+func (x *Settings) GetAutoTechnology() bool {
+	if x != nil {
+		return x.AutoTechnology
+	}
+	return false
+}
+
+func (x *Settings) GetDnsMonitor() bool {
+	if x != nil {
+		return x.DnsMonitor
+	}
+	return false
+}
+
+func (x *Settings) GetDnsFilter() []string {
+	if x != nil {
+		return x.DnsFilter
+	}
+	return nil
+}
+
+func (x *Settings) GetNetworkManager() bool {
+	if x != nil {
+		return x.NetworkManager
+	}
+	return false
+}
+
 var File_settings_proto protoreflect.FileDescriptor
 
 var file_settings_proto_rawDesc = []byte{
@@ -298,51 +638,180 @@ var file_settings_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x74, 0x65, 0x63, 0x68, 0x6e,
 	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x63, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x23, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x9a, 0x04, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x32,
-	0x0a, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x65, 0x63, 0x68,
-	0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f,
-	0x67, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x12, 0x1f,
-	0x0a, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x6b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12,
-	0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x70,
-	0x76, 0x36, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x70, 0x76, 0x36, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x75, 0x74,
-	0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69,
-	0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x77, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x06, 0x66, 0x77, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e,
-	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61,
-	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6e, 0x73, 0x18,
-	0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x64, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x68,
-	0x72, 0x65, 0x61, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x6c, 0x69, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x74, 0x68, 0x72, 0x65,
-	0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65,
-	0x12, 0x2c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x23,
-	0x0a, 0x0d, 0x6c, 0x61, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6c, 0x61, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x79, 0x12, 0x2b, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x6f,
-	0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x42, 0x31,
-	0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72,
-	0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70,
-	0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70,
-	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x6f, 0x1a, 0x17, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x72,
+	0x65, 0x73, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x09, 0x73, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x23, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x8d, 0x13, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x0a,
+	0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x52, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x6b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x36,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x70, 0x76, 0x36, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x77, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x66, 0x77, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6e, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x03, 0x64, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69,
+	0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74,
+	0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x12, 0x2c,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x23, 0x0a, 0x0d,
+	0x6c, 0x61, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x6c, 0x61, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x12, 0x2b, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c,
+	0x69, 0x73, 0x74, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x12,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x10,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x6e, 0x44, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x33, 0x0a,
+	0x16, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6f,
+	0x6e, 0x44, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x12, 0x44, 0x0a, 0x12, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x11, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x6e, 0x73, 0x5f,
+	0x69, 0x70, 0x76, 0x36, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x6e, 0x73, 0x49,
+	0x70, 0x76, 0x36, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x64, 0x6c, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x64, 0x6c, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6b,
+	0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x12, 0x25, 0x0a, 0x07,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x65, 0x64, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x74,
+	0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x69, 0x65, 0x73, 0x18, 0x1b, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x6e,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x54, 0x65,
+	0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x69, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x13, 0x73, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x11,
+	0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x1d, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x15, 0x63, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x6e, 0x73,
+	0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18,
+	0x1e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x64, 0x6e, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x10, 0x66, 0x69, 0x72, 0x65, 0x77,
+	0x61, 0x6c, 0x6c, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x1f, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x0f, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c,
+	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x0c, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x0b,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x25, 0x0a, 0x07, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
+	0x62, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x12, 0x40, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x22, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x10, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x32, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x0b, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6b, 0x69, 0x6c,
+	0x6c, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x6c, 0x61, 0x6e, 0x18, 0x26, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x6b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4c, 0x61,
+	0x6e, 0x12, 0x29, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x27,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3e, 0x0a, 0x10,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x28, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x63, 0x70, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x29, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x74, 0x63, 0x70, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12,
+	0x3e, 0x0a, 0x10, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x4f,
+	0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0f,
+	0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x2c, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x64, 0x6e, 0x73, 0x18, 0x2e, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x18, 0x2f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x18, 0x30, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x09, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x45, 0x53, 0x54, 0x41, 0x50, 0x49, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74, 0x41,
+	0x70, 0x69, 0x12, 0x2b, 0x0a, 0x0a, 0x64, 0x6e, 0x73, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x32, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x52, 0x09, 0x64, 0x6e, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12,
+	0x29, 0x0a, 0x09, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x33, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x50, 0x76, 0x36, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x08, 0x69, 0x70, 0x76, 0x36, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x18, 0x34, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61,
+	0x73, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x76,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x35, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x36, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x12, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x75, 0x74, 0x6f, 0x5f,
+	0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x37, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6e, 0x73, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x18,
+	0x38, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x6e, 0x73, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x6e, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x39, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x6e, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x4a, 0x04, 0x08, 0x23, 0x10, 0x24, 0x42,
+	0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f,
+	0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76,
+	0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -359,23 +828,58 @@ func file_settings_proto_rawDescGZIP() []byte {
 
 var file_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_settings_proto_goTypes = []interface{}{
-	(*SettingsRequest)(nil),  // 0: pb.SettingsRequest
-	(*SettingsResponse)(nil), // 1: pb.SettingsResponse
-	(*Settings)(nil),         // 2: pb.Settings
-	(config.Technology)(0),   // 3: config.Technology
-	(config.Protocol)(0),     // 4: config.Protocol
-	(*Allowlist)(nil),        // 5: pb.Allowlist
+	(*SettingsRequest)(nil),   // 0: pb.SettingsRequest
+	(*SettingsResponse)(nil),  // 1: pb.SettingsResponse
+	(*Settings)(nil),          // 2: pb.Settings
+	(config.Technology)(0),    // 3: config.Technology
+	(config.Protocol)(0),      // 4: config.Protocol
+	(*Allowlist)(nil),         // 5: pb.Allowlist
+	(DefaultRouteMode)(0),     // 6: pb.DefaultRouteMode
+	(*FirewallTemplates)(nil), // 7: pb.FirewallTemplates
+	(*Metered)(nil),           // 8: pb.Metered
+	(SubnetOverlapMode)(0),    // 9: pb.SubnetOverlapMode
+	(FirewallBackend)(0),      // 10: pb.FirewallBackend
+	(*SplitTunnel)(nil),       // 11: pb.SplitTunnel
+	(*Metrics)(nil),           // 12: pb.Metrics
+	(*AutoConnectRule)(nil),   // 13: pb.AutoConnectRule
+	(*LocalProxy)(nil),        // 14: pb.LocalProxy
+	(*ServerPorts)(nil),       // 15: pb.ServerPorts
+	(LogLevel)(0),             // 16: pb.LogLevel
+	(ServerSelection)(0),      // 17: pb.ServerSelection
+	(ObfuscationMode)(0),      // 18: pb.ObfuscationMode
+	(*ReconnectPolicy)(nil),   // 19: pb.ReconnectPolicy
+	(*RESTAPI)(nil),           // 20: pb.RESTAPI
+	(*DNSRoute)(nil),          // 21: pb.DNSRoute
+	(IPv6Mode)(0),             // 22: pb.IPv6Mode
 }
 var file_settings_proto_depIdxs = []int32{
-	2, // 0: pb.SettingsResponse.data:type_name -> pb.Settings
-	3, // 1: pb.Settings.technology:type_name -> config.Technology
-	4, // 2: pb.Settings.protocol:type_name -> config.Protocol
-	5, // 3: pb.Settings.allowlist:type_name -> pb.Allowlist
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	2,  // 0: pb.SettingsResponse.data:type_name -> pb.Settings
+	3,  // 1: pb.Settings.technology:type_name -> config.Technology
+	4,  // 2: pb.Settings.protocol:type_name -> config.Protocol
+	5,  // 3: pb.Settings.allowlist:type_name -> pb.Allowlist
+	6,  // 4: pb.Settings.default_route_mode:type_name -> pb.DefaultRouteMode
+	7,  // 5: pb.Settings.firewall_templates:type_name -> pb.FirewallTemplates
+	8,  // 6: pb.Settings.metered:type_name -> pb.Metered
+	3,  // 7: pb.Settings.allowed_technologies:type_name -> config.Technology
+	9,  // 8: pb.Settings.subnet_overlap_mode:type_name -> pb.SubnetOverlapMode
+	10, // 9: pb.Settings.firewall_backend:type_name -> pb.FirewallBackend
+	11, // 10: pb.Settings.split_tunnel:type_name -> pb.SplitTunnel
+	12, // 11: pb.Settings.metrics:type_name -> pb.Metrics
+	13, // 12: pb.Settings.autoconnect_rules:type_name -> pb.AutoConnectRule
+	14, // 13: pb.Settings.local_proxy:type_name -> pb.LocalProxy
+	15, // 14: pb.Settings.server_ports:type_name -> pb.ServerPorts
+	16, // 15: pb.Settings.log_level:type_name -> pb.LogLevel
+	17, // 16: pb.Settings.server_selection:type_name -> pb.ServerSelection
+	18, // 17: pb.Settings.obfuscation_mode:type_name -> pb.ObfuscationMode
+	19, // 18: pb.Settings.reconnect:type_name -> pb.ReconnectPolicy
+	20, // 19: pb.Settings.rest_api:type_name -> pb.RESTAPI
+	21, // 20: pb.Settings.dns_routes:type_name -> pb.DNSRoute
+	22, // 21: pb.Settings.ipv6_mode:type_name -> pb.IPv6Mode
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_settings_proto_init() }
@@ -384,6 +888,15 @@ func file_settings_proto_init() {
 		return
 	}
 	file_common_proto_init()
+	file_autoconnect_rules_proto_init()
+	file_local_proxy_proto_init()
+	file_metered_proto_init()
+	file_metrics_proto_init()
+	file_reconnect_proto_init()
+	file_rest_api_proto_init()
+	file_server_ports_proto_init()
+	file_set_proto_init()
+	file_split_tunnel_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_settings_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SettingsRequest); i {
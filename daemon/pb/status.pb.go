@@ -36,6 +36,32 @@ type StatusResponse struct {
 	Download   uint64            `protobuf:"varint,8,opt,name=download,proto3" json:"download,omitempty"`
 	Upload     uint64            `protobuf:"varint,9,opt,name=upload,proto3" json:"upload,omitempty"`
 	Uptime     int64             `protobuf:"varint,10,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	// idle_disconnect_in is the number of seconds until the idle connection is
+	// torn down, 0 when idle disconnect is disabled
+	IdleDisconnectIn int64 `protobuf:"varint,11,opt,name=idle_disconnect_in,json=idleDisconnectIn,proto3" json:"idle_disconnect_in,omitempty"`
+	// note and rating given to the server by the user
+	Note   string `protobuf:"bytes,12,opt,name=note,proto3" json:"note,omitempty"`
+	Rating uint32 `protobuf:"varint,13,opt,name=rating,proto3" json:"rating,omitempty"`
+	// resume_in is the number of seconds until the paused connection is
+	// resumed, 0 when not paused
+	ResumeIn int64 `protobuf:"varint,14,opt,name=resume_in,json=resumeIn,proto3" json:"resume_in,omitempty"`
+	// via is the hostname of the entry server of the multi-hop connection
+	Via string `protobuf:"bytes,15,opt,name=via,proto3" json:"via,omitempty"`
+	// download_rate and upload_rate are the current throughput of the
+	// connection in bytes per second
+	DownloadRate uint64 `protobuf:"varint,17,opt,name=download_rate,json=downloadRate,proto3" json:"download_rate,omitempty"`
+	UploadRate   uint64 `protobuf:"varint,18,opt,name=upload_rate,json=uploadRate,proto3" json:"upload_rate,omitempty"`
+	// handshake_age is the number of seconds since the latest handshake with
+	// the server rounded up, 0 when not known
+	HandshakeAge int64 `protobuf:"varint,19,opt,name=handshake_age,json=handshakeAge,proto3" json:"handshake_age,omitempty"`
+	// server_load is the load of the connected server in percent
+	ServerLoad int64 `protobuf:"varint,20,opt,name=server_load,json=serverLoad,proto3" json:"server_load,omitempty"`
+	// data_plane is the WireGuard implementation used by the NordLynx
+	// connection, kernel or userspace
+	DataPlane string `protobuf:"bytes,21,opt,name=data_plane,json=dataPlane,proto3" json:"data_plane,omitempty"`
+	// uplink is the network interface the tunnel is pinned to, empty if it
+	// follows the default route
+	Uplink string `protobuf:"bytes,22,opt,name=uplink,proto3" json:"uplink,omitempty"`
 }
 
 func (x *StatusResponse) Reset() {
@@ -140,6 +166,426 @@ func (x *StatusResponse) GetUptime() int64 {
 	return 0
 }
 
+func (x *StatusResponse) GetIdleDisconnectIn() int64 {
+	if x != nil {
+		return x.IdleDisconnectIn
+	}
+	return 0
+}
+
+func (x *StatusResponse) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetRating() uint32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *StatusResponse) GetResumeIn() int64 {
+	if x != nil {
+		return x.ResumeIn
+	}
+	return 0
+}
+
+func (x *StatusResponse) GetVia() string {
+	if x != nil {
+		return x.Via
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetDownloadRate() uint64 {
+	if x != nil {
+		return x.DownloadRate
+	}
+	return 0
+}
+
+func (x *StatusResponse) GetUploadRate() uint64 {
+	if x != nil {
+		return x.UploadRate
+	}
+	return 0
+}
+
+func (x *StatusResponse) GetHandshakeAge() int64 {
+	if x != nil {
+		return x.HandshakeAge
+	}
+	return 0
+}
+
+func (x *StatusResponse) GetServerLoad() int64 {
+	if x != nil {
+		return x.ServerLoad
+	}
+	return 0
+}
+
+func (x *StatusResponse) GetDataPlane() string {
+	if x != nil {
+		return x.DataPlane
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetUplink() string {
+	if x != nil {
+		return x.Uplink
+	}
+	return ""
+}
+
+type ConnectionIPsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type int64 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	// entry_ip is the address of the VPN server the tunnel is established with
+	EntryIp string `protobuf:"bytes,2,opt,name=entry_ip,json=entryIp,proto3" json:"entry_ip,omitempty"`
+	// exit_ip is the address the traffic leaves the VPN from as seen by the
+	// internet, empty if the lookup through the tunnel failed
+	ExitIp          string `protobuf:"bytes,3,opt,name=exit_ip,json=exitIp,proto3" json:"exit_ip,omitempty"`
+	ExitCountryCode string `protobuf:"bytes,4,opt,name=exit_country_code,json=exitCountryCode,proto3" json:"exit_country_code,omitempty"`
+}
+
+func (x *ConnectionIPsResponse) Reset() {
+	*x = ConnectionIPsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_status_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionIPsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionIPsResponse) ProtoMessage() {}
+
+func (x *ConnectionIPsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_status_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionIPsResponse.ProtoReflect.Descriptor instead.
+func (*ConnectionIPsResponse) Descriptor() ([]byte, []int) {
+	return file_status_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConnectionIPsResponse) GetType() int64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ConnectionIPsResponse) GetEntryIp() string {
+	if x != nil {
+		return x.EntryIp
+	}
+	return ""
+}
+
+func (x *ConnectionIPsResponse) GetExitIp() string {
+	if x != nil {
+		return x.ExitIp
+	}
+	return ""
+}
+
+func (x *ConnectionIPsResponse) GetExitCountryCode() string {
+	if x != nil {
+		return x.ExitCountryCode
+	}
+	return ""
+}
+
+type TunnelOverheadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// measure_seconds is the duration of the measurement, 0 skips the measurement
+	MeasureSeconds uint32 `protobuf:"varint,1,opt,name=measure_seconds,json=measureSeconds,proto3" json:"measure_seconds,omitempty"`
+}
+
+func (x *TunnelOverheadRequest) Reset() {
+	*x = TunnelOverheadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_status_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelOverheadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelOverheadRequest) ProtoMessage() {}
+
+func (x *TunnelOverheadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_status_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelOverheadRequest.ProtoReflect.Descriptor instead.
+func (*TunnelOverheadRequest) Descriptor() ([]byte, []int) {
+	return file_status_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TunnelOverheadRequest) GetMeasureSeconds() uint32 {
+	if x != nil {
+		return x.MeasureSeconds
+	}
+	return 0
+}
+
+// TunnelMeasurement contains traffic counted on the tunnel and the link
+// interfaces during the measurement
+type TunnelMeasurement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DurationSeconds uint32 `protobuf:"varint,1,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	TunnelBytes     uint64 `protobuf:"varint,2,opt,name=tunnel_bytes,json=tunnelBytes,proto3" json:"tunnel_bytes,omitempty"`
+	TunnelPackets   uint64 `protobuf:"varint,3,opt,name=tunnel_packets,json=tunnelPackets,proto3" json:"tunnel_packets,omitempty"`
+	LinkBytes       uint64 `protobuf:"varint,4,opt,name=link_bytes,json=linkBytes,proto3" json:"link_bytes,omitempty"`
+	// overhead is the average amount of bytes added to a packet
+	Overhead float64 `protobuf:"fixed64,5,opt,name=overhead,proto3" json:"overhead,omitempty"`
+	// goodput is the ratio of the link traffic used by the payload
+	Goodput float64 `protobuf:"fixed64,6,opt,name=goodput,proto3" json:"goodput,omitempty"`
+}
+
+func (x *TunnelMeasurement) Reset() {
+	*x = TunnelMeasurement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_status_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelMeasurement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelMeasurement) ProtoMessage() {}
+
+func (x *TunnelMeasurement) ProtoReflect() protoreflect.Message {
+	mi := &file_status_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelMeasurement.ProtoReflect.Descriptor instead.
+func (*TunnelMeasurement) Descriptor() ([]byte, []int) {
+	return file_status_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TunnelMeasurement) GetDurationSeconds() uint32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *TunnelMeasurement) GetTunnelBytes() uint64 {
+	if x != nil {
+		return x.TunnelBytes
+	}
+	return 0
+}
+
+func (x *TunnelMeasurement) GetTunnelPackets() uint64 {
+	if x != nil {
+		return x.TunnelPackets
+	}
+	return 0
+}
+
+func (x *TunnelMeasurement) GetLinkBytes() uint64 {
+	if x != nil {
+		return x.LinkBytes
+	}
+	return 0
+}
+
+func (x *TunnelMeasurement) GetOverhead() float64 {
+	if x != nil {
+		return x.Overhead
+	}
+	return 0
+}
+
+func (x *TunnelMeasurement) GetGoodput() float64 {
+	if x != nil {
+		return x.Goodput
+	}
+	return 0
+}
+
+type TunnelOverheadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type          int64             `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Technology    config.Technology `protobuf:"varint,2,opt,name=technology,proto3,enum=config.Technology" json:"technology,omitempty"`
+	Protocol      config.Protocol   `protobuf:"varint,3,opt,name=protocol,proto3,enum=config.Protocol" json:"protocol,omitempty"`
+	Interface     string            `protobuf:"bytes,4,opt,name=interface,proto3" json:"interface,omitempty"`
+	TunnelMtu     uint32            `protobuf:"varint,5,opt,name=tunnel_mtu,json=tunnelMtu,proto3" json:"tunnel_mtu,omitempty"`
+	LinkInterface string            `protobuf:"bytes,6,opt,name=link_interface,json=linkInterface,proto3" json:"link_interface,omitempty"`
+	LinkMtu       uint32            `protobuf:"varint,7,opt,name=link_mtu,json=linkMtu,proto3" json:"link_mtu,omitempty"`
+	// overhead is the amount of bytes added to every packet by the tunnel
+	Overhead uint32 `protobuf:"varint,8,opt,name=overhead,proto3" json:"overhead,omitempty"`
+	// goodput is the ratio of the link traffic used by the payload of full sized packets
+	Goodput float64 `protobuf:"fixed64,9,opt,name=goodput,proto3" json:"goodput,omitempty"`
+	// link_goodput is the goodput of the link without the tunnel
+	LinkGoodput float64            `protobuf:"fixed64,10,opt,name=link_goodput,json=linkGoodput,proto3" json:"link_goodput,omitempty"`
+	Measurement *TunnelMeasurement `protobuf:"bytes,11,opt,name=measurement,proto3" json:"measurement,omitempty"`
+}
+
+func (x *TunnelOverheadResponse) Reset() {
+	*x = TunnelOverheadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_status_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelOverheadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelOverheadResponse) ProtoMessage() {}
+
+func (x *TunnelOverheadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_status_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelOverheadResponse.ProtoReflect.Descriptor instead.
+func (*TunnelOverheadResponse) Descriptor() ([]byte, []int) {
+	return file_status_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TunnelOverheadResponse) GetType() int64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *TunnelOverheadResponse) GetTechnology() config.Technology {
+	if x != nil {
+		return x.Technology
+	}
+	return config.Technology(0)
+}
+
+func (x *TunnelOverheadResponse) GetProtocol() config.Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return config.Protocol(0)
+}
+
+func (x *TunnelOverheadResponse) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *TunnelOverheadResponse) GetTunnelMtu() uint32 {
+	if x != nil {
+		return x.TunnelMtu
+	}
+	return 0
+}
+
+func (x *TunnelOverheadResponse) GetLinkInterface() string {
+	if x != nil {
+		return x.LinkInterface
+	}
+	return ""
+}
+
+func (x *TunnelOverheadResponse) GetLinkMtu() uint32 {
+	if x != nil {
+		return x.LinkMtu
+	}
+	return 0
+}
+
+func (x *TunnelOverheadResponse) GetOverhead() uint32 {
+	if x != nil {
+		return x.Overhead
+	}
+	return 0
+}
+
+func (x *TunnelOverheadResponse) GetGoodput() float64 {
+	if x != nil {
+		return x.Goodput
+	}
+	return 0
+}
+
+func (x *TunnelOverheadResponse) GetLinkGoodput() float64 {
+	if x != nil {
+		return x.LinkGoodput
+	}
+	return 0
+}
+
+func (x *TunnelOverheadResponse) GetMeasurement() *TunnelMeasurement {
+	if x != nil {
+		return x.Measurement
+	}
+	return nil
+}
+
 var File_status_proto protoreflect.FileDescriptor
 
 var file_status_proto_rawDesc = []byte{
@@ -147,7 +593,7 @@ var file_status_proto_rawDesc = []byte{
 	0x70, 0x62, 0x1a, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x63, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x2f, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xae, 0x02, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x22, 0x80, 0x05, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x74,
 	0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
@@ -166,10 +612,85 @@ var file_status_proto_rawDesc = []byte{
 	0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x09, 0x20,
 	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75,
 	0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x70, 0x74,
-	0x69, 0x6d, 0x65, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e,
-	0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x69, 0x64, 0x6c, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69,
+	0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x69, 0x61, 0x12, 0x23, 0x0a, 0x0d,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x5f,
+	0x61, 0x67, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x68, 0x61, 0x6e, 0x64, 0x73,
+	0x68, 0x61, 0x6b, 0x65, 0x41, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61,
+	0x74, 0x61, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x6c, 0x69, 0x6e,
+	0x6b, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x4a,
+	0x04, 0x08, 0x10, 0x10, 0x11, 0x22, 0x8b, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x50, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x70, 0x12, 0x17,
+	0x0a, 0x07, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x78, 0x69, 0x74, 0x49, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x69, 0x74, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x22, 0x40, 0x0a, 0x15, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x76, 0x65,
+	0x72, 0x68, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x11, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x68, 0x65, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x68, 0x65, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x67,
+	0x6f, 0x6f, 0x64, 0x70, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x67, 0x6f,
+	0x6f, 0x64, 0x70, 0x75, 0x74, 0x22, 0x9f, 0x03, 0x0a, 0x16, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4f, 0x76, 0x65, 0x72, 0x68, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f,
+	0x67, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x0a, 0x74, 0x65,
+	0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d,
+	0x74, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4d, 0x74, 0x75, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x69, 0x6e,
+	0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x69,
+	0x6e, 0x6b, 0x5f, 0x6d, 0x74, 0x75, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6c, 0x69,
+	0x6e, 0x6b, 0x4d, 0x74, 0x75, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x68, 0x65, 0x61,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x68, 0x65, 0x61,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x6f, 0x6f, 0x64, 0x70, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x67, 0x6f, 0x6f, 0x64, 0x70, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x67, 0x6f, 0x6f, 0x64, 0x70, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x6b, 0x47, 0x6f, 0x6f, 0x64, 0x70, 0x75, 0x74, 0x12, 0x37,
+	0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78,
+	0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -184,20 +705,27 @@ func file_status_proto_rawDescGZIP() []byte {
 	return file_status_proto_rawDescData
 }
 
-var file_status_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_status_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_status_proto_goTypes = []interface{}{
-	(*StatusResponse)(nil), // 0: pb.StatusResponse
-	(config.Technology)(0), // 1: config.Technology
-	(config.Protocol)(0),   // 2: config.Protocol
+	(*StatusResponse)(nil),         // 0: pb.StatusResponse
+	(*ConnectionIPsResponse)(nil),  // 1: pb.ConnectionIPsResponse
+	(*TunnelOverheadRequest)(nil),  // 2: pb.TunnelOverheadRequest
+	(*TunnelMeasurement)(nil),      // 3: pb.TunnelMeasurement
+	(*TunnelOverheadResponse)(nil), // 4: pb.TunnelOverheadResponse
+	(config.Technology)(0),         // 5: config.Technology
+	(config.Protocol)(0),           // 6: config.Protocol
 }
 var file_status_proto_depIdxs = []int32{
-	1, // 0: pb.StatusResponse.technology:type_name -> config.Technology
-	2, // 1: pb.StatusResponse.protocol:type_name -> config.Protocol
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5, // 0: pb.StatusResponse.technology:type_name -> config.Technology
+	6, // 1: pb.StatusResponse.protocol:type_name -> config.Protocol
+	5, // 2: pb.TunnelOverheadResponse.technology:type_name -> config.Technology
+	6, // 3: pb.TunnelOverheadResponse.protocol:type_name -> config.Protocol
+	3, // 4: pb.TunnelOverheadResponse.measurement:type_name -> pb.TunnelMeasurement
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_status_proto_init() }
@@ -218,6 +746,54 @@ func file_status_proto_init() {
 				return nil
 			}
 		}
+		file_status_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionIPsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_status_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelOverheadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_status_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelMeasurement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_status_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelOverheadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -225,7 +801,7 @@ func file_status_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_status_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
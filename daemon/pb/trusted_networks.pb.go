@@ -0,0 +1,156 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: trusted_networks.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SetTrustedNetworkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// network connection name or subnet in CIDR notation
+	Network string `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Trusted bool   `protobuf:"varint,2,opt,name=trusted,proto3" json:"trusted,omitempty"`
+}
+
+func (x *SetTrustedNetworkRequest) Reset() {
+	*x = SetTrustedNetworkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trusted_networks_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTrustedNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTrustedNetworkRequest) ProtoMessage() {}
+
+func (x *SetTrustedNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trusted_networks_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTrustedNetworkRequest.ProtoReflect.Descriptor instead.
+func (*SetTrustedNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_trusted_networks_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetTrustedNetworkRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *SetTrustedNetworkRequest) GetTrusted() bool {
+	if x != nil {
+		return x.Trusted
+	}
+	return false
+}
+
+var File_trusted_networks_proto protoreflect.FileDescriptor
+
+var file_trusted_networks_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x4e, 0x0a, 0x18,
+	0x53, 0x65, 0x74, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x42, 0x31, 0x5a, 0x2f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d,
+	0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_trusted_networks_proto_rawDescOnce sync.Once
+	file_trusted_networks_proto_rawDescData = file_trusted_networks_proto_rawDesc
+)
+
+func file_trusted_networks_proto_rawDescGZIP() []byte {
+	file_trusted_networks_proto_rawDescOnce.Do(func() {
+		file_trusted_networks_proto_rawDescData = protoimpl.X.CompressGZIP(file_trusted_networks_proto_rawDescData)
+	})
+	return file_trusted_networks_proto_rawDescData
+}
+
+var file_trusted_networks_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_trusted_networks_proto_goTypes = []interface{}{
+	(*SetTrustedNetworkRequest)(nil), // 0: pb.SetTrustedNetworkRequest
+}
+var file_trusted_networks_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_trusted_networks_proto_init() }
+func file_trusted_networks_proto_init() {
+	if File_trusted_networks_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_trusted_networks_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTrustedNetworkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_trusted_networks_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_trusted_networks_proto_goTypes,
+		DependencyIndexes: file_trusted_networks_proto_depIdxs,
+		MessageInfos:      file_trusted_networks_proto_msgTypes,
+	}.Build()
+	File_trusted_networks_proto = out.File
+	file_trusted_networks_proto_rawDesc = nil
+	file_trusted_networks_proto_goTypes = nil
+	file_trusted_networks_proto_depIdxs = nil
+}
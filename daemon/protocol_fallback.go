@@ -0,0 +1,113 @@
+package daemon
+
+import (
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"golang.org/x/exp/slices"
+)
+
+// udpAttemptsBeforeTCP is how many times OpenVPN over UDP is tried before
+// falling back to TCP
+const udpAttemptsBeforeTCP = 2
+
+// tcpFallbackPort is used by HTTPS, so it is rarely blocked by firewalls
+const tcpFallbackPort = 443
+
+// ConnectWithTCPFallback behaves like Connect, but retries the failed OpenVPN
+// connection over UDP and falls back to TCP once UDP failed repeatedly, which
+// usually means that it is blocked by the network. onFallback is called before
+// connecting over TCP.
+func ConnectWithTCPFallback(
+	events chan ConnectEvent,
+	creds vpn.Credentials,
+	serverData vpn.ServerData,
+	allowlist config.Allowlist,
+	nameservers []string,
+	netw networker.Networker,
+	onFallback func(),
+) {
+	defer close(events)
+
+	for attempt := 1; ; attempt++ {
+		attemptEvents := make(chan ConnectEvent)
+		go Connect(attemptEvents, creds, serverData, allowlist, nameservers, netw)
+
+		var failure *ConnectEvent
+		for ev := range attemptEvents {
+			ev := ev
+			switch {
+			case ev.Code == internal.CodeFailure:
+				failure = &ev
+			case ev.Code == internal.CodeConnecting && attempt > 1:
+				// the user was already notified about connecting
+			default:
+				events <- ev
+			}
+		}
+
+		if failure == nil {
+			return
+		}
+		if serverData.Protocol != config.Protocol_UDP {
+			events <- *failure
+			return
+		}
+
+		log.Printf("%s OpenVPN over UDP failed (attempt %d of %d): %s",
+			internal.WarningPrefix, attempt, udpAttemptsBeforeTCP, failure.Message)
+		if attempt < udpAttemptsBeforeTCP {
+			continue
+		}
+
+		log.Println(internal.InfoPrefix, "falling back to OpenVPN over TCP on port", tcpFallbackPort)
+		serverData.Protocol = config.Protocol_TCP
+		serverData.Port = tcpFallbackPort
+		onFallback()
+		events <- ConnectEvent{Code: internal.CodeProtocolFallback}
+	}
+}
+
+// usesOpenVPNOverUDP reports whether the connections use OpenVPN over UDP
+// without obfuscation, which can fall back to TCP. Obfuscated servers are
+// picked separately for each protocol, so they are not retried.
+func usesOpenVPNOverUDP(cfg config.Config) bool {
+	return cfg.Technology == config.Technology_OPENVPN &&
+		cfg.AutoConnectData.Protocol == config.Protocol_UDP &&
+		!cfg.AutoConnectData.Obfuscate
+}
+
+// networkName returns the name of the primary network connection, empty if
+// not known
+func (r *RPC) networkName() string {
+	if r.metered == nil || r.metered.detector == nil {
+		return ""
+	}
+	connection, err := r.metered.detector.PrimaryConnection()
+	if err != nil {
+		log.Println(internal.WarningPrefix, "detecting network connection:", err)
+		return ""
+	}
+	return connection.Name
+}
+
+// preferTCP makes the connections on the network use OpenVPN over TCP right
+// away
+func (r *RPC) preferTCP(network string) {
+	if network == "" {
+		return
+	}
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		if !slices.Contains(c.TCPNetworks, network) {
+			c.TCPNetworks = append(c.TCPNetworks, network)
+		}
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, "saving TCP preference of the network:", err)
+		return
+	}
+	log.Println(internal.InfoPrefix, "OpenVPN over TCP will be used on", network)
+}
@@ -0,0 +1,141 @@
+package daemon
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	mocknetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+// blockingNetworker fails to start the connections over the blocked protocols
+type blockingNetworker struct {
+	mocknetworker.Mock
+	blocked []config.Protocol
+	started []vpn.ServerData
+}
+
+func (n *blockingNetworker) Start(
+	_ vpn.Credentials,
+	serverData vpn.ServerData,
+	_ config.Allowlist,
+	_ config.DNS,
+	_ bool,
+) error {
+	n.started = append(n.started, serverData)
+	for _, protocol := range n.blocked {
+		if serverData.Protocol == protocol {
+			return errors.New("TLS handshake failed")
+		}
+	}
+	return nil
+}
+
+func TestConnectWithTCPFallback(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name              string
+		protocol          config.Protocol
+		blocked           []config.Protocol
+		expectedCodes     []int64
+		expectedProtocols []config.Protocol
+		fallback          bool
+	}{
+		{
+			name:              "udp works",
+			protocol:          config.Protocol_UDP,
+			expectedCodes:     []int64{internal.CodeConnecting, internal.CodeConnected},
+			expectedProtocols: []config.Protocol{config.Protocol_UDP},
+		},
+		{
+			name:     "udp blocked",
+			protocol: config.Protocol_UDP,
+			blocked:  []config.Protocol{config.Protocol_UDP},
+			expectedCodes: []int64{
+				internal.CodeConnecting,
+				internal.CodeProtocolFallback,
+				internal.CodeConnected,
+			},
+			expectedProtocols: []config.Protocol{
+				config.Protocol_UDP,
+				config.Protocol_UDP,
+				config.Protocol_TCP,
+			},
+			fallback: true,
+		},
+		{
+			name:     "udp and tcp blocked",
+			protocol: config.Protocol_UDP,
+			blocked:  []config.Protocol{config.Protocol_UDP, config.Protocol_TCP},
+			expectedCodes: []int64{
+				internal.CodeConnecting,
+				internal.CodeProtocolFallback,
+				internal.CodeFailure,
+			},
+			expectedProtocols: []config.Protocol{
+				config.Protocol_UDP,
+				config.Protocol_UDP,
+				config.Protocol_TCP,
+			},
+			fallback: true,
+		},
+		{
+			name:              "tcp blocked",
+			protocol:          config.Protocol_TCP,
+			blocked:           []config.Protocol{config.Protocol_TCP},
+			expectedCodes:     []int64{internal.CodeConnecting, internal.CodeFailure},
+			expectedProtocols: []config.Protocol{config.Protocol_TCP},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			netw := &blockingNetworker{blocked: test.blocked}
+			var fallback bool
+			events := make(chan ConnectEvent)
+			go ConnectWithTCPFallback(
+				events,
+				vpn.Credentials{},
+				vpn.ServerData{Protocol: test.protocol, Port: 1194},
+				config.Allowlist{},
+				nil,
+				netw,
+				func() { fallback = true },
+			)
+
+			var codes []int64
+			for ev := range events {
+				codes = append(codes, ev.Code)
+			}
+			assert.Equal(t, test.expectedCodes, codes)
+			assert.Equal(t, test.fallback, fallback)
+
+			var protocols []config.Protocol
+			for _, serverData := range netw.started {
+				protocols = append(protocols, serverData.Protocol)
+				if serverData.Protocol == config.Protocol_TCP && test.fallback {
+					assert.Equal(t, uint16(tcpFallbackPort), serverData.Port)
+				}
+			}
+			assert.Equal(t, test.expectedProtocols, protocols)
+		})
+	}
+}
+
+func TestRPC_PreferTCP(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := newMockConfigManager()
+	r := &RPC{cm: cm}
+
+	r.preferTCP("Airport Wi-Fi")
+	r.preferTCP("Airport Wi-Fi")
+	r.preferTCP("")
+
+	assert.Equal(t, []string{"Airport Wi-Fi"}, cm.c.TCPNetworks)
+}
@@ -0,0 +1,263 @@
+package proxy
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// SOCKS5 protocol constants defined in RFC 1928
+const (
+	socksVersion           = 5
+	socksMethodNoAuth      = 0
+	socksMethodNone        = 0xff
+	socksCommandConnect    = 1
+	socksAddressIPv4       = 1
+	socksAddressDomain     = 3
+	socksAddressIPv6       = 4
+	socksReplySuccess      = 0
+	socksReplyFailure      = 1
+	socksReplyNetwork      = 3
+	socksReplyHost         = 4
+	socksReplyRefused      = 5
+	socksReplyCommand      = 7
+	socksReplyAddressType  = 8
+	httpDefaultPort        = "80"
+	httpConnectEstablished = "HTTP/1.1 200 Connection established\r\n\r\n"
+)
+
+// handle the client connection. First byte tells apart SOCKS5 from HTTP, as
+// HTTP requests start with the method name.
+func (s *Server) handle(conn net.Conn) {
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return
+	}
+
+	reader := bufio.NewReader(conn)
+	first, err := reader.Peek(1)
+	if err != nil {
+		return
+	}
+
+	var upstream net.Conn
+	if first[0] == socksVersion {
+		upstream, err = s.handleSOCKS(conn, reader)
+	} else {
+		upstream, err = s.handleHTTP(conn, reader)
+	}
+	if err != nil {
+		return
+	}
+	defer upstream.Close()
+
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		return
+	}
+	pipe(conn, reader, upstream)
+}
+
+// handleSOCKS negotiates the connection without authentication. Only the
+// CONNECT command is supported.
+func (s *Server) handleSOCKS(conn net.Conn, reader *bufio.Reader) (net.Conn, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return nil, err
+	}
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(reader, methods); err != nil {
+		return nil, err
+	}
+	method := byte(socksMethodNone)
+	for _, m := range methods {
+		if m == socksMethodNoAuth {
+			method = socksMethodNoAuth
+		}
+	}
+	if _, err := conn.Write([]byte{socksVersion, method}); err != nil {
+		return nil, err
+	}
+	if method == socksMethodNone {
+		return nil, errors.New("socks client requires authentication")
+	}
+
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(reader, request); err != nil {
+		return nil, err
+	}
+	if request[0] != socksVersion {
+		return nil, fmt.Errorf("unsupported socks version %d", request[0])
+	}
+	if request[1] != socksCommandConnect {
+		writeSOCKSReply(conn, socksReplyCommand, nil)
+		return nil, fmt.Errorf("unsupported socks command %d", request[1])
+	}
+	host, err := readSOCKSHost(reader, request[3])
+	if err != nil {
+		writeSOCKSReply(conn, socksReplyAddressType, nil)
+		return nil, err
+	}
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(reader, port); err != nil {
+		return nil, err
+	}
+
+	address := net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port))))
+	upstream, err := s.connect(address)
+	if err != nil {
+		writeSOCKSReply(conn, socksReplyCode(err), nil)
+		return nil, err
+	}
+	if err := writeSOCKSReply(conn, socksReplySuccess, upstream.LocalAddr()); err != nil {
+		upstream.Close()
+		return nil, err
+	}
+	return upstream, nil
+}
+
+func readSOCKSHost(reader *bufio.Reader, addressType byte) (string, error) {
+	switch addressType {
+	case socksAddressIPv4, socksAddressIPv6:
+		size := net.IPv4len
+		if addressType == socksAddressIPv6 {
+			size = net.IPv6len
+		}
+		ip := make([]byte, size)
+		if _, err := io.ReadFull(reader, ip); err != nil {
+			return "", err
+		}
+		return net.IP(ip).String(), nil
+	case socksAddressDomain:
+		size, err := reader.ReadByte()
+		if err != nil {
+			return "", err
+		}
+		domain := make([]byte, size)
+		if _, err := io.ReadFull(reader, domain); err != nil {
+			return "", err
+		}
+		return string(domain), nil
+	default:
+		return "", fmt.Errorf("unsupported socks address type %d", addressType)
+	}
+}
+
+// writeSOCKSReply with the bound address, or the unspecified address if it is
+// not known
+func writeSOCKSReply(conn net.Conn, code byte, bound net.Addr) error {
+	ip := net.IPv4zero.To4()
+	port := 0
+	if addr, ok := bound.(*net.TCPAddr); ok {
+		ip = addr.IP
+		port = addr.Port
+	}
+	reply := []byte{socksVersion, code, 0}
+	if ip4 := ip.To4(); ip4 != nil {
+		reply = append(reply, socksAddressIPv4)
+		reply = append(reply, ip4...)
+	} else {
+		reply = append(reply, socksAddressIPv6)
+		reply = append(reply, ip.To16()...)
+	}
+	reply = binary.BigEndian.AppendUint16(reply, uint16(port))
+	_, err := conn.Write(reply)
+	return err
+}
+
+func socksReplyCode(err error) byte {
+	switch {
+	case errors.Is(err, errNotConnected):
+		return socksReplyNetwork
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return socksReplyRefused
+	case errors.Is(err, syscall.EHOSTUNREACH), errors.Is(err, syscall.ENETUNREACH):
+		return socksReplyHost
+	default:
+		return socksReplyFailure
+	}
+}
+
+// handleHTTP tunnels the CONNECT requests and forwards the plain HTTP requests.
+// Plain HTTP connection is closed after the first response.
+func (s *Server) handleHTTP(conn net.Conn, reader *bufio.Reader) (net.Conn, error) {
+	req, err := http.ReadRequest(reader)
+	if err != nil {
+		writeHTTPStatus(conn, http.StatusBadRequest)
+		return nil, err
+	}
+
+	if req.Method == http.MethodConnect {
+		upstream, err := s.connect(req.Host)
+		if err != nil {
+			writeHTTPStatus(conn, httpStatusCode(err))
+			return nil, err
+		}
+		if _, err := io.WriteString(conn, httpConnectEstablished); err != nil {
+			upstream.Close()
+			return nil, err
+		}
+		return upstream, nil
+	}
+
+	if req.URL.Scheme != "http" || req.URL.Host == "" {
+		writeHTTPStatus(conn, http.StatusBadRequest)
+		return nil, fmt.Errorf("unsupported proxy request for %s", req.URL)
+	}
+	address := req.URL.Host
+	if req.URL.Port() == "" {
+		address = net.JoinHostPort(req.URL.Hostname(), httpDefaultPort)
+	}
+	upstream, err := s.connect(address)
+	if err != nil {
+		writeHTTPStatus(conn, httpStatusCode(err))
+		return nil, err
+	}
+
+	req.Header.Del("Proxy-Connection")
+	req.Header.Del("Proxy-Authorization")
+	req.Close = true
+	if err := req.Write(upstream); err != nil {
+		upstream.Close()
+		writeHTTPStatus(conn, http.StatusBadGateway)
+		return nil, err
+	}
+	return upstream, nil
+}
+
+func writeHTTPStatus(conn net.Conn, code int) {
+	fmt.Fprintf(conn, "HTTP/1.1 %d %s\r\nConnection: close\r\nContent-Length: 0\r\n\r\n", code, http.StatusText(code))
+}
+
+func httpStatusCode(err error) int {
+	if errors.Is(err, errNotConnected) {
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusBadGateway
+}
+
+// pipe copies the data in both directions until both of the sides finish
+// sending
+func pipe(client net.Conn, clientReader io.Reader, upstream net.Conn) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		io.Copy(client, upstream)
+		closeWrite(client)
+	}()
+	io.Copy(upstream, clientReader)
+	closeWrite(upstream)
+	<-done
+}
+
+func closeWrite(conn net.Conn) {
+	if c, ok := conn.(interface{ CloseWrite() error }); ok {
+		c.CloseWrite()
+	}
+}
@@ -0,0 +1,170 @@
+// Package proxy implements the local SOCKS5 and HTTP proxy forwarding the
+// connections through the VPN tunnel.
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// DefaultPort is used when the port is not configured
+	DefaultPort = 1080
+	// listenAddress is loopback, so that the proxy is available to the local
+	// applications only
+	listenAddress = "127.0.0.1"
+	// handshakeTimeout limits the time the client has to send the request
+	handshakeTimeout = 10 * time.Second
+	dialTimeout      = 30 * time.Second
+)
+
+// errNotConnected is returned when the connection is requested while VPN is not
+// connected. Proxy never falls back to the default route.
+var errNotConnected = errors.New("vpn is not connected")
+
+// TunnelFunc returns the name of the tunnel interface, or false if VPN is not
+// connected
+type TunnelFunc func() (string, bool)
+
+// Port returns the port the proxy listens on
+func Port(cfg config.LocalProxy) uint16 {
+	if cfg.Port == 0 {
+		return DefaultPort
+	}
+	return cfg.Port
+}
+
+// Server accepts the SOCKS5 and HTTP proxy requests on the same port and
+// forwards them through the tunnel interface
+type Server struct {
+	tunnel TunnelFunc
+	// dial connects to the address through the interface
+	dial     func(ctx context.Context, iface string, address string) (net.Conn, error)
+	mu       sync.Mutex
+	listener net.Listener
+	port     uint16
+}
+
+// NewServer creates a stopped server
+func NewServer(tunnel TunnelFunc) *Server {
+	return &Server{tunnel: tunnel, dial: dialBound}
+}
+
+// Set starts, restarts or stops the server according to the settings
+func (s *Server) Set(cfg config.LocalProxy) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	port := Port(cfg)
+	if cfg.Enabled && s.listener != nil && s.port == port {
+		return nil
+	}
+	if err := s.stop(); err != nil {
+		return err
+	}
+	if !cfg.Enabled {
+		return nil
+	}
+	return s.start(port)
+}
+
+// Stop the server if it is running. Connections which are already established
+// are kept until either of the sides closes them.
+func (s *Server) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stop()
+}
+
+// Thread unsafe.
+func (s *Server) start(port uint16) error {
+	address := net.JoinHostPort(listenAddress, strconv.Itoa(int(port)))
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("listening on %s: %w", address, err)
+	}
+	go s.serve(listener)
+	s.listener = listener
+	s.port = port
+	log.Println(internal.InfoPrefix, "serving local proxy on", address)
+	return nil
+}
+
+// Thread unsafe.
+func (s *Server) stop() error {
+	if s.listener == nil {
+		return nil
+	}
+	err := s.listener.Close()
+	s.listener = nil
+	s.port = 0
+	if err != nil {
+		return fmt.Errorf("stopping local proxy: %w", err)
+	}
+	return nil
+}
+
+func (s *Server) serve(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				log.Println(internal.ErrorPrefix, "local proxy accepting connection:", err)
+			}
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+// connect to the address through the tunnel
+func (s *Server) connect(address string) (net.Conn, error) {
+	iface, ok := s.tunnel()
+	if !ok {
+		return nil, errNotConnected
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+	defer cancel()
+	return s.dial(ctx, iface, address)
+}
+
+// dialBound connects to the address through the interface. Hostnames are
+// resolved through the interface as well, so that the queries do not leak.
+func dialBound(ctx context.Context, iface string, address string) (net.Conn, error) {
+	dialer := boundDialer(iface)
+	dialer.Resolver = &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			return boundDialer(iface).DialContext(ctx, network, address)
+		},
+	}
+	return dialer.DialContext(ctx, "tcp", address)
+}
+
+// boundDialer creates connections bound to the interface
+func boundDialer(iface string) *net.Dialer {
+	return &net.Dialer{
+		Control: func(network, address string, conn syscall.RawConn) error {
+			var sockErr error
+			err := conn.Control(func(fd uintptr) {
+				sockErr = unix.SetsockoptString(int(fd), unix.SOL_SOCKET, unix.SO_BINDTODEVICE, iface)
+			})
+			if err != nil {
+				return err
+			}
+			return sockErr
+		},
+	}
+}
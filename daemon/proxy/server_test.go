@@ -0,0 +1,239 @@
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testProxy serves the proxy on a random port and returns its address along
+// with the interfaces the connections were made through
+func testProxy(t *testing.T, connected bool) (string, *[]string) {
+	t.Helper()
+	ifaces := &[]string{}
+	server := &Server{
+		tunnel: func() (string, bool) { return "nordlynx", connected },
+		dial: func(ctx context.Context, iface string, address string) (net.Conn, error) {
+			*ifaces = append(*ifaces, iface)
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, "tcp", address)
+		},
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	go server.serve(listener)
+	return listener.Addr().String(), ifaces
+}
+
+// echoServer replies with the data it receives
+func echoServer(t *testing.T) *net.TCPAddr {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+	return listener.Addr().(*net.TCPAddr)
+}
+
+func socksConnect(t *testing.T, proxyAddress string, request []byte) (net.Conn, byte) {
+	t.Helper()
+	conn, err := net.Dial("tcp", proxyAddress)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	_, err = conn.Write([]byte{socksVersion, 1, socksMethodNoAuth})
+	require.NoError(t, err)
+	method := make([]byte, 2)
+	_, err = io.ReadFull(conn, method)
+	require.NoError(t, err)
+	require.Equal(t, []byte{socksVersion, socksMethodNoAuth}, method)
+
+	_, err = conn.Write(request)
+	require.NoError(t, err)
+	reply := make([]byte, 10)
+	_, err = io.ReadFull(conn, reply)
+	require.NoError(t, err)
+	return conn, reply[1]
+}
+
+func assertEcho(t *testing.T, conn net.Conn) {
+	t.Helper()
+	_, err := conn.Write([]byte("ping"))
+	require.NoError(t, err)
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "ping", string(buf))
+}
+
+func TestServer_SOCKS(t *testing.T) {
+	category.Set(t, category.Integration)
+
+	echo := echoServer(t)
+	port := binary.BigEndian.AppendUint16(nil, uint16(echo.Port))
+	domain := "localhost"
+
+	tests := []struct {
+		name      string
+		connected bool
+		request   []byte
+		reply     byte
+	}{
+		{
+			name:      "ipv4",
+			connected: true,
+			request:   append([]byte{socksVersion, socksCommandConnect, 0, socksAddressIPv4, 127, 0, 0, 1}, port...),
+			reply:     socksReplySuccess,
+		},
+		{
+			name:      "domain",
+			connected: true,
+			request: append(append([]byte{socksVersion, socksCommandConnect, 0, socksAddressDomain, byte(len(domain))},
+				domain...), port...),
+			reply: socksReplySuccess,
+		},
+		{
+			name:      "vpn not connected",
+			connected: false,
+			request:   append([]byte{socksVersion, socksCommandConnect, 0, socksAddressIPv4, 127, 0, 0, 1}, port...),
+			reply:     socksReplyNetwork,
+		},
+		{
+			name:      "bind command",
+			connected: true,
+			request:   append([]byte{socksVersion, 2, 0, socksAddressIPv4, 127, 0, 0, 1}, port...),
+			reply:     socksReplyCommand,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			address, ifaces := testProxy(t, test.connected)
+			conn, reply := socksConnect(t, address, test.request)
+			assert.Equal(t, test.reply, reply)
+			if test.reply != socksReplySuccess {
+				return
+			}
+			assertEcho(t, conn)
+			assert.Equal(t, []string{"nordlynx"}, *ifaces)
+		})
+	}
+}
+
+func TestServer_SOCKSAuthRequired(t *testing.T) {
+	category.Set(t, category.Integration)
+
+	address, _ := testProxy(t, true)
+	conn, err := net.Dial("tcp", address)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// username and password authentication only
+	_, err = conn.Write([]byte{socksVersion, 1, 2})
+	require.NoError(t, err)
+	method := make([]byte, 2)
+	_, err = io.ReadFull(conn, method)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{socksVersion, socksMethodNone}, method)
+}
+
+func TestServer_HTTPConnect(t *testing.T) {
+	category.Set(t, category.Integration)
+
+	echo := echoServer(t)
+	tests := []struct {
+		name      string
+		connected bool
+		status    int
+	}{
+		{name: "connected", connected: true, status: http.StatusOK},
+		{name: "vpn not connected", connected: false, status: http.StatusServiceUnavailable},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			address, _ := testProxy(t, test.connected)
+			conn, err := net.Dial("tcp", address)
+			require.NoError(t, err)
+			defer conn.Close()
+
+			_, err = fmt.Fprintf(conn, "CONNECT %s HTTP/1.1\r\nHost: %s\r\n\r\n", echo, echo)
+			require.NoError(t, err)
+			reader := bufio.NewReader(conn)
+			resp, err := http.ReadResponse(reader, nil)
+			require.NoError(t, err)
+			assert.Equal(t, test.status, resp.StatusCode)
+			if test.status != http.StatusOK {
+				return
+			}
+
+			_, err = conn.Write([]byte("ping"))
+			require.NoError(t, err)
+			buf := make([]byte, 4)
+			_, err = io.ReadFull(reader, buf)
+			require.NoError(t, err)
+			assert.Equal(t, "ping", string(buf))
+		})
+	}
+}
+
+func TestServer_HTTPForward(t *testing.T) {
+	category.Set(t, category.Integration)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	var received *http.Request
+	go http.Serve(listener, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		fmt.Fprint(w, "pong")
+	}))
+
+	address, ifaces := testProxy(t, true)
+	conn, err := net.Dial("tcp", address)
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = fmt.Fprintf(conn, "GET http://%s/ping HTTP/1.1\r\nHost: %s\r\nProxy-Connection: keep-alive\r\n\r\n",
+		listener.Addr(), listener.Addr())
+	require.NoError(t, err)
+
+	resp, err := http.ReadResponse(bufio.NewReader(conn), nil)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "pong", string(body))
+	assert.Equal(t, "/ping", received.URL.Path)
+	assert.Empty(t, received.Header.Get("Proxy-Connection"))
+	assert.Equal(t, []string{"nordlynx"}, *ifaces)
+}
+
+func TestPort(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, uint16(DefaultPort), Port(config.LocalProxy{}))
+	assert.Equal(t, uint16(8080), Port(config.LocalProxy{Port: 8080}))
+}
@@ -0,0 +1,180 @@
+package daemon
+
+import (
+	"log"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+)
+
+const (
+	// AggressiveReconnectInterval is the delay between the retries in the
+	// aggressive mode
+	AggressiveReconnectInterval = time.Second
+	// MaxReconnectBackoff bounds the doubled backoff
+	MaxReconnectBackoff = 10 * time.Minute
+)
+
+// reconnectDelay returns the delay before the given retry, counted from 1. The
+// default delay is used if the policy does not set the backoff.
+func reconnectDelay(policy config.ReconnectPolicy, tries int, defaultDelay GetTimeoutFunc) time.Duration {
+	var delay time.Duration
+	switch {
+	case policy.Aggressive:
+		delay = AggressiveReconnectInterval
+	case policy.Backoff == 0:
+		delay = defaultDelay(tries)
+	default:
+		delay = policy.Backoff
+		for i := 1; i < tries && delay < MaxReconnectBackoff; i++ {
+			delay *= 2
+		}
+		if delay > MaxReconnectBackoff {
+			delay = MaxReconnectBackoff
+		}
+	}
+	if policy.Jitter > 0 {
+		// #nosec G404 -- not used for cryptographic purposes
+		delay += time.Duration(rand.Int63n(int64(policy.Jitter) + 1))
+	}
+	return delay
+}
+
+// retriesExhausted reports whether the policy allows no more retries after the
+// given number of tries
+func retriesExhausted(policy config.ReconnectPolicy, tries int) bool {
+	return policy.MaxRetries > 0 && tries >= int(policy.MaxRetries)
+}
+
+// connectionRecovery connects again to the last server after the VPN
+// connection drops without being disconnected by the daemon, e.g. when the
+// tunnel could not be set up again after a network change. Retries follow
+// the reconnect policy.
+type connectionRecovery struct {
+	cm   config.Manager
+	netw networker.Networker
+	// connect to VPN with the request
+	connect func(*pb.ConnectRequest) error
+	// lastServer returns the tag of the last connected server
+	lastServer   func() string
+	defaultDelay GetTimeoutFunc
+	now          func() time.Time
+	mu           sync.Mutex
+	// active is true if VPN was connected on the last check
+	active bool
+	// server is the tag of the server reconnected to, empty if not recovering
+	server string
+	// tries is the number of the failed attempts
+	tries int
+	// next is when the next attempt is made
+	next time.Time
+	// held is true while the daemon stops the connection on purpose
+	held bool
+}
+
+func (c *connectionRecovery) check() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.held {
+		return
+	}
+	if c.netw.IsVPNActive() {
+		if c.server != "" {
+			log.Println(internal.InfoPrefix, "reconnect: connected meanwhile, the recovery is over")
+			c.server = ""
+		}
+		c.active = true
+		return
+	}
+
+	var cfg config.Config
+	if err := c.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return
+	}
+
+	if c.active {
+		c.active = false
+		c.server = c.lastServer()
+		c.tries = 0
+		c.next = c.now()
+		if !cfg.Reconnect.Aggressive {
+			c.next = c.next.Add(reconnectDelay(cfg.Reconnect, 1, c.defaultDelay))
+		}
+		log.Println(internal.WarningPrefix, "reconnect: connection to", c.server, "dropped, reconnecting at", c.next.Format(time.RFC3339))
+	}
+	if c.server == "" || c.now().Before(c.next) {
+		return
+	}
+
+	log.Println(internal.InfoPrefix, "reconnect: attempt", c.tries+1, "to", c.server)
+	if err := c.connect(&pb.ConnectRequest{ServerTag: c.server}); err != nil {
+		c.tries++
+		if retriesExhausted(cfg.Reconnect, c.tries) {
+			log.Println(internal.ErrorPrefix, "reconnect: attempt", c.tries, "failed:", err, "- giving up")
+			c.server = ""
+			return
+		}
+		delay := reconnectDelay(cfg.Reconnect, c.tries+1, c.defaultDelay)
+		c.next = c.now().Add(delay)
+		log.Println(internal.ErrorPrefix, "reconnect: attempt", c.tries, "failed:", err, "- retrying after", delay)
+		return
+	}
+
+	log.Println(internal.InfoPrefix, "reconnect: attempt", c.tries+1, "succeeded")
+	c.server = ""
+	c.active = true
+}
+
+// recovering reports whether the dropped connection is still being retried
+func (c *connectionRecovery) recovering() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.server != ""
+}
+
+// cancel stops the recovery and forgets the connection, so that disconnecting
+// on purpose is not treated as a drop. Returns false if the connection was not
+// being recovered.
+func (c *connectionRecovery) cancel() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	recovering := c.server != ""
+	c.active = false
+	c.server = ""
+	if recovering {
+		log.Println(internal.InfoPrefix, "reconnect: cancelled")
+	}
+	return recovering
+}
+
+// hold keeps the checks from treating the connection as dropped while the
+// daemon stops it on purpose
+func (c *connectionRecovery) hold() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.held = true
+}
+
+// release resumes the checks after hold. The recovery is cancelled if the
+// connection was stopped, otherwise it is still watched.
+func (c *connectionRecovery) release(stopped bool) {
+	if stopped {
+		c.cancel()
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.held = false
+}
+
+// JobReconnect connects again after the VPN connection drops
+func JobReconnect(r *RPC) func() {
+	return r.recovery.check
+}
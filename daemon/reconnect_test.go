@@ -0,0 +1,205 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconnectDelay(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	defaultDelay := func(tries int) time.Duration { return time.Duration(tries) * time.Minute }
+	tests := []struct {
+		name     string
+		policy   config.ReconnectPolicy
+		tries    int
+		expected time.Duration
+	}{
+		{
+			name:     "default schedule",
+			tries:    3,
+			expected: 3 * time.Minute,
+		},
+		{
+			name:     "first backoff",
+			policy:   config.ReconnectPolicy{Backoff: 2 * time.Second},
+			tries:    1,
+			expected: 2 * time.Second,
+		},
+		{
+			name:     "doubled backoff",
+			policy:   config.ReconnectPolicy{Backoff: 2 * time.Second},
+			tries:    4,
+			expected: 16 * time.Second,
+		},
+		{
+			name:     "backoff is bounded",
+			policy:   config.ReconnectPolicy{Backoff: time.Minute},
+			tries:    100,
+			expected: MaxReconnectBackoff,
+		},
+		{
+			name:     "aggressive",
+			policy:   config.ReconnectPolicy{Backoff: time.Minute, Aggressive: true},
+			tries:    10,
+			expected: AggressiveReconnectInterval,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, reconnectDelay(test.policy, test.tries, defaultDelay))
+		})
+	}
+}
+
+func TestReconnectDelay_Jitter(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	policy := config.ReconnectPolicy{Backoff: time.Second, Jitter: 500 * time.Millisecond}
+	for i := 0; i < 100; i++ {
+		delay := reconnectDelay(policy, 1, nil)
+		assert.GreaterOrEqual(t, delay, time.Second)
+		assert.LessOrEqual(t, delay, 1500*time.Millisecond)
+	}
+}
+
+func TestConnectionRecovery(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Reconnect = config.ReconnectPolicy{Backoff: 2 * time.Second, MaxRetries: 2}
+	netw := &onDemandNetworker{active: true}
+	now := time.Now()
+	var requests []*pb.ConnectRequest
+	c := connectionRecovery{
+		cm:   cm,
+		netw: netw,
+		connect: func(request *pb.ConnectRequest) error {
+			requests = append(requests, request)
+			return mock.ErrOnPurpose
+		},
+		lastServer: func() string { return "lt16" },
+		now:        func() time.Time { return now },
+	}
+
+	c.check()
+	netw.active = false
+	c.check()
+	assert.Empty(t, requests)
+
+	now = now.Add(2 * time.Second)
+	c.check()
+	assert.Equal(t, []*pb.ConnectRequest{{ServerTag: "lt16"}}, requests)
+
+	// the second retry waits for the doubled backoff
+	now = now.Add(2 * time.Second)
+	c.check()
+	assert.Len(t, requests, 1)
+	now = now.Add(2 * time.Second)
+	c.check()
+	assert.Len(t, requests, 2)
+
+	// no more retries are allowed
+	now = now.Add(time.Hour)
+	c.check()
+	assert.Len(t, requests, 2)
+}
+
+func TestConnectionRecovery_Aggressive(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Reconnect = config.ReconnectPolicy{Aggressive: true}
+	netw := &onDemandNetworker{active: true}
+	connects := 0
+	c := connectionRecovery{
+		cm:   cm,
+		netw: netw,
+		connect: func(*pb.ConnectRequest) error {
+			connects++
+			netw.active = true
+			return nil
+		},
+		lastServer: func() string { return "lt16" },
+		now:        time.Now,
+	}
+
+	c.check()
+	netw.active = false
+	c.check()
+	assert.Equal(t, 1, connects)
+	assert.Empty(t, c.server)
+}
+
+func TestConnectionRecovery_Cancel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Reconnect = config.ReconnectPolicy{Aggressive: true}
+	netw := &onDemandNetworker{active: true}
+	connects := 0
+	c := connectionRecovery{
+		cm:   cm,
+		netw: netw,
+		connect: func(*pb.ConnectRequest) error {
+			connects++
+			return nil
+		},
+		lastServer: func() string { return "lt16" },
+		now:        time.Now,
+	}
+
+	c.check()
+	// disconnecting on purpose is not a drop
+	assert.False(t, c.cancel())
+	netw.active = false
+	c.check()
+	assert.Equal(t, 0, connects)
+}
+
+func TestConnectionRecovery_Hold(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Reconnect = config.ReconnectPolicy{Aggressive: true}
+	netw := &onDemandNetworker{active: true}
+	connects := 0
+	c := connectionRecovery{
+		cm:   cm,
+		netw: netw,
+		connect: func(*pb.ConnectRequest) error {
+			connects++
+			return nil
+		},
+		lastServer: func() string { return "lt16" },
+		now:        time.Now,
+	}
+
+	c.check()
+	c.hold()
+	// connection is down before the stop returns
+	netw.active = false
+	c.check()
+	assert.Equal(t, 0, connects)
+	assert.Empty(t, c.server)
+
+	c.release(true)
+	c.check()
+	assert.Equal(t, 0, connects)
+
+	// connection which failed to stop is still recovered
+	netw.active = true
+	c.check()
+	c.hold()
+	c.release(false)
+	netw.active = false
+	c.check()
+	assert.Equal(t, 1, connects)
+}
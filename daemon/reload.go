@@ -0,0 +1,341 @@
+package daemon
+
+import (
+	"log"
+	"reflect"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// reloadEffect describes when the changed setting takes effect
+type reloadEffect int
+
+const (
+	// reloadLive settings are applied by the reload
+	reloadLive reloadEffect = iota
+	// reloadReconnect settings are used when connecting to VPN
+	reloadReconnect
+	// reloadRestart settings are used when the daemon starts
+	reloadRestart
+)
+
+// reloadableSetting is a setting which is checked for changes on reload
+type reloadableSetting struct {
+	name    string
+	changed func(old config.Config, new config.Config) bool
+	// apply the setting to the running daemon, nil when nothing has to be done
+	// or the setting cannot be applied live
+	apply  func(r *RPC, cfg config.Config) error
+	effect reloadEffect
+}
+
+// reloadableSettings are named after the set commands. Settings which are not
+// listed are read from the config whenever they are needed.
+var reloadableSettings = []reloadableSetting{
+	{
+		name: "allowlist",
+		changed: func(old config.Config, new config.Config) bool {
+			return !reflect.DeepEqual(old.AutoConnectData.Allowlist, new.AutoConnectData.Allowlist)
+		},
+		apply: applyAllowlist,
+	},
+	{
+		name:    "lan-discovery",
+		changed: func(old config.Config, new config.Config) bool { return old.LanDiscovery != new.LanDiscovery },
+		apply: func(r *RPC, cfg config.Config) error {
+			r.netw.SetLanDiscovery(cfg.LanDiscovery)
+			return applyAllowlist(r, cfg)
+		},
+	},
+	{
+		name: "killswitch",
+		changed: func(old config.Config, new config.Config) bool {
+			return old.KillSwitch != new.KillSwitch
+		},
+		apply: func(r *RPC, cfg config.Config) error {
+			r.syncBootKillSwitch()
+			if cfg.KillSwitch {
+				return r.netw.SetKillSwitch(reloadedAllowlist(cfg))
+			}
+			return r.netw.UnsetKillSwitch()
+		},
+	},
+	{
+		name: "dns",
+		changed: func(old config.Config, new config.Config) bool {
+			return !reflect.DeepEqual(old.AutoConnectData.DNS, new.AutoConnectData.DNS)
+		},
+		apply: applyDNS,
+	},
+	{
+		name: "threatprotectionlite",
+		changed: func(old config.Config, new config.Config) bool {
+			return old.AutoConnectData.ThreatProtectionLite != new.AutoConnectData.ThreatProtectionLite
+		},
+		apply: applyDNS,
+	},
+	{
+		name:    "dns-ipv6",
+		changed: func(old config.Config, new config.Config) bool { return old.DNSIPv6.Get() != new.DNSIPv6.Get() },
+		apply:   func(r *RPC, cfg config.Config) error { return r.netw.SetDNSIPv6(cfg.DNSIPv6.Get()) },
+	},
+	{
+		name: "dns-search",
+		changed: func(old config.Config, new config.Config) bool {
+			return !reflect.DeepEqual(old.DNSSearchDomains, new.DNSSearchDomains)
+		},
+		apply: func(r *RPC, cfg config.Config) error { return r.netw.SetDNSSearchDomains(cfg.DNSSearchDomains) },
+	},
+	{
+		name: "dns-route",
+		changed: func(old config.Config, new config.Config) bool {
+			return !reflect.DeepEqual(old.DNSRoutes, new.DNSRoutes)
+		},
+		apply: func(r *RPC, cfg config.Config) error { return r.netw.SetDNSRoutes(cfg.DNSRoutes) },
+	},
+	{
+		name: "dns-filter",
+		changed: func(old config.Config, new config.Config) bool {
+			return !reflect.DeepEqual(old.DNSFilter, new.DNSFilter)
+		},
+		apply: func(r *RPC, cfg config.Config) error { return r.netw.SetDNSFilter(cfg.DNSFilter) },
+	},
+	{
+		name:    "default-route",
+		changed: func(old config.Config, new config.Config) bool { return old.DefaultRouteMode != new.DefaultRouteMode },
+		apply: func(r *RPC, cfg config.Config) error {
+			r.netw.SetDefaultRouteMode(cfg.DefaultRouteMode)
+			return nil
+		},
+	},
+	{
+		name:    "subnet-overlap",
+		changed: func(old config.Config, new config.Config) bool { return old.SubnetOverlapMode != new.SubnetOverlapMode },
+		apply: func(r *RPC, cfg config.Config) error {
+			r.netw.SetSubnetOverlapMode(cfg.SubnetOverlapMode)
+			return nil
+		},
+	},
+	{
+		name:    "killswitch-log",
+		changed: func(old config.Config, new config.Config) bool { return old.KillSwitchLog != new.KillSwitchLog },
+		apply: func(r *RPC, cfg config.Config) error {
+			r.netw.SetKillSwitchLog(cfg.KillSwitchLog)
+			return nil
+		},
+	},
+	{
+		name:    "loglevel",
+		changed: func(old config.Config, new config.Config) bool { return old.LogLevel != new.LogLevel },
+		apply: func(r *RPC, cfg config.Config) error {
+			r.logLevel.SetLevel(LoggingLevel(cfg.LogLevel))
+			return nil
+		},
+	},
+	{
+		name:    "killswitch-lan",
+		changed: func(old config.Config, new config.Config) bool { return old.KillSwitchLAN != new.KillSwitchLAN },
+		apply: func(r *RPC, cfg config.Config) error {
+			r.syncBootKillSwitch()
+			return r.netw.SetKillSwitchLAN(cfg.KillSwitchLAN)
+		},
+	},
+	{
+		name: "multicast-discovery",
+		changed: func(old config.Config, new config.Config) bool {
+			return old.MulticastDiscovery != new.MulticastDiscovery
+		},
+		apply: func(r *RPC, cfg config.Config) error { return r.netw.SetMulticastDiscovery(cfg.MulticastDiscovery) },
+	},
+	{
+		name: "virtual-location",
+		changed: func(old config.Config, new config.Config) bool {
+			return old.VirtualLocation.Get() != new.VirtualLocation.Get()
+		},
+		apply: func(r *RPC, cfg config.Config) error {
+			SetAppData(r.dm, cfg.Technology, cfg.VirtualLocation.Get(), r.dm.GetServersData().Servers)
+			return nil
+		},
+	},
+	{
+		name: "firewall-template",
+		changed: func(old config.Config, new config.Config) bool {
+			return old.FirewallTemplates != new.FirewallTemplates
+		},
+		apply: func(r *RPC, cfg config.Config) error { return r.netw.SetFirewallTemplates(cfg.FirewallTemplates) },
+	},
+	{
+		name: "split-tunnel",
+		changed: func(old config.Config, new config.Config) bool {
+			return !reflect.DeepEqual(old.SplitTunnel, new.SplitTunnel)
+		},
+		apply: func(r *RPC, cfg config.Config) error { return r.netw.SetSplitTunnel(cfg.SplitTunnel) },
+	},
+	{
+		name:    "mtu",
+		changed: func(old config.Config, new config.Config) bool { return old.MTU != new.MTU },
+		apply:   func(r *RPC, cfg config.Config) error { return r.netw.SetMTU(cfg.MTU) },
+	},
+	{
+		name:    "metrics",
+		changed: func(old config.Config, new config.Config) bool { return old.Metrics != new.Metrics },
+		apply:   func(r *RPC, cfg config.Config) error { return r.metrics.Set(cfg.Metrics) },
+	},
+	{
+		name:    "REST API",
+		changed: func(old config.Config, new config.Config) bool { return old.RESTAPI != new.RESTAPI },
+		apply:   func(r *RPC, cfg config.Config) error { return r.restAPI.Set(cfg.RESTAPI) },
+	},
+	{
+		name:    "local-proxy",
+		changed: func(old config.Config, new config.Config) bool { return old.LocalProxy != new.LocalProxy },
+		apply:   func(r *RPC, cfg config.Config) error { return r.localProxy.Set(cfg.LocalProxy) },
+	},
+	{
+		name:    "autoconnect",
+		changed: func(old config.Config, new config.Config) bool { return old.AutoConnect != new.AutoConnect },
+	},
+	{
+		name:    "technology",
+		changed: func(old config.Config, new config.Config) bool { return old.Technology != new.Technology },
+		effect:  reloadReconnect,
+	},
+	{
+		name: "protocol",
+		changed: func(old config.Config, new config.Config) bool {
+			return old.AutoConnectData.Protocol != new.AutoConnectData.Protocol
+		},
+		effect: reloadReconnect,
+	},
+	{
+		name: "obfuscate",
+		changed: func(old config.Config, new config.Config) bool {
+			return old.AutoConnectData.Obfuscate != new.AutoConnectData.Obfuscate
+		},
+		effect: reloadReconnect,
+	},
+	{
+		name:    "server-port",
+		changed: func(old config.Config, new config.Config) bool { return old.ServerPorts != new.ServerPorts },
+		effect:  reloadReconnect,
+	},
+	{
+		name:    "ipv6",
+		changed: func(old config.Config, new config.Config) bool { return old.GetIPv6Mode() != new.GetIPv6Mode() },
+		apply: func(r *RPC, cfg config.Config) error {
+			r.netw.SetIPv6Mode(cfg.GetIPv6Mode())
+			return nil
+		},
+		effect: reloadReconnect,
+	},
+	{
+		name:    "fwmark",
+		changed: func(old config.Config, new config.Config) bool { return old.FirewallMark != new.FirewallMark },
+		effect:  reloadRestart,
+	},
+	{
+		name:    "routing-table",
+		changed: func(old config.Config, new config.Config) bool { return old.RoutingTable != new.RoutingTable },
+		effect:  reloadRestart,
+	},
+	{
+		name:    "legacy-dns",
+		changed: func(old config.Config, new config.Config) bool { return old.LegacyDNS != new.LegacyDNS },
+		effect:  reloadRestart,
+	},
+	{
+		name:    "firewall-backend",
+		changed: func(old config.Config, new config.Config) bool { return old.FirewallBackend != new.FirewallBackend },
+		effect:  reloadRestart,
+	},
+}
+
+// reloadedAllowlist returns the allowlist applied to the firewall
+func reloadedAllowlist(cfg config.Config) config.Allowlist {
+	allowlist := cfg.AutoConnectData.Allowlist
+	if cfg.LanDiscovery {
+		allowlist = addLANPermissions(allowlist)
+	}
+	return allowlist
+}
+
+func applyAllowlist(r *RPC, cfg config.Config) error {
+	r.syncBootKillSwitch()
+	return r.netw.SetAllowlist(reloadedAllowlist(cfg))
+}
+
+func applyDNS(r *RPC, cfg config.Config) error {
+	if !r.netw.IsVPNActive() {
+		return nil
+	}
+	return r.netw.SetDNS(cfg.AutoConnectData.DNS.Or(
+		r.nameservers.Get(cfg.AutoConnectData.ThreatProtectionLite, r.lastServer.SupportsIPv6()),
+	))
+}
+
+// ReloadReport lists the changed settings by the outcome of the reload
+type ReloadReport struct {
+	Applied           []string
+	Failed            []string
+	ReconnectRequired []string
+	RestartRequired   []string
+}
+
+// configReload keeps the config which was applied by the last reload, so that
+// only the changed settings are applied
+type configReload struct {
+	mu      sync.Mutex
+	applied config.Config
+}
+
+// ReloadConfig re-reads the config and applies the settings which were changed
+// since the daemon start or the previous reload. Active VPN connection is kept.
+func (r *RPC) ReloadConfig() (ReloadReport, error) {
+	r.reload.mu.Lock()
+	defer r.reload.mu.Unlock()
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		return ReloadReport{}, err
+	}
+
+	var report ReloadReport
+	for _, setting := range reloadableSettings {
+		if !setting.changed(r.reload.applied, cfg) {
+			continue
+		}
+		switch setting.effect {
+		case reloadLive:
+			if setting.apply == nil {
+				report.Applied = append(report.Applied, setting.name)
+				continue
+			}
+			if err := setting.apply(r, cfg); err != nil {
+				log.Println(internal.ErrorPrefix, "reloading", setting.name, "setting:", err)
+				report.Failed = append(report.Failed, setting.name)
+				continue
+			}
+			report.Applied = append(report.Applied, setting.name)
+		case reloadReconnect:
+			if r.netw.IsVPNActive() {
+				report.ReconnectRequired = append(report.ReconnectRequired, setting.name)
+			} else {
+				report.Applied = append(report.Applied, setting.name)
+			}
+		case reloadRestart:
+			report.RestartRequired = append(report.RestartRequired, setting.name)
+		}
+	}
+
+	// all the changed settings are applied again on the next reload if some failed
+	if len(report.Failed) == 0 {
+		r.reload.applied = cfg
+	}
+	log.Println(internal.InfoPrefix, "config reloaded, applied:", report.Applied,
+		"failed:", report.Failed,
+		"reconnect required:", report.ReconnectRequired,
+		"restart required:", report.RestartRequired)
+	return report, nil
+}
@@ -0,0 +1,160 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slices"
+)
+
+func TestReloadConfig(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	applied := config.Config{
+		Technology:   config.Technology_NORDLYNX,
+		FirewallMark: 0xe1f1,
+		AutoConnectData: config.AutoConnectData{
+			Allowlist: config.NewAllowlist(nil, nil, nil),
+		},
+	}
+	changed := applied
+	changed.Technology = config.Technology_OPENVPN
+	changed.FirewallMark = 0xe1f2
+	changed.AutoConnectData.Allowlist = config.NewAllowlist(nil, []int64{22}, nil)
+	changed.AutoConnectData.DNS = config.DNS{"192.0.2.1"}
+	changed.DefaultRouteMode = config.DefaultRouteCoexist
+
+	tests := []struct {
+		name     string
+		cfg      config.Config
+		netw     networker.Networker
+		expected ReloadReport
+	}{
+		{
+			name:     "nothing changed",
+			cfg:      applied,
+			netw:     &testnetworker.Mock{VpnActive: true},
+			expected: ReloadReport{},
+		},
+		{
+			name: "connected",
+			cfg:  changed,
+			netw: &testnetworker.Mock{VpnActive: true},
+			expected: ReloadReport{
+				Applied:           []string{"allowlist", "dns", "default-route"},
+				ReconnectRequired: []string{"technology"},
+				RestartRequired:   []string{"fwmark"},
+			},
+		},
+		{
+			name: "disconnected",
+			cfg:  changed,
+			netw: &testnetworker.Mock{ConnectRetries: -100},
+			expected: ReloadReport{
+				Applied:         []string{"allowlist", "dns", "default-route", "technology"},
+				RestartRequired: []string{"fwmark"},
+			},
+		},
+		{
+			name: "networker failure",
+			cfg:  changed,
+			netw: testnetworker.Failing{},
+			expected: ReloadReport{
+				Applied:         []string{"dns", "default-route", "technology"},
+				Failed:          []string{"allowlist"},
+				RestartRequired: []string{"fwmark"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg = &test.cfg
+			r := RPC{
+				cm:          cm,
+				netw:        test.netw,
+				nameservers: &mock.DNSGetter{Names: []string{"1.1.1.1"}},
+				reload:      &configReload{applied: applied},
+			}
+
+			report, err := r.ReloadConfig()
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, report)
+
+			if netw, ok := test.netw.(*testnetworker.Mock); ok {
+				assert.False(t, netw.Stopped)
+				if slices.Contains(report.Applied, "allowlist") {
+					assert.Equal(t, test.cfg.AutoConnectData.Allowlist, netw.Allowlist)
+				}
+				if slices.Contains(report.Applied, "dns") && netw.VpnActive {
+					assert.Equal(t, []string(test.cfg.AutoConnectData.DNS), netw.Dns)
+				}
+				assert.Equal(t, test.cfg.DefaultRouteMode, netw.DefaultRouteMode)
+			}
+
+			// changes are applied only once unless some failed
+			report, err = r.ReloadConfig()
+			require.NoError(t, err)
+			if len(test.expected.Failed) == 0 {
+				assert.Equal(t, ReloadReport{}, report)
+			} else {
+				assert.Equal(t, test.expected, report)
+			}
+		})
+	}
+}
+
+func TestReload(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		netw         networker.Networker
+		loadErr      error
+		expectedCode int64
+	}{
+		{
+			name:         "success",
+			netw:         &testnetworker.Mock{},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "networker failure",
+			netw:         testnetworker.Failing{},
+			expectedCode: internal.CodeFailure,
+		},
+		{
+			name:         "config failure",
+			netw:         &testnetworker.Mock{},
+			loadErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.KillSwitch = true
+			cm.LoadErr = test.loadErr
+			r := RPC{
+				cm:     cm,
+				netw:   test.netw,
+				reload: &configReload{},
+			}
+
+			resp, err := r.Reload(context.Background(), &pb.Empty{})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+		})
+	}
+}
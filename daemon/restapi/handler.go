@@ -0,0 +1,268 @@
+package restapi
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// settingsPath is followed by the name of the setting when changing it
+	settingsPath = "/v1/settings/"
+	maxBodySize  = 1 << 20
+	contentType  = "application/json"
+)
+
+var (
+	marshalOptions   = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+	unmarshalOptions = protojson.UnmarshalOptions{}
+
+	errNoResponse = errors.New("daemon did not respond")
+)
+
+type handler struct {
+	client   pb.DaemonClient
+	token    []byte
+	mux      *http.ServeMux
+	settings map[string]http.HandlerFunc
+}
+
+// newHandler routes the requests authenticated with the token to the daemon.
+// Requests and responses are the daemon protobuf messages encoded as JSON
+// with the field names used in the .proto files.
+func newHandler(client pb.DaemonClient, token string) http.Handler {
+	h := &handler{client: client, token: []byte(token), mux: http.NewServeMux()}
+	h.settings = map[string]http.HandlerFunc{
+		"autoconnect":          unary(h.setAutoConnect),
+		"dns":                  unary(client.SetDNS),
+		"firewall":             unary(client.SetFirewall),
+		"ipv6":                 unary(client.SetIpv6),
+		"killswitch":           unary(h.setKillSwitch),
+		"lan-discovery":        unary(client.SetLANDiscovery),
+		"obfuscate":            unary(client.SetObfuscate),
+		"protocol":             unary(client.SetProtocol),
+		"routing":              unary(client.SetRouting),
+		"technology":           unary(client.SetTechnology),
+		"threatprotectionlite": unary(client.SetThreatProtectionLite),
+	}
+	h.mux.HandleFunc("/v1/status", method(http.MethodGet, unary(client.Status)))
+	h.mux.HandleFunc("/v1/settings", method(http.MethodGet, unary(client.Settings)))
+	h.mux.HandleFunc(settingsPath, method(http.MethodPost, h.setSetting))
+	h.mux.HandleFunc("/v1/connect", method(http.MethodPost, h.connect))
+	h.mux.HandleFunc("/v1/disconnect", method(http.MethodPost, h.disconnect))
+	return h
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(token), h.token) != 1 {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		writeError(w, http.StatusUnauthorized, errors.New("invalid token"))
+		return
+	}
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *handler) setSetting(w http.ResponseWriter, r *http.Request) {
+	set, ok := h.settings[strings.TrimPrefix(r.URL.Path, settingsPath)]
+	if !ok {
+		writeError(w, http.StatusNotFound, errors.New("unknown setting"))
+		return
+	}
+	set(w, r)
+}
+
+func (h *handler) connect(w http.ResponseWriter, r *http.Request) {
+	req := &pb.ConnectRequest{}
+	if err := readRequest(w, r, req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	// daemon writes the log as root, so the path is not accepted from the network
+	if req.GetLogFile() != "" {
+		writeError(w, http.StatusBadRequest, errors.New("log_file is not supported"))
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), connectTimeout)
+	defer cancel()
+	stream, err := h.client.Connect(ctx, req)
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	writeStream(w, stream)
+}
+
+func (h *handler) disconnect(w http.ResponseWriter, r *http.Request) {
+	stream, err := h.client.Disconnect(r.Context(), &pb.Empty{})
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	writeStream(w, stream)
+}
+
+// setKillSwitch keeps the current allowlist unless a new one is provided
+func (h *handler) setKillSwitch(ctx context.Context, req *pb.SetKillSwitchRequest, opts ...grpc.CallOption) (*pb.Payload, error) {
+	if req.Allowlist == nil {
+		allowlist, err := h.allowlist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.Allowlist = allowlist
+	}
+	return h.client.SetKillSwitch(ctx, req, opts...)
+}
+
+// setAutoConnect keeps the current allowlist unless a new one is provided
+func (h *handler) setAutoConnect(ctx context.Context, req *pb.SetAutoconnectRequest, opts ...grpc.CallOption) (*pb.Payload, error) {
+	if req.Allowlist == nil {
+		allowlist, err := h.allowlist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.Allowlist = allowlist
+	}
+	return h.client.SetAutoConnect(ctx, req, opts...)
+}
+
+func (h *handler) allowlist(ctx context.Context) (*pb.Allowlist, error) {
+	resp, err := h.client.Settings(ctx, &pb.SettingsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetData().GetAllowlist(), nil
+}
+
+// unary handles the request with the unary daemon RPC
+func unary[Req, Resp proto.Message](call func(context.Context, Req, ...grpc.CallOption) (Resp, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var zero Req
+		req := zero.ProtoReflect().Type().New().Interface().(Req)
+		if err := readRequest(w, r, req); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		resp, err := call(r.Context(), req)
+		if err != nil {
+			writeRPCError(w, err)
+			return
+		}
+		writeResponse(w, resp)
+	}
+}
+
+func method(method string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			w.Header().Set("Allow", method)
+			writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// readRequest decodes the body into the request, empty body is the empty request
+func readRequest(w http.ResponseWriter, r *http.Request, req proto.Message) error {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		return fmt.Errorf("reading request: %w", err)
+	}
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return nil
+	}
+	if err := unmarshalOptions.Unmarshal(body, req); err != nil {
+		return fmt.Errorf("decoding request: %w", err)
+	}
+	return nil
+}
+
+type payloadStream interface {
+	Recv() (*pb.Payload, error)
+}
+
+// writeStream writes the last payload sent by the daemon, which is the result
+// of the operation
+func writeStream(w http.ResponseWriter, stream payloadStream) {
+	var last *pb.Payload
+	for {
+		payload, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			writeRPCError(w, err)
+			return
+		}
+		last = payload
+	}
+	if last == nil {
+		writeError(w, http.StatusBadGateway, errNoResponse)
+		return
+	}
+	writeResponse(w, last)
+}
+
+func writeResponse(w http.ResponseWriter, resp proto.Message) {
+	data, err := marshalOptions.Marshal(resp)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	code := http.StatusOK
+	// payload type is the result code, failures are reported with the
+	// payload, so that the client can tell them apart
+	if payload, ok := resp.(*pb.Payload); ok && payload.GetType() >= internal.CodeFailure {
+		code = http.StatusUnprocessableEntity
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(code)
+	if _, err := w.Write(data); err != nil {
+		log.Println(internal.WarningPrefix, "writing REST API response:", err)
+	}
+}
+
+func writeRPCError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.Unauthenticated:
+		code = http.StatusUnauthorized
+	case codes.PermissionDenied:
+		code = http.StatusForbidden
+	case codes.NotFound:
+		code = http.StatusNotFound
+	case codes.Unimplemented:
+		code = http.StatusNotImplemented
+	case codes.Unavailable:
+		code = http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		code = http.StatusGatewayTimeout
+	}
+	writeError(w, code, errors.New(status.Convert(err).Message()))
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(map[string]string{"error": err.Error()}); err != nil {
+		log.Println(internal.WarningPrefix, "writing REST API response:", err)
+	}
+}
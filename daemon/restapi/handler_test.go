@@ -0,0 +1,203 @@
+package restapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testToken = "token"
+
+type mockConnectClient struct {
+	grpc.ClientStream
+	payloads []*pb.Payload
+}
+
+func (m *mockConnectClient) Recv() (*pb.Payload, error) {
+	if len(m.payloads) == 0 {
+		return nil, io.EOF
+	}
+	payload := m.payloads[0]
+	m.payloads = m.payloads[1:]
+	return payload, nil
+}
+
+type mockDaemonClient struct {
+	pb.DaemonClient
+	killSwitchReq *pb.SetKillSwitchRequest
+	connectReq    *pb.ConnectRequest
+	payloadType   int64
+}
+
+func (m *mockDaemonClient) Status(context.Context, *pb.Empty, ...grpc.CallOption) (*pb.StatusResponse, error) {
+	return &pb.StatusResponse{State: "Connected", Hostname: "lt1.nordvpn.com"}, nil
+}
+
+func (m *mockDaemonClient) Settings(context.Context, *pb.SettingsRequest, ...grpc.CallOption) (*pb.SettingsResponse, error) {
+	return &pb.SettingsResponse{
+		Type: internal.CodeSuccess,
+		Data: &pb.Settings{Allowlist: &pb.Allowlist{Subnets: []string{"192.168.1.0/24"}}},
+	}, nil
+}
+
+func (m *mockDaemonClient) SetKillSwitch(_ context.Context, in *pb.SetKillSwitchRequest, _ ...grpc.CallOption) (*pb.Payload, error) {
+	m.killSwitchReq = in
+	return &pb.Payload{Type: m.payloadType}, nil
+}
+
+func (m *mockDaemonClient) SetDNS(context.Context, *pb.SetDNSRequest, ...grpc.CallOption) (*pb.SetDNSResponse, error) {
+	return nil, status.Error(codes.Unavailable, "daemon is not running")
+}
+
+func (m *mockDaemonClient) Connect(_ context.Context, in *pb.ConnectRequest, _ ...grpc.CallOption) (pb.Daemon_ConnectClient, error) {
+	m.connectReq = in
+	return &mockConnectClient{payloads: []*pb.Payload{
+		{Type: internal.CodeConnecting},
+		{Type: internal.CodeConnected, Data: []string{"Lithuania #1"}},
+	}}, nil
+}
+
+func TestHandler(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		body         string
+		token        string
+		payloadType  int64
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "missing token",
+			method:       http.MethodGet,
+			path:         "/v1/status",
+			expectedCode: http.StatusUnauthorized,
+			expectedBody: `{"error":"invalid token"}`,
+		},
+		{
+			name:         "invalid token",
+			method:       http.MethodGet,
+			path:         "/v1/status",
+			token:        "other",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "status",
+			method:       http.MethodGet,
+			path:         "/v1/status",
+			token:        testToken,
+			expectedCode: http.StatusOK,
+			expectedBody: `"hostname":"lt1.nordvpn.com"`,
+		},
+		{
+			name:         "method not allowed",
+			method:       http.MethodPost,
+			path:         "/v1/status",
+			token:        testToken,
+			expectedCode: http.StatusMethodNotAllowed,
+		},
+		{
+			name:         "unknown setting",
+			method:       http.MethodPost,
+			path:         "/v1/settings/meshnet",
+			token:        testToken,
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "invalid body",
+			method:       http.MethodPost,
+			path:         "/v1/settings/killswitch",
+			body:         `{"kill_switch":"on"}`,
+			token:        testToken,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "setting",
+			method:       http.MethodPost,
+			path:         "/v1/settings/killswitch",
+			body:         `{"kill_switch":true}`,
+			token:        testToken,
+			payloadType:  internal.CodeSuccess,
+			expectedCode: http.StatusOK,
+			expectedBody: `"type":"1000"`,
+		},
+		{
+			name:         "setting failure",
+			method:       http.MethodPost,
+			path:         "/v1/settings/killswitch",
+			token:        testToken,
+			payloadType:  internal.CodeFailure,
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "daemon unavailable",
+			method:       http.MethodPost,
+			path:         "/v1/settings/dns",
+			token:        testToken,
+			expectedCode: http.StatusServiceUnavailable,
+			expectedBody: `{"error":"daemon is not running"}`,
+		},
+		{
+			name:         "connect",
+			method:       http.MethodPost,
+			path:         "/v1/connect",
+			body:         `{"server_tag":"lt"}`,
+			token:        testToken,
+			expectedCode: http.StatusOK,
+			expectedBody: `"data":["Lithuania #1"]`,
+		},
+		{
+			name:         "connect with log file",
+			method:       http.MethodPost,
+			path:         "/v1/connect",
+			body:         `{"log_file":"/etc/passwd"}`,
+			token:        testToken,
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := &mockDaemonClient{payloadType: test.payloadType}
+			req := httptest.NewRequest(test.method, test.path, strings.NewReader(test.body))
+			if test.token != "" {
+				req.Header.Set("Authorization", "Bearer "+test.token)
+			}
+			recorder := httptest.NewRecorder()
+
+			newHandler(client, testToken).ServeHTTP(recorder, req)
+
+			assert.Equal(t, test.expectedCode, recorder.Code)
+			assert.Equal(t, contentType, recorder.Header().Get("Content-Type"))
+			assert.Contains(t, recorder.Body.String(), test.expectedBody)
+		})
+	}
+}
+
+func TestHandler_KillSwitchKeepsAllowlist(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	client := &mockDaemonClient{}
+	req := httptest.NewRequest(http.MethodPost, "/v1/settings/killswitch", strings.NewReader(`{"kill_switch":true}`))
+	req.Header.Set("Authorization", "Bearer "+testToken)
+
+	newHandler(client, testToken).ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.True(t, client.killSwitchReq.GetKillSwitch())
+	assert.Equal(t, []string{"192.168.1.0/24"}, client.killSwitchReq.GetAllowlist().GetSubnets())
+}
@@ -0,0 +1,189 @@
+// Package restapi exposes a part of the daemon API over HTTP as JSON, so that
+// home automation and web dashboards can control the app without protobuf
+// tooling.
+//
+// The routes are written by hand instead of being generated with grpc-gateway,
+// which is not among the module dependencies and would need the HTTP
+// annotations in the .proto files. Messages are still encoded with protojson,
+// so the JSON is the same as the gateway would produce.
+//
+// Exposed are the status, the settings, connect, disconnect and the settings
+// listed in newHandler. Left out are the account and login calls, the server
+// lists, the WatchStatus and SubscribeEvents streams, the meshnet and fileshare
+// services, profiles, split tunneling and the calls which change the access to
+// the daemon or the host, such as SetRESTAPI, SetAllowlist and SetFirewallMark.
+package restapi
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+const (
+	// DefaultAddress is used when the address is not configured
+	DefaultAddress = "127.0.0.1:9102"
+	// connectTimeout is long enough for the connection to be established
+	connectTimeout = 2 * time.Minute
+	requestTimeout = 10 * time.Second
+	tokenLength    = 32
+)
+
+// ErrInvalidAddress is returned when the address is not a loopback IP address with a port
+var ErrInvalidAddress = errors.New("REST API address must be a loopback IP address with a port")
+
+// IsValidAddress checks whether the address is a loopback IP address with a
+// port, e.g. 127.0.0.1:9102. The API controls the VPN, so it is never exposed
+// to the network.
+func IsValidAddress(address string) bool {
+	addrPort, err := netip.ParseAddrPort(address)
+	return err == nil && addrPort.Port() != 0 && addrPort.Addr().IsLoopback()
+}
+
+// Address returns the address the API is served on
+func Address(cfg config.RESTAPI) string {
+	if cfg.Address == "" {
+		return DefaultAddress
+	}
+	return cfg.Address
+}
+
+// NewToken generates a random token authenticating the requests
+func NewToken() (string, error) {
+	token := make([]byte, tokenLength)
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf("generating token: %w", err)
+	}
+	return hex.EncodeToString(token), nil
+}
+
+// Server serves the daemon API over HTTP. Requests are forwarded to the
+// daemon gRPC server through the client.
+type Server struct {
+	client  pb.DaemonClient
+	mu      sync.Mutex
+	server  *http.Server
+	address string
+	token   string
+}
+
+// NewServer creates a stopped server
+func NewServer(client pb.DaemonClient) *Server {
+	return &Server{client: client}
+}
+
+// Set starts, restarts or stops the server according to the settings. The
+// running server is kept when the server with the new settings cannot start.
+func (s *Server) Set(cfg config.RESTAPI) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	address := Address(cfg)
+	if cfg.Enabled && s.server != nil && s.address == address && s.token == cfg.Token {
+		return nil
+	}
+	if !cfg.Enabled {
+		return s.stop()
+	}
+
+	if s.server != nil && s.address == address {
+		// address cannot be bound twice, so the old server is started again
+		// if the new one fails
+		token := s.token
+		if err := s.stop(); err != nil {
+			return err
+		}
+		listener, err := listen(address, cfg.Token)
+		if err != nil {
+			if listener, err := listen(address, token); err == nil {
+				s.serve(listener, address, token)
+			} else {
+				log.Println(internal.ErrorPrefix, "restoring REST API:", err)
+			}
+			return err
+		}
+		s.serve(listener, address, cfg.Token)
+		return nil
+	}
+
+	listener, err := listen(address, cfg.Token)
+	if err != nil {
+		return err
+	}
+	if err := s.stop(); err != nil {
+		if err := listener.Close(); err != nil {
+			log.Println(internal.DeferPrefix, err)
+		}
+		return err
+	}
+	s.serve(listener, address, cfg.Token)
+	return nil
+}
+
+// Stop the server if it is running
+func (s *Server) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stop()
+}
+
+func listen(address string, token string) (net.Listener, error) {
+	if !IsValidAddress(address) {
+		return nil, ErrInvalidAddress
+	}
+	if token == "" {
+		return nil, errors.New("REST API token is not set")
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("listening on %s: %w", address, err)
+	}
+	return listener, nil
+}
+
+// Thread unsafe.
+func (s *Server) serve(listener net.Listener, address string, token string) {
+	server := &http.Server{
+		Handler:           newHandler(s.client, token),
+		ReadHeaderTimeout: requestTimeout,
+		WriteTimeout:      connectTimeout + requestTimeout,
+	}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Println(internal.ErrorPrefix, "serving REST API:", err)
+		}
+	}()
+	s.server = server
+	s.address = address
+	s.token = token
+	log.Println(internal.InfoPrefix, "serving REST API on", address)
+}
+
+// Thread unsafe.
+func (s *Server) stop() error {
+	if s.server == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	err := s.server.Shutdown(ctx)
+	s.server = nil
+	s.address = ""
+	s.token = ""
+	if err != nil {
+		return fmt.Errorf("stopping REST API server: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package restapi
+
+import (
+	"net"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsValidAddress(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		address  string
+		expected bool
+	}{
+		{address: "127.0.0.1:9102", expected: true},
+		{address: "127.0.0.2:8080", expected: true},
+		{address: "[::1]:9102", expected: true},
+		{address: "0.0.0.0:9102", expected: false},
+		{address: "192.168.1.1:9102", expected: false},
+		{address: "localhost:9102", expected: false},
+		{address: "127.0.0.1", expected: false},
+		{address: "127.0.0.1:0", expected: false},
+		{address: "", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.address, func(t *testing.T) {
+			assert.Equal(t, test.expected, IsValidAddress(test.address))
+		})
+	}
+}
+
+func freeAddress(t *testing.T) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+	return address
+}
+
+func TestServer_Set(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	server := NewServer(&mockDaemonClient{})
+	defer server.Stop()
+
+	address := freeAddress(t)
+	require.NoError(t, server.Set(config.RESTAPI{Enabled: true, Address: address, Token: "old"}))
+	assert.Equal(t, address, server.address)
+
+	// new address is taken, the running server is kept
+	taken, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer taken.Close()
+	assert.Error(t, server.Set(config.RESTAPI{Enabled: true, Address: taken.Addr().String(), Token: "old"}))
+	assert.Equal(t, address, server.address)
+	conn, err := net.Dial("tcp", address)
+	require.NoError(t, err)
+	conn.Close()
+
+	// same address is bound again with the new token
+	require.NoError(t, server.Set(config.RESTAPI{Enabled: true, Address: address, Token: "new"}))
+	assert.Equal(t, "new", server.token)
+
+	other := freeAddress(t)
+	require.NoError(t, server.Set(config.RESTAPI{Enabled: true, Address: other, Token: "new"}))
+	assert.Equal(t, other, server.address)
+
+	require.NoError(t, server.Set(config.RESTAPI{Address: other, Token: "new"}))
+	assert.Nil(t, server.server)
+}
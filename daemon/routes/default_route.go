@@ -0,0 +1,94 @@
+package routes
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// DefaultRouteManager is responsible for handling default routes which were not
+// added by the app, e.g. configured by the system or manually by the user.
+type DefaultRouteManager interface {
+	// List IPv4 default routes in the given routing table
+	List(tableID uint) ([]Route, error)
+	// Add route to the system
+	Add(route Route) error
+	// Delete route from the system
+	Delete(route Route) error
+}
+
+// NetlinkDefaultRouteManager manages default routes using netlink
+type NetlinkDefaultRouteManager struct{}
+
+// List IPv4 default routes in the given routing table. Table 0 stands for the main table.
+func (NetlinkDefaultRouteManager) List(tableID uint) ([]Route, error) {
+	table := tableOrMain(tableID)
+	routeList, err := netlink.RouteListFiltered(
+		netlink.FAMILY_V4,
+		&netlink.Route{Table: table},
+		netlink.RT_FILTER_TABLE,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing routes in table %d: %w", table, err)
+	}
+
+	var defaultRoutes []Route
+	for _, r := range routeList {
+		if r.Dst != nil {
+			continue
+		}
+		iface, err := net.InterfaceByIndex(r.LinkIndex)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving network interface by index: %w", err)
+		}
+		route := Route{
+			Subnet:  netip.MustParsePrefix("0.0.0.0/0"),
+			Device:  *iface,
+			TableID: tableID,
+			Metric:  r.Priority,
+		}
+		if gw, ok := netip.AddrFromSlice(r.Gw); ok {
+			route.Gateway = gw.Unmap()
+		}
+		defaultRoutes = append(defaultRoutes, route)
+	}
+	return defaultRoutes, nil
+}
+
+// Add route to the system
+func (NetlinkDefaultRouteManager) Add(route Route) error {
+	if err := netlink.RouteAdd(toNetlinkRoute(route)); err != nil {
+		return fmt.Errorf("adding default route via %s dev %s: %w", route.Gateway, route.Device.Name, err)
+	}
+	return nil
+}
+
+// Delete route from the system
+func (NetlinkDefaultRouteManager) Delete(route Route) error {
+	if err := netlink.RouteDel(toNetlinkRoute(route)); err != nil {
+		return fmt.Errorf("deleting default route via %s dev %s: %w", route.Gateway, route.Device.Name, err)
+	}
+	return nil
+}
+
+func toNetlinkRoute(route Route) *netlink.Route {
+	r := &netlink.Route{
+		LinkIndex: route.Device.Index,
+		Table:     tableOrMain(route.TableID),
+		Priority:  route.Metric,
+	}
+	if route.Gateway.IsValid() {
+		r.Gw = route.Gateway.AsSlice()
+	}
+	return r
+}
+
+func tableOrMain(tableID uint) int {
+	if tableID == 0 {
+		return unix.RT_TABLE_MAIN
+	}
+	return int(tableID)
+}
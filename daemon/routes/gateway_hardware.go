@@ -0,0 +1,37 @@
+package routes
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/vishvananda/netlink"
+)
+
+// ErrGatewayNotResolved is returned when the gateway is not in the neighbour table
+var ErrGatewayNotResolved = errors.New("gateway hardware address is not known")
+
+// GatewayHardwareAddr returns the MAC address of the gateway reachable through
+// the interface from the neighbour table
+func GatewayHardwareAddr(gateway netip.Addr, iface net.Interface) (net.HardwareAddr, error) {
+	family := netlink.FAMILY_V4
+	if gateway.Is6() {
+		family = netlink.FAMILY_V6
+	}
+	neighbors, err := netlink.NeighList(iface.Index, family)
+	if err != nil {
+		return nil, fmt.Errorf("listing neighbours of %s: %w", iface.Name, err)
+	}
+	for _, neighbor := range neighbors {
+		ip, ok := netip.AddrFromSlice(neighbor.IP)
+		if !ok || ip.Unmap() != gateway || len(neighbor.HardwareAddr) == 0 {
+			continue
+		}
+		if neighbor.State&(netlink.NUD_INCOMPLETE|netlink.NUD_FAILED) != 0 {
+			continue
+		}
+		return neighbor.HardwareAddr, nil
+	}
+	return nil, ErrGatewayNotResolved
+}
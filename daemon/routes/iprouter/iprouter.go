@@ -141,6 +141,14 @@ func getRouteArgs(route routes.Route, operation string) ([]string, error) {
 		)
 	}
 
+	if route.Metric != 0 {
+		args = append(
+			args,
+			"metric",
+			strconv.Itoa(route.Metric),
+		)
+	}
+
 	if route.Subnet.Addr() != (netip.Addr{}) && route.Gateway != (netip.Addr{}) {
 		return append(
 			args,
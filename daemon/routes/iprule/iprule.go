@@ -19,13 +19,17 @@ import (
 type Router struct {
 	rpFilterManager routes.RPFilterManager
 	tableID         uint
-	fwmark          uint32
-	mu              sync.Mutex
+	// customTableID is used for the fwmark rule instead of the first table not
+	// in use, 0 if not set
+	customTableID uint
+	fwmark        uint32
+	mu            sync.Mutex
 }
 
-// NewRouter is a default constructor for Router
-func NewRouter(rpFilterManager routes.RPFilterManager, fwmark uint32) *Router {
-	return &Router{rpFilterManager: rpFilterManager, fwmark: fwmark}
+// NewRouter is a default constructor for Router. Routing table with
+// customTableID is used, unless it is 0.
+func NewRouter(rpFilterManager routes.RPFilterManager, fwmark uint32, customTableID uint) *Router {
+	return &Router{rpFilterManager: rpFilterManager, fwmark: fwmark, customTableID: customTableID}
 }
 
 // SetupRoutingRules setup or adjust policy based routing rules
@@ -73,7 +77,7 @@ func (r *Router) SetupRoutingRules(
 			if err != nil {
 				return err
 			}
-			routingTableID, err = calculateCustomTableID(ipv6)
+			routingTableID, err = r.newTableID(ipv6)
 			if err != nil {
 				return err
 			}
@@ -153,6 +157,14 @@ func (r *Router) TableID() uint {
 	return r.tableID
 }
 
+// newTableID returns the table for the fwmark rule
+func (r *Router) newTableID(ipv6 bool) (uint, error) {
+	if r.customTableID != 0 {
+		return r.customTableID, nil
+	}
+	return calculateCustomTableID(ipv6)
+}
+
 // calculateRulePriority find out what priority id to use for Fwmark rule
 //
 // On some environments already existing IP rules can exist with very high priority
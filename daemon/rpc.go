@@ -3,6 +3,8 @@
 package daemon
 
 import (
+	"log"
+	"strings"
 	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/auth"
@@ -11,7 +13,13 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/core/mesh"
 	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
 	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/blocked"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/ondemand"
+	"github.com/NordSecurity/nordvpn-linux/daemon/metered"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/routes"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/nordlynx"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/openvpn"
 	"github.com/NordSecurity/nordvpn-linux/events"
 	"github.com/NordSecurity/nordvpn-linux/fileshare/service"
 	"github.com/NordSecurity/nordvpn-linux/internal"
@@ -42,15 +50,46 @@ type RPC struct {
 	// factory picks which VPN implementation to use
 	factory          FactoryFunc
 	endpointResolver network.EndpointResolver
+	nat64            network.NAT64Detector
 	endpoint         network.Endpoint
 	scheduler        *gocron.Scheduler
 	netw             networker.Networker
 	publisher        events.Publisher[string]
 	nameservers      dns.Getter
+	dnsPreviewer     dns.Previewer
+	serverProber     ServerProber
+	blockedTraffic   blocked.Lister
+	metered          *meteredPolicy
 	ncClient         nc.NotificationClient
 	analytics        events.Analytics
 	fileshare        service.Fileshare
 	meshRegistry     mesh.Registry
+	demandDetector   ondemand.Detector
+	idleDisconnect   *idleDisconnect
+	captivePortal    *captivePortal
+	autoConnectRules *autoConnectRules
+	trustedNetworks  *trustedNetworks
+	techSelector     *technologySelector
+	pause            *vpnPause
+	recovery         *connectionRecovery
+	throughput       *throughputCollapse
+	health           *serverHealth
+	credentials      *credentialsRotation
+	sessionLog       *SessionLog
+	selfTest         *SelfTest
+	reload           *configReload
+	metrics          MetricsServer
+	localProxy       LocalProxyServer
+	leakTest         LeakTester
+	logLevel         LogLevelSetter
+	history          *ConnectionHistory
+	hooks            HookRunner
+	eventStream      *EventStream
+	restAPI          RESTAPIServer
+	bootKillSwitch   BootKillSwitch
+	// obfuscateNext is set to 1 to make the next plain OpenVPN connection use
+	// an obfuscated server, accessed atomically
+	obfuscateNext uint32
 	pb.UnimplementedDaemonServer
 }
 
@@ -70,6 +109,7 @@ func NewRPC(
 	events *Events,
 	factory FactoryFunc,
 	endpointResolver network.EndpointResolver,
+	nat64 network.NAT64Detector,
 	netw networker.Networker,
 	publisher events.Publisher[string],
 	nameservers dns.Getter,
@@ -77,8 +117,24 @@ func NewRPC(
 	analytics events.Analytics,
 	fileshare service.Fileshare,
 	meshRegistry mesh.Registry,
+	demandDetector ondemand.Detector,
+	dnsPreviewer dns.Previewer,
+	blockedTraffic blocked.Lister,
+	meteredDetector metered.Detector,
+	portalProber PortalProber,
+	sessionLog *SessionLog,
+	selfTest *SelfTest,
+	metrics MetricsServer,
+	localProxy LocalProxyServer,
+	leakTest LeakTester,
+	logLevel LogLevelSetter,
+	history *ConnectionHistory,
+	hooks HookRunner,
+	eventStream *EventStream,
+	restAPI RESTAPIServer,
+	bootKillSwitch BootKillSwitch,
 ) *RPC {
-	return &RPC{
+	r := &RPC{
 		environment:      environment,
 		ac:               ac,
 		cm:               cm,
@@ -95,6 +151,7 @@ func NewRPC(
 		factory:          factory,
 		events:           events,
 		endpointResolver: endpointResolver,
+		nat64:            nat64,
 		scheduler:        gocron.NewScheduler(time.UTC),
 		netw:             netw,
 		publisher:        publisher,
@@ -103,5 +160,123 @@ func NewRPC(
 		analytics:        analytics,
 		fileshare:        fileshare,
 		meshRegistry:     meshRegistry,
+		demandDetector:   demandDetector,
+		dnsPreviewer:     dnsPreviewer,
+		serverProber:     TCPProber{},
+		blockedTraffic:   blockedTraffic,
+		metered:          &meteredPolicy{cm: cm, detector: meteredDetector},
+		sessionLog:       sessionLog,
+		selfTest:         selfTest,
+		metrics:          metrics,
+		localProxy:       localProxy,
+		leakTest:         leakTest,
+		logLevel:         logLevel,
+		history:          history,
+		hooks:            hooks,
+		eventStream:      eventStream,
+		restAPI:          restAPI,
+		bootKillSwitch:   bootKillSwitch,
 	}
+	r.idleDisconnect = &idleDisconnect{
+		cm:   cm,
+		netw: netw,
+		disconnect: func() error {
+			return r.disconnect(disconnectReasonIdle)
+		},
+		now: time.Now,
+	}
+	r.reload = &configReload{}
+	if err := cm.Load(&r.reload.applied); err != nil {
+		log.Println(internal.WarningPrefix, "loading config for reload:", err)
+	}
+	r.captivePortal = &captivePortal{
+		cm:       cm,
+		netw:     netw,
+		detector: meteredDetector,
+		prober:   portalProber,
+		gateway:  routes.IPGatewayRetriever{},
+		notify: func(network string) {
+			if err := Notify(cm, internal.NotificationCaptivePortal, []string{network}); err != nil {
+				log.Println(internal.WarningPrefix, "captive portal: notifying:", err)
+			}
+		},
+		now: time.Now,
+	}
+	r.autoConnectRules = &autoConnectRules{
+		cm:       cm,
+		netw:     netw,
+		detector: meteredDetector,
+		connect:  r.connectWith,
+		allowed:  r.metered.autoConnectAllowed,
+	}
+	r.trustedNetworks = &trustedNetworks{
+		cm:           cm,
+		netw:         netw,
+		detector:     meteredDetector,
+		gateway:      routes.IPGatewayRetriever{},
+		hardwareAddr: routes.GatewayHardwareAddr,
+		disconnect: func() error {
+			return r.disconnect(disconnectReasonTrusted)
+		},
+		connect: r.connectWith,
+		lastServer: func() string {
+			return strings.Split(r.lastServer.Hostname, ".")[0]
+		},
+	}
+	r.techSelector = &technologySelector{
+		detector:      meteredDetector,
+		gateway:       routes.IPGatewayRetriever{},
+		prober:        nordlynx.HandshakeProber{},
+		openVPNProber: openvpn.ConnectProber{},
+	}
+	r.pause = &vpnPause{
+		cm:   cm,
+		netw: netw,
+		disconnect: func() error {
+			return r.disconnect(disconnectReasonPause)
+		},
+		connect: r.connectWith,
+		now:     time.Now,
+	}
+	r.recovery = &connectionRecovery{
+		cm:      cm,
+		netw:    netw,
+		connect: r.connectWith,
+		lastServer: func() string {
+			return strings.Split(r.lastServer.Hostname, ".")[0]
+		},
+		defaultDelay: network.ExponentialBackoff,
+		now:          time.Now,
+	}
+	r.throughput = &throughputCollapse{
+		netw: netw,
+		lastServer: func() core.Server {
+			return r.lastServer
+		},
+		switchToObfuscated: r.switchToObfuscated,
+		now:                time.Now,
+	}
+	r.health = &serverHealth{
+		netw: netw,
+		api:  serversAPI,
+		lastServer: func() core.Server {
+			return r.lastServer
+		},
+		failOver: r.failOver,
+		now:      time.Now,
+	}
+	r.credentials = &credentialsRotation{
+		cm:   cm,
+		api:  credentialsAPI,
+		netw: netw,
+		reconnect: func() error {
+			server := strings.Split(r.lastServer.Hostname, ".")[0]
+			if err := r.disconnect(disconnectReasonCredentials); err != nil {
+				return err
+			}
+			return r.connectWith(&pb.ConnectRequest{ServerTag: server})
+		},
+		now: time.Now,
+	}
+	return r
 }
@@ -0,0 +1,126 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// AddAutoConnectRule adds the auto-connect rule. The rule with the same criteria
+// is replaced in place, so that the order of the rules is kept.
+func (r *RPC) AddAutoConnectRule(ctx context.Context, in *pb.AddAutoConnectRuleRequest) (*pb.Payload, error) {
+	rule, ok := autoConnectRuleFromPb(in.GetRule())
+	if !ok {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	rules := append(config.AutoConnectRules{}, cfg.AutoConnectRules...)
+	replaced := false
+	for i, existing := range rules {
+		if existing.SameCriteria(rule) {
+			if existing == rule {
+				return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+			}
+			rules[i] = rule
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		rules = append(rules, rule)
+	}
+
+	return r.saveAutoConnectRules(rules), nil
+}
+
+// RemoveAutoConnectRule removes the auto-connect rule by its index in settings
+func (r *RPC) RemoveAutoConnectRule(ctx context.Context, in *pb.RemoveAutoConnectRuleRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	index := int(in.GetIndex())
+	if index < 1 || index > len(cfg.AutoConnectRules) {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	rules := config.AutoConnectRules{}
+	rules = append(rules, cfg.AutoConnectRules[:index-1]...)
+	rules = append(rules, cfg.AutoConnectRules[index:]...)
+	return r.saveAutoConnectRules(rules), nil
+}
+
+func (r *RPC) saveAutoConnectRules(rules config.AutoConnectRules) *pb.Payload {
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.AutoConnectRules = rules
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}
+	}
+	// rules are applied on the next network change
+	return &pb.Payload{Type: internal.CodeSuccess}
+}
+
+// autoConnectRuleFromPb returns the rule if it has at least one criterion and
+// the server is only set for the connect action
+func autoConnectRuleFromPb(in *pb.AutoConnectRule) (config.AutoConnectRule, bool) {
+	rule := config.AutoConnectRule{
+		SSID:        in.GetSsid(),
+		BSSID:       strings.ToLower(in.GetBssid()),
+		NetworkType: config.NetworkType(in.GetNetworkType()),
+		Action:      config.AutoConnectAction(in.GetAction()),
+		ServerTag:   strings.TrimSpace(in.GetServerTag()),
+		ServerGroup: strings.TrimSpace(in.GetServerGroup()),
+	}
+
+	if rule.SSID == "" && rule.BSSID == "" && rule.NetworkType == "" {
+		return rule, false
+	}
+	if rule.BSSID != "" {
+		if mac, err := net.ParseMAC(rule.BSSID); err != nil || len(mac) != 6 {
+			return rule, false
+		}
+	}
+	switch rule.NetworkType {
+	case "", config.NetworkTypeWiFi, config.NetworkTypeEthernet, config.NetworkTypeMobile:
+	default:
+		return rule, false
+	}
+	switch rule.Action {
+	case config.AutoConnectActionConnect:
+	case config.AutoConnectActionSkip:
+		if rule.ServerTag != "" || rule.ServerGroup != "" {
+			return rule, false
+		}
+	default:
+		return rule, false
+	}
+	return rule, true
+}
+
+func autoConnectRulesToPb(rules config.AutoConnectRules) []*pb.AutoConnectRule {
+	out := []*pb.AutoConnectRule{}
+	for _, rule := range rules {
+		out = append(out, &pb.AutoConnectRule{
+			Ssid:        rule.SSID,
+			Bssid:       rule.BSSID,
+			NetworkType: string(rule.NetworkType),
+			Action:      string(rule.Action),
+			ServerTag:   rule.ServerTag,
+			ServerGroup: rule.ServerGroup,
+		})
+	}
+	return out
+}
@@ -0,0 +1,167 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddAutoConnectRule(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	home := config.AutoConnectRule{SSID: "HomeLAN", Action: config.AutoConnectActionSkip}
+	coffee := config.AutoConnectRule{SSID: "CoffeeShop", Action: config.AutoConnectActionConnect, ServerGroup: "obfuscated"}
+
+	tests := []struct {
+		name         string
+		current      config.AutoConnectRules
+		rule         *pb.AutoConnectRule
+		saveErr      error
+		expected     config.AutoConnectRules
+		expectedCode int64
+	}{
+		{
+			name:         "add",
+			current:      config.AutoConnectRules{home},
+			rule:         &pb.AutoConnectRule{Ssid: "CoffeeShop", Action: "connect", ServerGroup: "obfuscated"},
+			expected:     config.AutoConnectRules{home, coffee},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:    "replace keeps order",
+			current: config.AutoConnectRules{coffee, home},
+			rule:    &pb.AutoConnectRule{Ssid: "CoffeeShop", Action: "connect", ServerTag: "lt"},
+			expected: config.AutoConnectRules{
+				{SSID: "CoffeeShop", Action: config.AutoConnectActionConnect, ServerTag: "lt"},
+				home,
+			},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already added",
+			current:      config.AutoConnectRules{home},
+			rule:         &pb.AutoConnectRule{Ssid: "HomeLAN", Action: "skip"},
+			expected:     config.AutoConnectRules{home},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name: "bssid is normalized",
+			rule: &pb.AutoConnectRule{Bssid: "AA:BB:CC:DD:EE:FF", NetworkType: "wifi", Action: "connect"},
+			expected: config.AutoConnectRules{{
+				BSSID:       "aa:bb:cc:dd:ee:ff",
+				NetworkType: config.NetworkTypeWiFi,
+				Action:      config.AutoConnectActionConnect,
+			}},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "no criteria",
+			rule:         &pb.AutoConnectRule{Action: "connect"},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "invalid bssid",
+			rule:         &pb.AutoConnectRule{Bssid: "aa:bb", Action: "connect"},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "invalid network type",
+			rule:         &pb.AutoConnectRule{NetworkType: "bluetooth", Action: "connect"},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "invalid action",
+			rule:         &pb.AutoConnectRule{Ssid: "HomeLAN", Action: "disconnect"},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "skip with server",
+			rule:         &pb.AutoConnectRule{Ssid: "HomeLAN", Action: "skip", ServerTag: "lt"},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "config failure",
+			rule:         &pb.AutoConnectRule{Ssid: "HomeLAN", Action: "skip"},
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.AutoConnectRules = test.current
+			cm.SaveErr = test.saveErr
+
+			r := RPC{cm: cm}
+			resp, err := r.AddAutoConnectRule(context.Background(), &pb.AddAutoConnectRuleRequest{Rule: test.rule})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			if test.expected != nil {
+				assert.Equal(t, test.expected, cm.Cfg.AutoConnectRules)
+			} else {
+				assert.Equal(t, test.current, cm.Cfg.AutoConnectRules)
+			}
+		})
+	}
+}
+
+func TestRemoveAutoConnectRule(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	rules := config.AutoConnectRules{
+		{SSID: "HomeLAN", Action: config.AutoConnectActionSkip},
+		{SSID: "CoffeeShop", Action: config.AutoConnectActionConnect},
+		{NetworkType: config.NetworkTypeMobile, Action: config.AutoConnectActionConnect},
+	}
+
+	tests := []struct {
+		name         string
+		index        uint32
+		expected     config.AutoConnectRules
+		expectedCode int64
+	}{
+		{
+			name:         "remove",
+			index:        2,
+			expected:     config.AutoConnectRules{rules[0], rules[2]},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "remove last",
+			index:        3,
+			expected:     rules[:2],
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "zero index",
+			expected:     rules,
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "out of range",
+			index:        4,
+			expected:     rules,
+			expectedCode: internal.CodeNothingToDo,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.AutoConnectRules = append(config.AutoConnectRules{}, rules...)
+
+			r := RPC{cm: cm}
+			resp, err := r.RemoveAutoConnectRule(context.Background(), &pb.RemoveAutoConnectRuleRequest{Index: test.index})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.AutoConnectRules)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package daemon
+
+import (
+	"context"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// CacheStats reports the use of the server list cache since the daemon start
+func (r *RPC) CacheStats(ctx context.Context, in *pb.Empty) (*pb.CacheStatsResponse, error) {
+	stats := r.dm.GetServersCacheStats()
+	resp := &pb.CacheStatsResponse{
+		Type:      internal.CodeSuccess,
+		Hits:      stats.Hits,
+		Downloads: stats.Downloads,
+		Servers:   uint64(stats.Servers),
+		Size:      uint64(stats.Size),
+		Fresh:     stats.Fresh,
+	}
+	if !stats.UpdatedAt.IsZero() {
+		resp.UpdatedAt = stats.UpdatedAt.Unix()
+	}
+	return resp, nil
+}
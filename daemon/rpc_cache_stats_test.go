@@ -0,0 +1,97 @@
+package daemon
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheStats(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	filePath := filepath.Join(t.TempDir(), "servers.dat")
+	assert.NoError(t, os.WriteFile(filePath, make([]byte, 2048), internal.PermUserRWGroupROthersR))
+	updatedAt := time.Now().Add(-10 * time.Minute)
+
+	tests := []struct {
+		name     string
+		dm       *DataManager
+		expected *pb.CacheStatsResponse
+	}{
+		{
+			name: "no cache",
+			dm:   NewDataManager("", filepath.Join(t.TempDir(), "servers.dat"), "", ""),
+			expected: &pb.CacheStatsResponse{
+				Type: internal.CodeSuccess,
+			},
+		},
+		{
+			name: "fresh cache",
+			dm: &DataManager{
+				serversData: ServersData{
+					filePath:  filePath,
+					UpdatedAt: updatedAt,
+					Servers:   core.Servers{{ID: 1}, {ID: 2}},
+				},
+				serversCacheStats: ServersCacheStats{Hits: 5, Downloads: 1},
+			},
+			expected: &pb.CacheStatsResponse{
+				Type:      internal.CodeSuccess,
+				Hits:      5,
+				Downloads: 1,
+				Servers:   2,
+				Size:      2048,
+				UpdatedAt: updatedAt.Unix(),
+				Fresh:     true,
+			},
+		},
+		{
+			name: "outdated cache",
+			dm: &DataManager{
+				serversData: ServersData{
+					filePath:  filePath,
+					UpdatedAt: updatedAt.Add(-time.Hour),
+					Servers:   core.Servers{{ID: 1}},
+				},
+				serversCacheStats: ServersCacheStats{Downloads: 1},
+			},
+			expected: &pb.CacheStatsResponse{
+				Type:      internal.CodeSuccess,
+				Downloads: 1,
+				Servers:   1,
+				Size:      2048,
+				UpdatedAt: updatedAt.Add(-time.Hour).Unix(),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rpc := RPC{dm: test.dm}
+			resp, err := rpc.CacheStats(context.Background(), &pb.Empty{})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected.String(), resp.String())
+		})
+	}
+}
+
+func TestCacheStats_CountsHits(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	dm := NewDataManager("", filepath.Join(t.TempDir(), "servers.dat"), "", "")
+	dm.CountServersDownload()
+	dm.CountServersCacheHit()
+	dm.CountServersCacheHit()
+
+	stats := dm.GetServersCacheStats()
+	assert.Equal(t, uint64(2), stats.Hits)
+	assert.Equal(t, uint64(1), stats.Downloads)
+}
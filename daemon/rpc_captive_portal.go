@@ -0,0 +1,80 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/slices"
+)
+
+// SetCaptivePortalNetwork marks the network connection as having a captive
+// portal or removes the mark
+func (r *RPC) SetCaptivePortalNetwork(ctx context.Context, in *pb.SetCaptivePortalNetworkRequest) (*pb.Payload, error) {
+	network := strings.TrimSpace(in.GetNetwork())
+	if network == "" {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	if slices.Contains(cfg.CaptivePortalNetworks, network) == in.GetCaptivePortal() {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		networks := []string{}
+		for _, n := range c.CaptivePortalNetworks {
+			if n != network {
+				networks = append(networks, n)
+			}
+		}
+		if in.GetCaptivePortal() {
+			networks = append(networks, network)
+		}
+		c.CaptivePortalNetworks = networks
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+// UnlockCaptivePortal opens kill switch for the sign-in page of the captive
+// portal for a bounded time
+func (r *RPC) UnlockCaptivePortal(ctx context.Context, in *pb.UnlockCaptivePortalRequest) (*pb.Payload, error) {
+	duration := time.Duration(in.GetDuration()) * time.Second
+	if duration == 0 {
+		duration = DefaultPortalUnlock
+	}
+	if duration > MaxPortalUnlock {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+	if !cfg.KillSwitch {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+	if r.netw.IsVPNActive() {
+		return &pb.Payload{Type: internal.CodeVPNRunning}, nil
+	}
+
+	portalURL, err := r.captivePortal.unlock(duration)
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "unlocking captive portal:", err)
+		return &pb.Payload{Type: internal.CodeFailure}, nil
+	}
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{duration.String(), portalURL}}, nil
+}
@@ -0,0 +1,145 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetCaptivePortalNetwork(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      []string
+		req          *pb.SetCaptivePortalNetworkRequest
+		saveErr      error
+		expected     []string
+		expectedCode int64
+	}{
+		{
+			name:         "add",
+			current:      []string{"office guest"},
+			req:          &pb.SetCaptivePortalNetworkRequest{Network: "hotel wifi", CaptivePortal: true},
+			expected:     []string{"office guest", "hotel wifi"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "remove",
+			current:      []string{"office guest", "hotel wifi"},
+			req:          &pb.SetCaptivePortalNetworkRequest{Network: "office guest"},
+			expected:     []string{"hotel wifi"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already added",
+			current:      []string{"hotel wifi"},
+			req:          &pb.SetCaptivePortalNetworkRequest{Network: "hotel wifi", CaptivePortal: true},
+			expected:     []string{"hotel wifi"},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "not added",
+			req:          &pb.SetCaptivePortalNetworkRequest{Network: "hotel wifi"},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "empty name",
+			req:          &pb.SetCaptivePortalNetworkRequest{Network: " ", CaptivePortal: true},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "config failure",
+			req:          &pb.SetCaptivePortalNetworkRequest{Network: "hotel wifi", CaptivePortal: true},
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.CaptivePortalNetworks = test.current
+			cm.SaveErr = test.saveErr
+
+			r := RPC{cm: cm}
+			resp, err := r.SetCaptivePortalNetwork(context.Background(), test.req)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.CaptivePortalNetworks)
+		})
+	}
+}
+
+func TestUnlockCaptivePortal(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		duration     uint32
+		killSwitch   bool
+		netw         networker.Networker
+		expectedCode int64
+	}{
+		{
+			name:         "default duration",
+			killSwitch:   true,
+			netw:         &testnetworker.Mock{},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "too long",
+			duration:     uint32((MaxPortalUnlock + time.Second) / time.Second),
+			killSwitch:   true,
+			netw:         &testnetworker.Mock{},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "kill switch disabled",
+			netw:         &testnetworker.Mock{},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "vpn is active",
+			killSwitch:   true,
+			netw:         &testnetworker.Mock{VpnActive: true},
+			expectedCode: internal.CodeVPNRunning,
+		},
+		{
+			name:         "firewall failure",
+			killSwitch:   true,
+			netw:         testnetworker.Failing{},
+			expectedCode: internal.CodeFailure,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.KillSwitch = test.killSwitch
+			rpc := RPC{cm: cm, netw: test.netw}
+			rpc.captivePortal = &captivePortal{
+				cm:      cm,
+				netw:    test.netw,
+				prober:  &mockPortalProber{},
+				gateway: mockPortalGateway{},
+				now:     time.Now,
+			}
+
+			resp, err := rpc.UnlockCaptivePortal(context.Background(), &pb.UnlockCaptivePortalRequest{Duration: test.duration})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			if test.expectedCode == internal.CodeSuccess {
+				assert.Equal(t, []string{DefaultPortalUnlock.String(), ""}, resp.Data)
+			}
+		})
+	}
+}
@@ -4,15 +4,20 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net"
+	"strings"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/auth"
 	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/hooks"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/events"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	"github.com/NordSecurity/nordvpn-linux/network"
+	"golang.org/x/exp/slices"
 )
 
 // Connect initiates and handles the VPN connection process
@@ -21,6 +26,19 @@ func (r *RPC) Connect(in *pb.ConnectRequest, srv pb.Daemon_ConnectServer) (retEr
 		return internal.ErrNotLoggedIn
 	}
 
+	var connected bool
+	if in.GetLogFile() != "" {
+		if err := r.startSessionLog(srv.Context(), in.GetLogFile()); err != nil {
+			log.Println(internal.ErrorPrefix, "starting session log:", err)
+			return internal.ErrSessionLog
+		}
+		defer func() {
+			if !connected {
+				r.stopSessionLog()
+			}
+		}()
+	}
+
 	if r.systemInfoFunc != nil && r.networkInfoFunc != nil {
 		log.Printf("PRE_CONNECT system info:\n%s\n%s\n", r.systemInfoFunc(r.version), r.networkInfoFunc())
 	}
@@ -38,6 +56,66 @@ func (r *RPC) Connect(in *pb.ConnectRequest, srv pb.Daemon_ConnectServer) (retEr
 		log.Println(internal.ErrorPrefix, err)
 	}
 
+	var profile config.Profile
+	if in.GetProfile() != "" {
+		var ok bool
+		if profile, ok = cfg.Profiles.Get(in.GetProfile()); !ok {
+			return internal.ErrProfileDoesNotExist
+		}
+		if err := r.applyProfile(profile); err != nil {
+			return err
+		}
+		if err := r.cm.Load(&cfg); err != nil {
+			log.Println(internal.ErrorPrefix, err)
+		}
+	}
+
+	if in.GetViaInterface() != "" {
+		if err := checkUplink(in.GetViaInterface()); err != nil {
+			log.Println(internal.ErrorPrefix, "checking uplink:", err)
+			return internal.ErrUplinkUnavailable
+		}
+	}
+
+	// the technology of the active connection cannot be switched under it
+	var autoFingerprint string
+	if cfg.AutoTechnology && !r.netw.IsVPNActive() {
+		var tech config.Technology
+		tech, autoFingerprint = r.autoTechnology(cfg)
+		if tech != cfg.Technology {
+			if err := r.switchTechnology(cfg, tech, true); err != nil {
+				log.Println(internal.ErrorPrefix, "switching to the picked technology:", err)
+				return internal.ErrUnhandled
+			}
+			if err := r.cm.Load(&cfg); err != nil {
+				log.Println(internal.ErrorPrefix, err)
+			}
+		}
+	}
+
+	var networkName string
+	if cfg.Technology == config.Technology_OPENVPN {
+		networkName = r.networkName()
+	}
+	if r.obfuscationPreferred(cfg, networkName) {
+		log.Println(internal.InfoPrefix, "plain OpenVPN was interfered with on", networkName, "using obfuscated servers")
+		cfg.AutoConnectData.Obfuscate = true
+	}
+
+	if cfg.Technology == config.Technology_OPENVPN &&
+		cfg.AutoConnectData.Obfuscate &&
+		cfg.ObfuscationMode.IsWrapped() {
+		// TLS and WebSocket carry a stream, so OpenVPN uses TCP
+		cfg.AutoConnectData.Protocol = config.Protocol_TCP
+	}
+
+	port := cfg.ServerPorts.Get(cfg.Technology)
+	if usesOpenVPNOverUDP(cfg) && networkName != "" && slices.Contains(cfg.TCPNetworks, networkName) {
+		log.Println(internal.InfoPrefix, "OpenVPN over UDP is blocked on", networkName, "using TCP")
+		cfg.AutoConnectData.Protocol = config.Protocol_TCP
+		port = tcpFallbackPort
+	}
+
 	insights := r.dm.GetInsightsData().Insights
 
 	event := events.DataConnect{
@@ -69,35 +147,109 @@ func (r *RPC) Connect(in *pb.ConnectRequest, srv pb.Daemon_ConnectServer) (retEr
 		}
 	}()
 
-	log.Println(internal.DebugPrefix, "picking servers for", cfg.Technology, "technology")
-	server, remote, err := PickServer(
-		r.serversAPI,
-		r.dm.GetCountryData().Countries,
-		r.dm.GetServersData().Servers,
-		insights.Longitude,
-		insights.Latitude,
-		cfg.Technology,
-		cfg.AutoConnectData.Protocol,
-		cfg.AutoConnectData.Obfuscate,
-		in.GetServerTag(),
-		in.GetServerGroup(),
-	)
+	serverTag, serverGroup := in.GetServerTag(), in.GetServerGroup()
+	if in.GetFavorite() != "" {
+		favorite, ok := cfg.Favorites.Get(in.GetFavorite())
+		if !ok {
+			return internal.ErrFavoriteDoesNotExist
+		}
+		serverTag, serverGroup = favorite.ServerTag, favorite.ServerGroup
+	}
+	if serverTag == "" && serverGroup == "" {
+		serverGroup = profile.ServerGroup
+	}
 
-	if err != nil {
-		log.Println(internal.ErrorPrefix, "picking servers:", err)
+	if !cfg.AllowedTechnologies.Allows(cfg.Technology) {
+		log.Println(internal.WarningPrefix, cfg.Technology, "technology is not allowed, allowed:", cfg.AllowedTechnologies)
+		return internal.ErrTechnologyNotAllowed
+	}
+
+	log.Println(internal.DebugPrefix, "picking servers for", cfg.Technology, "technology")
+	servers, serversAPI := r.selectableServers(cfg), r.selectableServersAPI(cfg)
+	pick := func(tag string, group string) (core.Server, bool, error) {
 		switch {
-		case errors.Is(err, core.ErrUnauthorized):
-			if err := r.cm.SaveWith(auth.Logout(cfg.AutoConnectData.ID)); err != nil {
-				return err
+		case in.GetVerify():
+			server, skipped, err := PickVerifiedServer(
+				r.serverProber,
+				servers,
+				cfg.Technology,
+				cfg.AutoConnectData.Protocol,
+				cfg.AutoConnectData.Obfuscate,
+				tag,
+				group,
+			)
+			for _, s := range skipped {
+				if err := srv.Send(&pb.Payload{Type: internal.CodeServerSkipped, Data: []string{s.Hostname, s.Reason}}); err != nil {
+					log.Println(internal.ErrorPrefix, err)
+					return core.Server{}, false, internal.ErrUnhandled
+				}
 			}
-			return internal.ErrNotLoggedIn
-		case errors.Is(err, internal.ErrTagDoesNotExist),
-			errors.Is(err, internal.ErrGroupDoesNotExist),
-			errors.Is(err, internal.ErrServerIsUnavailable),
-			errors.Is(err, internal.ErrDoubleGroup):
-			return err
+			return server, false, err
+		case in.GetRandom():
+			server, err := PickRandomServer(
+				servers,
+				cfg.Technology,
+				cfg.AutoConnectData.Protocol,
+				cfg.AutoConnectData.Obfuscate,
+				tag,
+				group,
+			)
+			if err == nil {
+				log.Println(internal.InfoPrefix, "randomly picked server", server.Hostname, "with load", server.Load)
+			}
+			return server, false, err
+		case cfg.ServerSelection != config.ServerSelectionLoad:
+			return PickServerByLatency(
+				r.serverProber,
+				serversAPI,
+				r.dm.GetCountryData().Countries,
+				servers,
+				insights.Longitude,
+				insights.Latitude,
+				cfg.Technology,
+				cfg.AutoConnectData.Protocol,
+				cfg.AutoConnectData.Obfuscate,
+				tag,
+				group,
+				cfg.ServerSelection,
+			)
 		default:
-			return internal.ErrUnhandled
+			return PickServer(
+				serversAPI,
+				r.dm.GetCountryData().Countries,
+				servers,
+				insights.Longitude,
+				insights.Latitude,
+				cfg.Technology,
+				cfg.AutoConnectData.Protocol,
+				cfg.AutoConnectData.Obfuscate,
+				tag,
+				group,
+				cfg.ServerNotes,
+				cfg.RatingWeight,
+			)
+		}
+	}
+
+	var server core.Server
+	var remote bool
+	if in.GetFavorite() == "" && serverGroup == "" && strings.EqualFold(serverTag, favoritesServerTag) {
+		server, remote, err = pickFavoriteServer(cfg.Favorites, pick)
+	} else {
+		server, remote, err = pick(serverTag, serverGroup)
+	}
+
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "picking servers:", err)
+		return r.pickServerError(cfg, err)
+	}
+
+	var via *vpn.ServerData
+	if in.GetVia() != "" {
+		via, err = r.pickViaServer(cfg, in.GetVia(), server)
+		if err != nil {
+			log.Println(internal.ErrorPrefix, "picking entry server:", err)
+			return r.pickServerError(cfg, err)
 		}
 	}
 
@@ -106,19 +258,31 @@ func (r *RPC) Connect(in *pb.ConnectRequest, srv pb.Daemon_ConnectServer) (retEr
 		log.Println(internal.ErrorPrefix, err)
 	}
 
-	if cfg.IPv6 {
+	endpoint, ipv6Only, err := r.serverEndpoint(cfg, server)
+	if err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return internal.ErrUnhandled
+	}
+	switch {
+	case ipv6Only:
+		// IPv4 is not reachable on IPv6-only network, so the tunnel goes over
+		// IPv6 regardless of the IPv6 setting
 		if err := r.netw.PermitIPv6(); err != nil {
 			log.Println(internal.ErrorPrefix, "failed to re-enable ipv6:", err)
 		}
-		r.endpoint = network.DefaultEndpoint(r.endpointResolver, server.IPs())
-	} else {
-		ip, err := server.IPv4()
-		if err != nil {
-			log.Println(internal.ErrorPrefix, err)
-			return internal.ErrUnhandled
+	case cfg.GetIPv6Mode() == config.IPv6Tunnel:
+		if err := r.netw.PermitIPv6(); err != nil {
+			log.Println(internal.ErrorPrefix, "failed to re-enable ipv6:", err)
+		}
+	case cfg.GetIPv6Mode() == config.IPv6Native:
+		// IPv6 is left as it is, so the server is reachable over both
+	default:
+		// IPv6 might be permitted by the previous connection
+		if err := r.netw.DenyIPv6(); err != nil {
+			log.Println(internal.ErrorPrefix, "failed to disable ipv6:", err)
 		}
-		r.endpoint = network.NewIPv4Endpoint(ip)
 	}
+	r.endpoint = endpoint
 
 	subnet, err := r.endpoint.Network()
 	if err != nil {
@@ -147,7 +311,18 @@ func (r *RPC) Connect(in *pb.ConnectRequest, srv pb.Daemon_ConnectServer) (retEr
 		Protocol:          cfg.AutoConnectData.Protocol,
 		NordLynxPublicKey: server.NordLynxPublicKey,
 		Obfuscated:        cfg.AutoConnectData.Obfuscate,
+		ObfuscationMode:   cfg.ObfuscationMode,
 		OpenVPNVersion:    server.Version(),
+		Port:              port,
+		Via:               via,
+		Technology:        cfg.Technology,
+		InterfaceName:     cfg.InterfaceName,
+		Uplink:            in.GetViaInterface(),
+	}
+	if cfg.AutoConnectData.Obfuscate {
+		serverData.ObfuscationPorts = server.Ports(
+			techToServerTech(cfg.Technology, cfg.AutoConnectData.Protocol, true),
+		)
 	}
 
 	allowlist := cfg.AutoConnectData.Allowlist
@@ -161,16 +336,102 @@ func (r *RPC) Connect(in *pb.ConnectRequest, srv pb.Daemon_ConnectServer) (retEr
 	event.TargetServerDomain = server.Hostname
 	event.TargetServerIP = subnet.Addr().String()
 
-	go Connect(
-		eventCh,
-		creds,
-		serverData,
-		allowlist,
-		cfg.AutoConnectData.DNS.Or(
-			r.nameservers.Get(cfg.AutoConnectData.ThreatProtectionLite, server.SupportsIPv6()),
-		),
-		r.netw,
+	nameservers := cfg.AutoConnectData.DNS.Or(
+		r.nameservers.Get(cfg.AutoConnectData.ThreatProtectionLite, server.SupportsIPv6()),
 	)
+	r.runHooks(hooks.PreConnect, hooks.Env{
+		Hostname:   server.Hostname,
+		Country:    country.Name,
+		City:       city,
+		ServerIP:   subnet.Addr(),
+		Technology: cfg.Technology.String(),
+		Protocol:   cfg.AutoConnectData.Protocol.String(),
+		Interface:  cfg.InterfaceName,
+	})
+	tcpFallback := usesOpenVPNOverUDP(cfg) && core.IsConnectableVia(core.OpenVPNTCP)(server)
+	connect := func(ch chan ConnectEvent, serverData vpn.ServerData) {
+		if tcpFallback && !serverData.Obfuscated {
+			ConnectWithTCPFallback(
+				ch,
+				creds,
+				serverData,
+				allowlist,
+				nameservers,
+				r.netw,
+				func() { r.preferTCP(networkName) },
+			)
+			return
+		}
+		Connect(ch, creds, serverData, allowlist, nameservers, r.netw)
+	}
+
+	// fallback is set before internal.CodeObfuscationFallback is sent
+	var fallback struct {
+		server   core.Server
+		endpoint network.Endpoint
+		data     vpn.ServerData
+	}
+	obfuscated := func() (vpn.ServerData, error) {
+		protocol := cfg.AutoConnectData.Protocol
+		if cfg.ObfuscationMode.IsWrapped() {
+			protocol = config.Protocol_TCP
+		}
+		picked, _, err := PickServer(
+			serversAPI,
+			r.dm.GetCountryData().Countries,
+			servers,
+			insights.Longitude,
+			insights.Latitude,
+			cfg.Technology,
+			protocol,
+			true,
+			serverTag,
+			serverGroup,
+			cfg.ServerNotes,
+			cfg.RatingWeight,
+		)
+		if err != nil {
+			return vpn.ServerData{}, err
+		}
+		endpoint, _, err := r.serverEndpoint(cfg, picked)
+		if err != nil {
+			return vpn.ServerData{}, err
+		}
+		subnet, err := endpoint.Network()
+		if err != nil {
+			return vpn.ServerData{}, err
+		}
+		country, err := picked.Locations.Country()
+		if err != nil {
+			return vpn.ServerData{}, err
+		}
+		data := serverData
+		data.IP = subnet.Addr()
+		data.Hostname = picked.Hostname
+		data.Country = country.Name
+		data.City = country.City.Name
+		data.Protocol = protocol
+		data.Obfuscated = true
+		data.ObfuscationPorts = picked.Ports(techToServerTech(cfg.Technology, protocol, true))
+		data.OpenVPNVersion = picked.Version()
+		data.Port = cfg.ServerPorts.Get(cfg.Technology)
+		fallback.server, fallback.endpoint, fallback.data = picked, endpoint, data
+		return data, nil
+	}
+
+	if in.GetVia() == "" && canFallBackToObfuscation(cfg, servers, serverTag, serverGroup) {
+		go ConnectWithObfuscationFallback(eventCh, serverData, connect, obfuscated)
+	} else {
+		go connect(eventCh, serverData)
+	}
+
+	historyEntry := HistoryEntry{
+		Hostname:   server.Hostname,
+		Country:    country.Name,
+		City:       city,
+		Technology: cfg.Technology,
+		Protocol:   cfg.AutoConnectData.Protocol,
+	}
 
 	var data []string
 	for ev := range eventCh {
@@ -179,13 +440,31 @@ func (r *RPC) Connect(in *pb.ConnectRequest, srv pb.Daemon_ConnectServer) (retEr
 			// If server has at least one IPv6 address
 			// regardless if IPv4 or IPv6 is used to connect
 			// to the server - DO NOT DISABLE IPv6.
-			if !server.SupportsIPv6() {
+			// On IPv6-only network it is needed for the tunnel itself.
+			if !server.SupportsIPv6() && !ipv6Only {
 				if err := r.netw.DenyIPv6(); err != nil {
 					log.Println(internal.ErrorPrefix, "failed to disable ipv6:", err)
 				}
 			}
+			connected = true
+			historyEntry.ConnectedAt = time.Now()
+			r.recordConnect(historyEntry)
 			event.Type = events.ConnectSuccess
 			r.events.Service.Connect.Publish(event)
+			if status, err := r.netw.ConnectionStatus(); err == nil {
+				r.runHooks(hooks.PostConnect, hookEnv(status))
+			}
+
+			if overlaps := r.netw.SubnetOverlaps(); len(overlaps) > 0 {
+				report := make([]string, 0, len(overlaps))
+				for _, overlap := range overlaps {
+					report = append(report, overlap.String())
+				}
+				if err := srv.Send(&pb.Payload{Type: internal.CodeSubnetOverlap, Data: report}); err != nil {
+					log.Println(internal.ErrorPrefix, err)
+					return internal.ErrUnhandled
+				}
+			}
 
 			data = []string{r.lastServer.Name, r.lastServer.Hostname}
 			if err := srv.Send(&pb.Payload{Type: ev.Code, Data: data}); err != nil {
@@ -205,6 +484,39 @@ func (r *RPC) Connect(in *pb.ConnectRequest, srv pb.Daemon_ConnectServer) (retEr
 			r.publisher.Publish(ev.Message)
 			event.Type = events.ConnectFailure
 			r.events.Service.Connect.Publish(event)
+			historyEntry.ConnectedAt = time.Now()
+			historyEntry.DisconnectedAt = historyEntry.ConnectedAt
+			historyEntry.DisconnectReason = disconnectReasonFailure
+			r.recordConnect(historyEntry)
+			// the network may have changed since the technology was picked
+			r.forgetTechnology(autoFingerprint)
+		case internal.CodeProtocolFallback:
+			event.Protocol = config.Protocol_TCP
+			historyEntry.Protocol = config.Protocol_TCP
+		case internal.CodeObfuscationFallback:
+			r.announceObfuscationFallback(events.DataObfuscationFallback{
+				Type:     events.ObfuscationFallbackHandshake,
+				Hostname: server.Hostname,
+				Network:  networkName,
+			})
+			r.preferObfuscation(networkName)
+			server = fallback.server
+			r.lastServer = server
+			r.endpoint = fallback.endpoint
+			event.TargetServerCity = fallback.data.City
+			event.TargetServerCountry = fallback.data.Country
+			event.TargetServerDomain = server.Hostname
+			event.TargetServerIP = fallback.data.IP.String()
+			event.Protocol = fallback.data.Protocol
+			historyEntry.Hostname = server.Hostname
+			historyEntry.Country = fallback.data.Country
+			historyEntry.City = fallback.data.City
+			historyEntry.Protocol = fallback.data.Protocol
+			if err := srv.Send(&pb.Payload{Type: ev.Code, Data: []string{server.Hostname}}); err != nil {
+				log.Println(internal.ErrorPrefix, err)
+				return internal.ErrUnhandled
+			}
+			continue
 		case internal.CodeDisconnected:
 		case internal.CodeVPNNotRunning:
 			// nothing to do here, because already connected to VPN
@@ -219,4 +531,69 @@ func (r *RPC) Connect(in *pb.ConnectRequest, srv pb.Daemon_ConnectServer) (retEr
 	return nil
 }
 
+// serverEndpoint returns the endpoint of the server for the IPv6 mode and true
+// if the daemon runs on IPv6-only network
+func (r *RPC) serverEndpoint(cfg config.Config, server core.Server) (network.Endpoint, bool, error) {
+	if endpoint, ipv6Only := r.nat64Endpoint(server); ipv6Only {
+		return endpoint, true, nil
+	}
+	switch cfg.GetIPv6Mode() {
+	case config.IPv6Tunnel, config.IPv6Native:
+		return network.DefaultEndpoint(r.endpointResolver, server.IPs()), false, nil
+	case config.IPv6Block:
+		fallthrough
+	default:
+		ip, err := server.IPv4()
+		if err != nil {
+			return network.Endpoint{}, false, err
+		}
+		return network.NewIPv4Endpoint(ip), false, nil
+	}
+}
+
+// checkUplink returns an error if the network interface selected for the
+// tunnel packets does not exist or is down
+func checkUplink(name string) error {
+	iface, err := net.InterfaceByName(name)
+	if err != nil {
+		return err
+	}
+	if iface.Flags&net.FlagUp == 0 {
+		return fmt.Errorf("%s is down", name)
+	}
+	return nil
+}
+
+// pickServerError returns the error of picking the server to be shown to the
+// user
+func (r *RPC) pickServerError(cfg config.Config, err error) error {
+	switch {
+	case errors.Is(err, core.ErrUnauthorized):
+		if err := r.cm.SaveWith(auth.Logout(cfg.AutoConnectData.ID)); err != nil {
+			return err
+		}
+		return internal.ErrNotLoggedIn
+	case errors.Is(err, internal.ErrTagDoesNotExist),
+		errors.Is(err, internal.ErrGroupDoesNotExist),
+		errors.Is(err, internal.ErrServerIsUnavailable),
+		errors.Is(err, internal.ErrDoubleGroup),
+		errors.Is(err, internal.ErrNoFavorites),
+		errors.Is(err, internal.ErrSameHop):
+		return err
+	default:
+		return internal.ErrUnhandled
+	}
+}
+
+// ConnectVPN connects to the VPN server matching the given server tag. Empty
+// tag selects the recommended server. It is used by meshnet as a fallback when
+// the exit node peer drops.
+func (r *RPC) ConnectVPN(serverTag string) error {
+	server := autoconnectServer{}
+	if err := r.Connect(&pb.ConnectRequest{ServerTag: serverTag}, &server); err != nil {
+		return err
+	}
+	return server.err
+}
+
 type FactoryFunc func(config.Technology) (vpn.VPN, error)
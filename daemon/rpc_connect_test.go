@@ -195,12 +195,14 @@ func TestRpcConnect(t *testing.T) {
 					&subs.Subject[events.DataConnect]{},
 					&subs.Subject[events.DataDisconnect]{},
 					&subs.Subject[any]{},
+					&subs.Subject[any]{},
 					&subs.Subject[core.ServicesResponse]{},
 					&subs.Subject[events.ServerRating]{},
 					&subs.Subject[int]{},
 				),
 				test.factory,
 				newEndpointResolverMock(netip.MustParseAddr("127.0.0.1")),
+				nil,
 				test.netw,
 				&subs.Subject[string]{},
 				&mock.DNSGetter{Names: []string{"1.1.1.1"}},
@@ -208,6 +210,22 @@ func TestRpcConnect(t *testing.T) {
 				&mockAnalytics{},
 				service.NoopFileshare{},
 				&RegistryMock{},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			)
 			server := &mockRPCServer{}
 			err := rpc.Connect(&pb.ConnectRequest{}, server)
@@ -274,12 +292,14 @@ func TestRpcReconnect(t *testing.T) {
 			&subs.Subject[events.DataConnect]{},
 			&subs.Subject[events.DataDisconnect]{},
 			&subs.Subject[any]{},
+			&subs.Subject[any]{},
 			&subs.Subject[core.ServicesResponse]{},
 			&subs.Subject[events.ServerRating]{},
 			&subs.Subject[int]{},
 		),
 		factory,
 		newEndpointResolverMock(netip.MustParseAddr("127.0.0.1")),
+		nil,
 		&testnetworker.Mock{},
 		&subs.Subject[string]{},
 		&mock.DNSGetter{Names: []string{"1.1.1.1"}},
@@ -287,6 +307,22 @@ func TestRpcReconnect(t *testing.T) {
 		&mockAnalytics{},
 		service.NoopFileshare{},
 		&RegistryMock{},
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 	err := rpc.Connect(&pb.ConnectRequest{}, &mockRPCServer{})
 	assert.NoError(t, err)
@@ -299,3 +335,22 @@ func TestRpcReconnect(t *testing.T) {
 	err = rpc.Connect(&pb.ConnectRequest{}, &mockRPCServer{})
 	assert.NoError(t, err)
 }
+
+func TestCheckUplink(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name   string
+		uplink string
+		hasErr bool
+	}{
+		{name: "loopback is up", uplink: "lo"},
+		{name: "interface does not exist", uplink: "nonexistent0", hasErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkUplink(test.uplink)
+			assert.Equal(t, test.hasErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package daemon
+
+import (
+	"context"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// ConnectionHistory returns the recorded connections, the oldest first. The
+// transfer of the active connection is taken from the current status.
+func (r *RPC) ConnectionHistory(ctx context.Context, in *pb.ConnectionHistoryRequest) (*pb.ConnectionHistoryResponse, error) {
+	if r.history == nil {
+		return &pb.ConnectionHistoryResponse{Type: internal.CodeSuccess}, nil
+	}
+
+	var since time.Time
+	if in.GetSince() > 0 {
+		since = time.Unix(in.GetSince(), 0)
+	}
+
+	entries := r.history.Since(since)
+	resp := &pb.ConnectionHistoryResponse{
+		Type:    internal.CodeSuccess,
+		Entries: make([]*pb.ConnectionHistoryEntry, 0, len(entries)),
+	}
+	for i, entry := range entries {
+		if entry.IsActive() {
+			if i == len(entries)-1 && r.netw.IsVPNActive() {
+				status, _ := r.netw.ConnectionStatus()
+				entry.Download, entry.Upload = status.Download, status.Upload
+			} else {
+				// the daemon stopped or the VPN went down without being recorded
+				entry.DisconnectReason = disconnectReasonInterrupted
+			}
+		}
+		resp.Entries = append(resp.Entries, historyEntryToPb(entry))
+	}
+	return resp, nil
+}
+
+func historyEntryToPb(entry HistoryEntry) *pb.ConnectionHistoryEntry {
+	var disconnectedAt int64
+	if !entry.DisconnectedAt.IsZero() {
+		disconnectedAt = entry.DisconnectedAt.Unix()
+	}
+	return &pb.ConnectionHistoryEntry{
+		Hostname:         entry.Hostname,
+		Country:          entry.Country,
+		City:             entry.City,
+		Technology:       entry.Technology,
+		Protocol:         entry.Protocol,
+		ConnectedAt:      entry.ConnectedAt.Unix(),
+		DisconnectedAt:   disconnectedAt,
+		Download:         entry.Download,
+		Upload:           entry.Upload,
+		DisconnectReason: entry.DisconnectReason,
+	}
+}
@@ -0,0 +1,116 @@
+package daemon
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/events/subs"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	mocknetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRPCConnectionHistory(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	start := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		vpnActive        bool
+		since            int64
+		expectedCount    int
+		expectedReason   string
+		expectedDownload uint64
+	}{
+		{
+			name:             "active connection",
+			vpnActive:        true,
+			expectedCount:    2,
+			expectedDownload: 2048,
+		},
+		{
+			name:           "connection went down unnoticed",
+			expectedCount:  2,
+			expectedReason: disconnectReasonInterrupted,
+		},
+		{
+			name:             "since",
+			vpnActive:        true,
+			since:            start.Add(2 * time.Hour).Unix(),
+			expectedCount:    1,
+			expectedDownload: 2048,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			history := NewConnectionHistory(filepath.Join(t.TempDir(), "history.dat"))
+			require.NoError(t, history.Add(HistoryEntry{
+				Hostname:    "de1.nordvpn.com",
+				Technology:  config.Technology_NORDLYNX,
+				ConnectedAt: start,
+			}))
+			require.NoError(t, history.Finish(start.Add(time.Hour), disconnectReasonUser, 100, 10))
+			require.NoError(t, history.Add(HistoryEntry{
+				Hostname:    "lt1.nordvpn.com",
+				Technology:  config.Technology_OPENVPN,
+				Protocol:    config.Protocol_TCP,
+				ConnectedAt: start.Add(3 * time.Hour),
+			}))
+
+			rpc := RPC{
+				netw: &mocknetworker.Mock{
+					VpnActive:  test.vpnActive,
+					ConnStatus: networker.ConnectionStatus{Download: 2048, Upload: 1024},
+				},
+				history: history,
+			}
+			resp, err := rpc.ConnectionHistory(context.Background(), &pb.ConnectionHistoryRequest{Since: test.since})
+			assert.NoError(t, err)
+			assert.Equal(t, internal.CodeSuccess, resp.Type)
+			require.Len(t, resp.Entries, test.expectedCount)
+
+			last := resp.Entries[len(resp.Entries)-1]
+			assert.Equal(t, "lt1.nordvpn.com", last.Hostname)
+			assert.Equal(t, config.Protocol_TCP, last.Protocol)
+			assert.Equal(t, start.Add(3*time.Hour).Unix(), last.ConnectedAt)
+			assert.Equal(t, int64(0), last.DisconnectedAt)
+			assert.Equal(t, test.expectedReason, last.DisconnectReason)
+			assert.Equal(t, test.expectedDownload, last.Download)
+		})
+	}
+}
+
+func TestRPCDisconnect_RecordsHistory(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	history := NewConnectionHistory(filepath.Join(t.TempDir(), "history.dat"))
+	require.NoError(t, history.Add(HistoryEntry{Hostname: "de1.nordvpn.com", ConnectedAt: time.Now()}))
+
+	rpc := RPC{
+		cm:     newMockConfigManager(),
+		events: &Events{Service: &ServiceEvents{Disconnect: &subs.Subject[events.DataDisconnect]{}}},
+		netw: &mocknetworker.Mock{
+			VpnActive:  true,
+			ConnStatus: networker.ConnectionStatus{Download: 2048, Upload: 1024},
+		},
+		history: history,
+	}
+	require.NoError(t, rpc.disconnect(disconnectReasonIdle))
+
+	entries := history.Since(time.Time{})
+	require.Len(t, entries, 1)
+	assert.False(t, entries[0].IsActive())
+	assert.Equal(t, disconnectReasonIdle, entries[0].DisconnectReason)
+	assert.Equal(t, uint64(2048), entries[0].Download)
+	assert.Equal(t, uint64(1024), entries[0].Upload)
+}
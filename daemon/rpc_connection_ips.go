@@ -0,0 +1,37 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// ConnectionIPs returns the address through which the traffic enters the VPN and the
+// address it exits to the internet from. Exit address is looked up through the tunnel.
+func (r *RPC) ConnectionIPs(ctx context.Context, in *pb.Empty) (*pb.ConnectionIPsResponse, error) {
+	if !r.netw.IsVPNActive() {
+		return &pb.ConnectionIPsResponse{Type: internal.CodeVPNNotRunning}, nil
+	}
+
+	status, err := r.netw.ConnectionStatus()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "retrieving connection status:", err)
+		return &pb.ConnectionIPsResponse{Type: internal.CodeVPNNotRunning}, nil
+	}
+
+	resp := &pb.ConnectionIPsResponse{Type: internal.CodeSuccess}
+	if status.IP.IsValid() {
+		resp.EntryIp = status.IP.String()
+	}
+
+	insights, err := r.api.Insights()
+	if err != nil || insights == nil {
+		log.Println(internal.WarningPrefix, "looking up exit ip:", err)
+		return resp, nil
+	}
+	resp.ExitIp = insights.IP
+	resp.ExitCountryCode = insights.CountryCode
+	return resp, nil
+}
@@ -0,0 +1,77 @@
+package daemon
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	mocknetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockInsightsAPI struct {
+	core.CombinedAPI
+	insights *core.Insights
+	err      error
+}
+
+func (m mockInsightsAPI) Insights() (*core.Insights, error) { return m.insights, m.err }
+
+func TestConnectionIPs(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		active   bool
+		api      mockInsightsAPI
+		expected *pb.ConnectionIPsResponse
+	}{
+		{
+			name:   "entry and exit",
+			active: true,
+			api:    mockInsightsAPI{insights: &core.Insights{IP: "198.51.100.7", CountryCode: "DE"}},
+			expected: &pb.ConnectionIPsResponse{
+				Type:            internal.CodeSuccess,
+				EntryIp:         "192.0.2.10",
+				ExitIp:          "198.51.100.7",
+				ExitCountryCode: "DE",
+			},
+		},
+		{
+			name:   "exit lookup fails",
+			active: true,
+			api:    mockInsightsAPI{err: mock.ErrOnPurpose},
+			expected: &pb.ConnectionIPsResponse{
+				Type:    internal.CodeSuccess,
+				EntryIp: "192.0.2.10",
+			},
+		},
+		{
+			name:     "not connected",
+			expected: &pb.ConnectionIPsResponse{Type: internal.CodeVPNNotRunning},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			netw := &mocknetworker.Mock{
+				VpnActive:  test.active,
+				ConnStatus: networker.ConnectionStatus{IP: netip.MustParseAddr("192.0.2.10")},
+			}
+			rpc := RPC{netw: netw, api: test.api}
+
+			resp, err := rpc.ConnectionIPs(context.Background(), &pb.Empty{})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected.Type, resp.Type)
+			assert.Equal(t, test.expected.EntryIp, resp.EntryIp)
+			assert.Equal(t, test.expected.ExitIp, resp.ExitIp)
+			assert.Equal(t, test.expected.ExitCountryCode, resp.ExitCountryCode)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// CredentialsStatus re-provisions the service credentials if they were rotated
+// and reports when they and the login expire.
+func (r *RPC) CredentialsStatus(ctx context.Context, _ *pb.Empty) (*pb.CredentialsStatusResponse, error) {
+	if !r.ac.IsLoggedIn() {
+		return nil, internal.ErrNotLoggedIn
+	}
+
+	resp := &pb.CredentialsStatusResponse{Type: internal.CodeSuccess}
+	rotated, err := r.credentials.check()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "checking credentials:", err)
+		if errors.Is(err, core.ErrUnauthorized) {
+			resp.Type = internal.CodeTokenInvalid
+		} else {
+			resp.Type = internal.CodeGatewayError
+		}
+	}
+	resp.Rotated = rotated
+
+	vpnExpired, err := r.ac.IsVPNExpired()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "checking VPN expiration:", err)
+	} else if vpnExpired && resp.Type == internal.CodeSuccess {
+		resp.Type = internal.CodeNoVPNService
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.CredentialsStatusResponse{Type: internal.CodeConfigError}, nil
+	}
+
+	tokenData := cfg.TokensData[cfg.AutoConnectData.ID]
+	// token logins have no renew token, their expiry is not known
+	resp.TokenLogin = tokenData.RenewToken == ""
+	if !resp.TokenLogin {
+		resp.TokenExpiresAt = tokenData.TokenExpiry
+	}
+	resp.ServiceExpiresAt = tokenData.ServiceExpiry
+	resp.UpdatedAt = tokenData.CredentialsUpdatedAt
+	resp.CheckedAt = tokenData.CredentialsCheckedAt
+	return resp, nil
+}
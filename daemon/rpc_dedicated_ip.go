@@ -0,0 +1,83 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// DedicatedIP returns the Dedicated IP services of the user with the servers assigned to them
+func (r *RPC) DedicatedIP(ctx context.Context, _ *pb.Empty) (*pb.DedicatedIPResponse, error) {
+	if !r.ac.IsLoggedIn() {
+		return nil, internal.ErrNotLoggedIn
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.DedicatedIPResponse{Type: internal.CodeConfigError}, nil
+	}
+
+	services, err := r.credentialsAPI.Services(cfg.TokensData[cfg.AutoConnectData.ID].Token)
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "retrieving services:", err)
+		if errors.Is(err, core.ErrUnauthorized) {
+			return &pb.DedicatedIPResponse{Type: internal.CodeTokenInvalid}, nil
+		}
+		return &pb.DedicatedIPResponse{Type: internal.CodeGatewayError}, nil
+	}
+
+	resp := &pb.DedicatedIPResponse{Type: internal.CodeSuccess}
+	servers := r.dm.GetServersData().Servers
+	for _, service := range services {
+		if service.Service.ID != core.DedicatedIPServiceID {
+			continue
+		}
+		dedicatedIP := &pb.DedicatedIPService{ExpiresAt: service.ExpiresAt}
+		for _, assigned := range service.Details.Servers {
+			server, err := r.dedicatedIPServer(servers, assigned.ID)
+			if err != nil {
+				log.Println(internal.WarningPrefix, "retrieving dedicated IP server", assigned.ID, err)
+				continue
+			}
+			dedicatedIP.Servers = append(dedicatedIP.Servers, dedicatedIPServerToPb(server))
+		}
+		resp.Services = append(resp.Services, dedicatedIP)
+	}
+	if len(resp.Services) == 0 {
+		resp.Type = internal.CodeNoDedicatedIPService
+	}
+	return resp, nil
+}
+
+// dedicatedIPServer returns the server from the local list if it is there,
+// otherwise it is requested from the API
+func (r *RPC) dedicatedIPServer(servers core.Servers, id int64) (core.Server, error) {
+	for _, server := range servers {
+		if server.ID == id {
+			return server, nil
+		}
+	}
+	server, err := r.api.Server(id)
+	if err != nil {
+		return core.Server{}, err
+	}
+	return *server, nil
+}
+
+func dedicatedIPServerToPb(server core.Server) *pb.DedicatedIPServer {
+	ret := &pb.DedicatedIPServer{
+		Id:       server.ID,
+		Hostname: server.Hostname,
+	}
+	if len(server.Locations) > 0 {
+		ret.Country = server.Locations[0].Country.Name
+		ret.City = server.Locations[0].Country.City.Name
+	}
+	return ret
+}
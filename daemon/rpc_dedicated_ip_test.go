@@ -0,0 +1,132 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type servicesCredentialsAPI struct {
+	validCredentialsAPI
+	services core.ServicesResponse
+	err      error
+}
+
+func (s servicesCredentialsAPI) Services(string) (core.ServicesResponse, error) {
+	return s.services, s.err
+}
+
+type serverAPI struct {
+	core.CombinedAPI
+	servers core.Servers
+}
+
+func (s serverAPI) Server(id int64) (*core.Server, error) {
+	for _, server := range s.servers {
+		if server.ID == id {
+			return &server, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func dedicatedIPTestServer(id int64, hostname string, country string, city string) core.Server {
+	return core.Server{
+		ID:       id,
+		Hostname: hostname,
+		Locations: core.Locations{
+			{Country: core.Country{Name: country, City: core.City{Name: city}}},
+		},
+	}
+}
+
+func TestRPCDedicatedIP(t *testing.T) {
+	category.Set(t, category.Unit)
+	defer testsCleanup()
+
+	dm := testNewDataManager()
+	require.NoError(t, dm.SetServersData(time.Now(), core.Servers{
+		dedicatedIPTestServer(1, "de1.nordvpn.com", "Germany", "Berlin"),
+	}, ""))
+	api := serverAPI{servers: core.Servers{
+		dedicatedIPTestServer(2, "us2.nordvpn.com", "United States", "New York"),
+	}}
+
+	tests := []struct {
+		name         string
+		services     core.ServicesResponse
+		err          error
+		expectedCode int64
+		expected     []*pb.DedicatedIPService
+	}{
+		{
+			name: "servers assigned",
+			services: core.ServicesResponse{
+				{ExpiresAt: "2029-12-27 00:00:00", Service: core.Service{ID: core.VPNServiceID}},
+				{
+					ExpiresAt: "2025-01-01 00:00:00",
+					Service:   core.Service{ID: core.DedicatedIPServiceID},
+					Details: core.ServiceDetails{Servers: []core.ServiceServer{
+						{ID: 1}, {ID: 2}, {ID: 3},
+					}},
+				},
+			},
+			expectedCode: internal.CodeSuccess,
+			expected: []*pb.DedicatedIPService{{
+				ExpiresAt: "2025-01-01 00:00:00",
+				Servers: []*pb.DedicatedIPServer{
+					{Id: 1, Hostname: "de1.nordvpn.com", Country: "Germany", City: "Berlin"},
+					{Id: 2, Hostname: "us2.nordvpn.com", Country: "United States", City: "New York"},
+				},
+			}},
+		},
+		{
+			name: "no dedicated IP",
+			services: core.ServicesResponse{
+				{ExpiresAt: "2029-12-27 00:00:00", Service: core.Service{ID: core.VPNServiceID}},
+			},
+			expectedCode: internal.CodeNoDedicatedIPService,
+		},
+		{
+			name:         "token rejected",
+			err:          core.ErrUnauthorized,
+			expectedCode: internal.CodeTokenInvalid,
+		},
+		{
+			name:         "API failure",
+			err:          core.ErrServerInternal,
+			expectedCode: internal.CodeGatewayError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rpc := RPC{
+				ac:             &workingLoginChecker{},
+				cm:             newMockConfigManager(),
+				dm:             dm,
+				api:            api,
+				credentialsAPI: servicesCredentialsAPI{services: test.services, err: test.err},
+			}
+			resp, err := rpc.DedicatedIP(context.Background(), &pb.Empty{})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, len(test.expected), len(resp.Services))
+			for i, service := range test.expected {
+				assert.Equal(t, service.ExpiresAt, resp.Services[i].ExpiresAt)
+				assert.Equal(t, len(service.Servers), len(resp.Services[i].Servers))
+				for j, server := range service.Servers {
+					assert.Equal(t, server.String(), resp.Services[i].Servers[j].String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/diagnostics"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// LeakTester verifies the leak protection of the current connection
+type LeakTester interface {
+	Run(context.Context, diagnostics.State) []diagnostics.Result
+	VerifyRouting(diagnostics.State) error
+}
+
+// Diagnose runs the leak tests against the current connection and kill switch state
+func (r *RPC) Diagnose(ctx context.Context, in *pb.Empty) (*pb.DiagnoseResponse, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.DiagnoseResponse{Type: internal.CodeConfigError}, nil
+	}
+
+	state := diagnostics.State{
+		Connected:  r.netw.IsVPNActive(),
+		KillSwitch: cfg.KillSwitch,
+	}
+	if state.Connected {
+		status, err := r.netw.ConnectionStatus()
+		if err != nil {
+			log.Println(internal.WarningPrefix, "getting connection status for diagnostics:", err)
+		}
+		state.Tunnel = status.Interface
+		state.Nameservers = cfg.AutoConnectData.DNS.Or(
+			r.nameservers.Get(cfg.AutoConnectData.ThreatProtectionLite, r.lastServer.SupportsIPv6()),
+		)
+	}
+
+	resp := &pb.DiagnoseResponse{Type: internal.CodeSuccess}
+	for _, result := range r.leakTest.Run(ctx, state) {
+		resp.Results = append(resp.Results, &pb.DiagnosticResult{
+			Name:    result.Name,
+			Status:  diagnosticStatusToPb(result.Status),
+			Details: result.Details,
+			Hint:    result.Hint,
+		})
+	}
+	for _, result := range resp.Results {
+		if result.Status == pb.DiagnosticStatus_FAILED {
+			log.Println(internal.WarningPrefix, "diagnostics", result.Name+":", result.Details)
+		}
+	}
+	return resp, nil
+}
+
+func diagnosticStatusToPb(status diagnostics.Status) pb.DiagnosticStatus {
+	switch status {
+	case diagnostics.Passed:
+		return pb.DiagnosticStatus_PASSED
+	case diagnostics.Failed:
+		return pb.DiagnosticStatus_FAILED
+	case diagnostics.Skipped:
+		return pb.DiagnosticStatus_SKIPPED
+	}
+	return pb.DiagnosticStatus_SKIPPED
+}
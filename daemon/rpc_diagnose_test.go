@@ -0,0 +1,65 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/diagnostics"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	mocknetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingLeakTester struct {
+	state      diagnostics.State
+	routingErr error
+}
+
+func (r *recordingLeakTester) Run(_ context.Context, state diagnostics.State) []diagnostics.Result {
+	r.state = state
+	return []diagnostics.Result{
+		{Name: "DNS leak protection", Status: diagnostics.Passed, Details: "blocked"},
+		{Name: "Kill Switch integrity", Status: diagnostics.Failed, Details: "leaked", Hint: "toggle"},
+	}
+}
+
+func (r *recordingLeakTester) VerifyRouting(state diagnostics.State) error {
+	r.state = state
+	return r.routingErr
+}
+
+func TestDiagnose(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.KillSwitch = true
+	cm.Cfg.AutoConnectData.DNS = []string{"1.2.3.4"}
+	leakTester := &recordingLeakTester{}
+	rpc := RPC{
+		cm: cm,
+		netw: &mocknetworker.Mock{
+			VpnActive:  true,
+			ConnStatus: networker.ConnectionStatus{Interface: "nordlynx"},
+		},
+		nameservers: &mock.DNSGetter{},
+		leakTest:    leakTester,
+	}
+
+	resp, err := rpc.Diagnose(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.Equal(t, diagnostics.State{
+		Connected:   true,
+		KillSwitch:  true,
+		Tunnel:      "nordlynx",
+		Nameservers: []string{"1.2.3.4"},
+	}, leakTester.state)
+	assert.Len(t, resp.Results, 2)
+	assert.Equal(t, pb.DiagnosticStatus_PASSED, resp.Results[0].Status)
+	assert.Equal(t, pb.DiagnosticStatus_FAILED, resp.Results[1].Status)
+	assert.Equal(t, "toggle", resp.Results[1].Hint)
+}
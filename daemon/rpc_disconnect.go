@@ -4,6 +4,7 @@ import (
 	"log"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/hooks"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/events"
 	"github.com/NordSecurity/nordvpn-linux/internal"
@@ -11,16 +12,38 @@ import (
 
 func (r *RPC) Disconnect(_ *pb.Empty, srv pb.Daemon_DisconnectServer) error {
 	if !r.netw.IsVPNActive() {
+		if r.pause != nil && r.pause.cancel() {
+			return srv.Send(&pb.Payload{
+				Type: internal.CodeDisconnected,
+			})
+		}
+		if r.recovery != nil && r.recovery.cancel() {
+			return srv.Send(&pb.Payload{
+				Type: internal.CodeDisconnected,
+			})
+		}
 		return srv.Send(&pb.Payload{
 			Type: internal.CodeVPNNotRunning,
 		})
 	}
 
-	if err := r.netw.Stop(); err != nil {
+	if err := r.disconnect(disconnectReasonUser); err != nil {
 		log.Println(internal.ErrorPrefix, err)
 		return internal.ErrUnhandled
 	}
 
+	return srv.Send(&pb.Payload{
+		Type: internal.CodeDisconnected,
+	})
+}
+
+// disconnect stops the VPN connection and notifies about it. The reason is
+// recorded in the connection history.
+func (r *RPC) disconnect(reason string) error {
+	if err := r.stopConnection(reason); err != nil {
+		return err
+	}
+
 	var cfg config.Config
 	if err := r.cm.Load(&cfg); err != nil {
 		log.Println(internal.ErrorPrefix, err)
@@ -32,12 +55,35 @@ func (r *RPC) Disconnect(_ *pb.Empty, srv pb.Daemon_DisconnectServer) error {
 		Technology:           cfg.Technology,
 		ThreatProtectionLite: cfg.AutoConnectData.ThreatProtectionLite,
 	})
-	if err := Notify(r.cm, internal.NotificationDisconnected, []string{}); err != nil {
-		log.Println(internal.ErrorPrefix, err)
-		return internal.ErrUnhandled
-	}
+	return Notify(r.cm, internal.NotificationDisconnected, []string{})
+}
 
-	return srv.Send(&pb.Payload{
-		Type: internal.CodeDisconnected,
-	})
+// stopConnection stops the VPN connection on purpose and records it in the
+// connection history with the reason. Disconnect hooks are run around the stop
+// if VPN was connected.
+func (r *RPC) stopConnection(reason string) error {
+	active := r.netw.IsVPNActive()
+	status, _ := r.netw.ConnectionStatus()
+	env := hookEnv(status)
+	// hooks can take long, so they are run before the recovery is held
+	if active {
+		r.runHooks(hooks.PreDisconnect, env)
+	}
+	if r.recovery != nil {
+		// the disconnect on purpose is not treated as a drop
+		r.recovery.hold()
+	}
+	err := r.netw.Stop()
+	if r.recovery != nil {
+		r.recovery.release(err == nil)
+	}
+	if err != nil {
+		return err
+	}
+	r.recordDisconnect(status, reason)
+	r.stopSessionLog()
+	if active {
+		r.runHooks(hooks.PostDisconnect, env)
+	}
+	return nil
 }
@@ -0,0 +1,24 @@
+package daemon
+
+import (
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+)
+
+// SubscribeEvents sends the events as they happen until the client cancels
+func (r *RPC) SubscribeEvents(in *pb.Empty, srv pb.Daemon_SubscribeEventsServer) error {
+	eventsCh, unsubscribe := r.eventStream.subscribe()
+	defer unsubscribe()
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case event, ok := <-eventsCh:
+			if !ok {
+				return ErrEventSubscriberTooSlow
+			}
+			if err := srv.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
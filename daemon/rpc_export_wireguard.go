@@ -0,0 +1,95 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"net/netip"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/nordlynx"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/slices"
+)
+
+// redactedPrivateKey replaces the private key unless it is explicitly requested
+const redactedPrivateKey = "<redacted>"
+
+// ExportWireGuardConfig returns wg-quick config for the current NordLynx
+// connection or for the given server
+func (r *RPC) ExportWireGuardConfig(
+	ctx context.Context,
+	in *pb.ExportWireGuardConfigRequest,
+) (*pb.ExportWireGuardConfigResponse, error) {
+	if !r.ac.IsLoggedIn() {
+		return nil, internal.ErrNotLoggedIn
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.ExportWireGuardConfigResponse{Type: internal.CodeConfigError}, nil
+	}
+
+	var server core.Server
+	var serverIP netip.Addr
+	if in.GetServerTag() == "" {
+		if !r.netw.IsVPNActive() {
+			return &pb.ExportWireGuardConfigResponse{Type: internal.CodeVPNNotRunning}, nil
+		}
+		status, err := r.netw.ConnectionStatus()
+		if err != nil {
+			log.Println(internal.ErrorPrefix, "retrieving connection status:", err)
+			return &pb.ExportWireGuardConfigResponse{Type: internal.CodeVPNNotRunning}, nil
+		}
+		if status.Technology != config.Technology_NORDLYNX {
+			return &pb.ExportWireGuardConfigResponse{Type: internal.CodeDependencyError}, nil
+		}
+		if !slices.Contains(r.lastServer.IPs(), status.IP) {
+			// connected to the meshnet peer
+			return &pb.ExportWireGuardConfigResponse{Type: internal.CodeVPNNotRunning}, nil
+		}
+		server, serverIP = r.lastServer, status.IP
+	} else {
+		idx := slices.IndexFunc(r.dm.GetServersData().Servers, func(s core.Server) bool {
+			return strings.EqualFold(in.GetServerTag(), strings.Split(s.Hostname, ".")[0])
+		})
+		if idx == -1 {
+			return &pb.ExportWireGuardConfigResponse{Type: internal.CodeTagNonexisting}, nil
+		}
+		server = r.dm.GetServersData().Servers[idx]
+		ip, err := server.IPv4()
+		if err != nil {
+			log.Println(internal.ErrorPrefix, err)
+			return &pb.ExportWireGuardConfigResponse{Type: internal.CodeFailure}, nil
+		}
+		serverIP = ip
+	}
+
+	if server.NordLynxPublicKey == "" {
+		return &pb.ExportWireGuardConfigResponse{Type: internal.CodeServerUnavailable}, nil
+	}
+
+	privateKey := redactedPrivateKey
+	if in.GetIncludePrivateKey() {
+		privateKey = cfg.TokensData[cfg.AutoConnectData.ID].NordLynxPrivateKey
+		if privateKey == "" {
+			log.Println(internal.ErrorPrefix, "NordLynx private key is missing")
+			return &pb.ExportWireGuardConfigResponse{Type: internal.CodeFailure}, nil
+		}
+		log.Println(internal.WarningPrefix, "exporting NordLynx private key for", server.Hostname)
+	}
+
+	// wg-quick supports plain nameservers only
+	nameservers := config.DNS(dns.PlainOnly(cfg.AutoConnectData.DNS)).Or(
+		r.nameservers.Get(cfg.AutoConnectData.ThreatProtectionLite, serverIP.Is6()),
+	)
+	return &pb.ExportWireGuardConfigResponse{
+		Type:     internal.CodeSuccess,
+		Hostname: server.Hostname,
+		Config:   nordlynx.ExternalConfig(privateKey, server.NordLynxPublicKey, serverIP, nameservers),
+	}, nil
+}
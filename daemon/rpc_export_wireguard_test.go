@@ -0,0 +1,100 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportWireGuardConfig(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	dm := &DataManager{serversData: ServersData{Servers: core.Servers{
+		{Hostname: "lt10.nordvpn.com", Station: "192.0.2.10", NordLynxPublicKey: "server-public-key"},
+		{Hostname: "lt11.nordvpn.com", Station: "192.0.2.11"},
+	}}}
+
+	tests := []struct {
+		name              string
+		request           *pb.ExportWireGuardConfigRequest
+		privateKey        string
+		expectedCode      int64
+		expectedContained []string
+		expectedMissing   []string
+	}{
+		{
+			name:         "private key is redacted by default",
+			request:      &pb.ExportWireGuardConfigRequest{ServerTag: "LT10"},
+			privateKey:   "my-private-key",
+			expectedCode: internal.CodeSuccess,
+			expectedContained: []string{
+				"PrivateKey = <redacted>",
+				"PublicKey = server-public-key",
+				"Endpoint = 192.0.2.10:51820",
+			},
+			expectedMissing: []string{"my-private-key"},
+		},
+		{
+			name:              "private key is included",
+			request:           &pb.ExportWireGuardConfigRequest{ServerTag: "lt10", IncludePrivateKey: true},
+			privateKey:        "my-private-key",
+			expectedCode:      internal.CodeSuccess,
+			expectedContained: []string{"PrivateKey = my-private-key"},
+		},
+		{
+			name:         "private key is missing",
+			request:      &pb.ExportWireGuardConfigRequest{ServerTag: "lt10", IncludePrivateKey: true},
+			expectedCode: internal.CodeFailure,
+		},
+		{
+			name:         "unknown server",
+			request:      &pb.ExportWireGuardConfigRequest{ServerTag: "lt99"},
+			expectedCode: internal.CodeTagNonexisting,
+		},
+		{
+			name:         "server without nordlynx",
+			request:      &pb.ExportWireGuardConfigRequest{ServerTag: "lt11"},
+			expectedCode: internal.CodeServerUnavailable,
+		},
+		{
+			name:         "not connected",
+			request:      &pb.ExportWireGuardConfigRequest{},
+			expectedCode: internal.CodeVPNNotRunning,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.TokensData = map[int64]config.TokenData{
+				cm.Cfg.AutoConnectData.ID: {NordLynxPrivateKey: test.privateKey},
+			}
+
+			rpc := RPC{
+				ac:          &workingLoginChecker{},
+				cm:          cm,
+				dm:          dm,
+				netw:        &networker.Mock{},
+				nameservers: &mock.DNSGetter{},
+			}
+			resp, err := rpc.ExportWireGuardConfig(context.Background(), test.request)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			for _, expected := range test.expectedContained {
+				assert.Contains(t, resp.Config, expected)
+			}
+			for _, missing := range test.expectedMissing {
+				assert.NotContains(t, resp.Config, missing)
+			}
+		})
+	}
+}
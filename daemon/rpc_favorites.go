@@ -0,0 +1,96 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// ImportFavorites validates the favorites against the server catalog and adds
+// the known ones to the configuration. Favorites with existing names are replaced.
+func (r *RPC) ImportFavorites(ctx context.Context, in *pb.ImportFavoritesRequest) (*pb.ImportFavoritesResponse, error) {
+	var imported config.Favorites
+	var unknown []string
+	for _, f := range in.GetFavorites() {
+		favorite := config.Favorite{
+			Name:        strings.TrimSpace(f.GetName()),
+			ServerTag:   strings.ToLower(strings.TrimSpace(f.GetServerTag())),
+			ServerGroup: strings.TrimSpace(f.GetServerGroup()),
+		}
+		if favorite.Name == "" || (favorite.ServerTag == "" && favorite.ServerGroup == "") {
+			return &pb.ImportFavoritesResponse{Type: internal.CodeFormatError}, nil
+		}
+		if !r.isKnownFavorite(favorite) {
+			unknown = append(unknown, favorite.Name)
+			continue
+		}
+		imported = append(imported, favorite)
+	}
+
+	if len(imported) == 0 {
+		return &pb.ImportFavoritesResponse{Type: internal.CodeNothingToDo, Unknown: unknown}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.Favorites = c.Favorites.Merge(imported)
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.ImportFavoritesResponse{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.ImportFavoritesResponse{
+		Type:     internal.CodeSuccess,
+		Imported: uint32(len(imported)),
+		Unknown:  unknown,
+	}, nil
+}
+
+// Favorites returns the stored favorites
+func (r *RPC) Favorites(ctx context.Context, in *pb.Empty) (*pb.FavoritesResponse, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.FavoritesResponse{Type: internal.CodeConfigError}, nil
+	}
+
+	favorites := make([]*pb.Favorite, 0, len(cfg.Favorites))
+	for _, favorite := range cfg.Favorites {
+		favorites = append(favorites, &pb.Favorite{
+			Name:        favorite.Name,
+			ServerTag:   favorite.ServerTag,
+			ServerGroup: favorite.ServerGroup,
+		})
+	}
+	return &pb.FavoritesResponse{Type: internal.CodeSuccess, Favorites: favorites}, nil
+}
+
+// isKnownFavorite checks whether the server tag and group of the favorite can be
+// resolved using the server catalog
+func (r *RPC) isKnownFavorite(favorite config.Favorite) bool {
+	group, err := resolveServerGroup(favorite.ServerGroup, favorite.ServerTag)
+	if err != nil {
+		log.Println(internal.WarningPrefix, "favorite", favorite.Name, err)
+		return false
+	}
+	if favorite.ServerTag == "" {
+		return true
+	}
+
+	if _, err := serverTagFromString(
+		r.dm.GetCountryData().Countries,
+		r.serversAPI,
+		favorite.ServerTag,
+		group,
+		r.dm.GetServersData().Servers,
+		favorite.ServerGroup != "",
+	); err != nil {
+		log.Println(internal.WarningPrefix, "favorite", favorite.Name, err)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportFavorites(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	dm := &DataManager{
+		countryData: CountryData{Countries: core.Countries{
+			{Name: "Lithuania", Code: "LT", Cities: core.Cities{{Name: "Vilnius"}}},
+		}},
+		serversData: ServersData{Servers: core.Servers{
+			{ID: 1, Hostname: "lt10.nordvpn.com"},
+		}},
+	}
+
+	tests := []struct {
+		name             string
+		current          config.Favorites
+		favorites        []*pb.Favorite
+		saveErr          error
+		expected         config.Favorites
+		expectedCode     int64
+		expectedImported uint32
+		expectedUnknown  []string
+	}{
+		{
+			name: "all known",
+			favorites: []*pb.Favorite{
+				{Name: "office", ServerTag: "LT"},
+				{Name: "capital", ServerTag: "lithuania vilnius"},
+				{Name: "server", ServerTag: "lt10"},
+				{Name: "p2p", ServerGroup: "p2p"},
+				{Name: "p2p-lt", ServerTag: "lt", ServerGroup: "p2p"},
+			},
+			expected: config.Favorites{
+				{Name: "office", ServerTag: "lt"},
+				{Name: "capital", ServerTag: "lithuania vilnius"},
+				{Name: "server", ServerTag: "lt10"},
+				{Name: "p2p", ServerGroup: "p2p"},
+				{Name: "p2p-lt", ServerTag: "lt", ServerGroup: "p2p"},
+			},
+			expectedCode:     internal.CodeSuccess,
+			expectedImported: 5,
+		},
+		{
+			name:    "unknown are skipped and existing are replaced",
+			current: config.Favorites{{Name: "office", ServerTag: "lv"}, {Name: "home", ServerTag: "lt"}},
+			favorites: []*pb.Favorite{
+				{Name: "office", ServerTag: "lt"},
+				{Name: "mars", ServerTag: "mars"},
+				{Name: "bogus", ServerGroup: "bogus"},
+				{Name: "double", ServerTag: "p2p", ServerGroup: "p2p"},
+			},
+			expected:         config.Favorites{{Name: "office", ServerTag: "lt"}, {Name: "home", ServerTag: "lt"}},
+			expectedCode:     internal.CodeSuccess,
+			expectedImported: 1,
+			expectedUnknown:  []string{"mars", "bogus", "double"},
+		},
+		{
+			name:            "nothing known",
+			current:         config.Favorites{{Name: "home", ServerTag: "lt"}},
+			favorites:       []*pb.Favorite{{Name: "mars", ServerTag: "mars"}},
+			expected:        config.Favorites{{Name: "home", ServerTag: "lt"}},
+			expectedCode:    internal.CodeNothingToDo,
+			expectedUnknown: []string{"mars"},
+		},
+		{
+			name:         "missing name",
+			favorites:    []*pb.Favorite{{ServerTag: "lt"}},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "missing server",
+			favorites:    []*pb.Favorite{{Name: "office"}},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "config failure",
+			favorites:    []*pb.Favorite{{Name: "office", ServerTag: "lt"}},
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Favorites = test.current
+			cm.SaveErr = test.saveErr
+
+			rpc := RPC{cm: cm, dm: dm}
+			resp, err := rpc.ImportFavorites(context.Background(), &pb.ImportFavoritesRequest{
+				Favorites: test.favorites,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedImported, resp.Imported)
+			assert.Equal(t, test.expectedUnknown, resp.Unknown)
+			assert.Equal(t, test.expected, cm.Cfg.Favorites)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/diagnostics"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// ErrDaemonNotReady is reported while the self-test is running or if its
+// critical check has failed
+var ErrDaemonNotReady = errors.New("daemon is not ready")
+
+// Health reports the results of the startup self-test and whether the VPN can
+// be used by the workloads depending on it
+func (r *RPC) Health(ctx context.Context, in *pb.Empty) (*pb.HealthResponse, error) {
+	results, done := r.selfTest.Results()
+	connected, routed, err := r.vpnHealth()
+	resp := &pb.HealthResponse{
+		Type:      internal.CodeSuccess,
+		Done:      done,
+		Ready:     r.selfTest.Ready(),
+		Connected: connected,
+		Routed:    routed,
+		Healthy:   err == nil,
+	}
+	if err != nil {
+		resp.Reason = err.Error()
+	}
+	for _, result := range results {
+		check := &pb.SelfTestCheck{Name: result.Name, Critical: result.Critical}
+		if result.Err != nil {
+			check.Error = result.Err.Error()
+		}
+		resp.Checks = append(resp.Checks, check)
+	}
+	return resp, nil
+}
+
+// CheckHealth returns nil if the daemon is ready, connected to VPN and the
+// traffic is routed through the tunnel
+func (r *RPC) CheckHealth() error {
+	_, _, err := r.vpnHealth()
+	return err
+}
+
+func (r *RPC) vpnHealth() (connected bool, routed bool, err error) {
+	state := diagnostics.State{Connected: r.netw.IsVPNActive()}
+	if state.Connected {
+		status, err := r.netw.ConnectionStatus()
+		if err != nil {
+			log.Println(internal.WarningPrefix, "getting connection status for health check:", err)
+		}
+		state.Tunnel = status.Interface
+	}
+	routingErr := r.leakTest.VerifyRouting(state)
+	switch {
+	case !r.selfTest.Ready():
+		err = ErrDaemonNotReady
+	case routingErr != nil:
+		err = routingErr
+	}
+	return state.Connected, routingErr == nil, err
+}
@@ -0,0 +1,39 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// KillSwitchBlocked returns the summaries of the traffic recently dropped by the
+// kill switch
+func (r *RPC) KillSwitchBlocked(ctx context.Context, in *pb.Empty) (*pb.KillSwitchBlockedResponse, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.KillSwitchBlockedResponse{Type: internal.CodeConfigError}, nil
+	}
+
+	traffic := []*pb.BlockedTraffic{}
+	for _, summary := range r.blockedTraffic.Recent() {
+		traffic = append(traffic, &pb.BlockedTraffic{
+			Inbound:  summary.Direction == firewall.Inbound,
+			Protocol: summary.Protocol,
+			Address:  summary.Address.String(),
+			Port:     uint32(summary.Port),
+			Count:    summary.Count,
+			LastSeen: summary.LastSeen.Unix(),
+		})
+	}
+
+	return &pb.KillSwitchBlockedResponse{
+		Type:    internal.CodeSuccess,
+		Logging: cfg.KillSwitchLog,
+		Traffic: traffic,
+	}, nil
+}
@@ -0,0 +1,132 @@
+package daemon
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/blocked"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockBlockedTraffic []blocked.Summary
+
+func (m mockBlockedTraffic) Recent() []blocked.Summary { return m }
+
+func TestKillSwitchBlocked(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	lastSeen := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.KillSwitchLog = true
+	r := RPC{
+		cm: cm,
+		blockedTraffic: mockBlockedTraffic{
+			{
+				Direction: firewall.Outbound,
+				Protocol:  "UDP",
+				Address:   netip.MustParseAddr("1.1.1.1"),
+				Port:      53,
+				Count:     3,
+				LastSeen:  lastSeen,
+			},
+			{
+				Direction: firewall.Inbound,
+				Protocol:  "ICMP",
+				Address:   netip.MustParseAddr("192.168.1.7"),
+				Count:     1,
+				LastSeen:  lastSeen.Add(-time.Minute),
+			},
+		},
+	}
+
+	resp, err := r.KillSwitchBlocked(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.True(t, resp.Logging)
+	assert.Len(t, resp.Traffic, 2)
+	assert.Equal(t, "1.1.1.1", resp.Traffic[0].Address)
+	assert.Equal(t, uint32(53), resp.Traffic[0].Port)
+	assert.Equal(t, uint64(3), resp.Traffic[0].Count)
+	assert.Equal(t, lastSeen.Unix(), resp.Traffic[0].LastSeen)
+	assert.False(t, resp.Traffic[0].Inbound)
+	assert.True(t, resp.Traffic[1].Inbound)
+}
+
+func TestSetKillSwitchLog(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		firewall     bool
+		current      bool
+		enabled      bool
+		saveErr      error
+		expected     bool
+		expectedCode int64
+	}{
+		{
+			name:         "enable",
+			firewall:     true,
+			enabled:      true,
+			expected:     true,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "disable",
+			firewall:     true,
+			current:      true,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "disable without firewall",
+			current:      true,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already set",
+			firewall:     true,
+			current:      true,
+			enabled:      true,
+			expected:     true,
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "firewall is disabled",
+			enabled:      true,
+			expectedCode: internal.CodeDependencyError,
+		},
+		{
+			name:         "config failure",
+			firewall:     true,
+			enabled:      true,
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Firewall = test.firewall
+			cm.Cfg.KillSwitchLog = test.current
+			cm.SaveErr = test.saveErr
+			netw := &testnetworker.Mock{KillSwitchLog: test.current}
+
+			r := RPC{cm: cm, netw: netw}
+			resp, err := r.SetKillSwitchLog(context.Background(), &pb.SetGenericRequest{Enabled: test.enabled})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.KillSwitchLog)
+			assert.Equal(t, test.expected, netw.KillSwitchLog)
+		})
+	}
+}
@@ -89,12 +89,13 @@ func (r *RPC) loginCommon(customCB customCallbackType) (*pb.LoginResponse, error
 
 	if err := r.cm.SaveWith(func(c config.Config) config.Config {
 		c.TokensData[credentials.ID] = config.TokenData{
-			Token:              resp.Token,
-			RenewToken:         resp.RenewToken,
-			TokenExpiry:        resp.ExpiresAt,
-			NordLynxPrivateKey: credentials.NordlynxPrivateKey,
-			OpenVPNUsername:    credentials.Username,
-			OpenVPNPassword:    credentials.Password,
+			Token:                resp.Token,
+			RenewToken:           resp.RenewToken,
+			TokenExpiry:          resp.ExpiresAt,
+			NordLynxPrivateKey:   credentials.NordlynxPrivateKey,
+			OpenVPNUsername:      credentials.Username,
+			OpenVPNPassword:      credentials.Password,
+			CredentialsUpdatedAt: credentials.UpdatedAt,
 		}
 		c.AutoConnectData.ID = credentials.ID
 		return c
@@ -154,12 +155,13 @@ func (r *RPC) LoginOAuth2Callback(ctx context.Context, in *pb.String) (*pb.Empty
 
 	if err := r.cm.SaveWith(func(c config.Config) config.Config {
 		c.TokensData[credentials.ID] = config.TokenData{
-			Token:              resp.Token,
-			RenewToken:         resp.RenewToken,
-			TokenExpiry:        resp.ExpiresAt,
-			NordLynxPrivateKey: credentials.NordlynxPrivateKey,
-			OpenVPNUsername:    credentials.Username,
-			OpenVPNPassword:    credentials.Password,
+			Token:                resp.Token,
+			RenewToken:           resp.RenewToken,
+			TokenExpiry:          resp.ExpiresAt,
+			NordLynxPrivateKey:   credentials.NordlynxPrivateKey,
+			OpenVPNUsername:      credentials.Username,
+			OpenVPNPassword:      credentials.Password,
+			CredentialsUpdatedAt: credentials.UpdatedAt,
 		}
 		c.AutoConnectData.ID = credentials.ID
 		return c
@@ -29,7 +29,7 @@ func (r *RPC) Logout(ctx context.Context, in *pb.LogoutRequest) (*pb.Payload, er
 		log.Println(internal.ErrorPrefix, "disabling fileshare: ", err)
 	}
 
-	if err := r.netw.Stop(); err != nil {
+	if err := r.stopConnection(disconnectReasonLogout); err != nil {
 		log.Println(internal.ErrorPrefix, err)
 		return &pb.Payload{Type: internal.CodeFailure}, nil
 	}
@@ -95,6 +95,7 @@ func (r *RPC) Logout(ctx context.Context, in *pb.LogoutRequest) (*pb.Payload, er
 		log.Println(internal.WarningPrefix, err)
 	}
 	r.publisher.Publish("user logged out")
+	r.events.Service.Logout.Publish(nil)
 
 	// RenewToken being empty means user logged in using Access Token
 	if !in.GetPersistToken() && tokenData.RenewToken == "" {
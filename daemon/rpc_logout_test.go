@@ -37,6 +37,7 @@ func TestLogout_Token(t *testing.T) {
 		ncClient:  mockNC{},
 		publisher: &subs.Subject[string]{},
 		api:       mockApi{},
+		events:    &Events{Service: &ServiceEvents{Logout: &subs.Subject[any]{}}},
 	}
 
 	tests := []struct {
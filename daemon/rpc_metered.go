@@ -0,0 +1,108 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/slices"
+)
+
+// SetMetered sets the policy applied while the network connection is metered
+func (r *RPC) SetMetered(ctx context.Context, in *pb.SetMeteredRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	policy := cfg.Metered
+	policy.Enabled = in.GetEnabled()
+	policy.AllowRefresh = in.GetAllowRefresh()
+	policy.AllowAutoConnect = in.GetAllowAutoconnect()
+	if policy.Enabled == cfg.Metered.Enabled &&
+		policy.AllowRefresh == cfg.Metered.AllowRefresh &&
+		policy.AllowAutoConnect == cfg.Metered.AllowAutoConnect {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.Metered = policy
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+// SetMeteredNetwork marks the network connection as metered or removes the mark
+func (r *RPC) SetMeteredNetwork(ctx context.Context, in *pb.SetMeteredNetworkRequest) (*pb.Payload, error) {
+	network := strings.TrimSpace(in.GetNetwork())
+	if network == "" {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	if slices.Contains(cfg.Metered.Networks, network) == in.GetMetered() {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		networks := []string{}
+		for _, n := range c.Metered.Networks {
+			if n != network {
+				networks = append(networks, n)
+			}
+		}
+		if in.GetMetered() {
+			networks = append(networks, network)
+		}
+		c.Metered.Networks = networks
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+// MeteredStatus returns the metered state of the primary network connection
+func (r *RPC) MeteredStatus(ctx context.Context, in *pb.Empty) (*pb.MeteredStatusResponse, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.MeteredStatusResponse{Type: internal.CodeConfigError}, nil
+	}
+
+	status := r.metered.status(cfg)
+	resp := &pb.MeteredStatusResponse{
+		Type:    internal.CodeSuccess,
+		Network: status.connection.Name,
+		Metered: status.isMetered(),
+		Manual:  status.manual,
+		Active:  status.active,
+	}
+	if status.err != nil {
+		log.Println(internal.WarningPrefix, "detecting metered connection:", status.err)
+		resp.Error = status.err.Error()
+	}
+	return resp, nil
+}
+
+func meteredToPb(metered config.Metered) *pb.Metered {
+	return &pb.Metered{
+		Enabled:          metered.Enabled,
+		AllowRefresh:     metered.AllowRefresh,
+		AllowAutoconnect: metered.AllowAutoConnect,
+		Networks:         metered.Networks,
+	}
+}
@@ -0,0 +1,150 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/metered"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetMetered(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      config.Metered
+		req          *pb.SetMeteredRequest
+		saveErr      error
+		expected     config.Metered
+		expectedCode int64
+	}{
+		{
+			name:         "enable",
+			current:      config.Metered{Networks: []string{"phone"}},
+			req:          &pb.SetMeteredRequest{Enabled: true, AllowRefresh: true},
+			expected:     config.Metered{Enabled: true, AllowRefresh: true, Networks: []string{"phone"}},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "change policy",
+			current:      config.Metered{Enabled: true, AllowRefresh: true},
+			req:          &pb.SetMeteredRequest{Enabled: true, AllowAutoconnect: true},
+			expected:     config.Metered{Enabled: true, AllowAutoConnect: true},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already set",
+			current:      config.Metered{Enabled: true},
+			req:          &pb.SetMeteredRequest{Enabled: true},
+			expected:     config.Metered{Enabled: true},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "config failure",
+			req:          &pb.SetMeteredRequest{Enabled: true},
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Metered = test.current
+			cm.SaveErr = test.saveErr
+
+			r := RPC{cm: cm}
+			resp, err := r.SetMetered(context.Background(), test.req)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.Metered)
+		})
+	}
+}
+
+func TestSetMeteredNetwork(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      []string
+		req          *pb.SetMeteredNetworkRequest
+		expected     []string
+		expectedCode int64
+	}{
+		{
+			name:         "add",
+			current:      []string{"phone"},
+			req:          &pb.SetMeteredNetworkRequest{Network: "hotel wifi", Metered: true},
+			expected:     []string{"phone", "hotel wifi"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "remove",
+			current:      []string{"phone", "hotel wifi"},
+			req:          &pb.SetMeteredNetworkRequest{Network: "phone"},
+			expected:     []string{"hotel wifi"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already added",
+			current:      []string{"phone"},
+			req:          &pb.SetMeteredNetworkRequest{Network: "phone", Metered: true},
+			expected:     []string{"phone"},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "not added",
+			req:          &pb.SetMeteredNetworkRequest{Network: "phone"},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "empty name",
+			req:          &pb.SetMeteredNetworkRequest{Network: " ", Metered: true},
+			expectedCode: internal.CodeFormatError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Metered.Networks = test.current
+
+			r := RPC{cm: cm}
+			resp, err := r.SetMeteredNetwork(context.Background(), test.req)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.Metered.Networks)
+		})
+	}
+}
+
+func TestMeteredStatus(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Metered = config.Metered{Enabled: true, Networks: []string{"phone"}}
+	r := RPC{
+		cm: cm,
+		metered: &meteredPolicy{
+			cm:       cm,
+			detector: &mockMeteredDetector{connection: metered.Connection{Name: "phone"}},
+		},
+	}
+
+	resp, err := r.MeteredStatus(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.MeteredStatusResponse{
+		Type:    internal.CodeSuccess,
+		Network: "phone",
+		Metered: true,
+		Manual:  true,
+		Active:  true,
+	}, resp)
+}
@@ -0,0 +1,44 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// Pause disconnects from VPN and connects to the same server again after the
+// given duration
+func (r *RPC) Pause(ctx context.Context, in *pb.PauseRequest) (*pb.Payload, error) {
+	duration := time.Duration(in.GetDurationSeconds()) * time.Second
+	if duration == 0 || duration > MaxPauseDuration {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	if !r.netw.IsVPNActive() {
+		return &pb.Payload{Type: internal.CodeVPNNotRunning}, nil
+	}
+
+	server := strings.Split(r.lastServer.Hostname, ".")[0]
+	if err := r.pause.start(duration, server, in.GetKeepKillSwitch()); err != nil {
+		log.Println(internal.ErrorPrefix, "pausing connection:", err)
+		return nil, internal.ErrUnhandled
+	}
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+// Resume connects the paused connection without waiting for the pause to end
+func (r *RPC) Resume(ctx context.Context, in *pb.Empty) (*pb.Payload, error) {
+	paused, err := r.pause.resume()
+	if !paused {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "resuming connection:", err)
+		return &pb.Payload{Type: internal.CodeFailure, Data: []string{err.Error()}}, nil
+	}
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
@@ -0,0 +1,66 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPCPause(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		duration     uint32
+		vpnActive    bool
+		expectedCode int64
+	}{
+		{
+			name:         "pause",
+			duration:     600,
+			vpnActive:    true,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "not connected",
+			duration:     600,
+			expectedCode: internal.CodeVPNNotRunning,
+		},
+		{
+			name:         "zero duration",
+			vpnActive:    true,
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "too long",
+			duration:     uint32((MaxPauseDuration + time.Second).Seconds()),
+			vpnActive:    true,
+			expectedCode: internal.CodeFormatError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			netw := captivePortalNetworker{killSwitchNetworker{
+				onDemandNetworker: onDemandNetworker{active: test.vpnActive},
+			}}
+			now := time.Now()
+			p, _ := newTestPause(&netw, false, &now)
+			r := RPC{cm: p.cm, netw: &netw, pause: p}
+			r.lastServer.Hostname = "lt10.nordvpn.com"
+
+			resp, err := r.Pause(context.Background(), &pb.PauseRequest{DurationSeconds: test.duration})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			if test.expectedCode == internal.CodeSuccess {
+				assert.Equal(t, "lt10", p.server)
+				assert.Equal(t, time.Duration(test.duration)*time.Second, p.remainingTime())
+			}
+		})
+	}
+}
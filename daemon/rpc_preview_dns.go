@@ -0,0 +1,64 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/nordlynx"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/openvpn"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"golang.org/x/exp/slices"
+)
+
+// PreviewDNS reports the changes which would be made to the system DNS configuration
+// when connecting with the current settings, nothing is applied
+func (r *RPC) PreviewDNS(ctx context.Context, in *pb.Empty) (*pb.PreviewDNSResponse, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.PreviewDNSResponse{Type: internal.CodeConfigError}, nil
+	}
+
+	// IPv6 support is known only for the current server
+	supportsIPv6 := r.netw.IsVPNActive() && r.lastServer.SupportsIPv6()
+	nameservers := cfg.AutoConnectData.DNS.Or(
+		r.nameservers.Get(cfg.AutoConnectData.ThreatProtectionLite, supportsIPv6),
+	)
+	if !cfg.DNSIPv6.Get() {
+		nameservers = dns.IPv4Only(nameservers)
+	}
+
+	iface := openvpn.InterfaceName
+	if cfg.Technology == config.Technology_NORDLYNX {
+		iface = nordlynx.InterfaceName
+	}
+	if cfg.InterfaceName != "" {
+		iface = cfg.InterfaceName
+	}
+
+	searchDomains := cfg.DNSSearchDomains
+	if cfg.Mesh {
+		searchDomains = append(slices.Clone(searchDomains), dns.MeshnetSearchDomain)
+	}
+
+	preview, err := r.dnsPreviewer.Preview(iface, nameservers, searchDomains)
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "previewing dns:", err)
+		return &pb.PreviewDNSResponse{Type: internal.CodeFailure}, nil
+	}
+
+	return &pb.PreviewDNSResponse{
+		Type:        internal.CodeSuccess,
+		Method:      preview.Method,
+		Nameservers: nameservers,
+		Commands:    preview.Commands,
+		File:        preview.File,
+		Before:      preview.Before,
+		After:       preview.After,
+		Diff:        preview.Diff(),
+	}, nil
+}
@@ -0,0 +1,135 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockDNSPreviewer struct {
+	iface         string
+	nameservers   []string
+	searchDomains []string
+	err           error
+}
+
+func (m *mockDNSPreviewer) Preview(iface string, nameservers []string, searchDomains []string) (dns.Preview, error) {
+	m.iface = iface
+	m.nameservers = nameservers
+	m.searchDomains = searchDomains
+	return dns.Preview{
+		Method: "resolv.conf, default",
+		File:   "/etc/resolv.conf",
+		Before: "nameserver 192.168.1.1\n",
+		After:  "# Generated by NordVPN\nnameserver 192.0.2.1",
+	}, m.err
+}
+
+func TestPreviewDNS(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name                string
+		cfg                 config.Config
+		dnsIPv6             bool
+		previewErr          error
+		expectedCode        int64
+		expectedIface       string
+		expectedNameservers []string
+		expectedDomains     []string
+	}{
+		{
+			name: "ipv6 nameservers disabled",
+			cfg: config.Config{
+				Technology:      config.Technology_NORDLYNX,
+				AutoConnectData: config.AutoConnectData{DNS: config.DNS{"192.0.2.1", "2001:db8::1"}},
+			},
+			expectedCode:        internal.CodeSuccess,
+			expectedIface:       "nordlynx",
+			expectedNameservers: []string{"192.0.2.1"},
+		},
+		{
+			name: "ipv6 nameservers",
+			cfg: config.Config{
+				Technology:      config.Technology_OPENVPN,
+				AutoConnectData: config.AutoConnectData{DNS: config.DNS{"192.0.2.1", "2001:db8::1"}},
+			},
+			dnsIPv6:             true,
+			expectedCode:        internal.CodeSuccess,
+			expectedIface:       "nordtun",
+			expectedNameservers: []string{"192.0.2.1", "2001:db8::1"},
+		},
+		{
+			name:                "default dns",
+			cfg:                 config.Config{Technology: config.Technology_NORDLYNX},
+			expectedCode:        internal.CodeSuccess,
+			expectedIface:       "nordlynx",
+			expectedNameservers: []string{"1.1.1.1"},
+		},
+		{
+			name: "search domains",
+			cfg: config.Config{
+				Technology:       config.Technology_NORDLYNX,
+				DNSSearchDomains: []string{"corp.example.com"},
+			},
+			expectedCode:        internal.CodeSuccess,
+			expectedIface:       "nordlynx",
+			expectedNameservers: []string{"1.1.1.1"},
+			expectedDomains:     []string{"corp.example.com"},
+		},
+		{
+			name: "search domains with meshnet",
+			cfg: config.Config{
+				Technology:       config.Technology_NORDLYNX,
+				DNSSearchDomains: []string{"corp.example.com"},
+				Mesh:             true,
+			},
+			expectedCode:        internal.CodeSuccess,
+			expectedIface:       "nordlynx",
+			expectedNameservers: []string{"1.1.1.1"},
+			expectedDomains:     []string{"corp.example.com", "nord"},
+		},
+		{
+			name:         "preview fails",
+			cfg:          config.Config{Technology: config.Technology_NORDLYNX},
+			previewErr:   fmt.Errorf("no dns setting method is available"),
+			expectedCode: internal.CodeFailure,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			test.cfg.DNSIPv6.Set(test.dnsIPv6)
+			cm.Cfg = &test.cfg
+			previewer := &mockDNSPreviewer{err: test.previewErr}
+			r := RPC{
+				cm:           cm,
+				netw:         &networker.Mock{},
+				nameservers:  &mock.DNSGetter{Names: []string{"1.1.1.1"}},
+				dnsPreviewer: previewer,
+			}
+
+			resp, err := r.PreviewDNS(context.Background(), &pb.Empty{})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			if test.expectedCode != internal.CodeSuccess {
+				return
+			}
+			assert.Equal(t, test.expectedIface, previewer.iface)
+			assert.Equal(t, test.expectedNameservers, previewer.nameservers)
+			assert.Equal(t, test.expectedNameservers, resp.Nameservers)
+			assert.Equal(t, test.expectedDomains, previewer.searchDomains)
+			assert.Equal(t, "-nameserver 192.168.1.1\n+# Generated by NordVPN\n+nameserver 192.0.2.1\n", resp.Diff)
+		})
+	}
+}
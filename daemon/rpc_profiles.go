@@ -0,0 +1,167 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SaveProfile captures the current connection settings under the given name.
+// Profile with the same name is replaced.
+func (r *RPC) SaveProfile(ctx context.Context, in *pb.SaveProfileRequest) (*pb.Payload, error) {
+	name := strings.TrimSpace(in.GetName())
+	if name == "" {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+	serverGroup := strings.TrimSpace(in.GetServerGroup())
+	if _, err := resolveServerGroup(serverGroup, ""); err != nil {
+		return &pb.Payload{Type: internal.CodeGroupNonexisting}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	profile := config.NewProfile(name, serverGroup, cfg)
+	if existing, ok := cfg.Profiles.Get(name); ok && reflect.DeepEqual(existing, profile) {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.Profiles = c.Profiles.Set(profile)
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+// RemoveProfile removes the profile by its name
+func (r *RPC) RemoveProfile(ctx context.Context, in *pb.RemoveProfileRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	if _, ok := cfg.Profiles.Get(in.GetName()); !ok {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.Profiles = c.Profiles.Remove(in.GetName())
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+// Profiles returns the saved profiles
+func (r *RPC) Profiles(ctx context.Context, in *pb.Empty) (*pb.ProfilesResponse, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.ProfilesResponse{Type: internal.CodeConfigError}, nil
+	}
+
+	profiles := make([]*pb.Profile, 0, len(cfg.Profiles))
+	for _, profile := range cfg.Profiles {
+		profiles = append(profiles, &pb.Profile{
+			Name:        profile.Name,
+			Technology:  profile.Technology,
+			Protocol:    profile.Protocol,
+			ServerGroup: profile.ServerGroup,
+			Dns:         profile.DNS,
+			Allowlist:   allowlistToPb(profile.Allowlist),
+			KillSwitch:  profile.KillSwitch,
+		})
+	}
+	return &pb.ProfilesResponse{Type: internal.CodeSuccess, Profiles: profiles}, nil
+}
+
+// applyProfile saves all of the settings of the profile at once and applies
+// the ones which take effect without reconnecting. Previous settings are
+// restored if any of them cannot be applied.
+func (r *RPC) applyProfile(profile config.Profile) error {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	if !cfg.AllowedTechnologies.Allows(profile.Technology) {
+		log.Println(internal.WarningPrefix, "profile", profile.Name, "uses technology", profile.Technology,
+			"which is not allowed, allowed:", cfg.AllowedTechnologies)
+		return internal.ErrTechnologyNotAllowed
+	}
+	if profile.KillSwitch && !cfg.Firewall {
+		log.Println(internal.ErrorPrefix, "profile", profile.Name, "enables kill switch while firewall is disabled")
+		return internal.ErrProfileNotApplied
+	}
+
+	updated := profile.ApplyTo(cfg)
+	// VPN is replaced only after all of the settings are applied, as it cannot
+	// be restored
+	var nextVPN vpn.VPN
+	if updated.Technology != cfg.Technology {
+		var err error
+		if nextVPN, err = r.factory(updated.Technology); err != nil {
+			log.Println(internal.ErrorPrefix, "applying profile", profile.Name, err)
+			return internal.ErrProfileNotApplied
+		}
+	}
+
+	if err := r.cm.SaveWith(profile.ApplyTo); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return internal.ErrProfileNotApplied
+	}
+
+	var applied []reloadableSetting
+	for _, setting := range reloadableSettings {
+		if setting.effect != reloadLive || setting.apply == nil || !setting.changed(cfg, updated) {
+			continue
+		}
+		if err := setting.apply(r, updated); err != nil {
+			log.Println(internal.ErrorPrefix, "applying profile", profile.Name, setting.name, "setting:", err)
+			r.restoreSettings(cfg, applied)
+			return internal.ErrProfileNotApplied
+		}
+		applied = append(applied, setting)
+	}
+
+	if nextVPN != nil {
+		r.netw.SetVPN(nextVPN)
+		r.events.Settings.Technology.Publish(updated.Technology)
+		SetAppData(r.dm, updated.Technology, updated.VirtualLocation.Get(), r.dm.GetServersData().Servers)
+	}
+	if updated.KillSwitch != cfg.KillSwitch {
+		r.events.Settings.Killswitch.Publish(updated.KillSwitch)
+	}
+	log.Println(internal.InfoPrefix, "applied profile", profile.Name)
+	return nil
+}
+
+// restoreSettings saves the connection settings of the previous config and
+// applies them again in place of the applied settings
+func (r *RPC) restoreSettings(previous config.Config, applied []reloadableSetting) {
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c = config.NewProfile("", "", previous).ApplyTo(c)
+		c.AutoConnectData.Obfuscate = previous.AutoConnectData.Obfuscate
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, "restoring settings:", err)
+	}
+	for _, setting := range applied {
+		if err := setting.apply(r, previous); err != nil {
+			log.Println(internal.ErrorPrefix, "restoring", setting.name, "setting:", err)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveProfile(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	current := config.Config{
+		Technology: config.Technology_NORDLYNX,
+		KillSwitch: true,
+		AutoConnectData: config.AutoConnectData{
+			Protocol:  config.Protocol_UDP,
+			DNS:       config.DNS{"192.0.2.1"},
+			Allowlist: config.NewAllowlist(nil, []int64{22}, nil),
+		},
+	}
+	work := config.NewProfile("work", "p2p", current)
+
+	tests := []struct {
+		name         string
+		profiles     config.Profiles
+		req          *pb.SaveProfileRequest
+		saveErr      error
+		expected     config.Profiles
+		expectedCode int64
+	}{
+		{
+			name:         "new profile",
+			req:          &pb.SaveProfileRequest{Name: "work", ServerGroup: "p2p"},
+			expected:     config.Profiles{work},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "replace profile",
+			profiles:     config.Profiles{{Name: "Work"}, {Name: "home"}},
+			req:          &pb.SaveProfileRequest{Name: "work", ServerGroup: "p2p"},
+			expected:     config.Profiles{work, {Name: "home"}},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "same profile",
+			profiles:     config.Profiles{work},
+			req:          &pb.SaveProfileRequest{Name: "work", ServerGroup: "p2p"},
+			expected:     config.Profiles{work},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "empty name",
+			req:          &pb.SaveProfileRequest{Name: " "},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "unknown group",
+			req:          &pb.SaveProfileRequest{Name: "work", ServerGroup: "unknown"},
+			expectedCode: internal.CodeGroupNonexisting,
+		},
+		{
+			name:         "config failure",
+			req:          &pb.SaveProfileRequest{Name: "work"},
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := current
+			cfg.Profiles = test.profiles
+			cm := mock.NewMockConfigManager()
+			cm.Cfg = &cfg
+			cm.SaveErr = test.saveErr
+
+			r := RPC{cm: cm}
+			resp, err := r.SaveProfile(context.Background(), test.req)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.Profiles)
+		})
+	}
+}
+
+func TestRemoveProfile(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Profiles = config.Profiles{{Name: "work"}, {Name: "home"}}
+	r := RPC{cm: cm}
+
+	resp, err := r.RemoveProfile(context.Background(), &pb.RemoveProfileRequest{Name: "Work"})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.Equal(t, config.Profiles{{Name: "home"}}, cm.Cfg.Profiles)
+
+	resp, err = r.RemoveProfile(context.Background(), &pb.RemoveProfileRequest{Name: "work"})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeNothingToDo, resp.Type)
+}
+
+func TestApplyProfile(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	previous := config.Config{
+		Technology: config.Technology_NORDLYNX,
+		Firewall:   true,
+		AutoConnectData: config.AutoConnectData{
+			Protocol:  config.Protocol_UDP,
+			Allowlist: config.NewAllowlist(nil, nil, nil),
+		},
+	}
+	profile := config.Profile{
+		Name:       "work",
+		Technology: config.Technology_NORDLYNX,
+		Protocol:   config.Protocol_UDP,
+		DNS:        config.DNS{"192.0.2.1"},
+		Allowlist:  config.NewAllowlist(nil, []int64{22}, nil),
+		KillSwitch: true,
+	}
+
+	tests := []struct {
+		name        string
+		cfg         func(config.Config) config.Config
+		netw        *testnetworker.Mock
+		expectedErr error
+	}{
+		{
+			name: "applied",
+			netw: &testnetworker.Mock{VpnActive: true},
+		},
+		{
+			name: "technology not allowed",
+			cfg: func(c config.Config) config.Config {
+				c.AllowedTechnologies = config.Technologies{config.Technology_OPENVPN}
+				return c
+			},
+			netw:        &testnetworker.Mock{VpnActive: true},
+			expectedErr: internal.ErrTechnologyNotAllowed,
+		},
+		{
+			name: "kill switch without firewall",
+			cfg: func(c config.Config) config.Config {
+				c.Firewall = false
+				return c
+			},
+			netw:        &testnetworker.Mock{VpnActive: true},
+			expectedErr: internal.ErrProfileNotApplied,
+		},
+		{
+			name:        "settings restored on failure",
+			netw:        &testnetworker.Mock{VpnActive: true, SetDNSErr: mock.ErrOnPurpose},
+			expectedErr: internal.ErrProfileNotApplied,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := previous
+			if test.cfg != nil {
+				cfg = test.cfg(cfg)
+			}
+			cm := mock.NewMockConfigManager()
+			cm.Cfg = &cfg
+			killSwitchPublisher := &mockPublisherSubcriber{}
+
+			r := RPC{
+				cm:          cm,
+				netw:        test.netw,
+				nameservers: &mock.DNSGetter{Names: []string{"1.1.1.1"}},
+				events:      &Events{Settings: &SettingsEvents{Killswitch: killSwitchPublisher}},
+			}
+			err := r.applyProfile(profile)
+			assert.ErrorIs(t, err, test.expectedErr)
+
+			if test.expectedErr != nil {
+				assert.False(t, cm.Cfg.KillSwitch)
+				assert.Empty(t, cm.Cfg.AutoConnectData.DNS)
+				assert.Equal(t, previous.AutoConnectData.Allowlist, cm.Cfg.AutoConnectData.Allowlist)
+				assert.False(t, killSwitchPublisher.eventPublished)
+				return
+			}
+			assert.True(t, cm.Cfg.KillSwitch)
+			assert.Equal(t, profile.DNS, cm.Cfg.AutoConnectData.DNS)
+			assert.Equal(t, profile.Allowlist, cm.Cfg.AutoConnectData.Allowlist)
+			assert.Equal(t, profile.Allowlist, test.netw.Allowlist)
+			assert.Equal(t, []string(profile.DNS), test.netw.Dns)
+			assert.True(t, killSwitchPublisher.eventPublished)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// RebuildFirewall removes the firewall rules from the system and applies them
+// again from the current state without touching the tunnel and the routes
+func (r *RPC) RebuildFirewall(ctx context.Context, in *pb.Empty) (*pb.RebuildFirewallResponse, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.RebuildFirewallResponse{Type: internal.CodeConfigError}, nil
+	}
+
+	if !cfg.Firewall {
+		return &pb.RebuildFirewallResponse{Type: internal.CodeDependencyError}, nil
+	}
+
+	result, err := r.netw.RebuildFirewall()
+	resp := &pb.RebuildFirewallResponse{
+		Type:       internal.CodeSuccess,
+		Missing:    result.Missing,
+		Duplicated: result.Duplicated,
+		Unverified: result.Unverified,
+	}
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "rebuilding firewall:", err)
+		resp.Type = internal.CodeFailure
+		resp.Error = err.Error()
+		return resp, nil
+	}
+	log.Println(internal.InfoPrefix, "firewall rebuilt, missing:", result.Missing,
+		"duplicated:", result.Duplicated, "unverified:", result.Unverified)
+	return resp, nil
+}
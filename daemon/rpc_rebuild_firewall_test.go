@@ -0,0 +1,65 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRebuildFirewall(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		firewall bool
+		netw     networker.Mock
+		expected *pb.RebuildFirewallResponse
+	}{
+		{
+			name:     "rebuilt",
+			firewall: true,
+			netw: networker.Mock{Reconciliation: firewall.Reconciliation{
+				Missing:    []string{"killswitch"},
+				Duplicated: []string{"api-allowlist"},
+			}},
+			expected: &pb.RebuildFirewallResponse{
+				Type:       internal.CodeSuccess,
+				Missing:    []string{"killswitch"},
+				Duplicated: []string{"api-allowlist"},
+			},
+		},
+		{
+			name:     "firewall disabled",
+			expected: &pb.RebuildFirewallResponse{Type: internal.CodeDependencyError},
+		},
+		{
+			name:     "rebuild failure",
+			firewall: true,
+			netw:     networker.Mock{RebuildFirewallErr: mock.ErrOnPurpose},
+			expected: &pb.RebuildFirewallResponse{
+				Type:  internal.CodeFailure,
+				Error: mock.ErrOnPurpose.Error(),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Firewall = test.firewall
+			netw := test.netw
+
+			r := RPC{cm: cm, netw: &netw}
+			resp, err := r.RebuildFirewall(context.Background(), &pb.Empty{})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, resp)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// Reload applies the settings changed since the daemon start or the previous
+// reload and reports the ones which still need a reconnect or a restart
+func (r *RPC) Reload(ctx context.Context, in *pb.Empty) (*pb.ReloadResponse, error) {
+	report, err := r.ReloadConfig()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "reloading config:", err)
+		return &pb.ReloadResponse{Type: internal.CodeConfigError}, nil
+	}
+
+	resp := &pb.ReloadResponse{
+		Type:              internal.CodeSuccess,
+		Applied:           report.Applied,
+		Failed:            report.Failed,
+		ReconnectRequired: report.ReconnectRequired,
+		RestartRequired:   report.RestartRequired,
+	}
+	if len(report.Failed) > 0 {
+		resp.Type = internal.CodeFailure
+	}
+	return resp, nil
+}
@@ -0,0 +1,92 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/openvpn"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/slices"
+)
+
+// RenderOpenVPNConfig renders the OpenVPN config for the given server without
+// connecting, so that the template errors are visible before connecting
+func (r *RPC) RenderOpenVPNConfig(
+	ctx context.Context,
+	in *pb.RenderOpenVPNConfigRequest,
+) (*pb.RenderOpenVPNConfigResponse, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.RenderOpenVPNConfigResponse{Type: internal.CodeConfigError}, nil
+	}
+
+	var server core.Server
+	if len(in.GetServer()) > 0 {
+		if err := json.Unmarshal(in.GetServer(), &server); err != nil {
+			return &pb.RenderOpenVPNConfigResponse{
+				Type:  internal.CodeFormatError,
+				Error: err.Error(),
+			}, nil
+		}
+	} else {
+		idx := slices.IndexFunc(r.dm.GetServersData().Servers, func(s core.Server) bool {
+			return strings.EqualFold(in.GetServerTag(), strings.Split(s.Hostname, ".")[0])
+		})
+		if idx == -1 {
+			return &pb.RenderOpenVPNConfigResponse{Type: internal.CodeTagNonexisting}, nil
+		}
+		server = r.dm.GetServersData().Servers[idx]
+	}
+
+	serverIP, err := server.IPv4()
+	if err != nil {
+		return &pb.RenderOpenVPNConfigResponse{
+			Type:  internal.CodeFormatError,
+			Error: err.Error(),
+		}, nil
+	}
+
+	protocol := in.GetProtocol()
+	if protocol == config.Protocol_UNKNOWN_PROTOCOL {
+		protocol = cfg.AutoConnectData.Protocol
+	}
+
+	var obfuscationPorts []uint16
+	if in.GetObfuscated() {
+		obfuscationPorts = server.Ports(techToServerTech(config.Technology_OPENVPN, protocol, true))
+	}
+
+	var template []byte
+	if len(in.GetTemplate()) > 0 {
+		template = in.GetTemplate()
+	}
+
+	out, err := openvpn.RenderConfig(
+		protocol,
+		serverIP,
+		in.GetObfuscated(),
+		obfuscationPorts,
+		cfg.ServerPorts.OpenVPN,
+		template,
+	)
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "rendering OpenVPN config for", server.Hostname+":", err)
+		return &pb.RenderOpenVPNConfigResponse{
+			Type:     internal.CodeFailure,
+			Hostname: server.Hostname,
+			Error:    err.Error(),
+		}, nil
+	}
+
+	return &pb.RenderOpenVPNConfigResponse{
+		Type:     internal.CodeSuccess,
+		Hostname: server.Hostname,
+		Config:   string(out),
+	}, nil
+}
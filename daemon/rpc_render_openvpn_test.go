@@ -0,0 +1,121 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+const testOpenVPNTemplate = `<?xml version="1.0"?>
+<xsl:stylesheet xmlns:xsl="http://www.w3.org/1999/XSL/Transform" version="1.0">
+<xsl:output method="text"/>
+<xsl:template match="/">remote <xsl:value-of select="/config/ips/ip/@address"/> 1194 udp
+</xsl:template>
+</xsl:stylesheet>`
+
+func TestRenderOpenVPNConfig(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	dm := &DataManager{serversData: ServersData{Servers: core.Servers{
+		{Hostname: "lt10.nordvpn.com", Station: "192.0.2.10"},
+	}}}
+
+	tests := []struct {
+		name              string
+		request           *pb.RenderOpenVPNConfigRequest
+		expectedCode      int64
+		expectedHostname  string
+		expectedContained []string
+	}{
+		{
+			name: "cached server",
+			request: &pb.RenderOpenVPNConfigRequest{
+				ServerTag: "LT10",
+				Protocol:  config.Protocol_UDP,
+				Template:  []byte(testOpenVPNTemplate),
+			},
+			expectedCode:      internal.CodeSuccess,
+			expectedHostname:  "lt10.nordvpn.com",
+			expectedContained: []string{"remote 192.0.2.10 1194 udp"},
+		},
+		{
+			name: "server record",
+			request: &pb.RenderOpenVPNConfigRequest{
+				Server:   []byte(`{"hostname": "de5.nordvpn.com", "station": "192.0.2.5"}`),
+				Template: []byte(testOpenVPNTemplate),
+			},
+			expectedCode:      internal.CodeSuccess,
+			expectedHostname:  "de5.nordvpn.com",
+			expectedContained: []string{"remote 192.0.2.5 1194 udp"},
+		},
+		{
+			name: "obfuscated server without advertised ports",
+			request: &pb.RenderOpenVPNConfigRequest{
+				ServerTag:  "lt10",
+				Protocol:   config.Protocol_UDP,
+				Obfuscated: true,
+				Template:   []byte(testOpenVPNTemplate),
+			},
+			expectedCode:     internal.CodeSuccess,
+			expectedHostname: "lt10.nordvpn.com",
+			expectedContained: []string{
+				"remote 192.0.2.10 1194 udp",
+				"remote 192.0.2.10 443 udp",
+				"remote 192.0.2.10 53 udp",
+			},
+		},
+		{
+			name:         "unknown server",
+			request:      &pb.RenderOpenVPNConfigRequest{ServerTag: "lt99"},
+			expectedCode: internal.CodeTagNonexisting,
+		},
+		{
+			name:         "invalid server record",
+			request:      &pb.RenderOpenVPNConfigRequest{Server: []byte("{")},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "server record without address",
+			request:      &pb.RenderOpenVPNConfigRequest{Server: []byte(`{"hostname": "de5.nordvpn.com"}`)},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name: "invalid template",
+			request: &pb.RenderOpenVPNConfigRequest{
+				ServerTag: "lt10",
+				Template:  []byte("invalid"),
+			},
+			expectedCode:     internal.CodeFailure,
+			expectedHostname: "lt10.nordvpn.com",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.AutoConnectData.Protocol = config.Protocol_UDP
+			rpc := RPC{cm: cm, dm: dm}
+
+			resp, err := rpc.RenderOpenVPNConfig(context.Background(), test.request)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedHostname, resp.Hostname)
+			if test.expectedCode != internal.CodeSuccess {
+				assert.Empty(t, resp.Config)
+				if test.expectedCode != internal.CodeTagNonexisting {
+					assert.NotEmpty(t, resp.Error)
+				}
+			}
+			for _, expected := range test.expectedContained {
+				assert.Contains(t, resp.Config, expected)
+			}
+		})
+	}
+}
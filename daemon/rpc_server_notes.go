@@ -0,0 +1,97 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/slices"
+)
+
+// SetServerNote sets the note of the server, empty note removes it
+func (r *RPC) SetServerNote(ctx context.Context, in *pb.SetServerNoteRequest) (*pb.Payload, error) {
+	text := strings.TrimSpace(in.GetNote())
+	return r.updateServerNote(in.GetServer(), func(note config.ServerNote) config.ServerNote {
+		note.Note = text
+		return note
+	}), nil
+}
+
+// SetServerRating sets the rating of the server, 0 removes it
+func (r *RPC) SetServerRating(ctx context.Context, in *pb.SetServerRatingRequest) (*pb.Payload, error) {
+	rating := in.GetRating()
+	if rating != 0 && (rating < config.MinServerRating || rating > config.MaxServerRating) {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+	return r.updateServerNote(in.GetServer(), func(note config.ServerNote) config.ServerNote {
+		note.Rating = rating
+		return note
+	}), nil
+}
+
+// ServerNotes returns the server notes sorted by the server name. Notes of the servers
+// which are no longer in the server list are marked as stale.
+func (r *RPC) ServerNotes(ctx context.Context, in *pb.Empty) (*pb.ServerNotesResponse, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.ServerNotesResponse{Type: internal.CodeConfigError}, nil
+	}
+
+	// nothing can be told about staleness until the server list is downloaded
+	servers := r.dm.GetServersData().Servers
+	notes := make([]*pb.ServerNote, 0, len(cfg.ServerNotes))
+	for name, note := range cfg.ServerNotes {
+		notes = append(notes, &pb.ServerNote{
+			Server: name,
+			Note:   note.Note,
+			Rating: note.Rating,
+			Stale:  len(servers) > 0 && !isKnownServer(servers, name),
+		})
+	}
+	sort.Slice(notes, func(i, j int) bool { return notes[i].Server < notes[j].Server })
+
+	return &pb.ServerNotesResponse{Type: internal.CodeSuccess, Notes: notes}, nil
+}
+
+// updateServerNote applies the update to the note of the server and saves it
+func (r *RPC) updateServerNote(server string, update func(config.ServerNote) config.ServerNote) *pb.Payload {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}
+	}
+
+	name := config.ServerName(server)
+	current, ok := cfg.ServerNotes.Get(name)
+	// existing notes can be edited even if the server was removed from the server list
+	if !ok && !isKnownServer(r.dm.GetServersData().Servers, name) {
+		return &pb.Payload{Type: internal.CodeTagNonexisting}
+	}
+
+	note := update(current)
+	if note == current {
+		return &pb.Payload{Type: internal.CodeNothingToDo}
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.ServerNotes = c.ServerNotes.With(name, note)
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}
+	}
+	return &pb.Payload{Type: internal.CodeSuccess}
+}
+
+// isKnownServer checks whether the server with the given name is in the server list
+func isKnownServer(servers core.Servers, name string) bool {
+	return slices.ContainsFunc(servers, func(s core.Server) bool {
+		return config.ServerName(s.Hostname) == name
+	})
+}
@@ -0,0 +1,199 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetServerNote(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	dm := &DataManager{serversData: ServersData{Servers: core.Servers{
+		{ID: 1, Hostname: "lt10.nordvpn.com"},
+	}}}
+
+	tests := []struct {
+		name         string
+		current      config.ServerNotes
+		server       string
+		note         string
+		saveErr      error
+		expected     config.ServerNotes
+		expectedCode int64
+	}{
+		{
+			name:         "new note",
+			server:       "LT10.nordvpn.com",
+			note:         " great for streaming ",
+			expected:     config.ServerNotes{"lt10": {Note: "great for streaming"}},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "rating is kept",
+			current:      config.ServerNotes{"lt10": {Note: "slow", Rating: 2}},
+			server:       "lt10",
+			note:         "fast",
+			expected:     config.ServerNotes{"lt10": {Note: "fast", Rating: 2}},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "note is removed",
+			current:      config.ServerNotes{"lt10": {Note: "slow"}, "lt11": {Rating: 4}},
+			server:       "lt10",
+			expected:     config.ServerNotes{"lt11": {Rating: 4}},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "stale note is edited",
+			current:      config.ServerNotes{"lv20": {Note: "slow"}},
+			server:       "lv20",
+			note:         "removed",
+			expected:     config.ServerNotes{"lv20": {Note: "removed"}},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "unknown server",
+			server:       "lv20",
+			note:         "slow",
+			expectedCode: internal.CodeTagNonexisting,
+		},
+		{
+			name:         "same note",
+			current:      config.ServerNotes{"lt10": {Note: "fast"}},
+			server:       "lt10",
+			note:         "fast",
+			expected:     config.ServerNotes{"lt10": {Note: "fast"}},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "config failure",
+			server:       "lt10",
+			note:         "fast",
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.ServerNotes = test.current
+			cm.SaveErr = test.saveErr
+
+			rpc := RPC{cm: cm, dm: dm}
+			resp, err := rpc.SetServerNote(context.Background(), &pb.SetServerNoteRequest{
+				Server: test.server,
+				Note:   test.note,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.ServerNotes)
+		})
+	}
+}
+
+func TestSetServerRating(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	dm := &DataManager{serversData: ServersData{Servers: core.Servers{
+		{ID: 1, Hostname: "lt10.nordvpn.com"},
+	}}}
+
+	tests := []struct {
+		name         string
+		current      config.ServerNotes
+		rating       uint32
+		expected     config.ServerNotes
+		expectedCode int64
+	}{
+		{
+			name:         "new rating",
+			rating:       5,
+			expected:     config.ServerNotes{"lt10": {Rating: 5}},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "rating is removed",
+			current:      config.ServerNotes{"lt10": {Note: "fast", Rating: 5}},
+			rating:       0,
+			expected:     config.ServerNotes{"lt10": {Note: "fast"}},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "rating too high",
+			rating:       6,
+			expectedCode: internal.CodeFormatError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.ServerNotes = test.current
+
+			rpc := RPC{cm: cm, dm: dm}
+			resp, err := rpc.SetServerRating(context.Background(), &pb.SetServerRatingRequest{
+				Server: "lt10",
+				Rating: test.rating,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.ServerNotes)
+		})
+	}
+}
+
+func TestServerNotes(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	notes := config.ServerNotes{
+		"lv20": {Note: "slow"},
+		"lt10": {Note: "fast", Rating: 5},
+	}
+
+	tests := []struct {
+		name     string
+		servers  core.Servers
+		expected []*pb.ServerNote
+	}{
+		{
+			name:    "removed server is stale",
+			servers: core.Servers{{ID: 1, Hostname: "lt10.nordvpn.com"}},
+			expected: []*pb.ServerNote{
+				{Server: "lt10", Note: "fast", Rating: 5},
+				{Server: "lv20", Note: "slow", Stale: true},
+			},
+		},
+		{
+			name: "server list is not downloaded",
+			expected: []*pb.ServerNote{
+				{Server: "lt10", Note: "fast", Rating: 5},
+				{Server: "lv20", Note: "slow"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.ServerNotes = notes
+
+			rpc := RPC{cm: cm, dm: &DataManager{serversData: ServersData{Servers: test.servers}}}
+			resp, err := rpc.ServerNotes(context.Background(), &pb.Empty{})
+
+			assert.NoError(t, err)
+			assert.Equal(t, internal.CodeSuccess, resp.Type)
+			assert.Equal(t, test.expected, resp.Notes)
+		})
+	}
+}
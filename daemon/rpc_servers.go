@@ -0,0 +1,124 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/slices"
+)
+
+// Servers lists the servers from the cached server list which can be connected to
+// with the current settings, filtered and sorted as requested
+func (r *RPC) Servers(ctx context.Context, in *pb.ServersRequest) (*pb.ServersResponse, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.ServersResponse{Type: internal.CodeConfigError}, nil
+	}
+
+	servers := r.selectableServers(cfg)
+	if len(servers) == 0 {
+		return &pb.ServersResponse{Type: internal.CodeEmptyPayloadError}, nil
+	}
+
+	group := config.UndefinedGroup
+	if in.GetGroup() != "" {
+		group = groupConvert(in.GetGroup())
+		if group == config.UndefinedGroup {
+			return &pb.ServersResponse{Type: internal.CodeGroupNonexisting}, nil
+		}
+	}
+
+	inLocation := func(s core.Server) bool { return isInLocation(s, in.GetCountry(), in.GetCity()) }
+	if !slices.ContainsFunc(servers, inLocation) {
+		return &pb.ServersResponse{Type: internal.CodeTagNonexisting}, nil
+	}
+
+	connectable := canConnect(cfg.Technology, cfg.AutoConnectData.Protocol, "", group, cfg.AutoConnectData.Obfuscate)
+	servers = internal.Filter(servers, func(s core.Server) bool {
+		return connectable(s) && inLocation(s) && (in.GetMaxLoad() == 0 || s.Load <= int64(in.GetMaxLoad()))
+	})
+
+	sortServers(servers, in.GetSort())
+	if limit := int(in.GetLimit()); limit > 0 && len(servers) > limit {
+		servers = servers[:limit]
+	}
+
+	resp := &pb.ServersResponse{
+		Type:    internal.CodeSuccess,
+		Servers: make([]*pb.ServerInfo, 0, len(servers)),
+	}
+	for _, server := range servers {
+		resp.Servers = append(resp.Servers, serverToPb(server))
+	}
+	return resp, nil
+}
+
+// isInLocation checks whether the server is in the country, given by the name
+// or the code, and in the city. Empty country or city matches any.
+func isInLocation(server core.Server, country string, city string) bool {
+	if len(server.Locations) == 0 {
+		return country == "" && city == ""
+	}
+	location := server.Locations[0].Country
+	if country != "" {
+		if strings.EqualFold(country, "uk") {
+			country = "gb"
+		}
+		if !strings.EqualFold(country, location.Code) &&
+			!strings.EqualFold(internal.SnakeCase(country), internal.SnakeCase(location.Name)) {
+			return false
+		}
+	}
+	return city == "" || strings.EqualFold(internal.SnakeCase(city), internal.SnakeCase(location.City.Name))
+}
+
+// sortServers sorts the servers by the key, ties are broken by the server name
+func sortServers(servers []core.Server, by pb.ServerSort) {
+	sort.SliceStable(servers, func(i, j int) bool {
+		a, b := servers[i], servers[j]
+		switch by {
+		case pb.ServerSort_SERVER_SORT_LOAD:
+			if a.Load != b.Load {
+				return a.Load < b.Load
+			}
+		case pb.ServerSort_SERVER_SORT_COUNTRY:
+			if countryA, countryB := serverCountry(a), serverCountry(b); countryA != countryB {
+				return countryA < countryB
+			}
+		case pb.ServerSort_SERVER_SORT_NAME:
+		}
+		return a.Name < b.Name
+	})
+}
+
+func serverCountry(server core.Server) string {
+	if len(server.Locations) == 0 {
+		return ""
+	}
+	return server.Locations[0].Country.Name
+}
+
+func serverToPb(server core.Server) *pb.ServerInfo {
+	info := &pb.ServerInfo{
+		Name:     server.Name,
+		Hostname: server.Hostname,
+		Load:     uint32(server.Load),
+	}
+	if len(server.Locations) > 0 {
+		country := server.Locations[0].Country
+		info.Country = country.Name
+		info.CountryCode = country.Code
+		info.City = country.City.Name
+	}
+	for _, group := range server.Groups {
+		info.Groups = append(info.Groups, group.Title)
+	}
+	return info
+}
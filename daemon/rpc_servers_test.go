@@ -0,0 +1,143 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	newServer := func(name string, country string, code string, city string, load int64, groups ...config.ServerGroup) core.Server {
+		server := core.Server{
+			Name:      name,
+			Hostname:  name + ".nordvpn.com",
+			Load:      load,
+			Status:    core.Online,
+			Locations: core.Locations{{Country: core.Country{Name: country, Code: code, City: core.City{Name: city}}}},
+			Technologies: core.Technologies{
+				core.Technology{
+					ID:    core.WireguardTech,
+					Pivot: core.Pivot{Status: core.Online},
+				},
+			},
+		}
+		for _, group := range groups {
+			server.Groups = append(server.Groups, core.Group{ID: group})
+		}
+		return server
+	}
+	servers := core.Servers{
+		newServer("de1", "Germany", "DE", "Berlin", 40, config.StandardVPNServers, config.P2P),
+		newServer("de2", "Germany", "DE", "Frankfurt", 10, config.StandardVPNServers),
+		newServer("de3", "Germany", "DE", "Berlin", 20, config.StandardVPNServers, config.P2P),
+		newServer("gb1", "United Kingdom", "GB", "London", 5, config.StandardVPNServers, config.P2P),
+		newServer("lt1", "Lithuania", "LT", "Vilnius", 30, config.StandardVPNServers),
+	}
+
+	tests := []struct {
+		name         string
+		servers      core.Servers
+		request      *pb.ServersRequest
+		expected     []string
+		expectedCode int64
+	}{
+		{
+			name:         "all sorted by load",
+			servers:      servers,
+			request:      &pb.ServersRequest{},
+			expected:     []string{"gb1", "de2", "de3", "lt1", "de1"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "country code and group",
+			servers:      servers,
+			request:      &pb.ServersRequest{Country: "de", Group: "p2p"},
+			expected:     []string{"de3", "de1"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "country name and city",
+			servers:      servers,
+			request:      &pb.ServersRequest{Country: "germany", City: "berlin", Sort: pb.ServerSort_SERVER_SORT_NAME},
+			expected:     []string{"de1", "de3"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "uk alias",
+			servers:      servers,
+			request:      &pb.ServersRequest{Country: "uk"},
+			expected:     []string{"gb1"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "max load and limit",
+			servers:      servers,
+			request:      &pb.ServersRequest{MaxLoad: 30, Limit: 2},
+			expected:     []string{"gb1", "de2"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "sort by country",
+			servers:      servers,
+			request:      &pb.ServersRequest{Sort: pb.ServerSort_SERVER_SORT_COUNTRY},
+			expected:     []string{"de1", "de2", "de3", "lt1", "gb1"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "no matches",
+			servers:      servers,
+			request:      &pb.ServersRequest{Country: "lt", Group: "p2p"},
+			expected:     []string{},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "unknown country",
+			servers:      servers,
+			request:      &pb.ServersRequest{Country: "atlantis"},
+			expectedCode: internal.CodeTagNonexisting,
+		},
+		{
+			name:         "unknown group",
+			servers:      servers,
+			request:      &pb.ServersRequest{Group: "fast"},
+			expectedCode: internal.CodeGroupNonexisting,
+		},
+		{
+			name:         "server list not downloaded",
+			request:      &pb.ServersRequest{},
+			expectedCode: internal.CodeEmptyPayloadError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Technology = config.Technology_NORDLYNX
+			rpc := RPC{
+				cm: cm,
+				dm: &DataManager{serversData: ServersData{Servers: test.servers}},
+			}
+
+			resp, err := rpc.Servers(context.Background(), test.request)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			if test.expectedCode != internal.CodeSuccess {
+				return
+			}
+			names := []string{}
+			for _, server := range resp.Servers {
+				names = append(names, server.Name)
+			}
+			assert.Equal(t, test.expected, names)
+		})
+	}
+}
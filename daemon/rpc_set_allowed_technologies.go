@@ -0,0 +1,52 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/slices"
+)
+
+// SetAllowedTechnologies restricts the technologies used to connect. The current
+// technology must stay allowed, so that the connection does not start failing.
+func (r *RPC) SetAllowedTechnologies(ctx context.Context, in *pb.SetAllowedTechnologiesRequest) (*pb.Payload, error) {
+	var allowed config.Technologies
+	for _, tech := range in.GetTechnologies() {
+		if tech == config.Technology_UNKNOWN_TECHNOLOGY {
+			return &pb.Payload{Type: internal.CodeFormatError}, nil
+		}
+		if !slices.Contains(allowed, tech) {
+			allowed = append(allowed, tech)
+		}
+	}
+	slices.Sort(allowed)
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if !allowed.Allows(cfg.Technology) {
+		return &pb.Payload{
+			Type: internal.CodeTechnologyNotAllowed,
+			Data: []string{cfg.Technology.String()},
+		}, nil
+	}
+
+	if slices.Equal(cfg.AllowedTechnologies, allowed) {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.AllowedTechnologies = allowed
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
@@ -0,0 +1,101 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetAllowedTechnologies(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      config.Technologies
+		technology   config.Technology
+		technologies []config.Technology
+		saveErr      error
+		expected     config.Technologies
+		expectedCode int64
+	}{
+		{
+			name:         "restrict",
+			technology:   config.Technology_NORDLYNX,
+			technologies: []config.Technology{config.Technology_NORDLYNX, config.Technology_NORDLYNX},
+			expected:     config.Technologies{config.Technology_NORDLYNX},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "allow all",
+			current:      config.Technologies{config.Technology_NORDLYNX},
+			technology:   config.Technology_NORDLYNX,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already set",
+			current:      config.Technologies{config.Technology_OPENVPN, config.Technology_NORDLYNX},
+			technology:   config.Technology_NORDLYNX,
+			technologies: []config.Technology{config.Technology_NORDLYNX, config.Technology_OPENVPN},
+			expected:     config.Technologies{config.Technology_OPENVPN, config.Technology_NORDLYNX},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "current technology excluded",
+			technology:   config.Technology_OPENVPN,
+			technologies: []config.Technology{config.Technology_NORDLYNX},
+			expectedCode: internal.CodeTechnologyNotAllowed,
+		},
+		{
+			name:         "unknown technology",
+			technology:   config.Technology_NORDLYNX,
+			technologies: []config.Technology{config.Technology_UNKNOWN_TECHNOLOGY},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "config failure",
+			technology:   config.Technology_NORDLYNX,
+			technologies: []config.Technology{config.Technology_NORDLYNX},
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Technology = test.technology
+			cm.Cfg.AllowedTechnologies = test.current
+			cm.SaveErr = test.saveErr
+			rpc := RPC{cm: cm}
+
+			resp, err := rpc.SetAllowedTechnologies(context.Background(), &pb.SetAllowedTechnologiesRequest{
+				Technologies: test.technologies,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.AllowedTechnologies)
+		})
+	}
+}
+
+func TestSetTechnology_NotAllowed(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Technology = config.Technology_NORDLYNX
+	cm.Cfg.AllowedTechnologies = config.Technologies{config.Technology_NORDLYNX}
+	rpc := RPC{cm: cm}
+
+	resp, err := rpc.SetTechnology(context.Background(), &pb.SetTechnologyRequest{
+		Technology: config.Technology_OPENVPN,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeTechnologyNotAllowed, resp.Type)
+	assert.Equal(t, config.Technology_NORDLYNX, cm.Cfg.Technology)
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"log"
 	"net/netip"
+	"strings"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/events"
 	"github.com/NordSecurity/nordvpn-linux/internal"
@@ -23,6 +25,15 @@ func containsPrivateNetwork(subnets []string) bool {
 	return false
 }
 
+// isValidAllowlistDomain returns true if the domain is a DNS name, addresses
+// have to be allowlisted as subnets
+func isValidAllowlistDomain(domain string) bool {
+	if _, err := netip.ParseAddr(domain); err == nil {
+		return false
+	}
+	return domain == strings.ToLower(domain) && dns.IsValidDomain(domain)
+}
+
 func (r *RPC) SetAllowlist(ctx context.Context, in *pb.SetAllowlistRequest) (*pb.Payload, error) {
 	var cfg config.Config
 	err := r.cm.Load(&cfg)
@@ -30,11 +41,13 @@ func (r *RPC) SetAllowlist(ctx context.Context, in *pb.SetAllowlistRequest) (*pb
 		log.Println(internal.ErrorPrefix, err)
 	}
 
-	allowlist := config.NewAllowlist(
-		in.GetAllowlist().GetPorts().GetUdp(),
-		in.GetAllowlist().GetPorts().GetTcp(),
-		in.GetAllowlist().GetSubnets(),
-	)
+	for _, domain := range in.GetAllowlist().GetDomains() {
+		if !isValidAllowlistDomain(domain) {
+			return &pb.Payload{Type: internal.CodeFormatError, Data: []string{domain}}, nil
+		}
+	}
+
+	allowlist := allowlistFromPb(in.GetAllowlist())
 
 	if cfg.LanDiscovery &&
 		containsPrivateNetwork(in.GetAllowlist().GetSubnets()) {
@@ -66,6 +79,7 @@ func (r *RPC) SetAllowlist(ctx context.Context, in *pb.SetAllowlistRequest) (*pb
 			Type: internal.CodeConfigError,
 		}, nil
 	}
+	r.syncBootKillSwitch()
 	r.events.Settings.Allowlist.Publish(events.DataAllowlist{
 		TCPPorts: in.GetAllowlist().GetPorts().GetTcp(),
 		UDPPorts: in.GetAllowlist().GetPorts().GetUdp(),
@@ -0,0 +1,70 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/events/subs"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetAllowlist_Domains(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		domains      []string
+		expected     config.Domains
+		expectedCode int64
+	}{
+		{
+			name:         "domains allowlisted",
+			domains:      []string{"intranet.corp.com", "git.corp.com"},
+			expected:     config.Domains{"intranet.corp.com": true, "git.corp.com": true},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "invalid domain",
+			domains:      []string{"bad_domain.corp.com"},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "address instead of domain",
+			domains:      []string{"198.51.100.2"},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "not normalized domain",
+			domains:      []string{"Intranet.Corp.com"},
+			expectedCode: internal.CodeFormatError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			netw := &testnetworker.Mock{}
+			rpc := RPC{
+				cm:     cm,
+				netw:   netw,
+				events: &Events{Settings: &SettingsEvents{Allowlist: &subs.Subject[events.DataAllowlist]{}}},
+			}
+
+			resp, err := rpc.SetAllowlist(context.Background(), &pb.SetAllowlistRequest{
+				Allowlist: &pb.Allowlist{Domains: test.domains},
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.AutoConnectData.Allowlist.Domains)
+			assert.Equal(t, test.expected, netw.Allowlist.Domains)
+		})
+	}
+}
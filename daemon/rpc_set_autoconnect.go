@@ -56,11 +56,7 @@ func (r *RPC) SetAutoConnect(ctx context.Context, in *pb.SetAutoconnectRequest)
 				ThreatProtectionLite: cfg.AutoConnectData.ThreatProtectionLite,
 				Obfuscate:            in.GetObfuscate(),
 				DNS:                  cfg.AutoConnectData.DNS,
-				Allowlist: config.NewAllowlist(
-					in.GetAllowlist().GetPorts().GetTcp(),
-					in.GetAllowlist().GetPorts().GetUdp(),
-					in.GetAllowlist().GetSubnets(),
-				),
+				Allowlist:            allowlistFromPb(in.GetAllowlist()),
 			}
 			return c
 		}); err != nil {
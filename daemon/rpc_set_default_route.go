@@ -0,0 +1,61 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetDefaultRouteMode controls how a conflicting default route is handled on connect
+func (r *RPC) SetDefaultRouteMode(ctx context.Context, in *pb.SetDefaultRouteModeRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	mode := defaultRouteModeToConfig(in.GetMode())
+	if cfg.DefaultRouteMode == mode {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.DefaultRouteMode = mode
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	r.netw.SetDefaultRouteMode(mode)
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+func defaultRouteModeToConfig(mode pb.DefaultRouteMode) config.DefaultRouteMode {
+	switch mode {
+	case pb.DefaultRouteMode_COEXIST:
+		return config.DefaultRouteCoexist
+	case pb.DefaultRouteMode_REFUSE:
+		return config.DefaultRouteRefuse
+	case pb.DefaultRouteMode_REPLACE:
+		fallthrough
+	default:
+		return config.DefaultRouteReplace
+	}
+}
+
+func defaultRouteModeToPb(mode config.DefaultRouteMode) pb.DefaultRouteMode {
+	switch mode {
+	case config.DefaultRouteCoexist:
+		return pb.DefaultRouteMode_COEXIST
+	case config.DefaultRouteRefuse:
+		return pb.DefaultRouteMode_REFUSE
+	case config.DefaultRouteReplace:
+		fallthrough
+	default:
+		return pb.DefaultRouteMode_REPLACE
+	}
+}
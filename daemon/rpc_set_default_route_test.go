@@ -0,0 +1,77 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetDefaultRouteMode(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		currentMode  config.DefaultRouteMode
+		mode         pb.DefaultRouteMode
+		saveErr      error
+		expectedMode config.DefaultRouteMode
+		expectedCode int64
+	}{
+		{
+			name:         "set coexist",
+			mode:         pb.DefaultRouteMode_COEXIST,
+			expectedMode: config.DefaultRouteCoexist,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "set replace",
+			currentMode:  config.DefaultRouteRefuse,
+			mode:         pb.DefaultRouteMode_REPLACE,
+			expectedMode: config.DefaultRouteReplace,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already set",
+			currentMode:  config.DefaultRouteRefuse,
+			mode:         pb.DefaultRouteMode_REFUSE,
+			expectedMode: config.DefaultRouteRefuse,
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "config failure",
+			mode:         pb.DefaultRouteMode_REFUSE,
+			saveErr:      mock.ErrOnPurpose,
+			expectedMode: config.DefaultRouteReplace,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.DefaultRouteMode = test.currentMode
+			cm.SaveErr = test.saveErr
+			netw := networker.Mock{DefaultRouteMode: test.currentMode}
+
+			rpc := RPC{
+				cm:   cm,
+				netw: &netw,
+			}
+			resp, err := rpc.SetDefaultRouteMode(context.Background(), &pb.SetDefaultRouteModeRequest{
+				Mode: test.mode,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedMode, cm.Cfg.DefaultRouteMode)
+			assert.Equal(t, test.expectedMode, netw.DefaultRouteMode)
+		})
+	}
+}
@@ -20,7 +20,7 @@ func (r *RPC) SetDefaults(ctx context.Context, in *pb.Empty) (*pb.Payload, error
 		log.Println(internal.ErrorPrefix, "disabling fileshare: ", err)
 	}
 
-	if err := r.netw.Stop(); err != nil {
+	if err := r.stopConnection(disconnectReasonDefaults); err != nil {
 		log.Println(internal.ErrorPrefix, err)
 		return &pb.Payload{Type: internal.CodeFailure}, nil
 	}
@@ -49,7 +49,41 @@ func (r *RPC) SetDefaults(ctx context.Context, in *pb.Empty) (*pb.Payload, error
 		}, nil
 	}
 	r.netw.SetVPN(v)
+	SetAppData(r.dm, cfg.Technology, cfg.VirtualLocation.Get(), r.dm.GetServersData().Servers)
+	r.netw.SetDefaultRouteMode(cfg.DefaultRouteMode)
+	r.netw.SetKillSwitchLog(cfg.KillSwitchLog)
+	r.logLevel.SetLevel(LoggingLevel(cfg.LogLevel))
+	if err := r.netw.SetKillSwitchLAN(cfg.KillSwitchLAN); err != nil {
+		log.Println(internal.WarningPrefix, "resetting kill switch LAN access:", err)
+	}
+	if err := r.netw.SetMulticastDiscovery(cfg.MulticastDiscovery); err != nil {
+		log.Println(internal.WarningPrefix, "resetting multicast discovery:", err)
+	}
+	r.netw.SetSubnetOverlapMode(cfg.SubnetOverlapMode)
+	r.netw.SetIPv6Mode(cfg.GetIPv6Mode())
+	if err := r.netw.SetDNSIPv6(cfg.DNSIPv6.Get()); err != nil {
+		log.Println(internal.WarningPrefix, "reconfiguring DNS:", err)
+	}
+	if err := r.netw.SetDNSSearchDomains(cfg.DNSSearchDomains); err != nil {
+		log.Println(internal.WarningPrefix, "reconfiguring DNS:", err)
+	}
+	if err := r.netw.SetDNSRoutes(cfg.DNSRoutes); err != nil {
+		log.Println(internal.WarningPrefix, "reconfiguring DNS:", err)
+	}
+	if err := r.netw.SetDNSFilter(cfg.DNSFilter); err != nil {
+		log.Println(internal.WarningPrefix, "reconfiguring DNS:", err)
+	}
+	if err := r.netw.SetMTU(cfg.MTU); err != nil {
+		log.Println(internal.WarningPrefix, "restoring MTU of the tunnel:", err)
+	}
+	if err := r.netw.SetFirewallTemplates(cfg.FirewallTemplates); err != nil {
+		log.Println(internal.WarningPrefix, "removing firewall templates:", err)
+	}
+	if err := r.netw.SetSplitTunnel(cfg.SplitTunnel); err != nil {
+		log.Println(internal.WarningPrefix, "removing split tunnel:", err)
+	}
 
+	r.syncBootKillSwitch()
 	r.events.Settings.Defaults.Publish(nil)
 	r.events.Settings.Publish(cfg)
 	if err := r.ncClient.Stop(); err != nil {
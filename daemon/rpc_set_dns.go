@@ -3,9 +3,9 @@ package daemon
 import (
 	"context"
 	"log"
-	"net"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/events"
 	"github.com/NordSecurity/nordvpn-linux/internal"
@@ -35,7 +35,7 @@ func (r *RPC) SetDNS(ctx context.Context, in *pb.SetDNSRequest) (*pb.SetDNSRespo
 	}
 
 	for _, address := range nameservers {
-		if parsedAddress := net.ParseIP(address); parsedAddress == nil {
+		if !dns.IsValidNameserver(address) {
 			return &pb.SetDNSResponse{
 				Response: &pb.SetDNSResponse_SetDnsStatus{SetDnsStatus: pb.SetDNSStatus_INVALID_DNS_ADDRESS},
 			}, nil
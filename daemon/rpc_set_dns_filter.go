@@ -0,0 +1,53 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"golang.org/x/exp/slices"
+)
+
+// SetDNSFilter configures the record types answered locally with an empty answer
+// on connect instead of being resolved
+func (r *RPC) SetDNSFilter(ctx context.Context, in *pb.SetDNSFilterRequest) (*pb.Payload, error) {
+	var types []string
+	for _, qtype := range in.GetTypes() {
+		qtype = strings.ToUpper(strings.TrimSpace(qtype))
+		if _, err := dns.NewQueryTypeFilter([]string{qtype}); err != nil {
+			return &pb.Payload{Type: internal.CodeFormatError, Data: []string{qtype}}, nil
+		}
+		if !slices.Contains(types, qtype) {
+			types = append(types, qtype)
+		}
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	if slices.Equal(cfg.DNSFilter, types) {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.DNSFilter = types
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if err := r.netw.SetDNSFilter(types); err != nil {
+		log.Println(internal.ErrorPrefix, "reconfiguring DNS:", err)
+		return &pb.Payload{Type: internal.CodeFailure}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
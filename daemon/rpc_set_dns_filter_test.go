@@ -0,0 +1,100 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetDNSFilter(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      []string
+		types        []string
+		saveErr      error
+		netw         networker.Networker
+		expected     []string
+		expectedCode int64
+	}{
+		{
+			name:         "set",
+			types:        []string{"AAAA", "HTTPS"},
+			netw:         &testnetworker.Mock{},
+			expected:     []string{"AAAA", "HTTPS"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "normalized and deduplicated",
+			types:        []string{"aaaa", "AAAA", "type65"},
+			netw:         &testnetworker.Mock{},
+			expected:     []string{"AAAA", "TYPE65"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "removed",
+			current:      []string{"AAAA"},
+			netw:         &testnetworker.Mock{},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already set",
+			current:      []string{"AAAA"},
+			types:        []string{"AAAA"},
+			netw:         &testnetworker.Mock{},
+			expected:     []string{"AAAA"},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "unknown type",
+			current:      []string{"AAAA"},
+			types:        []string{"HTTPS", "BOGUS"},
+			netw:         &testnetworker.Mock{},
+			expected:     []string{"AAAA"},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "config failure",
+			types:        []string{"AAAA"},
+			saveErr:      mock.ErrOnPurpose,
+			netw:         &testnetworker.Mock{},
+			expectedCode: internal.CodeConfigError,
+		},
+		{
+			name:         "networker failure",
+			types:        []string{"AAAA"},
+			netw:         testnetworker.Failing{},
+			expected:     []string{"AAAA"},
+			expectedCode: internal.CodeFailure,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.DNSFilter = test.current
+			cm.SaveErr = test.saveErr
+
+			rpc := RPC{
+				cm:   cm,
+				netw: test.netw,
+			}
+			resp, err := rpc.SetDNSFilter(context.Background(), &pb.SetDNSFilterRequest{Types: test.types})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.DNSFilter)
+			if netw, ok := test.netw.(*testnetworker.Mock); ok && test.expectedCode == internal.CodeSuccess {
+				assert.Equal(t, test.expected, netw.DNSFilter)
+			}
+		})
+	}
+}
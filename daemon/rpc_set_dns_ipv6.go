@@ -0,0 +1,37 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetDNSIPv6 controls whether IPv6 nameservers are configured on connect
+func (r *RPC) SetDNSIPv6(ctx context.Context, in *pb.SetGenericRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	if cfg.DNSIPv6.Get() == in.GetEnabled() {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.DNSIPv6.Set(in.GetEnabled())
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if err := r.netw.SetDNSIPv6(in.GetEnabled()); err != nil {
+		log.Println(internal.ErrorPrefix, "reconfiguring DNS:", err)
+		return &pb.Payload{Type: internal.CodeFailure}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
@@ -0,0 +1,91 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetDNSIPv6(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      bool
+		enabled      bool
+		saveErr      error
+		netw         networker.Networker
+		expected     bool
+		expectedCode int64
+	}{
+		{
+			name:         "disable",
+			current:      true,
+			enabled:      false,
+			netw:         &testnetworker.Mock{},
+			expected:     false,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "enable",
+			current:      false,
+			enabled:      true,
+			netw:         &testnetworker.Mock{},
+			expected:     true,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already set",
+			current:      true,
+			enabled:      true,
+			netw:         &testnetworker.Mock{},
+			expected:     true,
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "config failure",
+			current:      true,
+			enabled:      false,
+			saveErr:      mock.ErrOnPurpose,
+			netw:         &testnetworker.Mock{},
+			expected:     true,
+			expectedCode: internal.CodeConfigError,
+		},
+		{
+			name:         "networker failure",
+			current:      true,
+			enabled:      false,
+			netw:         testnetworker.Failing{},
+			expected:     false,
+			expectedCode: internal.CodeFailure,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.DNSIPv6.Set(test.current)
+			cm.SaveErr = test.saveErr
+
+			rpc := RPC{
+				cm:   cm,
+				netw: test.netw,
+			}
+			resp, err := rpc.SetDNSIPv6(context.Background(), &pb.SetGenericRequest{Enabled: test.enabled})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.DNSIPv6.Get())
+			if netw, ok := test.netw.(*testnetworker.Mock); ok && test.expectedCode == internal.CodeSuccess {
+				assert.Equal(t, test.expected, netw.DNSIPv6)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetDNSMonitor controls whether DNS is watched while connected and re-applied
+// if another process overwrites it. It takes effect the next time DNS is set.
+func (r *RPC) SetDNSMonitor(ctx context.Context, in *pb.SetGenericRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	if cfg.DNSMonitor.Get() == in.GetEnabled() {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.DNSMonitor.Set(in.GetEnabled())
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
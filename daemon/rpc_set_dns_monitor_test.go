@@ -0,0 +1,65 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetDNSMonitor(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      bool
+		enabled      bool
+		saveErr      error
+		expected     bool
+		expectedCode int64
+	}{
+		{
+			name:         "enable",
+			enabled:      true,
+			expected:     true,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "disable",
+			current:      true,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already enabled",
+			current:      true,
+			enabled:      true,
+			expected:     true,
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "config failure",
+			enabled:      true,
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.DNSMonitor.Set(test.current)
+			cm.SaveErr = test.saveErr
+
+			rpc := RPC{cm: cm}
+			resp, err := rpc.SetDNSMonitor(context.Background(), &pb.SetGenericRequest{Enabled: test.enabled})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.DNSMonitor.Get())
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"net/netip"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"golang.org/x/exp/slices"
+)
+
+// SetDNSRoute adds, replaces or removes the nameserver the queries of the domain
+// are sent to while connected
+func (r *RPC) SetDNSRoute(ctx context.Context, in *pb.SetDNSRouteRequest) (*pb.Payload, error) {
+	domain := strings.ToLower(strings.TrimSuffix(in.GetRoute().GetDomain(), "."))
+	if !dns.IsValidDomain(domain) {
+		return &pb.Payload{Type: internal.CodeFormatError, Data: []string{domain}}, nil
+	}
+	// meshnet domain is served by the meshnet resolver
+	if domain == dns.MeshnetSearchDomain {
+		return &pb.Payload{Type: internal.CodeConflict, Data: []string{domain}}, nil
+	}
+
+	route := config.DNSRoute{Domain: domain, ViaTunnel: in.GetRoute().GetViaTunnel()}
+	if !in.GetRemove() {
+		addr, err := netip.ParseAddr(in.GetRoute().GetNameserver())
+		if err != nil || addr.Zone() != "" {
+			return &pb.Payload{Type: internal.CodeFormatError, Data: []string{in.GetRoute().GetNameserver()}}, nil
+		}
+		route.Nameserver = addr.String()
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	var routes config.DNSRoutes
+	if in.GetRemove() {
+		var removed bool
+		if routes, removed = cfg.DNSRoutes.Remove(domain); !removed {
+			return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+		}
+	} else {
+		if slices.Contains(cfg.DNSRoutes, route) {
+			return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+		}
+		routes = cfg.DNSRoutes.Set(route)
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.DNSRoutes = routes
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if err := r.netw.SetDNSRoutes(routes); err != nil {
+		log.Println(internal.ErrorPrefix, "reconfiguring DNS:", err)
+		return &pb.Payload{Type: internal.CodeFailure}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+func dnsRoutesToPb(routes config.DNSRoutes) []*pb.DNSRoute {
+	var ret []*pb.DNSRoute
+	for _, route := range routes {
+		ret = append(ret, &pb.DNSRoute{
+			Domain:     route.Domain,
+			Nameserver: route.Nameserver,
+			ViaTunnel:  route.ViaTunnel,
+		})
+	}
+	return ret
+}
@@ -0,0 +1,126 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetDNSRoute(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	corp := config.DNSRoute{Domain: "corp.example", Nameserver: "10.0.0.53"}
+
+	tests := []struct {
+		name         string
+		current      config.DNSRoutes
+		req          *pb.SetDNSRouteRequest
+		saveErr      error
+		netw         networker.Networker
+		expected     config.DNSRoutes
+		expectedCode int64
+	}{
+		{
+			name:         "added",
+			req:          &pb.SetDNSRouteRequest{Route: &pb.DNSRoute{Domain: "corp.example", Nameserver: "10.0.0.53"}},
+			netw:         &testnetworker.Mock{},
+			expected:     config.DNSRoutes{corp},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:    "normalized and replaced",
+			current: config.DNSRoutes{corp},
+			req: &pb.SetDNSRouteRequest{
+				Route: &pb.DNSRoute{Domain: "Corp.Example.", Nameserver: "fd00::0053", ViaTunnel: true},
+			},
+			netw:         &testnetworker.Mock{},
+			expected:     config.DNSRoutes{{Domain: "corp.example", Nameserver: "fd00::53", ViaTunnel: true}},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already set",
+			current:      config.DNSRoutes{corp},
+			req:          &pb.SetDNSRouteRequest{Route: &pb.DNSRoute{Domain: "corp.example", Nameserver: "10.0.0.53"}},
+			netw:         &testnetworker.Mock{},
+			expected:     config.DNSRoutes{corp},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "removed",
+			current:      config.DNSRoutes{corp},
+			req:          &pb.SetDNSRouteRequest{Route: &pb.DNSRoute{Domain: "corp.example"}, Remove: true},
+			netw:         &testnetworker.Mock{},
+			expected:     config.DNSRoutes{},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "removed not existing",
+			current:      config.DNSRoutes{corp},
+			req:          &pb.SetDNSRouteRequest{Route: &pb.DNSRoute{Domain: "lab.example"}, Remove: true},
+			netw:         &testnetworker.Mock{},
+			expected:     config.DNSRoutes{corp},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "invalid domain",
+			req:          &pb.SetDNSRouteRequest{Route: &pb.DNSRoute{Domain: "bad_domain", Nameserver: "10.0.0.53"}},
+			netw:         &testnetworker.Mock{},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "invalid nameserver",
+			req:          &pb.SetDNSRouteRequest{Route: &pb.DNSRoute{Domain: "corp.example", Nameserver: "ns.corp.example"}},
+			netw:         &testnetworker.Mock{},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "meshnet domain",
+			req:          &pb.SetDNSRouteRequest{Route: &pb.DNSRoute{Domain: "nord", Nameserver: "10.0.0.53"}},
+			netw:         &testnetworker.Mock{},
+			expectedCode: internal.CodeConflict,
+		},
+		{
+			name:         "config failure",
+			req:          &pb.SetDNSRouteRequest{Route: &pb.DNSRoute{Domain: "corp.example", Nameserver: "10.0.0.53"}},
+			saveErr:      mock.ErrOnPurpose,
+			netw:         &testnetworker.Mock{},
+			expectedCode: internal.CodeConfigError,
+		},
+		{
+			name:         "networker failure",
+			req:          &pb.SetDNSRouteRequest{Route: &pb.DNSRoute{Domain: "corp.example", Nameserver: "10.0.0.53"}},
+			netw:         testnetworker.Failing{},
+			expected:     config.DNSRoutes{corp},
+			expectedCode: internal.CodeFailure,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.DNSRoutes = test.current
+			cm.SaveErr = test.saveErr
+
+			rpc := RPC{
+				cm:   cm,
+				netw: test.netw,
+			}
+			resp, err := rpc.SetDNSRoute(context.Background(), test.req)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.DNSRoutes)
+			if netw, ok := test.netw.(*testnetworker.Mock); ok && test.expectedCode == internal.CodeSuccess {
+				assert.Equal(t, test.expected, netw.DNSRoutes)
+			}
+		})
+	}
+}
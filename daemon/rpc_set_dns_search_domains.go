@@ -0,0 +1,57 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"golang.org/x/exp/slices"
+)
+
+// SetDNSSearchDomains configures the domains appended to the resolver search list on connect
+func (r *RPC) SetDNSSearchDomains(ctx context.Context, in *pb.SetDNSSearchDomainsRequest) (*pb.Payload, error) {
+	var domains []string
+	for _, domain := range in.GetDomains() {
+		domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+		if !dns.IsValidSearchDomain(domain) {
+			return &pb.Payload{Type: internal.CodeFormatError, Data: []string{domain}}, nil
+		}
+		if !slices.Contains(domains, domain) {
+			domains = append(domains, domain)
+		}
+	}
+
+	// one entry of the search line is reserved for the meshnet domain
+	if len(domains) > dns.MaxSearchDomains-1 {
+		return &pb.Payload{Type: internal.CodeBadRequest}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	if slices.Equal(cfg.DNSSearchDomains, domains) {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.DNSSearchDomains = domains
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if err := r.netw.SetDNSSearchDomains(domains); err != nil {
+		log.Println(internal.ErrorPrefix, "reconfiguring DNS:", err)
+		return &pb.Payload{Type: internal.CodeFailure}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
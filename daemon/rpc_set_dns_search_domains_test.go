@@ -0,0 +1,107 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetDNSSearchDomains(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      []string
+		domains      []string
+		saveErr      error
+		netw         networker.Networker
+		expected     []string
+		expectedCode int64
+	}{
+		{
+			name:         "set",
+			domains:      []string{"corp.example.com", "example.org"},
+			netw:         &testnetworker.Mock{},
+			expected:     []string{"corp.example.com", "example.org"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "normalized and deduplicated",
+			domains:      []string{"Corp.Example.com.", "corp.example.com"},
+			netw:         &testnetworker.Mock{},
+			expected:     []string{"corp.example.com"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "removed",
+			current:      []string{"corp.example.com"},
+			netw:         &testnetworker.Mock{},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already set",
+			current:      []string{"corp.example.com"},
+			domains:      []string{"corp.example.com"},
+			netw:         &testnetworker.Mock{},
+			expected:     []string{"corp.example.com"},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "invalid domain",
+			current:      []string{"corp.example.com"},
+			domains:      []string{"example.org", "bad_domain"},
+			netw:         &testnetworker.Mock{},
+			expected:     []string{"corp.example.com"},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "too many domains",
+			domains:      []string{"a.com", "b.com", "c.com", "d.com", "e.com", "f.com"},
+			netw:         &testnetworker.Mock{},
+			expectedCode: internal.CodeBadRequest,
+		},
+		{
+			name:         "config failure",
+			domains:      []string{"corp.example.com"},
+			saveErr:      mock.ErrOnPurpose,
+			netw:         &testnetworker.Mock{},
+			expectedCode: internal.CodeConfigError,
+		},
+		{
+			name:         "networker failure",
+			domains:      []string{"corp.example.com"},
+			netw:         testnetworker.Failing{},
+			expected:     []string{"corp.example.com"},
+			expectedCode: internal.CodeFailure,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.DNSSearchDomains = test.current
+			cm.SaveErr = test.saveErr
+
+			rpc := RPC{
+				cm:   cm,
+				netw: test.netw,
+			}
+			resp, err := rpc.SetDNSSearchDomains(context.Background(),
+				&pb.SetDNSSearchDomainsRequest{Domains: test.domains})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.DNSSearchDomains)
+			if netw, ok := test.netw.(*testnetworker.Mock); ok && test.expectedCode == internal.CodeSuccess {
+				assert.Equal(t, test.expected, netw.DNSSearchDomains)
+			}
+		})
+	}
+}
@@ -73,6 +73,12 @@ func TestSetDNS_Success(t *testing.T) {
 			expectedDNS:         currentDNSMock,
 			expectedDNSInConfig: currentDNSMock,
 		},
+		{
+			name:                "set encrypted DNS",
+			requestedDNS:        config.DNS{"9.9.9.9", "https://dns.example/dns-query", "tls://1.1.1.1"},
+			expectedDNS:         config.DNS{"9.9.9.9", "https://dns.example/dns-query", "tls://1.1.1.1"},
+			expectedDNSInConfig: config.DNS{"9.9.9.9", "https://dns.example/dns-query", "tls://1.1.1.1"},
+		},
 		{
 			name:                "set new DNS ipv6",
 			requestedDNS:        dnsV6Mock,
@@ -218,6 +224,13 @@ func TestSetDNS_Errors(t *testing.T) {
 				Response: &pb.SetDNSResponse_SetDnsStatus{SetDnsStatus: pb.SetDNSStatus_INVALID_DNS_ADDRESS},
 			},
 		},
+		{
+			name:         "dns-over-tls hostname",
+			requestedDNS: config.DNS{"tls://dns.example"},
+			expectedResponse: &pb.SetDNSResponse{
+				Response: &pb.SetDNSResponse_SetDnsStatus{SetDnsStatus: pb.SetDNSStatus_INVALID_DNS_ADDRESS},
+			},
+		},
 		{
 			name:         "network error",
 			requestedDNS: dnsMock,
@@ -0,0 +1,85 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetFirewallBackend selects how the firewall rules are applied. The backend is
+// chosen on the daemon start, so the change takes effect after a restart.
+// nftables is refused while the features relying on iptables are enabled.
+func (r *RPC) SetFirewallBackend(ctx context.Context, in *pb.SetFirewallBackendRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	backend := firewallBackendToConfig(in.GetBackend())
+	if cfg.FirewallBackend == backend {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if backend == config.FirewallBackendNftables {
+		if features := IPTablesFeatures(cfg); len(features) > 0 {
+			return &pb.Payload{Type: internal.CodeConflict, Data: features}, nil
+		}
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.FirewallBackend = backend
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+// IPTablesFeatures returns the enabled features whose rules are applied only
+// with iptables, so they cannot be used with the nftables backend
+func IPTablesFeatures(cfg config.Config) []string {
+	var features []string
+	allowlist := cfg.AutoConnectData.Allowlist
+	if len(allowlist.Subnets) > 0 || len(allowlist.Ports.TCP) > 0 || len(allowlist.Ports.UDP) > 0 {
+		features = append(features, "allowlist")
+	}
+	if cfg.Mesh {
+		features = append(features, "meshnet")
+	}
+	if len(cfg.SplitTunnel.Apps) > 0 {
+		features = append(features, "split tunnel")
+	}
+	if cfg.OnDemand.Enabled {
+		features = append(features, "on-demand")
+	}
+	if cfg.FirewallTemplates != (config.FirewallTemplates{}) {
+		features = append(features, "firewall templates")
+	}
+	return features
+}
+
+func firewallBackendToConfig(backend pb.FirewallBackend) config.FirewallBackend {
+	switch backend {
+	case pb.FirewallBackend_NFTABLES:
+		return config.FirewallBackendNftables
+	case pb.FirewallBackend_IPTABLES:
+		fallthrough
+	default:
+		return config.FirewallBackendIPTables
+	}
+}
+
+func firewallBackendToPb(backend config.FirewallBackend) pb.FirewallBackend {
+	switch backend {
+	case config.FirewallBackendNftables:
+		return pb.FirewallBackend_NFTABLES
+	case config.FirewallBackendIPTables:
+		fallthrough
+	default:
+		return pb.FirewallBackend_IPTABLES
+	}
+}
@@ -0,0 +1,100 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetFirewallBackend(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name            string
+		currentBackend  config.FirewallBackend
+		backend         pb.FirewallBackend
+		cfg             func(*config.Config)
+		saveErr         error
+		expectedBackend config.FirewallBackend
+		expectedCode    int64
+		expectedData    []string
+	}{
+		{
+			name:            "set nftables",
+			backend:         pb.FirewallBackend_NFTABLES,
+			expectedBackend: config.FirewallBackendNftables,
+			expectedCode:    internal.CodeSuccess,
+		},
+		{
+			name:            "set iptables",
+			currentBackend:  config.FirewallBackendNftables,
+			backend:         pb.FirewallBackend_IPTABLES,
+			expectedBackend: config.FirewallBackendIPTables,
+			expectedCode:    internal.CodeSuccess,
+		},
+		{
+			name:            "already set",
+			currentBackend:  config.FirewallBackendNftables,
+			backend:         pb.FirewallBackend_NFTABLES,
+			expectedBackend: config.FirewallBackendNftables,
+			expectedCode:    internal.CodeNothingToDo,
+		},
+		{
+			name:    "nftables with features using iptables",
+			backend: pb.FirewallBackend_NFTABLES,
+			cfg: func(c *config.Config) {
+				c.AutoConnectData.Allowlist = config.NewAllowlist(nil, nil, []string{"10.0.0.0/8"})
+				c.Mesh = true
+				c.FirewallTemplates.Persistent = "/etc/nordvpn/rules"
+			},
+			expectedBackend: config.FirewallBackendIPTables,
+			expectedCode:    internal.CodeConflict,
+			expectedData:    []string{"allowlist", "meshnet", "firewall templates"},
+		},
+		{
+			name:           "iptables with features using iptables",
+			currentBackend: config.FirewallBackendNftables,
+			backend:        pb.FirewallBackend_IPTABLES,
+			cfg: func(c *config.Config) {
+				c.SplitTunnel.Apps = []string{"firefox"}
+				c.OnDemand.Enabled = true
+			},
+			expectedBackend: config.FirewallBackendIPTables,
+			expectedCode:    internal.CodeSuccess,
+		},
+		{
+			name:            "config failure",
+			backend:         pb.FirewallBackend_NFTABLES,
+			saveErr:         mock.ErrOnPurpose,
+			expectedBackend: config.FirewallBackendIPTables,
+			expectedCode:    internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.FirewallBackend = test.currentBackend
+			if test.cfg != nil {
+				test.cfg(cm.Cfg)
+			}
+			cm.SaveErr = test.saveErr
+
+			rpc := RPC{cm: cm}
+			resp, err := rpc.SetFirewallBackend(context.Background(), &pb.SetFirewallBackendRequest{
+				Backend: test.backend,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedData, resp.Data)
+			assert.Equal(t, test.expectedBackend, cm.Cfg.FirewallBackend)
+		})
+	}
+}
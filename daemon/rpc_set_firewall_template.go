@@ -0,0 +1,68 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"path/filepath"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetFirewallTemplate sets the user supplied firewall rules applied at the given stage
+func (r *RPC) SetFirewallTemplate(ctx context.Context, in *pb.SetFirewallTemplateRequest) (*pb.Payload, error) {
+	path := in.GetPath()
+	if path != "" && !filepath.IsAbs(path) {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	paths := cfg.FirewallTemplates
+	switch in.GetStage() {
+	case pb.FirewallTemplateStage_TEMPLATE_PRE_CONNECT:
+		paths.PreConnect = path
+	case pb.FirewallTemplateStage_TEMPLATE_POST_DISCONNECT:
+		paths.PostDisconnect = path
+	case pb.FirewallTemplateStage_TEMPLATE_PERSISTENT:
+		paths.Persistent = path
+	default:
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	// template is re-applied even if the path is the same, so that the changes
+	// of the file contents can be picked up
+	if path == "" && paths == cfg.FirewallTemplates {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.netw.SetFirewallTemplates(paths); err != nil {
+		log.Println(internal.ErrorPrefix, "setting firewall template:", err)
+		return &pb.Payload{Type: internal.CodeFailure, Data: []string{err.Error()}}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.FirewallTemplates = paths
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		if err := r.netw.SetFirewallTemplates(cfg.FirewallTemplates); err != nil {
+			log.Println(internal.ErrorPrefix, "restoring firewall templates:", err)
+		}
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+func firewallTemplatesToPb(paths config.FirewallTemplates) *pb.FirewallTemplates {
+	return &pb.FirewallTemplates{
+		Persistent:     paths.Persistent,
+		PreConnect:     paths.PreConnect,
+		PostDisconnect: paths.PostDisconnect,
+	}
+}
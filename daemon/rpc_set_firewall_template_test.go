@@ -0,0 +1,106 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetFirewallTemplate(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      config.FirewallTemplates
+		request      *pb.SetFirewallTemplateRequest
+		applyErr     error
+		saveErr      error
+		expected     config.FirewallTemplates
+		expectedCode int64
+	}{
+		{
+			name: "set pre-connect",
+			request: &pb.SetFirewallTemplateRequest{
+				Stage: pb.FirewallTemplateStage_TEMPLATE_PRE_CONNECT,
+				Path:  "/etc/nordvpn/pre-connect.rules",
+			},
+			expected:     config.FirewallTemplates{PreConnect: "/etc/nordvpn/pre-connect.rules"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:    "remove persistent",
+			current: config.FirewallTemplates{Persistent: "/etc/nordvpn/persistent.rules"},
+			request: &pb.SetFirewallTemplateRequest{
+				Stage: pb.FirewallTemplateStage_TEMPLATE_PERSISTENT,
+			},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name: "already removed",
+			request: &pb.SetFirewallTemplateRequest{
+				Stage: pb.FirewallTemplateStage_TEMPLATE_POST_DISCONNECT,
+			},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name: "relative path",
+			request: &pb.SetFirewallTemplateRequest{
+				Stage: pb.FirewallTemplateStage_TEMPLATE_POST_DISCONNECT,
+				Path:  "post-disconnect.rules",
+			},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name: "invalid template",
+			request: &pb.SetFirewallTemplateRequest{
+				Stage: pb.FirewallTemplateStage_TEMPLATE_POST_DISCONNECT,
+				Path:  "/etc/nordvpn/post-disconnect.rules",
+			},
+			applyErr:     mock.ErrOnPurpose,
+			expectedCode: internal.CodeFailure,
+		},
+		{
+			name: "config failure",
+			request: &pb.SetFirewallTemplateRequest{
+				Stage: pb.FirewallTemplateStage_TEMPLATE_POST_DISCONNECT,
+				Path:  "/etc/nordvpn/post-disconnect.rules",
+			},
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.FirewallTemplates = test.current
+			cm.SaveErr = test.saveErr
+			netw := networker.Mock{
+				FirewallTemplates:       test.current,
+				SetFirewallTemplatesErr: test.applyErr,
+			}
+
+			rpc := RPC{
+				cm:   cm,
+				netw: &netw,
+			}
+			resp, err := rpc.SetFirewallTemplate(context.Background(), test.request)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			if test.expectedCode == internal.CodeSuccess {
+				assert.Equal(t, test.expected, cm.Cfg.FirewallTemplates)
+			} else {
+				assert.Equal(t, test.current, cm.Cfg.FirewallTemplates)
+			}
+			assert.Equal(t, cm.Cfg.FirewallTemplates, netw.FirewallTemplates)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetIdleDisconnect controls whether the idle connection is torn down automatically
+func (r *RPC) SetIdleDisconnect(ctx context.Context, in *pb.SetIdleDisconnectRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	idle := config.IdleDisconnect{
+		Timeout:    time.Duration(in.GetTimeout()) * time.Second,
+		KillSwitch: in.GetKillSwitch(),
+	}
+	if idle.Timeout == 0 {
+		idle.KillSwitch = false
+	} else if idle.Timeout < MinIdleDisconnectTimeout {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	if idle.KillSwitch && !cfg.Firewall {
+		return &pb.Payload{Type: internal.CodeDependencyError}, nil
+	}
+
+	if cfg.IdleDisconnect == idle {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.IdleDisconnect = idle
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
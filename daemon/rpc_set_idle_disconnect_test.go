@@ -0,0 +1,88 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetIdleDisconnect(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      config.IdleDisconnect
+		firewall     bool
+		request      *pb.SetIdleDisconnectRequest
+		saveErr      error
+		expected     config.IdleDisconnect
+		expectedCode int64
+	}{
+		{
+			name:         "enable",
+			request:      &pb.SetIdleDisconnectRequest{Timeout: 1800},
+			expected:     config.IdleDisconnect{Timeout: 30 * time.Minute},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "enable with kill switch",
+			firewall:     true,
+			request:      &pb.SetIdleDisconnectRequest{Timeout: 1800, KillSwitch: true},
+			expected:     config.IdleDisconnect{Timeout: 30 * time.Minute, KillSwitch: true},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "kill switch without firewall",
+			request:      &pb.SetIdleDisconnectRequest{Timeout: 1800, KillSwitch: true},
+			expectedCode: internal.CodeDependencyError,
+		},
+		{
+			name:         "disable",
+			current:      config.IdleDisconnect{Timeout: 30 * time.Minute, KillSwitch: true},
+			request:      &pb.SetIdleDisconnectRequest{KillSwitch: true},
+			expected:     config.IdleDisconnect{},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already set",
+			current:      config.IdleDisconnect{Timeout: 30 * time.Minute},
+			request:      &pb.SetIdleDisconnectRequest{Timeout: 1800},
+			expected:     config.IdleDisconnect{Timeout: 30 * time.Minute},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "timeout too short",
+			request:      &pb.SetIdleDisconnectRequest{Timeout: 10},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "config failure",
+			request:      &pb.SetIdleDisconnectRequest{Timeout: 1800},
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.IdleDisconnect = test.current
+			cm.Cfg.Firewall = test.firewall
+			cm.SaveErr = test.saveErr
+
+			rpc := RPC{cm: cm}
+			resp, err := rpc.SetIdleDisconnect(context.Background(), test.request)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.IdleDisconnect)
+		})
+	}
+}
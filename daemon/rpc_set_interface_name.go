@@ -0,0 +1,51 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// interfaceNamePattern matches the names accepted by the kernel, which limits
+// them to 15 characters
+var interfaceNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_.-]{1,15}$`)
+
+// SetInterfaceName sets the name of the tunnel interface used by the next
+// connection, empty name restores the default names
+func (r *RPC) SetInterfaceName(ctx context.Context, in *pb.SetInterfaceNameRequest) (*pb.Payload, error) {
+	name := in.GetName()
+	if name != "" && !isValidInterfaceName(name) {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	if cfg.InterfaceName == name {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.InterfaceName = name
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{
+		Type: internal.CodeSuccess,
+		Data: []string{strconv.FormatBool(r.netw.IsVPNActive())},
+	}, nil
+}
+
+func isValidInterfaceName(name string) bool {
+	return name != "." && name != ".." && interfaceNamePattern.MatchString(name)
+}
@@ -0,0 +1,97 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetInterfaceName(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      string
+		ifaceName    string
+		connected    bool
+		saveErr      error
+		expected     string
+		expectedCode int64
+		expectedData []string
+	}{
+		{
+			name:         "set name",
+			ifaceName:    "corpvpn0",
+			expected:     "corpvpn0",
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"false"},
+		},
+		{
+			name:         "set name while connected",
+			ifaceName:    "corpvpn0",
+			connected:    true,
+			expected:     "corpvpn0",
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"true"},
+		},
+		{
+			name:         "restore default",
+			current:      "corpvpn0",
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"false"},
+		},
+		{
+			name:         "already set",
+			current:      "corpvpn0",
+			ifaceName:    "corpvpn0",
+			expected:     "corpvpn0",
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "too long",
+			ifaceName:    "corporatevpn0123",
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "invalid characters",
+			ifaceName:    "corp/vpn",
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "dot",
+			ifaceName:    "..",
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "config failure",
+			ifaceName:    "corpvpn0",
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.InterfaceName = test.current
+			cm.SaveErr = test.saveErr
+
+			rpc := RPC{
+				cm:   cm,
+				netw: &testnetworker.Mock{VpnActive: test.connected},
+			}
+			resp, err := rpc.SetInterfaceName(context.Background(), &pb.SetInterfaceNameRequest{Name: test.ifaceName})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedData, resp.Data)
+			assert.Equal(t, test.expected, cm.Cfg.InterfaceName)
+		})
+	}
+}
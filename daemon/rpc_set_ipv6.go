@@ -9,27 +9,69 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/internal"
 )
 
-// SetIpv6 controls whether ipv6 usage should be allowed
+// SetIpv6 controls whether ipv6 usage should be allowed. Enabling it sets the
+// tunnel mode and disabling it sets the block mode.
 func (r *RPC) SetIpv6(ctx context.Context, in *pb.SetGenericRequest) (*pb.Payload, error) {
+	mode := config.IPv6Block
+	if in.GetEnabled() {
+		mode = config.IPv6Tunnel
+	}
+	return r.setIPv6Mode(mode), nil
+}
+
+// SetIPv6Mode controls how the IPv6 traffic is handled while connected
+func (r *RPC) SetIPv6Mode(ctx context.Context, in *pb.SetIPv6ModeRequest) (*pb.Payload, error) {
+	return r.setIPv6Mode(ipv6ModeToConfig(in.GetMode())), nil
+}
+
+func (r *RPC) setIPv6Mode(mode config.IPv6Mode) *pb.Payload {
 	var cfg config.Config
-	err := r.cm.Load(&cfg)
-	if err != nil {
+	if err := r.cm.Load(&cfg); err != nil {
 		log.Println(internal.ErrorPrefix, err)
 	}
 
-	if cfg.IPv6 == in.Enabled {
-		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	if cfg.GetIPv6Mode() == mode {
+		return &pb.Payload{Type: internal.CodeNothingToDo}
 	}
 
 	if err := r.cm.SaveWith(func(c config.Config) config.Config {
-		c.IPv6 = in.Enabled
+		c.IPv6Mode = mode
+		// kept for the older versions reading the config
+		c.IPv6 = mode == config.IPv6Tunnel
 		return c
 	}); err != nil {
 		log.Println(internal.ErrorPrefix, err)
-		return &pb.Payload{Type: internal.CodeConfigError}, nil
+		return &pb.Payload{Type: internal.CodeConfigError}
 	}
 
-	r.events.Settings.Ipv6.Publish(in.GetEnabled())
+	r.netw.SetIPv6Mode(mode)
+	r.events.Settings.Ipv6.Publish(mode == config.IPv6Tunnel)
 
-	return &pb.Payload{Type: internal.CodeSuccess}, nil
+	return &pb.Payload{Type: internal.CodeSuccess}
+}
+
+func ipv6ModeToConfig(mode pb.IPv6Mode) config.IPv6Mode {
+	switch mode {
+	case pb.IPv6Mode_IPV6_MODE_TUNNEL:
+		return config.IPv6Tunnel
+	case pb.IPv6Mode_IPV6_MODE_NATIVE:
+		return config.IPv6Native
+	case pb.IPv6Mode_IPV6_MODE_BLOCK:
+		fallthrough
+	default:
+		return config.IPv6Block
+	}
+}
+
+func ipv6ModeToPb(mode config.IPv6Mode) pb.IPv6Mode {
+	switch mode {
+	case config.IPv6Tunnel:
+		return pb.IPv6Mode_IPV6_MODE_TUNNEL
+	case config.IPv6Native:
+		return pb.IPv6Mode_IPV6_MODE_NATIVE
+	case config.IPv6Block:
+		fallthrough
+	default:
+		return pb.IPv6Mode_IPV6_MODE_BLOCK
+	}
 }
@@ -0,0 +1,132 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/events/subs"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetIPv6Mode(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		currentMode  config.IPv6Mode
+		legacyIPv6   bool
+		mode         pb.IPv6Mode
+		saveErr      error
+		expectedMode config.IPv6Mode
+		expectedCode int64
+	}{
+		{
+			name:         "set tunnel",
+			mode:         pb.IPv6Mode_IPV6_MODE_TUNNEL,
+			expectedMode: config.IPv6Tunnel,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "set native",
+			currentMode:  config.IPv6Tunnel,
+			mode:         pb.IPv6Mode_IPV6_MODE_NATIVE,
+			expectedMode: config.IPv6Native,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "set block",
+			currentMode:  config.IPv6Native,
+			mode:         pb.IPv6Mode_IPV6_MODE_BLOCK,
+			expectedMode: config.IPv6Block,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "block over legacy enabled ipv6",
+			legacyIPv6:   true,
+			mode:         pb.IPv6Mode_IPV6_MODE_BLOCK,
+			expectedMode: config.IPv6Block,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already set",
+			currentMode:  config.IPv6Native,
+			mode:         pb.IPv6Mode_IPV6_MODE_NATIVE,
+			expectedMode: config.IPv6Native,
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "legacy enabled ipv6 is tunnel",
+			legacyIPv6:   true,
+			mode:         pb.IPv6Mode_IPV6_MODE_TUNNEL,
+			expectedMode: config.IPv6Tunnel,
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "config failure",
+			mode:         pb.IPv6Mode_IPV6_MODE_TUNNEL,
+			saveErr:      mock.ErrOnPurpose,
+			expectedMode: config.IPv6Block,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.IPv6Mode = test.currentMode
+			cm.Cfg.IPv6 = test.legacyIPv6
+			cm.SaveErr = test.saveErr
+			netw := networker.Mock{IPv6Mode: cm.Cfg.GetIPv6Mode()}
+			published := false
+			ipv6Events := &subs.Subject[bool]{}
+			ipv6Events.Subscribe(func(bool) error {
+				published = true
+				return nil
+			})
+
+			rpc := RPC{
+				cm:     cm,
+				netw:   &netw,
+				events: &Events{Settings: &SettingsEvents{Ipv6: ipv6Events}},
+			}
+			resp, err := rpc.SetIPv6Mode(context.Background(), &pb.SetIPv6ModeRequest{
+				Mode: test.mode,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedMode, cm.Cfg.GetIPv6Mode())
+			assert.Equal(t, test.expectedMode == config.IPv6Tunnel, cm.Cfg.IPv6)
+			assert.Equal(t, test.expectedMode, netw.IPv6Mode)
+			assert.Equal(t, test.expectedCode == internal.CodeSuccess, published)
+		})
+	}
+}
+
+func TestSetIpv6(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	netw := networker.Mock{}
+	rpc := RPC{
+		cm:     cm,
+		netw:   &netw,
+		events: &Events{Settings: &SettingsEvents{Ipv6: &subs.Subject[bool]{}}},
+	}
+
+	resp, err := rpc.SetIpv6(context.Background(), &pb.SetGenericRequest{Enabled: true})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.Equal(t, config.IPv6Tunnel, cm.Cfg.GetIPv6Mode())
+
+	resp, err = rpc.SetIpv6(context.Background(), &pb.SetGenericRequest{Enabled: false})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.Equal(t, config.IPv6Block, cm.Cfg.GetIPv6Mode())
+}
@@ -26,11 +26,7 @@ func (r *RPC) SetKillSwitch(ctx context.Context, in *pb.SetKillSwitchRequest) (*
 	}
 
 	if in.KillSwitch {
-		allowlist := config.NewAllowlist(
-			in.GetAllowlist().GetPorts().GetUdp(),
-			in.GetAllowlist().GetPorts().GetTcp(),
-			in.GetAllowlist().GetSubnets(),
-		)
+		allowlist := allowlistFromPb(in.GetAllowlist())
 
 		if cfg.LanDiscovery {
 			allowlist = addLANPermissions(allowlist)
@@ -60,6 +56,7 @@ func (r *RPC) SetKillSwitch(ctx context.Context, in *pb.SetKillSwitchRequest) (*
 			Type: internal.CodeConfigError,
 		}, nil
 	}
+	r.syncBootKillSwitch()
 	r.events.Settings.Killswitch.Publish(in.GetKillSwitch())
 
 	return &pb.Payload{
@@ -0,0 +1,42 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetKillSwitchLAN controls whether the LAN traffic is allowed while the kill switch is set
+func (r *RPC) SetKillSwitchLAN(ctx context.Context, in *pb.SetGenericRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	if in.GetEnabled() && !cfg.Firewall {
+		return &pb.Payload{Type: internal.CodeDependencyError}, nil
+	}
+
+	if cfg.KillSwitchLAN == in.GetEnabled() {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.netw.SetKillSwitchLAN(in.GetEnabled()); err != nil {
+		log.Println(internal.ErrorPrefix, "setting kill switch LAN access:", err)
+		return &pb.Payload{Type: internal.CodeKillSwitchError}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.KillSwitchLAN = in.GetEnabled()
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+	r.syncBootKillSwitch()
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
@@ -0,0 +1,86 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	mocknetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetKillSwitchLAN(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		firewall     bool
+		current      bool
+		allowed      bool
+		netw         networker.Networker
+		expected     bool
+		expectedCode int64
+	}{
+		{
+			name:         "allow",
+			firewall:     true,
+			allowed:      true,
+			netw:         &mocknetworker.Mock{},
+			expected:     true,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "block",
+			firewall:     true,
+			current:      true,
+			netw:         &mocknetworker.Mock{},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already allowed",
+			firewall:     true,
+			current:      true,
+			allowed:      true,
+			netw:         &mocknetworker.Mock{},
+			expected:     true,
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "firewall disabled",
+			allowed:      true,
+			netw:         &mocknetworker.Mock{},
+			expectedCode: internal.CodeDependencyError,
+		},
+		{
+			name:         "firewall failure",
+			firewall:     true,
+			allowed:      true,
+			netw:         mocknetworker.Failing{},
+			expectedCode: internal.CodeKillSwitchError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Firewall = test.firewall
+			cm.Cfg.KillSwitchLAN = test.current
+
+			rpc := RPC{
+				cm:   cm,
+				netw: test.netw,
+			}
+			resp, err := rpc.SetKillSwitchLAN(context.Background(), &pb.SetGenericRequest{
+				Enabled: test.allowed,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.KillSwitchLAN)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetKillSwitchLog controls whether the traffic dropped by the kill switch is logged
+func (r *RPC) SetKillSwitchLog(ctx context.Context, in *pb.SetGenericRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	if in.GetEnabled() && !cfg.Firewall {
+		return &pb.Payload{Type: internal.CodeDependencyError}, nil
+	}
+
+	if cfg.KillSwitchLog == in.GetEnabled() {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.KillSwitchLog = in.GetEnabled()
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	r.netw.SetKillSwitchLog(in.GetEnabled())
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
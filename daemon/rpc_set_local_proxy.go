@@ -0,0 +1,61 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"math"
+	"strconv"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/proxy"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// LocalProxyServer serves the local proxy according to the settings
+type LocalProxyServer interface {
+	Set(config.LocalProxy) error
+}
+
+// SetLocalProxy controls whether the daemon serves the local proxy forwarding
+// through the tunnel and on which port
+func (r *RPC) SetLocalProxy(ctx context.Context, in *pb.SetLocalProxyRequest) (*pb.Payload, error) {
+	if in.GetPort() > math.MaxUint16 {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	settings := config.LocalProxy{Enabled: in.GetEnabled(), Port: cfg.LocalProxy.Port}
+	if in.GetPort() != 0 {
+		settings.Port = uint16(in.GetPort())
+	}
+	if cfg.LocalProxy == settings {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.LocalProxy = settings
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if err := r.localProxy.Set(settings); err != nil {
+		log.Println(internal.ErrorPrefix, "setting local proxy:", err)
+		return &pb.Payload{Type: internal.CodeFailure, Data: []string{err.Error()}}, nil
+	}
+
+	return &pb.Payload{
+		Type: internal.CodeSuccess,
+		Data: []string{strconv.Itoa(int(proxy.Port(settings))), strconv.FormatBool(r.netw.IsVPNActive())},
+	}, nil
+}
+
+func localProxyToPb(cfg config.LocalProxy) *pb.LocalProxy {
+	return &pb.LocalProxy{Enabled: cfg.Enabled, Port: uint32(proxy.Port(cfg))}
+}
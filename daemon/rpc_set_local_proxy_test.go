@@ -0,0 +1,112 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockLocalProxyServer struct {
+	cfg config.LocalProxy
+	err error
+}
+
+func (m *mockLocalProxyServer) Set(cfg config.LocalProxy) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.cfg = cfg
+	return nil
+}
+
+func TestSetLocalProxy(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      config.LocalProxy
+		req          *pb.SetLocalProxyRequest
+		vpnActive    bool
+		saveErr      error
+		serverErr    error
+		expected     config.LocalProxy
+		expectedCode int64
+		expectedData []string
+	}{
+		{
+			name:         "enable",
+			req:          &pb.SetLocalProxyRequest{Enabled: true},
+			expected:     config.LocalProxy{Enabled: true},
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"1080", "false"},
+		},
+		{
+			name:         "enable with port while connected",
+			req:          &pb.SetLocalProxyRequest{Enabled: true, Port: 8080},
+			vpnActive:    true,
+			expected:     config.LocalProxy{Enabled: true, Port: 8080},
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"8080", "true"},
+		},
+		{
+			name:         "disable keeps port",
+			current:      config.LocalProxy{Enabled: true, Port: 8080},
+			req:          &pb.SetLocalProxyRequest{},
+			expected:     config.LocalProxy{Port: 8080},
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"8080", "false"},
+		},
+		{
+			name:         "already enabled",
+			current:      config.LocalProxy{Enabled: true, Port: 8080},
+			req:          &pb.SetLocalProxyRequest{Enabled: true},
+			expected:     config.LocalProxy{Enabled: true, Port: 8080},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "port out of range",
+			req:          &pb.SetLocalProxyRequest{Enabled: true, Port: 65536},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "config failure",
+			req:          &pb.SetLocalProxyRequest{Enabled: true},
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+		{
+			name:         "listen failure",
+			req:          &pb.SetLocalProxyRequest{Enabled: true},
+			serverErr:    mock.ErrOnPurpose,
+			expected:     config.LocalProxy{Enabled: true},
+			expectedCode: internal.CodeFailure,
+			expectedData: []string{mock.ErrOnPurpose.Error()},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.LocalProxy = test.current
+			cm.SaveErr = test.saveErr
+			server := &mockLocalProxyServer{cfg: test.current, err: test.serverErr}
+
+			r := RPC{cm: cm, netw: &testnetworker.Mock{VpnActive: test.vpnActive}, localProxy: server}
+			resp, err := r.SetLocalProxy(context.Background(), test.req)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedData, resp.Data)
+			assert.Equal(t, test.expected, cm.Cfg.LocalProxy)
+			if test.serverErr == nil {
+				assert.Equal(t, test.expected, server.cfg)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/logging"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// LogLevelSetter changes the verbosity of the daemon log
+type LogLevelSetter interface {
+	SetLevel(logging.Level)
+}
+
+// SetLogLevel changes the verbosity of the daemon log without restarting it
+func (r *RPC) SetLogLevel(ctx context.Context, in *pb.SetLogLevelRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	level := logLevelToConfig(in.GetLevel())
+	if cfg.LogLevel == level {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.LogLevel = level
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	r.logLevel.SetLevel(LoggingLevel(level))
+	log.Println(internal.InfoPrefix, "log level is set to", LoggingLevel(level))
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+// LoggingLevel returns the minimum level of the log records for the configured level
+func LoggingLevel(level config.LogLevel) logging.Level {
+	switch level {
+	case config.LogLevelDebug:
+		return logging.Debug
+	case config.LogLevelWarn:
+		return logging.Warning
+	case config.LogLevelInfo:
+		fallthrough
+	default:
+		return logging.Info
+	}
+}
+
+func logLevelToConfig(level pb.LogLevel) config.LogLevel {
+	switch level {
+	case pb.LogLevel_LOG_LEVEL_DEBUG:
+		return config.LogLevelDebug
+	case pb.LogLevel_LOG_LEVEL_WARN:
+		return config.LogLevelWarn
+	case pb.LogLevel_LOG_LEVEL_INFO:
+		fallthrough
+	default:
+		return config.LogLevelInfo
+	}
+}
+
+func logLevelToPb(level config.LogLevel) pb.LogLevel {
+	switch level {
+	case config.LogLevelDebug:
+		return pb.LogLevel_LOG_LEVEL_DEBUG
+	case config.LogLevelWarn:
+		return pb.LogLevel_LOG_LEVEL_WARN
+	case config.LogLevelInfo:
+		fallthrough
+	default:
+		return pb.LogLevel_LOG_LEVEL_INFO
+	}
+}
@@ -0,0 +1,83 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/logging"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockLogLevelSetter struct {
+	level logging.Level
+}
+
+func (m *mockLogLevelSetter) SetLevel(level logging.Level) { m.level = level }
+
+func TestSetLogLevel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name          string
+		current       config.LogLevel
+		level         pb.LogLevel
+		expected      config.LogLevel
+		expectedLevel logging.Level
+		expectedCode  int64
+	}{
+		{
+			name:          "debug",
+			level:         pb.LogLevel_LOG_LEVEL_DEBUG,
+			expected:      config.LogLevelDebug,
+			expectedLevel: logging.Debug,
+			expectedCode:  internal.CodeSuccess,
+		},
+		{
+			name:          "warn",
+			current:       config.LogLevelDebug,
+			level:         pb.LogLevel_LOG_LEVEL_WARN,
+			expected:      config.LogLevelWarn,
+			expectedLevel: logging.Warning,
+			expectedCode:  internal.CodeSuccess,
+		},
+		{
+			name:          "back to info",
+			current:       config.LogLevelWarn,
+			level:         pb.LogLevel_LOG_LEVEL_INFO,
+			expected:      config.LogLevelInfo,
+			expectedLevel: logging.Info,
+			expectedCode:  internal.CodeSuccess,
+		},
+		{
+			name:          "already set",
+			current:       config.LogLevelDebug,
+			level:         pb.LogLevel_LOG_LEVEL_DEBUG,
+			expected:      config.LogLevelDebug,
+			expectedLevel: logging.Info,
+			expectedCode:  internal.CodeNothingToDo,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.LogLevel = test.current
+			setter := &mockLogLevelSetter{level: logging.Info}
+
+			rpc := RPC{
+				cm:       cm,
+				logLevel: setter,
+			}
+			resp, err := rpc.SetLogLevel(context.Background(), &pb.SetLogLevelRequest{Level: test.level})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.LogLevel)
+			assert.Equal(t, test.expectedLevel, setter.level)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/metrics"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// MetricsServer serves the daemon metrics according to the settings
+type MetricsServer interface {
+	Set(config.Metrics) error
+}
+
+// SetMetrics controls whether the daemon serves the metrics for Prometheus and
+// on which address
+func (r *RPC) SetMetrics(ctx context.Context, in *pb.SetMetricsRequest) (*pb.Payload, error) {
+	if in.GetAddress() != "" && !metrics.IsValidAddress(in.GetAddress()) {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	settings := config.Metrics{Enabled: in.GetEnabled(), Address: cfg.Metrics.Address}
+	if in.GetAddress() != "" {
+		settings.Address = in.GetAddress()
+	}
+	if cfg.Metrics == settings {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.Metrics = settings
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if err := r.metrics.Set(settings); err != nil {
+		log.Println(internal.ErrorPrefix, "setting metrics:", err)
+		return &pb.Payload{Type: internal.CodeFailure, Data: []string{err.Error()}}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{metrics.Address(settings)}}, nil
+}
+
+func metricsToPb(cfg config.Metrics) *pb.Metrics {
+	return &pb.Metrics{Enabled: cfg.Enabled, Address: metrics.Address(cfg)}
+}
@@ -0,0 +1,115 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockMetricsServer struct {
+	cfg config.Metrics
+	err error
+}
+
+func (m *mockMetricsServer) Set(cfg config.Metrics) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.cfg = cfg
+	return nil
+}
+
+func TestSetMetrics(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      config.Metrics
+		req          *pb.SetMetricsRequest
+		saveErr      error
+		serverErr    error
+		expected     config.Metrics
+		expectedCode int64
+	}{
+		{
+			name:         "enable",
+			req:          &pb.SetMetricsRequest{Enabled: true},
+			expected:     config.Metrics{Enabled: true},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "enable with address",
+			req:          &pb.SetMetricsRequest{Enabled: true, Address: "0.0.0.0:9100"},
+			expected:     config.Metrics{Enabled: true, Address: "0.0.0.0:9100"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "change address",
+			current:      config.Metrics{Enabled: true},
+			req:          &pb.SetMetricsRequest{Enabled: true, Address: "[::1]:9101"},
+			expected:     config.Metrics{Enabled: true, Address: "[::1]:9101"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "disable keeps address",
+			current:      config.Metrics{Enabled: true, Address: "0.0.0.0:9100"},
+			req:          &pb.SetMetricsRequest{},
+			expected:     config.Metrics{Address: "0.0.0.0:9100"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already enabled",
+			current:      config.Metrics{Enabled: true, Address: "0.0.0.0:9100"},
+			req:          &pb.SetMetricsRequest{Enabled: true},
+			expected:     config.Metrics{Enabled: true, Address: "0.0.0.0:9100"},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "hostname",
+			req:          &pb.SetMetricsRequest{Enabled: true, Address: "localhost:9101"},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "missing port",
+			req:          &pb.SetMetricsRequest{Enabled: true, Address: "127.0.0.1"},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "config failure",
+			req:          &pb.SetMetricsRequest{Enabled: true},
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+		{
+			name:         "listen failure",
+			req:          &pb.SetMetricsRequest{Enabled: true},
+			serverErr:    mock.ErrOnPurpose,
+			expected:     config.Metrics{Enabled: true},
+			expectedCode: internal.CodeFailure,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Metrics = test.current
+			cm.SaveErr = test.saveErr
+			server := &mockMetricsServer{cfg: test.current, err: test.serverErr}
+
+			r := RPC{cm: cm, metrics: server}
+			resp, err := r.SetMetrics(context.Background(), test.req)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.Metrics)
+			if test.serverErr == nil {
+				assert.Equal(t, test.expected, server.cfg)
+			}
+		})
+	}
+}
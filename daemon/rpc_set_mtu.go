@@ -0,0 +1,51 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+const (
+	// minTunnelMTU is the smallest MTU every IPv4 host has to accept
+	minTunnelMTU = 576
+	// maxTunnelMTU is the MTU of Ethernet, tunnel packets do not fit larger
+	// MTU on the usual links
+	maxTunnelMTU = 1500
+)
+
+// SetMTU overrides the MTU of the tunnel, 0 restores the detection of the path
+// MTU. MTU of the active connection is changed right away.
+func (r *RPC) SetMTU(ctx context.Context, in *pb.SetMTURequest) (*pb.Payload, error) {
+	mtu := in.GetMtu()
+	if mtu != 0 && (mtu < minTunnelMTU || mtu > maxTunnelMTU) {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	if cfg.MTU == mtu {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.MTU = mtu
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if err := r.netw.SetMTU(mtu); err != nil {
+		log.Println(internal.ErrorPrefix, "setting MTU of the tunnel:", err)
+		return &pb.Payload{Type: internal.CodeFailure}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
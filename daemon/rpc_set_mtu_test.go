@@ -0,0 +1,97 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetMTU(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      uint32
+		mtu          uint32
+		saveErr      error
+		netw         networker.Networker
+		expected     uint32
+		expectedCode int64
+	}{
+		{
+			name:         "set mtu",
+			mtu:          1380,
+			netw:         &testnetworker.Mock{},
+			expected:     1380,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "restore detection",
+			current:      1380,
+			netw:         &testnetworker.Mock{},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already set",
+			current:      1380,
+			mtu:          1380,
+			netw:         &testnetworker.Mock{},
+			expected:     1380,
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "too small",
+			mtu:          500,
+			netw:         &testnetworker.Mock{},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "too large",
+			mtu:          9000,
+			netw:         &testnetworker.Mock{},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "config failure",
+			mtu:          1380,
+			saveErr:      mock.ErrOnPurpose,
+			netw:         &testnetworker.Mock{},
+			expectedCode: internal.CodeConfigError,
+		},
+		{
+			name:         "networker failure",
+			mtu:          1380,
+			netw:         testnetworker.Failing{},
+			expected:     1380,
+			expectedCode: internal.CodeFailure,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.MTU = test.current
+			cm.SaveErr = test.saveErr
+
+			rpc := RPC{
+				cm:   cm,
+				netw: test.netw,
+			}
+			resp, err := rpc.SetMTU(context.Background(), &pb.SetMTURequest{Mtu: test.mtu})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.MTU)
+			if netw, ok := test.netw.(*testnetworker.Mock); ok && test.expectedCode == internal.CodeSuccess {
+				assert.Equal(t, test.expected, netw.MTU)
+			}
+		})
+	}
+}
@@ -17,7 +17,8 @@ func (r *RPC) SetObfuscate(ctx context.Context, in *pb.SetGenericRequest) (*pb.P
 		log.Println(internal.ErrorPrefix, err)
 	}
 
-	if cfg.AutoConnectData.Obfuscate == in.GetEnabled() {
+	// setting the same value again still resets the networks which use obfuscation
+	if cfg.AutoConnectData.Obfuscate == in.GetEnabled() && len(cfg.ObfuscatedNetworks) == 0 {
 		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
 	}
 
@@ -43,6 +44,8 @@ func (r *RPC) SetObfuscate(ctx context.Context, in *pb.SetGenericRequest) (*pb.P
 
 	if err := r.cm.SaveWith(func(c config.Config) config.Config {
 		c.AutoConnectData.Obfuscate = in.GetEnabled()
+		// the obfuscation picked by the user overrides the learned preferences
+		c.ObfuscatedNetworks = nil
 		return c
 	}); err != nil {
 		log.Println(internal.ErrorPrefix, err)
@@ -0,0 +1,60 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetObfuscationMode controls how the obfuscated OpenVPN connections are
+// disguised
+func (r *RPC) SetObfuscationMode(ctx context.Context, in *pb.SetObfuscationModeRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	mode := obfuscationModeToConfig(in.GetMode())
+	if cfg.ObfuscationMode == mode {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.ObfuscationMode = mode
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+func obfuscationModeToConfig(mode pb.ObfuscationMode) config.ObfuscationMode {
+	switch mode {
+	case pb.ObfuscationMode_OBFUSCATION_MODE_TLS:
+		return config.ObfuscationModeTLS
+	case pb.ObfuscationMode_OBFUSCATION_MODE_WEBSOCKET:
+		return config.ObfuscationModeWebSocket
+	case pb.ObfuscationMode_OBFUSCATION_MODE_XOR:
+		fallthrough
+	default:
+		return config.ObfuscationModeXOR
+	}
+}
+
+func obfuscationModeToPb(mode config.ObfuscationMode) pb.ObfuscationMode {
+	switch mode {
+	case config.ObfuscationModeTLS:
+		return pb.ObfuscationMode_OBFUSCATION_MODE_TLS
+	case config.ObfuscationModeWebSocket:
+		return pb.ObfuscationMode_OBFUSCATION_MODE_WEBSOCKET
+	case config.ObfuscationModeXOR:
+		fallthrough
+	default:
+		return pb.ObfuscationMode_OBFUSCATION_MODE_XOR
+	}
+}
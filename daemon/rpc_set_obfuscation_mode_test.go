@@ -0,0 +1,71 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetObfuscationMode(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      config.ObfuscationMode
+		mode         pb.ObfuscationMode
+		saveErr      error
+		expectedMode config.ObfuscationMode
+		expectedCode int64
+	}{
+		{
+			name:         "set tls",
+			mode:         pb.ObfuscationMode_OBFUSCATION_MODE_TLS,
+			expectedMode: config.ObfuscationModeTLS,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "set xor",
+			current:      config.ObfuscationModeWebSocket,
+			mode:         pb.ObfuscationMode_OBFUSCATION_MODE_XOR,
+			expectedMode: config.ObfuscationModeXOR,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already set",
+			current:      config.ObfuscationModeWebSocket,
+			mode:         pb.ObfuscationMode_OBFUSCATION_MODE_WEBSOCKET,
+			expectedMode: config.ObfuscationModeWebSocket,
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "config failure",
+			mode:         pb.ObfuscationMode_OBFUSCATION_MODE_WEBSOCKET,
+			saveErr:      mock.ErrOnPurpose,
+			expectedMode: config.ObfuscationModeXOR,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.ObfuscationMode = test.current
+			cm.SaveErr = test.saveErr
+
+			rpc := RPC{cm: cm}
+			resp, err := rpc.SetObfuscationMode(context.Background(), &pb.SetObfuscationModeRequest{
+				Mode: test.mode,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedMode, cm.Cfg.ObfuscationMode)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetOnDemand controls whether the connection is established automatically on outgoing traffic
+func (r *RPC) SetOnDemand(ctx context.Context, in *pb.SetOnDemandRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	idleTimeout := cfg.OnDemand.IdleTimeout
+	if in.GetIdleTimeout() != 0 {
+		idleTimeout = time.Duration(in.GetIdleTimeout()) * time.Second
+		if idleTimeout < MinOnDemandIdleTimeout {
+			return &pb.Payload{Type: internal.CodeFormatError}, nil
+		}
+	}
+
+	if cfg.OnDemand.Enabled == in.GetEnabled() && cfg.OnDemand.IdleTimeout == idleTimeout {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.OnDemand.Enabled = in.GetEnabled()
+		c.OnDemand.IdleTimeout = idleTimeout
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
@@ -0,0 +1,80 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetOnDemand(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      config.OnDemand
+		request      *pb.SetOnDemandRequest
+		saveErr      error
+		expected     config.OnDemand
+		expectedCode int64
+	}{
+		{
+			name:         "enable",
+			request:      &pb.SetOnDemandRequest{Enabled: true},
+			expected:     config.OnDemand{Enabled: true},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "enable with idle timeout",
+			request:      &pb.SetOnDemandRequest{Enabled: true, IdleTimeout: 600},
+			expected:     config.OnDemand{Enabled: true, IdleTimeout: 10 * time.Minute},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "disable keeps idle timeout",
+			current:      config.OnDemand{Enabled: true, IdleTimeout: 10 * time.Minute},
+			request:      &pb.SetOnDemandRequest{},
+			expected:     config.OnDemand{IdleTimeout: 10 * time.Minute},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already set",
+			current:      config.OnDemand{Enabled: true},
+			request:      &pb.SetOnDemandRequest{Enabled: true},
+			expected:     config.OnDemand{Enabled: true},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "idle timeout too short",
+			request:      &pb.SetOnDemandRequest{Enabled: true, IdleTimeout: 10},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "config failure",
+			request:      &pb.SetOnDemandRequest{Enabled: true},
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.OnDemand = test.current
+			cm.SaveErr = test.saveErr
+
+			rpc := RPC{cm: cm}
+			resp, err := rpc.SetOnDemand(context.Background(), test.request)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.OnDemand)
+		})
+	}
+}
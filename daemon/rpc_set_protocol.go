@@ -15,7 +15,8 @@ func (r *RPC) SetProtocol(ctx context.Context, in *pb.SetProtocolRequest) (*pb.S
 		log.Println(internal.ErrorPrefix, err)
 	}
 
-	if cfg.AutoConnectData.Protocol == in.Protocol {
+	// setting the same protocol again still resets the networks which use TCP
+	if cfg.AutoConnectData.Protocol == in.Protocol && len(cfg.TCPNetworks) == 0 {
 		return &pb.SetProtocolResponse{
 			Response: &pb.SetProtocolResponse_ErrorCode{
 				ErrorCode: pb.SetErrorCode_ALREADY_SET,
@@ -33,6 +34,8 @@ func (r *RPC) SetProtocol(ctx context.Context, in *pb.SetProtocolRequest) (*pb.S
 
 	if err := r.cm.SaveWith(func(c config.Config) config.Config {
 		c.AutoConnectData.Protocol = in.GetProtocol()
+		// the protocol picked by the user overrides the learned preferences
+		c.TCPNetworks = nil
 		return c
 	}); err != nil {
 		log.Println(internal.ErrorPrefix, err)
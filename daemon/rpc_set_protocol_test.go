@@ -28,6 +28,7 @@ func TestSetProtocol_Success(t *testing.T) {
 		vpnActive       bool
 		currentProtocol config.Protocol
 		desiredProtocol config.Protocol
+		tcpNetworks     []string
 		expectedStatus  pb.SetProtocolStatus
 	}{
 		{
@@ -56,6 +57,13 @@ func TestSetProtocol_Success(t *testing.T) {
 			desiredProtocol: config.Protocol_UDP,
 			expectedStatus:  pb.SetProtocolStatus_PROTOCOL_CONFIGURED_VPN_ON,
 		},
+		{
+			name:            "set protocol udp again resets tcp networks",
+			currentProtocol: config.Protocol_UDP,
+			desiredProtocol: config.Protocol_UDP,
+			tcpNetworks:     []string{"Airport Wi-Fi"},
+			expectedStatus:  pb.SetProtocolStatus_PROTOCOL_CONFIGURED,
+		},
 	}
 
 	for _, test := range tests {
@@ -70,6 +78,7 @@ func TestSetProtocol_Success(t *testing.T) {
 			configManager.SaveWith(func(c config.Config) config.Config {
 				c.AutoConnectData.Protocol = test.currentProtocol
 				c.Technology = config.Technology_OPENVPN
+				c.TCPNetworks = test.tcpNetworks
 				return c
 			})
 
@@ -99,6 +108,7 @@ func TestSetProtocol_Success(t *testing.T) {
 
 			assert.Equal(t, test.desiredProtocol, cfg.AutoConnectData.Protocol,
 				"Invalid status saved in configuration.")
+			assert.Empty(t, cfg.TCPNetworks)
 			assert.Equal(t, true, protocolPublisher.eventPublished,
 				"Protocol event was not published after success.")
 		})
@@ -0,0 +1,66 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetReconnect sets a single option of the reconnect policy
+func (r *RPC) SetReconnect(ctx context.Context, in *pb.SetReconnectRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	policy := cfg.Reconnect
+	value := in.GetValue()
+	switch in.GetOption() {
+	case pb.ReconnectOption_RECONNECT_MAX_RETRIES:
+		policy.MaxRetries = value
+	case pb.ReconnectOption_RECONNECT_BACKOFF:
+		policy.Backoff = time.Duration(value) * time.Millisecond
+		if policy.Backoff > MaxReconnectBackoff {
+			return &pb.Payload{Type: internal.CodeFormatError}, nil
+		}
+	case pb.ReconnectOption_RECONNECT_JITTER:
+		policy.Jitter = time.Duration(value) * time.Millisecond
+		if policy.Jitter > MaxReconnectBackoff {
+			return &pb.Payload{Type: internal.CodeFormatError}, nil
+		}
+	case pb.ReconnectOption_RECONNECT_AGGRESSIVE:
+		if value > 1 {
+			return &pb.Payload{Type: internal.CodeFormatError}, nil
+		}
+		policy.Aggressive = value == 1
+	default:
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	if cfg.Reconnect == policy {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.Reconnect = policy
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+func reconnectToPb(policy config.ReconnectPolicy) *pb.ReconnectPolicy {
+	return &pb.ReconnectPolicy{
+		MaxRetries: policy.MaxRetries,
+		Backoff:    uint32(policy.Backoff.Milliseconds()),
+		Jitter:     uint32(policy.Jitter.Milliseconds()),
+		Aggressive: policy.Aggressive,
+	}
+}
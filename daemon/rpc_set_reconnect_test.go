@@ -0,0 +1,90 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetReconnect(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      config.ReconnectPolicy
+		req          *pb.SetReconnectRequest
+		saveErr      error
+		expected     config.ReconnectPolicy
+		expectedCode int64
+	}{
+		{
+			name:         "max retries",
+			req:          &pb.SetReconnectRequest{Option: pb.ReconnectOption_RECONNECT_MAX_RETRIES, Value: 5},
+			expected:     config.ReconnectPolicy{MaxRetries: 5},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "backoff keeps the other options",
+			current:      config.ReconnectPolicy{MaxRetries: 5},
+			req:          &pb.SetReconnectRequest{Option: pb.ReconnectOption_RECONNECT_BACKOFF, Value: 2000},
+			expected:     config.ReconnectPolicy{MaxRetries: 5, Backoff: 2 * time.Second},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "jitter",
+			req:          &pb.SetReconnectRequest{Option: pb.ReconnectOption_RECONNECT_JITTER, Value: 500},
+			expected:     config.ReconnectPolicy{Jitter: 500 * time.Millisecond},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "aggressive",
+			req:          &pb.SetReconnectRequest{Option: pb.ReconnectOption_RECONNECT_AGGRESSIVE, Value: 1},
+			expected:     config.ReconnectPolicy{Aggressive: true},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already set",
+			current:      config.ReconnectPolicy{Aggressive: true},
+			req:          &pb.SetReconnectRequest{Option: pb.ReconnectOption_RECONNECT_AGGRESSIVE, Value: 1},
+			expected:     config.ReconnectPolicy{Aggressive: true},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "backoff too long",
+			req:          &pb.SetReconnectRequest{Option: pb.ReconnectOption_RECONNECT_BACKOFF, Value: uint32((MaxReconnectBackoff + time.Second).Milliseconds())},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "invalid flag",
+			req:          &pb.SetReconnectRequest{Option: pb.ReconnectOption_RECONNECT_AGGRESSIVE, Value: 2},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "config failure",
+			req:          &pb.SetReconnectRequest{Option: pb.ReconnectOption_RECONNECT_MAX_RETRIES, Value: 5},
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Reconnect = test.current
+			cm.SaveErr = test.saveErr
+
+			r := RPC{cm: cm}
+			resp, err := r.SetReconnect(context.Background(), test.req)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.Reconnect)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/restapi"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// RESTAPIServer serves the daemon API over HTTP according to the settings
+type RESTAPIServer interface {
+	Set(config.RESTAPI) error
+}
+
+// SetRESTAPI controls whether the daemon API is served over HTTP and on which
+// address. Payload data contains the address and the token, so that the users
+// allowed to control the daemon can look the token up by enabling the API again.
+func (r *RPC) SetRESTAPI(ctx context.Context, in *pb.SetRESTAPIRequest) (*pb.Payload, error) {
+	if in.GetAddress() != "" && !restapi.IsValidAddress(in.GetAddress()) {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	settings := config.RESTAPI{Enabled: in.GetEnabled(), Address: cfg.RESTAPI.Address, Token: cfg.RESTAPI.Token}
+	if in.GetAddress() != "" {
+		settings.Address = in.GetAddress()
+	}
+	if settings.Enabled && (settings.Token == "" || in.GetNewToken()) {
+		token, err := restapi.NewToken()
+		if err != nil {
+			log.Println(internal.ErrorPrefix, err)
+			return &pb.Payload{Type: internal.CodeFailure}, nil
+		}
+		settings.Token = token
+	}
+	data := []string{restapi.Address(settings), settings.Token}
+	if cfg.RESTAPI == settings {
+		return &pb.Payload{Type: internal.CodeNothingToDo, Data: data}, nil
+	}
+
+	// server is reconfigured first, so that the settings it could not listen
+	// with are not saved and do not fail the daemon start
+	if err := r.restAPI.Set(settings); err != nil {
+		log.Println(internal.ErrorPrefix, "setting REST API:", err)
+		return &pb.Payload{Type: internal.CodeFailure, Data: []string{err.Error()}}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.RESTAPI = settings
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		if err := r.restAPI.Set(cfg.RESTAPI); err != nil {
+			log.Println(internal.ErrorPrefix, "restoring REST API:", err)
+		}
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess, Data: data}, nil
+}
+
+func restAPIToPb(cfg config.RESTAPI) *pb.RESTAPI {
+	return &pb.RESTAPI{Enabled: cfg.Enabled, Address: restapi.Address(cfg)}
+}
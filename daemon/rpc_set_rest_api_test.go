@@ -0,0 +1,131 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockRESTAPIServer struct {
+	cfg config.RESTAPI
+	err error
+}
+
+func (m *mockRESTAPIServer) Set(cfg config.RESTAPI) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.cfg = cfg
+	return nil
+}
+
+func TestSetRESTAPI(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      config.RESTAPI
+		req          *pb.SetRESTAPIRequest
+		saveErr      error
+		serverErr    error
+		newToken     bool
+		expected     config.RESTAPI
+		expectedCode int64
+	}{
+		{
+			name:         "enable generates token",
+			req:          &pb.SetRESTAPIRequest{Enabled: true},
+			newToken:     true,
+			expected:     config.RESTAPI{Enabled: true},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "enable keeps token",
+			current:      config.RESTAPI{Token: "token"},
+			req:          &pb.SetRESTAPIRequest{Enabled: true, Address: "[::1]:9103"},
+			expected:     config.RESTAPI{Enabled: true, Address: "[::1]:9103", Token: "token"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "new token",
+			current:      config.RESTAPI{Enabled: true, Token: "token"},
+			req:          &pb.SetRESTAPIRequest{Enabled: true, NewToken: true},
+			newToken:     true,
+			expected:     config.RESTAPI{Enabled: true},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "disable keeps address and token",
+			current:      config.RESTAPI{Enabled: true, Address: "127.0.0.1:9103", Token: "token"},
+			req:          &pb.SetRESTAPIRequest{},
+			expected:     config.RESTAPI{Address: "127.0.0.1:9103", Token: "token"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already enabled",
+			current:      config.RESTAPI{Enabled: true, Token: "token"},
+			req:          &pb.SetRESTAPIRequest{Enabled: true},
+			expected:     config.RESTAPI{Enabled: true, Token: "token"},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "not loopback",
+			req:          &pb.SetRESTAPIRequest{Enabled: true, Address: "0.0.0.0:9102"},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "missing port",
+			req:          &pb.SetRESTAPIRequest{Enabled: true, Address: "127.0.0.1"},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "config failure",
+			current:      config.RESTAPI{Token: "token"},
+			req:          &pb.SetRESTAPIRequest{Enabled: true},
+			saveErr:      mock.ErrOnPurpose,
+			expected:     config.RESTAPI{Token: "token"},
+			expectedCode: internal.CodeConfigError,
+		},
+		{
+			name:         "listen failure",
+			current:      config.RESTAPI{Token: "token"},
+			req:          &pb.SetRESTAPIRequest{Enabled: true},
+			serverErr:    mock.ErrOnPurpose,
+			expected:     config.RESTAPI{Token: "token"},
+			expectedCode: internal.CodeFailure,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.RESTAPI = test.current
+			cm.SaveErr = test.saveErr
+			server := &mockRESTAPIServer{cfg: test.current, err: test.serverErr}
+
+			r := RPC{cm: cm, restAPI: server}
+			resp, err := r.SetRESTAPI(context.Background(), test.req)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+
+			actual := cm.Cfg.RESTAPI
+			if test.newToken {
+				assert.Len(t, actual.Token, 64)
+				assert.NotEqual(t, test.current.Token, actual.Token)
+				actual.Token = ""
+			}
+			assert.Equal(t, test.expected, actual)
+			if test.expectedCode == internal.CodeSuccess || test.expectedCode == internal.CodeNothingToDo {
+				assert.Equal(t, cm.Cfg.RESTAPI.Token, resp.Data[1])
+			}
+			// server is left with the saved settings also when one of the steps fails
+			assert.Equal(t, cm.Cfg.RESTAPI, server.cfg)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/sys/unix"
+)
+
+// SetRoutingTable sets the routing table used for the VPN traffic, 0 restores
+// picking the first table not in use. It takes effect after restarting the
+// daemon, same as the firewall mark.
+func (r *RPC) SetRoutingTable(ctx context.Context, in *pb.SetUint32Request) (*pb.Payload, error) {
+	table := in.GetValue()
+	if isReservedRoutingTable(table) {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	if cfg.RoutingTable == table {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.RoutingTable = table
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+// isReservedRoutingTable reports whether the table is managed by the kernel
+func isReservedRoutingTable(table uint32) bool {
+	switch table {
+	case unix.RT_TABLE_DEFAULT, unix.RT_TABLE_MAIN, unix.RT_TABLE_LOCAL:
+		return true
+	default:
+		return false
+	}
+}
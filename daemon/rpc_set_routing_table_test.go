@@ -0,0 +1,75 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetRoutingTable(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      uint32
+		table        uint32
+		saveErr      error
+		expected     uint32
+		expectedCode int64
+	}{
+		{
+			name:         "set table",
+			table:        205,
+			expected:     205,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "restore picking",
+			current:      205,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already set",
+			current:      205,
+			table:        205,
+			expected:     205,
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "main table",
+			table:        254,
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "local table",
+			table:        255,
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "config failure",
+			table:        205,
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.RoutingTable = test.current
+			cm.SaveErr = test.saveErr
+
+			rpc := RPC{cm: cm}
+			resp, err := rpc.SetRoutingTable(context.Background(), &pb.SetUint32Request{Value: test.table})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.RoutingTable)
+		})
+	}
+}
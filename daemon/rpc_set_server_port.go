@@ -0,0 +1,54 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"math"
+	"strconv"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetServerPort pins the port of the VPN server for the technology. It is
+// applied on the next connection.
+func (r *RPC) SetServerPort(ctx context.Context, in *pb.SetServerPortRequest) (*pb.Payload, error) {
+	if in.GetPort() > math.MaxUint16 {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	tech := in.GetTechnology()
+	if tech == config.Technology_UNKNOWN_TECHNOLOGY {
+		tech = cfg.Technology
+	}
+	port := uint16(in.GetPort())
+	if cfg.ServerPorts.Get(tech) == port {
+		return &pb.Payload{Type: internal.CodeNothingToDo, Data: []string{tech.String()}}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.ServerPorts = c.ServerPorts.Set(tech, port)
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{
+		Type: internal.CodeSuccess,
+		Data: []string{tech.String(), strconv.FormatBool(r.netw.IsVPNActive() && tech == cfg.Technology)},
+	}, nil
+}
+
+func serverPortsToPb(ports config.ServerPorts) *pb.ServerPorts {
+	return &pb.ServerPorts{
+		Openvpn:  uint32(ports.OpenVPN),
+		Nordlynx: uint32(ports.NordLynx),
+	}
+}
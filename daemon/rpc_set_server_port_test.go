@@ -0,0 +1,96 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetServerPort(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      config.ServerPorts
+		req          *pb.SetServerPortRequest
+		vpnActive    bool
+		saveErr      error
+		expected     config.ServerPorts
+		expectedCode int64
+		expectedData []string
+	}{
+		{
+			name:         "current technology",
+			req:          &pb.SetServerPortRequest{Port: 443},
+			expected:     config.ServerPorts{NordLynx: 443},
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"NORDLYNX", "false"},
+		},
+		{
+			name:         "current technology while connected",
+			req:          &pb.SetServerPortRequest{Port: 443},
+			vpnActive:    true,
+			expected:     config.ServerPorts{NordLynx: 443},
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"NORDLYNX", "true"},
+		},
+		{
+			name:         "other technology while connected",
+			req:          &pb.SetServerPortRequest{Technology: config.Technology_OPENVPN, Port: 53},
+			vpnActive:    true,
+			expected:     config.ServerPorts{OpenVPN: 53},
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"OPENVPN", "false"},
+		},
+		{
+			name:         "restore default",
+			current:      config.ServerPorts{OpenVPN: 53, NordLynx: 443},
+			req:          &pb.SetServerPortRequest{Technology: config.Technology_OPENVPN},
+			expected:     config.ServerPorts{NordLynx: 443},
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"OPENVPN", "false"},
+		},
+		{
+			name:         "already set",
+			current:      config.ServerPorts{NordLynx: 443},
+			req:          &pb.SetServerPortRequest{Port: 443},
+			expected:     config.ServerPorts{NordLynx: 443},
+			expectedCode: internal.CodeNothingToDo,
+			expectedData: []string{"NORDLYNX"},
+		},
+		{
+			name:         "port out of range",
+			req:          &pb.SetServerPortRequest{Port: 65536},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "config failure",
+			req:          &pb.SetServerPortRequest{Port: 443},
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Technology = config.Technology_NORDLYNX
+			cm.Cfg.ServerPorts = test.current
+			cm.SaveErr = test.saveErr
+
+			r := RPC{cm: cm, netw: &testnetworker.Mock{VpnActive: test.vpnActive}}
+			resp, err := r.SetServerPort(context.Background(), test.req)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedData, resp.Data)
+			assert.Equal(t, test.expected, cm.Cfg.ServerPorts)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetServerSelection controls how the server is picked when connecting
+func (r *RPC) SetServerSelection(ctx context.Context, in *pb.SetServerSelectionRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	selection := serverSelectionToConfig(in.GetSelection())
+	if cfg.ServerSelection == selection {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.ServerSelection = selection
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+func serverSelectionToConfig(selection pb.ServerSelection) config.ServerSelection {
+	switch selection {
+	case pb.ServerSelection_SERVER_SELECTION_LATENCY:
+		return config.ServerSelectionLatency
+	case pb.ServerSelection_SERVER_SELECTION_AUTO:
+		return config.ServerSelectionAuto
+	case pb.ServerSelection_SERVER_SELECTION_LOAD:
+		fallthrough
+	default:
+		return config.ServerSelectionLoad
+	}
+}
+
+func serverSelectionToPb(selection config.ServerSelection) pb.ServerSelection {
+	switch selection {
+	case config.ServerSelectionLatency:
+		return pb.ServerSelection_SERVER_SELECTION_LATENCY
+	case config.ServerSelectionAuto:
+		return pb.ServerSelection_SERVER_SELECTION_AUTO
+	case config.ServerSelectionLoad:
+		fallthrough
+	default:
+		return pb.ServerSelection_SERVER_SELECTION_LOAD
+	}
+}
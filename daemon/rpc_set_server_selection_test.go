@@ -0,0 +1,71 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetServerSelection(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name              string
+		current           config.ServerSelection
+		selection         pb.ServerSelection
+		saveErr           error
+		expectedSelection config.ServerSelection
+		expectedCode      int64
+	}{
+		{
+			name:              "set latency",
+			selection:         pb.ServerSelection_SERVER_SELECTION_LATENCY,
+			expectedSelection: config.ServerSelectionLatency,
+			expectedCode:      internal.CodeSuccess,
+		},
+		{
+			name:              "set load",
+			current:           config.ServerSelectionAuto,
+			selection:         pb.ServerSelection_SERVER_SELECTION_LOAD,
+			expectedSelection: config.ServerSelectionLoad,
+			expectedCode:      internal.CodeSuccess,
+		},
+		{
+			name:              "already set",
+			current:           config.ServerSelectionAuto,
+			selection:         pb.ServerSelection_SERVER_SELECTION_AUTO,
+			expectedSelection: config.ServerSelectionAuto,
+			expectedCode:      internal.CodeNothingToDo,
+		},
+		{
+			name:              "config failure",
+			selection:         pb.ServerSelection_SERVER_SELECTION_AUTO,
+			saveErr:           mock.ErrOnPurpose,
+			expectedSelection: config.ServerSelectionLoad,
+			expectedCode:      internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.ServerSelection = test.current
+			cm.SaveErr = test.saveErr
+
+			rpc := RPC{cm: cm}
+			resp, err := rpc.SetServerSelection(context.Background(), &pb.SetServerSelectionRequest{
+				Selection: test.selection,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedSelection, cm.Cfg.ServerSelection)
+		})
+	}
+}
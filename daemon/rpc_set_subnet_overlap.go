@@ -0,0 +1,62 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetSubnetOverlapMode controls how the LAN subnets overlapping with the VPN
+// subnets are handled on connect
+func (r *RPC) SetSubnetOverlapMode(ctx context.Context, in *pb.SetSubnetOverlapModeRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	mode := subnetOverlapModeToConfig(in.GetMode())
+	if cfg.SubnetOverlapMode == mode {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.SubnetOverlapMode = mode
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	r.netw.SetSubnetOverlapMode(mode)
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+func subnetOverlapModeToConfig(mode pb.SubnetOverlapMode) config.SubnetOverlapMode {
+	switch mode {
+	case pb.SubnetOverlapMode_PREFER_LAN:
+		return config.SubnetOverlapPreferLAN
+	case pb.SubnetOverlapMode_PREFER_VPN:
+		return config.SubnetOverlapPreferVPN
+	case pb.SubnetOverlapMode_WARN:
+		fallthrough
+	default:
+		return config.SubnetOverlapWarn
+	}
+}
+
+func subnetOverlapModeToPb(mode config.SubnetOverlapMode) pb.SubnetOverlapMode {
+	switch mode {
+	case config.SubnetOverlapPreferLAN:
+		return pb.SubnetOverlapMode_PREFER_LAN
+	case config.SubnetOverlapPreferVPN:
+		return pb.SubnetOverlapMode_PREFER_VPN
+	case config.SubnetOverlapWarn:
+		fallthrough
+	default:
+		return pb.SubnetOverlapMode_WARN
+	}
+}
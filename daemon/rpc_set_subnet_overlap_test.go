@@ -0,0 +1,77 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetSubnetOverlapMode(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		currentMode  config.SubnetOverlapMode
+		mode         pb.SubnetOverlapMode
+		saveErr      error
+		expectedMode config.SubnetOverlapMode
+		expectedCode int64
+	}{
+		{
+			name:         "set prefer lan",
+			mode:         pb.SubnetOverlapMode_PREFER_LAN,
+			expectedMode: config.SubnetOverlapPreferLAN,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "set warn",
+			currentMode:  config.SubnetOverlapPreferVPN,
+			mode:         pb.SubnetOverlapMode_WARN,
+			expectedMode: config.SubnetOverlapWarn,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already set",
+			currentMode:  config.SubnetOverlapPreferVPN,
+			mode:         pb.SubnetOverlapMode_PREFER_VPN,
+			expectedMode: config.SubnetOverlapPreferVPN,
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "config failure",
+			mode:         pb.SubnetOverlapMode_PREFER_VPN,
+			saveErr:      mock.ErrOnPurpose,
+			expectedMode: config.SubnetOverlapWarn,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.SubnetOverlapMode = test.currentMode
+			cm.SaveErr = test.saveErr
+			netw := networker.Mock{SubnetOverlapMode: test.currentMode}
+
+			rpc := RPC{
+				cm:   cm,
+				netw: &netw,
+			}
+			resp, err := rpc.SetSubnetOverlapMode(context.Background(), &pb.SetSubnetOverlapModeRequest{
+				Mode: test.mode,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedMode, cm.Cfg.SubnetOverlapMode)
+			assert.Equal(t, test.expectedMode, netw.SubnetOverlapMode)
+		})
+	}
+}
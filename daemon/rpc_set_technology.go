@@ -2,6 +2,7 @@ package daemon
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strconv"
 
@@ -10,59 +11,101 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/internal"
 )
 
+// autoTechnologyLabel is shown instead of the technology in the automatic mode
+const autoTechnologyLabel = "AUTO"
+
 func (r *RPC) SetTechnology(ctx context.Context, in *pb.SetTechnologyRequest) (*pb.Payload, error) {
 	var cfg config.Config
 	if err := r.cm.Load(&cfg); err != nil {
 		log.Println(internal.ErrorPrefix, err)
 	}
 
-	if cfg.Technology == in.GetTechnology() {
+	if in.GetAuto() {
+		return r.setAutoTechnology(cfg)
+	}
+
+	if cfg.Technology == in.GetTechnology() && !cfg.AutoTechnology {
 		return &pb.Payload{
 			Type: internal.CodeNothingToDo,
 			Data: []string{in.GetTechnology().String()},
 		}, nil
 	}
 
-	v, err := r.factory(in.GetTechnology())
-	if err != nil {
+	if !cfg.AllowedTechnologies.Allows(in.GetTechnology()) {
+		return &pb.Payload{
+			Type: internal.CodeTechnologyNotAllowed,
+			Data: []string{in.GetTechnology().String()},
+		}, nil
+	}
+
+	if err := r.switchTechnology(cfg, in.GetTechnology(), false); err != nil {
 		log.Println(internal.ErrorPrefix, err)
 		return &pb.Payload{
 			Type: internal.CodeConfigError,
 		}, nil
 	}
-	r.netw.SetVPN(v)
 
-	payload := &pb.Payload{}
-	// payload.Type gets overridden in case of failure
-	//
-	// Previously it was at the end of the function, which overrode any failures
-	// and user was not given any error messages because of it. Most notably,
-	// internal.CodeSuccessWithoutAC was overridden with generic internal.CodeSuccess
-	payload.Type = internal.CodeSuccess
+	return &pb.Payload{
+		Type: internal.CodeSuccess,
+		Data: []string{strconv.FormatBool(r.netw.IsVPNActive()), in.GetTechnology().String()},
+	}, nil
+}
+
+// setAutoTechnology makes the technology picked for each network on connect
+func (r *RPC) setAutoTechnology(cfg config.Config) (*pb.Payload, error) {
+	if cfg.AutoTechnology {
+		return &pb.Payload{
+			Type: internal.CodeNothingToDo,
+			Data: []string{autoTechnologyLabel},
+		}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.AutoTechnology = true
+		// the networks may have changed since the mode was used last time
+		c.AutoTechnologyNetworks = nil
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{
+			Type: internal.CodeConfigError,
+		}, nil
+	}
+
+	return &pb.Payload{
+		Type: internal.CodeSuccess,
+		Data: []string{strconv.FormatBool(r.netw.IsVPNActive()), autoTechnologyLabel},
+	}, nil
+}
+
+// switchTechnology makes the VPN use the technology and saves it. auto keeps
+// the automatic technology mode, otherwise it is turned off.
+func (r *RPC) switchTechnology(cfg config.Config, tech config.Technology, auto bool) error {
+	v, err := r.factory(tech)
+	if err != nil {
+		return fmt.Errorf("creating %s vpn: %w", tech, err)
+	}
+	r.netw.SetVPN(v)
 
 	protocol := cfg.AutoConnectData.Protocol
 	obfuscate := cfg.AutoConnectData.Obfuscate
-	if in.GetTechnology() == config.Technology_NORDLYNX {
+	if tech == config.Technology_NORDLYNX {
 		protocol = config.Protocol_UDP
 		obfuscate = false
 	}
 
 	if err := r.cm.SaveWith(func(c config.Config) config.Config {
-		c.Technology = in.GetTechnology()
+		c.Technology = tech
+		c.AutoTechnology = auto
 		c.AutoConnectData.Protocol = protocol
 		c.AutoConnectData.Obfuscate = obfuscate
 		return c
 	}); err != nil {
-		log.Println(internal.ErrorPrefix, err)
-		return &pb.Payload{
-			Type: internal.CodeConfigError,
-		}, nil
+		return fmt.Errorf("saving technology: %w", err)
 	}
 
-	r.events.Settings.Technology.Publish(in.GetTechnology())
-
-	SetAppData(r.dm, in.GetTechnology(), r.dm.GetServersData().Servers)
+	r.events.Settings.Technology.Publish(tech)
 
-	payload.Data = []string{strconv.FormatBool(r.netw.IsVPNActive()), in.GetTechnology().String()}
-	return payload, nil
+	SetAppData(r.dm, tech, cfg.VirtualLocation.Get(), r.dm.GetServersData().Servers)
+	return nil
 }
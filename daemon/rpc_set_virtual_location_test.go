@@ -0,0 +1,116 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetVirtualLocation(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	newServer := func(country string, city string, virtual bool) core.Server {
+		server := core.Server{
+			Status:    core.Online,
+			Locations: core.Locations{{Country: core.Country{Name: country, City: core.City{Name: city}}}},
+			Technologies: core.Technologies{
+				core.Technology{
+					ID:    core.WireguardTech,
+					Pivot: core.Pivot{Status: core.Online},
+				},
+			},
+		}
+		if virtual {
+			server.Specifications = []core.Specification{{
+				Identifier: "virtual_location",
+				Values: []struct {
+					Value string `json:"value"`
+				}{{Value: "true"}},
+			}}
+		}
+		return server
+	}
+	servers := core.Servers{
+		newServer("Germany", "Berlin", false),
+		newServer("Germany", "Hamburg", true),
+		newServer("Bahamas", "Nassau", true),
+	}
+
+	tests := []struct {
+		name              string
+		current           bool
+		enabled           bool
+		saveErr           error
+		expected          bool
+		expectedCode      int64
+		expectedCountries []string
+		expectedCities    []string
+	}{
+		{
+			name:              "disable",
+			current:           true,
+			enabled:           false,
+			expected:          false,
+			expectedCode:      internal.CodeSuccess,
+			expectedCountries: []string{"Germany"},
+			expectedCities:    []string{"Berlin"},
+		},
+		{
+			name:              "enable",
+			current:           false,
+			enabled:           true,
+			expected:          true,
+			expectedCode:      internal.CodeSuccess,
+			expectedCountries: []string{"Bahamas", "Germany"},
+			expectedCities:    []string{"Berlin", "Hamburg"},
+		},
+		{
+			name:         "already set",
+			current:      true,
+			enabled:      true,
+			expected:     true,
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "config failure",
+			current:      true,
+			enabled:      false,
+			saveErr:      mock.ErrOnPurpose,
+			expected:     true,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Technology = config.Technology_NORDLYNX
+			cm.Cfg.VirtualLocation.Set(test.current)
+			cm.SaveErr = test.saveErr
+
+			rpc := RPC{
+				cm: cm,
+				dm: &DataManager{serversData: ServersData{Servers: servers}},
+			}
+			resp, err := rpc.SetVirtualLocation(context.Background(), &pb.SetGenericRequest{Enabled: test.enabled})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.VirtualLocation.Get())
+			if test.expectedCode == internal.CodeSuccess {
+				appData := rpc.dm.GetAppData()
+				assert.ElementsMatch(t, test.expectedCountries,
+					appData.CountryNames[false][config.Protocol_UDP].ToSlice())
+				assert.ElementsMatch(t, test.expectedCities,
+					appData.CityNames[false][config.Protocol_UDP]["germany"].ToSlice())
+			}
+		})
+	}
+}
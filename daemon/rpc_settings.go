@@ -3,6 +3,7 @@ package daemon
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
@@ -16,41 +17,66 @@ func (r *RPC) Settings(ctx context.Context, in *pb.SettingsRequest) (*pb.Setting
 		log.Println(internal.ErrorPrefix, err)
 	}
 
-	ports := pb.Ports{}
-	for port := range cfg.AutoConnectData.Allowlist.Ports.TCP {
-		ports.Tcp = append(ports.Tcp, port)
-	}
-	for port := range cfg.AutoConnectData.Allowlist.Ports.UDP {
-		ports.Udp = append(ports.Udp, port)
-	}
-
-	subnets := []string{}
-	for subnet := range cfg.AutoConnectData.Allowlist.Subnets {
-		subnets = append(subnets, subnet)
-	}
-
 	return &pb.SettingsResponse{
 		Type: internal.CodeSuccess,
 		Data: &pb.Settings{
-			Technology:           cfg.Technology,
-			Firewall:             cfg.Firewall,
-			Fwmark:               cfg.FirewallMark,
-			Routing:              cfg.Routing.Get(),
-			Analytics:            cfg.Analytics.Get(),
-			KillSwitch:           cfg.KillSwitch,
-			AutoConnect:          cfg.AutoConnect,
-			Ipv6:                 cfg.IPv6,
-			Notify:               cfg.UsersData.Notify[in.GetUid()],
-			Meshnet:              cfg.Mesh,
-			Dns:                  cfg.AutoConnectData.DNS,
-			ThreatProtectionLite: cfg.AutoConnectData.ThreatProtectionLite,
-			Protocol:             cfg.AutoConnectData.Protocol,
-			LanDiscovery:         cfg.LanDiscovery,
-			Allowlist: &pb.Allowlist{
-				Ports:   &ports,
-				Subnets: subnets,
-			},
-			Obfuscate: cfg.AutoConnectData.Obfuscate,
+			Technology:            cfg.Technology,
+			Firewall:              cfg.Firewall,
+			Fwmark:                cfg.FirewallMark,
+			Routing:               cfg.Routing.Get(),
+			Analytics:             cfg.Analytics.Get(),
+			KillSwitch:            cfg.KillSwitch,
+			AutoConnect:           cfg.AutoConnect,
+			Ipv6:                  cfg.GetIPv6Mode() == config.IPv6Tunnel,
+			Notify:                cfg.UsersData.Notify[in.GetUid()],
+			Meshnet:               cfg.Mesh,
+			Dns:                   cfg.AutoConnectData.DNS,
+			ThreatProtectionLite:  cfg.AutoConnectData.ThreatProtectionLite,
+			Protocol:              cfg.AutoConnectData.Protocol,
+			LanDiscovery:          cfg.LanDiscovery,
+			Allowlist:             allowlistToPb(cfg.AutoConnectData.Allowlist),
+			Obfuscate:             cfg.AutoConnectData.Obfuscate,
+			DefaultRouteMode:      defaultRouteModeToPb(cfg.DefaultRouteMode),
+			FirewallBackend:       firewallBackendToPb(cfg.FirewallBackend),
+			OnDemand:              cfg.OnDemand.Enabled,
+			OnDemandIdleTimeout:   uint32(onDemandIdleTimeout(cfg.OnDemand).Seconds()),
+			FirewallTemplates:     firewallTemplatesToPb(cfg.FirewallTemplates),
+			DnsIpv6:               cfg.DNSIPv6.Get(),
+			IdleTimeout:           uint32(cfg.IdleDisconnect.Timeout.Seconds()),
+			IdleTimeoutKillSwitch: cfg.IdleDisconnect.KillSwitch,
+			RatingWeight:          cfg.RatingWeight,
+			KillSwitchLog:         cfg.KillSwitchLog,
+			KillSwitchLan:         cfg.KillSwitchLAN,
+			MulticastDiscovery:    cfg.MulticastDiscovery,
+			LogLevel:              logLevelToPb(cfg.LogLevel),
+			ServerSelection:       serverSelectionToPb(cfg.ServerSelection),
+			VirtualLocation:       cfg.VirtualLocation.Get(),
+			DnsMonitor:            cfg.DNSMonitor.Get(),
+			NetworkManager:        cfg.NetworkManager.Get(),
+			Metered:               meteredToPb(cfg.Metered),
+			Reconnect:             reconnectToPb(cfg.Reconnect),
+			AllowedTechnologies:   cfg.AllowedTechnologies,
+			SubnetOverlapMode:     subnetOverlapModeToPb(cfg.SubnetOverlapMode),
+			Ipv6Mode:              ipv6ModeToPb(cfg.GetIPv6Mode()),
+			CaptivePortalNetworks: cfg.CaptivePortalNetworks,
+			TcpNetworks:           cfg.TCPNetworks,
+			ObfuscatedNetworks:    cfg.ObfuscatedNetworks,
+			TrustedNetworks:       cfg.TrustedNetworks,
+			ObfuscationMode:       obfuscationModeToPb(cfg.ObfuscationMode),
+			InterfaceName:         cfg.InterfaceName,
+			Mtu:                   cfg.MTU,
+			RoutingTable:          cfg.RoutingTable,
+			LegacyDns:             cfg.LegacyDNS,
+			DnsSearchDomains:      cfg.DNSSearchDomains,
+			DnsFilter:             cfg.DNSFilter,
+			DnsRoutes:             dnsRoutesToPb(cfg.DNSRoutes),
+			SplitTunnel:           splitTunnelToPb(cfg.SplitTunnel),
+			Metrics:               metricsToPb(cfg.Metrics),
+			RestApi:               restAPIToPb(cfg.RESTAPI),
+			AutoconnectRules:      autoConnectRulesToPb(cfg.AutoConnectRules),
+			LocalProxy:            localProxyToPb(cfg.LocalProxy),
+			ServerPorts:           serverPortsToPb(cfg.ServerPorts),
+			AutoTechnology:        cfg.AutoTechnology,
 		},
 	}, nil
 }
@@ -66,7 +92,55 @@ func (r RPC) SettingsTechnologies(ctx context.Context, _ *pb.Empty) (*pb.Payload
 	return &pb.Payload{
 		Type: internal.CodeSuccess,
 		Data: []string{
-			config.Technology_OPENVPN.String(), config.Technology_NORDLYNX.String(),
+			config.Technology_OPENVPN.String(), config.Technology_NORDLYNX.String(), autoTechnologyLabel,
 		},
 	}, nil
 }
+
+func allowlistToPb(allowlist config.Allowlist) *pb.Allowlist {
+	ports := pb.Ports{}
+	for port := range allowlist.Ports.TCP {
+		ports.Tcp = append(ports.Tcp, port)
+	}
+	for port := range allowlist.Ports.UDP {
+		ports.Udp = append(ports.Udp, port)
+	}
+
+	subnets := []string{}
+	for subnet := range allowlist.Subnets {
+		subnets = append(subnets, subnet)
+	}
+	var expiresAt map[string]int64
+	for key, expiry := range allowlist.Expiry {
+		if expiresAt == nil {
+			expiresAt = map[string]int64{}
+		}
+		expiresAt[key] = expiry.Unix()
+	}
+	return &pb.Allowlist{
+		Ports:     &ports,
+		Subnets:   subnets,
+		Domains:   allowlist.Domains.ToSlice(),
+		ExpiresAt: expiresAt,
+	}
+}
+
+func allowlistFromPb(allowlist *pb.Allowlist) config.Allowlist {
+	result := config.NewAllowlist(
+		allowlist.GetPorts().GetUdp(),
+		allowlist.GetPorts().GetTcp(),
+		allowlist.GetSubnets(),
+	)
+	result.Domains = config.NewDomains(allowlist.GetDomains())
+	// expiry of the removed entries is forgotten
+	for key, expiresAt := range allowlist.GetExpiresAt() {
+		if !result.Has(key) {
+			continue
+		}
+		if result.Expiry == nil {
+			result.Expiry = map[string]time.Time{}
+		}
+		result.Expiry[key] = time.Unix(expiresAt, 0)
+	}
+	return result
+}
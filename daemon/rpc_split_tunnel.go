@@ -0,0 +1,100 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/splittunnel"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/slices"
+)
+
+// SetSplitTunnelApp adds the application to the split tunnel or removes it
+func (r *RPC) SetSplitTunnelApp(ctx context.Context, in *pb.SetSplitTunnelAppRequest) (*pb.Payload, error) {
+	app := strings.TrimSpace(in.GetApp())
+	if !splittunnel.IsValidApp(app) {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+	if filepath.IsAbs(app) {
+		app = filepath.Clean(app)
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	if slices.Contains(cfg.SplitTunnel.Apps, app) == in.GetEnabled() {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	apps := []string{}
+	for _, a := range cfg.SplitTunnel.Apps {
+		if a != app {
+			apps = append(apps, a)
+		}
+	}
+	if in.GetEnabled() {
+		apps = append(apps, app)
+	}
+
+	return r.saveSplitTunnel(config.SplitTunnel{Mode: cfg.SplitTunnel.Mode, Apps: apps}), nil
+}
+
+// SetSplitTunnelMode controls whether the split tunnel applications bypass the
+// VPN or are the only ones using it
+func (r *RPC) SetSplitTunnelMode(ctx context.Context, in *pb.SetSplitTunnelModeRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	mode := splitTunnelModeToConfig(in.GetMode())
+	if cfg.SplitTunnel.Mode == mode {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	return r.saveSplitTunnel(config.SplitTunnel{Mode: mode, Apps: cfg.SplitTunnel.Apps}), nil
+}
+
+func (r *RPC) saveSplitTunnel(splitTunnel config.SplitTunnel) *pb.Payload {
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.SplitTunnel = splitTunnel
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}
+	}
+
+	if err := r.netw.SetSplitTunnel(splitTunnel); err != nil {
+		log.Println(internal.ErrorPrefix, "setting split tunnel:", err)
+		// setting is saved and will be applied on the next connect if the
+		// cause is fixed
+		return &pb.Payload{Type: internal.CodeFailure, Data: []string{err.Error()}}
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}
+}
+
+func splitTunnelModeToConfig(mode pb.SplitTunnelMode) config.SplitTunnelMode {
+	switch mode {
+	case pb.SplitTunnelMode_SPLIT_TUNNEL_INCLUDE:
+		return config.SplitTunnelInclude
+	case pb.SplitTunnelMode_SPLIT_TUNNEL_EXCLUDE:
+		fallthrough
+	default:
+		return config.SplitTunnelExclude
+	}
+}
+
+func splitTunnelToPb(splitTunnel config.SplitTunnel) *pb.SplitTunnel {
+	mode := pb.SplitTunnelMode_SPLIT_TUNNEL_EXCLUDE
+	if splitTunnel.Mode == config.SplitTunnelInclude {
+		mode = pb.SplitTunnelMode_SPLIT_TUNNEL_INCLUDE
+	}
+	return &pb.SplitTunnel{Mode: mode, Apps: splitTunnel.Apps}
+}
@@ -0,0 +1,121 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetSplitTunnelApp(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      config.SplitTunnel
+		req          *pb.SetSplitTunnelAppRequest
+		saveErr      error
+		expected     config.SplitTunnel
+		expectedCode int64
+	}{
+		{
+			name:         "add",
+			current:      config.SplitTunnel{Apps: []string{"firefox"}},
+			req:          &pb.SetSplitTunnelAppRequest{App: "/usr/bin/../bin/curl", Enabled: true},
+			expected:     config.SplitTunnel{Apps: []string{"firefox", "/usr/bin/curl"}},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "remove keeps mode",
+			current:      config.SplitTunnel{Mode: config.SplitTunnelInclude, Apps: []string{"firefox", "curl"}},
+			req:          &pb.SetSplitTunnelAppRequest{App: "firefox"},
+			expected:     config.SplitTunnel{Mode: config.SplitTunnelInclude, Apps: []string{"curl"}},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already added",
+			current:      config.SplitTunnel{Apps: []string{"firefox"}},
+			req:          &pb.SetSplitTunnelAppRequest{App: "firefox", Enabled: true},
+			expected:     config.SplitTunnel{Apps: []string{"firefox"}},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "not added",
+			req:          &pb.SetSplitTunnelAppRequest{App: "firefox"},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "relative path",
+			req:          &pb.SetSplitTunnelAppRequest{App: "bin/firefox", Enabled: true},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "config failure",
+			req:          &pb.SetSplitTunnelAppRequest{App: "firefox", Enabled: true},
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.SplitTunnel = test.current
+			cm.SaveErr = test.saveErr
+			netw := networker.Mock{SplitTunnel: test.current}
+
+			r := RPC{cm: cm, netw: &netw}
+			resp, err := r.SetSplitTunnelApp(context.Background(), test.req)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.SplitTunnel)
+			assert.Equal(t, test.expected, netw.SplitTunnel)
+		})
+	}
+}
+
+func TestSetSplitTunnelMode(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      config.SplitTunnel
+		mode         pb.SplitTunnelMode
+		expected     config.SplitTunnel
+		expectedCode int64
+	}{
+		{
+			name:         "set include",
+			current:      config.SplitTunnel{Apps: []string{"firefox"}},
+			mode:         pb.SplitTunnelMode_SPLIT_TUNNEL_INCLUDE,
+			expected:     config.SplitTunnel{Mode: config.SplitTunnelInclude, Apps: []string{"firefox"}},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "already set",
+			mode:         pb.SplitTunnelMode_SPLIT_TUNNEL_EXCLUDE,
+			expectedCode: internal.CodeNothingToDo,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.SplitTunnel = test.current
+			netw := networker.Mock{SplitTunnel: test.current}
+
+			r := RPC{cm: cm, netw: &netw}
+			resp, err := r.SetSplitTunnelMode(context.Background(), &pb.SetSplitTunnelModeRequest{Mode: test.mode})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.SplitTunnel)
+			assert.Equal(t, test.expected, netw.SplitTunnel)
+		})
+	}
+}
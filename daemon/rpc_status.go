@@ -2,16 +2,30 @@ package daemon
 
 import (
 	"context"
+	"log"
+	"math"
+	"strings"
+	"time"
 
+	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
 )
 
+// statusWatchInterval is how often the status is sent to the watchers
+const statusWatchInterval = time.Second
+
 // Status of daemon and connection
 func (r *RPC) Status(context.Context, *pb.Empty) (*pb.StatusResponse, error) {
 	if !r.netw.IsVPNActive() {
+		var resumeIn int64
+		if r.pause != nil {
+			resumeIn = int64(r.pause.remainingTime().Seconds())
+		}
 		return &pb.StatusResponse{
-			State:  "Disconnected",
-			Uptime: -1,
+			State:    "Disconnected",
+			Uptime:   -1,
+			ResumeIn: resumeIn,
 		}, nil
 	}
 
@@ -24,6 +38,22 @@ func (r *RPC) Status(context.Context, *pb.Empty) (*pb.StatusResponse, error) {
 		uptime = -1
 	}
 
+	var idleDisconnectIn int64
+	if r.idleDisconnect != nil {
+		idleDisconnectIn = int64(r.idleDisconnect.remainingTime().Seconds())
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+	note, _ := cfg.ServerNotes.Get(status.Hostname)
+
+	var handshakeAge int64
+	if !status.LastHandshake.IsZero() {
+		handshakeAge = int64(math.Ceil(time.Since(status.LastHandshake).Seconds()))
+	}
+
 	switch status.State { //nolint:exhaustive
 	case "EXITING":
 		status.State = "Disconnecting"
@@ -38,15 +68,61 @@ func (r *RPC) Status(context.Context, *pb.Empty) (*pb.StatusResponse, error) {
 	}
 
 	return &pb.StatusResponse{
-		State:      string(status.State),
-		Technology: status.Technology,
-		Protocol:   status.Protocol,
-		Ip:         status.IP.String(),
-		Hostname:   status.Hostname,
-		Country:    status.Country,
-		City:       status.City,
-		Download:   status.Download,
-		Upload:     status.Upload,
-		Uptime:     uptime,
+		State:            string(status.State),
+		Technology:       status.Technology,
+		Protocol:         status.Protocol,
+		Ip:               status.IP.String(),
+		Hostname:         status.Hostname,
+		Country:          status.Country,
+		City:             status.City,
+		Download:         status.Download,
+		Upload:           status.Upload,
+		Uptime:           uptime,
+		IdleDisconnectIn: idleDisconnectIn,
+		Note:             note.Note,
+		Rating:           note.Rating,
+		Via:              status.Via,
+		DownloadRate:     status.Throughput.Download,
+		UploadRate:       status.Throughput.Upload,
+		HandshakeAge:     handshakeAge,
+		ServerLoad:       r.serverLoad(status.Hostname),
+		DataPlane:        status.DataPlane,
+		Uplink:           status.Uplink,
 	}, nil
 }
+
+// WatchStatus sends the status once per second until the client cancels
+func (r *RPC) WatchStatus(in *pb.Empty, srv pb.Daemon_WatchStatusServer) error {
+	ticker := time.NewTicker(statusWatchInterval)
+	defer ticker.Stop()
+	for {
+		status, err := r.Status(srv.Context(), in)
+		if err != nil {
+			return err
+		}
+		if err := srv.Send(status); err != nil {
+			return err
+		}
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// serverLoad returns the most recently fetched load of the server, as the
+// load changes during the connection
+func (r *RPC) serverLoad(hostname string) int64 {
+	if r.dm != nil {
+		for _, server := range r.dm.GetServersData().Servers {
+			if strings.EqualFold(server.Hostname, hostname) {
+				return server.Load
+			}
+		}
+	}
+	if strings.EqualFold(r.lastServer.Hostname, hostname) {
+		return r.lastServer.Load
+	}
+	return 0
+}
@@ -0,0 +1,77 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatus_TransferStatistics(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name          string
+		lastHandshake time.Time
+		servers       core.Servers
+		lastServer    core.Server
+		handshakeAge  int64
+		serverLoad    int64
+	}{
+		{
+			name:          "fetched server load",
+			lastHandshake: time.Now().Add(-29500 * time.Millisecond),
+			servers:       core.Servers{{Hostname: "de123.nordvpn.com", Load: 42}},
+			lastServer:    core.Server{Hostname: "de123.nordvpn.com", Load: 10},
+			handshakeAge:  30,
+			serverLoad:    42,
+		},
+		{
+			name:       "server load when connected",
+			lastServer: core.Server{Hostname: "de123.nordvpn.com", Load: 10},
+			serverLoad: 10,
+		},
+		{
+			name:       "unknown server",
+			lastServer: core.Server{Hostname: "us456.nordvpn.com", Load: 10},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dm := testNewDataManager()
+			dm.serversData.Servers = test.servers
+			r := RPC{
+				cm: mock.NewMockConfigManager(),
+				dm: dm,
+				netw: &testnetworker.Mock{
+					VpnActive: true,
+					ConnStatus: networker.ConnectionStatus{
+						Hostname:      "de123.nordvpn.com",
+						Download:      4096,
+						Upload:        1024,
+						Throughput:    networker.Throughput{Download: 2048, Upload: 512},
+						LastHandshake: test.lastHandshake,
+					},
+				},
+				lastServer: test.lastServer,
+			}
+
+			resp, err := r.Status(context.Background(), &pb.Empty{})
+			assert.NoError(t, err)
+			assert.Equal(t, uint64(4096), resp.Download)
+			assert.Equal(t, uint64(1024), resp.Upload)
+			assert.Equal(t, uint64(2048), resp.DownloadRate)
+			assert.Equal(t, uint64(512), resp.UploadRate)
+			assert.Equal(t, test.handshakeAge, resp.HandshakeAge)
+			assert.Equal(t, test.serverLoad, resp.ServerLoad)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"net/netip"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/slices"
+)
+
+// SetTrustedNetwork adds the current network to the trusted networks by its
+// connection name or SSID or removes the network from them. The network is
+// pinned to the hardware address of its gateway, so that another network with
+// the same name is not trusted.
+func (r *RPC) SetTrustedNetwork(ctx context.Context, in *pb.SetTrustedNetworkRequest) (*pb.Payload, error) {
+	network := normalizeTrustedNetwork(in.GetNetwork())
+	if network == "" {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	var address string
+	if in.GetTrusted() {
+		// subnets and gateway addresses are shared by unrelated networks
+		if _, err := netip.ParsePrefix(network); err == nil {
+			return &pb.Payload{Type: internal.CodeFormatError}, nil
+		}
+		connection, addr, err := r.trustedNetworks.identify()
+		if err != nil || addr == "" {
+			log.Println(internal.ErrorPrefix, "identifying network:", err)
+			return &pb.Payload{Type: internal.CodeGatewayError}, nil
+		}
+		if network != connection.Name && network != connection.SSID {
+			return &pb.Payload{Type: internal.CodeConflict}, nil
+		}
+		address = addr
+	}
+
+	if slices.Contains(cfg.TrustedNetworks, network) == in.GetTrusted() &&
+		cfg.TrustedNetworkAddresses[network] == address {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		networks := []string{}
+		for _, n := range c.TrustedNetworks {
+			if n != network {
+				networks = append(networks, n)
+			}
+		}
+		addresses := map[string]string{}
+		for n, a := range c.TrustedNetworkAddresses {
+			if n != network {
+				addresses[n] = a
+			}
+		}
+		if in.GetTrusted() {
+			networks = append(networks, network)
+			addresses[network] = address
+		}
+		c.TrustedNetworks = networks
+		c.TrustedNetworkAddresses = addresses
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
@@ -0,0 +1,144 @@
+package daemon
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/metered"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetTrustedNetwork(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	home := metered.Connection{Name: "HomeLAN 1", SSID: "HomeLAN"}
+	tests := []struct {
+		name              string
+		current           []string
+		currentAddresses  map[string]string
+		connection        metered.Connection
+		mac               string
+		req               *pb.SetTrustedNetworkRequest
+		saveErr           error
+		expected          []string
+		expectedAddresses map[string]string
+		expectedCode      int64
+	}{
+		{
+			name:              "add",
+			current:           []string{"Office"},
+			currentAddresses:  map[string]string{"Office": "11:22:33:44:55:66"},
+			connection:        home,
+			mac:               "aa:bb:cc:dd:ee:ff",
+			req:               &pb.SetTrustedNetworkRequest{Network: "HomeLAN", Trusted: true},
+			expected:          []string{"Office", "HomeLAN"},
+			expectedAddresses: map[string]string{"Office": "11:22:33:44:55:66", "HomeLAN": "aa:bb:cc:dd:ee:ff"},
+			expectedCode:      internal.CodeSuccess,
+		},
+		{
+			name:              "remove",
+			current:           []string{"HomeLAN", "Office"},
+			currentAddresses:  map[string]string{"Office": "11:22:33:44:55:66", "HomeLAN": "aa:bb:cc:dd:ee:ff"},
+			req:               &pb.SetTrustedNetworkRequest{Network: "HomeLAN"},
+			expected:          []string{"Office"},
+			expectedAddresses: map[string]string{"Office": "11:22:33:44:55:66"},
+			expectedCode:      internal.CodeSuccess,
+		},
+		{
+			name:              "subnet added before can be removed",
+			current:           []string{"HomeLAN", "10.0.0.0/24"},
+			currentAddresses:  map[string]string{"HomeLAN": "aa:bb:cc:dd:ee:ff"},
+			req:               &pb.SetTrustedNetworkRequest{Network: "10.0.0.7/24"},
+			expected:          []string{"HomeLAN"},
+			expectedAddresses: map[string]string{"HomeLAN": "aa:bb:cc:dd:ee:ff"},
+			expectedCode:      internal.CodeSuccess,
+		},
+		{
+			name:              "gateway changed",
+			current:           []string{"HomeLAN"},
+			currentAddresses:  map[string]string{"HomeLAN": "aa:bb:cc:dd:ee:00"},
+			connection:        home,
+			mac:               "aa:bb:cc:dd:ee:ff",
+			req:               &pb.SetTrustedNetworkRequest{Network: "HomeLAN", Trusted: true},
+			expected:          []string{"HomeLAN"},
+			expectedAddresses: map[string]string{"HomeLAN": "aa:bb:cc:dd:ee:ff"},
+			expectedCode:      internal.CodeSuccess,
+		},
+		{
+			name:              "already added",
+			current:           []string{"HomeLAN"},
+			currentAddresses:  map[string]string{"HomeLAN": "aa:bb:cc:dd:ee:ff"},
+			connection:        home,
+			mac:               "aa:bb:cc:dd:ee:ff",
+			req:               &pb.SetTrustedNetworkRequest{Network: "HomeLAN", Trusted: true},
+			expected:          []string{"HomeLAN"},
+			expectedAddresses: map[string]string{"HomeLAN": "aa:bb:cc:dd:ee:ff"},
+			expectedCode:      internal.CodeNothingToDo,
+		},
+		{
+			name:         "not added",
+			req:          &pb.SetTrustedNetworkRequest{Network: "HomeLAN"},
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "subnet",
+			connection:   home,
+			mac:          "aa:bb:cc:dd:ee:ff",
+			req:          &pb.SetTrustedNetworkRequest{Network: "10.0.0.0/24", Trusted: true},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "not joined",
+			connection:   metered.Connection{Name: "Airport"},
+			mac:          "aa:bb:cc:dd:ee:ff",
+			req:          &pb.SetTrustedNetworkRequest{Network: "HomeLAN", Trusted: true},
+			expectedCode: internal.CodeConflict,
+		},
+		{
+			name:         "gateway unknown",
+			connection:   metered.Connection{Name: "HomeLAN"},
+			req:          &pb.SetTrustedNetworkRequest{Network: "HomeLAN", Trusted: true},
+			expectedCode: internal.CodeGatewayError,
+		},
+		{
+			name:         "empty name",
+			req:          &pb.SetTrustedNetworkRequest{Network: " ", Trusted: true},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "config failure",
+			connection:   home,
+			mac:          "aa:bb:cc:dd:ee:ff",
+			req:          &pb.SetTrustedNetworkRequest{Network: "HomeLAN", Trusted: true},
+			saveErr:      mock.ErrOnPurpose,
+			expectedCode: internal.CodeConfigError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.TrustedNetworks = test.current
+			cm.Cfg.TrustedNetworkAddresses = test.currentAddresses
+			cm.SaveErr = test.saveErr
+
+			r := RPC{cm: cm, trustedNetworks: &trustedNetworks{
+				detector:     &mockMeteredDetector{connection: test.connection},
+				gateway:      &mockTrustedGateway{addr: netip.MustParseAddr("192.168.1.1")},
+				hardwareAddr: gatewayHardwareAddr(test.mac),
+			}}
+			resp, err := r.SetTrustedNetwork(context.Background(), test.req)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.TrustedNetworks)
+			if test.expectedAddresses != nil {
+				assert.Equal(t, test.expectedAddresses, cm.Cfg.TrustedNetworkAddresses)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"net"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/device"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// TunnelOverhead estimates the encapsulation overhead of the active tunnel and
+// optionally measures it
+func (r *RPC) TunnelOverhead(ctx context.Context, in *pb.TunnelOverheadRequest) (*pb.TunnelOverheadResponse, error) {
+	if in.GetMeasureSeconds() > maxTunnelMeasurement {
+		return &pb.TunnelOverheadResponse{Type: internal.CodeFormatError}, nil
+	}
+
+	if !r.netw.IsVPNActive() {
+		return &pb.TunnelOverheadResponse{Type: internal.CodeVPNNotRunning}, nil
+	}
+	status, err := r.netw.ConnectionStatus()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "retrieving connection status:", err)
+		return &pb.TunnelOverheadResponse{Type: internal.CodeVPNNotRunning}, nil
+	}
+
+	tunnel, err := net.InterfaceByName(status.Interface)
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "retrieving tunnel interface:", err)
+		return &pb.TunnelOverheadResponse{Type: internal.CodeFailure}, nil
+	}
+	link, err := device.DefaultGateway(status.IP.Is6())
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "retrieving link interface:", err)
+		return &pb.TunnelOverheadResponse{Type: internal.CodeFailure}, nil
+	}
+
+	overhead := tunnelOverhead(status.Technology, status.Protocol, status.IP.Is6())
+	resp := &pb.TunnelOverheadResponse{
+		Type:          internal.CodeSuccess,
+		Technology:    status.Technology,
+		Protocol:      status.Protocol,
+		Interface:     tunnel.Name,
+		TunnelMtu:     uint32(tunnel.MTU),
+		LinkInterface: link.Name,
+		LinkMtu:       uint32(link.MTU),
+		Overhead:      overhead,
+		Goodput:       goodput(uint32(tunnel.MTU), overhead),
+		LinkGoodput:   goodput(uint32(link.MTU), 0),
+	}
+
+	if in.GetMeasureSeconds() == 0 {
+		return resp, nil
+	}
+	measurement, err := measureTunnel(ctx, tunnel.Name, link.Name, time.Duration(in.GetMeasureSeconds())*time.Second)
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "measuring tunnel overhead:", err)
+		return &pb.TunnelOverheadResponse{Type: internal.CodeFailure}, nil
+	}
+	measurement.DurationSeconds = in.GetMeasureSeconds()
+	resp.Measurement = measurement
+	return resp, nil
+}
+
+// measureTunnel counts the traffic on the tunnel and the link interfaces
+func measureTunnel(ctx context.Context, tunnel string, link string, duration time.Duration) (*pb.TunnelMeasurement, error) {
+	tunnelStart, err := readInterfaceStats(tunnel)
+	if err != nil {
+		return nil, err
+	}
+	linkStart, err := readInterfaceStats(link)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(duration):
+	}
+
+	tunnelEnd, err := readInterfaceStats(tunnel)
+	if err != nil {
+		return nil, err
+	}
+	linkEnd, err := readInterfaceStats(link)
+	if err != nil {
+		return nil, err
+	}
+
+	tunnelStats := tunnelEnd.sub(tunnelStart)
+	linkStats := linkEnd.sub(linkStart)
+	overhead, goodput := measuredOverhead(tunnelStats, linkStats)
+	return &pb.TunnelMeasurement{
+		TunnelBytes:   tunnelStats.bytes,
+		TunnelPackets: tunnelStats.packets,
+		LinkBytes:     linkStats.bytes,
+		Overhead:      overhead,
+		Goodput:       goodput,
+	}, nil
+}
@@ -0,0 +1,181 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// selfTestTimeout limits the duration of a single check, so that the whole
+// self-test takes no longer than the slowest check
+const selfTestTimeout = 5 * time.Second
+
+var errSelfTestTimeout = errors.New("timed out")
+
+// SelfTestCheck verifies a single requirement of the daemon environment
+type SelfTestCheck struct {
+	Name string
+	// Critical check failure makes the daemon not ready
+	Critical bool
+	Run      func() error
+}
+
+// SelfTestResult is the outcome of a single check
+type SelfTestResult struct {
+	Name     string
+	Critical bool
+	Err      error
+}
+
+// SelfTest runs environment checks on the daemon startup, so that problems
+// are detected before they surface as connect failures
+type SelfTest struct {
+	checks  []SelfTestCheck
+	timeout time.Duration
+	mu      sync.Mutex
+	results []SelfTestResult
+	done    bool
+}
+
+// NewSelfTest creates a SelfTest which runs the given checks
+func NewSelfTest(checks []SelfTestCheck) *SelfTest {
+	return &SelfTest{checks: checks, timeout: selfTestTimeout}
+}
+
+// Run all the checks concurrently and log the summary
+func (s *SelfTest) Run() {
+	results := make([]SelfTestResult, len(s.checks))
+	var wg sync.WaitGroup
+	for idx, check := range s.checks {
+		wg.Add(1)
+		go func(idx int, check SelfTestCheck) {
+			defer wg.Done()
+			results[idx] = SelfTestResult{
+				Name:     check.Name,
+				Critical: check.Critical,
+				Err:      runWithTimeout(check.Run, s.timeout),
+			}
+		}(idx, check)
+	}
+	wg.Wait()
+
+	s.mu.Lock()
+	s.results = results
+	s.done = true
+	s.mu.Unlock()
+
+	logSelfTest(results)
+}
+
+// Results returns the results of the checks and whether the self-test has finished
+func (s *SelfTest) Results() ([]SelfTestResult, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.results, s.done
+}
+
+// Ready reports whether the self-test has finished and no critical check failed
+func (s *SelfTest) Ready() bool {
+	results, done := s.Results()
+	if !done {
+		return false
+	}
+	for _, result := range results {
+		if result.Critical && result.Err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func runWithTimeout(check func() error, timeout time.Duration) error {
+	errCh := make(chan error, 1)
+	go func() { errCh <- check() }()
+	select {
+	case err := <-errCh:
+		return err
+	case <-time.After(timeout):
+		return errSelfTestTimeout
+	}
+}
+
+func logSelfTest(results []SelfTestResult) {
+	var failed []string
+	for _, result := range results {
+		switch {
+		case result.Err == nil:
+			log.Println(internal.InfoPrefix, "self-test", result.Name+": passed")
+		case result.Critical:
+			failed = append(failed, result.Name)
+			log.Println(internal.ErrorPrefix, "self-test", result.Name+":", result.Err)
+		default:
+			log.Println(internal.WarningPrefix, "self-test", result.Name+":", result.Err)
+		}
+	}
+	if len(failed) > 0 {
+		log.Println(internal.ErrorPrefix, "self-test failed, daemon is not ready:", strings.Join(failed, ", "))
+		return
+	}
+	log.Println(internal.InfoPrefix, "self-test passed, daemon is ready")
+}
+
+// CheckFilesExist returns a check which fails if any of the files is missing
+func CheckFilesExist(paths ...string) func() error {
+	return func() error {
+		var missing []string
+		for _, path := range paths {
+			if !internal.FileExists(path) {
+				missing = append(missing, path)
+			}
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("missing %s", strings.Join(missing, ", "))
+		}
+		return nil
+	}
+}
+
+// CheckCommandsAvailable returns a check which fails if any of the commands is missing
+// or no commands are given
+func CheckCommandsAvailable(commands ...string) func() error {
+	return func() error {
+		if len(commands) == 0 {
+			return errors.New("no commands are supported on this platform")
+		}
+		var missing []string
+		for _, command := range commands {
+			if !internal.IsCommandAvailable(command) {
+				missing = append(missing, command)
+			}
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("%s not found", strings.Join(missing, ", "))
+		}
+		return nil
+	}
+}
+
+// CheckURLReachable returns a check which fails if no HTTP response is received from
+// the URL. Any response status is accepted.
+func CheckURLReachable(client *http.Client, url string) func() error {
+	return func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), selfTestTimeout)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+}
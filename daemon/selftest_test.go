@@ -0,0 +1,156 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/diagnostics"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+)
+
+func passingCheck() error { return nil }
+
+func failingCheck() error { return errors.New("failed") }
+
+func TestSelfTest_Ready(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name   string
+		checks []SelfTestCheck
+		ready  bool
+	}{
+		{
+			name: "all passed",
+			checks: []SelfTestCheck{
+				{Name: "a", Critical: true, Run: passingCheck},
+				{Name: "b", Run: passingCheck},
+			},
+			ready: true,
+		},
+		{
+			name: "non-critical failed",
+			checks: []SelfTestCheck{
+				{Name: "a", Critical: true, Run: passingCheck},
+				{Name: "b", Run: failingCheck},
+			},
+			ready: true,
+		},
+		{
+			name: "critical failed",
+			checks: []SelfTestCheck{
+				{Name: "a", Critical: true, Run: failingCheck},
+				{Name: "b", Run: passingCheck},
+			},
+			ready: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			selfTest := NewSelfTest(test.checks)
+			assert.False(t, selfTest.Ready())
+			_, done := selfTest.Results()
+			assert.False(t, done)
+
+			selfTest.Run()
+			assert.Equal(t, test.ready, selfTest.Ready())
+			results, done := selfTest.Results()
+			assert.True(t, done)
+			assert.Len(t, results, len(test.checks))
+		})
+	}
+}
+
+func TestSelfTest_Timeout(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	selfTest := NewSelfTest([]SelfTestCheck{
+		{Name: "slow", Critical: true, Run: func() error {
+			time.Sleep(time.Second)
+			return nil
+		}},
+	})
+	selfTest.timeout = 10 * time.Millisecond
+	selfTest.Run()
+
+	results, _ := selfTest.Results()
+	assert.ErrorIs(t, results[0].Err, errSelfTestTimeout)
+	assert.False(t, selfTest.Ready())
+}
+
+func TestCheckFilesExist(t *testing.T) {
+	category.Set(t, category.File)
+
+	dir := t.TempDir()
+	assert.NoError(t, CheckFilesExist(dir)())
+	assert.Error(t, CheckFilesExist(dir, filepath.Join(dir, "missing"))())
+}
+
+func TestCheckCommandsAvailable(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.NoError(t, CheckCommandsAvailable("sh")())
+	assert.Error(t, CheckCommandsAvailable("sh", "nordvpn-missing-command")())
+	assert.Error(t, CheckCommandsAvailable()())
+}
+
+func TestHealth(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	selfTest := NewSelfTest([]SelfTestCheck{
+		{Name: "firewall backend", Critical: true, Run: passingCheck},
+		{Name: "API", Run: failingCheck},
+	})
+	leakTest := &recordingLeakTester{}
+	rpc := RPC{
+		selfTest: selfTest,
+		netw: &testnetworker.Mock{
+			VpnActive:  true,
+			ConnStatus: networker.ConnectionStatus{Interface: "nordlynx"},
+		},
+		leakTest: leakTest,
+	}
+
+	resp, err := rpc.Health(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.HealthResponse{
+		Type:      internal.CodeSuccess,
+		Connected: true,
+		Routed:    true,
+		Reason:    ErrDaemonNotReady.Error(),
+	}, resp)
+	assert.Equal(t, diagnostics.State{Connected: true, Tunnel: "nordlynx"}, leakTest.state)
+
+	selfTest.Run()
+	resp, err = rpc.Health(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.True(t, resp.Done)
+	assert.True(t, resp.Ready)
+	assert.Len(t, resp.Checks, 2)
+	assert.Equal(t, "firewall backend", resp.Checks[0].Name)
+	assert.True(t, resp.Checks[0].Critical)
+	assert.Empty(t, resp.Checks[0].Error)
+	assert.Equal(t, "API", resp.Checks[1].Name)
+	assert.Equal(t, "failed", resp.Checks[1].Error)
+	assert.True(t, resp.Healthy)
+	assert.NoError(t, rpc.CheckHealth())
+
+	leakTest.routingErr = mock.ErrOnPurpose
+	resp, err = rpc.Health(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.False(t, resp.Routed)
+	assert.False(t, resp.Healthy)
+	assert.Equal(t, mock.ErrOnPurpose.Error(), resp.Reason)
+	assert.ErrorIs(t, rpc.CheckHealth(), mock.ErrOnPurpose)
+}
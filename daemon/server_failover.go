@@ -0,0 +1,232 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// staleHandshakeTimeout is the age of the latest handshake after which the
+	// server is considered not responding. The keepalives make WireGuard renew
+	// the handshake every 2 minutes and the sessions older than 3 minutes are
+	// rejected.
+	staleHandshakeTimeout = 3 * time.Minute
+	// serverStatusInterval is how often the status of the connected server is
+	// checked with the API
+	serverStatusInterval = 5 * time.Minute
+)
+
+// failoverReasons describe the reasons in the notification
+var failoverReasons = map[events.TypeServerFailover]string{
+	events.ServerFailoverHandshake:   "stopped responding",
+	events.ServerFailoverOffline:     "went offline",
+	events.ServerFailoverMaintenance: "is under maintenance",
+	events.ServerFailoverWithdrawn:   "was withdrawn",
+}
+
+// serverHealth moves the connection to an equivalent server once the connected
+// one stops completing the handshakes or the API reports it as unavailable
+type serverHealth struct {
+	netw networker.Networker
+	api  core.ServersAPI
+	// lastServer returns the server connected to
+	lastServer func() core.Server
+	// failOver reconnects to an equivalent server for the reason
+	failOver func(events.TypeServerFailover) error
+	now      func() time.Time
+	mu       sync.Mutex
+	// serverID is the server the status was checked for
+	serverID int64
+	// checkedAt is when the status was checked, zero if it was not
+	checkedAt time.Time
+}
+
+func (h *serverHealth) check() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.netw.IsVPNActive() {
+		h.reset()
+		return
+	}
+
+	server := h.lastServer()
+	// dedicated IP is not shared with the other servers, so it has no
+	// equivalent
+	if server.ID == 0 || slices.ContainsFunc(server.Groups, core.ByGroup(config.DedicatedIP)) {
+		return
+	}
+
+	status, err := h.netw.ConnectionStatus()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "server health: retrieving connection status:", err)
+		return
+	}
+
+	reason, degraded := events.ServerFailoverHandshake, handshakeStale(status, h.now())
+	if !degraded {
+		reason, degraded = h.serverUnavailable(server)
+	}
+	if !degraded {
+		return
+	}
+
+	log.Println(internal.WarningPrefix, "server health:", server.Hostname, failoverReasons[reason],
+		"- reconnecting to an equivalent server")
+	h.reset()
+	if err := h.failOver(reason); err != nil {
+		log.Println(internal.ErrorPrefix, "server health: reconnecting to an equivalent server:", err)
+	}
+}
+
+func (h *serverHealth) reset() {
+	h.serverID = 0
+	h.checkedAt = time.Time{}
+}
+
+// handshakeStale reports whether the server stopped completing the handshakes.
+// The connections which do not report the handshakes, e.g. OpenVPN, are never
+// stale.
+func handshakeStale(status networker.ConnectionStatus, now time.Time) bool {
+	return !status.LastHandshake.IsZero() && now.Sub(status.LastHandshake) > staleHandshakeTimeout
+}
+
+// serverUnavailable checks the status of the server with the API once in the
+// serverStatusInterval. The server is considered available if the API cannot
+// be reached.
+func (h *serverHealth) serverUnavailable(server core.Server) (events.TypeServerFailover, bool) {
+	now := h.now()
+	if h.serverID == server.ID && now.Sub(h.checkedAt) < serverStatusInterval {
+		return 0, false
+	}
+	h.serverID, h.checkedAt = server.ID, now
+
+	current, err := h.api.Server(server.ID)
+	switch {
+	case errors.Is(err, core.ErrNotFound):
+		return events.ServerFailoverWithdrawn, true
+	case err != nil:
+		log.Println(internal.DebugPrefix, "server health: retrieving server status:", err)
+		return 0, false
+	}
+	switch current.Status {
+	case core.Offline:
+		return events.ServerFailoverOffline, true
+	case core.Maintenance:
+		return events.ServerFailoverMaintenance, true
+	}
+	return 0, false
+}
+
+// equivalentServer returns the least loaded server which can replace the given
+// one. It is connectable with the same settings, belongs to all of the groups of
+// the server and is in the same city, or in the same country if the city has no
+// other servers.
+func equivalentServer(
+	servers core.Servers,
+	server core.Server,
+	tech config.Technology,
+	protocol config.Protocol,
+	obfuscated bool,
+) (core.Server, error) {
+	country, err := server.Locations.Country()
+	if err != nil {
+		return core.Server{}, err
+	}
+
+	candidates := internal.Filter(servers, func(s core.Server) bool {
+		return s.ID != server.ID &&
+			core.IsConnectableWithProtocol(tech, protocol)(s) &&
+			core.IsObfuscated()(s) == obfuscated &&
+			len(s.Locations) > 0 && s.Locations[0].Country.Code == country.Code &&
+			!slices.ContainsFunc(server.Groups, func(g core.Group) bool {
+				return !slices.ContainsFunc(s.Groups, core.ByGroup(g.ID))
+			})
+	})
+	if len(candidates) == 0 {
+		return core.Server{}, internal.ErrServerIsUnavailable
+	}
+	if sameCity := internal.Filter(candidates, func(s core.Server) bool {
+		return s.Locations[0].Country.City.Name == country.City.Name
+	}); len(sameCity) > 0 {
+		candidates = sameCity
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].Load < candidates[j].Load })
+	return candidates[0], nil
+}
+
+// announceServerFailover tells the user why the connection is moved to another
+// server
+func (r *RPC) announceServerFailover(data events.DataServerFailover) {
+	if r.eventStream != nil {
+		if err := r.eventStream.NotifyServerFailover(data); err != nil {
+			log.Println(internal.WarningPrefix, "publishing server failover:", err)
+		}
+	}
+	if err := Notify(r.cm, internal.NotificationServerFailover, []string{
+		data.Hostname,
+		failoverReasons[data.Type],
+		data.Target,
+	}); err != nil {
+		log.Println(internal.WarningPrefix, "notifying about server failover:", err)
+	}
+}
+
+// failOver reconnects to the server equivalent to the connected one after it
+// degraded or was withdrawn. Multi-hop connection keeps its entry server.
+func (r *RPC) failOver(reason events.TypeServerFailover) error {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	server := r.lastServer
+	target, err := equivalentServer(
+		r.selectableServers(cfg),
+		server,
+		cfg.Technology,
+		cfg.AutoConnectData.Protocol,
+		cfg.AutoConnectData.Obfuscate,
+	)
+	if err != nil {
+		return fmt.Errorf("picking equivalent server: %w", err)
+	}
+
+	var via string
+	if status, err := r.netw.ConnectionStatus(); err == nil && status.Via != "" {
+		via = strings.Split(status.Via, ".")[0]
+	}
+
+	r.announceServerFailover(events.DataServerFailover{
+		Type:     reason,
+		Hostname: server.Hostname,
+		Target:   target.Hostname,
+	})
+	if err := r.disconnect(disconnectReasonFailover); err != nil {
+		return err
+	}
+	return r.connectWith(&pb.ConnectRequest{
+		ServerTag: strings.Split(target.Hostname, ".")[0],
+		Via:       via,
+	})
+}
+
+// JobServerHealth reconnects to an equivalent server once the connected one
+// degrades or is withdrawn
+func JobServerHealth(r *RPC) func() {
+	return r.health.check
+}
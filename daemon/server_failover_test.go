@@ -0,0 +1,266 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	mocknetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// healthNetworker reports the latest handshake of the connection
+type healthNetworker struct {
+	mocknetworker.Mock
+	active        bool
+	lastHandshake time.Time
+}
+
+func (n *healthNetworker) IsVPNActive() bool { return n.active }
+
+func (n *healthNetworker) ConnectionStatus() (networker.ConnectionStatus, error) {
+	return networker.ConnectionStatus{
+		Technology:    config.Technology_NORDLYNX,
+		LastHandshake: n.lastHandshake,
+	}, nil
+}
+
+// statusServersAPI returns the status of the connected server
+type statusServersAPI struct {
+	core.ServersAPI
+	status core.Status
+	err    error
+	calls  int
+}
+
+func (s *statusServersAPI) Server(id int64) (*core.Server, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &core.Server{ID: id, Status: s.status}, nil
+}
+
+func failoverTestServer(id int64, country string, city string, load int64, groups ...config.ServerGroup) core.Server {
+	server := core.Server{
+		ID:       id,
+		Hostname: fmt.Sprintf("%s%d.nordvpn.com", country, id),
+		Load:     load,
+		Status:   core.Online,
+		Locations: core.Locations{{Country: core.Country{
+			Code: country,
+			City: core.City{Name: city},
+		}}},
+		Technologies: core.Technologies{
+			{ID: core.WireguardTech, Pivot: core.Pivot{Status: core.Online}},
+		},
+	}
+	for _, group := range groups {
+		server.Groups = append(server.Groups, core.Group{ID: group})
+	}
+	return server
+}
+
+func TestHandshakeStale(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Now()
+	tests := []struct {
+		name          string
+		lastHandshake time.Time
+		expected      bool
+	}{
+		{name: "recent handshake", lastHandshake: now.Add(-2 * time.Minute)},
+		{name: "stale handshake", lastHandshake: now.Add(-staleHandshakeTimeout - time.Second), expected: true},
+		{name: "handshakes not reported"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status := networker.ConnectionStatus{LastHandshake: test.lastHandshake}
+			assert.Equal(t, test.expected, handshakeStale(status, now))
+		})
+	}
+}
+
+func TestServerHealth(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Now()
+	server := failoverTestServer(1, "de", "Berlin", 10, config.StandardVPNServers)
+	tests := []struct {
+		name          string
+		inactive      bool
+		server        core.Server
+		lastHandshake time.Time
+		status        core.Status
+		apiErr        error
+		reason        events.TypeServerFailover
+		failedOver    bool
+		apiCalls      int
+	}{
+		{
+			name:          "healthy",
+			lastHandshake: now.Add(-time.Minute),
+			status:        core.Online,
+			apiCalls:      1,
+		},
+		{
+			name:          "handshake stale",
+			lastHandshake: now.Add(-5 * time.Minute),
+			status:        core.Online,
+			reason:        events.ServerFailoverHandshake,
+			failedOver:    true,
+		},
+		{
+			name:       "offline",
+			status:     core.Offline,
+			reason:     events.ServerFailoverOffline,
+			failedOver: true,
+			apiCalls:   1,
+		},
+		{
+			name:       "maintenance",
+			status:     core.Maintenance,
+			reason:     events.ServerFailoverMaintenance,
+			failedOver: true,
+			apiCalls:   1,
+		},
+		{
+			name:       "withdrawn",
+			apiErr:     fmt.Errorf("server 1: %w", core.ErrNotFound),
+			reason:     events.ServerFailoverWithdrawn,
+			failedOver: true,
+			apiCalls:   1,
+		},
+		{
+			name:     "API unreachable",
+			apiErr:   errors.New("i/o timeout"),
+			apiCalls: 1,
+		},
+		{
+			name:          "dedicated IP",
+			server:        failoverTestServer(2, "de", "Berlin", 10, config.DedicatedIP),
+			lastHandshake: now.Add(-5 * time.Minute),
+			status:        core.Maintenance,
+		},
+		{
+			name:          "disconnected",
+			inactive:      true,
+			lastHandshake: now.Add(-5 * time.Minute),
+			status:        core.Maintenance,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			connected := server
+			if test.server.ID != 0 {
+				connected = test.server
+			}
+			api := &statusServersAPI{status: test.status, err: test.apiErr}
+			var reason *events.TypeServerFailover
+			h := serverHealth{
+				netw:       &healthNetworker{active: !test.inactive, lastHandshake: test.lastHandshake},
+				api:        api,
+				lastServer: func() core.Server { return connected },
+				failOver: func(r events.TypeServerFailover) error {
+					reason = &r
+					return nil
+				},
+				now: func() time.Time { return now },
+			}
+
+			h.check()
+			assert.Equal(t, test.apiCalls, api.calls)
+			if !test.failedOver {
+				assert.Nil(t, reason)
+				return
+			}
+			require.NotNil(t, reason)
+			assert.Equal(t, test.reason, *reason)
+		})
+	}
+}
+
+func TestServerHealth_ChecksStatusPeriodically(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Now()
+	server := failoverTestServer(1, "de", "Berlin", 10)
+	api := &statusServersAPI{status: core.Online}
+	h := serverHealth{
+		netw:       &healthNetworker{active: true},
+		api:        api,
+		lastServer: func() core.Server { return server },
+		failOver:   func(events.TypeServerFailover) error { return errors.New("should not be called") },
+		now:        func() time.Time { return now },
+	}
+
+	h.check()
+	now = now.Add(serverStatusInterval / 2)
+	h.check()
+	assert.Equal(t, 1, api.calls)
+
+	now = now.Add(serverStatusInterval / 2)
+	h.check()
+	assert.Equal(t, 2, api.calls)
+
+	// the status of another server is checked right away
+	server = failoverTestServer(2, "de", "Berlin", 10)
+	h.check()
+	assert.Equal(t, 3, api.calls)
+}
+
+func TestEquivalentServer(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	current := failoverTestServer(1, "de", "Berlin", 10, config.StandardVPNServers, config.P2P)
+	otherGroup := failoverTestServer(2, "de", "Berlin", 1, config.StandardVPNServers)
+	sameCity := failoverTestServer(3, "de", "Berlin", 50, config.StandardVPNServers, config.P2P)
+	sameCountry := failoverTestServer(4, "de", "Frankfurt", 5, config.StandardVPNServers, config.P2P)
+	otherCountry := failoverTestServer(5, "nl", "Amsterdam", 1, config.StandardVPNServers, config.P2P)
+	openVPN := failoverTestServer(6, "de", "Berlin", 1, config.StandardVPNServers, config.P2P)
+	openVPN.Technologies = core.Technologies{{ID: core.OpenVPNUDP, Pivot: core.Pivot{Status: core.Online}}}
+	offline := failoverTestServer(7, "de", "Berlin", 1, config.StandardVPNServers, config.P2P)
+	offline.Status = core.Maintenance
+
+	tests := []struct {
+		name     string
+		servers  core.Servers
+		expected core.Server
+		err      error
+	}{
+		{
+			name:     "same city",
+			servers:  core.Servers{current, otherGroup, sameCity, sameCountry, otherCountry, openVPN, offline},
+			expected: sameCity,
+		},
+		{
+			name:     "same country",
+			servers:  core.Servers{current, otherGroup, sameCountry, otherCountry},
+			expected: sameCountry,
+		},
+		{
+			name:    "none",
+			servers: core.Servers{current, otherGroup, otherCountry, openVPN, offline},
+			err:     internal.ErrServerIsUnavailable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, err := equivalentServer(test.servers, current, config.Technology_NORDLYNX, config.Protocol_UDP, false)
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.expected.ID, server.ID)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"math"
 	"math/rand"
 	"regexp"
 	"strings"
@@ -17,7 +18,15 @@ import (
 
 var tag = regexp.MustCompile(`^[a-z]{2}[0-9]{2,4}$`)
 
-// PickServer by the specified criteria.
+const (
+	// recommendedServersLimit is the number of recommended servers requested from the API
+	recommendedServersLimit = 20
+	// neutralServerRating neither increases nor decreases the chance of the server to be picked
+	neutralServerRating = 3
+)
+
+// PickServer by the specified criteria. When ratingWeight is set, servers rated by the user
+// higher are more likely to be picked from the recommended ones.
 func PickServer(
 	api core.ServersAPI,
 	countries core.Countries,
@@ -29,7 +38,13 @@ func PickServer(
 	obfuscated bool,
 	tag string,
 	groupFlag string,
+	notes config.ServerNotes,
+	ratingWeight uint32,
 ) (core.Server, bool, error) {
+	count := 1
+	if ratingWeight > 0 && len(notes) > 0 {
+		count = recommendedServersLimit
+	}
 	result, remote, err := getServers(
 		api,
 		countries,
@@ -41,14 +56,105 @@ func PickServer(
 		obfuscated,
 		tag,
 		groupFlag,
-		1,
+		count,
 	)
 	if err != nil {
 		return core.Server{}, remote, err
 	}
 
 	// #nosec G404 -- not used for cryptographic purposes
-	return result[rand.Intn(len(result))], remote, nil
+	return pickWeightedByRating(result, notes, ratingWeight, rand.Float64), remote, nil
+}
+
+// PickRandomServer picks a random server by the specified criteria from the locally stored
+// servers instead of the recommended ones. Servers with lower load are more likely to be
+// picked.
+func PickRandomServer(
+	servers core.Servers,
+	tech config.Technology,
+	protocol config.Protocol,
+	obfuscated bool,
+	tag string,
+	groupFlag string,
+) (core.Server, error) {
+	serverGroup, err := resolveServerGroup(groupFlag, tag)
+	if err != nil {
+		return core.Server{}, err
+	}
+
+	// server keys contain no spaces, e.g. country and city are stored as "germanyberlin"
+	tag = strings.ReplaceAll(tag, " ", "")
+	if tag != "" && !slices.ContainsFunc(servers, func(s core.Server) bool {
+		return slices.Contains(s.Keys, tag)
+	}) {
+		return core.Server{}, internal.ErrTagDoesNotExist
+	}
+
+	result, err := filterServers(servers, tech, protocol, tag, serverGroup, obfuscated)
+	if err != nil {
+		return core.Server{}, err
+	}
+
+	// #nosec G404 -- not used for cryptographic purposes
+	return pickWeightedByLoad(result, rand.Float64), nil
+}
+
+// pickWeightedByLoad picks a server with the probability proportional to its free capacity,
+// fully loaded servers still have a small chance to be picked
+func pickWeightedByLoad(servers []core.Server, randFloat func() float64) core.Server {
+	weight := func(s core.Server) int64 {
+		switch {
+		case s.Load >= 100:
+			return 1
+		case s.Load <= 0:
+			return 101
+		default:
+			return 101 - s.Load
+		}
+	}
+
+	var total int64
+	for _, server := range servers {
+		total += weight(server)
+	}
+
+	pick := int64(randFloat() * float64(total))
+	for _, server := range servers {
+		pick -= weight(server)
+		if pick < 0 {
+			return server
+		}
+	}
+	return servers[len(servers)-1]
+}
+
+// pickWeightedByRating picks a server with the probability depending on the rating given
+// by the user. Every star above or below the neutral rating multiplies or divides the
+// chance by 1+weight/100, unrated servers are treated as neutral.
+func pickWeightedByRating(
+	servers []core.Server,
+	notes config.ServerNotes,
+	weight uint32,
+	randFloat func() float64,
+) core.Server {
+	weights := make([]float64, len(servers))
+	var total float64
+	for i, server := range servers {
+		weights[i] = 1
+		if note, ok := notes.Get(server.Hostname); ok && note.Rating != 0 {
+			weights[i] = math.Pow(1+float64(weight)/100, float64(note.Rating)-neutralServerRating)
+		}
+		total += weights[i]
+	}
+
+	pick := randFloat() * total
+	for i, server := range servers {
+		pick -= weights[i]
+		if pick < 0 {
+			return server
+		}
+	}
+	return servers[len(servers)-1]
 }
 
 func getServers(
@@ -188,7 +294,7 @@ func getServersRemote(
 	if serverTech == core.Unknown {
 		return nil, errors.New("unknown technology")
 	}
-	limit := 20
+	limit := recommendedServersLimit
 	if count != 1 {
 		limit = count
 	}
@@ -0,0 +1,44 @@
+package daemon
+
+import (
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// favoritesServerTag is the server tag which connects to one of the favorites,
+// e.g. 'nordvpn connect favorites'
+const favoritesServerTag = "favorites"
+
+// pickFavoriteServer picks a server for every favorite and returns the least
+// loaded one. Favorites whose servers cannot be picked, e.g. because they went
+// offline, are skipped.
+func pickFavoriteServer(
+	favorites config.Favorites,
+	pick func(tag string, group string) (core.Server, bool, error),
+) (core.Server, bool, error) {
+	if len(favorites) == 0 {
+		return core.Server{}, false, internal.ErrNoFavorites
+	}
+
+	var best core.Server
+	var bestRemote, found bool
+	var lastErr error
+	for _, favorite := range favorites {
+		server, remote, err := pick(favorite.ServerTag, favorite.ServerGroup)
+		if err != nil {
+			log.Println(internal.WarningPrefix, "favorite", favorite.Name, err)
+			lastErr = err
+			continue
+		}
+		if !found || server.Load < best.Load {
+			best, bestRemote, found = server, remote, true
+		}
+	}
+	if !found {
+		return core.Server{}, false, lastErr
+	}
+	return best, bestRemote, nil
+}
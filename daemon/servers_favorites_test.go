@@ -0,0 +1,73 @@
+package daemon
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPickFavoriteServer(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	servers := map[string]core.Server{
+		"de1024":  {Hostname: "de1024.nordvpn.com", Load: 40},
+		"lt10":    {Hostname: "lt10.nordvpn.com", Load: 10},
+		"germany": {Hostname: "de1.nordvpn.com", Load: 20},
+	}
+	pick := func(tag string, group string) (core.Server, bool, error) {
+		server, ok := servers[tag]
+		if !ok {
+			return core.Server{}, false, internal.ErrServerIsUnavailable
+		}
+		return server, tag == "germany", nil
+	}
+
+	tests := []struct {
+		name           string
+		favorites      config.Favorites
+		expected       string
+		expectedRemote bool
+		expectedErr    error
+	}{
+		{
+			name: "least loaded",
+			favorites: config.Favorites{
+				{Name: "de1024", ServerTag: "de1024"},
+				{Name: "lt10", ServerTag: "lt10"},
+			},
+			expected: "lt10.nordvpn.com",
+		},
+		{
+			name: "unavailable are skipped",
+			favorites: config.Favorites{
+				{Name: "uk5", ServerTag: "uk5"},
+				{Name: "office", ServerTag: "germany"},
+				{Name: "de1024", ServerTag: "de1024"},
+			},
+			expected:       "de1.nordvpn.com",
+			expectedRemote: true,
+		},
+		{
+			name:        "none available",
+			favorites:   config.Favorites{{Name: "uk5", ServerTag: "uk5"}},
+			expectedErr: internal.ErrServerIsUnavailable,
+		},
+		{
+			name:        "no favorites",
+			expectedErr: internal.ErrNoFavorites,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, remote, err := pickFavoriteServer(test.favorites, pick)
+			assert.ErrorIs(t, err, test.expectedErr)
+			assert.Equal(t, test.expected, server.Hostname)
+			assert.Equal(t, test.expectedRemote, remote)
+		})
+	}
+}
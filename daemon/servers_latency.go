@@ -0,0 +1,94 @@
+package daemon
+
+import (
+	"log"
+	"sort"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// PickServerByLatency picks the server by the specified criteria after probing
+// the shortlist of the candidates. Depending on the selection, the one with the
+// lowest latency or with the best combination of the latency and the load wins.
+func PickServerByLatency(
+	prober ServerProber,
+	api core.ServersAPI,
+	countries core.Countries,
+	servers core.Servers,
+	longitude float64,
+	latitude float64,
+	tech config.Technology,
+	protocol config.Protocol,
+	obfuscated bool,
+	tag string,
+	groupFlag string,
+	selection config.ServerSelection,
+) (core.Server, bool, error) {
+	candidates, remote, err := getServers(
+		api,
+		countries,
+		servers,
+		longitude,
+		latitude,
+		tech,
+		protocol,
+		obfuscated,
+		tag,
+		groupFlag,
+		recommendedServersLimit,
+	)
+	if err != nil {
+		return core.Server{}, remote, err
+	}
+	if !remote {
+		// unlike the recommendations, local servers are not ordered
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].Load < candidates[j].Load
+		})
+	}
+	return pickByLatency(prober, candidates, selection), remote, nil
+}
+
+// pickByLatency probes the first servers concurrently and picks the one with the
+// lowest score. The first server is picked if none of them responds.
+func pickByLatency(prober ServerProber, servers []core.Server, selection config.ServerSelection) core.Server {
+	if len(servers) == 1 {
+		return servers[0]
+	}
+	if len(servers) > maxProbedServers {
+		servers = servers[:maxProbedServers]
+	}
+
+	var best *probedServer
+	var bestScore float64
+	probed := probeServers(prober, servers)
+	for i, result := range probed {
+		if result.err != nil {
+			continue
+		}
+		if score := latencyScore(result, selection); best == nil || score < bestScore {
+			best, bestScore = &probed[i], score
+		}
+	}
+
+	if best == nil {
+		log.Println(internal.WarningPrefix, "none of the servers responded to the probes")
+		return servers[0]
+	}
+	log.Println(internal.InfoPrefix, "picked server", best.server.Hostname,
+		"with latency", best.latency, "and load", best.server.Load)
+	return best.server
+}
+
+// latencyScore is lower for the better servers. For the auto selection, the latency
+// grows with the load, so that a bit more distant but idle server is preferred over
+// a nearby one which is busy.
+func latencyScore(result probedServer, selection config.ServerSelection) float64 {
+	score := float64(result.latency)
+	if selection == config.ServerSelectionAuto {
+		score *= 1 + float64(result.server.Load)/100
+	}
+	return score
+}
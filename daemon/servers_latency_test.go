@@ -0,0 +1,84 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPickByLatency(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	newServer := func(hostname string, station string, load int64) core.Server {
+		return core.Server{Hostname: hostname, Station: station, Load: load}
+	}
+	servers := []core.Server{
+		newServer("de1.nordvpn.com", "192.0.2.1", 10),
+		newServer("de2.nordvpn.com", "192.0.2.2", 90),
+		newServer("de3.nordvpn.com", "192.0.2.3", 5),
+	}
+
+	tests := []struct {
+		name      string
+		servers   []core.Server
+		latencies map[string]time.Duration
+		selection config.ServerSelection
+		expected  string
+	}{
+		{
+			name:    "lowest latency",
+			servers: servers,
+			latencies: map[string]time.Duration{
+				"192.0.2.1": 30 * time.Millisecond,
+				"192.0.2.2": 20 * time.Millisecond,
+				"192.0.2.3": 40 * time.Millisecond,
+			},
+			selection: config.ServerSelectionLatency,
+			expected:  "de2.nordvpn.com",
+		},
+		{
+			name:    "latency combined with load",
+			servers: servers,
+			latencies: map[string]time.Duration{
+				"192.0.2.1": 30 * time.Millisecond,
+				"192.0.2.2": 20 * time.Millisecond,
+				"192.0.2.3": 40 * time.Millisecond,
+			},
+			selection: config.ServerSelectionAuto,
+			expected:  "de1.nordvpn.com",
+		},
+		{
+			name:    "unreachable servers are skipped",
+			servers: servers,
+			latencies: map[string]time.Duration{
+				"192.0.2.2": -1,
+				"192.0.2.3": 40 * time.Millisecond,
+			},
+			selection: config.ServerSelectionLatency,
+			expected:  "de3.nordvpn.com",
+		},
+		{
+			name:      "no response",
+			servers:   servers,
+			selection: config.ServerSelectionAuto,
+			expected:  "de1.nordvpn.com",
+		},
+		{
+			name:      "single server is not probed",
+			servers:   servers[1:2],
+			selection: config.ServerSelectionLatency,
+			expected:  "de2.nordvpn.com",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := pickByLatency(mockServerProber{latencies: test.latencies}, test.servers, test.selection)
+			assert.Equal(t, test.expected, server.Hostname)
+		})
+	}
+}
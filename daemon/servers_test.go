@@ -149,6 +149,145 @@ func TestFilterServers(t *testing.T) {
 	}
 }
 
+func TestPickRandomServer(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	newServer := func(hostname string, status core.Status, keys ...string) core.Server {
+		return core.Server{
+			Hostname: hostname,
+			Status:   status,
+			Keys:     keys,
+			Technologies: core.Technologies{
+				core.Technology{
+					ID:    core.WireguardTech,
+					Pivot: core.Pivot{Status: core.Online},
+				},
+			},
+			Groups: core.Groups{
+				core.Group{ID: config.StandardVPNServers},
+			},
+		}
+	}
+	servers := core.Servers{
+		newServer("de1.nordvpn.com", core.Online, "germany", "de", "germanyberlin", "berlin", "de1"),
+		newServer("de2.nordvpn.com", core.Maintenance, "germany", "de", "germanyberlin", "berlin", "de2"),
+		newServer("lt1.nordvpn.com", core.Offline, "lithuania", "lt", "lithuaniavilnius", "vilnius", "lt1"),
+	}
+
+	tests := []struct {
+		name     string
+		tag      string
+		group    string
+		expected string
+		err      error
+	}{
+		{
+			name:     "any server",
+			expected: "de1.nordvpn.com",
+		},
+		{
+			name:     "country and city",
+			tag:      "germany berlin",
+			expected: "de1.nordvpn.com",
+		},
+		{
+			name: "only offline servers",
+			tag:  "lt",
+			err:  internal.ErrServerIsUnavailable,
+		},
+		{
+			name: "nonexistent tag",
+			tag:  "latvia",
+			err:  internal.ErrTagDoesNotExist,
+		},
+		{
+			name:  "nonexistent group",
+			group: "nonexistent",
+			err:   internal.ErrGroupDoesNotExist,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, err := PickRandomServer(
+				servers,
+				config.Technology_NORDLYNX,
+				config.Protocol_UDP,
+				false,
+				test.tag,
+				test.group,
+			)
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.expected, server.Hostname)
+		})
+	}
+}
+
+func TestPickWeightedByLoad(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	// weights are 91, 1 and 10
+	servers := []core.Server{
+		{Hostname: "a", Load: 10},
+		{Hostname: "b", Load: 100},
+		{Hostname: "c", Load: 91},
+	}
+
+	tests := []struct {
+		random   float64
+		expected string
+	}{
+		{random: 0, expected: "a"},
+		{random: 0.89, expected: "a"},
+		{random: 0.9, expected: "b"},
+		{random: 0.91, expected: "c"},
+		{random: 0.99, expected: "c"},
+	}
+
+	for _, test := range tests {
+		server := pickWeightedByLoad(servers, func() float64 { return test.random })
+		assert.Equal(t, test.expected, server.Hostname, test.random)
+	}
+}
+
+func TestPickWeightedByRating(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	servers := []core.Server{
+		{Hostname: "lt10.nordvpn.com"},
+		{Hostname: "lt11.nordvpn.com"},
+		{Hostname: "lt12.nordvpn.com"},
+	}
+	notes := config.ServerNotes{
+		"lt10": {Rating: 5},
+		"lt11": {Note: "not rated"},
+		"lt12": {Rating: 2},
+	}
+
+	tests := []struct {
+		name     string
+		weight   uint32
+		random   float64
+		expected string
+	}{
+		// weights are 4, 1 and 0.5
+		{name: "highest rated", weight: 100, random: 0.72, expected: "lt10.nordvpn.com"},
+		{name: "unrated", weight: 100, random: 0.73, expected: "lt11.nordvpn.com"},
+		{name: "lowest rated", weight: 100, random: 0.91, expected: "lt12.nordvpn.com"},
+		// weights are equal
+		{name: "disabled first", weight: 0, random: 0.33, expected: "lt10.nordvpn.com"},
+		{name: "disabled second", weight: 0, random: 0.34, expected: "lt11.nordvpn.com"},
+		{name: "disabled last", weight: 0, random: 0.67, expected: "lt12.nordvpn.com"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := pickWeightedByRating(servers, notes, test.weight, func() float64 { return test.random })
+			assert.Equal(t, test.expected, server.Hostname)
+		})
+	}
+}
+
 func TestResolveServerGroup(t *testing.T) {
 	category.Set(t, category.Unit)
 
@@ -0,0 +1,175 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/netip"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// maxProbedServers is the number of the least loaded servers considered for the connection
+	maxProbedServers = 10
+	// probeTimeout bounds the probing of all the considered servers
+	probeTimeout = 3 * time.Second
+	// probePort is used by OpenVPN TCP, servers refusing it are still reachable
+	probePort = 443
+)
+
+// ServerProber measures the round trip time to the server
+type ServerProber interface {
+	Probe(ctx context.Context, ip netip.Addr) (time.Duration, error)
+}
+
+// TCPProber measures the duration of the TCP handshake with the server. Refused
+// connection proves the server to be reachable as well.
+type TCPProber struct{}
+
+func (TCPProber) Probe(ctx context.Context, ip netip.Addr) (time.Duration, error) {
+	var dialer net.Dialer
+	start := time.Now()
+	conn, err := dialer.DialContext(ctx, "tcp", netip.AddrPortFrom(ip, probePort).String())
+	latency := time.Since(start)
+	if err != nil {
+		if errors.Is(err, syscall.ECONNREFUSED) {
+			return latency, nil
+		}
+		return 0, err
+	}
+	// #nosec G104 -- nothing was sent
+	conn.Close()
+	return latency, nil
+}
+
+// SkippedServer is a server which was not picked during the verification
+type SkippedServer struct {
+	Hostname string
+	Reason   string
+}
+
+type probedServer struct {
+	server  core.Server
+	latency time.Duration
+	err     error
+}
+
+// PickVerifiedServer probes the least loaded servers matching the criteria and
+// picks the one with the lowest latency. Servers which are down or did not
+// respond are returned as skipped.
+func PickVerifiedServer(
+	prober ServerProber,
+	servers core.Servers,
+	tech config.Technology,
+	protocol config.Protocol,
+	obfuscated bool,
+	tag string,
+	groupFlag string,
+) (core.Server, []SkippedServer, error) {
+	serverGroup, err := resolveServerGroup(groupFlag, tag)
+	if err != nil {
+		return core.Server{}, nil, err
+	}
+
+	// server keys contain no spaces, e.g. country and city are stored as "germanyberlin"
+	tag = strings.ReplaceAll(tag, " ", "")
+	candidates := internal.Filter(servers, func(s core.Server) bool {
+		return core.IsObfuscated()(s) == obfuscated && selectFilter(tag, serverGroup, obfuscated)(s)
+	})
+	if len(candidates) == 0 {
+		if tag != "" && !slices.ContainsFunc(servers, func(s core.Server) bool {
+			return slices.Contains(s.Keys, tag)
+		}) {
+			return core.Server{}, nil, internal.ErrTagDoesNotExist
+		}
+		return core.Server{}, nil, internal.ErrServerIsUnavailable
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Load < candidates[j].Load
+	})
+	if len(candidates) > maxProbedServers {
+		candidates = candidates[:maxProbedServers]
+	}
+
+	var skipped []SkippedServer
+	var online []core.Server
+	for _, server := range candidates {
+		if reason := unavailabilityReason(server, tech, protocol); reason != "" {
+			skipped = append(skipped, SkippedServer{Hostname: server.Hostname, Reason: reason})
+			continue
+		}
+		online = append(online, server)
+	}
+
+	probed := probeServers(prober, online)
+	var best *probedServer
+	for i, result := range probed {
+		if result.err != nil {
+			reason := fmt.Sprintf("unreachable: %s", result.err)
+			if errors.Is(result.err, context.DeadlineExceeded) {
+				reason = fmt.Sprintf("no response in %s", probeTimeout)
+			}
+			skipped = append(skipped, SkippedServer{Hostname: result.server.Hostname, Reason: reason})
+			continue
+		}
+		if best == nil || result.latency < best.latency {
+			best = &probed[i]
+		}
+	}
+
+	if best == nil {
+		return core.Server{}, skipped, internal.ErrServerIsUnavailable
+	}
+	log.Println(internal.InfoPrefix, "verified server", best.server.Hostname, "with latency", best.latency)
+	return best.server, skipped, nil
+}
+
+// unavailabilityReason describes why the server cannot be connected to, empty
+// for available servers
+func unavailabilityReason(server core.Server, tech config.Technology, protocol config.Protocol) string {
+	switch {
+	case server.Status != core.Online:
+		return string(server.Status)
+	case !core.IsConnectableWithProtocol(tech, protocol)(server):
+		return fmt.Sprintf("%s is unavailable", tech)
+	default:
+		return ""
+	}
+}
+
+// probeServers probes all the servers concurrently within probeTimeout. Results
+// are in the same order as the servers.
+func probeServers(prober ServerProber, servers []core.Server) []probedServer {
+	ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+	defer cancel()
+
+	results := make([]probedServer, len(servers))
+	var wg sync.WaitGroup
+	for i, server := range servers {
+		results[i].server = server
+		ip, err := server.IPv4()
+		if err != nil {
+			results[i].err = err
+			continue
+		}
+		wg.Add(1)
+		go func(result *probedServer, ip netip.Addr) {
+			defer wg.Done()
+			result.latency, result.err = prober.Probe(ctx, ip)
+		}(&results[i], ip)
+	}
+	wg.Wait()
+	return results
+}
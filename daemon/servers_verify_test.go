@@ -0,0 +1,131 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockServerProber struct {
+	latencies map[string]time.Duration
+}
+
+func (m mockServerProber) Probe(_ context.Context, ip netip.Addr) (time.Duration, error) {
+	latency, ok := m.latencies[ip.String()]
+	if !ok {
+		return 0, context.DeadlineExceeded
+	}
+	if latency < 0 {
+		return 0, errors.New("no route to host")
+	}
+	return latency, nil
+}
+
+func TestPickVerifiedServer(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	newServer := func(hostname string, station string, load int64, status core.Status, keys ...string) core.Server {
+		return core.Server{
+			Hostname: hostname,
+			Station:  station,
+			Load:     load,
+			Status:   status,
+			Keys:     keys,
+			Technologies: core.Technologies{
+				core.Technology{
+					ID:    core.WireguardTech,
+					Pivot: core.Pivot{Status: core.Online},
+				},
+			},
+			Groups: core.Groups{
+				core.Group{ID: config.StandardVPNServers},
+			},
+		}
+	}
+	servers := core.Servers{
+		newServer("de1.nordvpn.com", "192.0.2.1", 50, core.Online, "germany", "de1"),
+		newServer("de2.nordvpn.com", "192.0.2.2", 10, core.Online, "germany", "de2"),
+		newServer("de3.nordvpn.com", "192.0.2.3", 20, core.Maintenance, "germany", "de3"),
+		newServer("de4.nordvpn.com", "192.0.2.4", 30, core.Online, "germany", "de4"),
+		newServer("lt1.nordvpn.com", "192.0.2.5", 10, core.Online, "lithuania", "lt1"),
+	}
+
+	tests := []struct {
+		name      string
+		tag       string
+		group     string
+		latencies map[string]time.Duration
+		expected  string
+		skipped   []SkippedServer
+		err       error
+	}{
+		{
+			name: "lowest latency",
+			tag:  "germany",
+			latencies: map[string]time.Duration{
+				"192.0.2.1": 20 * time.Millisecond,
+				"192.0.2.2": 30 * time.Millisecond,
+				"192.0.2.4": 40 * time.Millisecond,
+			},
+			expected: "de1.nordvpn.com",
+			skipped:  []SkippedServer{{Hostname: "de3.nordvpn.com", Reason: "maintenance"}},
+		},
+		{
+			name: "unreachable servers skipped",
+			tag:  "germany",
+			latencies: map[string]time.Duration{
+				"192.0.2.1": -1,
+				"192.0.2.4": 40 * time.Millisecond,
+			},
+			expected: "de4.nordvpn.com",
+			skipped: []SkippedServer{
+				{Hostname: "de3.nordvpn.com", Reason: "maintenance"},
+				{Hostname: "de2.nordvpn.com", Reason: "no response in 3s"},
+				{Hostname: "de1.nordvpn.com", Reason: "unreachable: no route to host"},
+			},
+		},
+		{
+			name: "no reachable servers",
+			tag:  "lt1",
+			skipped: []SkippedServer{
+				{Hostname: "lt1.nordvpn.com", Reason: "no response in 3s"},
+			},
+			err: internal.ErrServerIsUnavailable,
+		},
+		{
+			name: "nonexistent tag",
+			tag:  "latvia",
+			err:  internal.ErrTagDoesNotExist,
+		},
+		{
+			name:  "nonexistent group",
+			group: "nonexistent",
+			err:   internal.ErrGroupDoesNotExist,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, skipped, err := PickVerifiedServer(
+				mockServerProber{latencies: test.latencies},
+				servers,
+				config.Technology_NORDLYNX,
+				config.Protocol_UDP,
+				false,
+				test.tag,
+				test.group,
+			)
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.expected, server.Hostname)
+			assert.Equal(t, test.skipped, skipped)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package daemon
+
+import (
+	"net/http"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// physicalServersAPI drops the virtual location servers from the server
+// selection responses, so that the traffic exits in the advertised country
+type physicalServersAPI struct {
+	core.ServersAPI
+}
+
+func (a physicalServersAPI) RecommendedServers(
+	filter core.ServersFilter,
+	longitude float64,
+	latitude float64,
+) (core.Servers, http.Header, error) {
+	servers, headers, err := a.ServersAPI.RecommendedServers(filter, longitude, latitude)
+	if err != nil {
+		return nil, headers, err
+	}
+	return withoutVirtualLocations(servers), headers, nil
+}
+
+func (a physicalServersAPI) Server(id int64) (*core.Server, error) {
+	server, err := a.ServersAPI.Server(id)
+	if err != nil {
+		return nil, err
+	}
+	if core.IsVirtualLocation()(*server) {
+		return nil, internal.ErrServerIsUnavailable
+	}
+	return server, nil
+}
+
+func withoutVirtualLocations(servers core.Servers) core.Servers {
+	return internal.Filter(servers, func(s core.Server) bool {
+		return !core.IsVirtualLocation()(s)
+	})
+}
+
+// selectableServers returns the cached servers which can be picked with the
+// virtual location setting
+func (r *RPC) selectableServers(cfg config.Config) core.Servers {
+	servers := r.dm.GetServersData().Servers
+	if cfg.VirtualLocation.Get() {
+		return servers
+	}
+	return withoutVirtualLocations(servers)
+}
+
+// selectableServersAPI returns the servers API picking only the servers allowed
+// by the virtual location setting
+func (r *RPC) selectableServersAPI(cfg config.Config) core.ServersAPI {
+	if cfg.VirtualLocation.Get() {
+		return r.serversAPI
+	}
+	return physicalServersAPI{ServersAPI: r.serversAPI}
+}
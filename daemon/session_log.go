@@ -0,0 +1,210 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/sys/unix"
+
+	"google.golang.org/grpc/peer"
+)
+
+var (
+	errSessionLogPathNotAbsolute = errors.New("session log path is not absolute")
+	errSessionLogNotOwned        = errors.New("session log location is not owned by the caller")
+)
+
+// SessionLog duplicates the log output to a file for the duration of a single
+// connection session. The regular output is never affected by the file.
+type SessionLog struct {
+	out  io.Writer
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewSessionLog creates a SessionLog which writes to out
+func NewSessionLog(out io.Writer) *SessionLog {
+	return &SessionLog{out: out}
+}
+
+// Write writes p to the regular output and the file of the current session
+func (l *SessionLog) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file != nil {
+		if _, err := l.file.Write(p); err != nil {
+			// log cannot be used here, the session is ended silently
+			// #nosec G104 -- the file is not usable anyway
+			l.file.Close()
+			l.file = nil
+		}
+	}
+	return l.out.Write(p)
+}
+
+// Start begins a new session which is logged to the file at path until Stop is
+// called. File of the previous session is closed. As the daemon runs with
+// elevated privileges, the file is created on behalf of the given user in the
+// directory owned by them, unless it is root.
+func (l *SessionLog) Start(path string, uid uint32, gid uint32) error {
+	if !filepath.IsAbs(path) {
+		return errSessionLogPathNotAbsolute
+	}
+	file, err := createSessionLog(path, uid, gid)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file != nil {
+		// #nosec G104 -- the previous session is over
+		l.file.Close()
+	}
+	l.file = file
+	return nil
+}
+
+// Stop ends the current session and closes its file
+func (l *SessionLog) Stop() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+// createSessionLog creates the file at path for the user. The directory is
+// checked through the opened descriptor, so it cannot be swapped after the
+// check, and the file is created with the filesystem ids of the user, so the
+// daemon never writes to a file the user could not write to.
+func createSessionLog(path string, uid uint32, gid uint32) (*os.File, error) {
+	dir, err := unix.Open(filepath.Dir(path), unix.O_RDONLY|unix.O_DIRECTORY|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("opening session log directory: %w", err)
+	}
+	// #nosec G104 -- the directory is only used to create the file
+	defer unix.Close(dir)
+
+	var stat unix.Stat_t
+	if err := unix.Fstat(dir, &stat); err != nil {
+		return nil, fmt.Errorf("checking session log directory: %w", err)
+	}
+	if uid != 0 && stat.Uid != uid {
+		return nil, errSessionLogNotOwned
+	}
+
+	name := filepath.Base(path)
+	var fd int
+	if err := asUser(uid, gid, func() error {
+		if err := removeSessionLog(dir, name, uid); err != nil {
+			return err
+		}
+		var err error
+		fd, err = unix.Openat(
+			dir,
+			name,
+			unix.O_CREAT|unix.O_EXCL|unix.O_WRONLY|unix.O_NOFOLLOW|unix.O_NONBLOCK|unix.O_CLOEXEC,
+			internal.PermUserRW,
+		)
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("creating session log: %w", err)
+	}
+
+	file := os.NewFile(uintptr(fd), path)
+	if err := unix.Fstat(fd, &stat); err != nil || stat.Mode&unix.S_IFMT != unix.S_IFREG {
+		// #nosec G104 -- the file is not used
+		file.Close()
+		return nil, errSessionLogNotOwned
+	}
+	return file, nil
+}
+
+// removeSessionLog removes the file of the earlier session, so that a new one
+// is created instead of writing to the existing one. Only a regular file of the
+// user which is not linked anywhere else is removed.
+func removeSessionLog(dir int, name string, uid uint32) error {
+	var stat unix.Stat_t
+	err := unix.Fstatat(dir, name, &stat, unix.AT_SYMLINK_NOFOLLOW)
+	if errors.Is(err, unix.ENOENT) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("checking session log: %w", err)
+	}
+	if stat.Mode&unix.S_IFMT != unix.S_IFREG || stat.Nlink != 1 || uid != 0 && stat.Uid != uid {
+		return errSessionLogNotOwned
+	}
+	if err := unix.Unlinkat(dir, name, 0); err != nil {
+		return fmt.Errorf("removing previous session log: %w", err)
+	}
+	return nil
+}
+
+// asUser runs fn with the filesystem ids of the user, so that the kernel checks
+// the access as for the user and the created files are owned by them. The ids
+// are per thread, so the thread is locked and it is discarded if the ids of the
+// daemon cannot be restored.
+func asUser(uid uint32, gid uint32, fn func() error) error {
+	runtime.LockOSThread()
+	prevGid, _ := unix.SetfsgidRetGid(-1)
+	prevUid, _ := unix.SetfsuidRetUid(-1)
+	defer func() {
+		if setfsid(unix.SetfsuidRetUid, prevUid) && setfsid(unix.SetfsgidRetGid, prevGid) {
+			runtime.UnlockOSThread()
+		}
+	}()
+
+	if !setfsid(unix.SetfsgidRetGid, int(gid)) || !setfsid(unix.SetfsuidRetUid, int(uid)) {
+		return errors.New("switching to the filesystem ids of the user")
+	}
+	return fn()
+}
+
+// setfsid sets the filesystem id and reports whether it was set, as the
+// syscall does not return errors
+func setfsid(set func(int) (int, error), id int) bool {
+	if _, err := set(id); err != nil {
+		return false
+	}
+	current, err := set(-1)
+	return err == nil && current == id
+}
+
+// startSessionLog starts logging the connection session to the file of the caller
+func (r *RPC) startSessionLog(ctx context.Context, path string) error {
+	if r.sessionLog == nil {
+		return errors.New("session log is not available")
+	}
+	peer, ok := peer.FromContext(ctx)
+	if !ok || peer.AuthInfo == nil {
+		return errors.New("unable to retrieve AuthInfo from gRPC context")
+	}
+	ucred, err := internal.StringToUcred(peer.AuthInfo.AuthType())
+	if err != nil {
+		return fmt.Errorf("parsing AuthType: %w", err)
+	}
+	return r.sessionLog.Start(path, ucred.Uid, ucred.Gid)
+}
+
+// stopSessionLog ends logging of the connection session if there is one
+func (r *RPC) stopSessionLog() {
+	if r.sessionLog == nil {
+		return
+	}
+	if err := r.sessionLog.Stop(); err != nil {
+		log.Println(internal.WarningPrefix, "closing session log:", err)
+	}
+}
@@ -0,0 +1,132 @@
+package daemon
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionLog(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var out bytes.Buffer
+	sessionLog := NewSessionLog(&out)
+	path := filepath.Join(t.TempDir(), "session.log")
+	uid, gid := uint32(os.Getuid()), uint32(os.Getgid())
+
+	_, err := sessionLog.Write([]byte("before\n"))
+	require.NoError(t, err)
+	require.NoError(t, sessionLog.Start(path, uid, gid))
+	_, err = sessionLog.Write([]byte("during\n"))
+	require.NoError(t, err)
+	require.NoError(t, sessionLog.Stop())
+	_, err = sessionLog.Write([]byte("after\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "before\nduring\nafter\n", out.String())
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "during\n", string(content))
+
+	// file is cleared for a new session
+	require.NoError(t, sessionLog.Start(path, uid, gid))
+	require.NoError(t, sessionLog.Stop())
+	content, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Empty(t, content)
+	assert.NoError(t, sessionLog.Stop())
+}
+
+func TestSessionLog_StartFails(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	dir := t.TempDir()
+	target := filepath.Join(dir, "target")
+	require.NoError(t, os.WriteFile(target, []byte("secret"), 0600))
+	require.NoError(t, os.Symlink(target, filepath.Join(dir, "symlink")))
+	require.NoError(t, os.Link(target, filepath.Join(dir, "hardlink")))
+	require.NoError(t, syscall.Mkfifo(filepath.Join(dir, "fifo"), 0600))
+	require.NoError(t, os.Symlink(dir, filepath.Join(t.TempDir(), "dir")))
+	uid, gid := uint32(os.Getuid()), uint32(os.Getgid())
+
+	tests := []struct {
+		name string
+		path string
+		uid  uint32
+	}{
+		{
+			name: "relative path",
+			path: "session.log",
+			uid:  uid,
+		},
+		{
+			name: "missing directory",
+			path: filepath.Join(dir, "missing", "session.log"),
+			uid:  uid,
+		},
+		{
+			name: "directory of another user",
+			path: filepath.Join(dir, "session.log"),
+			uid:  uid + 1,
+		},
+		{
+			name: "symlink",
+			path: filepath.Join(dir, "symlink"),
+			uid:  uid,
+		},
+		{
+			name: "hard link",
+			path: filepath.Join(dir, "hardlink"),
+			uid:  uid,
+		},
+		{
+			name: "fifo",
+			path: filepath.Join(dir, "fifo"),
+			uid:  uid,
+		},
+		{
+			name: "symlinked directory",
+			path: filepath.Join(filepath.Dir(dir), "dir", "session.log"),
+			uid:  uid,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sessionLog := NewSessionLog(&bytes.Buffer{})
+			assert.Error(t, sessionLog.Start(test.path, test.uid, gid))
+			content, err := os.ReadFile(target)
+			require.NoError(t, err)
+			assert.Equal(t, "secret", string(content))
+		})
+	}
+}
+
+func TestSessionLog_CreatedAsUser(t *testing.T) {
+	category.Set(t, category.Root)
+
+	const nobody = 65534
+	dir := filepath.Join(t.TempDir(), "user")
+	require.NoError(t, os.Mkdir(dir, 0700))
+	require.NoError(t, os.Chown(dir, nobody, nobody))
+	path := filepath.Join(dir, "session.log")
+
+	sessionLog := NewSessionLog(&bytes.Buffer{})
+	require.NoError(t, sessionLog.Start(path, nobody, nobody))
+	require.NoError(t, sessionLog.Stop())
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	stat := info.Sys().(*syscall.Stat_t)
+	assert.Equal(t, uint32(nobody), stat.Uid)
+	assert.Equal(t, uint32(nobody), stat.Gid)
+
+	// the daemon has no more access than the user
+	require.NoError(t, os.Chmod(dir, 0500))
+	assert.Error(t, sessionLog.Start(path, nobody, nobody))
+}
@@ -0,0 +1,339 @@
+// Package splittunnel routes the traffic of the selected applications outside
+// of the VPN tunnel, or routes only their traffic through it.
+//
+// Processes of the applications are moved to a dedicated cgroup v2 group. The
+// packets sent from the group, or from outside of it in include mode, get the
+// firewall mark of the app, so that the policy routing rule of the VPN
+// connection sends them to the main routing table instead of the tunnel. The
+// source address is selected before the packets are marked, therefore the
+// marked packets are masqueraded.
+//
+// Processes are found by periodically scanning /proc and matching their
+// executables against the configured applications. Processes started by them
+// inherit the group.
+package splittunnel
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// Group is the name of the cgroup of the split tunnel processes
+	Group = "nordvpn-split"
+
+	comment           = "nordvpn-split-tunnel"
+	defaultCgroupRoot = "/sys/fs/cgroup"
+	defaultProcRoot   = "/proc"
+	procsFile         = "cgroup.procs"
+	scanInterval      = 2 * time.Second
+)
+
+var (
+	// ErrCgroupV2NotMounted is returned when the unified cgroup hierarchy is not available
+	ErrCgroupV2NotMounted = errors.New("cgroup v2 is not mounted on " + defaultCgroupRoot)
+
+	iptablesCmds = []string{"iptables", "ip6tables"}
+)
+
+// Manager classifies the processes of the split tunnel applications
+type Manager interface {
+	// Enable starts routing the applications according to the mode, previous
+	// configuration is replaced
+	Enable(config.SplitTunnel) error
+	// Disable stops routing the applications differently and moves their
+	// processes back to their original cgroups
+	Disable() error
+}
+
+type runCommandFunc func(command string, arg ...string) ([]byte, error)
+
+// Cgroup classifies the processes using cgroup v2 and marks their packets
+// with iptables
+type Cgroup struct {
+	runCommandFunc runCommandFunc
+	fwmark         uint32
+	cgroupRoot     string
+	procRoot       string
+	cfg            config.SplitTunnel
+	// origins are the cgroups the processes were moved from
+	origins map[int]string
+	stop    chan struct{}
+	mu      sync.Mutex
+}
+
+// NewCgroup is a default constructor for Cgroup
+func NewCgroup(commandFunc runCommandFunc, fwmark uint32) *Cgroup {
+	return &Cgroup{
+		runCommandFunc: commandFunc,
+		fwmark:         fwmark,
+		cgroupRoot:     defaultCgroupRoot,
+		procRoot:       defaultProcRoot,
+		origins:        map[int]string{},
+	}
+}
+
+func (c *Cgroup) Enable(cfg config.SplitTunnel) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.disable(); err != nil {
+		return err
+	}
+	if len(cfg.Apps) == 0 {
+		return nil
+	}
+
+	if _, err := os.Stat(filepath.Join(c.cgroupRoot, "cgroup.controllers")); err != nil {
+		return ErrCgroupV2NotMounted
+	}
+	if err := os.Mkdir(c.groupPath(), 0755); err != nil && !errors.Is(err, fs.ErrExist) {
+		return fmt.Errorf("creating cgroup: %w", err)
+	}
+	if err := c.addRules(cfg.Mode); err != nil {
+		if err := c.disable(); err != nil {
+			log.Println(internal.DeferPrefix, err)
+		}
+		return err
+	}
+
+	c.cfg = cfg
+	c.scan()
+	c.stop = make(chan struct{})
+	go c.run(c.stop)
+	return nil
+}
+
+func (c *Cgroup) Disable() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.disable()
+}
+
+func (c *Cgroup) disable() error {
+	if c.stop != nil {
+		close(c.stop)
+		c.stop = nil
+	}
+	c.cfg = config.SplitTunnel{}
+
+	if err := c.removeRules(); err != nil {
+		return err
+	}
+
+	pids, err := c.groupPids()
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("listing cgroup processes: %w", err)
+	}
+	for _, pid := range pids {
+		origin := filepath.Join(c.cgroupRoot, c.origins[pid])
+		if err := c.movePid(pid, origin); err != nil {
+			// original cgroup could have been removed in the meantime
+			if err := c.movePid(pid, c.cgroupRoot); err != nil {
+				log.Println(internal.WarningPrefix, "moving process out of split tunnel cgroup:", err)
+			}
+		}
+	}
+	c.origins = map[int]string{}
+
+	if err := os.Remove(c.groupPath()); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("removing cgroup: %w", err)
+	}
+	return nil
+}
+
+// run scans for the new processes of the applications until stopped
+func (c *Cgroup) run(stop <-chan struct{}) {
+	ticker := time.NewTicker(scanInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			c.mu.Lock()
+			// configuration could have been changed while waiting for the lock
+			select {
+			case <-stop:
+			default:
+				c.scan()
+			}
+			c.mu.Unlock()
+		}
+	}
+}
+
+// scan moves the processes of the applications to the split tunnel cgroup
+func (c *Cgroup) scan() {
+	entries, err := os.ReadDir(c.procRoot)
+	if err != nil {
+		log.Println(internal.WarningPrefix, "listing processes:", err)
+		return
+	}
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		// processes exit at any time, so errors are expected
+		exe, err := os.Readlink(filepath.Join(c.procRoot, entry.Name(), "exe"))
+		if err != nil || !Matches(c.cfg.Apps, strings.TrimSuffix(exe, " (deleted)")) {
+			continue
+		}
+		origin, err := c.pidCgroup(pid)
+		if err != nil || origin == "/"+Group {
+			continue
+		}
+		if err := c.movePid(pid, c.groupPath()); err != nil {
+			continue
+		}
+		c.origins[pid] = origin
+	}
+}
+
+// pidCgroup returns the path of the process cgroup relative to the cgroup root
+func (c *Cgroup) pidCgroup(pid int) (string, error) {
+	data, err := os.ReadFile(filepath.Join(c.procRoot, strconv.Itoa(pid), "cgroup"))
+	if err != nil {
+		return "", err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		// unified hierarchy is listed as 0::<path>
+		if path, ok := strings.CutPrefix(scanner.Text(), "0::"); ok {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("process %d is not in the unified cgroup hierarchy", pid)
+}
+
+func (c *Cgroup) groupPids() ([]int, error) {
+	data, err := os.ReadFile(filepath.Join(c.groupPath(), procsFile))
+	if err != nil {
+		return nil, err
+	}
+	var pids []int
+	for _, field := range strings.Fields(string(data)) {
+		pid, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, err
+		}
+		pids = append(pids, pid)
+	}
+	return pids, nil
+}
+
+func (c *Cgroup) movePid(pid int, cgroup string) error {
+	return os.WriteFile(filepath.Join(cgroup, procsFile), []byte(strconv.Itoa(pid)), 0644)
+}
+
+func (c *Cgroup) groupPath() string {
+	return filepath.Join(c.cgroupRoot, Group)
+}
+
+func (c *Cgroup) addRules(mode config.SplitTunnelMode) error {
+	for _, cmd := range iptablesCmds {
+		for _, args := range ruleArgs(mode, c.fwmark) {
+			// #nosec G204 -- input is properly sanitized
+			out, err := c.runCommandFunc(cmd, args...)
+			if err != nil {
+				return fmt.Errorf("%s %s: %w: %s", cmd, strings.Join(args, " "), err, string(out))
+			}
+		}
+	}
+	return nil
+}
+
+// removeRules removes the rules by the comment, so leftovers of the previous
+// run are removed as well
+func (c *Cgroup) removeRules() error {
+	for _, cmd := range iptablesCmds {
+		for _, table := range []string{"mangle", "nat"} {
+			// #nosec G204 -- input is properly sanitized
+			out, err := c.runCommandFunc(cmd, "-t", table, "-S")
+			if err != nil {
+				return fmt.Errorf("%s listing rules: %w: %s", cmd, err, string(out))
+			}
+			for _, line := range bytes.Split(out, []byte{'\n'}) {
+				args := strings.Fields(string(line))
+				if len(args) < 2 || args[0] != "-A" || !slices.Contains(args, comment) {
+					continue
+				}
+				args[0] = "-D"
+				args = append([]string{"-t", table}, args...)
+				// #nosec G204 -- input is properly sanitized
+				out, err := c.runCommandFunc(cmd, args...)
+				if err != nil {
+					return fmt.Errorf("%s deleting rule: %w: %s", cmd, err, string(out))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ruleArgs returns the iptables arguments for marking and masquerading the
+// traffic which bypasses the VPN
+func ruleArgs(mode config.SplitTunnelMode, fwmark uint32) [][]string {
+	match := []string{"-m", "cgroup", "--path", Group}
+	if mode == config.SplitTunnelInclude {
+		match = []string{"-m", "cgroup", "!", "--path", Group}
+	}
+	mark := fmt.Sprintf("%#x", fwmark)
+	commentArgs := []string{"-m", "comment", "--comment", comment}
+
+	markRule := append([]string{"-t", "mangle", "-A", "OUTPUT"}, match...)
+	markRule = append(markRule, commentArgs...)
+	markRule = append(markRule, "-j", "MARK", "--set-mark", mark)
+
+	masqueradeRule := append([]string{"-t", "nat", "-A", "POSTROUTING"}, match...)
+	masqueradeRule = append(masqueradeRule, "-m", "mark", "--mark", mark)
+	masqueradeRule = append(masqueradeRule, commentArgs...)
+	masqueradeRule = append(masqueradeRule, "-j", "MASQUERADE")
+
+	return [][]string{markRule, masqueradeRule}
+}
+
+// Matches reports whether the executable belongs to one of the applications.
+// Applications given as absolute paths must match the executable path, the
+// rest must match the executable name.
+func Matches(apps []string, exe string) bool {
+	for _, app := range apps {
+		if filepath.IsAbs(app) {
+			if filepath.Clean(app) == exe {
+				return true
+			}
+			continue
+		}
+		if filepath.Base(exe) == app {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidApp checks whether the application is a binary name or an absolute path
+func IsValidApp(app string) bool {
+	if strings.TrimSpace(app) == "" || strings.ContainsAny(app, "\n\x00") {
+		return false
+	}
+	return filepath.IsAbs(app) || !strings.Contains(app, "/")
+}
@@ -0,0 +1,138 @@
+package splittunnel
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type commandRecorder struct {
+	commands []string
+	output   map[string]string
+}
+
+func (c *commandRecorder) run(command string, arg ...string) ([]byte, error) {
+	args := command + " " + strings.Join(arg, " ")
+	c.commands = append(c.commands, args)
+	return []byte(c.output[args]), nil
+}
+
+func TestMatches(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		apps     []string
+		exe      string
+		expected bool
+	}{
+		{name: "binary name", apps: []string{"firefox"}, exe: "/usr/lib/firefox/firefox", expected: true},
+		{name: "absolute path", apps: []string{"/usr/bin/curl"}, exe: "/usr/bin/curl", expected: true},
+		{name: "unclean path", apps: []string{"/usr/bin/../bin/curl"}, exe: "/usr/bin/curl", expected: true},
+		{name: "path of other binary", apps: []string{"/usr/local/bin/curl"}, exe: "/usr/bin/curl"},
+		{name: "name prefix", apps: []string{"fire"}, exe: "/usr/bin/firefox"},
+		{name: "no apps", exe: "/usr/bin/firefox"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Matches(test.apps, test.exe))
+		})
+	}
+}
+
+func TestIsValidApp(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	for app, expected := range map[string]bool{
+		"firefox":           true,
+		"/usr/bin/firefox":  true,
+		"/opt/My App/app":   true,
+		"":                  false,
+		" ":                 false,
+		"bin/firefox":       false,
+		"./firefox":         false,
+		"fire\nfox":         false,
+		"/usr/bin/fire\x00": false,
+	} {
+		assert.Equal(t, expected, IsValidApp(app), app)
+	}
+}
+
+func TestRuleArgs(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, [][]string{
+		{
+			"-t", "mangle", "-A", "OUTPUT", "-m", "cgroup", "--path", Group,
+			"-m", "comment", "--comment", comment, "-j", "MARK", "--set-mark", "0xe1f1",
+		},
+		{
+			"-t", "nat", "-A", "POSTROUTING", "-m", "cgroup", "--path", Group, "-m", "mark", "--mark", "0xe1f1",
+			"-m", "comment", "--comment", comment, "-j", "MASQUERADE",
+		},
+	}, ruleArgs(config.SplitTunnelExclude, 0xe1f1))
+
+	for _, args := range ruleArgs(config.SplitTunnelInclude, 0xe1f1) {
+		assert.Contains(t, strings.Join(args, " "), "-m cgroup ! --path "+Group)
+	}
+}
+
+func TestCgroup_RemoveRules(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	recorder := &commandRecorder{output: map[string]string{
+		"iptables -t mangle -S": "-P OUTPUT ACCEPT\n" +
+			"-A OUTPUT -m cgroup --path nordvpn-split -m comment --comment nordvpn-split-tunnel -j MARK --set-xmark 0xe1f1/0xffffffff\n" +
+			"-A OUTPUT -j ACCEPT\n",
+	}}
+	c := NewCgroup(recorder.run, 0xe1f1)
+	require.NoError(t, c.removeRules())
+	assert.Contains(t, recorder.commands,
+		"iptables -t mangle -D OUTPUT -m cgroup --path nordvpn-split -m comment --comment nordvpn-split-tunnel -j MARK --set-xmark 0xe1f1/0xffffffff")
+	assert.Len(t, recorder.commands, 5)
+}
+
+func TestCgroup_Scan(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	procRoot := t.TempDir()
+	cgroupRoot := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(cgroupRoot, Group), 0755))
+
+	addProcess := func(pid string, exe string, cgroup string) {
+		dir := filepath.Join(procRoot, pid)
+		require.NoError(t, os.Mkdir(dir, 0755))
+		require.NoError(t, os.Symlink(exe, filepath.Join(dir, "exe")))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "cgroup"), []byte("0::"+cgroup+"\n"), 0644))
+	}
+	addProcess("100", "/usr/bin/curl", "/user.slice")
+	addProcess("200", "/usr/lib/firefox/firefox (deleted)", "/user.slice/app.scope")
+	require.NoError(t, os.Mkdir(filepath.Join(procRoot, "self"), 0755))
+
+	c := NewCgroup((&commandRecorder{}).run, 0xe1f1)
+	c.procRoot = procRoot
+	c.cgroupRoot = cgroupRoot
+	c.cfg = config.SplitTunnel{Apps: []string{"firefox"}}
+	c.scan()
+
+	pids, err := c.groupPids()
+	require.NoError(t, err)
+	assert.Equal(t, []int{200}, pids)
+	assert.Equal(t, map[int]string{200: "/user.slice/app.scope"}, c.origins)
+}
+
+func TestCgroup_EnableWithoutCgroupV2(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	c := NewCgroup((&commandRecorder{}).run, 0xe1f1)
+	c.cgroupRoot = t.TempDir()
+	assert.ErrorIs(t, c.Enable(config.SplitTunnel{Apps: []string{"firefox"}}), ErrCgroupV2NotMounted)
+	assert.NoError(t, c.Enable(config.SplitTunnel{}))
+}
@@ -0,0 +1,215 @@
+package daemon
+
+import (
+	"log"
+	"net"
+	"net/netip"
+	"strings"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/metered"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/routes"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+)
+
+// trustedNetworks disconnects from VPN and relaxes kill switch after joining a
+// trusted network, and enforces kill switch and connects again after leaving
+// it. Connecting manually on the trusted network is respected until the
+// network changes.
+type trustedNetworks struct {
+	cm       config.Manager
+	netw     networker.Networker
+	detector metered.Detector
+	gateway  routes.GatewayRetriever
+	// hardwareAddr returns the MAC address of the gateway
+	hardwareAddr func(netip.Addr, net.Interface) (net.HardwareAddr, error)
+	// disconnect from VPN on the trusted network
+	disconnect func() error
+	// connect to VPN with the request after leaving the trusted network
+	connect func(*pb.ConnectRequest) error
+	// lastServer returns the tag of the connected server
+	lastServer func() string
+	mu         sync.Mutex
+	// checked is false until the first check
+	checked bool
+	// trusted is the matched entry of the current network, empty if the
+	// network is not trusted
+	trusted string
+	// server is the tag of the server disconnected from on the trusted
+	// network, empty if VPN was not connected
+	server string
+	// killSwitchRelaxed is true if kill switch was unset on the trusted network
+	killSwitchRelaxed bool
+}
+
+func (t *trustedNetworks) check() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var cfg config.Config
+	if err := t.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return
+	}
+
+	trusted := ""
+	if len(cfg.TrustedNetworks) > 0 || t.trusted != "" {
+		var ok bool
+		if trusted, ok = t.match(cfg); !ok {
+			return
+		}
+	}
+	if t.checked && trusted == t.trusted {
+		return
+	}
+	t.checked = true
+
+	previous := t.trusted
+	t.trusted = trusted
+	if previous != "" {
+		t.leave(cfg, previous)
+	}
+	if trusted != "" {
+		t.join(cfg, trusted, t.lastServer())
+	}
+}
+
+// match returns the entry matching the current network by the connection name
+// or SSID together with the hardware address it was added with. Returns false
+// if the network cannot be detected.
+func (t *trustedNetworks) match(cfg config.Config) (string, bool) {
+	connection, address, err := t.identify()
+	if err != nil {
+		log.Println(internal.WarningPrefix, "trusted networks: detecting network connection:", err)
+		return "", false
+	}
+	return matchTrustedNetwork(cfg.TrustedNetworks, cfg.TrustedNetworkAddresses, connection, address), true
+}
+
+// identify returns the current network connection and the hardware address
+// identifying the network. It is the MAC address of the default gateway, or
+// the BSSID of the access point when the gateway is not known. The address is
+// empty if neither is known.
+func (t *trustedNetworks) identify() (metered.Connection, string, error) {
+	connection, err := t.detector.PrimaryConnection()
+	if err != nil {
+		return metered.Connection{}, "", err
+	}
+	// the default route of the main table goes through the physical interface
+	// even while VPN is connected
+	gateway, iface, err := t.gateway.Default(false)
+	if err == nil && gateway.IsValid() && t.hardwareAddr != nil {
+		mac, err := t.hardwareAddr(gateway, iface)
+		if err == nil {
+			return connection, mac.String(), nil
+		}
+		log.Println(internal.DebugPrefix, "trusted networks: resolving gateway:", err)
+	}
+	return connection, strings.ToLower(connection.BSSID), nil
+}
+
+// current returns the entry matching the current network, empty if the network
+// is not trusted
+func (t *trustedNetworks) current(cfg config.Config) string {
+	if len(cfg.TrustedNetworks) == 0 {
+		return ""
+	}
+	network, _ := t.match(cfg)
+	return network
+}
+
+// join disconnects from VPN and relaxes kill switch on the trusted network
+func (t *trustedNetworks) join(cfg config.Config, network string, server string) {
+	log.Println(internal.InfoPrefix, "trusted networks: joined", network)
+	t.server = ""
+	if t.netw.IsVPNActive() {
+		if err := t.disconnect(); err != nil {
+			log.Println(internal.ErrorPrefix, "trusted networks: disconnecting:", err)
+		} else {
+			t.server = server
+		}
+	}
+
+	t.killSwitchRelaxed = false
+	if !cfg.KillSwitch {
+		return
+	}
+	if err := t.netw.UnsetKillSwitch(); err != nil {
+		log.Println(internal.ErrorPrefix, "trusted networks: relaxing kill switch:", err)
+		return
+	}
+	t.killSwitchRelaxed = true
+}
+
+// leave enforces kill switch again unless it was disabled by the user meanwhile
+// and connects to VPN if it was disconnected on the trusted network or
+// auto-connect is enabled
+func (t *trustedNetworks) leave(cfg config.Config, network string) {
+	log.Println(internal.InfoPrefix, "trusted networks: left", network)
+	if t.killSwitchRelaxed && cfg.KillSwitch {
+		if err := t.netw.SetKillSwitch(reloadedAllowlist(cfg)); err != nil {
+			log.Println(internal.ErrorPrefix, "trusted networks: enforcing kill switch:", err)
+		}
+	}
+	t.killSwitchRelaxed = false
+
+	server := t.server
+	t.server = ""
+	if t.netw.IsVPNActive() {
+		return
+	}
+	switch {
+	case server != "":
+	case cfg.AutoConnect:
+		server = cfg.AutoConnectData.ServerTag
+	default:
+		return
+	}
+	if err := t.connect(&pb.ConnectRequest{ServerTag: server}); err != nil {
+		log.Println(internal.ErrorPrefix, "trusted networks: connecting:", err)
+	}
+}
+
+// matchTrustedNetwork returns the first entry naming the connection or its SSID
+// which was added with the given hardware address. Network is never trusted by
+// its addresses alone, as any network can use them. Returns empty string if
+// none matches.
+func matchTrustedNetwork(
+	networks []string,
+	addresses map[string]string,
+	connection metered.Connection,
+	address string,
+) string {
+	if address == "" {
+		return ""
+	}
+	for _, network := range networks {
+		if !(connection.Name != "" && network == connection.Name ||
+			connection.SSID != "" && network == connection.SSID) {
+			continue
+		}
+		if strings.EqualFold(addresses[network], address) {
+			return network
+		}
+	}
+	return ""
+}
+
+// normalizeTrustedNetwork returns the trimmed connection name. Subnets, which
+// were accepted before, are returned in the canonical form, so that they can
+// be removed.
+func normalizeTrustedNetwork(network string) string {
+	network = strings.TrimSpace(network)
+	if prefix, err := netip.ParsePrefix(network); err == nil {
+		return prefix.Masked().String()
+	}
+	return network
+}
+
+// JobTrustedNetworks suppresses VPN on the trusted networks
+func JobTrustedNetworks(r *RPC) func() {
+	return r.trustedNetworks.check
+}
@@ -0,0 +1,237 @@
+package daemon
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/metered"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/routes"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockTrustedGateway struct {
+	addr netip.Addr
+}
+
+func (g *mockTrustedGateway) Default(bool) (netip.Addr, net.Interface, error) {
+	return g.addr, net.Interface{}, nil
+}
+
+// gatewayHardwareAddr returns the hardware address resolver returning the mac
+func gatewayHardwareAddr(mac string) func(netip.Addr, net.Interface) (net.HardwareAddr, error) {
+	return func(netip.Addr, net.Interface) (net.HardwareAddr, error) {
+		if mac == "" {
+			return nil, routes.ErrGatewayNotResolved
+		}
+		return net.ParseMAC(mac)
+	}
+}
+
+func TestMatchTrustedNetwork(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	networks := []string{"HomeLAN", "10.0.0.0/24"}
+	addresses := map[string]string{
+		"HomeLAN":     "aa:bb:cc:dd:ee:ff",
+		"10.0.0.0/24": "11:22:33:44:55:66",
+	}
+	tests := []struct {
+		name       string
+		connection metered.Connection
+		address    string
+		expected   string
+	}{
+		{
+			name:       "connection name",
+			connection: metered.Connection{Name: "HomeLAN"},
+			address:    "aa:bb:cc:dd:ee:ff",
+			expected:   "HomeLAN",
+		},
+		{
+			name:       "ssid",
+			connection: metered.Connection{Name: "HomeLAN 1", SSID: "HomeLAN"},
+			address:    "AA:BB:CC:DD:EE:FF",
+			expected:   "HomeLAN",
+		},
+		{
+			name:       "other gateway",
+			connection: metered.Connection{Name: "HomeLAN"},
+			address:    "aa:bb:cc:dd:ee:00",
+		},
+		{
+			name:       "unknown gateway",
+			connection: metered.Connection{Name: "HomeLAN"},
+		},
+		{
+			name:       "subnet is never matched",
+			connection: metered.Connection{Name: "Wired connection 1"},
+			address:    "11:22:33:44:55:66",
+		},
+		{
+			name:       "other network",
+			connection: metered.Connection{Name: "Airport"},
+			address:    "aa:bb:cc:dd:ee:ff",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected,
+				matchTrustedNetwork(networks, addresses, test.connection, test.address))
+		})
+	}
+}
+
+func TestTrustedNetworks_Identify(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	detector := mockMeteredDetector{connection: metered.Connection{
+		Name:  "HomeLAN",
+		SSID:  "HomeLAN",
+		BSSID: "AA:BB:CC:00:00:01",
+	}}
+	tn := trustedNetworks{
+		detector:     &detector,
+		gateway:      &mockTrustedGateway{addr: netip.MustParseAddr("192.168.1.1")},
+		hardwareAddr: gatewayHardwareAddr("aa:bb:cc:dd:ee:ff"),
+	}
+
+	connection, address, err := tn.identify()
+	assert.NoError(t, err)
+	assert.Equal(t, "HomeLAN", connection.Name)
+	assert.Equal(t, "aa:bb:cc:dd:ee:ff", address)
+
+	// the access point identifies the network when the gateway is not resolved
+	tn.hardwareAddr = gatewayHardwareAddr("")
+	_, address, err = tn.identify()
+	assert.NoError(t, err)
+	assert.Equal(t, "aa:bb:cc:00:00:01", address)
+
+	detector.connection = metered.Connection{Name: "Wired connection 1"}
+	_, address, err = tn.identify()
+	assert.NoError(t, err)
+	assert.Equal(t, "", address)
+}
+
+func TestNormalizeTrustedNetwork(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, "192.168.1.0/24", normalizeTrustedNetwork(" 192.168.1.17/24 "))
+	assert.Equal(t, "Home LAN", normalizeTrustedNetwork(" Home LAN "))
+	assert.Equal(t, "", normalizeTrustedNetwork(" "))
+}
+
+func TestTrustedNetworks(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.KillSwitch = true
+	cm.Cfg.TrustedNetworks = []string{"HomeLAN"}
+	cm.Cfg.TrustedNetworkAddresses = map[string]string{"HomeLAN": "aa:bb:cc:dd:ee:ff"}
+	netw := &captivePortalNetworker{}
+	netw.active = true
+	netw.killSwitch = true
+	detector := mockMeteredDetector{connection: metered.Connection{Name: "Airport"}}
+	disconnects := 0
+	var requests []*pb.ConnectRequest
+	tn := trustedNetworks{
+		cm:           cm,
+		netw:         netw,
+		detector:     &detector,
+		gateway:      &mockTrustedGateway{addr: netip.MustParseAddr("192.168.1.1")},
+		hardwareAddr: gatewayHardwareAddr("aa:bb:cc:dd:ee:ff"),
+		disconnect: func() error {
+			disconnects++
+			netw.active = false
+			return nil
+		},
+		connect: func(request *pb.ConnectRequest) error {
+			requests = append(requests, request)
+			netw.active = true
+			return nil
+		},
+		lastServer: func() string { return "lt16" },
+	}
+
+	tn.check()
+	assert.Equal(t, 0, disconnects)
+
+	detector.connection = metered.Connection{Name: "HomeLAN"}
+	tn.check()
+	assert.Equal(t, 1, disconnects)
+	assert.False(t, netw.active)
+	assert.False(t, netw.killSwitch)
+
+	// connecting manually on the trusted network is respected
+	netw.active = true
+	tn.check()
+	assert.Equal(t, 1, disconnects)
+
+	netw.active = false
+	detector.connection = metered.Connection{Name: "Airport"}
+	tn.check()
+	assert.True(t, netw.killSwitch)
+	assert.Equal(t, []*pb.ConnectRequest{{ServerTag: "lt16"}}, requests)
+
+	// network with the same name behind another gateway is not trusted
+	tn.hardwareAddr = gatewayHardwareAddr("aa:bb:cc:dd:ee:00")
+	detector.connection = metered.Connection{Name: "HomeLAN"}
+	tn.check()
+	assert.Equal(t, 1, disconnects)
+	assert.True(t, netw.killSwitch)
+}
+
+func TestTrustedNetworks_OnDaemonStart(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name        string
+		autoConnect bool
+		expected    []*pb.ConnectRequest
+	}{
+		{
+			name: "stays disconnected after leaving",
+		},
+		{
+			name:        "auto-connects after leaving",
+			autoConnect: true,
+			expected:    []*pb.ConnectRequest{{ServerTag: "de"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.TrustedNetworks = []string{"Wired connection 1"}
+			cm.Cfg.TrustedNetworkAddresses = map[string]string{"Wired connection 1": "aa:bb:cc:dd:ee:ff"}
+			cm.Cfg.AutoConnect = test.autoConnect
+			cm.Cfg.AutoConnectData.ServerTag = "de"
+			detector := mockMeteredDetector{connection: metered.Connection{Name: "Wired connection 1"}}
+			var requests []*pb.ConnectRequest
+			tn := trustedNetworks{
+				cm:           cm,
+				netw:         &onDemandNetworker{},
+				detector:     &detector,
+				gateway:      &mockTrustedGateway{addr: netip.MustParseAddr("192.168.1.1")},
+				hardwareAddr: gatewayHardwareAddr("aa:bb:cc:dd:ee:ff"),
+				disconnect:   func() error { return nil },
+				connect: func(request *pb.ConnectRequest) error {
+					requests = append(requests, request)
+					return nil
+				},
+				lastServer: func() string { return "" },
+			}
+
+			tn.check()
+			assert.Equal(t, "Wired connection 1", tn.trusted)
+
+			detector.connection = metered.Connection{Name: "Wired connection 2"}
+			tn.check()
+			assert.Equal(t, test.expected, requests)
+		})
+	}
+}
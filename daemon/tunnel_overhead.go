@@ -0,0 +1,112 @@
+package daemon
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+)
+
+const (
+	ipv4HeaderSize = 20
+	ipv6HeaderSize = 40
+	udpHeaderSize  = 8
+	tcpHeaderSize  = 20
+	// wireguardOverhead consists of the message type, receiver index, counter
+	// and the authentication tag
+	wireguardOverhead = 32
+	// openVPNOverhead consists of the opcode with peer id, packet id and the
+	// AES-GCM authentication tag
+	openVPNOverhead = 24
+	// openVPNTCPFraming is the packet length prepended to every packet over TCP
+	openVPNTCPFraming = 2
+	// innerHeadersSize are IPv4 and TCP headers of the tunneled packet, which
+	// do not count towards the goodput
+	innerHeadersSize = ipv4HeaderSize + tcpHeaderSize
+	// maxTunnelMeasurement bounds the duration of the measurement
+	maxTunnelMeasurement = 30
+)
+
+// tunnelOverhead returns the amount of bytes added to every packet by the tunnel
+func tunnelOverhead(technology config.Technology, protocol config.Protocol, ipv6 bool) uint32 {
+	var overhead uint32 = ipv4HeaderSize
+	if ipv6 {
+		overhead = ipv6HeaderSize
+	}
+
+	switch {
+	case technology == config.Technology_NORDLYNX:
+		return overhead + udpHeaderSize + wireguardOverhead
+	case protocol == config.Protocol_TCP:
+		return overhead + tcpHeaderSize + openVPNTCPFraming + openVPNOverhead
+	default:
+		return overhead + udpHeaderSize + openVPNOverhead
+	}
+}
+
+// goodput returns the ratio of the link traffic used by the payload of full
+// sized packets
+func goodput(mtu uint32, overhead uint32) float64 {
+	if mtu <= innerHeadersSize {
+		return 0
+	}
+	return float64(mtu-innerHeadersSize) / float64(mtu+overhead)
+}
+
+// interfaceStats are the traffic counters of a network interface
+type interfaceStats struct {
+	bytes   uint64
+	packets uint64
+}
+
+func (s interfaceStats) sub(other interfaceStats) interfaceStats {
+	return interfaceStats{
+		bytes:   s.bytes - other.bytes,
+		packets: s.packets - other.packets,
+	}
+}
+
+// readInterfaceStats sums up received and transmitted traffic of the interface
+func readInterfaceStats(name string) (interfaceStats, error) {
+	var stats interfaceStats
+	for _, counter := range []struct {
+		file  string
+		value *uint64
+	}{
+		{"rx_bytes", &stats.bytes},
+		{"tx_bytes", &stats.bytes},
+		{"rx_packets", &stats.packets},
+		{"tx_packets", &stats.packets},
+	} {
+		// #nosec G304 -- interface name is not provided by the user
+		data, err := os.ReadFile(filepath.Join("/sys/class/net", name, "statistics", counter.file))
+		if err != nil {
+			return interfaceStats{}, fmt.Errorf("reading %s statistics: %w", name, err)
+		}
+		value, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+		if err != nil {
+			return interfaceStats{}, fmt.Errorf("parsing %s statistics: %w", name, err)
+		}
+		*counter.value += value
+	}
+	return stats, nil
+}
+
+// measuredOverhead calculates the average overhead per packet and the goodput
+// from the traffic counted during the measurement. Link traffic which did not
+// go through the tunnel is counted as well, so the result is approximate.
+func measuredOverhead(tunnel interfaceStats, link interfaceStats) (float64, float64) {
+	if tunnel.packets == 0 || link.bytes <= tunnel.bytes {
+		return 0, 0
+	}
+	overhead := float64(link.bytes-tunnel.bytes) / float64(tunnel.packets)
+
+	var payload uint64
+	if headers := tunnel.packets * innerHeadersSize; tunnel.bytes > headers {
+		payload = tunnel.bytes - headers
+	}
+	return overhead, float64(payload) / float64(link.bytes)
+}
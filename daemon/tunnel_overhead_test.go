@@ -0,0 +1,99 @@
+package daemon
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTunnelOverhead(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name       string
+		technology config.Technology
+		protocol   config.Protocol
+		ipv6       bool
+		expected   uint32
+	}{
+		{
+			name:       "nordlynx",
+			technology: config.Technology_NORDLYNX,
+			protocol:   config.Protocol_UDP,
+			expected:   60,
+		},
+		{
+			name:       "nordlynx over ipv6",
+			technology: config.Technology_NORDLYNX,
+			protocol:   config.Protocol_UDP,
+			ipv6:       true,
+			expected:   80,
+		},
+		{
+			name:       "openvpn udp",
+			technology: config.Technology_OPENVPN,
+			protocol:   config.Protocol_UDP,
+			expected:   52,
+		},
+		{
+			name:       "openvpn tcp",
+			technology: config.Technology_OPENVPN,
+			protocol:   config.Protocol_TCP,
+			expected:   66,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, tunnelOverhead(test.technology, test.protocol, test.ipv6))
+		})
+	}
+}
+
+func TestGoodput(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	// default NordLynx MTU leaves room for IPv6 outer headers within the Ethernet frame
+	assert.InDelta(t, 1380.0/1500.0, goodput(1420, 80), 0.0001)
+	assert.InDelta(t, 1460.0/1500.0, goodput(1500, 0), 0.0001)
+	assert.Equal(t, 0.0, goodput(40, 60))
+}
+
+func TestMeasuredOverhead(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name             string
+		tunnel           interfaceStats
+		link             interfaceStats
+		expectedOverhead float64
+		expectedGoodput  float64
+	}{
+		{
+			name:             "full sized packets",
+			tunnel:           interfaceStats{bytes: 14200, packets: 10},
+			link:             interfaceStats{bytes: 15000, packets: 10},
+			expectedOverhead: 80,
+			expectedGoodput:  13800.0 / 15000.0,
+		},
+		{
+			name: "no traffic",
+			link: interfaceStats{bytes: 500, packets: 5},
+		},
+		{
+			name:   "counters reset",
+			tunnel: interfaceStats{bytes: 1000, packets: 5},
+			link:   interfaceStats{bytes: 500, packets: 5},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			overhead, goodput := measuredOverhead(test.tunnel, test.link)
+			assert.InDelta(t, test.expectedOverhead, overhead, 0.0001)
+			assert.InDelta(t, test.expectedGoodput, goodput, 0.0001)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package nordlynx
+
+import (
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/tunnel"
+)
+
+// Fallback uses the kernel WireGuard when it is available and transparently
+// falls back to the userspace wireguard-go otherwise, e.g. in containers which
+// cannot load kernel modules or on old kernels.
+type Fallback struct {
+	kernel    vpn.VPN
+	userspace vpn.VPN
+	// toolsAvailable reports whether wg tools used to configure the kernel
+	// WireGuard are installed
+	toolsAvailable func() bool
+	// current is the implementation used by the latest start
+	current vpn.VPN
+	mu      sync.Mutex
+}
+
+func NewFallback(fwmark uint32) *Fallback {
+	return &Fallback{
+		kernel:    NewKernelSpace(fwmark),
+		userspace: NewUserSpace(fwmark),
+		toolsAvailable: func() bool {
+			return internal.IsCommandAvailable("wg")
+		},
+	}
+}
+
+func (f *Fallback) Start(creds vpn.Credentials, serverData vpn.ServerData) error {
+	if f.implementation().IsActive() {
+		return vpn.ErrVPNAIsAlreadyStarted
+	}
+
+	if f.toolsAvailable() {
+		err := f.kernel.Start(creds, serverData)
+		if err == nil {
+			f.setCurrent(f.kernel)
+			return nil
+		}
+		if !errors.Is(err, errNoKernelModule) {
+			return err
+		}
+		log.Println(internal.WarningPrefix, "kernel WireGuard is not available, falling back to userspace:", err)
+	} else {
+		log.Println(internal.InfoPrefix, "wg tools are not installed, using userspace WireGuard")
+	}
+
+	if err := f.userspace.Start(creds, serverData); err != nil {
+		return err
+	}
+	f.setCurrent(f.userspace)
+	return nil
+}
+
+func (f *Fallback) Stop() error {
+	return f.implementation().Stop()
+}
+
+func (f *Fallback) State() vpn.State {
+	return f.implementation().State()
+}
+
+func (f *Fallback) IsActive() bool {
+	return f.implementation().IsActive()
+}
+
+func (f *Fallback) Tun() tunnel.T {
+	return f.implementation().Tun()
+}
+
+func (f *Fallback) NetworkChanged() error {
+	return f.implementation().NetworkChanged()
+}
+
+// LatestHandshake with the server, zero if not connected
+func (f *Fallback) LatestHandshake() (time.Time, error) {
+	if reporter, ok := f.implementation().(vpn.HandshakeReporter); ok {
+		return reporter.LatestHandshake()
+	}
+	return time.Time{}, nil
+}
+
+// DataPlane of the established connection, empty if not connected
+func (f *Fallback) DataPlane() string {
+	implementation := f.implementation()
+	if !implementation.IsActive() {
+		return ""
+	}
+	if reporter, ok := implementation.(vpn.DataPlaneReporter); ok {
+		return reporter.DataPlane()
+	}
+	return ""
+}
+
+// implementation returns the implementation used by the latest start or the
+// kernel one if none was started yet
+func (f *Fallback) implementation() vpn.VPN {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.current == nil {
+		return f.kernel
+	}
+	return f.current
+}
+
+func (f *Fallback) setCurrent(implementation vpn.VPN) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.current = implementation
+}
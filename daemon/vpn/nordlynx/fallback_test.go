@@ -0,0 +1,82 @@
+package nordlynx
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type dataPlaneVPN struct {
+	mock.WorkingVPN
+	dataPlane string
+}
+
+func (d *dataPlaneVPN) DataPlane() string { return d.dataPlane }
+
+func TestFallback_Start(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name              string
+		toolsAvailable    bool
+		kernelErr         error
+		expectedErr       error
+		expectedDataPlane string
+		expectedUserspace bool
+	}{
+		{
+			name:              "kernel",
+			toolsAvailable:    true,
+			expectedDataPlane: vpn.DataPlaneKernel,
+		},
+		{
+			name:              "no kernel module",
+			toolsAvailable:    true,
+			kernelErr:         fmt.Errorf("turning on nordlynx: %w", errNoKernelModule),
+			expectedDataPlane: vpn.DataPlaneUserspace,
+			expectedUserspace: true,
+		},
+		{
+			name:              "no wg tools",
+			expectedDataPlane: vpn.DataPlaneUserspace,
+			expectedUserspace: true,
+		},
+		{
+			name:           "other kernel failure",
+			toolsAvailable: true,
+			kernelErr:      mock.ErrOnPurpose,
+			expectedErr:    mock.ErrOnPurpose,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kernel := &dataPlaneVPN{WorkingVPN: mock.WorkingVPN{StartErr: test.kernelErr}, dataPlane: vpn.DataPlaneKernel}
+			userspace := &dataPlaneVPN{dataPlane: vpn.DataPlaneUserspace}
+			fallback := Fallback{
+				kernel:         kernel,
+				userspace:      userspace,
+				toolsAvailable: func() bool { return test.toolsAvailable },
+			}
+			assert.Equal(t, "", fallback.DataPlane())
+
+			err := fallback.Start(vpn.Credentials{}, vpn.ServerData{})
+			assert.ErrorIs(t, err, test.expectedErr)
+			assert.Equal(t, test.expectedDataPlane, fallback.DataPlane())
+			assert.Equal(t, test.expectedUserspace, userspace.IsActive())
+			if test.expectedErr != nil {
+				return
+			}
+
+			assert.NoError(t, fallback.Stop())
+			assert.False(t, kernel.IsActive())
+			assert.False(t, userspace.IsActive())
+			assert.Equal(t, "", fallback.DataPlane())
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package nordlynx
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"net"
+	"net/netip"
+	"time"
+
+	"golang.org/x/crypto/blake2s"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/curve25519"
+)
+
+// Noise protocol values of WireGuard, see https://www.wireguard.com/protocol/
+const (
+	noiseConstruction = "Noise_IKpsk2_25519_ChaChaPoly_BLAKE2s"
+	noiseIdentifier   = "WireGuard v1 zx2c4 Jason@zx2c4.com"
+	labelMAC1         = "mac1----"
+
+	messageInitiationType = 1
+	messageResponseType   = 2
+	messageCookieType     = 3
+	messageInitiationSize = 148
+	// tai64nBase is the TAI64 label of the unix epoch
+	tai64nBase = 0x400000000000000a
+)
+
+var errUnexpectedReply = errors.New("unexpected reply to handshake initiation")
+
+// HandshakeProber checks whether the NordLynx server is reachable over the
+// network by sending it a handshake initiation. The server replies only to the
+// initiation from the known key, so the reply proves that WireGuard traffic
+// passes both ways. The handshake is not completed, so no session is made.
+type HandshakeProber struct{}
+
+// Probe returns once the server replied to the handshake initiation or the
+// context is done
+func (HandshakeProber) Probe(
+	ctx context.Context,
+	endpoint netip.AddrPort,
+	privateKey string,
+	publicKey string,
+) error {
+	rawPrivateKey, err := base64.StdEncoding.DecodeString(privateKey)
+	if err != nil {
+		return fmt.Errorf("decoding private key: %w", err)
+	}
+	rawPublicKey, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return fmt.Errorf("decoding server public key: %w", err)
+	}
+
+	var sender uint32
+	if err := binary.Read(rand.Reader, binary.LittleEndian, &sender); err != nil {
+		return fmt.Errorf("generating sender index: %w", err)
+	}
+	initiation, err := handshakeInitiation(rawPrivateKey, rawPublicKey, sender, time.Now())
+	if err != nil {
+		return fmt.Errorf("creating handshake initiation: %w", err)
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "udp", endpoint.String())
+	if err != nil {
+		return fmt.Errorf("dialing %s: %w", endpoint, err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return fmt.Errorf("setting deadline: %w", err)
+		}
+	}
+
+	if _, err := conn.Write(initiation); err != nil {
+		return fmt.Errorf("sending handshake initiation: %w", err)
+	}
+	reply := make([]byte, messageInitiationSize)
+	n, err := conn.Read(reply)
+	if err != nil {
+		return fmt.Errorf("receiving handshake response: %w", err)
+	}
+	if receiver, ok := replyReceiver(reply[:n]); !ok || receiver != sender {
+		return errUnexpectedReply
+	}
+	return nil
+}
+
+// replyReceiver returns the sender index of the initiation the reply is for.
+// Cookie reply is sent instead of the response by the server under load, it is
+// as good a proof of reachability.
+func replyReceiver(reply []byte) (uint32, bool) {
+	switch {
+	case len(reply) >= 12 && reply[0] == messageResponseType:
+		return binary.LittleEndian.Uint32(reply[8:12]), true
+	case len(reply) >= 8 && reply[0] == messageCookieType:
+		return binary.LittleEndian.Uint32(reply[4:8]), true
+	}
+	return 0, false
+}
+
+// handshakeInitiation returns the first message of the WireGuard handshake
+func handshakeInitiation(privateKey []byte, remotePublicKey []byte, sender uint32, now time.Time) ([]byte, error) {
+	publicKey, err := curve25519.X25519(privateKey, curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+	ephemeralPrivateKey := make([]byte, curve25519.ScalarSize)
+	if _, err := rand.Read(ephemeralPrivateKey); err != nil {
+		return nil, err
+	}
+	ephemeralPublicKey, err := curve25519.X25519(ephemeralPrivateKey, curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := make([]byte, messageInitiationSize)
+	msg[0] = messageInitiationType
+	binary.LittleEndian.PutUint32(msg[4:8], sender)
+	copy(msg[8:40], ephemeralPublicKey)
+
+	chainKey := blake2s.Sum256([]byte(noiseConstruction))
+	hash := mixHash(chainKey, []byte(noiseIdentifier))
+	hash = mixHash(hash, remotePublicKey)
+	chainKey = kdf1(chainKey, ephemeralPublicKey)
+	hash = mixHash(hash, ephemeralPublicKey)
+
+	shared, err := curve25519.X25519(ephemeralPrivateKey, remotePublicKey)
+	if err != nil {
+		return nil, err
+	}
+	var key [chacha20poly1305.KeySize]byte
+	chainKey, key = kdf2(chainKey, shared)
+	static, err := seal(key, publicKey, hash)
+	if err != nil {
+		return nil, err
+	}
+	copy(msg[40:88], static)
+	hash = mixHash(hash, static)
+
+	shared, err = curve25519.X25519(privateKey, remotePublicKey)
+	if err != nil {
+		return nil, err
+	}
+	_, key = kdf2(chainKey, shared)
+	timestamp, err := seal(key, tai64n(now), hash)
+	if err != nil {
+		return nil, err
+	}
+	copy(msg[88:116], timestamp)
+
+	macKey := blake2s.Sum256(append([]byte(labelMAC1), remotePublicKey...))
+	mac, err := blake2s.New128(macKey[:])
+	if err != nil {
+		return nil, err
+	}
+	mac.Write(msg[:116])
+	copy(msg[116:132], mac.Sum(nil))
+	// mac2 is left empty, it is needed only after the server sent a cookie
+	return msg, nil
+}
+
+func mixHash(hash [blake2s.Size]byte, data []byte) [blake2s.Size]byte {
+	return blake2s.Sum256(append(hash[:], data...))
+}
+
+func hmacBlake2s(key []byte, data ...[]byte) [blake2s.Size]byte {
+	mac := hmac.New(func() hash.Hash {
+		h, _ := blake2s.New256(nil)
+		return h
+	}, key)
+	for _, d := range data {
+		mac.Write(d)
+	}
+	var sum [blake2s.Size]byte
+	copy(sum[:], mac.Sum(nil))
+	return sum
+}
+
+func kdf1(chainKey [blake2s.Size]byte, input []byte) [blake2s.Size]byte {
+	prk := hmacBlake2s(chainKey[:], input)
+	return hmacBlake2s(prk[:], []byte{1})
+}
+
+func kdf2(chainKey [blake2s.Size]byte, input []byte) ([blake2s.Size]byte, [chacha20poly1305.KeySize]byte) {
+	prk := hmacBlake2s(chainKey[:], input)
+	first := hmacBlake2s(prk[:], []byte{1})
+	second := hmacBlake2s(prk[:], first[:], []byte{2})
+	return first, second
+}
+
+// seal encrypts with zero nonce, the keys are used only once
+func seal(key [chacha20poly1305.KeySize]byte, plaintext []byte, hash [blake2s.Size]byte) ([]byte, error) {
+	aead, err := chacha20poly1305.New(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return aead.Seal(nil, make([]byte, chacha20poly1305.NonceSize), plaintext, hash[:]), nil
+}
+
+func tai64n(t time.Time) []byte {
+	timestamp := make([]byte, 12)
+	binary.BigEndian.PutUint64(timestamp, tai64nBase+uint64(t.Unix()))
+	binary.BigEndian.PutUint32(timestamp[8:], uint32(t.Nanosecond()))
+	return timestamp
+}
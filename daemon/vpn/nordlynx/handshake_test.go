@@ -0,0 +1,126 @@
+package nordlynx
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"golang.org/x/crypto/curve25519"
+	"golang.zx2c4.com/wireguard/conn"
+	"golang.zx2c4.com/wireguard/device"
+	"golang.zx2c4.com/wireguard/tun/tuntest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testKeyPair(t *testing.T, seed byte) ([]byte, []byte) {
+	t.Helper()
+	private := make([]byte, curve25519.ScalarSize)
+	private[1], private[31] = seed, 64
+	public, err := curve25519.X25519(private, curve25519.Basepoint)
+	require.NoError(t, err)
+	return private, public
+}
+
+func TestHandshakeProber(t *testing.T) {
+	category.Set(t, category.Integration)
+
+	serverPrivate, serverPublic := testKeyPair(t, 1)
+	clientPrivate, clientPublic := testKeyPair(t, 2)
+
+	server := device.NewDevice(
+		tuntest.NewChannelTUN().TUN(),
+		conn.NewDefaultBind(),
+		device.NewLogger(device.LogLevelSilent, ""),
+	)
+	defer server.Close()
+	require.NoError(t, server.IpcSet(fmt.Sprintf(
+		"private_key=%s\nlisten_port=0\npublic_key=%s\nallowed_ip=10.5.0.2/32\n",
+		hex.EncodeToString(serverPrivate),
+		hex.EncodeToString(clientPublic),
+	)))
+	require.NoError(t, server.Up())
+	ipc, err := server.IpcGet()
+	require.NoError(t, err)
+	_, port, _ := strings.Cut(ipc[strings.Index(ipc, "listen_port="):], "=")
+	port, _, _ = strings.Cut(port, "\n")
+	listenPort, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	endpoint := netip.AddrPortFrom(netip.MustParseAddr("127.0.0.1"), uint16(listenPort))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	assert.NoError(t, HandshakeProber{}.Probe(
+		ctx,
+		endpoint,
+		base64.StdEncoding.EncodeToString(clientPrivate),
+		base64.StdEncoding.EncodeToString(serverPublic),
+	))
+
+	// server ignores the initiation meant for another key
+	_, otherPublic := testKeyPair(t, 3)
+	ctx, cancel = context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	assert.Error(t, HandshakeProber{}.Probe(
+		ctx,
+		endpoint,
+		base64.StdEncoding.EncodeToString(clientPrivate),
+		base64.StdEncoding.EncodeToString(otherPublic),
+	))
+}
+
+func TestHandshakeInitiation(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	private, _ := testKeyPair(t, 1)
+	_, remotePublic := testKeyPair(t, 2)
+	msg, err := handshakeInitiation(private, remotePublic, 0x01020304, time.Now())
+	require.NoError(t, err)
+
+	assert.Len(t, msg, messageInitiationSize)
+	assert.Equal(t, []byte{messageInitiationType, 0, 0, 0}, msg[:4])
+	assert.Equal(t, uint32(0x01020304), binary.LittleEndian.Uint32(msg[4:8]))
+	assert.Equal(t, make([]byte, 16), msg[132:], "mac2 is set only after the cookie")
+}
+
+func TestReplyReceiver(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	response := make([]byte, 92)
+	response[0] = messageResponseType
+	binary.LittleEndian.PutUint32(response[4:8], 1)
+	binary.LittleEndian.PutUint32(response[8:12], 2)
+	cookie := make([]byte, 64)
+	cookie[0] = messageCookieType
+	binary.LittleEndian.PutUint32(cookie[4:8], 3)
+
+	tests := []struct {
+		name     string
+		reply    []byte
+		receiver uint32
+		ok       bool
+	}{
+		{name: "response", reply: response, receiver: 2, ok: true},
+		{name: "cookie", reply: cookie, receiver: 3, ok: true},
+		{name: "initiation", reply: []byte{messageInitiationType, 0, 0, 0, 1, 0, 0, 0, 2, 0, 0, 0}},
+		{name: "truncated", reply: response[:10]},
+		{name: "empty"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			receiver, ok := replyReceiver(test.reply)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.receiver, receiver)
+		})
+	}
+}
@@ -6,8 +6,8 @@ import (
 	"net"
 	"net/netip"
 	"os/exec"
-	"strconv"
 	"sync"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/internal"
@@ -19,6 +19,8 @@ type KernelSpace struct {
 	active bool
 	fwmark uint32
 	tun    *tunnel.Tunnel
+	// name of the interface
+	name string
 	sync.Mutex
 }
 
@@ -26,6 +28,18 @@ func NewKernelSpace(fwmark uint32) *KernelSpace {
 	return &KernelSpace{
 		state:  vpn.ExitedState,
 		fwmark: fwmark,
+		name:   InterfaceName,
+	}
+}
+
+// NewHop returns the kernel space NordLynx for the entry hop of the multi-hop
+// connection. It uses its own interface, so that it can run together with the
+// tunnel to the exit server.
+func NewHop(fwmark uint32) *KernelSpace {
+	return &KernelSpace{
+		state:  vpn.ExitedState,
+		fwmark: fwmark,
+		name:   HopInterfaceName,
 	}
 }
 
@@ -44,19 +58,26 @@ func (k *KernelSpace) Start(
 		k.fwmark,
 		serverData.NordLynxPublicKey,
 		serverData.IP,
+		serverData.Port,
 	)
 
+	name := k.name
+	if serverData.InterfaceName != "" {
+		name = serverData.InterfaceName
+	}
+
 	//check if wireguard is not up already
-	if _, err := exec.Command("ip", "link", "show", "dev", InterfaceName).Output(); err == nil {
+	// #nosec G204 -- interface name is validated before saving it to the config
+	if _, err := exec.Command("ip", "link", "show", "dev", name).Output(); err == nil {
 		return vpn.ErrTunnelAlreadyExists
 	}
 
 	//add wireguard interface
-	if err := upWGInterface(InterfaceName); err != nil {
+	if err := upWGInterface(name); err != nil {
 		return fmt.Errorf("turning on nordlynx: %w", err)
 	}
 
-	iface, err := net.InterfaceByName(InterfaceName)
+	iface, err := net.InterfaceByName(name)
 	if err != nil {
 		if err := k.Stop(); err != nil {
 			log.Println(internal.DeferPrefix, err)
@@ -64,11 +85,7 @@ func (k *KernelSpace) Start(
 		return err
 	}
 
-	interfaceIps := []netip.Addr{netip.MustParseAddr("10.5.0.2")}
-	ipv6, err := vpn.InterfaceIPv6(serverData.IP, interfaceID())
-	if err == nil {
-		interfaceIps = append(interfaceIps, ipv6)
-	}
+	interfaceIps := interfaceIPs(serverData.IP)
 
 	tun := tunnel.New(*iface, interfaceIps)
 	k.tun = tun
@@ -128,6 +145,21 @@ func (k *KernelSpace) Tun() tunnel.T {
 	return k.tun
 }
 
+// LatestHandshake with the server, zero if not connected
+func (k *KernelSpace) LatestHandshake() (time.Time, error) {
+	k.Lock()
+	defer k.Unlock()
+	if k.tun == nil {
+		return time.Time{}, nil
+	}
+	return latestHandshake(k.tun.Interface().Name)
+}
+
+// DataPlane is always the kernel
+func (k *KernelSpace) DataPlane() string {
+	return vpn.DataPlaneKernel
+}
+
 func (k *KernelSpace) State() vpn.State {
 	k.Lock()
 	defer k.Unlock()
@@ -187,15 +219,13 @@ func wgQuickConfig(
 	fwmark uint32,
 	publicKey string,
 	serverIP netip.Addr,
+	port uint16,
 ) string {
 	return fmt.Sprintf(
 		wgQuickTemplate,
 		privateKey,
 		fwmark,
 		publicKey,
-		net.JoinHostPort(
-			serverIP.String(),
-			strconv.Itoa(defaultPort),
-		),
+		Endpoint(serverIP, port),
 	)
 }
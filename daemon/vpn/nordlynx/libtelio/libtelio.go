@@ -83,6 +83,7 @@ type Libtelio struct {
 	// retrieved from the API
 	currentPrivateKey      string
 	currentServerIP        netip.Addr
+	currentServerPort      uint16
 	currentServerPublicKey string
 	isMeshEnabled          bool
 	meshnetMap             string
@@ -240,7 +241,7 @@ func (l *Libtelio) Start(
 		return fmt.Errorf("opening the tunnel: %w", err)
 	}
 
-	if err = l.connect(serverData.IP, serverData.NordLynxPublicKey); err != nil {
+	if err = l.connect(serverData.IP, serverData.Port, serverData.NordLynxPublicKey); err != nil {
 		return err
 	}
 
@@ -248,12 +249,13 @@ func (l *Libtelio) Start(
 	// in case meshnet is enabled and disabled before calling Stop
 	l.currentPrivateKey = creds.NordLynxPrivateKey
 	l.currentServerIP = serverData.IP
+	l.currentServerPort = serverData.Port
 	l.currentServerPublicKey = serverData.NordLynxPublicKey
 	return nil
 }
 
 // connect to the VPN server
-func (l *Libtelio) connect(serverIP netip.Addr, serverPublicKey string) error {
+func (l *Libtelio) connect(serverIP netip.Addr, serverPort uint16, serverPublicKey string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 	defer cancel()
 	// Start monitoring connection events before connecting to not miss any
@@ -262,7 +264,7 @@ func (l *Libtelio) connect(serverIP netip.Addr, serverPublicKey string) error {
 	if err := toError(l.lib.ConnectToExitNode(
 		serverPublicKey,
 		"0.0.0.0/0",
-		net.JoinHostPort(serverIP.String(), "51820"),
+		nordlynx.Endpoint(serverIP, serverPort),
 	)); err != nil {
 		if !l.isMeshEnabled {
 			// only close the tunnel when there was VPN connect problem
@@ -371,7 +373,7 @@ func (l *Libtelio) Enable(ip netip.Addr, privateKey string) (err error) {
 		}
 
 		// Re-connect to the VPN server
-		if err = l.connect(l.currentServerIP, l.currentServerPublicKey); err != nil {
+		if err = l.connect(l.currentServerIP, l.currentServerPort, l.currentServerPublicKey); err != nil {
 			return fmt.Errorf("reconnecting to server: %w", err)
 		}
 	}
@@ -417,12 +419,13 @@ func (l *Libtelio) NetworkChanged() error {
 
 		if l.active {
 			serverIP := l.currentServerIP
+			serverPort := l.currentServerPort
 			serverPublicKey := l.currentServerPublicKey
 			if err := l.disconnect(); err != nil {
 				return err
 			}
 
-			if err := l.connect(serverIP, serverPublicKey); err != nil {
+			if err := l.connect(serverIP, serverPort, serverPublicKey); err != nil {
 				return err
 			}
 		}
@@ -469,15 +472,13 @@ func (l *Libtelio) Refresh(c mesh.MachineMap) error {
 type peer struct {
 	PublicKey string `json:"public_key"`
 	State     string `json:"state"`
+	Path      string `json:"path"`
 }
 
 func (l *Libtelio) StatusMap() (map[string]string, error) {
-	l.mu.Lock()
-	defer l.mu.Unlock()
-
-	var peers []peer
-	if err := json.Unmarshal([]byte(l.lib.GetStatusMap()), &peers); err != nil {
-		return nil, fmt.Errorf("unmarshalling peer list: %w", err)
+	peers, err := l.peers()
+	if err != nil {
+		return nil, err
 	}
 
 	m := map[string]string{}
@@ -487,6 +488,33 @@ func (l *Libtelio) StatusMap() (map[string]string, error) {
 	return m, nil
 }
 
+// PathMap returns path types of the connected peers
+func (l *Libtelio) PathMap() (map[string]string, error) {
+	peers, err := l.peers()
+	if err != nil {
+		return nil, err
+	}
+
+	m := map[string]string{}
+	for _, p := range peers {
+		if p.State == "connected" && p.Path != "" {
+			m[p.PublicKey] = p.Path
+		}
+	}
+	return m, nil
+}
+
+func (l *Libtelio) peers() ([]peer, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var peers []peer
+	if err := json.Unmarshal([]byte(l.lib.GetStatusMap()), &peers); err != nil {
+		return nil, fmt.Errorf("unmarshalling peer list: %w", err)
+	}
+	return peers, nil
+}
+
 // openTunnel if not opened already
 func (l *Libtelio) openTunnel(ip netip.Addr, privateKey string) (err error) {
 	if l.tun != nil {
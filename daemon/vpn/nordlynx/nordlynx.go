@@ -6,10 +6,14 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"net/netip"
 	"os/exec"
+	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 
 	"golang.org/x/sys/unix"
@@ -17,7 +21,9 @@ import (
 
 const (
 	// InterfaceName for various NordLynx implementations
-	InterfaceName       = "nordlynx"
+	InterfaceName = "nordlynx"
+	// HopInterfaceName is used by the entry hop of the multi-hop connection
+	HopInterfaceName    = "nordlynx-hop"
 	defaultPort         = 51820
 	defaultMTU          = 1500
 	wireguardHeaderSize = 80
@@ -37,6 +43,62 @@ func interfaceID() [8]byte {
 	return [8]byte{0x0, 0x0, 0x0, 0x11, 0x0, 0x5, 0x0, 0x2}
 }
 
+// interfaceIPs returns addresses of the NordLynx interface when connected to the server
+func interfaceIPs(serverIP netip.Addr) []netip.Addr {
+	ips := []netip.Addr{netip.MustParseAddr("10.5.0.2")}
+	ipv6, err := vpn.InterfaceIPv6(serverIP, interfaceID())
+	if err == nil {
+		ips = append(ips, ipv6)
+	}
+	return ips
+}
+
+// externalConfigTemplate is a template for wg-quick config used outside of the app
+const externalConfigTemplate = `# This config contains the credentials of your NordVPN account.
+# Do not share it with anyone.
+[Interface]
+PrivateKey = %s
+Address = %s
+DNS = %s
+
+[Peer]
+PublicKey = %s
+AllowedIPs = 0.0.0.0/0, ::/0
+Endpoint = %s
+PersistentKeepalive = 25
+`
+
+// ExternalConfig returns wg-quick config which connects to the server using
+// other WireGuard clients
+func ExternalConfig(
+	privateKey string,
+	publicKey string,
+	serverIP netip.Addr,
+	nameservers []string,
+) string {
+	var addresses []string
+	for _, ip := range interfaceIPs(serverIP) {
+		addresses = append(addresses, netip.PrefixFrom(ip, ip.BitLen()).String())
+	}
+	return fmt.Sprintf(
+		externalConfigTemplate,
+		privateKey,
+		strings.Join(addresses, ", "),
+		strings.Join(nameservers, ", "),
+		publicKey,
+		Endpoint(serverIP, 0),
+	)
+}
+
+// Endpoint of the NordLynx server on the given port, or on the default port if
+// the port is zero
+func Endpoint(serverIP netip.Addr, port uint16) string {
+	if port == 0 {
+		port = defaultPort
+	}
+	return net.JoinHostPort(serverIP.String(), strconv.Itoa(int(port)))
+}
+
 // getDefaultIpRouteInterface takes output of the `ip route show default` command and returns the
 // interface/device name. If there are multiple default routes in the output, first one will be returned
 func getDefaultIpRouteInterface(ipRouteOutput string) (string, error) {
@@ -119,16 +181,10 @@ func calculateMTU(ipRouteOutput string) int {
 
 func upWGInterface(iface string) error {
 	debug("ip", "link", "add", iface, "type", "wireguard")
-	err := addDevice(iface)
-	// there are only 2 cases when this can fail:
-	// 1. Either kernel module is not found or the kernel was
-	// recently updated, but the system is yet to be rebooted.
-	// 2. wg command not found in path. (valid while we still rely on wg-tools)
-	if err != nil {
-		if internal.IsCommandAvailable("wg") {
-			return err
-		}
-		return errNoKernelModule
+	// this can fail only if the kernel module is not found or the kernel was
+	// recently updated, but the system is yet to be rebooted
+	if err := addDevice(iface); err != nil {
+		return fmt.Errorf("%w: %s", errNoKernelModule, err)
 	}
 	return nil
 }
@@ -162,6 +218,40 @@ func removeDevice(device string) ([]byte, error) {
 	return out, nil
 }
 
+// latestHandshake returns the time of the most recent handshake with any of
+// the peers of the interface
+func latestHandshake(iface string) (time.Time, error) {
+	// #nosec G204 -- input is properly sanitized
+	out, err := exec.Command("wg", "show", iface, "latest-handshakes").CombinedOutput()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("showing latest handshakes: %s: %w", string(out), err)
+	}
+	return parseLatestHandshakes(string(out))
+}
+
+// parseLatestHandshakes parses the output of 'wg show <iface> latest-handshakes'
+// where each line consists of the peer public key and the unix timestamp
+func parseLatestHandshakes(output string) (time.Time, error) {
+	var latest int64
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		timestamp, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parsing handshake time: %w", err)
+		}
+		if timestamp > latest {
+			latest = timestamp
+		}
+	}
+	if latest == 0 {
+		return time.Time{}, nil
+	}
+	return time.Unix(latest, 0), nil
+}
+
 func debug(data ...string) {
 	log.Println("[nordlynx]", strings.Join(data, " "))
 }
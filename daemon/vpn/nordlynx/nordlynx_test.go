@@ -2,9 +2,11 @@ package nordlynx
 
 import (
 	"net"
+	"net/netip"
 	"os/exec"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/test/category"
 
@@ -162,3 +164,74 @@ func TestRetrieveAndCalculateMTU(t *testing.T) {
 	mtu := retrieveAndCalculateMTU()
 	assert.Equal(t, defaultGateway.MTU-wireguardHeaderSize, mtu)
 }
+
+func TestExternalConfig(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	config := ExternalConfig(
+		"private",
+		"public",
+		netip.MustParseAddr("2001:db8::1"),
+		[]string{"103.86.96.100", "103.86.99.100"},
+	)
+	assert.Contains(t, config, "PrivateKey = private\n")
+	assert.Contains(t, config, "Address = 10.5.0.2/32, 2001:db8::11:5:2/128\n")
+	assert.Contains(t, config, "DNS = 103.86.96.100, 103.86.99.100\n")
+	assert.Contains(t, config, "PublicKey = public\n")
+	assert.Contains(t, config, "Endpoint = [2001:db8::1]:51820\n")
+}
+
+func TestParseLatestHandshakes(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		output   string
+		expected time.Time
+		hasError bool
+	}{
+		{
+			name:     "single peer",
+			output:   "hSDwCYkwp1R0i33ctD73Wg2/Og0mOBr066SpjqqbTmo=\t1700000000\n",
+			expected: time.Unix(1700000000, 0),
+		},
+		{
+			name: "most recent peer",
+			output: "hSDwCYkwp1R0i33ctD73Wg2/Og0mOBr066SpjqqbTmo=\t1700000000\n" +
+				"dwdtCnMYpX08FsFyUbJmRd9ML4frwJkqsXf7pR25LCo=\t1700000100\n",
+			expected: time.Unix(1700000100, 0),
+		},
+		{
+			name:   "no handshake yet",
+			output: "hSDwCYkwp1R0i33ctD73Wg2/Og0mOBr066SpjqqbTmo=\t0\n",
+		},
+		{
+			name: "no peers",
+		},
+		{
+			name:     "invalid timestamp",
+			output:   "hSDwCYkwp1R0i33ctD73Wg2/Og0mOBr066SpjqqbTmo=\tnever\n",
+			hasError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			latest, err := parseLatestHandshakes(test.output)
+			if test.hasError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, latest)
+		})
+	}
+}
+
+func TestEndpoint(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, "1.1.1.1:51820", Endpoint(netip.MustParseAddr("1.1.1.1"), 0))
+	assert.Equal(t, "1.1.1.1:443", Endpoint(netip.MustParseAddr("1.1.1.1"), 443))
+	assert.Equal(t, "[2001:db8::1]:53", Endpoint(netip.MustParseAddr("2001:db8::1"), 53))
+}
@@ -12,9 +12,9 @@ import (
 	"net/netip"
 	"os"
 	"os/exec"
-	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/internal"
@@ -58,6 +58,7 @@ func uapiConfig(
 	fwmark uint32,
 	publicKey string,
 	serverIP netip.Addr,
+	port uint16,
 ) (string, error) {
 	// UAPI requires keys as hex encoded raw bytes
 	rawPrivKey, err := base64.StdEncoding.DecodeString(privateKey)
@@ -72,10 +73,7 @@ func uapiConfig(
 		hex.EncodeToString(rawPrivKey),
 		fwmark,
 		hex.EncodeToString(rawPubKey),
-		net.JoinHostPort(
-			serverIP.String(),
-			strconv.Itoa(defaultPort),
-		),
+		Endpoint(serverIP, port),
 	), nil
 }
 
@@ -94,23 +92,29 @@ func (u *UserSpace) Start(
 		u.fwmark,
 		serverData.NordLynxPublicKey,
 		serverData.IP,
+		serverData.Port,
 	)
 	if err != nil {
 		return fmt.Errorf("generating uapi config: %w", err)
 	}
-	log.Println("UAPI CONFIG:", conf)
+
+	name := InterfaceName
+	if serverData.InterfaceName != "" {
+		name = serverData.InterfaceName
+	}
 
 	// check if wireguard interface is not up already
-	if _, err := exec.Command("ip", "link", "show", "dev", InterfaceName).Output(); err == nil {
+	// #nosec G204 -- interface name is validated before saving it to the config
+	if _, err := exec.Command("ip", "link", "show", "dev", name).Output(); err == nil {
 		return vpn.ErrTunnelAlreadyExists
 	}
 
-	conn, err := wgGoTurnOn(InterfaceName, conf)
+	conn, err := wgGoTurnOn(name, conf)
 	if err != nil {
 		return fmt.Errorf("turning on nordlynx: %w", err)
 	}
 
-	iface, err := net.InterfaceByName(InterfaceName)
+	iface, err := net.InterfaceByName(name)
 	if err != nil {
 		if err := u.stop(); err != nil {
 			log.Println(internal.DeferPrefix, err)
@@ -118,11 +122,7 @@ func (u *UserSpace) Start(
 		return err
 	}
 
-	interfaceIps := []netip.Addr{netip.MustParseAddr("10.5.0.2")}
-	ipv6, err := vpn.InterfaceIPv6(serverData.IP, interfaceID())
-	if err == nil {
-		interfaceIps = append(interfaceIps, ipv6)
-	}
+	interfaceIps := interfaceIPs(serverData.IP)
 
 	u.conn = conn
 
@@ -185,12 +185,32 @@ func (u *UserSpace) IsActive() bool {
 	return u.active
 }
 
+func (u *UserSpace) NetworkChanged() error {
+	return fmt.Errorf("not supported")
+}
+
+// DataPlane is always the userspace
+func (u *UserSpace) DataPlane() string {
+	return vpn.DataPlaneUserspace
+}
+
 func (u *UserSpace) State() vpn.State {
 	u.Lock()
 	defer u.Unlock()
 	return u.state
 }
 
+// LatestHandshake with the server, zero if not connected. Device is queried
+// through its UAPI socket.
+func (u *UserSpace) LatestHandshake() (time.Time, error) {
+	u.Lock()
+	defer u.Unlock()
+	if u.tun == nil {
+		return time.Time{}, nil
+	}
+	return latestHandshake(u.tun.Interface().Name)
+}
+
 func (u *UserSpace) Tun() tunnel.T {
 	u.Lock()
 	defer u.Unlock()
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"log"
 	"net/netip"
 	"regexp"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -46,6 +48,15 @@ const (
 	InterfaceName = "nordtun"
 )
 
+// defaultObfuscationPorts are tried when the server does not advertise the ports
+// it accepts obfuscated connections on
+var defaultObfuscationPorts = map[config.Protocol][]uint16{
+	config.Protocol_UDP: {1194, 443, 53},
+	config.Protocol_TCP: {443, 80, 465, 587, 993, 995},
+}
+
+var remoteRegex = regexp.MustCompile(`^remote\s+\S+\s+\d+(\s+\S+)?\s*$`)
+
 type ovpnConfigData struct {
 	Address    string
 	Identifier string
@@ -53,36 +64,30 @@ type ovpnConfigData struct {
 
 // setOpenVPNConfig is used to pass generated config to the OpenVPN process.
 // Config has to be passed everytime when new OpenVPN process is started.
-func setOpenVPNConfig(protocol config.Protocol, serverIP netip.Addr, obfuscated bool, serverVersion string) error {
+func setOpenVPNConfig(
+	protocol config.Protocol,
+	serverIP netip.Addr,
+	obfuscated bool,
+	obfuscationPorts []uint16,
+	port uint16,
+	serverVersion string,
+) error {
 	if serverVersion == "" {
 		return ErrServerVersion
 	}
-	return generateConfigFile(protocol, serverIP, obfuscated)
+	return generateConfigFile(protocol, serverIP, obfuscated, obfuscationPorts, port)
 }
 
-func generateConfigFile(protocol config.Protocol, serverIP netip.Addr, obfuscated bool) error {
-	templatePath := internal.OvpnTemplatePath
-	if obfuscated {
-		templatePath = internal.OvpnObfsTemplatePath
-	}
-
-	identifier, err := getConfigIdentifier(protocol, obfuscated)
-	if err != nil {
-		return fmt.Errorf("getting config identifier: %w", err)
-	}
-
-	template, err := internal.FileRead(templatePath)
+func generateConfigFile(
+	protocol config.Protocol,
+	serverIP netip.Addr,
+	obfuscated bool,
+	obfuscationPorts []uint16,
+	port uint16,
+) error {
+	out, err := RenderConfig(protocol, serverIP, obfuscated, obfuscationPorts, port, nil)
 	if err != nil {
-		return fmt.Errorf("reading ovpn template file")
-	}
-
-	out, err := generateConfig(serverIP, identifier, template)
-	if err != nil {
-		return fmt.Errorf("generating OpenVPN config: %w", err)
-	}
-
-	if err := addExtraParameters(out, serverIP, protocol); err != nil {
-		return fmt.Errorf("adding extra parameters to OpenVPN config: %w", err)
+		return err
 	}
 
 	if internal.FileExists(openVPNConfigFileName) {
@@ -106,6 +111,55 @@ func generateConfigFile(protocol config.Protocol, serverIP netip.Addr, obfuscate
 	return ovpnConfig.Close()
 }
 
+// RenderConfig renders the OpenVPN config the same way as it is done before
+// connecting, without writing it to the disk. The installed template is used if
+// template is nil. Non-zero port replaces the ports of the remotes.
+func RenderConfig(
+	protocol config.Protocol,
+	serverIP netip.Addr,
+	obfuscated bool,
+	obfuscationPorts []uint16,
+	port uint16,
+	template []byte,
+) ([]byte, error) {
+	identifier, err := getConfigIdentifier(protocol, obfuscated)
+	if err != nil {
+		return nil, fmt.Errorf("getting config identifier: %w", err)
+	}
+
+	if template == nil {
+		templatePath := internal.OvpnTemplatePath
+		if obfuscated {
+			templatePath = internal.OvpnObfsTemplatePath
+		}
+		template, err = internal.FileRead(templatePath)
+		if err != nil {
+			return nil, fmt.Errorf("reading ovpn template file")
+		}
+	}
+
+	out, err := generateConfig(serverIP, identifier, template)
+	if err != nil {
+		return nil, fmt.Errorf("generating OpenVPN config: %w", err)
+	}
+
+	if err := addExtraParameters(out, serverIP, protocol); err != nil {
+		return nil, fmt.Errorf("adding extra parameters to OpenVPN config: %w", err)
+	}
+
+	if obfuscated {
+		if len(obfuscationPorts) == 0 {
+			obfuscationPorts = defaultObfuscationPorts[protocol]
+			log.Println(internal.InfoPrefix, "server does not advertise obfuscation ports, trying", obfuscationPorts)
+		}
+		out = setRemotePorts(out, obfuscationPorts)
+	}
+	if port != 0 {
+		out = setRemotePorts(out, []uint16{port})
+	}
+	return out, nil
+}
+
 func generateConfig(serverIP netip.Addr, identifier openvpnID, template []byte) ([]byte, error) {
 	xmlConfig, err := generateConfigXML(serverIP, identifier)
 	if err != nil {
@@ -199,6 +253,29 @@ func addExtraParameters(data []byte, serverIP netip.Addr, protocol config.Protoc
 	return nil
 }
 
+// setRemotePorts replaces the remotes of the config with the remotes on the
+// given ports. Address and protocol of the first remote are kept.
+func setRemotePorts(data []byte, ports []uint16) []byte {
+	var lines []string
+	replaced := false
+	for _, line := range strings.Split(string(data), "\n") {
+		if !remoteRegex.MatchString(line) {
+			lines = append(lines, line)
+			continue
+		}
+		if replaced {
+			continue
+		}
+		replaced = true
+		fields := strings.Fields(line)
+		for _, port := range ports {
+			remote := append([]string{fields[0], fields[1], strconv.Itoa(int(port))}, fields[3:]...)
+			lines = append(lines, strings.Join(remote, " "))
+		}
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
+
 func addOrReplaceArgument(args []string, newArg string, regex string) []string {
 	index := -1
 	reg, _ := regexp.Compile(regex)
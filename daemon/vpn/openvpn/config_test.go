@@ -135,3 +135,47 @@ func TestGenerateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestSetRemotePorts(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	data := []byte(`client
+remote 5.5.5.5 465 tcp
+remote 5.5.5.5 587 tcp
+remote 5.5.5.5 993 tcp
+
+remote-random
+remote-cert-tls server`)
+
+	expected := `client
+remote 5.5.5.5 443 tcp
+remote 5.5.5.5 8443 tcp
+
+remote-random
+remote-cert-tls server`
+	assert.Equal(t, expected, string(setRemotePorts(data, []uint16{443, 8443})))
+}
+
+func TestRemotePortRegex(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		line     string
+		expected string
+	}{
+		{line: "UDP link remote: [AF_INET]5.5.5.5:1194", expected: "1194"},
+		{line: "TCP connection established with [AF_INET]5.5.5.5:443", expected: "443"},
+		{line: "TCP connection established with [AF_INET6]2001:db8::1:465", expected: "465"},
+		{line: "Attempting to establish TCP connection with [AF_INET]5.5.5.5:443"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.line, func(t *testing.T) {
+			var port string
+			if match := remotePortRegex.FindStringSubmatch(test.line); match != nil {
+				port = match[1]
+			}
+			assert.Equal(t, test.expected, port)
+		})
+	}
+}
@@ -10,13 +10,16 @@ import (
 	"net"
 	"net/netip"
 	"os/exec"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	gopenvpn "github.com/NordSecurity/gopenvpn/openvpn"
+	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/transport"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	"github.com/NordSecurity/nordvpn-linux/tunnel"
 )
@@ -46,6 +49,11 @@ type OpenVPN struct {
 	tun      *tunnel.Tunnel
 	active   bool
 	fwmark   uint32
+	// remotePort is the server port reported by OpenVPN
+	remotePort string
+	// forwarder relays the connection through the obfuscation transport, nil
+	// if the connection is not wrapped
+	forwarder *transport.Forwarder
 	// sync.Mutex is used all over the place due to how OpenVPN
 	// is managed over the management interface.
 	// Simple Lock(); defer Unlock() results in deadlocks, since
@@ -77,13 +85,26 @@ func (ovpn *OpenVPN) Start(
 		return errors.New("server credentials not provided")
 	}
 
+	ovpn.closeForwarder()
+	remote := serverData
+	if serverData.Obfuscated && serverData.ObfuscationMode.IsWrapped() {
+		var err error
+		if remote, err = ovpn.startForwarder(serverData); err != nil {
+			ovpn.Unlock()
+			return fmt.Errorf("starting obfuscation transport: %w", err)
+		}
+	}
+
 	err := setOpenVPNConfig(
-		serverData.Protocol,
-		serverData.IP,
-		serverData.Obfuscated,
-		serverData.OpenVPNVersion,
+		remote.Protocol,
+		remote.IP,
+		remote.Obfuscated,
+		remote.ObfuscationPorts,
+		remote.Port,
+		remote.OpenVPNVersion,
 	)
 	if err != nil {
+		ovpn.closeForwarder()
 		ovpn.Unlock()
 		return fmt.Errorf("setting openvpn server to connect to: %w", err)
 	}
@@ -98,6 +119,11 @@ func (ovpn *OpenVPN) Start(
 	defer close(mErrCh)
 
 	ovpn.active = true
+	ovpn.remotePort = ""
+	name := InterfaceName
+	if serverData.InterfaceName != "" {
+		name = serverData.InterfaceName
+	}
 	// #nosec G204 -- input is properly sanitized
 	ovpn.process = exec.Command(
 		openVPNExec,
@@ -112,7 +138,7 @@ func (ovpn *OpenVPN) Start(
 		"--verify-x509-name", fmt.Sprintf("CN=%s", serverData.Hostname), // certificate validation
 		"--mark", strconv.Itoa(int(ovpn.fwmark)),
 		"--dev-type", interfaceType,
-		"--dev", InterfaceName,
+		"--dev", name,
 	)
 	ovpn.Unlock()
 
@@ -204,6 +230,7 @@ func (ovpn *OpenVPN) stop() error {
 
 	ovpn.Lock()
 	defer ovpn.Unlock()
+	ovpn.closeForwarder()
 	ovpn.manager = nil
 	ovpn.tun = nil
 	ovpn.active = false
@@ -211,6 +238,49 @@ func (ovpn *OpenVPN) stop() error {
 	return nil
 }
 
+// startForwarder starts relaying the connection through the transport of the
+// obfuscation mode and returns the server data of the local end, which OpenVPN
+// connects to over TCP. Must be called with the lock held.
+func (ovpn *OpenVPN) startForwarder(serverData vpn.ServerData) (vpn.ServerData, error) {
+	wrapper, err := transport.New(serverData.ObfuscationMode, serverData.Hostname, ovpn.fwmark)
+	if err != nil {
+		return vpn.ServerData{}, err
+	}
+	port := serverData.Port
+	if port == 0 {
+		port = transport.Port
+	}
+	forwarder, err := transport.NewForwarder(
+		wrapper,
+		netip.AddrPortFrom(serverData.IP, port).String(),
+	)
+	if err != nil {
+		return vpn.ServerData{}, err
+	}
+	ovpn.forwarder = forwarder
+	log.Println(internal.InfoPrefix, "wrapping OpenVPN connection in", serverData.ObfuscationMode, "on port", port)
+
+	local := serverData
+	local.IP = forwarder.Addr().Addr()
+	local.Port = forwarder.Addr().Port()
+	local.Protocol = config.Protocol_TCP
+	local.Obfuscated = false
+	local.ObfuscationPorts = nil
+	return local, nil
+}
+
+// closeForwarder stops relaying the connection through the transport. Must be
+// called with the lock held.
+func (ovpn *OpenVPN) closeForwarder() {
+	if ovpn.forwarder == nil {
+		return
+	}
+	if err := ovpn.forwarder.Close(); err != nil {
+		log.Println(internal.WarningPrefix, "closing obfuscation transport:", err)
+	}
+	ovpn.forwarder = nil
+}
+
 func (ovpn *OpenVPN) NetworkChanged() error {
 	// application uses the flag --persist-tun and it should not close the TUN interface at restarts
 	// but because the servers sends different configuration at pull, then the TUN is closed + recreated
@@ -262,8 +332,8 @@ func (ovpn *OpenVPN) startOpenVPN() error {
 
 	stdoutCh := make(chan struct{})
 	stderrCh := make(chan struct{})
-	go vpnMonitor(stdout, "INFO", stdoutCh)
-	go vpnMonitor(stderr, "ERROR", stderrCh)
+	go vpnMonitor(stdout, "INFO", stdoutCh, ovpn.setRemotePort)
+	go vpnMonitor(stderr, "ERROR", stderrCh, ovpn.setRemotePort)
 
 	err = ovpn.process.Start()
 	if err != nil {
@@ -300,6 +370,18 @@ func (ovpn *OpenVPN) setState(arg string) {
 	ovpn.state, _ = vpn.StringToState(arg)
 }
 
+func (ovpn *OpenVPN) setRemotePort(port string) {
+	ovpn.Lock()
+	defer ovpn.Unlock()
+	ovpn.remotePort = port
+}
+
+func (ovpn *OpenVPN) getRemotePort() string {
+	ovpn.Lock()
+	defer ovpn.Unlock()
+	return ovpn.remotePort
+}
+
 func (ovpn *OpenVPN) setSubstate(substate vpn.Substate) {
 	ovpn.Lock()
 	defer ovpn.Unlock()
@@ -380,6 +462,7 @@ func stage1Handler(
 					return err
 				}
 				ovpn.setTun(tunnel)
+				log.Println(internal.InfoPrefix, "connected to", event.RemoteAddr(), "on port", ovpn.getRemotePort())
 				// #nosec G104 -- it's okay to ignore an error here
 				internal.FileDelete(openVPNConfigFileName)
 				return nil
@@ -443,9 +526,12 @@ func stage2Handler(
 	}
 }
 
+// remotePortRegex matches the OpenVPN messages which contain the address of the server
+var remotePortRegex = regexp.MustCompile(`(?:link remote:|connection established with) \[AF_INET6?\]\S+:(\d+)`)
+
 // VPNMonitor reads from the reader and logs the output.
-// It may also signal to retry on certain errors.
-func vpnMonitor(reader io.Reader, prefix string, inform chan struct{}) {
+// It may also signal to retry on certain errors and reports the server port.
+func vpnMonitor(reader io.Reader, prefix string, inform chan struct{}, onRemotePort func(string)) {
 	cipherErr := "cipher final failed"
 	tlsErr := "keys are out of sync"
 	scanner := bufio.NewScanner(reader)
@@ -454,6 +540,9 @@ func vpnMonitor(reader io.Reader, prefix string, inform chan struct{}) {
 		if containsSeveral(txt, []string{cipherErr, tlsErr}) {
 			inform <- struct{}{}
 		}
+		if match := remotePortRegex.FindStringSubmatch(txt); match != nil {
+			onRemotePort(match[1])
+		}
 		log.Println(prefix, txt)
 	}
 }
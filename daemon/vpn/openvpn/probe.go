@@ -0,0 +1,28 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+)
+
+// DefaultTCPPort is used by the OpenVPN TCP servers when the port is not
+// configured
+const DefaultTCPPort = 443
+
+// ConnectProber checks whether the OpenVPN server accepts TCP connections. UDP
+// is not probed, the servers drop the packets which are not signed with the
+// tls-auth key of the config template, so a missing reply would tell nothing.
+type ConnectProber struct{}
+
+// Probe returns once the connection is established or the context is done.
+// The connection is closed right away, so no session is made.
+func (ConnectProber) Probe(ctx context.Context, endpoint netip.AddrPort) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", endpoint.String())
+	if err != nil {
+		return fmt.Errorf("connecting to %s: %w", endpoint, err)
+	}
+	return conn.Close()
+}
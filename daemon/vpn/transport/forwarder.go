@@ -0,0 +1,114 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/netip"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// Forwarder relays the local connections to the server through the transport
+type Forwarder struct {
+	listener  net.Listener
+	transport Transport
+	// address of the server
+	address string
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// NewForwarder starts listening on the loopback interface for the connections
+// forwarded to the server address
+func NewForwarder(transport Transport, address string) (*Forwarder, error) {
+	listener, err := net.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("listening: %w", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	f := &Forwarder{
+		listener:  listener,
+		transport: transport,
+		address:   address,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+	f.wg.Add(1)
+	go f.serve()
+	return f, nil
+}
+
+// Addr returns the local address to connect to
+func (f *Forwarder) Addr() netip.AddrPort {
+	return f.listener.Addr().(*net.TCPAddr).AddrPort()
+}
+
+// Close stops accepting the connections and closes the forwarded ones
+func (f *Forwarder) Close() error {
+	f.cancel()
+	err := f.listener.Close()
+	f.wg.Wait()
+	return err
+}
+
+func (f *Forwarder) serve() {
+	defer f.wg.Done()
+	for {
+		local, err := f.listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				log.Println(internal.ErrorPrefix, "transport: accepting connection:", err)
+			}
+			return
+		}
+		f.wg.Add(1)
+		go func() {
+			defer f.wg.Done()
+			f.forward(local)
+		}()
+	}
+}
+
+func (f *Forwarder) forward(local net.Conn) {
+	defer local.Close()
+
+	remote, err := f.transport.Dial(f.ctx, f.address)
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "transport:", err)
+		return
+	}
+	defer remote.Close()
+
+	finished := make(chan struct{})
+	defer close(finished)
+	go func() {
+		select {
+		case <-f.ctx.Done():
+			// closing the connections stops the copying
+			// #nosec G104 -- the connections are closed anyway
+			local.Close()
+			// #nosec G104 -- the connections are closed anyway
+			remote.Close()
+		case <-finished:
+		}
+	}()
+
+	done := make(chan struct{}, 2)
+	relay := func(dst io.WriteCloser, src io.Reader) {
+		// #nosec G104 -- the error means that the connection is closed
+		io.Copy(dst, src)
+		// #nosec G104 -- the other direction is stopped by closing
+		dst.Close()
+		done <- struct{}{}
+	}
+	go relay(remote, local)
+	go relay(local, remote)
+	<-done
+	<-done
+}
@@ -0,0 +1,85 @@
+/*
+Package transport wraps the stream of the OpenVPN connection in another protocol
+to disguise it from the deep packet inspection.
+
+OpenVPN connects to the local Forwarder, which dials the server through the
+selected Transport and relays the traffic in both directions.
+*/
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+
+	"golang.org/x/sys/unix"
+)
+
+// Port is used by the wrapped connections, as it is the HTTPS port which is
+// rarely blocked
+const Port = 443
+
+// dialTimeout bounds establishing the connection to the server including the
+// handshakes of the wrapping protocols
+const dialTimeout = 10 * time.Second
+
+// Transport dials the VPN server wrapping the connection
+type Transport interface {
+	Dial(ctx context.Context, address string) (net.Conn, error)
+}
+
+// New returns the transport of the obfuscation mode. serverName is used to
+// verify the certificate of the server. Connections are marked with fwmark, so
+// that they bypass the tunnel.
+func New(mode config.ObfuscationMode, serverName string, fwmark uint32) (Transport, error) {
+	tlsConfig := &tls.Config{
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+	switch mode {
+	case config.ObfuscationModeTLS:
+		return &TLS{Dialer: markedDialer(fwmark), Config: tlsConfig}, nil
+	case config.ObfuscationModeWebSocket:
+		return &WebSocket{Dialer: markedDialer(fwmark), Config: tlsConfig}, nil
+	case config.ObfuscationModeXOR:
+		fallthrough
+	default:
+		return nil, fmt.Errorf("obfuscation mode %q is not a transport", mode)
+	}
+}
+
+// TLS wraps the connection in TLS
+type TLS struct {
+	Dialer *net.Dialer
+	Config *tls.Config
+}
+
+func (t *TLS) Dial(ctx context.Context, address string) (net.Conn, error) {
+	dialer := &tls.Dialer{NetDialer: t.Dialer, Config: t.Config}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("dialing TLS: %w", err)
+	}
+	return conn, nil
+}
+
+// markedDialer returns the dialer which sets the firewall mark on the sockets
+func markedDialer(fwmark uint32) *net.Dialer {
+	return &net.Dialer{
+		Control: func(network, address string, conn syscall.RawConn) error {
+			var operr error
+			if err := conn.Control(func(fd uintptr) {
+				operr = syscall.SetsockoptInt(int(fd), unix.SOL_SOCKET, unix.SO_MARK, int(fwmark))
+			}); err != nil {
+				return err
+			}
+			return operr
+		},
+		Timeout: dialTimeout,
+	}
+}
@@ -0,0 +1,136 @@
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// plain dials the server without wrapping
+type plain struct{}
+
+func (plain) Dial(ctx context.Context, address string) (net.Conn, error) {
+	return (&net.Dialer{}).DialContext(ctx, "tcp", address)
+}
+
+func echoServer(t *testing.T) string {
+	t.Helper()
+	listener, err := net.Listen("tcp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func assertEcho(t *testing.T, conn net.Conn) {
+	t.Helper()
+	message := []byte("openvpn packet")
+	_, err := conn.Write(message)
+	require.NoError(t, err)
+	reply := make([]byte, len(message))
+	_, err = io.ReadFull(conn, reply)
+	require.NoError(t, err)
+	assert.Equal(t, message, reply)
+}
+
+func TestForwarder(t *testing.T) {
+	category.Set(t, category.Integration)
+
+	forwarder, err := NewForwarder(plain{}, echoServer(t))
+	require.NoError(t, err)
+	assert.True(t, forwarder.Addr().Addr().IsLoopback())
+
+	conn, err := net.Dial("tcp", forwarder.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	assertEcho(t, conn)
+
+	// closing the forwarder closes the forwarded connections
+	assert.NoError(t, forwarder.Close())
+	_, err = conn.Read(make([]byte, 1))
+	assert.Error(t, err)
+}
+
+func TestWebSocket(t *testing.T) {
+	category.Set(t, category.Integration)
+
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			messageType, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if err := conn.WriteMessage(messageType, message); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+	transport := &WebSocket{
+		Dialer: &net.Dialer{},
+		// certificate of the test server is issued for example.com
+		Config: &tls.Config{ServerName: "example.com", RootCAs: roots, MinVersion: tls.VersionTLS12},
+	}
+
+	conn, err := transport.Dial(context.Background(), server.Listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	assertEcho(t, conn)
+}
+
+func TestNew(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		mode     config.ObfuscationMode
+		expected Transport
+	}{
+		{mode: config.ObfuscationModeTLS, expected: &TLS{}},
+		{mode: config.ObfuscationModeWebSocket, expected: &WebSocket{}},
+		{mode: config.ObfuscationModeXOR},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.mode), func(t *testing.T) {
+			transport, err := New(test.mode, "lt1.nordvpn.com", 0xe1f1)
+			if test.expected == nil {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.IsType(t, test.expected, transport)
+		})
+	}
+}
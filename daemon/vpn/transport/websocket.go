@@ -0,0 +1,104 @@
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// webSocketPath is requested when upgrading the connection
+const webSocketPath = "/"
+
+// WebSocket wraps the connection in WebSocket over TLS, so it looks like a
+// regular web application traffic
+type WebSocket struct {
+	Dialer *net.Dialer
+	Config *tls.Config
+}
+
+func (w *WebSocket) Dial(ctx context.Context, address string) (net.Conn, error) {
+	host := w.Config.ServerName
+	if host == "" {
+		host = address
+	}
+	dialer := &websocket.Dialer{
+		// the server is dialed by the address, the name is used in the request
+		// and in the certificate verification
+		NetDialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return w.Dialer.DialContext(ctx, network, address)
+		},
+		TLSClientConfig:  w.Config,
+		HandshakeTimeout: dialTimeout,
+	}
+	u := url.URL{Scheme: "wss", Host: host, Path: webSocketPath}
+	conn, resp, err := dialer.DialContext(ctx, u.String(), nil)
+	if resp != nil && resp.Body != nil {
+		// #nosec G104 -- the body is not used
+		resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dialing WebSocket: %w", err)
+	}
+	return &webSocketConn{conn: conn}, nil
+}
+
+// webSocketConn is a stream over the binary messages of the WebSocket connection
+type webSocketConn struct {
+	conn   *websocket.Conn
+	reader io.Reader
+	// mu guards writes, as WebSocket allows only a single concurrent writer
+	mu sync.Mutex
+}
+
+func (c *webSocketConn) Read(b []byte) (int, error) {
+	for {
+		if c.reader == nil {
+			messageType, reader, err := c.conn.NextReader()
+			if err != nil {
+				return 0, err
+			}
+			if messageType != websocket.BinaryMessage {
+				continue
+			}
+			c.reader = reader
+		}
+		n, err := c.reader.Read(b)
+		if err == io.EOF {
+			c.reader = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+func (c *webSocketConn) Write(b []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.conn.WriteMessage(websocket.BinaryMessage, b); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (c *webSocketConn) Close() error                       { return c.conn.Close() }
+func (c *webSocketConn) LocalAddr() net.Addr                { return c.conn.LocalAddr() }
+func (c *webSocketConn) RemoteAddr() net.Addr               { return c.conn.RemoteAddr() }
+func (c *webSocketConn) SetReadDeadline(t time.Time) error  { return c.conn.SetReadDeadline(t) }
+func (c *webSocketConn) SetWriteDeadline(t time.Time) error { return c.conn.SetWriteDeadline(t) }
+
+func (c *webSocketConn) SetDeadline(t time.Time) error {
+	if err := c.conn.SetReadDeadline(t); err != nil {
+		return err
+	}
+	return c.conn.SetWriteDeadline(t)
+}
@@ -3,6 +3,7 @@ package vpn
 
 import (
 	"net/netip"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/tunnel"
@@ -18,6 +19,28 @@ type VPN interface {
 	NetworkChanged() error
 }
 
+// HandshakeReporter is implemented by the VPNs which can tell when the latest
+// handshake with the server happened
+type HandshakeReporter interface {
+	// LatestHandshake returns zero time if there was no handshake yet
+	LatestHandshake() (time.Time, error)
+}
+
+// Data planes of the VPNs
+const (
+	// DataPlaneKernel processes the tunnel traffic in the kernel
+	DataPlaneKernel = "kernel"
+	// DataPlaneUserspace processes the tunnel traffic in the daemon process
+	DataPlaneUserspace = "userspace"
+)
+
+// DataPlaneReporter is implemented by the VPNs which can tell where the tunnel
+// traffic is processed
+type DataPlaneReporter interface {
+	// DataPlane returns empty string if not connected
+	DataPlane() string
+}
+
 // Credentials define a possible set of credentials required to
 // connect to the VPN server
 type Credentials struct {
@@ -41,5 +64,23 @@ type ServerData struct {
 	Protocol          config.Protocol
 	NordLynxPublicKey string
 	Obfuscated        bool
-	OpenVPNVersion    string
+	// ObfuscationPorts are advertised by the obfuscated server. Empty if unknown.
+	ObfuscationPorts []uint16
+	// ObfuscationMode selects how the obfuscated OpenVPN connection is disguised
+	ObfuscationMode config.ObfuscationMode
+	// Port pinned by the user, zero means the default port of the technology
+	Port           uint16
+	OpenVPNVersion string
+	// Via is the entry server of the multi-hop connection, the tunnel to this
+	// server is nested inside the tunnel to the entry server. Nil if the
+	// connection is direct.
+	Via *ServerData
+	// Technology of the connection
+	Technology config.Technology
+	// InterfaceName of the tunnel, empty means the default name of the
+	// implementation
+	InterfaceName string
+	// Uplink is the network interface the tunnel packets leave through,
+	// empty means that they follow the default route
+	Uplink string
 }
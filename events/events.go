@@ -84,6 +84,96 @@ type DataDisconnect struct {
 	ThreatProtectionLite  bool
 }
 
+type TypeExitNodeRecovery int
+
+const (
+	// ExitNodeDropped is published when a meshnet exit node stops responding
+	ExitNodeDropped TypeExitNodeRecovery = iota
+	// ExitNodeRecovering is published when a recovery attempt is started
+	ExitNodeRecovering
+	// ExitNodeRecovered is published when the traffic is routed again
+	ExitNodeRecovered
+	// ExitNodeRecoveryFailure is published when a recovery attempt fails
+	ExitNodeRecoveryFailure
+)
+
+// DataExitNodeRecovery describes a transition of the meshnet exit node recovery
+type DataExitNodeRecovery struct {
+	Type TypeExitNodeRecovery
+	// Peer is the hostname of the exit node which dropped
+	Peer string
+	// Target is the peer hostname or the server tag used for recovery
+	Target string
+	Error  error
+}
+
+type TypeDNSOverwrite int
+
+const (
+	// DNSRestored is published when the VPN DNS overwritten by another program
+	// is applied again
+	DNSRestored TypeDNSOverwrite = iota
+	// DNSRestoreFailure is published when the VPN DNS cannot be applied again or
+	// keeps being overwritten, so DNS queries may leak
+	DNSRestoreFailure
+)
+
+// DataDNSOverwrite describes the handling of the VPN DNS overwritten mid-session
+type DataDNSOverwrite struct {
+	Type TypeDNSOverwrite
+	// Nameservers are the VPN nameservers
+	Nameservers []string
+	Error       error
+}
+
+type TypeObfuscationFallback int
+
+const (
+	// ObfuscationFallbackHandshake is published when plain OpenVPN failed to
+	// connect repeatedly
+	ObfuscationFallbackHandshake TypeObfuscationFallback = iota
+	// ObfuscationFallbackThroughput is published when the traffic of the plain
+	// OpenVPN connection stopped coming back
+	ObfuscationFallbackThroughput
+)
+
+// DataObfuscationFallback describes the switch to an obfuscated server after
+// the network interfered with plain OpenVPN, e.g. by deep packet inspection
+type DataObfuscationFallback struct {
+	Type TypeObfuscationFallback
+	// Hostname of the plain OpenVPN server
+	Hostname string
+	// Network is the name of the network connection, empty if not known
+	Network string
+}
+
+type TypeServerFailover int
+
+const (
+	// ServerFailoverHandshake is published when the server stopped completing
+	// the handshakes
+	ServerFailoverHandshake TypeServerFailover = iota
+	// ServerFailoverOffline is published when the API reports the server as
+	// offline
+	ServerFailoverOffline
+	// ServerFailoverMaintenance is published when the server was taken down
+	// for maintenance
+	ServerFailoverMaintenance
+	// ServerFailoverWithdrawn is published when the server was removed from the
+	// server list
+	ServerFailoverWithdrawn
+)
+
+// DataServerFailover describes the reconnect to an equivalent server after the
+// connected one degraded or was withdrawn
+type DataServerFailover struct {
+	Type TypeServerFailover
+	// Hostname of the server the connection is moved from
+	Hostname string
+	// Target is the hostname of the server the connection is moved to
+	Target string
+}
+
 type DataRequestAPI struct {
 	// Note: Never use `Request.Body`, use `Request.GetBody` instead
 	Request *http.Request
@@ -66,6 +66,21 @@ func (Subscriber) NotifyRequestAPIVerbose(data events.DataRequestAPI) error {
 	return nil
 }
 
+// NotifyExitNodeRecovery logs meshnet exit node recovery transitions
+func (Subscriber) NotifyExitNodeRecovery(data events.DataExitNodeRecovery) error {
+	switch data.Type {
+	case events.ExitNodeDropped:
+		log.Println(internal.WarningPrefix, "meshnet exit node", data.Peer, "is unreachable")
+	case events.ExitNodeRecovering:
+		log.Println(internal.InfoPrefix, "recovering meshnet exit node", data.Peer, "using", data.Target)
+	case events.ExitNodeRecovered:
+		log.Println(internal.InfoPrefix, "meshnet exit node", data.Peer, "recovered using", data.Target)
+	case events.ExitNodeRecoveryFailure:
+		log.Println(internal.ErrorPrefix, "recovering meshnet exit node", data.Peer, "failed:", data.Error)
+	}
+	return nil
+}
+
 func dataRequestAPIToString(
 	data events.DataRequestAPI,
 	reqBody []byte,
@@ -224,6 +224,8 @@ func (s *Subscriber) NotifyIpv6(data bool) error {
 
 func (s *Subscriber) NotifyLogin(any) error { return nil }
 
+func (s *Subscriber) NotifyLogout(any) error { return nil }
+
 func (s *Subscriber) NotifyRate(data events.ServerRating) error {
 	return s.response(moose.Send_userInterface_uiItems_click(
 		"server_speed_rating",
@@ -251,6 +253,8 @@ func (s *Subscriber) NotifyPeerUpdate([]string) error { return nil }
 
 func (s *Subscriber) NotifySelfRemoved(any) error { return nil }
 
+func (s *Subscriber) NotifyExitNodeRecovery(events.DataExitNodeRecovery) error { return nil }
+
 func (s *Subscriber) NotifyThreatProtectionLite(data bool) error {
 	return s.response(moose.Set_context_application_config_userPreferences_threatProtectionLiteEnabled_value(data))
 }
@@ -0,0 +1,61 @@
+package fileshare
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// AcceptDirsPath returns the file storing the directories which transfers can be
+// accepted into besides the default download directory. nordfileshared reads
+// it before entering the sandbox, as nothing else is writable inside of it.
+func AcceptDirsPath(homeDir string) string {
+	return path.Join(homeDir, internal.ConfigDirectory, internal.UserDataPath, internal.FileshareAcceptDirsFile)
+}
+
+// LoadAcceptDirectories returns the directories stored in the file, none if the
+// file does not exist
+func LoadAcceptDirectories(storagePath string) ([]string, error) {
+	data, err := os.ReadFile(storagePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading accept directories: %w", err)
+	}
+	var dirs []string
+	if err := json.Unmarshal(data, &dirs); err != nil {
+		return nil, fmt.Errorf("parsing accept directories: %w", err)
+	}
+	// only absolute paths can be allowed in the sandbox
+	var valid []string
+	for _, dir := range dirs {
+		if filepath.IsAbs(dir) {
+			valid = append(valid, filepath.Clean(dir))
+		}
+	}
+	return valid, nil
+}
+
+// SaveAcceptDirectories replaces the directories stored in the file
+func SaveAcceptDirectories(storagePath string, dirs []string) error {
+	if dirs == nil {
+		dirs = []string{}
+	}
+	data, err := json.Marshal(dirs)
+	if err != nil {
+		return fmt.Errorf("marshaling accept directories: %w", err)
+	}
+	if err := internal.EnsureDir(storagePath); err != nil {
+		return fmt.Errorf("ensuring dir for accept directories: %w", err)
+	}
+	if err := internal.FileWrite(storagePath, data, internal.PermUserRW); err != nil {
+		return fmt.Errorf("writing accept directories: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package fileshare
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadAcceptDirectories(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	storagePath := filepath.Join(t.TempDir(), "nordvpn", "accept_dirs.json")
+
+	// nothing is permitted before saving
+	dirs, err := LoadAcceptDirectories(storagePath)
+	assert.NoError(t, err)
+	assert.Empty(t, dirs)
+
+	require.NoError(t, SaveAcceptDirectories(storagePath, []string{"/home/user/Music", "/mnt/share/"}))
+	dirs, err = LoadAcceptDirectories(storagePath)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/home/user/Music", "/mnt/share"}, dirs)
+
+	require.NoError(t, SaveAcceptDirectories(storagePath, nil))
+	dirs, err = LoadAcceptDirectories(storagePath)
+	assert.NoError(t, err)
+	assert.Empty(t, dirs)
+
+	// relative paths cannot be allowed in the sandbox
+	require.NoError(t, os.WriteFile(storagePath, []byte(`["Downloads","/srv/files"]`), 0600))
+	dirs, err = LoadAcceptDirectories(storagePath)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/srv/files"}, dirs)
+
+	require.NoError(t, os.WriteFile(storagePath, []byte(`{`), 0600))
+	_, err = LoadAcceptDirectories(storagePath)
+	assert.Error(t, err)
+}
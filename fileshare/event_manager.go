@@ -8,8 +8,10 @@ import (
 	"log"
 	"os"
 	"os/user"
+	"path/filepath"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"syscall"
 
@@ -29,10 +31,13 @@ var (
 	ErrAcceptDirIsASymlink            = errors.New("accept directory is a symlink")
 	ErrAcceptDirIsNotADirectory       = errors.New("accept directory is not a directory")
 	ErrNoPermissionsToAcceptDirectory = errors.New("no permissions to accept directory")
+	ErrAcceptDirNotPermitted          = errors.New("accept directory is not permitted")
 	ErrNotificationsAlreadyEnabled    = errors.New("notifications already enabled")
 	ErrNotificationsAlreadyDisabled   = errors.New("notifications already disabled")
 	ErrTransferCanceledByPeer         = errors.New("transfer has been canceled by peer")
 	ErrTransferCanceledByUs           = errors.New("transfer has been canceled by us")
+	ErrTransferResumeOutgoing         = errors.New("can't resume outgoing transfer")
+	ErrNothingToResume                = errors.New("transfer has no interrupted files")
 )
 
 // EventManager is responsible for libdrop event handling.
@@ -47,8 +52,9 @@ type EventManager struct {
 	// Must delete transfers when they are finished.
 	liveTransfers map[string]*LiveTransfer
 	// stores transfer status notification channels added by Subscribe,
-	// removed by Unsubscribe when TransferFinished event is received
-	transferSubscriptions map[string]chan TransferProgressInfo
+	// removed when TransferFinished event is received. A transfer can be
+	// tracked by multiple subscribers at once.
+	transferSubscriptions map[string][]chan TransferProgressInfo
 	storage               Storage
 	meshClient            meshpb.MeshnetClient
 	fileshare             Fileshare
@@ -56,6 +62,14 @@ type EventManager struct {
 	filesystem            Filesystem
 	notificationManager   *NotificationManager
 	defaultDownloadDir    string
+	// when limited, transfers are accepted only into acceptDirs and their
+	// subdirectories, no directory is allowed if the list is empty
+	acceptDirsLimited bool
+	acceptDirs        []string
+	// limits the amount of concurrently downloaded transfers
+	queue *transferQueue
+	// key is transfer ID
+	rateLimits map[string]transferRateLimit
 }
 
 // NewEventManager loads transfer state from storage, or creates empty state if loading fails.
@@ -68,7 +82,9 @@ func NewEventManager(
 	return &EventManager{
 		isProd:                isProd,
 		liveTransfers:         map[string]*LiveTransfer{},
-		transferSubscriptions: map[string]chan TransferProgressInfo{},
+		transferSubscriptions: map[string][]chan TransferProgressInfo{},
+		queue:                 newTransferQueue(0),
+		rateLimits:            map[string]transferRateLimit{},
 		meshClient:            meshClient,
 		osInfo:                osInfo,
 		filesystem:            filesystem,
@@ -125,6 +141,17 @@ func (em *EventManager) DisableNotifications() error {
 	return nil
 }
 
+// notify sends the notification if the notifications are enabled
+func (em *EventManager) notify(summary string, body string) {
+	em.mutex.Lock()
+	notificationManager := em.notificationManager
+	em.mutex.Unlock()
+
+	if notificationManager != nil {
+		notificationManager.sendGenericNotification(summary, body)
+	}
+}
+
 // EventFunc processes events and handles live transfer state.
 // It should be passed directly to libdrop to be called on events.
 func (em *EventManager) EventFunc(eventJSON string) {
@@ -198,12 +225,18 @@ func (em *EventManager) handleRequestReceivedEvent(eventJson json.RawMessage) {
 		return
 	}
 
-	for _, file := range transfer.Files {
-		err = em.fileshare.Accept(event.TransferID, em.defaultDownloadDir, file.Id)
-		if err != nil {
-			log.Println("failed to autoaccept file: ", err)
+	em.queue.schedule(event.TransferID, func() bool {
+		started := false
+		for _, file := range transfer.Files {
+			err := em.fileshare.Accept(event.TransferID, em.defaultDownloadDir, file.Id)
+			if err != nil {
+				log.Println("failed to autoaccept file: ", err)
+			} else {
+				started = true
+			}
 		}
-	}
+		return started
+	})
 
 	if em.notificationManager != nil {
 		em.notificationManager.NotifyNewAutoacceptTransfer(event.TransferID, peer.Hostname)
@@ -234,15 +267,17 @@ func (em *EventManager) handleTransferProgressEvent(eventJSON json.RawMessage) {
 	transfer.TotalTransferred += event.Transferred - file.Transferred // add only delta
 	file.Transferred = event.Transferred
 
-	if progressCh, ok := em.transferSubscriptions[transfer.ID]; ok {
+	if progressChs, ok := em.transferSubscriptions[transfer.ID]; ok {
 		var progressPercent uint32
 		if transfer.TotalSize > 0 { // transfer progress percentage should be reported to subscriber
 			progressPercent = uint32(float64(transfer.TotalTransferred) / float64(transfer.TotalSize) * 100)
 		}
-		progressCh <- TransferProgressInfo{
-			TransferID:  event.TransferID,
-			Transferred: progressPercent,
-			Status:      pb.Status_ONGOING,
+		for _, progressCh := range progressChs {
+			progressCh <- TransferProgressInfo{
+				TransferID:  event.TransferID,
+				Transferred: progressPercent,
+				Status:      pb.Status_ONGOING,
+			}
 		}
 	}
 }
@@ -299,6 +334,9 @@ func (em *EventManager) handleTransferFinishedEvent(eventJSON json.RawMessage) {
 		} else {
 			fileStatusInNotification = event.Data.Status
 			removeFileFromLiveTransfer(transfer, file)
+			if slices.Contains(resumableFileStatuses, event.Data.Status) {
+				transfer.Interrupted = true
+			}
 		}
 		if em.notificationManager != nil && file != nil {
 			displayPath := file.FullPath
@@ -316,8 +354,11 @@ func (em *EventManager) handleTransferFinishedEvent(eventJSON json.RawMessage) {
 		// cancel it manually after all of the files have finished downloading/uploading. This will generate
 		// a TransferCanceled event, which is processed in transferCanceled case and will trigger the
 		// finalization of transfer.
+		// Interrupted transfers are kept in libdrop, so that they can be resumed later.
 		if isLiveTransferFinished(transfer) && transfer.Direction == pb.Direction_INCOMING {
-			if err := em.fileshare.Cancel(event.TransferID); err != nil {
+			if transfer.Interrupted {
+				em.finalizeTransfer(transfer, pb.Status_INTERRUPTED)
+			} else if err := em.fileshare.Cancel(event.TransferID); err != nil {
 				log.Printf("failed to finalize transfer %s: %s", event.TransferID, err)
 			}
 		}
@@ -327,17 +368,50 @@ func (em *EventManager) handleTransferFinishedEvent(eventJSON json.RawMessage) {
 }
 
 func (em *EventManager) finalizeTransfer(transfer *LiveTransfer, status pb.Status) {
-	if progressCh, ok := em.transferSubscriptions[transfer.ID]; ok {
-		progressCh <- TransferProgressInfo{
-			TransferID: transfer.ID,
-			Status:     status,
+	if progressChs, ok := em.transferSubscriptions[transfer.ID]; ok {
+		for _, progressCh := range progressChs {
+			progressCh <- TransferProgressInfo{
+				TransferID: transfer.ID,
+				Status:     status,
+			}
+			// unsubscribe finished transfer
+			close(progressCh)
 		}
-		// unsubscribe finished transfer
-		close(progressCh)
 		delete(em.transferSubscriptions, transfer.ID)
 	}
 
 	delete(em.liveTransfers, transfer.ID)
+	em.queue.done(transfer.ID)
+	em.resetTransferRateLimit(transfer.ID)
+}
+
+// SetConcurrency limits the amount of concurrently downloaded transfers, 0 means unlimited.
+// Accepted transfers above the limit are queued.
+func (em *EventManager) SetConcurrency(limit int) {
+	em.queue.setLimit(limit)
+}
+
+// SetAcceptDirectories limits the directories transfers can be accepted into,
+// e.g. when the rest of the filesystem is not writable in the sandbox. Empty or
+// nil list denies accepting into any directory.
+func (em *EventManager) SetAcceptDirectories(dirs []string) {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+	em.acceptDirsLimited = true
+	em.acceptDirs = dirs
+}
+
+func (em *EventManager) isAcceptDirAllowed(path string) bool {
+	if !em.acceptDirsLimited {
+		return true
+	}
+	for _, dir := range em.acceptDirs {
+		rel, err := filepath.Rel(dir, path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, "../") {
+			return true
+		}
+	}
+	return false
 }
 
 // GetTransfers is used for listing transfers.
@@ -354,7 +428,7 @@ func (em *EventManager) GetTransfers() ([]*pb.Transfer, error) {
 	transfers := make([]*pb.Transfer, 0, len(storageTransfers))
 	for _, storageTransfer := range storageTransfers {
 		updatedTransfer := updateTransferWithLiveData(storageTransfer, em.liveTransfers)
-		transfers = append(transfers, updatedTransfer)
+		transfers = append(transfers, em.updateTransferWithQueueData(updatedTransfer))
 	}
 
 	sort.Slice(transfers, func(i int, j int) bool {
@@ -389,7 +463,16 @@ func (em *EventManager) getTransfer(transferID string) (*pb.Transfer, error) {
 		return nil, err
 	}
 	transfer = updateTransferWithLiveData(transfer, em.liveTransfers)
-	return transfer, nil
+	return em.updateTransferWithQueueData(transfer), nil
+}
+
+// updateTransferWithQueueData marks accepted transfers waiting for a free slot as queued,
+// as libdrop is not aware of them being accepted yet
+func (em *EventManager) updateTransferWithQueueData(transfer *pb.Transfer) *pb.Transfer {
+	if em.queue.isQueued(transfer.Id) {
+		transfer.Status = pb.Status_QUEUED
+	}
+	return transfer
 }
 
 func getTransferFromStorage(id string, storage Storage) (*pb.Transfer, error) {
@@ -468,6 +551,10 @@ func (em *EventManager) acceptTransfer(
 		return nil, ErrNoPermissionsToAcceptDirectory
 	}
 
+	if !em.isAcceptDirAllowed(path) {
+		return nil, ErrAcceptDirNotPermitted
+	}
+
 	transfer, err := em.getTransfer(transferID)
 	if err != nil {
 		return nil, err
@@ -516,6 +603,58 @@ func (em *EventManager) acceptTransfer(
 	return transfer, nil
 }
 
+// ResumeTransfer validates the transfer to ensure it can be resumed and returns its
+// interrupted files. Returned files are tracked as live again.
+func (em *EventManager) ResumeTransfer(transferID string) (*pb.Transfer, []*pb.File, error) {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+
+	transfer, err := em.getTransfer(transferID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if transfer.Direction != pb.Direction_INCOMING {
+		return nil, nil, ErrTransferResumeOutgoing
+	}
+	if transfer.Status == pb.Status_CANCELED_BY_PEER {
+		return nil, nil, ErrTransferCanceledByPeer
+	}
+	if transfer.Status == pb.Status_CANCELED {
+		return nil, nil, ErrTransferCanceledByUs
+	}
+
+	var files []*pb.File
+	for _, file := range transfer.Files {
+		if IsFileResumable(file) {
+			files = append(files, file)
+		}
+	}
+	if len(files) == 0 {
+		return nil, nil, ErrNothingToResume
+	}
+
+	liveTransfer, err := em.getLiveTransfer(transferID)
+	if err != nil {
+		return nil, nil, err
+	}
+	liveTransfer.Interrupted = false
+	for _, file := range files {
+		if _, ok := liveTransfer.Files[file.Id]; ok {
+			continue
+		}
+		liveTransfer.Files[file.Id] = &LiveFile{
+			ID:          file.Id,
+			FullPath:    file.FullPath,
+			Size:        file.Size,
+			Transferred: file.Transferred,
+		}
+		liveTransfer.TotalSize += file.Size
+		liveTransfer.TotalTransferred += file.Transferred
+	}
+
+	return transfer, files, nil
+}
+
 func isFileWriteable(fileInfo fs.FileInfo, user *user.User, gids []string) bool {
 	var ownerUID int
 	var ownerGID int
@@ -561,18 +700,22 @@ func (em *EventManager) Subscribe(id string) <-chan TransferProgressInfo {
 	em.mutex.Lock()
 	defer em.mutex.Unlock()
 
-	em.transferSubscriptions[id] = make(chan TransferProgressInfo)
+	progressCh := make(chan TransferProgressInfo)
+	em.transferSubscriptions[id] = append(em.transferSubscriptions[id], progressCh)
 
-	return em.transferSubscriptions[id]
+	return progressCh
 }
 
 // LiveTransfer to track ongoing transfers live in app based on events
 type LiveTransfer struct {
 	ID               string
 	Direction        pb.Direction
+	Peer             string
 	TotalSize        uint64
 	TotalTransferred uint64
 	Files            map[string]*LiveFile // Key is ID
+	// Interrupted is set when any of the files was interrupted by the network
+	Interrupted bool
 }
 
 // LiveFile is part of LiveTransfer
@@ -599,6 +742,7 @@ func (em *EventManager) getLiveTransfer(id string) (*LiveTransfer, error) {
 	transfer = &LiveTransfer{
 		ID:               storageTransfer.Id,
 		Direction:        storageTransfer.Direction,
+		Peer:             storageTransfer.Peer,
 		TotalSize:        storageTransfer.TotalSize,
 		TotalTransferred: storageTransfer.TotalTransferred,
 		Files:            map[string]*LiveFile{},
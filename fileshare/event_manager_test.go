@@ -574,6 +574,96 @@ func TestAcceptTransfer_AlreadyAccepted(t *testing.T) {
 	assert.Equal(t, ErrTransferAlreadyAccepted, err)
 }
 
+func TestResumeTransfer(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		testName      string
+		direction     pb.Direction
+		status        pb.Status
+		fileStatuses  []pb.Status
+		expectedErr   error
+		expectedFiles []string
+	}{
+		{
+			testName:      "resume interrupted files",
+			direction:     pb.Direction_INCOMING,
+			status:        pb.Status_INTERRUPTED,
+			fileStatuses:  []pb.Status{pb.Status_SUCCESS, pb.Status_PAUSED, pb.Status_WS_CLIENT},
+			expectedFiles: []string{"fileB", "fileC"},
+		},
+		{
+			testName:     "outgoing transfer",
+			direction:    pb.Direction_OUTGOING,
+			status:       pb.Status_INTERRUPTED,
+			fileStatuses: []pb.Status{pb.Status_PAUSED, pb.Status_PAUSED, pb.Status_PAUSED},
+			expectedErr:  ErrTransferResumeOutgoing,
+		},
+		{
+			testName:     "canceled by peer",
+			direction:    pb.Direction_INCOMING,
+			status:       pb.Status_CANCELED_BY_PEER,
+			fileStatuses: []pb.Status{pb.Status_PAUSED, pb.Status_PAUSED, pb.Status_PAUSED},
+			expectedErr:  ErrTransferCanceledByPeer,
+		},
+		{
+			testName:     "nothing to resume",
+			direction:    pb.Direction_INCOMING,
+			status:       pb.Status_FINISHED_WITH_ERRORS,
+			fileStatuses: []pb.Status{pb.Status_SUCCESS, pb.Status_CANCELED, pb.Status_FILE_CHECKSUM_MISMATCH},
+			expectedErr:  ErrNothingToResume,
+		},
+	}
+
+	mockSystemEnvironment := newMockSystemEnvironment(t)
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			eventManager := NewEventManager(false,
+				&mockMeshClient{},
+				&mockSystemEnvironment.mockEventManagerOsInfo,
+				&mockSystemEnvironment.mockEventManagerFilesystem,
+				"")
+			storage := &mockStorage{transfers: map[string]*pb.Transfer{}}
+			eventManager.SetStorage(storage)
+			storage.transfers[exampleUUID] = &pb.Transfer{
+				Id:        exampleUUID,
+				Direction: test.direction,
+				Status:    test.status,
+				// interrupted files are excluded from the totals
+				TotalSize:        1,
+				TotalTransferred: 1,
+				Files: []*pb.File{
+					{Id: "fileA", Path: "file_A", FullPath: "/tmp/file_A", Size: 1, Transferred: 1, Status: test.fileStatuses[0]},
+					{Id: "fileB", Path: "file_B", FullPath: "/tmp/file_B", Size: 2, Transferred: 1, Status: test.fileStatuses[1]},
+					{Id: "fileC", Path: "file_C", FullPath: "/tmp/file_C", Size: 3, Status: test.fileStatuses[2]},
+				},
+			}
+
+			_, files, err := eventManager.ResumeTransfer(exampleUUID)
+			assert.Equal(t, test.expectedErr, err)
+			if err != nil {
+				return
+			}
+
+			fileIDs := []string{}
+			for _, file := range files {
+				fileIDs = append(fileIDs, file.Id)
+			}
+			assert.Equal(t, test.expectedFiles, fileIDs)
+
+			liveTransfer := eventManager.liveTransfers[exampleUUID]
+			assert.NotNil(t, liveTransfer)
+			for _, id := range test.expectedFiles {
+				assert.Contains(t, liveTransfer.Files, id)
+				assert.False(t, liveTransfer.Files[id].Finished)
+			}
+			assert.Equal(t, uint64(6), liveTransfer.TotalSize)
+			assert.Equal(t, uint64(2), liveTransfer.TotalTransferred)
+		})
+	}
+}
+
 func TestTransferFinishedNotifications(t *testing.T) {
 	transferID := exampleUUID
 	fileID := "file_id"
@@ -1351,3 +1441,34 @@ func TestAutoaccept(t *testing.T) {
 		})
 	}
 }
+
+func TestAcceptDirectories(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name      string
+		unlimited bool
+		dirs      []string
+		path      string
+		expected  bool
+	}{
+		{name: "not limited", unlimited: true, path: "/etc", expected: true},
+		{name: "accept directory", dirs: []string{"/home/user/Downloads"}, path: "/home/user/Downloads", expected: true},
+		{name: "subdirectory", dirs: []string{"/home/user/Downloads"}, path: "/home/user/Downloads/docs/", expected: true},
+		{name: "outside", dirs: []string{"/home/user/Downloads"}, path: "/home/user", expected: false},
+		{name: "sibling with common prefix", dirs: []string{"/home/user/Downloads"}, path: "/home/user/Downloads2", expected: false},
+		{name: "escaping", dirs: []string{"/home/user/Downloads"}, path: "/home/user/Downloads/../.ssh", expected: false},
+		{name: "no accept directories", dirs: []string{}, path: "/home/user/Downloads", expected: false},
+		{name: "nil accept directories", dirs: nil, path: "/home/user/Downloads", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			eventManager := NewEventManager(false, &mockMeshClient{}, &mockEventManagerOsInfo{}, &mockEventManagerFilesystem{}, "")
+			if !test.unlimited {
+				eventManager.SetAcceptDirectories(test.dirs)
+			}
+			assert.Equal(t, test.expected, eventManager.isAcceptDirAllowed(test.path))
+		})
+	}
+}
@@ -39,3 +39,9 @@ type Storage interface {
 	Load() (map[string]*pb.Transfer, error)
 	PurgeTransfersUntil(until time.Time) error
 }
+
+// TagStorage is used for persistence of the local transfer tags
+type TagStorage interface {
+	// SetTags of the transfer, tags must be validated using ValidateTags
+	SetTags(transferID string, tags []string) error
+}
@@ -31,6 +31,7 @@ const (
 	downloadDirIsASymlinkError          = "The download path can’t be a symbolic link."
 	downloadDirIsNotADirError           = "The download path must be a directory."
 	downloadDirNoPermissions            = "You don’t have write permissions for the download directory."
+	downloadDirNotPermitted             = "Transfers can’t be accepted into the download directory."
 	notEnoughSpaceOnDeviceError         = "There’s not enough storage on your device."
 
 	cancelFailedNotificationSummary = "Failed to decline transfer"
@@ -277,6 +278,8 @@ func acceptErrorToNotificationBody(err error) string {
 		return downloadDirIsNotADirError
 	case errors.Is(err, ErrNoPermissionsToAcceptDirectory):
 		return downloadDirNoPermissions
+	case errors.Is(err, ErrAcceptDirNotPermitted):
+		return downloadDirNotPermitted
 	case errors.Is(err, ErrTransferCanceledByPeer):
 		return transferCanceledByPeerNotificationBody
 	default:
@@ -28,6 +28,8 @@ type FileshareClient interface {
 	Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (Fileshare_SendClient, error)
 	// Accept a request from another peer to send you a file
 	Accept(ctx context.Context, in *AcceptRequest, opts ...grpc.CallOption) (Fileshare_AcceptClient, error)
+	// Resume interrupted download from the already received part of the files
+	Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (Fileshare_ResumeClient, error)
 	// Reject a request from another peer to send you a file
 	Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*Error, error)
 	// List all transfers
@@ -38,6 +40,18 @@ type FileshareClient interface {
 	SetNotifications(ctx context.Context, in *SetNotificationsRequest, opts ...grpc.CallOption) (*SetNotificationsResponse, error)
 	// PurgeTransfersUntil provided time from fileshare implementation storage
 	PurgeTransfersUntil(ctx context.Context, in *PurgeTransfersUntilRequest, opts ...grpc.CallOption) (*Error, error)
+	// SetConcurrency limits the amount of concurrently active transfers
+	SetConcurrency(ctx context.Context, in *SetConcurrencyRequest, opts ...grpc.CallOption) (*Error, error)
+	// SetRateLimit limits the throughput of the file transfers
+	SetRateLimit(ctx context.Context, in *SetRateLimitRequest, opts ...grpc.CallOption) (*Error, error)
+	// Watch a directory and send the files dropped into it to a peer
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*Error, error)
+	// Unwatch stops watching the directory
+	Unwatch(ctx context.Context, in *UnwatchRequest, opts ...grpc.CallOption) (*Error, error)
+	// ListWatches lists the watched directories
+	ListWatches(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListWatchesResponse, error)
+	// StreamProgress sends the per-file progress, throughput and ETA of the active transfers every second
+	StreamProgress(ctx context.Context, in *ProgressRequest, opts ...grpc.CallOption) (Fileshare_StreamProgressClient, error)
 }
 
 type fileshareClient struct {
@@ -121,6 +135,38 @@ func (x *fileshareAcceptClient) Recv() (*StatusResponse, error) {
 	return m, nil
 }
 
+func (c *fileshareClient) Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (Fileshare_ResumeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Fileshare_ServiceDesc.Streams[2], "/filesharepb.Fileshare/Resume", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fileshareResumeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Fileshare_ResumeClient interface {
+	Recv() (*StatusResponse, error)
+	grpc.ClientStream
+}
+
+type fileshareResumeClient struct {
+	grpc.ClientStream
+}
+
+func (x *fileshareResumeClient) Recv() (*StatusResponse, error) {
+	m := new(StatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *fileshareClient) Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*Error, error) {
 	out := new(Error)
 	err := c.cc.Invoke(ctx, "/filesharepb.Fileshare/Cancel", in, out, opts...)
@@ -131,7 +177,7 @@ func (c *fileshareClient) Cancel(ctx context.Context, in *CancelRequest, opts ..
 }
 
 func (c *fileshareClient) List(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Fileshare_ListClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Fileshare_ServiceDesc.Streams[2], "/filesharepb.Fileshare/List", opts...)
+	stream, err := c.cc.NewStream(ctx, &Fileshare_ServiceDesc.Streams[3], "/filesharepb.Fileshare/List", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -189,6 +235,83 @@ func (c *fileshareClient) PurgeTransfersUntil(ctx context.Context, in *PurgeTran
 	return out, nil
 }
 
+func (c *fileshareClient) SetConcurrency(ctx context.Context, in *SetConcurrencyRequest, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/filesharepb.Fileshare/SetConcurrency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileshareClient) SetRateLimit(ctx context.Context, in *SetRateLimitRequest, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/filesharepb.Fileshare/SetRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileshareClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/filesharepb.Fileshare/Watch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileshareClient) Unwatch(ctx context.Context, in *UnwatchRequest, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/filesharepb.Fileshare/Unwatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileshareClient) ListWatches(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListWatchesResponse, error) {
+	out := new(ListWatchesResponse)
+	err := c.cc.Invoke(ctx, "/filesharepb.Fileshare/ListWatches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileshareClient) StreamProgress(ctx context.Context, in *ProgressRequest, opts ...grpc.CallOption) (Fileshare_StreamProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Fileshare_ServiceDesc.Streams[4], "/filesharepb.Fileshare/StreamProgress", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fileshareStreamProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Fileshare_StreamProgressClient interface {
+	Recv() (*ProgressResponse, error)
+	grpc.ClientStream
+}
+
+type fileshareStreamProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *fileshareStreamProgressClient) Recv() (*ProgressResponse, error) {
+	m := new(ProgressResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // FileshareServer is the server API for Fileshare service.
 // All implementations must embed UnimplementedFileshareServer
 // for forward compatibility
@@ -199,6 +322,8 @@ type FileshareServer interface {
 	Send(*SendRequest, Fileshare_SendServer) error
 	// Accept a request from another peer to send you a file
 	Accept(*AcceptRequest, Fileshare_AcceptServer) error
+	// Resume interrupted download from the already received part of the files
+	Resume(*ResumeRequest, Fileshare_ResumeServer) error
 	// Reject a request from another peer to send you a file
 	Cancel(context.Context, *CancelRequest) (*Error, error)
 	// List all transfers
@@ -209,6 +334,18 @@ type FileshareServer interface {
 	SetNotifications(context.Context, *SetNotificationsRequest) (*SetNotificationsResponse, error)
 	// PurgeTransfersUntil provided time from fileshare implementation storage
 	PurgeTransfersUntil(context.Context, *PurgeTransfersUntilRequest) (*Error, error)
+	// SetConcurrency limits the amount of concurrently active transfers
+	SetConcurrency(context.Context, *SetConcurrencyRequest) (*Error, error)
+	// SetRateLimit limits the throughput of the file transfers
+	SetRateLimit(context.Context, *SetRateLimitRequest) (*Error, error)
+	// Watch a directory and send the files dropped into it to a peer
+	Watch(context.Context, *WatchRequest) (*Error, error)
+	// Unwatch stops watching the directory
+	Unwatch(context.Context, *UnwatchRequest) (*Error, error)
+	// ListWatches lists the watched directories
+	ListWatches(context.Context, *Empty) (*ListWatchesResponse, error)
+	// StreamProgress sends the per-file progress, throughput and ETA of the active transfers every second
+	StreamProgress(*ProgressRequest, Fileshare_StreamProgressServer) error
 	mustEmbedUnimplementedFileshareServer()
 }
 
@@ -225,6 +362,9 @@ func (UnimplementedFileshareServer) Send(*SendRequest, Fileshare_SendServer) err
 func (UnimplementedFileshareServer) Accept(*AcceptRequest, Fileshare_AcceptServer) error {
 	return status.Errorf(codes.Unimplemented, "method Accept not implemented")
 }
+func (UnimplementedFileshareServer) Resume(*ResumeRequest, Fileshare_ResumeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
 func (UnimplementedFileshareServer) Cancel(context.Context, *CancelRequest) (*Error, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
 }
@@ -240,6 +380,24 @@ func (UnimplementedFileshareServer) SetNotifications(context.Context, *SetNotifi
 func (UnimplementedFileshareServer) PurgeTransfersUntil(context.Context, *PurgeTransfersUntilRequest) (*Error, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurgeTransfersUntil not implemented")
 }
+func (UnimplementedFileshareServer) SetConcurrency(context.Context, *SetConcurrencyRequest) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConcurrency not implemented")
+}
+func (UnimplementedFileshareServer) SetRateLimit(context.Context, *SetRateLimitRequest) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateLimit not implemented")
+}
+func (UnimplementedFileshareServer) Watch(context.Context, *WatchRequest) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedFileshareServer) Unwatch(context.Context, *UnwatchRequest) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unwatch not implemented")
+}
+func (UnimplementedFileshareServer) ListWatches(context.Context, *Empty) (*ListWatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWatches not implemented")
+}
+func (UnimplementedFileshareServer) StreamProgress(*ProgressRequest, Fileshare_StreamProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamProgress not implemented")
+}
 func (UnimplementedFileshareServer) mustEmbedUnimplementedFileshareServer() {}
 
 // UnsafeFileshareServer may be embedded to opt out of forward compatibility for this service.
@@ -313,6 +471,27 @@ func (x *fileshareAcceptServer) Send(m *StatusResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Fileshare_Resume_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResumeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FileshareServer).Resume(m, &fileshareResumeServer{stream})
+}
+
+type Fileshare_ResumeServer interface {
+	Send(*StatusResponse) error
+	grpc.ServerStream
+}
+
+type fileshareResumeServer struct {
+	grpc.ServerStream
+}
+
+func (x *fileshareResumeServer) Send(m *StatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Fileshare_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelRequest)
 	if err := dec(in); err != nil {
@@ -406,6 +585,117 @@ func _Fileshare_PurgeTransfersUntil_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Fileshare_SetConcurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConcurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileshareServer).SetConcurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filesharepb.Fileshare/SetConcurrency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileshareServer).SetConcurrency(ctx, req.(*SetConcurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fileshare_SetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileshareServer).SetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filesharepb.Fileshare/SetRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileshareServer).SetRateLimit(ctx, req.(*SetRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fileshare_Watch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileshareServer).Watch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filesharepb.Fileshare/Watch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileshareServer).Watch(ctx, req.(*WatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fileshare_Unwatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnwatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileshareServer).Unwatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filesharepb.Fileshare/Unwatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileshareServer).Unwatch(ctx, req.(*UnwatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fileshare_ListWatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileshareServer).ListWatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filesharepb.Fileshare/ListWatches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileshareServer).ListWatches(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fileshare_StreamProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProgressRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FileshareServer).StreamProgress(m, &fileshareStreamProgressServer{stream})
+}
+
+type Fileshare_StreamProgressServer interface {
+	Send(*ProgressResponse) error
+	grpc.ServerStream
+}
+
+type fileshareStreamProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *fileshareStreamProgressServer) Send(m *ProgressResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Fileshare_ServiceDesc is the grpc.ServiceDesc for Fileshare service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -433,6 +723,26 @@ var Fileshare_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PurgeTransfersUntil",
 			Handler:    _Fileshare_PurgeTransfersUntil_Handler,
 		},
+		{
+			MethodName: "SetConcurrency",
+			Handler:    _Fileshare_SetConcurrency_Handler,
+		},
+		{
+			MethodName: "SetRateLimit",
+			Handler:    _Fileshare_SetRateLimit_Handler,
+		},
+		{
+			MethodName: "Watch",
+			Handler:    _Fileshare_Watch_Handler,
+		},
+		{
+			MethodName: "Unwatch",
+			Handler:    _Fileshare_Unwatch_Handler,
+		},
+		{
+			MethodName: "ListWatches",
+			Handler:    _Fileshare_ListWatches_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -445,11 +755,21 @@ var Fileshare_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Fileshare_Accept_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Resume",
+			Handler:       _Fileshare_Resume_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "List",
 			Handler:       _Fileshare_List_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamProgress",
+			Handler:       _Fileshare_StreamProgress_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "service.proto",
 }
@@ -0,0 +1,133 @@
+package fileshare
+
+import (
+	"sort"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+)
+
+const (
+	// ProgressInterval is how often the progress of the transfers is streamed
+	ProgressInterval = time.Second
+	// throughputSmoothing is the weight of the latest sample in the average
+	// throughput, so that the ETA does not jump with every sample
+	throughputSmoothing = 0.3
+)
+
+// LiveProgress returns the progress of the active transfers, or of the given
+// transfer only if the ID is not empty. Transfers become active once the first
+// file starts transferring.
+func (em *EventManager) LiveProgress(transferID string) []*pb.TransferProgress {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+
+	progress := []*pb.TransferProgress{}
+	for id, transfer := range em.liveTransfers {
+		if transferID != "" && id != transferID {
+			continue
+		}
+		transferProgress := &pb.TransferProgress{
+			TransferId:       transfer.ID,
+			Direction:        transfer.Direction,
+			Peer:             transfer.Peer,
+			Status:           pb.Status_ONGOING,
+			TotalSize:        transfer.TotalSize,
+			TotalTransferred: transfer.TotalTransferred,
+		}
+		for _, file := range transfer.Files {
+			transferProgress.Files = append(transferProgress.Files, &pb.FileProgress{
+				Id:          file.ID,
+				Path:        file.FullPath,
+				Size:        file.Size,
+				Transferred: file.Transferred,
+				Finished:    file.Finished,
+			})
+		}
+		sort.Slice(transferProgress.Files, func(i, j int) bool {
+			return transferProgress.Files[i].Path < transferProgress.Files[j].Path
+		})
+		progress = append(progress, transferProgress)
+	}
+	sort.Slice(progress, func(i, j int) bool { return progress[i].TransferId < progress[j].TransferId })
+	return progress
+}
+
+// unfinishedTransferStatuses are the statuses of the transfers which are not
+// active yet or anymore, but will be
+var unfinishedTransferStatuses = []pb.Status{
+	pb.Status_REQUESTED,
+	pb.Status_ONGOING,
+	pb.Status_QUEUED,
+	pb.Status_PENDING,
+	pb.Status_PAUSED,
+}
+
+// transferToProgress returns the progress of the transfer which is not active
+func transferToProgress(transfer *pb.Transfer) *pb.TransferProgress {
+	progress := &pb.TransferProgress{
+		TransferId:       transfer.GetId(),
+		Direction:        transfer.GetDirection(),
+		Peer:             transfer.GetPeer(),
+		Status:           transfer.GetStatus(),
+		TotalSize:        transfer.GetTotalSize(),
+		TotalTransferred: transfer.GetTotalTransferred(),
+	}
+	for _, file := range transfer.GetFiles() {
+		progress.Files = append(progress.Files, &pb.FileProgress{
+			Id:          file.GetId(),
+			Path:        file.GetFullPath(),
+			Size:        file.GetSize(),
+			Transferred: file.GetTransferred(),
+			Finished:    isFileCompleted(file),
+		})
+	}
+	return progress
+}
+
+type throughputSample struct {
+	transferred uint64
+	at          time.Time
+	// rate is the average in bytes per second
+	rate float64
+}
+
+// throughputMeter estimates the throughput and the ETA of the transfers from
+// the consecutive progress samples
+type throughputMeter struct {
+	samples map[string]throughputSample
+}
+
+func newThroughputMeter() *throughputMeter {
+	return &throughputMeter{samples: map[string]throughputSample{}}
+}
+
+// update sets the throughput and the ETA of the transfers and forgets the ones
+// which are no longer active
+func (m *throughputMeter) update(progress []*pb.TransferProgress, now time.Time) {
+	samples := make(map[string]throughputSample, len(progress))
+	for _, transfer := range progress {
+		sample := throughputSample{transferred: transfer.TotalTransferred, at: now}
+		if previous, ok := m.samples[transfer.TransferId]; ok {
+			sample.rate = previous.rate
+			elapsed := now.Sub(previous.at).Seconds()
+			if elapsed > 0 && transfer.TotalTransferred >= previous.transferred {
+				rate := float64(transfer.TotalTransferred-previous.transferred) / elapsed
+				if previous.rate == 0 {
+					sample.rate = rate
+				} else {
+					sample.rate = throughputSmoothing*rate + (1-throughputSmoothing)*previous.rate
+				}
+			}
+		}
+		samples[transfer.TransferId] = sample
+
+		transfer.BytesPerSecond = uint64(sample.rate)
+		if sample.rate > 0 && transfer.TotalSize > transfer.TotalTransferred {
+			remaining := float64(transfer.TotalSize - transfer.TotalTransferred)
+			// rounded up, so that the ETA is not 0 until the transfer is finished
+			transfer.EtaSeconds = uint64(remaining/sample.rate) + 1
+		}
+	}
+	m.samples = samples
+}
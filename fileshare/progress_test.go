@@ -0,0 +1,107 @@
+package fileshare
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLiveProgress(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	eventManager := NewEventManager(false, &mockMeshClient{}, &mockEventManagerOsInfo{}, &mockEventManagerFilesystem{}, "")
+	eventManager.liveTransfers["b"] = &LiveTransfer{
+		ID:               "b",
+		Direction:        pb.Direction_OUTGOING,
+		Peer:             "pubkey",
+		TotalSize:        10,
+		TotalTransferred: 4,
+		Files: map[string]*LiveFile{
+			"2": {ID: "2", FullPath: "/tmp/z", Size: 6, Transferred: 0},
+			"1": {ID: "1", FullPath: "/tmp/a", Size: 4, Transferred: 4, Finished: true},
+		},
+	}
+	eventManager.liveTransfers["a"] = &LiveTransfer{ID: "a", Direction: pb.Direction_INCOMING}
+
+	progress := eventManager.LiveProgress("")
+	require.Len(t, progress, 2)
+	assert.Equal(t, "a", progress[0].TransferId)
+	assert.Equal(t, &pb.TransferProgress{
+		TransferId:       "b",
+		Direction:        pb.Direction_OUTGOING,
+		Peer:             "pubkey",
+		Status:           pb.Status_ONGOING,
+		TotalSize:        10,
+		TotalTransferred: 4,
+		Files: []*pb.FileProgress{
+			{Id: "1", Path: "/tmp/a", Size: 4, Transferred: 4, Finished: true},
+			{Id: "2", Path: "/tmp/z", Size: 6},
+		},
+	}, progress[1])
+
+	progress = eventManager.LiveProgress("b")
+	require.Len(t, progress, 1)
+	assert.Equal(t, "b", progress[0].TransferId)
+
+	assert.Empty(t, eventManager.LiveProgress("c"))
+}
+
+func TestThroughputMeter(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	meter := newThroughputMeter()
+	start := time.Now()
+	sample := func(transferred uint64) *pb.TransferProgress {
+		return &pb.TransferProgress{TransferId: "id", TotalSize: 1000, TotalTransferred: transferred}
+	}
+
+	// throughput is unknown until the second sample
+	progress := sample(100)
+	meter.update([]*pb.TransferProgress{progress}, start)
+	assert.Zero(t, progress.BytesPerSecond)
+	assert.Zero(t, progress.EtaSeconds)
+
+	progress = sample(200)
+	meter.update([]*pb.TransferProgress{progress}, start.Add(time.Second))
+	assert.Equal(t, uint64(100), progress.BytesPerSecond)
+	assert.Equal(t, uint64(9), progress.EtaSeconds)
+
+	// sudden changes are smoothed
+	progress = sample(500)
+	meter.update([]*pb.TransferProgress{progress}, start.Add(2*time.Second))
+	assert.Equal(t, uint64(160), progress.BytesPerSecond)
+	assert.Equal(t, uint64(4), progress.EtaSeconds)
+
+	// finished transfer has no ETA
+	progress = sample(1000)
+	meter.update([]*pb.TransferProgress{progress}, start.Add(3*time.Second))
+	assert.Zero(t, progress.EtaSeconds)
+
+	// inactive transfers are forgotten
+	meter.update(nil, start.Add(4*time.Second))
+	assert.Empty(t, meter.samples)
+}
+
+func TestFinalizeTransfer_MultipleSubscribers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	eventManager := NewEventManager(false, &mockMeshClient{}, &mockEventManagerOsInfo{}, &mockEventManagerFilesystem{}, "")
+	eventManager.liveTransfers["id"] = &LiveTransfer{ID: "id"}
+	first := eventManager.Subscribe("id")
+	second := eventManager.Subscribe("id")
+
+	go eventManager.finalizeTransfer(eventManager.liveTransfers["id"], pb.Status_SUCCESS)
+
+	for _, ch := range []<-chan TransferProgressInfo{first, second} {
+		var statuses []pb.Status
+		for ev := range ch {
+			statuses = append(statuses, ev.Status)
+		}
+		assert.Equal(t, []pb.Status{pb.Status_SUCCESS}, statuses)
+	}
+}
@@ -0,0 +1,119 @@
+package fileshare
+
+import (
+	"sync"
+
+	"golang.org/x/exp/slices"
+)
+
+// transferStartFunc starts the transfer and reports whether it was started
+type transferStartFunc func() bool
+
+type queuedTransfer struct {
+	id    string
+	start transferStartFunc
+}
+
+// transferQueue limits the amount of concurrently active transfers. Transfers above
+// the limit wait in the queue and are started in the order they were scheduled once
+// active transfers finish, so every queued transfer is eventually started.
+// Thread safe.
+type transferQueue struct {
+	mu sync.Mutex
+	// limit of active transfers, 0 means unlimited
+	limit  int
+	active map[string]bool
+	queued []queuedTransfer
+}
+
+func newTransferQueue(limit int) *transferQueue {
+	return &transferQueue{
+		limit:  limit,
+		active: map[string]bool{},
+	}
+}
+
+// schedule starts the transfer right away if there is a free slot, otherwise queues
+// it. Returns true if the transfer was queued.
+func (q *transferQueue) schedule(transferID string, start transferStartFunc) bool {
+	q.mu.Lock()
+	if q.hasFreeSlot() && len(q.queued) == 0 {
+		q.active[transferID] = true
+		q.mu.Unlock()
+		if !start() {
+			q.done(transferID)
+		}
+		return false
+	}
+	q.queued = append(q.queued, queuedTransfer{id: transferID, start: start})
+	q.mu.Unlock()
+	return true
+}
+
+// done frees the slot of the finished transfer or removes it from the queue if it
+// was never started
+func (q *transferQueue) done(transferID string) {
+	q.mu.Lock()
+	delete(q.active, transferID)
+	if index := q.indexOf(transferID); index != -1 {
+		q.queued = slices.Delete(q.queued, index, index+1)
+	}
+	next := q.dequeue()
+	q.mu.Unlock()
+	q.start(next)
+}
+
+// setLimit changes the limit, queued transfers are started if the limit was raised.
+// Active transfers are never stopped when the limit is lowered.
+func (q *transferQueue) setLimit(limit int) {
+	q.mu.Lock()
+	q.limit = limit
+	next := q.dequeue()
+	q.mu.Unlock()
+	q.start(next)
+}
+
+func (q *transferQueue) getLimit() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.limit
+}
+
+func (q *transferQueue) isQueued(transferID string) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.indexOf(transferID) != -1
+}
+
+// indexOf the transfer in the queue. Must be called with the mutex held.
+func (q *transferQueue) indexOf(transferID string) int {
+	return slices.IndexFunc(q.queued, func(t queuedTransfer) bool {
+		return t.id == transferID
+	})
+}
+
+// dequeue takes as many transfers from the head of the queue as there are free slots.
+// Must be called with the mutex held.
+func (q *transferQueue) dequeue() []queuedTransfer {
+	var next []queuedTransfer
+	for len(q.queued) > 0 && q.hasFreeSlot() {
+		transfer := q.queued[0]
+		q.queued = q.queued[1:]
+		q.active[transfer.id] = true
+		next = append(next, transfer)
+	}
+	return next
+}
+
+// start is called without the mutex held, because starting a transfer can take a while
+func (q *transferQueue) start(transfers []queuedTransfer) {
+	for _, transfer := range transfers {
+		if !transfer.start() {
+			q.done(transfer.id)
+		}
+	}
+}
+
+func (q *transferQueue) hasFreeSlot() bool {
+	return q.limit == 0 || len(q.active) < q.limit
+}
@@ -0,0 +1,127 @@
+package fileshare
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransferQueue_StartsInOrder(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	queue := newTransferQueue(1)
+	var started []string
+	start := func(id string) transferStartFunc {
+		return func() bool {
+			started = append(started, id)
+			return true
+		}
+	}
+
+	assert.False(t, queue.schedule("a", start("a")))
+	assert.True(t, queue.schedule("b", start("b")))
+	assert.True(t, queue.schedule("c", start("c")))
+	assert.True(t, queue.isQueued("b"))
+	assert.Equal(t, []string{"a"}, started)
+
+	// canceled before it was started
+	queue.done("b")
+	assert.False(t, queue.isQueued("b"))
+	assert.Equal(t, []string{"a"}, started)
+
+	queue.done("a")
+	assert.Equal(t, []string{"a", "c"}, started)
+	assert.False(t, queue.isQueued("c"))
+}
+
+func TestTransferQueue_SetLimit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	queue := newTransferQueue(1)
+	var started int
+	start := func() bool {
+		started++
+		return true
+	}
+
+	for i := 0; i < 4; i++ {
+		queue.schedule(fmt.Sprint(i), start)
+	}
+	assert.Equal(t, 1, started)
+
+	queue.setLimit(3)
+	assert.Equal(t, 3, started)
+
+	// active transfers are not stopped
+	queue.setLimit(1)
+	queue.done("0")
+	queue.done("1")
+	assert.Equal(t, 3, started)
+	queue.done("2")
+	assert.Equal(t, 4, started)
+
+	queue.setLimit(0)
+	assert.False(t, queue.schedule("5", start))
+	assert.Equal(t, 5, started)
+}
+
+func TestTransferQueue_FailedStartFreesSlot(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	queue := newTransferQueue(1)
+	var started []string
+
+	assert.False(t, queue.schedule("a", func() bool { return true }))
+	assert.True(t, queue.schedule("b", func() bool { return false }))
+	assert.True(t, queue.schedule("c", func() bool {
+		started = append(started, "c")
+		return true
+	}))
+
+	queue.done("a")
+	assert.Equal(t, []string{"c"}, started)
+}
+
+func TestTransferQueue_LimitIsHonoredUnderLoad(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	const limit = 3
+	const transfers = 200
+	queue := newTransferQueue(limit)
+
+	var active, maxActive, started atomic.Int32
+	var wg sync.WaitGroup
+	finish := make(chan string, transfers)
+	for i := 0; i < transfers; i++ {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			queue.schedule(id, func() bool {
+				current := active.Add(1)
+				for {
+					max := maxActive.Load()
+					if current <= max || maxActive.CompareAndSwap(max, current) {
+						break
+					}
+				}
+				started.Add(1)
+				finish <- id
+				return true
+			})
+		}(fmt.Sprint(i))
+	}
+
+	for i := 0; i < transfers; i++ {
+		id := <-finish
+		active.Add(-1)
+		queue.done(id)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(transfers), started.Load())
+	assert.LessOrEqual(t, maxActive.Load(), int32(limit))
+}
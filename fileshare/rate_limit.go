@@ -0,0 +1,74 @@
+package fileshare
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	meshpb "github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+)
+
+// transferRateLimit overrides the rate limit of the peer while the transfer is active
+type transferRateLimit struct {
+	peer  string
+	limit uint64
+}
+
+// SetRateLimit limits the throughput of the transfers to the given amount of bytes per second
+// in each direction, 0 means unlimited.
+func (em *EventManager) SetRateLimit(limit uint64) error {
+	return setFileshareRateLimit(em.meshClient, "", limit)
+}
+
+// SetTransferRateLimit overrides the rate limit of the transfer peer until the transfer is finished.
+func (em *EventManager) SetTransferRateLimit(transferID string, peer string, limit uint64) error {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+
+	if err := setFileshareRateLimit(em.meshClient, peer, limit); err != nil {
+		return err
+	}
+	em.rateLimits[transferID] = transferRateLimit{peer: peer, limit: limit}
+	return nil
+}
+
+// resetTransferRateLimit restores the rate limit of the finished transfer peer
+func (em *EventManager) resetTransferRateLimit(transferID string) {
+	rateLimit, ok := em.rateLimits[transferID]
+	if !ok {
+		return
+	}
+	delete(em.rateLimits, transferID)
+
+	// the other active transfers with the same peer keep their limit
+	var limit uint64
+	for _, other := range em.rateLimits {
+		if other.peer == rateLimit.peer {
+			limit = other.limit
+		}
+	}
+	if err := setFileshareRateLimit(em.meshClient, rateLimit.peer, limit); err != nil {
+		log.Printf("resetting rate limit of transfer %s: %s", transferID, err)
+	}
+}
+
+// setFileshareRateLimit applies the limit using meshnet daemon, as it manages the firewall
+func setFileshareRateLimit(meshClient meshpb.MeshnetClient, peer string, limit uint64) error {
+	resp, err := meshClient.SetFileshareRateLimit(context.Background(),
+		&meshpb.SetFileshareRateLimitRequest{PeerIp: peer, Limit: limit})
+	if err != nil {
+		return fmt.Errorf("failed to set rate limit: %w", err)
+	}
+	switch resp := resp.Response.(type) {
+	case *meshpb.SetFileshareRateLimitResponse_Empty:
+		return nil
+	case *meshpb.SetFileshareRateLimitResponse_UpdatePeerErrorCode:
+		return fmt.Errorf("SetFileshareRateLimit failed, peer error: %s", resp.UpdatePeerErrorCode)
+	case *meshpb.SetFileshareRateLimitResponse_ServiceErrorCode:
+		return fmt.Errorf("SetFileshareRateLimit failed, service error: %s", resp.ServiceErrorCode)
+	case *meshpb.SetFileshareRateLimitResponse_MeshnetErrorCode:
+		return fmt.Errorf("SetFileshareRateLimit failed, meshnet error: %s", resp.MeshnetErrorCode)
+	default:
+		return fmt.Errorf("SetFileshareRateLimit failed, unknown error")
+	}
+}
@@ -0,0 +1,95 @@
+package fileshare
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransferRateLimit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	meshClient := &mockMeshClient{}
+	eventManager := NewEventManager(false, meshClient, &mockEventManagerOsInfo{}, &mockEventManagerFilesystem{}, "")
+
+	peer := "100.64.0.2"
+	assert.NoError(t, eventManager.SetTransferRateLimit("transfer1", peer, 1024))
+	assert.NoError(t, eventManager.SetTransferRateLimit("transfer2", peer, 2048))
+	assert.Equal(t, uint64(2048), meshClient.rateLimits[peer])
+
+	// limit of the other active transfer is restored
+	eventManager.finalizeTransfer(&LiveTransfer{ID: "transfer2"}, pb.Status_SUCCESS)
+	assert.Equal(t, uint64(1024), meshClient.rateLimits[peer])
+
+	eventManager.finalizeTransfer(&LiveTransfer{ID: "transfer1"}, pb.Status_SUCCESS)
+	assert.Equal(t, uint64(0), meshClient.rateLimits[peer])
+	assert.Empty(t, eventManager.rateLimits)
+
+	// transfers without the limit don't change it
+	meshClient.rateLimits = nil
+	eventManager.finalizeTransfer(&LiveTransfer{ID: "transfer3"}, pb.Status_SUCCESS)
+	assert.Nil(t, meshClient.rateLimits)
+}
+
+func TestTransferRateLimit_Failure(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	meshClient := &mockMeshClient{rateLimitFails: true}
+	eventManager := NewEventManager(false, meshClient, &mockEventManagerOsInfo{}, &mockEventManagerFilesystem{}, "")
+
+	assert.Error(t, eventManager.SetTransferRateLimit("transfer1", "100.64.0.2", 1024))
+	assert.Empty(t, eventManager.rateLimits)
+}
+
+func TestSetRateLimit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name           string
+		isMeshEnabled  bool
+		rateLimitFails bool
+		response       *pb.Error
+	}{
+		{
+			name:     "mesh not enabled",
+			response: serviceError(pb.ServiceErrorCode_MESH_NOT_ENABLED),
+		},
+		{
+			name:           "rate limit failure",
+			isMeshEnabled:  true,
+			rateLimitFails: true,
+			response:       fileshareError(pb.FileshareErrorCode_RATE_LIMIT_FAILURE),
+		},
+		{
+			name:          "success",
+			isMeshEnabled: true,
+			response:      empty(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			meshClient := &mockMeshClient{isEnabled: test.isMeshEnabled, rateLimitFails: test.rateLimitFails}
+			eventManager := NewEventManager(false, meshClient, &mockEventManagerOsInfo{}, &mockEventManagerFilesystem{}, "")
+			server := NewServer(
+				&mockServerFileshare{},
+				eventManager,
+				nil,
+				meshClient,
+				newMockFilesystem(),
+				&mockOsInfo{},
+				0,
+			)
+
+			resp, err := server.SetRateLimit(context.Background(), &pb.SetRateLimitRequest{Limit: 5 * 1024 * 1024})
+			assert.NoError(t, err)
+			assert.Equal(t, test.response, resp)
+			if resp.GetEmpty() != nil {
+				assert.Equal(t, uint64(5*1024*1024), meshClient.rateLimits[""])
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// Package sandbox confines nordfileshared, which parses the input of meshnet
+// peers and writes the received files into the home directory of the user.
+//
+// Writing is limited with Landlock to the given paths, e.g. the download
+// directory, the transfer history and the socket. Reading is not limited, as
+// any file of the user can be sent. Syscalls which the daemon never needs and
+// which could be used to escalate privileges are denied with seccomp.
+//
+// Both are applied per thread, which cannot be done for every thread of a cgo
+// binary, so the restricted thread executes the daemon again and the new
+// process inherits the restrictions.
+package sandbox
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"runtime"
+	"syscall"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/slices"
+	"golang.org/x/sys/unix"
+)
+
+// sandboxedArg is appended to the arguments of the process executed inside the
+// sandbox
+const sandboxedArg = "--sandboxed"
+
+// ErrUnsupported is returned when the kernel does not support the restriction
+var ErrUnsupported = errors.New("not supported by the kernel")
+
+// Enter restricts writing to the writable paths and executes the daemon again
+// inside the sandbox. It returns nil without doing anything when the process
+// is already sandboxed. The restrictions which the kernel does not support are
+// skipped.
+func Enter(writable []string) error {
+	if sandboxed(os.Args, noNewPrivs) {
+		return nil
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("finding executable: %w", err)
+	}
+
+	// restrictions apply to the calling thread only, exec has to happen on it
+	runtime.LockOSThread()
+
+	// required to install seccomp filters as unprivileged and by Landlock
+	if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return fmt.Errorf("setting no new privileges: %w", err)
+	}
+
+	if err := restrictPaths(writable); err != nil {
+		if !errors.Is(err, ErrUnsupported) {
+			return fmt.Errorf("restricting paths: %w", err)
+		}
+		log.Println(internal.WarningPrefix, "restricting paths:", err)
+	}
+
+	if err := restrictSyscalls(); err != nil {
+		if !errors.Is(err, ErrUnsupported) {
+			return fmt.Errorf("restricting syscalls: %w", err)
+		}
+		log.Println(internal.WarningPrefix, "restricting syscalls:", err)
+	}
+
+	args := os.Args
+	if !slices.Contains(args[1:], sandboxedArg) {
+		args = append(slices.Clone(args), sandboxedArg)
+	}
+	// #nosec G204 -- the same executable is run with the same arguments
+	err = syscall.Exec(exe, args, os.Environ())
+	return fmt.Errorf("executing sandboxed daemon: %w", err)
+}
+
+// sandboxed reports whether the process was executed by Enter. The argument
+// alone can be passed by anyone starting the daemon, so no new privileges,
+// which is set before the restrictions and cannot be unset, is required too.
+func sandboxed(args []string, noNewPrivs func() (bool, error)) bool {
+	if len(args) < 2 || !slices.Contains(args[1:], sandboxedArg) {
+		return false
+	}
+	set, err := noNewPrivs()
+	if err != nil {
+		log.Println(internal.WarningPrefix, "checking no new privileges:", err)
+		return false
+	}
+	return set
+}
+
+func noNewPrivs() (bool, error) {
+	ret, err := unix.PrctlRetInt(unix.PR_GET_NO_NEW_PRIVS, 0, 0, 0, 0)
+	if err != nil {
+		return false, err
+	}
+	return ret == 1, nil
+}
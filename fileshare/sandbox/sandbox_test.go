@@ -0,0 +1,100 @@
+package sandbox
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"golang.org/x/net/bpf"
+	"golang.org/x/sys/unix"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seccompData encodes the beginning of struct seccomp_data. The VM loads in
+// network byte order, unlike the kernel, so the values are big endian here.
+func seccompData(nr uint32, arch uint32) []byte {
+	data := make([]byte, 64)
+	binary.BigEndian.PutUint32(data[seccompDataNr:], nr)
+	binary.BigEndian.PutUint32(data[seccompDataArch:], arch)
+	return data
+}
+
+func TestSyscallFilter(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	vm, err := bpf.NewVM(syscallFilter(unix.AUDIT_ARCH_X86_64, []uint32{101, 165, 169}))
+	require.NoError(t, err)
+
+	deny := seccompRetErrno | int(unix.EPERM)
+	tests := []struct {
+		name     string
+		nr       uint32
+		arch     uint32
+		expected int
+	}{
+		{name: "allowed", nr: 0, arch: unix.AUDIT_ARCH_X86_64, expected: seccompRetAllow},
+		{name: "allowed between denied", nr: 166, arch: unix.AUDIT_ARCH_X86_64, expected: seccompRetAllow},
+		{name: "first denied", nr: 101, arch: unix.AUDIT_ARCH_X86_64, expected: deny},
+		{name: "last denied", nr: 169, arch: unix.AUDIT_ARCH_X86_64, expected: deny},
+		{name: "x32", nr: x32SyscallBit, arch: unix.AUDIT_ARCH_X86_64, expected: deny},
+		{name: "other architecture", nr: 0, arch: unix.AUDIT_ARCH_I386, expected: deny},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ret, err := vm.Run(seccompData(test.nr, test.arch))
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, ret)
+		})
+	}
+}
+
+func TestSyscallFilter_Assembles(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	for goarch, arch := range auditArches {
+		_, err := bpf.Assemble(syscallFilter(arch, deniedSyscalls))
+		assert.NoError(t, err, goarch)
+	}
+}
+
+func TestHandledAccess(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, uint64(writeAccess), handledAccess(1))
+	assert.Equal(t, uint64(writeAccess|unix.LANDLOCK_ACCESS_FS_REFER), handledAccess(2))
+	assert.Equal(t,
+		uint64(writeAccess|unix.LANDLOCK_ACCESS_FS_REFER|unix.LANDLOCK_ACCESS_FS_TRUNCATE),
+		handledAccess(4),
+	)
+	// reading is never restricted, files of the user are sent from anywhere
+	assert.Zero(t, handledAccess(4)&(unix.LANDLOCK_ACCESS_FS_READ_FILE|unix.LANDLOCK_ACCESS_FS_READ_DIR))
+}
+
+func TestSandboxed(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name       string
+		args       []string
+		noNewPrivs bool
+		err        error
+		expected   bool
+	}{
+		{name: "sandboxed", args: []string{"nordfileshared", sandboxedArg}, noNewPrivs: true, expected: true},
+		{name: "not executed yet", args: []string{"nordfileshared"}, noNewPrivs: true},
+		{name: "argument without no new privileges", args: []string{"nordfileshared", sandboxedArg}},
+		{name: "argument as the program name", args: []string{sandboxedArg}, noNewPrivs: true},
+		{name: "prctl failure", args: []string{"nordfileshared", sandboxedArg}, err: unix.EINVAL},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, sandboxed(test.args, func() (bool, error) {
+				return test.noNewPrivs, test.err
+			}))
+		})
+	}
+}
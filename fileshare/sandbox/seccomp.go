@@ -0,0 +1,109 @@
+package sandbox
+
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+
+	"golang.org/x/net/bpf"
+	"golang.org/x/sys/unix"
+)
+
+// seccomp constants missing from x/sys
+const (
+	seccompSetModeFilter = 1
+	seccompRetAllow      = 0x7fff0000
+	seccompRetErrno      = 0x00050000
+	// offsets within struct seccomp_data
+	seccompDataNr   = 0
+	seccompDataArch = 4
+	// x32SyscallBit marks the x32 syscalls, which are a separate table on x86_64
+	x32SyscallBit = 0x40000000
+)
+
+// auditArches are the architectures nordfileshared is built for
+var auditArches = map[string]uint32{
+	"amd64": unix.AUDIT_ARCH_X86_64,
+	"386":   unix.AUDIT_ARCH_I386,
+	"arm64": unix.AUDIT_ARCH_AARCH64,
+	"arm":   unix.AUDIT_ARCH_ARM,
+}
+
+// deniedSyscalls are not used by the daemon nor libdrop. They are denied, so
+// that code executed through a parsing bug cannot use them to attack the rest
+// of the system. Namespaces are not denied, as the applications opened from
+// the notifications inherit the filter and some of them sandbox themselves.
+var deniedSyscalls = []uint32{
+	unix.SYS_PTRACE,
+	unix.SYS_PROCESS_VM_READV,
+	unix.SYS_PROCESS_VM_WRITEV,
+	unix.SYS_MOUNT,
+	unix.SYS_UMOUNT2,
+	unix.SYS_PIVOT_ROOT,
+	unix.SYS_CHROOT,
+	unix.SYS_KEXEC_LOAD,
+	unix.SYS_INIT_MODULE,
+	unix.SYS_FINIT_MODULE,
+	unix.SYS_DELETE_MODULE,
+	unix.SYS_BPF,
+	unix.SYS_PERF_EVENT_OPEN,
+	unix.SYS_USERFAULTFD,
+	unix.SYS_OPEN_BY_HANDLE_AT,
+	unix.SYS_KEYCTL,
+	unix.SYS_ADD_KEY,
+	unix.SYS_REQUEST_KEY,
+	unix.SYS_REBOOT,
+	unix.SYS_SWAPON,
+	unix.SYS_SWAPOFF,
+	unix.SYS_ACCT,
+	unix.SYS_SETTIMEOFDAY,
+	unix.SYS_SETHOSTNAME,
+	unix.SYS_SETDOMAINNAME,
+}
+
+// syscallFilter returns the filter which fails the denied syscalls with EPERM.
+// Syscalls of other architectures are denied as well, as their numbers differ.
+func syscallFilter(arch uint32, denied []uint32) []bpf.Instruction {
+	deny := bpf.RetConstant{Val: seccompRetErrno | uint32(unix.EPERM)}
+	filter := []bpf.Instruction{
+		bpf.LoadAbsolute{Off: seccompDataArch, Size: 4},
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: arch, SkipTrue: 1},
+		deny,
+		bpf.LoadAbsolute{Off: seccompDataNr, Size: 4},
+		bpf.JumpIf{Cond: bpf.JumpGreaterOrEqual, Val: x32SyscallBit, SkipTrue: uint8(len(denied) + 1)},
+	}
+	for i, nr := range denied {
+		filter = append(filter, bpf.JumpIf{Cond: bpf.JumpEqual, Val: nr, SkipTrue: uint8(len(denied) - i)})
+	}
+	return append(filter, bpf.RetConstant{Val: seccompRetAllow}, deny)
+}
+
+// restrictSyscalls installs the filter on the calling thread
+func restrictSyscalls() error {
+	arch, ok := auditArches[runtime.GOARCH]
+	if !ok {
+		return fmt.Errorf("seccomp on %s: %w", runtime.GOARCH, ErrUnsupported)
+	}
+	raw, err := bpf.Assemble(syscallFilter(arch, deniedSyscalls))
+	if err != nil {
+		return fmt.Errorf("assembling seccomp filter: %w", err)
+	}
+
+	filter := make([]unix.SockFilter, len(raw))
+	for i, inst := range raw {
+		filter[i] = unix.SockFilter{Code: inst.Op, Jt: inst.Jt, Jf: inst.Jf, K: inst.K}
+	}
+	prog := unix.SockFprog{Len: uint16(len(filter)), Filter: &filter[0]}
+	if _, _, errno := unix.Syscall(
+		unix.SYS_SECCOMP,
+		seccompSetModeFilter,
+		0,
+		uintptr(unsafe.Pointer(&prog)),
+	); errno != 0 {
+		if errno == unix.EINVAL || errno == unix.ENOSYS {
+			return fmt.Errorf("seccomp: %w", ErrUnsupported)
+		}
+		return fmt.Errorf("installing seccomp filter: %w", errno)
+	}
+	return nil
+}
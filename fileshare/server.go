@@ -3,9 +3,11 @@ package fileshare
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"net/netip"
 	"strings"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
 	"github.com/NordSecurity/nordvpn-linux/meshnet"
@@ -36,16 +38,19 @@ type Server struct {
 	// Errors on Fileshare methods shouldn't be logged, because they are logged by the library itself.
 	fileshare     Fileshare
 	eventManager  *EventManager
+	tagStorage    TagStorage
 	meshClient    meshpb.MeshnetClient
 	filesystem    Filesystem
 	osInfo        OsInfo
 	listChunkSize int
+	watcher       *FolderWatcher
 }
 
 // NewServer is a default constructor for a fileshare server
 func NewServer(
 	fileshare Fileshare,
 	eventManager *EventManager,
+	tagStorage TagStorage,
 	meshClient meshpb.MeshnetClient,
 	filesystem Filesystem,
 	osInfo OsInfo,
@@ -54,6 +59,7 @@ func NewServer(
 	return &Server{
 		fileshare:     fileshare,
 		eventManager:  eventManager,
+		tagStorage:    tagStorage,
 		meshClient:    meshClient,
 		filesystem:    filesystem,
 		osInfo:        osInfo,
@@ -61,6 +67,15 @@ func NewServer(
 	}
 }
 
+// SetFolderWatcher must be called before serving the watch rpcs. Files
+// dropped into the watched folders are sent through the server.
+func (s *Server) SetFolderWatcher(watcher *FolderWatcher) {
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+	watcher.send = s.sendFiles
+	s.watcher = watcher
+}
+
 func (s *Server) isDirectory(path string) (bool, error) {
 	fileInfo, err := s.filesystem.Stat(path)
 	if err != nil {
@@ -124,6 +139,8 @@ func (s *Server) startTransferStatusStream(srv pb.Fileshare_SendServer, transfer
 			return srv.Send(&pb.StatusResponse{TransferId: ev.TransferID, Status: pb.Status_CANCELED_BY_PEER})
 		case pb.Status_CANCELED:
 			return srv.Send(&pb.StatusResponse{TransferId: ev.TransferID, Status: pb.Status_CANCELED})
+		case pb.Status_INTERRUPTED:
+			return srv.Send(&pb.StatusResponse{TransferId: ev.TransferID, Status: pb.Status_INTERRUPTED})
 		}
 	}
 	return nil
@@ -154,6 +171,67 @@ func (s *Server) getPeers() (map[string]*meshpb.Peer, map[string]*meshpb.Peer, e
 	}
 }
 
+// findPeer looks the peer up by its public key, or by its IP, hostname or nickname
+func findPeer(peerPubkeyToPeer map[string]*meshpb.Peer, peerNameToPeer map[string]*meshpb.Peer, name string) (*meshpb.Peer, bool) {
+	peer, ok := peerPubkeyToPeer[name]
+	if !ok {
+		peer, ok = peerNameToPeer[strings.ToLower(name)]
+	}
+	return peer, ok
+}
+
+// peerDisplayName returns the nickname or the hostname of the peer, or the
+// given name if the peer is not in the meshnet
+func peerDisplayName(peerPubkeyToPeer map[string]*meshpb.Peer, peerNameToPeer map[string]*meshpb.Peer, name string) string {
+	peer, ok := findPeer(peerPubkeyToPeer, peerNameToPeer, name)
+	if !ok {
+		return name
+	}
+	if peer.Nickname != "" {
+		return peer.Nickname
+	}
+	return peer.Hostname
+}
+
+// sendFiles starts the transfer of the files to the peer in the background
+func (s *Server) sendFiles(peerName string, paths []string) (string, error) {
+	peerPubkeyToPeer, peerNameToPeer, err := s.getPeers()
+	if err != nil {
+		return "", err
+	}
+	peer, ok := findPeer(peerPubkeyToPeer, peerNameToPeer, peerName)
+	if !ok {
+		return "", errors.New("peer not found")
+	}
+	if peer.Status == meshpb.PeerStatus_DISCONNECTED {
+		return "", errors.New("peer is disconnected")
+	}
+	if !peer.IsFileshareAllowed {
+		return "", errors.New("peer does not allow sending files")
+	}
+	parsedIP, err := netip.ParseAddr(peer.Ip)
+	if err != nil {
+		return "", fmt.Errorf("parsing peer ip: %w", err)
+	}
+
+	transferID, err := s.fileshare.Send(parsedIP, paths)
+	if err != nil {
+		return "", err
+	}
+
+	fileName := ""
+	if len(paths) == 1 {
+		fileName = paths[0]
+	}
+	go s.meshClient.NotifyNewTransfer(context.Background(), &meshpb.NewTransferNotification{
+		Identifier: peer.Identifier,
+		Os:         peer.Os,
+		FileName:   fileName,
+		FileCount:  int32(len(paths)),
+	})
+	return transferID, nil
+}
+
 // Ping rpc
 func (*Server) Ping(ctx context.Context, _ *pb.Empty) (*pb.Empty, error) {
 	return &pb.Empty{}, nil
@@ -166,6 +244,11 @@ func (s *Server) Send(req *pb.SendRequest, srv pb.Fileshare_SendServer) error {
 		return srv.Send(&pb.StatusResponse{Error: serviceError(pb.ServiceErrorCode_MESH_NOT_ENABLED)})
 	}
 
+	tags, err := ValidateTags(req.GetTags())
+	if err != nil {
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_INVALID_TAG)})
+	}
+
 	fileCount := 0
 	for _, path := range req.Paths {
 		isDirectory, err := s.isDirectory(path)
@@ -202,12 +285,9 @@ func (s *Server) Send(req *pb.SendRequest, srv pb.Fileshare_SendServer) error {
 		return srv.Send(&pb.StatusResponse{Error: serviceError(pb.ServiceErrorCode_INTERNAL_FAILURE)})
 	}
 
-	peer, ok := peerPubkeyToPeer[req.Peer]
+	peer, ok := findPeer(peerPubkeyToPeer, peerNameToPeer, req.Peer)
 	if !ok {
-		peer, ok = peerNameToPeer[strings.ToLower(req.Peer)]
-		if !ok {
-			return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_INVALID_PEER)})
-		}
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_INVALID_PEER)})
 	}
 
 	if peer.Status == meshpb.PeerStatus_DISCONNECTED {
@@ -228,6 +308,23 @@ func (s *Server) Send(req *pb.SendRequest, srv pb.Fileshare_SendServer) error {
 		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_TRANSFER_NOT_CREATED)})
 	}
 
+	if req.GetRateLimit() != 0 {
+		if err := s.eventManager.SetTransferRateLimit(transferID, peer.Ip, req.GetRateLimit()); err != nil {
+			log.Printf("error setting rate limit of transfer %s: %s", transferID, err)
+			if err := s.fileshare.Cancel(transferID); err != nil {
+				log.Printf("error canceling transfer %s: %s", transferID, err)
+			}
+			return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_RATE_LIMIT_FAILURE)})
+		}
+	}
+
+	if len(tags) > 0 {
+		// transfer is already created, so failing to store the tags only loses them
+		if err := s.tagStorage.SetTags(transferID, tags); err != nil {
+			log.Printf("storing tags of transfer %s: %s", transferID, err)
+		}
+	}
+
 	// Ignore response here
 	fileName := ""
 	if len(req.Paths) == 1 {
@@ -282,6 +379,8 @@ func (s *Server) Accept(req *pb.AcceptRequest, srv pb.Fileshare_AcceptServer) er
 		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_ACCEPT_DIR_IS_NOT_A_DIRECTORY)})
 	case errors.Is(err, ErrNoPermissionsToAcceptDirectory):
 		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_ACCEPT_DIR_NO_PERMISSIONS)})
+	case errors.Is(err, ErrAcceptDirNotPermitted):
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_ACCEPT_DIR_NOT_PERMITTED)})
 	case errors.Is(err, ErrTransferCanceledByUs):
 		fallthrough
 	case errors.Is(err, ErrTransferCanceledByPeer):
@@ -293,34 +392,48 @@ func (s *Server) Accept(req *pb.AcceptRequest, srv pb.Fileshare_AcceptServer) er
 		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_LIB_FAILURE)})
 	}
 
-	transferStarted := false
-	// if user has given command to accept only one (or some) file in whole transfer
-	// given files should be accepted, but other files has to be canceled for whole transfer to get processed at once
-	for _, file := range transfer.Files {
-		isAccepted := len(req.Files) == 0 || slices.ContainsFunc(req.Files,
-			func(acceptedFilePath string) bool {
-				// user can provide a directory name in order to accept multiple files, so we use HasPrefix instead of comparing ids directly
-				return strings.HasPrefix(file.Path, acceptedFilePath)
-			})
+	if req.GetRateLimit() != 0 {
+		if err := s.eventManager.SetTransferRateLimit(transfer.Id, transfer.Peer, req.GetRateLimit()); err != nil {
+			log.Printf("error setting rate limit of transfer %s: %s", transfer.Id, err)
+			return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_RATE_LIMIT_FAILURE)})
+		}
+	}
 
-		if isAccepted {
-			if err := s.fileshare.Accept(req.TransferId, req.DstPath, file.Id); err != nil {
-				log.Printf("error accepting file %s in transfer %s: %s", file.Id, req.TransferId, err)
+	transferStarted := false
+	start := func() bool {
+		// if user has given command to accept only one (or some) file in whole transfer
+		// given files should be accepted, but other files has to be canceled for whole transfer to get processed at once
+		for _, file := range transfer.Files {
+			isAccepted := len(req.Files) == 0 || slices.ContainsFunc(req.Files,
+				func(acceptedFilePath string) bool {
+					// user can provide a directory name in order to accept multiple files, so we use HasPrefix instead of comparing ids directly
+					return strings.HasPrefix(file.Path, acceptedFilePath)
+				})
+
+			if isAccepted {
+				if err := s.fileshare.Accept(req.TransferId, req.DstPath, file.Id); err != nil {
+					log.Printf("error accepting file %s in transfer %s: %s", file.Id, req.TransferId, err)
+				} else {
+					transferStarted = true
+				}
 			} else {
-				transferStarted = true
-			}
-		} else {
-			if err := s.fileshare.CancelFile(req.TransferId, file.Id); err != nil {
-				log.Printf("error cancelling file %s in transfer %s: %s", file.Id, req.TransferId, err)
+				if err := s.fileshare.CancelFile(req.TransferId, file.Id); err != nil {
+					log.Printf("error cancelling file %s in transfer %s: %s", file.Id, req.TransferId, err)
+				}
 			}
 		}
+		return transferStarted
 	}
 
-	if !transferStarted {
+	// transfer is started later if the concurrency limit is reached
+	status := pb.Status_REQUESTED
+	if s.eventManager.queue.schedule(req.TransferId, start) {
+		status = pb.Status_QUEUED
+	} else if !transferStarted {
 		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_ACCEPT_ALL_FILES_FAILED)})
 	}
 
-	if err := srv.Send(&pb.StatusResponse{TransferId: transfer.Id, Status: pb.Status_REQUESTED}); err != nil {
+	if err := srv.Send(&pb.StatusResponse{TransferId: transfer.Id, Status: status}); err != nil {
 		return err
 	}
 
@@ -331,6 +444,57 @@ func (s *Server) Accept(req *pb.AcceptRequest, srv pb.Fileshare_AcceptServer) er
 	return s.startTransferStatusStream(srv, transfer.Id)
 }
 
+// Resume rpc
+func (s *Server) Resume(req *pb.ResumeRequest, srv pb.Fileshare_ResumeServer) error {
+	resp, err := s.meshClient.IsEnabled(context.Background(), &meshpb.Empty{})
+	if err != nil || !resp.GetValue() {
+		return srv.Send(&pb.StatusResponse{Error: serviceError(pb.ServiceErrorCode_MESH_NOT_ENABLED)})
+	}
+
+	transfer, files, err := s.eventManager.ResumeTransfer(req.GetTransferId())
+	switch {
+	case errors.Is(err, ErrTransferNotFound):
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_TRANSFER_NOT_FOUND)})
+	case errors.Is(err, ErrTransferResumeOutgoing):
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_RESUME_OUTGOING)})
+	case errors.Is(err, ErrNothingToResume):
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_NOTHING_TO_RESUME)})
+	case errors.Is(err, ErrTransferCanceledByUs):
+		fallthrough
+	case errors.Is(err, ErrTransferCanceledByPeer):
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_TRANSFER_INVALIDATED)})
+	case err == nil:
+		break
+	default:
+		log.Printf("error while resuming transfer %s: %s", req.GetTransferId(), err)
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_LIB_FAILURE)})
+	}
+
+	resumed := false
+	for _, file := range files {
+		// Downloading the file into the same directory again makes libdrop continue
+		// from the already received part, once its checksum is verified
+		if err := s.fileshare.Accept(transfer.Id, fileDownloadDir(file), file.Id); err != nil {
+			log.Printf("error resuming file %s in transfer %s: %s", file.Id, transfer.Id, err)
+			continue
+		}
+		resumed = true
+	}
+	if !resumed {
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_RESUME_FAILED)})
+	}
+
+	if err := srv.Send(&pb.StatusResponse{TransferId: transfer.Id, Status: pb.Status_ONGOING}); err != nil {
+		return err
+	}
+
+	if req.GetSilent() {
+		return nil
+	}
+
+	return s.startTransferStatusStream(srv, transfer.Id)
+}
+
 // Cancel rpc
 func (s *Server) Cancel(
 	ctx context.Context,
@@ -381,17 +545,7 @@ func (s *Server) List(_ *pb.Empty, srv pb.Fileshare_ListServer) error {
 		return srv.Send(&pb.ListResponse{Error: fileshareError(pb.FileshareErrorCode_LIB_FAILURE)})
 	}
 	for _, transfer := range transfers {
-		peer, ok := peerPubkeyToPeer[transfer.Peer]
-		if !ok {
-			peer, ok = peerNameToPeer[strings.ToLower(transfer.Peer)]
-		}
-		if ok {
-			if peer.Nickname != "" {
-				transfer.Peer = peer.Nickname
-			} else {
-				transfer.Peer = peer.Hostname
-			}
-		}
+		transfer.Peer = peerDisplayName(peerPubkeyToPeer, peerNameToPeer, transfer.Peer)
 	}
 
 	for chunkStart := 0; chunkStart < len(transfers); chunkStart += s.listChunkSize {
@@ -477,6 +631,27 @@ func (s *Server) SetNotifications(ctx context.Context, in *pb.SetNotificationsRe
 	}
 }
 
+// SetConcurrency rpc
+func (s *Server) SetConcurrency(ctx context.Context, req *pb.SetConcurrencyRequest) (*pb.Error, error) {
+	s.eventManager.SetConcurrency(int(req.GetLimit()))
+	return empty(), nil
+}
+
+// SetRateLimit rpc
+func (s *Server) SetRateLimit(ctx context.Context, req *pb.SetRateLimitRequest) (*pb.Error, error) {
+	resp, err := s.meshClient.IsEnabled(context.Background(), &meshpb.Empty{})
+	if err != nil || !resp.GetValue() {
+		return serviceError(pb.ServiceErrorCode_MESH_NOT_ENABLED), nil
+	}
+
+	if err := s.eventManager.SetRateLimit(req.GetLimit()); err != nil {
+		log.Printf("error while setting rate limit: %s", err)
+		return fileshareError(pb.FileshareErrorCode_RATE_LIMIT_FAILURE), nil
+	}
+
+	return empty(), nil
+}
+
 func (s *Server) PurgeTransfersUntil(ctx context.Context, req *pb.PurgeTransfersUntilRequest) (*pb.Error, error) {
 	resp, err := s.meshClient.IsEnabled(context.Background(), &meshpb.Empty{})
 	if err != nil || !resp.GetValue() {
@@ -491,3 +666,130 @@ func (s *Server) PurgeTransfersUntil(ctx context.Context, req *pb.PurgeTransfers
 
 	return empty(), nil
 }
+
+// Watch rpc
+func (s *Server) Watch(ctx context.Context, req *pb.WatchRequest) (*pb.Error, error) {
+	resp, err := s.meshClient.IsEnabled(context.Background(), &meshpb.Empty{})
+	if err != nil || !resp.GetValue() {
+		return serviceError(pb.ServiceErrorCode_MESH_NOT_ENABLED), nil
+	}
+
+	peerPubkeyToPeer, peerNameToPeer, err := s.getPeers()
+	if err != nil {
+		return serviceError(pb.ServiceErrorCode_INTERNAL_FAILURE), nil
+	}
+	peer, ok := findPeer(peerPubkeyToPeer, peerNameToPeer, req.GetPeer())
+	if !ok {
+		return fileshareError(pb.FileshareErrorCode_INVALID_PEER), nil
+	}
+	if !peer.IsFileshareAllowed {
+		return fileshareError(pb.FileshareErrorCode_SENDING_NOT_ALLOWED), nil
+	}
+
+	// peer is stored by the public key, so that renaming it does not break the watch
+	err = s.watcher.Watch(req.GetPath(), peer.Pubkey)
+	switch {
+	case errors.Is(err, ErrFileNotFound):
+		return fileshareError(pb.FileshareErrorCode_FILE_NOT_FOUND), nil
+	case errors.Is(err, ErrWatchNotADirectory):
+		return fileshareError(pb.FileshareErrorCode_WATCH_NOT_A_DIRECTORY), nil
+	case errors.Is(err, ErrWatchAlreadyExists):
+		return fileshareError(pb.FileshareErrorCode_WATCH_ALREADY_EXISTS), nil
+	case err != nil:
+		log.Printf("error while watching %s: %s", req.GetPath(), err)
+		return fileshareError(pb.FileshareErrorCode_WATCH_FAILURE), nil
+	}
+
+	return empty(), nil
+}
+
+// Unwatch rpc
+func (s *Server) Unwatch(ctx context.Context, req *pb.UnwatchRequest) (*pb.Error, error) {
+	err := s.watcher.Unwatch(req.GetPath())
+	switch {
+	case errors.Is(err, ErrWatchNotFound):
+		return fileshareError(pb.FileshareErrorCode_WATCH_NOT_FOUND), nil
+	case err != nil:
+		log.Printf("error while unwatching %s: %s", req.GetPath(), err)
+		return fileshareError(pb.FileshareErrorCode_WATCH_FAILURE), nil
+	}
+
+	return empty(), nil
+}
+
+// ListWatches rpc
+func (s *Server) ListWatches(ctx context.Context, _ *pb.Empty) (*pb.ListWatchesResponse, error) {
+	folders := s.watcher.List()
+
+	// peers are shown by their names, if they are still in the meshnet
+	peerPubkeyToPeer, peerNameToPeer, err := s.getPeers()
+	if err == nil {
+		for _, folder := range folders {
+			folder.Peer = peerDisplayName(peerPubkeyToPeer, peerNameToPeer, folder.Peer)
+		}
+	}
+
+	return &pb.ListWatchesResponse{Error: empty(), Folders: folders}, nil
+}
+
+// StreamProgress rpc
+func (s *Server) StreamProgress(req *pb.ProgressRequest, srv pb.Fileshare_StreamProgressServer) error {
+	resp, err := s.meshClient.IsEnabled(context.Background(), &meshpb.Empty{})
+	if err != nil || !resp.GetValue() {
+		return srv.Send(&pb.ProgressResponse{Error: serviceError(pb.ServiceErrorCode_MESH_NOT_ENABLED)})
+	}
+
+	transferID := req.GetTransferId()
+	if transferID != "" {
+		_, err := s.eventManager.GetTransfer(transferID)
+		switch {
+		case errors.Is(err, ErrTransferNotFound):
+			return srv.Send(&pb.ProgressResponse{Error: fileshareError(pb.FileshareErrorCode_TRANSFER_NOT_FOUND)})
+		case err != nil:
+			log.Printf("error while streaming progress of transfer %s: %s", transferID, err)
+			return srv.Send(&pb.ProgressResponse{Error: fileshareError(pb.FileshareErrorCode_LIB_FAILURE)})
+		}
+	}
+
+	// peers joining the meshnet later are shown by their public keys or IPs
+	peerPubkeyToPeer, peerNameToPeer, err := s.getPeers()
+	if err != nil {
+		return srv.Send(&pb.ProgressResponse{Error: serviceError(pb.ServiceErrorCode_INTERNAL_FAILURE)})
+	}
+
+	meter := newThroughputMeter()
+	ticker := time.NewTicker(ProgressInterval)
+	defer ticker.Stop()
+	for {
+		progress := s.eventManager.LiveProgress(transferID)
+		meter.update(progress, time.Now())
+
+		finished := false
+		if transferID != "" && len(progress) == 0 {
+			// the transfer is waiting to be started or it is already finished
+			transfer, err := s.eventManager.GetTransfer(transferID)
+			if err != nil {
+				log.Printf("error while streaming progress of transfer %s: %s", transferID, err)
+				return srv.Send(&pb.ProgressResponse{Error: fileshareError(pb.FileshareErrorCode_LIB_FAILURE)})
+			}
+			progress = append(progress, transferToProgress(transfer))
+			finished = !slices.Contains(unfinishedTransferStatuses, transfer.Status)
+		}
+
+		for _, transfer := range progress {
+			transfer.Peer = peerDisplayName(peerPubkeyToPeer, peerNameToPeer, transfer.Peer)
+		}
+		if err := srv.Send(&pb.ProgressResponse{Error: empty(), Transfers: progress}); err != nil {
+			return err
+		}
+		if finished {
+			return nil
+		}
+
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -156,6 +156,8 @@ type mockMeshClient struct {
 	externalPeers  []*meshpb.Peer
 	selfPeer       *meshpb.Peer
 	getPeersCalled bool
+	rateLimits     map[string]uint64
+	rateLimitFails bool
 }
 
 // IsEnabled mock implementation
@@ -185,6 +187,22 @@ func (m *mockMeshClient) NotifyNewTransfer(ctx context.Context, in *meshpb.NewTr
 	}, nil
 }
 
+// SetFileshareRateLimit mock implementation
+func (m *mockMeshClient) SetFileshareRateLimit(ctx context.Context, in *meshpb.SetFileshareRateLimitRequest, opts ...grpc.CallOption) (*meshpb.SetFileshareRateLimitResponse, error) {
+	if m.rateLimitFails {
+		return &meshpb.SetFileshareRateLimitResponse{
+			Response: &meshpb.SetFileshareRateLimitResponse_MeshnetErrorCode{MeshnetErrorCode: meshpb.MeshnetErrorCode_LIB_FAILURE},
+		}, nil
+	}
+	if m.rateLimits == nil {
+		m.rateLimits = map[string]uint64{}
+	}
+	m.rateLimits[in.PeerIp] = in.Limit
+	return &meshpb.SetFileshareRateLimitResponse{
+		Response: &meshpb.SetFileshareRateLimitResponse_Empty{},
+	}, nil
+}
+
 func getTransfers(t *testing.T, numberOfTransfers int) map[string]*pb.Transfer {
 	t.Helper()
 
@@ -361,6 +379,7 @@ func TestSend(t *testing.T) {
 		server := NewServer(
 			&mockFileshare,
 			&EventManager{},
+			nil,
 			&mockMeshClient,
 			mockFs,
 			&mockOsInfo{},
@@ -384,6 +403,80 @@ func TestSend(t *testing.T) {
 	}
 }
 
+type mockTagStorage struct {
+	tags map[string][]string
+}
+
+func (m *mockTagStorage) SetTags(transferID string, tags []string) error {
+	m.tags[transferID] = tags
+	return nil
+}
+
+func TestSendTags(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	mockFs := newMockFilesystem()
+	populateMapFs(t, &mockFs.MapFS, "dir", 1)
+	peers := []*meshpb.Peer{
+		{
+			Ip:                 "38.30.202.86",
+			Pubkey:             "aZ9KwmEzystVJ0R1YitV02NzNngmSrZ3JDTj6tkI8T6=",
+			Hostname:           "internal.peer1.nord",
+			IsFileshareAllowed: true,
+			Status:             1,
+		},
+	}
+
+	tests := []struct {
+		name          string
+		tags          []string
+		expectedError *pb.Error
+		expectedTags  map[string][]string
+	}{
+		{
+			name:         "no tags",
+			expectedTags: map[string][]string{},
+		},
+		{
+			name:         "tags are stored",
+			tags:         []string{"invoices", "Q4"},
+			expectedTags: map[string][]string{"": {"invoices", "q4"}},
+		},
+		{
+			name:          "invalid tag",
+			tags:          []string{"my invoices"},
+			expectedError: fileshareError(pb.FileshareErrorCode_INVALID_TAG),
+			expectedTags:  map[string][]string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tagStorage := mockTagStorage{tags: map[string][]string{}}
+			server := NewServer(
+				&mockServerFileshare{},
+				&EventManager{},
+				&tagStorage,
+				&mockMeshClient{isEnabled: true, localPeers: peers},
+				mockFs,
+				&mockOsInfo{},
+				0,
+			)
+
+			sendServer := mockSendServer{}
+			err := server.Send(&pb.SendRequest{
+				Peer:   "internal.peer1.nord",
+				Paths:  []string{"dir"},
+				Silent: true,
+				Tags:   test.tags,
+			}, &sendServer)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedError, sendServer.response.GetError())
+			assert.Equal(t, test.expectedTags, tagStorage.tags)
+		})
+	}
+}
+
 func TestSendDirectoryFilesystemErrorHandling(t *testing.T) {
 	category.Set(t, category.Unit)
 
@@ -471,6 +564,7 @@ func TestSendDirectoryFilesystemErrorHandling(t *testing.T) {
 		server := NewServer(
 			&mockServerFileshare{},
 			&EventManager{},
+			nil,
 			&mockMeshClient{isEnabled: true},
 			mockFs,
 			&mockOsInfo{},
@@ -686,6 +780,7 @@ func TestAccept(t *testing.T) {
 			}},
 		}
 		eventManager := EventManager{
+			queue: newTransferQueue(0),
 			storage: &mockStorage{
 				transfers: map[string]*pb.Transfer{
 					transferID: &transfer,
@@ -696,6 +791,7 @@ func TestAccept(t *testing.T) {
 		server := NewServer(
 			&mockServerFileshare{},
 			&eventManager,
+			nil,
 			&mockMeshClient{isEnabled: true},
 			mockFs,
 			&mockOsInfo,
@@ -860,6 +956,7 @@ func TestAcceptDirectory(t *testing.T) {
 	for _, test := range tests {
 		transfer.Status = pb.Status_REQUESTED
 		eventManager := EventManager{
+			queue: newTransferQueue(0),
 			storage: &mockStorage{
 				transfers: map[string]*pb.Transfer{
 					transferID: &transfer,
@@ -879,6 +976,7 @@ func TestAcceptDirectory(t *testing.T) {
 		server := NewServer(
 			fileshare,
 			&eventManager,
+			nil,
 			&mockMeshClient{isEnabled: true},
 			mockFs,
 			&mockOsInfo,
@@ -981,6 +1079,7 @@ func TestCancel(t *testing.T) {
 	for _, test := range fileshareTests {
 		transfer.Files[0].Status = test.transferStatus
 		eventManager := EventManager{
+			queue: newTransferQueue(0),
 			storage: &mockStorage{
 				transfers: map[string]*pb.Transfer{
 					transferID: &transfer,
@@ -991,6 +1090,7 @@ func TestCancel(t *testing.T) {
 		server := NewServer(
 			&mockServerFileshare{cancelReturnValue: test.cancelError},
 			&eventManager,
+			nil,
 			&mockMeshClient{isEnabled: test.isMeshEnabled},
 			newMockFilesystem(),
 			&mockOsInfo{},
@@ -1041,12 +1141,14 @@ func TestList(t *testing.T) {
 			transfers: expectedTransfers,
 		}
 		eventManager := EventManager{
+			queue:   newTransferQueue(0),
 			storage: storage,
 		}
 
 		server := NewServer(
 			&mockEventManagerFileshare{},
 			&eventManager,
+			nil,
 			&mockMeshClient{isEnabled: true},
 			newMockFilesystem(),
 			&mockOsInfo{},
@@ -21,6 +21,9 @@ var ErrNotStarted = errors.New("fileshare wasn't started")
 
 // ForkFileshare manages fileshare service through exec.Command
 type ForkFileshare struct {
+	// OpenLog returns the writer for the log file at path, which is already
+	// created and owned by the user. If nil, the file is written to directly.
+	OpenLog func(path string) io.WriteCloser
 	cmd     *exec.Cmd
 	logFile io.Closer
 }
@@ -35,9 +38,10 @@ func (f *ForkFileshare) Enable(uid, gid uint32) (err error) {
 	if err != nil {
 		return fmt.Errorf("opening fileshare log file %s: %w", logFilePath, err)
 	}
+	var logWriter io.WriteCloser = logFile
 	defer func() {
 		if err != nil {
-			if err := logFile.Close(); err != nil {
+			if err := logWriter.Close(); err != nil {
 				log.Printf("closing fileshare log file: %s", err)
 			}
 		}
@@ -45,6 +49,12 @@ func (f *ForkFileshare) Enable(uid, gid uint32) (err error) {
 	if err = logFile.Chown(int(uid), int(gid)); err != nil {
 		return fmt.Errorf("changing file %s ownership: %w", logFilePath, err)
 	}
+	if f.OpenLog != nil {
+		if err = logFile.Close(); err != nil {
+			return fmt.Errorf("closing fileshare log file %s: %w", logFilePath, err)
+		}
+		logWriter = f.OpenLog(logFilePath)
+	}
 
 	// Set up socket dir
 	socketDir := filepath.Dir(internal.GetFilesharedSocket(int(uid)))
@@ -69,8 +79,8 @@ func (f *ForkFileshare) Enable(uid, gid uint32) (err error) {
 
 	// #nosec G204 -- no input comes from user
 	cmd := exec.Command("/usr/bin/" + internal.Fileshared)
-	cmd.Stdout = logFile
-	cmd.Stderr = logFile
+	cmd.Stdout = logWriter
+	cmd.Stderr = logWriter
 	cmd.Stdin = nil
 	credential := &syscall.Credential{
 		Uid:    uid,
@@ -80,7 +90,7 @@ func (f *ForkFileshare) Enable(uid, gid uint32) (err error) {
 	cmd.SysProcAttr = &syscall.SysProcAttr{Credential: credential}
 
 	f.cmd = cmd
-	f.logFile = logFile
+	f.logFile = logWriter
 	return cmd.Start()
 }
 
@@ -99,9 +109,12 @@ func (f *ForkFileshare) Stop(uid, _ uint32) error {
 	if err := os.RemoveAll(filepath.Dir(internal.GetFilesharedSocket(int(uid)))); err != nil {
 		log.Println("deleting fileshare socket dir: " + err.Error())
 	}
-	if err := f.logFile.Close(); err != nil {
-		log.Println("closing fileshare process log file: " + err.Error())
-	}
+	// log is closed after the process exits, as its output can still be copied to the log
+	defer func() {
+		if err := f.logFile.Close(); err != nil {
+			log.Println("closing fileshare process log file: " + err.Error())
+		}
+	}()
 
 	err := f.cmd.Process.Signal(unix.SIGTERM)
 	if err != nil {
@@ -14,13 +14,15 @@ import (
 // Originally we had our own storage implementation in JSON file. Later libDrop introduced an
 // integrated storage solution, so we migrated to that. But to not lose transfer history when
 // updating the app, we still load transfers from the original file storage.
+// Local transfer tags are stored separately and are attached to the loaded transfers.
 type Combined struct {
 	json    fileshare.Storage
 	libdrop fileshare.Storage
+	tags    *TagsFile
 }
 
 func NewCombined(storagePath string, fileshare fileshare.Fileshare) *Combined {
-	return &Combined{NewJsonFile(storagePath), NewLibdrop(fileshare)}
+	return &Combined{NewJsonFile(storagePath), NewLibdrop(fileshare), NewTagsFile(storagePath)}
 }
 
 func (c *Combined) Load() (map[string]*pb.Transfer, error) {
@@ -38,9 +40,23 @@ func (c *Combined) Load() (map[string]*pb.Transfer, error) {
 		log.Printf("json history file corrupted: %s", err)
 	}
 
+	tags, err := c.tags.Load()
+	if err != nil {
+		log.Printf("transfer tags file corrupted: %s", err)
+	}
+	for id, transferTags := range tags {
+		if transfer, ok := libdropTransfers[id]; ok {
+			transfer.Tags = transferTags
+		}
+	}
+
 	return libdropTransfers, nil
 }
 
+func (c *Combined) SetTags(transferID string, tags []string) error {
+	return c.tags.SetTags(transferID, tags)
+}
+
 func (c *Combined) PurgeTransfersUntil(until time.Time) error {
 	err := c.libdrop.PurgeTransfersUntil(until)
 	if err != nil {
@@ -52,5 +68,12 @@ func (c *Combined) PurgeTransfersUntil(until time.Time) error {
 		return err
 	}
 
-	return nil
+	transfers, err := c.Load()
+	if err != nil {
+		return err
+	}
+	return c.tags.Prune(func(transferID string) bool {
+		_, ok := transfers[transferID]
+		return ok
+	})
 }
@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+const tagsFile = "transfer_tags"
+
+// TagsFile stores local transfer tags in a JSON file next to the transfer history.
+// Tags are never sent to the peer.
+//
+// Thread-safe.
+type TagsFile struct {
+	storagePath string
+	mu          sync.Mutex
+}
+
+func NewTagsFile(storagePath string) *TagsFile {
+	return &TagsFile{storagePath: storagePath}
+}
+
+// Load tags of all transfers
+func (tf *TagsFile) Load() (map[string][]string, error) {
+	tf.mu.Lock()
+	defer tf.mu.Unlock()
+	return tf.load()
+}
+
+func (tf *TagsFile) load() (map[string][]string, error) {
+	tags := map[string][]string{}
+	jsonBytes, err := os.ReadFile(filepath.Clean(path.Join(tf.storagePath, tagsFile)))
+	if errors.Is(err, os.ErrNotExist) {
+		return tags, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("loading transfer tags file: %w", err)
+	}
+
+	if err := json.Unmarshal(jsonBytes, &tags); err != nil {
+		return nil, fmt.Errorf("unmarshalling transfer tags: %w", err)
+	}
+	return tags, nil
+}
+
+func (tf *TagsFile) save(tags map[string][]string) error {
+	tagsFilePath := path.Join(tf.storagePath, tagsFile)
+	if err := internal.EnsureDir(tagsFilePath); err != nil {
+		return fmt.Errorf("saving transfer tags: %w", err)
+	}
+
+	jsonBytes, err := json.Marshal(tags)
+	if err != nil {
+		return fmt.Errorf("marshalling transfer tags: %w", err)
+	}
+
+	if err := os.WriteFile(tagsFilePath, jsonBytes, internal.PermUserRW); err != nil {
+		return fmt.Errorf("writing transfer tags file: %w", err)
+	}
+	return nil
+}
+
+// SetTags of the transfer, empty tags remove the transfer from the file
+func (tf *TagsFile) SetTags(transferID string, transferTags []string) error {
+	tf.mu.Lock()
+	defer tf.mu.Unlock()
+
+	tags, err := tf.load()
+	if err != nil {
+		return err
+	}
+
+	if len(transferTags) == 0 {
+		if _, ok := tags[transferID]; !ok {
+			return nil
+		}
+		delete(tags, transferID)
+	} else {
+		tags[transferID] = transferTags
+	}
+	return tf.save(tags)
+}
+
+// Prune tags of the transfers which are no longer in the history
+func (tf *TagsFile) Prune(exists func(transferID string) bool) error {
+	tf.mu.Lock()
+	defer tf.mu.Unlock()
+
+	tags, err := tf.load()
+	if err != nil {
+		return err
+	}
+
+	pruned := false
+	for transferID := range tags {
+		if !exists(transferID) {
+			delete(tags, transferID)
+			pruned = true
+		}
+	}
+	if !pruned {
+		return nil
+	}
+	return tf.save(tags)
+}
@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagsFile(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tagsFile := NewTagsFile(t.TempDir())
+
+	// no file yet
+	tags, err := tagsFile.Load()
+	assert.NoError(t, err)
+	assert.Empty(t, tags)
+
+	assert.NoError(t, tagsFile.SetTags("first", []string{"invoices", "q4"}))
+	assert.NoError(t, tagsFile.SetTags("second", []string{"photos"}))
+	assert.NoError(t, tagsFile.SetTags("third", []string{"invoices"}))
+	tags, err = tagsFile.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"first":  {"invoices", "q4"},
+		"second": {"photos"},
+		"third":  {"invoices"},
+	}, tags)
+
+	assert.NoError(t, tagsFile.SetTags("second", nil))
+	assert.NoError(t, tagsFile.Prune(func(transferID string) bool { return transferID != "third" }))
+	tags, err = tagsFile.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{"first": {"invoices", "q4"}}, tags)
+}
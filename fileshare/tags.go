@@ -0,0 +1,38 @@
+package fileshare
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// TransferTagLimit is the maximum number of tags of a single transfer
+const TransferTagLimit = 10
+
+var (
+	ErrInvalidTag    = errors.New("tag must be 1-32 characters long and contain only letters, digits, '-' and '_'")
+	ErrTooManyTags   = fmt.Errorf("transfer cannot have more than %d tags", TransferTagLimit)
+	transferTagRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,32}$`)
+)
+
+// ValidateTags checks the format of the tags and returns them lowercased and
+// without duplicates
+func ValidateTags(tags []string) ([]string, error) {
+	var validated []string
+	for _, tag := range tags {
+		if !transferTagRegex.MatchString(tag) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidTag, tag)
+		}
+		tag = strings.ToLower(tag)
+		if !slices.Contains(validated, tag) {
+			validated = append(validated, tag)
+		}
+	}
+	if len(validated) > TransferTagLimit {
+		return nil, ErrTooManyTags
+	}
+	return validated, nil
+}
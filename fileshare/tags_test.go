@@ -0,0 +1,61 @@
+package fileshare
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTags(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		tags     []string
+		expected []string
+		err      error
+	}{
+		{
+			name: "no tags",
+		},
+		{
+			name:     "valid tags",
+			tags:     []string{"invoices", "Q4_2023", "tax-returns"},
+			expected: []string{"invoices", "q4_2023", "tax-returns"},
+		},
+		{
+			name:     "duplicates",
+			tags:     []string{"invoices", "Invoices"},
+			expected: []string{"invoices"},
+		},
+		{
+			name: "empty tag",
+			tags: []string{""},
+			err:  ErrInvalidTag,
+		},
+		{
+			name: "invalid characters",
+			tags: []string{"my invoices"},
+			err:  ErrInvalidTag,
+		},
+		{
+			name: "too long",
+			tags: []string{"abcdefghijklmnopqrstuvwxyz0123456"},
+			err:  ErrInvalidTag,
+		},
+		{
+			name: "too many tags",
+			tags: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"},
+			err:  ErrTooManyTags,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tags, err := ValidateTags(test.tags)
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.expected, tags)
+		})
+	}
+}
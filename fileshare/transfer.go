@@ -10,6 +10,7 @@ import (
 
 	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
 	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/slices"
 )
 
 var (
@@ -23,6 +24,7 @@ var (
 		pb.Status_CANCELED:             "canceled",
 		pb.Status_CANCELED_BY_PEER:     "canceled by peer",
 		pb.Status_PENDING:              "pending",
+		pb.Status_QUEUED:               "queued",
 	}
 	OutgoingStatus = map[pb.Status]string{
 		pb.Status_REQUESTED:            "request sent",
@@ -70,6 +72,7 @@ var (
 		pb.Status_FILENAME_TOO_LONG:        "filename too long",
 		pb.Status_AUTHENTICATION_FAILED:    "authentication failed",
 		pb.Status_FILE_CHECKSUM_MISMATCH:   "the file is corrupted",
+		pb.Status_PAUSED:                   "interrupted",
 	}
 	IncomingFileStatus = map[pb.Status]string{
 		pb.Status_SUCCESS:              "downloaded",
@@ -89,6 +92,28 @@ var (
 	}
 )
 
+// resumableFileStatuses are the statuses of the files whose download was
+// interrupted by the peer going offline or the network change. Such downloads
+// are continued from the already received part of the file.
+var resumableFileStatuses = []pb.Status{
+	pb.Status_PAUSED,
+	pb.Status_INTERRUPTED,
+	pb.Status_SERVICE_STOP,
+	pb.Status_TRANSFER_TIMEOUT,
+	pb.Status_WS_SERVER,
+	pb.Status_WS_CLIENT,
+}
+
+// IsFileResumable reports whether the interrupted download of the file can be resumed
+func IsFileResumable(file *pb.File) bool {
+	return slices.Contains(resumableFileStatuses, file.Status)
+}
+
+// fileDownloadDir returns the directory which the file is downloaded to
+func fileDownloadDir(file *pb.File) string {
+	return filepath.Clean(strings.TrimSuffix(file.FullPath, file.Path))
+}
+
 // GetTransferStatus translate transfer status into human readable form
 func GetTransferStatus(tr *pb.Transfer) string {
 	if tr.Direction == pb.Direction_INCOMING {
@@ -357,6 +382,7 @@ func getTransferStatus(files []*pb.File) pb.Status {
 	allFinished := true
 	hasNoErrors := true
 	hasStarted := false
+	hasResumable := false
 	for _, file := range files {
 		if allCanceled && file.Status != pb.Status_CANCELED {
 			allCanceled = false
@@ -370,11 +396,16 @@ func getTransferStatus(files []*pb.File) pb.Status {
 		if !hasStarted && file.Status != pb.Status_REQUESTED {
 			hasStarted = true
 		}
+		if !hasResumable && IsFileResumable(file) {
+			hasResumable = true
+		}
 	}
 
 	switch {
 	case allCanceled:
 		return pb.Status_CANCELED
+	case allFinished && hasResumable:
+		return pb.Status_INTERRUPTED
 	case allFinished && hasNoErrors:
 		return pb.Status_SUCCESS
 	case allFinished && !hasNoErrors:
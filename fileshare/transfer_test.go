@@ -287,3 +287,48 @@ func TestLibdropTransferToInternalTransfer(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTransferStatus_Interrupted(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []pb.Status
+		expected pb.Status
+	}{
+		{
+			name:     "all files interrupted",
+			statuses: []pb.Status{pb.Status_PAUSED, pb.Status_WS_CLIENT},
+			expected: pb.Status_INTERRUPTED,
+		},
+		{
+			name:     "some files downloaded",
+			statuses: []pb.Status{pb.Status_SUCCESS, pb.Status_PAUSED, pb.Status_CANCELED},
+			expected: pb.Status_INTERRUPTED,
+		},
+		{
+			name:     "download continues",
+			statuses: []pb.Status{pb.Status_ONGOING, pb.Status_PAUSED},
+			expected: pb.Status_ONGOING,
+		},
+		{
+			name:     "not resumable error",
+			statuses: []pb.Status{pb.Status_SUCCESS, pb.Status_FILE_CHECKSUM_MISMATCH},
+			expected: pb.Status_FINISHED_WITH_ERRORS,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := []*pb.File{}
+			for _, status := range test.statuses {
+				files = append(files, &pb.File{Status: status})
+			}
+			assert.Equal(t, test.expected, getTransferStatus(files))
+		})
+	}
+}
+
+func TestFileDownloadDir(t *testing.T) {
+	assert.Equal(t, "/home/user/Downloads",
+		fileDownloadDir(&pb.File{Path: "dir/file1", FullPath: "/home/user/Downloads/dir/file1"}))
+	assert.Equal(t, "/tmp", fileDownloadDir(&pb.File{Path: "file2", FullPath: "/tmp/file2"}))
+}
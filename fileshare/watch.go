@@ -0,0 +1,356 @@
+package fileshare
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// WatchScanInterval is how often the watched folders are checked for new files
+const WatchScanInterval = 5 * time.Second
+
+const (
+	watchedFilesSentSummary   = "Files sent from watched folder"
+	watchedFilesFailedSummary = "Failed to send files from watched folder"
+	watchedFilesBody          = "%s → %s"
+)
+
+var (
+	ErrWatchAlreadyExists = errors.New("folder is already watched")
+	ErrWatchNotFound      = errors.New("folder is not watched")
+	ErrWatchNotADirectory = errors.New("watched path is not a directory")
+)
+
+// fileVersion identifies the content of the file, so that the same version is
+// sent only once
+type fileVersion struct {
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+}
+
+// watchedFolder is the directory whose files are sent to the peer
+type watchedFolder struct {
+	Path string `json:"path"`
+	Peer string `json:"peer"`
+	// Files are the versions of the already handled files, including the ones
+	// present when the watch was started, key is the file name
+	Files map[string]fileVersion `json:"files"`
+	// SentFiles is the number of files sent since the watch was started
+	SentFiles uint32 `json:"sent_files"`
+	// seen are the files found by the previous scan. They are sent once they
+	// do not change between two scans, so that partially written files are not
+	// sent.
+	seen map[string]fileVersion
+	// lastErr is used to avoid logging the same send failure on every scan
+	lastErr string
+}
+
+// sendFunc sends the files to the peer and returns the transfer ID
+type sendFunc func(peer string, paths []string) (string, error)
+
+// FolderWatcher sends the files dropped into the watched folders to the
+// chosen peers. Watched folders are persisted in the storage file. Thread safe.
+type FolderWatcher struct {
+	mu           sync.Mutex
+	folders      map[string]*watchedFolder
+	storagePath  string
+	filesystem   Filesystem
+	eventManager *EventManager
+	send         sendFunc
+	stop         chan struct{}
+	done         chan struct{}
+}
+
+// NewFolderWatcher loads the watched folders from the storage file, or starts
+// with none if loading fails
+func NewFolderWatcher(storagePath string, filesystem Filesystem, eventManager *EventManager) *FolderWatcher {
+	w := &FolderWatcher{
+		folders:      map[string]*watchedFolder{},
+		storagePath:  storagePath,
+		filesystem:   filesystem,
+		eventManager: eventManager,
+	}
+	if err := w.load(); err != nil {
+		log.Printf("loading watched folders: %s", err)
+	}
+	return w
+}
+
+// Start scanning the watched folders periodically
+func (w *FolderWatcher) Start(interval time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.stop != nil {
+		return
+	}
+	w.stop = make(chan struct{})
+	w.done = make(chan struct{})
+
+	go func(stop <-chan struct{}, done chan<- struct{}) {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				w.Scan()
+			case <-stop:
+				return
+			}
+		}
+	}(w.stop, w.done)
+}
+
+// Stop scanning the watched folders
+func (w *FolderWatcher) Stop() {
+	w.mu.Lock()
+	stop, done := w.stop, w.done
+	w.stop, w.done = nil, nil
+	w.mu.Unlock()
+
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+}
+
+// Watch starts sending the files dropped into the folder to the peer. Files
+// already in the folder are not sent.
+func (w *FolderWatcher) Watch(path string, peer string) error {
+	info, err := w.filesystem.Stat(path)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrFileNotFound, err)
+	}
+	if !info.IsDir() {
+		return ErrWatchNotADirectory
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if _, ok := w.folders[path]; ok {
+		return ErrWatchAlreadyExists
+	}
+	files, err := w.readFolder(path)
+	if err != nil {
+		return fmt.Errorf("reading folder: %w", err)
+	}
+	w.folders[path] = &watchedFolder{Path: path, Peer: peer, Files: files}
+	if err := w.save(); err != nil {
+		delete(w.folders, path)
+		return err
+	}
+	return nil
+}
+
+// Unwatch stops sending the files dropped into the folder
+func (w *FolderWatcher) Unwatch(path string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	folder, ok := w.folders[path]
+	if !ok {
+		return ErrWatchNotFound
+	}
+	delete(w.folders, path)
+	if err := w.save(); err != nil {
+		w.folders[path] = folder
+		return err
+	}
+	return nil
+}
+
+// List the watched folders sorted by path
+func (w *FolderWatcher) List() []*pb.WatchedFolder {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	folders := make([]*pb.WatchedFolder, 0, len(w.folders))
+	for _, folder := range w.folders {
+		folders = append(folders, &pb.WatchedFolder{
+			Path:      folder.Path,
+			Peer:      folder.Peer,
+			SentFiles: folder.SentFiles,
+		})
+	}
+	sort.Slice(folders, func(i, j int) bool { return folders[i].Path < folders[j].Path })
+	return folders
+}
+
+// Scan the watched folders once and send the new or changed files which were
+// not modified since the previous scan
+func (w *FolderWatcher) Scan() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.send == nil {
+		return
+	}
+
+	changed := false
+	for _, folder := range w.folders {
+		files, err := w.readFolder(folder.Path)
+		if err != nil {
+			w.logFolderError(folder, fmt.Errorf("reading folder: %w", err))
+			continue
+		}
+
+		// forget the removed files, so that the watched state does not grow
+		for name := range folder.Files {
+			if _, ok := files[name]; !ok {
+				delete(folder.Files, name)
+				changed = true
+			}
+		}
+
+		ready := readyFiles(folder, files)
+		folder.seen = files
+		if len(ready) == 0 {
+			continue
+		}
+
+		paths := make([]string, 0, len(ready))
+		for _, name := range ready {
+			paths = append(paths, filepath.Join(folder.Path, name))
+		}
+		transferID, err := w.send(folder.Peer, paths)
+		if err != nil {
+			// files are sent on the next scan, once the peer is reachable
+			folder.seen = nil
+			w.logFolderError(folder, fmt.Errorf("sending files to %s: %w", folder.Peer, err))
+			continue
+		}
+		folder.lastErr = ""
+		for _, name := range ready {
+			folder.Files[name] = files[name]
+		}
+		folder.SentFiles += uint32(len(ready))
+		changed = true
+		go w.notifyWhenFinished(transferID, folder.Path, folder.Peer, ready)
+	}
+
+	if changed {
+		if err := w.save(); err != nil {
+			log.Printf("saving watched folders: %s", err)
+		}
+	}
+}
+
+// readyFiles returns the names of the files which were not sent in their
+// current version and did not change since the previous scan
+func readyFiles(folder *watchedFolder, files map[string]fileVersion) []string {
+	var ready []string
+	for name, version := range files {
+		if handled, ok := folder.Files[name]; ok && handled.equal(version) {
+			continue
+		}
+		if seen, ok := folder.seen[name]; !ok || !seen.equal(version) {
+			continue
+		}
+		ready = append(ready, name)
+	}
+	sort.Strings(ready)
+	return ready
+}
+
+// readFolder returns the regular files of the folder. Hidden files are
+// skipped, as they are usually temporary files of the applications writing
+// into the folder.
+func (w *FolderWatcher) readFolder(path string) (map[string]fileVersion, error) {
+	entries, err := w.filesystem.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	files := map[string]fileVersion{}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		files[entry.Name()] = fileVersion{Size: info.Size(), ModTime: info.ModTime()}
+	}
+	return files, nil
+}
+
+// notifyWhenFinished waits until the transfer is finished and notifies the user
+// about its result
+func (w *FolderWatcher) notifyWhenFinished(transferID string, path string, peer string, files []string) {
+	status := pb.Status_REQUESTED
+	for ev := range w.eventManager.Subscribe(transferID) {
+		status = ev.Status
+	}
+
+	summary := watchedFilesSentSummary
+	if status != pb.Status_SUCCESS {
+		summary = watchedFilesFailedSummary
+		log.Printf("transfer %s of the files watched in %s finished with status %s", transferID, path, status)
+	}
+	name := files[0]
+	if len(files) > 1 {
+		name = fmt.Sprintf("%d files", len(files))
+	}
+	w.eventManager.notify(summary, fmt.Sprintf(watchedFilesBody, name, peer))
+}
+
+func (w *FolderWatcher) logFolderError(folder *watchedFolder, err error) {
+	if err.Error() == folder.lastErr {
+		return
+	}
+	folder.lastErr = err.Error()
+	log.Printf("watched folder %s: %s", folder.Path, err)
+}
+
+func (w *FolderWatcher) load() error {
+	data, err := os.ReadFile(w.storagePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var folders []*watchedFolder
+	if err := json.Unmarshal(data, &folders); err != nil {
+		return err
+	}
+	for _, folder := range folders {
+		if folder.Files == nil {
+			folder.Files = map[string]fileVersion{}
+		}
+		w.folders[folder.Path] = folder
+	}
+	return nil
+}
+
+// save must be called with the lock held
+func (w *FolderWatcher) save() error {
+	folders := make([]*watchedFolder, 0, len(w.folders))
+	for _, folder := range w.folders {
+		folders = append(folders, folder)
+	}
+	sort.Slice(folders, func(i, j int) bool { return folders[i].Path < folders[j].Path })
+	data, err := json.Marshal(folders)
+	if err != nil {
+		return fmt.Errorf("marshaling watched folders: %w", err)
+	}
+	if err := internal.FileWrite(w.storagePath, data, internal.PermUserRW); err != nil {
+		return fmt.Errorf("writing watched folders: %w", err)
+	}
+	return nil
+}
+
+func (v fileVersion) equal(other fileVersion) bool {
+	return v.Size == other.Size && v.ModTime.Equal(other.ModTime)
+}
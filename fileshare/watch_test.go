@@ -0,0 +1,133 @@
+package fileshare
+
+import (
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockWatchSender struct {
+	sent [][]string
+	err  error
+}
+
+func (m *mockWatchSender) send(peer string, paths []string) (string, error) {
+	if m.err != nil {
+		return "", m.err
+	}
+	m.sent = append(m.sent, paths)
+	return "transfer", nil
+}
+
+func newTestFolderWatcher(t *testing.T, filesystem mockFilesystem, sender *mockWatchSender) *FolderWatcher {
+	t.Helper()
+	eventManager := NewEventManager(false, nil, &mockOsInfo{}, filesystem, "")
+	watcher := NewFolderWatcher(filepath.Join(t.TempDir(), "watches.json"), filesystem, eventManager)
+	watcher.send = sender.send
+	return watcher
+}
+
+func TestFolderWatcher_SendsNewFilesOnce(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	modTime := time.Now()
+	filesystem := newMockFilesystem()
+	filesystem.MapFS["outbox"] = &fstest.MapFile{Mode: fs.ModeDir}
+	filesystem.MapFS["outbox/existing"] = &fstest.MapFile{Data: []byte("old"), ModTime: modTime}
+
+	sender := &mockWatchSender{}
+	watcher := newTestFolderWatcher(t, filesystem, sender)
+	require.NoError(t, watcher.Watch("outbox", "pubkey"))
+
+	filesystem.MapFS["outbox/new"] = &fstest.MapFile{Data: []byte("new"), ModTime: modTime}
+	filesystem.MapFS["outbox/.partial"] = &fstest.MapFile{Data: []byte("tmp"), ModTime: modTime}
+	filesystem.MapFS["outbox/subdir"] = &fstest.MapFile{Mode: fs.ModeDir}
+
+	// file is sent only once it does not change between the scans
+	watcher.Scan()
+	assert.Empty(t, sender.sent)
+	watcher.Scan()
+	assert.Equal(t, [][]string{{"outbox/new"}}, sender.sent)
+	watcher.Scan()
+	assert.Len(t, sender.sent, 1)
+
+	// modified file is sent again
+	filesystem.MapFS["outbox/new"] = &fstest.MapFile{Data: []byte("newer"), ModTime: modTime.Add(time.Second)}
+	watcher.Scan()
+	watcher.Scan()
+	assert.Equal(t, [][]string{{"outbox/new"}, {"outbox/new"}}, sender.sent)
+
+	folders := watcher.List()
+	require.Len(t, folders, 1)
+	assert.Equal(t, uint32(2), folders[0].SentFiles)
+}
+
+func TestFolderWatcher_RetriesFailedSend(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	filesystem := newMockFilesystem()
+	filesystem.MapFS["outbox"] = &fstest.MapFile{Mode: fs.ModeDir}
+
+	sender := &mockWatchSender{err: errors.New("peer is disconnected")}
+	watcher := newTestFolderWatcher(t, filesystem, sender)
+	require.NoError(t, watcher.Watch("outbox", "pubkey"))
+
+	filesystem.MapFS["outbox/file"] = &fstest.MapFile{Data: []byte("data")}
+	watcher.Scan()
+	watcher.Scan()
+	assert.Empty(t, sender.sent)
+
+	sender.err = nil
+	watcher.Scan()
+	watcher.Scan()
+	assert.Equal(t, [][]string{{"outbox/file"}}, sender.sent)
+}
+
+func TestFolderWatcher_WatchErrors(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	filesystem := newMockFilesystem()
+	filesystem.MapFS["outbox"] = &fstest.MapFile{Mode: fs.ModeDir}
+	filesystem.MapFS["file"] = &fstest.MapFile{}
+	watcher := newTestFolderWatcher(t, filesystem, &mockWatchSender{})
+
+	assert.ErrorIs(t, watcher.Watch("missing", "pubkey"), ErrFileNotFound)
+	assert.ErrorIs(t, watcher.Watch("file", "pubkey"), ErrWatchNotADirectory)
+	assert.NoError(t, watcher.Watch("outbox", "pubkey"))
+	assert.ErrorIs(t, watcher.Watch("outbox", "other"), ErrWatchAlreadyExists)
+
+	assert.NoError(t, watcher.Unwatch("outbox"))
+	assert.ErrorIs(t, watcher.Unwatch("outbox"), ErrWatchNotFound)
+	assert.Empty(t, watcher.List())
+}
+
+func TestFolderWatcher_Persistence(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	filesystem := newMockFilesystem()
+	filesystem.MapFS["outbox"] = &fstest.MapFile{Mode: fs.ModeDir}
+	filesystem.MapFS["outbox/existing"] = &fstest.MapFile{Data: []byte("old"), ModTime: time.Now()}
+	storagePath := filepath.Join(t.TempDir(), "watches.json")
+	eventManager := NewEventManager(false, nil, &mockOsInfo{}, filesystem, "")
+
+	watcher := NewFolderWatcher(storagePath, filesystem, eventManager)
+	require.NoError(t, watcher.Watch("outbox", "pubkey"))
+
+	sender := &mockWatchSender{}
+	loaded := NewFolderWatcher(storagePath, filesystem, eventManager)
+	loaded.send = sender.send
+	assert.Equal(t, watcher.List(), loaded.List())
+
+	// files handled before the restart are not sent again
+	loaded.Scan()
+	loaded.Scan()
+	assert.Empty(t, sender.sent)
+}
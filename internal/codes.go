@@ -17,6 +17,11 @@ const (
 	CodeVPNNotRunning    int64 = 2003
 	CodeUFWDisabled      int64 = 2004
 	CodeTokenInvalidated int64 = 2005
+	CodeServerSkipped    int64 = 2006
+	CodeProtocolFallback int64 = 2007
+	// CodeObfuscationFallback is sent when plain OpenVPN failed and an obfuscated
+	// server is tried instead
+	CodeObfuscationFallback int64 = 2008
 
 	// Error
 	CodeFailure      int64 = 3000
@@ -50,4 +55,7 @@ const (
 	CodeAutoConnectServerObfuscated    int64 = 3038
 	CodeTokenInvalid                   int64 = 3039
 	CodePrivateSubnetLANDiscovery      int64 = 3040
+	CodeTechnologyNotAllowed           int64 = 3041
+	CodeSubnetOverlap                  int64 = 3042
+	CodeNoDedicatedIPService           int64 = 3043
 )
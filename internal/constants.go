@@ -32,9 +32,6 @@ const (
 	// LogPath defines where logs are located if systemd isn't used
 	LogPath = "/var/log/nordvpn/"
 
-	// NordvpnGroup that can access daemon socket
-	NordvpnGroup = "nordvpn"
-
 	// DaemonSocket defines system daemon socket file location
 	DaemonSocket = RunDir + "nordvpnd.sock"
 
@@ -86,6 +83,17 @@ const (
 
 	// FileshareHistoryFile is the storage file used by libdrop
 	FileshareHistoryFile = "fileshare_history.db"
+
+	// FileshareWatchesFile stores the folders watched by nordfileshared
+	FileshareWatchesFile = "fileshare_watches.json"
+
+	// FileshareAcceptDirsFile stores the directories which nordfileshared can
+	// accept transfers into besides the default download directory
+	FileshareAcceptDirsFile = "fileshare_accept_dirs.json"
+
+	// FileshareTempDir is the private directory of the temporary files of
+	// nordfileshared
+	FileshareTempDir = "fileshare_tmp"
 )
 
 const (
@@ -176,6 +184,10 @@ func GetFilesharedLogPath(uid string) string {
 	return filepath.Join(configDir, filesharedLogFilename)
 }
 
+// NordvpnGroup that can access daemon socket. It can be changed in the daemon
+// configuration file.
+var NordvpnGroup = "nordvpn"
+
 // GetNordvpnGid returns id of group defined in NordvpnGroup
 func GetNordvpnGid() (int, error) {
 	group, err := user.LookupGroup(NordvpnGroup)
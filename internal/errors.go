@@ -15,6 +15,14 @@ var (
 	ErrTagDoesNotExist         = errors.New(TagNonexistentErrorMessage)
 	ErrGroupDoesNotExist       = errors.New(GroupNonexistentErrorMessage)
 	ErrDoubleGroup             = errors.New(DoubleGroupErrorMessage)
+	ErrFavoriteDoesNotExist    = errors.New(FavoriteNonexistentErrorMessage)
+	ErrNoFavorites             = errors.New(NoFavoritesErrorMessage)
+	ErrTechnologyNotAllowed    = errors.New(TechnologyNotAllowedErrorMessage)
+	ErrSessionLog              = errors.New(SessionLogErrorMessage)
+	ErrSameHop                 = errors.New(SameHopErrorMessage)
+	ErrProfileDoesNotExist     = errors.New(ProfileNonexistentErrorMessage)
+	ErrProfileNotApplied       = errors.New(ProfileNotAppliedErrorMessage)
+	ErrUplinkUnavailable       = errors.New(UplinkUnavailableErrorMessage)
 	// ErrAlreadyLoggedIn is returned on repeated logins
 	ErrAlreadyLoggedIn = errors.New("you are already logged in")
 	// ErrNotLoggedIn is returned when the caller is expected to be logged in
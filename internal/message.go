@@ -1,20 +1,42 @@
 package internal
 
 const (
-	ConnectSuccess    = "You are connected to %s (%s)!"
-	ReconnectSuccess  = "You have been reconnected to %s (%s)"
-	DisconnectSuccess = "You are disconnected from NordVPN."
+	ConnectSuccess     = "You are connected to %s (%s)!"
+	ReconnectSuccess   = "You have been reconnected to %s (%s)"
+	DisconnectSuccess  = "You are disconnected from NordVPN."
+	CaptivePortalFound = "Network %s requires to sign in, which is blocked by kill switch. " +
+		"Use 'nordvpn portal unlock' to open the sign-in page for a few minutes."
+	MeshnetInviteReceived = "%s invited you to join their meshnet. " +
+		"Use 'nordvpn meshnet invite accept %[1]s' or 'nordvpn meshnet invite deny %[1]s' to respond."
+	MeshnetInviteActions = "%s invited you to join their meshnet."
+	DNSRestored          = "DNS settings were changed by another program. NordVPN DNS was restored."
+	DNSLeak              = "DNS settings keep being changed by another program. " +
+		"DNS queries may leak outside of NordVPN."
+	ObfuscationFallback = "The network seems to interfere with VPN traffic. " +
+		"Switching to an obfuscated server."
+	ServerFailover = "Server %s %s. Reconnecting to %s."
 
 	ProtocolErrorMessage   = "protocol: failed to parse %s"
 	TechnologyErrorMessage = "technology: failed to parse %s"
 
 	DaemonConnRefusedErrorMessage = "Cannot reach System Daemon."
 
-	ServerUnavailableErrorMessage = "The specified server is not available at the moment or does not support your connection settings."
-	TagNonexistentErrorMessage    = "The specified server does not exist."
-	GroupNonexistentErrorMessage  = "The specified group does not exist."
-	FilterNonExistentErrorMessage = "The specified filter does not exist."
-	DoubleGroupErrorMessage       = "You cannot connect to a group and set the group option at the same time."
+	ServerUnavailableErrorMessage   = "The specified server is not available at the moment or does not support your connection settings."
+	TagNonexistentErrorMessage      = "The specified server does not exist."
+	GroupNonexistentErrorMessage    = "The specified group does not exist."
+	FilterNonExistentErrorMessage   = "The specified filter does not exist."
+	DoubleGroupErrorMessage         = "You cannot connect to a group and set the group option at the same time."
+	FavoriteNonexistentErrorMessage = "The specified favorite does not exist."
+	NoFavoritesErrorMessage         = "You have no favorites. Add one with 'nordvpn favorites add <server>'."
+	SessionLogErrorMessage          = "The connection log file cannot be created. The file must be in a directory owned by you."
+	SameHopErrorMessage             = "The multi-hop connection must go through a different server than the one you are connecting to."
+	UplinkUnavailableErrorMessage   = "The specified network interface does not exist or is down."
+	ProfileNonexistentErrorMessage  = "The specified profile does not exist."
+	ProfileNotAppliedErrorMessage   = "The settings of the profile could not be applied, so the connection was not made. " +
+		"Your previous settings are kept."
+
+	TechnologyNotAllowedErrorMessage = "The current technology is not allowed, so no server can be picked. " +
+		"Change it with 'nordvpn set technology' or allow it with 'nordvpn set allowed-technologies'."
 
 	DebugPrefix = "[Debug]"
 	// DeferPrefix is used when logging errors in deferred or cleanup code.
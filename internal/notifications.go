@@ -1,7 +1,17 @@
 package internal
 
 const (
-	NotificationConnected    = 0000
-	NotificationReconnected  = 0001
-	NotificationDisconnected = 0002
+	NotificationConnected     = 0000
+	NotificationReconnected   = 0001
+	NotificationDisconnected  = 0002
+	NotificationCaptivePortal = 0003
+	NotificationMeshnetInvite = 0004
+	NotificationDNSRestored   = 0005
+	NotificationDNSLeak       = 0006
+	// NotificationObfuscationFallback is sent when the connection switches
+	// to an obfuscated server
+	NotificationObfuscationFallback = 0007
+	// NotificationServerFailover is sent when the connection moves to an
+	// equivalent server
+	NotificationServerFailover = 0010
 )
@@ -9,7 +9,39 @@ import (
 
 // WaitSignal for app to shutdown
 func WaitSignal() {
+	waitSignal(os.Interrupt, linux.SIGTERM, linux.SIGHUP)
+}
+
+// WaitTerminationSignal for app to shutdown. Unlike WaitSignal, SIGHUP is left
+// for the app to handle with OnReloadSignal.
+func WaitTerminationSignal() {
+	waitSignal(os.Interrupt, linux.SIGTERM)
+}
+
+// WaitTerminationSignalOr returns on the termination signal or once done is
+// closed, whichever comes first
+func WaitTerminationSignalOr(done <-chan struct{}) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, linux.SIGTERM)
+	select {
+	case <-signals:
+	case <-done:
+	}
+}
+
+// OnReloadSignal calls fn on every SIGHUP received by the app
+func OnReloadSignal(fn func()) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, linux.SIGHUP)
+	go func() {
+		for range signals {
+			fn()
+		}
+	}()
+}
+
+func waitSignal(sig ...os.Signal) {
 	signals := make(chan os.Signal, 1)
-	signal.Notify(signals, os.Interrupt, linux.SIGTERM, linux.SIGHUP)
+	signal.Notify(signals, sig...)
 	<-signals
 }
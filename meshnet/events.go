@@ -6,23 +6,31 @@ import "github.com/NordSecurity/nordvpn-linux/events"
 type Publisher interface {
 	NotifyPeerUpdate([]string) error
 	NotifySelfRemoved(any) error
+	NotifyExitNodeRecovery(events.DataExitNodeRecovery) error
 }
 
 // Events allow for publishing and subscribing to meshnet related notifications
 type Events struct {
-	PeerUpdate  events.PublishSubcriber[[]string]
-	SelfRemoved events.PublishSubcriber[any]
+	PeerUpdate       events.PublishSubcriber[[]string]
+	SelfRemoved      events.PublishSubcriber[any]
+	ExitNodeRecovery events.PublishSubcriber[events.DataExitNodeRecovery]
 }
 
 func NewEvents(
 	peerUpdate events.PublishSubcriber[[]string],
 	selfRemoved events.PublishSubcriber[any],
+	exitNodeRecovery events.PublishSubcriber[events.DataExitNodeRecovery],
 ) *Events {
-	return &Events{PeerUpdate: peerUpdate, SelfRemoved: selfRemoved}
+	return &Events{
+		PeerUpdate:       peerUpdate,
+		SelfRemoved:      selfRemoved,
+		ExitNodeRecovery: exitNodeRecovery,
+	}
 }
 
-// Subscribe to PeerUpdated and SelfRemoved notifications
+// Subscribe to PeerUpdated, SelfRemoved and ExitNodeRecovery notifications
 func (e *Events) Subscribe(to Publisher) {
 	e.PeerUpdate.Subscribe(to.NotifyPeerUpdate)
 	e.SelfRemoved.Subscribe(to.NotifySelfRemoved)
+	e.ExitNodeRecovery.Subscribe(to.NotifyExitNodeRecovery)
 }
@@ -0,0 +1,300 @@
+package meshnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+)
+
+// exitNodeMaxMissedChecks is the amount of consecutive status checks in which the
+// exit node has to be unreachable for it to be considered dropped
+const exitNodeMaxMissedChecks = 3
+
+var (
+	errExitNodePeerNotFound  = errors.New("fallback peer not found")
+	errExitNodePeerNoRouting = errors.New("fallback peer does not allow routing")
+)
+
+// VPNConnector connects to a regular VPN server. It is used when the
+// meshnet peer acting as an exit node drops and the fallback is set to VPN.
+type VPNConnector interface {
+	ConnectVPN(serverTag string) error
+}
+
+// exitNode keeps track of the meshnet peer which is currently used as an
+// exit node.
+type exitNode struct {
+	mu     sync.Mutex
+	peer   *mesh.MachinePeer
+	missed int
+	down   bool
+}
+
+func (e *exitNode) set(peer mesh.MachinePeer) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.peer = &peer
+	e.missed = 0
+	e.down = false
+}
+
+func (e *exitNode) reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.peer = nil
+	e.missed = 0
+	e.down = false
+}
+
+func (e *exitNode) get() (mesh.MachinePeer, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.peer == nil {
+		return mesh.MachinePeer{}, false
+	}
+	return *e.peer, true
+}
+
+// check records the reachability of the exit node. dropped is true only for the
+// check in which the exit node got considered dropped, down stays true until the
+// exit node is set again.
+func (e *exitNode) check(reachable bool) (dropped bool, down bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.down {
+		return false, true
+	}
+	if reachable {
+		e.missed = 0
+		return false, false
+	}
+	e.missed++
+	if e.missed >= exitNodeMaxMissedChecks {
+		e.down = true
+		return true, true
+	}
+	return false, false
+}
+
+// SetExitNodeFallback configures what happens when the exit node peer drops.
+func (s *Server) SetExitNodeFallback(
+	ctx context.Context,
+	req *pb.SetExitNodeFallbackRequest,
+) (*pb.SetExitNodeFallbackResponse, error) {
+	if !s.ac.IsLoggedIn() {
+		return &pb.SetExitNodeFallbackResponse{
+			Response: &pb.SetExitNodeFallbackResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+			},
+		}, nil
+	}
+
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		s.pub.Publish(err)
+		return &pb.SetExitNodeFallbackResponse{
+			Response: &pb.SetExitNodeFallbackResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	if !cfg.Mesh {
+		return &pb.SetExitNodeFallbackResponse{
+			Response: &pb.SetExitNodeFallbackResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_ENABLED,
+			},
+		}, nil
+	}
+
+	fallback := config.ExitNodeFallback{Target: req.GetTarget()}
+	switch req.GetMode() {
+	case pb.ExitNodeFallbackMode_RECONNECT:
+		fallback = config.ExitNodeFallback{Mode: config.ExitNodeFallbackReconnect}
+	case pb.ExitNodeFallbackMode_FALLBACK_OFF:
+		fallback = config.ExitNodeFallback{Mode: config.ExitNodeFallbackOff}
+	case pb.ExitNodeFallbackMode_FALLBACK_VPN:
+		fallback.Mode = config.ExitNodeFallbackVPN
+	case pb.ExitNodeFallbackMode_FALLBACK_PEER:
+		token := cfg.TokensData[cfg.AutoConnectData.ID].Token
+		peers, err := s.reg.List(token, cfg.MeshDevice.ID)
+		if err != nil {
+			s.pub.Publish(fmt.Errorf("listing peers (@SetExitNodeFallback): %w", err))
+			return &pb.SetExitNodeFallbackResponse{
+				Response: &pb.SetExitNodeFallbackResponse_ServiceErrorCode{
+					ServiceErrorCode: pb.ServiceErrorCode_API_FAILURE,
+				},
+			}, nil
+		}
+
+		peer := s.getPeerWithIdentifier(req.GetTarget(), peers)
+		if peer == nil {
+			return &pb.SetExitNodeFallbackResponse{
+				Response: &pb.SetExitNodeFallbackResponse_UpdatePeerErrorCode{
+					UpdatePeerErrorCode: pb.UpdatePeerErrorCode_PEER_NOT_FOUND,
+				},
+			}, nil
+		}
+
+		if !peer.DoesPeerAllowRouting {
+			return &pb.SetExitNodeFallbackResponse{
+				Response: &pb.SetExitNodeFallbackResponse_ConnectErrorCode{
+					ConnectErrorCode: pb.ConnectErrorCode_PEER_DOES_NOT_ALLOW_ROUTING,
+				},
+			}, nil
+		}
+		fallback = config.ExitNodeFallback{
+			Mode:   config.ExitNodeFallbackPeer,
+			Target: peer.ID.String(),
+		}
+	}
+
+	if err := s.cm.SaveWith(func(c config.Config) config.Config {
+		c.Meshnet.ExitNodeFallback = fallback
+		return c
+	}); err != nil {
+		s.pub.Publish(err)
+		return &pb.SetExitNodeFallbackResponse{
+			Response: &pb.SetExitNodeFallbackResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	return &pb.SetExitNodeFallbackResponse{
+		Response: &pb.SetExitNodeFallbackResponse_Empty{},
+	}, nil
+}
+
+// checkExitNode detects an unreachable exit node peer and recovers the connection
+// according to the configured fallback. Networker is never stopped during the
+// recovery, so the kill switch and the rest of the firewall rules stay in place
+// until the traffic is routed again.
+func (s *Server) checkExitNode() {
+	peer, ok := s.exitNode.get()
+	if !ok {
+		return
+	}
+
+	// connection was stopped or replaced by other means
+	if !s.netw.IsVPNActive() || s.netw.LastServerName() != peer.Hostname {
+		s.exitNode.reset()
+		return
+	}
+
+	statusMap, err := s.netw.StatusMap()
+	if err != nil {
+		s.pub.Publish(fmt.Errorf("retrieving exit node status: %w", err))
+		return
+	}
+	reachable := statusMap[peer.PublicKey] == "connected"
+
+	dropped, down := s.exitNode.check(reachable)
+	if dropped {
+		s.subjectExitNode.Publish(events.DataExitNodeRecovery{
+			Type: events.ExitNodeDropped,
+			Peer: peer.Hostname,
+		})
+	}
+	if !down {
+		return
+	}
+
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		s.pub.Publish(err)
+		return
+	}
+
+	fallback := cfg.Meshnet.ExitNodeFallback
+	switch fallback.Mode {
+	case config.ExitNodeFallbackReconnect:
+		if reachable {
+			s.recoverWithPeer(cfg, peer.Hostname, peer.ID.String(), statusMap)
+		}
+	case config.ExitNodeFallbackPeer:
+		s.recoverWithPeer(cfg, peer.Hostname, fallback.Target, statusMap)
+	case config.ExitNodeFallbackVPN:
+		s.recoverWithVPN(peer.Hostname, fallback.Target)
+	case config.ExitNodeFallbackOff:
+	}
+}
+
+func (s *Server) recoverWithPeer(
+	cfg config.Config,
+	dropped string,
+	identifier string,
+	statusMap map[string]string,
+) {
+	token := cfg.TokensData[cfg.AutoConnectData.ID].Token
+	peers, err := s.reg.List(token, cfg.MeshDevice.ID)
+	if err != nil {
+		s.publishExitNodeFailure(dropped, identifier, fmt.Errorf("listing peers: %w", err))
+		return
+	}
+
+	peer := s.getPeerWithIdentifier(identifier, peers)
+	switch {
+	case peer == nil:
+		s.publishExitNodeFailure(dropped, identifier, errExitNodePeerNotFound)
+		return
+	case !peer.DoesPeerAllowRouting:
+		s.publishExitNodeFailure(dropped, peer.Hostname, errExitNodePeerNoRouting)
+		return
+	case !peer.Address.IsValid() || statusMap[peer.PublicKey] != "connected":
+		// wait for the peer to come online, next check will retry
+		return
+	}
+
+	s.subjectExitNode.Publish(events.DataExitNodeRecovery{
+		Type:   events.ExitNodeRecovering,
+		Peer:   dropped,
+		Target: peer.Hostname,
+	})
+	if err := s.connectToPeer(cfg, *peer); err != nil {
+		s.publishExitNodeFailure(dropped, peer.Hostname, err)
+		return
+	}
+	s.subjectExitNode.Publish(events.DataExitNodeRecovery{
+		Type:   events.ExitNodeRecovered,
+		Peer:   dropped,
+		Target: peer.Hostname,
+	})
+}
+
+func (s *Server) recoverWithVPN(dropped string, serverTag string) {
+	if s.vpnConnector == nil {
+		return
+	}
+
+	s.subjectExitNode.Publish(events.DataExitNodeRecovery{
+		Type:   events.ExitNodeRecovering,
+		Peer:   dropped,
+		Target: serverTag,
+	})
+	if err := s.vpnConnector.ConnectVPN(serverTag); err != nil {
+		s.publishExitNodeFailure(dropped, serverTag, err)
+		return
+	}
+	s.exitNode.reset()
+	s.subjectExitNode.Publish(events.DataExitNodeRecovery{
+		Type:   events.ExitNodeRecovered,
+		Peer:   dropped,
+		Target: serverTag,
+	})
+}
+
+func (s *Server) publishExitNodeFailure(dropped string, target string, err error) {
+	s.subjectExitNode.Publish(events.DataExitNodeRecovery{
+		Type:   events.ExitNodeRecoveryFailure,
+		Peer:   dropped,
+		Target: target,
+		Error:  err,
+	})
+}
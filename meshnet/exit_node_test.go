@@ -0,0 +1,168 @@
+package meshnet
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/events/subs"
+	"github.com/NordSecurity/nordvpn-linux/fileshare/service"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type exitNodeNetworker struct {
+	workingNetworker
+	serverName string
+	statusMap  map[string]string
+}
+
+func (n *exitNodeNetworker) StatusMap() (map[string]string, error) { return n.statusMap, nil }
+func (n *exitNodeNetworker) LastServerName() string                { return n.serverName }
+func (*exitNodeNetworker) IsVPNActive() bool                       { return true }
+
+type vpnConnectorMock struct {
+	serverTag string
+	err       error
+}
+
+func (v *vpnConnectorMock) ConnectVPN(serverTag string) error {
+	v.serverTag = serverTag
+	return v.err
+}
+
+func TestExitNode_Check(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	node := exitNode{}
+	node.set(mesh.MachinePeer{Hostname: "exit"})
+
+	for i := 1; i < exitNodeMaxMissedChecks; i++ {
+		dropped, down := node.check(false)
+		assert.False(t, dropped)
+		assert.False(t, down)
+	}
+
+	// reachable check resets the counter
+	dropped, down := node.check(true)
+	assert.False(t, dropped)
+	assert.False(t, down)
+
+	for i := 1; i < exitNodeMaxMissedChecks; i++ {
+		node.check(false)
+	}
+	dropped, down = node.check(false)
+	assert.True(t, dropped)
+	assert.True(t, down)
+
+	// dropped is reported only once
+	dropped, down = node.check(true)
+	assert.False(t, dropped)
+	assert.True(t, down)
+
+	node.reset()
+	_, ok := node.get()
+	assert.False(t, ok)
+}
+
+func TestServer_CheckExitNode(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	peer := mesh.MachinePeer{
+		ID:                   uuid.New(),
+		Hostname:             "exit.nord",
+		PublicKey:            "exit-key",
+		DoesPeerAllowRouting: true,
+	}
+
+	tests := []struct {
+		name       string
+		fallback   config.ExitNodeFallback
+		connectErr error
+		status     string
+		serverTag  string
+		events     []events.TypeExitNodeRecovery
+	}{
+		{
+			name:   "exit node reachable",
+			status: "connected",
+		},
+		{
+			name:     "fallback off",
+			fallback: config.ExitNodeFallback{Mode: config.ExitNodeFallbackOff},
+			events:   []events.TypeExitNodeRecovery{events.ExitNodeDropped},
+		},
+		{
+			name:      "fallback to vpn",
+			fallback:  config.ExitNodeFallback{Mode: config.ExitNodeFallbackVPN, Target: "lt"},
+			serverTag: "lt",
+			events: []events.TypeExitNodeRecovery{
+				events.ExitNodeDropped,
+				events.ExitNodeRecovering,
+				events.ExitNodeRecovered,
+			},
+		},
+		{
+			name:       "fallback to vpn fails",
+			fallback:   config.ExitNodeFallback{Mode: config.ExitNodeFallbackVPN},
+			connectErr: errors.New("connect failed"),
+			events: []events.TypeExitNodeRecovery{
+				events.ExitNodeDropped,
+				events.ExitNodeRecovering,
+				events.ExitNodeRecoveryFailure,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Mesh = true
+			cm.Cfg.Meshnet.ExitNodeFallback = test.fallback
+
+			netw := &exitNodeNetworker{
+				serverName: peer.Hostname,
+				statusMap:  map[string]string{peer.PublicKey: test.status},
+			}
+			connector := &vpnConnectorMock{err: test.connectErr}
+			subject := &subs.Subject[events.DataExitNodeRecovery]{}
+			var published []events.TypeExitNodeRecovery
+			subject.Subscribe(func(data events.DataExitNodeRecovery) error {
+				published = append(published, data.Type)
+				return nil
+			})
+
+			server := NewServer(
+				meshRenewChecker{},
+				cm,
+				registrationChecker{},
+				invitationsAPI{},
+				netw,
+				&mock.RegistryMock{Peers: mesh.MachinePeers{peer}},
+				&mock.DNSGetter{},
+				&subs.Subject[error]{},
+				&subs.Subject[[]string]{},
+				&subs.Subject[bool]{},
+				&subs.Subject[events.DataConnect]{},
+				subject,
+				service.NoopFileshare{},
+				&mockLimiter{},
+				connector,
+				nil,
+			)
+			server.exitNode.set(peer)
+
+			for i := 0; i < exitNodeMaxMissedChecks; i++ {
+				server.checkExitNode()
+			}
+
+			assert.Equal(t, test.events, published)
+			assert.Equal(t, test.serverTag, connector.serverTag)
+		})
+	}
+}
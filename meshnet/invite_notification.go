@@ -0,0 +1,131 @@
+package meshnet
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+
+	"github.com/google/uuid"
+)
+
+// InviteAnswer is the action chosen by the user in the invitation notification
+type InviteAnswer int
+
+const (
+	// InviteIgnored means that the notification was dismissed or timed out
+	InviteIgnored InviteAnswer = iota
+	// InviteAccepted means that the user wants to join the inviter's meshnet
+	InviteAccepted
+	// InviteDeclined means that the user rejected the invitation
+	InviteDeclined
+)
+
+// InviteNotifier shows the received meshnet invitations to the users as
+// desktop notifications with the actions to respond to them.
+type InviteNotifier interface {
+	// NotifyInvite blocks until the notification is answered or dismissed
+	NotifyInvite(email string) (InviteAnswer, error)
+}
+
+// inviteNotifications remembers the invitations which were already shown,
+// so that each of them is notified only once.
+type inviteNotifications struct {
+	mu       sync.Mutex
+	notified map[uuid.UUID]bool
+}
+
+// update returns the invitations which were not notified yet and forgets the
+// ones which are no longer pending.
+func (n *inviteNotifications) update(received map[uuid.UUID]string) map[uuid.UUID]string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.notified == nil {
+		n.notified = map[uuid.UUID]bool{}
+	}
+	for id := range n.notified {
+		if _, ok := received[id]; !ok {
+			delete(n.notified, id)
+		}
+	}
+	fresh := map[uuid.UUID]string{}
+	for id, email := range received {
+		if !n.notified[id] {
+			n.notified[id] = true
+			fresh[id] = email
+		}
+	}
+	return fresh
+}
+
+// checkInvites notifies the users about the newly received invitations
+func (s *Server) checkInvites() {
+	if s.inviteNotifier == nil || !s.ac.IsLoggedIn() {
+		return
+	}
+
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		s.pub.Publish(err)
+		return
+	}
+	if !cfg.Mesh || cfg.MeshDevice == nil {
+		return
+	}
+
+	tokenData := cfg.TokensData[cfg.AutoConnectData.ID]
+	invites, err := s.invitationAPI.Received(tokenData.Token, cfg.MeshDevice.ID)
+	if err != nil {
+		s.pub.Publish(fmt.Errorf("listing received invitations: %w", err))
+		return
+	}
+
+	received := map[uuid.UUID]string{}
+	for _, invite := range invites {
+		received[invite.ID] = invite.Email
+	}
+	for _, email := range s.invites.update(received) {
+		go s.notifyInvite(email)
+	}
+}
+
+// notifyInvite shows the invitation and responds to it with the chosen action
+func (s *Server) notifyInvite(email string) {
+	answer, err := s.inviteNotifier.NotifyInvite(email)
+	if err != nil {
+		s.pub.Publish(fmt.Errorf("notifying about invitation: %w", err))
+		return
+	}
+
+	var resp *pb.RespondToInviteResponse
+	switch answer {
+	case InviteAccepted:
+		// permissions match the defaults suggested by `nordvpn meshnet invite accept`
+		req := &pb.InviteRequest{
+			Email:                email,
+			AllowIncomingTraffic: true,
+			AllowTrafficRouting:  false,
+			AllowLocalNetwork:    true,
+			AllowFileshare:       true,
+		}
+		resp, err = s.AcceptInvite(context.Background(), req)
+	case InviteDeclined:
+		resp, err = s.DenyInvite(context.Background(), &pb.DenyInviteRequest{Email: email})
+	default:
+		return
+	}
+	if err != nil {
+		s.pub.Publish(fmt.Errorf("responding to invitation: %w", err))
+		return
+	}
+	if _, ok := resp.GetResponse().(*pb.RespondToInviteResponse_Empty); !ok {
+		log.Println(internal.WarningPrefix, "responding to invitation failed:", resp.GetResponse())
+		return
+	}
+	log.Println(internal.InfoPrefix, "invitation answered from the notification")
+}
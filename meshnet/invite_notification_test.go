@@ -0,0 +1,120 @@
+package meshnet
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/events/subs"
+	"github.com/NordSecurity/nordvpn-linux/fileshare/service"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type inviteNotifierMock struct {
+	answer InviteAnswer
+	err    error
+	emails []string
+}
+
+func (n *inviteNotifierMock) NotifyInvite(email string) (InviteAnswer, error) {
+	n.emails = append(n.emails, email)
+	return n.answer, n.err
+}
+
+type respondingInvitationsAPI struct {
+	invitationsAPI
+	accepted []uuid.UUID
+	rejected []uuid.UUID
+}
+
+func (*respondingInvitationsAPI) Received(string, uuid.UUID) (mesh.Invitations, error) {
+	return mesh.Invitations{
+		mesh.Invitation{ID: uuid.MustParse("8a3bdb1b-7ec3-4ec3-9bd5-d5a5a1d4a5d1"), Email: "inviter@nordvpn.com"},
+	}, nil
+}
+
+func (r *respondingInvitationsAPI) Accept(_ string, _ uuid.UUID, id uuid.UUID, _, _, _, _ bool) error {
+	r.accepted = append(r.accepted, id)
+	return nil
+}
+
+func (r *respondingInvitationsAPI) Reject(_ string, _ uuid.UUID, id uuid.UUID) error {
+	r.rejected = append(r.rejected, id)
+	return nil
+}
+
+func TestServer_NotifyInvite(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	inviteID := uuid.MustParse("8a3bdb1b-7ec3-4ec3-9bd5-d5a5a1d4a5d1")
+	tests := []struct {
+		name     string
+		answer   InviteAnswer
+		err      error
+		accepted []uuid.UUID
+		rejected []uuid.UUID
+	}{
+		{name: "accepted", answer: InviteAccepted, accepted: []uuid.UUID{inviteID}},
+		{name: "declined", answer: InviteDeclined, rejected: []uuid.UUID{inviteID}},
+		{name: "ignored", answer: InviteIgnored},
+		{name: "notification fails", answer: InviteAccepted, err: errors.New("no session")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Mesh = true
+			api := &respondingInvitationsAPI{}
+			notifier := &inviteNotifierMock{answer: test.answer, err: test.err}
+
+			server := NewServer(
+				meshRenewChecker{},
+				cm,
+				registrationChecker{},
+				api,
+				&workingNetworker{},
+				&mock.RegistryMock{},
+				&mock.DNSGetter{},
+				&subs.Subject[error]{},
+				&subs.Subject[[]string]{},
+				&subs.Subject[bool]{},
+				&subs.Subject[events.DataConnect]{},
+				&subs.Subject[events.DataExitNodeRecovery]{},
+				service.NoopFileshare{},
+				&mockLimiter{},
+				nil,
+				notifier,
+			)
+
+			server.notifyInvite("inviter@nordvpn.com")
+			assert.Equal(t, []string{"inviter@nordvpn.com"}, notifier.emails)
+			assert.Equal(t, test.accepted, api.accepted)
+			assert.Equal(t, test.rejected, api.rejected)
+		})
+	}
+}
+
+func TestInviteNotifications_Update(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	first := uuid.New()
+	second := uuid.New()
+	var invites inviteNotifications
+
+	assert.Equal(t,
+		map[uuid.UUID]string{first: "first@nordvpn.com"},
+		invites.update(map[uuid.UUID]string{first: "first@nordvpn.com"}))
+	assert.Equal(t,
+		map[uuid.UUID]string{second: "second@nordvpn.com"},
+		invites.update(map[uuid.UUID]string{first: "first@nordvpn.com", second: "second@nordvpn.com"}))
+	// answered invitations are forgotten
+	assert.Empty(t, invites.update(map[uuid.UUID]string{second: "second@nordvpn.com"}))
+	assert.Equal(t,
+		map[uuid.UUID]string{first: "first@nordvpn.com"},
+		invites.update(map[uuid.UUID]string{first: "first@nordvpn.com", second: "second@nordvpn.com"}))
+}
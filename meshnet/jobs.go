@@ -10,6 +10,12 @@ func (s *Server) StartJobs() {
 	if _, err := s.scheduler.Every(2).Hours().Do(JobRefreshMeshnet(s)); err != nil {
 		log.Println(internal.WarningPrefix, "starting job refresh meshnet", err)
 	}
+	if _, err := s.scheduler.Every(5).Seconds().Do(JobMonitorExitNode(s)); err != nil {
+		log.Println(internal.WarningPrefix, "starting job monitor exit node", err)
+	}
+	if _, err := s.scheduler.Every(1).Minute().Do(JobNotifyInvites(s)); err != nil {
+		log.Println(internal.WarningPrefix, "starting job notify invites", err)
+	}
 	s.scheduler.RunAll()
 	s.scheduler.StartBlocking()
 }
@@ -21,3 +27,17 @@ func JobRefreshMeshnet(s *Server) func() error {
 		return nil
 	}
 }
+
+// JobMonitorExitNode recovers the connection when the peer used as an exit node drops
+func JobMonitorExitNode(s *Server) func() {
+	return func() {
+		s.checkExitNode()
+	}
+}
+
+// JobNotifyInvites shows the received invitations as desktop notifications
+func JobNotifyInvites(s *Server) func() {
+	return func() {
+		s.checkInvites()
+	}
+}
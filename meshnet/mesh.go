@@ -25,6 +25,9 @@ type Mesh interface {
 	// StatusMap retrieves the current status map for the related
 	// meshnet peers
 	StatusMap() (map[string]string, error)
+	// PathMap retrieves the path type (direct or relay) used to reach
+	// the connected meshnet peers
+	PathMap() (map[string]string, error)
 	// NetworkChanged is called at network changes
 	NetworkChanged() error
 }
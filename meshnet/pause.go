@@ -0,0 +1,188 @@
+package meshnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+)
+
+// meshPause keeps track of the paused meshnet. While meshnet is paused, the peer
+// state is kept only in the API and the local networker changes are skipped,
+// because everything is set up from the meshnet map when meshnet is resumed.
+// Pause is not persisted, meshnet is set up again after the daemon restart.
+type meshPause struct {
+	mu    sync.Mutex
+	timer *time.Timer
+	// resumeMu prevents the timer and the user from resuming at the same time
+	resumeMu sync.Mutex
+}
+
+// start the pause or extend the current one. resume is called once the duration
+// passes.
+func (p *meshPause) start(duration time.Duration, resume func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.timer != nil {
+		p.timer.Stop()
+	}
+	p.timer = time.AfterFunc(duration, resume)
+}
+
+// stop the pause without resuming
+func (p *meshPause) stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.timer != nil {
+		p.timer.Stop()
+	}
+	p.timer = nil
+}
+
+func (p *meshPause) isPaused() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.timer != nil
+}
+
+// PauseMeshnet tears down the meshnet tunnels and firewall rules while keeping
+// meshnet enabled. Meshnet is resumed after the given duration.
+func (s *Server) PauseMeshnet(ctx context.Context, req *pb.PauseMeshnetRequest) (*pb.MeshnetResponse, error) {
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		s.pub.Publish(err)
+		return &pb.MeshnetResponse{
+			Response: &pb.MeshnetResponse_ServiceError{
+				ServiceError: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	if !cfg.Mesh {
+		return &pb.MeshnetResponse{
+			Response: &pb.MeshnetResponse_MeshnetError{
+				MeshnetError: pb.MeshnetErrorCode_NOT_ENABLED,
+			},
+		}, nil
+	}
+
+	if !s.pause.isPaused() {
+		if err := s.fileshare.Disable(cfg.Meshnet.EnabledByUID, cfg.Meshnet.EnabledByGID); err != nil {
+			s.pub.Publish(fmt.Errorf("disabling fileshare: %w", err))
+		}
+
+		// try to stop networker only if mesh peer connected before
+		if s.netw.LastServerName() == s.lastConnectedPeer {
+			if err := s.netw.Stop(); err != nil {
+				s.pub.Publish(fmt.Errorf("disconnecting: %w", err))
+			}
+		}
+		s.exitNode.reset()
+
+		if err := s.netw.UnSetMesh(); err != nil {
+			s.pub.Publish(fmt.Errorf("unsetting mesh: %w", err))
+		}
+	}
+
+	s.pause.start(time.Duration(req.GetDuration())*time.Second, func() {
+		if err := s.resumeMeshnet(); err != nil {
+			s.pub.Publish(fmt.Errorf("resuming paused meshnet: %w", err))
+		}
+	})
+
+	return &pb.MeshnetResponse{
+		Response: &pb.MeshnetResponse_Empty{},
+	}, nil
+}
+
+// ResumeMeshnet sets the paused meshnet up again
+func (s *Server) ResumeMeshnet(context.Context, *pb.Empty) (*pb.MeshnetResponse, error) {
+	if !s.pause.isPaused() {
+		return &pb.MeshnetResponse{
+			Response: &pb.MeshnetResponse_MeshnetError{
+				MeshnetError: pb.MeshnetErrorCode_NOT_PAUSED,
+			},
+		}, nil
+	}
+
+	if err := s.resumeMeshnet(); err != nil {
+		s.pub.Publish(fmt.Errorf("resuming meshnet: %w", err))
+		switch {
+		case errors.Is(err, ErrNotLoggedIn):
+			return &pb.MeshnetResponse{
+				Response: &pb.MeshnetResponse_ServiceError{
+					ServiceError: pb.ServiceErrorCode_NOT_LOGGED_IN,
+				},
+			}, nil
+		case errors.Is(err, ErrConfigLoad):
+			return &pb.MeshnetResponse{
+				Response: &pb.MeshnetResponse_ServiceError{
+					ServiceError: pb.ServiceErrorCode_CONFIG_FAILURE,
+				},
+			}, nil
+		case errors.Is(err, ErrMeshnetNotEnabled):
+			return &pb.MeshnetResponse{
+				Response: &pb.MeshnetResponse_MeshnetError{
+					MeshnetError: pb.MeshnetErrorCode_NOT_ENABLED,
+				},
+			}, nil
+		case errors.Is(err, ErrDeviceNotRegistered):
+			return &pb.MeshnetResponse{
+				Response: &pb.MeshnetResponse_MeshnetError{
+					MeshnetError: pb.MeshnetErrorCode_NOT_REGISTERED,
+				},
+			}, nil
+		default:
+			return &pb.MeshnetResponse{
+				Response: &pb.MeshnetResponse_MeshnetError{
+					MeshnetError: pb.MeshnetErrorCode_LIB_FAILURE,
+				},
+			}, nil
+		}
+	}
+
+	return &pb.MeshnetResponse{
+		Response: &pb.MeshnetResponse_Empty{},
+	}, nil
+}
+
+// resumeMeshnet sets meshnet up from the current meshnet map, so that the changes
+// made to the peers during the pause are applied. Meshnet stays paused if it fails.
+func (s *Server) resumeMeshnet() error {
+	s.pause.resumeMu.Lock()
+	defer s.pause.resumeMu.Unlock()
+	if !s.pause.isPaused() {
+		return nil
+	}
+
+	if err := s.StartMeshnet(); err != nil {
+		if errors.Is(err, ErrMeshnetNotEnabled) {
+			// meshnet was disabled meanwhile, nothing to resume
+			s.pause.stop()
+		}
+		return err
+	}
+	s.pause.stop()
+	return nil
+}
+
+// refreshMesh applies the meshnet map unless meshnet is paused
+func (s *Server) refreshMesh(resp mesh.MachineMap) error {
+	if s.pause.isPaused() {
+		return nil
+	}
+	return s.netw.Refresh(resp)
+}
+
+// resetRouting applies the routing permissions unless meshnet is paused
+func (s *Server) resetRouting(peer mesh.MachinePeer, peers mesh.MachinePeers) error {
+	if s.pause.isPaused() {
+		return nil
+	}
+	return s.netw.ResetRouting(peer, peers)
+}
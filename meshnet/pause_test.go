@@ -0,0 +1,134 @@
+package meshnet
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMeshPause(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var pause meshPause
+	assert.False(t, pause.isPaused())
+
+	resumed := make(chan struct{})
+	pause.start(time.Hour, func() {})
+	assert.True(t, pause.isPaused())
+
+	// starting again extends the pause with the new duration
+	pause.start(time.Millisecond, func() { close(resumed) })
+	select {
+	case <-resumed:
+	case <-time.After(time.Second):
+		t.Fatal("pause was not resumed")
+	}
+
+	pause.stop()
+	assert.False(t, pause.isPaused())
+}
+
+func TestServer_PauseMeshnet(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name             string
+		isMeshOn         bool
+		expectedResponse *pb.MeshnetResponse
+		expectedPaused   bool
+	}{
+		{
+			name:     "meshnet is not enabled",
+			isMeshOn: false,
+			expectedResponse: &pb.MeshnetResponse{
+				Response: &pb.MeshnetResponse_MeshnetError{
+					MeshnetError: pb.MeshnetErrorCode_NOT_ENABLED,
+				},
+			},
+		},
+		{
+			name:             "meshnet is paused",
+			isMeshOn:         true,
+			expectedResponse: &pb.MeshnetResponse{Response: &pb.MeshnetResponse_Empty{}},
+			expectedPaused:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newMockedServer(t, nil, nil, nil, test.isMeshOn, nil)
+			defer server.pause.stop()
+
+			resp, err := server.PauseMeshnet(context.Background(), &pb.PauseMeshnetRequest{Duration: 600})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, resp)
+			assert.Equal(t, test.expectedPaused, server.pause.isPaused())
+		})
+	}
+}
+
+func TestServer_ResumeMeshnet(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	server := newMockedServer(t, nil, nil, nil, true, nil)
+
+	resp, err := server.ResumeMeshnet(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.MeshnetResponse{
+		Response: &pb.MeshnetResponse_MeshnetError{
+			MeshnetError: pb.MeshnetErrorCode_NOT_PAUSED,
+		},
+	}, resp)
+
+	_, err = server.PauseMeshnet(context.Background(), &pb.PauseMeshnetRequest{Duration: 600})
+	assert.NoError(t, err)
+	assert.True(t, server.pause.isPaused())
+
+	resp, err = server.ResumeMeshnet(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.MeshnetResponse{Response: &pb.MeshnetResponse_Empty{}}, resp)
+	assert.False(t, server.pause.isPaused())
+}
+
+func TestServer_PausedMeshnetPeers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	peers := []mesh.MachinePeer{
+		{
+			Hostname:  "test0-everest.nord",
+			PublicKey: "sfB1pvE4RavTwF6oAQlNbJpVp2RqEmEcB6YyoD4tYWG=",
+			Address:   netip.MustParseAddr("172.17.0.1"),
+			IsLocal:   true,
+		},
+		{
+			Hostname:  "test1-everest.nord",
+			PublicKey: "PsDbpNdCOLWsuvbnvGRrXQ2XBp6PGFvgvgMeStJvxk8=",
+			Address:   netip.MustParseAddr("192.17.30.5"),
+		},
+	}
+	server := newMockedServer(t, nil, nil, nil, true, peers)
+	defer server.pause.stop()
+
+	_, err := server.PauseMeshnet(context.Background(), &pb.PauseMeshnetRequest{Duration: 600})
+	assert.NoError(t, err)
+
+	resp, err := server.GetPeers(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.GetPeersResponse_Peers{}, resp.Response)
+	assert.Len(t, resp.GetPeers().External, 1)
+	for _, peer := range append(resp.GetPeers().Local, resp.GetPeers().External...) {
+		assert.Equal(t, pb.PeerStatus_PAUSED, peer.Status)
+	}
+
+	connResp, err := server.Connect(context.Background(), &pb.UpdatePeerRequest{Identifier: "test1-everest.nord"})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.ConnectResponse{Response: &pb.ConnectResponse_MeshnetErrorCode{
+		MeshnetErrorCode: pb.MeshnetErrorCode_MESHNET_PAUSED,
+	}}, connResp)
+}
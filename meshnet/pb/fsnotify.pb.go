@@ -201,6 +201,173 @@ func (*NotifyNewTransferResponse_ServiceErrorCode) isNotifyNewTransferResponse_R
 
 func (*NotifyNewTransferResponse_MeshnetErrorCode) isNotifyNewTransferResponse_Response() {}
 
+// SetFileshareRateLimitRequest limits the fileshare traffic to the given amount of
+// bytes per second in each direction, 0 removes the limit
+type SetFileshareRateLimitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerIp string `protobuf:"bytes,1,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"` // Limit applies to all the peers without their own limit when empty
+	Limit  uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SetFileshareRateLimitRequest) Reset() {
+	*x = SetFileshareRateLimitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fsnotify_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFileshareRateLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFileshareRateLimitRequest) ProtoMessage() {}
+
+func (x *SetFileshareRateLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fsnotify_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFileshareRateLimitRequest.ProtoReflect.Descriptor instead.
+func (*SetFileshareRateLimitRequest) Descriptor() ([]byte, []int) {
+	return file_fsnotify_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetFileshareRateLimitRequest) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *SetFileshareRateLimitRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SetFileshareRateLimitResponse defines a response of setting the fileshare rate limit
+type SetFileshareRateLimitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*SetFileshareRateLimitResponse_Empty
+	//	*SetFileshareRateLimitResponse_UpdatePeerErrorCode
+	//	*SetFileshareRateLimitResponse_ServiceErrorCode
+	//	*SetFileshareRateLimitResponse_MeshnetErrorCode
+	Response isSetFileshareRateLimitResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *SetFileshareRateLimitResponse) Reset() {
+	*x = SetFileshareRateLimitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fsnotify_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFileshareRateLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFileshareRateLimitResponse) ProtoMessage() {}
+
+func (x *SetFileshareRateLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fsnotify_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFileshareRateLimitResponse.ProtoReflect.Descriptor instead.
+func (*SetFileshareRateLimitResponse) Descriptor() ([]byte, []int) {
+	return file_fsnotify_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *SetFileshareRateLimitResponse) GetResponse() isSetFileshareRateLimitResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *SetFileshareRateLimitResponse) GetEmpty() *Empty {
+	if x, ok := x.GetResponse().(*SetFileshareRateLimitResponse_Empty); ok {
+		return x.Empty
+	}
+	return nil
+}
+
+func (x *SetFileshareRateLimitResponse) GetUpdatePeerErrorCode() UpdatePeerErrorCode {
+	if x, ok := x.GetResponse().(*SetFileshareRateLimitResponse_UpdatePeerErrorCode); ok {
+		return x.UpdatePeerErrorCode
+	}
+	return UpdatePeerErrorCode_PEER_NOT_FOUND
+}
+
+func (x *SetFileshareRateLimitResponse) GetServiceErrorCode() ServiceErrorCode {
+	if x, ok := x.GetResponse().(*SetFileshareRateLimitResponse_ServiceErrorCode); ok {
+		return x.ServiceErrorCode
+	}
+	return ServiceErrorCode_NOT_LOGGED_IN
+}
+
+func (x *SetFileshareRateLimitResponse) GetMeshnetErrorCode() MeshnetErrorCode {
+	if x, ok := x.GetResponse().(*SetFileshareRateLimitResponse_MeshnetErrorCode); ok {
+		return x.MeshnetErrorCode
+	}
+	return MeshnetErrorCode_NOT_REGISTERED
+}
+
+type isSetFileshareRateLimitResponse_Response interface {
+	isSetFileshareRateLimitResponse_Response()
+}
+
+type SetFileshareRateLimitResponse_Empty struct {
+	Empty *Empty `protobuf:"bytes,1,opt,name=empty,proto3,oneof"`
+}
+
+type SetFileshareRateLimitResponse_UpdatePeerErrorCode struct {
+	UpdatePeerErrorCode UpdatePeerErrorCode `protobuf:"varint,2,opt,name=update_peer_error_code,json=updatePeerErrorCode,proto3,enum=meshpb.UpdatePeerErrorCode,oneof"`
+}
+
+type SetFileshareRateLimitResponse_ServiceErrorCode struct {
+	ServiceErrorCode ServiceErrorCode `protobuf:"varint,3,opt,name=service_error_code,json=serviceErrorCode,proto3,enum=meshpb.ServiceErrorCode,oneof"`
+}
+
+type SetFileshareRateLimitResponse_MeshnetErrorCode struct {
+	MeshnetErrorCode MeshnetErrorCode `protobuf:"varint,4,opt,name=meshnet_error_code,json=meshnetErrorCode,proto3,enum=meshpb.MeshnetErrorCode,oneof"`
+}
+
+func (*SetFileshareRateLimitResponse_Empty) isSetFileshareRateLimitResponse_Response() {}
+
+func (*SetFileshareRateLimitResponse_UpdatePeerErrorCode) isSetFileshareRateLimitResponse_Response() {
+}
+
+func (*SetFileshareRateLimitResponse_ServiceErrorCode) isSetFileshareRateLimitResponse_Response() {}
+
+func (*SetFileshareRateLimitResponse_MeshnetErrorCode) isSetFileshareRateLimitResponse_Response() {}
+
 var File_fsnotify_proto protoreflect.FileDescriptor
 
 var file_fsnotify_proto_rawDesc = []byte{
@@ -236,9 +403,36 @@ var file_fsnotify_proto_rawDesc = []byte{
 	0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65,
 	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65,
 	0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x14, 0x5a, 0x12, 0x6e, 0x6f,
-	0x72, 0x64, 0x76, 0x70, 0x6e, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x2f, 0x70, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x0a, 0x1c, 0x53, 0x65,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65,
+	0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65,
+	0x72, 0x49, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xba, 0x02, 0x0a, 0x1d, 0x53, 0x65,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48,
+	0x00, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f,
+	0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -253,25 +447,31 @@ func file_fsnotify_proto_rawDescGZIP() []byte {
 	return file_fsnotify_proto_rawDescData
 }
 
-var file_fsnotify_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_fsnotify_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_fsnotify_proto_goTypes = []interface{}{
-	(*NewTransferNotification)(nil),   // 0: meshpb.NewTransferNotification
-	(*NotifyNewTransferResponse)(nil), // 1: meshpb.NotifyNewTransferResponse
-	(*Empty)(nil),                     // 2: meshpb.Empty
-	(UpdatePeerErrorCode)(0),          // 3: meshpb.UpdatePeerErrorCode
-	(ServiceErrorCode)(0),             // 4: meshpb.ServiceErrorCode
-	(MeshnetErrorCode)(0),             // 5: meshpb.MeshnetErrorCode
+	(*NewTransferNotification)(nil),       // 0: meshpb.NewTransferNotification
+	(*NotifyNewTransferResponse)(nil),     // 1: meshpb.NotifyNewTransferResponse
+	(*SetFileshareRateLimitRequest)(nil),  // 2: meshpb.SetFileshareRateLimitRequest
+	(*SetFileshareRateLimitResponse)(nil), // 3: meshpb.SetFileshareRateLimitResponse
+	(*Empty)(nil),                         // 4: meshpb.Empty
+	(UpdatePeerErrorCode)(0),              // 5: meshpb.UpdatePeerErrorCode
+	(ServiceErrorCode)(0),                 // 6: meshpb.ServiceErrorCode
+	(MeshnetErrorCode)(0),                 // 7: meshpb.MeshnetErrorCode
 }
 var file_fsnotify_proto_depIdxs = []int32{
-	2, // 0: meshpb.NotifyNewTransferResponse.empty:type_name -> meshpb.Empty
-	3, // 1: meshpb.NotifyNewTransferResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
-	4, // 2: meshpb.NotifyNewTransferResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	5, // 3: meshpb.NotifyNewTransferResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	4, // 0: meshpb.NotifyNewTransferResponse.empty:type_name -> meshpb.Empty
+	5, // 1: meshpb.NotifyNewTransferResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	6, // 2: meshpb.NotifyNewTransferResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	7, // 3: meshpb.NotifyNewTransferResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	4, // 4: meshpb.SetFileshareRateLimitResponse.empty:type_name -> meshpb.Empty
+	5, // 5: meshpb.SetFileshareRateLimitResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	6, // 6: meshpb.SetFileshareRateLimitResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	7, // 7: meshpb.SetFileshareRateLimitResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_fsnotify_proto_init() }
@@ -307,6 +507,30 @@ func file_fsnotify_proto_init() {
 				return nil
 			}
 		}
+		file_fsnotify_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFileshareRateLimitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fsnotify_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFileshareRateLimitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_fsnotify_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*NotifyNewTransferResponse_Empty)(nil),
@@ -314,13 +538,19 @@ func file_fsnotify_proto_init() {
 		(*NotifyNewTransferResponse_ServiceErrorCode)(nil),
 		(*NotifyNewTransferResponse_MeshnetErrorCode)(nil),
 	}
+	file_fsnotify_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*SetFileshareRateLimitResponse_Empty)(nil),
+		(*SetFileshareRateLimitResponse_UpdatePeerErrorCode)(nil),
+		(*SetFileshareRateLimitResponse_ServiceErrorCode)(nil),
+		(*SetFileshareRateLimitResponse_MeshnetErrorCode)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_fsnotify_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,147 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: pause.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PauseMeshnetRequest defines for how long meshnet is paused
+type PauseMeshnetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// duration in seconds after which meshnet is resumed automatically
+	Duration uint32 `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *PauseMeshnetRequest) Reset() {
+	*x = PauseMeshnetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pause_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseMeshnetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseMeshnetRequest) ProtoMessage() {}
+
+func (x *PauseMeshnetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pause_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseMeshnetRequest.ProtoReflect.Descriptor instead.
+func (*PauseMeshnetRequest) Descriptor() ([]byte, []int) {
+	return file_pause_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PauseMeshnetRequest) GetDuration() uint32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+var File_pause_proto protoreflect.FileDescriptor
+
+var file_pause_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x70, 0x61, 0x75, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6d,
+	0x65, 0x73, 0x68, 0x70, 0x62, 0x22, 0x31, 0x0a, 0x13, 0x50, 0x61, 0x75, 0x73, 0x65, 0x4d, 0x65,
+	0x73, 0x68, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75,
+	0x78, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pause_proto_rawDescOnce sync.Once
+	file_pause_proto_rawDescData = file_pause_proto_rawDesc
+)
+
+func file_pause_proto_rawDescGZIP() []byte {
+	file_pause_proto_rawDescOnce.Do(func() {
+		file_pause_proto_rawDescData = protoimpl.X.CompressGZIP(file_pause_proto_rawDescData)
+	})
+	return file_pause_proto_rawDescData
+}
+
+var file_pause_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pause_proto_goTypes = []interface{}{
+	(*PauseMeshnetRequest)(nil), // 0: meshpb.PauseMeshnetRequest
+}
+var file_pause_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_pause_proto_init() }
+func file_pause_proto_init() {
+	if File_pause_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pause_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseMeshnetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pause_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pause_proto_goTypes,
+		DependencyIndexes: file_pause_proto_depIdxs,
+		MessageInfos:      file_pause_proto_msgTypes,
+	}.Build()
+	File_pause_proto = out.File
+	file_pause_proto_rawDesc = nil
+	file_pause_proto_goTypes = nil
+	file_pause_proto_depIdxs = nil
+}
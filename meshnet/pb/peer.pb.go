@@ -26,6 +26,7 @@ type PeerStatus int32
 const (
 	PeerStatus_DISCONNECTED PeerStatus = 0
 	PeerStatus_CONNECTED    PeerStatus = 1
+	PeerStatus_PAUSED       PeerStatus = 2
 )
 
 // Enum value maps for PeerStatus.
@@ -33,10 +34,12 @@ var (
 	PeerStatus_name = map[int32]string{
 		0: "DISCONNECTED",
 		1: "CONNECTED",
+		2: "PAUSED",
 	}
 	PeerStatus_value = map[string]int32{
 		"DISCONNECTED": 0,
 		"CONNECTED":    1,
+		"PAUSED":       2,
 	}
 )
 
@@ -677,6 +680,109 @@ func (ConnectErrorCode) EnumDescriptor() ([]byte, []int) {
 	return file_peer_proto_rawDescGZIP(), []int{13}
 }
 
+// ExitNodeFallbackMode defines how to recover when the exit node peer drops
+type ExitNodeFallbackMode int32
+
+const (
+	ExitNodeFallbackMode_RECONNECT     ExitNodeFallbackMode = 0
+	ExitNodeFallbackMode_FALLBACK_PEER ExitNodeFallbackMode = 1
+	ExitNodeFallbackMode_FALLBACK_VPN  ExitNodeFallbackMode = 2
+	ExitNodeFallbackMode_FALLBACK_OFF  ExitNodeFallbackMode = 3
+)
+
+// Enum value maps for ExitNodeFallbackMode.
+var (
+	ExitNodeFallbackMode_name = map[int32]string{
+		0: "RECONNECT",
+		1: "FALLBACK_PEER",
+		2: "FALLBACK_VPN",
+		3: "FALLBACK_OFF",
+	}
+	ExitNodeFallbackMode_value = map[string]int32{
+		"RECONNECT":     0,
+		"FALLBACK_PEER": 1,
+		"FALLBACK_VPN":  2,
+		"FALLBACK_OFF":  3,
+	}
+)
+
+func (x ExitNodeFallbackMode) Enum() *ExitNodeFallbackMode {
+	p := new(ExitNodeFallbackMode)
+	*p = x
+	return p
+}
+
+func (x ExitNodeFallbackMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExitNodeFallbackMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_peer_proto_enumTypes[14].Descriptor()
+}
+
+func (ExitNodeFallbackMode) Type() protoreflect.EnumType {
+	return &file_peer_proto_enumTypes[14]
+}
+
+func (x ExitNodeFallbackMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExitNodeFallbackMode.Descriptor instead.
+func (ExitNodeFallbackMode) EnumDescriptor() ([]byte, []int) {
+	return file_peer_proto_rawDescGZIP(), []int{14}
+}
+
+// PeerPath defines how the traffic reaches the peer
+type PeerPath int32
+
+const (
+	PeerPath_PATH_UNKNOWN PeerPath = 0
+	PeerPath_PATH_DIRECT  PeerPath = 1
+	PeerPath_PATH_RELAY   PeerPath = 2
+)
+
+// Enum value maps for PeerPath.
+var (
+	PeerPath_name = map[int32]string{
+		0: "PATH_UNKNOWN",
+		1: "PATH_DIRECT",
+		2: "PATH_RELAY",
+	}
+	PeerPath_value = map[string]int32{
+		"PATH_UNKNOWN": 0,
+		"PATH_DIRECT":  1,
+		"PATH_RELAY":   2,
+	}
+)
+
+func (x PeerPath) Enum() *PeerPath {
+	p := new(PeerPath)
+	*p = x
+	return p
+}
+
+func (x PeerPath) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeerPath) Descriptor() protoreflect.EnumDescriptor {
+	return file_peer_proto_enumTypes[15].Descriptor()
+}
+
+func (PeerPath) Type() protoreflect.EnumType {
+	return &file_peer_proto_enumTypes[15]
+}
+
+func (x PeerPath) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeerPath.Descriptor instead.
+func (PeerPath) EnumDescriptor() ([]byte, []int) {
+	return file_peer_proto_rawDescGZIP(), []int{15}
+}
+
 // GetPeersResponse defines
 type GetPeersResponse struct {
 	state         protoimpl.MessageState
@@ -2798,6 +2904,444 @@ func (*ConnectResponse_ServiceErrorCode) isConnectResponse_Response() {}
 
 func (*ConnectResponse_MeshnetErrorCode) isConnectResponse_Response() {}
 
+// SetExitNodeFallbackRequest defines a request to configure exit node recovery.
+// target is a peer identifier for FALLBACK_PEER or an optional server tag for
+// FALLBACK_VPN
+type SetExitNodeFallbackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode   ExitNodeFallbackMode `protobuf:"varint,1,opt,name=mode,proto3,enum=meshpb.ExitNodeFallbackMode" json:"mode,omitempty"`
+	Target string               `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *SetExitNodeFallbackRequest) Reset() {
+	*x = SetExitNodeFallbackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetExitNodeFallbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExitNodeFallbackRequest) ProtoMessage() {}
+
+func (x *SetExitNodeFallbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExitNodeFallbackRequest.ProtoReflect.Descriptor instead.
+func (*SetExitNodeFallbackRequest) Descriptor() ([]byte, []int) {
+	return file_peer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetExitNodeFallbackRequest) GetMode() ExitNodeFallbackMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ExitNodeFallbackMode_RECONNECT
+}
+
+func (x *SetExitNodeFallbackRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type SetExitNodeFallbackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*SetExitNodeFallbackResponse_Empty
+	//	*SetExitNodeFallbackResponse_UpdatePeerErrorCode
+	//	*SetExitNodeFallbackResponse_ConnectErrorCode
+	//	*SetExitNodeFallbackResponse_ServiceErrorCode
+	//	*SetExitNodeFallbackResponse_MeshnetErrorCode
+	Response isSetExitNodeFallbackResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *SetExitNodeFallbackResponse) Reset() {
+	*x = SetExitNodeFallbackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetExitNodeFallbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExitNodeFallbackResponse) ProtoMessage() {}
+
+func (x *SetExitNodeFallbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExitNodeFallbackResponse.ProtoReflect.Descriptor instead.
+func (*SetExitNodeFallbackResponse) Descriptor() ([]byte, []int) {
+	return file_peer_proto_rawDescGZIP(), []int{20}
+}
+
+func (m *SetExitNodeFallbackResponse) GetResponse() isSetExitNodeFallbackResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *SetExitNodeFallbackResponse) GetEmpty() *Empty {
+	if x, ok := x.GetResponse().(*SetExitNodeFallbackResponse_Empty); ok {
+		return x.Empty
+	}
+	return nil
+}
+
+func (x *SetExitNodeFallbackResponse) GetUpdatePeerErrorCode() UpdatePeerErrorCode {
+	if x, ok := x.GetResponse().(*SetExitNodeFallbackResponse_UpdatePeerErrorCode); ok {
+		return x.UpdatePeerErrorCode
+	}
+	return UpdatePeerErrorCode_PEER_NOT_FOUND
+}
+
+func (x *SetExitNodeFallbackResponse) GetConnectErrorCode() ConnectErrorCode {
+	if x, ok := x.GetResponse().(*SetExitNodeFallbackResponse_ConnectErrorCode); ok {
+		return x.ConnectErrorCode
+	}
+	return ConnectErrorCode_PEER_DOES_NOT_ALLOW_ROUTING
+}
+
+func (x *SetExitNodeFallbackResponse) GetServiceErrorCode() ServiceErrorCode {
+	if x, ok := x.GetResponse().(*SetExitNodeFallbackResponse_ServiceErrorCode); ok {
+		return x.ServiceErrorCode
+	}
+	return ServiceErrorCode_NOT_LOGGED_IN
+}
+
+func (x *SetExitNodeFallbackResponse) GetMeshnetErrorCode() MeshnetErrorCode {
+	if x, ok := x.GetResponse().(*SetExitNodeFallbackResponse_MeshnetErrorCode); ok {
+		return x.MeshnetErrorCode
+	}
+	return MeshnetErrorCode_NOT_REGISTERED
+}
+
+type isSetExitNodeFallbackResponse_Response interface {
+	isSetExitNodeFallbackResponse_Response()
+}
+
+type SetExitNodeFallbackResponse_Empty struct {
+	Empty *Empty `protobuf:"bytes,1,opt,name=empty,proto3,oneof"`
+}
+
+type SetExitNodeFallbackResponse_UpdatePeerErrorCode struct {
+	UpdatePeerErrorCode UpdatePeerErrorCode `protobuf:"varint,2,opt,name=update_peer_error_code,json=updatePeerErrorCode,proto3,enum=meshpb.UpdatePeerErrorCode,oneof"`
+}
+
+type SetExitNodeFallbackResponse_ConnectErrorCode struct {
+	ConnectErrorCode ConnectErrorCode `protobuf:"varint,3,opt,name=connect_error_code,json=connectErrorCode,proto3,enum=meshpb.ConnectErrorCode,oneof"`
+}
+
+type SetExitNodeFallbackResponse_ServiceErrorCode struct {
+	ServiceErrorCode ServiceErrorCode `protobuf:"varint,4,opt,name=service_error_code,json=serviceErrorCode,proto3,enum=meshpb.ServiceErrorCode,oneof"`
+}
+
+type SetExitNodeFallbackResponse_MeshnetErrorCode struct {
+	MeshnetErrorCode MeshnetErrorCode `protobuf:"varint,5,opt,name=meshnet_error_code,json=meshnetErrorCode,proto3,enum=meshpb.MeshnetErrorCode,oneof"`
+}
+
+func (*SetExitNodeFallbackResponse_Empty) isSetExitNodeFallbackResponse_Response() {}
+
+func (*SetExitNodeFallbackResponse_UpdatePeerErrorCode) isSetExitNodeFallbackResponse_Response() {}
+
+func (*SetExitNodeFallbackResponse_ConnectErrorCode) isSetExitNodeFallbackResponse_Response() {}
+
+func (*SetExitNodeFallbackResponse_ServiceErrorCode) isSetExitNodeFallbackResponse_Response() {}
+
+func (*SetExitNodeFallbackResponse_MeshnetErrorCode) isSetExitNodeFallbackResponse_Response() {}
+
+// PeerReachability defines the result of probing a single peer
+type PeerReachability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname  string     `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Nickname  string     `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Ip        string     `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	Status    PeerStatus `protobuf:"varint,4,opt,name=status,proto3,enum=meshpb.PeerStatus" json:"status,omitempty"`
+	Reachable bool       `protobuf:"varint,5,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	Path      PeerPath   `protobuf:"varint,6,opt,name=path,proto3,enum=meshpb.PeerPath" json:"path,omitempty"`
+	// rtt_ms is the round trip time of the probe in milliseconds
+	RttMs uint32 `protobuf:"varint,7,opt,name=rtt_ms,json=rttMs,proto3" json:"rtt_ms,omitempty"`
+	// error describes why the probe failed
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *PeerReachability) Reset() {
+	*x = PeerReachability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerReachability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerReachability) ProtoMessage() {}
+
+func (x *PeerReachability) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerReachability.ProtoReflect.Descriptor instead.
+func (*PeerReachability) Descriptor() ([]byte, []int) {
+	return file_peer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PeerReachability) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *PeerReachability) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *PeerReachability) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *PeerReachability) GetStatus() PeerStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PeerStatus_DISCONNECTED
+}
+
+func (x *PeerReachability) GetReachable() bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return false
+}
+
+func (x *PeerReachability) GetPath() PeerPath {
+	if x != nil {
+		return x.Path
+	}
+	return PeerPath_PATH_UNKNOWN
+}
+
+func (x *PeerReachability) GetRttMs() uint32 {
+	if x != nil {
+		return x.RttMs
+	}
+	return 0
+}
+
+func (x *PeerReachability) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// PeerReachabilityList defines reachability of the peers from this device
+type PeerReachabilityList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname string              `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Peers    []*PeerReachability `protobuf:"bytes,2,rep,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (x *PeerReachabilityList) Reset() {
+	*x = PeerReachabilityList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerReachabilityList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerReachabilityList) ProtoMessage() {}
+
+func (x *PeerReachabilityList) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerReachabilityList.ProtoReflect.Descriptor instead.
+func (*PeerReachabilityList) Descriptor() ([]byte, []int) {
+	return file_peer_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PeerReachabilityList) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *PeerReachabilityList) GetPeers() []*PeerReachability {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+type GetPeerReachabilityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*GetPeerReachabilityResponse_Reachability
+	//	*GetPeerReachabilityResponse_ServiceErrorCode
+	//	*GetPeerReachabilityResponse_MeshnetErrorCode
+	Response isGetPeerReachabilityResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *GetPeerReachabilityResponse) Reset() {
+	*x = GetPeerReachabilityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeerReachabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerReachabilityResponse) ProtoMessage() {}
+
+func (x *GetPeerReachabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerReachabilityResponse.ProtoReflect.Descriptor instead.
+func (*GetPeerReachabilityResponse) Descriptor() ([]byte, []int) {
+	return file_peer_proto_rawDescGZIP(), []int{23}
+}
+
+func (m *GetPeerReachabilityResponse) GetResponse() isGetPeerReachabilityResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GetPeerReachabilityResponse) GetReachability() *PeerReachabilityList {
+	if x, ok := x.GetResponse().(*GetPeerReachabilityResponse_Reachability); ok {
+		return x.Reachability
+	}
+	return nil
+}
+
+func (x *GetPeerReachabilityResponse) GetServiceErrorCode() ServiceErrorCode {
+	if x, ok := x.GetResponse().(*GetPeerReachabilityResponse_ServiceErrorCode); ok {
+		return x.ServiceErrorCode
+	}
+	return ServiceErrorCode_NOT_LOGGED_IN
+}
+
+func (x *GetPeerReachabilityResponse) GetMeshnetErrorCode() MeshnetErrorCode {
+	if x, ok := x.GetResponse().(*GetPeerReachabilityResponse_MeshnetErrorCode); ok {
+		return x.MeshnetErrorCode
+	}
+	return MeshnetErrorCode_NOT_REGISTERED
+}
+
+type isGetPeerReachabilityResponse_Response interface {
+	isGetPeerReachabilityResponse_Response()
+}
+
+type GetPeerReachabilityResponse_Reachability struct {
+	Reachability *PeerReachabilityList `protobuf:"bytes,1,opt,name=reachability,proto3,oneof"`
+}
+
+type GetPeerReachabilityResponse_ServiceErrorCode struct {
+	ServiceErrorCode ServiceErrorCode `protobuf:"varint,2,opt,name=service_error_code,json=serviceErrorCode,proto3,enum=meshpb.ServiceErrorCode,oneof"`
+}
+
+type GetPeerReachabilityResponse_MeshnetErrorCode struct {
+	MeshnetErrorCode MeshnetErrorCode `protobuf:"varint,3,opt,name=meshnet_error_code,json=meshnetErrorCode,proto3,enum=meshpb.MeshnetErrorCode,oneof"`
+}
+
+func (*GetPeerReachabilityResponse_Reachability) isGetPeerReachabilityResponse_Response() {}
+
+func (*GetPeerReachabilityResponse_ServiceErrorCode) isGetPeerReachabilityResponse_Response() {}
+
+func (*GetPeerReachabilityResponse_MeshnetErrorCode) isGetPeerReachabilityResponse_Response() {}
+
 type PrivateKeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2813,7 +3357,7 @@ type PrivateKeyResponse struct {
 func (x *PrivateKeyResponse) Reset() {
 	*x = PrivateKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peer_proto_msgTypes[19]
+		mi := &file_peer_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2826,7 +3370,7 @@ func (x *PrivateKeyResponse) String() string {
 func (*PrivateKeyResponse) ProtoMessage() {}
 
 func (x *PrivateKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_peer_proto_msgTypes[19]
+	mi := &file_peer_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2839,7 +3383,7 @@ func (x *PrivateKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrivateKeyResponse.ProtoReflect.Descriptor instead.
 func (*PrivateKeyResponse) Descriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{19}
+	return file_peer_proto_rawDescGZIP(), []int{24}
 }
 
 func (m *PrivateKeyResponse) GetResponse() isPrivateKeyResponse_Response {
@@ -3290,88 +3834,168 @@ var file_peer_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73,
 	0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
 	0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x01,
-	0x0a, 0x12, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
-	0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x2d, 0x0a,
-	0x0a, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x0c, 0x44,
-	0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a,
-	0x09, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x29, 0x0a, 0x13,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
-	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x00, 0x2a, 0x98, 0x02, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x41, 0x4d, 0x45, 0x5f, 0x4e, 0x49, 0x43, 0x4b,
-	0x4e, 0x41, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4e, 0x49, 0x43, 0x4b, 0x4e, 0x41,
-	0x4d, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59,
-	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x5f, 0x4e, 0x41, 0x4d,
-	0x45, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x41,
-	0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x48, 0x10, 0x03,
-	0x12, 0x15, 0x0a, 0x11, 0x4e, 0x49, 0x43, 0x4b, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f,
-	0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x55, 0x50, 0x4c, 0x49,
-	0x43, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x49, 0x43, 0x4b, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x05, 0x12,
-	0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x42,
-	0x49, 0x44, 0x44, 0x45, 0x4e, 0x5f, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x06, 0x12, 0x20, 0x0a, 0x1c,
-	0x53, 0x55, 0x46, 0x46, 0x49, 0x58, 0x5f, 0x4f, 0x52, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x49, 0x58,
-	0x5f, 0x41, 0x52, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x07, 0x12, 0x1f,
-	0x0a, 0x1b, 0x4e, 0x49, 0x43, 0x4b, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x48, 0x41, 0x53, 0x5f, 0x44,
-	0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f, 0x48, 0x59, 0x50, 0x48, 0x45, 0x4e, 0x53, 0x10, 0x08, 0x12,
-	0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x53,
-	0x10, 0x09, 0x2a, 0x34, 0x0a, 0x15, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x6f, 0x75, 0x74, 0x69,
-	0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x52,
-	0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x41,
-	0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x32, 0x0a, 0x14, 0x44, 0x65, 0x6e, 0x79,
-	0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x1a, 0x0a, 0x16, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x4c, 0x52, 0x45,
-	0x41, 0x44, 0x59, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x36, 0x0a, 0x16,
-	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x49,
-	0x4e, 0x47, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57,
-	0x45, 0x44, 0x10, 0x00, 0x2a, 0x34, 0x0a, 0x15, 0x44, 0x65, 0x6e, 0x79, 0x49, 0x6e, 0x63, 0x6f,
-	0x6d, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a,
-	0x17, 0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44,
-	0x59, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x3f, 0x0a, 0x1a, 0x41, 0x6c,
-	0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x4f, 0x43, 0x41,
-	0x4c, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44,
-	0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x3d, 0x0a, 0x19, 0x44,
-	0x65, 0x6e, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x4f, 0x43, 0x41,
-	0x4c, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44,
-	0x59, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x33, 0x0a, 0x17, 0x41, 0x6c,
-	0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x41, 0x4c,
+	0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0x0a,
+	0x1a, 0x53, 0x65, 0x74, 0x45, 0x78, 0x69, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x46, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x70, 0x62, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x46, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x82, 0x03, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x45, 0x78, 0x69,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x16,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x48, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65,
+	0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf7, 0x01, 0x0a, 0x10, 0x50,
+	0x65, 0x65, 0x72, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x10, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x50, 0x61, 0x74,
+	0x68, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x74, 0x74, 0x5f, 0x6d,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x74, 0x74, 0x4d, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x62, 0x0a, 0x14, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x61, 0x63,
+	0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x81, 0x02, 0x0a, 0x1b, 0x47, 0x65, 0x74,
+	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x63,
+	0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x61, 0x63,
+	0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c,
+	0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x48, 0x0a, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65,
+	0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68,
+	0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10,
+	0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x01, 0x0a,
+	0x12, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x39, 0x0a, 0x0a,
+	0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x49,
+	0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
+	0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x50,
+	0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x29, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12,
+	0x0a, 0x0e, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x00, 0x2a, 0x98, 0x02, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x69, 0x63,
+	0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x11,
+	0x0a, 0x0d, 0x53, 0x41, 0x4d, 0x45, 0x5f, 0x4e, 0x49, 0x43, 0x4b, 0x4e, 0x41, 0x4d, 0x45, 0x10,
+	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4e, 0x49, 0x43, 0x4b, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x41, 0x4c,
+	0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x01, 0x12, 0x16, 0x0a,
+	0x12, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x45, 0x58, 0x49,
+	0x53, 0x54, 0x53, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49,
+	0x4d, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x48, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x4e,
+	0x49, 0x43, 0x4b, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47,
+	0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f,
+	0x4e, 0x49, 0x43, 0x4b, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f,
+	0x4e, 0x54, 0x41, 0x49, 0x4e, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e,
+	0x5f, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x06, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x55, 0x46, 0x46, 0x49,
+	0x58, 0x5f, 0x4f, 0x52, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x49, 0x58, 0x5f, 0x41, 0x52, 0x45, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x07, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x49, 0x43,
+	0x4b, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x48, 0x41, 0x53, 0x5f, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45,
+	0x5f, 0x48, 0x59, 0x50, 0x48, 0x45, 0x4e, 0x53, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x53, 0x10, 0x09, 0x2a, 0x34, 0x0a,
+	0x15, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e,
+	0x47, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45,
+	0x44, 0x10, 0x00, 0x2a, 0x32, 0x0a, 0x14, 0x44, 0x65, 0x6e, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x52,
+	0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x44,
+	0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x36, 0x0a, 0x16, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x4c,
 	0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x00, 0x2a,
-	0x31, 0x0a, 0x16, 0x44, 0x65, 0x6e, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45, 0x4e,
-	0x44, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x2a, 0x4c, 0x0a, 0x21, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f,
-	0x6d, 0x61, 0x74, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x23, 0x41, 0x55, 0x54, 0x4f, 0x4d,
-	0x41, 0x54, 0x49, 0x43, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x41,
-	0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x00,
-	0x2a, 0x4e, 0x0a, 0x22, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d,
-	0x61, 0x74, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x24, 0x41, 0x55, 0x54, 0x4f, 0x4d, 0x41,
-	0x54, 0x49, 0x43, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x41, 0x4c,
-	0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x00,
-	0x2a, 0x6e, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x44, 0x4f, 0x45,
-	0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x5f, 0x52, 0x4f, 0x55, 0x54,
-	0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59,
-	0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e,
-	0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02,
-	0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x5f, 0x49, 0x50, 0x10, 0x03,
-	0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e,
-	0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64,
-	0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65,
-	0x74, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x34, 0x0a, 0x15, 0x44, 0x65, 0x6e, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x43, 0x4f,
+	0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x44, 0x45, 0x4e,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x3f, 0x0a, 0x1a, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x4e, 0x45, 0x54,
+	0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x41, 0x4c, 0x4c,
+	0x4f, 0x57, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x3d, 0x0a, 0x19, 0x44, 0x65, 0x6e, 0x79, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x4e, 0x45, 0x54,
+	0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x44, 0x45, 0x4e,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x33, 0x0a, 0x17, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59,
+	0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x31, 0x0a, 0x16, 0x44, 0x65,
+	0x6e, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x41, 0x4c, 0x52,
+	0x45, 0x41, 0x44, 0x59, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x4c, 0x0a,
+	0x21, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x27, 0x0a, 0x23, 0x41, 0x55, 0x54, 0x4f, 0x4d, 0x41, 0x54, 0x49, 0x43, 0x5f,
+	0x46, 0x49, 0x4c, 0x45, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44,
+	0x59, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x4e, 0x0a, 0x22, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x28, 0x0a, 0x24, 0x41, 0x55, 0x54, 0x4f, 0x4d, 0x41, 0x54, 0x49, 0x43, 0x5f, 0x46,
+	0x49, 0x4c, 0x45, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59,
+	0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x6e, 0x0a, 0x10, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x1f, 0x0a, 0x1b, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x00,
+	0x12, 0x15, 0x0a, 0x11, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x4e,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x4e, 0x45,
+	0x43, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x50,
+	0x45, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x5f, 0x49, 0x50, 0x10, 0x03, 0x2a, 0x5c, 0x0a, 0x14, 0x45,
+	0x78, 0x69, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
+	0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x50,
+	0x45, 0x45, 0x52, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43,
+	0x4b, 0x5f, 0x56, 0x50, 0x4e, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x41, 0x4c, 0x4c, 0x42,
+	0x41, 0x43, 0x4b, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x03, 0x2a, 0x3d, 0x0a, 0x08, 0x50, 0x65, 0x65,
+	0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x41, 0x54, 0x48, 0x5f,
+	0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x41, 0x54, 0x48,
+	0x5f, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x10, 0x02, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75,
+	0x78, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3386,8 +4010,8 @@ func file_peer_proto_rawDescGZIP() []byte {
 	return file_peer_proto_rawDescData
 }
 
-var file_peer_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
-var file_peer_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_peer_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
+var file_peer_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_peer_proto_goTypes = []interface{}{
 	(PeerStatus)(0),                           // 0: meshpb.PeerStatus
 	(UpdatePeerErrorCode)(0),                  // 1: meshpb.UpdatePeerErrorCode
@@ -3403,108 +4027,127 @@ var file_peer_proto_goTypes = []interface{}{
 	(EnableAutomaticFileshareErrorCode)(0),    // 11: meshpb.EnableAutomaticFileshareErrorCode
 	(DisableAutomaticFileshareErrorCode)(0),   // 12: meshpb.DisableAutomaticFileshareErrorCode
 	(ConnectErrorCode)(0),                     // 13: meshpb.ConnectErrorCode
-	(*GetPeersResponse)(nil),                  // 14: meshpb.GetPeersResponse
-	(*PeerList)(nil),                          // 15: meshpb.PeerList
-	(*Peer)(nil),                              // 16: meshpb.Peer
-	(*UpdatePeerRequest)(nil),                 // 17: meshpb.UpdatePeerRequest
-	(*RemovePeerResponse)(nil),                // 18: meshpb.RemovePeerResponse
-	(*ChangePeerNicknameRequest)(nil),         // 19: meshpb.ChangePeerNicknameRequest
-	(*ChangeMachineNicknameRequest)(nil),      // 20: meshpb.ChangeMachineNicknameRequest
-	(*ChangeNicknameResponse)(nil),            // 21: meshpb.ChangeNicknameResponse
-	(*AllowRoutingResponse)(nil),              // 22: meshpb.AllowRoutingResponse
-	(*DenyRoutingResponse)(nil),               // 23: meshpb.DenyRoutingResponse
-	(*AllowIncomingResponse)(nil),             // 24: meshpb.AllowIncomingResponse
-	(*DenyIncomingResponse)(nil),              // 25: meshpb.DenyIncomingResponse
-	(*AllowLocalNetworkResponse)(nil),         // 26: meshpb.AllowLocalNetworkResponse
-	(*DenyLocalNetworkResponse)(nil),          // 27: meshpb.DenyLocalNetworkResponse
-	(*AllowFileshareResponse)(nil),            // 28: meshpb.AllowFileshareResponse
-	(*DenyFileshareResponse)(nil),             // 29: meshpb.DenyFileshareResponse
-	(*EnableAutomaticFileshareResponse)(nil),  // 30: meshpb.EnableAutomaticFileshareResponse
-	(*DisableAutomaticFileshareResponse)(nil), // 31: meshpb.DisableAutomaticFileshareResponse
-	(*ConnectResponse)(nil),                   // 32: meshpb.ConnectResponse
-	(*PrivateKeyResponse)(nil),                // 33: meshpb.PrivateKeyResponse
-	(ServiceErrorCode)(0),                     // 34: meshpb.ServiceErrorCode
-	(MeshnetErrorCode)(0),                     // 35: meshpb.MeshnetErrorCode
-	(*Empty)(nil),                             // 36: meshpb.Empty
+	(ExitNodeFallbackMode)(0),                 // 14: meshpb.ExitNodeFallbackMode
+	(PeerPath)(0),                             // 15: meshpb.PeerPath
+	(*GetPeersResponse)(nil),                  // 16: meshpb.GetPeersResponse
+	(*PeerList)(nil),                          // 17: meshpb.PeerList
+	(*Peer)(nil),                              // 18: meshpb.Peer
+	(*UpdatePeerRequest)(nil),                 // 19: meshpb.UpdatePeerRequest
+	(*RemovePeerResponse)(nil),                // 20: meshpb.RemovePeerResponse
+	(*ChangePeerNicknameRequest)(nil),         // 21: meshpb.ChangePeerNicknameRequest
+	(*ChangeMachineNicknameRequest)(nil),      // 22: meshpb.ChangeMachineNicknameRequest
+	(*ChangeNicknameResponse)(nil),            // 23: meshpb.ChangeNicknameResponse
+	(*AllowRoutingResponse)(nil),              // 24: meshpb.AllowRoutingResponse
+	(*DenyRoutingResponse)(nil),               // 25: meshpb.DenyRoutingResponse
+	(*AllowIncomingResponse)(nil),             // 26: meshpb.AllowIncomingResponse
+	(*DenyIncomingResponse)(nil),              // 27: meshpb.DenyIncomingResponse
+	(*AllowLocalNetworkResponse)(nil),         // 28: meshpb.AllowLocalNetworkResponse
+	(*DenyLocalNetworkResponse)(nil),          // 29: meshpb.DenyLocalNetworkResponse
+	(*AllowFileshareResponse)(nil),            // 30: meshpb.AllowFileshareResponse
+	(*DenyFileshareResponse)(nil),             // 31: meshpb.DenyFileshareResponse
+	(*EnableAutomaticFileshareResponse)(nil),  // 32: meshpb.EnableAutomaticFileshareResponse
+	(*DisableAutomaticFileshareResponse)(nil), // 33: meshpb.DisableAutomaticFileshareResponse
+	(*ConnectResponse)(nil),                   // 34: meshpb.ConnectResponse
+	(*SetExitNodeFallbackRequest)(nil),        // 35: meshpb.SetExitNodeFallbackRequest
+	(*SetExitNodeFallbackResponse)(nil),       // 36: meshpb.SetExitNodeFallbackResponse
+	(*PeerReachability)(nil),                  // 37: meshpb.PeerReachability
+	(*PeerReachabilityList)(nil),              // 38: meshpb.PeerReachabilityList
+	(*GetPeerReachabilityResponse)(nil),       // 39: meshpb.GetPeerReachabilityResponse
+	(*PrivateKeyResponse)(nil),                // 40: meshpb.PrivateKeyResponse
+	(ServiceErrorCode)(0),                     // 41: meshpb.ServiceErrorCode
+	(MeshnetErrorCode)(0),                     // 42: meshpb.MeshnetErrorCode
+	(*Empty)(nil),                             // 43: meshpb.Empty
 }
 var file_peer_proto_depIdxs = []int32{
-	15, // 0: meshpb.GetPeersResponse.peers:type_name -> meshpb.PeerList
-	34, // 1: meshpb.GetPeersResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 2: meshpb.GetPeersResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	16, // 3: meshpb.PeerList.self:type_name -> meshpb.Peer
-	16, // 4: meshpb.PeerList.local:type_name -> meshpb.Peer
-	16, // 5: meshpb.PeerList.external:type_name -> meshpb.Peer
+	17, // 0: meshpb.GetPeersResponse.peers:type_name -> meshpb.PeerList
+	41, // 1: meshpb.GetPeersResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	42, // 2: meshpb.GetPeersResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	18, // 3: meshpb.PeerList.self:type_name -> meshpb.Peer
+	18, // 4: meshpb.PeerList.local:type_name -> meshpb.Peer
+	18, // 5: meshpb.PeerList.external:type_name -> meshpb.Peer
 	0,  // 6: meshpb.Peer.status:type_name -> meshpb.PeerStatus
-	36, // 7: meshpb.RemovePeerResponse.empty:type_name -> meshpb.Empty
+	43, // 7: meshpb.RemovePeerResponse.empty:type_name -> meshpb.Empty
 	1,  // 8: meshpb.RemovePeerResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
-	34, // 9: meshpb.RemovePeerResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 10: meshpb.RemovePeerResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 11: meshpb.ChangeNicknameResponse.empty:type_name -> meshpb.Empty
+	41, // 9: meshpb.RemovePeerResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	42, // 10: meshpb.RemovePeerResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	43, // 11: meshpb.ChangeNicknameResponse.empty:type_name -> meshpb.Empty
 	1,  // 12: meshpb.ChangeNicknameResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
-	34, // 13: meshpb.ChangeNicknameResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 14: meshpb.ChangeNicknameResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	41, // 13: meshpb.ChangeNicknameResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	42, // 14: meshpb.ChangeNicknameResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
 	2,  // 15: meshpb.ChangeNicknameResponse.change_nickname_error_code:type_name -> meshpb.ChangeNicknameErrorCode
-	36, // 16: meshpb.AllowRoutingResponse.empty:type_name -> meshpb.Empty
+	43, // 16: meshpb.AllowRoutingResponse.empty:type_name -> meshpb.Empty
 	1,  // 17: meshpb.AllowRoutingResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
 	3,  // 18: meshpb.AllowRoutingResponse.allow_routing_error_code:type_name -> meshpb.AllowRoutingErrorCode
-	34, // 19: meshpb.AllowRoutingResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 20: meshpb.AllowRoutingResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 21: meshpb.DenyRoutingResponse.empty:type_name -> meshpb.Empty
+	41, // 19: meshpb.AllowRoutingResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	42, // 20: meshpb.AllowRoutingResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	43, // 21: meshpb.DenyRoutingResponse.empty:type_name -> meshpb.Empty
 	1,  // 22: meshpb.DenyRoutingResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
 	4,  // 23: meshpb.DenyRoutingResponse.deny_routing_error_code:type_name -> meshpb.DenyRoutingErrorCode
-	34, // 24: meshpb.DenyRoutingResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 25: meshpb.DenyRoutingResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 26: meshpb.AllowIncomingResponse.empty:type_name -> meshpb.Empty
+	41, // 24: meshpb.DenyRoutingResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	42, // 25: meshpb.DenyRoutingResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	43, // 26: meshpb.AllowIncomingResponse.empty:type_name -> meshpb.Empty
 	1,  // 27: meshpb.AllowIncomingResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
 	5,  // 28: meshpb.AllowIncomingResponse.allow_incoming_error_code:type_name -> meshpb.AllowIncomingErrorCode
-	34, // 29: meshpb.AllowIncomingResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 30: meshpb.AllowIncomingResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 31: meshpb.DenyIncomingResponse.empty:type_name -> meshpb.Empty
+	41, // 29: meshpb.AllowIncomingResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	42, // 30: meshpb.AllowIncomingResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	43, // 31: meshpb.DenyIncomingResponse.empty:type_name -> meshpb.Empty
 	1,  // 32: meshpb.DenyIncomingResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
 	6,  // 33: meshpb.DenyIncomingResponse.deny_incoming_error_code:type_name -> meshpb.DenyIncomingErrorCode
-	34, // 34: meshpb.DenyIncomingResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 35: meshpb.DenyIncomingResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 36: meshpb.AllowLocalNetworkResponse.empty:type_name -> meshpb.Empty
+	41, // 34: meshpb.DenyIncomingResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	42, // 35: meshpb.DenyIncomingResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	43, // 36: meshpb.AllowLocalNetworkResponse.empty:type_name -> meshpb.Empty
 	1,  // 37: meshpb.AllowLocalNetworkResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
 	7,  // 38: meshpb.AllowLocalNetworkResponse.allow_local_network_error_code:type_name -> meshpb.AllowLocalNetworkErrorCode
-	34, // 39: meshpb.AllowLocalNetworkResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 40: meshpb.AllowLocalNetworkResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 41: meshpb.DenyLocalNetworkResponse.empty:type_name -> meshpb.Empty
+	41, // 39: meshpb.AllowLocalNetworkResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	42, // 40: meshpb.AllowLocalNetworkResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	43, // 41: meshpb.DenyLocalNetworkResponse.empty:type_name -> meshpb.Empty
 	1,  // 42: meshpb.DenyLocalNetworkResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
 	8,  // 43: meshpb.DenyLocalNetworkResponse.deny_local_network_error_code:type_name -> meshpb.DenyLocalNetworkErrorCode
-	34, // 44: meshpb.DenyLocalNetworkResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 45: meshpb.DenyLocalNetworkResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 46: meshpb.AllowFileshareResponse.empty:type_name -> meshpb.Empty
+	41, // 44: meshpb.DenyLocalNetworkResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	42, // 45: meshpb.DenyLocalNetworkResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	43, // 46: meshpb.AllowFileshareResponse.empty:type_name -> meshpb.Empty
 	1,  // 47: meshpb.AllowFileshareResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
 	9,  // 48: meshpb.AllowFileshareResponse.allow_send_error_code:type_name -> meshpb.AllowFileshareErrorCode
-	34, // 49: meshpb.AllowFileshareResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 50: meshpb.AllowFileshareResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 51: meshpb.DenyFileshareResponse.empty:type_name -> meshpb.Empty
+	41, // 49: meshpb.AllowFileshareResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	42, // 50: meshpb.AllowFileshareResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	43, // 51: meshpb.DenyFileshareResponse.empty:type_name -> meshpb.Empty
 	1,  // 52: meshpb.DenyFileshareResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
 	10, // 53: meshpb.DenyFileshareResponse.deny_send_error_code:type_name -> meshpb.DenyFileshareErrorCode
-	34, // 54: meshpb.DenyFileshareResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 55: meshpb.DenyFileshareResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 56: meshpb.EnableAutomaticFileshareResponse.empty:type_name -> meshpb.Empty
+	41, // 54: meshpb.DenyFileshareResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	42, // 55: meshpb.DenyFileshareResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	43, // 56: meshpb.EnableAutomaticFileshareResponse.empty:type_name -> meshpb.Empty
 	1,  // 57: meshpb.EnableAutomaticFileshareResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
 	11, // 58: meshpb.EnableAutomaticFileshareResponse.enable_automatic_fileshare_error_code:type_name -> meshpb.EnableAutomaticFileshareErrorCode
-	34, // 59: meshpb.EnableAutomaticFileshareResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 60: meshpb.EnableAutomaticFileshareResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 61: meshpb.DisableAutomaticFileshareResponse.empty:type_name -> meshpb.Empty
+	41, // 59: meshpb.EnableAutomaticFileshareResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	42, // 60: meshpb.EnableAutomaticFileshareResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	43, // 61: meshpb.DisableAutomaticFileshareResponse.empty:type_name -> meshpb.Empty
 	1,  // 62: meshpb.DisableAutomaticFileshareResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
 	12, // 63: meshpb.DisableAutomaticFileshareResponse.disable_automatic_fileshare_error_code:type_name -> meshpb.DisableAutomaticFileshareErrorCode
-	34, // 64: meshpb.DisableAutomaticFileshareResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 65: meshpb.DisableAutomaticFileshareResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 66: meshpb.ConnectResponse.empty:type_name -> meshpb.Empty
+	41, // 64: meshpb.DisableAutomaticFileshareResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	42, // 65: meshpb.DisableAutomaticFileshareResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	43, // 66: meshpb.ConnectResponse.empty:type_name -> meshpb.Empty
 	1,  // 67: meshpb.ConnectResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
 	13, // 68: meshpb.ConnectResponse.connect_error_code:type_name -> meshpb.ConnectErrorCode
-	34, // 69: meshpb.ConnectResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 70: meshpb.ConnectResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	34, // 71: meshpb.PrivateKeyResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	72, // [72:72] is the sub-list for method output_type
-	72, // [72:72] is the sub-list for method input_type
-	72, // [72:72] is the sub-list for extension type_name
-	72, // [72:72] is the sub-list for extension extendee
-	0,  // [0:72] is the sub-list for field type_name
+	41, // 69: meshpb.ConnectResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	42, // 70: meshpb.ConnectResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	14, // 71: meshpb.SetExitNodeFallbackRequest.mode:type_name -> meshpb.ExitNodeFallbackMode
+	43, // 72: meshpb.SetExitNodeFallbackResponse.empty:type_name -> meshpb.Empty
+	1,  // 73: meshpb.SetExitNodeFallbackResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	13, // 74: meshpb.SetExitNodeFallbackResponse.connect_error_code:type_name -> meshpb.ConnectErrorCode
+	41, // 75: meshpb.SetExitNodeFallbackResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	42, // 76: meshpb.SetExitNodeFallbackResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	0,  // 77: meshpb.PeerReachability.status:type_name -> meshpb.PeerStatus
+	15, // 78: meshpb.PeerReachability.path:type_name -> meshpb.PeerPath
+	37, // 79: meshpb.PeerReachabilityList.peers:type_name -> meshpb.PeerReachability
+	38, // 80: meshpb.GetPeerReachabilityResponse.reachability:type_name -> meshpb.PeerReachabilityList
+	41, // 81: meshpb.GetPeerReachabilityResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	42, // 82: meshpb.GetPeerReachabilityResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	41, // 83: meshpb.PrivateKeyResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	84, // [84:84] is the sub-list for method output_type
+	84, // [84:84] is the sub-list for method input_type
+	84, // [84:84] is the sub-list for extension type_name
+	84, // [84:84] is the sub-list for extension extendee
+	0,  // [0:84] is the sub-list for field type_name
 }
 
 func init() { file_peer_proto_init() }
@@ -3744,6 +4387,66 @@ func file_peer_proto_init() {
 			}
 		}
 		file_peer_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetExitNodeFallbackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peer_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetExitNodeFallbackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peer_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerReachability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peer_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerReachabilityList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peer_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerReachabilityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peer_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PrivateKeyResponse); i {
 			case 0:
 				return &v.state
@@ -3851,7 +4554,19 @@ func file_peer_proto_init() {
 		(*ConnectResponse_ServiceErrorCode)(nil),
 		(*ConnectResponse_MeshnetErrorCode)(nil),
 	}
-	file_peer_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_peer_proto_msgTypes[20].OneofWrappers = []interface{}{
+		(*SetExitNodeFallbackResponse_Empty)(nil),
+		(*SetExitNodeFallbackResponse_UpdatePeerErrorCode)(nil),
+		(*SetExitNodeFallbackResponse_ConnectErrorCode)(nil),
+		(*SetExitNodeFallbackResponse_ServiceErrorCode)(nil),
+		(*SetExitNodeFallbackResponse_MeshnetErrorCode)(nil),
+	}
+	file_peer_proto_msgTypes[23].OneofWrappers = []interface{}{
+		(*GetPeerReachabilityResponse_Reachability)(nil),
+		(*GetPeerReachabilityResponse_ServiceErrorCode)(nil),
+		(*GetPeerReachabilityResponse_MeshnetErrorCode)(nil),
+	}
+	file_peer_proto_msgTypes[24].OneofWrappers = []interface{}{
 		(*PrivateKeyResponse_PrivateKey)(nil),
 		(*PrivateKeyResponse_ServiceErrorCode)(nil),
 	}
@@ -3860,8 +4575,8 @@ func file_peer_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_peer_proto_rawDesc,
-			NumEnums:      14,
-			NumMessages:   20,
+			NumEnums:      16,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,1235 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: peer_group.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PeerGroupErrorCode defines the errors of managing the groups
+type PeerGroupErrorCode int32
+
+const (
+	PeerGroupErrorCode_GROUP_NOT_FOUND       PeerGroupErrorCode = 0
+	PeerGroupErrorCode_GROUP_ALREADY_EXISTS  PeerGroupErrorCode = 1
+	PeerGroupErrorCode_INVALID_GROUP_NAME    PeerGroupErrorCode = 2
+	PeerGroupErrorCode_PEER_ALREADY_IN_GROUP PeerGroupErrorCode = 3
+	PeerGroupErrorCode_PEER_NOT_IN_GROUP     PeerGroupErrorCode = 4
+	PeerGroupErrorCode_GROUP_EMPTY           PeerGroupErrorCode = 5
+)
+
+// Enum value maps for PeerGroupErrorCode.
+var (
+	PeerGroupErrorCode_name = map[int32]string{
+		0: "GROUP_NOT_FOUND",
+		1: "GROUP_ALREADY_EXISTS",
+		2: "INVALID_GROUP_NAME",
+		3: "PEER_ALREADY_IN_GROUP",
+		4: "PEER_NOT_IN_GROUP",
+		5: "GROUP_EMPTY",
+	}
+	PeerGroupErrorCode_value = map[string]int32{
+		"GROUP_NOT_FOUND":       0,
+		"GROUP_ALREADY_EXISTS":  1,
+		"INVALID_GROUP_NAME":    2,
+		"PEER_ALREADY_IN_GROUP": 3,
+		"PEER_NOT_IN_GROUP":     4,
+		"GROUP_EMPTY":           5,
+	}
+)
+
+func (x PeerGroupErrorCode) Enum() *PeerGroupErrorCode {
+	p := new(PeerGroupErrorCode)
+	*p = x
+	return p
+}
+
+func (x PeerGroupErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeerGroupErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_peer_group_proto_enumTypes[0].Descriptor()
+}
+
+func (PeerGroupErrorCode) Type() protoreflect.EnumType {
+	return &file_peer_group_proto_enumTypes[0]
+}
+
+func (x PeerGroupErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeerGroupErrorCode.Descriptor instead.
+func (PeerGroupErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_peer_group_proto_rawDescGZIP(), []int{0}
+}
+
+// PeerPermission defines the permissions which can be set for the group
+type PeerPermission int32
+
+const (
+	PeerPermission_PERMISSION_INCOMING      PeerPermission = 0
+	PeerPermission_PERMISSION_ROUTING       PeerPermission = 1
+	PeerPermission_PERMISSION_LOCAL_NETWORK PeerPermission = 2
+	PeerPermission_PERMISSION_FILESHARE     PeerPermission = 3
+)
+
+// Enum value maps for PeerPermission.
+var (
+	PeerPermission_name = map[int32]string{
+		0: "PERMISSION_INCOMING",
+		1: "PERMISSION_ROUTING",
+		2: "PERMISSION_LOCAL_NETWORK",
+		3: "PERMISSION_FILESHARE",
+	}
+	PeerPermission_value = map[string]int32{
+		"PERMISSION_INCOMING":      0,
+		"PERMISSION_ROUTING":       1,
+		"PERMISSION_LOCAL_NETWORK": 2,
+		"PERMISSION_FILESHARE":     3,
+	}
+)
+
+func (x PeerPermission) Enum() *PeerPermission {
+	p := new(PeerPermission)
+	*p = x
+	return p
+}
+
+func (x PeerPermission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeerPermission) Descriptor() protoreflect.EnumDescriptor {
+	return file_peer_group_proto_enumTypes[1].Descriptor()
+}
+
+func (PeerPermission) Type() protoreflect.EnumType {
+	return &file_peer_group_proto_enumTypes[1]
+}
+
+func (x PeerPermission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeerPermission.Descriptor instead.
+func (PeerPermission) EnumDescriptor() ([]byte, []int) {
+	return file_peer_group_proto_rawDescGZIP(), []int{1}
+}
+
+// PermissionOutcome defines what happened to the permission of a single peer
+type PermissionOutcome int32
+
+const (
+	PermissionOutcome_PERMISSION_UPDATED        PermissionOutcome = 0
+	PermissionOutcome_PERMISSION_UNCHANGED      PermissionOutcome = 1
+	PermissionOutcome_PERMISSION_FAILED         PermissionOutcome = 2
+	PermissionOutcome_PERMISSION_PEER_NOT_FOUND PermissionOutcome = 3
+)
+
+// Enum value maps for PermissionOutcome.
+var (
+	PermissionOutcome_name = map[int32]string{
+		0: "PERMISSION_UPDATED",
+		1: "PERMISSION_UNCHANGED",
+		2: "PERMISSION_FAILED",
+		3: "PERMISSION_PEER_NOT_FOUND",
+	}
+	PermissionOutcome_value = map[string]int32{
+		"PERMISSION_UPDATED":        0,
+		"PERMISSION_UNCHANGED":      1,
+		"PERMISSION_FAILED":         2,
+		"PERMISSION_PEER_NOT_FOUND": 3,
+	}
+)
+
+func (x PermissionOutcome) Enum() *PermissionOutcome {
+	p := new(PermissionOutcome)
+	*p = x
+	return p
+}
+
+func (x PermissionOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PermissionOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_peer_group_proto_enumTypes[2].Descriptor()
+}
+
+func (PermissionOutcome) Type() protoreflect.EnumType {
+	return &file_peer_group_proto_enumTypes[2]
+}
+
+func (x PermissionOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PermissionOutcome.Descriptor instead.
+func (PermissionOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_peer_group_proto_rawDescGZIP(), []int{2}
+}
+
+// PeerGroup is a named set of peers whose permissions are managed together
+type PeerGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// peers are the identifiers of the peers in the group
+	Peers []string `protobuf:"bytes,2,rep,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (x *PeerGroup) Reset() {
+	*x = PeerGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_group_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerGroup) ProtoMessage() {}
+
+func (x *PeerGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_group_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerGroup.ProtoReflect.Descriptor instead.
+func (*PeerGroup) Descriptor() ([]byte, []int) {
+	return file_peer_group_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PeerGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PeerGroup) GetPeers() []string {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+// PeerGroupRequest identifies the group to create or delete
+type PeerGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *PeerGroupRequest) Reset() {
+	*x = PeerGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_group_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerGroupRequest) ProtoMessage() {}
+
+func (x *PeerGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_group_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerGroupRequest.ProtoReflect.Descriptor instead.
+func (*PeerGroupRequest) Descriptor() ([]byte, []int) {
+	return file_peer_group_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PeerGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// PeerGroupMemberRequest adds the peer to the group or removes it
+type PeerGroupMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group      string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (x *PeerGroupMemberRequest) Reset() {
+	*x = PeerGroupMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_group_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerGroupMemberRequest) ProtoMessage() {}
+
+func (x *PeerGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_group_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*PeerGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_peer_group_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PeerGroupMemberRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *PeerGroupMemberRequest) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+type PeerGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*PeerGroupResponse_Empty
+	//	*PeerGroupResponse_PeerGroupErrorCode
+	//	*PeerGroupResponse_UpdatePeerErrorCode
+	//	*PeerGroupResponse_ServiceErrorCode
+	//	*PeerGroupResponse_MeshnetErrorCode
+	Response isPeerGroupResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *PeerGroupResponse) Reset() {
+	*x = PeerGroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_group_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerGroupResponse) ProtoMessage() {}
+
+func (x *PeerGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_group_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerGroupResponse.ProtoReflect.Descriptor instead.
+func (*PeerGroupResponse) Descriptor() ([]byte, []int) {
+	return file_peer_group_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *PeerGroupResponse) GetResponse() isPeerGroupResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *PeerGroupResponse) GetEmpty() *Empty {
+	if x, ok := x.GetResponse().(*PeerGroupResponse_Empty); ok {
+		return x.Empty
+	}
+	return nil
+}
+
+func (x *PeerGroupResponse) GetPeerGroupErrorCode() PeerGroupErrorCode {
+	if x, ok := x.GetResponse().(*PeerGroupResponse_PeerGroupErrorCode); ok {
+		return x.PeerGroupErrorCode
+	}
+	return PeerGroupErrorCode_GROUP_NOT_FOUND
+}
+
+func (x *PeerGroupResponse) GetUpdatePeerErrorCode() UpdatePeerErrorCode {
+	if x, ok := x.GetResponse().(*PeerGroupResponse_UpdatePeerErrorCode); ok {
+		return x.UpdatePeerErrorCode
+	}
+	return UpdatePeerErrorCode_PEER_NOT_FOUND
+}
+
+func (x *PeerGroupResponse) GetServiceErrorCode() ServiceErrorCode {
+	if x, ok := x.GetResponse().(*PeerGroupResponse_ServiceErrorCode); ok {
+		return x.ServiceErrorCode
+	}
+	return ServiceErrorCode_NOT_LOGGED_IN
+}
+
+func (x *PeerGroupResponse) GetMeshnetErrorCode() MeshnetErrorCode {
+	if x, ok := x.GetResponse().(*PeerGroupResponse_MeshnetErrorCode); ok {
+		return x.MeshnetErrorCode
+	}
+	return MeshnetErrorCode_NOT_REGISTERED
+}
+
+type isPeerGroupResponse_Response interface {
+	isPeerGroupResponse_Response()
+}
+
+type PeerGroupResponse_Empty struct {
+	Empty *Empty `protobuf:"bytes,1,opt,name=empty,proto3,oneof"`
+}
+
+type PeerGroupResponse_PeerGroupErrorCode struct {
+	PeerGroupErrorCode PeerGroupErrorCode `protobuf:"varint,2,opt,name=peer_group_error_code,json=peerGroupErrorCode,proto3,enum=meshpb.PeerGroupErrorCode,oneof"`
+}
+
+type PeerGroupResponse_UpdatePeerErrorCode struct {
+	UpdatePeerErrorCode UpdatePeerErrorCode `protobuf:"varint,3,opt,name=update_peer_error_code,json=updatePeerErrorCode,proto3,enum=meshpb.UpdatePeerErrorCode,oneof"`
+}
+
+type PeerGroupResponse_ServiceErrorCode struct {
+	ServiceErrorCode ServiceErrorCode `protobuf:"varint,4,opt,name=service_error_code,json=serviceErrorCode,proto3,enum=meshpb.ServiceErrorCode,oneof"`
+}
+
+type PeerGroupResponse_MeshnetErrorCode struct {
+	MeshnetErrorCode MeshnetErrorCode `protobuf:"varint,5,opt,name=meshnet_error_code,json=meshnetErrorCode,proto3,enum=meshpb.MeshnetErrorCode,oneof"`
+}
+
+func (*PeerGroupResponse_Empty) isPeerGroupResponse_Response() {}
+
+func (*PeerGroupResponse_PeerGroupErrorCode) isPeerGroupResponse_Response() {}
+
+func (*PeerGroupResponse_UpdatePeerErrorCode) isPeerGroupResponse_Response() {}
+
+func (*PeerGroupResponse_ServiceErrorCode) isPeerGroupResponse_Response() {}
+
+func (*PeerGroupResponse_MeshnetErrorCode) isPeerGroupResponse_Response() {}
+
+type PeerGroups struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*PeerGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *PeerGroups) Reset() {
+	*x = PeerGroups{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_group_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerGroups) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerGroups) ProtoMessage() {}
+
+func (x *PeerGroups) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_group_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerGroups.ProtoReflect.Descriptor instead.
+func (*PeerGroups) Descriptor() ([]byte, []int) {
+	return file_peer_group_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PeerGroups) GetGroups() []*PeerGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GetPeerGroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*GetPeerGroupsResponse_Groups
+	//	*GetPeerGroupsResponse_ServiceErrorCode
+	Response isGetPeerGroupsResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *GetPeerGroupsResponse) Reset() {
+	*x = GetPeerGroupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_group_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeerGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerGroupsResponse) ProtoMessage() {}
+
+func (x *GetPeerGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_group_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerGroupsResponse.ProtoReflect.Descriptor instead.
+func (*GetPeerGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_peer_group_proto_rawDescGZIP(), []int{5}
+}
+
+func (m *GetPeerGroupsResponse) GetResponse() isGetPeerGroupsResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GetPeerGroupsResponse) GetGroups() *PeerGroups {
+	if x, ok := x.GetResponse().(*GetPeerGroupsResponse_Groups); ok {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GetPeerGroupsResponse) GetServiceErrorCode() ServiceErrorCode {
+	if x, ok := x.GetResponse().(*GetPeerGroupsResponse_ServiceErrorCode); ok {
+		return x.ServiceErrorCode
+	}
+	return ServiceErrorCode_NOT_LOGGED_IN
+}
+
+type isGetPeerGroupsResponse_Response interface {
+	isGetPeerGroupsResponse_Response()
+}
+
+type GetPeerGroupsResponse_Groups struct {
+	Groups *PeerGroups `protobuf:"bytes,1,opt,name=groups,proto3,oneof"`
+}
+
+type GetPeerGroupsResponse_ServiceErrorCode struct {
+	ServiceErrorCode ServiceErrorCode `protobuf:"varint,2,opt,name=service_error_code,json=serviceErrorCode,proto3,enum=meshpb.ServiceErrorCode,oneof"`
+}
+
+func (*GetPeerGroupsResponse_Groups) isGetPeerGroupsResponse_Response() {}
+
+func (*GetPeerGroupsResponse_ServiceErrorCode) isGetPeerGroupsResponse_Response() {}
+
+// SetGroupPermissionRequest allows or denies the permission for all the
+// peers of the group
+type SetGroupPermissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group      string         `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Permission PeerPermission `protobuf:"varint,2,opt,name=permission,proto3,enum=meshpb.PeerPermission" json:"permission,omitempty"`
+	Allow      bool           `protobuf:"varint,3,opt,name=allow,proto3" json:"allow,omitempty"`
+}
+
+func (x *SetGroupPermissionRequest) Reset() {
+	*x = SetGroupPermissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_group_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupPermissionRequest) ProtoMessage() {}
+
+func (x *SetGroupPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_group_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupPermissionRequest.ProtoReflect.Descriptor instead.
+func (*SetGroupPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_peer_group_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetGroupPermissionRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *SetGroupPermissionRequest) GetPermission() PeerPermission {
+	if x != nil {
+		return x.Permission
+	}
+	return PeerPermission_PERMISSION_INCOMING
+}
+
+func (x *SetGroupPermissionRequest) GetAllow() bool {
+	if x != nil {
+		return x.Allow
+	}
+	return false
+}
+
+type PeerPermissionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// hostname is empty if the peer is no longer in the meshnet
+	Hostname string            `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Outcome  PermissionOutcome `protobuf:"varint,3,opt,name=outcome,proto3,enum=meshpb.PermissionOutcome" json:"outcome,omitempty"`
+}
+
+func (x *PeerPermissionResult) Reset() {
+	*x = PeerPermissionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_group_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerPermissionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerPermissionResult) ProtoMessage() {}
+
+func (x *PeerPermissionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_group_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerPermissionResult.ProtoReflect.Descriptor instead.
+func (*PeerPermissionResult) Descriptor() ([]byte, []int) {
+	return file_peer_group_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PeerPermissionResult) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *PeerPermissionResult) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *PeerPermissionResult) GetOutcome() PermissionOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return PermissionOutcome_PERMISSION_UPDATED
+}
+
+type GroupPermissionResults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*PeerPermissionResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *GroupPermissionResults) Reset() {
+	*x = GroupPermissionResults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_group_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupPermissionResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupPermissionResults) ProtoMessage() {}
+
+func (x *GroupPermissionResults) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_group_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupPermissionResults.ProtoReflect.Descriptor instead.
+func (*GroupPermissionResults) Descriptor() ([]byte, []int) {
+	return file_peer_group_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GroupPermissionResults) GetResults() []*PeerPermissionResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type SetGroupPermissionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*SetGroupPermissionResponse_Results
+	//	*SetGroupPermissionResponse_PeerGroupErrorCode
+	//	*SetGroupPermissionResponse_ServiceErrorCode
+	//	*SetGroupPermissionResponse_MeshnetErrorCode
+	Response isSetGroupPermissionResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *SetGroupPermissionResponse) Reset() {
+	*x = SetGroupPermissionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_group_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupPermissionResponse) ProtoMessage() {}
+
+func (x *SetGroupPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_group_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupPermissionResponse.ProtoReflect.Descriptor instead.
+func (*SetGroupPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_peer_group_proto_rawDescGZIP(), []int{9}
+}
+
+func (m *SetGroupPermissionResponse) GetResponse() isSetGroupPermissionResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *SetGroupPermissionResponse) GetResults() *GroupPermissionResults {
+	if x, ok := x.GetResponse().(*SetGroupPermissionResponse_Results); ok {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SetGroupPermissionResponse) GetPeerGroupErrorCode() PeerGroupErrorCode {
+	if x, ok := x.GetResponse().(*SetGroupPermissionResponse_PeerGroupErrorCode); ok {
+		return x.PeerGroupErrorCode
+	}
+	return PeerGroupErrorCode_GROUP_NOT_FOUND
+}
+
+func (x *SetGroupPermissionResponse) GetServiceErrorCode() ServiceErrorCode {
+	if x, ok := x.GetResponse().(*SetGroupPermissionResponse_ServiceErrorCode); ok {
+		return x.ServiceErrorCode
+	}
+	return ServiceErrorCode_NOT_LOGGED_IN
+}
+
+func (x *SetGroupPermissionResponse) GetMeshnetErrorCode() MeshnetErrorCode {
+	if x, ok := x.GetResponse().(*SetGroupPermissionResponse_MeshnetErrorCode); ok {
+		return x.MeshnetErrorCode
+	}
+	return MeshnetErrorCode_NOT_REGISTERED
+}
+
+type isSetGroupPermissionResponse_Response interface {
+	isSetGroupPermissionResponse_Response()
+}
+
+type SetGroupPermissionResponse_Results struct {
+	Results *GroupPermissionResults `protobuf:"bytes,1,opt,name=results,proto3,oneof"`
+}
+
+type SetGroupPermissionResponse_PeerGroupErrorCode struct {
+	PeerGroupErrorCode PeerGroupErrorCode `protobuf:"varint,2,opt,name=peer_group_error_code,json=peerGroupErrorCode,proto3,enum=meshpb.PeerGroupErrorCode,oneof"`
+}
+
+type SetGroupPermissionResponse_ServiceErrorCode struct {
+	ServiceErrorCode ServiceErrorCode `protobuf:"varint,3,opt,name=service_error_code,json=serviceErrorCode,proto3,enum=meshpb.ServiceErrorCode,oneof"`
+}
+
+type SetGroupPermissionResponse_MeshnetErrorCode struct {
+	MeshnetErrorCode MeshnetErrorCode `protobuf:"varint,4,opt,name=meshnet_error_code,json=meshnetErrorCode,proto3,enum=meshpb.MeshnetErrorCode,oneof"`
+}
+
+func (*SetGroupPermissionResponse_Results) isSetGroupPermissionResponse_Response() {}
+
+func (*SetGroupPermissionResponse_PeerGroupErrorCode) isSetGroupPermissionResponse_Response() {}
+
+func (*SetGroupPermissionResponse_ServiceErrorCode) isSetGroupPermissionResponse_Response() {}
+
+func (*SetGroupPermissionResponse_MeshnetErrorCode) isSetGroupPermissionResponse_Response() {}
+
+var File_peer_group_proto protoreflect.FileDescriptor
+
+var file_peer_group_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x1a, 0x0b, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x35, 0x0a, 0x09, 0x50,
+	0x65, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x22, 0x26, 0x0a, 0x10, 0x50, 0x65, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4e, 0x0a, 0x16, 0x50, 0x65,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0xff, 0x02, 0x0a, 0x11, 0x50,
+	0x65, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4f, 0x0a, 0x15, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e,
+	0x50, 0x65, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x48, 0x00, 0x52, 0x12, 0x70, 0x65, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70,
+	0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65,
+	0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68,
+	0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10,
+	0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x0a, 0x0a,
+	0x50, 0x65, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65,
+	0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x48, 0x00, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x48, 0x0a,
+	0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x7f, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x36, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x87, 0x01, 0x0a, 0x14, 0x50, 0x65, 0x65, 0x72, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x6f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x50,
+	0x0a, 0x16, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x22, 0xc9, 0x02, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x15, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x12, 0x70, 0x65, 0x65, 0x72, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65,
+	0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68,
+	0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10,
+	0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x9e, 0x01, 0x0a,
+	0x12, 0x50, 0x65, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x52, 0x4f, 0x55,
+	0x50, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53,
+	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x47, 0x52,
+	0x4f, 0x55, 0x50, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x45,
+	0x45, 0x52, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x49, 0x4e, 0x5f, 0x47, 0x52,
+	0x4f, 0x55, 0x50, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b,
+	0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x05, 0x2a, 0x79, 0x0a,
+	0x0e, 0x50, 0x65, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x17, 0x0a, 0x13, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e,
+	0x43, 0x4f, 0x4d, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x45, 0x52, 0x4d,
+	0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01,
+	0x12, 0x1c, 0x0a, 0x18, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4c,
+	0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x02, 0x12, 0x18,
+	0x0a, 0x14, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x49, 0x4c,
+	0x45, 0x53, 0x48, 0x41, 0x52, 0x45, 0x10, 0x03, 0x2a, 0x7b, 0x0a, 0x11, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x12, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x15, 0x0a, 0x11, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0x03, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6d,
+	0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_peer_group_proto_rawDescOnce sync.Once
+	file_peer_group_proto_rawDescData = file_peer_group_proto_rawDesc
+)
+
+func file_peer_group_proto_rawDescGZIP() []byte {
+	file_peer_group_proto_rawDescOnce.Do(func() {
+		file_peer_group_proto_rawDescData = protoimpl.X.CompressGZIP(file_peer_group_proto_rawDescData)
+	})
+	return file_peer_group_proto_rawDescData
+}
+
+var file_peer_group_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_peer_group_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_peer_group_proto_goTypes = []interface{}{
+	(PeerGroupErrorCode)(0),            // 0: meshpb.PeerGroupErrorCode
+	(PeerPermission)(0),                // 1: meshpb.PeerPermission
+	(PermissionOutcome)(0),             // 2: meshpb.PermissionOutcome
+	(*PeerGroup)(nil),                  // 3: meshpb.PeerGroup
+	(*PeerGroupRequest)(nil),           // 4: meshpb.PeerGroupRequest
+	(*PeerGroupMemberRequest)(nil),     // 5: meshpb.PeerGroupMemberRequest
+	(*PeerGroupResponse)(nil),          // 6: meshpb.PeerGroupResponse
+	(*PeerGroups)(nil),                 // 7: meshpb.PeerGroups
+	(*GetPeerGroupsResponse)(nil),      // 8: meshpb.GetPeerGroupsResponse
+	(*SetGroupPermissionRequest)(nil),  // 9: meshpb.SetGroupPermissionRequest
+	(*PeerPermissionResult)(nil),       // 10: meshpb.PeerPermissionResult
+	(*GroupPermissionResults)(nil),     // 11: meshpb.GroupPermissionResults
+	(*SetGroupPermissionResponse)(nil), // 12: meshpb.SetGroupPermissionResponse
+	(*Empty)(nil),                      // 13: meshpb.Empty
+	(UpdatePeerErrorCode)(0),           // 14: meshpb.UpdatePeerErrorCode
+	(ServiceErrorCode)(0),              // 15: meshpb.ServiceErrorCode
+	(MeshnetErrorCode)(0),              // 16: meshpb.MeshnetErrorCode
+}
+var file_peer_group_proto_depIdxs = []int32{
+	13, // 0: meshpb.PeerGroupResponse.empty:type_name -> meshpb.Empty
+	0,  // 1: meshpb.PeerGroupResponse.peer_group_error_code:type_name -> meshpb.PeerGroupErrorCode
+	14, // 2: meshpb.PeerGroupResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	15, // 3: meshpb.PeerGroupResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	16, // 4: meshpb.PeerGroupResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	3,  // 5: meshpb.PeerGroups.groups:type_name -> meshpb.PeerGroup
+	7,  // 6: meshpb.GetPeerGroupsResponse.groups:type_name -> meshpb.PeerGroups
+	15, // 7: meshpb.GetPeerGroupsResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	1,  // 8: meshpb.SetGroupPermissionRequest.permission:type_name -> meshpb.PeerPermission
+	2,  // 9: meshpb.PeerPermissionResult.outcome:type_name -> meshpb.PermissionOutcome
+	10, // 10: meshpb.GroupPermissionResults.results:type_name -> meshpb.PeerPermissionResult
+	11, // 11: meshpb.SetGroupPermissionResponse.results:type_name -> meshpb.GroupPermissionResults
+	0,  // 12: meshpb.SetGroupPermissionResponse.peer_group_error_code:type_name -> meshpb.PeerGroupErrorCode
+	15, // 13: meshpb.SetGroupPermissionResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	16, // 14: meshpb.SetGroupPermissionResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_peer_group_proto_init() }
+func file_peer_group_proto_init() {
+	if File_peer_group_proto != nil {
+		return
+	}
+	file_empty_proto_init()
+	file_peer_proto_init()
+	file_service_response_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_peer_group_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peer_group_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peer_group_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerGroupMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peer_group_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peer_group_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerGroups); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peer_group_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peer_group_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupPermissionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peer_group_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerPermissionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peer_group_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupPermissionResults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peer_group_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupPermissionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_peer_group_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*PeerGroupResponse_Empty)(nil),
+		(*PeerGroupResponse_PeerGroupErrorCode)(nil),
+		(*PeerGroupResponse_UpdatePeerErrorCode)(nil),
+		(*PeerGroupResponse_ServiceErrorCode)(nil),
+		(*PeerGroupResponse_MeshnetErrorCode)(nil),
+	}
+	file_peer_group_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*GetPeerGroupsResponse_Groups)(nil),
+		(*GetPeerGroupsResponse_ServiceErrorCode)(nil),
+	}
+	file_peer_group_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*SetGroupPermissionResponse_Results)(nil),
+		(*SetGroupPermissionResponse_PeerGroupErrorCode)(nil),
+		(*SetGroupPermissionResponse_ServiceErrorCode)(nil),
+		(*SetGroupPermissionResponse_MeshnetErrorCode)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_peer_group_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_peer_group_proto_goTypes,
+		DependencyIndexes: file_peer_group_proto_depIdxs,
+		EnumInfos:         file_peer_group_proto_enumTypes,
+		MessageInfos:      file_peer_group_proto_msgTypes,
+	}.Build()
+	File_peer_group_proto = out.File
+	file_peer_group_proto_rawDesc = nil
+	file_peer_group_proto_goTypes = nil
+	file_peer_group_proto_depIdxs = nil
+}
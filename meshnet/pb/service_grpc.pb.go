@@ -29,6 +29,11 @@ type MeshnetClient interface {
 	// DisableMeshnet disables the meshnet on this device
 	DisableMeshnet(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MeshnetResponse, error)
 	RefreshMeshnet(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MeshnetResponse, error)
+	// PauseMeshnet tears down the meshnet tunnels and firewall rules for a
+	// while, keeping meshnet enabled
+	PauseMeshnet(ctx context.Context, in *PauseMeshnetRequest, opts ...grpc.CallOption) (*MeshnetResponse, error)
+	// ResumeMeshnet sets the paused meshnet up again
+	ResumeMeshnet(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MeshnetResponse, error)
 	// GetInvites retrieves a list of all the invites related to
 	// this device
 	GetInvites(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetInvitesResponse, error)
@@ -76,9 +81,30 @@ type MeshnetClient interface {
 	// DisableAutomaticFileshare from peer
 	DisableAutomaticFileshare(ctx context.Context, in *UpdatePeerRequest, opts ...grpc.CallOption) (*DisableAutomaticFileshareResponse, error)
 	Connect(ctx context.Context, in *UpdatePeerRequest, opts ...grpc.CallOption) (*ConnectResponse, error)
+	// SetExitNodeFallback configures the recovery used when the peer
+	// acting as an exit node drops
+	SetExitNodeFallback(ctx context.Context, in *SetExitNodeFallbackRequest, opts ...grpc.CallOption) (*SetExitNodeFallbackResponse, error)
+	// GetPeerReachability probes the peers from this device and reports
+	// whether they are reachable and how
+	GetPeerReachability(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetPeerReachabilityResponse, error)
 	// NotifyNewTransfer notifies meshnet service about a newly created transaction so it can
 	// notify a corresponding meshnet peer
 	NotifyNewTransfer(ctx context.Context, in *NewTransferNotification, opts ...grpc.CallOption) (*NotifyNewTransferResponse, error)
+	// SetFileshareRateLimit throttles the fileshare traffic of the peers
+	SetFileshareRateLimit(ctx context.Context, in *SetFileshareRateLimitRequest, opts ...grpc.CallOption) (*SetFileshareRateLimitResponse, error)
+	// CreatePeerGroup creates an empty group of peers
+	CreatePeerGroup(ctx context.Context, in *PeerGroupRequest, opts ...grpc.CallOption) (*PeerGroupResponse, error)
+	// DeletePeerGroup deletes the group, the peers are not affected
+	DeletePeerGroup(ctx context.Context, in *PeerGroupRequest, opts ...grpc.CallOption) (*PeerGroupResponse, error)
+	// AddPeerToGroup adds the peer to the group
+	AddPeerToGroup(ctx context.Context, in *PeerGroupMemberRequest, opts ...grpc.CallOption) (*PeerGroupResponse, error)
+	// RemovePeerFromGroup removes the peer from the group
+	RemovePeerFromGroup(ctx context.Context, in *PeerGroupMemberRequest, opts ...grpc.CallOption) (*PeerGroupResponse, error)
+	// GetPeerGroups lists the groups and their peers
+	GetPeerGroups(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetPeerGroupsResponse, error)
+	// SetGroupPermission allows or denies the permission for all the peers
+	// of the group at once
+	SetGroupPermission(ctx context.Context, in *SetGroupPermissionRequest, opts ...grpc.CallOption) (*SetGroupPermissionResponse, error)
 	// GetPrivateKey is used to send self private key over to fileshare daemon
 	GetPrivateKey(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PrivateKeyResponse, error)
 }
@@ -127,6 +153,24 @@ func (c *meshnetClient) RefreshMeshnet(ctx context.Context, in *Empty, opts ...g
 	return out, nil
 }
 
+func (c *meshnetClient) PauseMeshnet(ctx context.Context, in *PauseMeshnetRequest, opts ...grpc.CallOption) (*MeshnetResponse, error) {
+	out := new(MeshnetResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/PauseMeshnet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) ResumeMeshnet(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MeshnetResponse, error) {
+	out := new(MeshnetResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/ResumeMeshnet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *meshnetClient) GetInvites(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetInvitesResponse, error) {
 	out := new(GetInvitesResponse)
 	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/GetInvites", in, out, opts...)
@@ -307,6 +351,24 @@ func (c *meshnetClient) Connect(ctx context.Context, in *UpdatePeerRequest, opts
 	return out, nil
 }
 
+func (c *meshnetClient) SetExitNodeFallback(ctx context.Context, in *SetExitNodeFallbackRequest, opts ...grpc.CallOption) (*SetExitNodeFallbackResponse, error) {
+	out := new(SetExitNodeFallbackResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/SetExitNodeFallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) GetPeerReachability(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetPeerReachabilityResponse, error) {
+	out := new(GetPeerReachabilityResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/GetPeerReachability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *meshnetClient) NotifyNewTransfer(ctx context.Context, in *NewTransferNotification, opts ...grpc.CallOption) (*NotifyNewTransferResponse, error) {
 	out := new(NotifyNewTransferResponse)
 	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/NotifyNewTransfer", in, out, opts...)
@@ -316,6 +378,69 @@ func (c *meshnetClient) NotifyNewTransfer(ctx context.Context, in *NewTransferNo
 	return out, nil
 }
 
+func (c *meshnetClient) SetFileshareRateLimit(ctx context.Context, in *SetFileshareRateLimitRequest, opts ...grpc.CallOption) (*SetFileshareRateLimitResponse, error) {
+	out := new(SetFileshareRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/SetFileshareRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) CreatePeerGroup(ctx context.Context, in *PeerGroupRequest, opts ...grpc.CallOption) (*PeerGroupResponse, error) {
+	out := new(PeerGroupResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/CreatePeerGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) DeletePeerGroup(ctx context.Context, in *PeerGroupRequest, opts ...grpc.CallOption) (*PeerGroupResponse, error) {
+	out := new(PeerGroupResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/DeletePeerGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) AddPeerToGroup(ctx context.Context, in *PeerGroupMemberRequest, opts ...grpc.CallOption) (*PeerGroupResponse, error) {
+	out := new(PeerGroupResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/AddPeerToGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) RemovePeerFromGroup(ctx context.Context, in *PeerGroupMemberRequest, opts ...grpc.CallOption) (*PeerGroupResponse, error) {
+	out := new(PeerGroupResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/RemovePeerFromGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) GetPeerGroups(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetPeerGroupsResponse, error) {
+	out := new(GetPeerGroupsResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/GetPeerGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) SetGroupPermission(ctx context.Context, in *SetGroupPermissionRequest, opts ...grpc.CallOption) (*SetGroupPermissionResponse, error) {
+	out := new(SetGroupPermissionResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/SetGroupPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *meshnetClient) GetPrivateKey(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PrivateKeyResponse, error) {
 	out := new(PrivateKeyResponse)
 	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/GetPrivateKey", in, out, opts...)
@@ -336,6 +461,11 @@ type MeshnetServer interface {
 	// DisableMeshnet disables the meshnet on this device
 	DisableMeshnet(context.Context, *Empty) (*MeshnetResponse, error)
 	RefreshMeshnet(context.Context, *Empty) (*MeshnetResponse, error)
+	// PauseMeshnet tears down the meshnet tunnels and firewall rules for a
+	// while, keeping meshnet enabled
+	PauseMeshnet(context.Context, *PauseMeshnetRequest) (*MeshnetResponse, error)
+	// ResumeMeshnet sets the paused meshnet up again
+	ResumeMeshnet(context.Context, *Empty) (*MeshnetResponse, error)
 	// GetInvites retrieves a list of all the invites related to
 	// this device
 	GetInvites(context.Context, *Empty) (*GetInvitesResponse, error)
@@ -383,9 +513,30 @@ type MeshnetServer interface {
 	// DisableAutomaticFileshare from peer
 	DisableAutomaticFileshare(context.Context, *UpdatePeerRequest) (*DisableAutomaticFileshareResponse, error)
 	Connect(context.Context, *UpdatePeerRequest) (*ConnectResponse, error)
+	// SetExitNodeFallback configures the recovery used when the peer
+	// acting as an exit node drops
+	SetExitNodeFallback(context.Context, *SetExitNodeFallbackRequest) (*SetExitNodeFallbackResponse, error)
+	// GetPeerReachability probes the peers from this device and reports
+	// whether they are reachable and how
+	GetPeerReachability(context.Context, *Empty) (*GetPeerReachabilityResponse, error)
 	// NotifyNewTransfer notifies meshnet service about a newly created transaction so it can
 	// notify a corresponding meshnet peer
 	NotifyNewTransfer(context.Context, *NewTransferNotification) (*NotifyNewTransferResponse, error)
+	// SetFileshareRateLimit throttles the fileshare traffic of the peers
+	SetFileshareRateLimit(context.Context, *SetFileshareRateLimitRequest) (*SetFileshareRateLimitResponse, error)
+	// CreatePeerGroup creates an empty group of peers
+	CreatePeerGroup(context.Context, *PeerGroupRequest) (*PeerGroupResponse, error)
+	// DeletePeerGroup deletes the group, the peers are not affected
+	DeletePeerGroup(context.Context, *PeerGroupRequest) (*PeerGroupResponse, error)
+	// AddPeerToGroup adds the peer to the group
+	AddPeerToGroup(context.Context, *PeerGroupMemberRequest) (*PeerGroupResponse, error)
+	// RemovePeerFromGroup removes the peer from the group
+	RemovePeerFromGroup(context.Context, *PeerGroupMemberRequest) (*PeerGroupResponse, error)
+	// GetPeerGroups lists the groups and their peers
+	GetPeerGroups(context.Context, *Empty) (*GetPeerGroupsResponse, error)
+	// SetGroupPermission allows or denies the permission for all the peers
+	// of the group at once
+	SetGroupPermission(context.Context, *SetGroupPermissionRequest) (*SetGroupPermissionResponse, error)
 	// GetPrivateKey is used to send self private key over to fileshare daemon
 	GetPrivateKey(context.Context, *Empty) (*PrivateKeyResponse, error)
 	mustEmbedUnimplementedMeshnetServer()
@@ -407,6 +558,12 @@ func (UnimplementedMeshnetServer) DisableMeshnet(context.Context, *Empty) (*Mesh
 func (UnimplementedMeshnetServer) RefreshMeshnet(context.Context, *Empty) (*MeshnetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshMeshnet not implemented")
 }
+func (UnimplementedMeshnetServer) PauseMeshnet(context.Context, *PauseMeshnetRequest) (*MeshnetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseMeshnet not implemented")
+}
+func (UnimplementedMeshnetServer) ResumeMeshnet(context.Context, *Empty) (*MeshnetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeMeshnet not implemented")
+}
 func (UnimplementedMeshnetServer) GetInvites(context.Context, *Empty) (*GetInvitesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInvites not implemented")
 }
@@ -467,9 +624,36 @@ func (UnimplementedMeshnetServer) DisableAutomaticFileshare(context.Context, *Up
 func (UnimplementedMeshnetServer) Connect(context.Context, *UpdatePeerRequest) (*ConnectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Connect not implemented")
 }
+func (UnimplementedMeshnetServer) SetExitNodeFallback(context.Context, *SetExitNodeFallbackRequest) (*SetExitNodeFallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExitNodeFallback not implemented")
+}
+func (UnimplementedMeshnetServer) GetPeerReachability(context.Context, *Empty) (*GetPeerReachabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerReachability not implemented")
+}
 func (UnimplementedMeshnetServer) NotifyNewTransfer(context.Context, *NewTransferNotification) (*NotifyNewTransferResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NotifyNewTransfer not implemented")
 }
+func (UnimplementedMeshnetServer) SetFileshareRateLimit(context.Context, *SetFileshareRateLimitRequest) (*SetFileshareRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFileshareRateLimit not implemented")
+}
+func (UnimplementedMeshnetServer) CreatePeerGroup(context.Context, *PeerGroupRequest) (*PeerGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePeerGroup not implemented")
+}
+func (UnimplementedMeshnetServer) DeletePeerGroup(context.Context, *PeerGroupRequest) (*PeerGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePeerGroup not implemented")
+}
+func (UnimplementedMeshnetServer) AddPeerToGroup(context.Context, *PeerGroupMemberRequest) (*PeerGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPeerToGroup not implemented")
+}
+func (UnimplementedMeshnetServer) RemovePeerFromGroup(context.Context, *PeerGroupMemberRequest) (*PeerGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePeerFromGroup not implemented")
+}
+func (UnimplementedMeshnetServer) GetPeerGroups(context.Context, *Empty) (*GetPeerGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerGroups not implemented")
+}
+func (UnimplementedMeshnetServer) SetGroupPermission(context.Context, *SetGroupPermissionRequest) (*SetGroupPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupPermission not implemented")
+}
 func (UnimplementedMeshnetServer) GetPrivateKey(context.Context, *Empty) (*PrivateKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPrivateKey not implemented")
 }
@@ -558,6 +742,42 @@ func _Meshnet_RefreshMeshnet_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Meshnet_PauseMeshnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseMeshnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).PauseMeshnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/PauseMeshnet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).PauseMeshnet(ctx, req.(*PauseMeshnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_ResumeMeshnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).ResumeMeshnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/ResumeMeshnet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).ResumeMeshnet(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Meshnet_GetInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -918,6 +1138,42 @@ func _Meshnet_Connect_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Meshnet_SetExitNodeFallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExitNodeFallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).SetExitNodeFallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/SetExitNodeFallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).SetExitNodeFallback(ctx, req.(*SetExitNodeFallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_GetPeerReachability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).GetPeerReachability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/GetPeerReachability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).GetPeerReachability(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Meshnet_NotifyNewTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NewTransferNotification)
 	if err := dec(in); err != nil {
@@ -936,6 +1192,132 @@ func _Meshnet_NotifyNewTransfer_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Meshnet_SetFileshareRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFileshareRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).SetFileshareRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/SetFileshareRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).SetFileshareRateLimit(ctx, req.(*SetFileshareRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_CreatePeerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).CreatePeerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/CreatePeerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).CreatePeerGroup(ctx, req.(*PeerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_DeletePeerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).DeletePeerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/DeletePeerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).DeletePeerGroup(ctx, req.(*PeerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_AddPeerToGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).AddPeerToGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/AddPeerToGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).AddPeerToGroup(ctx, req.(*PeerGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_RemovePeerFromGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).RemovePeerFromGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/RemovePeerFromGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).RemovePeerFromGroup(ctx, req.(*PeerGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_GetPeerGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).GetPeerGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/GetPeerGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).GetPeerGroups(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_SetGroupPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).SetGroupPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/SetGroupPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).SetGroupPermission(ctx, req.(*SetGroupPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Meshnet_GetPrivateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -977,6 +1359,14 @@ var Meshnet_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshMeshnet",
 			Handler:    _Meshnet_RefreshMeshnet_Handler,
 		},
+		{
+			MethodName: "PauseMeshnet",
+			Handler:    _Meshnet_PauseMeshnet_Handler,
+		},
+		{
+			MethodName: "ResumeMeshnet",
+			Handler:    _Meshnet_ResumeMeshnet_Handler,
+		},
 		{
 			MethodName: "GetInvites",
 			Handler:    _Meshnet_GetInvites_Handler,
@@ -1057,10 +1447,46 @@ var Meshnet_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Connect",
 			Handler:    _Meshnet_Connect_Handler,
 		},
+		{
+			MethodName: "SetExitNodeFallback",
+			Handler:    _Meshnet_SetExitNodeFallback_Handler,
+		},
+		{
+			MethodName: "GetPeerReachability",
+			Handler:    _Meshnet_GetPeerReachability_Handler,
+		},
 		{
 			MethodName: "NotifyNewTransfer",
 			Handler:    _Meshnet_NotifyNewTransfer_Handler,
 		},
+		{
+			MethodName: "SetFileshareRateLimit",
+			Handler:    _Meshnet_SetFileshareRateLimit_Handler,
+		},
+		{
+			MethodName: "CreatePeerGroup",
+			Handler:    _Meshnet_CreatePeerGroup_Handler,
+		},
+		{
+			MethodName: "DeletePeerGroup",
+			Handler:    _Meshnet_DeletePeerGroup_Handler,
+		},
+		{
+			MethodName: "AddPeerToGroup",
+			Handler:    _Meshnet_AddPeerToGroup_Handler,
+		},
+		{
+			MethodName: "RemovePeerFromGroup",
+			Handler:    _Meshnet_RemovePeerFromGroup_Handler,
+		},
+		{
+			MethodName: "GetPeerGroups",
+			Handler:    _Meshnet_GetPeerGroups_Handler,
+		},
+		{
+			MethodName: "SetGroupPermission",
+			Handler:    _Meshnet_SetGroupPermission_Handler,
+		},
 		{
 			MethodName: "GetPrivateKey",
 			Handler:    _Meshnet_GetPrivateKey_Handler,
@@ -82,6 +82,8 @@ const (
 	MeshnetErrorCode_NOT_ENABLED      MeshnetErrorCode = 5
 	MeshnetErrorCode_TECH_FAILURE     MeshnetErrorCode = 6
 	MeshnetErrorCode_TUNNEL_CLOSED    MeshnetErrorCode = 7
+	MeshnetErrorCode_MESHNET_PAUSED   MeshnetErrorCode = 8
+	MeshnetErrorCode_NOT_PAUSED       MeshnetErrorCode = 9
 )
 
 // Enum value maps for MeshnetErrorCode.
@@ -94,6 +96,8 @@ var (
 		5: "NOT_ENABLED",
 		6: "TECH_FAILURE",
 		7: "TUNNEL_CLOSED",
+		8: "MESHNET_PAUSED",
+		9: "NOT_PAUSED",
 	}
 	MeshnetErrorCode_value = map[string]int32{
 		"NOT_REGISTERED":   0,
@@ -103,6 +107,8 @@ var (
 		"NOT_ENABLED":      5,
 		"TECH_FAILURE":     6,
 		"TUNNEL_CLOSED":    7,
+		"MESHNET_PAUSED":   8,
+		"NOT_PAUSED":       9,
 	}
 )
 
@@ -434,7 +440,7 @@ var file_service_response_proto_rawDesc = []byte{
 	0x65, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x47, 0x45, 0x44, 0x5f,
 	0x49, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x50, 0x49, 0x5f, 0x46, 0x41, 0x49, 0x4c,
 	0x55, 0x52, 0x45, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f,
-	0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x2a, 0x98, 0x01, 0x0a, 0x10, 0x4d, 0x65,
+	0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x2a, 0xbc, 0x01, 0x0a, 0x10, 0x4d, 0x65,
 	0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12,
 	0x0a, 0x0e, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x45, 0x44,
 	0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x49, 0x42, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52,
@@ -444,10 +450,13 @@ var file_service_response_proto_rawDesc = []byte{
 	0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12,
 	0x10, 0x0a, 0x0c, 0x54, 0x45, 0x43, 0x48, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10,
 	0x06, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x43, 0x4c, 0x4f, 0x53,
-	0x45, 0x44, 0x10, 0x07, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f,
-	0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6d, 0x65,
-	0x73, 0x68, 0x6e, 0x65, 0x74, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x45, 0x44, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x45, 0x53, 0x48, 0x4e, 0x45, 0x54, 0x5f,
+	0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x08, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x54, 0x5f,
+	0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x09, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75,
+	0x78, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
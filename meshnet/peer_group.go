@@ -0,0 +1,414 @@
+package meshnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/NordSecurity/nordvpn-linux/auth"
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+)
+
+// peerGroupName restricts the group names to the ones which are easy to type
+// and to complete in the shell
+var peerGroupName = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,32}$`)
+
+// peerGroupPreconditions checks what every group operation requires and
+// returns the loaded config. Non-nil response must be returned to the caller.
+func (s *Server) peerGroupPreconditions() (config.Config, *pb.PeerGroupResponse) {
+	if !s.ac.IsLoggedIn() {
+		return config.Config{}, &pb.PeerGroupResponse{
+			Response: &pb.PeerGroupResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+			},
+		}
+	}
+
+	if !s.mc.IsRegistrationInfoCorrect() {
+		return config.Config{}, &pb.PeerGroupResponse{
+			Response: &pb.PeerGroupResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_REGISTERED,
+			},
+		}
+	}
+
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		s.pub.Publish(err)
+		return config.Config{}, &pb.PeerGroupResponse{
+			Response: &pb.PeerGroupResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}
+	}
+
+	if !cfg.Mesh {
+		return config.Config{}, &pb.PeerGroupResponse{
+			Response: &pb.PeerGroupResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_ENABLED,
+			},
+		}
+	}
+	return cfg, nil
+}
+
+func peerGroupErrorResponse(code pb.PeerGroupErrorCode) *pb.PeerGroupResponse {
+	return &pb.PeerGroupResponse{
+		Response: &pb.PeerGroupResponse_PeerGroupErrorCode{
+			PeerGroupErrorCode: code,
+		},
+	}
+}
+
+func (s *Server) savePeerGroups(fn func(groups map[string][]string)) *pb.PeerGroupResponse {
+	if err := s.cm.SaveWith(func(c config.Config) config.Config {
+		if c.Meshnet.PeerGroups == nil {
+			c.Meshnet.PeerGroups = map[string][]string{}
+		}
+		fn(c.Meshnet.PeerGroups)
+		return c
+	}); err != nil {
+		s.pub.Publish(err)
+		return &pb.PeerGroupResponse{
+			Response: &pb.PeerGroupResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}
+	}
+	return &pb.PeerGroupResponse{Response: &pb.PeerGroupResponse_Empty{}}
+}
+
+// removeFromPeerGroups forgets the removed peer in all the groups
+func removeFromPeerGroups(identifier string) config.SaveFunc {
+	return func(c config.Config) config.Config {
+		for name, peers := range c.Meshnet.PeerGroups {
+			if index := slices.Index(peers, identifier); index != -1 {
+				c.Meshnet.PeerGroups[name] = slices.Delete(peers, index, index+1)
+			}
+		}
+		return c
+	}
+}
+
+// CreatePeerGroup creates an empty group of peers
+func (s *Server) CreatePeerGroup(
+	ctx context.Context,
+	req *pb.PeerGroupRequest,
+) (*pb.PeerGroupResponse, error) {
+	cfg, resp := s.peerGroupPreconditions()
+	if resp != nil {
+		return resp, nil
+	}
+
+	name := req.GetName()
+	if !peerGroupName.MatchString(name) {
+		return peerGroupErrorResponse(pb.PeerGroupErrorCode_INVALID_GROUP_NAME), nil
+	}
+	if _, ok := cfg.Meshnet.PeerGroups[name]; ok {
+		return peerGroupErrorResponse(pb.PeerGroupErrorCode_GROUP_ALREADY_EXISTS), nil
+	}
+
+	return s.savePeerGroups(func(groups map[string][]string) {
+		groups[name] = []string{}
+	}), nil
+}
+
+// DeletePeerGroup deletes the group. Permissions of its peers stay as they are.
+func (s *Server) DeletePeerGroup(
+	ctx context.Context,
+	req *pb.PeerGroupRequest,
+) (*pb.PeerGroupResponse, error) {
+	cfg, resp := s.peerGroupPreconditions()
+	if resp != nil {
+		return resp, nil
+	}
+
+	name := req.GetName()
+	if _, ok := cfg.Meshnet.PeerGroups[name]; !ok {
+		return peerGroupErrorResponse(pb.PeerGroupErrorCode_GROUP_NOT_FOUND), nil
+	}
+
+	return s.savePeerGroups(func(groups map[string][]string) {
+		delete(groups, name)
+	}), nil
+}
+
+// AddPeerToGroup adds the meshnet peer to the group
+func (s *Server) AddPeerToGroup(
+	ctx context.Context,
+	req *pb.PeerGroupMemberRequest,
+) (*pb.PeerGroupResponse, error) {
+	cfg, resp := s.peerGroupPreconditions()
+	if resp != nil {
+		return resp, nil
+	}
+
+	name := req.GetGroup()
+	members, ok := cfg.Meshnet.PeerGroups[name]
+	if !ok {
+		return peerGroupErrorResponse(pb.PeerGroupErrorCode_GROUP_NOT_FOUND), nil
+	}
+	if slices.Contains(members, req.GetIdentifier()) {
+		return peerGroupErrorResponse(pb.PeerGroupErrorCode_PEER_ALREADY_IN_GROUP), nil
+	}
+
+	token := cfg.TokensData[cfg.AutoConnectData.ID].Token
+	peers, err := s.reg.List(token, cfg.MeshDevice.ID)
+	if err != nil {
+		s.pub.Publish(fmt.Errorf("listing peers (@AddPeerToGroup): %w", err))
+		return &pb.PeerGroupResponse{
+			Response: &pb.PeerGroupResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_API_FAILURE,
+			},
+		}, nil
+	}
+	if !slices.ContainsFunc(peers, func(p mesh.MachinePeer) bool {
+		return p.ID.String() == req.GetIdentifier()
+	}) {
+		return &pb.PeerGroupResponse{
+			Response: &pb.PeerGroupResponse_UpdatePeerErrorCode{
+				UpdatePeerErrorCode: pb.UpdatePeerErrorCode_PEER_NOT_FOUND,
+			},
+		}, nil
+	}
+
+	return s.savePeerGroups(func(groups map[string][]string) {
+		groups[name] = append(groups[name], req.GetIdentifier())
+	}), nil
+}
+
+// RemovePeerFromGroup removes the peer from the group. The peer does not have
+// to be in the meshnet anymore.
+func (s *Server) RemovePeerFromGroup(
+	ctx context.Context,
+	req *pb.PeerGroupMemberRequest,
+) (*pb.PeerGroupResponse, error) {
+	cfg, resp := s.peerGroupPreconditions()
+	if resp != nil {
+		return resp, nil
+	}
+
+	name := req.GetGroup()
+	members, ok := cfg.Meshnet.PeerGroups[name]
+	if !ok {
+		return peerGroupErrorResponse(pb.PeerGroupErrorCode_GROUP_NOT_FOUND), nil
+	}
+	index := slices.Index(members, req.GetIdentifier())
+	if index == -1 {
+		return peerGroupErrorResponse(pb.PeerGroupErrorCode_PEER_NOT_IN_GROUP), nil
+	}
+
+	return s.savePeerGroups(func(groups map[string][]string) {
+		groups[name] = slices.Delete(groups[name], index, index+1)
+	}), nil
+}
+
+// GetPeerGroups lists the groups sorted by name
+func (s *Server) GetPeerGroups(context.Context, *pb.Empty) (*pb.GetPeerGroupsResponse, error) {
+	if !s.ac.IsLoggedIn() {
+		return &pb.GetPeerGroupsResponse{
+			Response: &pb.GetPeerGroupsResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+			},
+		}, nil
+	}
+
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		s.pub.Publish(err)
+		return &pb.GetPeerGroupsResponse{
+			Response: &pb.GetPeerGroupsResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	groups := []*pb.PeerGroup{}
+	for name, peers := range cfg.Meshnet.PeerGroups {
+		groups = append(groups, &pb.PeerGroup{Name: name, Peers: peers})
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+
+	return &pb.GetPeerGroupsResponse{
+		Response: &pb.GetPeerGroupsResponse_Groups{
+			Groups: &pb.PeerGroups{Groups: groups},
+		},
+	}, nil
+}
+
+// SetGroupPermission allows or denies the permission for all the peers of the
+// group. Peers are listed once and updated one by one, failure of one peer
+// does not stop updating the others.
+func (s *Server) SetGroupPermission(
+	ctx context.Context,
+	req *pb.SetGroupPermissionRequest,
+) (*pb.SetGroupPermissionResponse, error) {
+	cfg, resp := s.peerGroupPreconditions()
+	if resp != nil {
+		// preconditions return service or meshnet errors only
+		switch resp := resp.GetResponse().(type) {
+		case *pb.PeerGroupResponse_ServiceErrorCode:
+			return &pb.SetGroupPermissionResponse{
+				Response: &pb.SetGroupPermissionResponse_ServiceErrorCode{
+					ServiceErrorCode: resp.ServiceErrorCode,
+				},
+			}, nil
+		case *pb.PeerGroupResponse_MeshnetErrorCode:
+			return &pb.SetGroupPermissionResponse{
+				Response: &pb.SetGroupPermissionResponse_MeshnetErrorCode{
+					MeshnetErrorCode: resp.MeshnetErrorCode,
+				},
+			}, nil
+		}
+	}
+
+	members, ok := cfg.Meshnet.PeerGroups[req.GetGroup()]
+	if !ok {
+		return &pb.SetGroupPermissionResponse{
+			Response: &pb.SetGroupPermissionResponse_PeerGroupErrorCode{
+				PeerGroupErrorCode: pb.PeerGroupErrorCode_GROUP_NOT_FOUND,
+			},
+		}, nil
+	}
+	if len(members) == 0 {
+		return &pb.SetGroupPermissionResponse{
+			Response: &pb.SetGroupPermissionResponse_PeerGroupErrorCode{
+				PeerGroupErrorCode: pb.PeerGroupErrorCode_GROUP_EMPTY,
+			},
+		}, nil
+	}
+
+	token := cfg.TokensData[cfg.AutoConnectData.ID].Token
+	peers, err := s.reg.List(token, cfg.MeshDevice.ID)
+	if err != nil {
+		if errors.Is(err, core.ErrUnauthorized) {
+			if err := s.cm.SaveWith(auth.Logout(cfg.AutoConnectData.ID)); err != nil {
+				s.pub.Publish(err)
+				return &pb.SetGroupPermissionResponse{
+					Response: &pb.SetGroupPermissionResponse_ServiceErrorCode{
+						ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+					},
+				}, nil
+			}
+			return &pb.SetGroupPermissionResponse{
+				Response: &pb.SetGroupPermissionResponse_ServiceErrorCode{
+					ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+				},
+			}, nil
+		}
+		s.pub.Publish(fmt.Errorf("listing peers (@SetGroupPermission): %w", err))
+		return &pb.SetGroupPermissionResponse{
+			Response: &pb.SetGroupPermissionResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_API_FAILURE,
+			},
+		}, nil
+	}
+
+	results := make([]*pb.PeerPermissionResult, 0, len(members))
+	for _, identifier := range members {
+		result := &pb.PeerPermissionResult{Identifier: identifier}
+		results = append(results, result)
+
+		index := slices.IndexFunc(peers, func(p mesh.MachinePeer) bool {
+			return p.ID.String() == identifier
+		})
+		if index == -1 {
+			result.Outcome = pb.PermissionOutcome_PERMISSION_PEER_NOT_FOUND
+			continue
+		}
+		result.Hostname = peers[index].Hostname
+		result.Outcome = s.setPeerPermission(
+			token,
+			cfg.MeshDevice.ID,
+			index,
+			peers,
+			req.GetPermission(),
+			req.GetAllow(),
+		)
+	}
+
+	return &pb.SetGroupPermissionResponse{
+		Response: &pb.SetGroupPermissionResponse_Results{
+			Results: &pb.GroupPermissionResults{Results: results},
+		},
+	}, nil
+}
+
+// setPeerPermission updates the permission of the peer at the index and
+// applies it the same way as the single peer permission handlers do
+func (s *Server) setPeerPermission(
+	token string,
+	deviceID uuid.UUID,
+	index int,
+	peers mesh.MachinePeers,
+	permission pb.PeerPermission,
+	allow bool,
+) pb.PermissionOutcome {
+	peer := &peers[index]
+	var current *bool
+	switch permission {
+	case pb.PeerPermission_PERMISSION_INCOMING:
+		current = &peer.DoIAllowInbound
+	case pb.PeerPermission_PERMISSION_ROUTING:
+		current = &peer.DoIAllowRouting
+	case pb.PeerPermission_PERMISSION_LOCAL_NETWORK:
+		current = &peer.DoIAllowLocalNetwork
+	case pb.PeerPermission_PERMISSION_FILESHARE:
+		current = &peer.DoIAllowFileshare
+	default:
+		return pb.PermissionOutcome_PERMISSION_FAILED
+	}
+	if *current == allow {
+		return pb.PermissionOutcome_PERMISSION_UNCHANGED
+	}
+
+	*current = allow
+	if err := s.updatePeerPermissions(token, deviceID, *peer); err != nil {
+		*current = !allow
+		s.pub.Publish(err)
+		return pb.PermissionOutcome_PERMISSION_FAILED
+	}
+
+	if err := s.applyPeerPermission(*peer, peers, permission, allow); err != nil {
+		s.pub.Publish(err)
+		return pb.PermissionOutcome_PERMISSION_FAILED
+	}
+	return pb.PermissionOutcome_PERMISSION_UPDATED
+}
+
+// applyPeerPermission applies the changed permission of the peer to this
+// device
+func (s *Server) applyPeerPermission(
+	peer mesh.MachinePeer,
+	peers mesh.MachinePeers,
+	permission pb.PeerPermission,
+	allow bool,
+) error {
+	switch permission {
+	case pb.PeerPermission_PERMISSION_ROUTING, pb.PeerPermission_PERMISSION_LOCAL_NETWORK:
+		return s.resetRouting(peer, peers)
+	}
+
+	if !peer.Address.IsValid() || s.pause.isPaused() {
+		return nil
+	}
+	address := UniqueAddress{UID: peer.PublicKey, Address: peer.Address}
+	switch {
+	case permission == pb.PeerPermission_PERMISSION_INCOMING && allow:
+		return s.netw.AllowIncoming(address, peer.DoIAllowRouting && peer.DoIAllowLocalNetwork)
+	case permission == pb.PeerPermission_PERMISSION_INCOMING:
+		return s.netw.BlockIncoming(address)
+	case allow:
+		return s.netw.AllowFileshare(address)
+	default:
+		return s.netw.BlockFileshare(address)
+	}
+}
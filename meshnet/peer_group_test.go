@@ -0,0 +1,204 @@
+package meshnet
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/events/subs"
+	"github.com/NordSecurity/nordvpn-linux/fileshare/service"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPeerGroupServer(cm *mock.ConfigManager, reg *mock.RegistryMock, netw *workingNetworker) *Server {
+	return NewServer(
+		meshRenewChecker{},
+		cm,
+		registrationChecker{},
+		invitationsAPI{},
+		netw,
+		reg,
+		&mock.DNSGetter{},
+		&subs.Subject[error]{},
+		&subs.Subject[[]string]{},
+		&subs.Subject[bool]{},
+		&subs.Subject[events.DataConnect]{},
+		&subs.Subject[events.DataExitNodeRecovery]{},
+		service.NoopFileshare{},
+		&mockLimiter{},
+		nil,
+		nil,
+	)
+}
+
+func TestServer_PeerGroups(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	peer := mesh.MachinePeer{ID: uuid.New(), Hostname: "nas.nord"}
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Mesh = true
+	server := newPeerGroupServer(cm, &mock.RegistryMock{Peers: mesh.MachinePeers{peer}}, &workingNetworker{})
+	ctx := context.Background()
+
+	errorCode := func(resp *pb.PeerGroupResponse) pb.PeerGroupErrorCode {
+		code, ok := resp.GetResponse().(*pb.PeerGroupResponse_PeerGroupErrorCode)
+		assert.True(t, ok, "unexpected response %v", resp)
+		return code.PeerGroupErrorCode
+	}
+
+	resp, _ := server.CreatePeerGroup(ctx, &pb.PeerGroupRequest{Name: "homelab"})
+	assert.IsType(t, &pb.PeerGroupResponse_Empty{}, resp.GetResponse())
+	resp, _ = server.CreatePeerGroup(ctx, &pb.PeerGroupRequest{Name: "homelab"})
+	assert.Equal(t, pb.PeerGroupErrorCode_GROUP_ALREADY_EXISTS, errorCode(resp))
+	resp, _ = server.CreatePeerGroup(ctx, &pb.PeerGroupRequest{Name: "home lab"})
+	assert.Equal(t, pb.PeerGroupErrorCode_INVALID_GROUP_NAME, errorCode(resp))
+
+	resp, _ = server.AddPeerToGroup(ctx, &pb.PeerGroupMemberRequest{Group: "homelab", Identifier: peer.ID.String()})
+	assert.IsType(t, &pb.PeerGroupResponse_Empty{}, resp.GetResponse())
+	resp, _ = server.AddPeerToGroup(ctx, &pb.PeerGroupMemberRequest{Group: "homelab", Identifier: peer.ID.String()})
+	assert.Equal(t, pb.PeerGroupErrorCode_PEER_ALREADY_IN_GROUP, errorCode(resp))
+	resp, _ = server.AddPeerToGroup(ctx, &pb.PeerGroupMemberRequest{Group: "homelab", Identifier: uuid.NewString()})
+	assert.IsType(t, &pb.PeerGroupResponse_UpdatePeerErrorCode{}, resp.GetResponse())
+	resp, _ = server.AddPeerToGroup(ctx, &pb.PeerGroupMemberRequest{Group: "office", Identifier: peer.ID.String()})
+	assert.Equal(t, pb.PeerGroupErrorCode_GROUP_NOT_FOUND, errorCode(resp))
+	assert.Equal(t, map[string][]string{"homelab": {peer.ID.String()}}, cm.Cfg.Meshnet.PeerGroups)
+
+	groups, _ := server.GetPeerGroups(ctx, &pb.Empty{})
+	assert.Len(t, groups.GetGroups().GetGroups(), 1)
+	assert.Equal(t, "homelab", groups.GetGroups().GetGroups()[0].GetName())
+
+	resp, _ = server.RemovePeerFromGroup(ctx, &pb.PeerGroupMemberRequest{Group: "homelab", Identifier: peer.ID.String()})
+	assert.IsType(t, &pb.PeerGroupResponse_Empty{}, resp.GetResponse())
+	resp, _ = server.RemovePeerFromGroup(ctx, &pb.PeerGroupMemberRequest{Group: "homelab", Identifier: peer.ID.String()})
+	assert.Equal(t, pb.PeerGroupErrorCode_PEER_NOT_IN_GROUP, errorCode(resp))
+
+	resp, _ = server.DeletePeerGroup(ctx, &pb.PeerGroupRequest{Name: "homelab"})
+	assert.IsType(t, &pb.PeerGroupResponse_Empty{}, resp.GetResponse())
+	assert.Empty(t, cm.Cfg.Meshnet.PeerGroups)
+}
+
+func TestServer_PeerGroupsMeshnetDisabled(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	server := newPeerGroupServer(mock.NewMockConfigManager(), &mock.RegistryMock{}, &workingNetworker{})
+	resp, _ := server.CreatePeerGroup(context.Background(), &pb.PeerGroupRequest{Name: "homelab"})
+	assert.Equal(t, &pb.PeerGroupResponse_MeshnetErrorCode{
+		MeshnetErrorCode: pb.MeshnetErrorCode_NOT_ENABLED,
+	}, resp.GetResponse())
+}
+
+func TestServer_SetGroupPermission(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	allowed := mesh.MachinePeer{
+		ID:              uuid.New(),
+		Hostname:        "allowed.nord",
+		PublicKey:       "allowed",
+		Address:         netip.MustParseAddr("100.64.0.2"),
+		DoIAllowInbound: true,
+	}
+	denied := mesh.MachinePeer{
+		ID:        uuid.New(),
+		Hostname:  "denied.nord",
+		PublicKey: "denied",
+		Address:   netip.MustParseAddr("100.64.0.3"),
+	}
+	gone := uuid.NewString()
+
+	tests := []struct {
+		name       string
+		permission pb.PeerPermission
+		allow      bool
+		outcomes   []pb.PermissionOutcome
+		incoming   int
+		blocked    int
+		reset      []string
+	}{
+		{
+			name:       "allow incoming",
+			permission: pb.PeerPermission_PERMISSION_INCOMING,
+			allow:      true,
+			outcomes: []pb.PermissionOutcome{
+				pb.PermissionOutcome_PERMISSION_UNCHANGED,
+				pb.PermissionOutcome_PERMISSION_UPDATED,
+				pb.PermissionOutcome_PERMISSION_PEER_NOT_FOUND,
+			},
+			incoming: 1,
+		},
+		{
+			name:       "deny incoming",
+			permission: pb.PeerPermission_PERMISSION_INCOMING,
+			outcomes: []pb.PermissionOutcome{
+				pb.PermissionOutcome_PERMISSION_UPDATED,
+				pb.PermissionOutcome_PERMISSION_UNCHANGED,
+				pb.PermissionOutcome_PERMISSION_PEER_NOT_FOUND,
+			},
+			blocked: 1,
+		},
+		{
+			name:       "allow routing",
+			permission: pb.PeerPermission_PERMISSION_ROUTING,
+			allow:      true,
+			outcomes: []pb.PermissionOutcome{
+				pb.PermissionOutcome_PERMISSION_UPDATED,
+				pb.PermissionOutcome_PERMISSION_UPDATED,
+				pb.PermissionOutcome_PERMISSION_PEER_NOT_FOUND,
+			},
+			reset: []string{"allowed", "denied"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Mesh = true
+			cm.Cfg.Meshnet.PeerGroups = map[string][]string{
+				"homelab": {allowed.ID.String(), denied.ID.String(), gone},
+			}
+			netw := &workingNetworker{}
+			reg := &mock.RegistryMock{Peers: mesh.MachinePeers{allowed, denied}}
+			server := newPeerGroupServer(cm, reg, netw)
+
+			resp, err := server.SetGroupPermission(context.Background(), &pb.SetGroupPermissionRequest{
+				Group:      "homelab",
+				Permission: test.permission,
+				Allow:      test.allow,
+			})
+			assert.NoError(t, err)
+
+			var outcomes []pb.PermissionOutcome
+			for _, result := range resp.GetResults().GetResults() {
+				outcomes = append(outcomes, result.GetOutcome())
+			}
+			assert.Equal(t, test.outcomes, outcomes)
+			assert.Len(t, netw.allowedIncoming, test.incoming)
+			assert.Len(t, netw.blockedIncoming, test.blocked)
+			assert.Equal(t, test.reset, netw.resetPeers)
+		})
+	}
+}
+
+func TestServer_SetGroupPermissionErrors(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Mesh = true
+	cm.Cfg.Meshnet.PeerGroups = map[string][]string{"empty": {}}
+	server := newPeerGroupServer(cm, &mock.RegistryMock{}, &workingNetworker{})
+
+	resp, _ := server.SetGroupPermission(context.Background(), &pb.SetGroupPermissionRequest{Group: "homelab"})
+	assert.Equal(t, &pb.SetGroupPermissionResponse_PeerGroupErrorCode{
+		PeerGroupErrorCode: pb.PeerGroupErrorCode_GROUP_NOT_FOUND,
+	}, resp.GetResponse())
+	resp, _ = server.SetGroupPermission(context.Background(), &pb.SetGroupPermissionRequest{Group: "empty"})
+	assert.Equal(t, &pb.SetGroupPermissionResponse_PeerGroupErrorCode{
+		PeerGroupErrorCode: pb.PeerGroupErrorCode_GROUP_EMPTY,
+	}, resp.GetResponse())
+}
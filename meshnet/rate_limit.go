@@ -0,0 +1,61 @@
+package meshnet
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+)
+
+// SetFileshareRateLimit throttles the fileshare traffic of the peers. Limits are
+// kept until meshnet is disabled.
+func (s *Server) SetFileshareRateLimit(
+	ctx context.Context,
+	req *pb.SetFileshareRateLimitRequest,
+) (*pb.SetFileshareRateLimitResponse, error) {
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		s.pub.Publish(err)
+		return &pb.SetFileshareRateLimitResponse{
+			Response: &pb.SetFileshareRateLimitResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	if !cfg.Mesh {
+		return &pb.SetFileshareRateLimitResponse{
+			Response: &pb.SetFileshareRateLimitResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_ENABLED,
+			},
+		}, nil
+	}
+
+	var peer netip.Addr
+	if req.GetPeerIp() != "" {
+		var err error
+		peer, err = netip.ParseAddr(req.GetPeerIp())
+		if err != nil {
+			return &pb.SetFileshareRateLimitResponse{
+				Response: &pb.SetFileshareRateLimitResponse_UpdatePeerErrorCode{
+					UpdatePeerErrorCode: pb.UpdatePeerErrorCode_PEER_NOT_FOUND,
+				},
+			}, nil
+		}
+	}
+
+	if err := s.limiter.Set(peer, req.GetLimit()); err != nil {
+		s.pub.Publish(fmt.Errorf("setting fileshare rate limit: %w", err))
+		return &pb.SetFileshareRateLimitResponse{
+			Response: &pb.SetFileshareRateLimitResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_LIB_FAILURE,
+			},
+		}, nil
+	}
+
+	return &pb.SetFileshareRateLimitResponse{
+		Response: &pb.SetFileshareRateLimitResponse_Empty{},
+	}, nil
+}
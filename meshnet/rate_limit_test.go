@@ -0,0 +1,130 @@
+package meshnet
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/events/subs"
+	"github.com/NordSecurity/nordvpn-linux/fileshare/service"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockLimiter struct {
+	limits   map[netip.Addr]uint64
+	disabled bool
+	err      error
+}
+
+func (l *mockLimiter) Set(peer netip.Addr, limit uint64) error {
+	if l.err != nil {
+		return l.err
+	}
+	if l.limits == nil {
+		l.limits = map[netip.Addr]uint64{}
+	}
+	l.limits[peer] = limit
+	return nil
+}
+
+func (l *mockLimiter) Disable() error {
+	l.limits = nil
+	l.disabled = true
+	return nil
+}
+
+func TestSetFileshareRateLimit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		mesh     bool
+		peerIP   string
+		setErr   error
+		expected *pb.SetFileshareRateLimitResponse
+		limits   map[netip.Addr]uint64
+	}{
+		{
+			name:     "default limit",
+			mesh:     true,
+			expected: &pb.SetFileshareRateLimitResponse{Response: &pb.SetFileshareRateLimitResponse_Empty{}},
+			limits:   map[netip.Addr]uint64{{}: 1024},
+		},
+		{
+			name:     "peer limit",
+			mesh:     true,
+			peerIP:   "100.64.0.2",
+			expected: &pb.SetFileshareRateLimitResponse{Response: &pb.SetFileshareRateLimitResponse_Empty{}},
+			limits:   map[netip.Addr]uint64{netip.MustParseAddr("100.64.0.2"): 1024},
+		},
+		{
+			name:   "meshnet disabled",
+			peerIP: "100.64.0.2",
+			expected: &pb.SetFileshareRateLimitResponse{
+				Response: &pb.SetFileshareRateLimitResponse_MeshnetErrorCode{
+					MeshnetErrorCode: pb.MeshnetErrorCode_NOT_ENABLED,
+				},
+			},
+		},
+		{
+			name:   "invalid peer",
+			mesh:   true,
+			peerIP: "peer",
+			expected: &pb.SetFileshareRateLimitResponse{
+				Response: &pb.SetFileshareRateLimitResponse_UpdatePeerErrorCode{
+					UpdatePeerErrorCode: pb.UpdatePeerErrorCode_PEER_NOT_FOUND,
+				},
+			},
+		},
+		{
+			name:   "firewall failure",
+			mesh:   true,
+			setErr: errors.New("iptables failed"),
+			expected: &pb.SetFileshareRateLimitResponse{
+				Response: &pb.SetFileshareRateLimitResponse_MeshnetErrorCode{
+					MeshnetErrorCode: pb.MeshnetErrorCode_LIB_FAILURE,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Mesh = test.mesh
+			limiter := &mockLimiter{err: test.setErr}
+
+			server := NewServer(
+				meshRenewChecker{},
+				cm,
+				registrationChecker{},
+				invitationsAPI{},
+				&workingNetworker{},
+				&mock.RegistryMock{},
+				&mock.DNSGetter{},
+				&subs.Subject[error]{},
+				&subs.Subject[[]string]{},
+				&subs.Subject[bool]{},
+				&subs.Subject[events.DataConnect]{},
+				&subs.Subject[events.DataExitNodeRecovery]{},
+				service.NoopFileshare{},
+				limiter,
+				nil,
+				nil,
+			)
+
+			resp, err := server.SetFileshareRateLimit(context.Background(), &pb.SetFileshareRateLimitRequest{
+				PeerIp: test.peerIP,
+				Limit:  1024,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected.Response, resp.Response)
+			assert.Equal(t, test.limits, limiter.limits)
+		})
+	}
+}
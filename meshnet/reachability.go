@@ -0,0 +1,214 @@
+package meshnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/auth"
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+)
+
+const (
+	// reachabilityProbeTimeout bounds a single peer probe
+	reachabilityProbeTimeout = 3 * time.Second
+	// reachabilityMaxProbes bounds the amount of peers probed at once
+	reachabilityMaxProbes = 8
+)
+
+var (
+	errPeerOffline   = errors.New("peer is offline")
+	errPeerNoAddress = errors.New("peer has no meshnet address")
+	pingRTTPattern   = regexp.MustCompile(`time=([0-9.]+) ms`)
+)
+
+// Prober checks whether the peer is reachable over meshnet
+type Prober interface {
+	// Probe returns the round trip time to the given address
+	Probe(ctx context.Context, addr netip.Addr) (time.Duration, error)
+}
+
+// PingProber probes the peers with a single ICMP echo request
+type PingProber struct{}
+
+func (PingProber) Probe(ctx context.Context, addr netip.Addr) (time.Duration, error) {
+	start := time.Now()
+	// #nosec G204 -- input is properly sanitized
+	out, err := exec.CommandContext(ctx, "ping", "-n", "-c", "1", addr.String()).CombinedOutput()
+	if err != nil {
+		if ctx.Err() != nil {
+			return 0, fmt.Errorf("no reply: %w", ctx.Err())
+		}
+		return 0, fmt.Errorf("no reply: %w", err)
+	}
+
+	match := pingRTTPattern.FindSubmatch(out)
+	if match == nil {
+		return time.Since(start), nil
+	}
+	ms, err := strconv.ParseFloat(string(match[1]), 64)
+	if err != nil {
+		return time.Since(start), nil
+	}
+	return time.Duration(ms * float64(time.Millisecond)), nil
+}
+
+// GetPeerReachability probes every peer from this device. Offline peers are
+// reported without probing them.
+func (s *Server) GetPeerReachability(ctx context.Context, _ *pb.Empty) (*pb.GetPeerReachabilityResponse, error) {
+	if !s.ac.IsLoggedIn() {
+		return &pb.GetPeerReachabilityResponse{
+			Response: &pb.GetPeerReachabilityResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+			},
+		}, nil
+	}
+
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		s.pub.Publish(err)
+		return &pb.GetPeerReachabilityResponse{
+			Response: &pb.GetPeerReachabilityResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	if !cfg.Mesh || cfg.MeshDevice == nil {
+		return &pb.GetPeerReachabilityResponse{
+			Response: &pb.GetPeerReachabilityResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_ENABLED,
+			},
+		}, nil
+	}
+
+	if s.pause.isPaused() {
+		return &pb.GetPeerReachabilityResponse{
+			Response: &pb.GetPeerReachabilityResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_MESHNET_PAUSED,
+			},
+		}, nil
+	}
+
+	token := cfg.TokensData[cfg.AutoConnectData.ID].Token
+	peers, err := s.reg.List(token, cfg.MeshDevice.ID)
+	if err != nil {
+		if errors.Is(err, core.ErrUnauthorized) {
+			if err := s.cm.SaveWith(auth.Logout(cfg.AutoConnectData.ID)); err != nil {
+				s.pub.Publish(err)
+				return &pb.GetPeerReachabilityResponse{
+					Response: &pb.GetPeerReachabilityResponse_ServiceErrorCode{
+						ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+					},
+				}, nil
+			}
+			return &pb.GetPeerReachabilityResponse{
+				Response: &pb.GetPeerReachabilityResponse_ServiceErrorCode{
+					ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+				},
+			}, nil
+		}
+		s.pub.Publish(fmt.Errorf("listing peers (@GetPeerReachability): %w", err))
+		return &pb.GetPeerReachabilityResponse{
+			Response: &pb.GetPeerReachabilityResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_API_FAILURE,
+			},
+		}, nil
+	}
+
+	statusMap, err := s.netw.StatusMap()
+	if err != nil {
+		s.pub.Publish(fmt.Errorf("retrieving peer status: %w", err))
+		statusMap = map[string]string{}
+	}
+	pathMap, err := s.netw.PathMap()
+	if err != nil {
+		s.pub.Publish(fmt.Errorf("retrieving peer paths: %w", err))
+		pathMap = map[string]string{}
+	}
+
+	return &pb.GetPeerReachabilityResponse{
+		Response: &pb.GetPeerReachabilityResponse_Reachability{
+			Reachability: &pb.PeerReachabilityList{
+				Hostname: cfg.MeshDevice.Hostname,
+				Peers:    probePeers(ctx, s.prober, peers, statusMap, pathMap),
+			},
+		},
+	}, nil
+}
+
+// probePeers probes at most reachabilityMaxProbes peers at once, each of them
+// for at most reachabilityProbeTimeout. Result order matches the peer order.
+func probePeers(
+	ctx context.Context,
+	prober Prober,
+	peers mesh.MachinePeers,
+	statusMap map[string]string,
+	pathMap map[string]string,
+) []*pb.PeerReachability {
+	results := make([]*pb.PeerReachability, len(peers))
+	sem := make(chan struct{}, reachabilityMaxProbes)
+	var wg sync.WaitGroup
+	for i, peer := range peers {
+		result := &pb.PeerReachability{
+			Hostname: peer.Hostname,
+			Nickname: peer.Nickname,
+			Status:   pb.PeerStatus_DISCONNECTED,
+		}
+		results[i] = result
+		if peer.Address.IsValid() {
+			result.Ip = peer.Address.String()
+		}
+
+		switch {
+		case statusMap[peer.PublicKey] != "connected":
+			result.Error = errPeerOffline.Error()
+			continue
+		case !peer.Address.IsValid():
+			result.Status = pb.PeerStatus_CONNECTED
+			result.Error = errPeerNoAddress.Error()
+			continue
+		}
+		result.Status = pb.PeerStatus_CONNECTED
+		result.Path = toPeerPath(pathMap[peer.PublicKey])
+
+		wg.Add(1)
+		go func(addr netip.Addr) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			ctx, cancel := context.WithTimeout(ctx, reachabilityProbeTimeout)
+			defer cancel()
+			rtt, err := prober.Probe(ctx, addr)
+			if err != nil {
+				result.Error = err.Error()
+				return
+			}
+			result.Reachable = true
+			result.RttMs = uint32(rtt.Milliseconds())
+		}(peer.Address)
+	}
+	wg.Wait()
+	return results
+}
+
+func toPeerPath(path string) pb.PeerPath {
+	switch path {
+	case "direct":
+		return pb.PeerPath_PATH_DIRECT
+	case "relay":
+		return pb.PeerPath_PATH_RELAY
+	default:
+		return pb.PeerPath_PATH_UNKNOWN
+	}
+}
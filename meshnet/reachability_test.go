@@ -0,0 +1,156 @@
+package meshnet
+
+import (
+	"context"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type proberMock struct {
+	mu          sync.Mutex
+	unreachable map[netip.Addr]bool
+	probed      []netip.Addr
+}
+
+func (p *proberMock) Probe(_ context.Context, addr netip.Addr) (time.Duration, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.probed = append(p.probed, addr)
+	if p.unreachable[addr] {
+		return 0, mock.ErrOnPurpose
+	}
+	return 25 * time.Millisecond, nil
+}
+
+func TestProbePeers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	direct := netip.MustParseAddr("100.64.0.2")
+	relay := netip.MustParseAddr("100.64.0.3")
+	unreachable := netip.MustParseAddr("100.64.0.4")
+	offline := netip.MustParseAddr("100.64.0.5")
+	peers := mesh.MachinePeers{
+		{Hostname: "direct", PublicKey: "direct", Address: direct},
+		{Hostname: "relay", Nickname: "nick", PublicKey: "relay", Address: relay},
+		{Hostname: "unreachable", PublicKey: "unreachable", Address: unreachable},
+		{Hostname: "offline", PublicKey: "offline", Address: offline},
+	}
+	statusMap := map[string]string{
+		"direct":      "connected",
+		"relay":       "connected",
+		"unreachable": "connected",
+		"offline":     "disconnected",
+	}
+	pathMap := map[string]string{
+		"direct": "direct",
+		"relay":  "relay",
+	}
+	prober := proberMock{unreachable: map[netip.Addr]bool{unreachable: true}}
+
+	results := probePeers(context.Background(), &prober, peers, statusMap, pathMap)
+
+	assert.Equal(t, []*pb.PeerReachability{
+		{
+			Hostname:  "direct",
+			Ip:        direct.String(),
+			Status:    pb.PeerStatus_CONNECTED,
+			Reachable: true,
+			Path:      pb.PeerPath_PATH_DIRECT,
+			RttMs:     25,
+		},
+		{
+			Hostname:  "relay",
+			Nickname:  "nick",
+			Ip:        relay.String(),
+			Status:    pb.PeerStatus_CONNECTED,
+			Reachable: true,
+			Path:      pb.PeerPath_PATH_RELAY,
+			RttMs:     25,
+		},
+		{
+			Hostname: "unreachable",
+			Ip:       unreachable.String(),
+			Status:   pb.PeerStatus_CONNECTED,
+			Error:    mock.ErrOnPurpose.Error(),
+		},
+		{
+			Hostname: "offline",
+			Ip:       offline.String(),
+			Status:   pb.PeerStatus_DISCONNECTED,
+			Error:    errPeerOffline.Error(),
+		},
+	}, results)
+	assert.NotContains(t, prober.probed, offline)
+}
+
+func TestServer_GetPeerReachability(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	peer := mesh.MachinePeer{
+		Hostname:  "offline",
+		PublicKey: "offline",
+		Address:   netip.MustParseAddr("100.64.0.2"),
+	}
+	tests := []struct {
+		name         string
+		isMeshOn     bool
+		listErr      error
+		expectedResp *pb.GetPeerReachabilityResponse
+	}{
+		{
+			name: "meshnet disabled",
+			expectedResp: &pb.GetPeerReachabilityResponse{
+				Response: &pb.GetPeerReachabilityResponse_MeshnetErrorCode{
+					MeshnetErrorCode: pb.MeshnetErrorCode_NOT_ENABLED,
+				},
+			},
+		},
+		{
+			name:     "listing peers fails",
+			isMeshOn: true,
+			listErr:  mock.ErrOnPurpose,
+			expectedResp: &pb.GetPeerReachabilityResponse{
+				Response: &pb.GetPeerReachabilityResponse_ServiceErrorCode{
+					ServiceErrorCode: pb.ServiceErrorCode_API_FAILURE,
+				},
+			},
+		},
+		{
+			name:     "offline peer is not probed",
+			isMeshOn: true,
+			expectedResp: &pb.GetPeerReachabilityResponse{
+				Response: &pb.GetPeerReachabilityResponse_Reachability{
+					Reachability: &pb.PeerReachabilityList{
+						Peers: []*pb.PeerReachability{{
+							Hostname: "offline",
+							Ip:       "100.64.0.2",
+							Status:   pb.PeerStatus_DISCONNECTED,
+							Error:    errPeerOffline.Error(),
+						}},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newMockedServer(t, test.listErr, nil, nil, test.isMeshOn, []mesh.MachinePeer{peer})
+			prober := proberMock{}
+			server.prober = &prober
+
+			resp, err := server.GetPeerReachability(context.Background(), &pb.Empty{})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedResp.String(), resp.String())
+			assert.Empty(t, prober.probed)
+		})
+	}
+}
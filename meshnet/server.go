@@ -17,6 +17,7 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/core"
 	"github.com/NordSecurity/nordvpn-linux/core/mesh"
 	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/ratelimit"
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/events"
 	"github.com/NordSecurity/nordvpn-linux/fileshare/service"
@@ -47,10 +48,18 @@ type Server struct {
 	subjectPeerUpdate  events.Publisher[[]string]
 	subjectMeshSetting events.Publisher[bool]
 	subjectConnect     events.Publisher[events.DataConnect]
+	subjectExitNode    events.Publisher[events.DataExitNodeRecovery]
 	lastPeers          string
 	lastConnectedPeer  string
+	exitNode           exitNode
+	pause              meshPause
 	fileshare          service.Fileshare
+	limiter            ratelimit.Limiter
+	vpnConnector       VPNConnector
 	scheduler          *gocron.Scheduler
+	prober             Prober
+	inviteNotifier     InviteNotifier
+	invites            inviteNotifications
 	pb.UnimplementedMeshnetServer
 }
 
@@ -67,7 +76,11 @@ func NewServer(
 	subjectPeerUpdate events.Publisher[[]string],
 	subjectMeshSetting events.PublishSubcriber[bool],
 	subjectConnect events.Publisher[events.DataConnect],
+	subjectExitNode events.Publisher[events.DataExitNodeRecovery],
 	fileshare service.Fileshare,
+	limiter ratelimit.Limiter,
+	vpnConnector VPNConnector,
+	inviteNotifier InviteNotifier,
 ) *Server {
 	return &Server{
 		ac:                 ac,
@@ -81,8 +94,13 @@ func NewServer(
 		subjectPeerUpdate:  subjectPeerUpdate,
 		subjectMeshSetting: subjectMeshSetting,
 		subjectConnect:     subjectConnect,
+		subjectExitNode:    subjectExitNode,
 		fileshare:          fileshare,
+		limiter:            limiter,
+		vpnConnector:       vpnConnector,
 		scheduler:          gocron.NewScheduler(time.UTC),
+		prober:             PingProber{},
+		inviteNotifier:     inviteNotifier,
 	}
 }
 
@@ -319,19 +337,29 @@ func (s *Server) DisableMeshnet(context.Context, *pb.Empty) (*pb.MeshnetResponse
 		}, nil
 	}
 
-	if err := s.fileshare.Disable(cfg.Meshnet.EnabledByUID, cfg.Meshnet.EnabledByGID); err != nil {
-		s.pub.Publish(fmt.Errorf("disabling fileshare: %w", err))
-	}
+	if s.pause.isPaused() {
+		// everything was torn down when pausing
+		s.pause.stop()
+	} else {
+		if err := s.fileshare.Disable(cfg.Meshnet.EnabledByUID, cfg.Meshnet.EnabledByGID); err != nil {
+			s.pub.Publish(fmt.Errorf("disabling fileshare: %w", err))
+		}
 
-	// try to stop networker only if mesh peer connected before
-	if s.netw.LastServerName() == s.lastConnectedPeer {
-		if err := s.netw.Stop(); err != nil {
-			s.pub.Publish(fmt.Errorf("disconnecting: %w", err))
+		// try to stop networker only if mesh peer connected before
+		if s.netw.LastServerName() == s.lastConnectedPeer {
+			if err := s.netw.Stop(); err != nil {
+				s.pub.Publish(fmt.Errorf("disconnecting: %w", err))
+			}
+		}
+		s.exitNode.reset()
+
+		if err := s.netw.UnSetMesh(); err != nil {
+			s.pub.Publish(fmt.Errorf("unsetting mesh: %w", err))
 		}
 	}
 
-	if err := s.netw.UnSetMesh(); err != nil {
-		s.pub.Publish(fmt.Errorf("unsetting mesh: %w", err))
+	if err := s.limiter.Disable(); err != nil {
+		s.pub.Publish(fmt.Errorf("removing fileshare rate limits: %w", err))
 	}
 
 	if err := s.cm.SaveWith(func(c config.Config) config.Config {
@@ -414,7 +442,7 @@ func (s *Server) RefreshMeshnet(context.Context, *pb.Empty) (*pb.MeshnetResponse
 		}, nil
 	}
 
-	if err := s.netw.Refresh(*resp); err != nil {
+	if err := s.refreshMesh(*resp); err != nil {
 		s.pub.Publish(err)
 		return &pb.MeshnetResponse{
 			Response: &pb.MeshnetResponse_ServiceError{
@@ -652,7 +680,7 @@ func (s *Server) AcceptInvite(
 		}, nil
 	}
 
-	if err := s.netw.Refresh(*resp); err != nil {
+	if err := s.refreshMesh(*resp); err != nil {
 		s.pub.Publish(err)
 		return &pb.RespondToInviteResponse{
 			Response: &pb.RespondToInviteResponse_MeshnetErrorCode{
@@ -1059,6 +1087,7 @@ func (s *Server) GetPeers(context.Context, *pb.Empty) (*pb.GetPeersResponse, err
 		}
 
 		peers.Self = cfg.MeshDevice.ToProtobuf()
+		paused := s.pause.isPaused()
 		peerMap, err := s.netw.StatusMap()
 		if err != nil {
 			peerMap = map[string]string{}
@@ -1066,7 +1095,10 @@ func (s *Server) GetPeers(context.Context, *pb.Empty) (*pb.GetPeersResponse, err
 		for _, peer := range resp {
 			protoPeer := peer.ToProtobuf()
 			status := pb.PeerStatus_DISCONNECTED
-			if peerMap[peer.PublicKey] == "connected" {
+			switch {
+			case paused:
+				status = pb.PeerStatus_PAUSED
+			case peerMap[peer.PublicKey] == "connected":
 				status = pb.PeerStatus_CONNECTED
 			}
 			protoPeer.Status = status
@@ -1254,6 +1286,10 @@ func (s *Server) RemovePeer(
 		}
 	}
 
+	if err := s.cm.SaveWith(removeFromPeerGroups(peer.ID.String())); err != nil {
+		s.pub.Publish(err)
+	}
+
 	return &pb.RemovePeerResponse{
 		Response: &pb.RemovePeerResponse_Empty{},
 	}, nil
@@ -1385,7 +1421,7 @@ func (s *Server) ChangePeerNickname(
 		}, nil
 	}
 
-	if err := s.netw.Refresh(*mapResp); err != nil {
+	if err := s.refreshMesh(*mapResp); err != nil {
 		s.pub.Publish(err)
 		return &pb.ChangeNicknameResponse{
 			Response: &pb.ChangeNicknameResponse_ServiceErrorCode{
@@ -1568,7 +1604,7 @@ func (s *Server) ChangeMachineNickname(
 		}, nil
 	}
 
-	if err := s.netw.Refresh(*resp); err != nil {
+	if err := s.refreshMesh(*resp); err != nil {
 		s.pub.Publish(err)
 		return &pb.ChangeNicknameResponse{
 			Response: &pb.ChangeNicknameResponse_ServiceErrorCode{
@@ -1689,7 +1725,7 @@ func (s *Server) AllowIncoming(
 		}, nil
 	}
 
-	if peer.Address.IsValid() {
+	if peer.Address.IsValid() && !s.pause.isPaused() {
 		if err := s.netw.AllowIncoming(UniqueAddress{
 			UID: peer.PublicKey, Address: peer.Address,
 		}, peer.DoIAllowRouting && peer.DoIAllowLocalNetwork); err != nil {
@@ -1798,7 +1834,7 @@ func (s *Server) DenyIncoming(
 		}, nil
 	}
 
-	if peer.Address.IsValid() {
+	if peer.Address.IsValid() && !s.pause.isPaused() {
 		if err := s.netw.BlockIncoming(UniqueAddress{
 			UID: peer.PublicKey, Address: peer.Address,
 		}); err != nil {
@@ -1918,7 +1954,7 @@ func (s *Server) AllowRouting(
 		}, nil
 	}
 
-	if err := s.netw.ResetRouting(peers[index], peers); err != nil {
+	if err := s.resetRouting(peers[index], peers); err != nil {
 		s.pub.Publish(err)
 		return &pb.AllowRoutingResponse{
 			Response: &pb.AllowRoutingResponse_MeshnetErrorCode{
@@ -2034,7 +2070,7 @@ func (s *Server) DenyRouting(
 		}, nil
 	}
 
-	if err := s.netw.ResetRouting(peers[index], peers); err != nil {
+	if err := s.resetRouting(peers[index], peers); err != nil {
 		s.pub.Publish(err)
 		return &pb.DenyRoutingResponse{
 			Response: &pb.DenyRoutingResponse_MeshnetErrorCode{
@@ -2150,7 +2186,7 @@ func (s *Server) AllowLocalNetwork(
 		}, nil
 	}
 
-	if err := s.netw.ResetRouting(peers[index], peers); err != nil {
+	if err := s.resetRouting(peers[index], peers); err != nil {
 		s.pub.Publish(err)
 		return &pb.AllowLocalNetworkResponse{
 			Response: &pb.AllowLocalNetworkResponse_MeshnetErrorCode{
@@ -2266,7 +2302,7 @@ func (s *Server) DenyLocalNetwork(
 		}, nil
 	}
 
-	if err := s.netw.ResetRouting(peers[index], peers); err != nil {
+	if err := s.resetRouting(peers[index], peers); err != nil {
 		s.pub.Publish(err)
 		return &pb.DenyLocalNetworkResponse{
 			Response: &pb.DenyLocalNetworkResponse_MeshnetErrorCode{
@@ -2384,7 +2420,7 @@ func (s *Server) AllowFileshare(
 		}, nil
 	}
 
-	if peer.Address.IsValid() {
+	if peer.Address.IsValid() && !s.pause.isPaused() {
 		if err := s.netw.AllowFileshare(
 			UniqueAddress{UID: peer.PublicKey, Address: peer.Address}); err != nil {
 			return &pb.AllowFileshareResponse{
@@ -2504,7 +2540,7 @@ func (s *Server) DenyFileshare(
 		}, nil
 	}
 
-	if peer.Address.IsValid() {
+	if peer.Address.IsValid() && !s.pause.isPaused() {
 		if err := s.netw.BlockFileshare(
 			UniqueAddress{UID: peer.PublicKey, Address: peer.Address}); err != nil {
 			return &pb.DenyFileshareResponse{
@@ -2876,6 +2912,14 @@ func (s *Server) Connect(
 		}, nil
 	}
 
+	if s.pause.isPaused() {
+		return &pb.ConnectResponse{
+			Response: &pb.ConnectResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_MESHNET_PAUSED,
+			},
+		}, nil
+	}
+
 	if cfg.Technology != config.Technology_NORDLYNX {
 		return &pb.ConnectResponse{
 			Response: &pb.ConnectResponse_MeshnetErrorCode{
@@ -2949,6 +2993,30 @@ func (s *Server) Connect(
 		}, nil
 	}
 
+	if err := s.connectToPeer(cfg, peer); err != nil {
+		if strings.Contains(err.Error(), "already started") {
+			return &pb.ConnectResponse{
+				Response: &pb.ConnectResponse_ConnectErrorCode{
+					ConnectErrorCode: pb.ConnectErrorCode_ALREADY_CONNECTED,
+				},
+			}, nil
+		}
+		s.pub.Publish(fmt.Errorf("starting networker: %w", err))
+		return &pb.ConnectResponse{
+			Response: &pb.ConnectResponse_ConnectErrorCode{
+				ConnectErrorCode: pb.ConnectErrorCode_CONNECT_FAILED,
+			},
+		}, nil
+	}
+
+	return &pb.ConnectResponse{
+		Response: &pb.ConnectResponse_Empty{},
+	}, nil
+}
+
+// connectToPeer routes the traffic through the given peer and starts
+// monitoring it as an exit node
+func (s *Server) connectToPeer(cfg config.Config, peer mesh.MachinePeer) error {
 	var nameservers []string
 	if cfg.AutoConnectData.DNS != nil {
 		nameservers = cfg.AutoConnectData.DNS
@@ -2968,33 +3036,20 @@ func (s *Server) Connect(
 			Hostname:          peer.Hostname,
 			Protocol:          config.Protocol_UDP,
 			NordLynxPublicKey: peer.PublicKey,
+			Technology:        config.Technology_NORDLYNX,
 		},
 		cfg.AutoConnectData.Allowlist,
 		nameservers,
 		!peer.DoesPeerAllowLocalNetwork, // enableLocalTraffic if target peer does not permit its LAN access
 	); err != nil {
-		if strings.Contains(err.Error(), "already started") {
-			return &pb.ConnectResponse{
-				Response: &pb.ConnectResponse_ConnectErrorCode{
-					ConnectErrorCode: pb.ConnectErrorCode_ALREADY_CONNECTED,
-				},
-			}, nil
-		}
-		s.pub.Publish(fmt.Errorf("starting networker: %w", err))
-		return &pb.ConnectResponse{
-			Response: &pb.ConnectResponse_ConnectErrorCode{
-				ConnectErrorCode: pb.ConnectErrorCode_CONNECT_FAILED,
-			},
-		}, nil
+		return err
 	}
 	s.lastConnectedPeer = peer.Hostname
+	s.exitNode.set(peer)
 	s.subjectConnect.Publish(events.DataConnect{
 		IsMeshnetPeer: true,
 	})
-
-	return &pb.ConnectResponse{
-		Response: &pb.ConnectResponse_Empty{},
-	}, nil
+	return nil
 }
 
 // GetPrivateKey returns self private key
@@ -113,7 +113,11 @@ func (*workingNetworker) Refresh(mesh.MachineMap) error    { return nil }
 func (*workingNetworker) StatusMap() (map[string]string, error) {
 	return map[string]string{}, nil
 }
+func (*workingNetworker) PathMap() (map[string]string, error) {
+	return map[string]string{}, nil
+}
 func (*workingNetworker) LastServerName() string { return "" }
+func (*workingNetworker) IsVPNActive() bool      { return false }
 
 type invitationsAPI struct{}
 
@@ -194,7 +198,11 @@ func newMockedServer(
 		&subs.Subject[[]string]{},
 		&subs.Subject[bool]{},
 		&subs.Subject[events.DataConnect]{},
+		&subs.Subject[events.DataExitNodeRecovery]{},
 		service.NoopFileshare{},
+		&mockLimiter{},
+		nil,
+		nil,
 	)
 
 	if isMeshOn {
@@ -259,7 +267,11 @@ func TestServer_EnableMeshnet(t *testing.T) {
 				&subs.Subject[[]string]{},
 				&subs.Subject[bool]{},
 				&subs.Subject[events.DataConnect]{},
+				&subs.Subject[events.DataExitNodeRecovery]{},
 				test.fileshare,
+				&mockLimiter{},
+				nil,
+				nil,
 			)
 			assert.NotEqual(t, nil, mserver)
 			assert.Equal(t, test.cm, mserver.cm)
@@ -338,7 +350,11 @@ func TestServer_DisableMeshnet(t *testing.T) {
 				&subs.Subject[[]string]{},
 				&subs.Subject[bool]{},
 				&subs.Subject[events.DataConnect]{},
+				&subs.Subject[events.DataExitNodeRecovery]{},
 				test.fileshare,
+				&mockLimiter{},
+				nil,
+				nil,
 			)
 			assert.NotEqual(t, nil, mserver)
 			assert.Equal(t, test.cm, mserver.cm)
@@ -396,7 +412,11 @@ func TestServer_Invite(t *testing.T) {
 				&subs.Subject[[]string]{},
 				&subs.Subject[bool]{},
 				&subs.Subject[events.DataConnect]{},
+				&subs.Subject[events.DataExitNodeRecovery]{},
 				service.NoopFileshare{},
+				&mockLimiter{},
+				nil,
+				nil,
 			)
 			server.EnableMeshnet(context.Background(), &pb.Empty{})
 			resp, err := server.Invite(context.Background(), &pb.InviteRequest{})
@@ -425,7 +445,11 @@ func TestServer_AcceptInvite(t *testing.T) {
 		&subs.Subject[[]string]{},
 		&subs.Subject[bool]{},
 		&subs.Subject[events.DataConnect]{},
+		&subs.Subject[events.DataExitNodeRecovery]{},
 		service.NoopFileshare{},
+		&mockLimiter{},
+		nil,
+		nil,
 	)
 	server.EnableMeshnet(context.Background(), &pb.Empty{})
 	resp, err := server.AcceptInvite(context.Background(), &pb.InviteRequest{
@@ -454,7 +478,11 @@ func TestServer_GetPeersIPHandling(t *testing.T) {
 		&subs.Subject[[]string]{},
 		&subs.Subject[bool]{},
 		&subs.Subject[events.DataConnect]{},
+		&subs.Subject[events.DataExitNodeRecovery]{},
 		service.NoopFileshare{},
+		&mockLimiter{},
+		nil,
+		nil,
 	)
 	server.EnableMeshnet(context.Background(), &pb.Empty{})
 
@@ -555,7 +583,11 @@ func TestServer_Connect(t *testing.T) {
 			&subs.Subject[[]string]{},
 			&subs.Subject[bool]{},
 			&subs.Subject[events.DataConnect]{},
+			&subs.Subject[events.DataExitNodeRecovery]{},
 			service.NoopFileshare{},
+			&mockLimiter{},
+			nil,
+			nil,
 		)
 		server.EnableMeshnet(context.Background(), &pb.Empty{})
 		return server
@@ -689,7 +721,11 @@ func TestServer_AcceptIncoming(t *testing.T) {
 			&subs.Subject[[]string]{},
 			&subs.Subject[bool]{},
 			&subs.Subject[events.DataConnect]{},
+			&subs.Subject[events.DataExitNodeRecovery]{},
 			service.NoopFileshare{},
+			&mockLimiter{},
+			nil,
+			nil,
 		)
 		server.EnableMeshnet(context.Background(), &pb.Empty{})
 		return server, &networker
@@ -813,7 +849,11 @@ func TestServer_DenyIncoming(t *testing.T) {
 			&subs.Subject[[]string]{},
 			&subs.Subject[bool]{},
 			&subs.Subject[events.DataConnect]{},
+			&subs.Subject[events.DataExitNodeRecovery]{},
 			service.NoopFileshare{},
+			&mockLimiter{},
+			nil,
+			nil,
 		)
 		server.EnableMeshnet(context.Background(), &pb.Empty{})
 		return server, &networker
@@ -919,7 +959,11 @@ func TestServer_AllowFileshare(t *testing.T) {
 			&subs.Subject[[]string]{},
 			&subs.Subject[bool]{},
 			&subs.Subject[events.DataConnect]{},
+			&subs.Subject[events.DataExitNodeRecovery]{},
 			service.NoopFileshare{},
+			&mockLimiter{},
+			nil,
+			nil,
 		)
 		server.EnableMeshnet(context.Background(), &pb.Empty{})
 		return server, &networker
@@ -1025,7 +1069,11 @@ func TestServer_DenyFileshare(t *testing.T) {
 			&subs.Subject[[]string]{},
 			&subs.Subject[bool]{},
 			&subs.Subject[events.DataConnect]{},
+			&subs.Subject[events.DataExitNodeRecovery]{},
 			service.NoopFileshare{},
+			&mockLimiter{},
+			nil,
+			nil,
 		)
 		server.EnableMeshnet(context.Background(), &pb.Empty{})
 		return server, &networker
@@ -1635,7 +1683,11 @@ func TestServer_Peer_Nickname(t *testing.T) {
 				&subs.Subject[[]string]{},
 				&subs.Subject[bool]{},
 				&subs.Subject[events.DataConnect]{},
+				&subs.Subject[events.DataExitNodeRecovery]{},
 				service.NoopFileshare{},
+				&mockLimiter{},
+				nil,
+				nil,
 			)
 
 			if test.isMeshOn {
@@ -1947,7 +1999,11 @@ func TestServer_Current_Machine_Nickname(t *testing.T) {
 				&subs.Subject[[]string]{},
 				&subs.Subject[bool]{},
 				&subs.Subject[events.DataConnect]{},
+				&subs.Subject[events.DataExitNodeRecovery]{},
 				service.NoopFileshare{},
+				&mockLimiter{},
+				nil,
+				nil,
 			)
 
 			if test.isMeshOn {
@@ -0,0 +1,174 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/device"
+)
+
+const (
+	// nat64DiscoveryDomain resolves only to IPv4 addresses, so any IPv6 address
+	// returned for it is synthesized by DNS64 (RFC 7050)
+	nat64DiscoveryDomain = "ipv4only.arpa"
+	// nat64CacheDuration is how long the discovered prefix is reused, as it is
+	// needed for each API call
+	nat64CacheDuration = time.Minute
+	nat64LookupTimeout = 5 * time.Second
+)
+
+// nat64WellKnownIPs are the IPv4 addresses of ipv4only.arpa
+var nat64WellKnownIPs = []netip.Addr{
+	netip.AddrFrom4([4]byte{192, 0, 0, 170}),
+	netip.AddrFrom4([4]byte{192, 0, 0, 171}),
+}
+
+// nat64Layouts are the positions of the IPv4 address octets in the IPv6
+// address for each of the prefix lengths allowed by RFC 6052. Octet 8 is
+// reserved and must be zero.
+var nat64Layouts = []struct {
+	bits   int
+	octets [4]int
+}{
+	{bits: 96, octets: [4]int{12, 13, 14, 15}},
+	{bits: 64, octets: [4]int{9, 10, 11, 12}},
+	{bits: 56, octets: [4]int{7, 9, 10, 11}},
+	{bits: 48, octets: [4]int{6, 7, 9, 10}},
+	{bits: 40, octets: [4]int{5, 6, 7, 9}},
+	{bits: 32, octets: [4]int{4, 5, 6, 7}},
+}
+
+// NAT64Detector finds out whether IPv4 addresses have to be reached through
+// NAT64 on the current network
+type NAT64Detector interface {
+	// Prefix returns NAT64 prefix and true if the network is IPv6-only and
+	// the prefix was discovered
+	Prefix() (netip.Prefix, bool)
+}
+
+// NAT64 discovers NAT64 prefix of IPv6-only networks as described in RFC 7050
+type NAT64 struct {
+	hasIPv4   func() bool
+	lookup    func(domain string) ([]netip.Addr, error)
+	now       func() time.Time
+	mu        sync.Mutex
+	prefix    netip.Prefix
+	checkedAt time.Time
+}
+
+func NewNAT64() *NAT64 {
+	return &NAT64{
+		hasIPv4: hasIPv4DefaultRoute,
+		lookup:  lookupIPv6,
+		now:     time.Now,
+	}
+}
+
+// Prefix discovers the prefix or returns the cached one
+func (n *NAT64) Prefix() (netip.Prefix, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	now := n.now()
+	if n.checkedAt.IsZero() || now.Sub(n.checkedAt) >= nat64CacheDuration {
+		n.prefix = n.discover()
+		n.checkedAt = now
+	}
+	return n.prefix, n.prefix.IsValid()
+}
+
+func (n *NAT64) discover() netip.Prefix {
+	// NAT64 is only needed when IPv4 is not reachable directly
+	if n.hasIPv4() {
+		return netip.Prefix{}
+	}
+	ips, err := n.lookup(nat64DiscoveryDomain)
+	if err != nil {
+		return netip.Prefix{}
+	}
+	for _, ip := range ips {
+		if prefix, ok := NAT64Prefix(ip); ok {
+			return prefix
+		}
+	}
+	return netip.Prefix{}
+}
+
+// NAT64Prefix extracts the prefix from the IPv6 address synthesized for
+// ipv4only.arpa
+func NAT64Prefix(ip netip.Addr) (netip.Prefix, bool) {
+	if !ip.Is6() || ip.Is4In6() {
+		return netip.Prefix{}, false
+	}
+	octets := ip.As16()
+	for _, layout := range nat64Layouts {
+		if layout.bits != 96 && octets[8] != 0 {
+			continue
+		}
+		embedded := netip.AddrFrom4([4]byte{
+			octets[layout.octets[0]],
+			octets[layout.octets[1]],
+			octets[layout.octets[2]],
+			octets[layout.octets[3]],
+		})
+		for _, wellKnown := range nat64WellKnownIPs {
+			if embedded == wellKnown {
+				return netip.PrefixFrom(ip, layout.bits).Masked(), true
+			}
+		}
+	}
+	return netip.Prefix{}, false
+}
+
+// SynthesizeNAT64 embeds the IPv4 address into the NAT64 prefix as described
+// in RFC 6052
+func SynthesizeNAT64(prefix netip.Prefix, ip netip.Addr) (netip.Addr, error) {
+	ip = ip.Unmap()
+	if !ip.Is4() {
+		return netip.Addr{}, fmt.Errorf("%s is not an IPv4 address", ip)
+	}
+	for _, layout := range nat64Layouts {
+		if layout.bits != prefix.Bits() {
+			continue
+		}
+		octets := prefix.Masked().Addr().As16()
+		for i, octet := range ip.As4() {
+			octets[layout.octets[i]] = octet
+		}
+		return netip.AddrFrom16(octets), nil
+	}
+	return netip.Addr{}, fmt.Errorf("invalid NAT64 prefix %s", prefix)
+}
+
+// ThroughNAT64 returns the addresses reachable on IPv6-only network. IPv6
+// addresses are kept and go first, IPv4 ones are synthesized.
+func ThroughNAT64(prefix netip.Prefix, ips []netip.Addr) []netip.Addr {
+	var native, synthesized []netip.Addr
+	for _, ip := range ips {
+		if ip.Unmap().Is4() {
+			if ip6, err := SynthesizeNAT64(prefix, ip); err == nil {
+				synthesized = append(synthesized, ip6)
+			}
+			continue
+		}
+		native = append(native, ip)
+	}
+	return append(native, synthesized...)
+}
+
+func hasIPv4DefaultRoute() bool {
+	_, err := device.DefaultGateway(false)
+	return err == nil
+}
+
+// lookupIPv6 resolves the domain using the nameservers of the system, as only
+// they are able to synthesize the addresses
+func lookupIPv6(domain string) ([]netip.Addr, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), nat64LookupTimeout)
+	defer cancel()
+	return net.DefaultResolver.LookupNetIP(ctx, "ip6", domain)
+}
@@ -0,0 +1,135 @@
+package network
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNAT64Prefix(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name   string
+		ip     string
+		prefix string
+		ok     bool
+	}{
+		{name: "well-known prefix", ip: "64:ff9b::c000:aa", prefix: "64:ff9b::/96", ok: true},
+		{name: "second well-known address", ip: "64:ff9b::c000:ab", prefix: "64:ff9b::/96", ok: true},
+		{name: "network specific /64", ip: "2001:db8:1:2:c0:0:aa00:0", prefix: "2001:db8:1:2::/64", ok: true},
+		{name: "network specific /56", ip: "2001:db8:1:2c0:0:aa::", prefix: "2001:db8:1:200::/56", ok: true},
+		{name: "network specific /48", ip: "2001:db8:1:c000:0:aa00::", prefix: "2001:db8:1::/48", ok: true},
+		{name: "network specific /40", ip: "2001:db8:1c0:0:aa::", prefix: "2001:db8:100::/40", ok: true},
+		{name: "network specific /32", ip: "2001:db8:c000:aa::", prefix: "2001:db8::/32", ok: true},
+		{name: "not synthesized", ip: "2001:db8::1"},
+		{name: "ipv4", ip: "192.0.0.170"},
+		{name: "ipv4 mapped", ip: "::ffff:192.0.0.170"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			prefix, ok := NAT64Prefix(netip.MustParseAddr(test.ip))
+			assert.Equal(t, test.ok, ok)
+			if test.ok {
+				assert.Equal(t, netip.MustParsePrefix(test.prefix), prefix)
+			}
+		})
+	}
+}
+
+func TestSynthesizeNAT64(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		prefix   string
+		ip       string
+		expected string
+		hasError bool
+	}{
+		{prefix: "64:ff9b::/96", ip: "192.0.2.33", expected: "64:ff9b::c000:221"},
+		{prefix: "2001:db8:100::/40", ip: "192.0.2.33", expected: "2001:db8:1c0:2:21::"},
+		{prefix: "2001:db8:122:300::/56", ip: "192.0.2.33", expected: "2001:db8:122:3c0:0:221::"},
+		{prefix: "2001:db8::/32", ip: "::ffff:192.0.2.33", expected: "2001:db8:c000:221::"},
+		{prefix: "2001:db8::/33", ip: "192.0.2.33", hasError: true},
+		{prefix: "64:ff9b::/96", ip: "2001:db8::1", hasError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.prefix+" "+test.ip, func(t *testing.T) {
+			ip, err := SynthesizeNAT64(netip.MustParsePrefix(test.prefix), netip.MustParseAddr(test.ip))
+			if test.hasError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, netip.MustParseAddr(test.expected), ip)
+		})
+	}
+}
+
+func TestThroughNAT64(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	ips := ThroughNAT64(netip.MustParsePrefix("64:ff9b::/96"), []netip.Addr{
+		netip.MustParseAddr("192.0.2.33"),
+		netip.MustParseAddr("2001:db8::1"),
+	})
+	assert.Equal(t, []netip.Addr{
+		netip.MustParseAddr("2001:db8::1"),
+		netip.MustParseAddr("64:ff9b::c000:221"),
+	}, ips)
+}
+
+func TestNAT64_Prefix(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name    string
+		hasIPv4 bool
+		ips     []string
+		err     error
+		prefix  string
+	}{
+		{name: "ipv4 network", hasIPv4: true, ips: []string{"64:ff9b::c000:aa"}},
+		{name: "ipv6-only network", ips: []string{"2001:db8::1", "64:ff9b::c000:aa"}, prefix: "64:ff9b::/96"},
+		{name: "no dns64", err: errors.New("no such host")},
+		{name: "no synthesized addresses", ips: []string{"2001:db8::1"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			now := time.Now()
+			lookups := 0
+			nat64 := &NAT64{
+				hasIPv4: func() bool { return test.hasIPv4 },
+				lookup: func(domain string) ([]netip.Addr, error) {
+					assert.Equal(t, nat64DiscoveryDomain, domain)
+					lookups++
+					return StringsToIPs(test.ips), test.err
+				},
+				now: func() time.Time { return now },
+			}
+
+			prefix, ok := nat64.Prefix()
+			assert.Equal(t, test.prefix != "", ok)
+			if ok {
+				assert.Equal(t, netip.MustParsePrefix(test.prefix), prefix)
+			}
+
+			// result is cached
+			calls := lookups
+			nat64.Prefix()
+			assert.Equal(t, calls, lookups)
+			now = now.Add(nat64CacheDuration)
+			nat64.Prefix()
+			if !test.hasIPv4 {
+				assert.Equal(t, calls+1, lookups)
+			}
+		})
+	}
+}
@@ -15,13 +15,15 @@ import (
 type Resolver struct {
 	fw      firewall.Service
 	servers dns.Getter
+	nat64   NAT64Detector
 	sync.Mutex
 }
 
-func NewResolver(fw firewall.Service, servers dns.Getter) *Resolver {
+func NewResolver(fw firewall.Service, servers dns.Getter, nat64 NAT64Detector) *Resolver {
 	return &Resolver{
 		fw:      fw,
 		servers: servers,
+		nat64:   nat64,
 	}
 }
 
@@ -30,8 +32,20 @@ type DNSResolver interface {
 }
 
 func (r *Resolver) Resolve(domain string) ([]netip.Addr, error) {
-	nameservers := r.servers.Get(false, false)
-	return r.ResolveWithNameservers(domain, StringsToIPs(nameservers), "udp")
+	prefix, ok := r.nat64.Prefix()
+	if !ok {
+		nameservers := r.servers.Get(false, false)
+		return r.ResolveWithNameservers(domain, StringsToIPs(nameservers), "udp")
+	}
+
+	// on IPv6-only network both the nameservers and the domain can only be
+	// reached over IPv6
+	nameservers := ThroughNAT64(prefix, StringsToIPs(r.servers.Get(false, true)))
+	ips, err := r.ResolveWithNameservers(domain, nameservers, "udp")
+	if err != nil {
+		return nil, err
+	}
+	return ThroughNAT64(prefix, ips), nil
 }
 
 func (r *Resolver) ResolveWithNameservers(domain string, nameservers []netip.Addr, protocol string) ([]netip.Addr, error) {
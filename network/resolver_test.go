@@ -16,11 +16,17 @@ type workingAgent struct{}
 
 func (workingAgent) Add(firewall.Rule) error    { return nil }
 func (workingAgent) Delete(firewall.Rule) error { return nil }
+func (workingAgent) Exists(firewall.Rule) (bool, error) {
+	return true, nil
+}
 
 type failingAgent struct{}
 
 func (failingAgent) Add(firewall.Rule) error    { return mock.ErrOnPurpose }
 func (failingAgent) Delete(firewall.Rule) error { return mock.ErrOnPurpose }
+func (failingAgent) Exists(firewall.Rule) (bool, error) {
+	return false, mock.ErrOnPurpose
+}
 
 func TestAllowlistIP(t *testing.T) {
 	category.Set(t, category.Route)
@@ -0,0 +1,286 @@
+package networker
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"net"
+	"net/netip"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"golang.org/x/exp/slices"
+	"golang.org/x/net/dns/dnsmessage"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// resolvConfPath lists the nameservers used to resolve the allowlisted domains
+	resolvConfPath = "/etc/resolv.conf"
+	// minDomainTTL prevents re-resolving the allowlisted domains too often
+	minDomainTTL = 30 * time.Second
+	// maxDomainTTL makes sure that the address changes are noticed eventually
+	maxDomainTTL = time.Hour
+	// domainRetryInterval is waited before resolving the domain again after a
+	// failure
+	domainRetryInterval = time.Minute
+	// domainLookupTimeout bounds a single query to the nameserver
+	domainLookupTimeout = 5 * time.Second
+	// maxUDPMessageSize is the largest DNS response read
+	maxUDPMessageSize = 4096
+)
+
+// domainResolver returns the addresses of the domain and how long they are valid
+type domainResolver func(domain string) ([]netip.Addr, time.Duration, error)
+
+// domainAddrs are the resolved addresses of the allowlisted domain
+type domainAddrs struct {
+	addrs   []netip.Addr
+	expires time.Time
+}
+
+// resolveWithTTL queries the system nameservers for the domains. Queries are
+// marked with fwmark, so they bypass the tunnel the same way as the traffic to
+// the allowlisted addresses.
+func resolveWithTTL(fwmark uint32) domainResolver {
+	return func(domain string) ([]netip.Addr, time.Duration, error) {
+		nameservers, err := systemNameservers(resolvConfPath)
+		if err != nil {
+			return nil, 0, err
+		}
+		var errs []error
+		for _, nameserver := range nameservers {
+			addrs, ttl, err := lookupWithTTL(domain, nameserver, fwmark)
+			if err == nil {
+				return addrs, ttl, nil
+			}
+			errs = append(errs, fmt.Errorf("%s: %w", nameserver, err))
+		}
+		return nil, 0, errors.Join(errs...)
+	}
+}
+
+// systemNameservers returns the nameservers from resolv.conf
+func systemNameservers(path string) ([]netip.Addr, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var nameservers []netip.Addr
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != "nameserver" {
+			continue
+		}
+		// scope of the link local nameservers is not supported
+		if addr, err := netip.ParseAddr(fields[1]); err == nil && addr.Zone() == "" {
+			nameservers = append(nameservers, addr)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(nameservers) == 0 {
+		return nil, fmt.Errorf("no nameservers in %s", path)
+	}
+	return nameservers, nil
+}
+
+// lookupWithTTL resolves IPv4 and IPv6 addresses of the domain. Returned TTL is
+// the smallest TTL of the answers.
+func lookupWithTTL(domain string, nameserver netip.Addr, fwmark uint32) ([]netip.Addr, time.Duration, error) {
+	name, err := dnsmessage.NewName(strings.TrimSuffix(domain, ".") + ".")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), domainLookupTimeout)
+	defer cancel()
+	dialer := net.Dialer{
+		Control: func(network, address string, conn syscall.RawConn) error {
+			var operr error
+			if err := conn.Control(func(fd uintptr) {
+				operr = syscall.SetsockoptInt(int(fd), unix.SOL_SOCKET, unix.SO_MARK, int(fwmark))
+			}); err != nil {
+				return err
+			}
+			return operr
+		},
+	}
+	conn, err := dialer.DialContext(ctx, "udp", netip.AddrPortFrom(nameserver, 53).String())
+	if err != nil {
+		return nil, 0, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	var addrs []netip.Addr
+	ttl := uint32(math.MaxUint32)
+	for _, qtype := range []dnsmessage.Type{dnsmessage.TypeA, dnsmessage.TypeAAAA} {
+		answers, err := exchange(conn, dnsmessage.Question{Name: name, Type: qtype, Class: dnsmessage.ClassINET})
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, answer := range answers {
+			var addr netip.Addr
+			switch body := answer.Body.(type) {
+			case *dnsmessage.AResource:
+				addr = netip.AddrFrom4(body.A)
+			case *dnsmessage.AAAAResource:
+				addr = netip.AddrFrom16(body.AAAA)
+			default:
+				continue
+			}
+			if !slices.Contains(addrs, addr) {
+				addrs = append(addrs, addr)
+			}
+			if answer.Header.TTL < ttl {
+				ttl = answer.Header.TTL
+			}
+		}
+	}
+	if len(addrs) == 0 {
+		return nil, 0, fmt.Errorf("no addresses found for %s", domain)
+	}
+	return addrs, time.Duration(ttl) * time.Second, nil
+}
+
+// exchange sends the question and returns the answers
+func exchange(conn net.Conn, question dnsmessage.Question) ([]dnsmessage.Resource, error) {
+	// #nosec G404 -- query ID only has to differ between the queries
+	id := uint16(rand.Uint32())
+	query := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: id, RecursionDesired: true},
+		Questions: []dnsmessage.Question{question},
+	}
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := conn.Write(packed); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, maxUDPMessageSize)
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		var resp dnsmessage.Message
+		// responses to the other queries are skipped
+		if err := resp.Unpack(buf[:n]); err != nil || resp.ID != id || !resp.Response {
+			continue
+		}
+		switch resp.RCode {
+		case dnsmessage.RCodeSuccess:
+			return resp.Answers, nil
+		case dnsmessage.RCodeNameError:
+			return nil, fmt.Errorf("domain %s does not exist", question.Name)
+		default:
+			return nil, fmt.Errorf("nameserver responded with %s", resp.RCode)
+		}
+	}
+}
+
+// resolveAllowlistDomains returns the subnets of the addresses of the
+// allowlisted domains. Domains are resolved again when their addresses expire,
+// the previous addresses are kept if that fails. Returns true if the addresses
+// have changed.
+func (netw *Combined) resolveAllowlistDomains(domains config.Domains) ([]netip.Prefix, bool) {
+	now := time.Now()
+	changed := false
+	resolved := make(map[string]domainAddrs, len(domains))
+	for domain := range domains {
+		entry, ok := netw.allowlistDomains[domain]
+		if !ok || !now.Before(entry.expires) {
+			addrs, ttl, err := netw.resolveDomain(domain)
+			if err != nil {
+				log.Println(internal.WarningPrefix, "resolving allowlisted domain", domain+":", err)
+				entry.expires = now.Add(domainRetryInterval)
+			} else {
+				slices.SortFunc(addrs, func(a, b netip.Addr) bool { return a.Less(b) })
+				changed = changed || !slices.Equal(entry.addrs, addrs)
+				entry = domainAddrs{addrs: addrs, expires: now.Add(clampDomainTTL(ttl))}
+			}
+		}
+		resolved[domain] = entry
+	}
+	netw.allowlistDomains = resolved
+
+	var subnets []netip.Prefix
+	for _, entry := range resolved {
+		for _, addr := range entry.addrs {
+			subnets = append(subnets, netip.PrefixFrom(addr, addr.BitLen()))
+		}
+	}
+	return subnets, changed
+}
+
+func clampDomainTTL(ttl time.Duration) time.Duration {
+	if ttl < minDomainTTL {
+		return minDomainTTL
+	}
+	if ttl > maxDomainTTL {
+		return maxDomainTTL
+	}
+	return ttl
+}
+
+// scheduleDomainRefresh resolves the allowlisted domains again when the first
+// of their addresses expire
+func (netw *Combined) scheduleDomainRefresh() {
+	netw.stopDomainRefresh()
+	var next time.Time
+	for _, entry := range netw.allowlistDomains {
+		if next.IsZero() || entry.expires.Before(next) {
+			next = entry.expires
+		}
+	}
+	if next.IsZero() {
+		return
+	}
+	netw.domainRefresh = time.AfterFunc(time.Until(next), netw.refreshAllowlistDomains)
+}
+
+func (netw *Combined) stopDomainRefresh() {
+	if netw.domainRefresh != nil {
+		netw.domainRefresh.Stop()
+		netw.domainRefresh = nil
+	}
+}
+
+// refreshAllowlistDomains updates the routes and the firewall rules if the
+// addresses of the allowlisted domains have changed
+func (netw *Combined) refreshAllowlistDomains() {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+	if !netw.isNetworkSet || len(netw.allowlist.Domains) == 0 {
+		return
+	}
+
+	if _, changed := netw.resolveAllowlistDomains(netw.allowlist.Domains); !changed {
+		netw.scheduleDomainRefresh()
+		return
+	}
+	log.Println(internal.InfoPrefix, "addresses of the allowlisted domains have changed")
+	if err := netw.resetAllowlist(); err != nil {
+		log.Println(internal.ErrorPrefix, "updating allowlisted domains:", err)
+		netw.scheduleDomainRefresh()
+	}
+}
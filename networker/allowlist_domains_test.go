@@ -0,0 +1,156 @@
+package networker
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/events/subs"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingResolver returns the addresses assigned to the domains
+type countingResolver struct {
+	addrs map[string][]netip.Addr
+	ttl   time.Duration
+	calls int
+}
+
+func (r *countingResolver) resolve(domain string) ([]netip.Addr, time.Duration, error) {
+	r.calls++
+	addrs, ok := r.addrs[domain]
+	if !ok {
+		return nil, 0, mock.ErrOnPurpose
+	}
+	return append([]netip.Addr{}, addrs...), r.ttl, nil
+}
+
+func TestResolveAllowlistDomains(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	resolver := &countingResolver{
+		addrs: map[string][]netip.Addr{
+			"intranet.corp.com": {netip.MustParseAddr("198.51.100.2"), netip.MustParseAddr("2001:db8::1")},
+		},
+		ttl: time.Second,
+	}
+	netw := &Combined{resolveDomain: resolver.resolve}
+	domains := config.NewDomains([]string{"intranet.corp.com"})
+
+	subnets, changed := netw.resolveAllowlistDomains(domains)
+	assert.True(t, changed)
+	assert.ElementsMatch(t, []netip.Prefix{
+		netip.MustParsePrefix("198.51.100.2/32"),
+		netip.MustParsePrefix("2001:db8::1/128"),
+	}, subnets)
+	// short TTL is extended to the minimum
+	assert.WithinDuration(t, time.Now().Add(minDomainTTL), netw.allowlistDomains["intranet.corp.com"].expires, time.Second)
+
+	// addresses are not resolved again before they expire
+	_, changed = netw.resolveAllowlistDomains(domains)
+	assert.False(t, changed)
+	assert.Equal(t, 1, resolver.calls)
+
+	// previous addresses are kept if the domain cannot be resolved anymore
+	entry := netw.allowlistDomains["intranet.corp.com"]
+	entry.expires = time.Now()
+	netw.allowlistDomains["intranet.corp.com"] = entry
+	delete(resolver.addrs, "intranet.corp.com")
+	subnets, changed = netw.resolveAllowlistDomains(domains)
+	assert.False(t, changed)
+	assert.Len(t, subnets, 2)
+
+	// expired addresses are replaced
+	entry = netw.allowlistDomains["intranet.corp.com"]
+	entry.expires = time.Now()
+	netw.allowlistDomains["intranet.corp.com"] = entry
+	resolver.addrs["intranet.corp.com"] = []netip.Addr{netip.MustParseAddr("198.51.100.3")}
+	subnets, changed = netw.resolveAllowlistDomains(domains)
+	assert.True(t, changed)
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("198.51.100.3/32")}, subnets)
+
+	// removed domains are forgotten
+	subnets, _ = netw.resolveAllowlistDomains(nil)
+	assert.Empty(t, subnets)
+	assert.Empty(t, netw.allowlistDomains)
+}
+
+func TestCombined_SetAllowlistDomains(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fw := newWorkingFirewall()
+	netw := NewCombined(
+		nil,
+		nil,
+		nil,
+		workingGateway{},
+		workingDefaultRoutes{},
+		&subs.Subject[string]{},
+		workingRouter{},
+		&workingDNS{},
+		&workingIpv6{},
+		fw,
+		workingTemplates{},
+		workingSplitTunnel{},
+		workingAllowlistRouting{},
+		workingDeviceList,
+		&workingRoutingSetup{},
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		false,
+		config.DefaultRouteReplace,
+		true,
+	)
+	resolver := &countingResolver{
+		addrs: map[string][]netip.Addr{"intranet.corp.com": {netip.MustParseAddr("198.51.100.2")}},
+		ttl:   time.Hour,
+	}
+	netw.resolveDomain = resolver.resolve
+
+	allowlist := config.NewAllowlist(nil, nil, []string{"1.1.1.1/32"})
+	allowlist.Domains = config.NewDomains([]string{"intranet.corp.com", "unknown.corp.com"})
+	require.NoError(t, netw.setAllowlist(allowlist))
+	defer netw.stopDomainRefresh()
+
+	assert.ElementsMatch(t, []netip.Prefix{
+		netip.MustParsePrefix("1.1.1.1/32"),
+		netip.MustParsePrefix("198.51.100.2/32"),
+	}, fw.rules["allowlist_subnets"].RemoteNetworks)
+	assert.NotNil(t, netw.domainRefresh)
+
+	require.NoError(t, netw.unsetAllowlist())
+	assert.Nil(t, netw.domainRefresh)
+}
+
+func TestSystemNameservers(t *testing.T) {
+	category.Set(t, category.File)
+
+	path := filepath.Join(t.TempDir(), "resolv.conf")
+	require.NoError(t, os.WriteFile(path, []byte(`# generated
+nameserver 127.0.0.53
+nameserver fe80::1%eth0
+nameserver 2001:db8::53
+options edns0
+search corp.com
+`), 0600))
+
+	nameservers, err := systemNameservers(path)
+	require.NoError(t, err)
+	assert.Equal(t, []netip.Addr{
+		netip.MustParseAddr("127.0.0.53"),
+		netip.MustParseAddr("2001:db8::53"),
+	}, nameservers)
+
+	require.NoError(t, os.WriteFile(path, []byte("search corp.com\n"), 0600))
+	_, err = systemNameservers(path)
+	assert.Error(t, err)
+}
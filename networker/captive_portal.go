@@ -0,0 +1,88 @@
+package networker
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+)
+
+const (
+	// captivePortalTCPRule allows DNS, HTTP and HTTPS to the captive portal
+	captivePortalTCPRule = "captive_portal_tcp"
+	// captivePortalUDPRule allows DNS to the captive portal
+	captivePortalUDPRule = "captive_portal_udp"
+	// captivePortalDHCPRule allows DHCP, which is broadcast, so it is not
+	// limited to the captive portal
+	captivePortalDHCPRule = "captive_portal_dhcp"
+)
+
+// AllowCaptivePortal opens the kill switch for the sign-in to the captive
+// portal. Only DHCP and DNS, HTTP and HTTPS to the given addresses, e.g. the
+// gateway and the portal server, are allowed.
+func (netw *Combined) AllowCaptivePortal(addrs []netip.Addr) error {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+
+	ifaces, err := netw.devices()
+	if err != nil {
+		return err
+	}
+	var networks []netip.Prefix
+	for _, addr := range addrs {
+		networks = append(networks, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+
+	if err := netw.blockCaptivePortal(); err != nil {
+		return err
+	}
+	if err := netw.fw.Add([]firewall.Rule{
+		{
+			Name:           captivePortalTCPRule,
+			Interfaces:     ifaces,
+			RemoteNetworks: networks,
+			Protocols:      []string{"tcp"},
+			Ports:          []int{53, 80, 443},
+			Direction:      firewall.TwoWay,
+			Allow:          true,
+		},
+		{
+			Name:           captivePortalUDPRule,
+			Interfaces:     ifaces,
+			RemoteNetworks: networks,
+			Protocols:      []string{"udp"},
+			Ports:          []int{53},
+			Direction:      firewall.TwoWay,
+			Allow:          true,
+		},
+		{
+			Name:       captivePortalDHCPRule,
+			Interfaces: ifaces,
+			Protocols:  []string{"udp"},
+			Ports:      []int{67, 68},
+			Direction:  firewall.TwoWay,
+			Allow:      true,
+		},
+	}); err != nil {
+		return fmt.Errorf("allowing captive portal: %w", err)
+	}
+	return nil
+}
+
+// BlockCaptivePortal closes the kill switch opened by AllowCaptivePortal
+func (netw *Combined) BlockCaptivePortal() error {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+	return netw.blockCaptivePortal()
+}
+
+func (netw *Combined) blockCaptivePortal() error {
+	for _, rule := range []string{captivePortalTCPRule, captivePortalUDPRule, captivePortalDHCPRule} {
+		err := netw.fw.Delete([]string{rule})
+		if err != nil && !errors.Is(err, firewall.ErrRuleNotFound) {
+			return fmt.Errorf("blocking captive portal: %w", err)
+		}
+	}
+	return nil
+}
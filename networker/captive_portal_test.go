@@ -0,0 +1,37 @@
+package networker
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCombined_CaptivePortal(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fw := newWorkingFirewall()
+	netw := GetTestCombined()
+	netw.fw = fw
+
+	gateway := netip.MustParseAddr("192.168.1.1")
+	assert.NoError(t, netw.AllowCaptivePortal([]netip.Addr{gateway}))
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("192.168.1.1/32")}, fw.rules[captivePortalTCPRule].RemoteNetworks)
+	assert.Equal(t, []int{53, 80, 443}, fw.rules[captivePortalTCPRule].Ports)
+	assert.Equal(t, []int{53}, fw.rules[captivePortalUDPRule].Ports)
+	assert.Equal(t, []int{67, 68}, fw.rules[captivePortalDHCPRule].Ports)
+	assert.Empty(t, fw.rules[captivePortalDHCPRule].RemoteNetworks)
+
+	// allowing again replaces the addresses
+	portal := netip.MustParseAddr("203.0.113.7")
+	assert.NoError(t, netw.AllowCaptivePortal([]netip.Addr{gateway, portal}))
+	assert.Len(t, fw.rules[captivePortalTCPRule].RemoteNetworks, 2)
+
+	assert.NoError(t, netw.BlockCaptivePortal())
+	assert.NotContains(t, fw.rules, captivePortalTCPRule)
+	assert.NotContains(t, fw.rules, captivePortalUDPRule)
+	assert.NotContains(t, fw.rules, captivePortalDHCPRule)
+	// nothing to block
+	assert.NoError(t, netw.BlockCaptivePortal())
+}
@@ -0,0 +1,55 @@
+package networker
+
+import (
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+)
+
+const ipv6BlockRule = "vpn_block_ipv6"
+
+// SetIPv6Mode controls how the IPv6 traffic is handled. It is applied on the
+// next connect.
+func (netw *Combined) SetIPv6Mode(mode config.IPv6Mode) {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+	netw.ipv6Mode = mode
+}
+
+// blockIPv6Traffic drops the IPv6 traffic on all of the interfaces, so that it
+// does not bypass the VPN even if IPv6 gets re-enabled by other programs
+func (netw *Combined) blockIPv6Traffic() error {
+	if netw.isV6TrafficBlocked {
+		return nil
+	}
+
+	ifaces, err := netw.devices()
+	if err != nil {
+		return err
+	}
+
+	if err := netw.fw.Add([]firewall.Rule{
+		{
+			Name:       ipv6BlockRule,
+			Interfaces: ifaces,
+			Direction:  firewall.TwoWay,
+			Allow:      false,
+			Ipv6Only:   true,
+		},
+	}); err != nil {
+		return err
+	}
+	netw.isV6TrafficBlocked = true
+	return nil
+}
+
+func (netw *Combined) unblockIPv6Traffic() error {
+	if !netw.isV6TrafficBlocked {
+		return nil
+	}
+
+	if err := netw.fw.Delete([]string{ipv6BlockRule}); err != nil {
+		return err
+	}
+	netw.isV6TrafficBlocked = false
+	return nil
+}
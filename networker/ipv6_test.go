@@ -0,0 +1,109 @@
+package networker
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingIpv6 struct {
+	blocked bool
+}
+
+func (r *recordingIpv6) Block() error {
+	r.blocked = true
+	return nil
+}
+
+func (r *recordingIpv6) Unblock() error {
+	r.blocked = false
+	return nil
+}
+
+func TestCombined_DisableIPv6IfNeeded(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name            string
+		mode            config.IPv6Mode
+		ipv6Enabled     bool
+		expectedBlocked bool
+		expectedRule    bool
+	}{
+		{
+			name:            "block",
+			mode:            config.IPv6Block,
+			expectedBlocked: true,
+			expectedRule:    true,
+		},
+		{
+			name:        "block on IPv6-only network",
+			mode:        config.IPv6Block,
+			ipv6Enabled: true,
+		},
+		{
+			name:            "tunnel with server not supporting IPv6",
+			mode:            config.IPv6Tunnel,
+			expectedBlocked: true,
+		},
+		{
+			name:        "tunnel",
+			mode:        config.IPv6Tunnel,
+			ipv6Enabled: true,
+		},
+		{
+			name: "native",
+			mode: config.IPv6Native,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fw := newWorkingFirewall()
+			ipv6 := &recordingIpv6{}
+			netw := Combined{
+				fw:           fw,
+				ipv6:         ipv6,
+				devices:      workingDeviceList,
+				ipv6Mode:     test.mode,
+				ipv6Enabled:  test.ipv6Enabled,
+				isNetworkSet: true,
+			}
+
+			assert.NoError(t, netw.disableIPv6IfNeeded())
+			assert.Equal(t, test.expectedBlocked, ipv6.blocked)
+			_, ok := fw.rules[ipv6BlockRule]
+			assert.Equal(t, test.expectedRule, ok)
+
+			assert.NoError(t, netw.unblockIPv6Traffic())
+			assert.Empty(t, fw.rules)
+		})
+	}
+}
+
+func TestCombined_DenyIPv6(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name            string
+		mode            config.IPv6Mode
+		expectedBlocked bool
+	}{
+		{name: "block", mode: config.IPv6Block, expectedBlocked: true},
+		{name: "tunnel", mode: config.IPv6Tunnel, expectedBlocked: true},
+		{name: "native", mode: config.IPv6Native},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ipv6 := &recordingIpv6{}
+			netw := Combined{ipv6: ipv6, ipv6Mode: test.mode, isNetworkSet: true}
+
+			assert.NoError(t, netw.DenyIPv6())
+			assert.Equal(t, test.expectedBlocked, ipv6.blocked)
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package networker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/netip"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// minPathMTU is the smallest path MTU probed, tunnels carrying IPv6 do not
+	// work below it anyway
+	minPathMTU = 1280
+	// maxPathMTU is the largest path MTU probed, which is the MTU of Ethernet
+	maxPathMTU = 1500
+	// openVPNOverhead is the size of the headers added by the OpenVPN tunnel
+	// including the TCP framing
+	openVPNOverhead = 100
+	// mtuProbeTimeout bounds waiting for the reply to a single probe
+	mtuProbeTimeout = 300 * time.Millisecond
+	// icmpHeadersSize is the size of IPv4 and ICMP echo headers of the probe
+	icmpHeadersSize = 28
+)
+
+// mtuProbe checks whether the packets of the given size reach the server
+// without being fragmented
+type mtuProbe func(ctx context.Context, server netip.Addr, size int) (bool, error)
+
+// fitMTU sets the MTU of the tunnel after connecting. The manually set MTU is
+// used as is, otherwise the path MTU to the server is probed and the tunnel
+// MTU is lowered if the packets of the default size would not fit the path,
+// e.g. on PPPoE or LTE links.
+func (netw *Combined) fitMTU(serverData vpn.ServerData) {
+	if netw.mtu == 0 {
+		netw.probeTunnelMTU(serverData)
+	}
+	netw.nestTunnel(serverData)
+	if netw.mtu != 0 {
+		if err := netw.setLinkMTU(netw.vpnet.Tun().Interface().Name, int(netw.mtu)); err != nil {
+			log.Println(internal.WarningPrefix, "setting MTU of the tunnel:", err)
+		}
+	}
+}
+
+// probeTunnelMTU lowers the MTU of the outer tunnel to fit the path to the server
+func (netw *Combined) probeTunnelMTU(serverData vpn.ServerData) {
+	// with multi-hop the path to the entry server is probed, the tunnel to the
+	// exit server is nested into the entry one
+	outer, overhead := netw.vpnet, tunnelOverhead
+	if serverData.Via != nil {
+		outer, serverData = netw.hop, *serverData.Via
+	} else if serverData.Technology == config.Technology_OPENVPN {
+		overhead = openVPNOverhead
+	}
+
+	iface := outer.Tun().Interface()
+	// packets of the tunnel already fit the smallest path
+	if iface.MTU+overhead <= minPathMTU {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*mtuProbeTimeout)
+	defer cancel()
+	pathMTU, err := probePathMTU(ctx, netw.probeMTU, serverData.IP)
+	if err != nil {
+		log.Println(internal.WarningPrefix, "probing path MTU:", err)
+		return
+	}
+	log.Println(internal.InfoPrefix, "path MTU to the server:", pathMTU)
+
+	if mtu := pathMTU - overhead; mtu < iface.MTU {
+		if err := netw.setLinkMTU(iface.Name, mtu); err != nil {
+			log.Println(internal.WarningPrefix, "setting MTU of the tunnel:", err)
+		}
+	}
+}
+
+// SetMTU sets the MTU of the tunnel, 0 enables the detection of the path MTU.
+// MTU of the active connection is changed only when it is set manually, the
+// detection takes place on the next connection.
+func (netw *Combined) SetMTU(mtu uint32) error {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+	netw.mtu = mtu
+	if mtu == 0 || !netw.isConnectedToVPN() {
+		return nil
+	}
+	return netw.setLinkMTU(netw.vpnet.Tun().Interface().Name, int(mtu))
+}
+
+// probePathMTU finds the largest packet size which reaches the server. The
+// common case of the unrestricted path is checked first, then the size is
+// searched for between the bounds.
+func probePathMTU(ctx context.Context, probe mtuProbe, server netip.Addr) (int, error) {
+	ok, err := probe(ctx, server, maxPathMTU)
+	if err != nil {
+		return 0, err
+	}
+	if ok {
+		return maxPathMTU, nil
+	}
+	ok, err = probe(ctx, server, minPathMTU)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, errors.New("server does not reply to the probes")
+	}
+
+	low, high := minPathMTU, maxPathMTU
+	for high-low > 1 {
+		size := (low + high) / 2
+		ok, err := probe(ctx, server, size)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			low = size
+		} else {
+			high = size
+		}
+	}
+	return low, nil
+}
+
+// icmpProbe returns the probe sending ICMP echo requests with the don't
+// fragment flag. Requests are marked with fwmark, so they bypass the tunnel.
+func icmpProbe(fwmark uint32) mtuProbe {
+	var seq int
+	return func(ctx context.Context, server netip.Addr, size int) (bool, error) {
+		if !server.Is4() {
+			return false, fmt.Errorf("probing %s: only IPv4 is supported", server)
+		}
+		listenConfig := net.ListenConfig{
+			Control: func(network, address string, conn syscall.RawConn) error {
+				var operr error
+				if err := conn.Control(func(fd uintptr) {
+					if operr = syscall.SetsockoptInt(int(fd), unix.SOL_SOCKET, unix.SO_MARK, int(fwmark)); operr != nil {
+						return
+					}
+					// kernel sets the don't fragment flag and ignores the cached path MTU
+					operr = syscall.SetsockoptInt(int(fd), unix.IPPROTO_IP, unix.IP_MTU_DISCOVER, unix.IP_PMTUDISC_PROBE)
+				}); err != nil {
+					return err
+				}
+				return operr
+			},
+		}
+		conn, err := listenConfig.ListenPacket(ctx, "ip4:icmp", "0.0.0.0")
+		if err != nil {
+			return false, fmt.Errorf("opening ICMP socket: %w", err)
+		}
+		defer conn.Close()
+
+		seq++
+		id := os.Getpid() & 0xffff
+		request, err := (&icmp.Message{
+			Type: ipv4.ICMPTypeEcho,
+			Body: &icmp.Echo{ID: id, Seq: seq, Data: make([]byte, size-icmpHeadersSize)},
+		}).Marshal(nil)
+		if err != nil {
+			return false, err
+		}
+		if _, err := conn.WriteTo(request, &net.IPAddr{IP: server.AsSlice()}); err != nil {
+			// packet does not fit the MTU of the local link
+			if errors.Is(err, unix.EMSGSIZE) {
+				return false, nil
+			}
+			return false, fmt.Errorf("sending probe: %w", err)
+		}
+
+		deadline := time.Now().Add(mtuProbeTimeout)
+		if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+			deadline = ctxDeadline
+		}
+		if err := conn.SetReadDeadline(deadline); err != nil {
+			return false, err
+		}
+		reply := make([]byte, maxPathMTU)
+		for {
+			n, from, err := conn.ReadFrom(reply)
+			if err != nil {
+				// missing reply means that the probe was dropped on the way
+				if errors.Is(err, os.ErrDeadlineExceeded) {
+					return false, nil
+				}
+				return false, fmt.Errorf("receiving reply: %w", err)
+			}
+			if from.String() != server.String() {
+				continue
+			}
+			message, err := icmp.ParseMessage(ipv4.ICMPTypeEchoReply.Protocol(), reply[:n])
+			if err != nil || message.Type != ipv4.ICMPTypeEchoReply {
+				continue
+			}
+			if echo, ok := message.Body.(*icmp.Echo); ok && echo.ID == id && echo.Seq == seq {
+				return true, nil
+			}
+		}
+	}
+}
+
+// setLinkMTU sets the MTU of the interface
+func setLinkMTU(name string, mtu int) error {
+	link, err := netlink.LinkByName(name)
+	if err != nil {
+		return err
+	}
+	return netlink.LinkSetMTU(link, mtu)
+}
@@ -0,0 +1,166 @@
+package networker
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/tunnel"
+	"github.com/stretchr/testify/assert"
+)
+
+// pathProbe passes the packets up to the path MTU
+func pathProbe(pathMTU int) mtuProbe {
+	return func(_ context.Context, _ netip.Addr, size int) (bool, error) {
+		return size <= pathMTU, nil
+	}
+}
+
+type mtuTunnel struct {
+	mock.WorkingT
+	name string
+}
+
+func (t mtuTunnel) Interface() net.Interface { return net.Interface{Name: t.name, MTU: 1420} }
+
+type mtuVPN struct {
+	mock.ActiveVPN
+	name     string
+	inactive bool
+}
+
+func (v mtuVPN) Tun() tunnel.T  { return mtuTunnel{name: v.name} }
+func (v mtuVPN) IsActive() bool { return !v.inactive }
+
+func TestProbePathMTU(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		probe    mtuProbe
+		expected int
+		hasError bool
+	}{
+		{name: "unrestricted path", probe: pathProbe(1500), expected: 1500},
+		{name: "pppoe", probe: pathProbe(1492), expected: 1492},
+		{name: "smallest path", probe: pathProbe(1280), expected: 1280},
+		{name: "probes are blocked", probe: pathProbe(0), hasError: true},
+		{
+			name: "probe failure",
+			probe: func(context.Context, netip.Addr, int) (bool, error) {
+				return false, mock.ErrOnPurpose
+			},
+			hasError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mtu, err := probePathMTU(context.Background(), test.probe, netip.MustParseAddr("1.1.1.1"))
+			assert.Equal(t, test.hasError, err != nil)
+			assert.Equal(t, test.expected, mtu)
+		})
+	}
+}
+
+func TestCombined_FitMTU(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	direct := vpn.ServerData{IP: netip.MustParseAddr("2.2.2.2"), Technology: config.Technology_NORDLYNX}
+	tests := []struct {
+		name       string
+		mtu        uint32
+		pathMTU    int
+		serverData vpn.ServerData
+		expected   map[string]int
+	}{
+		{
+			name:       "unrestricted path",
+			pathMTU:    1500,
+			serverData: direct,
+			expected:   map[string]int{},
+		},
+		{
+			name:       "lte",
+			pathMTU:    1428,
+			serverData: direct,
+			expected:   map[string]int{"nordlynx": 1348},
+		},
+		{
+			name:    "openvpn",
+			pathMTU: 1492,
+			serverData: vpn.ServerData{
+				IP:         netip.MustParseAddr("2.2.2.2"),
+				Technology: config.Technology_OPENVPN,
+			},
+			expected: map[string]int{"nordlynx": 1392},
+		},
+		{
+			name:    "multi-hop probes entry server",
+			pathMTU: 1428,
+			serverData: vpn.ServerData{
+				IP:  netip.MustParseAddr("2.2.2.2"),
+				Via: &vpn.ServerData{IP: netip.MustParseAddr("1.1.1.1")},
+			},
+			expected: map[string]int{"hop": 1348},
+		},
+		{
+			name:       "manual",
+			mtu:        1380,
+			pathMTU:    1428,
+			serverData: direct,
+			expected:   map[string]int{"nordlynx": 1380},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			set := map[string]int{}
+			netw := GetTestCombined()
+			netw.vpnet = mtuVPN{name: "nordlynx"}
+			netw.hop = mtuVPN{name: "hop"}
+			netw.nestMTU = func(string, string) error { return nil }
+			netw.mtu = test.mtu
+			netw.probeMTU = pathProbe(test.pathMTU)
+			netw.setLinkMTU = func(name string, mtu int) error {
+				set[name] = mtu
+				return nil
+			}
+
+			netw.fitMTU(test.serverData)
+			assert.Equal(t, test.expected, set)
+		})
+	}
+}
+
+func TestCombined_SetMTU(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	set := map[string]int{}
+	netw := GetTestCombined()
+	netw.vpnet = mtuVPN{name: "nordlynx", inactive: true}
+	netw.setLinkMTU = func(name string, mtu int) error {
+		set[name] = mtu
+		return nil
+	}
+
+	// applied on the next connection while disconnected
+	assert.NoError(t, netw.SetMTU(1380))
+	assert.Empty(t, set)
+
+	// MTU of the active connection is changed
+	netw.vpnet = mtuVPN{name: "nordlynx"}
+	assert.NoError(t, netw.SetMTU(1400))
+	assert.Equal(t, map[string]int{"nordlynx": 1400}, set)
+	assert.Equal(t, uint32(1400), netw.mtu)
+
+	// detection takes place on the next connection
+	assert.NoError(t, netw.SetMTU(0))
+	assert.Equal(t, map[string]int{"nordlynx": 1400}, set)
+	assert.Equal(t, uint32(0), netw.mtu)
+}
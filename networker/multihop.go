@@ -0,0 +1,89 @@
+package networker
+
+import (
+	"fmt"
+	"log"
+	"net/netip"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/routes"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/vishvananda/netlink"
+)
+
+// tunnelOverhead is the size of the headers added by the tunnel. WireGuard
+// overhead is used, as it is the entry hop technology.
+const tunnelOverhead = 80
+
+// startHop connects to the entry server of the multi-hop connection and routes
+// the traffic to the exit server through it. Does nothing for the direct
+// connection.
+func (netw *Combined) startHop(creds vpn.Credentials, serverData vpn.ServerData) error {
+	if serverData.Via == nil {
+		return nil
+	}
+	if netw.hop == nil {
+		return errNilHop
+	}
+
+	netw.publisher.Publish("starting entry hop")
+	if err := netw.hop.Start(creds, *serverData.Via); err != nil {
+		if err := netw.hop.Stop(); err != nil {
+			log.Println(internal.DeferPrefix, err)
+		}
+		return fmt.Errorf("starting entry hop: %w", err)
+	}
+
+	// tunnel packets to the exit server are marked, so they are routed using
+	// the main table
+	if err := netw.router.Add(routes.Route{
+		Subnet: netip.PrefixFrom(serverData.IP, serverData.IP.BitLen()),
+		Device: netw.hop.Tun().Interface(),
+	}); err != nil {
+		if err := netw.stopHop(); err != nil {
+			log.Println(internal.DeferPrefix, err)
+		}
+		return fmt.Errorf("routing exit server through entry hop: %w", err)
+	}
+	return nil
+}
+
+// nestTunnel fits the tunnel to the exit server into the entry hop
+func (netw *Combined) nestTunnel(serverData vpn.ServerData) {
+	if serverData.Via == nil {
+		return
+	}
+	if err := netw.nestMTU(
+		netw.vpnet.Tun().Interface().Name,
+		netw.hop.Tun().Interface().Name,
+	); err != nil {
+		log.Println(internal.WarningPrefix, "setting MTU of multi-hop tunnel:", err)
+	}
+}
+
+// stopHop disconnects from the entry server if connected. Route to the exit
+// server is removed together with the other routes.
+func (netw *Combined) stopHop() error {
+	if netw.hop == nil || !netw.hop.IsActive() {
+		return nil
+	}
+	netw.publisher.Publish("stopping entry hop")
+	if err := netw.hop.Stop(); err != nil {
+		return fmt.Errorf("stopping entry hop: %w", err)
+	}
+	return nil
+}
+
+// nestMTU sets the MTU of the inner interface to the MTU of the outer one
+// decreased by the tunnel overhead
+func nestMTU(inner, outer string) error {
+	outerLink, err := netlink.LinkByName(outer)
+	if err != nil {
+		return err
+	}
+	innerLink, err := netlink.LinkByName(inner)
+	if err != nil {
+		return err
+	}
+	return netlink.LinkSetMTU(innerLink, outerLink.Attrs().MTU-tunnelOverhead)
+}
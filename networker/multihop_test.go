@@ -0,0 +1,136 @@
+package networker
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/routes"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCombined_MultiHop(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	exit := vpn.ServerData{
+		IP:       netip.MustParseAddr("2.2.2.2"),
+		Hostname: "us456.nordvpn.com",
+		Via: &vpn.ServerData{
+			IP:       netip.MustParseAddr("1.1.1.1"),
+			Hostname: "de123.nordvpn.com",
+		},
+	}
+
+	tests := []struct {
+		name       string
+		hop        vpn.VPN
+		serverData vpn.ServerData
+		vpnErr     error
+		routeErr   error
+		hasError   bool
+		hopActive  bool
+		exitRoute  bool
+		// exit server was routed through the hop
+		hopRoute  bool
+		vpnStarts int
+	}{
+		{
+			name:       "direct connection",
+			hop:        &mock.WorkingVPN{},
+			serverData: vpn.ServerData{IP: netip.MustParseAddr("2.2.2.2")},
+			vpnStarts:  1,
+		},
+		{
+			name:       "multi-hop connection",
+			hop:        &mock.WorkingVPN{},
+			serverData: exit,
+			hopActive:  true,
+			exitRoute:  true,
+			hopRoute:   true,
+			vpnStarts:  1,
+		},
+		{
+			name:       "hop is not supported",
+			serverData: exit,
+			hasError:   true,
+		},
+		{
+			name:       "hop fails to start",
+			hop:        mock.FailingVPN{},
+			serverData: exit,
+			hasError:   true,
+		},
+		{
+			name:       "exit server cannot be routed through hop",
+			hop:        &mock.WorkingVPN{},
+			serverData: exit,
+			routeErr:   mock.ErrOnPurpose,
+			hasError:   true,
+		},
+		{
+			name:       "exit tunnel fails to start",
+			hop:        &mock.WorkingVPN{},
+			serverData: exit,
+			vpnErr:     mock.ErrOnPurpose,
+			hasError:   true,
+			hopRoute:   true,
+			vpnStarts:  1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vpnet := &mock.WorkingVPN{StartErr: test.vpnErr}
+			router := &recordingRouter{err: test.routeErr}
+			var nested bool
+			netw := GetTestCombined()
+			netw.vpnet = vpnet
+			netw.hop = test.hop
+			netw.router = router
+			netw.nestMTU = func(string, string) error {
+				nested = true
+				return nil
+			}
+
+			err := netw.Start(vpn.Credentials{}, test.serverData, config.Allowlist{}, nil, true)
+			assert.Equal(t, test.hasError, err != nil)
+			assert.Equal(t, test.vpnStarts, vpnet.ExecutionStats[mock.StatsStart])
+			assert.Equal(t, test.exitRoute, nested)
+			if test.hop != nil {
+				assert.Equal(t, test.hopActive, test.hop.IsActive())
+			}
+
+			exitRoute := routes.Route{
+				Subnet: netip.MustParsePrefix("2.2.2.2/32"),
+				Device: mock.En0Interface,
+			}
+			assert.Equal(t, test.hopRoute, containsRoute(router.added, exitRoute))
+			if err != nil {
+				return
+			}
+
+			status, err := netw.ConnectionStatus()
+			assert.NoError(t, err)
+			if test.serverData.Via != nil {
+				assert.Equal(t, test.serverData.Via.Hostname, status.Via)
+			} else {
+				assert.Empty(t, status.Via)
+			}
+
+			assert.NoError(t, netw.Stop())
+			assert.False(t, test.hop.IsActive())
+		})
+	}
+}
+
+func containsRoute(list []routes.Route, route routes.Route) bool {
+	for _, r := range list {
+		if r.Subnet == route.Subnet && r.Device.Name == route.Device.Name && r.TableID == route.TableID {
+			return true
+		}
+	}
+	return false
+}
@@ -21,7 +21,9 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
 	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
 	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/allowlist"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/templates"
 	"github.com/NordSecurity/nordvpn-linux/daemon/routes"
+	"github.com/NordSecurity/nordvpn-linux/daemon/splittunnel"
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/events"
 	"github.com/NordSecurity/nordvpn-linux/internal"
@@ -38,13 +40,18 @@ var (
 	// errNilVPN is returned when there is a bug in program logic.
 	errNilVPN      = errors.New("vpn is nil")
 	errInactiveVPN = errors.New("not connected to vpn")
+	// errNilHop is returned when multi-hop connection is not supported
+	errNilHop = errors.New("multi-hop is not supported")
 	// ErrMeshNotActive to report to outside
 	ErrMeshNotActive = errors.New("mesh is not active")
 	// ErrMeshPeerIsNotRoutable to report to outside
 	ErrMeshPeerIsNotRoutable = errors.New("mesh peer is not routable")
 	// ErrMeshPeerNotFound to report to outside
 	ErrMeshPeerNotFound = errors.New("mesh peer not found")
-	defaultMeshSubnet   = netip.MustParsePrefix("100.64.0.0/10")
+	// ErrDefaultRouteConflict is returned when other default route exists and
+	// default route mode is set to refuse
+	ErrDefaultRouteConflict = errors.New("conflicting default route exists")
+	defaultMeshSubnet       = netip.MustParsePrefix("100.64.0.0/10")
 )
 
 const (
@@ -54,8 +61,37 @@ const (
 	// a string to be prepended with peers public key and appended with peers ip address to form the internal rule name
 	// for blocking incoming connections into local networks
 	blockLanRule = "-block-lan-rule-"
+	// killSwitchLANRule allows the LAN traffic through the kill switch
+	killSwitchLANRule = "killswitch_lan"
+	// multicastDiscoveryRule prefixes the rules allowing the mDNS and SSDP
+	// traffic through the kill switch
+	multicastDiscoveryRule = "killswitch_multicast_discovery"
+	// metric assigned to the conflicting default route in coexist mode, so that
+	// the VPN default route with metric 0 takes precedence
+	coexistDefaultRouteMetric = 1000
 )
 
+// killSwitchLANNetworks are the private (RFC1918) and link-local networks
+var killSwitchLANNetworks = []netip.Prefix{
+	netip.MustParsePrefix("10.0.0.0/8"),
+	netip.MustParsePrefix("172.16.0.0/12"),
+	netip.MustParsePrefix("192.168.0.0/16"),
+	netip.MustParsePrefix("169.254.0.0/16"),
+	netip.MustParsePrefix("fe80::/10"),
+}
+
+// multicastDiscoveryGroups are the IPv4 and IPv6 link-local multicast groups
+// of mDNS and SSDP
+var multicastDiscoveryGroups = []netip.Prefix{
+	netip.MustParsePrefix("224.0.0.251/32"),
+	netip.MustParsePrefix("239.255.255.250/32"),
+	netip.MustParsePrefix("ff02::fb/128"),
+	netip.MustParsePrefix("ff02::c/128"),
+}
+
+// multicastDiscoveryPorts are the mDNS and SSDP ports
+var multicastDiscoveryPorts = []int{1900, 5353}
+
 // ConnectionStatus of a currently active connection
 type ConnectionStatus struct {
 	// State of the vpn. OpenVPN specific.
@@ -78,6 +114,21 @@ type ConnectionStatus struct {
 	Upload uint64
 	// Uptime since the connection start
 	Uptime *time.Duration
+	// Interface is the name of the tunnel interface
+	Interface string
+	// Uplink is the network interface the tunnel is pinned to, empty if it
+	// follows the default route
+	Uplink string
+	// Via is the hostname of the entry server of the multi-hop connection
+	Via string
+	// Throughput is the current rate of the transfer through the connection
+	Throughput Throughput
+	// LastHandshake is the time of the latest handshake with the server, zero
+	// if not known
+	LastHandshake time.Time
+	// DataPlane is the WireGuard implementation used by the NordLynx
+	// connection, either the kernel module or the userspace one
+	DataPlane string
 }
 
 // Networker configures networking for connections.
@@ -111,6 +162,23 @@ type Networker interface {
 	SetVPN(vpn.VPN)
 	LastServerName() string
 	SetLanDiscovery(bool)
+	SetDefaultRouteMode(config.DefaultRouteMode)
+	SetFirewallTemplates(config.FirewallTemplates) error
+	SetSplitTunnel(config.SplitTunnel) error
+	SetDNSIPv6(bool) error
+	SetDNSSearchDomains([]string) error
+	SetDNSRoutes(config.DNSRoutes) error
+	SetDNSFilter([]string) error
+	SetMTU(uint32) error
+	SetKillSwitchLog(bool)
+	SetKillSwitchLAN(bool) error
+	SetMulticastDiscovery(bool) error
+	AllowCaptivePortal(addrs []netip.Addr) error
+	BlockCaptivePortal() error
+	SetSubnetOverlapMode(config.SubnetOverlapMode)
+	SetIPv6Mode(config.IPv6Mode)
+	SubnetOverlaps() []SubnetOverlap
+	RebuildFirewall() (firewall.Reconciliation, error)
 }
 
 // Combined configures networking for VPN connections.
@@ -119,13 +187,17 @@ type Networker interface {
 // use sync.Mutex and all private ones don't.
 type Combined struct {
 	vpnet              vpn.VPN
+	hop                vpn.VPN
 	mesh               meshnet.Mesh
 	gateway            routes.GatewayRetriever
+	defaultRoutes      routes.DefaultRouteManager
 	publisher          events.Publisher[string]
 	allowlistRouter    routes.Service
 	dnsSetter          dns.Setter
 	ipv6               ipv6.Blocker
 	fw                 firewall.Service
+	fwTemplates        templates.Manager
+	splitTunnel        splittunnel.Manager
 	allowlistRouting   allowlist.Routing
 	devices            device.ListFunc
 	policyRouter       routes.PolicyService
@@ -136,6 +208,7 @@ type Combined struct {
 	isNetworkSet       bool // used during cleanup
 	isKillSwitchSet    bool // used during cleanup
 	isV6TrafficAllowed bool // used during cleanup
+	isV6TrafficBlocked bool // used during cleanup
 	isVpnSet           bool // used during cleanup
 	isMeshnetSet       bool
 	rules              []string // firewall rule names
@@ -151,6 +224,46 @@ type Combined struct {
 	fwmark             uint32
 	mu                 sync.Mutex
 	lanDiscovery       bool
+	defaultRouteMode   config.DefaultRouteMode
+	templatePaths      config.FirewallTemplates
+	// splitTunnelCfg is applied while connected to VPN
+	splitTunnelCfg config.SplitTunnel
+	// isSplitTunnelSet is used during cleanup
+	isSplitTunnelSet bool
+	// dnsIPv6 controls whether IPv6 nameservers are configured
+	dnsIPv6 bool
+	// dnsSearchDomains are configured together with the nameservers
+	dnsSearchDomains []string
+	// dnsRoutes send the queries of the domains to the internal nameservers
+	dnsRoutes config.DNSRoutes
+	// dnsFilter answers the queries of the filtered record types locally
+	dnsFilter *dns.QueryTypeFilter
+	// dnsForwarder serves the encrypted nameservers, the DNS routes and the DNS
+	// filter to the system resolver
+	dnsForwarder *dns.Forwarder
+	// killSwitchLog controls whether the packets dropped by the traffic block are
+	// logged
+	killSwitchLog bool
+	// killSwitchLAN controls whether the LAN traffic is allowed while the kill
+	// switch is set
+	killSwitchLAN bool
+	// multicastDiscovery controls whether the mDNS and SSDP traffic is allowed
+	// while the kill switch is set
+	multicastDiscovery bool
+	// ipv6Mode defines how the IPv6 traffic is handled while connected
+	ipv6Mode config.IPv6Mode
+	// subnetOverlapMode defines how the LAN subnets overlapping with the VPN
+	// subnets are handled
+	subnetOverlapMode config.SubnetOverlapMode
+	// subnetOverlaps found when the current connection was set up
+	subnetOverlaps []SubnetOverlap
+	// subnets lists the subnets of the interface, used to find the overlaps
+	subnets SubnetsFunc
+	// default routes which were removed because of conflicting with the VPN
+	// default route, they are restored on disconnect
+	priorDefaultRoutes []routes.Route
+	// default routes which were added instead of the removed ones in coexist mode
+	demotedDefaultRoutes []routes.Route
 	// need to memorize route to remote LAN state set on mesh peer connect
 	// according how remote peer has set its permission, for later when
 	// doing mesh refresh which may happen in background e.g. when network
@@ -159,19 +272,47 @@ type Combined struct {
 	// list with the existing OS interfaces when VPN was connected.
 	// This is used at network changes to know when a new interface was inserted
 	interfaces mapset.Set[string]
+	// nestMTU fits the MTU of the inner tunnel into the outer one of the
+	// multi-hop connection
+	nestMTU func(inner, outer string) error
+	// mtu of the tunnel set manually, 0 means that it is fitted to the path
+	// MTU to the server
+	mtu uint32
+	// probeMTU checks the path MTU to the server
+	probeMTU mtuProbe
+	// setLinkMTU sets the MTU of the tunnel interface
+	setLinkMTU func(name string, mtu int) error
+	// routeThroughUplink routes the server through the uplink of the connection
+	routeThroughUplink func(server netip.Addr, uplink string) (func() error, error)
+	// uplinkUnpin removes the route to the server through the uplink, nil if
+	// the connection follows the default route
+	uplinkUnpin func() error
+	// traffic samples the throughput while connected
+	traffic *trafficSampler
+	// resolveDomain resolves the allowlisted domains
+	resolveDomain domainResolver
+	// allowlistDomains are the addresses of the allowlisted domains
+	allowlistDomains map[string]domainAddrs
+	// domainRefresh resolves the allowlisted domains again when their
+	// addresses expire
+	domainRefresh *time.Timer
 }
 
 // NewCombined returns a ready made version of
 // Combined.
 func NewCombined(
 	vpnet vpn.VPN,
+	hop vpn.VPN,
 	mesh meshnet.Mesh,
 	gateway routes.GatewayRetriever,
+	defaultRoutes routes.DefaultRouteManager,
 	publisher events.Publisher[string],
 	allowlistRouter routes.Service,
 	dnsSetter dns.Setter,
 	ipv6 ipv6.Blocker,
 	fw firewall.Service,
+	fwTemplates templates.Manager,
+	splitTunnel splittunnel.Manager,
 	allowlist allowlist.Routing,
 	devices device.ListFunc,
 	policyRouter routes.PolicyService,
@@ -181,16 +322,22 @@ func NewCombined(
 	exitNode exitnode.Node,
 	fwmark uint32,
 	lanDiscovery bool,
+	defaultRouteMode config.DefaultRouteMode,
+	dnsIPv6 bool,
 ) *Combined {
 	return &Combined{
 		vpnet:              vpnet,
+		hop:                hop,
 		mesh:               mesh,
 		gateway:            gateway,
+		defaultRoutes:      defaultRoutes,
 		publisher:          publisher,
 		allowlistRouter:    allowlistRouter,
 		dnsSetter:          dnsSetter,
 		ipv6:               ipv6,
 		fw:                 fw,
+		fwTemplates:        fwTemplates,
+		splitTunnel:        splitTunnel,
 		allowlistRouting:   allowlist,
 		devices:            devices,
 		policyRouter:       policyRouter,
@@ -201,8 +348,16 @@ func NewCombined(
 		rules:              []string{},
 		fwmark:             fwmark,
 		lanDiscovery:       lanDiscovery,
+		defaultRouteMode:   defaultRouteMode,
+		dnsIPv6:            dnsIPv6,
 		enableLocalTraffic: true,
 		interfaces:         mapset.NewSet[string](),
+		subnets:            interfaceSubnets,
+		nestMTU:            nestMTU,
+		probeMTU:           icmpProbe(fwmark),
+		setLinkMTU:         setLinkMTU,
+		routeThroughUplink: routeThroughUplink,
+		resolveDomain:      resolveWithTTL(fwmark),
 	}
 }
 
@@ -234,10 +389,17 @@ func failureRecover(netw *Combined) {
 	if err := netw.router.Flush(); err != nil {
 		log.Println(internal.DeferPrefix, err)
 	}
+	netw.unpinUplink()
+	netw.restoreDefaultRoutes()
 
+	netw.stopDNSForwarder()
+	netw.stopTrafficSampler()
 	if err := netw.vpnet.Stop(); err != nil {
 		log.Println(internal.DeferPrefix, err)
 	}
+	if err := netw.stopHop(); err != nil {
+		log.Println(internal.DeferPrefix, err)
+	}
 
 	if netw.isNetworkSet && !netw.isKillSwitchSet {
 		if err := netw.unsetNetwork(); err != nil {
@@ -250,7 +412,18 @@ func failureRecover(netw *Combined) {
 			log.Println(internal.DebugPrefix, err)
 		}
 	}
+	if err := netw.unblockIPv6Traffic(); err != nil {
+		log.Println(internal.DeferPrefix, err)
+	}
 	netw.isVpnSet = false
+
+	if err := netw.unsetSplitTunnel(); err != nil {
+		log.Println(internal.DeferPrefix, err)
+	}
+
+	if err := netw.switchTemplates(templates.PreConnect, templates.PostDisconnect); err != nil {
+		log.Println(internal.DeferPrefix, err)
+	}
 }
 
 func (netw *Combined) start(
@@ -272,17 +445,32 @@ func (netw *Combined) start(
 		}
 	}()
 
+	if err = netw.switchTemplates(templates.PostDisconnect, templates.PreConnect); err != nil {
+		return fmt.Errorf("applying firewall template: %w", err)
+	}
+
 	netw.publisher.Publish("starting vpn")
 
 	if serverData.IP == (netip.Addr{}) {
 		serverData = netw.lastServer
 	}
+	if err = netw.pinUplink(serverData); err != nil {
+		return err
+	}
+	if err = netw.startHop(creds, serverData); err != nil {
+		return err
+	}
 	if err = netw.vpnet.Start(creds, serverData); err != nil {
 		if err := netw.vpnet.Stop(); err != nil {
 			log.Println(internal.DeferPrefix, err)
 		}
+		// entry hop of the multi-hop connection is not used without the tunnel
+		if err := netw.stopHop(); err != nil {
+			log.Println(internal.DeferPrefix, err)
+		}
 		return err
 	}
+	netw.fitMTU(serverData)
 
 	netw.publisher.Publish("Setting the routing rules up")
 
@@ -300,11 +488,16 @@ func (netw *Combined) start(
 	}
 
 	netw.isVpnSet = true
+	// connection is usable without the split tunnel, so its failure is not fatal
+	if err := netw.setSplitTunnel(); err != nil {
+		log.Println(internal.WarningPrefix, "setting up split tunnel:", err)
+	}
 	netw.lastServer = serverData
 	netw.lastCreds = creds
 	netw.lastNameservers = nameservers
 	start := time.Now()
 	netw.startTime = &start
+	netw.startTrafficSampler()
 	netw.interfaces = device.InterfacesWithDefaultRoute(mapset.NewSet(netw.vpnet.Tun().Interface().Name))
 	return nil
 }
@@ -337,10 +530,25 @@ func (netw *Combined) configureNetwork(
 }
 
 func (netw *Combined) disableIPv6IfNeeded() error {
-	if !netw.ipv6Enabled {
+	switch netw.ipv6Mode {
+	case config.IPv6Native:
+		log.Println(internal.WarningPrefix, "IPv6 is left untouched, IPv6 traffic bypasses the VPN and leaks")
+	case config.IPv6Block:
+		// IPv6 is permitted only on IPv6-only networks, where the tunnel
+		// itself goes over IPv6
+		if netw.ipv6Enabled {
+			return nil
+		}
 		if err := netw.denyIPv6(); err != nil {
 			return err
 		}
+		return netw.blockIPv6Traffic()
+	case config.IPv6Tunnel:
+		if !netw.ipv6Enabled {
+			if err := netw.denyIPv6(); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -357,6 +565,10 @@ func (netw *Combined) configureDNS(serverData vpn.ServerData, nameservers config
 }
 
 func (netw *Combined) addDefaultRoute() error {
+	if err := netw.resolveDefaultRouteConflict(); err != nil {
+		return err
+	}
+
 	err := netw.router.Add(routes.Route{
 		Subnet:  netip.MustParsePrefix("0.0.0.0/0"),
 		Device:  netw.vpnet.Tun().Interface(),
@@ -366,7 +578,95 @@ func (netw *Combined) addDefaultRoute() error {
 	if err != nil {
 		return fmt.Errorf("adding the default route: %w", err)
 	}
-	return err
+
+	netw.handleSubnetOverlaps()
+	return nil
+}
+
+// resolveDefaultRouteConflict handles default routes in the VPN routing table
+// which were not added by the app according to the default route mode.
+func (netw *Combined) resolveDefaultRouteConflict() error {
+	if !netw.router.IsEnabled() {
+		// default route is not added
+		return nil
+	}
+
+	tableID := netw.policyRouter.TableID()
+	existing, err := netw.defaultRoutes.List(tableID)
+	if err != nil {
+		return fmt.Errorf("listing default routes: %w", err)
+	}
+
+	tunName := netw.vpnet.Tun().Interface().Name
+	var conflicting []routes.Route
+	for _, route := range existing {
+		if route.Device.Name != tunName {
+			conflicting = append(conflicting, route)
+		}
+	}
+	if len(conflicting) == 0 {
+		return nil
+	}
+
+	for _, route := range conflicting {
+		log.Printf("%s found conflicting default route via %s dev %s metric %d in table %d",
+			internal.InfoPrefix, route.Gateway, route.Device.Name, route.Metric, tableID)
+	}
+
+	switch netw.defaultRouteMode {
+	case config.DefaultRouteRefuse:
+		log.Println(internal.WarningPrefix, "refusing to connect while conflicting default route exists")
+		return ErrDefaultRouteConflict
+	case config.DefaultRouteCoexist:
+		for _, route := range conflicting {
+			if route.Metric != 0 {
+				// VPN default route already takes precedence
+				continue
+			}
+			log.Println(internal.InfoPrefix, "lowering priority of default route via",
+				route.Gateway, "dev", route.Device.Name, "to metric", coexistDefaultRouteMetric)
+			if err := netw.defaultRoutes.Delete(route); err != nil {
+				return err
+			}
+			netw.priorDefaultRoutes = append(netw.priorDefaultRoutes, route)
+			demoted := route
+			demoted.Metric = coexistDefaultRouteMetric
+			if err := netw.defaultRoutes.Add(demoted); err != nil {
+				return err
+			}
+			netw.demotedDefaultRoutes = append(netw.demotedDefaultRoutes, demoted)
+		}
+	case config.DefaultRouteReplace:
+		for _, route := range conflicting {
+			log.Println(internal.InfoPrefix, "replacing default route via",
+				route.Gateway, "dev", route.Device.Name)
+			if err := netw.defaultRoutes.Delete(route); err != nil {
+				return err
+			}
+			netw.priorDefaultRoutes = append(netw.priorDefaultRoutes, route)
+		}
+	}
+	return nil
+}
+
+// restoreDefaultRoutes brings back the default routes which were changed
+// because of conflicting with the VPN default route.
+func (netw *Combined) restoreDefaultRoutes() {
+	for _, route := range netw.demotedDefaultRoutes {
+		if err := netw.defaultRoutes.Delete(route); err != nil {
+			log.Println(internal.WarningPrefix, err)
+		}
+	}
+	netw.demotedDefaultRoutes = nil
+
+	for _, route := range netw.priorDefaultRoutes {
+		log.Println(internal.InfoPrefix, "restoring default route via",
+			route.Gateway, "dev", route.Device.Name, "metric", route.Metric)
+		if err := netw.defaultRoutes.Add(route); err != nil {
+			log.Println(internal.WarningPrefix, err)
+		}
+	}
+	netw.priorDefaultRoutes = nil
 }
 
 func (netw *Combined) configureFirewall(allowlist config.Allowlist) error {
@@ -408,6 +708,9 @@ func (netw *Combined) restart(
 	if err != nil {
 		return err
 	}
+	if err = netw.stopHop(); err != nil {
+		return err
+	}
 
 	netw.publisher.Publish("restarting vpn")
 
@@ -416,12 +719,23 @@ func (netw *Combined) restart(
 	if serverData.IP == (netip.Addr{}) {
 		serverData = netw.lastServer
 	}
+	if err = netw.pinUplink(serverData); err != nil {
+		return err
+	}
+	if err = netw.startHop(creds, serverData); err != nil {
+		return err
+	}
 	if err = netw.vpnet.Start(creds, serverData); err != nil {
 		if err := netw.vpnet.Stop(); err != nil {
 			log.Println(internal.DeferPrefix, err)
 		}
+		// entry hop of the multi-hop connection is not used without the tunnel
+		if err := netw.stopHop(); err != nil {
+			log.Println(internal.DeferPrefix, err)
+		}
 		return err
 	}
+	netw.fitMTU(serverData)
 
 	// after restarting need to restore routing - because tun interface was recreated
 	// assuming all other routing rules are left as it was before restart
@@ -447,6 +761,7 @@ func (netw *Combined) restart(
 	netw.lastCreds = creds
 	start := time.Now()
 	netw.startTime = &start
+	netw.startTrafficSampler()
 	return nil
 }
 
@@ -473,6 +788,9 @@ func (netw *Combined) stop() error {
 	if err := netw.ipv6.Unblock(); err != nil {
 		log.Println(internal.WarningPrefix, err)
 	}
+	if err := netw.unblockIPv6Traffic(); err != nil {
+		log.Println(internal.WarningPrefix, err)
+	}
 	err := netw.unsetDNS()
 	if err != nil {
 		return err
@@ -497,12 +815,18 @@ func (netw *Combined) stop() error {
 	if err := netw.router.Flush(); err != nil {
 		log.Println(internal.WarningPrefix, err)
 	}
+	netw.unpinUplink()
+	netw.restoreDefaultRoutes()
 
 	netw.publisher.Publish("stopping vpn")
+	netw.stopTrafficSampler()
 	err = netw.vpnet.Stop()
 	if err != nil {
 		return err
 	}
+	if err = netw.stopHop(); err != nil {
+		return err
+	}
 	if !netw.isKillSwitchSet {
 		if err = netw.unsetNetwork(); err != nil {
 			return fmt.Errorf("unsetting network: %w", err)
@@ -511,6 +835,14 @@ func (netw *Combined) stop() error {
 
 	netw.switchToNextVpn()
 	netw.isVpnSet = false
+
+	if err := netw.unsetSplitTunnel(); err != nil {
+		log.Println(internal.WarningPrefix, "removing split tunnel:", err)
+	}
+
+	if err := netw.switchTemplates(templates.PreConnect, templates.PostDisconnect); err != nil {
+		log.Println(internal.WarningPrefix, "applying firewall template:", err)
+	}
 	return nil
 }
 
@@ -535,10 +867,7 @@ func (netw *Combined) ConnectionStatus() (ConnectionStatus, error) {
 		return ConnectionStatus{}, err
 	}
 
-	tech := config.Technology_OPENVPN
-	if netw.vpnet.Tun().Interface().Name == "nordlynx" {
-		tech = config.Technology_NORDLYNX
-	}
+	tech := netw.lastServer.Technology
 
 	var uptime *time.Duration
 	if netw.startTime != nil {
@@ -546,17 +875,45 @@ func (netw *Combined) ConnectionStatus() (ConnectionStatus, error) {
 		uptime = &dur
 	}
 
+	var via string
+	if netw.lastServer.Via != nil {
+		via = netw.lastServer.Via.Hostname
+	}
+
+	var throughput Throughput
+	if netw.traffic != nil {
+		throughput = netw.traffic.throughput()
+	}
+
+	var lastHandshake time.Time
+	if reporter, ok := netw.vpnet.(vpn.HandshakeReporter); ok {
+		if lastHandshake, err = reporter.LatestHandshake(); err != nil {
+			log.Println(internal.WarningPrefix, "getting latest handshake:", err)
+		}
+	}
+
+	var dataPlane string
+	if reporter, ok := netw.vpnet.(vpn.DataPlaneReporter); ok {
+		dataPlane = reporter.DataPlane()
+	}
+
 	return ConnectionStatus{
-		State:      vpn.ConnectedState,
-		Technology: tech,
-		Protocol:   netw.lastServer.Protocol,
-		IP:         netw.lastServer.IP,
-		Hostname:   netw.lastServer.Hostname,
-		Country:    netw.lastServer.Country,
-		City:       netw.lastServer.City,
-		Download:   stats.Rx,
-		Upload:     stats.Tx,
-		Uptime:     uptime,
+		State:         vpn.ConnectedState,
+		Technology:    tech,
+		Protocol:      netw.lastServer.Protocol,
+		IP:            netw.lastServer.IP,
+		Hostname:      netw.lastServer.Hostname,
+		Country:       netw.lastServer.Country,
+		City:          netw.lastServer.City,
+		Download:      stats.Rx,
+		Upload:        stats.Tx,
+		Uptime:        uptime,
+		Interface:     netw.vpnet.Tun().Interface().Name,
+		Uplink:        netw.lastServer.Uplink,
+		Via:           via,
+		Throughput:    throughput,
+		LastHandshake: lastHandshake,
+		DataPlane:     dataPlane,
 	}, nil
 }
 
@@ -578,13 +935,79 @@ func (netw *Combined) SetDNS(nameservers []string) error {
 }
 
 func (netw *Combined) setDNS(nameservers []string) error {
-	err := netw.dnsSetter.Set(netw.vpnet.Tun().Interface().Name, nameservers)
+	if !netw.dnsIPv6 {
+		nameservers = dns.IPv4Only(nameservers)
+	}
+	nameservers, err := netw.forwardDNS(nameservers)
+	if err != nil {
+		return fmt.Errorf("networker starting dns forwarder: %w", err)
+	}
+	err = netw.dnsSetter.Set(netw.vpnet.Tun().Interface().Name, nameservers, netw.searchDomains())
 	if err != nil {
 		return fmt.Errorf("networker setting dns: %w", err)
 	}
 	return nil
 }
 
+// forwardDNS starts the local forwarder if any of the nameservers is encrypted,
+// DNS routes or DNS filter are configured and returns the nameservers for the
+// system resolver. Forwarder listens on the tunnel address, so that it is reachable
+// regardless of the DNS method used.
+func (netw *Combined) forwardDNS(nameservers []string) ([]string, error) {
+	netw.stopDNSForwarder()
+	if !dns.HasEncrypted(nameservers) && len(netw.dnsRoutes) == 0 && netw.dnsFilter == nil {
+		return nameservers, nil
+	}
+
+	tun := netw.vpnet.Tun()
+	var listenAddr netip.Addr
+	for _, ip := range tun.IPs() {
+		if ip.Is4() {
+			listenAddr = ip
+			break
+		}
+	}
+	if !listenAddr.IsValid() {
+		return nil, errors.New("tunnel has no IPv4 address")
+	}
+
+	forwarder, err := dns.NewForwarder(
+		tun.Interface().Name,
+		nameservers,
+		dnsRoutes(netw.dnsRoutes),
+		netw.dnsFilter,
+		netw.fwmark,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := forwarder.Start(listenAddr); err != nil {
+		return nil, err
+	}
+	netw.dnsForwarder = forwarder
+	return []string{listenAddr.String()}, nil
+}
+
+func (netw *Combined) stopDNSForwarder() {
+	if netw.dnsForwarder == nil {
+		return
+	}
+	if err := netw.dnsForwarder.Stop(); err != nil {
+		log.Println(internal.WarningPrefix, "stopping dns forwarder:", err)
+	}
+	netw.dnsForwarder = nil
+}
+
+// searchDomains returns the configured search domains and the meshnet domain if
+// meshnet is set, so that the short names of the peers are resolved
+func (netw *Combined) searchDomains() []string {
+	domains := slices.Clone(netw.dnsSearchDomains)
+	if netw.isMeshnetSet && !slices.Contains(domains, dns.MeshnetSearchDomain) {
+		domains = append(domains, dns.MeshnetSearchDomain)
+	}
+	return domains
+}
+
 // UnsetDNS to original settings.
 func (netw *Combined) UnsetDNS() error {
 	netw.mu.Lock()
@@ -596,6 +1019,7 @@ func (netw *Combined) UnsetDNS() error {
 }
 
 func (netw *Combined) unsetDNS() error {
+	netw.stopDNSForwarder()
 	err := netw.dnsSetter.Unset(netw.vpnet.Tun().Interface().Name)
 	if err != nil {
 		return fmt.Errorf("networker unsetting dns: %w", err)
@@ -610,9 +1034,13 @@ func (netw *Combined) PermitIPv6() error {
 	return netw.ipv6.Unblock()
 }
 
+// DenyIPv6 disables IPv6, unless it is left untouched by the native mode
 func (netw *Combined) DenyIPv6() error {
 	netw.mu.Lock()
 	defer netw.mu.Unlock()
+	if netw.ipv6Mode == config.IPv6Native {
+		return nil
+	}
 	return netw.denyIPv6()
 }
 
@@ -636,6 +1064,7 @@ func (netw *Combined) blockTraffic() error {
 			Direction:  firewall.TwoWay,
 			Interfaces: ifaces,
 			Allow:      false,
+			Log:        netw.killSwitchLog,
 		},
 	})
 }
@@ -887,13 +1316,19 @@ func (netw *Combined) setAllowlist(allowlist config.Allowlist) error {
 		defaultInterface net.Interface
 	}
 
+	allowlistSubnets := make([]netip.Prefix, 0, len(allowlist.Subnets))
 	for cidr := range allowlist.Subnets {
 		subnet, err := netip.ParsePrefix(cidr)
 		if err != nil {
 			// TODO: after Go 1.20, rewrite using error joining
 			return fmt.Errorf("parsing subnet CIDR: %w", err)
 		}
+		allowlistSubnets = append(allowlistSubnets, subnet)
+	}
+	// addresses of the domains are allowlisted the same way as the subnets
+	domainSubnets, _ := netw.resolveAllowlistDomains(allowlist.Domains)
 
+	for _, subnet := range append(allowlistSubnets, domainSubnets...) {
 		// for private network we add only firewall exception
 		if subnet.Addr().IsPrivate() || subnet.Addr().IsLinkLocalUnicast() {
 			subnets = append(subnets, subnet)
@@ -974,10 +1409,12 @@ func (netw *Combined) setAllowlist(allowlist config.Allowlist) error {
 		return err
 	}
 	netw.allowlist = allowlist
+	netw.scheduleDomainRefresh()
 	return nil
 }
 
 func (netw *Combined) unsetAllowlist() error {
+	netw.stopDomainRefresh()
 	if err := netw.allowlistRouter.Flush(); err != nil {
 		return fmt.Errorf("flushing the allowlist router: %w", err)
 	}
@@ -1081,6 +1518,16 @@ func (netw *Combined) setKillSwitch(allowlist config.Allowlist) error {
 			return err
 		}
 	}
+	if netw.killSwitchLAN {
+		if err := netw.allowKillSwitchLAN(); err != nil {
+			return err
+		}
+	}
+	if netw.multicastDiscovery {
+		if err := netw.allowMulticastDiscovery(); err != nil {
+			return err
+		}
+	}
 	netw.isKillSwitchSet = true
 	return nil
 }
@@ -1092,6 +1539,13 @@ func (netw *Combined) UnsetKillSwitch() error {
 }
 
 func (netw *Combined) unsetKillSwitch() error {
+	if err := netw.blockKillSwitchLAN(); err != nil {
+		return err
+	}
+	if err := netw.blockMulticastDiscovery(); err != nil {
+		return err
+	}
+
 	if !netw.isVpnSet {
 		if err := netw.unsetNetwork(); err != nil {
 			return err
@@ -1399,6 +1853,12 @@ func (netw *Combined) StatusMap() (map[string]string, error) {
 	return netw.mesh.StatusMap()
 }
 
+func (netw *Combined) PathMap() (map[string]string, error) {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+	return netw.mesh.PathMap()
+}
+
 // AllowIncoming traffic from the uniqueAddress.
 func (netw *Combined) AllowIncoming(uniqueAddress meshnet.UniqueAddress, lanAllowed bool) error {
 	netw.mu.Lock()
@@ -1643,3 +2103,315 @@ func (netw *Combined) SetLanDiscovery(enabled bool) {
 			err)
 	}
 }
+
+func (netw *Combined) SetDefaultRouteMode(mode config.DefaultRouteMode) {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+	netw.defaultRouteMode = mode
+}
+
+// SetKillSwitchLog controls whether the packets dropped by the traffic block are
+// logged. It is applied the next time the traffic block is set up, because
+// re-adding the block would put it above the already allowed traffic.
+func (netw *Combined) SetKillSwitchLog(enabled bool) {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+	netw.killSwitchLog = enabled
+}
+
+// SetKillSwitchLAN controls whether the LAN traffic is allowed while the kill
+// switch is set. It does not depend on the LAN discovery, which controls the LAN
+// access while connected to VPN.
+func (netw *Combined) SetKillSwitchLAN(allowed bool) error {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+
+	if netw.isKillSwitchSet {
+		var err error
+		if allowed {
+			err = netw.allowKillSwitchLAN()
+		} else {
+			err = netw.blockKillSwitchLAN()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	netw.killSwitchLAN = allowed
+	return nil
+}
+
+func (netw *Combined) allowKillSwitchLAN() error {
+	ifaces, err := netw.devices()
+	if err != nil {
+		return err
+	}
+
+	err = netw.fw.Add([]firewall.Rule{
+		{
+			Name:           killSwitchLANRule,
+			Interfaces:     ifaces,
+			RemoteNetworks: killSwitchLANNetworks,
+			Direction:      firewall.TwoWay,
+			Allow:          true,
+		},
+	})
+	if err != nil && !errors.Is(err, firewall.ErrRuleAlreadyExists) {
+		return fmt.Errorf("allowing LAN traffic: %w", err)
+	}
+	return nil
+}
+
+func (netw *Combined) blockKillSwitchLAN() error {
+	err := netw.fw.Delete([]string{killSwitchLANRule})
+	if err != nil && !errors.Is(err, firewall.ErrRuleNotFound) {
+		return fmt.Errorf("blocking LAN traffic: %w", err)
+	}
+	return nil
+}
+
+// SetMulticastDiscovery controls whether the mDNS and SSDP traffic is allowed
+// while the kill switch is set, so that printers and casting devices are found
+// while the rest of the LAN traffic is blocked.
+func (netw *Combined) SetMulticastDiscovery(allowed bool) error {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+
+	if netw.isKillSwitchSet {
+		var err error
+		if allowed {
+			err = netw.allowMulticastDiscovery()
+		} else {
+			err = netw.blockMulticastDiscovery()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	netw.multicastDiscovery = allowed
+	return nil
+}
+
+// allowMulticastDiscovery allows the queries and announcements sent to the
+// multicast groups and the unicast replies sent from the discovery ports of the
+// LAN devices. Other unicast LAN traffic stays blocked.
+func (netw *Combined) allowMulticastDiscovery() error {
+	ifaces, err := netw.devices()
+	if err != nil {
+		return err
+	}
+
+	err = netw.fw.Add([]firewall.Rule{
+		{
+			Name:           multicastDiscoveryRule + "_out",
+			Interfaces:     ifaces,
+			RemoteNetworks: multicastDiscoveryGroups,
+			Protocols:      []string{"udp"},
+			Ports:          multicastDiscoveryPorts,
+			PortsDirection: firewall.Destination,
+			Direction:      firewall.Outbound,
+			Allow:          true,
+		},
+		{
+			Name:           multicastDiscoveryRule + "_in",
+			Interfaces:     ifaces,
+			LocalNetworks:  multicastDiscoveryGroups,
+			Protocols:      []string{"udp"},
+			Ports:          multicastDiscoveryPorts,
+			PortsDirection: firewall.Destination,
+			Direction:      firewall.Inbound,
+			Allow:          true,
+		},
+		{
+			Name:           multicastDiscoveryRule + "_reply",
+			Interfaces:     ifaces,
+			RemoteNetworks: killSwitchLANNetworks,
+			Protocols:      []string{"udp"},
+			Ports:          multicastDiscoveryPorts,
+			PortsDirection: firewall.Source,
+			Direction:      firewall.Inbound,
+			Allow:          true,
+		},
+	})
+	if err != nil && !errors.Is(err, firewall.ErrRuleAlreadyExists) {
+		return fmt.Errorf("allowing multicast discovery: %w", err)
+	}
+	return nil
+}
+
+func (netw *Combined) blockMulticastDiscovery() error {
+	err := netw.fw.Delete([]string{
+		multicastDiscoveryRule + "_out",
+		multicastDiscoveryRule + "_in",
+		multicastDiscoveryRule + "_reply",
+	})
+	if err != nil && !errors.Is(err, firewall.ErrRuleNotFound) {
+		return fmt.Errorf("blocking multicast discovery: %w", err)
+	}
+	return nil
+}
+
+// RebuildFirewall removes the firewall rules from the system and applies them
+// again, the tunnel and the routes are not changed
+func (netw *Combined) RebuildFirewall() (firewall.Reconciliation, error) {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+	return netw.fw.Rebuild()
+}
+
+// SetDNSIPv6 controls whether IPv6 nameservers are configured. DNS of the active
+// connection is reconfigured.
+func (netw *Combined) SetDNSIPv6(enabled bool) error {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+	netw.dnsIPv6 = enabled
+	if !netw.isConnectedToVPN() || len(netw.lastNameservers) == 0 {
+		return nil
+	}
+	return netw.setDNS(netw.lastNameservers)
+}
+
+// SetDNSSearchDomains sets the search domains configured together with the
+// nameservers. DNS of the active connection is reconfigured.
+func (netw *Combined) SetDNSSearchDomains(domains []string) error {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+	netw.dnsSearchDomains = domains
+	if !netw.isConnectedToVPN() || len(netw.lastNameservers) == 0 {
+		return nil
+	}
+	return netw.setDNS(netw.lastNameservers)
+}
+
+// SetDNSRoutes sets the domains resolved by the internal nameservers. DNS of the
+// active connection is reconfigured.
+func (netw *Combined) SetDNSRoutes(routes config.DNSRoutes) error {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+	netw.dnsRoutes = routes
+	if !netw.isConnectedToVPN() || len(netw.lastNameservers) == 0 {
+		return nil
+	}
+	return netw.setDNS(netw.lastNameservers)
+}
+
+// SetDNSFilter sets the record types answered locally with an empty answer
+// instead of being resolved. Empty types disable the filter. DNS of the active
+// connection is reconfigured.
+func (netw *Combined) SetDNSFilter(types []string) error {
+	var filter *dns.QueryTypeFilter
+	if len(types) > 0 {
+		var err error
+		if filter, err = dns.NewQueryTypeFilter(types); err != nil {
+			return err
+		}
+	}
+
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+	netw.dnsFilter = filter
+	if !netw.isConnectedToVPN() || len(netw.lastNameservers) == 0 {
+		return nil
+	}
+	return netw.setDNS(netw.lastNameservers)
+}
+
+// dnsRoutes skips the routes with invalid nameservers, they are validated
+// when the routes are added
+func dnsRoutes(routes config.DNSRoutes) []dns.Route {
+	var ret []dns.Route
+	for _, route := range routes {
+		addr, err := netip.ParseAddr(route.Nameserver)
+		if err != nil {
+			log.Println(internal.WarningPrefix, "invalid nameserver of the dns route", route.Domain+":", route.Nameserver)
+			continue
+		}
+		ret = append(ret, dns.Route{Domain: route.Domain, Nameserver: addr, ViaTunnel: route.ViaTunnel})
+	}
+	return ret
+}
+
+// SetFirewallTemplates validates the templates and applies the ones of the
+// current connection stage. Nothing is changed if any of the templates is invalid.
+func (netw *Combined) SetFirewallTemplates(paths config.FirewallTemplates) error {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+
+	for _, path := range []string{paths.Persistent, paths.PreConnect, paths.PostDisconnect} {
+		if path == "" {
+			continue
+		}
+		if err := netw.fwTemplates.Validate(path); err != nil {
+			return err
+		}
+	}
+	netw.templatePaths = paths
+
+	if err := netw.applyTemplate(templates.Persistent); err != nil {
+		return err
+	}
+	if netw.isVpnSet {
+		return netw.applyTemplate(templates.PreConnect)
+	}
+	return netw.applyTemplate(templates.PostDisconnect)
+}
+
+// SetSplitTunnel sets the applications routed differently from the rest of the
+// system. Configuration is applied right away if VPN is connected.
+func (netw *Combined) SetSplitTunnel(cfg config.SplitTunnel) error {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+	netw.splitTunnelCfg = cfg
+	if !netw.isVpnSet {
+		return nil
+	}
+	return netw.setSplitTunnel()
+}
+
+func (netw *Combined) setSplitTunnel() error {
+	if len(netw.splitTunnelCfg.Apps) == 0 {
+		return netw.unsetSplitTunnel()
+	}
+	if err := netw.splitTunnel.Enable(netw.splitTunnelCfg); err != nil {
+		return err
+	}
+	netw.isSplitTunnelSet = true
+	return nil
+}
+
+func (netw *Combined) unsetSplitTunnel() error {
+	if !netw.isSplitTunnelSet {
+		return nil
+	}
+	if err := netw.splitTunnel.Disable(); err != nil {
+		return err
+	}
+	netw.isSplitTunnelSet = false
+	return nil
+}
+
+// switchTemplates removes the rules of the ending stage and applies the rules
+// of the next one
+func (netw *Combined) switchTemplates(from templates.Stage, to templates.Stage) error {
+	if err := netw.fwTemplates.Remove(from); err != nil {
+		return err
+	}
+	return netw.applyTemplate(to)
+}
+
+func (netw *Combined) applyTemplate(stage templates.Stage) error {
+	var path string
+	switch stage {
+	case templates.Persistent:
+		path = netw.templatePaths.Persistent
+	case templates.PreConnect:
+		path = netw.templatePaths.PreConnect
+	case templates.PostDisconnect:
+		path = netw.templatePaths.PostDisconnect
+	}
+
+	if path == "" {
+		return netw.fwTemplates.Remove(stage)
+	}
+	return netw.fwTemplates.Apply(stage, path)
+}
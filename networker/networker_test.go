@@ -1,6 +1,7 @@
 package networker
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/netip"
@@ -13,6 +14,7 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
 	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
 	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/allowlist"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/templates"
 	"github.com/NordSecurity/nordvpn-linux/daemon/routes"
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/events/subs"
@@ -23,18 +25,23 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/tunnel"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slices"
 )
 
 func GetTestCombined() *Combined {
-	return NewCombined(
+	netw := NewCombined(
 		&mock.WorkingVPN{},
+		nil,
 		&workingMesh{},
 		workingGateway{},
+		workingDefaultRoutes{},
 		&subs.Subject[string]{},
 		workingRouter{},
 		&workingDNS{},
 		&workingIpv6{},
 		newWorkingFirewall(),
+		workingTemplates{},
+		workingSplitTunnel{},
 		workingAllowlistRouting{},
 		workingDeviceList,
 		&workingRoutingSetup{},
@@ -44,7 +51,13 @@ func GetTestCombined() *Combined {
 		&workingExitNode{},
 		0,
 		false,
+		config.DefaultRouteReplace,
+		true,
 	)
+	netw.probeMTU = func(context.Context, netip.Addr, int) (bool, error) { return true, nil }
+	netw.setLinkMTU = func(string, int) error { return nil }
+	netw.routeThroughUplink = func(netip.Addr, string) (func() error, error) { return func() error { return nil }, nil }
+	return netw
 }
 
 type workingGateway struct{}
@@ -53,6 +66,23 @@ func (w workingGateway) Default(bool) (netip.Addr, net.Interface, error) {
 	return netip.MustParseAddr("1.1.1.1"), mock.En0Interface, nil
 }
 
+type workingDefaultRoutes struct{}
+
+func (workingDefaultRoutes) List(uint) ([]routes.Route, error) { return nil, nil }
+func (workingDefaultRoutes) Add(routes.Route) error            { return nil }
+func (workingDefaultRoutes) Delete(routes.Route) error         { return nil }
+
+type workingTemplates struct{}
+
+func (workingTemplates) Validate(string) error               { return nil }
+func (workingTemplates) Apply(templates.Stage, string) error { return nil }
+func (workingTemplates) Remove(templates.Stage) error        { return nil }
+
+type workingSplitTunnel struct{}
+
+func (workingSplitTunnel) Enable(config.SplitTunnel) error { return nil }
+func (workingSplitTunnel) Disable() error                  { return nil }
+
 type workingRouter struct{}
 
 func (workingRouter) Add(routes.Route) error { return nil }
@@ -69,15 +99,22 @@ func (failingRouter) Enable(uint) error      { return mock.ErrOnPurpose }
 func (failingRouter) Disable() error         { return mock.ErrOnPurpose }
 func (failingRouter) IsEnabled() bool        { return false }
 
-type workingDNS struct{ setDNS []string }
+type workingDNS struct {
+	setDNS           []string
+	setSearchDomains []string
+}
 
-func (w *workingDNS) Set(_ string, dns []string) error { w.setDNS = dns; return nil }
-func (w *workingDNS) Unset(string) error               { w.setDNS = nil; return nil }
+func (w *workingDNS) Set(_ string, dns []string, searchDomains []string) error {
+	w.setDNS = dns
+	w.setSearchDomains = searchDomains
+	return nil
+}
+func (w *workingDNS) Unset(string) error { w.setDNS = nil; w.setSearchDomains = nil; return nil }
 
 type failingDNS struct{}
 
-func (failingDNS) Set(string, []string) error { return mock.ErrOnPurpose }
-func (failingDNS) Unset(string) error         { return mock.ErrOnPurpose }
+func (failingDNS) Set(string, []string, []string) error { return mock.ErrOnPurpose }
+func (failingDNS) Unset(string) error                   { return mock.ErrOnPurpose }
 
 type workingIpv6 struct{}
 
@@ -121,6 +158,9 @@ func (f *workingFirewall) Delete(rules []string) error {
 func (workingFirewall) Enable() error   { return nil }
 func (workingFirewall) Disable() error  { return nil }
 func (workingFirewall) IsEnabled() bool { return true }
+func (workingFirewall) Rebuild() (firewall.Reconciliation, error) {
+	return firewall.Reconciliation{}, nil
+}
 
 type workingAllowlistRouting struct{}
 
@@ -135,6 +175,9 @@ func (failingFirewall) Delete([]string) error     { return mock.ErrOnPurpose }
 func (failingFirewall) Enable() error             { return mock.ErrOnPurpose }
 func (failingFirewall) Disable() error            { return mock.ErrOnPurpose }
 func (failingFirewall) IsEnabled() bool           { return false }
+func (failingFirewall) Rebuild() (firewall.Reconciliation, error) {
+	return firewall.Reconciliation{}, mock.ErrOnPurpose
+}
 
 type meshnetterFirewall struct{}
 
@@ -154,6 +197,9 @@ func (meshnetterFirewall) Delete([]string) error { return nil }
 func (meshnetterFirewall) Enable() error         { return nil }
 func (meshnetterFirewall) Disable() error        { return nil }
 func (meshnetterFirewall) IsEnabled() bool       { return true }
+func (meshnetterFirewall) Rebuild() (firewall.Reconciliation, error) {
+	return firewall.Reconciliation{}, nil
+}
 
 func workingDeviceList() ([]net.Interface, error) {
 	return []net.Interface{mock.En0Interface}, nil
@@ -219,6 +265,9 @@ func (*workingMesh) Tun() tunnel.T                     { return mock.WorkingT{}
 func (*workingMesh) StatusMap() (map[string]string, error) {
 	return map[string]string{}, nil
 }
+func (*workingMesh) PathMap() (map[string]string, error) {
+	return map[string]string{}, nil
+}
 func (w *workingMesh) NetworkChanged() error { return w.networkChangedErr }
 
 type workingHostSetter struct {
@@ -347,12 +396,16 @@ func TestCombined_Start(t *testing.T) {
 			netw := NewCombined(
 				test.vpn,
 				nil,
+				nil,
 				test.gateway,
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				test.allowlistRouter,
 				test.dns,
 				&workingIpv6{},
 				test.fw,
+				workingTemplates{},
+				workingSplitTunnel{},
 				test.allowlist,
 				test.devices,
 				test.routing,
@@ -362,6 +415,8 @@ func TestCombined_Start(t *testing.T) {
 				&workingExitNode{},
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			err := netw.Start(
 				vpn.Credentials{},
@@ -415,12 +470,16 @@ func TestCombined_Stop(t *testing.T) {
 			netw := NewCombined(
 				test.vpn,
 				nil,
+				nil,
 				workingGateway{},
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				workingRouter{},
 				test.dns,
 				&workingIpv6{},
 				&workingFirewall{},
+				workingTemplates{},
+				workingSplitTunnel{},
 				workingAllowlistRouting{},
 				nil,
 				&workingRoutingSetup{},
@@ -430,6 +489,8 @@ func TestCombined_Stop(t *testing.T) {
 				&workingExitNode{},
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			netw.vpnet = test.vpn
 			err := netw.stop()
@@ -467,7 +528,7 @@ func TestCombined_TransferRates(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			// Test does not rely on any of the values provided via constructor
 			// so it's fine to pass nils to all of them.
-			netw := NewCombined(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0, false)
+			netw := NewCombined(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0, false, config.DefaultRouteReplace, true)
 			// injecting VPN implementation without calling netw.Start
 			netw.vpnet = test.vpn
 			connStus, err := netw.ConnectionStatus()
@@ -516,14 +577,18 @@ func TestCombined_SetDNS(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			netw := NewCombined(
+				nil,
 				nil,
 				nil,
 				workingGateway{},
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				workingRouter{},
 				test.dns,
 				&workingIpv6{},
 				&workingFirewall{},
+				workingTemplates{},
+				workingSplitTunnel{},
 				nil,
 				nil,
 				nil,
@@ -533,6 +598,8 @@ func TestCombined_SetDNS(t *testing.T) {
 				nil,
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			netw.vpnet = &mock.WorkingVPN{}
 			err := netw.setDNS(test.nameservers)
@@ -541,6 +608,49 @@ func TestCombined_SetDNS(t *testing.T) {
 	}
 }
 
+func TestCombined_SetDNSIPv6(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	dnsSetter := &workingDNS{}
+	netw := GetTestCombined()
+	netw.vpnet = &mock.ActiveVPN{}
+	netw.dnsSetter = dnsSetter
+	nameservers := []string{"103.86.96.100", "2400:bb40:4444::100", "103.86.99.100"}
+
+	assert.NoError(t, netw.SetDNSIPv6(false))
+	assert.NoError(t, netw.SetDNS(nameservers))
+	assert.Equal(t, []string{"103.86.96.100", "103.86.99.100"}, dnsSetter.setDNS)
+
+	// DNS of the active connection is reconfigured
+	assert.NoError(t, netw.SetDNSIPv6(true))
+	assert.Equal(t, nameservers, dnsSetter.setDNS)
+}
+
+func TestCombined_SetDNSSearchDomains(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	dnsSetter := &workingDNS{}
+	netw := GetTestCombined()
+	netw.vpnet = &mock.ActiveVPN{}
+	netw.dnsSetter = dnsSetter
+
+	assert.NoError(t, netw.SetDNS([]string{"103.86.96.100"}))
+	assert.Empty(t, dnsSetter.setSearchDomains)
+
+	// DNS of the active connection is reconfigured
+	assert.NoError(t, netw.SetDNSSearchDomains([]string{"corp.example.com"}))
+	assert.Equal(t, []string{"corp.example.com"}, dnsSetter.setSearchDomains)
+
+	// meshnet domain is added while meshnet is set
+	netw.isMeshnetSet = true
+	assert.NoError(t, netw.SetDNS([]string{"103.86.96.100"}))
+	assert.Equal(t, []string{"corp.example.com", dns.MeshnetSearchDomain}, dnsSetter.setSearchDomains)
+	assert.Equal(t, []string{"corp.example.com"}, netw.dnsSearchDomains)
+
+	assert.NoError(t, netw.UnsetDNS())
+	assert.Empty(t, dnsSetter.setSearchDomains)
+}
+
 func TestCombined_UnsetDNS(t *testing.T) {
 	category.Set(t, category.Unit)
 
@@ -566,12 +676,16 @@ func TestCombined_UnsetDNS(t *testing.T) {
 			netw := NewCombined(
 				&mock.ActiveVPN{},
 				nil,
+				nil,
 				workingGateway{},
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				workingRouter{},
 				test.dns,
 				&workingIpv6{},
 				&workingFirewall{},
+				workingTemplates{},
+				workingSplitTunnel{},
 				nil,
 				nil,
 				nil,
@@ -581,6 +695,8 @@ func TestCombined_UnsetDNS(t *testing.T) {
 				nil,
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			err := netw.UnsetDNS()
 			assert.Equal(t, test.hasError, err != nil)
@@ -627,14 +743,18 @@ func TestCombined_ResetAllowlist(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			netw := NewCombined(
+				nil,
 				nil,
 				nil,
 				workingGateway{},
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				workingRouter{},
 				&workingDNS{},
 				workingIpv6{},
 				test.fw,
+				workingTemplates{},
+				workingSplitTunnel{},
 				test.allowlist,
 				test.devices,
 				test.routing,
@@ -644,6 +764,8 @@ func TestCombined_ResetAllowlist(t *testing.T) {
 				nil,
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			assert.ErrorIs(t, netw.resetAllowlist(), test.err)
 		})
@@ -691,10 +813,14 @@ func TestCombined_BlockTraffic(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				workingDefaultRoutes{},
+				nil,
 				nil,
 				nil,
 				nil,
 				test.fw,
+				workingTemplates{},
+				workingSplitTunnel{},
 				nil,
 				test.devices,
 				test.routing,
@@ -704,6 +830,8 @@ func TestCombined_BlockTraffic(t *testing.T) {
 				nil,
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			assert.ErrorIs(t, netw.blockTraffic(), test.err)
 		})
@@ -738,10 +866,14 @@ func TestCombined_UnblockTraffic(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				workingDefaultRoutes{},
+				nil,
 				nil,
 				nil,
 				nil,
 				test.fw,
+				workingTemplates{},
+				workingSplitTunnel{},
 				nil,
 				nil,
 				nil,
@@ -751,6 +883,8 @@ func TestCombined_UnblockTraffic(t *testing.T) {
 				nil,
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			assert.ErrorIs(t, netw.unblockTraffic(), test.err)
 		})
@@ -798,10 +932,14 @@ func TestCombined_AllowIPv6Traffic(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				workingDefaultRoutes{},
+				nil,
 				nil,
 				nil,
 				nil,
 				test.fw,
+				workingTemplates{},
+				workingSplitTunnel{},
 				nil,
 				test.devices,
 				test.routing,
@@ -811,6 +949,8 @@ func TestCombined_AllowIPv6Traffic(t *testing.T) {
 				nil,
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			assert.ErrorIs(t, netw.allowIPv6Traffic(), test.err)
 		})
@@ -845,10 +985,14 @@ func TestCombined_StopAllowedIPv6Traffic(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				workingDefaultRoutes{},
+				nil,
 				nil,
 				nil,
 				nil,
 				test.fw,
+				workingTemplates{},
+				workingSplitTunnel{},
 				nil,
 				nil,
 				nil,
@@ -858,6 +1002,8 @@ func TestCombined_StopAllowedIPv6Traffic(t *testing.T) {
 				nil,
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			assert.ErrorIs(t, netw.stopAllowedIPv6Traffic(), test.err)
 		})
@@ -938,14 +1084,18 @@ func TestCombined_SetAllowlist(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			netw := NewCombined(
+				nil,
 				nil,
 				nil,
 				workingGateway{},
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				test.rt,
 				&workingDNS{},
 				&workingIpv6{},
 				test.fw,
+				workingTemplates{},
+				workingSplitTunnel{},
 				test.allowlistRouting,
 				test.devices,
 				test.routing,
@@ -955,6 +1105,8 @@ func TestCombined_SetAllowlist(t *testing.T) {
 				nil,
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			assert.ErrorIs(t, netw.setAllowlist(test.allowlist), test.err)
 		})
@@ -996,14 +1148,18 @@ func TestCombined_UnsetAllowlist(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			netw := NewCombined(
+				nil,
 				nil,
 				nil,
 				workingGateway{},
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				test.rt,
 				&workingDNS{},
 				&workingIpv6{},
 				test.fw,
+				workingTemplates{},
+				workingSplitTunnel{},
 				test.allowlist,
 				workingDeviceList,
 				&workingRoutingSetup{},
@@ -1013,6 +1169,8 @@ func TestCombined_UnsetAllowlist(t *testing.T) {
 				nil,
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			err := netw.unsetAllowlist()
 			assert.ErrorIs(t, err, test.err)
@@ -1075,14 +1233,18 @@ func TestCombined_SetNetwork(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			netw := NewCombined(
+				nil,
 				nil,
 				nil,
 				workingGateway{},
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				test.rt,
 				&workingDNS{},
 				&workingIpv6{},
 				test.fw,
+				workingTemplates{},
+				workingSplitTunnel{},
 				test.allowlist,
 				test.devices,
 				test.routing,
@@ -1092,6 +1254,8 @@ func TestCombined_SetNetwork(t *testing.T) {
 				&workingExitNode{},
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			assert.False(t, netw.IsNetworkSet())
 			err := netw.setNetwork(
@@ -1140,14 +1304,18 @@ func TestCombined_UnsetNetwork(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			netw := NewCombined(
+				nil,
 				nil,
 				nil,
 				workingGateway{},
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				test.rt,
 				&workingDNS{},
 				&workingIpv6{},
 				test.fw,
+				workingTemplates{},
+				workingSplitTunnel{},
 				test.allowlist,
 				workingDeviceList,
 				&workingRoutingSetup{},
@@ -1157,6 +1325,8 @@ func TestCombined_UnsetNetwork(t *testing.T) {
 				&workingExitNode{},
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			assert.ErrorIs(t, netw.unsetNetwork(), test.err)
 		})
@@ -1222,14 +1392,18 @@ func TestCombined_AllowIncoming(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			netw := NewCombined(
+				nil,
 				nil,
 				nil,
 				workingGateway{},
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				test.rt,
 				&workingDNS{},
 				&workingIpv6{},
 				test.fw,
+				workingTemplates{},
+				workingSplitTunnel{},
 				test.allowlist,
 				workingDeviceList,
 				&workingRoutingSetup{},
@@ -1239,6 +1413,8 @@ func TestCombined_AllowIncoming(t *testing.T) {
 				nil,
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			uniqueAddress := meshnet.UniqueAddress{UID: test.publicKey, Address: netip.MustParseAddr(test.address)}
 			err := netw.AllowIncoming(uniqueAddress, test.lanAllowed)
@@ -1292,14 +1468,18 @@ func TestCombined_BlockIncoming(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			netw := NewCombined(
+				nil,
 				nil,
 				nil,
 				workingGateway{},
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				test.rt,
 				&workingDNS{},
 				&workingIpv6{},
 				test.fw,
+				workingTemplates{},
+				workingSplitTunnel{},
 				test.allowlist,
 				workingDeviceList,
 				&workingRoutingSetup{},
@@ -1309,6 +1489,8 @@ func TestCombined_BlockIncoming(t *testing.T) {
 				nil,
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			uniqueAddress := meshnet.UniqueAddress{UID: test.publicKey, Address: netip.MustParseAddr(test.address)}
 			err := netw.AllowIncoming(uniqueAddress, true)
@@ -1344,14 +1526,18 @@ func TestCombined_SetMesh(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.publicKey, func(t *testing.T) {
 			netw := NewCombined(
+				nil,
 				nil,
 				&workingMesh{},
 				workingGateway{},
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				test.rt,
 				&workingDNS{},
 				&workingIpv6{},
 				test.fw,
+				workingTemplates{},
+				workingSplitTunnel{},
 				test.allowlist,
 				workingDeviceList,
 				&workingRoutingSetup{},
@@ -1361,6 +1547,8 @@ func TestCombined_SetMesh(t *testing.T) {
 				&workingExitNode{},
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			assert.ErrorIs(t, test.err, netw.SetMesh(
 				mesh.MachineMap{},
@@ -1396,14 +1584,18 @@ func TestCombined_UnSetMesh(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.publicKey, func(t *testing.T) {
 			netw := NewCombined(
+				nil,
 				nil,
 				&workingMesh{},
 				workingGateway{},
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				test.rt,
 				&workingDNS{},
 				&workingIpv6{},
 				test.fw,
+				workingTemplates{},
+				workingSplitTunnel{},
 				test.allowlist,
 				workingDeviceList,
 				&workingRoutingSetup{},
@@ -1413,6 +1605,8 @@ func TestCombined_UnSetMesh(t *testing.T) {
 				&workingExitNode{},
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			netw.isMeshnetSet = true
 			assert.ErrorIs(t, test.err, netw.UnSetMesh())
@@ -1453,14 +1647,18 @@ func TestCombined_Reconnect(t *testing.T) {
 			meshnet := &workingMesh{}
 			meshnet.networkChangedErr = mock.ErrOnPurpose
 			netw := NewCombined(
+				nil,
 				nil,
 				meshnet,
 				workingGateway{},
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				nil,
 				&workingDNS{},
 				&workingIpv6{},
 				fw,
+				workingTemplates{},
+				workingSplitTunnel{},
 				nil,
 				workingDeviceList,
 				router,
@@ -1470,6 +1668,8 @@ func TestCombined_Reconnect(t *testing.T) {
 				&workingExitNode{},
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			// activate meshnet
 			assert.ErrorIs(t, test.err, netw.SetMesh(
@@ -1535,11 +1735,15 @@ func TestCombined_allowIncoming(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				nil,
 				nil,
 				nil,
 				&mockFirewall,
+				workingTemplates{},
+				workingSplitTunnel{},
 				nil,
 				nil,
 				nil,
@@ -1549,6 +1753,8 @@ func TestCombined_allowIncoming(t *testing.T) {
 				nil,
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			err := netw.allowIncoming(test.name, netip.MustParseAddr(test.address), test.lanAllowed)
 
@@ -1605,11 +1811,15 @@ func TestCombined_Block(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				nil,
 				nil,
 				nil,
 				test.fw,
+				workingTemplates{},
+				workingSplitTunnel{},
 				nil,
 				nil,
 				nil,
@@ -1619,6 +1829,8 @@ func TestCombined_Block(t *testing.T) {
 				nil,
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			err := netw.allowIncoming(test.name, netip.MustParseAddr(test.address), true)
 			assert.Nil(t, err)
@@ -1664,11 +1876,15 @@ func TestCombined_allowGeneratedRule(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				nil,
 				nil,
 				nil,
 				test.fw,
+				workingTemplates{},
+				workingSplitTunnel{},
 				nil,
 				nil,
 				nil,
@@ -1678,6 +1894,8 @@ func TestCombined_allowGeneratedRule(t *testing.T) {
 				nil,
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			err := netw.allowIncoming(test.name, netip.MustParseAddr(test.address), true)
 			assert.Equal(t, nil, err)
@@ -1707,11 +1925,15 @@ func TestCombined_BlocNonExistingRuleFail(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				nil,
 				nil,
 				nil,
 				test.fw,
+				workingTemplates{},
+				workingSplitTunnel{},
 				nil,
 				nil,
 				nil,
@@ -1721,6 +1943,8 @@ func TestCombined_BlocNonExistingRuleFail(t *testing.T) {
 				nil,
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			// Should fail to block rule non existing
 			expectedErrorMsg := fmt.Sprintf("allow rule does not exist for %s", test.ruleName)
@@ -1753,11 +1977,15 @@ func TestCombined_allowExistingRuleFail(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				nil,
 				nil,
 				nil,
 				&mockFirewall,
+				workingTemplates{},
+				workingSplitTunnel{},
 				nil,
 				nil,
 				nil,
@@ -1767,6 +1995,8 @@ func TestCombined_allowExistingRuleFail(t *testing.T) {
 				nil,
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			err := netw.allowIncoming(test.name, netip.MustParseAddr(test.address), false)
 			assert.Equal(t, nil, err)
@@ -1785,14 +2015,18 @@ func TestCombined_Refresh(t *testing.T) {
 	exitNode := newWorkingExitNode()
 
 	netw := NewCombined(
+		nil,
 		nil,
 		&workingMesh{},
 		workingGateway{},
+		workingDefaultRoutes{},
 		&subs.Subject[string]{},
 		workingRouter{},
 		&workingDNS{},
 		&workingIpv6{},
 		fw,
+		workingTemplates{},
+		workingSplitTunnel{},
 		nil,
 		workingDeviceList,
 		&workingRoutingSetup{},
@@ -1802,6 +2036,8 @@ func TestCombined_Refresh(t *testing.T) {
 		exitNode,
 		0,
 		false,
+		config.DefaultRouteReplace,
+		true,
 	)
 
 	machineHostName := "test-fuji.nord"
@@ -1953,11 +2189,15 @@ func TestDnsAfterVPNRefresh(t *testing.T) {
 		&mock.WorkingVPN{},
 		nil,
 		nil,
+		nil,
+		workingDefaultRoutes{},
 		&subs.Subject[string]{},
 		workingRouter{},
 		dns,
 		&workingIpv6{},
 		newWorkingFirewall(),
+		workingTemplates{},
+		workingSplitTunnel{},
 		workingAllowlistRouting{},
 		workingDeviceList,
 		&workingRoutingSetup{},
@@ -1967,6 +2207,8 @@ func TestDnsAfterVPNRefresh(t *testing.T) {
 		&workingExitNode{},
 		0,
 		false,
+		config.DefaultRouteReplace,
+		true,
 	)
 
 	err := netw.start(vpn.Credentials{}, vpn.ServerData{}, config.Allowlist{}, config.DNS{"1.1.1.1"})
@@ -2307,11 +2549,15 @@ func TestResetRouting(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				workingDefaultRoutes{},
 				&subs.Subject[string]{},
 				nil,
 				nil,
 				nil,
 				&mockFirewall,
+				workingTemplates{},
+				workingSplitTunnel{},
 				nil,
 				nil,
 				nil,
@@ -2321,6 +2567,8 @@ func TestResetRouting(t *testing.T) {
 				exitNode,
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 
 			err := netw.ResetRouting(peers[test.changedPeerIdx], peers)
@@ -2335,3 +2583,296 @@ func TestResetRouting(t *testing.T) {
 		})
 	}
 }
+
+type recordingDefaultRoutes struct {
+	routes []routes.Route
+}
+
+func (r *recordingDefaultRoutes) List(uint) ([]routes.Route, error) {
+	return slices.Clone(r.routes), nil
+}
+
+func (r *recordingDefaultRoutes) Add(route routes.Route) error {
+	r.routes = append(r.routes, route)
+	return nil
+}
+
+func (r *recordingDefaultRoutes) Delete(route routes.Route) error {
+	var left []routes.Route
+	for _, ro := range r.routes {
+		if !ro.IsEqual(route) {
+			left = append(left, ro)
+		}
+	}
+	r.routes = left
+	return nil
+}
+
+func TestCombined_DefaultRouteConflict(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	eth0 := net.Interface{Index: 2, Name: "eth0"}
+	conflicting := routes.Route{
+		Gateway: netip.MustParseAddr("192.168.1.1"),
+		Subnet:  netip.MustParsePrefix("0.0.0.0/0"),
+		Device:  eth0,
+	}
+	lowPriority := conflicting
+	lowPriority.Metric = 100
+	demoted := conflicting
+	demoted.Metric = coexistDefaultRouteMetric
+
+	tests := []struct {
+		name      string
+		mode      config.DefaultRouteMode
+		existing  []routes.Route
+		connected []routes.Route
+		err       error
+	}{
+		{
+			name:      "no conflict",
+			mode:      config.DefaultRouteRefuse,
+			connected: nil,
+		},
+		{
+			name:      "replace",
+			mode:      config.DefaultRouteReplace,
+			existing:  []routes.Route{conflicting},
+			connected: nil,
+		},
+		{
+			name:      "coexist",
+			mode:      config.DefaultRouteCoexist,
+			existing:  []routes.Route{conflicting},
+			connected: []routes.Route{demoted},
+		},
+		{
+			name:      "coexist with lower priority route",
+			mode:      config.DefaultRouteCoexist,
+			existing:  []routes.Route{lowPriority},
+			connected: []routes.Route{lowPriority},
+		},
+		{
+			name:      "refuse",
+			mode:      config.DefaultRouteRefuse,
+			existing:  []routes.Route{conflicting},
+			connected: []routes.Route{conflicting},
+			err:       ErrDefaultRouteConflict,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defaultRoutes := &recordingDefaultRoutes{routes: slices.Clone(test.existing)}
+			netw := NewCombined(
+				&mock.WorkingVPN{},
+				nil,
+				nil,
+				nil,
+				defaultRoutes,
+				&subs.Subject[string]{},
+				nil,
+				nil,
+				nil,
+				nil,
+				workingTemplates{},
+				workingSplitTunnel{},
+				nil,
+				nil,
+				&workingRoutingSetup{},
+				nil,
+				workingRouter{},
+				nil,
+				nil,
+				0,
+				false,
+				test.mode,
+				true,
+			)
+
+			err := netw.resolveDefaultRouteConflict()
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.connected, defaultRoutes.routes)
+
+			netw.restoreDefaultRoutes()
+			assert.ElementsMatch(t, test.existing, defaultRoutes.routes)
+		})
+	}
+}
+
+type recordingTemplates struct {
+	applied map[templates.Stage]string
+	invalid string
+}
+
+func (r *recordingTemplates) Validate(path string) error {
+	if path == r.invalid {
+		return mock.ErrOnPurpose
+	}
+	return nil
+}
+
+func (r *recordingTemplates) Apply(stage templates.Stage, path string) error {
+	r.applied[stage] = path
+	return nil
+}
+
+func (r *recordingTemplates) Remove(stage templates.Stage) error {
+	delete(r.applied, stage)
+	return nil
+}
+
+func TestCombined_FirewallTemplates(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fwTemplates := &recordingTemplates{
+		applied: map[templates.Stage]string{},
+		invalid: "/invalid.rules",
+	}
+	netw := GetTestCombined()
+	netw.fwTemplates = fwTemplates
+
+	paths := config.FirewallTemplates{
+		Persistent:     "/persistent.rules",
+		PreConnect:     "/pre-connect.rules",
+		PostDisconnect: "/post-disconnect.rules",
+	}
+	assert.NoError(t, netw.SetFirewallTemplates(paths))
+	assert.Equal(t, map[templates.Stage]string{
+		templates.Persistent:     "/persistent.rules",
+		templates.PostDisconnect: "/post-disconnect.rules",
+	}, fwTemplates.applied)
+
+	assert.NoError(t, netw.Start(vpn.Credentials{}, vpn.ServerData{}, config.Allowlist{}, nil, true))
+	assert.Equal(t, map[templates.Stage]string{
+		templates.Persistent: "/persistent.rules",
+		templates.PreConnect: "/pre-connect.rules",
+	}, fwTemplates.applied)
+
+	// nothing is changed when any of the templates is invalid
+	invalid := paths
+	invalid.PostDisconnect = "/invalid.rules"
+	assert.Error(t, netw.SetFirewallTemplates(invalid))
+
+	assert.NoError(t, netw.Stop())
+	assert.Equal(t, map[templates.Stage]string{
+		templates.Persistent:     "/persistent.rules",
+		templates.PostDisconnect: "/post-disconnect.rules",
+	}, fwTemplates.applied)
+
+	assert.NoError(t, netw.SetFirewallTemplates(config.FirewallTemplates{}))
+	assert.Empty(t, fwTemplates.applied)
+}
+
+type recordingSplitTunnel struct {
+	enabled *config.SplitTunnel
+}
+
+func (r *recordingSplitTunnel) Enable(cfg config.SplitTunnel) error {
+	r.enabled = &cfg
+	return nil
+}
+
+func (r *recordingSplitTunnel) Disable() error {
+	r.enabled = nil
+	return nil
+}
+
+func TestCombined_SplitTunnel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	splitTunnel := &recordingSplitTunnel{}
+	netw := GetTestCombined()
+	netw.splitTunnel = splitTunnel
+
+	cfg := config.SplitTunnel{Apps: []string{"firefox"}}
+	// applied only while connected
+	assert.NoError(t, netw.SetSplitTunnel(cfg))
+	assert.Nil(t, splitTunnel.enabled)
+
+	assert.NoError(t, netw.Start(vpn.Credentials{}, vpn.ServerData{}, config.Allowlist{}, nil, true))
+	assert.Equal(t, &cfg, splitTunnel.enabled)
+
+	cfg = config.SplitTunnel{Mode: config.SplitTunnelInclude, Apps: []string{"firefox"}}
+	assert.NoError(t, netw.SetSplitTunnel(cfg))
+	assert.Equal(t, &cfg, splitTunnel.enabled)
+
+	assert.NoError(t, netw.SetSplitTunnel(config.SplitTunnel{}))
+	assert.Nil(t, splitTunnel.enabled)
+
+	assert.NoError(t, netw.SetSplitTunnel(cfg))
+	assert.NoError(t, netw.Stop())
+	assert.Nil(t, splitTunnel.enabled)
+}
+
+func TestCombined_KillSwitchLAN(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fw := newWorkingFirewall()
+	netw := GetTestCombined()
+	netw.fw = fw
+
+	// applied only while the kill switch is set
+	assert.NoError(t, netw.SetKillSwitchLAN(true))
+	assert.NotContains(t, fw.rules, killSwitchLANRule)
+
+	assert.NoError(t, netw.SetKillSwitch(config.Allowlist{}))
+	assert.Contains(t, fw.rules, "drop")
+	assert.Equal(t, killSwitchLANNetworks, fw.rules[killSwitchLANRule].RemoteNetworks)
+	assert.True(t, fw.rules[killSwitchLANRule].Allow)
+
+	assert.NoError(t, netw.SetKillSwitchLAN(false))
+	assert.NotContains(t, fw.rules, killSwitchLANRule)
+	assert.Contains(t, fw.rules, "drop")
+
+	assert.NoError(t, netw.SetKillSwitchLAN(true))
+	assert.Contains(t, fw.rules, killSwitchLANRule)
+
+	// independent of the LAN discovery
+	netw.SetLanDiscovery(false)
+	assert.Contains(t, fw.rules, killSwitchLANRule)
+
+	assert.NoError(t, netw.UnsetKillSwitch())
+	assert.NotContains(t, fw.rules, killSwitchLANRule)
+	assert.True(t, netw.killSwitchLAN)
+}
+
+func TestCombined_MulticastDiscovery(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fw := newWorkingFirewall()
+	netw := GetTestCombined()
+	netw.fw = fw
+
+	// applied only while the kill switch is set
+	assert.NoError(t, netw.SetMulticastDiscovery(true))
+	assert.NotContains(t, fw.rules, multicastDiscoveryRule+"_out")
+
+	assert.NoError(t, netw.SetKillSwitch(config.Allowlist{}))
+	assert.Contains(t, fw.rules, "drop")
+	// unicast LAN traffic stays blocked
+	assert.NotContains(t, fw.rules, killSwitchLANRule)
+	out := fw.rules[multicastDiscoveryRule+"_out"]
+	assert.Equal(t, multicastDiscoveryGroups, out.RemoteNetworks)
+	assert.Equal(t, firewall.Outbound, out.Direction)
+	assert.Equal(t, multicastDiscoveryPorts, out.Ports)
+	in := fw.rules[multicastDiscoveryRule+"_in"]
+	assert.Equal(t, multicastDiscoveryGroups, in.LocalNetworks)
+	assert.Equal(t, firewall.Inbound, in.Direction)
+	reply := fw.rules[multicastDiscoveryRule+"_reply"]
+	assert.Equal(t, firewall.Source, reply.PortsDirection)
+	assert.Equal(t, firewall.Inbound, reply.Direction)
+
+	assert.NoError(t, netw.SetMulticastDiscovery(false))
+	assert.NotContains(t, fw.rules, multicastDiscoveryRule+"_out")
+	assert.NotContains(t, fw.rules, multicastDiscoveryRule+"_in")
+	assert.NotContains(t, fw.rules, multicastDiscoveryRule+"_reply")
+	assert.Contains(t, fw.rules, "drop")
+
+	assert.NoError(t, netw.SetMulticastDiscovery(true))
+	assert.Contains(t, fw.rules, multicastDiscoveryRule+"_in")
+
+	assert.NoError(t, netw.UnsetKillSwitch())
+	assert.NotContains(t, fw.rules, multicastDiscoveryRule+"_in")
+	assert.True(t, netw.multicastDiscovery)
+}
@@ -0,0 +1,195 @@
+package networker
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"net/netip"
+	"sort"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/routes"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SubnetOverlap is a LAN subnet which overlaps with a subnet used by the VPN.
+// Addresses of the overlap are reachable only through one of the interfaces.
+type SubnetOverlap struct {
+	LAN netip.Prefix
+	// Interface is the name of the LAN interface
+	Interface string
+	VPN       netip.Prefix
+	// Preferred is the name of the interface the overlapping addresses are routed
+	// to, empty if the overlap is only reported
+	Preferred string
+}
+
+// Overlap returns the addresses which belong to both subnets
+func (o SubnetOverlap) Overlap() netip.Prefix {
+	if o.LAN.Bits() > o.VPN.Bits() {
+		return o.LAN
+	}
+	return o.VPN
+}
+
+func (o SubnetOverlap) String() string {
+	s := fmt.Sprintf("LAN subnet %s on %s overlaps with VPN subnet %s", o.LAN, o.Interface, o.VPN)
+	if o.Preferred != "" {
+		s += fmt.Sprintf(", %s is routed to %s", o.Overlap(), o.Preferred)
+	}
+	return s
+}
+
+// SubnetsFunc returns the subnets of the addresses assigned to the interface
+type SubnetsFunc func(net.Interface) ([]netip.Prefix, error)
+
+// interfaceSubnets returns the subnets of the global unicast addresses assigned
+// to the interface
+func interfaceSubnets(iface net.Interface) ([]netip.Prefix, error) {
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return nil, fmt.Errorf("listing addresses of %s: %w", iface.Name, err)
+	}
+
+	var subnets []netip.Prefix
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok {
+			continue
+		}
+		ip, ok := netip.AddrFromSlice(ipNet.IP)
+		if !ok || !ip.Unmap().IsGlobalUnicast() {
+			continue
+		}
+		ones, _ := ipNet.Mask.Size()
+		subnets = append(subnets, netip.PrefixFrom(ip.Unmap(), ones).Masked())
+	}
+	return subnets, nil
+}
+
+// findSubnetOverlaps returns the LAN subnets which overlap with the VPN subnets.
+// lan is keyed by the interface name.
+func findSubnetOverlaps(lan map[string][]netip.Prefix, vpn []netip.Prefix) []SubnetOverlap {
+	names := make([]string, 0, len(lan))
+	for name := range lan {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var overlaps []SubnetOverlap
+	for _, name := range names {
+		for _, lanSubnet := range lan[name] {
+			for _, vpnSubnet := range vpn {
+				if lanSubnet.Overlaps(vpnSubnet) {
+					overlaps = append(overlaps, SubnetOverlap{
+						LAN:       lanSubnet,
+						Interface: name,
+						VPN:       vpnSubnet,
+					})
+				}
+			}
+		}
+	}
+	return overlaps
+}
+
+// overlapRoutes returns routes in the main table which are more specific than
+// both overlapping subnets, so that the longest prefix match picks the device
+// regardless of which subnet is the narrower one
+func overlapRoutes(overlap netip.Prefix, device net.Interface) []routes.Route {
+	overlap = overlap.Masked()
+	if overlap.Bits() == overlap.Addr().BitLen() {
+		return []routes.Route{{Subnet: overlap, Device: device}}
+	}
+
+	bits := overlap.Bits()
+	upper := overlap.Addr().AsSlice()
+	upper[bits/8] |= 0x80 >> (bits % 8)
+	upperAddr, _ := netip.AddrFromSlice(upper)
+	return []routes.Route{
+		{Subnet: netip.PrefixFrom(overlap.Addr(), bits+1), Device: device},
+		{Subnet: netip.PrefixFrom(upperAddr, bits+1), Device: device},
+	}
+}
+
+// handleSubnetOverlaps finds the LAN subnets which overlap with the tunnel and
+// meshnet subnets and routes the overlapping addresses according to the subnet
+// overlap mode. Routes are added using the VPN router, so they are removed
+// together with the VPN default route.
+func (netw *Combined) handleSubnetOverlaps() {
+	netw.subnetOverlaps = nil
+
+	tun := netw.vpnet.Tun().Interface()
+	vpnSubnets, err := netw.subnets(tun)
+	if err != nil {
+		log.Println(internal.WarningPrefix, "checking subnet overlaps:", err)
+		return
+	}
+	if netw.isMeshnetSet {
+		vpnSubnets = append(vpnSubnets, defaultMeshSubnet)
+	}
+
+	devices, err := netw.devices()
+	if err != nil {
+		log.Println(internal.WarningPrefix, "checking subnet overlaps:", err)
+		return
+	}
+	lan := map[string][]netip.Prefix{}
+	lanDevices := map[string]net.Interface{}
+	for _, device := range devices {
+		if device.Name == tun.Name {
+			continue
+		}
+		subnets, err := netw.subnets(device)
+		if err != nil {
+			log.Println(internal.WarningPrefix, "checking subnet overlaps:", err)
+			continue
+		}
+		lan[device.Name] = subnets
+		lanDevices[device.Name] = device
+	}
+
+	overlaps := findSubnetOverlaps(lan, vpnSubnets)
+	for i, overlap := range overlaps {
+		var preferred net.Interface
+		switch netw.subnetOverlapMode {
+		case config.SubnetOverlapPreferLAN:
+			preferred = lanDevices[overlap.Interface]
+		case config.SubnetOverlapPreferVPN:
+			preferred = tun
+		case config.SubnetOverlapWarn:
+			log.Println(internal.WarningPrefix, overlap)
+			continue
+		}
+
+		for _, route := range overlapRoutes(overlap.Overlap(), preferred) {
+			if err := netw.router.Add(route); err != nil {
+				log.Println(internal.WarningPrefix, "routing overlapping subnet:", err)
+			}
+		}
+		overlaps[i].Preferred = preferred.Name
+		log.Println(internal.WarningPrefix, overlaps[i])
+	}
+	netw.subnetOverlaps = overlaps
+}
+
+// SubnetOverlaps returns the LAN subnets which overlapped with the VPN subnets
+// when the current connection was set up
+func (netw *Combined) SubnetOverlaps() []SubnetOverlap {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+	if !netw.isVpnSet {
+		return nil
+	}
+	overlaps := make([]SubnetOverlap, len(netw.subnetOverlaps))
+	copy(overlaps, netw.subnetOverlaps)
+	return overlaps
+}
+
+// SetSubnetOverlapMode controls how the overlapping subnets are handled. It is
+// applied on the next connect.
+func (netw *Combined) SetSubnetOverlapMode(mode config.SubnetOverlapMode) {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+	netw.subnetOverlapMode = mode
+}
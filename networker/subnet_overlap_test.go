@@ -0,0 +1,178 @@
+package networker
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/routes"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingRouter struct {
+	workingRouter
+	added []routes.Route
+	err   error
+}
+
+func (r *recordingRouter) Add(route routes.Route) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.added = append(r.added, route)
+	return nil
+}
+
+func TestFindSubnetOverlaps(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	lan := map[string][]netip.Prefix{
+		"wlan0": {netip.MustParsePrefix("192.168.1.0/24")},
+		"eth0":  {netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("100.100.0.0/16")},
+	}
+	vpn := []netip.Prefix{netip.MustParsePrefix("10.5.0.0/16"), defaultMeshSubnet}
+
+	overlaps := findSubnetOverlaps(lan, vpn)
+	assert.Equal(t, []SubnetOverlap{
+		{
+			LAN:       netip.MustParsePrefix("10.0.0.0/8"),
+			Interface: "eth0",
+			VPN:       netip.MustParsePrefix("10.5.0.0/16"),
+		},
+		{
+			LAN:       netip.MustParsePrefix("100.100.0.0/16"),
+			Interface: "eth0",
+			VPN:       defaultMeshSubnet,
+		},
+	}, overlaps)
+	assert.Equal(t, netip.MustParsePrefix("10.5.0.0/16"), overlaps[0].Overlap())
+	assert.Equal(t, netip.MustParsePrefix("100.100.0.0/16"), overlaps[1].Overlap())
+}
+
+func TestOverlapRoutes(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		overlap  netip.Prefix
+		expected []netip.Prefix
+	}{
+		{
+			name:    "ipv4",
+			overlap: netip.MustParsePrefix("10.5.0.0/16"),
+			expected: []netip.Prefix{
+				netip.MustParsePrefix("10.5.0.0/17"),
+				netip.MustParsePrefix("10.5.128.0/17"),
+			},
+		},
+		{
+			name:    "ipv4 unaligned",
+			overlap: netip.MustParsePrefix("192.168.1.0/27"),
+			expected: []netip.Prefix{
+				netip.MustParsePrefix("192.168.1.0/28"),
+				netip.MustParsePrefix("192.168.1.16/28"),
+			},
+		},
+		{
+			name:    "ipv6",
+			overlap: netip.MustParsePrefix("fd00::/8"),
+			expected: []netip.Prefix{
+				netip.MustParsePrefix("fd00::/9"),
+				netip.MustParsePrefix("fd80::/9"),
+			},
+		},
+		{
+			name:     "single address",
+			overlap:  netip.MustParsePrefix("10.5.0.2/32"),
+			expected: []netip.Prefix{netip.MustParsePrefix("10.5.0.2/32")},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var subnets []netip.Prefix
+			for _, route := range overlapRoutes(test.overlap, mock.En1Interface) {
+				assert.Equal(t, mock.En1Interface.Name, route.Device.Name)
+				assert.Zero(t, route.TableID)
+				subnets = append(subnets, route.Subnet)
+			}
+			assert.Equal(t, test.expected, subnets)
+		})
+	}
+}
+
+func TestCombined_HandleSubnetOverlaps(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	// en0 is the tunnel of the mock VPN, en1 is the LAN interface
+	subnets := func(iface net.Interface) ([]netip.Prefix, error) {
+		switch iface.Name {
+		case mock.En0Interface.Name:
+			return []netip.Prefix{netip.MustParsePrefix("10.5.0.0/16")}, nil
+		case mock.En1Interface.Name:
+			return []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}, nil
+		}
+		return nil, nil
+	}
+	devices := func() ([]net.Interface, error) {
+		return []net.Interface{mock.En0Interface, mock.En1Interface}, nil
+	}
+
+	tests := []struct {
+		name              string
+		mode              config.SubnetOverlapMode
+		expectedPreferred string
+		expectedDevice    string
+	}{
+		{
+			name: "warn",
+			mode: config.SubnetOverlapWarn,
+		},
+		{
+			name:              "prefer LAN",
+			mode:              config.SubnetOverlapPreferLAN,
+			expectedPreferred: mock.En1Interface.Name,
+			expectedDevice:    mock.En1Interface.Name,
+		},
+		{
+			name:              "prefer VPN",
+			mode:              config.SubnetOverlapPreferVPN,
+			expectedPreferred: mock.En0Interface.Name,
+			expectedDevice:    mock.En0Interface.Name,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			router := &recordingRouter{}
+			netw := Combined{
+				vpnet:             &mock.WorkingVPN{},
+				devices:           devices,
+				router:            router,
+				subnets:           subnets,
+				subnetOverlapMode: test.mode,
+				isVpnSet:          true,
+			}
+
+			netw.handleSubnetOverlaps()
+
+			overlaps := netw.SubnetOverlaps()
+			assert.Len(t, overlaps, 1)
+			assert.Equal(t, netip.MustParsePrefix("10.0.0.0/8"), overlaps[0].LAN)
+			assert.Equal(t, netip.MustParsePrefix("10.5.0.0/16"), overlaps[0].VPN)
+			assert.Equal(t, test.expectedPreferred, overlaps[0].Preferred)
+
+			if test.expectedDevice == "" {
+				assert.Empty(t, router.added)
+				return
+			}
+			assert.Len(t, router.added, 2)
+			for _, route := range router.added {
+				assert.Equal(t, test.expectedDevice, route.Device.Name)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package networker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/tunnel"
+)
+
+// trafficSampleInterval is how often the transferred bytes are sampled
+const trafficSampleInterval = time.Second
+
+// Throughput of the connection in bytes per second
+type Throughput struct {
+	Download uint64
+	Upload   uint64
+}
+
+type trafficSample struct {
+	stats tunnel.Statistics
+	at    time.Time
+}
+
+// trafficSampler measures the throughput of the tunnel by periodically
+// sampling the amount of the transferred bytes
+type trafficSampler struct {
+	vpnet vpn.VPN
+	mu    sync.Mutex
+	// last is the latest sample, zero until the first one is taken
+	last trafficSample
+	rate Throughput
+	done chan struct{}
+}
+
+func newTrafficSampler(vpnet vpn.VPN) *trafficSampler {
+	return &trafficSampler{vpnet: vpnet, done: make(chan struct{})}
+}
+
+// run samples the traffic until the sampler is stopped
+func (s *trafficSampler) run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case now := <-ticker.C:
+			tun := s.vpnet.Tun()
+			if tun == nil {
+				continue
+			}
+			stats, err := tun.TransferRates()
+			if err != nil {
+				continue
+			}
+			s.add(stats, now)
+		}
+	}
+}
+
+func (s *trafficSampler) stop() {
+	close(s.done)
+}
+
+// add the sample and update the throughput. Counters going backwards mean
+// that the tunnel was recreated, so the throughput is not known until the
+// next sample.
+func (s *trafficSampler) add(stats tunnel.Statistics, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	last := s.last
+	s.last = trafficSample{stats: stats, at: at}
+	elapsed := at.Sub(last.at).Seconds()
+	if last.at.IsZero() || elapsed <= 0 || stats.Rx < last.stats.Rx || stats.Tx < last.stats.Tx {
+		s.rate = Throughput{}
+		return
+	}
+	s.rate = Throughput{
+		Download: uint64(float64(stats.Rx-last.stats.Rx) / elapsed),
+		Upload:   uint64(float64(stats.Tx-last.stats.Tx) / elapsed),
+	}
+}
+
+func (s *trafficSampler) throughput() Throughput {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rate
+}
+
+// startTrafficSampler replaces the running sampler with the one for the
+// current VPN. Thread unsafe.
+func (netw *Combined) startTrafficSampler() {
+	netw.stopTrafficSampler()
+	netw.traffic = newTrafficSampler(netw.vpnet)
+	go netw.traffic.run(trafficSampleInterval)
+}
+
+// Thread unsafe.
+func (netw *Combined) stopTrafficSampler() {
+	if netw.traffic == nil {
+		return
+	}
+	netw.traffic.stop()
+	netw.traffic = nil
+}
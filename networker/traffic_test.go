@@ -0,0 +1,71 @@
+package networker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/tunnel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrafficSampler_Throughput(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		samples  []trafficSample
+		expected Throughput
+	}{
+		{
+			name: "no samples",
+		},
+		{
+			name:    "single sample",
+			samples: []trafficSample{{stats: tunnel.Statistics{Rx: 1000, Tx: 100}, at: start}},
+		},
+		{
+			name: "one second apart",
+			samples: []trafficSample{
+				{stats: tunnel.Statistics{Rx: 1000, Tx: 100}, at: start},
+				{stats: tunnel.Statistics{Rx: 3000, Tx: 600}, at: start.Add(time.Second)},
+			},
+			expected: Throughput{Download: 2000, Upload: 500},
+		},
+		{
+			name: "delayed sample",
+			samples: []trafficSample{
+				{stats: tunnel.Statistics{Rx: 1000, Tx: 100}, at: start},
+				{stats: tunnel.Statistics{Rx: 5000, Tx: 900}, at: start.Add(2 * time.Second)},
+			},
+			expected: Throughput{Download: 2000, Upload: 400},
+		},
+		{
+			name: "counters reset",
+			samples: []trafficSample{
+				{stats: tunnel.Statistics{Rx: 5000, Tx: 900}, at: start},
+				{stats: tunnel.Statistics{Rx: 100, Tx: 10}, at: start.Add(time.Second)},
+			},
+		},
+		{
+			name: "after counters reset",
+			samples: []trafficSample{
+				{stats: tunnel.Statistics{Rx: 5000, Tx: 900}, at: start},
+				{stats: tunnel.Statistics{Rx: 100, Tx: 10}, at: start.Add(time.Second)},
+				{stats: tunnel.Statistics{Rx: 300, Tx: 20}, at: start.Add(2 * time.Second)},
+			},
+			expected: Throughput{Download: 200, Upload: 10},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sampler := newTrafficSampler(nil)
+			for _, sample := range test.samples {
+				sampler.add(sample.stats, sample.at)
+			}
+			assert.Equal(t, test.expected, sampler.throughput())
+		})
+	}
+}
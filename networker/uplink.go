@@ -0,0 +1,111 @@
+package networker
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/netip"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+var (
+	errUplinkDown      = errors.New("uplink is down")
+	errUplinkNoGateway = errors.New("uplink has no default gateway")
+)
+
+// pinUplink routes the packets to the VPN server through the uplink selected
+// for the connection instead of following the default route. With multi-hop
+// the entry server is routed, as the tunnel to the exit server is nested
+// inside. The route is replaced, so that it follows the changes of the uplink.
+func (netw *Combined) pinUplink(serverData vpn.ServerData) error {
+	netw.unpinUplink()
+	if serverData.Uplink == "" {
+		return nil
+	}
+
+	server := serverData.IP
+	if serverData.Via != nil {
+		server = serverData.Via.IP
+	}
+	unpin, err := netw.routeThroughUplink(server, serverData.Uplink)
+	if err != nil {
+		return fmt.Errorf("routing server through %s: %w", serverData.Uplink, err)
+	}
+	netw.uplinkUnpin = unpin
+	return nil
+}
+
+// unpinUplink removes the route to the VPN server through the uplink if it was added
+func (netw *Combined) unpinUplink() {
+	if netw.uplinkUnpin == nil {
+		return
+	}
+	if err := netw.uplinkUnpin(); err != nil {
+		log.Println(internal.WarningPrefix, "removing route through uplink:", err)
+	}
+	netw.uplinkUnpin = nil
+}
+
+// routeThroughUplink adds the route to the server through the default gateway
+// of the uplink. Point-to-point uplinks, e.g. mobile modems, are routed
+// directly. Returns the function removing the route.
+func routeThroughUplink(server netip.Addr, uplink string) (func() error, error) {
+	link, err := netlink.LinkByName(uplink)
+	if err != nil {
+		return nil, err
+	}
+	attrs := link.Attrs()
+	if attrs.Flags&net.FlagUp == 0 {
+		return nil, errUplinkDown
+	}
+
+	family := netlink.FAMILY_V4
+	if server.Is6() {
+		family = netlink.FAMILY_V6
+	}
+	list, err := netlink.RouteListFiltered(
+		family,
+		&netlink.Route{LinkIndex: attrs.Index, Table: unix.RT_TABLE_MAIN},
+		netlink.RT_FILTER_OIF|netlink.RT_FILTER_TABLE,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing routes: %w", err)
+	}
+	var gateway net.IP
+	for _, route := range list {
+		if isDefaultRoute(route) && route.Gw != nil {
+			gateway = route.Gw
+			break
+		}
+	}
+	if gateway == nil && attrs.Flags&net.FlagPointToPoint == 0 {
+		return nil, errUplinkNoGateway
+	}
+
+	route := &netlink.Route{
+		LinkIndex: attrs.Index,
+		Dst: &net.IPNet{
+			IP:   server.AsSlice(),
+			Mask: net.CIDRMask(server.BitLen(), server.BitLen()),
+		},
+		Gw:    gateway,
+		Table: unix.RT_TABLE_MAIN,
+	}
+	if err := netlink.RouteReplace(route); err != nil {
+		return nil, fmt.Errorf("adding route: %w", err)
+	}
+	return func() error { return netlink.RouteDel(route) }, nil
+}
+
+func isDefaultRoute(route netlink.Route) bool {
+	if route.Dst == nil {
+		return true
+	}
+	ones, _ := route.Dst.Mask.Size()
+	return ones == 0
+}
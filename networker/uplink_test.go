@@ -0,0 +1,115 @@
+package networker
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+// uplinkRoutes records the routes to the servers through the uplinks
+type uplinkRoutes struct {
+	routes map[netip.Addr]string
+	err    error
+}
+
+func (u *uplinkRoutes) route(server netip.Addr, uplink string) (func() error, error) {
+	if u.err != nil {
+		return nil, u.err
+	}
+	u.routes[server] = uplink
+	return func() error {
+		delete(u.routes, server)
+		return nil
+	}, nil
+}
+
+func TestCombined_PinUplink(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name       string
+		serverData vpn.ServerData
+		routeErr   error
+		hasError   bool
+		expected   map[netip.Addr]string
+	}{
+		{
+			name:       "default route",
+			serverData: vpn.ServerData{IP: netip.MustParseAddr("2.2.2.2")},
+			expected:   map[netip.Addr]string{},
+		},
+		{
+			name:       "pinned to uplink",
+			serverData: vpn.ServerData{IP: netip.MustParseAddr("2.2.2.2"), Uplink: "wwan0"},
+			expected:   map[netip.Addr]string{netip.MustParseAddr("2.2.2.2"): "wwan0"},
+		},
+		{
+			name: "multi-hop pins entry server",
+			serverData: vpn.ServerData{
+				IP:     netip.MustParseAddr("2.2.2.2"),
+				Via:    &vpn.ServerData{IP: netip.MustParseAddr("1.1.1.1")},
+				Uplink: "wwan0",
+			},
+			expected: map[netip.Addr]string{netip.MustParseAddr("1.1.1.1"): "wwan0"},
+		},
+		{
+			name:       "uplink is down",
+			serverData: vpn.ServerData{IP: netip.MustParseAddr("2.2.2.2"), Uplink: "wwan0"},
+			routeErr:   errUplinkDown,
+			hasError:   true,
+			expected:   map[netip.Addr]string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			uplink := &uplinkRoutes{routes: map[netip.Addr]string{}, err: test.routeErr}
+			netw := GetTestCombined()
+			netw.hop = &mock.WorkingVPN{}
+			netw.nestMTU = func(string, string) error { return nil }
+			netw.routeThroughUplink = uplink.route
+
+			err := netw.Start(vpn.Credentials{}, test.serverData, config.Allowlist{}, nil, true)
+			assert.Equal(t, test.hasError, err != nil)
+			assert.Equal(t, test.expected, uplink.routes)
+			if err != nil {
+				return
+			}
+
+			status, err := netw.ConnectionStatus()
+			assert.NoError(t, err)
+			assert.Equal(t, test.serverData.Uplink, status.Uplink)
+
+			assert.NoError(t, netw.Stop())
+			assert.Empty(t, uplink.routes)
+		})
+	}
+}
+
+func TestCombined_PinUplinkOnNetworkChange(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var pinned, unpinned int
+	netw := GetTestCombined()
+	netw.routeThroughUplink = func(netip.Addr, string) (func() error, error) {
+		pinned++
+		return func() error {
+			unpinned++
+			return nil
+		}, nil
+	}
+
+	serverData := vpn.ServerData{IP: netip.MustParseAddr("2.2.2.2"), Uplink: "wwan0"}
+	assert.NoError(t, netw.Start(vpn.Credentials{}, serverData, config.Allowlist{}, nil, true))
+	assert.Equal(t, 1, pinned)
+
+	// route is replaced, as the gateway of the uplink may have changed
+	assert.NoError(t, netw.handleNetworkChanged())
+	assert.Equal(t, 2, pinned)
+	assert.Equal(t, 1, unpinned)
+}
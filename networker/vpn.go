@@ -42,6 +42,10 @@ func (netw *Combined) handleNetworkChanged() error {
 	}
 
 	if netw.isVpnSet {
+		// gateway of the uplink may have changed together with its address
+		if err := netw.pinUplink(netw.lastServer); err != nil {
+			return err
+		}
 		// for Nordlynx VPN + Meshnet NetworkChanged was already executed, so skip
 		vpn, ok := netw.mesh.(vpn.VPN)
 		if netw.isMeshnetSet && ok && vpn == netw.vpnet {
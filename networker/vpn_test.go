@@ -57,8 +57,14 @@ func TestVPNNetworker_IsVPNActive(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				0,
 				false,
+				config.DefaultRouteReplace,
+				true,
 			)
 			// injecting VPN implementation without calling netw.Start
 			netw.vpnet = test.vpn
@@ -0,0 +1,79 @@
+package request
+
+import (
+	"net/http"
+	"net/url"
+	"sync/atomic"
+)
+
+// MirrorRoundTripper sends the requests to the host to its mirrors when the
+// host cannot be reached. Mirror which responded last is used for the following
+// requests, until it cannot be reached as well.
+type MirrorRoundTripper struct {
+	inner http.RoundTripper
+	// hosts contain the original host followed by the mirrors
+	hosts   []*url.URL
+	current *atomic.Int64
+}
+
+// NewMirrorRoundTripper sends the requests to the host of baseURL to the
+// mirrors, which are base URLs as well
+func NewMirrorRoundTripper(inner http.RoundTripper, baseURL string, mirrors []string) (*MirrorRoundTripper, error) {
+	hosts := make([]*url.URL, 0, len(mirrors)+1)
+	for _, rawURL := range append([]string{baseURL}, mirrors...) {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, err
+		}
+		hosts = append(hosts, u)
+	}
+	if inner == nil {
+		inner = http.DefaultTransport
+	}
+	return &MirrorRoundTripper{inner: inner, hosts: hosts, current: &atomic.Int64{}}, nil
+}
+
+func (rt *MirrorRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host != rt.hosts[0].Host {
+		return rt.inner.RoundTrip(req)
+	}
+
+	first := int(rt.current.Load())
+	var lastErr error
+	for i := 0; i < len(rt.hosts); i++ {
+		index := (first + i) % len(rt.hosts)
+		mirrorReq := req
+		if i > 0 || index > 0 {
+			var err error
+			if mirrorReq, err = mirrorRequest(req, rt.hosts[index]); err != nil {
+				return nil, err
+			}
+		}
+		resp, err := rt.inner.RoundTrip(mirrorReq)
+		if err == nil {
+			rt.current.Store(int64(index))
+			return resp, nil
+		}
+		lastErr = err
+		// body cannot be sent again
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			break
+		}
+	}
+	return nil, lastErr
+}
+
+func mirrorRequest(req *http.Request, host *url.URL) (*http.Request, error) {
+	mirrorReq := req.Clone(req.Context())
+	mirrorReq.URL.Scheme = host.Scheme
+	mirrorReq.URL.Host = host.Host
+	mirrorReq.Host = host.Host
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		mirrorReq.Body = body
+	}
+	return mirrorReq, nil
+}
@@ -0,0 +1,112 @@
+package request
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// hostRoundTripper fails the requests to the unreachable hosts and records the
+// requested hosts and bodies
+type hostRoundTripper struct {
+	unreachable map[string]bool
+	hosts       []string
+	bodies      []string
+}
+
+func (m *hostRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	m.hosts = append(m.hosts, req.URL.Host)
+	if req.Body != nil {
+		body, _ := io.ReadAll(req.Body)
+		m.bodies = append(m.bodies, string(body))
+	}
+	if m.unreachable[req.URL.Host] {
+		return nil, err1
+	}
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func TestMirrorRoundTripper_RoundTrip(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name        string
+		url         string
+		unreachable []string
+		hosts       []string
+		err         error
+	}{
+		{
+			name:  "host is reachable",
+			url:   "https://api.example.com/v1/servers",
+			hosts: []string{"api.example.com"},
+		},
+		{
+			name:        "first mirror",
+			url:         "https://api.example.com/v1/servers",
+			unreachable: []string{"api.example.com"},
+			hosts:       []string{"api.example.com", "mirror1.example.com"},
+		},
+		{
+			name:        "second mirror",
+			url:         "https://api.example.com/v1/servers",
+			unreachable: []string{"api.example.com", "mirror1.example.com"},
+			hosts:       []string{"api.example.com", "mirror1.example.com", "mirror2.example.com"},
+		},
+		{
+			name:        "all unreachable",
+			url:         "https://api.example.com/v1/servers",
+			unreachable: []string{"api.example.com", "mirror1.example.com", "mirror2.example.com"},
+			hosts:       []string{"api.example.com", "mirror1.example.com", "mirror2.example.com"},
+			err:         err1,
+		},
+		{
+			name:        "other host",
+			url:         "https://downloads.example.com/config.zip",
+			unreachable: []string{"downloads.example.com"},
+			hosts:       []string{"downloads.example.com"},
+			err:         err1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			inner := &hostRoundTripper{unreachable: map[string]bool{}}
+			for _, host := range test.unreachable {
+				inner.unreachable[host] = true
+			}
+			rt, err := NewMirrorRoundTripper(inner, "https://api.example.com",
+				[]string{"https://mirror1.example.com", "https://mirror2.example.com"})
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodGet, test.url, nil)
+			require.NoError(t, err)
+			_, err = rt.RoundTrip(req)
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.hosts, inner.hosts)
+		})
+	}
+}
+
+func TestMirrorRoundTripper_KeepsWorkingMirror(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	inner := &hostRoundTripper{unreachable: map[string]bool{"api.example.com": true}}
+	rt, err := NewMirrorRoundTripper(inner, "https://api.example.com", []string{"https://mirror.example.com"})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequest(http.MethodPost, "https://api.example.com/v1/users/tokens", bytes.NewBufferString("body"))
+		require.NoError(t, err)
+		_, err = rt.RoundTrip(req)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, []string{"api.example.com", "mirror.example.com", "mirror.example.com"}, inner.hosts)
+	// body is sent to the mirror again
+	assert.Equal(t, []string{"body", "body", "body"}, inner.bodies)
+}
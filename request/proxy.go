@@ -0,0 +1,55 @@
+package request
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Proxy returns the URL of the proxy the request is sent through. Request is
+// sent directly if the URL is nil.
+type Proxy func(*http.Request) (*url.URL, error)
+
+// NewProxy sends all of the requests through the proxy at proxyURL. Proxy is
+// taken from HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables when
+// proxyURL is empty. http, https and socks5 proxies are supported.
+func NewProxy(proxyURL string) (Proxy, error) {
+	if proxyURL == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme: %s", u.Scheme)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("proxy host is missing: %s", proxyURL)
+	}
+	return http.ProxyURL(u), nil
+}
+
+// IsUsedFor returns true if the requests to rawURL are sent through the proxy
+func (p Proxy) IsUsedFor(rawURL string) bool {
+	if p == nil {
+		return false
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	proxyURL, err := p(&http.Request{URL: u})
+	return err == nil && proxyURL != nil
+}
+
+// WithProxy sends the client's requests through the proxy
+func WithProxy(proxy Proxy) StdOpt {
+	return func(client *http.Client) {
+		if transport, ok := client.Transport.(*http.Transport); ok {
+			transport.Proxy = proxy
+		}
+	}
+}
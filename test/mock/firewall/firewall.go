@@ -43,3 +43,8 @@ func (mf *FirewallMock) Enable() error {
 func (mf *FirewallMock) Disable() error {
 	return nil
 }
+
+// Rebuild firewall rules
+func (mf *FirewallMock) Rebuild() (firewall.Reconciliation, error) {
+	return firewall.Reconciliation{}, nil
+}
@@ -7,23 +7,44 @@ import (
 
 	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/networker"
 	"github.com/NordSecurity/nordvpn-linux/test/mock"
 )
 
 type Mock struct {
-	Dns               []string
-	Allowlist         config.Allowlist
-	VpnActive         bool
-	MeshActive        bool
-	ConnectRetries    int
-	LanDiscovery      bool
-	MeshPeers         mesh.MachinePeers
-	MeshnetRetries    int
-	SetDNSErr         error
-	SetAllowlistErr   error
-	UnsetAllowlistErr error
+	Dns                     []string
+	Allowlist               config.Allowlist
+	VpnActive               bool
+	Stopped                 bool
+	MeshActive              bool
+	ConnectRetries          int
+	LanDiscovery            bool
+	DefaultRouteMode        config.DefaultRouteMode
+	FirewallTemplates       config.FirewallTemplates
+	SplitTunnel             config.SplitTunnel
+	DNSIPv6                 bool
+	DNSSearchDomains        []string
+	DNSRoutes               config.DNSRoutes
+	DNSFilter               []string
+	MTU                     uint32
+	KillSwitchLog           bool
+	KillSwitchLAN           bool
+	MulticastDiscovery      bool
+	CaptivePortal           []netip.Addr
+	SubnetOverlapMode       config.SubnetOverlapMode
+	IPv6Mode                config.IPv6Mode
+	Overlaps                []networker.SubnetOverlap
+	MeshPeers               mesh.MachinePeers
+	MeshnetRetries          int
+	SetDNSErr               error
+	SetAllowlistErr         error
+	UnsetAllowlistErr       error
+	SetFirewallTemplatesErr error
+	ConnStatus              networker.ConnectionStatus
+	Reconciliation          firewall.Reconciliation
+	RebuildFirewallErr      error
 }
 
 func (Mock) Start(
@@ -35,9 +56,13 @@ func (Mock) Start(
 ) error {
 	return nil
 }
-func (*Mock) Stop() error      { return nil }
 func (*Mock) UnSetMesh() error { return nil }
 
+func (m *Mock) Stop() error {
+	m.Stopped = true
+	return nil
+}
+
 func (m *Mock) SetDNS(nameservers []string) error {
 	m.Dns = nameservers
 	return m.SetDNSErr
@@ -50,8 +75,8 @@ func (m *Mock) IsVPNActive() bool {
 	return m.VpnActive || m.ConnectRetries > 5
 }
 
-func (*Mock) ConnectionStatus() (networker.ConnectionStatus, error) {
-	return networker.ConnectionStatus{}, nil
+func (m *Mock) ConnectionStatus() (networker.ConnectionStatus, error) {
+	return m.ConnStatus, nil
 }
 
 func (*Mock) EnableFirewall() error  { return nil }
@@ -100,6 +125,88 @@ func (m *Mock) SetLanDiscovery(enabled bool) {
 	m.LanDiscovery = enabled
 }
 
+func (m *Mock) SetDefaultRouteMode(mode config.DefaultRouteMode) {
+	m.DefaultRouteMode = mode
+}
+
+func (m *Mock) SetDNSIPv6(enabled bool) error {
+	m.DNSIPv6 = enabled
+	return nil
+}
+
+func (m *Mock) SetDNSSearchDomains(domains []string) error {
+	m.DNSSearchDomains = domains
+	return nil
+}
+
+func (m *Mock) SetDNSRoutes(routes config.DNSRoutes) error {
+	m.DNSRoutes = routes
+	return nil
+}
+
+func (m *Mock) SetDNSFilter(types []string) error {
+	m.DNSFilter = types
+	return nil
+}
+
+func (m *Mock) SetMTU(mtu uint32) error {
+	m.MTU = mtu
+	return nil
+}
+
+func (m *Mock) SetKillSwitchLog(enabled bool) {
+	m.KillSwitchLog = enabled
+}
+
+func (m *Mock) SetKillSwitchLAN(allowed bool) error {
+	m.KillSwitchLAN = allowed
+	return nil
+}
+
+func (m *Mock) SetMulticastDiscovery(allowed bool) error {
+	m.MulticastDiscovery = allowed
+	return nil
+}
+
+func (m *Mock) AllowCaptivePortal(addrs []netip.Addr) error {
+	m.CaptivePortal = addrs
+	return nil
+}
+
+func (m *Mock) BlockCaptivePortal() error {
+	m.CaptivePortal = nil
+	return nil
+}
+
+func (m *Mock) SetSubnetOverlapMode(mode config.SubnetOverlapMode) {
+	m.SubnetOverlapMode = mode
+}
+
+func (m *Mock) SetIPv6Mode(mode config.IPv6Mode) {
+	m.IPv6Mode = mode
+}
+
+func (m *Mock) SubnetOverlaps() []networker.SubnetOverlap {
+	return m.Overlaps
+}
+
+func (m *Mock) RebuildFirewall() (firewall.Reconciliation, error) {
+	return m.Reconciliation, m.RebuildFirewallErr
+}
+
+func (m *Mock) SetFirewallTemplates(paths config.FirewallTemplates) error {
+	if m.SetFirewallTemplatesErr != nil {
+		return m.SetFirewallTemplatesErr
+	}
+	m.FirewallTemplates = paths
+	return nil
+}
+
+func (m *Mock) SetSplitTunnel(cfg config.SplitTunnel) error {
+	m.SplitTunnel = cfg
+	return nil
+}
+
 type Failing struct{}
 
 func (Failing) Start(
@@ -141,3 +248,22 @@ func (Failing) SetVPN(vpn.VPN)                                      {}
 func (Failing) LastServerName() string                              { return "" }
 func (Failing) SetLanDiscoveryAndResetMesh(bool, mesh.MachinePeers) {}
 func (Failing) SetLanDiscovery(bool)                                {}
+func (Failing) SetDefaultRouteMode(config.DefaultRouteMode)         {}
+func (Failing) SetFirewallTemplates(config.FirewallTemplates) error { return mock.ErrOnPurpose }
+func (Failing) SetSplitTunnel(config.SplitTunnel) error             { return mock.ErrOnPurpose }
+func (Failing) SetDNSIPv6(bool) error                               { return mock.ErrOnPurpose }
+func (Failing) SetDNSSearchDomains([]string) error                  { return mock.ErrOnPurpose }
+func (Failing) SetDNSRoutes(config.DNSRoutes) error                 { return mock.ErrOnPurpose }
+func (Failing) SetDNSFilter([]string) error                         { return mock.ErrOnPurpose }
+func (Failing) SetMTU(uint32) error                                 { return mock.ErrOnPurpose }
+func (Failing) SetKillSwitchLog(bool)                               {}
+func (Failing) SetKillSwitchLAN(bool) error                         { return mock.ErrOnPurpose }
+func (Failing) SetMulticastDiscovery(bool) error                    { return mock.ErrOnPurpose }
+func (Failing) AllowCaptivePortal([]netip.Addr) error               { return mock.ErrOnPurpose }
+func (Failing) BlockCaptivePortal() error                           { return mock.ErrOnPurpose }
+func (Failing) SetSubnetOverlapMode(config.SubnetOverlapMode)       {}
+func (Failing) SetIPv6Mode(config.IPv6Mode)                         {}
+func (Failing) SubnetOverlaps() []networker.SubnetOverlap           { return nil }
+func (Failing) RebuildFirewall() (firewall.Reconciliation, error) {
+	return firewall.Reconciliation{}, mock.ErrOnPurpose
+}
@@ -88,3 +88,6 @@ func (*MeshnetAndVPN) Refresh(mesh.MachineMap) error     { return nil }
 func (*MeshnetAndVPN) StatusMap() (map[string]string, error) {
 	return map[string]string{}, nil
 }
+func (*MeshnetAndVPN) PathMap() (map[string]string, error) {
+	return map[string]string{}, nil
+}